@@ -20,6 +20,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
@@ -27,9 +28,12 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/replay"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/scaler"
 )
@@ -199,6 +203,27 @@ func (c *RemoteIndex) GetObject(ctx context.Context, hostName, indexName,
 	q.Set("selectProperties", selectPropsEncoded)
 	url.RawQuery = q.Encode()
 
+	return c.getObject(ctx, method, url)
+}
+
+// GetObjectAtVersion returns a retained version of an object, nil if the
+// version isn't retained
+func (c *RemoteIndex) GetObjectAtVersion(ctx context.Context, hostName, indexName,
+	shardName string, id strfmt.UUID, version int64,
+) (*storobj.Object, error) {
+	path := fmt.Sprintf("/indices/%s/shards/%s/objects/%s", indexName, shardName, id)
+	method := http.MethodGet
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+	q := url.Query()
+	q.Set("version", strconv.FormatInt(version, 10))
+	url.RawQuery = q.Encode()
+
+	return c.getObject(ctx, method, url)
+}
+
+func (c *RemoteIndex) getObject(ctx context.Context, method string,
+	url url.URL,
+) (*storobj.Object, error) {
 	req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "open http request")
@@ -403,12 +428,13 @@ func (c *RemoteIndex) MultiGetObjects(ctx context.Context, hostName, indexName,
 }
 
 func (c *RemoteIndex) SearchShard(ctx context.Context, hostName, indexName,
-	shardName string, vector []float32, limit int, filters *filters.LocalFilter,
-	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
+	shardName string, vector []float32, targetVector string, targets *searchparams.TargetVectors,
+	distance float32, limit int, filter *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
 	paramsBytes, err := clusterapi.IndicesPayloads.SearchParams.
-		Marshal(vector, limit, filters, keywordRanking, sort, cursor, additional)
+		Marshal(vector, targetVector, targets, distance, limit, filter, keywordRanking, sort, cursor, additional,
+			hnswent.FilterStrategyFromContext(ctx), filters.ShardVectorCursorFromContext(ctx))
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "marshal request payload")
 	}
@@ -424,6 +450,7 @@ func (c *RemoteIndex) SearchShard(ctx context.Context, hostName, indexName,
 	}
 
 	clusterapi.IndicesPayloads.SearchParams.SetContentTypeHeaderReq(req)
+	clusterapi.SetQueryDeadlineHeader(ctx, req)
 	res, err := c.client.Do(req)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "send http request")
@@ -450,6 +477,7 @@ func (c *RemoteIndex) SearchShard(ctx context.Context, hostName, indexName,
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "unmarshal body")
 	}
+	clusterapi.CheckPartialResultsHeader(ctx, res)
 	return objs, dists, nil
 }
 
@@ -473,6 +501,7 @@ func (c *RemoteIndex) Aggregate(ctx context.Context, hostName, indexName,
 	}
 
 	clusterapi.IndicesPayloads.AggregationParams.SetContentTypeHeaderReq(req)
+	clusterapi.SetQueryDeadlineHeader(ctx, req)
 	res, err := c.client.Do(req)
 	if err != nil {
 		return nil, errors.Wrap(err, "send http request")
@@ -503,6 +532,56 @@ func (c *RemoteIndex) Aggregate(ctx context.Context, hostName, indexName,
 	return aggRes, nil
 }
 
+func (c *RemoteIndex) SampleObjects(ctx context.Context, hostName, indexName,
+	shardName string, filters *filters.LocalFilter, sample filters.Sample,
+	additional additional.Properties,
+) ([]*storobj.Object, []uint64, error) {
+	paramsBytes, err := clusterapi.IndicesPayloads.SampleParams.
+		Marshal(filters, sample, additional)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "marshal request payload")
+	}
+
+	path := fmt.Sprintf("/indices/%s/shards/%s/objects/_sample", indexName, shardName)
+	method := http.MethodPost
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(),
+		bytes.NewReader(paramsBytes))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "open http request")
+	}
+
+	clusterapi.IndicesPayloads.SampleParams.SetContentTypeHeaderReq(req)
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "send http request")
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return nil, nil, errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
+			body)
+	}
+
+	resBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "read body")
+	}
+
+	ct, ok := clusterapi.IndicesPayloads.SampleResults.CheckContentTypeHeader(res)
+	if !ok {
+		return nil, nil, errors.Errorf("unexpected content type: %s", ct)
+	}
+
+	objs, priorities, err := clusterapi.IndicesPayloads.SampleResults.Unmarshal(resBytes)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "unmarshal body")
+	}
+	return objs, priorities, nil
+}
+
 func (c *RemoteIndex) FindDocIDs(ctx context.Context, hostName, indexName,
 	shardName string, filters *filters.LocalFilter,
 ) ([]uint64, error) {
@@ -686,6 +765,179 @@ func (c *RemoteIndex) UpdateShardStatus(ctx context.Context, hostName, indexName
 	return c.retry(ctx, 9, try)
 }
 
+func (c *RemoteIndex) OptimizeShard(ctx context.Context, hostName, indexName,
+	shardName string,
+) (*models.ShardOptimizeResult, error) {
+	path := fmt.Sprintf("/indices/%s/shards/%s:optimize", indexName, shardName)
+	method := http.MethodPost
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	var result *models.ShardOptimizeResult
+	try := func(ctx context.Context) (bool, error) {
+		req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
+		if err != nil {
+			return false, fmt.Errorf("create http request: %w", err)
+		}
+
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusOK {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		resBytes, err := io.ReadAll(res.Body)
+		if err != nil {
+			return false, errors.Wrap(err, "read body")
+		}
+
+		ct, ok := clusterapi.IndicesPayloads.ShardOptimizeResult.CheckContentTypeHeader(res)
+		if !ok {
+			return false, errors.Errorf("unexpected content type: %s", ct)
+		}
+
+		result, err = clusterapi.IndicesPayloads.ShardOptimizeResult.Unmarshal(resBytes)
+		if err != nil {
+			return false, errors.Wrap(err, "unmarshal body")
+		}
+		return false, nil
+	}
+
+	return result, c.retry(ctx, 9, try)
+}
+
+func (c *RemoteIndex) CleanupInvertedShard(ctx context.Context, hostName, indexName,
+	shardName string,
+) (*models.ShardInvertedCleanupResult, error) {
+	path := fmt.Sprintf("/indices/%s/shards/%s:cleanup-inverted", indexName, shardName)
+	method := http.MethodPost
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	var result *models.ShardInvertedCleanupResult
+	try := func(ctx context.Context) (bool, error) {
+		req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
+		if err != nil {
+			return false, fmt.Errorf("create http request: %w", err)
+		}
+
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusOK {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		resBytes, err := io.ReadAll(res.Body)
+		if err != nil {
+			return false, errors.Wrap(err, "read body")
+		}
+
+		ct, ok := clusterapi.IndicesPayloads.ShardCleanupResult.CheckContentTypeHeader(res)
+		if !ok {
+			return false, errors.Errorf("unexpected content type: %s", ct)
+		}
+
+		result, err = clusterapi.IndicesPayloads.ShardCleanupResult.Unmarshal(resBytes)
+		if err != nil {
+			return false, errors.Wrap(err, "unmarshal body")
+		}
+		return false, nil
+	}
+
+	return result, c.retry(ctx, 9, try)
+}
+
+func (c *RemoteIndex) PrepareMigrationShard(ctx context.Context, hostName, indexName,
+	shardName string, migration *models.SchemaMigration,
+) error {
+	path := fmt.Sprintf("/indices/%s/shards/%s:migrate", indexName, shardName)
+	method := http.MethodPost
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	marshalled, err := clusterapi.IndicesPayloads.SchemaMigration.Marshal(migration)
+	if err != nil {
+		return errors.Wrap(err, "marshal payload")
+	}
+
+	try := func(ctx context.Context) (bool, error) {
+		req, err := http.NewRequestWithContext(ctx, method, url.String(),
+			bytes.NewReader(marshalled))
+		if err != nil {
+			return false, fmt.Errorf("create http request: %w", err)
+		}
+		clusterapi.IndicesPayloads.SchemaMigration.SetContentTypeHeaderReq(req)
+
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusNoContent {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		return false, nil
+	}
+
+	return c.retry(ctx, 9, try)
+}
+
+func (c *RemoteIndex) ReplayShard(ctx context.Context, hostName, indexName,
+	shardName string, from, to int64, after replay.Cursor, limit int,
+) (*replay.Page, error) {
+	path := fmt.Sprintf("/indices/%s/shards/%s/objects:replay", indexName, shardName)
+	query := url.Values{}
+	query.Set("from", strconv.FormatInt(from, 10))
+	query.Set("to", strconv.FormatInt(to, 10))
+	query.Set("after", after.String())
+	query.Set("limit", strconv.Itoa(limit))
+	method := http.MethodGet
+	url := url.URL{Scheme: "http", Host: hostName, Path: path, RawQuery: query.Encode()}
+
+	var page *replay.Page
+	try := func(ctx context.Context) (bool, error) {
+		req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
+		if err != nil {
+			return false, fmt.Errorf("create http request: %w", err)
+		}
+
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusOK {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		resBytes, err := io.ReadAll(res.Body)
+		if err != nil {
+			return false, errors.Wrap(err, "read body")
+		}
+
+		ct, ok := clusterapi.IndicesPayloads.ReplayPage.CheckContentTypeHeader(res)
+		if !ok {
+			return false, errors.Errorf("unexpected content type: %s", ct)
+		}
+
+		page, err = clusterapi.IndicesPayloads.ReplayPage.Unmarshal(resBytes)
+		if err != nil {
+			return false, errors.Wrap(err, "unmarshal body")
+		}
+		return false, nil
+	}
+
+	return page, c.retry(ctx, 9, try)
+}
+
 func (c *RemoteIndex) PutFile(ctx context.Context, hostName, indexName,
 	shardName, fileName string, payload io.ReadSeekCloser,
 ) error {
@@ -813,3 +1065,38 @@ func (c *RemoteIndex) IncreaseReplicationFactor(ctx context.Context,
 	}
 	return c.retry(ctx, 34, try)
 }
+
+// MoveReplicas asks the remote node to move its replicas of the shards to
+// the nodes of the distribution
+func (c *RemoteIndex) MoveReplicas(ctx context.Context,
+	hostName, indexName string, dist scaler.ShardDist,
+) error {
+	path := fmt.Sprintf("/replicas/indices/%s/replicas:move", indexName)
+
+	method := http.MethodPut
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	body, err := clusterapi.IndicesPayloads.MoveReplicas.Marshall(dist)
+	if err != nil {
+		return err
+	}
+	try := func(ctx context.Context) (bool, error) {
+		req, err := http.NewRequestWithContext(ctx, method, url.String(), bytes.NewReader(body))
+		if err != nil {
+			return false, fmt.Errorf("create http request: %w", err)
+		}
+
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusNoContent {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		return false, nil
+	}
+	return c.retry(ctx, 9, try)
+}
@@ -33,7 +33,10 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/clusterapi"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/querytimeout"
 )
 
 func TestRemoteIndexIncreaseRF(t *testing.T) {
@@ -248,6 +251,43 @@ func TestRemoteIndexPutFile(t *testing.T) {
 	})
 }
 
+func TestRemoteIndexSearchShardPartialResults(t *testing.T) {
+	t.Parallel()
+	var (
+		path = "/indices/C1/shards/S1/objects/_search"
+		fs   = newFakeRemoteIndexServer(t, http.MethodPost, path)
+	)
+	ts := fs.server(t)
+	defer ts.Close()
+	client := newRemoteIndex(ts.Client())
+
+	partial := false
+	fs.doAfter = func(w http.ResponseWriter, r *http.Request) {
+		clusterapi.IndicesPayloads.SearchResults.SetContentTypeHeader(w)
+		if partial {
+			w.Header().Set("X-Weaviate-Partial-Results", "true")
+		}
+		bytes, _ := clusterapi.IndicesPayloads.SearchResults.Marshal(nil, nil)
+		w.Write(bytes)
+	}
+
+	search := func() context.Context {
+		ctx := querytimeout.WithPartialResults(context.Background())
+		_, _, err := client.SearchShard(ctx, fs.host, "C1", "S1", nil, "", nil, 0, 10,
+			nil, nil, nil, nil, additional.Properties{})
+		require.Nil(t, err)
+		return ctx
+	}
+
+	t.Run("complete results", func(t *testing.T) {
+		assert.False(t, querytimeout.Partial(search()))
+	})
+	t.Run("search stopped at the partial deadline", func(t *testing.T) {
+		partial = true
+		assert.True(t, querytimeout.Partial(search()))
+	})
+}
+
 func newRemoteIndex(httpClient *http.Client) *RemoteIndex {
 	ri := NewRemoteIndex(httpClient)
 	ri.minBackOff = time.Millisecond * 1
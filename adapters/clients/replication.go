@@ -22,6 +22,7 @@ import (
 	"math/rand"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/go-openapi/strfmt"
@@ -76,6 +77,38 @@ func (c *replicationClient) DigestObjects(ctx context.Context,
 	return resp, err
 }
 
+func (c *replicationClient) HashTree(ctx context.Context,
+	host, index, shard string, height int,
+) (*replica.HashTree, error) {
+	var resp replica.HashTree
+	req, err := newHttpReplicaRequest(
+		ctx, http.MethodGet, host, index, shard,
+		"", "_hashtree", nil)
+	if err != nil {
+		return nil, fmt.Errorf("create http request: %w", err)
+	}
+	req.URL.RawQuery = url.Values{"height": []string{strconv.Itoa(height)}}.Encode()
+	if err := c.do(c.timeoutUnit*90, req, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *replicationClient) DigestLeaf(ctx context.Context,
+	host, index, shard string, height, leaf int,
+) ([]replica.RepairResponse, error) {
+	var resp []replica.RepairResponse
+	req, err := newHttpReplicaRequest(
+		ctx, http.MethodGet, host, index, shard,
+		"", fmt.Sprintf("_hashtree/%d", leaf), nil)
+	if err != nil {
+		return nil, fmt.Errorf("create http request: %w", err)
+	}
+	req.URL.RawQuery = url.Values{"height": []string{strconv.Itoa(height)}}.Encode()
+	err = c.do(c.timeoutUnit*90, req, nil, &resp)
+	return resp, err
+}
+
 func (c *replicationClient) OverwriteObjects(ctx context.Context,
 	host, index, shard string, vobjects []*objects.VObject,
 ) ([]replica.RepairResponse, error) {
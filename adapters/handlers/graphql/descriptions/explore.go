@@ -23,6 +23,11 @@ const (
 	Certainty            = "Normalized Distance between the result item and the search vector. Normalized to be between 0 (identical vectors) and 1 (perfect opposite)."
 	Distance             = "The required degree of similarity between an object's characteristics and the provided filter values"
 	Vector               = "Target vector to be used in kNN search"
+	TargetVector         = "Name of the vector space of the class to search, the default vector is searched if not set"
+	TargetVectors        = "Names of several vector spaces of the class to search at once, their distances are combined. The default vector is referred to by an empty name"
+	VectorPerTarget      = "Vectors to search the given targetVectors with instead of the vector, e.g. if their dimensions differ"
+	TargetCombination    = "How the distances of several target vectors are combined: 'sum', 'average', 'min' or 'relative', which sums the distances normalized to the range of each target. Defaults to 'sum'"
+	FilterStrategy       = "How a filtered search traverses the vector index: 'sweeping' skips the nodes which don't match the filter, 'acorn' only evaluates matching nodes and is faster for very selective filters. The setting of the class is used if not set"
 	Force                = "The force to apply for a particular movements. Must be between 0 and 1 where 0 is equivalent to no movement and 1 is equivalent to largest movement possible"
 	ClassName            = "Name of the Class"
 	ID                   = "Concept identifier in the uuid format"
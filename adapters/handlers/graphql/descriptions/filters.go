@@ -66,6 +66,13 @@ const (
 	WhereValueRangeGeoCoordinatesLongitude = "The longitude (in decimal format) of the geoCoordinates to search around."
 	WhereValueRangeDistance                = "The distance from the point specified via geoCoordinates."
 	WhereValueRangeDistanceMax             = "The maximum distance from the point specified geoCoordinates."
+	WhereValueGeoPolygon                   = "Specify the points of a polygon. The search will return any result which is located within the polygon. The last point is connected to the first one."
+	WhereValueGeoPolygonPoints             = "The points (latitude and longitude as decimals) of the polygon, at least 3 are required."
+	WhereValueGeoBox                       = "Specify the corners of a bounding box. The search will return any result which is located within the box."
+	WhereValueGeoBoxTopLeft                = "The north-western corner of the box."
+	WhereValueGeoBoxBottomRight            = "The south-eastern corner of the box, the box crosses the antimeridian if its longitude is lower than the one of topLeft."
+	WhereValueGeoCoordinatesLatitude       = "The latitude (in decimal format) of the point."
+	WhereValueGeoCoordinatesLongitude      = "The longitude (in decimal format) of the point."
 	WhereValueText                         = "Specify a Text value that the target property will be compared to"
 	WhereValueDate                         = "Specify a Date value that the target property will be compared to"
 )
@@ -145,3 +152,12 @@ const (
 	SortPath  = "Specify the path from the Objects fields to the property name (e.g. ['Get', 'City', 'population'] leads to the 'population' property of a 'City' object)"
 	SortOrder = "Specify the sort order, either ascending (asc) which is default or descending (desc)"
 )
+
+// Sampling
+const (
+	Sample = "Return a uniform random sample of the objects matching the where filter " +
+		"instead of the first ones"
+	SampleSize = "The number of objects in the sample"
+	SampleSeed = "The seed of the sample, the same seed returns the same sample as long " +
+		"as the objects don't change. A random seed is used if none is set"
+)
@@ -26,6 +26,43 @@ const GetObjectsActionsObj = "An object used to get %ss on a local Weaviate"
 
 const GetClassUUID = "The UUID of a Object, assigned by its local Weaviate"
 
+const (
+	GetFederated        = "Search several classes which share a vectorizer at once and merge the results by their normalized score"
+	GetFederatedClasses = "The classes to search"
+	GetFederatedResult  = "An object of one of the searched classes, select its properties with inline fragments"
+
+	GetEntity                  = "An object referenced by another subgraph of a federated graph"
+	GetEntities                = "Resolve the objects referenced by other subgraphs of a federated graph"
+	GetEntitiesRepresentations = "The references to the objects, each with the __typename and the @key of its class"
+	GetEntitiesAny             = "A reference to an object, a JSON object with the __typename and the @key of its class"
+	GetService                 = "The subgraph of this Weaviate in a federated graph"
+	GetServiceSDL              = "The schema of the subgraph with the @key of the classes"
+
+	GetAdditionalSourceClass     = "The class of the object in a federated search"
+	GetAdditionalNormalizedScore = "The score of the object in a federated search, normalized to [0, 1] within its class"
+)
+
+const GetAdditionalDistanceMeters = "The distance in meters between the object and the point of the " +
+	"withinGeoRange filter of the query"
+
+const (
+	GetVectorCursor = "Continue a vector search after a result, pass the _additional vectorCursor of the " +
+		"last result of the previous page. A search by distance without a limit returns pages of the " +
+		"maximum number of results until all results within the distance have been returned. " +
+		"A shard can return at most QUERY_MAXIMUM_VECTOR_CURSOR_DEPTH results across the pages. " +
+		"Can't be combined with offset, sort, group or functionScore"
+	GetAdditionalVectorCursor = "The position of the object in a vector search, pass it as vectorCursor " +
+		"to get the next page of results"
+	GetAdditionalRouting = "The node and shard which served the object and the nodes holding its replicas, " +
+		"send follow-up requests for the object to one of them to avoid a proxied request"
+)
+
+const GetFunctionScore = "Re-rank the results by an expression over their properties and the variables " +
+	"_score, _distance, _geoDistance, _creationTime and _lastUpdateTime, e.g. " +
+	"'_score * decay(_geoDistance, 1000)'. The functions abs, sqrt, log, log1p, min, max, pow, " +
+	"decay(value, halfLife) and recency(time, halfLifeSeconds) are available. " +
+	"The result of the expression replaces the score of the results."
+
 // Network
 const (
 	NetworkGet    = "Get Objects from a Weaviate in a network"
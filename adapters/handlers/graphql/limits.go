@@ -0,0 +1,211 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package graphql
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql/language/ast"
+	"github.com/tailor-inc/graphql/language/parser"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// maxEstimatedComplexity caps the estimate, so it can't overflow
+const maxEstimatedComplexity = 1 << 53
+
+// QueryStats describe the shape of a GraphQL query. Fragments are counted
+// wherever they are spread.
+type QueryStats struct {
+	// Depth is the deepest nesting of fields, e.g. 3 for
+	// { Get { Article { title } } }
+	Depth int
+	// Aliases is the number of aliased fields
+	Aliases int
+	// Complexity estimates the number of resolved fields: each field counts
+	// once, fields below a field with a limit argument count limit times.
+	Complexity int
+}
+
+// CheckLimits rejects a query which exceeds the limits before it is
+// validated or executed, as a single pathological query, such as deeply
+// nested references, can exhaust the memory of a node
+func CheckLimits(query, operationName string, variables map[string]interface{},
+	limits config.GraphQLLimit,
+) error {
+	if limits.MaxDepth <= 0 && limits.MaxAliases <= 0 && limits.MaxComplexity <= 0 {
+		return nil
+	}
+
+	stats, err := AnalyzeQuery(query, operationName, variables)
+	if err != nil {
+		// syntax errors are reported by the regular execution
+		return nil
+	}
+
+	if limits.MaxDepth > 0 && stats.Depth > limits.MaxDepth {
+		return fmt.Errorf("query depth %d exceeds the limit of %d", stats.Depth, limits.MaxDepth)
+	}
+	if limits.MaxAliases > 0 && stats.Aliases > limits.MaxAliases {
+		return fmt.Errorf("query has %d aliases, which exceeds the limit of %d",
+			stats.Aliases, limits.MaxAliases)
+	}
+	if limits.MaxComplexity > 0 && stats.Complexity > limits.MaxComplexity {
+		return fmt.Errorf("estimated query complexity %d exceeds the limit of %d",
+			stats.Complexity, limits.MaxComplexity)
+	}
+	return nil
+}
+
+// AnalyzeQuery returns the stats of the operation which would be executed.
+// Without an operation name the stats of all operations are combined.
+func AnalyzeQuery(query, operationName string,
+	variables map[string]interface{},
+) (QueryStats, error) {
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return QueryStats{}, err
+	}
+
+	a := &queryAnalyzer{
+		fragments: map[string]*ast.FragmentDefinition{},
+		memo:      map[string]QueryStats{},
+		visiting:  map[string]bool{},
+		variables: variables,
+	}
+	var operations []*ast.OperationDefinition
+	for _, def := range doc.Definitions {
+		switch d := def.(type) {
+		case *ast.FragmentDefinition:
+			a.fragments[d.Name.Value] = d
+		case *ast.OperationDefinition:
+			if operationName == "" || (d.Name != nil && d.Name.Value == operationName) {
+				operations = append(operations, d)
+			}
+		}
+	}
+
+	var stats QueryStats
+	for _, op := range operations {
+		opStats := a.selectionSet(op.SelectionSet)
+		if opStats.Depth > stats.Depth {
+			stats.Depth = opStats.Depth
+		}
+		stats.Aliases += opStats.Aliases
+		stats.Complexity = saturatingAdd(stats.Complexity, opStats.Complexity)
+	}
+	return stats, nil
+}
+
+type queryAnalyzer struct {
+	fragments map[string]*ast.FragmentDefinition
+	// memo holds the stats of the fragments, so a fragment which is spread
+	// many times is analyzed only once
+	memo      map[string]QueryStats
+	visiting  map[string]bool
+	variables map[string]interface{}
+}
+
+func (a *queryAnalyzer) selectionSet(set *ast.SelectionSet) QueryStats {
+	var stats QueryStats
+	if set == nil {
+		return stats
+	}
+
+	add := func(s QueryStats) {
+		if s.Depth > stats.Depth {
+			stats.Depth = s.Depth
+		}
+		stats.Aliases += s.Aliases
+		stats.Complexity = saturatingAdd(stats.Complexity, s.Complexity)
+	}
+
+	for _, selection := range set.Selections {
+		switch s := selection.(type) {
+		case *ast.Field:
+			children := a.selectionSet(s.SelectionSet)
+			field := QueryStats{
+				Depth:      children.Depth + 1,
+				Aliases:    children.Aliases,
+				Complexity: saturatingAdd(1, saturatingMul(a.limit(s), children.Complexity)),
+			}
+			if s.Alias != nil && s.Alias.Value != "" && s.Alias.Value != s.Name.Value {
+				field.Aliases++
+			}
+			add(field)
+		case *ast.InlineFragment:
+			add(a.selectionSet(s.SelectionSet))
+		case *ast.FragmentSpread:
+			add(a.fragment(s.Name.Value))
+		}
+	}
+	return stats
+}
+
+func (a *queryAnalyzer) fragment(name string) QueryStats {
+	if stats, ok := a.memo[name]; ok {
+		return stats
+	}
+	def, ok := a.fragments[name]
+	if !ok || a.visiting[name] {
+		// unknown fragments and cycles are rejected by the validation
+		return QueryStats{}
+	}
+
+	a.visiting[name] = true
+	stats := a.selectionSet(def.SelectionSet)
+	delete(a.visiting, name)
+
+	a.memo[name] = stats
+	return stats
+}
+
+// limit is the multiplier of the children of a field
+func (a *queryAnalyzer) limit(field *ast.Field) int {
+	for _, arg := range field.Arguments {
+		if arg.Name == nil || arg.Name.Value != "limit" {
+			continue
+		}
+		switch v := arg.Value.(type) {
+		case *ast.IntValue:
+			var limit int
+			if _, err := fmt.Sscan(v.Value, &limit); err == nil && limit > 0 {
+				return limit
+			}
+		case *ast.Variable:
+			switch limit := a.variables[v.Name.Value].(type) {
+			case int:
+				if limit > 0 {
+					return limit
+				}
+			case float64:
+				if limit > 0 {
+					return int(limit)
+				}
+			}
+		}
+	}
+	return 1
+}
+
+func saturatingAdd(a, b int) int {
+	if a+b > maxEstimatedComplexity || a+b < 0 {
+		return maxEstimatedComplexity
+	}
+	return a + b
+}
+
+func saturatingMul(a, b int) int {
+	if a != 0 && b > maxEstimatedComplexity/a {
+		return maxEstimatedComplexity
+	}
+	return a * b
+}
@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package graphql
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestAnalyzeQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		variables map[string]interface{}
+		expected  QueryStats
+	}{
+		{
+			name:     "flat",
+			query:    `{ Get { Article { title url } } }`,
+			expected: QueryStats{Depth: 3, Complexity: 4},
+		},
+		{
+			name:     "limit multiplies the children",
+			query:    `{ Get { Article(limit: 10) { title url } } }`,
+			expected: QueryStats{Depth: 3, Complexity: 22},
+		},
+		{
+			name:      "limit from a variable",
+			query:     `query q($n: Int) { Get { Article(limit: $n) { title } } }`,
+			variables: map[string]interface{}{"n": float64(100)},
+			expected:  QueryStats{Depth: 3, Complexity: 102},
+		},
+		{
+			name:     "aliases",
+			query:    `{ a: Get { Article { t: title } } b: Get { Article { title } } }`,
+			expected: QueryStats{Depth: 3, Aliases: 3, Complexity: 6},
+		},
+		{
+			name: "fragments and inline fragments are expanded",
+			query: `{ Get { Article { ...a } } }
+				fragment a on Article { hasAuthors { ... on Author { name } } }`,
+			expected: QueryStats{Depth: 4, Complexity: 4},
+		},
+		{
+			name: "fragment cycles terminate",
+			query: `{ Get { Article { ...a } } }
+				fragment a on Article { title ...b }
+				fragment b on Article { url ...a }`,
+			expected: QueryStats{Depth: 3, Complexity: 4},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stats, err := AnalyzeQuery(test.query, "", test.variables)
+			require.Nil(t, err)
+			assert.Equal(t, test.expected, stats)
+		})
+	}
+}
+
+func TestCheckLimits(t *testing.T) {
+	nested := `{ Get { Article(limit: 1000) { hasAuthors { ... on Author {
+		wroteArticles { ... on Article { title } } } } } } }`
+
+	t.Run("unlimited", func(t *testing.T) {
+		assert.Nil(t, CheckLimits(nested, "", nil, config.GraphQLLimit{}))
+	})
+
+	t.Run("depth", func(t *testing.T) {
+		err := CheckLimits(nested, "", nil, config.GraphQLLimit{MaxDepth: 4})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "depth 5")
+	})
+
+	t.Run("aliases", func(t *testing.T) {
+		err := CheckLimits(`{ a: Get { Article { title } } b: Get { Article { title } } }`,
+			"", nil, config.GraphQLLimit{MaxAliases: 1})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "2 aliases")
+	})
+
+	t.Run("complexity", func(t *testing.T) {
+		err := CheckLimits(nested, "", nil, config.GraphQLLimit{MaxComplexity: 1000})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "complexity 3002")
+	})
+
+	t.Run("fragment bomb", func(t *testing.T) {
+		query := `{ Get { Article { ...f0 } } }
+			fragment f0 on Article { ...f1 ...f1 }`
+		for i := 1; i < 60; i++ {
+			query += fmt.Sprintf("\nfragment f%d on Article { ...f%d ...f%d }", i, i+1, i+1)
+		}
+		query += "\nfragment f60 on Article { title }"
+		err := CheckLimits(query, "", nil, config.GraphQLLimit{MaxComplexity: 10000})
+		require.NotNil(t, err)
+	})
+
+	t.Run("only the selected operation", func(t *testing.T) {
+		query := `query small { Get { Article { title } } }
+			query big { Get { Article { hasAuthors { ... on Author { name } } } } }`
+		assert.Nil(t, CheckLimits(query, "small", nil, config.GraphQLLimit{MaxDepth: 3}))
+		assert.NotNil(t, CheckLimits(query, "big", nil, config.GraphQLLimit{MaxDepth: 3}))
+	})
+}
@@ -121,6 +121,7 @@ func classField(class *models.Class, description string,
 			},
 			"nearVector": nearVectorArgument(class.Class),
 			"nearObject": nearObjectArgument(class.Class),
+			"sample":     sampleArgument(class.Class),
 			"objectLimit": &graphql.ArgumentConfig{
 				Description: descriptions.First,
 				Type:        graphql.Int,
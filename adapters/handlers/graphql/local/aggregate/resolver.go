@@ -88,6 +88,11 @@ func makeResolveClass(modulesProvider ModulesProvider, class *models.Class) grap
 			return nil, fmt.Errorf("could not extract objectLimit: %w", err)
 		}
 
+		sample, err := filters.ExtractSampleFromArgs(p.Args)
+		if err != nil {
+			return nil, fmt.Errorf("could not extract sample: %w", err)
+		}
+
 		filters, err := common_filters.ExtractFilters(p.Args, p.Info.FieldName)
 		if err != nil {
 			return nil, fmt.Errorf("could not extract filters: %w", err)
@@ -139,6 +144,7 @@ func makeResolveClass(modulesProvider ModulesProvider, class *models.Class) grap
 			IncludeMetaCount: includeMeta,
 			Limit:            limit,
 			ObjectLimit:      objectLimit,
+			Sample:           sample,
 			NearVector:       nearVectorParams,
 			NearObject:       nearObjectParams,
 			ModuleParams:     moduleParams,
@@ -150,6 +156,11 @@ func makeResolveClass(modulesProvider ModulesProvider, class *models.Class) grap
 			return nil, fmt.Errorf("objectLimit can only be used with a near<Media> or hybrid filter")
 		}
 
+		if params.Sample != nil && !validateSampleUsage(params) {
+			return nil, fmt.Errorf("sample cannot be combined with groupBy, objectLimit, " +
+				"a near<Media> or hybrid filter")
+		}
+
 		res, err := resolver.Aggregate(p.Context, principalFromContext(p.Context), params)
 		if err != nil {
 			return nil, err
@@ -309,3 +320,9 @@ func validateObjectLimitUsage(params *aggregation.Params) bool {
 		len(params.ModuleParams) > 0 ||
 		params.Hybrid != nil
 }
+
+func validateSampleUsage(params *aggregation.Params) bool {
+	return params.GroupBy == nil &&
+		params.ObjectLimit == nil &&
+		!validateObjectLimitUsage(params)
+}
@@ -35,6 +35,7 @@ type testCase struct {
 	expectedIncludeMetaCount bool
 	expectedLimit            *int
 	expectedObjectLimit      *int
+	expectedSample           *filters.Sample
 }
 
 type testCases []testCase
@@ -839,11 +840,59 @@ func Test_Resolve(t *testing.T) {
 				},
 			}},
 		},
+
+		testCase{
+			name: "with sample",
+			query: `
+				{
+					Aggregate{
+						Car(sample: {size: 100, seed: 7}) {
+							meta {
+								count
+							}
+						}
+					}
+				}
+			`,
+			expectedProps:            []aggregation.ParamProperty{},
+			expectedIncludeMetaCount: true,
+			expectedSample:           &filters.Sample{Size: 100, Seed: 7},
+			resolverReturn: []aggregation.Group{
+				{
+					Count: 100,
+				},
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"meta": map[string]interface{}{
+							"count": 100,
+						},
+					},
+				},
+			}},
+		},
 	}
 
 	tests.AssertExtraction(t, "Car")
 }
 
+func Test_ResolveSampleConflicts(t *testing.T) {
+	t.Parallel()
+
+	queries := []string{
+		`{ Aggregate { Car(sample: {size: 0}) { meta { count } } } }`,
+		`{ Aggregate { Car(sample: {size: 10}, groupBy: ["madeBy", "Manufacturer", "name"]) { meta { count } } } }`,
+		`{ Aggregate { Car(sample: {size: 10}, nearVector: {vector: [1, 2]}) { meta { count } } } }`,
+	}
+
+	for _, query := range queries {
+		resolver := newMockResolver(config.Config{})
+		resolver.AssertFailToResolve(t, query)
+	}
+}
+
 func (tests testCases) AssertExtraction(t *testing.T, className string) {
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -859,6 +908,7 @@ func (tests testCases) AssertExtraction(t *testing.T, className string) {
 				IncludeMetaCount: testCase.expectedIncludeMetaCount,
 				Limit:            testCase.expectedLimit,
 				ObjectLimit:      testCase.expectedObjectLimit,
+				Sample:           testCase.expectedSample,
 			}
 
 			resolver.On("Aggregate", expectedParams).
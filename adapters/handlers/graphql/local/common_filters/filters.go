@@ -21,6 +21,7 @@ import (
 
 // The filters common to Local->Get and Local->Meta queries.
 func BuildNew(path string) graphql.InputObjectConfigFieldMap {
+	geoCoordinates := newGeoCoordinatesInputObject(path)
 	commonFilters := graphql.InputObjectConfigFieldMap{
 		"operator": &graphql.InputObjectFieldConfig{
 			Type: graphql.NewEnum(graphql.EnumConfig{
@@ -38,6 +39,8 @@ func BuildNew(path string) graphql.InputObjectConfigFieldMap {
 					"LessThanEqual":    &graphql.EnumValueConfig{},
 					"WithinGeoRange":   &graphql.EnumValueConfig{},
 					"IsNull":           &graphql.EnumValueConfig{},
+					"WithinGeoPolygon": &graphql.EnumValueConfig{},
+					"WithinGeoBox":     &graphql.EnumValueConfig{},
 				},
 				Description: descriptions.WhereOperatorEnum,
 			}),
@@ -75,6 +78,14 @@ func BuildNew(path string) graphql.InputObjectConfigFieldMap {
 			Type:        newGeoRangeInputObject(path),
 			Description: descriptions.WhereValueRange,
 		},
+		"valueGeoPolygon": &graphql.InputObjectFieldConfig{
+			Type:        newGeoPolygonInputObject(path, geoCoordinates),
+			Description: descriptions.WhereValueGeoPolygon,
+		},
+		"valueGeoBox": &graphql.InputObjectFieldConfig{
+			Type:        newGeoBoxInputObject(path, geoCoordinates),
+			Description: descriptions.WhereValueGeoBox,
+		},
 	}
 
 	// Recurse into the same time.
@@ -126,6 +137,54 @@ func newGeoRangeGeoCoordinatesInputObject(path string) *graphql.InputObject {
 	})
 }
 
+func newGeoCoordinatesInputObject(path string) *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: fmt.Sprintf("%sWhereGeoCoordinatesInpObj", path),
+		Fields: graphql.InputObjectConfigFieldMap{
+			"latitude": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(graphql.Float),
+				Description: descriptions.WhereValueGeoCoordinatesLatitude,
+			},
+			"longitude": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(graphql.Float),
+				Description: descriptions.WhereValueGeoCoordinatesLongitude,
+			},
+		},
+	})
+}
+
+func newGeoPolygonInputObject(path string,
+	geoCoordinates *graphql.InputObject,
+) *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: fmt.Sprintf("%sWhereGeoPolygonInpObj", path),
+		Fields: graphql.InputObjectConfigFieldMap{
+			"points": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(geoCoordinates))),
+				Description: descriptions.WhereValueGeoPolygonPoints,
+			},
+		},
+	})
+}
+
+func newGeoBoxInputObject(path string,
+	geoCoordinates *graphql.InputObject,
+) *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: fmt.Sprintf("%sWhereGeoBoxInpObj", path),
+		Fields: graphql.InputObjectConfigFieldMap{
+			"topLeft": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(geoCoordinates),
+				Description: descriptions.WhereValueGeoBoxTopLeft,
+			},
+			"bottomRight": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(geoCoordinates),
+				Description: descriptions.WhereValueGeoBoxBottomRight,
+			},
+		},
+	})
+}
+
 func newGeoRangeDistanceInputObject(path string) *graphql.InputObject {
 	return graphql.NewInputObject(graphql.InputObjectConfig{
 		Name: fmt.Sprintf("%sWhereGeoRangeDistanceInpObj", path),
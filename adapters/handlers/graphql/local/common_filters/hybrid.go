@@ -79,6 +79,7 @@ func ExtractHybridSearch(source map[string]interface{}, explainScore bool) (*sea
 		args.Alpha = alpha.(float64)
 	} else {
 		args.Alpha = config.DefaultAlpha
+		args.AlphaDefaulted = true
 	}
 
 	if args.Alpha < 0 || args.Alpha > 1 {
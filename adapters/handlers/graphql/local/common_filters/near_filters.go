@@ -37,6 +37,32 @@ func nearVectorFields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: descriptions.Vector,
 			Type:        graphql.NewNonNull(graphql.NewList(graphql.Float)),
 		},
+		"targetVector": &graphql.InputObjectFieldConfig{
+			Description: descriptions.TargetVector,
+			Type:        graphql.String,
+		},
+		"targetVectors": &graphql.InputObjectFieldConfig{
+			Description: descriptions.TargetVectors,
+			Type:        graphql.NewList(graphql.String),
+		},
+		"targetCombination": &graphql.InputObjectFieldConfig{
+			Description: descriptions.TargetCombination,
+			Type:        graphql.String,
+		},
+		"vectorPerTarget": &graphql.InputObjectFieldConfig{
+			Description: descriptions.VectorPerTarget,
+			Type: graphql.NewList(graphql.NewInputObject(graphql.InputObjectConfig{
+				Name: fmt.Sprintf("%sNearVectorPerTargetInpObj", prefix),
+				Fields: graphql.InputObjectConfigFieldMap{
+					"target": &graphql.InputObjectFieldConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					"vector": &graphql.InputObjectFieldConfig{
+						Type: graphql.NewNonNull(graphql.NewList(graphql.Float)),
+					},
+				},
+			})),
+		},
 		"certainty": &graphql.InputObjectFieldConfig{
 			Description: descriptions.Certainty,
 			Type:        graphql.Float,
@@ -45,6 +71,10 @@ func nearVectorFields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: descriptions.Distance,
 			Type:        graphql.Float,
 		},
+		"filterStrategy": &graphql.InputObjectFieldConfig{
+			Description: descriptions.FilterStrategy,
+			Type:        graphql.String,
+		},
 	}
 }
 
@@ -15,6 +15,7 @@ import (
 	"fmt"
 
 	"github.com/weaviate/weaviate/entities/searchparams"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
 // ExtractNearVector arguments, such as "vector" and "distance"
@@ -28,6 +29,30 @@ func ExtractNearVector(source map[string]interface{}) (searchparams.NearVector,
 		args.Vector[i] = float32(value.(float64))
 	}
 
+	if targetVector, ok := source["targetVector"]; ok {
+		args.TargetVector = targetVector.(string)
+	}
+
+	if targetVectors, ok := source["targetVectors"]; ok {
+		targets, err := extractTargetVectors(targetVectors.([]interface{}),
+			source["targetCombination"], source["vectorPerTarget"], args.Vector)
+		if err != nil {
+			return searchparams.NearVector{}, err
+		}
+		if args.TargetVector != "" {
+			return searchparams.NearVector{},
+				fmt.Errorf("cannot provide targetVector and targetVectors")
+		}
+		args.TargetVectors = targets
+	}
+
+	if filterStrategy, ok := source["filterStrategy"]; ok {
+		args.FilterStrategy = filterStrategy.(string)
+		if err := hnswent.ValidateFilterStrategy(args.FilterStrategy); err != nil {
+			return searchparams.NearVector{}, err
+		}
+	}
+
 	certainty, certaintyOK := source["certainty"]
 	if certaintyOK {
 		args.Certainty = certainty.(float64)
@@ -46,3 +71,47 @@ func ExtractNearVector(source map[string]interface{}) (searchparams.NearVector,
 
 	return args, nil
 }
+
+// extractTargetVectors searches the targets with the vector of the query
+// unless vectorPerTarget holds one for them
+func extractTargetVectors(targets []interface{}, combination interface{},
+	vectorPerTarget interface{}, vector []float32,
+) (*searchparams.TargetVectors, error) {
+	vectors := map[string][]float32{}
+	if vectorPerTarget != nil {
+		for _, entry := range vectorPerTarget.([]interface{}) {
+			entry := entry.(map[string]interface{})
+			values := entry["vector"].([]interface{})
+			vec := make([]float32, len(values))
+			for i, value := range values {
+				vec[i] = float32(value.(float64))
+			}
+			vectors[entry["target"].(string)] = vec
+		}
+	}
+
+	out := &searchparams.TargetVectors{
+		Targets:     make([]string, len(targets)),
+		Vectors:     make([][]float32, len(targets)),
+		Combination: searchparams.TargetVectorCombinationSum,
+	}
+	for i, target := range targets {
+		out.Targets[i] = target.(string)
+		out.Vectors[i] = vector
+		if vec, ok := vectors[out.Targets[i]]; ok {
+			out.Vectors[i] = vec
+			delete(vectors, out.Targets[i])
+		}
+	}
+	for target := range vectors {
+		return nil, fmt.Errorf("vectorPerTarget: %q is not one of the targetVectors", target)
+	}
+	if combination != nil {
+		out.Combination = combination.(string)
+	}
+
+	if err := out.Validate(); err != nil {
+		return nil, fmt.Errorf("targetVectors: %w", err)
+	}
+	return out, nil
+}
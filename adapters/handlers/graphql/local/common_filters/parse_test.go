@@ -337,6 +337,102 @@ func TestExtractNearVector(t *testing.T) {
 		resolver.AssertResolve(t, query)
 	})
 
+	t.Run("with target vector provided", func(t *testing.T) {
+		t.Parallel()
+
+		query := `{ SomeAction(nearVector: {vector: [1, 2, 3], targetVector: "title_vec", distance: 0.4})}`
+		expectedparams := searchparams.NearVector{
+			Vector:       []float32{1, 2, 3},
+			TargetVector: "title_vec",
+			Distance:     0.4,
+			WithDistance: true,
+		}
+
+		resolver := newMockResolver(t, mockParams{reportNearVector: true})
+
+		resolver.On("ReportNearVector", expectedparams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with filter strategy provided", func(t *testing.T) {
+		t.Parallel()
+
+		query := `{ SomeAction(nearVector: {vector: [1, 2, 3], filterStrategy: "acorn"})}`
+		expectedparams := searchparams.NearVector{
+			Vector:         []float32{1, 2, 3},
+			FilterStrategy: "acorn",
+		}
+
+		resolver := newMockResolver(t, mockParams{reportNearVector: true})
+
+		resolver.On("ReportNearVector", expectedparams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with an unknown filter strategy", func(t *testing.T) {
+		t.Parallel()
+
+		query := `{ SomeAction(nearVector: {vector: [1, 2, 3], filterStrategy: "unknown"})}`
+		resolver := newMockResolver(t, mockParams{reportNearVector: true})
+		resolver.AssertFailToResolve(t, query)
+	})
+
+	t.Run("with target vectors provided", func(t *testing.T) {
+		t.Parallel()
+
+		query := `{ SomeAction(nearVector: {vector: [1, 2, 3], targetVectors: ["title_vec", "body_vec"], targetCombination: "average"})}`
+		expectedparams := searchparams.NearVector{
+			Vector: []float32{1, 2, 3},
+			TargetVectors: &searchparams.TargetVectors{
+				Targets:     []string{"title_vec", "body_vec"},
+				Vectors:     [][]float32{{1, 2, 3}, {1, 2, 3}},
+				Combination: searchparams.TargetVectorCombinationAverage,
+			},
+		}
+
+		resolver := newMockResolver(t, mockParams{reportNearVector: true})
+
+		resolver.On("ReportNearVector", expectedparams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with a vector per target provided", func(t *testing.T) {
+		t.Parallel()
+
+		query := `{ SomeAction(nearVector: {vector: [1, 2, 3], targetVectors: ["title_vec", "body_vec"], vectorPerTarget: [{target: "title_vec", vector: [4, 5]}]})}`
+		expectedparams := searchparams.NearVector{
+			Vector: []float32{1, 2, 3},
+			TargetVectors: &searchparams.TargetVectors{
+				Targets:     []string{"title_vec", "body_vec"},
+				Vectors:     [][]float32{{4, 5}, {1, 2, 3}},
+				Combination: searchparams.TargetVectorCombinationSum,
+			},
+		}
+
+		resolver := newMockResolver(t, mockParams{reportNearVector: true})
+
+		resolver.On("ReportNearVector", expectedparams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with an unrecognized target combination", func(t *testing.T) {
+		t.Parallel()
+
+		query := `{ SomeAction(nearVector: {vector: [1, 2, 3], targetVectors: ["title_vec"], targetCombination: "max"})}`
+
+		resolver := newMockResolver(t, mockParams{reportNearVector: true})
+
+		resolver.AssertFailToResolve(t, query)
+	})
+
 	t.Run("with distance and certainty provided", func(t *testing.T) {
 		t.Parallel()
 
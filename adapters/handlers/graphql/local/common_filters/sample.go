@@ -0,0 +1,41 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+)
+
+func SampleArgument(argumentPrefix, className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("%s%s", argumentPrefix, className)
+	return &graphql.ArgumentConfig{
+		Description: descriptions.Sample,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name: fmt.Sprintf("%sSampleInpObj", prefix),
+				Fields: graphql.InputObjectConfigFieldMap{
+					"size": &graphql.InputObjectFieldConfig{
+						Description: descriptions.SampleSize,
+						Type:        graphql.NewNonNull(graphql.Int),
+					},
+					"seed": &graphql.InputObjectFieldConfig{
+						Description: descriptions.SampleSeed,
+						Type:        graphql.Int,
+					},
+				},
+			},
+		),
+	}
+}
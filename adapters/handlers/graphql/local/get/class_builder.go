@@ -64,6 +64,10 @@ func (b *classBuilder) objects() (*graphql.Object, error) {
 	return b.kinds(b.schema.Objects)
 }
 
+func (b *classBuilder) entities() graphql.Fields {
+	return b.entityFields(b.schema.Objects.Classes)
+}
+
 func (b *classBuilder) kinds(kindSchema *models.Schema) (*graphql.Object, error) {
 	classFields := graphql.Fields{}
 
@@ -74,6 +78,9 @@ func (b *classBuilder) kinds(kindSchema *models.Schema) (*graphql.Object, error)
 		}
 		classFields[class.Class] = classField
 	}
+	if len(kindSchema.Classes) > 0 {
+		classFields[federatedFieldName] = b.federatedField(kindSchema.Classes, classFields)
+	}
 
 	classes := graphql.NewObject(graphql.ObjectConfig{
 		Name:        "GetObjectsObj",
@@ -131,6 +138,7 @@ func (b *classBuilder) classObject(class *models.Class) *graphql.Object {
 			return classProperties
 		}),
 		Description: class.Description,
+		Directives:  entityKey(),
 	})
 }
 
@@ -145,6 +153,23 @@ func (b *classBuilder) additionalFields(classProperties graphql.Fields, class *m
 	additionalProperties["lastUpdateTimeUnix"] = b.additionalLastUpdateTimeUnix()
 	additionalProperties["score"] = b.additionalScoreField()
 	additionalProperties["explainScore"] = b.additionalExplainScoreField()
+	additionalProperties["sourceClass"] = &graphql.Field{
+		Description: descriptions.GetAdditionalSourceClass,
+		Type:        graphql.String,
+	}
+	additionalProperties["normalizedScore"] = &graphql.Field{
+		Description: descriptions.GetAdditionalNormalizedScore,
+		Type:        graphql.Float,
+	}
+	additionalProperties["distanceMeters"] = &graphql.Field{
+		Description: descriptions.GetAdditionalDistanceMeters,
+		Type:        graphql.Float,
+	}
+	additionalProperties["vectorCursor"] = &graphql.Field{
+		Description: descriptions.GetAdditionalVectorCursor,
+		Type:        graphql.String,
+	}
+	additionalProperties["routing"] = b.additionalRoutingField(class)
 	if replicationEnabled(class) {
 		additionalProperties["isConsistent"] = &graphql.Field{
 			Type: graphql.Boolean,
@@ -186,6 +211,21 @@ func (b *classBuilder) additionalClassificationField(class *models.Class) *graph
 	}
 }
 
+func (b *classBuilder) additionalRoutingField(class *models.Class) *graphql.Field {
+	return &graphql.Field{
+		Description: descriptions.GetAdditionalRouting,
+		Type: graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalRouting", class.Class),
+			Fields: graphql.Fields{
+				"node":    &graphql.Field{Type: graphql.String},
+				"shard":   &graphql.Field{Type: graphql.String},
+				"nodes":   &graphql.Field{Type: graphql.NewList(graphql.String)},
+				"proxied": &graphql.Field{Type: graphql.Boolean},
+			},
+		}),
+	}
+}
+
 func (b *classBuilder) additionalCertaintyField(class *models.Class) *graphql.Field {
 	return &graphql.Field{
 		Type: graphql.Float,
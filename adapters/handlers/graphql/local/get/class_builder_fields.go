@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/functionscore"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -228,10 +229,19 @@ func buildGetClassField(classObject *graphql.Object,
 				Description: descriptions.After,
 				Type:        graphql.Int,
 			},
+			"functionScore": &graphql.ArgumentConfig{
+				Description: descriptions.GetFunctionScore,
+				Type:        graphql.String,
+			},
+			"vectorCursor": &graphql.ArgumentConfig{
+				Description: descriptions.GetVectorCursor,
+				Type:        graphql.String,
+			},
 
 			"sort":       sortArgument(class.Class),
 			"nearVector": nearVectorArgument(class.Class),
 			"nearObject": nearObjectArgument(class.Class),
+			"sample":     sampleArgument(class.Class),
 			"where":      whereArgument(class.Class),
 			"group":      groupArgument(class.Class),
 		},
@@ -336,6 +346,19 @@ func (r *resolver) makeResolveGetClass(className string) graphql.FieldResolveFn
 			return nil, err
 		}
 
+		sample, err := filters.ExtractSampleFromArgs(p.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		var vectorCursor *filters.VectorCursor
+		if in, ok := p.Args["vectorCursor"].(string); ok {
+			vectorCursor, err = filters.ParseVectorCursor(in)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		// There can only be exactly one ast.Field; it is the class name.
 		if len(p.Info.FieldASTs) != 1 {
 			panic("Only one Field expected here")
@@ -407,6 +430,14 @@ func (r *resolver) makeResolveGetClass(className string) graphql.FieldResolveFn
 			}
 		}
 
+		var functionScore *searchparams.FunctionScore
+		if expr, ok := p.Args["functionScore"].(string); ok {
+			if _, err := functionscore.Parse(expr); err != nil {
+				return nil, err
+			}
+			functionScore = &searchparams.FunctionScore{Expression: expr}
+		}
+
 		var replProps *additional.ReplicationProperties
 		if cl, ok := p.Args["consistencyLevel"]; ok {
 			replProps = &additional.ReplicationProperties{
@@ -421,6 +452,8 @@ func (r *resolver) makeResolveGetClass(className string) graphql.FieldResolveFn
 			ClassName:             className,
 			Pagination:            pagination,
 			Cursor:                cursor,
+			VectorCursor:          vectorCursor,
+			Sample:                sample,
 			Properties:            properties,
 			Sort:                  sort,
 			NearVector:            nearVectorParams,
@@ -430,6 +463,7 @@ func (r *resolver) makeResolveGetClass(className string) graphql.FieldResolveFn
 			AdditionalProperties:  addlProps,
 			KeywordRanking:        keywordRankingParams,
 			HybridSearch:          hybridParams,
+			FunctionScore:         functionScore,
 			ReplicationProperties: replProps,
 		}
 
@@ -535,7 +569,10 @@ func (ac *additionalCheck) isAdditional(name string) bool {
 	if name == "classification" || name == "certainty" ||
 		name == "distance" || name == "id" || name == "vector" ||
 		name == "creationTimeUnix" || name == "lastUpdateTimeUnix" ||
-		name == "score" || name == "explainScore" {
+		name == "score" || name == "explainScore" ||
+		name == "sourceClass" || name == "normalizedScore" ||
+		name == "distanceMeters" || name == "vectorCursor" ||
+		name == "routing" {
 		return true
 	}
 	if ac.isModuleAdditional(name) {
@@ -630,6 +667,18 @@ func extractProperties(className string, selections *ast.SelectionSet,
 							additionalProps.ExplainScore = true
 							continue
 						}
+						if additionalProperty == "distanceMeters" {
+							additionalProps.DistanceMeters = true
+							continue
+						}
+						if additionalProperty == "vectorCursor" {
+							additionalProps.VectorCursor = true
+							continue
+						}
+						if additionalProperty == "routing" {
+							additionalProps.Routing = true
+							continue
+						}
 						if additionalProperty == "lastUpdateTimeUnix" {
 							additionalProps.LastUpdateTimeUnix = true
 							continue
@@ -0,0 +1,307 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/tailor-inc/graphql/language/ast"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// The root fields and types of the Apollo Federation subgraph spec. They
+// start with an underscore, so they can't clash with class names.
+const (
+	entitiesFieldName = "_entities"
+	serviceFieldName  = "_service"
+
+	// entityKeyFields is the @key of every class, a gateway references an
+	// object with the representation
+	//
+	//	{"__typename": "Article", "_additional": {"id": "<uuid>"}}
+	entityKeyFields = "_additional { id }"
+)
+
+var entityKeyDirective = graphql.NewDirective(graphql.DirectiveConfig{
+	Name:      "key",
+	Locations: []string{graphql.DirectiveLocationObject},
+	Args: graphql.FieldConfigArgument{
+		"fields": &graphql.ArgumentConfig{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+	},
+})
+
+func entityKey() []*graphql.ObjectDirective {
+	return []*graphql.ObjectDirective{{
+		Directive: entityKeyDirective,
+		Args:      []graphql.ObjectDirectiveArg{{Name: "fields", Value: entityKeyFields}},
+	}}
+}
+
+// entityFields are the root fields with which a gateway such as the Apollo
+// Router composes the classes into a federated graph: _service returns the
+// SDL with the @key of the classes and _entities resolves the objects which
+// other subgraphs reference, e.g.
+//
+//	query($r: [_Any!]!) { _entities(representations: $r) {
+//	  ... on Article { title }
+//	} }
+//
+// The entities are a union of the class types of Get, as GraphQL types must
+// be unique.
+func (b *classBuilder) entityFields(classes []*models.Class) graphql.Fields {
+	types := make([]*graphql.Object, 0, len(classes))
+	byName := make(map[string]*models.Class, len(classes))
+	for _, class := range classes {
+		types = append(types, b.knownClasses[class.Class])
+		byName[class.Class] = class
+	}
+
+	entity := graphql.NewUnion(graphql.UnionConfig{
+		Name:        "_Entity",
+		Description: descriptions.GetEntity,
+		Types:       types,
+		ResolveType: func(p graphql.ResolveTypeParams) *graphql.Object {
+			return b.knownClasses[federatedSourceClass(p.Value)]
+		},
+	})
+
+	service := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "_Service",
+		Description: descriptions.GetService,
+		Fields: graphql.Fields{
+			"sdl": &graphql.Field{
+				Description: descriptions.GetServiceSDL,
+				Type:        graphql.NewNonNull(graphql.String),
+			},
+		},
+	})
+
+	return graphql.Fields{
+		entitiesFieldName: &graphql.Field{
+			Description: descriptions.GetEntities,
+			Type:        graphql.NewNonNull(graphql.NewList(entity)),
+			Args: graphql.FieldConfigArgument{
+				"representations": &graphql.ArgumentConfig{
+					Description: descriptions.GetEntitiesRepresentations,
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(anyScalar))),
+				},
+			},
+			Resolve: newResolver(b.modulesProvider).makeResolveEntities(byName),
+		},
+		serviceFieldName: &graphql.Field{
+			Description: descriptions.GetService,
+			Type:        graphql.NewNonNull(service),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return map[string]interface{}{"sdl": serviceSDL(p.Info.Schema)}, nil
+			},
+		},
+	}
+}
+
+// serviceSDL prints the schema for the gateway. The root query type is not
+// named Query, so it is declared explicitly.
+func serviceSDL(s graphql.Schema) string {
+	sdl := graphql.BuildSDL(s, &graphql.SDLExportOptions{HideDoubleUnderscorePrefix: true})
+	return fmt.Sprintf("schema {\n  query: %s\n}\n\n%s", s.QueryType().Name(), sdl)
+}
+
+type entityRef struct {
+	class string
+	id    string
+}
+
+func (r *resolver) makeResolveEntities(classes map[string]*models.Class) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		source, ok := p.Source.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected graphql root to be a map, but was %T", p.Source)
+		}
+		resolver, ok := source["Resolver"].(Resolver)
+		if !ok {
+			return nil, fmt.Errorf("expected source map to have a usable Resolver, but got %#v", source["Resolver"])
+		}
+
+		representations, _ := p.Args["representations"].([]interface{})
+		refs := make([]entityRef, len(representations))
+		// the classes in the order of the representations, so the queries
+		// are deterministic
+		var order []string
+		ids := map[string][]string{}
+		for i, rep := range representations {
+			ref, err := parseEntityRepresentation(rep)
+			if err != nil {
+				return nil, fmt.Errorf("representation %d: %w", i, err)
+			}
+			if _, ok := classes[ref.class]; !ok {
+				return nil, fmt.Errorf("representation %d: class %q does not exist", i, ref.class)
+			}
+			if _, ok := ids[ref.class]; !ok {
+				order = append(order, ref.class)
+			}
+			ids[ref.class] = append(ids[ref.class], ref.id)
+			refs[i] = ref
+		}
+
+		params := make([]dto.GetParams, len(order))
+		for i, className := range order {
+			var err error
+			params[i], err = r.entityParams(p, className, ids[className])
+			if err != nil {
+				return nil, fmt.Errorf("class %q: %w", className, err)
+			}
+		}
+
+		return func() (interface{}, error) {
+			found := map[entityRef]interface{}{}
+			for _, params := range params {
+				res, err := resolver.GetClass(p.Context, principalFromContext(p.Context), params)
+				if err != nil {
+					return nil, fmt.Errorf("class %q: %w", params.ClassName, err)
+				}
+				objects, _ := res.([]interface{})
+				for _, obj := range objects {
+					m, ok := obj.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					add, _ := m["_additional"].(map[string]interface{})
+					if add == nil {
+						continue
+					}
+					add["sourceClass"] = params.ClassName
+					found[entityRef{params.ClassName, fmt.Sprint(add["id"])}] = m
+				}
+			}
+
+			// the entities must be in the order of the representations,
+			// objects which don't exist (anymore) are null
+			out := make([]interface{}, len(refs))
+			for i, ref := range refs {
+				out[i] = found[ref]
+			}
+			return out, nil
+		}, nil
+	}
+}
+
+func parseEntityRepresentation(rep interface{}) (entityRef, error) {
+	m, ok := rep.(map[string]interface{})
+	if !ok {
+		return entityRef{}, fmt.Errorf("expected an object, but got %T", rep)
+	}
+	class, _ := m["__typename"].(string)
+	if class == "" {
+		return entityRef{}, fmt.Errorf("missing __typename")
+	}
+	add, _ := m["_additional"].(map[string]interface{})
+	id, _ := add["id"].(string)
+	if id == "" {
+		return entityRef{}, fmt.Errorf("missing key %q", entityKeyFields)
+	}
+	return entityRef{class: class, id: id}, nil
+}
+
+// entityParams fetch the objects of a class by their ids, the properties
+// are selected by the fragments on the class
+func (r *resolver) entityParams(p graphql.ResolveParams, className string,
+	ids []string,
+) (dto.GetParams, error) {
+	params := dto.GetParams{
+		ClassName:  className,
+		Pagination: &filters.Pagination{Limit: len(ids)},
+		Filters:    entityIDFilter(className, ids),
+	}
+
+	selections := federatedSelections(p.Info.FieldASTs[0].SelectionSet,
+		p.Info.Fragments, className)
+	properties, addlProps, err := extractProperties(className, selections,
+		p.Info.Fragments, r.modulesProvider)
+	if err != nil {
+		return params, err
+	}
+	params.Properties = properties
+	params.AdditionalProperties = addlProps
+	// the ids are needed to match the objects to the representations
+	params.AdditionalProperties.ID = true
+
+	return params, nil
+}
+
+func entityIDFilter(className string, ids []string) *filters.LocalFilter {
+	operands := make([]filters.Clause, len(ids))
+	for i, id := range ids {
+		operands[i] = filters.Clause{
+			Operator: filters.OperatorEqual,
+			On: &filters.Path{
+				Class:    schema.ClassName(className),
+				Property: filters.InternalPropID,
+			},
+			Value: &filters.Value{Value: id, Type: schema.DataTypeString},
+		}
+	}
+	if len(operands) == 1 {
+		return &filters.LocalFilter{Root: &operands[0]}
+	}
+	return &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorOr,
+		Operands: operands,
+	}}
+}
+
+// anyScalar is the _Any scalar of the representations, an arbitrary JSON
+// object
+var anyScalar = graphql.NewScalar(graphql.ScalarConfig{
+	Name:         "_Any",
+	Description:  descriptions.GetEntitiesAny,
+	Serialize:    func(value interface{}) interface{} { return value },
+	ParseValue:   func(value interface{}) interface{} { return value },
+	ParseLiteral: parseAnyLiteral,
+})
+
+func parseAnyLiteral(valueAST ast.Value) interface{} {
+	switch v := valueAST.(type) {
+	case *ast.ObjectValue:
+		out := make(map[string]interface{}, len(v.Fields))
+		for _, field := range v.Fields {
+			out[field.Name.Value] = parseAnyLiteral(field.Value)
+		}
+		return out
+	case *ast.ListValue:
+		out := make([]interface{}, len(v.Values))
+		for i, item := range v.Values {
+			out[i] = parseAnyLiteral(item)
+		}
+		return out
+	case *ast.IntValue:
+		i, _ := strconv.ParseInt(v.Value, 10, 64)
+		return i
+	case *ast.FloatValue:
+		f, _ := strconv.ParseFloat(v.Value, 64)
+		return f
+	case *ast.BooleanValue:
+		return v.Value
+	case *ast.StringValue:
+		return v.Value
+	case *ast.EnumValue:
+		return v.Value
+	default:
+		return nil
+	}
+}
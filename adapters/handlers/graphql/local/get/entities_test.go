@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/tailor-inc/graphql"
+	test_helper "github.com/weaviate/weaviate/adapters/handlers/graphql/test/helper"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func newEntitiesSchema(t *testing.T) (graphql.Schema, *mockResolver) {
+	logger, _ := test.NewNullLogger()
+	simpleSchema := test_helper.CreateSimpleSchema(config.VectorizerModuleText2VecContextionary)
+	getField, entityFields, err := BuildWithEntities(&simpleSchema, logger, getFakeModulesProvider())
+	require.Nil(t, err)
+
+	fields := graphql.Fields{"Get": getField}
+	for name, field := range entityFields {
+		fields[name] = field
+	}
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{Name: "WeaviateObj", Fields: fields}),
+	})
+	require.Nil(t, err)
+
+	mocker := &mockResolver{}
+	mocker.RootObject = map[string]interface{}{"Resolver": Resolver(mocker)}
+	return schema, mocker
+}
+
+func TestEntities(t *testing.T) {
+	t.Parallel()
+
+	schema, resolver := newEntitiesSchema(t)
+	resolve := func(query string, variables map[string]interface{}) *graphql.Result {
+		return graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  query,
+			VariableValues: variables,
+			RootObject:     resolver.RootObject,
+			Context:        context.Background(),
+		})
+	}
+
+	t.Run("service sdl has the key of the classes", func(t *testing.T) {
+		res := resolve(`{ _service { sdl } }`, nil)
+		require.Empty(t, res.Errors)
+
+		sdl := res.Data.(map[string]interface{})["_service"].(map[string]interface{})["sdl"].(string)
+		assert.True(t, strings.HasPrefix(sdl, "schema {\n  query: WeaviateObj\n}"))
+		assert.Contains(t, sdl, `type SomeThing @key(fields: "_additional { id }")`)
+		assert.Contains(t, sdl, `type SomeAction @key(fields: "_additional { id }")`)
+	})
+
+	t.Run("resolves the representations in order", func(t *testing.T) {
+		const (
+			id1 = "5d2c2d4b-2c3b-4b6f-9d0c-6a9f3c1e9a01"
+			id2 = "5d2c2d4b-2c3b-4b6f-9d0c-6a9f3c1e9a02"
+			id3 = "5d2c2d4b-2c3b-4b6f-9d0c-6a9f3c1e9a03"
+		)
+		query := `query($r: [_Any!]!) { _entities(representations: $r) {
+			... on SomeThing { intField }
+			... on SomeAction { intField _additional { id } }
+		} }`
+		variables := map[string]interface{}{"r": []interface{}{
+			map[string]interface{}{"__typename": "SomeThing", "_additional": map[string]interface{}{"id": id1}},
+			map[string]interface{}{"__typename": "SomeAction", "_additional": map[string]interface{}{"id": id2}},
+			map[string]interface{}{"__typename": "SomeThing", "_additional": map[string]interface{}{"id": id3}},
+		}}
+
+		resolver.On("GetClass", mock.MatchedBy(func(p dto.GetParams) bool {
+			return p.ClassName == "SomeThing"
+		})).Return([]interface{}{
+			map[string]interface{}{"intField": 1, "_additional": map[string]interface{}{"id": id1}},
+		}, nil).Run(func(args mock.Arguments) {
+			params := args.Get(0).(dto.GetParams)
+			assert.Equal(t, &filters.Pagination{Limit: 2}, params.Pagination)
+			assert.Equal(t, filters.OperatorOr, params.Filters.Root.Operator)
+			require.Len(t, params.Filters.Root.Operands, 2)
+			assert.Equal(t, id3, params.Filters.Root.Operands[1].Value.Value)
+			assert.Equal(t, search.SelectProperties{{Name: "intField", IsPrimitive: true}},
+				params.Properties)
+			assert.True(t, params.AdditionalProperties.ID)
+		}).Once()
+		resolver.On("GetClass", mock.MatchedBy(func(p dto.GetParams) bool {
+			return p.ClassName == "SomeAction"
+		})).Return([]interface{}{
+			map[string]interface{}{"intField": 2, "_additional": map[string]interface{}{"id": id2}},
+		}, nil).Run(func(args mock.Arguments) {
+			params := args.Get(0).(dto.GetParams)
+			assert.Equal(t, filters.OperatorEqual, params.Filters.Root.Operator)
+			assert.Equal(t, id2, params.Filters.Root.Value.Value)
+		}).Once()
+
+		res := resolve(query, variables)
+		require.Empty(t, res.Errors)
+
+		// the third object does not exist and is null
+		expected := []interface{}{
+			map[string]interface{}{"intField": 1},
+			map[string]interface{}{"intField": 2, "_additional": map[string]interface{}{"id": id2}},
+			nil,
+		}
+		assert.Equal(t, expected, res.Data.(map[string]interface{})["_entities"])
+		resolver.AssertExpectations(t)
+	})
+
+	t.Run("representations as literals", func(t *testing.T) {
+		res := resolve(`{ _entities(representations: [{__typename: "SomeThing"}]) {
+			... on SomeThing { intField } } }`, nil)
+		require.Len(t, res.Errors, 1)
+		assert.Contains(t, res.Errors[0].Message, `missing key "_additional { id }"`)
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		res := resolve(`{ _entities(representations: [{__typename: "Unknown", _additional: {id: "x"}}]) {
+			... on SomeThing { intField } } }`, nil)
+		require.Len(t, res.Errors, 1)
+		assert.Contains(t, res.Errors[0].Message, `class "Unknown" does not exist`)
+	})
+}
@@ -0,0 +1,367 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/tailor-inc/graphql/language/ast"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/common_filters"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"golang.org/x/sync/errgroup"
+)
+
+// federatedFieldName is the field of Get which searches several classes at
+// once. The leading underscore keeps it from clashing with class names.
+const federatedFieldName = "_Federated"
+
+// federatedField runs the same nearText-like or hybrid search on several
+// classes and merges the results. The results are a union of the classes,
+// so the properties are selected with inline fragments, e.g.
+//
+//	{ Get { _Federated(classes: ["Article", "Video"], hybrid: {query: "cats"}) {
+//	  ... on Article { title _additional { sourceClass normalizedScore } }
+//	  ... on Video { name _additional { sourceClass normalizedScore } }
+//	} } }
+//
+// GraphQL types must be unique, so the arguments are taken from the fields of
+// the classes.
+func (b *classBuilder) federatedField(classes []*models.Class,
+	classFields graphql.Fields,
+) *graphql.Field {
+	types := make([]*graphql.Object, 0, len(classes))
+	byName := make(map[string]*models.Class, len(classes))
+	for _, class := range classes {
+		types = append(types, b.knownClasses[class.Class])
+		byName[class.Class] = class
+	}
+
+	union := graphql.NewUnion(graphql.UnionConfig{
+		Name:        "FederatedResult",
+		Description: descriptions.GetFederatedResult,
+		Types:       types,
+		ResolveType: func(p graphql.ResolveTypeParams) *graphql.Object {
+			return b.knownClasses[federatedSourceClass(p.Value)]
+		},
+	})
+
+	field := &graphql.Field{
+		Type:        graphql.NewList(union),
+		Description: descriptions.GetFederated,
+		Args: graphql.FieldConfigArgument{
+			"classes": &graphql.ArgumentConfig{
+				Description: descriptions.GetFederatedClasses,
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String))),
+			},
+			"limit": &graphql.ArgumentConfig{
+				Description: descriptions.First,
+				Type:        graphql.Int,
+			},
+			"hybrid": classFields[classes[0].Class].Args["hybrid"],
+		},
+		Resolve: newResolver(b.modulesProvider).makeResolveFederated(byName),
+	}
+
+	// the search arguments of modules are the same for all classes which
+	// share a vectorizer, the first class of each vectorizer provides them
+	if b.modulesProvider != nil {
+		for _, class := range classes {
+			for name := range b.modulesProvider.GetArguments(class) {
+				if _, ok := field.Args[name]; !ok {
+					field.Args[name] = classFields[class.Class].Args[name]
+				}
+			}
+		}
+	}
+
+	return field
+}
+
+func federatedSourceClass(value interface{}) string {
+	res, _ := value.(map[string]interface{})
+	add, _ := res["_additional"].(map[string]interface{})
+	class, _ := add["sourceClass"].(string)
+	return class
+}
+
+func (r *resolver) makeResolveFederated(classes map[string]*models.Class) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		source, ok := p.Source.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected graphql root to be a map, but was %T", p.Source)
+		}
+		resolver, ok := source["Resolver"].(Resolver)
+		if !ok {
+			return nil, fmt.Errorf("expected source map to have a usable Resolver, but got %#v", source["Resolver"])
+		}
+
+		names, err := federatedClasses(p.Args["classes"], classes)
+		if err != nil {
+			return nil, err
+		}
+
+		var pagination *filters.Pagination
+		if limit, ok := p.Args["limit"].(int); ok && limit > 0 {
+			pagination = &filters.Pagination{Limit: limit}
+		}
+
+		params := make([]dto.GetParams, len(names))
+		for i, name := range names {
+			params[i], err = r.federatedParams(p, name, pagination)
+			if err != nil {
+				return nil, fmt.Errorf("class %q: %w", name, err)
+			}
+		}
+
+		return func() (interface{}, error) {
+			results := make([][]interface{}, len(params))
+			eg, ctx := errgroup.WithContext(p.Context)
+			for i := range params {
+				i := i
+				eg.Go(func() error {
+					res, err := resolver.GetClass(ctx, principalFromContext(p.Context), params[i])
+					if err != nil {
+						return fmt.Errorf("class %q: %w", params[i].ClassName, err)
+					}
+					results[i], _ = res.([]interface{})
+					return nil
+				})
+			}
+			if err := eg.Wait(); err != nil {
+				return nil, err
+			}
+
+			limit := 0
+			if pagination != nil {
+				limit = pagination.Limit
+			}
+			return mergeFederated(names, results, params[0].HybridSearch != nil, limit), nil
+		}, nil
+	}
+}
+
+// federatedClasses validates the classes of a federated search. Vectors of
+// different vectorizers are not comparable, so all classes must share one.
+func federatedClasses(arg interface{}, classes map[string]*models.Class) ([]string, error) {
+	list, _ := arg.([]interface{})
+	if len(list) == 0 {
+		return nil, fmt.Errorf("federated search requires at least one class")
+	}
+
+	names := make([]string, 0, len(list))
+	seen := map[string]struct{}{}
+	var vectorizer string
+	for _, item := range list {
+		name, _ := item.(string)
+		class, ok := classes[name]
+		if !ok {
+			return nil, fmt.Errorf("class %q does not exist", name)
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+
+		if len(names) == 0 {
+			vectorizer = class.Vectorizer
+		} else if class.Vectorizer != vectorizer {
+			return nil, fmt.Errorf("federated search requires all classes to share a "+
+				"vectorizer, but class %q uses %q and class %q uses %q",
+				names[0], vectorizer, name, class.Vectorizer)
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// federatedParams are the params of the search of a single class, its
+// properties are selected by the fragments on the class
+func (r *resolver) federatedParams(p graphql.ResolveParams, className string,
+	pagination *filters.Pagination,
+) (dto.GetParams, error) {
+	params := dto.GetParams{ClassName: className, Pagination: pagination}
+
+	selections := federatedSelections(p.Info.FieldASTs[0].SelectionSet,
+		p.Info.Fragments, className)
+	properties, addlProps, err := extractProperties(className, selections,
+		p.Info.Fragments, r.modulesProvider)
+	if err != nil {
+		return params, err
+	}
+	params.Properties = properties
+	params.AdditionalProperties = addlProps
+
+	if r.modulesProvider != nil {
+		if moduleParams := r.modulesProvider.ExtractSearchParams(p.Args, className); len(moduleParams) > 0 {
+			params.ModuleParams = moduleParams
+		}
+	}
+
+	var hybridParams *searchparams.HybridSearch
+	if hybrid, ok := p.Args["hybrid"]; ok {
+		hybridParams, err = common_filters.ExtractHybridSearch(hybrid.(map[string]interface{}),
+			addlProps.ExplainScore)
+		if err != nil {
+			return params, fmt.Errorf("failed to extract hybrid params: %w", err)
+		}
+		if pagination != nil {
+			hybridParams.Limit = pagination.Limit
+		}
+	}
+	params.HybridSearch = hybridParams
+
+	switch {
+	case hybridParams != nil && params.ModuleParams != nil:
+		return params, fmt.Errorf("federated search requires either hybrid or a " +
+			"vector search operator, not both")
+	case hybridParams != nil:
+		params.AdditionalProperties.Score = true
+	case params.ModuleParams != nil:
+		params.AdditionalProperties.Distance = true
+	default:
+		return params, fmt.Errorf("federated search requires hybrid or a vector " +
+			"search operator such as nearText")
+	}
+
+	setLimitBasedOnVectorSearchParams(&params)
+	return params, nil
+}
+
+// federatedSelections collects the selections of the inline fragments and
+// named fragments on the class
+func federatedSelections(selections *ast.SelectionSet,
+	fragments map[string]ast.Definition, className string,
+) *ast.SelectionSet {
+	result := &ast.SelectionSet{}
+	if selections == nil {
+		return result
+	}
+	for _, selection := range selections.Selections {
+		switch s := selection.(type) {
+		case *ast.InlineFragment:
+			if s.TypeCondition != nil && s.TypeCondition.Name.Value == className {
+				result.Selections = append(result.Selections, s.SelectionSet.Selections...)
+			}
+		case *ast.FragmentSpread:
+			def, ok := fragments[s.Name.Value]
+			if !ok {
+				continue
+			}
+			if name, err := hackyWorkaroundToExtractClassName(def, s.Name.Value); err == nil &&
+				name == className {
+				result.Selections = append(result.Selections, def.GetSelectionSet().Selections...)
+			}
+		}
+	}
+	return result
+}
+
+type federatedHit struct {
+	result map[string]interface{}
+	score  float64
+}
+
+// mergeFederated merges the results of the classes by their normalized
+// score. Scores of different classes are not comparable, e.g. because bm25
+// scores depend on the corpus of a class, so they are normalized to [0, 1]
+// for each class: the best result of a class has score 1 and the worst 0.
+// A limit of 0 keeps as many results as the longest result of a class.
+func mergeFederated(classes []string, results [][]interface{}, hybrid bool,
+	limit int,
+) []interface{} {
+	var hits []federatedHit
+	longest := 0
+	for i, res := range results {
+		if len(res) > longest {
+			longest = len(res)
+		}
+
+		classHits := make([]federatedHit, 0, len(res))
+		for _, item := range res {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			add, ok := m["_additional"].(map[string]interface{})
+			if !ok {
+				add = map[string]interface{}{}
+				m["_additional"] = add
+			}
+			add["sourceClass"] = classes[i]
+			classHits = append(classHits, federatedHit{m, federatedRelevance(add, hybrid)})
+		}
+		normalizeFederated(classHits)
+		hits = append(hits, classHits...)
+	}
+
+	sort.SliceStable(hits, func(a, b int) bool { return hits[a].score > hits[b].score })
+
+	if limit <= 0 {
+		limit = longest
+	}
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	out := make([]interface{}, len(hits))
+	for i, hit := range hits {
+		out[i] = hit.result
+	}
+	return out
+}
+
+// federatedRelevance is higher for better results
+func federatedRelevance(add map[string]interface{}, hybrid bool) float64 {
+	key := "distance"
+	if hybrid {
+		key = "score"
+	}
+	var v float64
+	switch x := add[key].(type) {
+	case float32:
+		v = float64(x)
+	case float64:
+		v = x
+	}
+	if hybrid {
+		return v
+	}
+	return -v
+}
+
+func normalizeFederated(hits []federatedHit) {
+	if len(hits) == 0 {
+		return
+	}
+	min, max := hits[0].score, hits[0].score
+	for _, hit := range hits {
+		if hit.score < min {
+			min = hit.score
+		}
+		if hit.score > max {
+			max = hit.score
+		}
+	}
+	for i := range hits {
+		if max == min {
+			hits[i].score = 1
+		} else {
+			hits[i].score = (hits[i].score - min) / (max - min)
+		}
+		hits[i].result["_additional"].(map[string]interface{})["normalizedScore"] = hits[i].score
+	}
+}
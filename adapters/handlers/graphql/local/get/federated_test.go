@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func TestMergeFederated(t *testing.T) {
+	hit := func(id string, key string, value float32) interface{} {
+		return map[string]interface{}{
+			"id":          id,
+			"_additional": map[string]interface{}{key: value},
+		}
+	}
+	ids := func(results []interface{}) []string {
+		out := make([]string, len(results))
+		for i, res := range results {
+			out[i] = res.(map[string]interface{})["id"].(string)
+		}
+		return out
+	}
+
+	t.Run("hybrid scores are normalized per class", func(t *testing.T) {
+		// the scores of class B are much higher, but the second result of
+		// class A is relatively better than the second result of class B
+		results := [][]interface{}{
+			{hit("a1", "score", 0.9), hit("a2", "score", 0.8), hit("a3", "score", 0.1)},
+			{hit("b1", "score", 50), hit("b2", "score", 10), hit("b3", "score", 5)},
+		}
+		merged := mergeFederated([]string{"A", "B"}, results, true, 4)
+		assert.Equal(t, []string{"a1", "b1", "a2", "b2"}, ids(merged))
+
+		add := merged[2].(map[string]interface{})["_additional"].(map[string]interface{})
+		assert.Equal(t, "A", add["sourceClass"])
+		assert.InDelta(t, 0.875, add["normalizedScore"], 1e-6)
+		assert.Equal(t, float32(0.8), add["score"])
+	})
+
+	t.Run("lower distances are better", func(t *testing.T) {
+		results := [][]interface{}{
+			{hit("a1", "distance", 0.1), hit("a2", "distance", 0.5)},
+			{hit("b1", "distance", 0.2), hit("b2", "distance", 0.25), hit("b3", "distance", 0.6)},
+		}
+		merged := mergeFederated([]string{"A", "B"}, results, false, 0)
+		// without a limit the merged result is as long as the longest one
+		assert.Equal(t, []string{"a1", "b1", "b2"}, ids(merged))
+	})
+
+	t.Run("empty classes", func(t *testing.T) {
+		merged := mergeFederated([]string{"A", "B"}, [][]interface{}{nil, {}}, true, 10)
+		assert.Empty(t, merged)
+	})
+}
+
+func TestFederatedSearch(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolverWithVectorizer("mock-custom-near-text-module")
+	forClass := func(class string) interface{} {
+		return mock.MatchedBy(func(p dto.GetParams) bool { return p.ClassName == class })
+	}
+
+	t.Run("merges the results of the classes", func(t *testing.T) {
+		query := `{ Get { _Federated(classes: ["SomeThing", "SomeAction"],
+			nearCustomText: {concepts: ["c1"]}, limit: 3) {
+				... on SomeThing { intField _additional { sourceClass normalizedScore } }
+				... on SomeAction { intField _additional { sourceClass distance } }
+			} } }`
+
+		resolver.On("GetClass", forClass("SomeThing")).Return([]interface{}{
+			map[string]interface{}{"intField": 1, "_additional": map[string]interface{}{"distance": float32(0.1)}},
+			map[string]interface{}{"intField": 2, "_additional": map[string]interface{}{"distance": float32(0.3)}},
+		}, nil).Run(func(args mock.Arguments) {
+			params := args.Get(0).(dto.GetParams)
+			assert.Equal(t, search.SelectProperties{{Name: "intField", IsPrimitive: true}}, params.Properties)
+			assert.True(t, params.AdditionalProperties.Distance)
+			assert.Equal(t, 3, params.Pagination.Limit)
+			assert.Contains(t, params.ModuleParams, "nearCustomText")
+		}).Once()
+		resolver.On("GetClass", forClass("SomeAction")).Return([]interface{}{
+			map[string]interface{}{"intField": 3, "_additional": map[string]interface{}{"distance": float32(0.2)}},
+			map[string]interface{}{"intField": 4, "_additional": map[string]interface{}{"distance": float32(0.4)}},
+		}, nil).Once()
+
+		res := resolver.AssertResolve(t, query).Get("Get", "_Federated").Result
+		hits, ok := res.([]interface{})
+		require.True(t, ok)
+		require.Len(t, hits, 3)
+		assert.Equal(t, map[string]interface{}{
+			"intField":    1,
+			"_additional": map[string]interface{}{"sourceClass": "SomeThing", "normalizedScore": 1.0},
+		}, hits[0])
+		assert.Equal(t, map[string]interface{}{
+			"intField":    3,
+			"_additional": map[string]interface{}{"sourceClass": "SomeAction", "distance": float32(0.2)},
+		}, hits[1])
+		assert.Equal(t, 2, hits[2].(map[string]interface{})["intField"])
+	})
+
+	t.Run("requires a shared vectorizer", func(t *testing.T) {
+		query := `{ Get { _Federated(classes: ["SomeThing", "CustomVectorClass"],
+			nearCustomText: {concepts: ["c1"]}) {
+				... on SomeThing { intField }
+			} } }`
+		resolver.AssertFailToResolve(t, query)
+	})
+
+	t.Run("requires a search operator", func(t *testing.T) {
+		query := `{ Get { _Federated(classes: ["SomeThing", "SomeAction"]) {
+				... on SomeThing { intField }
+			} } }`
+		resolver.AssertFailToResolve(t, query)
+	})
+}
@@ -35,8 +35,18 @@ type ModulesProvider interface {
 func Build(schema *schema.Schema, logger logrus.FieldLogger,
 	modulesProvider ModulesProvider,
 ) (*graphql.Field, error) {
+	field, _, err := BuildWithEntities(schema, logger, modulesProvider)
+	return field, err
+}
+
+// BuildWithEntities builds the Local.Get part of the graphql tree and the
+// root fields of the Apollo Federation subgraph spec, which share the class
+// types of Get.
+func BuildWithEntities(schema *schema.Schema, logger logrus.FieldLogger,
+	modulesProvider ModulesProvider,
+) (*graphql.Field, graphql.Fields, error) {
 	if len(schema.Objects.Classes) == 0 {
-		return nil, utils.ErrEmptySchema
+		return nil, nil, utils.ErrEmptySchema
 	}
 
 	cb := newClassBuilder(schema, logger, modulesProvider)
@@ -46,7 +56,7 @@ func Build(schema *schema.Schema, logger logrus.FieldLogger,
 	if len(schema.Objects.Classes) > 0 {
 		objects, err = cb.objects()
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
@@ -58,5 +68,5 @@ func Build(schema *schema.Schema, logger logrus.FieldLogger,
 			// Does nothing; pass through the filters
 			return p.Source, nil
 		},
-	}, nil
+	}, cb.entities(), nil
 }
@@ -64,6 +64,92 @@ func TestExtractIntField(t *testing.T) {
 	resolver.AssertResolve(t, query)
 }
 
+func TestFunctionScore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("with a valid expression", func(t *testing.T) {
+		resolver := newMockResolver()
+		expectedParams := dto.GetParams{
+			ClassName:     "SomeAction",
+			Properties:    []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			FunctionScore: &searchparams.FunctionScore{Expression: "_score * log1p(intField)"},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ Get { SomeAction(functionScore: "_score * log1p(intField)") { intField } } }`
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with an invalid expression", func(t *testing.T) {
+		resolver := newMockResolver()
+		query := `{ Get { SomeAction(functionScore: "exec(intField)") { intField } } }`
+		resolver.AssertFailToResolve(t, query)
+	})
+}
+
+func TestVectorCursor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("with a valid cursor", func(t *testing.T) {
+		resolver := newMockResolver()
+		cursor := (&filters.VectorCursor{}).Advance("shard1", 0.25,
+			"8a9d1b5e-62e4-4e5b-9a9e-0a4e0f3d9b71")
+		encoded, err := cursor.Encode()
+		require.Nil(t, err)
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVector: &searchparams.NearVector{
+				Vector: []float32{0.123, 0.984},
+			},
+			VectorCursor:         cursor,
+			AdditionalProperties: additional.Properties{VectorCursor: true},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := fmt.Sprintf(`{ Get { SomeAction(nearVector: {vector: [0.123, 0.984]}, `+
+			`vectorCursor: %q) { intField _additional { vectorCursor } } } }`, encoded)
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with an invalid cursor", func(t *testing.T) {
+		resolver := newMockResolver()
+		query := `{ Get { SomeAction(nearVector: {vector: [0.123, 0.984]}, ` +
+			`vectorCursor: "not a cursor") { intField } } }`
+		resolver.AssertFailToResolve(t, query)
+	})
+}
+
+func TestSample(t *testing.T) {
+	t.Parallel()
+
+	t.Run("with size and seed", func(t *testing.T) {
+		resolver := newMockResolver()
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Sample:     &filters.Sample{Size: 10, Seed: 42},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ Get { SomeAction(sample: {size: 10, seed: 42}) { intField } } }`
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("without a size", func(t *testing.T) {
+		resolver := newMockResolver()
+		query := `{ Get { SomeAction(sample: {seed: 42}) { intField } } }`
+		resolver.AssertFailToResolve(t, query)
+	})
+}
+
 func TestExtractGeoCoordinatesField(t *testing.T) {
 	t.Parallel()
 
@@ -26,7 +26,7 @@ import (
 func Build(dbSchema *schema.Schema, logger logrus.FieldLogger,
 	config config.Config, modulesProvider *modules.Provider,
 ) (graphql.Fields, error) {
-	getField, err := get.Build(dbSchema, logger, modulesProvider)
+	getField, entityFields, err := get.BuildWithEntities(dbSchema, logger, modulesProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +42,7 @@ func Build(dbSchema *schema.Schema, logger logrus.FieldLogger,
 			"Aggregate": aggregateField,
 		}
 
-		return localFields, nil
+		return withEntityFields(localFields, entityFields), nil
 	}
 
 	exploreField := explore.Build(dbSchema.Objects, modulesProvider)
@@ -53,5 +53,14 @@ func Build(dbSchema *schema.Schema, logger logrus.FieldLogger,
 		"Explore":   exploreField,
 	}
 
-	return localFields, nil
+	return withEntityFields(localFields, entityFields), nil
+}
+
+// withEntityFields adds the root fields with which the classes participate in
+// a federated graph
+func withEntityFields(localFields, entityFields graphql.Fields) graphql.Fields {
+	for name, field := range entityFields {
+		localFields[name] = field
+	}
+	return localFields
 }
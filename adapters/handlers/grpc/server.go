@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	pb "github.com/weaviate/weaviate/adapters/handlers/grpc/protocol"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/network/ipfilter"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// NewServer creates a gRPC server serving service. Streams count as
+// in-flight requests of the drainer, no new ones are accepted once the node
+// is shutting down. Like the REST API, clients are rejected if their address
+// isn't allowed by filter.
+func NewServer(service *Service, cfg config.GRPC, filter *ipfilter.Filter) *grpc.Server {
+	s := grpc.NewServer(
+		grpc.MaxRecvMsgSize(cfg.MaxMessageSizeBytes),
+		grpc.MaxSendMsgSize(cfg.MaxMessageSizeBytes),
+		grpc.ChainUnaryInterceptor(ipFilterUnaryInterceptor(filter)),
+		grpc.ChainStreamInterceptor(ipFilterStreamInterceptor(filter),
+			drainInterceptor(service.drainer)),
+	)
+	pb.RegisterWeaviateServer(s, service)
+	return s
+}
+
+// Serve listens on the port and serves s in the background
+func Serve(s *grpc.Server, port int) error {
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return fmt.Errorf("listen on grpc port %d: %w", port, err)
+	}
+	go s.Serve(lis)
+	return nil
+}
+
+// Shutdown waits for the open streams to complete and closes them once ctx
+// expires
+func Shutdown(ctx context.Context, s *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		s.Stop()
+		<-stopped
+	}
+}
+
+func drainInterceptor(d drainer) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+	) error {
+		done, ok := d.Begin()
+		if !ok {
+			return status.Error(codes.Unavailable, "server is shutting down")
+		}
+		defer done()
+		return handler(srv, stream)
+	}
+}
+
+func ipFilterUnaryInterceptor(f *ipfilter.Filter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := checkPeer(ctx, f); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func ipFilterStreamInterceptor(f *ipfilter.Filter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+	) error {
+		if err := checkPeer(stream.Context(), f); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+// checkPeer only considers the address of the direct peer, as the filter
+// middleware of the REST API does
+func checkPeer(ctx context.Context, f *ipfilter.Filter) error {
+	var ip net.IP
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if tcp, ok := p.Addr.(*net.TCPAddr); ok {
+			ip = tcp.IP
+		} else if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			ip = net.ParseIP(host)
+		}
+	}
+	if !f.Allowed(ip) {
+		return status.Error(codes.PermissionDenied, "forbidden")
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/weaviate/weaviate/adapters/handlers/grpc/protocol"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/network/ipfilter"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestBatchObjects(t *testing.T) {
+	t.Run("streams the results of every request", func(t *testing.T) {
+		batch := &fakeBatchManager{}
+		client := newTestClient(t, batch, &fakeDrainer{}, true)
+
+		stream := client.open(t, context.Background())
+		require.Nil(t, stream.Send(&pb.BatchObjectsRequest{Objects: []*pb.BatchObject{
+			{Uuid: "8e5ce6a5-a5ad-4a79-9e0a-0d1fbbdcd9ba", ClassName: "Article", Properties: []byte(`{"title":"foo"}`)},
+			{ClassName: "Article", Properties: []byte(`not json`)},
+			{ClassName: "Fail"},
+		}}))
+		reply := &pb.BatchObjectsReply{}
+		require.Nil(t, stream.RecvMsg(reply))
+		require.Len(t, reply.Results, 3)
+		assert.Equal(t, uint64(0), reply.Results[0].Index)
+		assert.Equal(t, "8e5ce6a5-a5ad-4a79-9e0a-0d1fbbdcd9ba", reply.Results[0].Uuid)
+		assert.Empty(t, reply.Results[0].Error)
+		assert.Contains(t, reply.Results[1].Error, "decode properties")
+		assert.Equal(t, uint64(2), reply.Results[2].Index)
+		assert.Equal(t, "class Fail failed", reply.Results[2].Error)
+
+		require.Nil(t, stream.Send(&pb.BatchObjectsRequest{
+			Objects:          []*pb.BatchObject{{ClassName: "Article"}},
+			ConsistencyLevel: "ALL",
+		}))
+		require.Nil(t, stream.RecvMsg(reply))
+		require.Len(t, reply.Results, 1)
+		assert.Equal(t, uint64(3), reply.Results[0].Index)
+		assert.Equal(t, "generated", reply.Results[0].Uuid)
+
+		require.Nil(t, stream.CloseSend())
+		assert.Equal(t, io.EOF, stream.RecvMsg(reply))
+
+		require.Len(t, batch.calls, 2)
+		assert.Equal(t, "foo", batch.calls[0][0].Properties.(map[string]interface{})["title"])
+		assert.Equal(t, "ALL", batch.repl.ConsistencyLevel)
+	})
+
+	t.Run("invalid consistency level", func(t *testing.T) {
+		client := newTestClient(t, &fakeBatchManager{}, &fakeDrainer{}, true)
+
+		stream := client.open(t, context.Background())
+		require.Nil(t, stream.Send(&pb.BatchObjectsRequest{
+			Objects:          []*pb.BatchObject{{ClassName: "Article"}},
+			ConsistencyLevel: "MOST",
+		}))
+		err := stream.RecvMsg(&pb.BatchObjectsReply{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("anonymous access disabled", func(t *testing.T) {
+		client := newTestClient(t, &fakeBatchManager{}, &fakeDrainer{}, false)
+
+		stream := client.open(t, context.Background())
+		err := stream.RecvMsg(&pb.BatchObjectsReply{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		ctx := metadata.AppendToOutgoingContext(context.Background(),
+			"authorization", "Bearer valid")
+		stream = client.open(t, ctx)
+		require.Nil(t, stream.Send(&pb.BatchObjectsRequest{
+			Objects: []*pb.BatchObject{{ClassName: "Article"}},
+		}))
+		require.Nil(t, stream.RecvMsg(&pb.BatchObjectsReply{}))
+	})
+
+	t.Run("rejected while draining", func(t *testing.T) {
+		drainer := &fakeDrainer{draining: true}
+		client := newTestClient(t, &fakeBatchManager{}, drainer, true)
+
+		stream := client.open(t, context.Background())
+		err := stream.RecvMsg(&pb.BatchObjectsReply{})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("rejected by the network access rules", func(t *testing.T) {
+		filter, err := ipfilter.New(ipfilter.Rules{Deny: []string{"127.0.0.0/8"}})
+		require.Nil(t, err)
+		client := newFilteredTestClient(t, &fakeBatchManager{}, &fakeDrainer{}, true, filter)
+
+		stream := client.open(t, context.Background())
+		_, err = stream.Recv()
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// rules are updated at runtime
+		require.Nil(t, filter.Update(ipfilter.Rules{Allow: []string{"127.0.0.1"}}))
+		stream = client.open(t, context.Background())
+		require.Nil(t, stream.Send(&pb.BatchObjectsRequest{
+			Objects: []*pb.BatchObject{{ClassName: "Article"}},
+		}))
+		_, err = stream.Recv()
+		require.Nil(t, err)
+	})
+}
+
+func TestIPFilterUnaryInterceptor(t *testing.T) {
+	filter, err := ipfilter.New(ipfilter.Rules{Allow: []string{"10.0.0.0/8"}})
+	require.Nil(t, err)
+	interceptor := ipFilterUnaryInterceptor(filter)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	for _, tc := range []struct {
+		addr net.Addr
+		code codes.Code
+	}{
+		{addr: &net.TCPAddr{IP: net.ParseIP("10.1.2.3"), Port: 4000}, code: codes.OK},
+		{addr: &net.TCPAddr{IP: net.ParseIP("192.168.0.1"), Port: 4000}, code: codes.PermissionDenied},
+		{addr: nil, code: codes.PermissionDenied},
+	} {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: tc.addr})
+		res, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+		assert.Equal(t, tc.code, status.Code(err))
+		if tc.code == codes.OK {
+			assert.Equal(t, "ok", res)
+		}
+	}
+}
+
+type testClient struct {
+	client pb.WeaviateClient
+}
+
+func newTestClient(t *testing.T, batch batchManager, drainer drainer,
+	anonymousAccess bool,
+) *testClient {
+	filter, err := ipfilter.New(ipfilter.Rules{})
+	require.Nil(t, err)
+	return newFilteredTestClient(t, batch, drainer, anonymousAccess, filter)
+}
+
+// newFilteredTestClient connects through the loopback interface, so the
+// server sees 127.0.0.1 as the address of the client
+func newFilteredTestClient(t *testing.T, batch batchManager, drainer drainer,
+	anonymousAccess bool, filter *ipfilter.Filter,
+) *testClient {
+	tokenAuth := func(token string, scopes []string) (*models.Principal, error) {
+		if token != "valid" {
+			return nil, errors.New("invalid token")
+		}
+		return &models.Principal{Username: "alice"}, nil
+	}
+	logger, _ := test.NewNullLogger()
+	service := NewService(batch, fakeBackpressure{}, tokenAuth, anonymousAccess,
+		drainer, nil, logger)
+	server := NewServer(service, config.GRPC{MaxMessageSizeBytes: 1024 * 1024}, filter)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(lis.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.Nil(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return &testClient{client: pb.NewWeaviateClient(conn)}
+}
+
+func (c *testClient) open(t *testing.T, ctx context.Context) pb.Weaviate_BatchObjectsClient {
+	stream, err := c.client.BatchObjects(ctx)
+	require.Nil(t, err)
+	return stream
+}
+
+type fakeBatchManager struct {
+	calls [][]*models.Object
+	repl  *additional.ReplicationProperties
+}
+
+func (f *fakeBatchManager) AddObjects(ctx context.Context, principal *models.Principal,
+	objs []*models.Object, fields []*string, upsert bool,
+	repl *additional.ReplicationProperties,
+) (objects.BatchObjects, error) {
+	f.calls = append(f.calls, objs)
+	f.repl = repl
+
+	res := make(objects.BatchObjects, len(objs))
+	for i, obj := range objs {
+		res[i] = objects.BatchObject{OriginalIndex: i, Object: obj, UUID: obj.ID}
+		if obj.ID == "" {
+			res[i].UUID = "generated"
+		}
+		if obj.Class == "Fail" {
+			res[i].Err = errors.New("class Fail failed")
+		}
+	}
+	return res, nil
+}
+
+type fakeBackpressure struct{}
+
+func (fakeBackpressure) Acquire(ctx context.Context, n int) (func(), error) {
+	return func() {}, nil
+}
+
+type fakeDrainer struct {
+	draining bool
+}
+
+func (d *fakeDrainer) Begin() (func(), bool) {
+	return func() {}, !d.draining
+}
+
+func (d *fakeDrainer) Draining() bool {
+	return d.draining
+}
@@ -0,0 +1,247 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	pb "github.com/weaviate/weaviate/adapters/handlers/grpc/protocol"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/metering"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/replica"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type batchManager interface {
+	AddObjects(ctx context.Context, principal *models.Principal,
+		objects []*models.Object, fields []*string, upsert bool,
+		repl *additional.ReplicationProperties) (objects.BatchObjects, error)
+}
+
+type batchBackpressure interface {
+	Acquire(ctx context.Context, n int) (func(), error)
+}
+
+type drainer interface {
+	Begin() (done func(), ok bool)
+	Draining() bool
+}
+
+// TokenFunc validates a bearer token, it matches the token validation of
+// the REST API
+type TokenFunc func(token string, scopes []string) (*models.Principal, error)
+
+// Service implements the Weaviate gRPC service
+type Service struct {
+	pb.UnimplementedWeaviateServer
+
+	batch           batchManager
+	backpressure    batchBackpressure
+	tokenAuth       TokenFunc
+	anonymousAccess bool
+	drainer         drainer
+	meter           *metering.Collector
+	logger          logrus.FieldLogger
+}
+
+// NewService creates the service. The meter is optional.
+func NewService(batch batchManager, backpressure batchBackpressure,
+	tokenAuth TokenFunc, anonymousAccess bool, drainer drainer,
+	meter *metering.Collector, logger logrus.FieldLogger,
+) *Service {
+	return &Service{
+		batch:           batch,
+		backpressure:    backpressure,
+		tokenAuth:       tokenAuth,
+		anonymousAccess: anonymousAccess,
+		drainer:         drainer,
+		meter:           meter,
+		logger:          logger,
+	}
+}
+
+// BatchObjects imports every request of the stream through the regular
+// batch pipeline and replies with the results of its objects once they
+// committed. A failing object does not end the stream, a failing batch does.
+func (s *Service) BatchObjects(stream pb.Weaviate_BatchObjectsServer) error {
+	ctx := stream.Context()
+	principal, err := s.principal(ctx)
+	if err != nil {
+		return err
+	}
+
+	var offset uint64
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if s.drainer.Draining() {
+			// the objects received so far are imported, the client is expected
+			// to continue with another node
+			return status.Error(codes.Unavailable, "server is shutting down")
+		}
+
+		results, err := s.importBatch(ctx, principal, req, offset)
+		if err != nil {
+			return err
+		}
+		offset += uint64(len(req.Objects))
+
+		if err := stream.Send(&pb.BatchObjectsReply{Results: results}); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Service) importBatch(ctx context.Context, principal *models.Principal,
+	req *pb.BatchObjectsRequest, offset uint64,
+) ([]*pb.BatchObjectResult, error) {
+	repl, err := replicationProperties(req.ConsistencyLevel)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// objects which can't be decoded fail individually, like invalid objects
+	// of the batch pipeline
+	results := make([]*pb.BatchObjectResult, len(req.Objects))
+	objs := make([]*models.Object, 0, len(req.Objects))
+	pos := make([]int, 0, len(req.Objects))
+	for i, obj := range req.Objects {
+		results[i] = &pb.BatchObjectResult{Index: offset + uint64(i), Uuid: obj.Uuid}
+		o, err := objectToModel(obj)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		objs = append(objs, o)
+		pos = append(pos, i)
+	}
+	if len(objs) == 0 {
+		return results, nil
+	}
+
+	release, err := s.backpressure.Acquire(ctx, len(objs))
+	if err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	imported, err := s.batch.AddObjects(ctx, principal, objs, nil, req.Upsert, repl)
+	release()
+	if err != nil {
+		switch err.(type) {
+		case autherrs.Forbidden:
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case objects.ErrInvalidUserInput:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	for _, obj := range imported {
+		res := results[pos[obj.OriginalIndex]]
+		res.Uuid = obj.UUID.String()
+		if obj.Err != nil {
+			res.Error = obj.Err.Error()
+		}
+	}
+	s.recordObjects(principal, imported)
+
+	return results, nil
+}
+
+func objectToModel(o *pb.BatchObject) (*models.Object, error) {
+	obj := &models.Object{Class: o.ClassName, Vector: o.Vector}
+	if o.Uuid != "" {
+		if !strfmt.IsUUID(o.Uuid) {
+			return nil, fmt.Errorf("invalid uuid %q", o.Uuid)
+		}
+		obj.ID = strfmt.UUID(o.Uuid)
+	}
+	if len(o.Properties) > 0 {
+		var props map[string]interface{}
+		if err := json.Unmarshal(o.Properties, &props); err != nil {
+			return nil, fmt.Errorf("decode properties: %w", err)
+		}
+		obj.Properties = props
+	}
+	return obj, nil
+}
+
+// principal authenticates the stream with the bearer token of the
+// authorization metadata. Streams without a token are only accepted if
+// anonymous access is enabled.
+func (s *Service) principal(ctx context.Context) (*models.Principal, error) {
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			token = strings.TrimPrefix(values[0], "Bearer ")
+		}
+	}
+	if token == "" {
+		if !s.anonymousAccess {
+			return nil, status.Error(codes.Unauthenticated,
+				"anonymous access not enabled, please provide an auth scheme such as OIDC")
+		}
+		return nil, nil
+	}
+
+	principal, err := s.tokenAuth(token, nil)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return principal, nil
+}
+
+// recordObjects meters the successfully imported objects of a batch
+func (s *Service) recordObjects(principal *models.Principal, objs objects.BatchObjects) {
+	if s.meter == nil {
+		return
+	}
+
+	byClass := map[string]int{}
+	for _, obj := range objs {
+		if obj.Err == nil && obj.Object != nil {
+			byClass[obj.Object.Class]++
+		}
+	}
+	for class, count := range byClass {
+		s.meter.Record(principal, class, metering.OperationBatchCreate, count)
+	}
+}
+
+func replicationProperties(lvl string) (*additional.ReplicationProperties, error) {
+	if lvl == "" {
+		return nil, nil
+	}
+	switch replica.ConsistencyLevel(lvl) {
+	case replica.One, replica.Quorum, replica.All:
+		return &additional.ReplicationProperties{ConsistencyLevel: lvl}, nil
+	default:
+		return nil, fmt.Errorf("unrecognized consistency level %q, "+
+			"try one of the following: ['ONE', 'QUORUM', 'ALL']", lvl)
+	}
+}
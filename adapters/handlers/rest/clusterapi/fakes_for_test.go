@@ -163,6 +163,18 @@ func (n *NilMigrator) DropClass(ctx context.Context, className string) error {
 	return nil
 }
 
+func (n *NilMigrator) TrashClass(ctx context.Context, className, trashID string) error {
+	return nil
+}
+
+func (n *NilMigrator) RestoreTrashedClass(ctx context.Context, trashID string) error {
+	return nil
+}
+
+func (n *NilMigrator) DeleteTrashedClass(ctx context.Context, trashID string) error {
+	return nil
+}
+
 func (n *NilMigrator) UpdateClass(ctx context.Context, className string, newClassName *string) error {
 	return nil
 }
@@ -175,10 +187,46 @@ func (n *NilMigrator) UpdateShardStatus(ctx context.Context, className, shardNam
 	return nil
 }
 
+func (n *NilMigrator) DropShard(ctx context.Context, className, shardName string) error {
+	return nil
+}
+
+func (n *NilMigrator) OptimizeClass(ctx context.Context, className string) (*models.ClassOptimizeResult, error) {
+	return nil, nil
+}
+
+func (n *NilMigrator) CleanupInvertedClass(ctx context.Context, className string) (*models.ClassInvertedCleanupResult, error) {
+	return nil, nil
+}
+
+func (n *NilMigrator) ReplayClass(ctx context.Context, className string, from, to int64,
+	after string, limit int, targetClass string,
+) (*models.ClassReplayResult, error) {
+	return nil, nil
+}
+
 func (n *NilMigrator) AddProperty(ctx context.Context, className string, prop *models.Property) error {
 	return nil
 }
 
+func (n *NilMigrator) PreparePropertyMigration(ctx context.Context, className string,
+	migration *models.SchemaMigration,
+) error {
+	return nil
+}
+
+func (n *NilMigrator) CommitPropertyMigration(ctx context.Context, className string,
+	migration *models.SchemaMigration,
+) error {
+	return nil
+}
+
+func (n *NilMigrator) AbortPropertyMigration(ctx context.Context, className string,
+	migration *models.SchemaMigration,
+) error {
+	return nil
+}
+
 func (n *NilMigrator) UpdateProperty(ctx context.Context, className string, propName string, newName *string) error {
 	return nil
 }
@@ -207,6 +255,10 @@ func (n *NilMigrator) UpdateInvertedIndexConfig(ctx context.Context, className s
 	return nil
 }
 
+func (n *NilMigrator) UpdateVersioningConfig(ctx context.Context, className string, updated *models.VersioningConfig) error {
+	return nil
+}
+
 func (n *NilMigrator) RecalculateVectorDimensions(ctx context.Context) error {
 	return nil
 }
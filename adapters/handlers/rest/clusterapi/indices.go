@@ -19,15 +19,23 @@ import (
 	"io"
 	"net/http"
 	"regexp"
+	"strconv"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/changes"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/querytimeout"
+	"github.com/weaviate/weaviate/entities/replay"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
 )
@@ -35,11 +43,13 @@ import (
 type indices struct {
 	shards                    shards
 	db                        db
+	redactor                  *authorization.Redactor
 	regexpObjects             *regexp.Regexp
 	regexpObjectsOverwrite    *regexp.Regexp
 	regexObjectsDigest        *regexp.Regexp
 	regexpObjectsSearch       *regexp.Regexp
 	regexpObjectsFind         *regexp.Regexp
+	regexpObjectsSample       *regexp.Regexp
 	regexpObjectsAggregations *regexp.Regexp
 	regexpObject              *regexp.Regexp
 	regexpReferences          *regexp.Regexp
@@ -47,6 +57,12 @@ type indices struct {
 	regexpShardFiles          *regexp.Regexp
 	regexpShard               *regexp.Regexp
 	regexpShardReinit         *regexp.Regexp
+	regexpShardIntegrity      *regexp.Regexp
+	regexpShardOptimize       *regexp.Regexp
+	regexpShardCleanup        *regexp.Regexp
+	regexpShardMigrate        *regexp.Regexp
+	regexpShardChanges        *regexp.Regexp
+	regexpShardReplay         *regexp.Regexp
 }
 
 const (
@@ -60,6 +76,8 @@ const (
 		`\/shards\/([A-Za-z0-9]+)\/objects\/_search`
 	urlPatternObjectsFind = `\/indices\/([A-Za-z0-9_+-]+)` +
 		`\/shards\/([A-Za-z0-9]+)\/objects\/_find`
+	urlPatternObjectsSample = `\/indices\/([A-Za-z0-9_+-]+)` +
+		`\/shards\/([A-Za-z0-9]+)\/objects\/_sample`
 	urlPatternObjectsAggregations = `\/indices\/([A-Za-z0-9_+-]+)` +
 		`\/shards\/([A-Za-z0-9]+)\/objects\/_aggregations`
 	urlPatternObject = `\/indices\/([A-Za-z0-9_+-]+)` +
@@ -74,6 +92,18 @@ const (
 		`\/shards\/([A-Za-z0-9]+)$`
 	urlPatternShardReinit = `\/indices\/([A-Za-z0-9_+-]+)` +
 		`\/shards\/([A-Za-z0-9]+):reinit`
+	urlPatternShardIntegrity = `\/indices\/([A-Za-z0-9_+-]+)` +
+		`\/shards\/([A-Za-z0-9]+)\/vector:integrity`
+	urlPatternShardOptimize = `\/indices\/([A-Za-z0-9_+-]+)` +
+		`\/shards\/([A-Za-z0-9]+):optimize`
+	urlPatternShardCleanup = `\/indices\/([A-Za-z0-9_+-]+)` +
+		`\/shards\/([A-Za-z0-9]+):cleanup-inverted`
+	urlPatternShardMigrate = `\/indices\/([A-Za-z0-9_+-]+)` +
+		`\/shards\/([A-Za-z0-9]+):migrate`
+	urlPatternShardChanges = `\/indices\/([A-Za-z0-9_+-]+)` +
+		`\/shards\/([A-Za-z0-9]+)\/objects:changes`
+	urlPatternShardReplay = `\/indices\/([A-Za-z0-9_+-]+)` +
+		`\/shards\/([A-Za-z0-9]+)\/objects:replay`
 )
 
 type shards interface {
@@ -86,6 +116,8 @@ type shards interface {
 	GetObject(ctx context.Context, indexName, shardName string,
 		id strfmt.UUID, selectProperties search.SelectProperties,
 		additional additional.Properties) (*storobj.Object, error)
+	GetObjectAtVersion(ctx context.Context, indexName, shardName string,
+		id strfmt.UUID, version int64) (*storobj.Object, error)
 	Exists(ctx context.Context, indexName, shardName string,
 		id strfmt.UUID) (bool, error)
 	DeleteObject(ctx context.Context, indexName, shardName string,
@@ -95,7 +127,8 @@ type shards interface {
 	MultiGetObjects(ctx context.Context, indexName, shardName string,
 		id []strfmt.UUID) ([]*storobj.Object, error)
 	Search(ctx context.Context, indexName, shardName string,
-		vector []float32, distance float32, limit int, filters *filters.LocalFilter,
+		vector []float32, targetVector string, targets *searchparams.TargetVectors,
+		distance float32, limit int, filters *filters.LocalFilter,
 		keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 		cursor *filters.Cursor, additional additional.Properties,
 	) ([]*storobj.Object, []float32, error)
@@ -103,6 +136,9 @@ type shards interface {
 		params aggregation.Params) (*aggregation.Result, error)
 	FindDocIDs(ctx context.Context, indexName, shardName string,
 		filters *filters.LocalFilter) ([]uint64, error)
+	SampleObjects(ctx context.Context, indexName, shardName string,
+		filters *filters.LocalFilter, sample filters.Sample,
+		additional additional.Properties) ([]*storobj.Object, []uint64, error)
 	DeleteObjectBatch(ctx context.Context, indexName, shardName string,
 		docIDs []uint64, dryRun bool) objects.BatchSimpleObjects
 	GetShardStatus(ctx context.Context, indexName, shardName string) (string, error)
@@ -120,19 +156,39 @@ type shards interface {
 		filePath string) (io.WriteCloser, error)
 	CreateShard(ctx context.Context, indexName, shardName string) error
 	ReInitShard(ctx context.Context, indexName, shardName string) error
+
+	// Maintenance
+	CheckVectorIndexIntegrity(ctx context.Context, indexName, shardName string,
+		repair bool) (hnsw.IntegrityReport, error)
+	OptimizeShard(ctx context.Context, indexName,
+		shardName string) (*models.ShardOptimizeResult, error)
+	CleanupInvertedShard(ctx context.Context, indexName,
+		shardName string) (*models.ShardInvertedCleanupResult, error)
+	PrepareMigrationShard(ctx context.Context, indexName, shardName string,
+		migration *models.SchemaMigration) error
+
+	// Incremental sync
+	ChangesSince(ctx context.Context, indexName, shardName, token string,
+		limit int) (*changes.Changes, error)
+	ReplayShard(ctx context.Context, indexName, shardName string, from, to int64,
+		after replay.Cursor, limit int) (*replay.Page, error)
 }
 
 type db interface {
 	StartupComplete() bool
 }
 
-func NewIndices(shards shards, db db) *indices {
+// NewIndices serves the indices of this node to the other nodes. The
+// redactor removes restricted properties from the changes feed, which is
+// consumed by downstream systems.
+func NewIndices(shards shards, db db, redactor *authorization.Redactor) *indices {
 	return &indices{
 		regexpObjects:             regexp.MustCompile(urlPatternObjects),
 		regexpObjectsOverwrite:    regexp.MustCompile(urlPatternObjectsOverwrite),
 		regexObjectsDigest:        regexp.MustCompile(urlPatternObjectsDigest),
 		regexpObjectsSearch:       regexp.MustCompile(urlPatternObjectsSearch),
 		regexpObjectsFind:         regexp.MustCompile(urlPatternObjectsFind),
+		regexpObjectsSample:       regexp.MustCompile(urlPatternObjectsSample),
 		regexpObjectsAggregations: regexp.MustCompile(urlPatternObjectsAggregations),
 		regexpObject:              regexp.MustCompile(urlPatternObject),
 		regexpReferences:          regexp.MustCompile(urlPatternReferences),
@@ -140,8 +196,15 @@ func NewIndices(shards shards, db db) *indices {
 		regexpShardFiles:          regexp.MustCompile(urlPatternShardFiles),
 		regexpShard:               regexp.MustCompile(urlPatternShard),
 		regexpShardReinit:         regexp.MustCompile(urlPatternShardReinit),
+		regexpShardIntegrity:      regexp.MustCompile(urlPatternShardIntegrity),
+		regexpShardOptimize:       regexp.MustCompile(urlPatternShardOptimize),
+		regexpShardCleanup:        regexp.MustCompile(urlPatternShardCleanup),
+		regexpShardMigrate:        regexp.MustCompile(urlPatternShardMigrate),
+		regexpShardChanges:        regexp.MustCompile(urlPatternShardChanges),
+		regexpShardReplay:         regexp.MustCompile(urlPatternShardReplay),
 		shards:                    shards,
 		db:                        db,
+		redactor:                  redactor,
 	}
 }
 
@@ -165,6 +228,14 @@ func (i *indices) Indices() http.Handler {
 
 			i.postFindDocIDs().ServeHTTP(w, r)
 			return
+		case i.regexpObjectsSample.MatchString(path):
+			if r.Method != http.MethodPost {
+				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+				return
+			}
+
+			i.postSampleObjects().ServeHTTP(w, r)
+			return
 		case i.regexpObjectsAggregations.MatchString(path):
 			if r.Method != http.MethodPost {
 				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
@@ -185,6 +256,21 @@ func (i *indices) Indices() http.Handler {
 			}
 
 			i.getObjectsDigest().ServeHTTP(w, r)
+		case i.regexpShardChanges.MatchString(path):
+			if r.Method == http.MethodGet {
+				i.getShardChanges().ServeHTTP(w, r)
+				return
+			}
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		case i.regexpShardReplay.MatchString(path):
+			if r.Method != http.MethodGet {
+				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+				return
+			}
+
+			i.getShardReplay().ServeHTTP(w, r)
+			return
 		case i.regexpObject.MatchString(path):
 			if r.Method == http.MethodGet {
 				i.getObject().ServeHTTP(w, r)
@@ -262,6 +348,39 @@ func (i *indices) Indices() http.Handler {
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
 
+		case i.regexpShardIntegrity.MatchString(path):
+			// GET only reports issues, POST also repairs them
+			if r.Method == http.MethodGet || r.Method == http.MethodPost {
+				i.checkShardIntegrity(r.Method == http.MethodPost).ServeHTTP(w, r)
+				return
+			}
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+
+		case i.regexpShardOptimize.MatchString(path):
+			if r.Method == http.MethodPost {
+				i.postShardOptimize().ServeHTTP(w, r)
+				return
+			}
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+
+		case i.regexpShardCleanup.MatchString(path):
+			if r.Method == http.MethodPost {
+				i.postShardCleanupInverted().ServeHTTP(w, r)
+				return
+			}
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+
+		case i.regexpShardMigrate.MatchString(path):
+			if r.Method == http.MethodPost {
+				i.postShardMigrate().ServeHTTP(w, r)
+				return
+			}
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+
 		default:
 			http.NotFound(w, r)
 			return
@@ -365,6 +484,11 @@ func (i *indices) getObject() http.Handler {
 			return
 		}
 
+		if version := r.URL.Query().Get("version"); version != "" {
+			i.getObjectAtVersion(w, r, index, shard, id, version)
+			return
+		}
+
 		additionalEncoded := r.URL.Query().Get("additional")
 		if additionalEncoded == "" {
 			http.Error(w, "missing required url param 'additional'",
@@ -449,6 +573,36 @@ func (i *indices) checkExists(w http.ResponseWriter, r *http.Request,
 	}
 }
 
+func (i *indices) getObjectAtVersion(w http.ResponseWriter, r *http.Request,
+	index, shard, id, version string,
+) {
+	v, err := strconv.ParseInt(version, 10, 64)
+	if err != nil {
+		http.Error(w, "parse 'version' param: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	obj, err := i.shards.GetObjectAtVersion(r.Context(), index, shard, strfmt.UUID(id), v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if obj == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	objBytes, err := IndicesPayloads.SingleObject.Marshal(obj)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	IndicesPayloads.SingleObject.SetContentTypeHeader(w)
+	w.Write(objBytes)
+}
+
 func (i *indices) deleteObject() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := i.regexpObject.FindStringSubmatch(r.URL.Path)
@@ -582,7 +736,7 @@ func (i *indices) postSearchObjects() http.Handler {
 			return
 		}
 
-		vector, certainty, limit, filters, keywordRanking, sort, cursor, additional, err := IndicesPayloads.SearchParams.
+		vector, targetVector, targets, certainty, limit, filter, keywordRanking, sort, cursor, additional, filterStrategy, vectorCursor, err := IndicesPayloads.SearchParams.
 			Unmarshal(reqPayload)
 		if err != nil {
 			http.Error(w, "unmarshal search params from json: "+err.Error(),
@@ -590,8 +744,13 @@ func (i *indices) postSearchObjects() http.Handler {
 			return
 		}
 
-		results, dists, err := i.shards.Search(r.Context(), index, shard,
-			vector, certainty, limit, filters, keywordRanking, sort, cursor, additional)
+		// the filter strategy, the deadline of the query and the position of
+		// a vector cursor are passed on to the shard through the context, as
+		// on the coordinating node
+		ctx := hnsw.NewFilterStrategyContext(queryDeadlineContext(r), filterStrategy)
+		ctx = filters.NewShardVectorCursorContext(ctx, vectorCursor)
+		results, dists, err := i.shards.Search(ctx, index, shard,
+			vector, targetVector, targets, certainty, limit, filter, keywordRanking, sort, cursor, additional)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
@@ -604,6 +763,57 @@ func (i *indices) postSearchObjects() http.Handler {
 		}
 
 		IndicesPayloads.SearchResults.SetContentTypeHeader(w)
+		setPartialResultsHeader(ctx, w)
+		w.Write(resBytes)
+	})
+}
+
+func (i *indices) postSampleObjects() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpObjectsSample.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+		reqPayload, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "read request body: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		ct, ok := IndicesPayloads.SampleParams.CheckContentTypeHeaderReq(r)
+		if !ok {
+			http.Error(w, errors.Errorf("unexpected content type: %s", ct).Error(),
+				http.StatusUnsupportedMediaType)
+			return
+		}
+
+		filters, sample, additional, err := IndicesPayloads.SampleParams.
+			Unmarshal(reqPayload)
+		if err != nil {
+			http.Error(w, "unmarshal sample params from json: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		results, priorities, err := i.shards.SampleObjects(r.Context(), index, shard,
+			filters, sample, additional)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		resBytes, err := IndicesPayloads.SampleResults.Marshal(results, priorities)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.SampleResults.SetContentTypeHeader(w)
 		w.Write(resBytes)
 	})
 }
@@ -684,7 +894,7 @@ func (i *indices) postAggregateObjects() http.Handler {
 			return
 		}
 
-		aggRes, err := i.shards.Aggregate(r.Context(), index, shard, params)
+		aggRes, err := i.shards.Aggregate(queryDeadlineContext(r), index, shard, params)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
@@ -1028,3 +1238,270 @@ func (i *indices) putShardReinit() http.Handler {
 		w.WriteHeader(http.StatusNoContent)
 	})
 }
+
+func (i *indices) checkShardIntegrity(repair bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpShardIntegrity.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		report, err := i.shards.CheckVectorIndexIntegrity(r.Context(), index, shard, repair)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		reportBytes, err := IndicesPayloads.VectorIndexIntegrity.Marshal(report)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.VectorIndexIntegrity.SetContentTypeHeader(w)
+		w.Write(reportBytes)
+	})
+}
+
+// getShardChanges serves the changes of a shard after the token given in the
+// "token" query parameter, an empty token starts at the beginning
+func (i *indices) getShardChanges() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpShardChanges.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		limit := 100
+		if l := r.URL.Query().Get("limit"); l != "" {
+			parsed, err := strconv.Atoi(l)
+			if err != nil || parsed < 1 {
+				http.Error(w, "limit must be a positive integer", http.StatusBadRequest)
+				return
+			}
+			limit = parsed
+		}
+
+		res, err := i.shards.ChangesSince(r.Context(), index, shard,
+			r.URL.Query().Get("token"), limit)
+		if err != nil {
+			if errors.Is(err, changes.ErrExpired) {
+				http.Error(w, err.Error(), http.StatusGone)
+				return
+			}
+			if errors.Is(err, changes.ErrInvalidToken) {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		// the cluster api has no principals, the feed is redacted as for an
+		// anonymous user
+		i.redactor.RedactObjects(nil, res.Objects)
+
+		resBytes, err := IndicesPayloads.ShardChanges.Marshal(res)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.ShardChanges.SetContentTypeHeader(w)
+		w.Write(resBytes)
+	})
+}
+
+func (i *indices) getShardReplay() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpShardReplay.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		query := r.URL.Query()
+		from, err := strconv.ParseInt(query.Get("from"), 10, 64)
+		if err != nil {
+			http.Error(w, "from must be an integer", http.StatusBadRequest)
+			return
+		}
+		to, err := strconv.ParseInt(query.Get("to"), 10, 64)
+		if err != nil {
+			http.Error(w, "to must be an integer", http.StatusBadRequest)
+			return
+		}
+		limit, err := strconv.Atoi(query.Get("limit"))
+		if err != nil || limit < 1 {
+			http.Error(w, "limit must be a positive integer", http.StatusBadRequest)
+			return
+		}
+		after, err := replay.ParseCursor(query.Get("after"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		page, err := i.shards.ReplayShard(r.Context(), index, shard, from, to,
+			after, limit)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		resBytes, err := IndicesPayloads.ReplayPage.Marshal(page)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.ReplayPage.SetContentTypeHeader(w)
+		w.Write(resBytes)
+	})
+}
+
+func (i *indices) postShardOptimize() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpShardOptimize.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		result, err := i.shards.OptimizeShard(r.Context(), index, shard)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		resultBytes, err := IndicesPayloads.ShardOptimizeResult.Marshal(result)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.ShardOptimizeResult.SetContentTypeHeader(w)
+		w.Write(resultBytes)
+	})
+}
+
+func (i *indices) postShardCleanupInverted() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpShardCleanup.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		result, err := i.shards.CleanupInvertedShard(r.Context(), index, shard)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		resultBytes, err := IndicesPayloads.ShardCleanupResult.Marshal(result)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.ShardCleanupResult.SetContentTypeHeader(w)
+		w.Write(resultBytes)
+	})
+}
+
+func (i *indices) postShardMigrate() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpShardMigrate.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+		ct, ok := IndicesPayloads.SchemaMigration.CheckContentTypeHeaderReq(r)
+		if !ok {
+			http.Error(w, errors.Errorf("unexpected content type: %s", ct).Error(),
+				http.StatusUnsupportedMediaType)
+			return
+		}
+
+		bodyBytes, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		migration, err := IndicesPayloads.SchemaMigration.Unmarshal(bodyBytes)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if err := i.shards.PrepareMigrationShard(r.Context(), index, shard,
+			migration); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// queryDeadlineHeader carries the partial deadline of a query, see
+// querytimeout.PartialDeadline, to the nodes which search its remote shards.
+// The deadline is sent as unix nanoseconds, the clocks of the nodes are
+// assumed to be in sync.
+const queryDeadlineHeader = "X-Weaviate-Query-Deadline"
+
+// SetQueryDeadlineHeader passes the partial deadline of the query, if it has
+// one, on to the node receiving the request
+func SetQueryDeadlineHeader(ctx context.Context, r *http.Request) {
+	if deadline, ok := querytimeout.PartialDeadline(ctx); ok {
+		r.Header.Set(queryDeadlineHeader, strconv.FormatInt(deadline.UnixNano(), 10))
+	}
+}
+
+// queryDeadlineContext continues the query of the request with its partial
+// deadline, if it has one
+func queryDeadlineContext(r *http.Request) context.Context {
+	nanos, err := strconv.ParseInt(r.Header.Get(queryDeadlineHeader), 10, 64)
+	if err != nil {
+		return r.Context()
+	}
+	return querytimeout.WithPartialDeadline(r.Context(), time.Unix(0, nanos))
+}
+
+// partialResultsHeader is set on the response of a remote shard whose search
+// stopped at the partial deadline of the query
+const partialResultsHeader = "X-Weaviate-Partial-Results"
+
+// setPartialResultsHeader marks the response as incomplete if the search of
+// the shard stopped at the partial deadline
+func setPartialResultsHeader(ctx context.Context, w http.ResponseWriter) {
+	if querytimeout.Partial(ctx) {
+		w.Header().Set(partialResultsHeader, "true")
+	}
+}
+
+// CheckPartialResultsHeader marks the query as incomplete if the search of
+// the remote shard stopped at the partial deadline
+func CheckPartialResultsHeader(ctx context.Context, res *http.Response) {
+	if res.Header.Get(partialResultsHeader) == "true" {
+		querytimeout.MarkPartial(ctx)
+	}
+}
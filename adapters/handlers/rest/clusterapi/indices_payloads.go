@@ -20,12 +20,17 @@ import (
 	"math"
 	"net/http"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/changes"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/replay"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/scaler"
 )
@@ -40,6 +45,8 @@ type indicesPayloads struct {
 	VersionedObjectList       versionedObjectListPayload
 	SearchResults             searchResultsPayload
 	SearchParams              searchParamsPayload
+	SampleResults             sampleResultsPayload
+	SampleParams              sampleParamsPayload
 	ReferenceList             referenceListPayload
 	AggregationParams         aggregationParamsPayload
 	AggregationResult         aggregationResultPayload
@@ -53,6 +60,13 @@ type indicesPayloads struct {
 	UpdateShardsStatusResults updateShardsStatusResultsPayload
 	ShardFiles                shardFilesPayload
 	IncreaseReplicationFactor increaseReplicationFactorPayload
+	MoveReplicas              moveReplicasPayload
+	VectorIndexIntegrity      vectorIndexIntegrityPayload
+	ShardOptimizeResult       shardOptimizeResultPayload
+	ShardCleanupResult        shardInvertedCleanupResultPayload
+	SchemaMigration           schemaMigrationPayload
+	ShardChanges              shardChangesPayload
+	ReplayPage                replayPagePayload
 }
 
 type increaseReplicationFactorPayload struct{}
@@ -79,6 +93,18 @@ func (p increaseReplicationFactorPayload) Unmarshal(in []byte) (scaler.ShardDist
 	return pay.ShardDist, nil
 }
 
+// moveReplicasPayload has the format of increaseReplicationFactorPayload, the
+// target of each shard is the only node of its distribution
+type moveReplicasPayload struct{}
+
+func (p moveReplicasPayload) Marshall(dist scaler.ShardDist) ([]byte, error) {
+	return increaseReplicationFactorPayload{}.Marshall(dist)
+}
+
+func (p moveReplicasPayload) Unmarshal(in []byte) (scaler.ShardDist, error) {
+	return increaseReplicationFactorPayload{}.Unmarshal(in)
+}
+
 type errorListPayload struct{}
 
 func (e errorListPayload) MIME() string {
@@ -340,30 +366,43 @@ func (p mergeDocPayload) Unmarshal(in []byte) (objects.MergeDocument, error) {
 
 type searchParamsPayload struct{}
 
-func (p searchParamsPayload) Marshal(vector []float32, limit int,
+func (p searchParamsPayload) Marshal(vector []float32, targetVector string,
+	targets *searchparams.TargetVectors, distance float32, limit int,
 	filter *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking,
 	sort []filters.Sort, cursor *filters.Cursor, addP additional.Properties,
+	filterStrategy string, vectorCursor *filters.ShardVectorCursor,
 ) ([]byte, error) {
 	type params struct {
 		SearchVector   []float32                    `json:"searchVector"`
+		TargetVector   string                       `json:"targetVector"`
+		TargetVectors  *searchparams.TargetVectors  `json:"targetVectors,omitempty"`
+		Distance       float32                      `json:"distance"`
 		Limit          int                          `json:"limit"`
 		Filters        *filters.LocalFilter         `json:"filters"`
 		KeywordRanking *searchparams.KeywordRanking `json:"keywordRanking"`
 		Sort           []filters.Sort               `json:"sort"`
 		Cursor         *filters.Cursor              `json:"cursor"`
 		Additional     additional.Properties        `json:"additional"`
+		FilterStrategy string                       `json:"filterStrategy,omitempty"`
+		VectorCursor   *filters.ShardVectorCursor   `json:"vectorCursor,omitempty"`
 	}
 
-	par := params{vector, limit, filter, keywordRanking, sort, cursor, addP}
+	par := params{
+		vector, targetVector, targets, distance, limit, filter, keywordRanking, sort,
+		cursor, addP, filterStrategy, vectorCursor,
+	}
 	return json.Marshal(par)
 }
 
-func (p searchParamsPayload) Unmarshal(in []byte) ([]float32, float32, int,
+func (p searchParamsPayload) Unmarshal(in []byte) ([]float32, string,
+	*searchparams.TargetVectors, float32, int,
 	*filters.LocalFilter, *searchparams.KeywordRanking, []filters.Sort,
-	*filters.Cursor, additional.Properties, error,
+	*filters.Cursor, additional.Properties, string, *filters.ShardVectorCursor, error,
 ) {
 	type searchParametersPayload struct {
 		SearchVector   []float32                    `json:"searchVector"`
+		TargetVector   string                       `json:"targetVector"`
+		TargetVectors  *searchparams.TargetVectors  `json:"targetVectors,omitempty"`
 		Distance       float32                      `json:"distance"`
 		Limit          int                          `json:"limit"`
 		Filters        *filters.LocalFilter         `json:"filters"`
@@ -371,11 +410,16 @@ func (p searchParamsPayload) Unmarshal(in []byte) ([]float32, float32, int,
 		Sort           []filters.Sort               `json:"sort"`
 		Cursor         *filters.Cursor              `json:"cursor"`
 		Additional     additional.Properties        `json:"additional"`
+		FilterStrategy string                       `json:"filterStrategy,omitempty"`
+		VectorCursor   *filters.ShardVectorCursor   `json:"vectorCursor,omitempty"`
 	}
-	var par searchParametersPayload
+	// nodes which don't send a distance don't restrict searches with a limit
+	// by distance
+	par := searchParametersPayload{Distance: filters.DistanceFlagNotSet}
 	err := json.Unmarshal(in, &par)
-	return par.SearchVector, par.Distance, par.Limit,
-		par.Filters, par.KeywordRanking, par.Sort, par.Cursor, par.Additional, err
+	return par.SearchVector, par.TargetVector, par.TargetVectors, par.Distance, par.Limit,
+		par.Filters, par.KeywordRanking, par.Sort, par.Cursor, par.Additional,
+		par.FilterStrategy, par.VectorCursor, err
 }
 
 func (p searchParamsPayload) MIME() string {
@@ -548,6 +592,116 @@ func (p aggregationResultPayload) Unmarshal(in []byte) (*aggregation.Result, err
 	return &out, err
 }
 
+type sampleParamsPayload struct{}
+
+func (p sampleParamsPayload) Marshal(filter *filters.LocalFilter,
+	sample filters.Sample, addP additional.Properties,
+) ([]byte, error) {
+	type params struct {
+		Filters    *filters.LocalFilter  `json:"filters"`
+		Sample     filters.Sample        `json:"sample"`
+		Additional additional.Properties `json:"additional"`
+	}
+
+	par := params{filter, sample, addP}
+	return json.Marshal(par)
+}
+
+func (p sampleParamsPayload) Unmarshal(in []byte) (*filters.LocalFilter,
+	filters.Sample, additional.Properties, error,
+) {
+	type sampleParametersPayload struct {
+		Filters    *filters.LocalFilter  `json:"filters"`
+		Sample     filters.Sample        `json:"sample"`
+		Additional additional.Properties `json:"additional"`
+	}
+	var par sampleParametersPayload
+	err := json.Unmarshal(in, &par)
+	return par.Filters, par.Sample, par.Additional, err
+}
+
+func (p sampleParamsPayload) MIME() string {
+	return "vnd.weaviate.sampleparams+json"
+}
+
+func (p sampleParamsPayload) CheckContentTypeHeaderReq(r *http.Request) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+func (p sampleParamsPayload) SetContentTypeHeaderReq(r *http.Request) {
+	r.Header.Set("content-type", p.MIME())
+}
+
+type sampleResultsPayload struct{}
+
+func (p sampleResultsPayload) Unmarshal(in []byte) ([]*storobj.Object, []uint64, error) {
+	read := uint64(0)
+
+	objsLength := binary.LittleEndian.Uint64(in[read : read+8])
+	read += 8
+
+	objs, err := IndicesPayloads.ObjectList.Unmarshal(in[read : read+objsLength])
+	if err != nil {
+		return nil, nil, err
+	}
+	read += objsLength
+
+	prioritiesLength := binary.LittleEndian.Uint64(in[read : read+8])
+	read += 8
+
+	priorities := make([]uint64, prioritiesLength)
+	for i := range priorities {
+		priorities[i] = binary.LittleEndian.Uint64(in[read : read+8])
+		read += 8
+	}
+
+	if read != uint64(len(in)) {
+		return nil, nil, errors.Errorf("corrupt read: %d != %d", read, len(in))
+	}
+
+	return objs, priorities, nil
+}
+
+func (p sampleResultsPayload) Marshal(objs []*storobj.Object,
+	priorities []uint64,
+) ([]byte, error) {
+	reusableLengthBuf := make([]byte, 8)
+	var out []byte
+	objsBytes, err := IndicesPayloads.ObjectList.Marshal(objs)
+	if err != nil {
+		return nil, err
+	}
+
+	binary.LittleEndian.PutUint64(reusableLengthBuf, uint64(len(objsBytes)))
+	out = append(out, reusableLengthBuf...)
+	out = append(out, objsBytes...)
+
+	binary.LittleEndian.PutUint64(reusableLengthBuf, uint64(len(priorities)))
+	out = append(out, reusableLengthBuf...)
+
+	prioritiesBuf := make([]byte, len(priorities)*8)
+	for i, priority := range priorities {
+		binary.LittleEndian.PutUint64(prioritiesBuf[(i*8):((i+1)*8)], priority)
+	}
+	out = append(out, prioritiesBuf...)
+
+	return out, nil
+}
+
+func (p sampleResultsPayload) MIME() string {
+	return "application/vnd.weaviate.sampleresults+octet-stream"
+}
+
+func (p sampleResultsPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p sampleResultsPayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
 type findDocIDsParamsPayload struct{}
 
 func (p findDocIDsParamsPayload) Marshal(filter *filters.LocalFilter) ([]byte, error) {
@@ -768,3 +922,204 @@ func (p shardFilesPayload) CheckContentTypeHeaderReq(r *http.Request) (string, b
 	ct := r.Header.Get("content-type")
 	return ct, ct == p.MIME()
 }
+
+type shardOptimizeResultPayload struct{}
+
+func (p shardOptimizeResultPayload) Marshal(in *models.ShardOptimizeResult) ([]byte, error) {
+	return json.Marshal(in)
+}
+
+func (p shardOptimizeResultPayload) Unmarshal(in []byte) (*models.ShardOptimizeResult, error) {
+	var out models.ShardOptimizeResult
+	if err := json.Unmarshal(in, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (p shardOptimizeResultPayload) MIME() string {
+	return "application/vnd.weaviate.shardoptimizeresult+json"
+}
+
+func (p shardOptimizeResultPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p shardOptimizeResultPayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+type shardInvertedCleanupResultPayload struct{}
+
+func (p shardInvertedCleanupResultPayload) Marshal(in *models.ShardInvertedCleanupResult) ([]byte, error) {
+	return json.Marshal(in)
+}
+
+func (p shardInvertedCleanupResultPayload) Unmarshal(in []byte) (*models.ShardInvertedCleanupResult, error) {
+	var out models.ShardInvertedCleanupResult
+	if err := json.Unmarshal(in, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (p shardInvertedCleanupResultPayload) MIME() string {
+	return "application/vnd.weaviate.shardinvertedcleanupresult+json"
+}
+
+func (p shardInvertedCleanupResultPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p shardInvertedCleanupResultPayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+type schemaMigrationPayload struct{}
+
+func (p schemaMigrationPayload) Marshal(in *models.SchemaMigration) ([]byte, error) {
+	return json.Marshal(in)
+}
+
+func (p schemaMigrationPayload) Unmarshal(in []byte) (*models.SchemaMigration, error) {
+	var out models.SchemaMigration
+	if err := json.Unmarshal(in, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (p schemaMigrationPayload) MIME() string {
+	return "application/vnd.weaviate.schemamigration+json"
+}
+
+func (p schemaMigrationPayload) SetContentTypeHeaderReq(r *http.Request) {
+	r.Header.Set("content-type", p.MIME())
+}
+
+func (p schemaMigrationPayload) CheckContentTypeHeaderReq(r *http.Request) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+type vectorIndexIntegrityPayload struct{}
+
+func (p vectorIndexIntegrityPayload) Marshal(in hnsw.IntegrityReport) ([]byte, error) {
+	return json.Marshal(in)
+}
+
+func (p vectorIndexIntegrityPayload) Unmarshal(in []byte) (hnsw.IntegrityReport, error) {
+	var out hnsw.IntegrityReport
+	err := json.Unmarshal(in, &out)
+	return out, err
+}
+
+func (p vectorIndexIntegrityPayload) MIME() string {
+	return "application/vnd.weaviate.vectorindexintegrity+json"
+}
+
+func (p vectorIndexIntegrityPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p vectorIndexIntegrityPayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+type shardChangesPayload struct{}
+
+func (p shardChangesPayload) Marshal(in *changes.Changes) ([]byte, error) {
+	return json.Marshal(in)
+}
+
+func (p shardChangesPayload) Unmarshal(in []byte) (*changes.Changes, error) {
+	var out changes.Changes
+	err := json.Unmarshal(in, &out)
+	return &out, err
+}
+
+func (p shardChangesPayload) MIME() string {
+	return "application/vnd.weaviate.shardchanges+json"
+}
+
+func (p shardChangesPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p shardChangesPayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+type replayPagePayload struct{}
+
+type replayWritePayload struct {
+	Time int64  `json:"time"`
+	ID   string `json:"id"`
+	// Object is the binary representation of the version, nil for deletions
+	Object []byte `json:"object,omitempty"`
+}
+
+type replayPageJSON struct {
+	Writes []replayWritePayload `json:"writes"`
+	More   bool                 `json:"more"`
+}
+
+func (p replayPagePayload) Marshal(in *replay.Page) ([]byte, error) {
+	out := replayPageJSON{
+		Writes: make([]replayWritePayload, len(in.Writes)),
+		More:   in.More,
+	}
+	for i, w := range in.Writes {
+		out.Writes[i] = replayWritePayload{Time: w.Time, ID: w.ID.String()}
+		if w.Deleted() {
+			continue
+		}
+		bytes, err := w.Object.MarshalBinary()
+		if err != nil {
+			return nil, errors.Wrapf(err, "marshal object %s", w.ID)
+		}
+		out.Writes[i].Object = bytes
+	}
+	return json.Marshal(out)
+}
+
+func (p replayPagePayload) Unmarshal(in []byte) (*replay.Page, error) {
+	var page replayPageJSON
+	if err := json.Unmarshal(in, &page); err != nil {
+		return nil, err
+	}
+
+	out := &replay.Page{
+		Writes: make([]replay.Write, len(page.Writes)),
+		More:   page.More,
+	}
+	for i, w := range page.Writes {
+		out.Writes[i] = replay.Write{Time: w.Time, ID: strfmt.UUID(w.ID)}
+		if w.Object == nil {
+			continue
+		}
+		obj, err := storobj.FromBinary(w.Object)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unmarshal object %s", w.ID)
+		}
+		out.Writes[i].Object = obj
+	}
+	return out, nil
+}
+
+func (p replayPagePayload) MIME() string {
+	return "application/vnd.weaviate.replaypage+json"
+}
+
+func (p replayPagePayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p replayPagePayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
@@ -18,8 +18,12 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/replay"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
 
@@ -94,3 +98,79 @@ func Test_objectListPayload_Marshal(t *testing.T) {
 	assert.EqualValues(t, objs[2].Object, received[1].Object)
 	assert.EqualValues(t, objs[2].ID(), received[1].ID())
 }
+
+func Test_searchParamsPayload_Distance(t *testing.T) {
+	payload := searchParamsPayload{}
+
+	t.Run("the distance is sent to the remote shard", func(t *testing.T) {
+		b, err := payload.Marshal([]float32{1, 2, 3}, "", nil, 0.25, -1, nil, nil, nil,
+			nil, additional.Properties{}, "", nil)
+		require.Nil(t, err)
+
+		vector, _, _, distance, limit, _, _, _, _, _, _, _, err := payload.Unmarshal(b)
+		require.Nil(t, err)
+		assert.Equal(t, []float32{1, 2, 3}, vector)
+		assert.Equal(t, float32(0.25), distance)
+		assert.Equal(t, -1, limit)
+	})
+
+	t.Run("a payload without a distance is not restricted", func(t *testing.T) {
+		b := []byte(`{"searchVector":[1,2,3],"limit":10}`)
+
+		_, _, _, distance, limit, _, _, _, _, _, _, _, err := payload.Unmarshal(b)
+		require.Nil(t, err)
+		assert.Equal(t, filters.DistanceFlagNotSet, distance)
+		assert.Equal(t, 10, limit)
+	})
+
+	t.Run("the targets of a multi-target search are sent to the remote shard", func(t *testing.T) {
+		targets := &searchparams.TargetVectors{
+			Targets:     []string{"title", "body"},
+			Vectors:     [][]float32{{1, 2}, {3, 4}},
+			Combination: searchparams.TargetVectorCombinationAverage,
+		}
+		b, err := payload.Marshal(nil, "", targets, 0.25, 10, nil, nil, nil,
+			nil, additional.Properties{}, "", nil)
+		require.Nil(t, err)
+
+		_, _, received, _, _, _, _, _, _, _, _, _, err := payload.Unmarshal(b)
+		require.Nil(t, err)
+		assert.Equal(t, targets, received)
+	})
+}
+
+func Test_replayPagePayload(t *testing.T) {
+	obj := &storobj.Object{
+		MarshallerVersion: 1,
+		Object: models.Object{
+			ID:                 "c6f85bf5-c3b7-4c1d-bd51-e899f9605336",
+			Class:              "SomeClass",
+			LastUpdateTimeUnix: 2000,
+			Properties:         map[string]interface{}{"name": "foo"},
+		},
+		Vector:    []float32{1, 2, 3},
+		VectorLen: 3,
+	}
+	page := &replay.Page{
+		Writes: []replay.Write{
+			{Time: 1000, ID: "88750a99-a72d-46c2-a582-89f02654391d"},
+			{Time: 2000, ID: obj.ID(), Object: obj},
+		},
+		More: true,
+	}
+
+	payload := replayPagePayload{}
+	b, err := payload.Marshal(page)
+	require.Nil(t, err)
+
+	received, err := payload.Unmarshal(b)
+	require.Nil(t, err)
+	assert.True(t, received.More)
+	require.Len(t, received.Writes, 2)
+	assert.True(t, received.Writes[0].Deleted())
+	assert.Equal(t, page.Writes[0].Cursor(), received.Writes[0].Cursor())
+	require.False(t, received.Writes[1].Deleted())
+	assert.Equal(t, page.Writes[1].Cursor(), received.Writes[1].Cursor())
+	assert.Equal(t, obj.Object, received.Writes[1].Object.Object)
+	assert.Equal(t, obj.Vector, received.Writes[1].Object.Vector)
+}
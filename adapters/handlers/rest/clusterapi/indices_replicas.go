@@ -19,6 +19,7 @@ import (
 	"io"
 	"net/http"
 	"regexp"
+	"strconv"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -54,11 +55,17 @@ type replicator interface {
 		shardName string, ids []strfmt.UUID) ([]objects.Replica, error)
 	DigestObjects(ctx context.Context, class, shardName string,
 		ids []strfmt.UUID) (result []replica.RepairResponse, err error)
+	HashTree(ctx context.Context, class, shardName string,
+		height int) (*replica.HashTree, error)
+	DigestLeaf(ctx context.Context, class, shardName string,
+		height, leaf int) ([]replica.RepairResponse, error)
 }
 
 type localScaler interface {
 	LocalScaleOut(ctx context.Context, className string,
 		dist scaler.ShardDist) error
+	LocalMoveReplicas(ctx context.Context, className string,
+		dist scaler.ShardDist) error
 }
 
 type replicatedIndices struct {
@@ -73,12 +80,18 @@ var (
 		`\/shards\/([A-Za-z0-9]+)\/objects/_overwrite`)
 	regxObjectsDigest = regexp.MustCompile(`\/indices\/([A-Za-z0-9_+-]+)` +
 		`\/shards\/([A-Za-z0-9]+)\/objects/_digest`)
+	regxHashTreeLeaf = regexp.MustCompile(`\/indices\/([A-Za-z0-9_+-]+)` +
+		`\/shards\/([A-Za-z0-9]+)\/objects/_hashtree/([0-9]+)`)
+	regxHashTree = regexp.MustCompile(`\/indices\/([A-Za-z0-9_+-]+)` +
+		`\/shards\/([A-Za-z0-9]+)\/objects/_hashtree`)
 	regxObjects = regexp.MustCompile(`\/replicas\/indices\/([A-Za-z0-9_+-]+)` +
 		`\/shards\/([A-Za-z0-9]+)\/objects`)
 	regxReferences = regexp.MustCompile(`\/replicas\/indices\/([A-Za-z0-9_+-]+)` +
 		`\/shards\/([A-Za-z0-9]+)\/objects/references`)
 	regxIncreaseRepFactor = regexp.MustCompile(`\/replicas\/indices\/([A-Za-z0-9_+-]+)` +
 		`\/replication-factor:increase`)
+	regxMoveReplicas = regexp.MustCompile(`\/replicas\/indices\/([A-Za-z0-9_+-]+)` +
+		`\/replicas:move`)
 	regxCommitPhase = regexp.MustCompile(`\/replicas\/indices\/([A-Za-z0-9_+-]+)` +
 		`\/shards\/([A-Za-z0-9]+):(commit|abort)`)
 )
@@ -100,6 +113,22 @@ func (i *replicatedIndices) Indices() http.Handler {
 				return
 			}
 
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		case regxHashTreeLeaf.MatchString(path):
+			if r.Method == http.MethodGet {
+				i.getHashTreeLeaf().ServeHTTP(w, r)
+				return
+			}
+
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		case regxHashTree.MatchString(path):
+			if r.Method == http.MethodGet {
+				i.getHashTree().ServeHTTP(w, r)
+				return
+			}
+
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
 		case regxOverwriteObjects.MatchString(path):
@@ -163,6 +192,15 @@ func (i *replicatedIndices) Indices() http.Handler {
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
 
+		case regxMoveReplicas.MatchString(path):
+			if r.Method == http.MethodPut {
+				i.moveReplicas().ServeHTTP(w, r)
+				return
+			}
+
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+
 		case regxCommitPhase.MatchString(path):
 			if r.Method == http.MethodPost {
 				i.executeCommitPhase().ServeHTTP(w, r)
@@ -252,6 +290,37 @@ func (i *replicatedIndices) increaseReplicationFactor() http.Handler {
 	})
 }
 
+func (i *replicatedIndices) moveReplicas() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := regxMoveReplicas.FindStringSubmatch(r.URL.Path)
+		if len(args) != 2 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index := args[1]
+
+		bodyBytes, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		dist, err := IndicesPayloads.MoveReplicas.Unmarshal(bodyBytes)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if err := i.scaler.LocalMoveReplicas(r.Context(), index, dist); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
 func (i *replicatedIndices) postObject() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := regxObjects.FindStringSubmatch(r.URL.Path)
@@ -373,6 +442,73 @@ func (i *replicatedIndices) getObjectsDigest() http.Handler {
 	})
 }
 
+func (i *replicatedIndices) getHashTree() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := regxHashTree.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+		height, err := strconv.Atoi(r.URL.Query().Get("height"))
+		if err != nil {
+			http.Error(w, "invalid height: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		tree, err := i.shards.HashTree(r.Context(), index, shard, height)
+		if err != nil {
+			http.Error(w, "hash tree: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		resBytes, err := json.Marshal(tree)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Write(resBytes)
+	})
+}
+
+func (i *replicatedIndices) getHashTreeLeaf() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := regxHashTreeLeaf.FindStringSubmatch(r.URL.Path)
+		if len(args) != 4 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+		leaf, err := strconv.Atoi(args[3])
+		if err != nil {
+			http.Error(w, "invalid leaf: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		height, err := strconv.Atoi(r.URL.Query().Get("height"))
+		if err != nil {
+			http.Error(w, "invalid height: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		results, err := i.shards.DigestLeaf(r.Context(), index, shard, height, leaf)
+		if err != nil {
+			http.Error(w, "digest leaf: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		resBytes, err := json.Marshal(results)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Write(resBytes)
+	})
+}
+
 func (i *replicatedIndices) putOverwriteObjects() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := regxOverwriteObjects.FindStringSubmatch(r.URL.Path)
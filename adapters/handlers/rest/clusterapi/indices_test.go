@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clusterapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/changes"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/adminlist"
+)
+
+// fakeChangesShards only serves the changes feed
+type fakeChangesShards struct {
+	shards
+}
+
+func (f *fakeChangesShards) ChangesSince(ctx context.Context, indexName, shardName,
+	token string, limit int,
+) (*changes.Changes, error) {
+	return &changes.Changes{
+		Objects: []*models.Object{{
+			Class: "Person",
+			Properties: map[string]interface{}{
+				"name":  "Alice",
+				"email": "alice@example.com",
+			},
+		}},
+		Next: "1",
+	}, nil
+}
+
+func TestShardChangesRedacted(t *testing.T) {
+	authorizer := adminlist.New(adminlist.Config{Enabled: true, Users: []string{"admin"}})
+	indices := NewIndices(&fakeChangesShards{}, nil,
+		authorization.NewRedactor(authorizer, []string{"Person.email"}))
+
+	req := httptest.NewRequest(http.MethodGet, "/indices/Person/shards/S1/objects:changes", nil)
+	w := httptest.NewRecorder()
+	indices.Indices().ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	res, err := IndicesPayloads.ShardChanges.Unmarshal(w.Body.Bytes())
+	require.Nil(t, err)
+	require.Len(t, res.Objects, 1)
+	assert.Equal(t, map[string]interface{}{"name": "Alice"}, res.Objects[0].Properties)
+}
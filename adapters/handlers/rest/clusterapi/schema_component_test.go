@@ -80,7 +80,7 @@ func TestComponentCluster(t *testing.T) {
 		err := localManager.AddClass(ctx, nil, testClass())
 		require.Nil(t, err)
 
-		err = localManager.DeleteClass(ctx, nil, testClass().Class, false)
+		err = localManager.DeleteClass(ctx, nil, testClass().Class, false, false)
 		require.Nil(t, err)
 
 		localSchema, err := localManager.GetSchema(nil)
@@ -102,7 +102,7 @@ func TestComponentCluster(t *testing.T) {
 		updated := testClass()
 		updated.VectorIndexConfig.(map[string]interface{})["secondKey"] = "added"
 
-		err = localManager.UpdateClass(ctx, nil, testClass().Class, updated)
+		err = localManager.UpdateClass(ctx, nil, testClass().Class, updated, false)
 		require.Nil(t, err)
 
 		localClass, err := localManager.GetClass(ctx, nil, testClass().Class)
@@ -182,5 +182,23 @@ func (f *fakeScaleOutManager) Scale(ctx context.Context,
 	return nil, nil
 }
 
+func (f *fakeScaleOutManager) ReplaceNodes(ctx context.Context,
+	className string, replFactor int64, nodes []string,
+) (*sharding.State, error) {
+	return nil, nil
+}
+
+func (f *fakeScaleOutManager) MoveReplica(ctx context.Context,
+	className string, m sharding.Move,
+) error {
+	return nil
+}
+
+func (f *fakeScaleOutManager) CancelMove(ctx context.Context,
+	className string, m sharding.Move,
+) error {
+	return nil
+}
+
 func (f *fakeScaleOutManager) SetSchemaManager(sm scaler.SchemaManager) {
 }
@@ -17,6 +17,7 @@ import (
 	"net/http"
 
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 )
 
 func Serve(appState *state.State) {
@@ -27,11 +28,14 @@ func Serve(appState *state.State) {
 		Debugf("serving cluster api on port %d", port)
 
 	schema := NewSchema(appState.SchemaManager.TxManager())
-	indices := NewIndices(appState.RemoteIndexIncoming, appState.DB)
+	indices := NewIndices(appState.RemoteIndexIncoming, appState.DB,
+		authorization.NewRedactor(appState.Authorizer,
+			appState.ServerConfig.Config.Authorization.RestrictedProperties))
 	replicatedIndices := NewReplicatedIndices(appState.RemoteReplicaIncoming, appState.Scaler)
 	classifications := NewClassifications(appState.ClassificationRepo.TxManager())
 	nodes := NewNodes(appState.RemoteNodeIncoming)
 	backups := NewBackups(appState.BackupManager)
+	timestampIndexing := NewTimestampIndexing(appState.DB)
 
 	mux := http.NewServeMux()
 	mux.Handle("/schema/transactions/",
@@ -49,8 +53,11 @@ func Serve(appState *state.State) {
 	mux.Handle("/backups/abort", backups.Abort())
 	mux.Handle("/backups/status", backups.Status())
 
+	mux.Handle("/timestamp-indexing", timestampIndexing.Status())
+
 	mux.Handle("/", index())
-	http.ListenAndServe(fmt.Sprintf(":%d", port), mux)
+	http.ListenAndServe(fmt.Sprintf(":%d", port),
+		appState.NetworkAccess.Cluster.Middleware(appState.Drainer.TrackingMiddleware(mux)))
 }
 
 func index() http.Handler {
@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clusterapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	repodb "github.com/weaviate/weaviate/adapters/repos/db"
+	schemaent "github.com/weaviate/weaviate/entities/schema"
+)
+
+type timestampIndexer interface {
+	TimestampIndexingStatus(className schemaent.ClassName) ([]repodb.TimestampIndexingStatus, error)
+}
+
+type timestampIndexing struct {
+	indexer timestampIndexer
+}
+
+func NewTimestampIndexing(indexer timestampIndexer) *timestampIndexing {
+	return &timestampIndexing{indexer: indexer}
+}
+
+type timestampIndexingResponse struct {
+	Class  string                           `json:"class"`
+	Shards []repodb.TimestampIndexingStatus `json:"shards"`
+}
+
+// Status serves /timestamp-indexing. GET with the class as query parameter
+// reports per shard the progress of indexing the timestamps of the objects
+// which have been imported before timestamp indexing was enabled.
+func (t *timestampIndexing) Status() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/timestamp-indexing" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Method != http.MethodGet {
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		class := r.URL.Query().Get("class")
+		if class == "" {
+			http.Error(w, "class is required", http.StatusBadRequest)
+			return
+		}
+
+		shards, err := t.indexer.TimestampIndexingStatus(schemaent.ClassName(class))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		resBytes, err := json.Marshal(timestampIndexingResponse{Class: class, Shards: shards})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("content-type", "application/json")
+		w.Write(resBytes)
+	})
+}
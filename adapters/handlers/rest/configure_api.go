@@ -27,17 +27,24 @@ import (
 	openapierrors "github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/clients"
+	weaviategrpc "github.com/weaviate/weaviate/adapters/handlers/grpc"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/clusterapi"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/adapters/repos/blobstorage"
 	"github.com/weaviate/weaviate/adapters/repos/classifications"
 	"github.com/weaviate/weaviate/adapters/repos/db"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/segmentstorage"
+	"github.com/weaviate/weaviate/adapters/repos/db/walcompression"
 	modulestorage "github.com/weaviate/weaviate/adapters/repos/modules"
 	schemarepo "github.com/weaviate/weaviate/adapters/repos/schema"
+	"github.com/weaviate/weaviate/adapters/repos/usageexport"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/search"
@@ -64,15 +71,20 @@ import (
 	"github.com/weaviate/weaviate/usecases/classification"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/drain"
+	"github.com/weaviate/weaviate/usecases/metering"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/queryreplay"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/scaler"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/schema/migrate"
 	"github.com/weaviate/weaviate/usecases/sharding"
 	"github.com/weaviate/weaviate/usecases/traverser"
+	"github.com/weaviate/weaviate/usecases/traverser/hybrid"
+	"google.golang.org/grpc"
 )
 
 const MinimumRequiredContextionaryVersion = "1.0.2"
@@ -115,7 +127,12 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		// only monitoring tool supported at the moment is prometheus
 		go func() {
 			mux := http.NewServeMux()
-			mux.Handle("/metrics", promhttp.Handler())
+			// exemplars are only exposed in the OpenMetrics format
+			metricsHandler := promhttp.InstrumentMetricHandler(prometheus.DefaultRegisterer,
+				promhttp.HandlerFor(prometheus.DefaultGatherer, promhttp.HandlerOpts{
+					EnableOpenMetrics: true,
+				}))
+			mux.Handle("/metrics", appState.NetworkAccess.Metrics.Middleware(metricsHandler))
 			http.ListenAndServe(":2112", mux)
 		}()
 	}
@@ -126,6 +143,11 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 			WithField("action", "startup").WithError(err).
 			Fatal("modules didn't load")
 	}
+	if err := appState.Modules.RegisterTokenizers(); err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("modules didn't load")
+	}
 
 	// now that modules are loaded we can run the remaining config validation
 	// which is module dependent
@@ -165,20 +187,69 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	remoteIndexClient := clients.NewRemoteIndex(clusterHttpClient)
 	remoteNodesClient := clients.NewRemoteNode(clusterHttpClient)
 	replicationClient := clients.NewReplicationClient(clusterHttpClient)
+	var remoteSegmentStorage lsmkv.RemoteStorage
+	if cfg := appState.ServerConfig.Config.Persistence.RemoteSegments; cfg.Enabled() {
+		remoteSegmentStorage, err = segmentstorage.NewS3(cfg)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("could not create remote segment storage")
+		}
+	}
+	var offloadStorage lsmkv.RemoteStorage
+	if cfg := appState.ServerConfig.Config.Persistence.Offload; cfg.Enabled() {
+		offloadStorage, err = segmentstorage.NewOffloadS3(cfg)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("could not create offload storage")
+		}
+	}
+	compaction := appState.ServerConfig.Config.Persistence.Compaction
+	appState.CompactionScheduler, err = lsmkv.NewCompactionScheduler(lsmkv.CompactionSchedule{
+		MaxConcurrent:         compaction.MaxConcurrent,
+		MaxConcurrentPerShard: compaction.MaxConcurrentPerShard,
+		MaxBytesPerSecond:     int64(compaction.MaxMBPerSecond) * 1024 * 1024,
+		QuietHours:            compaction.QuietHours,
+	})
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("invalid compaction config")
+	}
+	walCompression, err := walcompression.ParseCodec(
+		appState.ServerConfig.Config.Persistence.WALCompression)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("invalid wal compression config")
+	}
 	repo, err := db.New(appState.Logger, db.Config{
-		ServerVersion:             config.ServerVersion,
-		GitHash:                   config.GitHash,
-		MemtablesFlushIdleAfter:   appState.ServerConfig.Config.Persistence.FlushIdleMemtablesAfter,
-		MemtablesInitialSizeMB:    10,
-		MemtablesMaxSizeMB:        appState.ServerConfig.Config.Persistence.MemtablesMaxSizeMB,
-		MemtablesMinActiveSeconds: appState.ServerConfig.Config.Persistence.MemtablesMinActiveDurationSeconds,
-		MemtablesMaxActiveSeconds: appState.ServerConfig.Config.Persistence.MemtablesMaxActiveDurationSeconds,
-		RootPath:                  appState.ServerConfig.Config.Persistence.DataPath,
-		QueryLimit:                appState.ServerConfig.Config.QueryDefaults.Limit,
-		QueryMaximumResults:       appState.ServerConfig.Config.QueryMaximumResults,
-		MaxImportGoroutinesFactor: appState.ServerConfig.Config.MaxImportGoroutinesFactor,
-		TrackVectorDimensions:     appState.ServerConfig.Config.TrackVectorDimensions,
-		ResourceUsage:             appState.ServerConfig.Config.ResourceUsage,
+		ServerVersion:                 config.ServerVersion,
+		GitHash:                       config.GitHash,
+		MemtablesFlushIdleAfter:       appState.ServerConfig.Config.Persistence.FlushIdleMemtablesAfter,
+		MemtablesInitialSizeMB:        10,
+		MemtablesMaxSizeMB:            appState.ServerConfig.Config.Persistence.MemtablesMaxSizeMB,
+		MemtablesMinActiveSeconds:     appState.ServerConfig.Config.Persistence.MemtablesMinActiveDurationSeconds,
+		MemtablesMaxActiveSeconds:     appState.ServerConfig.Config.Persistence.MemtablesMaxActiveDurationSeconds,
+		RootPath:                      appState.ServerConfig.Config.Persistence.DataPath,
+		QueryLimit:                    appState.ServerConfig.Config.QueryDefaults.Limit,
+		QueryMaximumResults:           appState.ServerConfig.Config.QueryMaximumResults,
+		QueryMaximumVectorCursorDepth: appState.ServerConfig.Config.QueryMaximumVectorCursorDepth,
+		MaxImportGoroutinesFactor:     appState.ServerConfig.Config.MaxImportGoroutinesFactor,
+		TrackVectorDimensions:         appState.ServerConfig.Config.TrackVectorDimensions,
+		ResourceUsage:                 appState.ServerConfig.Config.ResourceUsage,
+		QuarantineAfterWriteErrors:    appState.ServerConfig.Config.Persistence.QuarantineAfterWriteErrors,
+		LeaderWrites:                  appState.ServerConfig.Config.Replication.LeaderWrites(),
+		RemoteSegments:                appState.ServerConfig.Config.Persistence.RemoteSegments,
+		RemoteSegmentStorage:          remoteSegmentStorage,
+		Startup:                       appState.ServerConfig.Config.Startup,
+		WorkerPools:                   appState.ServerConfig.Config.WorkerPools,
+		AsyncIndexing:                 appState.ServerConfig.Config.AsyncIndexing,
+		CompactionScheduler:           appState.CompactionScheduler,
+		OffloadStorage:                offloadStorage,
+		FilterCache:                   appState.ServerConfig.Config.FilterCache,
+		WALCompression:                walCompression,
 	}, remoteIndexClient, appState.Cluster, remoteNodesClient, replicationClient, appState.Metrics) // TODO client
 	if err != nil {
 		appState.Logger.
@@ -190,7 +261,18 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	vectorMigrator = db.NewMigrator(repo, appState.Logger)
 	vectorRepo = repo
 	migrator = vectorMigrator
-	explorer = traverser.NewExplorer(repo, appState.Logger, appState.Modules, traverser.NewMetrics(appState.Metrics))
+	traverserExplorer := traverser.NewExplorer(repo, appState.Logger, appState.Modules, traverser.NewMetrics(appState.Metrics))
+	appState.HybridTuner, err = hybrid.NewTuner(traverserExplorer, appState.Authorizer,
+		appState.ServerConfig.Config.Persistence.DataPath,
+		appState.ServerConfig.Config.HybridTuning.MinFeedback,
+		appState.ServerConfig.Config.HybridTuning.AutoApply)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not load hybrid tuning feedback")
+	}
+	traverserExplorer.SetHybridTuner(appState.HybridTuner)
+	explorer = traverserExplorer
 	schemaRepo, err = schemarepo.NewRepo(
 		appState.ServerConfig.Config.Persistence.DataPath, appState.Logger)
 	if err != nil {
@@ -215,16 +297,17 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		appState.Cluster, localClassifierRepo, appState.Logger)
 	appState.ClassificationRepo = classifierRepo
 
-	scaler := scaler.New(appState.Cluster, vectorRepo,
+	scaleOut := scaler.New(appState.Cluster, vectorRepo,
 		remoteIndexClient, appState.Logger, appState.ServerConfig.Config.Persistence.DataPath)
-	appState.Scaler = scaler
+	scaleOut.SetTransferLimit(appState.ServerConfig.Config.Replication.TransferMaxBytesPerSecond)
+	appState.Scaler = scaleOut
 
 	// TODO: configure http transport for efficient intra-cluster comm
 	schemaTxClient := clients.NewClusterSchema(clusterHttpClient)
 	schemaManager, err := schemaUC.NewManager(migrator, schemaRepo,
 		appState.Logger, appState.Authorizer, appState.ServerConfig.Config,
 		enthnsw.ParseAndValidateConfig, appState.Modules, inverted.ValidateConfig,
-		appState.Modules, appState.Cluster, schemaTxClient, scaler,
+		appState.Modules, appState.Cluster, schemaTxClient, scaleOut,
 	)
 	if err != nil {
 		appState.Logger.
@@ -233,8 +316,43 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		os.Exit(1)
 	}
 
+	if err := schemaManager.SetNetworkAccess(appState.NetworkAccess); err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not apply network access rules")
+		os.Exit(1)
+	}
+
 	appState.SchemaManager = schemaManager
 
+	if grace := appState.ServerConfig.Config.Replication.DeadNodeGracePeriodSeconds; grace > 0 {
+		rereplicator := scaler.NewRereplicator(appState.Cluster, schemaManager,
+			time.Duration(grace)*time.Second, appState.Logger)
+		go rereplicator.Run(context.Background())
+	}
+	if interval := appState.ServerConfig.Config.Replication.RebalanceIntervalSeconds; interval > 0 {
+		rebalancer := scaler.NewRebalancer(appState.Cluster, schemaManager,
+			time.Duration(interval)*time.Second, appState.Logger)
+		go rebalancer.Run(context.Background())
+	}
+
+	// deleted classes are also purged if the recycle bin has been disabled
+	// since they were deleted
+	purgeTrashCtx, purgeTrashCancel := context.WithCancel(context.Background())
+	go schemaManager.PurgeTrashPeriodically(purgeTrashCtx, time.Minute)
+
+	consistencyCtx, consistencyCancel := context.WithCancel(context.Background())
+	if cfg := appState.ServerConfig.Config.Replication; cfg.ConsistencyCheckIntervalSeconds > 0 {
+		go repo.CheckConsistencyPeriodically(consistencyCtx,
+			time.Duration(cfg.ConsistencyCheckIntervalSeconds)*time.Second,
+			cfg.ConsistencyCheckSampleSize)
+	}
+	if cfg := appState.ServerConfig.Config.Replication; cfg.AntiEntropyIntervalSeconds > 0 {
+		go repo.RepairReplicasPeriodically(consistencyCtx,
+			time.Duration(cfg.AntiEntropyIntervalSeconds)*time.Second,
+			cfg.AntiEntropyTreeHeight)
+	}
+
 	appState.RemoteIndexIncoming = sharding.NewRemoteIndexIncoming(repo)
 	appState.RemoteNodeIncoming = sharding.NewRemoteNodeIncoming(repo)
 	appState.RemoteReplicaIncoming = replica.NewRemoteReplicaIncoming(repo)
@@ -250,11 +368,35 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		schemaManager, repo, appState.Modules)
 	appState.BackupManager = backupManager
 
+	appState.Revectorizer = objects.NewRevectorizer(repo, appState.Modules,
+		schemaManager, appState.Authorizer,
+		appState.ServerConfig.Config.Persistence.DataPath, appState.Logger)
+	appState.Shadows = objects.NewShadows(repo, appState.Modules, schemaManager,
+		appState.Authorizer, appState.ServerConfig.Config.Authorization.RestrictedProperties,
+		appState.ServerConfig.Config.Persistence.DataPath, appState.Logger)
+	if cfg := appState.ServerConfig.Config.SlowQueryLog; cfg.Enabled() {
+		appState.SlowQueryLog, err = queryreplay.NewSlowQueryLog(cfg.Path,
+			time.Duration(cfg.ThresholdMs)*time.Millisecond,
+			int64(cfg.MaxSizeMB)*1024*1024, appState.Logger)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("could not open slow query log")
+		}
+	}
+	appState.QueryReplayer = queryreplay.NewReplayer(appState.SlowQueryLog,
+		appState.Authorizer, appState.Logger)
+	appState.ReferenceSnapshotRefresher = objects.NewReferenceSnapshotRefresher(repo,
+		schemaManager, time.Duration(appState.ServerConfig.Config.ReferenceSnapshots.
+			RefreshIntervalSeconds)*time.Second, appState.Logger)
+	appState.ReferenceSnapshotRefresher.Start()
+
 	go clusterapi.Serve(appState)
 
 	vectorRepo.SetSchemaGetter(schemaManager)
 	explorer.SetSchemaGetter(schemaManager)
 	appState.Modules.SetSchemaGetter(schemaManager)
+	appState.Modules.SetVectorDimensionsValidator(repo, appState.Metrics)
 
 	err = vectorRepo.WaitForStartup(ctx)
 	if err != nil {
@@ -265,13 +407,25 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		os.Exit(1)
 	}
 
+	// the migrations of this node rewrite the local shards, which are loaded now
+	schemaManager.ResumeMigrations(context.Background())
+
+	var blobStore objects.BlobStore
+	if cfg := appState.ServerConfig.Config.BlobStorage; cfg.Enabled() {
+		blobStore, err = blobstorage.NewS3(cfg)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("could not create blob storage")
+		}
+	}
 	objectsManager := objects.NewManager(appState.Locks,
 		schemaManager, appState.ServerConfig, appState.Logger,
 		appState.Authorizer, vectorRepo, appState.Modules,
-		objects.NewMetrics(appState.Metrics))
+		objects.NewMetrics(appState.Metrics), blobStore, appState.Shadows)
 	batchObjectsManager := objects.NewBatchManager(vectorRepo, appState.Modules,
 		appState.Locks, schemaManager, appState.ServerConfig, appState.Logger,
-		appState.Authorizer, appState.Metrics)
+		appState.Authorizer, appState.Metrics, blobStore, appState.Shadows)
 
 	objectsTraverser := traverser.NewTraverser(appState.ServerConfig, appState.Locks,
 		appState.Logger, appState.Authorizer, vectorRepo, explorer, schemaManager,
@@ -284,10 +438,56 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	updateSchemaCallback := makeUpdateSchemaCall(appState.Logger, appState, objectsTraverser)
 	schemaManager.RegisterSchemaUpdateCallback(updateSchemaCallback)
 
+	// the usage of the last period is exported on shutdown, before the db
+	// is shut down
+	var meter *metering.Collector
+	meteringCtx, meteringCancel := context.WithCancel(context.Background())
+	meteringDone := make(chan struct{})
+	if cfg := appState.ServerConfig.Config.Metering; cfg.Enabled() {
+		sink, err := usageexport.New(cfg)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("could not create metering sink")
+		}
+		meter = metering.NewCollector(appState.Cluster.LocalName(), repo, sink,
+			appState.Logger)
+		go func() {
+			meter.Run(meteringCtx, time.Duration(cfg.IntervalSeconds)*time.Second)
+			close(meteringDone)
+		}()
+	} else {
+		close(meteringDone)
+	}
+
 	setupSchemaHandlers(api, schemaManager)
-	setupObjectHandlers(api, objectsManager, appState.ServerConfig.Config, appState.Logger, appState.Modules)
-	setupObjectBatchHandlers(api, batchObjectsManager)
-	setupGraphQLHandlers(api, appState, schemaManager)
+	setupObjectHandlers(api, objectsManager, appState.ServerConfig.Config, appState.Logger,
+		appState.Modules, meter)
+	batchLoad, _ := vectorRepo.(objects.BatchLoadReporter)
+	batchBackpressure := objects.NewBatchBackpressure(
+		appState.ServerConfig.Config.BatchBackpressure,
+		appState.ServerConfig.Config.ResourceUsage.MemUse, batchLoad)
+	setupObjectBatchHandlers(api, batchObjectsManager, objectsManager, batchBackpressure, meter)
+	var grpcServer *grpc.Server
+	if cfg := appState.ServerConfig.Config.GRPC; cfg.Enabled() {
+		grpcServer = weaviategrpc.NewServer(weaviategrpc.NewService(batchObjectsManager,
+			batchBackpressure, weaviategrpc.TokenFunc(NewTokenAuthComposer(
+				appState.ServerConfig.Config.Authentication, appState.APIKey, appState.OIDC)),
+			appState.ServerConfig.Config.Authentication.AnonymousAccess.Enabled,
+			appState.Drainer, meter, appState.Logger), cfg, appState.NetworkAccess.API)
+		if err := weaviategrpc.Serve(grpcServer, cfg.Port); err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("could not start grpc server")
+		}
+	}
+	setupGraphQLHandlers(api, appState, schemaManager, appState.Metrics, meter,
+		appState.ServerConfig.Config.GraphQLLimits, appState.SlowQueryLog)
+	setupQueryReplayHandlers(api, appState.QueryReplayer)
+	setupHybridTuningHandlers(api, appState.HybridTuner)
+	setupNetworkAccessHandlers(api, schemaManager)
+	setupShadowHandlers(api, appState.Shadows)
+	setupRevectorizeHandlers(api, appState.Revectorizer)
 	setupMiscHandlers(api, appState.ServerConfig, schemaManager, appState.Modules)
 	setupClassificationHandlers(api, classifier)
 	setupBackupHandlers(api, backupScheduler)
@@ -307,9 +507,44 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		}()
 	}
 
+	api.PreServerShutdown = func() {
+		// the node reports that it isn't ready anymore and rejects new
+		// requests, so the in-flight ones can complete before the HTTP servers
+		// shut down and the data is flushed
+		timeout := time.Duration(appState.ServerConfig.Config.Shutdown.DrainTimeoutSeconds) * time.Second
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		before := time.Now()
+		if err := appState.Drainer.Drain(ctx); err != nil {
+			appState.Logger.WithField("action", "shutdown").
+				WithField("in_flight", appState.Drainer.InFlight()).
+				Warnf("in-flight requests did not complete within %s", timeout)
+			return
+		}
+		appState.Logger.WithField("action", "shutdown").
+			WithField("took", time.Since(before)).
+			Info("drained in-flight requests")
+	}
+
 	api.ServerShutdown = func() {
+		if grpcServer != nil {
+			// the streams have been drained already
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			weaviategrpc.Shutdown(ctx, grpcServer)
+			cancel()
+		}
 		// stop reindexing on server shutdown
 		reindexCtxCancel()
+		// re-vectorization jobs are resumed from their cursor when restarted
+		appState.Revectorizer.Shutdown()
+		appState.Shadows.Shutdown()
+		appState.QueryReplayer.Shutdown()
+		appState.ReferenceSnapshotRefresher.Shutdown()
+		purgeTrashCancel()
+		consistencyCancel()
+		meteringCancel()
+		<-meteringDone
 
 		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 		defer cancel()
@@ -320,7 +555,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	}
 	configureServer = makeConfigureServer(appState)
 	setupMiddlewares := makeSetupMiddlewares(appState)
-	setupGlobalMiddleware := makeSetupGlobalMiddleware(appState)
+	setupGlobalMiddleware := makeSetupGlobalMiddleware(appState, objectsManager)
 
 	// while we accept an overall longer startup, e.g. due to a recovery, we
 	// still want to limit the module startup context, as that's mostly service
@@ -388,6 +623,8 @@ func startupRoutine(ctx context.Context) *state.State {
 	appState.APIKey = configureAPIKey(appState)
 	appState.AnonymousAccess = configureAnonymousAccess(appState)
 	appState.Authorizer = configureAuthorizer(appState)
+	appState.NetworkAccess = configureNetworkAccess(appState)
+	appState.Drainer = drain.New()
 
 	logger.WithField("action", "startup").WithField("startup_time_left", timeTillDeadline(ctx)).
 		Debug("configured OIDC and anonymous access client")
@@ -397,7 +634,8 @@ func startupRoutine(ctx context.Context) *state.State {
 	logger.WithField("action", "startup").WithField("startup_time_left", timeTillDeadline(ctx)).
 		Debug("initialized schema")
 
-	clusterState, err := cluster.Init(serverConfig.Config.Cluster, logger)
+	clusterState, err := cluster.Init(serverConfig.Config.Cluster,
+		serverConfig.Config.Persistence.DataPath, logger)
 	if err != nil {
 		logger.WithField("action", "startup").WithError(err).
 			Error("could not init cluster state")
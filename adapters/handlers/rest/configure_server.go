@@ -28,6 +28,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/modules"
+	"github.com/weaviate/weaviate/usecases/network/ipfilter"
 	"github.com/weaviate/weaviate/usecases/traverser"
 )
 
@@ -106,6 +107,17 @@ func configureAuthorizer(appState *state.State) authorization.Authorizer {
 	return authorization.New(appState.ServerConfig.Config)
 }
 
+func configureNetworkAccess(appState *state.State) *ipfilter.Listeners {
+	cfg := appState.ServerConfig.Config.NetworkAccess
+	l, err := ipfilter.NewListeners(cfg.API, cfg.Metrics, cfg.Cluster)
+	if err != nil {
+		appState.Logger.WithField("action", "network_access_init").WithError(err).Fatal("invalid network access rules")
+		os.Exit(1)
+	}
+
+	return l
+}
+
 func timeTillDeadline(ctx context.Context) string {
 	dl, _ := ctx.Deadline()
 	return time.Until(dl).String()
@@ -175,6 +175,56 @@ func Test_ExtractFlatFilters(t *testing.T) {
 					},
 				}},
 			},
+			{
+				name: "valid geo polygon filter",
+				input: &models.WhereFilter{
+					Operator:        "WithinGeoPolygon",
+					ValueGeoPolygon: inputGeoPolygonFilter([2]float32{0, 0}, [2]float32{0, 1}, [2]float32{1, 1}),
+					Path:            []string{"geoField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorWithinGeoPolygon,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("geoField"),
+					},
+					Value: &filters.Value{
+						Value: filters.GeoPolygon{
+							Points: []models.GeoCoordinates{
+								{Latitude: ptFloat32(0), Longitude: ptFloat32(0)},
+								{Latitude: ptFloat32(0), Longitude: ptFloat32(1)},
+								{Latitude: ptFloat32(1), Longitude: ptFloat32(1)},
+							},
+						},
+						Type: schema.DataTypeGeoCoordinates,
+					},
+				}},
+			},
+			{
+				name: "valid geo box filter",
+				input: &models.WhereFilter{
+					Operator: "WithinGeoBox",
+					ValueGeoBox: &models.WhereFilterGeoBox{
+						TopLeft:     &models.GeoCoordinates{Latitude: ptFloat32(1), Longitude: ptFloat32(0)},
+						BottomRight: &models.GeoCoordinates{Latitude: ptFloat32(0), Longitude: ptFloat32(1)},
+					},
+					Path: []string{"geoField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorWithinGeoBox,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("geoField"),
+					},
+					Value: &filters.Value{
+						Value: filters.GeoBox{
+							TopLeft:     models.GeoCoordinates{Latitude: ptFloat32(1), Longitude: ptFloat32(0)},
+							BottomRight: models.GeoCoordinates{Latitude: ptFloat32(0), Longitude: ptFloat32(1)},
+						},
+						Type: schema.DataTypeGeoCoordinates,
+					},
+				}},
+			},
 		}
 
 		for _, test := range tests {
@@ -235,6 +285,41 @@ func Test_ExtractFlatFilters(t *testing.T) {
 				expectedErr: fmt.Errorf("invalid where filter: valueGeoRange: " +
 					"field 'distance.max' must be a positive number"),
 			},
+			{
+				name: "geo polygon with too few points",
+				input: &models.WhereFilter{
+					Operator:        "WithinGeoPolygon",
+					ValueGeoPolygon: inputGeoPolygonFilter([2]float32{0, 0}, [2]float32{0, 1}),
+					Path:            []string{"geoField"},
+				},
+				expectedErr: fmt.Errorf("invalid where filter: valueGeoPolygon: " +
+					"field 'points' must contain at least 3 points"),
+			},
+			{
+				name: "geo box with missing corner",
+				input: &models.WhereFilter{
+					Operator: "WithinGeoBox",
+					ValueGeoBox: &models.WhereFilterGeoBox{
+						TopLeft: &models.GeoCoordinates{Latitude: ptFloat32(1), Longitude: ptFloat32(0)},
+					},
+					Path: []string{"geoField"},
+				},
+				expectedErr: fmt.Errorf("invalid where filter: valueGeoBox: " +
+					"field 'bottomRight' must have a latitude and a longitude"),
+			},
+			{
+				name: "geo box with top below bottom",
+				input: &models.WhereFilter{
+					Operator: "WithinGeoBox",
+					ValueGeoBox: &models.WhereFilterGeoBox{
+						TopLeft:     &models.GeoCoordinates{Latitude: ptFloat32(0), Longitude: ptFloat32(0)},
+						BottomRight: &models.GeoCoordinates{Latitude: ptFloat32(1), Longitude: ptFloat32(1)},
+					},
+					Path: []string{"geoField"},
+				},
+				expectedErr: fmt.Errorf("invalid where filter: valueGeoBox: " +
+					"the latitude of 'topLeft' must not be below the one of 'bottomRight'"),
+			},
 			{
 				name: "and operator and path set",
 				input: &models.WhereFilter{
@@ -515,6 +600,17 @@ func inputGeoRangeFilter(lat, lon, max float64) *models.WhereFilterGeoRange {
 	}
 }
 
+func inputGeoPolygonFilter(points ...[2]float32) *models.WhereFilterGeoPolygon {
+	out := &models.WhereFilterGeoPolygon{}
+	for _, point := range points {
+		out.Points = append(out.Points, &models.GeoCoordinates{
+			Latitude:  ptFloat32(point[0]),
+			Longitude: ptFloat32(point[1]),
+		})
+	}
+	return out
+}
+
 func ptFloat32(in float32) *float32 {
 	return &in
 }
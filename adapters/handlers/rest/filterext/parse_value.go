@@ -124,6 +124,55 @@ var valueExtractors = []valueExtractorFunc{
 			},
 		}, schema.DataTypeGeoCoordinates), nil
 	},
+	// geo polygon
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueGeoPolygon == nil {
+			return nil, nil
+		}
+
+		if len(in.ValueGeoPolygon.Points) < 3 {
+			return nil, fmt.Errorf("valueGeoPolygon: field 'points' must contain at least 3 points")
+		}
+
+		polygon := filters.GeoPolygon{
+			Points: make([]models.GeoCoordinates, len(in.ValueGeoPolygon.Points)),
+		}
+		for i, point := range in.ValueGeoPolygon.Points {
+			if !validGeoCoordinates(point) {
+				return nil, fmt.Errorf("valueGeoPolygon: point %d must have a latitude and a longitude", i)
+			}
+			polygon.Points[i] = *point
+		}
+
+		return valueFilter(polygon, schema.DataTypeGeoCoordinates), nil
+	},
+	// geo box
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueGeoBox == nil {
+			return nil, nil
+		}
+
+		if !validGeoCoordinates(in.ValueGeoBox.TopLeft) {
+			return nil, fmt.Errorf("valueGeoBox: field 'topLeft' must have a latitude and a longitude")
+		}
+
+		if !validGeoCoordinates(in.ValueGeoBox.BottomRight) {
+			return nil, fmt.Errorf("valueGeoBox: field 'bottomRight' must have a latitude and a longitude")
+		}
+
+		if *in.ValueGeoBox.TopLeft.Latitude < *in.ValueGeoBox.BottomRight.Latitude {
+			return nil, fmt.Errorf("valueGeoBox: the latitude of 'topLeft' must not be below the one of 'bottomRight'")
+		}
+
+		return valueFilter(filters.GeoBox{
+			TopLeft:     *in.ValueGeoBox.TopLeft,
+			BottomRight: *in.ValueGeoBox.BottomRight,
+		}, schema.DataTypeGeoCoordinates), nil
+	},
+}
+
+func validGeoCoordinates(in *models.GeoCoordinates) bool {
+	return in != nil && in.Latitude != nil && in.Longitude != nil
 }
 
 func valueFilter(value interface{}, dt schema.DataType) *filters.Value {
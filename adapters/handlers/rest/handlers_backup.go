@@ -29,10 +29,11 @@ func (s *backupHandlers) createBackup(params backups.BackupsCreateParams,
 	principal *models.Principal,
 ) middleware.Responder {
 	req := ubak.BackupRequest{
-		ID:      params.Body.ID,
-		Backend: params.Backend,
-		Include: params.Body.Include,
-		Exclude: params.Body.Exclude,
+		ID:           params.Body.ID,
+		Backend:      params.Backend,
+		Include:      params.Body.Include,
+		Exclude:      params.Body.Exclude,
+		BaseBackupID: backupIncrementalBase(params.Body.Config),
 	}
 	meta, err := s.manager.Backup(params.HTTPRequest.Context(), principal, &req)
 	if err != nil {
@@ -92,6 +93,8 @@ func (s *backupHandlers) restoreBackup(params backups.BackupsRestoreParams,
 		Backend: params.Backend,
 		Include: params.Body.Include,
 		Exclude: params.Body.Exclude,
+
+		SkipVectorIndex: restoreSkipVectorIndex(params.Body.Config),
 	}
 	meta, err := s.manager.Restore(params.HTTPRequest.Context(), principal, &req)
 	if err != nil {
@@ -146,6 +149,28 @@ func (s *backupHandlers) restoreBackupStatus(params backups.BackupsRestoreStatus
 	return backups.NewBackupsRestoreStatusOK().WithPayload(&payload)
 }
 
+// restoreSkipVectorIndex reads the "skipVectorIndex" option of the restore
+// config
+func restoreSkipVectorIndex(config interface{}) bool {
+	asMap, ok := config.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	skip, _ := asMap["skipVectorIndex"].(bool)
+	return skip
+}
+
+// backupIncrementalBase reads the "incrementalBaseBackupId" option of the
+// backup config
+func backupIncrementalBase(config interface{}) string {
+	asMap, ok := config.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	id, _ := asMap["incrementalBaseBackupId"].(string)
+	return id
+}
+
 func setupBackupHandlers(api *operations.WeaviateAPI,
 	scheduler *ubak.Scheduler,
 ) {
@@ -12,17 +12,25 @@
 package rest
 
 import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/runtime"
 	middleware "github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/batch"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/metering"
 	"github.com/weaviate/weaviate/usecases/objects"
 )
 
 type batchObjectHandlers struct {
-	manager *objects.BatchManager
+	manager        *objects.BatchManager
+	objectsManager *objects.Manager
+	backpressure   *objects.BatchBackpressure
+	meter          *metering.Collector
 }
 
 func (h *batchObjectHandlers) addObjects(params batch.BatchObjectsCreateParams,
@@ -34,8 +42,17 @@ func (h *batchObjectHandlers) addObjects(params batch.BatchObjectsCreateParams,
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
+	release, err := h.backpressure.Acquire(params.HTTPRequest.Context(),
+		len(params.Body.Objects))
+	if err != nil {
+		return batch.NewBatchObjectsCreateInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	upsert := params.Body.Upsert != nil && *params.Body.Upsert
 	objs, err := h.manager.AddObjects(params.HTTPRequest.Context(),
-		principal, params.Body.Objects, params.Body.Fields, repl)
+		principal, params.Body.Objects, params.Body.Fields, upsert, repl)
+	release()
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
@@ -50,8 +67,69 @@ func (h *batchObjectHandlers) addObjects(params batch.BatchObjectsCreateParams,
 		}
 	}
 
-	return batch.NewBatchObjectsCreateOK().
-		WithPayload(h.objectsResponse(objs))
+	h.recordObjects(principal, metering.OperationBatchCreate, objs)
+
+	return h.withHint(batch.NewBatchObjectsCreateOK().
+		WithPayload(h.objectsResponse(objs)))
+}
+
+func (h *batchObjectHandlers) mergeObjects(params batch.BatchObjectsMergeParams,
+	principal *models.Principal,
+) middleware.Responder {
+	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	if err != nil {
+		return batch.NewBatchObjectsMergeBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	release, err := h.backpressure.Acquire(params.HTTPRequest.Context(),
+		len(params.Body.Objects))
+	if err != nil {
+		return batch.NewBatchObjectsMergeInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	vectorize := params.Body.Vectorize == nil || *params.Body.Vectorize
+	objs, err := h.objectsManager.MergeObjects(params.HTTPRequest.Context(),
+		principal, params.Body.Objects, vectorize, repl)
+	release()
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return batch.NewBatchObjectsMergeForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case objects.ErrInvalidUserInput:
+			return batch.NewBatchObjectsMergeUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return batch.NewBatchObjectsMergeInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.recordObjects(principal, metering.OperationUpdate, objs)
+
+	return h.withHint(batch.NewBatchObjectsMergeOK().
+		WithPayload(h.objectsResponse(objs)))
+}
+
+// recordObjects meters the successfully imported objects of a batch
+func (h *batchObjectHandlers) recordObjects(principal *models.Principal,
+	operation string, objs objects.BatchObjects,
+) {
+	if h.meter == nil {
+		return
+	}
+
+	byClass := map[string]int{}
+	for _, obj := range objs {
+		if obj.Err == nil && obj.Object != nil {
+			byClass[obj.Object.Class]++
+		}
+	}
+	for class, count := range byClass {
+		h.meter.Record(principal, class, operation, count)
+	}
 }
 
 func (h *batchObjectHandlers) objectsResponse(input objects.BatchObjects) []*models.ObjectsGetResponse {
@@ -83,7 +161,15 @@ func (h *batchObjectHandlers) addReferences(params batch.BatchReferencesCreatePa
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
+	release, err := h.backpressure.Acquire(params.HTTPRequest.Context(),
+		len(params.Body))
+	if err != nil {
+		return batch.NewBatchReferencesCreateInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
 	references, err := h.manager.AddReferences(params.HTTPRequest.Context(), principal, params.Body, repl)
+	release()
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
@@ -98,8 +184,22 @@ func (h *batchObjectHandlers) addReferences(params batch.BatchReferencesCreatePa
 		}
 	}
 
-	return batch.NewBatchReferencesCreateOK().
-		WithPayload(h.referencesResponse(references))
+	return h.withHint(batch.NewBatchReferencesCreateOK().
+		WithPayload(h.referencesResponse(references)))
+}
+
+// withHint adds headers to the response which tell clients how to size and
+// pace their next batch
+func (h *batchObjectHandlers) withHint(resp middleware.Responder) middleware.Responder {
+	hint := h.backpressure.Hint()
+	return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+		w.Header().Set("X-Weaviate-Batch-Queue-Depth", strconv.Itoa(hint.QueueDepth))
+		w.Header().Set("X-Weaviate-Batch-Suggested-Size",
+			strconv.Itoa(hint.SuggestedBatchSize))
+		w.Header().Set("X-Weaviate-Batch-Suggested-Delay-Ms",
+			strconv.FormatInt(hint.SuggestedDelay.Milliseconds(), 10))
+		resp.WriteResponse(w, p)
+	})
 }
 
 func (h *batchObjectHandlers) referencesResponse(input objects.BatchReferences) []*models.BatchReferenceResponse {
@@ -154,6 +254,16 @@ func (h *batchObjectHandlers) deleteObjects(params batch.BatchObjectsDeleteParam
 		}
 	}
 
+	if !res.DryRun {
+		deleted := 0
+		for _, obj := range res.Result.Objects {
+			if obj.Err == nil {
+				deleted++
+			}
+		}
+		h.meter.Record(principal, res.Match.Class, metering.OperationBatchDelete, deleted)
+	}
+
 	return batch.NewBatchObjectsDeleteOK().
 		WithPayload(h.objectsDeleteResponse(res))
 }
@@ -208,8 +318,11 @@ func (h *batchObjectHandlers) objectsDeleteResponse(input *objects.BatchDeleteRe
 	return response
 }
 
-func setupObjectBatchHandlers(api *operations.WeaviateAPI, manager *objects.BatchManager) {
-	h := &batchObjectHandlers{manager}
+func setupObjectBatchHandlers(api *operations.WeaviateAPI, manager *objects.BatchManager,
+	objectsManager *objects.Manager, backpressure *objects.BatchBackpressure,
+	meter *metering.Collector,
+) {
+	h := &batchObjectHandlers{manager, objectsManager, backpressure, meter}
 
 	api.BatchBatchObjectsCreateHandler = batch.
 		BatchObjectsCreateHandlerFunc(h.addObjects)
@@ -217,4 +330,6 @@ func setupObjectBatchHandlers(api *operations.WeaviateAPI, manager *objects.Batc
 		BatchReferencesCreateHandlerFunc(h.addReferences)
 	api.BatchBatchObjectsDeleteHandler = batch.
 		BatchObjectsDeleteHandlerFunc(h.deleteObjects)
+	api.BatchBatchObjectsMergeHandler = batch.
+		BatchObjectsMergeHandlerFunc(h.mergeObjects)
 }
@@ -15,12 +15,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strconv"
 	"sync"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/entities/querycost"
+	"github.com/weaviate/weaviate/entities/querytimeout"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/metering"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/queryreplay"
 	"github.com/weaviate/weaviate/usecases/schema"
 
+	"github.com/go-openapi/runtime"
 	middleware "github.com/go-openapi/runtime/middleware"
 	libgraphql "github.com/weaviate/weaviate/adapters/handlers/graphql"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
@@ -39,7 +49,14 @@ type graphQLProvider interface {
 	GetGraphQL() libgraphql.GraphQL
 }
 
-func setupGraphQLHandlers(api *operations.WeaviateAPI, gqlProvider graphQLProvider, m *schema.Manager) {
+// queryCostHeader carries the estimated cost of a GraphQL request as JSON,
+// see package querycost
+const queryCostHeader = "X-Weaviate-Query-Cost"
+
+func setupGraphQLHandlers(api *operations.WeaviateAPI, gqlProvider graphQLProvider, m *schema.Manager,
+	metrics *monitoring.PrometheusMetrics, meter *metering.Collector, limits config.GraphQLLimits,
+	slowQueries *queryreplay.SlowQueryLog,
+) {
 	api.GraphqlGraphqlPostHandler = graphql.GraphqlPostHandlerFunc(func(params graphql.GraphqlPostParams, principal *models.Principal) middleware.Responder {
 		// All requests to the graphQL API need at least permissions to read the schema. Request might have further
 		// authorization requirements.
@@ -77,6 +94,13 @@ func setupGraphQLHandlers(api *operations.WeaviateAPI, gqlProvider graphQLProvid
 			variables = params.Body.Variables.(map[string]interface{})
 		}
 
+		// Reject abusive queries before they are parsed again and executed
+		if err := libgraphql.CheckLimits(query, operationName, variables,
+			limits.Limit(graphQLUsername(principal))); err != nil {
+			return graphql.NewGraphqlPostUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+
 		graphQL := gqlProvider.GetGraphQL()
 		if graphQL == nil {
 			errorResponse.Error = []*models.ErrorResponseErrorItems0{
@@ -89,9 +113,14 @@ func setupGraphQLHandlers(api *operations.WeaviateAPI, gqlProvider graphQLProvid
 
 		ctx := params.HTTPRequest.Context()
 		ctx = context.WithValue(ctx, "principal", principal)
+		tracker := querycost.NewTracker()
+		ctx = querycost.NewContext(ctx, tracker)
+		ctx = querytimeout.WithPartialResults(ctx)
 
+		before := time.Now()
 		result := graphQL.Resolve(ctx, query,
 			operationName, variables)
+		slowQueries.Record(query, operationName, variables, time.Since(before))
 
 		// Marshal the JSON
 		resultJSON, jsonErr := json.Marshal(result)
@@ -118,8 +147,12 @@ func setupGraphQLHandlers(api *operations.WeaviateAPI, gqlProvider graphQLProvid
 			return graphql.NewGraphqlPostUnprocessableEntity().WithPayload(errorResponse)
 		}
 
+		markPartialResults(ctx, graphQLResponse)
+		recordGraphQLUsage(meter, principal, graphQLResponse)
+
 		// Return the response
-		return graphql.NewGraphqlPostOK().WithPayload(graphQLResponse)
+		return withQueryCost(graphql.NewGraphqlPostOK().WithPayload(graphQLResponse),
+			tracker, principal, metrics)
 	})
 
 	api.GraphqlGraphqlBatchHandler = graphql.GraphqlBatchHandlerFunc(func(params graphql.GraphqlBatchParams, principal *models.Principal) middleware.Responder {
@@ -135,6 +168,8 @@ func setupGraphQLHandlers(api *operations.WeaviateAPI, gqlProvider graphQLProvid
 
 		ctx := params.HTTPRequest.Context()
 		ctx = context.WithValue(ctx, "principal", principal)
+		tracker := querycost.NewTracker()
+		ctx = querycost.NewContext(ctx, tracker)
 
 		graphQL := gqlProvider.GetGraphQL()
 		if graphQL == nil {
@@ -146,7 +181,8 @@ func setupGraphQLHandlers(api *operations.WeaviateAPI, gqlProvider graphQLProvid
 		// Generate a goroutine for each separate request
 		for requestIndex, unbatchedRequest := range params.Body {
 			wg.Add(1)
-			go handleUnbatchedGraphQLRequest(ctx, wg, graphQL, unbatchedRequest, requestIndex, &requestResults)
+			go handleUnbatchedGraphQLRequest(ctx, wg, graphQL, unbatchedRequest, requestIndex, &requestResults,
+				limits.Limit(graphQLUsername(principal)), slowQueries)
 		}
 
 		wg.Wait()
@@ -158,14 +194,92 @@ func setupGraphQLHandlers(api *operations.WeaviateAPI, gqlProvider graphQLProvid
 		// Add the requests to the result array in the correct order
 		for unbatchedRequestResult := range requestResults {
 			batchedRequestResponse[unbatchedRequestResult.RequestIndex] = unbatchedRequestResult.Response
+			recordGraphQLUsage(meter, principal, unbatchedRequestResult.Response)
+		}
+
+		return withQueryCost(graphql.NewGraphqlBatchOK().WithPayload(batchedRequestResponse),
+			tracker, principal, metrics)
+	})
+}
+
+// graphQLUsername selects the limits of the principal, anonymous requests
+// use the limits of all users
+func graphQLUsername(principal *models.Principal) string {
+	if principal == nil {
+		return ""
+	}
+	return principal.Username
+}
+
+// recordGraphQLUsage meters the objects returned by Get and one aggregation
+// per class of an Aggregate query
+func recordGraphQLUsage(meter *metering.Collector, principal *models.Principal,
+	res *models.GraphQLResponse,
+) {
+	if meter == nil || res == nil {
+		return
+	}
+
+	if get, ok := res.Data["Get"].(map[string]interface{}); ok {
+		for class, objects := range get {
+			if list, ok := objects.([]interface{}); ok {
+				meter.Record(principal, class, metering.OperationQuery, len(list))
+			}
+		}
+	}
+	if aggregate, ok := res.Data["Aggregate"].(map[string]interface{}); ok {
+		for class := range aggregate {
+			meter.Record(principal, class, metering.OperationAggregate, 1)
 		}
+	}
+}
+
+// markPartialResults adds the partialResults extension to the response if a
+// query stopped at its maximum duration, as its results may be incomplete,
+// see config.QueryTimeout
+func markPartialResults(ctx context.Context, res *models.GraphQLResponse) {
+	if !querytimeout.Partial(ctx) {
+		return
+	}
+	if res.Extensions == nil {
+		res.Extensions = map[string]models.JSONObject{}
+	}
+	res.Extensions["partialResults"] = true
+}
+
+// withQueryCost adds the cost accumulated by the tracker to the response
+// headers and to the usage metrics of the principal
+func withQueryCost(resp middleware.Responder, tracker *querycost.Tracker,
+	principal *models.Principal, metrics *monitoring.PrometheusMetrics,
+) middleware.Responder {
+	cost := tracker.Cost()
+	if metrics != nil {
+		user := metering.User(principal)
+		for resource, value := range map[string]int64{
+			"vectors_compared": cost.VectorsCompared,
+			"postings_scanned": cost.PostingsScanned,
+			"objects_loaded":   cost.ObjectsLoaded,
+			"bytes_read":       cost.BytesRead,
+		} {
+			metrics.QueryCost.With(prometheus.Labels{
+				"user":     user,
+				"resource": resource,
+			}).Add(float64(value))
+		}
+	}
 
-		return graphql.NewGraphqlBatchOK().WithPayload(batchedRequestResponse)
+	costJSON, err := json.Marshal(cost)
+	if err != nil {
+		return resp
+	}
+	return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+		w.Header().Set(queryCostHeader, string(costJSON))
+		resp.WriteResponse(w, p)
 	})
 }
 
 // Handle a single unbatched GraphQL request, return a tuple containing the index of the request in the batch and either the response or an error
-func handleUnbatchedGraphQLRequest(ctx context.Context, wg *sync.WaitGroup, graphQL libgraphql.GraphQL, unbatchedRequest *models.GraphQLQuery, requestIndex int, requestResults *chan gqlUnbatchedRequestResponse) {
+func handleUnbatchedGraphQLRequest(ctx context.Context, wg *sync.WaitGroup, graphQL libgraphql.GraphQL, unbatchedRequest *models.GraphQLQuery, requestIndex int, requestResults *chan gqlUnbatchedRequestResponse, limits config.GraphQLLimit, slowQueries *queryreplay.SlowQueryLog) {
 	defer wg.Done()
 
 	// Get all input from the body of the request
@@ -191,7 +305,23 @@ func handleUnbatchedGraphQLRequest(ctx context.Context, wg *sync.WaitGroup, grap
 			variables = unbatchedRequest.Variables.(map[string]interface{})
 		}
 
+		// Reject abusive queries before they are executed
+		if err := libgraphql.CheckLimits(query, operationName, variables, limits); err != nil {
+			errorCode := strconv.Itoa(graphql.GraphqlBatchUnprocessableEntityCode)
+			errorMessage := fmt.Sprintf("%s: %s", errorCode, err)
+			errors := []*models.GraphQLError{{Message: errorMessage}}
+			*requestResults <- gqlUnbatchedRequestResponse{
+				requestIndex,
+				&models.GraphQLResponse{Data: nil, Errors: errors},
+			}
+			return
+		}
+
+		// every request of the batch is marked separately
+		ctx := querytimeout.WithPartialResults(ctx)
+		before := time.Now()
 		result := graphQL.Resolve(ctx, query, operationName, variables)
+		slowQueries.Record(query, operationName, variables, time.Since(before))
 
 		// Marshal the JSON
 		resultJSON, jsonErr := json.Marshal(result)
@@ -223,6 +353,7 @@ func handleUnbatchedGraphQLRequest(ctx context.Context, wg *sync.WaitGroup, grap
 					&graphQLResponse,
 				}
 			} else {
+				markPartialResults(ctx, graphQLResponse)
 				// Return the GraphQL response
 				*requestResults <- gqlUnbatchedRequestResponse{
 					requestIndex,
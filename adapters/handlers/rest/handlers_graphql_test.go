@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/querytimeout"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/metering"
+)
+
+type fakeStorageUsage struct{}
+
+func (f *fakeStorageUsage) StorageUsage(ctx context.Context) ([]metering.StorageUsage, error) {
+	return nil, nil
+}
+
+type fakeUsageSink struct {
+	records []metering.Record
+}
+
+func (f *fakeUsageSink) Export(ctx context.Context, record metering.Record) error {
+	f.records = append(f.records, record)
+	return nil
+}
+
+func TestRecordGraphQLUsage(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	sink := &fakeUsageSink{}
+	meter := metering.NewCollector("node1", &fakeStorageUsage{}, sink, logger)
+	principal := &models.Principal{Username: "alice"}
+
+	recordGraphQLUsage(meter, principal, &models.GraphQLResponse{
+		Data: map[string]models.JSONObject{
+			"Get": map[string]interface{}{
+				"Article": []interface{}{map[string]interface{}{}, map[string]interface{}{}},
+				"Author":  []interface{}{},
+			},
+			"Aggregate": map[string]interface{}{
+				"Article": []interface{}{map[string]interface{}{}},
+			},
+		},
+	})
+	recordGraphQLUsage(meter, principal, &models.GraphQLResponse{
+		Errors: []*models.GraphQLError{{Message: "invalid query"}},
+	})
+	recordGraphQLUsage(nil, principal, &models.GraphQLResponse{})
+
+	require.Nil(t, meter.Export(context.Background()))
+	require.Len(t, sink.records, 1)
+	assert.Equal(t, []metering.OperationUsage{
+		{Class: "Article", User: "alice", Operation: metering.OperationAggregate, Count: 1},
+		{Class: "Article", User: "alice", Operation: metering.OperationQuery, Count: 2},
+	}, sink.records[0].Operations)
+}
+
+// fakeStoppingGraphQL runs a search loop which stops at the partial deadline
+// for the queries named "stopped"
+type fakeStoppingGraphQL struct{}
+
+func (f *fakeStoppingGraphQL) Resolve(ctx context.Context, query string,
+	operationName string, variables map[string]interface{},
+) *graphql.Result {
+	deadline := time.Now().Add(time.Minute)
+	if query == "stopped" {
+		deadline = time.Now()
+	}
+	querytimeout.Stop(querytimeout.WithPartialDeadline(ctx, deadline))
+	return &graphql.Result{Data: map[string]interface{}{"Get": map[string]interface{}{}}}
+}
+
+func TestPartialResultsExtension(t *testing.T) {
+	queries := []string{"stopped", "completed"}
+	results := make(chan gqlUnbatchedRequestResponse, len(queries))
+	wg := &sync.WaitGroup{}
+	for i, query := range queries {
+		wg.Add(1)
+		handleUnbatchedGraphQLRequest(context.Background(), wg, &fakeStoppingGraphQL{},
+			&models.GraphQLQuery{Query: query}, i, &results, config.GraphQLLimit{}, nil)
+	}
+	wg.Wait()
+	close(results)
+
+	responses := make([]*models.GraphQLResponse, len(queries))
+	for res := range results {
+		responses[res.RequestIndex] = res.Response
+	}
+	assert.Equal(t, map[string]models.JSONObject{"partialResults": true},
+		responses[0].Extensions)
+	assert.Nil(t, responses[1].Extensions)
+}
@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/traverser/hybrid"
+)
+
+type hybridTuningHandlers struct {
+	tuner *hybrid.Tuner
+}
+
+func (h *hybridTuningHandlers) feedback(params schema.SchemaObjectsHybridTuningFeedbackParams,
+	principal *models.Principal,
+) middleware.Responder {
+	clicked := make([]strfmt.UUID, len(params.Body.Clicked))
+	for i, id := range params.Body.Clicked {
+		clicked[i] = strfmt.UUID(id)
+	}
+
+	err := h.tuner.Feedback(principal, params.ClassName, params.Body.Query, clicked)
+	if err != nil {
+		if err == hybrid.ErrUnknownQuery {
+			return schema.NewSchemaObjectsHybridTuningFeedbackNotFound()
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsHybridTuningFeedbackForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsHybridTuningFeedbackUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsHybridTuningFeedbackNoContent()
+}
+
+func (h *hybridTuningHandlers) suggest(params schema.SchemaObjectsHybridTuningGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	sugg, err := h.tuner.Suggest(params.HTTPRequest.Context(), principal, params.ClassName)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsHybridTuningGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsHybridTuningGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	res := &models.HybridTuningSuggestion{
+		Class:       sugg.Class,
+		Samples:     int64(sugg.Samples),
+		Ready:       sugg.Ready,
+		Alpha:       sugg.Alpha,
+		BaselineMRR: sugg.BaselineMRR,
+		Mrr:         sugg.MRR,
+	}
+	if sugg.BM25 != nil {
+		res.Bm25 = &models.HybridTuningKeywordSuggestion{
+			K1:  sugg.BM25.K1,
+			B:   sugg.BM25.B,
+			Mrr: sugg.BM25.MRR,
+		}
+	}
+	return schema.NewSchemaObjectsHybridTuningGetOK().WithPayload(res)
+}
+
+func setupHybridTuningHandlers(api *operations.WeaviateAPI, tuner *hybrid.Tuner) {
+	h := &hybridTuningHandlers{tuner}
+	api.SchemaSchemaObjectsHybridTuningFeedbackHandler = schema.
+		SchemaObjectsHybridTuningFeedbackHandlerFunc(h.feedback)
+	api.SchemaSchemaObjectsHybridTuningGetHandler = schema.
+		SchemaObjectsHybridTuningGetHandlerFunc(h.suggest)
+}
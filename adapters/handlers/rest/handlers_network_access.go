@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"net"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/nodes"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/network/ipfilter"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+type networkAccessHandlers struct {
+	manager *schemaUC.Manager
+}
+
+func (h *networkAccessHandlers) getRules(params nodes.NodesNetworkAccessGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	rules, err := h.manager.GetNetworkAccess(params.HTTPRequest.Context(), principal,
+		params.Listener)
+	if err != nil {
+		if err == schemaUC.ErrNotFound {
+			return nodes.NewNodesNetworkAccessGetNotFound()
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return nodes.NewNodesNetworkAccessGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesNetworkAccessGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return nodes.NewNodesNetworkAccessGetOK().WithPayload(networkAccessRules(rules))
+}
+
+func (h *networkAccessHandlers) updateRules(params nodes.NodesNetworkAccessUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	rules := ipfilter.Rules{
+		Allow: params.Body.Allow,
+		Deny:  params.Body.Deny,
+	}
+	// only the direct peer is considered, the same way the filter does
+	host, _, err := net.SplitHostPort(params.HTTPRequest.RemoteAddr)
+	if err != nil {
+		host = params.HTTPRequest.RemoteAddr
+	}
+
+	updated, err := h.manager.UpdateNetworkAccess(params.HTTPRequest.Context(),
+		principal, params.Listener, rules, net.ParseIP(host))
+	if err != nil {
+		if err == schemaUC.ErrNotFound {
+			return nodes.NewNodesNetworkAccessUpdateNotFound()
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return nodes.NewNodesNetworkAccessUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesNetworkAccessUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return nodes.NewNodesNetworkAccessUpdateOK().WithPayload(networkAccessRules(updated))
+}
+
+func networkAccessRules(rules ipfilter.Rules) *models.NetworkAccessRules {
+	return &models.NetworkAccessRules{
+		Allow: rules.Allow,
+		Deny:  rules.Deny,
+	}
+}
+
+func setupNetworkAccessHandlers(api *operations.WeaviateAPI,
+	manager *schemaUC.Manager,
+) {
+	h := &networkAccessHandlers{manager}
+	api.NodesNodesNetworkAccessGetHandler = nodes.
+		NodesNetworkAccessGetHandlerFunc(h.getRules)
+	api.NodesNodesNetworkAccessUpdateHandler = nodes.
+		NodesNetworkAccessUpdateHandlerFunc(h.updateRules)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/nodes"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
 	"github.com/weaviate/weaviate/adapters/repos/db"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
@@ -54,13 +55,311 @@ func (s *nodesHandlers) getNodesStatus(params nodes.NodesGetParams, principal *m
 	return nodes.NewNodesGetOK().WithPayload(status)
 }
 
+func (s *nodesHandlers) getMembers(params nodes.NodesMembersGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	view, err := s.manager.Members(principal)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return nodes.NewNodesMembersGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesMembersGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	res := &models.ClusterMembership{
+		Epoch:   int64(view.Epoch),
+		Local:   view.Local,
+		Members: make([]*models.ClusterMember, len(view.Members)),
+	}
+	for i, member := range view.Members {
+		res.Members[i] = &models.ClusterMember{
+			Name:       member.Name,
+			ID:         member.ID,
+			Generation: int64(member.Generation),
+			Address:    member.Address,
+			Status:     member.Status,
+			Labels:     member.Labels,
+		}
+	}
+	return nodes.NewNodesMembersGetOK().WithPayload(res)
+}
+
+func (s *nodesHandlers) joinCluster(params nodes.NodesMembersJoinParams,
+	principal *models.Principal,
+) middleware.Responder {
+	joined, err := s.manager.Join(principal, params.Body.Addresses)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return nodes.NewNodesMembersJoinForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrUnprocessable:
+			return nodes.NewNodesMembersJoinUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesMembersJoinConflict().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return nodes.NewNodesMembersJoinOK().
+		WithPayload(&models.ClusterJoinResponse{Joined: int64(joined)})
+}
+
+func (s *nodesHandlers) leaveCluster(params nodes.NodesMembersLeaveParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := s.manager.Leave(principal); err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return nodes.NewNodesMembersLeaveForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesMembersLeaveInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return nodes.NewNodesMembersLeaveNoContent()
+}
+
+func (s *nodesHandlers) deleteMember(params nodes.NodesMembersDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := s.manager.Forget(principal, params.Name); err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return nodes.NewNodesMembersDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesMembersDeleteConflict().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return nodes.NewNodesMembersDeleteNoContent()
+}
+
+func (s *nodesHandlers) getCompactionSchedule(params nodes.NodesCompactionGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	schedule, err := s.manager.CompactionSchedule(principal)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return nodes.NewNodesCompactionGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesCompactionGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return nodes.NewNodesCompactionGetOK().WithPayload(compactionSchedule(schedule))
+}
+
+func (s *nodesHandlers) updateCompactionSchedule(params nodes.NodesCompactionUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	schedule, err := s.manager.UpdateCompactionSchedule(principal, lsmkv.CompactionSchedule{
+		MaxConcurrent:         int(params.Body.MaxConcurrent),
+		MaxConcurrentPerShard: int(params.Body.MaxConcurrentPerShard),
+		MaxBytesPerSecond:     params.Body.MaxBytesPerSecond,
+		QuietHours:            params.Body.QuietHours,
+	})
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return nodes.NewNodesCompactionUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrUnprocessable:
+			return nodes.NewNodesCompactionUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesCompactionUpdateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return nodes.NewNodesCompactionUpdateOK().WithPayload(compactionSchedule(schedule))
+}
+
+func compactionSchedule(schedule lsmkv.CompactionSchedule) *models.CompactionSchedule {
+	return &models.CompactionSchedule{
+		MaxConcurrent:         int64(schedule.MaxConcurrent),
+		MaxConcurrentPerShard: int64(schedule.MaxConcurrentPerShard),
+		MaxBytesPerSecond:     schedule.MaxBytesPerSecond,
+		QuietHours:            schedule.QuietHours,
+	}
+}
+
+func (s *nodesHandlers) startVectorReindex(params nodes.NodesVectorReindexStartParams,
+	principal *models.Principal,
+) middleware.Responder {
+	statuses, err := s.manager.ReindexVectorIndex(params.HTTPRequest.Context(),
+		principal, params.ClassName)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return nodes.NewNodesVectorReindexStartForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrUnprocessable:
+			return nodes.NewNodesVectorReindexStartUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesVectorReindexStartInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return nodes.NewNodesVectorReindexStartOK().
+		WithPayload(vectorReindexStatus(params.ClassName, statuses))
+}
+
+func (s *nodesHandlers) getVectorReindex(params nodes.NodesVectorReindexGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	statuses, err := s.manager.VectorReindexStatus(params.HTTPRequest.Context(),
+		principal, params.ClassName)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return nodes.NewNodesVectorReindexGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrNotFound:
+			return nodes.NewNodesVectorReindexGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesVectorReindexGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return nodes.NewNodesVectorReindexGetOK().
+		WithPayload(vectorReindexStatus(params.ClassName, statuses))
+}
+
+func vectorReindexStatus(className string,
+	statuses []db.VectorReindexStatus,
+) *models.VectorReindexStatus {
+	res := &models.VectorReindexStatus{
+		Class:  className,
+		Shards: make([]*models.VectorReindexShardStatus, len(statuses)),
+	}
+	for i, status := range statuses {
+		res.Shards[i] = &models.VectorReindexShardStatus{
+			Shard:         status.Shard,
+			Status:        status.Status,
+			Processed:     status.Processed,
+			Total:         status.Total,
+			Error:         status.Error,
+			StartedAtUnix: status.StartedAt.UnixMilli(),
+		}
+		if status.FinishedAt != nil {
+			res.Shards[i].FinishedAtUnix = status.FinishedAt.UnixMilli()
+		}
+	}
+	return res
+}
+
+func (s *nodesHandlers) cloneShard(params nodes.NodesShardClonesCreateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.CloneShard(params.HTTPRequest.Context(), principal,
+		params.Body.SourceClass, params.Body.SourceShard,
+		params.Body.TargetClass, params.Body.TargetShard)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return nodes.NewNodesShardClonesCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrUnprocessable:
+			return nodes.NewNodesShardClonesCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesShardClonesCreateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return nodes.NewNodesShardClonesCreateNoContent()
+}
+
+func (s *nodesHandlers) getOffloads(params nodes.NodesOffloadsGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	classes, err := s.manager.OffloadedClasses(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return nodes.NewNodesOffloadsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesOffloadsGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return nodes.NewNodesOffloadsGetOK().
+		WithPayload(&models.OffloadedClasses{Classes: classes})
+}
+
+func (s *nodesHandlers) updateOffload(params nodes.NodesOffloadsUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	classes, err := s.manager.UpdateOffload(params.HTTPRequest.Context(), principal,
+		params.Body.Class, params.Body.Status)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return nodes.NewNodesOffloadsUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrUnprocessable:
+			return nodes.NewNodesOffloadsUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesOffloadsUpdateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return nodes.NewNodesOffloadsUpdateOK().
+		WithPayload(&models.OffloadedClasses{Classes: classes})
+}
+
 func setupNodesHandlers(api *operations.WeaviateAPI,
 	schemaManger *schemaUC.Manager, repo *db.DB, appState *state.State,
 ) {
 	nodesManager := nodesUC.NewManager(appState.Logger, appState.Authorizer,
-		repo, schemaManger)
+		repo, schemaManger, appState.Cluster, appState.CompactionScheduler)
 
 	h := &nodesHandlers{nodesManager}
 	api.NodesNodesGetHandler = nodes.
 		NodesGetHandlerFunc(h.getNodesStatus)
+	api.NodesNodesMembersGetHandler = nodes.
+		NodesMembersGetHandlerFunc(h.getMembers)
+	api.NodesNodesMembersJoinHandler = nodes.
+		NodesMembersJoinHandlerFunc(h.joinCluster)
+	api.NodesNodesMembersLeaveHandler = nodes.
+		NodesMembersLeaveHandlerFunc(h.leaveCluster)
+	api.NodesNodesMembersDeleteHandler = nodes.
+		NodesMembersDeleteHandlerFunc(h.deleteMember)
+	api.NodesNodesCompactionGetHandler = nodes.
+		NodesCompactionGetHandlerFunc(h.getCompactionSchedule)
+	api.NodesNodesCompactionUpdateHandler = nodes.
+		NodesCompactionUpdateHandlerFunc(h.updateCompactionSchedule)
+	api.NodesNodesVectorReindexStartHandler = nodes.
+		NodesVectorReindexStartHandlerFunc(h.startVectorReindex)
+	api.NodesNodesVectorReindexGetHandler = nodes.
+		NodesVectorReindexGetHandlerFunc(h.getVectorReindex)
+	api.NodesNodesShardClonesCreateHandler = nodes.
+		NodesShardClonesCreateHandlerFunc(h.cloneShard)
+	api.NodesNodesOffloadsGetHandler = nodes.
+		NodesOffloadsGetHandlerFunc(h.getOffloads)
+	api.NodesNodesOffloadsUpdateHandler = nodes.
+		NodesOffloadsUpdateHandlerFunc(h.updateOffload)
 }
@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/anonymous"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+)
+
+// objectBlobsPath is /v1/objects/{className}/{id}/blobs/{propertyName}
+var objectBlobsPath = regexp.MustCompile(`^/v1/objects/([^/]+)/([^/]+)/blobs/([^/]+)$`)
+
+type objectBlobsManager interface {
+	GetObjectBlob(ctx context.Context, principal *models.Principal,
+		className string, id strfmt.UUID, propName string,
+		repl *additional.ReplicationProperties) (*uco.Blob, error)
+}
+
+// makeAddObjectBlobs streams the values of blob properties, regardless of
+// whether they are stored inline or offloaded to the blob storage. The values
+// can be large, so they aren't part of the generated API, which would read
+// them into memory. Range requests are supported.
+func makeAddObjectBlobs(manager objectBlobsManager, tokenAuth openAPITokenFunc,
+	anonymousAccess *anonymous.Client, logger logrus.FieldLogger,
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		h := &objectBlobsHandler{manager: manager, tokenAuth: tokenAuth, logger: logger}
+		handler := anonymousAccess.Middleware(h)
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if (r.Method == http.MethodGet || r.Method == http.MethodHead) &&
+				objectBlobsPath.MatchString(r.URL.Path) {
+				handler.ServeHTTP(w, r)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+type objectBlobsHandler struct {
+	manager   objectBlobsManager
+	tokenAuth openAPITokenFunc
+	logger    logrus.FieldLogger
+}
+
+func (h *objectBlobsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	match := objectBlobsPath.FindStringSubmatch(r.URL.Path)
+	className, id, propName := match[1], match[2], match[3]
+	if !strfmt.IsUUID(id) {
+		h.writeError(w, http.StatusBadRequest, fmt.Errorf("invalid uuid %q", id))
+		return
+	}
+
+	principal, err := h.principal(r)
+	if err != nil {
+		h.writeError(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	query := r.URL.Query()
+	var consistencyLevel, nodeName *string
+	if v := query.Get("consistency_level"); v != "" {
+		consistencyLevel = &v
+	}
+	if v := query.Get("node_name"); v != "" {
+		nodeName = &v
+	}
+	repl, err := getReplicationProperties(consistencyLevel, nodeName)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	blob, err := h.manager.GetObjectBlob(r.Context(), principal, className,
+		strfmt.UUID(id), propName, repl)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			h.writeError(w, http.StatusForbidden, err)
+		case uco.ErrNotFound:
+			h.writeError(w, http.StatusNotFound, err)
+		case uco.ErrInvalidUserInput:
+			h.writeError(w, http.StatusUnprocessableEntity, err)
+		default:
+			h.writeError(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	defer blob.Close()
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	http.ServeContent(w, r, propName, blob.LastModified, blob)
+}
+
+// principal validates the bearer token of the request like the generated API.
+// Requests without token are anonymous, the anonymous access middleware
+// rejects them if anonymous access is disabled.
+func (h *objectBlobsHandler) principal(r *http.Request) (*models.Principal, error) {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == r.Header.Get("Authorization") {
+		token = r.URL.Query().Get("access_token")
+	}
+	if token == "" {
+		return nil, nil
+	}
+	return h.tokenAuth(token, nil)
+}
+
+func (h *objectBlobsHandler) writeError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(errPayloadFromSingleErr(err)); err != nil {
+		h.logger.WithField("action", "object_blobs").WithError(err).
+			Error("write error response")
+	}
+}
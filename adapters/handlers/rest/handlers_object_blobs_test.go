@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/anonymous"
+	"github.com/weaviate/weaviate/usecases/config"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+)
+
+type fakeObjectBlobsManager struct {
+	data      []byte
+	principal *models.Principal
+}
+
+func (f *fakeObjectBlobsManager) GetObjectBlob(ctx context.Context,
+	principal *models.Principal, className string, id strfmt.UUID, propName string,
+	repl *additional.ReplicationProperties,
+) (*uco.Blob, error) {
+	f.principal = principal
+	if propName != "image" {
+		return nil, uco.NewErrNotFound("no value")
+	}
+	return &uco.Blob{
+		ReadSeekCloser: nopReadSeekCloser{bytes.NewReader(f.data)},
+		LastModified:   time.Now(),
+	}, nil
+}
+
+type nopReadSeekCloser struct {
+	io.ReadSeeker
+}
+
+func (nopReadSeekCloser) Close() error { return nil }
+
+func TestObjectBlobs(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	tokenAuth := func(token string, scopes []string) (*models.Principal, error) {
+		return &models.Principal{Username: token}, nil
+	}
+	path := "/v1/objects/Image/5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc/blobs/"
+
+	handler := func(manager objectBlobsManager, anonymousEnabled bool) http.Handler {
+		cfg := config.Config{}
+		cfg.Authentication.AnonymousAccess.Enabled = anonymousEnabled
+		return makeAddObjectBlobs(manager, tokenAuth, anonymous.New(cfg), logger)(next)
+	}
+
+	t.Run("full blob", func(t *testing.T) {
+		manager := &fakeObjectBlobsManager{data: []byte("0123456789")}
+		w := httptest.NewRecorder()
+		handler(manager, true).ServeHTTP(w, httptest.NewRequest(http.MethodGet, path+"image", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "0123456789", w.Body.String())
+		assert.Equal(t, "application/octet-stream", w.Header().Get("Content-Type"))
+		assert.Nil(t, manager.principal)
+	})
+
+	t.Run("range", func(t *testing.T) {
+		manager := &fakeObjectBlobsManager{data: []byte("0123456789")}
+		r := httptest.NewRequest(http.MethodGet, path+"image", nil)
+		r.Header.Set("Range", "bytes=2-4")
+		w := httptest.NewRecorder()
+		handler(manager, true).ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusPartialContent, w.Code)
+		assert.Equal(t, "234", w.Body.String())
+		assert.Equal(t, "bytes 2-4/10", w.Header().Get("Content-Range"))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler(&fakeObjectBlobsManager{}, true).
+			ServeHTTP(w, httptest.NewRequest(http.MethodGet, path+"other", nil))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler(&fakeObjectBlobsManager{}, true).ServeHTTP(w,
+			httptest.NewRequest(http.MethodGet, "/v1/objects/Image/foo/blobs/image", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("authenticated", func(t *testing.T) {
+		manager := &fakeObjectBlobsManager{data: []byte("0123456789")}
+		r := httptest.NewRequest(http.MethodGet, path+"image", nil)
+		r.Header.Set("Authorization", "Bearer alice")
+		w := httptest.NewRecorder()
+		handler(manager, false).ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, &models.Principal{Username: "alice"}, manager.principal)
+	})
+
+	t.Run("anonymous access disabled", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler(&fakeObjectBlobsManager{}, false).
+			ServeHTTP(w, httptest.NewRequest(http.MethodGet, path+"image", nil))
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("other routes", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler(&fakeObjectBlobsManager{}, true).
+			ServeHTTP(w, httptest.NewRequest(http.MethodPut, path+"image", nil))
+		assert.Equal(t, http.StatusTeapot, w.Code)
+	})
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/metering"
 	uco "github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
 )
@@ -35,6 +36,7 @@ type objectHandlers struct {
 	logger          logrus.FieldLogger
 	config          config.Config
 	modulesProvider ModulesProvider
+	meter           *metering.Collector
 }
 
 type ModulesProvider interface {
@@ -49,6 +51,8 @@ type objectsManager interface {
 	ValidateObject(context.Context, *models.Principal, *models.Object, *additional.ReplicationProperties) error
 	GetObject(_ context.Context, _ *models.Principal, class string, _ strfmt.UUID,
 		_ additional.Properties, _ *additional.ReplicationProperties) (*models.Object, error)
+	GetObjectAtVersion(_ context.Context, _ *models.Principal, class string, _ strfmt.UUID,
+		version int64, _ additional.Properties) (*models.Object, error)
 	DeleteObject(_ context.Context, _ *models.Principal,
 		class string, _ strfmt.UUID, _ *additional.ReplicationProperties) error
 	UpdateObject(_ context.Context, _ *models.Principal, class string, _ strfmt.UUID,
@@ -90,6 +94,8 @@ func (h *objectHandlers) addObject(params objects.ObjectsCreateParams,
 		}
 	}
 
+	h.meter.Record(principal, object.Class, metering.OperationCreate, 1)
+
 	propertiesMap, ok := object.Properties.(map[string]interface{})
 	if ok {
 		object.Properties = h.extendPropertiesWithAPILinks(propertiesMap)
@@ -151,8 +157,14 @@ func (h *objectHandlers) getObject(params objects.ObjectsClassGetParams,
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
-	object, err := h.manager.GetObject(params.HTTPRequest.Context(), principal,
-		params.ClassName, params.ID, additional, replProps)
+	var object *models.Object
+	if params.Version != nil {
+		object, err = h.manager.GetObjectAtVersion(params.HTTPRequest.Context(), principal,
+			params.ClassName, params.ID, *params.Version, additional)
+	} else {
+		object, err = h.manager.GetObject(params.HTTPRequest.Context(), principal,
+			params.ClassName, params.ID, additional, replProps)
+	}
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
@@ -166,6 +178,8 @@ func (h *objectHandlers) getObject(params objects.ObjectsClassGetParams,
 		}
 	}
 
+	h.meter.Record(principal, object.Class, metering.OperationGet, 1)
+
 	propertiesMap, ok := object.Properties.(map[string]interface{})
 	if ok {
 		object.Properties = h.extendPropertiesWithAPILinks(propertiesMap)
@@ -201,6 +215,8 @@ func (h *objectHandlers) getObjects(params objects.ObjectsListParams,
 		}
 	}
 
+	h.recordObjects(principal, metering.OperationGet, list)
+
 	for i, object := range list {
 		propertiesMap, ok := object.Properties.(map[string]interface{})
 		if ok {
@@ -250,6 +266,8 @@ func (h *objectHandlers) query(params objects.ObjectsListParams,
 		}
 	}
 
+	h.recordObjects(principal, metering.OperationGet, resultSet)
+
 	for i, object := range resultSet {
 		propertiesMap, ok := object.Properties.(map[string]interface{})
 		if ok {
@@ -290,6 +308,8 @@ func (h *objectHandlers) deleteObject(params objects.ObjectsClassDeleteParams,
 		}
 	}
 
+	h.meter.Record(principal, params.ClassName, metering.OperationDelete, 1)
+
 	return objects.NewObjectsClassDeleteNoContent()
 }
 
@@ -321,6 +341,8 @@ func (h *objectHandlers) updateObject(params objects.ObjectsClassPutParams,
 		}
 	}
 
+	h.meter.Record(principal, params.ClassName, metering.OperationUpdate, 1)
+
 	propertiesMap, ok := object.Properties.(map[string]interface{})
 	if ok {
 		object.Properties = h.extendPropertiesWithAPILinks(propertiesMap)
@@ -385,6 +407,8 @@ func (h *objectHandlers) patchObject(params objects.ObjectsClassPatchParams, pri
 		}
 	}
 
+	h.meter.Record(principal, params.ClassName, metering.OperationUpdate, 1)
+
 	return objects.NewObjectsClassPatchNoContent()
 }
 
@@ -498,11 +522,28 @@ func (h *objectHandlers) deleteObjectReference(params objects.ObjectsClassRefere
 	return objects.NewObjectsClassReferencesDeleteNoContent()
 }
 
+// recordObjects meters an operation on a list of objects of any classes
+func (h *objectHandlers) recordObjects(principal *models.Principal,
+	operation string, list []*models.Object,
+) {
+	if h.meter == nil {
+		return
+	}
+
+	byClass := map[string]int{}
+	for _, object := range list {
+		byClass[object.Class]++
+	}
+	for class, count := range byClass {
+		h.meter.Record(principal, class, operation, count)
+	}
+}
+
 func setupObjectHandlers(api *operations.WeaviateAPI,
 	manager *uco.Manager, config config.Config, logger logrus.FieldLogger,
-	modulesProvider ModulesProvider,
+	modulesProvider ModulesProvider, meter *metering.Collector,
 ) {
-	h := &objectHandlers{manager, logger, config, modulesProvider}
+	h := &objectHandlers{manager, logger, config, modulesProvider, meter}
 	api.ObjectsObjectsCreateHandler = objects.
 		ObjectsCreateHandlerFunc(h.addObject)
 	api.ObjectsObjectsValidateHandler = objects.
@@ -708,6 +749,10 @@ func parseIncludeParam(in *string, modulesProvider ModulesProvider, includeModul
 			out.Vector = true
 			continue
 		}
+		if prop == "routing" {
+			out.Routing = true
+			continue
+		}
 		if includeModuleParams && modulesProvider != nil {
 			moduleParams := modulesProvider.RestApiAdditionalProperties(prop, class)
 			if len(moduleParams) > 0 {
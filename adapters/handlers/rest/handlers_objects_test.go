@@ -1071,6 +1071,12 @@ func (f *fakeManager) GetObject(_ context.Context, _ *models.Principal, class st
 	return f.getObjectReturn, f.getObjectErr
 }
 
+func (f *fakeManager) GetObjectAtVersion(_ context.Context, _ *models.Principal, class string,
+	_ strfmt.UUID, _ int64, _ additional.Properties,
+) (*models.Object, error) {
+	return f.getObjectReturn, f.getObjectErr
+}
+
 func (f *fakeManager) GetObjectsClass(ctx context.Context,
 	principal *models.Principal, id strfmt.UUID,
 ) (*models.Class, error) {
@@ -0,0 +1,163 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/graphql"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/queryreplay"
+)
+
+type queryReplayHandlers struct {
+	replayer *queryreplay.Replayer
+}
+
+func (h *queryReplayHandlers) startReplay(params graphql.GraphqlReplayStartParams,
+	principal *models.Principal,
+) middleware.Responder {
+	report, err := h.replayer.Start(principal, queryReplayParams(params.Body))
+	if err != nil {
+		if err == queryreplay.ErrRunning {
+			return graphql.NewGraphqlReplayStartConflict().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return graphql.NewGraphqlReplayStartForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case queryreplay.ErrInvalidParams:
+			return graphql.NewGraphqlReplayStartUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return graphql.NewGraphqlReplayStartInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return graphql.NewGraphqlReplayStartOK().WithPayload(queryReplayReport(report))
+}
+
+func (h *queryReplayHandlers) getReplay(params graphql.GraphqlReplayGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	report, err := h.replayer.Status(principal)
+	if err != nil {
+		if err == queryreplay.ErrNoReplay {
+			return graphql.NewGraphqlReplayGetNotFound()
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return graphql.NewGraphqlReplayGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return graphql.NewGraphqlReplayGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return graphql.NewGraphqlReplayGetOK().WithPayload(queryReplayReport(report))
+}
+
+func (h *queryReplayHandlers) cancelReplay(params graphql.GraphqlReplayCancelParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.replayer.Cancel(principal); err != nil {
+		if err == queryreplay.ErrNoReplay {
+			return graphql.NewGraphqlReplayCancelNotFound()
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return graphql.NewGraphqlReplayCancelForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return graphql.NewGraphqlReplayCancelInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return graphql.NewGraphqlReplayCancelNoContent()
+}
+
+func queryReplayParams(body *models.QueryReplayRequest) queryreplay.Params {
+	params := queryreplay.Params{
+		Target:      body.Target,
+		Rate:        body.Rate,
+		Concurrency: int(body.Concurrency),
+		Repeat:      int(body.Repeat),
+		Headers:     body.Headers,
+	}
+	for _, q := range body.Queries {
+		if q == nil {
+			continue
+		}
+		variables, _ := q.Variables.(map[string]interface{})
+		params.Queries = append(params.Queries, queryreplay.Entry{
+			Query:         q.Query,
+			OperationName: q.OperationName,
+			Variables:     variables,
+			DurationMs:    q.DurationMs,
+		})
+	}
+	return params
+}
+
+func queryReplayReport(report queryreplay.Report) *models.QueryReplayReport {
+	res := &models.QueryReplayReport{
+		Status:        report.Status,
+		Target:        report.Target,
+		Total:         int64(report.Total),
+		Sent:          int64(report.Sent),
+		Succeeded:     int64(report.Succeeded),
+		Failed:        int64(report.Failed),
+		StartedAtUnix: report.StartedAt.UnixMilli(),
+		Throughput:    report.Throughput,
+		Latency:       queryReplayLatency(report.Latency),
+		Captured:      queryReplayLatency(report.Captured),
+		LastError:     report.LastError,
+	}
+	if report.FinishedAt != nil {
+		res.FinishedAtUnix = report.FinishedAt.UnixMilli()
+	}
+	return res
+}
+
+func queryReplayLatency(latency *queryreplay.Latency) *models.QueryReplayLatency {
+	if latency == nil {
+		return nil
+	}
+	return &models.QueryReplayLatency{
+		Min:  latency.Min,
+		Mean: latency.Mean,
+		P50:  latency.P50,
+		P90:  latency.P90,
+		P99:  latency.P99,
+		Max:  latency.Max,
+	}
+}
+
+func setupQueryReplayHandlers(api *operations.WeaviateAPI,
+	replayer *queryreplay.Replayer,
+) {
+	h := &queryReplayHandlers{replayer}
+	api.GraphqlGraphqlReplayStartHandler = graphql.
+		GraphqlReplayStartHandlerFunc(h.startReplay)
+	api.GraphqlGraphqlReplayGetHandler = graphql.
+		GraphqlReplayGetHandlerFunc(h.getReplay)
+	api.GraphqlGraphqlReplayCancelHandler = graphql.
+		GraphqlReplayCancelHandlerFunc(h.cancelReplay)
+}
@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+)
+
+type revectorizeHandlers struct {
+	revectorizer *uco.Revectorizer
+}
+
+func (h *revectorizeHandlers) getJob(params schema.SchemaObjectsRevectorizeGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	job, err := h.revectorizer.Status(principal, params.ClassName)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsRevectorizeGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case uco.ErrNotFound:
+			return schema.NewSchemaObjectsRevectorizeGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsRevectorizeGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsRevectorizeGetOK().WithPayload(revectorizeJob(job))
+}
+
+func (h *revectorizeHandlers) startJob(params schema.SchemaObjectsRevectorizeStartParams,
+	principal *models.Principal,
+) middleware.Responder {
+	var jobParams uco.RevectorizeParams
+	if params.Body != nil {
+		jobParams = uco.RevectorizeParams{
+			BatchSize:           int(params.Body.BatchSize),
+			MaxObjectsPerSecond: params.Body.MaxObjectsPerSecond,
+			Restart:             params.Body.Restart,
+		}
+	}
+
+	job, err := h.revectorizer.Start(principal, params.ClassName, jobParams)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsRevectorizeStartForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case uco.ErrInvalidUserInput:
+			return schema.NewSchemaObjectsRevectorizeStartUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsRevectorizeStartInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsRevectorizeStartAccepted().WithPayload(revectorizeJob(job))
+}
+
+func (h *revectorizeHandlers) cancelJob(params schema.SchemaObjectsRevectorizeCancelParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.revectorizer.Cancel(principal, params.ClassName); err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsRevectorizeCancelForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case uco.ErrNotFound:
+			return schema.NewSchemaObjectsRevectorizeCancelNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsRevectorizeCancelInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsRevectorizeCancelNoContent()
+}
+
+func revectorizeJob(job uco.RevectorizeJob) *models.RevectorizeJob {
+	res := &models.RevectorizeJob{
+		Class:  job.Class,
+		Status: job.Status,
+		Params: &models.RevectorizeParams{
+			BatchSize:           int64(job.Params.BatchSize),
+			MaxObjectsPerSecond: job.Params.MaxObjectsPerSecond,
+			Restart:             job.Params.Restart,
+		},
+		Cursor:        job.Cursor.String(),
+		Processed:     job.Processed,
+		Failed:        job.Failed,
+		Error:         job.Error,
+		StartedAtUnix: job.StartedAt.UnixMilli(),
+	}
+	if !job.FinishedAt.IsZero() {
+		res.FinishedAtUnix = job.FinishedAt.UnixMilli()
+	}
+	return res
+}
+
+func setupRevectorizeHandlers(api *operations.WeaviateAPI, revectorizer *uco.Revectorizer) {
+	h := &revectorizeHandlers{revectorizer}
+	api.SchemaSchemaObjectsRevectorizeGetHandler = schema.
+		SchemaObjectsRevectorizeGetHandlerFunc(h.getJob)
+	api.SchemaSchemaObjectsRevectorizeStartHandler = schema.
+		SchemaObjectsRevectorizeStartHandlerFunc(h.startJob)
+	api.SchemaSchemaObjectsRevectorizeCancelHandler = schema.
+		SchemaObjectsRevectorizeCancelHandlerFunc(h.cancelJob)
+}
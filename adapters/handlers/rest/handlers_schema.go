@@ -45,8 +45,9 @@ func (s *schemaHandlers) addClass(params schema.SchemaObjectsCreateParams,
 func (s *schemaHandlers) updateClass(params schema.SchemaObjectsUpdateParams,
 	principal *models.Principal,
 ) middleware.Responder {
+	overrideProtection := params.OverrideProtection != nil && *params.OverrideProtection
 	err := s.manager.UpdateClass(params.HTTPRequest.Context(), principal, params.ClassName,
-		params.ObjectClass)
+		params.ObjectClass, overrideProtection)
 	if err != nil {
 		if err == schemaUC.ErrNotFound {
 			return schema.NewSchemaObjectsUpdateNotFound()
@@ -92,7 +93,9 @@ func (s *schemaHandlers) deleteClass(params schema.SchemaObjectsDeleteParams, pr
 	if params.Force != nil {
 		force = *params.Force
 	}
-	err := s.manager.DeleteClass(params.HTTPRequest.Context(), principal, params.ClassName, force)
+	overrideProtection := params.OverrideProtection != nil && *params.OverrideProtection
+	err := s.manager.DeleteClass(params.HTTPRequest.Context(), principal, params.ClassName,
+		force, overrideProtection)
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
@@ -182,6 +185,184 @@ func (s *schemaHandlers) updateShardStatus(params schema.SchemaObjectsShardsUpda
 	return schema.NewSchemaObjectsShardsUpdateOK().WithPayload(payload)
 }
 
+func (s *schemaHandlers) tokenizeProperty(params schema.SchemaObjectsPropertiesTokenizeParams,
+	principal *models.Principal,
+) middleware.Responder {
+	preview, err := s.manager.TokenizeProperty(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.PropertyName, params.Body.Text)
+	if err != nil {
+		if err == schemaUC.ErrNotFound {
+			return schema.NewSchemaObjectsPropertiesTokenizeNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsPropertiesTokenizeForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsPropertiesTokenizeUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsPropertiesTokenizeOK().WithPayload(preview)
+}
+
+func (s *schemaHandlers) listTrash(params schema.SchemaTrashListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	trash, err := s.manager.ListTrash(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaTrashListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaTrashListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaTrashListOK().WithPayload(trash)
+}
+
+func (s *schemaHandlers) restoreTrashedClass(params schema.SchemaTrashRestoreParams,
+	principal *models.Principal,
+) middleware.Responder {
+	class, err := s.manager.RestoreTrashedClass(params.HTTPRequest.Context(), principal, params.ID)
+	if err != nil {
+		if err == schemaUC.ErrNotFound {
+			return schema.NewSchemaTrashRestoreNotFound()
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaTrashRestoreForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaTrashRestoreUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaTrashRestoreOK().WithPayload(class)
+}
+
+func (s *schemaHandlers) optimizeClass(params schema.SchemaObjectsOptimizeParams,
+	principal *models.Principal,
+) middleware.Responder {
+	res, err := s.manager.OptimizeClass(params.HTTPRequest.Context(), principal, params.ClassName)
+	if err != nil {
+		if err == schemaUC.ErrNotFound {
+			return schema.NewSchemaObjectsOptimizeNotFound()
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsOptimizeForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsOptimizeUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsOptimizeOK().WithPayload(res)
+}
+
+func (s *schemaHandlers) cleanupInvertedIndex(params schema.SchemaObjectsInvertedCleanupParams,
+	principal *models.Principal,
+) middleware.Responder {
+	res, err := s.manager.CleanupInvertedIndex(params.HTTPRequest.Context(), principal,
+		params.ClassName)
+	if err != nil {
+		if err == schemaUC.ErrNotFound {
+			return schema.NewSchemaObjectsInvertedCleanupNotFound()
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsInvertedCleanupForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsInvertedCleanupUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsInvertedCleanupOK().WithPayload(res)
+}
+
+func (s *schemaHandlers) replayClass(params schema.SchemaObjectsReplayParams,
+	principal *models.Principal,
+) middleware.Responder {
+	res, err := s.manager.ReplayClass(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Body)
+	if err != nil {
+		if err == schemaUC.ErrNotFound {
+			return schema.NewSchemaObjectsReplayNotFound()
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsReplayForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsReplayUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsReplayOK().WithPayload(res)
+}
+
+func (s *schemaHandlers) startMigration(params schema.SchemaObjectsMigrationsCreateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	migration, err := s.manager.StartMigration(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Body)
+	if err != nil {
+		if err == schemaUC.ErrNotFound {
+			return schema.NewSchemaObjectsMigrationsCreateNotFound()
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsMigrationsCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsMigrationsCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsMigrationsCreateOK().WithPayload(migration)
+}
+
+func (s *schemaHandlers) listMigrations(params schema.SchemaObjectsMigrationsListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	migrations, err := s.manager.ListMigrations(params.HTTPRequest.Context(), principal,
+		params.ClassName)
+	if err != nil {
+		if err == schemaUC.ErrNotFound {
+			return schema.NewSchemaObjectsMigrationsListNotFound()
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsMigrationsListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsMigrationsListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsMigrationsListOK().WithPayload(migrations)
+}
+
 func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager) {
 	h := &schemaHandlers{manager}
 
@@ -191,6 +372,8 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager)
 		SchemaObjectsDeleteHandlerFunc(h.deleteClass)
 	api.SchemaSchemaObjectsPropertiesAddHandler = schema.
 		SchemaObjectsPropertiesAddHandlerFunc(h.addClassProperty)
+	api.SchemaSchemaObjectsPropertiesTokenizeHandler = schema.
+		SchemaObjectsPropertiesTokenizeHandlerFunc(h.tokenizeProperty)
 
 	api.SchemaSchemaObjectsUpdateHandler = schema.
 		SchemaObjectsUpdateHandlerFunc(h.updateClass)
@@ -204,4 +387,19 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager)
 		SchemaObjectsShardsGetHandlerFunc(h.getShardsStatus)
 	api.SchemaSchemaObjectsShardsUpdateHandler = schema.
 		SchemaObjectsShardsUpdateHandlerFunc(h.updateShardStatus)
+
+	api.SchemaSchemaTrashListHandler = schema.
+		SchemaTrashListHandlerFunc(h.listTrash)
+	api.SchemaSchemaTrashRestoreHandler = schema.
+		SchemaTrashRestoreHandlerFunc(h.restoreTrashedClass)
+	api.SchemaSchemaObjectsOptimizeHandler = schema.
+		SchemaObjectsOptimizeHandlerFunc(h.optimizeClass)
+	api.SchemaSchemaObjectsInvertedCleanupHandler = schema.
+		SchemaObjectsInvertedCleanupHandlerFunc(h.cleanupInvertedIndex)
+	api.SchemaSchemaObjectsReplayHandler = schema.
+		SchemaObjectsReplayHandlerFunc(h.replayClass)
+	api.SchemaSchemaObjectsMigrationsCreateHandler = schema.
+		SchemaObjectsMigrationsCreateHandlerFunc(h.startMigration)
+	api.SchemaSchemaObjectsMigrationsListHandler = schema.
+		SchemaObjectsMigrationsListHandlerFunc(h.listMigrations)
 }
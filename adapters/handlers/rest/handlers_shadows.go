@@ -0,0 +1,175 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+)
+
+type shadowHandlers struct {
+	shadows *uco.Shadows
+}
+
+func (h *shadowHandlers) getShadow(params schema.SchemaObjectsShadowGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	shadow, err := h.shadows.Status(principal, params.ClassName)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsShadowGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case uco.ErrNotFound:
+			return schema.NewSchemaObjectsShadowGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsShadowGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsShadowGetOK().WithPayload(classShadow(shadow))
+}
+
+func (h *shadowHandlers) startShadow(params schema.SchemaObjectsShadowStartParams,
+	principal *models.Principal,
+) middleware.Responder {
+	shadow, err := h.shadows.Start(principal, params.ClassName, uco.ShadowParams{
+		ShadowClass:     params.Body.ShadowClass,
+		DurationSeconds: params.Body.DurationSeconds,
+	})
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsShadowStartForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case uco.ErrInvalidUserInput:
+			return schema.NewSchemaObjectsShadowStartUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsShadowStartInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsShadowStartOK().WithPayload(classShadow(shadow))
+}
+
+func (h *shadowHandlers) deleteShadow(params schema.SchemaObjectsShadowDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := h.shadows.Stop(principal, params.ClassName); err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsShadowDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case uco.ErrNotFound:
+			return schema.NewSchemaObjectsShadowDeleteNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsShadowDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsShadowDeleteNoContent()
+}
+
+func (h *shadowHandlers) compareShadow(params schema.SchemaObjectsShadowCompareParams,
+	principal *models.Principal,
+) middleware.Responder {
+	var compare uco.ShadowCompareParams
+	if params.Body != nil {
+		compare.IDs = make([]strfmt.UUID, len(params.Body.Ids))
+		for i, id := range params.Body.Ids {
+			compare.IDs[i] = strfmt.UUID(id)
+		}
+		compare.Samples = int(params.Body.Samples)
+		compare.Limit = int(params.Body.Limit)
+	}
+
+	res, err := h.shadows.Compare(params.HTTPRequest.Context(), principal,
+		params.ClassName, compare)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsShadowCompareForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case uco.ErrNotFound:
+			return schema.NewSchemaObjectsShadowCompareNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case uco.ErrInvalidUserInput:
+			return schema.NewSchemaObjectsShadowCompareUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsShadowCompareInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	comparison := &models.ClassShadowComparison{
+		Class:       res.Class,
+		ShadowClass: res.ShadowClass,
+		Limit:       int64(res.Limit),
+		MeanOverlap: res.MeanOverlap,
+		Queries:     make([]*models.ClassShadowQueryComparison, len(res.Queries)),
+	}
+	for i, query := range res.Queries {
+		comparison.Queries[i] = &models.ClassShadowQueryComparison{
+			ID:      query.ID.String(),
+			Overlap: query.Overlap,
+			Missing: uuidStrings(query.Missing),
+			Extra:   uuidStrings(query.Extra),
+			Error:   query.Error,
+		}
+	}
+	return schema.NewSchemaObjectsShadowCompareOK().WithPayload(comparison)
+}
+
+func classShadow(shadow uco.Shadow) *models.ClassShadow {
+	return &models.ClassShadow{
+		Class:         shadow.Class,
+		ShadowClass:   shadow.ShadowClass,
+		Status:        shadow.Status,
+		StartedAtUnix: shadow.StartedAt.UnixMilli(),
+		UntilUnix:     shadow.Until.UnixMilli(),
+		Mirrored:      shadow.Mirrored,
+		Failed:        shadow.Failed,
+		Dropped:       shadow.Dropped,
+	}
+}
+
+func uuidStrings(ids []strfmt.UUID) []string {
+	out := make([]string, len(ids))
+	for i, id := range ids {
+		out[i] = id.String()
+	}
+	return out
+}
+
+func setupShadowHandlers(api *operations.WeaviateAPI, shadows *uco.Shadows) {
+	h := &shadowHandlers{shadows}
+	api.SchemaSchemaObjectsShadowGetHandler = schema.
+		SchemaObjectsShadowGetHandlerFunc(h.getShadow)
+	api.SchemaSchemaObjectsShadowStartHandler = schema.
+		SchemaObjectsShadowStartHandlerFunc(h.startShadow)
+	api.SchemaSchemaObjectsShadowDeleteHandler = schema.
+		SchemaObjectsShadowDeleteHandlerFunc(h.deleteShadow)
+	api.SchemaSchemaObjectsShadowCompareHandler = schema.
+		SchemaObjectsShadowCompareHandlerFunc(h.compareShadow)
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/swagger_middleware"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/replica"
 )
 
 // The middleware configuration is for the handler executors. These do not apply to the swagger.json document.
@@ -83,7 +84,9 @@ func makeAddModuleHandlers(modules *modules.Provider) func(http.Handler) http.Ha
 // The middleware configuration happens before anything, this middleware also applies to serving the swagger.json document.
 // So this is a good place to plug in a panic handling middleware, logging and metrics
 // Contains "x-api-key", "x-api-token" for legacy reasons, older interfaces might need these headers.
-func makeSetupGlobalMiddleware(appState *state.State) func(http.Handler) http.Handler {
+func makeSetupGlobalMiddleware(appState *state.State,
+	objectBlobs objectBlobsManager,
+) func(http.Handler) http.Handler {
 	return func(handler http.Handler) http.Handler {
 		handleCORS := cors.New(cors.Options{
 			OptionsPassthrough: true,
@@ -99,8 +102,15 @@ func makeSetupGlobalMiddleware(appState *state.State) func(http.Handler) http.Ha
 		handler = addLiveAndReadyness(appState, handler)
 		handler = addHandleRoot(handler)
 		handler = makeAddModuleHandlers(appState.Modules)(handler)
+		handler = makeAddObjectBlobs(objectBlobs, NewTokenAuthComposer(
+			appState.ServerConfig.Config.Authentication, appState.APIKey, appState.OIDC),
+			appState.AnonymousAccess, appState.Logger)(handler)
 		handler = addInjectHeadersIntoContext(handler)
+		handler = addTraceIDIntoContext(handler)
+		handler = addSessionIntoContext(handler)
+		handler = appState.Drainer.Middleware(handler)
 		handler = makeCatchPanics(appState.Logger)(handler)
+		handler = appState.NetworkAccess.API.Middleware(handler)
 
 		return handler
 	}
@@ -128,12 +138,11 @@ func makeAddMonitoring(metrics *monitoring.PrometheusMetrics) func(http.Handler)
 			next.ServeHTTP(w, r)
 
 			if strings.HasPrefix(path, "/v1/batch/objects") && method == http.MethodPost {
-				metrics.BatchTime.With(prometheus.Labels{
+				monitoring.ObserveWithTraceID(r.Context(), metrics.BatchTime.With(prometheus.Labels{
 					"operation":  "total_api_level",
 					"class_name": "n/a",
 					"shard_name": "n/a",
-				}).
-					Observe(float64(time.Since(before) / time.Millisecond))
+				}), float64(time.Since(before)/time.Millisecond))
 			}
 		})
 	}
@@ -145,7 +154,8 @@ func addPreflight(next http.Handler) http.Handler {
 			w.Header().Set("Access-Control-Allow-Origin", "*")
 			w.Header().Set("Access-Control-Allow-Methods", "*")
 			w.Header().Set("Access-Control-Allow-Headers",
-				"Content-Type, Authorization, Batch, X-Openai-Api-Key, X-Cohere-Api-Key, X-Huggingface-Api-Key")
+				"Content-Type, Authorization, Batch, X-Openai-Api-Key, X-Cohere-Api-Key, X-Huggingface-Api-Key, "+
+					replica.SessionTokenHeader)
 			return
 		}
 
@@ -172,6 +182,64 @@ func addInjectHeadersIntoContext(next http.Handler) http.Handler {
 	})
 }
 
+// addTraceIDIntoContext links the metrics of a request to its trace, see
+// monitoring.ObserveWithTraceID
+func addTraceIDIntoContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceID, ok := monitoring.TraceIDFromTraceparent(r.Header.Get(monitoring.TraceparentHeader))
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(monitoring.ContextWithTraceID(r.Context(), traceID)))
+	})
+}
+
+// addSessionIntoContext attaches the session of the request token to its
+// context and returns the updated token if the request has written to a
+// replicated shard, see replica.Session
+func addSessionIntoContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		session := replica.NewSession()
+		if token := r.Header.Get(replica.SessionTokenHeader); token != "" {
+			var err error
+			if session, err = replica.ParseSessionToken(token); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+
+		sw := &sessionResponseWriter{ResponseWriter: w, session: session}
+		next.ServeHTTP(sw, r.WithContext(replica.ContextWithSession(r.Context(), session)))
+	})
+}
+
+// sessionResponseWriter sets the session token header right before the
+// response is written
+type sessionResponseWriter struct {
+	http.ResponseWriter
+	session     *replica.Session
+	wroteHeader bool
+}
+
+func (w *sessionResponseWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		if w.session.Changed() {
+			w.Header().Set(replica.SessionTokenHeader, w.session.Token())
+		}
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *sessionResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
 func addLiveAndReadyness(state *state.State, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.String() == "/v1/.well-known/live" {
@@ -181,7 +249,8 @@ func addLiveAndReadyness(state *state.State, next http.Handler) http.Handler {
 
 		if r.URL.String() == "/v1/.well-known/ready" {
 			code := http.StatusServiceUnavailable
-			if state.DB.StartupComplete() && state.Cluster.ClusterHealthScore() == 0 {
+			if state.DB.StartupComplete() && state.Cluster.ClusterHealthScore() == 0 &&
+				!state.Drainer.Draining() {
 				code = http.StatusOK
 			}
 			w.WriteHeader(code)
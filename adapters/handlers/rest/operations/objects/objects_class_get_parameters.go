@@ -23,6 +23,7 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 )
 
@@ -65,6 +66,10 @@ type ObjectsClassGetParams struct {
 	  In: query
 	*/
 	NodeName *string
+	/*Return a retained version of the object instead of the current one, requires the versioningConfig of the class. Positive values are version numbers, the first write of an object is version 1. 0 is the latest version and negative values are relative to it, -1 is the version before the latest one
+	  In: query
+	*/
+	Version *int64
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -102,6 +107,11 @@ func (o *ObjectsClassGetParams) BindRequest(r *http.Request, route *middleware.M
 	if err := o.bindNodeName(qNodeName, qhkNodeName, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qVersion, qhkVersion, _ := qs.GetOK("version")
+	if err := o.bindVersion(qVersion, qhkVersion, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -208,3 +218,26 @@ func (o *ObjectsClassGetParams) bindNodeName(rawData []string, hasKey bool, form
 
 	return nil
 }
+
+// bindVersion binds and validates parameter Version from query.
+func (o *ObjectsClassGetParams) bindVersion(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("version", "query", "int64", raw)
+	}
+	o.Version = &value
+
+	return nil
+}
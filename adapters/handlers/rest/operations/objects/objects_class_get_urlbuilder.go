@@ -23,6 +23,7 @@ import (
 	"strings"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // ObjectsClassGetURL generates an URL for the objects class get operation
@@ -33,6 +34,7 @@ type ObjectsClassGetURL struct {
 	ConsistencyLevel *string
 	Include          *string
 	NodeName         *string
+	Version          *int64
 
 	_basePath string
 	// avoid unkeyed usage
@@ -106,6 +108,14 @@ func (o *ObjectsClassGetURL) Build() (*url.URL, error) {
 		qs.Set("node_name", nodeNameQ)
 	}
 
+	var versionQ string
+	if o.Version != nil {
+		versionQ = swag.FormatInt64(*o.Version)
+	}
+	if versionQ != "" {
+		qs.Set("version", versionQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
@@ -52,6 +52,10 @@ type SchemaObjectsDeleteParams struct {
 	  In: query
 	*/
 	Force *bool
+	/*Required to delete a class with deletion protection or to update a class whose configuration is frozen or whose protection is lowered
+	  In: query
+	*/
+	OverrideProtection *bool
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -74,6 +78,11 @@ func (o *SchemaObjectsDeleteParams) BindRequest(r *http.Request, route *middlewa
 	if err := o.bindForce(qForce, qhkForce, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qOverrideProtection, qhkOverrideProtection, _ := qs.GetOK("overrideProtection")
+	if err := o.bindOverrideProtection(qOverrideProtection, qhkOverrideProtection, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -116,3 +125,26 @@ func (o *SchemaObjectsDeleteParams) bindForce(rawData []string, hasKey bool, for
 
 	return nil
 }
+
+// bindOverrideProtection binds and validates parameter OverrideProtection from query.
+func (o *SchemaObjectsDeleteParams) bindOverrideProtection(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("overrideProtection", "query", "bool", raw)
+	}
+	o.OverrideProtection = &value
+
+	return nil
+}
@@ -31,6 +31,8 @@ type SchemaObjectsDeleteURL struct {
 
 	Force *bool
 
+	OverrideProtection *bool
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -80,6 +82,14 @@ func (o *SchemaObjectsDeleteURL) Build() (*url.URL, error) {
 		qs.Set("force", forceQ)
 	}
 
+	var overrideProtectionQ string
+	if o.OverrideProtection != nil {
+		overrideProtectionQ = swag.FormatBool(*o.OverrideProtection)
+	}
+	if overrideProtectionQ != "" {
+		qs.Set("overrideProtection", overrideProtectionQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
@@ -24,6 +24,7 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 
 	"github.com/weaviate/weaviate/entities/models"
@@ -56,6 +57,10 @@ type SchemaObjectsUpdateParams struct {
 	  In: body
 	*/
 	ObjectClass *models.Class
+	/*Required to delete a class with deletion protection or to update a class whose configuration is frozen or whose protection is lowered
+	  In: query
+	*/
+	OverrideProtection *bool
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -67,6 +72,8 @@ func (o *SchemaObjectsUpdateParams) BindRequest(r *http.Request, route *middlewa
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	rClassName, rhkClassName, _ := route.Params.GetOK("className")
 	if err := o.bindClassName(rClassName, rhkClassName, route.Formats); err != nil {
 		res = append(res, err)
@@ -99,6 +106,10 @@ func (o *SchemaObjectsUpdateParams) BindRequest(r *http.Request, route *middlewa
 	} else {
 		res = append(res, errors.Required("objectClass", "body", ""))
 	}
+	qOverrideProtection, qhkOverrideProtection, _ := qs.GetOK("overrideProtection")
+	if err := o.bindOverrideProtection(qOverrideProtection, qhkOverrideProtection, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -118,3 +129,26 @@ func (o *SchemaObjectsUpdateParams) bindClassName(rawData []string, hasKey bool,
 
 	return nil
 }
+
+// bindOverrideProtection binds and validates parameter OverrideProtection from query.
+func (o *SchemaObjectsUpdateParams) bindOverrideProtection(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("overrideProtection", "query", "bool", raw)
+	}
+	o.OverrideProtection = &value
+
+	return nil
+}
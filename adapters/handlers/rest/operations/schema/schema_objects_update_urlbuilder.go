@@ -21,12 +21,16 @@ import (
 	"net/url"
 	golangswaggerpaths "path"
 	"strings"
+
+	"github.com/go-openapi/swag"
 )
 
 // SchemaObjectsUpdateURL generates an URL for the schema objects update operation
 type SchemaObjectsUpdateURL struct {
 	ClassName string
 
+	OverrideProtection *bool
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -66,6 +70,18 @@ func (o *SchemaObjectsUpdateURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var overrideProtectionQ string
+	if o.OverrideProtection != nil {
+		overrideProtectionQ = swag.FormatBool(*o.OverrideProtection)
+	}
+	if overrideProtectionQ != "" {
+		qs.Set("overrideProtection", overrideProtectionQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
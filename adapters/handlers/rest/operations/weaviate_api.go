@@ -87,6 +87,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		BatchBatchObjectsDeleteHandler: batch.BatchObjectsDeleteHandlerFunc(func(params batch.BatchObjectsDeleteParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batch.BatchObjectsDelete has not yet been implemented")
 		}),
+		BatchBatchObjectsMergeHandler: batch.BatchObjectsMergeHandlerFunc(func(params batch.BatchObjectsMergeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batch.BatchObjectsMerge has not yet been implemented")
+		}),
 		BatchBatchReferencesCreateHandler: batch.BatchReferencesCreateHandlerFunc(func(params batch.BatchReferencesCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batch.BatchReferencesCreate has not yet been implemented")
 		}),
@@ -102,12 +105,60 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		GraphqlGraphqlPostHandler: graphql.GraphqlPostHandlerFunc(func(params graphql.GraphqlPostParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation graphql.GraphqlPost has not yet been implemented")
 		}),
+		GraphqlGraphqlReplayCancelHandler: graphql.GraphqlReplayCancelHandlerFunc(func(params graphql.GraphqlReplayCancelParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation graphql.GraphqlReplayCancel has not yet been implemented")
+		}),
+		GraphqlGraphqlReplayGetHandler: graphql.GraphqlReplayGetHandlerFunc(func(params graphql.GraphqlReplayGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation graphql.GraphqlReplayGet has not yet been implemented")
+		}),
+		GraphqlGraphqlReplayStartHandler: graphql.GraphqlReplayStartHandlerFunc(func(params graphql.GraphqlReplayStartParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation graphql.GraphqlReplayStart has not yet been implemented")
+		}),
 		MetaMetaGetHandler: meta.MetaGetHandlerFunc(func(params meta.MetaGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation meta.MetaGet has not yet been implemented")
 		}),
+		NodesNodesCompactionGetHandler: nodes.NodesCompactionGetHandlerFunc(func(params nodes.NodesCompactionGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesCompactionGet has not yet been implemented")
+		}),
+		NodesNodesCompactionUpdateHandler: nodes.NodesCompactionUpdateHandlerFunc(func(params nodes.NodesCompactionUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesCompactionUpdate has not yet been implemented")
+		}),
 		NodesNodesGetHandler: nodes.NodesGetHandlerFunc(func(params nodes.NodesGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation nodes.NodesGet has not yet been implemented")
 		}),
+		NodesNodesMembersDeleteHandler: nodes.NodesMembersDeleteHandlerFunc(func(params nodes.NodesMembersDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesMembersDelete has not yet been implemented")
+		}),
+		NodesNodesMembersGetHandler: nodes.NodesMembersGetHandlerFunc(func(params nodes.NodesMembersGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesMembersGet has not yet been implemented")
+		}),
+		NodesNodesMembersJoinHandler: nodes.NodesMembersJoinHandlerFunc(func(params nodes.NodesMembersJoinParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesMembersJoin has not yet been implemented")
+		}),
+		NodesNodesMembersLeaveHandler: nodes.NodesMembersLeaveHandlerFunc(func(params nodes.NodesMembersLeaveParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesMembersLeave has not yet been implemented")
+		}),
+		NodesNodesNetworkAccessGetHandler: nodes.NodesNetworkAccessGetHandlerFunc(func(params nodes.NodesNetworkAccessGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesNetworkAccessGet has not yet been implemented")
+		}),
+		NodesNodesNetworkAccessUpdateHandler: nodes.NodesNetworkAccessUpdateHandlerFunc(func(params nodes.NodesNetworkAccessUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesNetworkAccessUpdate has not yet been implemented")
+		}),
+		NodesNodesOffloadsGetHandler: nodes.NodesOffloadsGetHandlerFunc(func(params nodes.NodesOffloadsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesOffloadsGet has not yet been implemented")
+		}),
+		NodesNodesOffloadsUpdateHandler: nodes.NodesOffloadsUpdateHandlerFunc(func(params nodes.NodesOffloadsUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesOffloadsUpdate has not yet been implemented")
+		}),
+		NodesNodesShardClonesCreateHandler: nodes.NodesShardClonesCreateHandlerFunc(func(params nodes.NodesShardClonesCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesShardClonesCreate has not yet been implemented")
+		}),
+		NodesNodesVectorReindexGetHandler: nodes.NodesVectorReindexGetHandlerFunc(func(params nodes.NodesVectorReindexGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesVectorReindexGet has not yet been implemented")
+		}),
+		NodesNodesVectorReindexStartHandler: nodes.NodesVectorReindexStartHandlerFunc(func(params nodes.NodesVectorReindexStartParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesVectorReindexStart has not yet been implemented")
+		}),
 		ObjectsObjectsClassDeleteHandler: objects.ObjectsClassDeleteHandlerFunc(func(params objects.ObjectsClassDeleteParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsClassDelete has not yet been implemented")
 		}),
@@ -177,9 +228,54 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaObjectsGetHandler: schema.SchemaObjectsGetHandlerFunc(func(params schema.SchemaObjectsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsGet has not yet been implemented")
 		}),
+		SchemaSchemaObjectsHybridTuningFeedbackHandler: schema.SchemaObjectsHybridTuningFeedbackHandlerFunc(func(params schema.SchemaObjectsHybridTuningFeedbackParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsHybridTuningFeedback has not yet been implemented")
+		}),
+		SchemaSchemaObjectsHybridTuningGetHandler: schema.SchemaObjectsHybridTuningGetHandlerFunc(func(params schema.SchemaObjectsHybridTuningGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsHybridTuningGet has not yet been implemented")
+		}),
+		SchemaSchemaObjectsInvertedCleanupHandler: schema.SchemaObjectsInvertedCleanupHandlerFunc(func(params schema.SchemaObjectsInvertedCleanupParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsInvertedCleanup has not yet been implemented")
+		}),
+		SchemaSchemaObjectsMigrationsCreateHandler: schema.SchemaObjectsMigrationsCreateHandlerFunc(func(params schema.SchemaObjectsMigrationsCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsMigrationsCreate has not yet been implemented")
+		}),
+		SchemaSchemaObjectsMigrationsListHandler: schema.SchemaObjectsMigrationsListHandlerFunc(func(params schema.SchemaObjectsMigrationsListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsMigrationsList has not yet been implemented")
+		}),
+		SchemaSchemaObjectsOptimizeHandler: schema.SchemaObjectsOptimizeHandlerFunc(func(params schema.SchemaObjectsOptimizeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsOptimize has not yet been implemented")
+		}),
 		SchemaSchemaObjectsPropertiesAddHandler: schema.SchemaObjectsPropertiesAddHandlerFunc(func(params schema.SchemaObjectsPropertiesAddParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesAdd has not yet been implemented")
 		}),
+		SchemaSchemaObjectsPropertiesTokenizeHandler: schema.SchemaObjectsPropertiesTokenizeHandlerFunc(func(params schema.SchemaObjectsPropertiesTokenizeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesTokenize has not yet been implemented")
+		}),
+		SchemaSchemaObjectsReplayHandler: schema.SchemaObjectsReplayHandlerFunc(func(params schema.SchemaObjectsReplayParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsReplay has not yet been implemented")
+		}),
+		SchemaSchemaObjectsRevectorizeCancelHandler: schema.SchemaObjectsRevectorizeCancelHandlerFunc(func(params schema.SchemaObjectsRevectorizeCancelParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsRevectorizeCancel has not yet been implemented")
+		}),
+		SchemaSchemaObjectsRevectorizeGetHandler: schema.SchemaObjectsRevectorizeGetHandlerFunc(func(params schema.SchemaObjectsRevectorizeGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsRevectorizeGet has not yet been implemented")
+		}),
+		SchemaSchemaObjectsRevectorizeStartHandler: schema.SchemaObjectsRevectorizeStartHandlerFunc(func(params schema.SchemaObjectsRevectorizeStartParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsRevectorizeStart has not yet been implemented")
+		}),
+		SchemaSchemaObjectsShadowCompareHandler: schema.SchemaObjectsShadowCompareHandlerFunc(func(params schema.SchemaObjectsShadowCompareParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsShadowCompare has not yet been implemented")
+		}),
+		SchemaSchemaObjectsShadowDeleteHandler: schema.SchemaObjectsShadowDeleteHandlerFunc(func(params schema.SchemaObjectsShadowDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsShadowDelete has not yet been implemented")
+		}),
+		SchemaSchemaObjectsShadowGetHandler: schema.SchemaObjectsShadowGetHandlerFunc(func(params schema.SchemaObjectsShadowGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsShadowGet has not yet been implemented")
+		}),
+		SchemaSchemaObjectsShadowStartHandler: schema.SchemaObjectsShadowStartHandlerFunc(func(params schema.SchemaObjectsShadowStartParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsShadowStart has not yet been implemented")
+		}),
 		SchemaSchemaObjectsShardsGetHandler: schema.SchemaObjectsShardsGetHandlerFunc(func(params schema.SchemaObjectsShardsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsShardsGet has not yet been implemented")
 		}),
@@ -189,6 +285,12 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaObjectsUpdateHandler: schema.SchemaObjectsUpdateHandlerFunc(func(params schema.SchemaObjectsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsUpdate has not yet been implemented")
 		}),
+		SchemaSchemaTrashListHandler: schema.SchemaTrashListHandlerFunc(func(params schema.SchemaTrashListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaTrashList has not yet been implemented")
+		}),
+		SchemaSchemaTrashRestoreHandler: schema.SchemaTrashRestoreHandlerFunc(func(params schema.SchemaTrashRestoreParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaTrashRestore has not yet been implemented")
+		}),
 		WeaviateRootHandler: WeaviateRootHandlerFunc(func(params WeaviateRootParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation WeaviateRoot has not yet been implemented")
 		}),
@@ -264,6 +366,8 @@ type WeaviateAPI struct {
 	BatchBatchObjectsCreateHandler batch.BatchObjectsCreateHandler
 	// BatchBatchObjectsDeleteHandler sets the operation handler for the batch objects delete operation
 	BatchBatchObjectsDeleteHandler batch.BatchObjectsDeleteHandler
+	// BatchBatchObjectsMergeHandler sets the operation handler for the batch objects merge operation
+	BatchBatchObjectsMergeHandler batch.BatchObjectsMergeHandler
 	// BatchBatchReferencesCreateHandler sets the operation handler for the batch references create operation
 	BatchBatchReferencesCreateHandler batch.BatchReferencesCreateHandler
 	// ClassificationsClassificationsGetHandler sets the operation handler for the classifications get operation
@@ -274,10 +378,42 @@ type WeaviateAPI struct {
 	GraphqlGraphqlBatchHandler graphql.GraphqlBatchHandler
 	// GraphqlGraphqlPostHandler sets the operation handler for the graphql post operation
 	GraphqlGraphqlPostHandler graphql.GraphqlPostHandler
+	// GraphqlGraphqlReplayCancelHandler sets the operation handler for the graphql replay cancel operation
+	GraphqlGraphqlReplayCancelHandler graphql.GraphqlReplayCancelHandler
+	// GraphqlGraphqlReplayGetHandler sets the operation handler for the graphql replay get operation
+	GraphqlGraphqlReplayGetHandler graphql.GraphqlReplayGetHandler
+	// GraphqlGraphqlReplayStartHandler sets the operation handler for the graphql replay start operation
+	GraphqlGraphqlReplayStartHandler graphql.GraphqlReplayStartHandler
 	// MetaMetaGetHandler sets the operation handler for the meta get operation
 	MetaMetaGetHandler meta.MetaGetHandler
+	// NodesNodesCompactionGetHandler sets the operation handler for the nodes compaction get operation
+	NodesNodesCompactionGetHandler nodes.NodesCompactionGetHandler
+	// NodesNodesCompactionUpdateHandler sets the operation handler for the nodes compaction update operation
+	NodesNodesCompactionUpdateHandler nodes.NodesCompactionUpdateHandler
 	// NodesNodesGetHandler sets the operation handler for the nodes get operation
 	NodesNodesGetHandler nodes.NodesGetHandler
+	// NodesNodesMembersDeleteHandler sets the operation handler for the nodes members delete operation
+	NodesNodesMembersDeleteHandler nodes.NodesMembersDeleteHandler
+	// NodesNodesMembersGetHandler sets the operation handler for the nodes members get operation
+	NodesNodesMembersGetHandler nodes.NodesMembersGetHandler
+	// NodesNodesMembersJoinHandler sets the operation handler for the nodes members join operation
+	NodesNodesMembersJoinHandler nodes.NodesMembersJoinHandler
+	// NodesNodesMembersLeaveHandler sets the operation handler for the nodes members leave operation
+	NodesNodesMembersLeaveHandler nodes.NodesMembersLeaveHandler
+	// NodesNodesNetworkAccessGetHandler sets the operation handler for the nodes network access get operation
+	NodesNodesNetworkAccessGetHandler nodes.NodesNetworkAccessGetHandler
+	// NodesNodesNetworkAccessUpdateHandler sets the operation handler for the nodes network access update operation
+	NodesNodesNetworkAccessUpdateHandler nodes.NodesNetworkAccessUpdateHandler
+	// NodesNodesOffloadsGetHandler sets the operation handler for the nodes offloads get operation
+	NodesNodesOffloadsGetHandler nodes.NodesOffloadsGetHandler
+	// NodesNodesOffloadsUpdateHandler sets the operation handler for the nodes offloads update operation
+	NodesNodesOffloadsUpdateHandler nodes.NodesOffloadsUpdateHandler
+	// NodesNodesShardClonesCreateHandler sets the operation handler for the nodes shard clones create operation
+	NodesNodesShardClonesCreateHandler nodes.NodesShardClonesCreateHandler
+	// NodesNodesVectorReindexGetHandler sets the operation handler for the nodes vector reindex get operation
+	NodesNodesVectorReindexGetHandler nodes.NodesVectorReindexGetHandler
+	// NodesNodesVectorReindexStartHandler sets the operation handler for the nodes vector reindex start operation
+	NodesNodesVectorReindexStartHandler nodes.NodesVectorReindexStartHandler
 	// ObjectsObjectsClassDeleteHandler sets the operation handler for the objects class delete operation
 	ObjectsObjectsClassDeleteHandler objects.ObjectsClassDeleteHandler
 	// ObjectsObjectsClassGetHandler sets the operation handler for the objects class get operation
@@ -324,14 +460,48 @@ type WeaviateAPI struct {
 	SchemaSchemaObjectsDeleteHandler schema.SchemaObjectsDeleteHandler
 	// SchemaSchemaObjectsGetHandler sets the operation handler for the schema objects get operation
 	SchemaSchemaObjectsGetHandler schema.SchemaObjectsGetHandler
+	// SchemaSchemaObjectsHybridTuningFeedbackHandler sets the operation handler for the schema objects hybrid tuning feedback operation
+	SchemaSchemaObjectsHybridTuningFeedbackHandler schema.SchemaObjectsHybridTuningFeedbackHandler
+	// SchemaSchemaObjectsHybridTuningGetHandler sets the operation handler for the schema objects hybrid tuning get operation
+	SchemaSchemaObjectsHybridTuningGetHandler schema.SchemaObjectsHybridTuningGetHandler
+	// SchemaSchemaObjectsInvertedCleanupHandler sets the operation handler for the schema objects inverted cleanup operation
+	SchemaSchemaObjectsInvertedCleanupHandler schema.SchemaObjectsInvertedCleanupHandler
+	// SchemaSchemaObjectsMigrationsCreateHandler sets the operation handler for the schema objects migrations create operation
+	SchemaSchemaObjectsMigrationsCreateHandler schema.SchemaObjectsMigrationsCreateHandler
+	// SchemaSchemaObjectsMigrationsListHandler sets the operation handler for the schema objects migrations list operation
+	SchemaSchemaObjectsMigrationsListHandler schema.SchemaObjectsMigrationsListHandler
+	// SchemaSchemaObjectsOptimizeHandler sets the operation handler for the schema objects optimize operation
+	SchemaSchemaObjectsOptimizeHandler schema.SchemaObjectsOptimizeHandler
 	// SchemaSchemaObjectsPropertiesAddHandler sets the operation handler for the schema objects properties add operation
 	SchemaSchemaObjectsPropertiesAddHandler schema.SchemaObjectsPropertiesAddHandler
+	// SchemaSchemaObjectsPropertiesTokenizeHandler sets the operation handler for the schema objects properties tokenize operation
+	SchemaSchemaObjectsPropertiesTokenizeHandler schema.SchemaObjectsPropertiesTokenizeHandler
+	// SchemaSchemaObjectsReplayHandler sets the operation handler for the schema objects replay operation
+	SchemaSchemaObjectsReplayHandler schema.SchemaObjectsReplayHandler
+	// SchemaSchemaObjectsRevectorizeCancelHandler sets the operation handler for the schema objects revectorize cancel operation
+	SchemaSchemaObjectsRevectorizeCancelHandler schema.SchemaObjectsRevectorizeCancelHandler
+	// SchemaSchemaObjectsRevectorizeGetHandler sets the operation handler for the schema objects revectorize get operation
+	SchemaSchemaObjectsRevectorizeGetHandler schema.SchemaObjectsRevectorizeGetHandler
+	// SchemaSchemaObjectsRevectorizeStartHandler sets the operation handler for the schema objects revectorize start operation
+	SchemaSchemaObjectsRevectorizeStartHandler schema.SchemaObjectsRevectorizeStartHandler
+	// SchemaSchemaObjectsShadowCompareHandler sets the operation handler for the schema objects shadow compare operation
+	SchemaSchemaObjectsShadowCompareHandler schema.SchemaObjectsShadowCompareHandler
+	// SchemaSchemaObjectsShadowDeleteHandler sets the operation handler for the schema objects shadow delete operation
+	SchemaSchemaObjectsShadowDeleteHandler schema.SchemaObjectsShadowDeleteHandler
+	// SchemaSchemaObjectsShadowGetHandler sets the operation handler for the schema objects shadow get operation
+	SchemaSchemaObjectsShadowGetHandler schema.SchemaObjectsShadowGetHandler
+	// SchemaSchemaObjectsShadowStartHandler sets the operation handler for the schema objects shadow start operation
+	SchemaSchemaObjectsShadowStartHandler schema.SchemaObjectsShadowStartHandler
 	// SchemaSchemaObjectsShardsGetHandler sets the operation handler for the schema objects shards get operation
 	SchemaSchemaObjectsShardsGetHandler schema.SchemaObjectsShardsGetHandler
 	// SchemaSchemaObjectsShardsUpdateHandler sets the operation handler for the schema objects shards update operation
 	SchemaSchemaObjectsShardsUpdateHandler schema.SchemaObjectsShardsUpdateHandler
 	// SchemaSchemaObjectsUpdateHandler sets the operation handler for the schema objects update operation
 	SchemaSchemaObjectsUpdateHandler schema.SchemaObjectsUpdateHandler
+	// SchemaSchemaTrashListHandler sets the operation handler for the schema trash list operation
+	SchemaSchemaTrashListHandler schema.SchemaTrashListHandler
+	// SchemaSchemaTrashRestoreHandler sets the operation handler for the schema trash restore operation
+	SchemaSchemaTrashRestoreHandler schema.SchemaTrashRestoreHandler
 	// WeaviateRootHandler sets the operation handler for the weaviate root operation
 	WeaviateRootHandler WeaviateRootHandler
 	// WeaviateWellknownLivenessHandler sets the operation handler for the weaviate wellknown liveness operation
@@ -443,6 +613,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.BatchBatchObjectsDeleteHandler == nil {
 		unregistered = append(unregistered, "batch.BatchObjectsDeleteHandler")
 	}
+	if o.BatchBatchObjectsMergeHandler == nil {
+		unregistered = append(unregistered, "batch.BatchObjectsMergeHandler")
+	}
 	if o.BatchBatchReferencesCreateHandler == nil {
 		unregistered = append(unregistered, "batch.BatchReferencesCreateHandler")
 	}
@@ -458,12 +631,60 @@ func (o *WeaviateAPI) Validate() error {
 	if o.GraphqlGraphqlPostHandler == nil {
 		unregistered = append(unregistered, "graphql.GraphqlPostHandler")
 	}
+	if o.GraphqlGraphqlReplayCancelHandler == nil {
+		unregistered = append(unregistered, "graphql.GraphqlReplayCancelHandler")
+	}
+	if o.GraphqlGraphqlReplayGetHandler == nil {
+		unregistered = append(unregistered, "graphql.GraphqlReplayGetHandler")
+	}
+	if o.GraphqlGraphqlReplayStartHandler == nil {
+		unregistered = append(unregistered, "graphql.GraphqlReplayStartHandler")
+	}
 	if o.MetaMetaGetHandler == nil {
 		unregistered = append(unregistered, "meta.MetaGetHandler")
 	}
+	if o.NodesNodesCompactionGetHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesCompactionGetHandler")
+	}
+	if o.NodesNodesCompactionUpdateHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesCompactionUpdateHandler")
+	}
 	if o.NodesNodesGetHandler == nil {
 		unregistered = append(unregistered, "nodes.NodesGetHandler")
 	}
+	if o.NodesNodesMembersDeleteHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesMembersDeleteHandler")
+	}
+	if o.NodesNodesMembersGetHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesMembersGetHandler")
+	}
+	if o.NodesNodesMembersJoinHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesMembersJoinHandler")
+	}
+	if o.NodesNodesMembersLeaveHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesMembersLeaveHandler")
+	}
+	if o.NodesNodesNetworkAccessGetHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesNetworkAccessGetHandler")
+	}
+	if o.NodesNodesNetworkAccessUpdateHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesNetworkAccessUpdateHandler")
+	}
+	if o.NodesNodesOffloadsGetHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesOffloadsGetHandler")
+	}
+	if o.NodesNodesOffloadsUpdateHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesOffloadsUpdateHandler")
+	}
+	if o.NodesNodesShardClonesCreateHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesShardClonesCreateHandler")
+	}
+	if o.NodesNodesVectorReindexGetHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesVectorReindexGetHandler")
+	}
+	if o.NodesNodesVectorReindexStartHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesVectorReindexStartHandler")
+	}
 	if o.ObjectsObjectsClassDeleteHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsClassDeleteHandler")
 	}
@@ -533,9 +754,54 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaSchemaObjectsGetHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsGetHandler")
 	}
+	if o.SchemaSchemaObjectsHybridTuningFeedbackHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsHybridTuningFeedbackHandler")
+	}
+	if o.SchemaSchemaObjectsHybridTuningGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsHybridTuningGetHandler")
+	}
+	if o.SchemaSchemaObjectsInvertedCleanupHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsInvertedCleanupHandler")
+	}
+	if o.SchemaSchemaObjectsMigrationsCreateHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsMigrationsCreateHandler")
+	}
+	if o.SchemaSchemaObjectsMigrationsListHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsMigrationsListHandler")
+	}
+	if o.SchemaSchemaObjectsOptimizeHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsOptimizeHandler")
+	}
 	if o.SchemaSchemaObjectsPropertiesAddHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesAddHandler")
 	}
+	if o.SchemaSchemaObjectsPropertiesTokenizeHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesTokenizeHandler")
+	}
+	if o.SchemaSchemaObjectsReplayHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsReplayHandler")
+	}
+	if o.SchemaSchemaObjectsRevectorizeCancelHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsRevectorizeCancelHandler")
+	}
+	if o.SchemaSchemaObjectsRevectorizeGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsRevectorizeGetHandler")
+	}
+	if o.SchemaSchemaObjectsRevectorizeStartHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsRevectorizeStartHandler")
+	}
+	if o.SchemaSchemaObjectsShadowCompareHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsShadowCompareHandler")
+	}
+	if o.SchemaSchemaObjectsShadowDeleteHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsShadowDeleteHandler")
+	}
+	if o.SchemaSchemaObjectsShadowGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsShadowGetHandler")
+	}
+	if o.SchemaSchemaObjectsShadowStartHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsShadowStartHandler")
+	}
 	if o.SchemaSchemaObjectsShardsGetHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsShardsGetHandler")
 	}
@@ -545,6 +811,12 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaSchemaObjectsUpdateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsUpdateHandler")
 	}
+	if o.SchemaSchemaTrashListHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaTrashListHandler")
+	}
+	if o.SchemaSchemaTrashRestoreHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaTrashRestoreHandler")
+	}
 	if o.WeaviateRootHandler == nil {
 		unregistered = append(unregistered, "WeaviateRootHandler")
 	}
@@ -682,6 +954,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/batch/objects"] = batch.NewBatchObjectsDelete(o.context, o.BatchBatchObjectsDeleteHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/batch/objects"] = batch.NewBatchObjectsMerge(o.context, o.BatchBatchObjectsMergeHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -702,6 +978,18 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/graphql"] = graphql.NewGraphqlPost(o.context, o.GraphqlGraphqlPostHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/graphql/replay"] = graphql.NewGraphqlReplayCancel(o.context, o.GraphqlGraphqlReplayCancelHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/graphql/replay"] = graphql.NewGraphqlReplayGet(o.context, o.GraphqlGraphqlReplayGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/graphql/replay"] = graphql.NewGraphqlReplayStart(o.context, o.GraphqlGraphqlReplayStartHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -709,10 +997,62 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/nodes/compaction"] = nodes.NewNodesCompactionGet(o.context, o.NodesNodesCompactionGetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/nodes/compaction"] = nodes.NewNodesCompactionUpdate(o.context, o.NodesNodesCompactionUpdateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/nodes"] = nodes.NewNodesGet(o.context, o.NodesNodesGetHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/nodes/members/{name}"] = nodes.NewNodesMembersDelete(o.context, o.NodesNodesMembersDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/nodes/members"] = nodes.NewNodesMembersGet(o.context, o.NodesNodesMembersGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/nodes/members/join"] = nodes.NewNodesMembersJoin(o.context, o.NodesNodesMembersJoinHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/nodes/members/leave"] = nodes.NewNodesMembersLeave(o.context, o.NodesNodesMembersLeaveHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/nodes/network-access/{listener}"] = nodes.NewNodesNetworkAccessGet(o.context, o.NodesNodesNetworkAccessGetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/nodes/network-access/{listener}"] = nodes.NewNodesNetworkAccessUpdate(o.context, o.NodesNodesNetworkAccessUpdateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/nodes/offloads"] = nodes.NewNodesOffloadsGet(o.context, o.NodesNodesOffloadsGetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/nodes/offloads"] = nodes.NewNodesOffloadsUpdate(o.context, o.NodesNodesOffloadsUpdateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/nodes/shard-clones"] = nodes.NewNodesShardClonesCreate(o.context, o.NodesNodesShardClonesCreateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/nodes/vector-reindex/{className}"] = nodes.NewNodesVectorReindexGet(o.context, o.NodesNodesVectorReindexGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/nodes/vector-reindex/{className}"] = nodes.NewNodesVectorReindexStart(o.context, o.NodesNodesVectorReindexStartHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/objects/{className}/{id}"] = objects.NewObjectsClassDelete(o.context, o.ObjectsObjectsClassDeleteHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -805,7 +1145,67 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/schema/{className}/hybrid-tuning/feedback"] = schema.NewSchemaObjectsHybridTuningFeedback(o.context, o.SchemaSchemaObjectsHybridTuningFeedbackHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/hybrid-tuning"] = schema.NewSchemaObjectsHybridTuningGet(o.context, o.SchemaSchemaObjectsHybridTuningGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/inverted-cleanup"] = schema.NewSchemaObjectsInvertedCleanup(o.context, o.SchemaSchemaObjectsInvertedCleanupHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/migrations"] = schema.NewSchemaObjectsMigrationsCreate(o.context, o.SchemaSchemaObjectsMigrationsCreateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/migrations"] = schema.NewSchemaObjectsMigrationsList(o.context, o.SchemaSchemaObjectsMigrationsListHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/optimize"] = schema.NewSchemaObjectsOptimize(o.context, o.SchemaSchemaObjectsOptimizeHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/schema/{className}/properties"] = schema.NewSchemaObjectsPropertiesAdd(o.context, o.SchemaSchemaObjectsPropertiesAddHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/properties/{propertyName}/tokenize"] = schema.NewSchemaObjectsPropertiesTokenize(o.context, o.SchemaSchemaObjectsPropertiesTokenizeHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/replay"] = schema.NewSchemaObjectsReplay(o.context, o.SchemaSchemaObjectsReplayHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/{className}/revectorize"] = schema.NewSchemaObjectsRevectorizeCancel(o.context, o.SchemaSchemaObjectsRevectorizeCancelHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/revectorize"] = schema.NewSchemaObjectsRevectorizeGet(o.context, o.SchemaSchemaObjectsRevectorizeGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/revectorize"] = schema.NewSchemaObjectsRevectorizeStart(o.context, o.SchemaSchemaObjectsRevectorizeStartHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/shadow/compare"] = schema.NewSchemaObjectsShadowCompare(o.context, o.SchemaSchemaObjectsShadowCompareHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/{className}/shadow"] = schema.NewSchemaObjectsShadowDelete(o.context, o.SchemaSchemaObjectsShadowDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/shadow"] = schema.NewSchemaObjectsShadowGet(o.context, o.SchemaSchemaObjectsShadowGetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/schema/{className}/shadow"] = schema.NewSchemaObjectsShadowStart(o.context, o.SchemaSchemaObjectsShadowStartHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -821,6 +1221,14 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/schema/trash"] = schema.NewSchemaTrashList(o.context, o.SchemaSchemaTrashListHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/trash/{id}/restore"] = schema.NewSchemaTrashRestore(o.context, o.SchemaSchemaTrashRestoreHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"][""] = NewWeaviateRoot(o.context, o.WeaviateRootHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -16,6 +16,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/graphql"
 	"github.com/weaviate/weaviate/adapters/repos/classifications"
 	"github.com/weaviate/weaviate/adapters/repos/db"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/anonymous"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/oidc"
@@ -23,13 +24,18 @@ import (
 	"github.com/weaviate/weaviate/usecases/backup"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/drain"
 	"github.com/weaviate/weaviate/usecases/locks"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/network/ipfilter"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/queryreplay"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/scaler"
 	"github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
+	"github.com/weaviate/weaviate/usecases/traverser/hybrid"
 )
 
 // State is the only source of application-wide state
@@ -40,6 +46,7 @@ type State struct {
 	AnonymousAccess       *anonymous.Client
 	APIKey                *apikey.Client
 	Authorizer            authorization.Authorizer
+	NetworkAccess         *ipfilter.Listeners
 	ServerConfig          *config.WeaviateConfig
 	Locks                 locks.ConnectorSchemaLock
 	Logger                *logrus.Logger
@@ -52,10 +59,21 @@ type State struct {
 	RemoteNodeIncoming    *sharding.RemoteNodeIncoming
 	RemoteReplicaIncoming *replica.RemoteReplicaIncoming
 
-	ClassificationRepo *classifications.DistributedRepo
-	Metrics            *monitoring.PrometheusMetrics
-	BackupManager      *backup.Manager
-	DB                 *db.DB
+	ClassificationRepo         *classifications.DistributedRepo
+	Metrics                    *monitoring.PrometheusMetrics
+	BackupManager              *backup.Manager
+	DB                         *db.DB
+	Revectorizer               *objects.Revectorizer
+	Shadows                    *objects.Shadows
+	ReferenceSnapshotRefresher *objects.ReferenceSnapshotRefresher
+	HybridTuner                *hybrid.Tuner
+	CompactionScheduler        *lsmkv.CompactionScheduler
+	// Drainer tracks the in-flight requests of the REST and cluster APIs, so
+	// they complete before the node shuts down
+	Drainer *drain.Drainer
+	// SlowQueryLog is nil unless enabled, QueryReplayer replays its queries
+	SlowQueryLog  *queryreplay.SlowQueryLog
+	QueryReplayer *queryreplay.Replayer
 }
 
 // GetGraphQL is the safe way to retrieve GraphQL from the state as it can be
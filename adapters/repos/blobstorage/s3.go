@@ -0,0 +1,79 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package blobstorage stores offloaded values of blob properties in
+// S3-compatible object storage
+package blobstorage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"path"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/objectstorage"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// S3 implements objects.BlobStore
+type S3 struct {
+	client *minio.Client
+	bucket string
+	path   string
+}
+
+func NewS3(cfg config.BlobStorage) (*S3, error) {
+	client, err := objectstorage.NewS3Client(cfg.Endpoint, cfg.UseSSL)
+	if err != nil {
+		return nil, err
+	}
+	return &S3{client: client, bucket: cfg.Bucket, path: cfg.Path}, nil
+}
+
+func (s *S3) objectName(key string) string {
+	return path.Join(s.path, key)
+}
+
+func (s *S3) Put(ctx context.Context, key string, data []byte) error {
+	_, err := s.client.PutObject(ctx, s.bucket, s.objectName(key),
+		bytes.NewReader(data), int64(len(data)),
+		minio.PutObjectOptions{ContentType: "application/octet-stream"})
+	if err != nil {
+		return errors.Wrapf(err, "put object %q", s.objectName(key))
+	}
+	return nil
+}
+
+// Open returns a reader of the object, which fetches the requested ranges
+// lazily, so seeking doesn't download the skipped bytes
+func (s *S3) Open(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, s.objectName(key), minio.GetObjectOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "get object %q", s.objectName(key))
+	}
+	// the object is only requested on the first read, so a missing object
+	// would only fail there
+	if _, err := obj.Stat(); err != nil {
+		obj.Close()
+		return nil, errors.Wrapf(err, "stat object %q", s.objectName(key))
+	}
+	return obj, nil
+}
+
+func (s *S3) Delete(ctx context.Context, key string) error {
+	err := s.client.RemoveObject(ctx, s.bucket, s.objectName(key), minio.RemoveObjectOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "remove object %q", s.objectName(key))
+	}
+	return nil
+}
@@ -947,6 +947,62 @@ func testNumericalAggregationsWithGrouping(repo *DB, exact bool) func(t *testing
 				actualProps["price"].NumericalAggregations, epsilon*100)
 		})
 
+		t.Run("grouped by a prop of referenced objects", func(t *testing.T) {
+			for _, filter := range []*filters.LocalFilter{nil, sectorEqualsFoodFilter()} {
+				params := aggregation.Params{
+					ClassName: schema.ClassName(companyClass.Class),
+					GroupBy: &filters.Path{
+						Class:    schema.ClassName(companyClass.Class),
+						Property: schema.PropertyName("makesProduct"),
+						Child: &filters.Path{
+							Class:    schema.ClassName(productClass.Class),
+							Property: schema.PropertyName("name"),
+						},
+					},
+					Filters: filter,
+					Properties: []aggregation.ParamProperty{
+						{
+							Name:        schema.PropertyName("price"),
+							Aggregators: []aggregation.Aggregator{aggregation.SumAggregator},
+						},
+					},
+				}
+
+				res, err := repo.Aggregate(context.Background(), params)
+				require.Nil(t, err)
+
+				require.Len(t, res.Groups, 1)
+				assert.Equal(t, 10, res.Groups[0].Count)
+				assert.Equal(t, &aggregation.GroupedBy{
+					Path:  []string{"makesProduct", productClass.Class, "name"},
+					Value: "Superbread",
+				}, res.Groups[0].GroupedBy)
+				assert.InDelta(t, 100.,
+					res.Groups[0].Properties["price"].NumericalAggregations["sum"], epsilon)
+			}
+		})
+
+		t.Run("grouped by a prop of objects two hops away", func(t *testing.T) {
+			params := aggregation.Params{
+				ClassName: schema.ClassName(companyClass.Class),
+				GroupBy: &filters.Path{
+					Class:    schema.ClassName(companyClass.Class),
+					Property: schema.PropertyName("makesProduct"),
+					Child: &filters.Path{
+						Class:    schema.ClassName(productClass.Class),
+						Property: schema.PropertyName("madeBy"),
+						Child: &filters.Path{
+							Class:    schema.ClassName(companyClass.Class),
+							Property: schema.PropertyName("sector"),
+						},
+					},
+				},
+			}
+
+			_, err := repo.Aggregate(context.Background(), params)
+			assert.ErrorContains(t, err, "only supported for one hop")
+		})
+
 		t.Run("with ref filter, grouped by string", func(t *testing.T) {
 			params := aggregation.Params{
 				ClassName: schema.ClassName(companyClass.Class),
@@ -73,7 +73,8 @@ func (a *Aggregator) Do(ctx context.Context) (*aggregation.Result, error) {
 		return newGroupedAggregator(a).Do(ctx)
 	}
 
-	if a.params.Filters != nil || len(a.params.SearchVector) > 0 || a.params.Hybrid != nil {
+	if a.params.Filters != nil || len(a.params.SearchVector) > 0 || a.params.Hybrid != nil ||
+		a.params.Sample != nil {
 		return newFilteredAggregator(a).Do(ctx)
 	}
 
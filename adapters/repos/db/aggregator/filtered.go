@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/querytimeout"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -97,6 +98,10 @@ func (fa *filteredAggregator) hybrid(ctx context.Context) (*aggregation.Result,
 }
 
 func (fa *filteredAggregator) filtered(ctx context.Context) (*aggregation.Result, error) {
+	if fa.params.Sample != nil {
+		return fa.sampled(ctx)
+	}
+
 	var foundIDs []uint64
 
 	allowList, err := fa.buildAllowList(ctx)
@@ -116,6 +121,25 @@ func (fa *filteredAggregator) filtered(ctx context.Context) (*aggregation.Result
 	return fa.prepareResult(ctx, foundIDs)
 }
 
+// sampled aggregates over the shard's part of the sample, which is selected
+// by the max priority of the sample of the whole class
+func (fa *filteredAggregator) sampled(ctx context.Context) (*aggregation.Result, error) {
+	s := fa.getSchema.GetSchemaSkipAuth()
+	docs, err := inverted.NewSearcher(fa.logger, fa.store, s, fa.invertedRowCache,
+		nil, fa.classSearcher, fa.deletedDocIDs, fa.stopwords, fa.shardVersion).
+		SampleDocIDs(ctx, fa.params.Filters, *fa.params.Sample, fa.params.ClassName)
+	if err != nil {
+		return nil, fmt.Errorf("sample doc IDs: %w", err)
+	}
+
+	foundIDs := make([]uint64, len(docs))
+	for i, doc := range docs {
+		foundIDs[i] = doc.DocID
+	}
+
+	return fa.prepareResult(ctx, foundIDs)
+}
+
 func (fa *filteredAggregator) bm25Objects(ctx context.Context, kw *searchparams.KeywordRanking) ([]*storobj.Object, []float32, error) {
 	var (
 		s     = fa.getSchema.GetSchemaSkipAuth()
@@ -164,7 +188,7 @@ func (fa *filteredAggregator) properties(ctx context.Context,
 func (fa *filteredAggregator) analyzeObject(ctx context.Context,
 	properties *models.PropertySchema, propAggs map[string]propAgg,
 ) error {
-	if err := ctx.Err(); err != nil {
+	if err := querytimeout.Err(ctx); err != nil {
 		return err
 	}
 
@@ -15,6 +15,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/docid"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
@@ -45,8 +46,8 @@ func newGrouper(a *Aggregator, limit int) *grouper {
 }
 
 func (g *grouper) Do(ctx context.Context) ([]group, error) {
-	if len(g.params.GroupBy.Slice()) > 1 {
-		return nil, fmt.Errorf("grouping by cross-refs not supported")
+	if err := g.validateGroupBy(); err != nil {
+		return nil, err
 	}
 
 	if g.params.Filters == nil && len(g.params.SearchVector) == 0 && g.params.Hybrid == nil {
@@ -64,7 +65,7 @@ func (g *grouper) groupAll(ctx context.Context) ([]group, error) {
 		return nil, errors.Wrap(err, "group all (unfiltered)")
 	}
 
-	return g.aggregateAndSelect()
+	return g.aggregateAndSelect(ctx)
 }
 
 func (g *grouper) groupFiltered(ctx context.Context) ([]group, error) {
@@ -80,7 +81,7 @@ func (g *grouper) groupFiltered(ctx context.Context) ([]group, error) {
 		return nil, err
 	}
 
-	return g.aggregateAndSelect()
+	return g.aggregateAndSelect(ctx)
 }
 
 func (g *grouper) fetchDocIDs(ctx context.Context) (ids []uint64, err error) {
@@ -164,6 +165,11 @@ func (g *grouper) addElementById(s *models.PropertySchema, docID uint64) error {
 		return nil
 	}
 
+	g.addValue(item, docID)
+	return nil
+}
+
+func (g *grouper) addValue(item interface{}, docID uint64) {
 	switch val := item.(type) {
 	case []string:
 		for i := range val {
@@ -179,6 +185,13 @@ func (g *grouper) addElementById(s *models.PropertySchema, docID uint64) error {
 		}
 	case []interface{}:
 		for i := range val {
+			// references of partially loaded objects are not typed
+			if ref, ok := val[i].(map[string]interface{}); ok {
+				if beacon, ok := ref["beacon"].(string); ok {
+					g.addItem(strfmt.URI(beacon), docID)
+				}
+				continue
+			}
 			g.addItem(val[i], docID)
 		}
 	case models.MultipleRef:
@@ -188,8 +201,6 @@ func (g *grouper) addElementById(s *models.PropertySchema, docID uint64) error {
 	default:
 		g.addItem(val, docID)
 	}
-
-	return nil
 }
 
 func (g *grouper) addItem(item interface{}, docID uint64) {
@@ -201,7 +212,13 @@ func (g *grouper) addItem(item interface{}, docID uint64) {
 	g.values[item] = idsMap
 }
 
-func (g *grouper) aggregateAndSelect() ([]group, error) {
+func (g *grouper) aggregateAndSelect(ctx context.Context) ([]group, error) {
+	if g.groupsByReference() {
+		if err := g.resolveReferences(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	for value, idsMap := range g.values {
 		count := len(idsMap)
 		ids := make([]uint64, count)
@@ -0,0 +1,143 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// refGroupBatchSize is the number of referenced objects which are resolved
+// with a single nested search when grouping by a property of referenced
+// objects. It bounds the memory of the nested searches.
+const refGroupBatchSize = 100
+
+// groupsByReference is true if the groups are formed by a property of
+// the referenced objects, e.g. ["writtenBy", "Author", "country"]
+func (g *grouper) groupsByReference() bool {
+	return g.params.GroupBy.Child != nil
+}
+
+func (g *grouper) validateGroupBy() error {
+	switch len(g.params.GroupBy.Slice()) {
+	case 1:
+		return nil
+	case 3:
+		if g.params.GroupBy.Child.Child == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("grouping by cross-refs is only supported for one hop, " +
+		"i.e. [refProp, TargetClass, targetProp]")
+}
+
+// resolveReferences replaces the groups by beacons which were collected when
+// scanning the reference property with groups by the value of the property
+// of the referenced objects. Objects which reference several objects with
+// the same value count only once for this value.
+func (g *grouper) resolveReferences(ctx context.Context) error {
+	byBeacon := g.values
+	g.values = map[interface{}]map[uint64]struct{}{}
+
+	targetClass := g.params.GroupBy.Child.Class.String()
+	// docIDs of the source objects by the id of the referenced object
+	byTarget := map[strfmt.UUID][]map[uint64]struct{}{}
+	for beacon, docIDs := range byBeacon {
+		ref, err := crossref.Parse(fmt.Sprint(beacon))
+		if err != nil {
+			return fmt.Errorf("parse beacon: %w", err)
+		}
+		if ref.Class != "" && ref.Class != targetClass {
+			// references to other classes of a multi-class reference property
+			continue
+		}
+		byTarget[ref.TargetID] = append(byTarget[ref.TargetID], docIDs)
+	}
+
+	batch := make([]strfmt.UUID, 0, refGroupBatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		res, err := g.fetchReferenced(ctx, targetClass, batch)
+		if err != nil {
+			return fmt.Errorf("resolve references to class %q: %w", targetClass, err)
+		}
+		for _, obj := range res {
+			props, ok := obj.Schema.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			value, ok := props[g.params.GroupBy.Child.Property.String()]
+			if !ok {
+				continue
+			}
+			for _, docIDs := range byTarget[obj.ID] {
+				for docID := range docIDs {
+					g.addValue(value, docID)
+				}
+			}
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	for id := range byTarget {
+		batch = append(batch, id)
+		if len(batch) == refGroupBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return flush()
+}
+
+// fetchReferenced retrieves the referenced objects of a batch by their ids
+func (g *grouper) fetchReferenced(ctx context.Context, className string,
+	ids []strfmt.UUID,
+) ([]search.Result, error) {
+	operands := make([]filters.Clause, len(ids))
+	for i, id := range ids {
+		operands[i] = filters.Clause{
+			Operator: filters.OperatorEqual,
+			On: &filters.Path{
+				Class:    g.params.GroupBy.Child.Class,
+				Property: filters.InternalPropID,
+			},
+			Value: &filters.Value{
+				Value: id.String(),
+				Type:  schema.DataTypeText,
+			},
+		}
+	}
+
+	return g.classSearcher.ClassSearch(ctx, dto.GetParams{
+		ClassName: className,
+		Filters: &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorOr,
+			Operands: operands,
+		}},
+		Pagination: &filters.Pagination{Limit: len(ids)},
+		Properties: search.SelectProperties{{
+			Name:        g.params.GroupBy.Child.Property.String(),
+			IsPrimitive: true,
+		}},
+	})
+}
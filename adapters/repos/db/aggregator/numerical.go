@@ -40,16 +40,10 @@ func addNumericalAggregations(prop *aggregation.Property,
 		return
 	}
 
-	// when combining the results from different shards, we need the raw numbers to recompute the mode, mean and median.
-	// Therefor we add a reference later which needs to be cleared out before returning the results to a user
-loop:
-	for _, aProp := range aggs {
-		switch aProp {
-		case aggregation.ModeAggregator, aggregation.MedianAggregator, aggregation.MeanAggregator:
-			prop.NumericalAggregations["_numericalAggregator"] = agg
-			break loop
-		}
-	}
+	// when combining the results from different shards, we need the raw numbers to recompute the mode and median.
+	// Therefor we add a reference later which needs to be cleared out before returning the results to a user. The
+	// mean only needs the partial count and sum, which are much cheaper to hold and to send across the network
+	addPartialNumericalAggregations(prop, aggs, agg)
 
 	for _, aProp := range aggs {
 		switch aProp {
@@ -73,6 +67,24 @@ loop:
 	}
 }
 
+// addPartialNumericalAggregations attaches the per-shard state the
+// ShardCombiner needs to merge the requested aggregations. The entries are
+// prefixed with an underscore and removed again when the results are
+// finalized.
+func addPartialNumericalAggregations(prop *aggregation.Property,
+	aggs []aggregation.Aggregator, agg *numericalAggregator,
+) {
+	for _, aProp := range aggs {
+		switch aProp {
+		case aggregation.ModeAggregator, aggregation.MedianAggregator:
+			prop.NumericalAggregations["_numericalAggregator"] = agg
+		case aggregation.MeanAggregator:
+			prop.NumericalAggregations["_count"] = agg.Count()
+			prop.NumericalAggregations["_sum"] = agg.Sum()
+		}
+	}
+}
+
 func newNumericalAggregator() *numericalAggregator {
 	return &numericalAggregator{
 		min:          math.MaxFloat64,
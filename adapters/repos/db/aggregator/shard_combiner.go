@@ -205,7 +205,7 @@ func (sc *ShardCombiner) mergeNumericalProp(first, second map[string]interface{}
 		return
 	}
 
-	// add all values from the second map to the first one. This is needed to compute median, mean and mode correctly
+	// add all values from the second map to the first one. This is needed to compute median and mode correctly
 	for propType := range second {
 		switch propType {
 		case "_numericalAggregator":
@@ -213,15 +213,20 @@ func (sc *ShardCombiner) mergeNumericalProp(first, second map[string]interface{}
 			if numAggFirst, ok := first[propType]; ok {
 				numAggFirstTyped := numAggFirst.(*numericalAggregator)
 				for _, pair := range numAggSecondTyped.pairs {
-					for i := uint64(0); i < pair.count; i++ {
-						numAggFirstTyped.AddFloat64(pair.value)
-					}
+					numAggFirstTyped.AddNumberRow(pair.value, pair.count)
 				}
 				numAggFirstTyped.buildPairsFromCounts()
 				first[propType] = numAggFirstTyped
 			} else {
 				first[propType] = second[propType]
 			}
+		case "_count", "_sum":
+			// partial state for the mean, merged before the mean is recomputed
+			if val, ok := first[propType]; ok {
+				first[propType] = val.(float64) + second[propType].(float64)
+			} else {
+				first[propType] = second[propType]
+			}
 		}
 	}
 
@@ -237,8 +242,9 @@ func (sc *ShardCombiner) mergeNumericalProp(first, second map[string]interface{}
 			numAggFirst := first["_numericalAggregator"].(*numericalAggregator)
 			first[propType] = numAggFirst.Mode()
 		case "mean":
-			numAggFirst := first["_numericalAggregator"].(*numericalAggregator)
-			first[propType] = numAggFirst.Mean()
+			if count := first["_count"].(float64); count > 0 {
+				first[propType] = first["_sum"].(float64) / count
+			}
 		case "median":
 			numAggFirst := first["_numericalAggregator"].(*numericalAggregator)
 			first[propType] = numAggFirst.Median()
@@ -250,7 +256,7 @@ func (sc *ShardCombiner) mergeNumericalProp(first, second map[string]interface{}
 			if _, ok := first["maximum"]; !ok || value.(float64) > first["maximum"].(float64) {
 				first["maximum"] = value
 			}
-		case "_numericalAggregator":
+		case "_numericalAggregator", "_count", "_sum":
 			continue
 		default:
 			panic("unknown map entry: " + propType)
@@ -264,6 +270,8 @@ func (sc *ShardCombiner) finalizeDateProp(combined map[string]interface{}) {
 
 func (sc *ShardCombiner) finalizeNumerical(combined map[string]interface{}) {
 	delete(combined, "_numericalAggregator")
+	delete(combined, "_count")
+	delete(combined, "_sum")
 }
 
 func (sc *ShardCombiner) mergeBooleanProp(combined, source *aggregation.Boolean) {
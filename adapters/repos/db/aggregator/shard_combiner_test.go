@@ -12,12 +12,14 @@
 package aggregator
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/aggregation"
 )
 
@@ -211,6 +213,60 @@ func TestShardCombinerMergeNil(t *testing.T) {
 	}
 }
 
+func TestShardCombinerMergeGroupedPartialNumerical(t *testing.T) {
+	aggs := []aggregation.Aggregator{
+		aggregation.CountAggregator, aggregation.SumAggregator, aggregation.MinimumAggregator,
+		aggregation.MaximumAggregator, aggregation.MeanAggregator,
+	}
+
+	shardResult := func(groups map[string][]float64) *aggregation.Result {
+		res := &aggregation.Result{}
+		for value, numbers := range groups {
+			agg := newNumericalAggregator()
+			for _, num := range numbers {
+				agg.AddFloat64(num)
+			}
+			prop := aggregation.Property{Type: aggregation.PropertyTypeNumerical}
+			addNumericalAggregations(&prop, aggs, agg)
+			_, ok := prop.NumericalAggregations["_numericalAggregator"]
+			assert.False(t, ok, "no raw values are needed without median or mode")
+
+			res.Groups = append(res.Groups, aggregation.Group{
+				Count:      len(numbers),
+				GroupedBy:  &aggregation.GroupedBy{Value: value, Path: []string{"category"}},
+				Properties: map[string]aggregation.Property{"price": prop},
+			})
+		}
+
+		// simulate a result received from a remote shard
+		bytes, err := json.Marshal(res)
+		require.Nil(t, err)
+		var out aggregation.Result
+		require.Nil(t, json.Unmarshal(bytes, &out))
+		return &out
+	}
+
+	results := []*aggregation.Result{
+		shardResult(map[string][]float64{"a": {1, 2, 3}, "b": {10}}),
+		shardResult(map[string][]float64{"a": {4, 5}, "c": {7, 9}}),
+		shardResult(map[string][]float64{"b": {20, 30}}),
+	}
+
+	combined := NewShardCombiner().Do(results)
+	require.Len(t, combined.Groups, 3)
+
+	expected := map[string]map[string]interface{}{
+		"a": {"count": 5.0, "sum": 15.0, "minimum": 1.0, "maximum": 5.0, "mean": 3.0},
+		"b": {"count": 3.0, "sum": 60.0, "minimum": 10.0, "maximum": 30.0, "mean": 20.0},
+		"c": {"count": 2.0, "sum": 16.0, "minimum": 7.0, "maximum": 9.0, "mean": 8.0},
+	}
+	for _, group := range combined.Groups {
+		assert.Equal(t, expected[group.GroupedBy.Value.(string)],
+			group.Properties["price"].NumericalAggregations)
+	}
+	assert.Equal(t, "a", combined.Groups[0].GroupedBy.Value)
+}
+
 func testNumbers(t *testing.T, numbers1, numbers2 []float64, testMode bool) {
 	sc := NewShardCombiner()
 	numberMap1 := createNumericalAgg(numbers1)
@@ -18,6 +18,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/querytimeout"
 	"github.com/weaviate/weaviate/entities/schema"
 )
 
@@ -83,7 +84,7 @@ func (ua unfilteredAggregator) properties(
 	out := map[string]aggregation.Property{}
 
 	for _, prop := range ua.params.Properties {
-		if err := ctx.Err(); err != nil {
+		if err := querytimeout.Err(ctx); err != nil {
 			return nil, errors.Wrapf(err, "start property %s", prop.Name)
 		}
 
@@ -20,6 +20,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/querytimeout"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
@@ -60,6 +61,10 @@ func (ua unfilteredAggregator) parseBoolProp(ctx context.Context,
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if err := querytimeout.Err(ctx); err != nil {
+				return nil, err
+			}
+
 			err := parseFnRoaringSet(agg, k, v)
 			if err != nil {
 				return nil, err
@@ -70,6 +75,10 @@ func (ua unfilteredAggregator) parseBoolProp(ctx context.Context,
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if err := querytimeout.Err(ctx); err != nil {
+				return nil, err
+			}
+
 			err := parseFnSet(agg, k, v)
 			if err != nil {
 				return nil, err
@@ -161,6 +170,10 @@ func (ua unfilteredAggregator) floatProperty(ctx context.Context,
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if err := querytimeout.Err(ctx); err != nil {
+				return nil, err
+			}
+
 			if err := ua.parseAndAddFloatRowRoaringSet(agg, k, v); err != nil {
 				return nil, err
 			}
@@ -170,6 +183,10 @@ func (ua unfilteredAggregator) floatProperty(ctx context.Context,
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if err := querytimeout.Err(ctx); err != nil {
+				return nil, err
+			}
+
 			if err := ua.parseAndAddFloatRowSet(agg, k, v); err != nil {
 				return nil, err
 			}
@@ -202,6 +219,10 @@ func (ua unfilteredAggregator) intProperty(ctx context.Context,
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if err := querytimeout.Err(ctx); err != nil {
+				return nil, err
+			}
+
 			if err := ua.parseAndAddIntRowRoaringSet(agg, k, v); err != nil {
 				return nil, err
 			}
@@ -212,6 +233,10 @@ func (ua unfilteredAggregator) intProperty(ctx context.Context,
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if err := querytimeout.Err(ctx); err != nil {
+				return nil, err
+			}
+
 			if err := ua.parseAndAddIntRowSet(agg, k, v); err != nil {
 				return nil, err
 			}
@@ -244,6 +269,10 @@ func (ua unfilteredAggregator) dateProperty(ctx context.Context,
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if err := querytimeout.Err(ctx); err != nil {
+				return nil, err
+			}
+
 			if err := ua.parseAndAddDateRowRoaringSet(agg, k, v); err != nil {
 				return nil, err
 			}
@@ -253,6 +282,10 @@ func (ua unfilteredAggregator) dateProperty(ctx context.Context,
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if err := querytimeout.Err(ctx); err != nil {
+				return nil, err
+			}
+
 			if err := ua.parseAndAddDateRowSet(agg, k, v); err != nil {
 				return nil, err
 			}
@@ -315,6 +348,10 @@ func (ua unfilteredAggregator) dateArrayProperty(ctx context.Context,
 	defer c.Close()
 
 	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := querytimeout.Err(ctx); err != nil {
+			return nil, err
+		}
+
 		if err := ua.parseAndAddDateArrayRow(agg, v, prop.Name); err != nil {
 			return nil, err
 		}
@@ -459,6 +496,10 @@ func (ua unfilteredAggregator) textProperty(ctx context.Context,
 	defer c.Close()
 
 	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := querytimeout.Err(ctx); err != nil {
+			return nil, err
+		}
+
 		if err := ua.parseAndAddTextRow(agg, v, prop.Name); err != nil {
 			return nil, err
 		}
@@ -488,6 +529,10 @@ func (ua unfilteredAggregator) numberArrayProperty(ctx context.Context,
 	defer c.Close()
 
 	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := querytimeout.Err(ctx); err != nil {
+			return nil, err
+		}
+
 		if err := ua.parseAndAddNumberArrayRow(agg, v, prop.Name); err != nil {
 			return nil, err
 		}
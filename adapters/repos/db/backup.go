@@ -17,6 +17,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/changes"
 	"github.com/weaviate/weaviate/entities/schema"
 	"golang.org/x/sync/errgroup"
 )
@@ -39,13 +40,20 @@ func (db *DB) Backupable(ctx context.Context, classes []string) error {
 
 // ListBackupable returns a list of all classes which can be backed up.
 func (db *DB) ListBackupable() []string {
-	cs := make([]string, 0, len(db.indices))
+	db.loadLazyIndexes()
 	db.indexLock.RLock()
+	cs := make([]string, 0, len(db.indices))
 	defer db.indexLock.RUnlock()
 	for _, idx := range db.indices {
 		cls := string(idx.Config.ClassName)
 		cs = append(cs, cls)
 	}
+	// offloaded classes are activated when they are backed up
+	for _, lazy := range db.lazyIndexes {
+		if lazy.offloaded.Load() {
+			cs = append(cs, lazy.className)
+		}
+	}
 	return cs
 }
 
@@ -103,11 +111,16 @@ func (db *DB) ShardsBackup(
 		sm[shardName] = shard
 	}
 	for shardName, shard := range sm {
+		// all writes before the horizon are visible and therefore part of the
+		// flushed memtables
+		sd := backup.ShardDescriptor{
+			Name:         shardName,
+			ChangesToken: changes.FormatToken(shard.changesHorizon()),
+		}
 		if err := shard.beginBackup(ctx); err != nil {
 			return cd, fmt.Errorf("class %q: shard %q: begin backup: %w", class, shardName, err)
 		}
 
-		sd := backup.ShardDescriptor{Name: shardName}
 		if err := shard.listBackupFiles(ctx, &sd); err != nil {
 			return cd, fmt.Errorf("class %q: shard %q: list backup files: %w", class, shardName, err)
 		}
@@ -118,6 +131,18 @@ func (db *DB) ShardsBackup(
 	return cd, nil
 }
 
+// ChangesSince returns the changes of a local shard starting at the token, see
+// Shard.changesSince
+func (db *DB) ChangesSince(ctx context.Context, class, shard, token string,
+	limit int,
+) (*changes.Changes, error) {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return nil, fmt.Errorf("no index for class %q", class)
+	}
+	return idx.IncomingChangesSince(ctx, shard, token, limit)
+}
+
 // ReleaseBackup release resources acquired by the index during backup
 func (db *DB) ReleaseBackup(ctx context.Context, bakID, class string) error {
 	idx := db.GetIndex(schema.ClassName(class))
@@ -215,6 +240,14 @@ func (i *Index) initBackup(id string) error {
 				"means its contents have not yet been fully copied to its destination, "+
 				"try again later", i.backupState.BackupID)
 	}
+	if i.optimizing {
+		return errors.Errorf("cannot create new backup while index %q is being "+
+			"optimized, try again later", i.Config.ClassName)
+	}
+	if i.reindexing {
+		return errors.Errorf("cannot create new backup while the vector index "+
+			"of %q is rebuilt, try again later", i.Config.ClassName)
+	}
 
 	i.backupState = BackupState{
 		BackupID:   id,
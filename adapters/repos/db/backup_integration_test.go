@@ -27,6 +27,7 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -93,8 +94,12 @@ func TestBackup_DBLevel(t *testing.T) {
 					assert.Equal(t, expectedShardName, shd.Name)
 					assert.Equal(t, expectedNodeName, shd.Node)
 					assert.NotEmpty(t, shd.Files)
+					assert.NotEmpty(t, shd.Generations)
 					for _, f := range shd.Files {
 						assert.NotEmpty(t, f)
+						if backup.IsSegmentFile(f) {
+							assert.Contains(t, shd.Generations, f)
+						}
 					}
 					assert.Equal(t, expectedCounterPath, shd.DocIDCounterPath)
 					assert.Equal(t, expectedCounter, shd.DocIDCounter)
@@ -13,6 +13,7 @@ package db
 
 import (
 	"context"
+	"math"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/additional"
@@ -29,7 +30,18 @@ type batchQueue struct {
 func (db *DB) BatchPutObjects(ctx context.Context, objects objects.BatchObjects,
 	repl *additional.ReplicationProperties,
 ) (objects.BatchObjects, error) {
+	release, err := db.workerPools.acquireBatch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "wait for batch worker")
+	}
+	defer release()
+
 	byIndex := map[string]batchQueue{}
+	for _, item := range objects {
+		// make sure lazy indexes are loaded
+		db.GetIndex(schema.ClassName(item.Object.Class))
+	}
+
 	db.indexLock.RLock()
 	defer db.indexLock.RUnlock()
 
@@ -53,7 +65,7 @@ func (db *DB) BatchPutObjects(ctx context.Context, objects objects.BatchObjects,
 	}
 
 	for indexID, queue := range byIndex {
-		errs := db.indices[indexID].putObjectBatch(ctx, queue.objects, repl)
+		errs := db.indices[indexID].putIngestBatch(ctx, queue.objects, repl)
 		for index, err := range errs {
 			if err != nil {
 				objects[queue.originalIndex[index]].Err = err
@@ -67,7 +79,18 @@ func (db *DB) BatchPutObjects(ctx context.Context, objects objects.BatchObjects,
 func (db *DB) AddBatchReferences(ctx context.Context, references objects.BatchReferences,
 	repl *additional.ReplicationProperties,
 ) (objects.BatchReferences, error) {
+	release, err := db.workerPools.acquireBatch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "wait for batch worker")
+	}
+	defer release()
+
 	byIndex := map[string]objects.BatchReferences{}
+	for _, item := range references {
+		// make sure lazy indexes are loaded
+		db.GetIndex(item.From.Class)
+	}
+
 	db.indexLock.RLock()
 	defer db.indexLock.RUnlock()
 	for _, item := range references {
@@ -102,6 +125,12 @@ func (db *DB) AddBatchReferences(ctx context.Context, references objects.BatchRe
 func (db *DB) BatchDeleteObjects(ctx context.Context, params objects.BatchDeleteParams,
 	repl *additional.ReplicationProperties,
 ) (objects.BatchDeleteResult, error) {
+	release, err := db.workerPools.acquireBatch(ctx)
+	if err != nil {
+		return objects.BatchDeleteResult{}, errors.Wrap(err, "wait for batch worker")
+	}
+	defer release()
+
 	// get index for a given class
 	idx := db.GetIndex(params.ClassName)
 	// find all DocIDs in all shards that match the filter
@@ -139,3 +168,14 @@ func (db *DB) BatchDeleteObjects(ctx context.Context, params objects.BatchDelete
 	}
 	return result, nil
 }
+
+// BatchLoad reports the number of objects which wait for the workers which
+// add them to the vector and inverted indexes, as well as the memory usage
+// of the last resource scan
+func (db *DB) BatchLoad() objects.BatchLoad {
+	return objects.BatchLoad{
+		QueueDepth:    len(db.jobQueueCh),
+		QueueCapacity: cap(db.jobQueueCh),
+		MemoryRatio:   math.Float64frombits(db.memoryRatio.Load()),
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"strings"
 	"testing"
 	"time"
 
@@ -30,6 +31,8 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/tokenizer"
 	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
@@ -313,6 +316,38 @@ func TestBM25FJourney(t *testing.T) {
 		require.Equal(t, uint64(0), res[3].DocID())
 	})
 
+	t.Run("bm25f journey boosted by the class config", func(t *testing.T) {
+		search := func(props ...string) ([]*storobj.Object, []float32) {
+			kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: props, Query: "journey"}
+			res, scores, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, addit, nil)
+			require.Nil(t, err)
+			return res, scores
+		}
+		requireSameResults := func(expected, actual []*storobj.Object, expectedScores, actualScores []float32) {
+			require.Len(t, actual, len(expected))
+			for i := range expected {
+				require.Equal(t, expected[i].DocID(), actual[i].DocID())
+				EqualFloats(t, expectedScores[i], actualScores[i], 5)
+			}
+		}
+
+		unboosted, unboostedScores := search("title", "description")
+		queryBoosted, queryBoostedScores := search("title^3", "description")
+
+		initial := idx.getInvertedIndexConfig()
+		updated := initial
+		updated.BM25.PropertyBoosts = map[string]float32{"title": 3}
+		require.Nil(t, idx.updateInvertedIndexConfig(context.TODO(), updated))
+		defer idx.updateInvertedIndexConfig(context.TODO(), initial)
+
+		configBoosted, configBoostedScores := search("title", "description")
+		requireSameResults(queryBoosted, configBoosted, queryBoostedScores, configBoostedScores)
+
+		// a boost in the query takes precedence over the class config
+		overridden, overriddenScores := search("title^1", "description")
+		requireSameResults(unboosted, overridden, unboostedScores, overriddenScores)
+	})
+
 	t.Run("Check search with two terms", func(t *testing.T) {
 		kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"title", "description"}, Query: "journey somewhere"}
 		res, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, addit, nil)
@@ -953,3 +988,397 @@ func TestBM25F_SortMultiProp(t *testing.T) {
 		require.Equal(t, uint64(1), res[0].DocID())
 	})
 }
+
+func TestBM25FBoostProperty(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	invertedConfig := BM25FinvertedConfig(1.2, 0.75, "none")
+	invertedConfig.BoostProperty = "popularity"
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig,
+		Class:               "BoostClass",
+		Properties: []*models.Property{
+			{
+				Name:         "description",
+				DataType:     []string{string(schema.DataTypeText)},
+				Tokenization: "word",
+			},
+			{
+				Name:     "popularity",
+				DataType: []string{string(schema.DataTypeNumber)},
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	testData := []map[string]interface{}{
+		{"description": "journey journey journey", "popularity": 1.0},
+		{"description": "journey", "popularity": 10.0},
+		{"description": "journey"},
+		{"description": "unrelated", "popularity": 100.0},
+	}
+	for i, data := range testData {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+		obj := &models.Object{Class: class.Class, ID: id, Properties: data}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}, nil))
+	}
+
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+
+	kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"description"}, Query: "journey"}
+
+	t.Run("boosted objects are ranked higher", func(t *testing.T) {
+		res, scores, err := idx.objectSearch(context.TODO(), 3, nil, kwr, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+		assert.Equal(t, uint64(1), res[0].DocID())
+		assert.Equal(t, uint64(0), res[1].DocID())
+		assert.Equal(t, uint64(2), res[2].DocID())
+		assert.InDelta(t, 10*scores[2], scores[0], 1e-5)
+	})
+
+	t.Run("candidates beyond the limit are boosted", func(t *testing.T) {
+		res, _, err := idx.objectSearch(context.TODO(), 1, nil, kwr, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, uint64(1), res[0].DocID())
+	})
+}
+
+func TestBM25FCustomTokenizer(t *testing.T) {
+	// citations must be matched as a whole, the word tokenization would
+	// match any citation of the same title
+	err := tokenizer.Register("bm25-citation", tokenizer.Func(func(value string) []string {
+		return strings.Split(value, "; ")
+	}))
+	require.Nil(t, err)
+
+	dirName := t.TempDir()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: BM25FinvertedConfig(1.2, 0.75, "none"),
+		Class:               "Ruling",
+		Properties: []*models.Property{
+			{
+				Name:          "citations",
+				DataType:      []string{string(schema.DataTypeText)},
+				Tokenization:  "bm25-citation",
+				IndexInverted: truePointer(),
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+	require.Nil(t, NewMigrator(repo, logger).AddClass(context.Background(), class, schemaGetter.shardState))
+
+	for i, citations := range []string{
+		"42 U.S.C. § 1983; 18 U.S.C. § 242",
+		"42 U.S.C. § 1985",
+		"18 U.S.C. § 242",
+	} {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+		obj := &models.Object{Class: "Ruling", ID: id, Properties: map[string]interface{}{
+			"citations": citations,
+		}}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+	}
+
+	idx := repo.GetIndex("Ruling")
+	require.NotNil(t, idx)
+
+	t.Run("bm25", func(t *testing.T) {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"citations"}, Query: "42 U.S.C. § 1983"}
+		res, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, uint64(0), res[0].DocID())
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		filter := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: "Ruling", Property: "citations"},
+			Value:    &filters.Value{Value: "18 U.S.C. § 242", Type: schema.DataTypeText},
+		}}
+		res, _, err := idx.objectSearch(context.TODO(), 1000, filter, nil, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.ElementsMatch(t, []uint64{0, 2}, []uint64{res[0].DocID(), res[1].DocID()})
+	})
+}
+
+func TestBM25FCJKTokenizer(t *testing.T) {
+	dirName := t.TempDir()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: BM25FinvertedConfig(1.2, 0.75, "none"),
+		Class:               "Poem",
+		Properties: []*models.Property{
+			{
+				Name:          "text",
+				DataType:      []string{string(schema.DataTypeText)},
+				Tokenization:  tokenizer.CJKName,
+				IndexInverted: truePointer(),
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+	require.Nil(t, NewMigrator(repo, logger).AddClass(context.Background(), class, schemaGetter.shardState))
+
+	for i, text := range []string{
+		"床前明月光，疑是地上霜。",
+		"春眠不覚暁、処処啼鳥を聞く",
+		"東京の月はきれいです",
+	} {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+		obj := &models.Object{Class: "Poem", ID: id, Properties: map[string]interface{}{
+			"text": text,
+		}}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+	}
+
+	idx := repo.GetIndex("Poem")
+	require.NotNil(t, idx)
+
+	t.Run("bm25", func(t *testing.T) {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"text"}, Query: "明月"}
+		res, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, uint64(0), res[0].DocID())
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		filter := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: "Poem", Property: "text"},
+			Value:    &filters.Value{Value: "東京", Type: schema.DataTypeText},
+		}}
+		res, _, err := idx.objectSearch(context.TODO(), 1000, filter, nil, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, uint64(2), res[0].DocID())
+	})
+}
+
+func TestBM25FLanguageDetection(t *testing.T) {
+	dirName := t.TempDir()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: BM25FinvertedConfig(1.2, 0.75, "none"),
+		Class:               "Article",
+		Properties: []*models.Property{
+			{
+				Name:              "text",
+				DataType:          []string{string(schema.DataTypeText)},
+				Tokenization:      models.PropertyTokenizationWord,
+				IndexInverted:     truePointer(),
+				LanguageDetection: true,
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+	require.Nil(t, NewMigrator(repo, logger).AddClass(context.Background(), class, schemaGetter.shardState))
+
+	for i, text := range []string{
+		"東京の月はきれいです",
+		"Der Mond über der Stadt und die Sterne",
+		"The moon over the city and the stars",
+		"Le chat et les chiens de la voisine sont dans le jardin",
+	} {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+		obj := &models.Object{Class: "Article", ID: id, Properties: map[string]interface{}{
+			"text": text,
+		}}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+	}
+
+	idx := repo.GetIndex("Article")
+	require.NotNil(t, idx)
+
+	t.Run("cjk values are searched by bigrams", func(t *testing.T) {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"text"}, Query: "東京"}
+		res, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, uint64(0), res[0].DocID())
+	})
+
+	t.Run("stopwords of the query language are removed", func(t *testing.T) {
+		// "der" and "die" are German stopwords, only "mond" is searched
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"text"}, Query: "der Mond und die"}
+		res, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, uint64(1), res[0].DocID())
+	})
+
+	t.Run("filter by language", func(t *testing.T) {
+		for lang, docID := range map[string]uint64{"ja": 0, "de": 1, "en": 2, "fr": 3} {
+			filter := &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On:       &filters.Path{Class: "Article", Property: filters.InternalPropLanguage},
+				Value:    &filters.Value{Value: lang, Type: schema.DataTypeText},
+			}}
+			res, _, err := idx.objectSearch(context.TODO(), 1000, filter, nil, nil, nil, additional.Properties{}, nil)
+			require.Nil(t, err)
+			require.Len(t, res, 1, lang)
+			assert.Equal(t, docID, res[0].DocID(), lang)
+		}
+	})
+
+	t.Run("deleted objects are removed from the language index", func(t *testing.T) {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", 3)).String())
+		require.Nil(t, repo.DeleteObject(context.Background(), "Article", id, nil))
+
+		filter := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: "Article", Property: filters.InternalPropLanguage},
+			Value:    &filters.Value{Value: "fr", Type: schema.DataTypeText},
+		}}
+		res, _, err := idx.objectSearch(context.TODO(), 1000, filter, nil, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		assert.Len(t, res, 0)
+	})
+}
+
+func TestBM25FImpactPruning(t *testing.T) {
+	dirName := t.TempDir()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: BM25FinvertedConfig(1.2, 0.75, "none"),
+		Class:               "Article",
+		Properties: []*models.Property{
+			{
+				Name:                 "text",
+				DataType:             []string{string(schema.DataTypeText)},
+				Tokenization:         models.PropertyTokenizationWord,
+				IndexInverted:        truePointer(),
+				Bm25PruningThreshold: 0.3,
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+	require.Nil(t, NewMigrator(repo, logger).AddClass(context.Background(), class, schemaGetter.shardState))
+
+	verbose := []string{"rare"}
+	for i := 0; i < 10; i++ {
+		verbose = append(verbose, "common", fmt.Sprintf("filler%d", i), fmt.Sprintf("padding%d", i))
+	}
+	for i, text := range []string{
+		"alpha beta",
+		"alpha gamma",
+		strings.Join(verbose, " "),
+	} {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+		obj := &models.Object{Class: "Article", ID: id, Properties: map[string]interface{}{
+			"text": text,
+		}}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+	}
+
+	idx := repo.GetIndex("Article")
+	require.NotNil(t, idx)
+
+	search := func(t *testing.T, query string) []uint64 {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"text"}, Query: query}
+		res, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		docIDs := make([]uint64, len(res))
+		for i := range res {
+			docIDs[i] = res[i].DocID()
+		}
+		return docIDs
+	}
+
+	t.Run("terms of short values are kept", func(t *testing.T) {
+		assert.ElementsMatch(t, []uint64{0, 1}, search(t, "alpha"))
+	})
+
+	t.Run("frequent terms of verbose values are kept", func(t *testing.T) {
+		assert.ElementsMatch(t, []uint64{2}, search(t, "common"))
+	})
+
+	t.Run("terms with a low impact are pruned", func(t *testing.T) {
+		assert.Empty(t, search(t, "rare"))
+
+		filter := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: "Article", Property: "text"},
+			Value:    &filters.Value{Value: "rare", Type: schema.DataTypeText},
+		}}
+		res, _, err := idx.objectSearch(context.TODO(), 1000, filter, nil, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		assert.Len(t, res, 0)
+	})
+}
@@ -0,0 +1,49 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// boostCandidateFactor is the number of candidates per result which are
+// retrieved from each shard when BM25 scores are boosted. The shards rank by
+// the plain BM25 score, so objects with a high boost but a lower score are
+// only found if they are among the candidates.
+const boostCandidateFactor = 4
+
+// bm25Boost returns the boost config of the class if it applies to the
+// keyword ranking. Explicit sorting replaces the ranking, so it isn't boosted.
+func (i *Index) bm25Boost(keywordRanking *searchparams.KeywordRanking,
+	sort []filters.Sort,
+) (schema.BoostConfig, bool) {
+	if keywordRanking == nil || keywordRanking.Type != "bm25" || len(sort) > 0 {
+		return schema.BoostConfig{}, false
+	}
+	boost := i.getInvertedIndexConfig().Boost
+	return boost, boost.BoostsBM25()
+}
+
+// boostScores multiplies the scores with the boost of their objects
+func boostScores(objects []*storobj.Object, scores []float32,
+	boost schema.BoostConfig,
+) {
+	if len(objects) != len(scores) {
+		return
+	}
+	for i, obj := range objects {
+		scores[i] *= float32(boost.Boost(obj.Properties()))
+	}
+}
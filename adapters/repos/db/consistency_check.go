@@ -0,0 +1,283 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+// consistencyRecheckDelay is the time after which objects whose replicas
+// differ are compared a second time. Differences caused by writes which
+// were in flight during the first comparison are not reported.
+var consistencyRecheckDelay = time.Second
+
+// CheckConsistencyPeriodically compares the replicas of the local shards
+// every interval until ctx is cancelled.
+func (db *DB) CheckConsistencyPeriodically(ctx context.Context,
+	interval time.Duration, sampleSize int,
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := db.CheckConsistency(ctx, sampleSize); err != nil {
+				db.logger.WithField("action", "replica_consistency_check").
+					WithError(err).Error("could not check consistency of replicas")
+			}
+		}
+	}
+}
+
+// CheckConsistency compares the object counts and the digests of up to
+// sampleSize random objects of all replicas of the replicated shards which
+// this node is the first owner of. The reports are exposed through the
+// nodes API and as metrics.
+func (db *DB) CheckConsistency(ctx context.Context, sampleSize int) error {
+	thisNode := db.schemaGetter.NodeName()
+
+	db.indexLock.RLock()
+	type target struct {
+		shard *Shard
+		nodes []string
+	}
+	targets := []target{}
+	for _, index := range db.indices {
+		state := db.schemaGetter.ShardingState(index.Config.ClassName.String())
+		for name, shard := range index.Shards {
+			physical, ok := state.Physical[name]
+			if !ok || len(physical.BelongsToNodes) < 2 ||
+				physical.BelongsToNodes[0] != thisNode {
+				continue
+			}
+			targets = append(targets, target{shard: shard, nodes: physical.BelongsToNodes})
+		}
+	}
+	db.indexLock.RUnlock()
+
+	// the status of each remote node is fetched at most once per run
+	statuses := map[string]*models.NodeStatus{}
+	for _, t := range targets {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		for _, node := range t.nodes[1:] {
+			if _, ok := statuses[node]; ok {
+				continue
+			}
+			status, err := db.remoteNode.GetNodeStatus(ctx, node)
+			if err != nil {
+				db.logger.WithField("action", "replica_consistency_check").
+					WithField("node", node).WithError(err).
+					Warn("could not get node status")
+			}
+			statuses[node] = status
+		}
+
+		report := db.checkShardConsistency(ctx, t.shard, t.nodes, statuses, sampleSize)
+		db.storeConsistencyReport(t.shard, report)
+	}
+	return nil
+}
+
+func (db *DB) checkShardConsistency(ctx context.Context, shard *Shard,
+	nodes []string, statuses map[string]*models.NodeStatus, sampleSize int,
+) *models.ShardConsistencyReport {
+	className := shard.index.Config.ClassName.String()
+	report := &models.ShardConsistencyReport{
+		CheckedAt:           time.Now().UnixMilli(),
+		ReplicaObjectCounts: map[string]int64{nodes[0]: int64(shard.objectCount())},
+		UnreachableReplicas: []string{},
+	}
+
+	hosts := map[string]string{}
+	for _, node := range nodes[1:] {
+		count, ok := remoteObjectCount(statuses[node], className, shard.name)
+		host, resolved := db.nodeResolver.NodeHostname(node)
+		if !ok || !resolved {
+			report.UnreachableReplicas = append(report.UnreachableReplicas, node)
+			continue
+		}
+		report.ReplicaObjectCounts[node] = count
+		hosts[node] = host
+	}
+
+	ids := shard.sampleObjectIDs(sampleSize)
+	report.SampledObjects = int64(len(ids))
+	if len(ids) > 0 {
+		mismatched := db.compareReplicas(ctx, shard, hosts, ids, report)
+		if len(mismatched) > 0 {
+			time.Sleep(consistencyRecheckDelay)
+			mismatched = db.compareReplicas(ctx, shard, hosts, mismatched, report)
+		}
+		report.MismatchedObjects = int64(len(mismatched))
+	}
+
+	sort.Strings(report.UnreachableReplicas)
+	report.Consistent = len(report.UnreachableReplicas) == 0 &&
+		report.MismatchedObjects == 0 &&
+		objectCountDifference(report.ReplicaObjectCounts) == 0
+	return report
+}
+
+// compareReplicas returns the ids on which a remote replica disagrees with
+// the local one. Replicas which cannot be reached are removed from hosts
+// and added to the unreachable replicas of the report.
+func (db *DB) compareReplicas(ctx context.Context, shard *Shard,
+	hosts map[string]string, ids []strfmt.UUID, report *models.ShardConsistencyReport,
+) []strfmt.UUID {
+	className := shard.index.Config.ClassName.String()
+	local, err := shard.index.digestObjects(ctx, shard.name, ids)
+	if err != nil {
+		db.logger.WithField("action", "replica_consistency_check").
+			WithField("class", className).WithField("shard", shard.name).
+			WithError(err).Warn("could not digest local objects")
+		return nil
+	}
+
+	remote := make([][]replica.RepairResponse, 0, len(hosts))
+	for node, host := range hosts {
+		digests, err := db.replicaClient.DigestObjects(ctx, host, className, shard.name, ids)
+		if err != nil || len(digests) != len(ids) {
+			report.UnreachableReplicas = append(report.UnreachableReplicas, node)
+			delete(hosts, node)
+			continue
+		}
+		remote = append(remote, digests)
+	}
+
+	return diffDigests(ids, local, remote)
+}
+
+// diffDigests returns the ids of the objects for which any remote digest
+// differs from the local one
+func diffDigests(ids []strfmt.UUID, local []replica.RepairResponse,
+	remote [][]replica.RepairResponse,
+) []strfmt.UUID {
+	var mismatched []strfmt.UUID
+	for i := range ids {
+		for _, digests := range remote {
+			d := digests[i]
+			if d.Err != "" || d.Deleted != local[i].Deleted ||
+				d.UpdateTime != local[i].UpdateTime {
+				mismatched = append(mismatched, ids[i])
+				break
+			}
+		}
+	}
+	return mismatched
+}
+
+func remoteObjectCount(status *models.NodeStatus, className, shardName string) (int64, bool) {
+	if status == nil || status.Status == nil ||
+		*status.Status == models.NodeStatusStatusUNAVAILABLE {
+		return 0, false
+	}
+	for _, s := range status.Shards {
+		if s.Class == className && s.Name == shardName {
+			return s.ObjectCount, true
+		}
+	}
+	return 0, false
+}
+
+func objectCountDifference(counts map[string]int64) int64 {
+	var min, max int64
+	first := true
+	for _, count := range counts {
+		if first || count < min {
+			min = count
+		}
+		if first || count > max {
+			max = count
+		}
+		first = false
+	}
+	return max - min
+}
+
+// sampleObjectIDs picks up to n random objects of the shard by looking up
+// random doc ids. Doc ids of deleted objects are skipped, so fewer objects
+// may be returned for shards with many deletes.
+func (s *Shard) sampleObjectIDs(n int) []strfmt.UUID {
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	maxDocID := s.counter.Get()
+	if bucket == nil || maxDocID == 0 || n <= 0 {
+		return nil
+	}
+
+	seen := map[uint64]struct{}{}
+	ids := make([]strfmt.UUID, 0, n)
+	docIDBytes := make([]byte, 8)
+	for attempts := 0; attempts < 3*n && len(ids) < n; attempts++ {
+		docID := uint64(rand.Int63n(int64(maxDocID)))
+		if _, ok := seen[docID]; ok {
+			continue
+		}
+		seen[docID] = struct{}{}
+
+		binary.LittleEndian.PutUint64(docIDBytes, docID)
+		res, err := bucket.GetBySecondary(0, docIDBytes)
+		if err != nil || res == nil {
+			continue
+		}
+		prop, _, err := storobj.ParseAndExtractProperty(res, "id")
+		if err != nil || len(prop) == 0 {
+			continue
+		}
+		ids = append(ids, strfmt.UUID(prop[0]))
+	}
+	return ids
+}
+
+func (db *DB) storeConsistencyReport(shard *Shard, report *models.ShardConsistencyReport) {
+	className := shard.index.Config.ClassName.String()
+	db.consistencyLock.Lock()
+	db.consistencyReports[consistencyKey(className, shard.name)] = report
+	db.consistencyLock.Unlock()
+
+	if db.promMetrics == nil {
+		return
+	}
+	gauge := db.promMetrics.ReplicaInconsistency
+	gauge.WithLabelValues(className, shard.name, "object_count_difference").
+		Set(float64(objectCountDifference(report.ReplicaObjectCounts)))
+	gauge.WithLabelValues(className, shard.name, "mismatched_objects").
+		Set(float64(report.MismatchedObjects))
+	gauge.WithLabelValues(className, shard.name, "unreachable_replicas").
+		Set(float64(len(report.UnreachableReplicas)))
+}
+
+func (db *DB) consistencyReport(className, shardName string) *models.ShardConsistencyReport {
+	db.consistencyLock.Lock()
+	defer db.consistencyLock.Unlock()
+	return db.consistencyReports[consistencyKey(className, shardName)]
+}
+
+func consistencyKey(className, shardName string) string {
+	return fmt.Sprintf("%s/%s", className, shardName)
+}
@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestSampleObjectIDs(t *testing.T) {
+	dirName := t.TempDir()
+
+	class := &models.Class{
+		Class:               "ConsistencySampleClass",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "name",
+				DataType: []string{"text"},
+			},
+		},
+	}
+	shardState := singleShardState()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState, schema: schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, shardState))
+
+	ids := map[strfmt.UUID]struct{}{}
+	for i := 0; i < 20; i++ {
+		id := strfmt.UUID(fmt.Sprintf("6c0b2f5e-3d1a-4c47-9a77-%012d", i))
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			ID:         id,
+			Class:      class.Class,
+			Properties: map[string]interface{}{"name": fmt.Sprintf("object %d", i)},
+		}, []float32{1, 2, 3, 4}, nil))
+		if i%4 == 0 {
+			require.Nil(t, repo.DeleteObject(context.Background(), class.Class, id, nil))
+			continue
+		}
+		ids[id] = struct{}{}
+	}
+
+	shard := repo.GetIndex(schema.ClassName(class.Class)).
+		Shards[shardState.AllPhysicalShards()[0]]
+
+	t.Run("sample is smaller than the shard", func(t *testing.T) {
+		sample := shard.sampleObjectIDs(5)
+		require.NotEmpty(t, sample)
+		assert.LessOrEqual(t, len(sample), 5)
+		seen := map[strfmt.UUID]struct{}{}
+		for _, id := range sample {
+			assert.Contains(t, ids, id)
+			assert.NotContains(t, seen, id)
+			seen[id] = struct{}{}
+		}
+	})
+
+	t.Run("sample is larger than the shard", func(t *testing.T) {
+		sample := shard.sampleObjectIDs(100)
+		assert.Len(t, sample, len(ids))
+	})
+}
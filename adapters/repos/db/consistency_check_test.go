@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+func TestDiffDigests(t *testing.T) {
+	ids := []strfmt.UUID{
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f506001",
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f506002",
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f506003",
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f506004",
+	}
+	local := []replica.RepairResponse{
+		{ID: ids[0].String(), UpdateTime: 10},
+		{ID: ids[1].String(), UpdateTime: 20},
+		{ID: ids[2].String(), UpdateTime: 30},
+		{ID: ids[3].String(), UpdateTime: 40},
+	}
+
+	t.Run("replicas agree", func(t *testing.T) {
+		remote := [][]replica.RepairResponse{local, local}
+		assert.Empty(t, diffDigests(ids, local, remote))
+	})
+
+	t.Run("replicas differ", func(t *testing.T) {
+		outdated := []replica.RepairResponse{
+			{ID: ids[0].String(), UpdateTime: 10},
+			{ID: ids[1].String(), UpdateTime: 15},
+			{ID: ids[2].String(), UpdateTime: 30},
+			{ID: ids[3].String(), UpdateTime: 40},
+		}
+		missing := []replica.RepairResponse{
+			{ID: ids[0].String(), UpdateTime: 10},
+			{ID: ids[1].String(), UpdateTime: 20},
+			{ID: ids[2].String(), Deleted: true},
+			{ID: ids[3].String(), Err: "not found"},
+		}
+		remote := [][]replica.RepairResponse{outdated, missing}
+		assert.Equal(t, ids[1:], diffDigests(ids, local, remote))
+	})
+}
+
+func TestObjectCountDifference(t *testing.T) {
+	assert.Equal(t, int64(0), objectCountDifference(nil))
+	assert.Equal(t, int64(0), objectCountDifference(map[string]int64{"node1": 7}))
+	assert.Equal(t, int64(5), objectCountDifference(
+		map[string]int64{"node1": 7, "node2": 2, "node3": 4}))
+}
+
+func TestRemoteObjectCount(t *testing.T) {
+	healthy := models.NodeStatusStatusHEALTHY
+	unavailable := models.NodeStatusStatusUNAVAILABLE
+	status := &models.NodeStatus{
+		Status: &healthy,
+		Shards: []*models.NodeShardStatus{
+			{Class: "Article", Name: "shard1", ObjectCount: 3},
+			{Class: "Article", Name: "shard2", ObjectCount: 5},
+		},
+	}
+
+	count, ok := remoteObjectCount(status, "Article", "shard2")
+	assert.True(t, ok)
+	assert.Equal(t, int64(5), count)
+
+	_, ok = remoteObjectCount(status, "Article", "shard3")
+	assert.False(t, ok)
+
+	_, ok = remoteObjectCount(nil, "Article", "shard1")
+	assert.False(t, ok)
+
+	_, ok = remoteObjectCount(&models.NodeStatus{Status: &unavailable}, "Article", "shard1")
+	assert.False(t, ok)
+}
@@ -67,6 +67,11 @@ func (d *DB) MultiGet(ctx context.Context,
 	additional additional.Properties,
 ) ([]search.Result, error) {
 	byIndex := map[string][]multi.Identifier{}
+	for _, q := range query {
+		// make sure lazy indexes are loaded
+		d.GetIndex(schema.ClassName(q.ClassName))
+	}
+
 	d.indexLock.RLock()
 	defer d.indexLock.RUnlock()
 
@@ -131,6 +136,7 @@ func (d *DB) ObjectsByID(ctx context.Context, id strfmt.UUID,
 	var result []*storobj.Object
 	// TODO: Search in parallel, rather than sequentially or this will be
 	// painfully slow on large schemas
+	d.loadLazyIndexes()
 	d.indexLock.RLock()
 
 	for _, index := range d.indices {
@@ -178,6 +184,27 @@ func (d *DB) Object(ctx context.Context, class string,
 	return d.enrichRefsForSingle(ctx, r, props, adds)
 }
 
+// ObjectAtVersion gets a retained version of the object with id from the
+// index of the specified class, nil if the class doesn't keep the version.
+func (d *DB) ObjectAtVersion(ctx context.Context, class string,
+	id strfmt.UUID, version int64, props search.SelectProperties,
+	adds additional.Properties,
+) (*search.Result, error) {
+	idx := d.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return nil, nil
+	}
+
+	obj, err := idx.objectByIDAtVersion(ctx, id, version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "search index %s", idx.ID())
+	}
+	if obj == nil {
+		return nil, nil
+	}
+	return d.enrichRefsForSingle(ctx, obj.SearchResult(adds), props, adds)
+}
+
 func (d *DB) enrichRefsForSingle(ctx context.Context, obj *search.Result,
 	props search.SelectProperties, additional additional.Properties,
 ) (*search.Result, error) {
@@ -186,6 +213,7 @@ func (d *DB) enrichRefsForSingle(ctx context.Context, obj *search.Result,
 	if err != nil {
 		return nil, errors.Wrap(err, "resolve cross-refs")
 	}
+	d.addRoutingHints(res, additional)
 
 	return &res[0], nil
 }
@@ -208,6 +236,7 @@ func (d *DB) anyExists(ctx context.Context, id strfmt.UUID,
 ) (bool, error) {
 	// TODO: Search in parallel, rather than sequentially or this will be
 	// painfully slow on large schemas
+	d.loadLazyIndexes()
 	d.indexLock.RLock()
 	defer d.indexLock.RUnlock()
 
@@ -155,6 +155,102 @@ func TestCRUD_NoIndexProp(t *testing.T) {
 	})
 }
 
+func TestCRUD_IndexOnlyProp(t *testing.T) {
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	className := "ThingClassWithIndexOnlyProps"
+	thingclass := &models.Class{
+		Class:               className,
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:         "stringProp",
+			DataType:     []string{string(schema.DataTypeString)},
+			Tokenization: "word",
+		}, {
+			Name:         "indexOnlyStringProp",
+			DataType:     []string{string(schema.DataTypeString)},
+			Tokenization: "word",
+			IndexOnly:    ptBool(true),
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+		MemtablesFlushIdleAfter:   60,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t,
+		migrator.AddClass(context.Background(), thingclass, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{thingclass},
+		},
+	}
+
+	thingID := strfmt.UUID("9f119c4f-80da-4ae5-bfd1-e4b63054125f")
+	otherID := strfmt.UUID("5b4f7a2e-1c1a-4a3b-8f0e-2d3c4b5a6978")
+	put := func(t *testing.T, id strfmt.UUID, value string) {
+		err := repo.PutObject(context.Background(), &models.Object{
+			ID:    id,
+			Class: className,
+			Properties: map[string]interface{}{
+				"stringProp":          "some value",
+				"indexOnlyStringProp": value,
+			},
+		}, []float32{1, 3, 5, 0.4}, nil)
+		require.Nil(t, err)
+	}
+	find := func(t *testing.T, value string, limit int) []strfmt.UUID {
+		res, err := repo.ClassSearch(context.Background(), dto.GetParams{
+			ClassName:  className,
+			Pagination: &filters.Pagination{Limit: limit},
+			Filters:    buildFilter("indexOnlyStringProp", value, eq, dtString),
+		})
+		require.Nil(t, err)
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID
+		}
+		return ids
+	}
+
+	put(t, thingID, "first")
+
+	t.Run("the index-only prop is not stored", func(t *testing.T) {
+		res, err := repo.Object(context.Background(), className, thingID,
+			search.SelectProperties{}, additional.Properties{}, nil)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"stringProp": "some value",
+			"id":         thingID,
+		}, res.Schema)
+	})
+
+	t.Run("the index-only prop is filterable", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{thingID}, find(t, "first", 10))
+	})
+
+	t.Run("updates replace the indexed value", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			put(t, thingID, "shared")
+		}
+		put(t, otherID, "shared")
+
+		assert.Empty(t, find(t, "first", 10))
+		// the doc ids of the previous versions remain in the index, they are
+		// skipped within the limit
+		assert.ElementsMatch(t, []strfmt.UUID{thingID, otherID}, find(t, "shared", 2))
+	})
+}
+
 func ptBool(in bool) *bool {
 	return &in
 }
@@ -0,0 +1,31 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskformat
+
+import "context"
+
+// migrations must be extended, never changed, whenever the layout of the
+// data directory changes in a way which older data cannot be read with
+//
+// CHANGELOG
+//   - Version 1 - The layout of Weaviate v1.19, which is the first version
+//     to write the version file. Older layouts are still read as they are
+//     (see the shard versioner), so there is nothing to migrate.
+var migrations = []Migration{
+	{
+		Version:     1,
+		Description: "record the disk format of data written by earlier versions",
+		Up: func(ctx context.Context, m *MigrationContext) error {
+			return nil
+		},
+	},
+}
@@ -0,0 +1,354 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package diskformat versions the on-disk layout of the data directory as a
+// whole and upgrades it at startup, before any index or shard is loaded.
+// Every change to the layout of the LSM stores, the HNSW commit logs or the
+// metadata files which older data cannot be read with comes with a migration
+// in migrations.go.
+package diskformat
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// versionFile holds the format version of the data directory
+	versionFile = "disk_format.json"
+	// backupDir holds hard links to the files of the data directory while
+	// migrations run. If it is still present at startup, a migration was
+	// interrupted and the data directory is restored from it.
+	backupDir = ".disk_format_backup"
+	// backupTmpDir holds the backup while it is created, it is renamed to
+	// backupDir once complete. A left over one is incomplete and discarded.
+	backupTmpDir = ".disk_format_backup.tmp"
+)
+
+// Migration upgrades the data directory from Version-1 to Version.
+//
+// As the files are backed up with hard links, a migration must never modify
+// an existing file in place. It writes a new file and renames it over the old
+// one or deletes the old one instead.
+type Migration struct {
+	Version     int
+	Description string
+	Up          func(ctx context.Context, m *MigrationContext) error
+}
+
+// MigrationContext is passed to a running migration
+type MigrationContext struct {
+	RootPath string
+	Logger   logrus.FieldLogger
+
+	progress func(done, total int)
+}
+
+// Progress reports that done of total units of work are complete, it is
+// safe to call from several goroutines
+func (m *MigrationContext) Progress(done, total int) {
+	if m.progress != nil {
+		m.progress(done, total)
+	}
+}
+
+type formatVersion struct {
+	Version    int       `json:"version"`
+	MigratedAt time.Time `json:"migratedAt"`
+}
+
+type Migrator struct {
+	rootPath   string
+	logger     logrus.FieldLogger
+	migrations []Migration
+}
+
+// NewMigrator upgrades the data directory at rootPath to the latest format
+func NewMigrator(rootPath string, logger logrus.FieldLogger) *Migrator {
+	return newMigrator(rootPath, logger, migrations)
+}
+
+func newMigrator(rootPath string, logger logrus.FieldLogger,
+	migrations []Migration,
+) *Migrator {
+	sorted := make([]Migration, len(migrations))
+	copy(sorted, migrations)
+	sort.Slice(sorted, func(a, b int) bool {
+		return sorted[a].Version < sorted[b].Version
+	})
+
+	return &Migrator{
+		rootPath:   rootPath,
+		logger:     logger,
+		migrations: sorted,
+	}
+}
+
+// Latest is the format version written by this version of Weaviate
+func (m *Migrator) Latest() int {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Run applies all pending migrations. If one of them fails, the data
+// directory is rolled back to the state before the first one and the error is
+// returned, so the previous version of Weaviate can still start on it.
+func (m *Migrator) Run(ctx context.Context) error {
+	if err := m.restoreInterrupted(); err != nil {
+		return err
+	}
+
+	current, err := m.currentVersion()
+	if err != nil {
+		return err
+	}
+
+	latest := m.Latest()
+	if current > latest {
+		return errors.Errorf("data directory %q has disk format v%d, but this "+
+			"version of Weaviate supports at most v%d: downgrades are not supported",
+			m.rootPath, current, latest)
+	}
+	if current == latest {
+		return nil
+	}
+
+	var pending []Migration
+	for _, migration := range m.migrations {
+		if migration.Version > current {
+			pending = append(pending, migration)
+		}
+	}
+
+	if err := m.backup(); err != nil {
+		return errors.Wrap(err, "back up data directory before migration")
+	}
+
+	for _, migration := range pending {
+		if err := m.apply(ctx, migration); err != nil {
+			if rollbackErr := m.restore(); rollbackErr != nil {
+				return errors.Wrapf(err, "rollback failed, restore %q manually: %v",
+					filepath.Join(m.rootPath, backupDir), rollbackErr)
+			}
+			m.logger.WithField("action", "disk_format_migration_rolled_back").
+				WithField("version", current).
+				Errorf("migration to disk format v%d failed, rolled back to v%d",
+					migration.Version, current)
+			return errors.Wrapf(err, "migrate to disk format v%d", migration.Version)
+		}
+	}
+
+	if err := m.writeVersion(latest); err != nil {
+		if rollbackErr := m.restore(); rollbackErr != nil {
+			return errors.Wrapf(err, "rollback failed, restore %q manually: %v",
+				filepath.Join(m.rootPath, backupDir), rollbackErr)
+		}
+		return err
+	}
+
+	if err := os.RemoveAll(filepath.Join(m.rootPath, backupDir)); err != nil {
+		return errors.Wrap(err, "remove migration backup")
+	}
+	return nil
+}
+
+func (m *Migrator) apply(ctx context.Context, migration Migration) error {
+	logger := m.logger.WithField("action", "disk_format_migration").
+		WithField("version", migration.Version)
+	logger.Infof("migrating data directory to disk format v%d: %s",
+		migration.Version, migration.Description)
+
+	started := time.Now()
+	lastLogged := started
+	var progressLock sync.Mutex
+	mc := &MigrationContext{
+		RootPath: m.rootPath,
+		Logger:   logger,
+		progress: func(done, total int) {
+			progressLock.Lock()
+			defer progressLock.Unlock()
+			if time.Since(lastLogged) < 10*time.Second && done < total {
+				return
+			}
+			lastLogged = time.Now()
+			logger.WithField("done", done).WithField("total", total).
+				Infof("migration to disk format v%d: %d/%d", migration.Version, done, total)
+		},
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := migration.Up(ctx, mc); err != nil {
+		return err
+	}
+
+	logger.WithField("took", time.Since(started)).
+		Infof("migrated data directory to disk format v%d", migration.Version)
+	return nil
+}
+
+// currentVersion reads the version file. Without it a data directory with
+// content was written before the format was versioned, which is v0.
+func (m *Migrator) currentVersion() (int, error) {
+	content, err := os.ReadFile(filepath.Join(m.rootPath, versionFile))
+	if err == nil {
+		var v formatVersion
+		if err := json.Unmarshal(content, &v); err != nil {
+			return 0, errors.Wrapf(err, "parse %s", versionFile)
+		}
+		return v.Version, nil
+	}
+	if !os.IsNotExist(err) {
+		return 0, errors.Wrapf(err, "read %s", versionFile)
+	}
+
+	entries, err := os.ReadDir(m.rootPath)
+	if err != nil {
+		return 0, errors.Wrap(err, "read data directory")
+	}
+	if len(entries) > 0 {
+		return 0, nil
+	}
+
+	// a new data directory starts with the latest format
+	latest := m.Latest()
+	return latest, m.writeVersion(latest)
+}
+
+func (m *Migrator) writeVersion(version int) error {
+	content, err := json.Marshal(formatVersion{
+		Version:    version,
+		MigratedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(m.rootPath, versionFile)
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return errors.Wrapf(err, "write %s", versionFile)
+	}
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+		return errors.Wrapf(err, "write %s", versionFile)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return errors.Wrapf(err, "sync %s", versionFile)
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrapf(err, "close %s", versionFile)
+	}
+	return os.Rename(tmp, path)
+}
+
+// backup hard links every file of the data directory into the backup
+// directory, which is cheap regardless of the size of the data. The backup is
+// created in a temporary directory first, so that a crash while it is created
+// never leaves an incomplete backup which the data directory is restored from.
+func (m *Migrator) backup() error {
+	tmp := filepath.Join(m.rootPath, backupTmpDir)
+	if err := os.RemoveAll(tmp); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(tmp, 0o777); err != nil {
+		return err
+	}
+
+	err := filepath.Walk(m.rootPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(m.rootPath, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		if rel == backupDir || rel == backupTmpDir {
+			return filepath.SkipDir
+		}
+
+		target := filepath.Join(tmp, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode().Perm())
+		}
+		return os.Link(path, target)
+	})
+	if err == nil {
+		err = os.Rename(tmp, filepath.Join(m.rootPath, backupDir))
+	}
+	if err != nil {
+		os.RemoveAll(tmp)
+		return err
+	}
+	return nil
+}
+
+// restore replaces the entries of the data directory which are present in
+// the backup with their backed up version. Every entry is moved out of the
+// backup once it is restored, so that a restore which is interrupted can be
+// repeated without touching the entries which are already restored.
+func (m *Migrator) restore() error {
+	backup := filepath.Join(m.rootPath, backupDir)
+
+	entries, err := os.ReadDir(backup)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		target := filepath.Join(m.rootPath, entry.Name())
+		if err := os.RemoveAll(target); err != nil {
+			return err
+		}
+		if err := os.Rename(filepath.Join(backup, entry.Name()), target); err != nil {
+			return err
+		}
+	}
+	return os.Remove(backup)
+}
+
+// restoreInterrupted rolls back a migration which did not complete, e.g.
+// because the node crashed while it ran
+func (m *Migrator) restoreInterrupted() error {
+	// the migration didn't start before its backup was complete
+	if err := os.RemoveAll(filepath.Join(m.rootPath, backupTmpDir)); err != nil {
+		return errors.Wrap(err, "remove incomplete migration backup")
+	}
+
+	if _, err := os.Stat(filepath.Join(m.rootPath, backupDir)); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	m.logger.WithField("action", "disk_format_migration_rolled_back").
+		Warn("found the backup of an interrupted disk format migration, restoring it")
+	if err := m.restore(); err != nil {
+		return errors.Wrap(err, "restore interrupted disk format migration")
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskformat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrator(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	writeFile := func(t *testing.T, path, content string) {
+		require.Nil(t, os.MkdirAll(filepath.Dir(path), 0o777))
+		require.Nil(t, os.WriteFile(path, []byte(content), 0o666))
+	}
+	readFile := func(t *testing.T, path string) string {
+		content, err := os.ReadFile(path)
+		require.Nil(t, err)
+		return string(content)
+	}
+	version := func(t *testing.T, root string) int {
+		var v formatVersion
+		require.Nil(t, json.Unmarshal([]byte(readFile(t, filepath.Join(root, versionFile))), &v))
+		return v.Version
+	}
+
+	// v2 replaces the content of the segment, as migrations must not modify
+	// files in place
+	var ran []int
+	replaceSegment := Migration{
+		Version: 2,
+		Up: func(ctx context.Context, m *MigrationContext) error {
+			ran = append(ran, 2)
+			path := filepath.Join(m.RootPath, "article_abc_lsm", "segment.db")
+			require.Nil(t, os.WriteFile(path+".tmp", []byte("v2"), 0o666))
+			m.Progress(1, 1)
+			return os.Rename(path+".tmp", path)
+		},
+	}
+	baseline := Migration{
+		Version: 1,
+		Up: func(ctx context.Context, m *MigrationContext) error {
+			ran = append(ran, 1)
+			return nil
+		},
+	}
+
+	t.Run("new data directory starts with the latest format", func(t *testing.T) {
+		root := t.TempDir()
+		ran = nil
+
+		m := newMigrator(root, logger, []Migration{replaceSegment, baseline})
+		require.Nil(t, m.Run(context.Background()))
+		assert.Empty(t, ran)
+		assert.Equal(t, 2, version(t, root))
+	})
+
+	t.Run("unversioned data is migrated in order", func(t *testing.T) {
+		root := t.TempDir()
+		writeFile(t, filepath.Join(root, "article_abc_lsm", "segment.db"), "v0")
+		ran = nil
+
+		m := newMigrator(root, logger, []Migration{replaceSegment, baseline})
+		require.Nil(t, m.Run(context.Background()))
+		assert.Equal(t, []int{1, 2}, ran)
+		assert.Equal(t, 2, version(t, root))
+		assert.Equal(t, "v2", readFile(t, filepath.Join(root, "article_abc_lsm", "segment.db")))
+		assert.NoDirExists(t, filepath.Join(root, backupDir))
+
+		// nothing is pending on the next startup
+		ran = nil
+		require.Nil(t, m.Run(context.Background()))
+		assert.Empty(t, ran)
+	})
+
+	t.Run("only pending migrations run", func(t *testing.T) {
+		root := t.TempDir()
+		writeFile(t, filepath.Join(root, "article_abc_lsm", "segment.db"), "v1")
+		require.Nil(t, newMigrator(root, logger, nil).writeVersion(1))
+		ran = nil
+
+		m := newMigrator(root, logger, []Migration{baseline, replaceSegment})
+		require.Nil(t, m.Run(context.Background()))
+		assert.Equal(t, []int{2}, ran)
+		assert.Equal(t, 2, version(t, root))
+	})
+
+	t.Run("failed migration is rolled back", func(t *testing.T) {
+		root := t.TempDir()
+		segment := filepath.Join(root, "article_abc_lsm", "segment.db")
+		writeFile(t, segment, "v0")
+		ran = nil
+
+		failing := Migration{
+			Version: 3,
+			Up: func(ctx context.Context, m *MigrationContext) error {
+				writeFile(t, filepath.Join(m.RootPath, "article_abc_lsm", "new.db"), "new")
+				return errors.New("corrupt segment")
+			},
+		}
+
+		m := newMigrator(root, logger, []Migration{baseline, replaceSegment, failing})
+		err := m.Run(context.Background())
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "v3")
+		assert.Contains(t, err.Error(), "corrupt segment")
+
+		assert.Equal(t, "v0", readFile(t, segment))
+		assert.NoFileExists(t, filepath.Join(root, "article_abc_lsm", "new.db"))
+		assert.NoFileExists(t, filepath.Join(root, versionFile))
+		assert.NoDirExists(t, filepath.Join(root, backupDir))
+	})
+
+	t.Run("interrupted migration is rolled back on startup", func(t *testing.T) {
+		root := t.TempDir()
+		segment := filepath.Join(root, "article_abc_lsm", "segment.db")
+		writeFile(t, segment, "v0")
+
+		m := newMigrator(root, logger, []Migration{baseline})
+		require.Nil(t, m.backup())
+		// the node crashed after the migration replaced the segment
+		require.Nil(t, os.Remove(segment))
+		writeFile(t, segment, "half-migrated")
+
+		require.Nil(t, m.restoreInterrupted())
+		assert.Equal(t, "v0", readFile(t, segment))
+		assert.NoDirExists(t, filepath.Join(root, backupDir))
+	})
+
+	t.Run("incomplete backup is discarded on startup", func(t *testing.T) {
+		root := t.TempDir()
+		segment := filepath.Join(root, "article_abc_lsm", "segment.db")
+		writeFile(t, segment, "v0")
+		writeFile(t, filepath.Join(root, "article_def_lsm", "segment.db"), "v0")
+
+		// the node crashed while the backup was created
+		writeFile(t, filepath.Join(root, backupTmpDir, "article_abc_lsm", "segment.db"), "v0")
+
+		m := newMigrator(root, logger, []Migration{baseline})
+		require.Nil(t, m.restoreInterrupted())
+		assert.Equal(t, "v0", readFile(t, segment))
+		assert.Equal(t, "v0", readFile(t, filepath.Join(root, "article_def_lsm", "segment.db")))
+		assert.NoDirExists(t, filepath.Join(root, backupTmpDir))
+	})
+
+	t.Run("interrupted restore is repeated", func(t *testing.T) {
+		root := t.TempDir()
+		first := filepath.Join(root, "article_abc_lsm", "segment.db")
+		second := filepath.Join(root, "article_def_lsm", "segment.db")
+		writeFile(t, first, "v0")
+		writeFile(t, second, "v0")
+
+		m := newMigrator(root, logger, []Migration{baseline})
+		require.Nil(t, m.backup())
+		require.Nil(t, os.Remove(second))
+		writeFile(t, second, "half-migrated")
+
+		// the node crashed after the first entry was restored
+		require.Nil(t, os.RemoveAll(filepath.Join(root, "article_abc_lsm")))
+		require.Nil(t, os.Rename(filepath.Join(root, backupDir, "article_abc_lsm"),
+			filepath.Join(root, "article_abc_lsm")))
+
+		require.Nil(t, m.restoreInterrupted())
+		assert.Equal(t, "v0", readFile(t, first))
+		assert.Equal(t, "v0", readFile(t, second))
+		assert.NoDirExists(t, filepath.Join(root, backupDir))
+	})
+
+	t.Run("newer format is refused", func(t *testing.T) {
+		root := t.TempDir()
+		require.Nil(t, newMigrator(root, logger, nil).writeVersion(5))
+
+		err := newMigrator(root, logger, []Migration{baseline}).Run(context.Background())
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "downgrades are not supported")
+	})
+}
@@ -0,0 +1,168 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestDynamicVectorIndex(t *testing.T) {
+	dirName := t.TempDir()
+	ctx := context.Background()
+
+	interval := dynamicUpgradeInterval
+	dynamicUpgradeInterval = 10 * time.Millisecond
+	defer func() { dynamicUpgradeInterval = interval }()
+
+	userConfig := dynamic.NewDefaultUserConfig()
+	userConfig.Distance = hnsw.DistanceL2Squared
+	userConfig.HNSW.Distance = hnsw.DistanceL2Squared
+	userConfig.Flat.Distance = hnsw.DistanceL2Squared
+	userConfig.Threshold = 6
+	class := &models.Class{
+		Class:               "DynamicIndexClass",
+		VectorIndexType:     dynamic.IndexType,
+		VectorIndexConfig:   userConfig,
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:         "name",
+				DataType:     []string{"text"},
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+	shardState := singleShardState()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState, schema: schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}}
+	newRepo := func() *DB {
+		repo, err := New(logger, Config{
+			MemtablesFlushIdleAfter:   60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+	localShard := func(repo *DB) *Shard {
+		for _, shard := range repo.GetIndex(schema.ClassName(class.Class)).Shards {
+			return shard
+		}
+		t.Fatal("no local shard")
+		return nil
+	}
+	generation := func(repo *DB) int {
+		gen, err := localShard(repo).vectorIndexGeneration()
+		require.Nil(t, err)
+		return gen
+	}
+
+	repo := newRepo()
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(ctx, class, shardState))
+
+	ids := make([]strfmt.UUID, 10)
+	put := func(i int) {
+		ids[i] = strfmt.UUID(fmt.Sprintf("6a2d3b4c-8e5f-4a71-8b9c-%012d", i))
+		name := "odd"
+		if i%2 == 0 {
+			name = "even"
+		}
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			ID:         ids[i],
+			Class:      class.Class,
+			Properties: map[string]interface{}{"name": name},
+		}, []float32{float32(i), 0, 0}, nil))
+	}
+
+	search := func(repo *DB, filter *filters.LocalFilter) []strfmt.UUID {
+		res, err := repo.VectorClassSearch(ctx, dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: []float32{3.2, 0, 0},
+			Pagination:   &filters.Pagination{Limit: 3},
+			Filters:      filter,
+		})
+		require.Nil(t, err)
+		found := make([]strfmt.UUID, len(res))
+		for i := range res {
+			found[i] = res[i].ID
+		}
+		return found
+	}
+
+	t.Run("below the threshold the flat index is used", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			put(i)
+		}
+		time.Sleep(10 * dynamicUpgradeInterval)
+
+		assert.Equal(t, 0, generation(repo))
+		assert.Equal(t, []strfmt.UUID{ids[3], ids[4], ids[2]}, search(repo, nil))
+	})
+
+	t.Run("crossing the threshold upgrades to hnsw", func(t *testing.T) {
+		for i := 5; i < len(ids); i++ {
+			put(i)
+		}
+
+		require.Eventually(t, func() bool {
+			return generation(repo) == 1
+		}, 5*time.Second, dynamicUpgradeInterval)
+		assert.Equal(t, []strfmt.UUID{ids[3], ids[4], ids[2]}, search(repo, nil))
+	})
+
+	t.Run("writes after the upgrade go to hnsw", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(ctx, class.Class, ids[3], nil))
+		filter := buildFilter("name", "even", eq, dtText)
+		assert.Equal(t, []strfmt.UUID{ids[4], ids[2], ids[6]}, search(repo, filter))
+		assert.Equal(t, []strfmt.UUID{ids[4], ids[2], ids[5]}, search(repo, nil))
+	})
+
+	t.Run("the upgrade is kept across restarts", func(t *testing.T) {
+		require.Nil(t, repo.Shutdown(ctx))
+		repo = newRepo()
+		defer repo.Shutdown(ctx)
+
+		assert.Equal(t, 1, generation(repo))
+		assert.Equal(t, []strfmt.UUID{ids[4], ids[2], ids[5]}, search(repo, nil))
+
+		_, err := os.Stat(filepath.Join(localShard(repo).DBPathLSM(),
+			helpers.FlatVectorsBucketLSM))
+		assert.True(t, os.IsNotExist(err), "flat vectors are removed")
+	})
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/replay"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
@@ -141,6 +142,12 @@ func (f *fakeRemoteClient) GetObject(ctx context.Context, hostName, indexName,
 	return nil, nil
 }
 
+func (f *fakeRemoteClient) GetObjectAtVersion(ctx context.Context, hostName, indexName,
+	shardName string, id strfmt.UUID, version int64,
+) (*storobj.Object, error) {
+	return nil, nil
+}
+
 func (f *fakeRemoteClient) Exists(ctx context.Context, hostName, indexName,
 	shardName string, id strfmt.UUID,
 ) (bool, error) {
@@ -166,7 +173,8 @@ func (f *fakeRemoteClient) MultiGetObjects(ctx context.Context, hostName, indexN
 }
 
 func (f *fakeRemoteClient) SearchShard(ctx context.Context, hostName, indexName,
-	shardName string, vector []float32, limit int,
+	shardName string, vector []float32, targetVector string, _ *searchparams.TargetVectors,
+	distance float32, limit int,
 	filters *filters.LocalFilter, _ *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
@@ -185,6 +193,13 @@ func (f *fakeRemoteClient) BatchAddReferences(ctx context.Context, hostName,
 	return nil
 }
 
+func (f *fakeRemoteClient) SampleObjects(ctx context.Context, hostName, indexName,
+	shardName string, filters *filters.LocalFilter, sample filters.Sample,
+	additional additional.Properties,
+) ([]*storobj.Object, []uint64, error) {
+	return nil, nil, nil
+}
+
 func (f *fakeRemoteClient) FindDocIDs(ctx context.Context, hostName, indexName, shardName string,
 	filters *filters.LocalFilter,
 ) ([]uint64, error) {
@@ -209,6 +224,30 @@ func (f *fakeRemoteClient) UpdateShardStatus(ctx context.Context, hostName, inde
 	return nil
 }
 
+func (f *fakeRemoteClient) OptimizeShard(ctx context.Context, hostName, indexName,
+	shardName string,
+) (*models.ShardOptimizeResult, error) {
+	return &models.ShardOptimizeResult{Name: shardName}, nil
+}
+
+func (f *fakeRemoteClient) CleanupInvertedShard(ctx context.Context, hostName, indexName,
+	shardName string,
+) (*models.ShardInvertedCleanupResult, error) {
+	return &models.ShardInvertedCleanupResult{Name: shardName}, nil
+}
+
+func (f *fakeRemoteClient) PrepareMigrationShard(ctx context.Context, hostName, indexName,
+	shardName string, migration *models.SchemaMigration,
+) error {
+	return nil
+}
+
+func (f *fakeRemoteClient) ReplayShard(ctx context.Context, hostName, indexName,
+	shardName string, from, to int64, after replay.Cursor, limit int,
+) (*replay.Page, error) {
+	return &replay.Page{}, nil
+}
+
 func (f *fakeRemoteClient) PutFile(ctx context.Context, hostName, indexName, shardName,
 	fileName string, payload io.ReadSeekCloser,
 ) error {
@@ -292,6 +331,18 @@ func (*fakeReplicationClient) DigestObjects(ctx context.Context,
 	return nil, nil
 }
 
+func (*fakeReplicationClient) HashTree(ctx context.Context,
+	hostName, indexName, shardName string, height int,
+) (*replica.HashTree, error) {
+	return nil, nil
+}
+
+func (*fakeReplicationClient) DigestLeaf(ctx context.Context,
+	hostName, indexName, shardName string, height, leaf int,
+) ([]replica.RepairResponse, error) {
+	return nil, nil
+}
+
 func (*fakeReplicationClient) FetchObjects(ctx context.Context, host,
 	index, shard string, ids []strfmt.UUID,
 ) ([]objects.Replica, error) {
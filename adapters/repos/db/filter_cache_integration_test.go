@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestFilterCache(t *testing.T) {
+	dirName := t.TempDir()
+
+	class := &models.Class{
+		Class:               "FilterCacheClass",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:         "name",
+				DataType:     []string{"text"},
+				Tokenization: "word",
+			},
+			{
+				Name:     "count",
+				DataType: []string{"int"},
+			},
+		},
+	}
+	shardState := singleShardState()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState, schema: schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+		FilterCache:               config.FilterCache{Enabled: true, MaxSizeMB: 1},
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, shardState))
+
+	put := func(i int, name string) {
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			ID:         strfmt.UUID(fmt.Sprintf("6e3a1c9f-3b1e-4d0a-8f2c-%012d", i)),
+			Class:      class.Class,
+			Properties: map[string]interface{}{"name": name, "count": int64(i)},
+		}, []float32{1, float32(i) / 10, 0.5}, nil))
+	}
+	count := 10
+	for i := 0; i < count; i++ {
+		name := "odd object"
+		if i%2 == 0 {
+			name = "even object"
+		}
+		put(i, name)
+	}
+
+	evenFilter := &filters.LocalFilter{
+		Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On: &filters.Path{
+				Class:    schema.ClassName(class.Class),
+				Property: "name",
+			},
+			Value: &filters.Value{
+				Value: "even",
+				Type:  schema.DataTypeText,
+			},
+		},
+	}
+	search := func() int {
+		res, err := repo.VectorClassSearch(context.Background(), dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: []float32{1, 0, 0.5},
+			Pagination:   &filters.Pagination{Limit: 100},
+			Filters:      evenFilter,
+		})
+		require.Nil(t, err)
+		return len(res)
+	}
+	cache := repo.GetIndex(schema.ClassName(class.Class)).
+		Shards[shardState.AllPhysicalShards()[0]].filterCache
+	require.NotNil(t, cache)
+
+	t.Run("the first search populates the cache", func(t *testing.T) {
+		assert.Equal(t, count/2, search())
+		assert.Equal(t, 1, cache.Len())
+		assert.Equal(t, count/2, search())
+		assert.Equal(t, 1, cache.Len())
+	})
+
+	t.Run("a write to the property invalidates the entry", func(t *testing.T) {
+		put(1, "even object")
+		assert.Equal(t, 0, cache.Len())
+		assert.Equal(t, count/2+1, search())
+	})
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
 	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
@@ -77,6 +78,8 @@ var (
 	gt   = filters.OperatorGreaterThan
 	gte  = filters.OperatorGreaterThanEqual
 	wgr  = filters.OperatorWithinGeoRange
+	wgp  = filters.OperatorWithinGeoPolygon
+	wgb  = filters.OperatorWithinGeoBox
 	and  = filters.OperatorAnd
 	null = filters.OperatorIsNull
 
@@ -320,6 +323,31 @@ func testPrimitiveProps(repo *DB) func(t *testing.T) {
 				}, wgr, dtGeoCoordinates),
 				expectedIDs: []strfmt.UUID{carSprinterID},
 			},
+			{
+				name: "within a box around California",
+				filter: buildFilter("parkedAt", filters.GeoBox{
+					TopLeft: models.GeoCoordinates{
+						Latitude:  ptFloat32(42),
+						Longitude: ptFloat32(-124.5),
+					},
+					BottomRight: models.GeoCoordinates{
+						Latitude:  ptFloat32(32.5),
+						Longitude: ptFloat32(-114),
+					},
+				}, wgb, dtGeoCoordinates),
+				expectedIDs: []strfmt.UUID{carSprinterID},
+			},
+			{
+				name: "within a polygon around the east coast",
+				filter: buildFilter("parkedAt", filters.GeoPolygon{
+					Points: []models.GeoCoordinates{
+						{Latitude: ptFloat32(45), Longitude: ptFloat32(-80)},
+						{Latitude: ptFloat32(45), Longitude: ptFloat32(-67)},
+						{Latitude: ptFloat32(25), Longitude: ptFloat32(-80)},
+					},
+				}, wgp, dtGeoCoordinates),
+				expectedIDs: []strfmt.UUID{carE63sID},
+			},
 			// {
 			// 	name:        "by id like",
 			// 	filter:      buildFilter("id", carPoloID.String(), like, dtString),
@@ -971,6 +999,27 @@ func TestGeoPropUpdateJourney(t *testing.T) {
 		// notice the opposite order
 		assert.Equal(t, ids[1], res[0].ID)
 	})
+
+	t.Run("verify distances to the point of the filter", func(t *testing.T) {
+		params := getParamsWithFilter("GeoUpdateTestClass", buildFilter(
+			"location", filters.GeoRange{
+				GeoCoordinates: searchQuery.GeoCoordinates,
+				Distance:       3000000,
+			}, wgr, schema.DataTypeGeoCoordinates,
+		))
+		params.AdditionalProperties.DistanceMeters = true
+		// rank the farthest object first
+		params.FunctionScore = &searchparams.FunctionScore{Expression: "_geoDistance"}
+
+		res, err := repo.ClassSearch(context.Background(), params)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+
+		assert.Equal(t, ids[0], res[0].ID)
+		assert.InDelta(t, 2551991.8, res[0].AdditionalProperties["distanceMeters"], 1)
+		assert.Equal(t, ids[1], res[1].ID)
+		assert.InDelta(t, 123728.6, res[1].AdditionalProperties["distanceMeters"], 1)
+	})
 }
 
 // This test prevents a regression on
@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestFlatVectorIndex(t *testing.T) {
+	dirName := t.TempDir()
+	ctx := context.Background()
+
+	userConfig := flat.NewDefaultUserConfig()
+	userConfig.Distance = hnsw.DistanceL2Squared
+	class := &models.Class{
+		Class:               "FlatIndexClass",
+		VectorIndexType:     flat.IndexType,
+		VectorIndexConfig:   userConfig,
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:         "name",
+				DataType:     []string{"text"},
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+	shardState := singleShardState()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState, schema: schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}}
+	newRepo := func() *DB {
+		repo, err := New(logger, Config{
+			MemtablesFlushIdleAfter:   60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+
+	repo := newRepo()
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(ctx, class, shardState))
+
+	ids := make([]strfmt.UUID, 10)
+	for i := range ids {
+		ids[i] = strfmt.UUID(fmt.Sprintf("5f1c2a3b-7d4e-4f60-9a8b-%012d", i))
+		name := "odd"
+		if i%2 == 0 {
+			name = "even"
+		}
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			ID:         ids[i],
+			Class:      class.Class,
+			Properties: map[string]interface{}{"name": name},
+		}, []float32{float32(i), 0, 0}, nil))
+	}
+
+	search := func(repo *DB, filter *filters.LocalFilter) []strfmt.UUID {
+		res, err := repo.VectorClassSearch(ctx, dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: []float32{3.2, 0, 0},
+			Pagination:   &filters.Pagination{Limit: 3},
+			Filters:      filter,
+		})
+		require.Nil(t, err)
+		found := make([]strfmt.UUID, len(res))
+		for i := range res {
+			found[i] = res[i].ID
+		}
+		return found
+	}
+
+	t.Run("search returns the exact nearest neighbors", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{ids[3], ids[4], ids[2]}, search(repo, nil))
+	})
+
+	t.Run("filtered search", func(t *testing.T) {
+		filter := buildFilter("name", "even", eq, dtText)
+		assert.Equal(t, []strfmt.UUID{ids[4], ids[2], ids[6]}, search(repo, filter))
+	})
+
+	t.Run("deleted objects are not returned", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(ctx, class.Class, ids[3], nil))
+		assert.Equal(t, []strfmt.UUID{ids[4], ids[2], ids[5]}, search(repo, nil))
+	})
+
+	t.Run("vectors are persisted across restarts", func(t *testing.T) {
+		require.Nil(t, repo.Shutdown(ctx))
+		repo = newRepo()
+		defer repo.Shutdown(ctx)
+		assert.Equal(t, []strfmt.UUID{ids[4], ids[2], ids[5]}, search(repo, nil))
+	})
+}
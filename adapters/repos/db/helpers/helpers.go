@@ -21,7 +21,10 @@ var (
 	ObjectsBucket              = []byte("objects")
 	ObjectsBucketLSM           = "objects"
 	CompressedObjectsBucketLSM = "compressed_objects"
+	FlatVectorsBucketLSM       = "vectors_flat"
 	DimensionsBucketLSM        = "dimensions"
+	DeletionsBucketLSM         = "deletions"
+	ObjectVersionsBucketLSM    = "object_versions"
 	DocIDBucket                = []byte("doc_ids")
 )
 
@@ -66,6 +69,11 @@ func HashBucketFromPropNameNullLSM(propName string) string {
 	return HashBucketFromPropNameLSM(propName + filters.InternalNullIndex)
 }
 
+// FlatTargetVectorsBucketLSM is the bucket of the flat index of a named vector
+func FlatTargetVectorsBucketLSM(target string) string {
+	return fmt.Sprintf("%s_%s", FlatVectorsBucketLSM, target)
+}
+
 func TempBucketFromBucketName(bucketName string) string {
 	return bucketName + "_temp"
 }
@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import (
+	"container/heap"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+// SampledDoc is a doc id which is part of a sample with its priority
+type SampledDoc struct {
+	DocID    uint64
+	Priority uint64
+}
+
+// SampleReservoir keeps the doc ids with the lowest priorities of all doc
+// ids it has seen, without holding on to any of the others
+type SampleReservoir struct {
+	sample filters.Sample
+	docs   sampledDocHeap
+}
+
+func NewSampleReservoir(sample filters.Sample) *SampleReservoir {
+	return &SampleReservoir{sample: sample}
+}
+
+func (r *SampleReservoir) Add(docID uint64) {
+	priority := r.sample.Priority(docID)
+	if !r.sample.Includes(priority) {
+		return
+	}
+
+	if len(r.docs) < r.sample.Size {
+		heap.Push(&r.docs, SampledDoc{DocID: docID, Priority: priority})
+		return
+	}
+
+	if priority < r.docs[0].Priority {
+		r.docs[0] = SampledDoc{DocID: docID, Priority: priority}
+		heap.Fix(&r.docs, 0)
+	}
+}
+
+// Docs returns the sampled doc ids ordered by their priority
+func (r *SampleReservoir) Docs() []SampledDoc {
+	out := make([]SampledDoc, len(r.docs))
+	copy(out, r.docs)
+	sort.Slice(out, func(a, b int) bool {
+		return out[a].Priority < out[b].Priority
+	})
+	return out
+}
+
+// sampledDocHeap is a max-heap, so the doc with the highest priority can be
+// replaced by one with a lower priority
+type sampledDocHeap []SampledDoc
+
+func (h sampledDocHeap) Len() int           { return len(h) }
+func (h sampledDocHeap) Less(a, b int) bool { return h[a].Priority > h[b].Priority }
+func (h sampledDocHeap) Swap(a, b int)      { h[a], h[b] = h[b], h[a] }
+
+func (h *sampledDocHeap) Push(x interface{}) {
+	*h = append(*h, x.(SampledDoc))
+}
+
+func (h *sampledDocHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
@@ -0,0 +1,53 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+func TestSampleReservoir(t *testing.T) {
+	sample := filters.Sample{Size: 10, Seed: 42}
+
+	r := NewSampleReservoir(sample)
+	all := make([]SampledDoc, 1000)
+	for id := uint64(0); id < 1000; id++ {
+		r.Add(id)
+		all[id] = SampledDoc{DocID: id, Priority: sample.Priority(id)}
+	}
+	sort.Slice(all, func(a, b int) bool { return all[a].Priority < all[b].Priority })
+
+	t.Run("keeps the lowest priorities", func(t *testing.T) {
+		assert.Equal(t, all[:10], r.Docs())
+	})
+
+	t.Run("with a max priority", func(t *testing.T) {
+		max := all[4].Priority
+		withMax := NewSampleReservoir(filters.Sample{Size: 10, Seed: 42, MaxPriority: &max})
+		for id := uint64(0); id < 1000; id++ {
+			withMax.Add(id)
+		}
+		assert.Equal(t, all[:5], withMax.Docs())
+	})
+
+	t.Run("fewer docs than the sample size", func(t *testing.T) {
+		small := NewSampleReservoir(sample)
+		small.Add(1)
+		small.Add(2)
+		require.Len(t, small.Docs(), 2)
+	})
+}
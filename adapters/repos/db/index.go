@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-openapi/strfmt"
@@ -26,10 +27,14 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/sorter"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/walcompression"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/changes"
+	"github.com/weaviate/weaviate/entities/errorcompounder"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/multi"
@@ -37,7 +42,9 @@ import (
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/floatcomp"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
@@ -57,17 +64,45 @@ type Index struct {
 	getSchema             schemaUC.SchemaGetter
 	logger                logrus.FieldLogger
 	remote                *sharding.RemoteIndex
+	nodeResolver          nodeResolver
 	stopwords             *stopwords.Detector
 	replicator            *replica.Replicator
 
 	backupState     BackupState
 	backupStateLock sync.RWMutex
+	// optimizing is set while shards are optimized, guarded by backupStateLock
+	optimizing bool
+	// reindexing is set while the vector indexes of the shards are rebuilt,
+	// guarded by backupStateLock
+	reindexing bool
 
 	invertedIndexConfig     schema.InvertedIndexConfig
 	invertedIndexConfigLock sync.Mutex
 
 	metrics         *Metrics
 	centralJobQueue chan job
+
+	writeGeneration atomic.Uint64
+
+	// objectVersions is the number of versions kept per object, see
+	// maxObjectVersions
+	objectVersions atomic.Int64
+
+	// degradedMaxLimit caps the results per search under memory pressure,
+	// see memoryDegradation
+	degradedMaxLimit atomic.Int64
+
+	// vectorReindex tracks the last rebuild of the vector indexes, see
+	// ReindexVectorIndex
+	vectorReindex vectorReindexJob
+
+	// timestampIndexing tracks the indexing of the timestamps of existing
+	// objects, see startTimestampIndexing
+	timestampIndexing timestampIndexingJob
+
+	// ingestWorkers bounds the batches of the class which are written
+	// concurrently, see acquireIngest
+	ingestWorkers *workerPool
 }
 
 func (i *Index) ID() string {
@@ -108,14 +143,38 @@ func NewIndex(ctx context.Context, config IndexConfig,
 		replicator:            repl,
 		remote: sharding.NewRemoteIndex(config.ClassName.String(), sg,
 			nodeResolver, remoteClient),
+		nodeResolver:    nodeResolver,
 		metrics:         NewMetrics(logger, promMetrics, config.ClassName.String(), "n/a"),
 		centralJobQueue: jobQueueCh,
+		ingestWorkers:   newWorkerPool(0),
+	}
+
+	index.initWriteGeneration()
+	index.objectVersions.Store(int64(schema.MaxVersions(class)))
+	if config.LeaderWrites {
+		repl.EnableLeaderWrites(index)
 	}
+	repl.UseReplicationConfig(index.replicationConfig)
 
 	if err := index.checkSingleShardMigration(shardState); err != nil {
 		return nil, errors.Wrap(err, "migrating sharding state from previous version")
 	}
 
+	if err := index.initShards(ctx, shardState, promMetrics, class, jobQueueCh); err != nil {
+		return nil, err
+	}
+	index.resumeTimestampIndexing()
+
+	return index, nil
+}
+
+func (i *Index) initShards(ctx context.Context, shardState *sharding.State,
+	promMetrics *monitoring.PrometheusMetrics, class *models.Class, jobQueueCh chan job,
+) error {
+	var (
+		eg errgroup.Group
+		mu sync.Mutex
+	)
 	for _, shardName := range shardState.AllPhysicalShards() {
 
 		if !shardState.IsShardLocal(shardName) {
@@ -123,15 +182,32 @@ func NewIndex(ctx context.Context, config IndexConfig,
 			continue
 		}
 
-		shard, err := NewShard(ctx, promMetrics, shardName, index, class, jobQueueCh)
-		if err != nil {
-			return nil, errors.Wrapf(err, "init shard %s of index %s", shardName, index.ID())
+		shardName := shardName
+		load := func() error {
+			shard, err := NewShard(ctx, promMetrics, shardName, i, class, jobQueueCh)
+			if err != nil {
+				return errors.Wrapf(err, "init shard %s of index %s", shardName, i.ID())
+			}
+
+			mu.Lock()
+			i.Shards[shardName] = shard
+			mu.Unlock()
+			return nil
 		}
 
-		index.Shards[shardName] = shard
+		if i.Config.ShardLoadLimiter == nil {
+			if err := load(); err != nil {
+				return err
+			}
+			continue
+		}
+		eg.Go(func() error {
+			defer i.Config.ShardLoadLimiter.acquire()()
+			return load()
+		})
 	}
 
-	return index, nil
+	return eg.Wait()
 }
 
 func (i *Index) IterateObjects(ctx context.Context, cb func(index *Index, shard *Shard, object *storobj.Object) error) error {
@@ -187,6 +263,16 @@ func (i *Index) addTimestampProperties(ctx context.Context) error {
 	return nil
 }
 
+func (i *Index) addLanguageProperty(ctx context.Context) error {
+	for name, shard := range i.Shards {
+		if err := shard.addLanguageProperty(ctx); err != nil {
+			return errors.Wrapf(err, "add language property to shard %q", name)
+		}
+	}
+
+	return nil
+}
+
 func (i *Index) addNullStateProperty(ctx context.Context, prop *models.Property) error {
 	for name, shard := range i.Shards {
 		if err := shard.addNullState(ctx, prop); err != nil {
@@ -231,15 +317,57 @@ func (i *Index) getInvertedIndexConfig() schema.InvertedIndexConfig {
 	return i.invertedIndexConfig
 }
 
+// updateInvertedIndexConfig applies the updated config to the local shards.
+// If timestamp indexing is enabled, the timestamps of the existing objects
+// are indexed in the background, see TimestampIndexingStatus.
 func (i *Index) updateInvertedIndexConfig(ctx context.Context,
 	updated schema.InvertedIndexConfig,
 ) error {
+	enableTimestamps, err := i.applyInvertedIndexConfig(ctx, updated)
+	if err != nil {
+		return err
+	}
+
+	if enableTimestamps {
+		names := make([]string, 0, len(i.Shards))
+		for name := range i.Shards {
+			names = append(names, name)
+		}
+		i.startTimestampIndexing(names)
+	} else if !updated.IndexTimestamps {
+		i.stopTimestampIndexing()
+	}
+
+	return nil
+}
+
+func (i *Index) applyInvertedIndexConfig(ctx context.Context,
+	updated schema.InvertedIndexConfig,
+) (bool, error) {
 	i.invertedIndexConfigLock.Lock()
 	defer i.invertedIndexConfigLock.Unlock()
 
-	i.invertedIndexConfig = updated
+	enableTimestamps := updated.IndexTimestamps && !i.invertedIndexConfig.IndexTimestamps
+	if enableTimestamps {
+		// the buckets must exist before writes start indexing timestamps
+		for name, shard := range i.Shards {
+			if err := shard.addTimestampProperties(ctx); err != nil {
+				return false, errors.Wrapf(err, "add timestamp properties to shard %q", name)
+			}
+		}
+	}
 
-	return nil
+	if updated.WALRetention != i.invertedIndexConfig.WALRetention {
+		for name, shard := range i.Shards {
+			if err := shard.store.Bucket(helpers.ObjectsBucketLSM).
+				SetWALRetention(updated.WALRetention); err != nil {
+				return false, errors.Wrapf(err, "update wal retention of shard %q", name)
+			}
+		}
+	}
+
+	i.invertedIndexConfig = updated
+	return enableTimestamps, nil
 }
 
 type IndexConfig struct {
@@ -254,7 +382,23 @@ type IndexConfig struct {
 	MemtablesMaxActiveSeconds int
 	ReplicationFactor         int64
 
-	TrackVectorDimensions bool
+	TrackVectorDimensions      bool
+	QuarantineAfterWriteErrors int
+	LeaderWrites               bool
+	// RemoteSegments is set if older segments of the objects buckets are
+	// offloaded, the prefix is extended by each shard
+	RemoteSegments *lsmkv.RemoteSegments
+	// ShardLoadLimiter bounds the shards which are loaded concurrently, they
+	// are loaded one after another without it
+	ShardLoadLimiter loadLimiter
+	AsyncIndexing    config.AsyncIndexing
+	// CompactionScheduler limits the compactions of all shards of the node,
+	// they are not limited if it is nil
+	CompactionScheduler *lsmkv.CompactionScheduler
+	FilterCache         config.FilterCache
+	// WALCompression compresses the write-ahead logs of the buckets and the
+	// commit logs of the vector indexes
+	WALCompression walcompression.Codec
 }
 
 func indexID(class schema.ClassName) string {
@@ -621,6 +765,49 @@ func (i *Index) objectByID(ctx context.Context, id strfmt.UUID,
 	return obj, err
 }
 
+// objectByIDAtVersion returns a retained version of an object from the shard
+// holding it, see Shard.objectByIDAtVersion. Versions are stored by every
+// replica on its own, they are read from a single replica.
+func (i *Index) objectByIDAtVersion(ctx context.Context, id strfmt.UUID,
+	version int64,
+) (*storobj.Object, error) {
+	shardName, err := i.shardFromUUID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if i.isLocalShard(shardName) {
+		shard := i.Shards[shardName]
+		obj, err := shard.objectByIDAtVersion(ctx, id, version)
+		if err != nil {
+			return nil, fmt.Errorf("shard %s: %w", shard.ID(), err)
+		}
+		return obj, nil
+	}
+
+	obj, err := i.remote.GetObjectAtVersion(ctx, shardName, id, version)
+	if err != nil {
+		return nil, fmt.Errorf("get object version from remote index: %w", err)
+	}
+	return obj, nil
+}
+
+func (i *Index) IncomingGetObjectAtVersion(ctx context.Context, shardName string,
+	id strfmt.UUID, version int64,
+) (*storobj.Object, error) {
+	shard, ok := i.Shards[shardName]
+	if !ok {
+		return nil, errors.Errorf("shard %q does not exist locally", shardName)
+	}
+
+	obj, err := shard.objectByIDAtVersion(ctx, id, version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "shard %s", shard.ID())
+	}
+
+	return obj, nil
+}
+
 func (i *Index) IncomingGetObject(ctx context.Context, shardName string,
 	id strfmt.UUID, props search.SelectProperties,
 	additional additional.Properties,
@@ -777,6 +964,7 @@ func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor,
 	addlProps additional.Properties, replProps *additional.ReplicationProperties,
 ) ([]*storobj.Object, []float32, error) {
+	limit = i.degradedLimit(limit)
 	shardNames := i.getSchema.ShardingState(i.Config.ClassName.String()).
 		AllPhysicalShards()
 
@@ -807,12 +995,22 @@ func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 		}
 	}
 
-	outObjects, outScores, err := i.objectSearchByShard(ctx, limit,
+	shardLimit := limit
+	boost, boostBM25 := i.bm25Boost(keywordRanking, sort)
+	if boostBM25 && limit > 0 {
+		shardLimit = limit * boostCandidateFactor
+	}
+
+	outObjects, outScores, err := i.objectSearchByShard(ctx, shardLimit,
 		filters, keywordRanking, sort, cursor, addlProps, shardNames)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	if boostBM25 {
+		boostScores(outObjects, outScores, boost)
+	}
+
 	if len(outObjects) == len(outScores) {
 		if keywordRanking != nil && keywordRanking.Type == "bm25" {
 			for ii := range outObjects {
@@ -915,7 +1113,7 @@ func (i *Index) objectSearchByShard(ctx context.Context, limit int, filters *fil
 				}
 			} else {
 				objs, scores, err = i.remote.SearchShard(
-					ctx, shardName, nil, limit, filters, keywordRanking,
+					ctx, shardName, nil, "", nil, noDistanceThreshold, limit, filters, keywordRanking,
 					sort, cursor, addlProps, i.replicationEnabled())
 				if err != nil {
 					return fmt.Errorf(
@@ -957,9 +1155,10 @@ func (i *Index) sort(objects []*storobj.Object, scores []float32,
 }
 
 func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
-	dist float32, limit int, filters *filters.LocalFilter,
-	sort []filters.Sort, additional additional.Properties,
+	targetVector string, targets *searchparams.TargetVectors, dist float32, limit int,
+	filters *filters.LocalFilter, sort []filters.Sort, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
+	limit = i.degradedLimit(limit)
 	shardNames := i.getSchema.ShardingState(i.Config.ClassName.String()).
 		AllPhysicalShards()
 
@@ -990,14 +1189,19 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 
 			if local {
 				shard := i.Shards[shardName]
-				res, resDists, err = shard.objectVectorSearch(
-					ctx, searchVector, dist, limit, filters, sort, additional)
+				if targets != nil {
+					res, resDists, err = shard.objectMultiTargetVectorSearch(
+						ctx, *targets, dist, limit, filters, sort, additional)
+				} else {
+					res, resDists, err = shard.objectVectorSearch(
+						ctx, searchVector, targetVector, dist, limit, filters, sort, additional)
+				}
 				if err != nil {
 					return errors.Wrapf(err, "shard %s", shard.ID())
 				}
 			} else {
 				res, resDists, err = i.remote.SearchShard(ctx,
-					shardName, searchVector, limit, filters,
+					shardName, searchVector, targetVector, targets, dist, limit, filters,
 					nil, sort, nil, additional, i.replicationEnabled())
 				if err != nil {
 					return errors.Wrapf(err, "remote shard %s", shardName)
@@ -1026,6 +1230,11 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 	}
 
 	out, dists = newDistancesSorter().sort(out, dists)
+	if dist != noDistanceThreshold {
+		// nodes which don't support distance thresholds return their closest
+		// results regardless of the distance
+		out, dists = cutAtDistance(out, dists, dist)
+	}
 	if limit > 0 && len(out) > limit {
 		out = out[:limit]
 		dists = dists[:limit]
@@ -1034,8 +1243,22 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 	return out, dists, nil
 }
 
+// cutAtDistance drops the results of a sorted result set which are further
+// away than the target distance
+func cutAtDistance(objs []*storobj.Object, dists []float32,
+	target float32,
+) ([]*storobj.Object, []float32) {
+	for pos, dist := range dists {
+		if dist > target && !floatcomp.InDelta(float64(dist), float64(target), 1e-6) {
+			return objs[:pos], dists[:pos]
+		}
+	}
+	return objs, dists
+}
+
 func (i *Index) IncomingSearch(ctx context.Context, shardName string,
-	searchVector []float32, distance float32, limit int, filters *filters.LocalFilter,
+	searchVector []float32, targetVector string, targets *searchparams.TargetVectors,
+	distance float32, limit int, filter *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
@@ -1044,9 +1267,19 @@ func (i *Index) IncomingSearch(ctx context.Context, shardName string,
 		return nil, nil, errors.Errorf("shard %q does not exist locally", shardName)
 	}
 
+	if targets != nil {
+		res, resDists, err := shard.objectMultiTargetVectorSearch(
+			ctx, *targets, distance, limit, filter, sort, additional)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
+		}
+
+		return res, resDists, nil
+	}
+
 	if searchVector == nil {
 		// TODO: after
-		res, scores, err := shard.objectSearch(ctx, limit, filters, keywordRanking, sort, cursor, additional)
+		res, scores, err := shard.objectSearch(ctx, limit, filter, keywordRanking, sort, cursor, additional)
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 		}
@@ -1054,8 +1287,8 @@ func (i *Index) IncomingSearch(ctx context.Context, shardName string,
 		return res, scores, nil
 	}
 
-	res, resDists, err := shard.objectVectorSearch(
-		ctx, searchVector, distance, limit, filters, sort, additional)
+	res, resDists, err := shard.objectVectorSearchAfter(ctx, searchVector, targetVector,
+		distance, limit, filter, sort, additional, filters.ShardVectorCursorFromContext(ctx))
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -1171,6 +1404,14 @@ func (i *Index) IncomingMergeObject(ctx context.Context, shardName string,
 func (i *Index) aggregate(ctx context.Context,
 	params aggregation.Params,
 ) (*aggregation.Result, error) {
+	if params.Sample != nil && params.Sample.MaxPriority == nil {
+		sample, err := i.sampleMaxPriority(ctx, params.Filters, *params.Sample)
+		if err != nil {
+			return nil, errors.Wrap(err, "sample")
+		}
+		params.Sample = &sample
+	}
+
 	shardState := i.getSchema.ShardingState(i.Config.ClassName.String())
 	shardNames := shardState.AllPhysicalShards()
 
@@ -1213,6 +1454,9 @@ func (i *Index) IncomingAggregate(ctx context.Context, shardName string,
 }
 
 func (i *Index) drop() error {
+	i.stopVectorReindex()
+	i.stopTimestampIndexing()
+
 	i.backupStateLock.RLock()
 	defer i.backupStateLock.RUnlock()
 	for _, name := range i.getSchema.ShardingState(i.Config.ClassName.String()).
@@ -1232,16 +1476,39 @@ func (i *Index) drop() error {
 	return nil
 }
 
+// dropShard drops a local shard which the node doesn't own anymore. Writes
+// to the index are blocked until the shard is removed.
+func (i *Index) dropShard(name string) error {
+	i.backupStateLock.Lock()
+	defer i.backupStateLock.Unlock()
+
+	shard, ok := i.Shards[name]
+	if !ok {
+		return nil
+	}
+	if i.backupState.InProgress {
+		return errors.Errorf("cannot drop shard %q while backup %q is in progress",
+			name, i.backupState.BackupID)
+	}
+
+	delete(i.Shards, name)
+	return shard.drop()
+}
+
 func (i *Index) Shutdown(ctx context.Context) error {
+	i.stopVectorReindex()
+	i.stopTimestampIndexing()
+
 	i.backupStateLock.RLock()
 	defer i.backupStateLock.RUnlock()
+	var errs errorcompounder.ErrorCompounder
 	for id, shard := range i.Shards {
 		if err := shard.shutdown(ctx); err != nil {
-			return errors.Wrapf(err, "shutdown shard %q", id)
+			errs.AddWrap(err, fmt.Sprintf("shutdown shard %q", id))
 		}
 	}
 
-	return nil
+	return errs.ToError()
 }
 
 func (i *Index) getShardsStatus(ctx context.Context) (map[string]string, error) {
@@ -1313,6 +1580,26 @@ func (i *Index) IncomingUpdateShardStatus(ctx context.Context, shardName, target
 	return shard.updateStatus(targetStatus)
 }
 
+func (i *Index) IncomingCheckVectorIndexIntegrity(ctx context.Context,
+	shardName string, repair bool,
+) (hnswent.IntegrityReport, error) {
+	shard, ok := i.Shards[shardName]
+	if !ok {
+		return hnswent.IntegrityReport{}, errors.Errorf("shard %q does not exist locally", shardName)
+	}
+	return shard.checkVectorIndexIntegrity(ctx, repair)
+}
+
+func (i *Index) IncomingChangesSince(ctx context.Context, shardName, token string,
+	limit int,
+) (*changes.Changes, error) {
+	shard, ok := i.Shards[shardName]
+	if !ok {
+		return nil, errors.Errorf("shard %q does not exist locally", shardName)
+	}
+	return shard.changesSince(ctx, token, limit)
+}
+
 func (i *Index) notifyReady() {
 	for _, shd := range i.Shards {
 		shd.notifyReady()
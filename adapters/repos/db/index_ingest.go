@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// withIngestConfig applies the per-class overrides of the memtable settings
+// of the node. They are passed to the buckets when they are loaded, so
+// changes apply once the shards of the class are loaded again.
+func withIngestConfig(cfg IndexConfig, ingest *models.IngestConfig) IndexConfig {
+	if ingest == nil {
+		return cfg
+	}
+	if ingest.MemtablesMaxSizeMB > 0 {
+		cfg.MemtablesMaxSizeMB = int(ingest.MemtablesMaxSizeMB)
+		if cfg.MemtablesInitialSizeMB > cfg.MemtablesMaxSizeMB {
+			cfg.MemtablesInitialSizeMB = cfg.MemtablesMaxSizeMB
+		}
+	}
+	if ingest.MemtablesMaxActiveSeconds > 0 {
+		cfg.MemtablesMaxActiveSeconds = int(ingest.MemtablesMaxActiveSeconds)
+		if cfg.MemtablesMinActiveSeconds > cfg.MemtablesMaxActiveSeconds {
+			cfg.MemtablesMinActiveSeconds = cfg.MemtablesMaxActiveSeconds
+		}
+	}
+	return cfg
+}
+
+// ingestConcurrency is the number of batches of the class which may be
+// written concurrently, 0 if only the batch workers of the node apply. It
+// is read from the schema, so updates of the class apply right away.
+func (i *Index) ingestConcurrency() int {
+	sch := i.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(i.Config.ClassName)
+	if class == nil || class.IngestConfig == nil {
+		return 0
+	}
+	return int(class.IngestConfig.Concurrency)
+}
+
+// acquireIngest blocks until a batch of the class may be written, the
+// returned function must be called once it is written
+func (i *Index) acquireIngest(ctx context.Context) (func(), error) {
+	i.ingestWorkers.resize(i.ingestConcurrency())
+	return i.ingestWorkers.acquire(ctx)
+}
+
+// putIngestBatch writes the batch once the ingest concurrency of the class
+// allows it
+func (i *Index) putIngestBatch(ctx context.Context, objects []*storobj.Object,
+	repl *additional.ReplicationProperties,
+) []error {
+	release, err := i.acquireIngest(ctx)
+	if err != nil {
+		err = errors.Wrap(err, "wait for ingest worker")
+		errs := make([]error, len(objects))
+		for j := range errs {
+			errs[j] = err
+		}
+		return errs
+	}
+	defer release()
+
+	return i.putObjectBatch(ctx, objects, repl)
+}
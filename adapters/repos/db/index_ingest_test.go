@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestWithIngestConfig(t *testing.T) {
+	node := IndexConfig{
+		MemtablesInitialSizeMB:    10,
+		MemtablesMaxSizeMB:        200,
+		MemtablesMinActiveSeconds: 5,
+		MemtablesMaxActiveSeconds: 60,
+	}
+
+	t.Run("not set", func(t *testing.T) {
+		assert.Equal(t, node, withIngestConfig(node, nil))
+		assert.Equal(t, node, withIngestConfig(node, &models.IngestConfig{}))
+	})
+
+	t.Run("overrides", func(t *testing.T) {
+		cfg := withIngestConfig(node, &models.IngestConfig{
+			MemtablesMaxSizeMB:        1024,
+			MemtablesMaxActiveSeconds: 600,
+		})
+		assert.Equal(t, 10, cfg.MemtablesInitialSizeMB)
+		assert.Equal(t, 1024, cfg.MemtablesMaxSizeMB)
+		assert.Equal(t, 5, cfg.MemtablesMinActiveSeconds)
+		assert.Equal(t, 600, cfg.MemtablesMaxActiveSeconds)
+	})
+
+	t.Run("below the minimums of the node", func(t *testing.T) {
+		cfg := withIngestConfig(node, &models.IngestConfig{
+			MemtablesMaxSizeMB:        4,
+			MemtablesMaxActiveSeconds: 2,
+		})
+		assert.Equal(t, 4, cfg.MemtablesInitialSizeMB)
+		assert.Equal(t, 4, cfg.MemtablesMaxSizeMB)
+		assert.Equal(t, 2, cfg.MemtablesMinActiveSeconds)
+		assert.Equal(t, 2, cfg.MemtablesMaxActiveSeconds)
+	})
+}
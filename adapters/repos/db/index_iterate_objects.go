@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/replica"
+	"golang.org/x/sync/errgroup"
+)
+
+// shardObjectIterator buffers the next objects of a shard in uuid order
+type shardObjectIterator struct {
+	shard string
+	buf   []*storobj.Object
+	after string
+	done  bool
+}
+
+// iterateObjects returns the next limit objects of all shards in uuid order
+// after the cursor, and the cursor to continue with, which is nil once all
+// objects have been returned. Instead of every shard returning limit objects
+// which are merged, the shards are merged batch by batch, so that a page only
+// loads about limit objects plus one batch per shard. This makes exporting a
+// class with many shards page by page about as cheap as with a single shard.
+func (i *Index) iterateObjects(ctx context.Context, cursor *filters.Cursor,
+	limit int, addl additional.Properties,
+) ([]*storobj.Object, *filters.Cursor, error) {
+	if limit <= 0 {
+		return nil, cursor, nil
+	}
+
+	after := ""
+	if cursor != nil {
+		after = cursor.After
+	}
+
+	shardNames := i.getSchema.ShardingState(i.Config.ClassName.String()).
+		AllPhysicalShards()
+	batchSize := limit/len(shardNames) + 1
+
+	its := make([]*shardObjectIterator, len(shardNames))
+	eg := errgroup.Group{}
+	for j, shardName := range shardNames {
+		it := &shardObjectIterator{shard: shardName, after: after}
+		its[j] = it
+		eg.Go(func() error {
+			return i.nextShardObjects(ctx, it, batchSize, addl)
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	out := make([]*storobj.Object, 0, limit)
+	for len(out) < limit {
+		var next *shardObjectIterator
+		for _, it := range its {
+			if len(it.buf) == 0 && !it.done {
+				if err := i.nextShardObjects(ctx, it, batchSize, addl); err != nil {
+					return nil, nil, err
+				}
+			}
+			if len(it.buf) == 0 {
+				continue
+			}
+			if next == nil || it.buf[0].ID() < next.buf[0].ID() {
+				next = it
+			}
+		}
+		if next == nil {
+			break
+		}
+
+		out = append(out, next.buf[0])
+		next.buf = next.buf[1:]
+	}
+
+	if i.replicationEnabled() {
+		var err error
+		out, _, err = i.replicator.CheckConsistency(ctx, replica.One, out, nil)
+		if err != nil {
+			return nil, nil, fmt.Errorf(
+				"failed to check consistency of iterated objects: %w", err)
+		}
+	}
+
+	if len(out) < limit {
+		return out, nil, nil
+	}
+	return out, &filters.Cursor{After: out[len(out)-1].ID().String(), Limit: limit}, nil
+}
+
+// nextShardObjects loads the next batch of objects of the shard into the
+// buffer of the iterator
+func (i *Index) nextShardObjects(ctx context.Context, it *shardObjectIterator,
+	batchSize int, addl additional.Properties,
+) error {
+	cursor := &filters.Cursor{After: it.after, Limit: batchSize}
+
+	var objs []*storobj.Object
+	var err error
+	if i.isLocalShard(it.shard) {
+		shard := i.Shards[it.shard]
+		objs, err = shard.cursorObjectList(ctx, cursor, addl, i.Config.ClassName)
+		if err != nil {
+			return fmt.Errorf("local shard iterate objects %s: %w", shard.ID(), err)
+		}
+		if i.replicationEnabled() {
+			storobj.AddOwnership(objs, i.getSchema.NodeName(), it.shard)
+		}
+	} else {
+		objs, _, err = i.remote.SearchShard(ctx, it.shard, nil, "", nil, noDistanceThreshold, batchSize, nil,
+			nil, nil, cursor, addl, i.replicationEnabled())
+		if err != nil {
+			return fmt.Errorf("remote shard iterate objects %s: %w", it.shard, err)
+		}
+	}
+
+	it.buf = objs
+	it.done = len(objs) < batchSize
+	if len(objs) > 0 {
+		it.after = objs[len(objs)-1].ID().String()
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestIterateObjects_MultiShard(t *testing.T) {
+	dirName := t.TempDir()
+
+	class := &models.Class{
+		Class:               "IterateObjectsClass",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "name",
+				DataType: []string{"text"},
+			},
+		},
+	}
+	shardState := multiShardState()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState, schema: schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, shardState))
+
+	count := 45
+	expected := make([]strfmt.UUID, count)
+	for i := 0; i < count; i++ {
+		id := strfmt.UUID(fmt.Sprintf("%08x-62e4-4e5b-9a9e-0a4e0f3d9b71", i*7919%1000))
+		expected[i] = id
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			ID:         id,
+			Class:      class.Class,
+			Properties: map[string]interface{}{"name": fmt.Sprintf("object %d", i)},
+		}, []float32{1, 2, 3}, nil))
+	}
+	sort.Slice(expected, func(a, b int) bool { return expected[a] < expected[b] })
+
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+
+	t.Run("iterate all objects page by page", func(t *testing.T) {
+		var cursor *filters.Cursor
+		var ids []strfmt.UUID
+		pages := 0
+		for {
+			objs, next, err := idx.iterateObjects(context.Background(), cursor, 7,
+				additional.Properties{})
+			require.Nil(t, err)
+			for _, obj := range objs {
+				ids = append(ids, obj.ID())
+			}
+			pages++
+			if next == nil {
+				break
+			}
+			cursor = next
+		}
+		assert.Equal(t, expected, ids)
+		assert.Equal(t, 7, pages)
+	})
+
+	t.Run("resume after an id", func(t *testing.T) {
+		objs, _, err := idx.iterateObjects(context.Background(),
+			&filters.Cursor{After: expected[19].String()}, 10, additional.Properties{})
+		require.Nil(t, err)
+		require.Len(t, objs, 10)
+		for j, obj := range objs {
+			assert.Equal(t, expected[20+j], obj.ID())
+		}
+	})
+
+	t.Run("query with after", func(t *testing.T) {
+		res, qerr := repo.Query(context.Background(), &objects.QueryInput{
+			Class:  class.Class,
+			Limit:  5,
+			Cursor: &filters.Cursor{After: expected[39].String(), Limit: 5},
+		})
+		require.Nil(t, qerr)
+		require.Len(t, res, 5)
+		for j := range res {
+			assert.Equal(t, expected[40+j], res[j].ID)
+		}
+	})
+}
@@ -0,0 +1,664 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+const (
+	indexQueueOpAdd    byte = 1
+	indexQueueOpDelete byte = 2
+
+	// op, doc id and vector length
+	indexQueueHeaderSize = 1 + 8 + 4
+)
+
+// indexQueue is the durable queue of the vector index operations of a shard
+// with async indexing. Operations are appended to the queue file and applied
+// in order by a background worker, which persists the offset up to which
+// they have been applied after flushing the vector index. The queue file is
+// truncated once all operations have been applied.
+//
+// Deletes go through the queue as well, so an update or delete of an object
+// whose vector is still queued is applied after its insertion.
+//
+// While the queue is deferred, operations are only appended. The insertions
+// of a batch are applied by parallel workers, so a deferred queue is built
+// considerably faster than by inserting its vectors one by one.
+type indexQueue struct {
+	sync.Mutex
+	path      string
+	file      *os.File
+	size      int64
+	offset    int64
+	pending   atomic.Int64
+	deferred  atomic.Bool
+	batchSize int
+	workers   int
+	index     VectorIndex
+	logger    logrus.FieldLogger
+
+	// workerLock is held while a batch is applied, pause takes it to keep
+	// the queue and the vector index in sync during backups
+	workerLock sync.Mutex
+	paused     bool
+	notify     chan struct{}
+	stop       chan struct{}
+	done       chan struct{}
+}
+
+func newIndexQueue(path string, index VectorIndex, batchSize int, deferred bool,
+	logger logrus.FieldLogger,
+) (*indexQueue, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o666)
+	if err != nil {
+		return nil, errors.Wrap(err, "open index queue")
+	}
+
+	q := &indexQueue{
+		path:      path,
+		file:      file,
+		batchSize: batchSize,
+		workers:   runtime.GOMAXPROCS(0),
+		index:     index,
+		logger:    logger,
+		notify:    make(chan struct{}, 1),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+
+	q.deferred.Store(deferred)
+
+	if err := q.load(); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	go q.run()
+	return q, nil
+}
+
+// load restores the offset and the number of pending operations. A partially
+// written operation at the end of the queue, e.g. after a crash, is cut off.
+func (q *indexQueue) load() error {
+	info, err := q.file.Stat()
+	if err != nil {
+		return errors.Wrap(err, "stat index queue")
+	}
+	q.size = info.Size()
+
+	raw, err := os.ReadFile(q.offsetPath())
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "read index queue offset")
+	}
+	if len(raw) == 8 {
+		q.offset = int64(binary.LittleEndian.Uint64(raw))
+	}
+	// the queue was truncated, but the offset not reset
+	if q.offset > q.size {
+		q.offset = 0
+	}
+
+	r := bufio.NewReader(io.NewSectionReader(q.file, q.offset, q.size-q.offset))
+	end := q.offset
+	for {
+		n, err := skipIndexQueueOp(r)
+		if err != nil {
+			break
+		}
+		end += n
+		q.pending.Add(1)
+	}
+
+	if end < q.size {
+		if err := q.file.Truncate(end); err != nil {
+			return errors.Wrap(err, "truncate partial index queue operation")
+		}
+		q.size = end
+	}
+	return nil
+}
+
+// Add queues the insertion of a vector
+func (q *indexQueue) Add(docID uint64, vector []float32) error {
+	buf := make([]byte, indexQueueHeaderSize+4*len(vector))
+	buf[0] = indexQueueOpAdd
+	binary.LittleEndian.PutUint64(buf[1:9], docID)
+	binary.LittleEndian.PutUint32(buf[9:13], uint32(len(vector)))
+	for i, v := range vector {
+		binary.LittleEndian.PutUint32(buf[13+4*i:], math.Float32bits(v))
+	}
+	return q.append(buf, 1)
+}
+
+// Delete queues the deletion of the vectors of docIDs
+func (q *indexQueue) Delete(docIDs ...uint64) error {
+	if len(docIDs) == 0 {
+		return nil
+	}
+	buf := make([]byte, indexQueueHeaderSize*len(docIDs))
+	for i, docID := range docIDs {
+		op := buf[i*indexQueueHeaderSize:]
+		op[0] = indexQueueOpDelete
+		binary.LittleEndian.PutUint64(op[1:9], docID)
+	}
+	return q.append(buf, len(docIDs))
+}
+
+func (q *indexQueue) append(buf []byte, ops int) error {
+	q.Lock()
+	n, err := q.file.Write(buf)
+	q.size += int64(n)
+	if err != nil {
+		q.Unlock()
+		return errors.Wrap(err, "append to index queue")
+	}
+	q.pending.Add(int64(ops))
+	q.Unlock()
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Len is the number of operations which have not been applied to the vector
+// index yet
+func (q *indexQueue) Len() int64 {
+	return q.pending.Load()
+}
+
+// setDeferred stops or resumes applying operations, resuming starts the
+// build of the vector index from the queued vectors
+func (q *indexQueue) setDeferred(deferred bool) {
+	if q.deferred.Swap(deferred) == deferred || deferred {
+		return
+	}
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (q *indexQueue) status() string {
+	switch {
+	case q.deferred.Load():
+		return vectorIndexingDeferred
+	case q.Len() > 0:
+		return vectorIndexingIndexing
+	default:
+		return vectorIndexingReady
+	}
+}
+
+func (q *indexQueue) run() {
+	defer close(q.done)
+
+	t := time.NewTicker(time.Second)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-q.stop:
+			return
+		case <-q.notify:
+		case <-t.C:
+		}
+
+		if q.deferred.Load() {
+			continue
+		}
+
+		for {
+			applied, err := q.applyBatch()
+			if err != nil {
+				q.logger.WithField("action", "async_indexing").
+					WithField("path", q.path).WithError(err).
+					Error("could not apply queued vector index operations")
+				break
+			}
+			if applied == 0 {
+				break
+			}
+
+			select {
+			case <-q.stop:
+				return
+			default:
+			}
+			if q.deferred.Load() {
+				break
+			}
+		}
+	}
+}
+
+// applyBatch applies up to batchSize operations to the vector index and
+// returns the number of operations applied
+func (q *indexQueue) applyBatch() (int, error) {
+	q.workerLock.Lock()
+	defer q.workerLock.Unlock()
+
+	select {
+	case <-q.stop:
+		return 0, nil
+	default:
+	}
+
+	q.Lock()
+	start, end := q.offset, q.size
+	q.Unlock()
+
+	if start == end {
+		return 0, q.truncateIfDrained()
+	}
+
+	r := bufio.NewReader(io.NewSectionReader(q.file, start, end-start))
+	var consumed int64
+	var ops []indexQueueOp
+	for len(ops) < q.batchSize {
+		op, err := readIndexQueueOp(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, errors.Wrap(err, "read index queue")
+		}
+		consumed += op.size
+		ops = append(ops, op)
+	}
+
+	q.applyOps(ops)
+
+	if err := q.index.Flush(); err != nil {
+		return 0, errors.Wrap(err, "flush vector index")
+	}
+
+	q.Lock()
+	defer q.Unlock()
+	q.offset = start + consumed
+	q.pending.Add(-int64(len(ops)))
+	return len(ops), q.writeOffset()
+}
+
+// applyOps applies the deletes of a batch before inserting its vectors in
+// parallel. Doc ids are never reused, so the insertion of a vector which is
+// deleted in the same batch is skipped together with its delete, all other
+// deletes refer to vectors of earlier batches.
+func (q *indexQueue) applyOps(ops []indexQueueOp) {
+	added := map[uint64]struct{}{}
+	for _, op := range ops {
+		if op.op == indexQueueOpAdd {
+			added[op.docID] = struct{}{}
+		}
+	}
+	deleted := map[uint64]struct{}{}
+	for _, op := range ops {
+		if op.op != indexQueueOpDelete {
+			continue
+		}
+		deleted[op.docID] = struct{}{}
+		if _, ok := added[op.docID]; ok {
+			continue
+		}
+		if err := q.index.Delete(op.docID); err != nil {
+			q.logOpError(op, err)
+		}
+	}
+
+	adds := make(chan indexQueueOp)
+	wg := sync.WaitGroup{}
+	for i := 0; i < q.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for op := range adds {
+				if err := q.index.Add(op.docID, op.vector); err != nil {
+					q.logOpError(op, err)
+				}
+			}
+		}()
+	}
+	for _, op := range ops {
+		if _, ok := deleted[op.docID]; op.op == indexQueueOpAdd && !ok {
+			adds <- op
+		}
+	}
+	close(adds)
+	wg.Wait()
+}
+
+// logOpError logs operations which could not be applied, they are not
+// retried as they would block the queue
+func (q *indexQueue) logOpError(op indexQueueOp, err error) {
+	q.logger.WithField("action", "async_indexing").
+		WithField("path", q.path).WithField("doc_id", op.docID).WithError(err).
+		Warn("could not apply queued vector index operation")
+}
+
+func (q *indexQueue) truncateIfDrained() error {
+	q.Lock()
+	defer q.Unlock()
+
+	if q.size == 0 || q.offset != q.size {
+		return nil
+	}
+	if err := q.file.Truncate(0); err != nil {
+		return errors.Wrap(err, "truncate index queue")
+	}
+	q.size, q.offset = 0, 0
+	return q.writeOffset()
+}
+
+func (q *indexQueue) writeOffset() error {
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, uint64(q.offset))
+	if err := os.WriteFile(q.offsetPath(), buf, 0o666); err != nil {
+		return errors.Wrap(err, "write index queue offset")
+	}
+	return nil
+}
+
+func (q *indexQueue) offsetPath() string {
+	return q.path + ".offset"
+}
+
+// setIndex replaces the index the operations are applied to, it waits for
+// the batch which is applied at the moment
+func (q *indexQueue) setIndex(index VectorIndex) {
+	q.workerLock.Lock()
+	defer q.workerLock.Unlock()
+	q.index = index
+}
+
+// pause stops applying operations until resume is called
+func (q *indexQueue) pause() {
+	q.workerLock.Lock()
+	q.Lock()
+	q.paused = true
+	q.Unlock()
+}
+
+// resume is a no-op if the queue isn't paused
+func (q *indexQueue) resume() {
+	q.Lock()
+	paused := q.paused
+	q.paused = false
+	q.Unlock()
+	if paused {
+		q.workerLock.Unlock()
+	}
+}
+
+// files lists the queue files relative to the root path of the index
+func (q *indexQueue) files() []string {
+	files := []string{filepath.Base(q.path)}
+	if _, err := os.Stat(q.offsetPath()); err == nil {
+		files = append(files, filepath.Base(q.offsetPath()))
+	}
+	return files
+}
+
+// Close stops the worker, queued operations are applied after the next
+// startup
+func (q *indexQueue) Close() error {
+	close(q.stop)
+	q.resume()
+	<-q.done
+	return q.file.Close()
+}
+
+func (q *indexQueue) Drop() error {
+	if err := q.Close(); err != nil {
+		return err
+	}
+	if err := os.Remove(q.path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove index queue")
+	}
+	if err := os.Remove(q.offsetPath()); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove index queue offset")
+	}
+	return nil
+}
+
+type indexQueueOp struct {
+	op     byte
+	docID  uint64
+	vector []float32
+	size   int64
+}
+
+func readIndexQueueOp(r *bufio.Reader) (indexQueueOp, error) {
+	header := make([]byte, indexQueueHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return indexQueueOp{}, io.EOF
+		}
+		return indexQueueOp{}, err
+	}
+	op := indexQueueOp{
+		op:    header[0],
+		docID: binary.LittleEndian.Uint64(header[1:9]),
+	}
+	dims := binary.LittleEndian.Uint32(header[9:13])
+	op.size = int64(indexQueueHeaderSize + 4*dims)
+
+	if dims > 0 {
+		raw := make([]byte, 4*dims)
+		if _, err := io.ReadFull(r, raw); err != nil {
+			if err == io.ErrUnexpectedEOF {
+				return indexQueueOp{}, io.EOF
+			}
+			return indexQueueOp{}, err
+		}
+		op.vector = make([]float32, dims)
+		for i := range op.vector {
+			op.vector[i] = math.Float32frombits(binary.LittleEndian.Uint32(raw[4*i:]))
+		}
+	}
+
+	return op, nil
+}
+
+func skipIndexQueueOp(r *bufio.Reader) (int64, error) {
+	header := make([]byte, indexQueueHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, err
+	}
+	dims := int(binary.LittleEndian.Uint32(header[9:13]))
+	if n, err := r.Discard(4 * dims); err != nil || n != 4*dims {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return int64(indexQueueHeaderSize + 4*dims), nil
+}
+
+const (
+	vectorIndexingReady    = "READY"
+	vectorIndexingIndexing = "INDEXING"
+	vectorIndexingDeferred = "DEFERRED"
+)
+
+func (s *Shard) indexQueuePath() string {
+	return filepath.Join(s.index.Config.RootPath, s.ID()+".indexqueue")
+}
+
+// initIndexQueue creates the index queue with async or deferred indexing.
+// Without them, the queue is only created to apply the operations left in
+// it. The vectors of all objects are queued if the vector index was skipped
+// when the shard was restored from a backup.
+func (s *Shard) initIndexQueue(ctx context.Context, deferred bool) error {
+	rebuildPath := filepath.Join(s.index.Config.RootPath,
+		s.ID()+backup.VectorIndexRebuildSuffix)
+	_, err := os.Stat(rebuildPath)
+	rebuild := err == nil
+
+	if !s.index.Config.AsyncIndexing.Enabled && !deferred && !rebuild {
+		info, err := os.Stat(s.indexQueuePath())
+		if err != nil || info.Size() == 0 {
+			return nil
+		}
+	}
+
+	q, err := s.ensureIndexQueue(deferred)
+	if err != nil {
+		return err
+	}
+
+	if rebuild {
+		if err := s.queueAllVectors(ctx, q); err != nil {
+			return errors.Wrap(err, "queue vectors of restored objects")
+		}
+		if err := os.Remove(rebuildPath); err != nil {
+			return errors.Wrap(err, "remove vector index rebuild marker")
+		}
+	}
+	return nil
+}
+
+func (s *Shard) ensureIndexQueue(deferred bool) (*indexQueue, error) {
+	s.vectorQueueLock.Lock()
+	defer s.vectorQueueLock.Unlock()
+
+	if q := s.vectorQueue.Load(); q != nil {
+		q.setDeferred(deferred)
+		return q, nil
+	}
+
+	batchSize := s.index.Config.AsyncIndexing.BatchSize
+	if batchSize <= 0 {
+		batchSize = config.DefaultAsyncIndexingBatchSize
+	}
+	q, err := newIndexQueue(s.indexQueuePath(), s.queueIndex(), batchSize,
+		deferred, s.index.logger)
+	if err != nil {
+		return nil, err
+	}
+	s.vectorQueue.Store(q)
+	return q, nil
+}
+
+// setDeferIndexing defers the indexing of vectors or builds the vector index
+// from the deferred vectors
+func (s *Shard) setDeferIndexing(deferred bool) error {
+	if !deferred {
+		if q := s.vectorQueue.Load(); q != nil {
+			q.setDeferred(false)
+		}
+		return nil
+	}
+
+	_, err := s.ensureIndexQueue(true)
+	return err
+}
+
+func (s *Shard) queueAllVectors(ctx context.Context, q *indexQueue) error {
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	if bucket == nil {
+		return errors.Errorf("objects bucket not found")
+	}
+
+	c := bucket.Cursor()
+	defer c.Close()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		obj, err := storobj.FromBinary(v)
+		if err != nil {
+			return errors.Wrapf(err, "unmarshal object %s", string(k))
+		}
+		if len(obj.Vector) == 0 {
+			continue
+		}
+		if err := q.Add(obj.DocID(), obj.Vector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addToVectorIndex inserts the vector into the vector index or queues it
+// with async or deferred indexing
+func (s *Shard) addToVectorIndex(docID uint64, vector []float32) error {
+	if q := s.vectorQueue.Load(); q != nil {
+		return q.Add(docID, vector)
+	}
+
+	s.vectorIndexLock.RLock()
+	defer s.vectorIndexLock.RUnlock()
+	if err := s.vectorIndex.Add(docID, vector); err != nil {
+		return err
+	}
+	if r := s.vectorReindex.Load(); r != nil {
+		return r.add(docID, vector)
+	}
+	return nil
+}
+
+// deleteFromVectorIndex deletes the vectors from the vector index or queues
+// their deletion with async or deferred indexing. The named vectors are
+// always deleted right away.
+func (s *Shard) deleteFromVectorIndex(docIDs ...uint64) error {
+	if err := s.deleteFromTargetVectorIndexes(docIDs...); err != nil {
+		return err
+	}
+	if q := s.vectorQueue.Load(); q != nil {
+		return q.Delete(docIDs...)
+	}
+
+	s.vectorIndexLock.RLock()
+	defer s.vectorIndexLock.RUnlock()
+	if err := s.vectorIndex.Delete(docIDs...); err != nil {
+		return err
+	}
+	if r := s.vectorReindex.Load(); r != nil {
+		return r.delete(docIDs...)
+	}
+	return nil
+}
+
+// vectorQueueLength is the number of vector index operations waiting to be
+// applied with async or deferred indexing
+func (s *Shard) vectorQueueLength() int64 {
+	if q := s.vectorQueue.Load(); q != nil {
+		return q.Len()
+	}
+	return 0
+}
+
+func (s *Shard) vectorIndexingStatus() string {
+	if q := s.vectorQueue.Load(); q != nil {
+		return q.status()
+	}
+	return vectorIndexingReady
+}
@@ -0,0 +1,228 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestAsyncIndexing(t *testing.T) {
+	dirName := t.TempDir()
+
+	class := &models.Class{
+		Class:               "AsyncIndexingClass",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "name",
+				DataType: []string{"text"},
+			},
+		},
+	}
+	shardState := singleShardState()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState, schema: schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}}
+	newRepo := func() *DB {
+		repo, err := New(logger, Config{
+			MemtablesFlushIdleAfter:   60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+			AsyncIndexing:             config.AsyncIndexing{Enabled: true, BatchSize: 4},
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+
+	repo := newRepo()
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, shardState))
+
+	shardName := shardState.AllPhysicalShards()[0]
+	shard := repo.GetIndex(schema.ClassName(class.Class)).Shards[shardName]
+	require.NotNil(t, shard.vectorQueue.Load())
+
+	ids := make([]strfmt.UUID, 10)
+	for i := range ids {
+		ids[i] = strfmt.UUID(fmt.Sprintf("4b1c6e2d-8a3f-4f0e-b5d9-%012d", i))
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			ID:         ids[i],
+			Class:      class.Class,
+			Properties: map[string]interface{}{"name": fmt.Sprintf("object %d", i)},
+		}, []float32{1, float32(i), 3, 4}, nil))
+	}
+
+	t.Run("queued vectors are indexed in the background", func(t *testing.T) {
+		assert.Eventually(t, func() bool { return shard.vectorQueueLength() == 0 },
+			10*time.Second, 50*time.Millisecond)
+
+		found, _, err := shard.vectorIndex.SearchByVector([]float32{1, 2, 3, 4}, 20, nil)
+		require.Nil(t, err)
+		assert.Len(t, found, len(ids))
+	})
+
+	t.Run("deletes are applied after the queued insertion", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(context.Background(), class.Class, ids[0], nil))
+		assert.Eventually(t, func() bool { return shard.vectorQueueLength() == 0 },
+			10*time.Second, 50*time.Millisecond)
+
+		found, _, err := shard.vectorIndex.SearchByVector([]float32{1, 2, 3, 4}, 20, nil)
+		require.Nil(t, err)
+		assert.Len(t, found, len(ids)-1)
+	})
+
+	t.Run("the queue length is reported in the node status", func(t *testing.T) {
+		status := repo.localNodeStatus()
+		require.Len(t, status.Shards, 1)
+		assert.Equal(t, int64(0), status.Shards[0].VectorQueueLength)
+	})
+
+	t.Run("queued vectors are indexed after a restart", func(t *testing.T) {
+		shard.vectorQueue.Load().pause()
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			ID:         "4b1c6e2d-8a3f-4f0e-b5d9-000000000100",
+			Class:      class.Class,
+			Properties: map[string]interface{}{"name": "late object"},
+		}, []float32{1, 2, 3, 4}, nil))
+		assert.Equal(t, int64(1), shard.vectorQueueLength())
+		require.Nil(t, repo.Shutdown(context.Background()))
+
+		repo = newRepo()
+		defer repo.Shutdown(context.Background())
+		shard := repo.GetIndex(schema.ClassName(class.Class)).Shards[shardName]
+		assert.Eventually(t, func() bool { return shard.vectorQueueLength() == 0 },
+			10*time.Second, 50*time.Millisecond)
+
+		found, _, err := shard.vectorIndex.SearchByVector([]float32{1, 2, 3, 4}, 20, nil)
+		require.Nil(t, err)
+		assert.Len(t, found, len(ids))
+	})
+}
+
+func TestDeferredIndexing(t *testing.T) {
+	dirName := t.TempDir()
+
+	userConfig := hnsw.NewDefaultUserConfig()
+	userConfig.DeferIndexing = true
+	class := &models.Class{
+		Class:               "DeferredIndexingClass",
+		VectorIndexConfig:   userConfig,
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "name",
+				DataType: []string{"text"},
+			},
+		},
+	}
+	shardState := singleShardState()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState, schema: schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}}
+	newRepo := func() *DB {
+		repo, err := New(logger, Config{
+			MemtablesFlushIdleAfter:   60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+
+	repo := newRepo()
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, shardState))
+
+	shardName := shardState.AllPhysicalShards()[0]
+	shard := repo.GetIndex(schema.ClassName(class.Class)).Shards[shardName]
+
+	for i := 0; i < 10; i++ {
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			ID:         strfmt.UUID(fmt.Sprintf("7d2a9c4e-1b3f-4e6a-9c8d-%012d", i)),
+			Class:      class.Class,
+			Properties: map[string]interface{}{"name": fmt.Sprintf("object %d", i)},
+		}, []float32{1, float32(i), 3, 4}, nil))
+	}
+
+	t.Run("vectors are not indexed while deferred", func(t *testing.T) {
+		assert.Equal(t, int64(10), shard.vectorQueueLength())
+		assert.Equal(t, vectorIndexingDeferred, shard.vectorIndexingStatus())
+
+		found, _, err := shard.vectorIndex.SearchByVector([]float32{1, 2, 3, 4}, 20, nil)
+		require.Nil(t, err)
+		assert.Len(t, found, 0)
+	})
+
+	t.Run("the index is built once deferral is turned off", func(t *testing.T) {
+		updated := hnsw.NewDefaultUserConfig()
+		require.Nil(t, shard.updateVectorIndexConfig(context.Background(), updated))
+		assert.Eventually(t, func() bool { return shard.vectorQueueLength() == 0 },
+			10*time.Second, 50*time.Millisecond)
+		assert.Equal(t, vectorIndexingReady, shard.vectorIndexingStatus())
+
+		found, _, err := shard.vectorIndex.SearchByVector([]float32{1, 2, 3, 4}, 20, nil)
+		require.Nil(t, err)
+		assert.Len(t, found, 10)
+	})
+
+	t.Run("a restored shard without vector index is indexed again", func(t *testing.T) {
+		require.Nil(t, repo.Shutdown(context.Background()))
+		commitLogDir := path.Join(dirName, fmt.Sprintf("%s.hnsw.commitlog.d", shard.ID()))
+		require.DirExists(t, commitLogDir)
+		require.Nil(t, os.RemoveAll(commitLogDir))
+		require.Nil(t, os.WriteFile(path.Join(dirName,
+			shard.ID()+backup.VectorIndexRebuildSuffix), nil, os.ModePerm))
+		class.VectorIndexConfig = hnsw.NewDefaultUserConfig()
+
+		repo = newRepo()
+		defer repo.Shutdown(context.Background())
+		shard := repo.GetIndex(schema.ClassName(class.Class)).Shards[shardName]
+		assert.Eventually(t, func() bool { return shard.vectorQueueLength() == 0 },
+			10*time.Second, 50*time.Millisecond)
+
+		found, _, err := shard.vectorIndex.SearchByVector([]float32{1, 2, 3, 4}, 20, nil)
+		require.Nil(t, err)
+		assert.Len(t, found, 10)
+		_, err = os.Stat(path.Join(dirName, shard.ID()+backup.VectorIndexRebuildSuffix))
+		assert.True(t, os.IsNotExist(err))
+	})
+}
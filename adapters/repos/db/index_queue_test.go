@@ -0,0 +1,148 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexQueue(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	path := filepath.Join(t.TempDir(), "shard.indexqueue")
+
+	t.Run("operations are applied", func(t *testing.T) {
+		index := &fakeRecordingIndex{}
+		q, err := newIndexQueue(path, index, 2, false, logger)
+		require.Nil(t, err)
+
+		require.Nil(t, q.Add(1, []float32{1, 2, 3}))
+		require.Nil(t, q.Add(2, []float32{4, 5, 6}))
+		require.Nil(t, q.Delete(1))
+		require.Nil(t, q.Add(3, []float32{7, 8, 9}))
+
+		assert.Eventually(t, func() bool { return q.Len() == 0 },
+			5*time.Second, 10*time.Millisecond)
+		// the insertions of a batch are applied in parallel
+		assert.ElementsMatch(t, []string{"add 1", "add 2", "delete 1", "add 3"},
+			index.operations())
+		assert.Equal(t, map[uint64][]float32{2: {4, 5, 6}, 3: {7, 8, 9}}, index.vectors)
+		assert.Equal(t, vectorIndexingReady, q.status())
+
+		// the queue file is truncated once drained
+		assert.Eventually(t, func() bool {
+			info, err := os.Stat(path)
+			return err == nil && info.Size() == 0
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Nil(t, q.Close())
+	})
+
+	t.Run("deferred operations are applied once resumed", func(t *testing.T) {
+		index := &fakeRecordingIndex{}
+		q, err := newIndexQueue(path, index, 100, true, logger)
+		require.Nil(t, err)
+
+		for i := uint64(0); i < 50; i++ {
+			require.Nil(t, q.Add(i, []float32{float32(i), 1, 1}))
+		}
+		require.Nil(t, q.Delete(7, 8))
+		time.Sleep(1100 * time.Millisecond)
+		assert.Empty(t, index.operations())
+		assert.Equal(t, int64(52), q.Len())
+		assert.Equal(t, vectorIndexingDeferred, q.status())
+
+		q.setDeferred(false)
+		assert.Eventually(t, func() bool { return q.Len() == 0 },
+			5*time.Second, 10*time.Millisecond)
+		// vectors which are deleted in the same batch are not inserted
+		assert.Len(t, index.operations(), 48)
+		assert.Len(t, index.vectors, 48)
+		assert.NotContains(t, index.vectors, uint64(7))
+		require.Nil(t, q.Close())
+	})
+
+	t.Run("queued operations survive a restart", func(t *testing.T) {
+		index := &fakeRecordingIndex{}
+		q, err := newIndexQueue(path, index, 10, false, logger)
+		require.Nil(t, err)
+		q.pause()
+		require.Nil(t, q.Add(4, []float32{1, 1, 1}))
+		require.Nil(t, q.Delete(2, 3))
+		assert.Equal(t, int64(3), q.Len())
+		require.Nil(t, q.Close())
+		assert.Empty(t, index.operations())
+
+		// simulate a partially written operation
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o666)
+		require.Nil(t, err)
+		_, err = f.Write([]byte{indexQueueOpAdd, 5, 0, 0})
+		require.Nil(t, err)
+		require.Nil(t, f.Close())
+
+		q, err = newIndexQueue(path, index, 10, false, logger)
+		require.Nil(t, err)
+		assert.Eventually(t, func() bool { return q.Len() == 0 },
+			5*time.Second, 10*time.Millisecond)
+		// deletes of earlier batches are applied before the insertions
+		assert.Equal(t, []string{"delete 2", "delete 3", "add 4"}, index.operations())
+		require.Nil(t, q.Drop())
+
+		_, err = os.Stat(path)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+type fakeRecordingIndex struct {
+	VectorIndex
+	sync.Mutex
+	ops     []string
+	vectors map[uint64][]float32
+}
+
+func (f *fakeRecordingIndex) Add(id uint64, vector []float32) error {
+	f.Lock()
+	defer f.Unlock()
+	if f.vectors == nil {
+		f.vectors = map[uint64][]float32{}
+	}
+	f.vectors[id] = vector
+	f.ops = append(f.ops, fmt.Sprintf("add %d", id))
+	return nil
+}
+
+func (f *fakeRecordingIndex) Delete(ids ...uint64) error {
+	f.Lock()
+	defer f.Unlock()
+	for _, id := range ids {
+		delete(f.vectors, id)
+		f.ops = append(f.ops, fmt.Sprintf("delete %d", id))
+	}
+	return nil
+}
+
+func (f *fakeRecordingIndex) Flush() error {
+	return nil
+}
+
+func (f *fakeRecordingIndex) operations() []string {
+	f.Lock()
+	defer f.Unlock()
+	return append([]string{}, f.ops...)
+}
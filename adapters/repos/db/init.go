@@ -15,72 +15,267 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/diskformat"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/replica"
+	"golang.org/x/sync/errgroup"
 )
 
 // On init we get the current schema and create one index object per class.
 // They will in turn create shards which will either read an existing db file
-// from disk or create a new one if none exists
+// from disk or create a new one if none exists. The priority classes are
+// loaded first and one after another. With lazy loading the other classes are
+// loaded on their first access, see GetIndex.
 func (d *DB) init(ctx context.Context) error {
 	if err := os.MkdirAll(d.config.RootPath, 0o777); err != nil {
 		return errors.Wrapf(err, "create root path directory at %s", d.config.RootPath)
 	}
 
+	if err := diskformat.NewMigrator(d.config.RootPath, d.logger).Run(ctx); err != nil {
+		return errors.Wrap(err, "upgrade disk format")
+	}
+
 	objects := d.schemaGetter.GetSchemaSkipAuth().Objects
-	if objects != nil {
-		for _, class := range objects.Classes {
-			invertedConfig := class.InvertedIndexConfig
-			if invertedConfig == nil {
-				// for backward compatibility, this field was introduced in v1.0.4,
-				// prior schemas will not yet have the field. Init with the defaults
-				// which were previously hard-coded.
-				// In this method we are essentially reading the schema from disk, so
-				// it could have been created before v1.0.4
-				invertedConfig = &models.InvertedIndexConfig{
-					CleanupIntervalSeconds: config.DefaultCleanupIntervalSeconds,
-					Bm25: &models.BM25Config{
-						K1: config.DefaultBM25k1,
-						B:  config.DefaultBM25b,
-					},
-				}
-			}
-			if err := replica.ValidateConfig(class); err != nil {
-				return fmt.Errorf("replication config: %w", err)
-			}
+	if objects == nil {
+		return nil
+	}
+
+	// offloaded classes stay in object storage until they are accessed
+	classes := make([]*models.Class, 0, len(objects.Classes))
+	for _, class := range objects.Classes {
+		if !d.isOffloadedOnDisk(class.Class) {
+			classes = append(classes, class)
+			continue
+		}
+		lazy := &lazyIndex{className: class.Class}
+		lazy.offloaded.Store(true)
+		d.indexLock.Lock()
+		d.lazyIndexes[indexID(schema.ClassName(class.Class))] = lazy
+		d.indexLock.Unlock()
+	}
 
-			idx, err := NewIndex(ctx, IndexConfig{
-				ClassName:                 schema.ClassName(class.Class),
-				RootPath:                  d.config.RootPath,
-				ResourceUsage:             d.config.ResourceUsage,
-				QueryMaximumResults:       d.config.QueryMaximumResults,
-				MemtablesFlushIdleAfter:   d.config.MemtablesFlushIdleAfter,
-				MemtablesInitialSizeMB:    d.config.MemtablesInitialSizeMB,
-				MemtablesMaxSizeMB:        d.config.MemtablesMaxSizeMB,
-				MemtablesMinActiveSeconds: d.config.MemtablesMinActiveSeconds,
-				MemtablesMaxActiveSeconds: d.config.MemtablesMaxActiveSeconds,
-				TrackVectorDimensions:     d.config.TrackVectorDimensions,
-				ReplicationFactor:         class.ReplicationConfig.Factor,
-			}, d.schemaGetter.ShardingState(class.Class),
-				inverted.ConfigFromModel(invertedConfig),
-				class.VectorIndexConfig.(schema.VectorIndexConfig),
-				d.schemaGetter, d, d.logger, d.nodeResolver, d.remoteIndex,
-				d.replicaClient, d.promMetrics, class, d.jobQueueCh)
-			if err != nil {
-				return errors.Wrap(err, "create index")
+	priority, others := d.startupOrder(classes)
+	for _, class := range priority {
+		if _, err := d.initIndex(ctx, class); err != nil {
+			return err
+		}
+	}
+
+	if d.config.Startup.LazyLoading {
+		d.indexLock.Lock()
+		for _, class := range others {
+			d.lazyIndexes[indexID(schema.ClassName(class.Class))] = &lazyIndex{
+				className: class.Class,
 			}
+		}
+		d.indexLock.Unlock()
+		return nil
+	}
+
+	eg := errgroup.Group{}
+	eg.SetLimit(cap(d.shardLoadLimiter))
+	for _, class := range others {
+		class := class
+		eg.Go(func() error {
+			_, err := d.initIndex(ctx, class)
+			return err
+		})
+	}
+	return eg.Wait()
+}
+
+// startupOrder splits the classes into the priority classes, in the order
+// of the config, and all others
+func (d *DB) startupOrder(classes []*models.Class) (priority, others []*models.Class) {
+	byName := make(map[string]*models.Class, len(classes))
+	for _, class := range classes {
+		byName[class.Class] = class
+	}
 
-			d.indexLock.Lock()
-			d.indices[idx.ID()] = idx
-			idx.notifyReady()
-			d.indexLock.Unlock()
+	for _, name := range d.config.Startup.PriorityClasses {
+		if class, ok := byName[name]; ok {
+			priority = append(priority, class)
+			delete(byName, name)
+		}
+	}
+	for _, class := range classes {
+		if _, ok := byName[class.Class]; ok {
+			others = append(others, class)
 		}
 	}
+	return priority, others
+}
+
+func (d *DB) initIndex(ctx context.Context, class *models.Class) (*Index, error) {
+	invertedConfig := class.InvertedIndexConfig
+	if invertedConfig == nil {
+		// for backward compatibility, this field was introduced in v1.0.4,
+		// prior schemas will not yet have the field. Init with the defaults
+		// which were previously hard-coded.
+		// In this method we are essentially reading the schema from disk, so
+		// it could have been created before v1.0.4
+		invertedConfig = &models.InvertedIndexConfig{
+			CleanupIntervalSeconds: config.DefaultCleanupIntervalSeconds,
+			Bm25: &models.BM25Config{
+				K1: config.DefaultBM25k1,
+				B:  config.DefaultBM25b,
+			},
+		}
+	}
+	if err := replica.ValidateConfig(class); err != nil {
+		return nil, fmt.Errorf("replication config: %w", err)
+	}
+
+	idx, err := NewIndex(ctx, withIngestConfig(IndexConfig{
+		ClassName:                  schema.ClassName(class.Class),
+		RootPath:                   d.config.RootPath,
+		ResourceUsage:              d.config.ResourceUsage,
+		QueryMaximumResults:        d.config.QueryMaximumResults,
+		MemtablesFlushIdleAfter:    d.config.MemtablesFlushIdleAfter,
+		MemtablesInitialSizeMB:     d.config.MemtablesInitialSizeMB,
+		MemtablesMaxSizeMB:         d.config.MemtablesMaxSizeMB,
+		MemtablesMinActiveSeconds:  d.config.MemtablesMinActiveSeconds,
+		MemtablesMaxActiveSeconds:  d.config.MemtablesMaxActiveSeconds,
+		TrackVectorDimensions:      d.config.TrackVectorDimensions,
+		QuarantineAfterWriteErrors: d.config.QuarantineAfterWriteErrors,
+		LeaderWrites:               d.config.LeaderWrites,
+		ReplicationFactor:          class.ReplicationConfig.Factor,
+		RemoteSegments:             d.remoteSegments(class.Class),
+		ShardLoadLimiter:           d.shardLoadLimiter,
+		AsyncIndexing:              d.config.AsyncIndexing,
+		CompactionScheduler:        d.config.CompactionScheduler,
+		FilterCache:                d.config.FilterCache,
+		WALCompression:             d.config.WALCompression,
+	}, class.IngestConfig), d.schemaGetter.ShardingState(class.Class),
+		inverted.ConfigFromModel(invertedConfig),
+		class.VectorIndexConfig.(schema.VectorIndexConfig),
+		d.schemaGetter, d, d.logger, d.nodeResolver, d.remoteIndex,
+		d.replicaClient, d.promMetrics, class, d.jobQueueCh)
+	if err != nil {
+		return nil, errors.Wrap(err, "create index")
+	}
+
+	d.indexLock.Lock()
+	d.indices[idx.ID()] = idx
+	delete(d.lazyIndexes, idx.ID())
+	idx.notifyReady()
+	d.indexLock.Unlock()
+
+	return idx, nil
+}
+
+// lazyIndex is a class which is loaded on its first access
+type lazyIndex struct {
+	sync.Mutex
+	className string
+	// offloaded classes are downloaded from the offload storage before they
+	// are loaded, see OffloadIndex
+	offloaded atomic.Bool
+}
+
+func (d *DB) loadLazyIndex(id string, lazy *lazyIndex) *Index {
+	index, err := d.loadLazyIndexContext(context.Background(), id, lazy)
+	if err != nil {
+		d.logger.WithField("action", "lazy_load_index").
+			WithField("class", lazy.className).
+			WithError(err).
+			Error("could not load index on first access")
+		return nil
+	}
+	return index
+}
+
+func (d *DB) loadLazyIndexContext(ctx context.Context, id string,
+	lazy *lazyIndex,
+) (*Index, error) {
+	lazy.Lock()
+	defer lazy.Unlock()
+
+	d.indexLock.RLock()
+	index, ok := d.indices[id]
+	d.indexLock.RUnlock()
+	if ok {
+		// loaded by a concurrent access
+		return index, nil
+	}
+
+	sch := d.schemaGetter.GetSchemaSkipAuth()
+	class := sch.GetClass(schema.ClassName(lazy.className))
+	if class == nil {
+		return nil, nil
+	}
+
+	before := time.Now()
+	offloaded := lazy.offloaded.Load()
+	var manifest offloadManifest
+	if offloaded {
+		var err error
+		if manifest, err = d.downloadOffloaded(ctx, id); err != nil {
+			return nil, errors.Wrap(err, "download offloaded index")
+		}
+		lazy.offloaded.Store(false)
+	}
+
+	index, err := d.initIndex(ctx, class)
+	if err != nil {
+		return nil, err
+	}
+
+	if offloaded {
+		d.deleteOffloaded(ctx, id, manifest)
+		d.logger.WithField("action", "activate_index").
+			WithField("class", lazy.className).
+			WithField("took", time.Since(before)).
+			Info("activated offloaded index")
+		return index, nil
+	}
+
+	d.logger.WithField("action", "lazy_load_index").
+		WithField("class", lazy.className).
+		WithField("took", time.Since(before)).
+		Debug("loaded index on first access")
+	return index, nil
+}
+
+// loadLazyIndexes loads all indexes which weren't accessed yet. It is called
+// before queries across all classes, which don't activate offloaded classes.
+func (d *DB) loadLazyIndexes() {
+	d.indexLock.RLock()
+	pending := make(map[string]*lazyIndex, len(d.lazyIndexes))
+	for id, lazy := range d.lazyIndexes {
+		if lazy.offloaded.Load() {
+			continue
+		}
+		pending[id] = lazy
+	}
+	d.indexLock.RUnlock()
+
+	for id, lazy := range pending {
+		d.loadLazyIndex(id, lazy)
+	}
+}
+
+// loadLimiter bounds the number of shards which are loaded concurrently
+type loadLimiter chan struct{}
+
+func newLoadLimiter(n int) loadLimiter {
+	if n < 1 {
+		n = 1
+	}
+	return make(loadLimiter, n)
+}
 
-	return nil
+// acquire blocks until a shard may be loaded, the returned function must be
+// called once it is loaded
+func (l loadLimiter) acquire() func() {
+	l <- struct{}{}
+	return func() { <-l }
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/tokenizer"
 )
 
 type Countable struct {
@@ -31,6 +32,9 @@ type Property struct {
 	Items        []Countable
 	HasFrequency bool
 	Length       int
+	// PruningThreshold is the minimum BM25 impact of the postings of the
+	// property, see ImpactPruner
+	PruningThreshold float64
 }
 
 type Analyzer struct {
@@ -59,6 +63,8 @@ func textArrayTokenize(tokenization string, in []string) []string {
 		for _, value := range in {
 			parts = append(parts, helpers.TokenizeText(value)...)
 		}
+	default:
+		parts = customTokenize(tokenization, in)
 	}
 
 	return parts
@@ -92,11 +98,28 @@ func stringArrayTokenize(tokenization string, in []string) []string {
 		for _, value := range in {
 			parts = append(parts, helpers.TokenizeString(value)...)
 		}
+	default:
+		parts = customTokenize(tokenization, in)
 	}
 
 	return parts
 }
 
+// customTokenize tokenizes with a registered tokenizer, values of unknown
+// tokenizations aren't indexed like before
+func customTokenize(tokenization string, in []string) []string {
+	t := tokenizer.Get(tokenization)
+	if t == nil {
+		return nil
+	}
+
+	var parts []string
+	for _, value := range in {
+		parts = append(parts, t.Tokenize(value)...)
+	}
+	return parts
+}
+
 func (a *Analyzer) countParts(parts []string) []Countable {
 	terms := map[string]uint64{}
 	for _, word := range parts {
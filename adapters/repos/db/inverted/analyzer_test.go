@@ -15,12 +15,17 @@ import (
 	"bytes"
 	"math"
 	"sort"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/tokenizer"
 )
 
 func TestAnalyzer(t *testing.T) {
@@ -436,3 +441,46 @@ func newTestAnalyzer(t *testing.T, cfg models.StopwordConfig) *Analyzer {
 
 	return NewAnalyzer(sd)
 }
+
+// citationTokenizer keeps legal citations like "42 U.S.C. § 1983" as single
+// terms, which the word tokenization would split
+type citationTokenizer struct{}
+
+func (citationTokenizer) Tokenize(value string) []string {
+	return strings.Split(value, "; ")
+}
+
+var registerCitationTokenizer sync.Once
+
+func TestAnalyzer_CustomTokenizer(t *testing.T) {
+	registerCitationTokenizer.Do(func() {
+		require.Nil(t, tokenizer.Register("citation", citationTokenizer{}))
+	})
+	a := NewAnalyzer(fakeStopwordDetector{})
+	input := "42 U.S.C. § 1983; 18 U.S.C. § 242; 42 U.S.C. § 1983"
+
+	expected := []Countable{
+		{Data: []byte("42 U.S.C. § 1983"), TermFrequency: float32(2)},
+		{Data: []byte("18 U.S.C. § 242"), TermFrequency: float32(1)},
+	}
+	assert.ElementsMatch(t, expected, a.Text("citation", input))
+	assert.ElementsMatch(t, expected, a.String("citation", input))
+	assert.ElementsMatch(t, expected, a.StringArray("citation", []string{
+		"42 U.S.C. § 1983; 18 U.S.C. § 242", "42 U.S.C. § 1983",
+	}))
+
+	t.Run("unregistered tokenization", func(t *testing.T) {
+		assert.Empty(t, a.Text("unregistered", input))
+	})
+
+	t.Run("filter values", func(t *testing.T) {
+		parts, err := customTokenizeFilterValue("42 U.S.C. § 1983", filters.OperatorEqual,
+			"citation", schema.DataTypeText)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"42 U.S.C. § 1983"}, parts)
+
+		_, err = customTokenizeFilterValue("foo", filters.OperatorEqual,
+			"unregistered", schema.DataTypeText)
+		assert.ErrorContains(t, err, "unsupported tokenization")
+	})
+}
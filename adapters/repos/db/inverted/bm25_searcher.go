@@ -20,6 +20,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/langdetect"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	"golang.org/x/sync/errgroup"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
 
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/querycost"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -39,6 +41,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/tokenizer"
 )
 
 type BM25Searcher struct {
@@ -154,18 +157,27 @@ func (b *BM25Searcher) wand(
 	propertyNamesFullQuery := make([]string, 0)
 	propertyNamesText := make([]string, 0)
 	propertyNamesString := make([]string, 0)
+	propertyNamesCustom := map[string][]string{} // by tokenization
+	propertyNamesLanguage := make([]string, 0)
+	queryLanguage := ""
+	if hasLanguageDetection(class, params.Properties) {
+		queryLanguage = langdetect.Detect(params.Query)
+	}
 	propertyBoosts := make(map[string]float32, len(params.Properties))
 
 	averagePropLength := 0.
 	for _, propertyWithBoost := range params.Properties {
 		property := propertyWithBoost
-		propBoost := 1
+		propBoost := float32(1)
 		if strings.Contains(propertyWithBoost, "^") {
 			property = strings.Split(propertyWithBoost, "^")[0]
 			boostStr := strings.Split(propertyWithBoost, "^")[1]
-			propBoost, _ = strconv.Atoi(boostStr)
+			queryBoost, _ := strconv.Atoi(boostStr)
+			propBoost = float32(queryBoost)
+		} else if boost, ok := b.config.PropertyBoosts[property]; ok {
+			propBoost = boost
 		}
-		propertyBoosts[property] = float32(propBoost)
+		propertyBoosts[property] = propBoost
 
 		propMean, err := b.propLengths.PropertyMean(property)
 		if err != nil {
@@ -178,7 +190,14 @@ func (b *BM25Searcher) wand(
 			return nil, nil, err
 		}
 
-		if prop.Tokenization == "word" {
+		if prop.LanguageDetection {
+			// the query is tokenized like the values in its language
+			if tokenization := languageTokenization(prop, queryLanguage); tokenization != prop.Tokenization {
+				propertyNamesCustom[tokenization] = append(propertyNamesCustom[tokenization], property)
+			} else {
+				propertyNamesLanguage = append(propertyNamesLanguage, property)
+			}
+		} else if prop.Tokenization == "word" {
 			if prop.DataType[0] == "text" || prop.DataType[0] == "text[]" {
 				propertyNamesText = append(propertyNamesText, property)
 			} else if prop.DataType[0] == "string" || prop.DataType[0] == "string[]" {
@@ -186,6 +205,8 @@ func (b *BM25Searcher) wand(
 			} else {
 				return nil, nil, fmt.Errorf("cannot handle datatype %v", prop.DataType[0])
 			}
+		} else if tokenizer.Get(prop.Tokenization) != nil {
+			propertyNamesCustom[prop.Tokenization] = append(propertyNamesCustom[prop.Tokenization], property)
 		} else {
 			propertyNamesFullQuery = append(propertyNamesFullQuery, property)
 		}
@@ -206,7 +227,16 @@ func (b *BM25Searcher) wand(
 	if len(propertyNamesText) > 0 {
 		textLength = len(queryTextTerms)
 	}
-	lengthAllResults := textLength + stringLength + fullQueryLength
+	customGroups := customTermGroups(params.Query, propertyNamesCustom)
+	if len(propertyNamesLanguage) > 0 {
+		customGroups = append(customGroups, b.languageTermGroup(params.Query,
+			queryLanguage, propertyNamesLanguage, stopWordDetector))
+	}
+	customLength := 0
+	for _, group := range customGroups {
+		customLength += len(group.terms)
+	}
+	lengthAllResults := textLength + stringLength + fullQueryLength + customLength
 	results := make(terms, lengthAllResults)
 	indices := make([]map[uint64]int, lengthAllResults)
 
@@ -258,9 +288,35 @@ func (b *BM25Searcher) wand(
 		})
 	}
 
+	// properties with custom tokenizers are searched for the terms of the
+	// query tokenized by their tokenizer
+	j := textLength + stringLength + fullQueryLength
+	for _, group := range customGroups {
+		group := group
+		for i := range group.terms {
+			ind, pos := i, j
+			eg.Go(func() error {
+				termResult, docIndices, err := b.createTerm(N, filterDocIds, group.terms[ind], group.propNames, propertyBoosts, group.duplicateBoosts[ind], params.AdditionalExplanations)
+				if err != nil {
+					return err
+				}
+				results[pos] = termResult
+				indices[pos] = docIndices
+				return nil
+			})
+			j++
+		}
+	}
+
 	if err := eg.Wait(); err != nil {
 		return nil, nil, err
 	}
+	tracker := querycost.FromContext(ctx)
+	if tracker != nil {
+		for _, result := range results {
+			tracker.AddPostingsScanned(len(result.data))
+		}
+	}
 	// all results. Sum up the length of the results from all terms to get an upper bound of how many results there are
 	if limit == 0 {
 		for _, ind := range indices {
@@ -273,7 +329,63 @@ func (b *BM25Searcher) wand(
 	copy(resultsOriginalOrder, results)
 
 	topKHeap := b.getTopKHeap(limit, results, averagePropLength)
-	return b.getTopKObjects(topKHeap, resultsOriginalOrder, indices, params.AdditionalExplanations)
+	return b.getTopKObjects(topKHeap, resultsOriginalOrder, indices, params.AdditionalExplanations, tracker)
+}
+
+// customTermGroup are the terms of the query for the properties of one
+// custom tokenizer
+type customTermGroup struct {
+	terms           []string
+	duplicateBoosts []int
+	propNames       []string
+}
+
+func customTermGroups(query string, propNamesByTokenization map[string][]string) []customTermGroup {
+	tokenizations := make([]string, 0, len(propNamesByTokenization))
+	for tokenization := range propNamesByTokenization {
+		tokenizations = append(tokenizations, tokenization)
+	}
+	sort.Strings(tokenizations)
+
+	groups := make([]customTermGroup, 0, len(tokenizations))
+	for _, tokenization := range tokenizations {
+		terms, boosts := helpers.CountDuplicates(tokenizer.Get(tokenization).Tokenize(query))
+		groups = append(groups, customTermGroup{
+			terms:           terms,
+			duplicateBoosts: boosts,
+			propNames:       propNamesByTokenization[tokenization],
+		})
+	}
+	return groups
+}
+
+// hasLanguageDetection is true if the language of any of the searched
+// properties is detected
+func hasLanguageDetection(class *models.Class, propNames []string) bool {
+	for _, name := range propNames {
+		prop, err := schema.GetPropertyByName(class, strings.Split(name, "^")[0])
+		if err == nil && prop.LanguageDetection {
+			return true
+		}
+	}
+	return false
+}
+
+// languageTermGroup are the terms of the query for the properties with
+// language detection whose values are tokenized into words. Besides the
+// stopwords of the class the stopwords of the language of the query are
+// removed.
+func (b *BM25Searcher) languageTermGroup(query, lang string, propNames []string,
+	classStopwords *stopwords.Detector,
+) customTermGroup {
+	terms, boosts := helpers.TokenizeTextAndCountDuplicates(query)
+	terms, boosts = b.removeStopwordsFromQueryTerms(terms, boosts, classStopwords)
+	terms, boosts = b.removeStopwordsFromQueryTerms(terms, boosts, languageStopwords(lang))
+	return customTermGroup{
+		terms:           terms,
+		duplicateBoosts: boosts,
+		propNames:       propNames,
+	}
 }
 
 func (b *BM25Searcher) removeStopwordsFromQueryTerms(queryTerms []string, duplicateBoost []int, detector *stopwords.Detector) ([]string, []int) {
@@ -301,7 +413,9 @@ WordLoop:
 	}
 }
 
-func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue, results terms, indices []map[uint64]int, additionalExplanations bool) ([]*storobj.Object, []float32, error) {
+func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue, results terms, indices []map[uint64]int, additionalExplanations bool,
+	tracker *querycost.Tracker,
+) ([]*storobj.Object, []float32, error) {
 	objectsBucket := b.store.Bucket(helpers.ObjectsBucketLSM)
 	if objectsBucket == nil {
 		return nil, nil, errors.Errorf("objects bucket not found")
@@ -319,6 +433,7 @@ func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue, results ter
 		if err != nil {
 			return nil, nil, err
 		}
+		tracker.AddObjectLoaded(len(objectByte))
 
 		obj, err := storobj.FromBinary(objectByte)
 		if err != nil {
@@ -13,6 +13,7 @@ package inverted
 
 import (
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
@@ -26,6 +27,10 @@ func ValidateConfig(conf *models.InvertedIndexConfig) error {
 		return errors.Errorf("cleanup interval seconds must be > 0")
 	}
 
+	if conf.WalRetentionSeconds < 0 {
+		return errors.Errorf("wal retention seconds must be >= 0")
+	}
+
 	err := validateBM25Config(conf.Bm25)
 	if err != nil {
 		return err
@@ -45,6 +50,9 @@ func ConfigFromModel(iicm *models.InvertedIndexConfig) schema.InvertedIndexConfi
 	conf.IndexTimestamps = iicm.IndexTimestamps
 	conf.IndexNullState = iicm.IndexNullState
 	conf.IndexPropertyLength = iicm.IndexPropertyLength
+	conf.Boost = schema.BoostConfigFromModel(iicm)
+	conf.WALRetention = time.Duration(iicm.WalRetentionSeconds) * time.Second
+	conf.CleanupInterval = time.Duration(iicm.CleanupIntervalSeconds) * time.Second
 
 	if iicm.Bm25 == nil {
 		conf.BM25.K1 = float64(config.DefaultBM25k1)
@@ -52,6 +60,7 @@ func ConfigFromModel(iicm *models.InvertedIndexConfig) schema.InvertedIndexConfi
 	} else {
 		conf.BM25.K1 = float64(iicm.Bm25.K1)
 		conf.BM25.B = float64(iicm.Bm25.B)
+		conf.BM25.PropertyBoosts = iicm.Bm25.PropertyBoosts
 	}
 
 	if iicm.Stopwords == nil {
@@ -78,6 +87,11 @@ func validateBM25Config(conf *models.BM25Config) error {
 	if conf.B < 0 || conf.B > 1 {
 		return errors.Errorf("BM25.b must be <= 0 and <= 1")
 	}
+	for prop, boost := range conf.PropertyBoosts {
+		if boost <= 0 {
+			return errors.Errorf("BM25.propertyBoosts of property %q must be > 0", prop)
+		}
+	}
 
 	return nil
 }
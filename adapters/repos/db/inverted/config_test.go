@@ -57,6 +57,19 @@ func TestValidateConfig(t *testing.T) {
 		assert.EqualError(t, err, "BM25.b must be <= 0 and <= 1")
 	})
 
+	t.Run("with invalid BM25.propertyBoosts", func(t *testing.T) {
+		in := &models.InvertedIndexConfig{
+			Bm25: &models.BM25Config{
+				K1:             1,
+				B:              0.7,
+				PropertyBoosts: map[string]float32{"title": 0},
+			},
+		}
+
+		err := ValidateConfig(in)
+		assert.EqualError(t, err, `BM25.propertyBoosts of property "title" must be > 0`)
+	})
+
 	t.Run("with valid config", func(t *testing.T) {
 		in := &models.InvertedIndexConfig{
 			Bm25: &models.BM25Config{
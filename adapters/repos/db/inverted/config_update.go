@@ -21,6 +21,10 @@ func ValidateUserConfigUpdate(initial, updated *models.InvertedIndexConfig) erro
 		return errors.Errorf("cleanup interval seconds must be > 0")
 	}
 
+	if updated.WalRetentionSeconds < 0 {
+		return errors.Errorf("wal retention seconds must be >= 0")
+	}
+
 	err := validateBM25ConfigUpdate(initial, updated)
 	if err != nil {
 		return err
@@ -42,8 +46,9 @@ func ValidateUserConfigUpdate(initial, updated *models.InvertedIndexConfig) erro
 func validateBM25ConfigUpdate(initial, updated *models.InvertedIndexConfig) error {
 	if updated.Bm25 == nil {
 		updated.Bm25 = &models.BM25Config{
-			K1: initial.Bm25.K1,
-			B:  initial.Bm25.B,
+			K1:             initial.Bm25.K1,
+			B:              initial.Bm25.B,
+			PropertyBoosts: initial.Bm25.PropertyBoosts,
 		}
 		return nil
 	}
@@ -65,6 +70,12 @@ func validateInvertedIndexConfigUpdate(initial, updated *models.InvertedIndexCon
 		return errors.New("IndexNullState cannot be changed when updating a schema")
 	}
 
+	// timestamps of existing objects are indexed when enabling, but the
+	// buckets can't be dropped safely while queries might still use them
+	if initial.IndexTimestamps && !updated.IndexTimestamps {
+		return errors.New("IndexTimestamps cannot be disabled when updating a schema")
+	}
+
 	return nil
 }
 
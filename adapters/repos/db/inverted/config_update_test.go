@@ -138,4 +138,25 @@ func TestValidateUserConfigUpdate(t *testing.T) {
 		err := ValidateUserConfigUpdate(validInitial, updated)
 		require.EqualError(t, err, "IndexPropertyLength cannot be changed when updating a schema")
 	})
+
+	t.Run("with timestamp indexing enabled", func(t *testing.T) {
+		updated := &models.InvertedIndexConfig{
+			CleanupIntervalSeconds: 1,
+			IndexTimestamps:        true,
+		}
+
+		err := ValidateUserConfigUpdate(validInitial, updated)
+		require.Nil(t, err)
+	})
+
+	t.Run("with timestamp indexing disabled", func(t *testing.T) {
+		initial := *validInitial
+		initial.IndexTimestamps = true
+		updated := &models.InvertedIndexConfig{
+			CleanupIntervalSeconds: 1,
+		}
+
+		err := ValidateUserConfigUpdate(&initial, updated)
+		require.EqualError(t, err, "IndexTimestamps cannot be disabled when updating a schema")
+	})
 }
@@ -0,0 +1,224 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+// FilterCache is an LRU cache of the allow lists of where filters. The
+// bitmaps are stored serialized, so their memory can be accounted exactly.
+//
+// Each entry is tagged with the properties its filter reads. A write to a
+// property invalidates all entries which read it, see Invalidate. The
+// generation protects against entries which were computed concurrently to
+// an invalidation: they are not stored.
+type FilterCache struct {
+	sync.Mutex
+	maxSize     uint64
+	size        uint64
+	lru         *list.List // front is the most recently used entry
+	entries     map[string]*list.Element
+	byProp      map[string]map[string]struct{} // prop -> keys
+	generation  uint64
+	invalidated map[string]uint64 // prop -> generation of last invalidation
+}
+
+type filterCacheEntry struct {
+	key    string
+	props  []string
+	bitmap []byte
+}
+
+func NewFilterCache(maxSize uint64) *FilterCache {
+	return &FilterCache{
+		maxSize:     maxSize,
+		lru:         list.New(),
+		entries:     map[string]*list.Element{},
+		byProp:      map[string]map[string]struct{}{},
+		invalidated: map[string]uint64{},
+	}
+}
+
+// Generation must be read before the allow list of a filter is computed and
+// passed to Store
+func (c *FilterCache) Generation() uint64 {
+	c.Lock()
+	defer c.Unlock()
+	return c.generation
+}
+
+func (c *FilterCache) Load(key string) (helpers.AllowList, bool) {
+	c.Lock()
+	defer c.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	bm := sroar.FromBufferWithCopy(elem.Value.(*filterCacheEntry).bitmap)
+	return helpers.NewAllowListFromBitmap(bm), true
+}
+
+// Store adds the bitmap of a filter which reads the given props. It is
+// dropped if one of the props has been invalidated since generation.
+func (c *FilterCache) Store(key string, props []string, bm *sroar.Bitmap,
+	generation uint64,
+) {
+	buf := bm.ToBufferWithCopy()
+	size := uint64(len(buf))
+	if size > c.maxSize {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	for _, prop := range props {
+		if c.invalidated[prop] > generation {
+			return
+		}
+	}
+
+	c.remove(key)
+	for c.size+size > c.maxSize {
+		c.remove(c.lru.Back().Value.(*filterCacheEntry).key)
+	}
+	c.entries[key] = c.lru.PushFront(&filterCacheEntry{
+		key: key, props: props, bitmap: buf,
+	})
+	c.size += size
+	for _, prop := range props {
+		keys, ok := c.byProp[prop]
+		if !ok {
+			keys = map[string]struct{}{}
+			c.byProp[prop] = keys
+		}
+		keys[key] = struct{}{}
+	}
+}
+
+// Invalidate removes all entries which read one of props. It must be called
+// after the write to the props is visible to readers.
+func (c *FilterCache) Invalidate(props ...string) {
+	c.Lock()
+	defer c.Unlock()
+	c.generation++
+	for _, prop := range props {
+		c.invalidated[prop] = c.generation
+		for key := range c.byProp[prop] {
+			c.remove(key)
+		}
+	}
+}
+
+func (c *FilterCache) Size() uint64 {
+	c.Lock()
+	defer c.Unlock()
+	return c.size
+}
+
+func (c *FilterCache) Len() int {
+	c.Lock()
+	defer c.Unlock()
+	return c.lru.Len()
+}
+
+func (c *FilterCache) remove(key string) {
+	elem, ok := c.entries[key]
+	if !ok {
+		return
+	}
+	entry := elem.Value.(*filterCacheEntry)
+	c.lru.Remove(elem)
+	delete(c.entries, key)
+	c.size -= uint64(len(entry.bitmap))
+	for _, prop := range entry.props {
+		delete(c.byProp[prop], key)
+		if len(c.byProp[prop]) == 0 {
+			delete(c.byProp, prop)
+		}
+	}
+}
+
+// FilterCacheProp returns the property under which writes to the inverted
+// index of propName invalidate the filter cache. Length, null state and
+// reference count indexes are attributed to their property.
+func FilterCacheProp(propName string) string {
+	propName = strings.TrimSuffix(propName, filters.InternalPropertyLength)
+	propName = strings.TrimSuffix(propName, filters.InternalNullIndex)
+	propName = strings.TrimSuffix(propName, "__meta_count")
+	if propName == filters.InternalPropBackwardsCompatID {
+		return filters.InternalPropID
+	}
+	return propName
+}
+
+// filterCacheKey returns the key of filter and the props it reads. It
+// returns false if the filter can't be cached, because it reads other
+// classes or indexes which don't invalidate the cache.
+func filterCacheKey(filter *filters.LocalFilter, shardVersion uint16) (string, []string, bool) {
+	props := map[string]struct{}{}
+	if !filterCacheProps(filter.Root, props) {
+		return "", nil, false
+	}
+	encoded, err := json.Marshal(filter.Root)
+	if err != nil {
+		return "", nil, false
+	}
+
+	h := sha256.New()
+	binary.Write(h, binary.LittleEndian, shardVersion)
+	h.Write(encoded)
+
+	out := make([]string, 0, len(props))
+	for prop := range props {
+		out = append(out, prop)
+	}
+	return hex.EncodeToString(h.Sum(nil)), out, true
+}
+
+func filterCacheProps(clause *filters.Clause, props map[string]struct{}) bool {
+	if clause.Operands != nil {
+		for i := range clause.Operands {
+			if !filterCacheProps(&clause.Operands[i], props) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if isGeoOperator(clause.Operator) {
+		// served by the geo index of the property
+		return false
+	}
+	path := clause.On.Slice()
+	if len(path) != 1 {
+		// reference filter
+		return false
+	}
+	prop := path[0]
+	if strings.HasPrefix(prop, "len(") && strings.HasSuffix(prop, ")") {
+		prop = prop[len("len(") : len(prop)-1]
+	}
+	props[FilterCacheProp(prop)] = struct{}{}
+	return true
+}
@@ -0,0 +1,138 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func bitmapOf(ids ...uint64) *sroar.Bitmap {
+	bm := sroar.NewBitmap()
+	bm.SetMany(ids)
+	return bm
+}
+
+func TestFilterCache(t *testing.T) {
+	t.Run("load and invalidate", func(t *testing.T) {
+		c := NewFilterCache(1 << 20)
+		c.Store("a", []string{"name"}, bitmapOf(1, 2, 3), c.Generation())
+		c.Store("b", []string{"age", "_id"}, bitmapOf(4), c.Generation())
+
+		got, ok := c.Load("a")
+		require.True(t, ok)
+		assert.Equal(t, []uint64{1, 2, 3}, got.Slice())
+
+		c.Invalidate("name")
+		_, ok = c.Load("a")
+		assert.False(t, ok)
+		_, ok = c.Load("b")
+		assert.True(t, ok)
+
+		c.Invalidate("_id")
+		assert.Equal(t, 0, c.Len())
+		assert.Equal(t, uint64(0), c.Size())
+	})
+
+	t.Run("entries computed before an invalidation are dropped", func(t *testing.T) {
+		c := NewFilterCache(1 << 20)
+		generation := c.Generation()
+		c.Invalidate("name")
+		c.Store("a", []string{"name"}, bitmapOf(1), generation)
+		c.Store("b", []string{"age"}, bitmapOf(1), generation)
+
+		_, ok := c.Load("a")
+		assert.False(t, ok)
+		_, ok = c.Load("b")
+		assert.True(t, ok)
+	})
+
+	t.Run("least recently used entries are evicted", func(t *testing.T) {
+		size := uint64(len(bitmapOf(1).ToBuffer()))
+		c := NewFilterCache(2 * size)
+		c.Store("a", []string{"name"}, bitmapOf(1), 0)
+		c.Store("b", []string{"name"}, bitmapOf(2), 0)
+		c.Load("a")
+		c.Store("c", []string{"name"}, bitmapOf(3), 0)
+
+		_, ok := c.Load("b")
+		assert.False(t, ok)
+		for _, key := range []string{"a", "c"} {
+			_, ok := c.Load(key)
+			assert.True(t, ok)
+		}
+		assert.Equal(t, 2*size, c.Size())
+	})
+
+	t.Run("loaded allow lists don't alias the entry", func(t *testing.T) {
+		c := NewFilterCache(1 << 20)
+		c.Store("a", []string{"name"}, bitmapOf(1), 0)
+		got, _ := c.Load("a")
+		got.Insert(2)
+		got, _ = c.Load("a")
+		assert.Equal(t, []uint64{1}, got.Slice())
+	})
+}
+
+func TestFilterCacheKey(t *testing.T) {
+	clause := func(prop string, value interface{}) filters.Clause {
+		return filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: "Foo", Property: schema.PropertyName(prop)},
+			Value:    &filters.Value{Value: value, Type: schema.DataTypeText},
+		}
+	}
+	and := func(operands ...filters.Clause) *filters.LocalFilter {
+		return &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorAnd, Operands: operands,
+		}}
+	}
+
+	key1, props, ok := filterCacheKey(and(clause("name", "a"), clause("len(title)", 3),
+		clause("id", "x")), 2)
+	require.True(t, ok)
+	sort.Strings(props)
+	assert.Equal(t, []string{"_id", "name", "title"}, props)
+
+	key2, _, _ := filterCacheKey(and(clause("name", "b"), clause("len(title)", 3),
+		clause("id", "x")), 2)
+	assert.NotEqual(t, key1, key2)
+	key3, _, _ := filterCacheKey(and(clause("name", "a"), clause("len(title)", 3),
+		clause("id", "x")), 3)
+	assert.NotEqual(t, key1, key3)
+
+	ref := clause("", "a")
+	ref.On = &filters.Path{
+		Class: "Foo", Property: "hasBar",
+		Child: &filters.Path{Class: "Bar", Property: "name"},
+	}
+	_, _, ok = filterCacheKey(and(clause("name", "a"), ref), 2)
+	assert.False(t, ok)
+
+	geo := clause("location", nil)
+	geo.Operator = filters.OperatorWithinGeoRange
+	_, _, ok = filterCacheKey(and(geo), 2)
+	assert.False(t, ok)
+}
+
+func TestFilterCacheProp(t *testing.T) {
+	assert.Equal(t, "name", FilterCacheProp("name"+filters.InternalPropertyLength))
+	assert.Equal(t, "name", FilterCacheProp("name"+filters.InternalNullIndex))
+	assert.Equal(t, "hasBar", FilterCacheProp("hasBar__meta_count"))
+	assert.Equal(t, filters.InternalPropID, FilterCacheProp(filters.InternalPropBackwardsCompatID))
+}
@@ -0,0 +1,156 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package langdetect detects the language of the values of text properties.
+// The detection is deterministic and cheap enough to run for every indexed
+// value as well as for every query. Text in non-Latin scripts is detected by
+// its script, Latin text by the stopwords of the stopword presets it
+// contains.
+package langdetect
+
+import (
+	"unicode"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+)
+
+// Languages which are detected by their script
+const (
+	Chinese  = "zh"
+	Japanese = "ja"
+	Korean   = "ko"
+	Russian  = "ru"
+	Greek    = "el"
+	Arabic   = "ar"
+	Hebrew   = "he"
+	Thai     = "th"
+	Hindi    = "hi"
+)
+
+// latinLanguages are told apart by the stopwords of their presets
+var latinLanguages = []string{
+	stopwords.EnglishPreset,
+	stopwords.GermanPreset,
+	stopwords.FrenchPreset,
+	stopwords.SpanishPreset,
+	stopwords.ItalianPreset,
+	stopwords.DutchPreset,
+	stopwords.PortuguesePreset,
+}
+
+var scripts = []struct {
+	table    *unicode.RangeTable
+	language string
+}{
+	{unicode.Hangul, Korean},
+	{unicode.Hiragana, Japanese},
+	{unicode.Katakana, Japanese},
+	{unicode.Han, Chinese},
+	{unicode.Cyrillic, Russian},
+	{unicode.Greek, Greek},
+	{unicode.Arabic, Arabic},
+	{unicode.Hebrew, Hebrew},
+	{unicode.Thai, Thai},
+	{unicode.Devanagari, Hindi},
+}
+
+var latinStopwords = func() map[string]map[string]struct{} {
+	out := make(map[string]map[string]struct{}, len(latinLanguages))
+	for _, lang := range latinLanguages {
+		words := map[string]struct{}{}
+		for _, word := range stopwords.Presets[lang] {
+			words[word] = struct{}{}
+		}
+		out[lang] = words
+	}
+	return out
+}()
+
+// Detect returns the ISO 639-1 code of the language of the values or an
+// empty string if it can't be determined
+func Detect(values ...string) string {
+	counts := map[string]int{}
+	letters, latin := 0, 0
+	for _, value := range values {
+		for _, r := range value {
+			if !unicode.IsLetter(r) {
+				continue
+			}
+			// letters of other scripts, e.g. the prolonged sound mark of
+			// katakana, are shared by several languages and aren't counted
+			if unicode.Is(unicode.Latin, r) {
+				letters++
+				latin++
+				continue
+			}
+			for _, script := range scripts {
+				if unicode.Is(script.table, r) {
+					letters++
+					counts[script.language]++
+					break
+				}
+			}
+		}
+	}
+	if letters == 0 {
+		return ""
+	}
+
+	// Japanese mixes kanji with kana, any kana tells it apart from Chinese
+	if counts[Japanese] > 0 && counts[Japanese]+counts[Chinese] > letters/2 {
+		return Japanese
+	}
+	for _, script := range scripts {
+		if counts[script.language] > letters/2 {
+			return script.language
+		}
+	}
+	if latin > letters/2 {
+		return detectLatin(values)
+	}
+	return ""
+}
+
+// detectLatin returns the language whose stopwords occur most often in the
+// values, ties are not resolved
+func detectLatin(values []string) string {
+	hits := make(map[string]int, len(latinLanguages))
+	for _, value := range values {
+		for _, term := range helpers.TokenizeText(value) {
+			for lang, words := range latinStopwords {
+				if _, ok := words[term]; ok {
+					hits[lang]++
+				}
+			}
+		}
+	}
+
+	best, bestHits, tie := "", 0, false
+	for _, lang := range latinLanguages {
+		switch {
+		case hits[lang] > bestHits:
+			best, bestHits, tie = lang, hits[lang], false
+		case hits[lang] == bestHits && bestHits > 0:
+			tie = true
+		}
+	}
+	if tie {
+		return ""
+	}
+	return best
+}
+
+// IsCJK is true for the languages which are tokenized into bigrams as
+// they don't separate words by spaces
+func IsCJK(lang string) bool {
+	return lang == Chinese || lang == Japanese || lang == Korean
+}
@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package langdetect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []string
+		expected string
+	}{
+		{name: "empty", values: nil, expected: ""},
+		{name: "no letters", values: []string{"1234 !?"}, expected: ""},
+		{name: "english", values: []string{"The quick brown fox jumps over the lazy dog and the cat"}, expected: "en"},
+		{name: "german", values: []string{"Der schnelle braune Fuchs springt über den faulen Hund und die Katze"}, expected: "de"},
+		{name: "french", values: []string{"Le renard brun saute par-dessus le chien paresseux et les chats"}, expected: "fr"},
+		{name: "spanish", values: []string{"El zorro marrón salta sobre el perro perezoso y los gatos"}, expected: "es"},
+		{name: "italian", values: []string{"Il gatto è sul tavolo della cucina con gli amici"}, expected: "it"},
+		{name: "dutch", values: []string{"De kat zit op de tafel in het huis van mijn moeder"}, expected: "nl"},
+		{name: "portuguese", values: []string{"O gato está na mesa da cozinha com os amigos do João"}, expected: "pt"},
+		{name: "latin without stopwords", values: []string{"weaviate vector database"}, expected: ""},
+		{name: "chinese", values: []string{"东京是日本的首都"}, expected: "zh"},
+		{name: "japanese", values: []string{"東京は日本の首都です"}, expected: "ja"},
+		{name: "katakana only", values: []string{"コーヒー"}, expected: "ja"},
+		{name: "korean", values: []string{"서울은 한국의 수도입니다"}, expected: "ko"},
+		{name: "russian", values: []string{"Москва столица России"}, expected: "ru"},
+		{name: "greek", values: []string{"Η Αθήνα είναι η πρωτεύουσα"}, expected: "el"},
+		{name: "mixed script majority", values: []string{"東京 Tokyo 日本の首都です"}, expected: "ja"},
+		{name: "several values", values: []string{"the cat", "and the dog"}, expected: "en"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Detect(test.values...))
+		})
+	}
+}
+
+func TestIsCJK(t *testing.T) {
+	for _, lang := range []string{Chinese, Japanese, Korean} {
+		assert.True(t, IsCJK(lang), lang)
+	}
+	for _, lang := range []string{"", "en", Russian, Thai} {
+		assert.False(t, IsCJK(lang), lang)
+	}
+}
@@ -0,0 +1,148 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"sort"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/langdetect"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/tokenizer"
+)
+
+// HasLanguageDetection is true if the language of the values of any of the
+// properties is detected, their languages are then indexed in the
+// _language property
+func HasLanguageDetection(props []*models.Property) bool {
+	for _, prop := range props {
+		if prop.LanguageDetection {
+			return true
+		}
+	}
+	return false
+}
+
+// languageTokenization returns the tokenization of a value of the property
+// in the language lang. Values in Chinese, Japanese or Korean are split into
+// bigrams if the language of the property is detected.
+func languageTokenization(prop *models.Property, lang string) string {
+	if prop.LanguageDetection && langdetect.IsCJK(lang) &&
+		prop.Tokenization == models.PropertyTokenizationWord {
+		return tokenizer.CJKName
+	}
+	return prop.Tokenization
+}
+
+// propertyTokenization returns the tokenization of the values of the
+// property, it is the same for indexing and for queries
+func propertyTokenization(prop *models.Property, values ...string) string {
+	if !prop.LanguageDetection {
+		return prop.Tokenization
+	}
+	return languageTokenization(prop, langdetect.Detect(values...))
+}
+
+// valueAnalysis returns the tokenization of a filter value on the property
+// and the stopwords of its language, which are nil if the language of the
+// property isn't detected
+func valueAnalysis(prop *models.Property,
+	value interface{},
+) (string, stopwords.StopwordDetector) {
+	v, ok := value.(string)
+	if !prop.LanguageDetection || !ok {
+		return prop.Tokenization, nil
+	}
+	lang := langdetect.Detect(v)
+	if d := languageStopwords(lang); d != nil {
+		return languageTokenization(prop, lang), d
+	}
+	return languageTokenization(prop, lang), nil
+}
+
+var languageDetectors = func() map[string]*stopwords.Detector {
+	out := map[string]*stopwords.Detector{}
+	for lang := range stopwords.Presets {
+		if lang == stopwords.NoPreset {
+			continue
+		}
+		// can't fail, the preset exists
+		out[lang], _ = stopwords.NewDetectorFromPreset(lang)
+	}
+	return out
+}()
+
+// languageStopwords returns the stopwords of the language or nil if there is
+// no preset for it
+func languageStopwords(lang string) *stopwords.Detector {
+	return languageDetectors[lang]
+}
+
+// analyzeLanguageProp indexes the distinct languages detected in the values
+// of the properties with language detection. It returns nil if the object
+// has no such values.
+func (a *Analyzer) analyzeLanguageProp(props []*models.Property,
+	input map[string]any,
+) *Property {
+	seen := map[string]struct{}{}
+	for _, prop := range props {
+		if !prop.LanguageDetection {
+			continue
+		}
+		values := textValues(input[prop.Name])
+		if len(values) == 0 {
+			continue
+		}
+		if lang := langdetect.Detect(values...); lang != "" {
+			seen[lang] = struct{}{}
+		}
+	}
+	if len(seen) == 0 {
+		return nil
+	}
+
+	langs := make([]string, 0, len(seen))
+	for lang := range seen {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+
+	items := make([]Countable, len(langs))
+	for i, lang := range langs {
+		items[i] = Countable{Data: []byte(lang)}
+	}
+	return &Property{
+		Name:  filters.InternalPropLanguage,
+		Items: items,
+	}
+}
+
+// textValues returns the values of a text or text[] property
+func textValues(value any) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []any:
+		out := make([]string, 0, len(v))
+		for _, elem := range v {
+			if s, ok := elem.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
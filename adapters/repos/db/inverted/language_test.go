@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestAnalyzeLanguageDetection(t *testing.T) {
+	props := []*models.Property{
+		{
+			Name:              "title",
+			DataType:          []string{"text"},
+			Tokenization:      models.PropertyTokenizationWord,
+			LanguageDetection: true,
+		},
+		{
+			Name:              "tags",
+			DataType:          []string{"text[]"},
+			Tokenization:      models.PropertyTokenizationWord,
+			LanguageDetection: true,
+		},
+		{
+			Name:         "plain",
+			DataType:     []string{"text"},
+			Tokenization: models.PropertyTokenizationWord,
+		},
+	}
+	analyze := func(input map[string]any) map[string]Property {
+		res, err := NewAnalyzer(nil).Object(input, props, "ce03ac8a-5f5e-4d8c-a5b0-6a4d1f5c5f67")
+		require.Nil(t, err)
+		out := map[string]Property{}
+		for _, prop := range res {
+			out[prop.Name] = prop
+		}
+		return out
+	}
+	terms := func(prop Property) []string {
+		out := make([]string, len(prop.Items))
+		for i, item := range prop.Items {
+			out[i] = string(item.Data)
+		}
+		return out
+	}
+
+	t.Run("cjk values are split into bigrams", func(t *testing.T) {
+		res := analyze(map[string]any{
+			"title": "東京タワー",
+			"plain": "東京タワー",
+		})
+		assert.ElementsMatch(t, []string{"東京", "京タ", "タワ", "ワー"}, terms(res["title"]))
+		assert.ElementsMatch(t, []string{"東京タワー"}, terms(res["plain"]))
+		assert.Equal(t, []string{"ja"}, terms(res[filters.InternalPropLanguage]))
+	})
+
+	t.Run("distinct languages of all properties are indexed", func(t *testing.T) {
+		res := analyze(map[string]any{
+			"title": "The fox and the hound",
+			"tags":  []any{"der Fuchs und der Hund", "die Katze"},
+			"plain": "le chat et le chien",
+		})
+		assert.ElementsMatch(t, []string{"the", "fox", "and", "hound"}, terms(res["title"]))
+		assert.Equal(t, []string{"de", "en"}, terms(res[filters.InternalPropLanguage]))
+	})
+
+	t.Run("no language is indexed if it can't be detected", func(t *testing.T) {
+		res := analyze(map[string]any{"title": "weaviate", "plain": "the fox"})
+		_, ok := res[filters.InternalPropLanguage]
+		assert.False(t, ok)
+	})
+}
+
+func TestValueAnalysis(t *testing.T) {
+	prop := &models.Property{
+		Name:              "title",
+		DataType:          []string{"text"},
+		Tokenization:      models.PropertyTokenizationWord,
+		LanguageDetection: true,
+	}
+
+	tokenization, stopwords := valueAnalysis(prop, "東京タワー")
+	assert.Equal(t, "cjk", tokenization)
+	assert.Nil(t, stopwords)
+
+	tokenization, stopwords = valueAnalysis(prop, "der Fuchs und der Hund")
+	assert.Equal(t, models.PropertyTokenizationWord, tokenization)
+	require.NotNil(t, stopwords)
+	assert.True(t, stopwords.IsStopword("und"))
+	assert.False(t, stopwords.IsStopword("fuchs"))
+
+	prop.LanguageDetection = false
+	tokenization, stopwords = valueAnalysis(prop, "東京タワー")
+	assert.Equal(t, models.PropertyTokenizationWord, tokenization)
+	assert.Nil(t, stopwords)
+}
@@ -46,6 +46,10 @@ func (a *Analyzer) Object(input map[string]any, props []*models.Property,
 	}
 	properties = append(properties, *idProp)
 
+	if langProp := a.analyzeLanguageProp(props, input); langProp != nil {
+		properties = append(properties, *langProp)
+	}
+
 	tsProps, err := a.analyzeTimestampProps(input)
 	if err != nil {
 		return nil, fmt.Errorf("analyze timestamp props: %w", err)
@@ -218,7 +222,7 @@ func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []any) (*Prope
 		if err != nil {
 			return nil, err
 		}
-		items = a.TextArray(prop.Tokenization, in)
+		items = a.TextArray(propertyTokenization(prop, in...), in)
 	case schema.DataTypeStringArray:
 		hasFrequency = HasFrequency(dt)
 		in, err := stringsFromValues(prop, values)
@@ -337,10 +341,11 @@ func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []any) (*Prope
 	}
 
 	return &Property{
-		Name:         prop.Name,
-		Items:        items,
-		HasFrequency: hasFrequency,
-		Length:       len(values),
+		Name:             prop.Name,
+		Items:            items,
+		HasFrequency:     hasFrequency,
+		Length:           len(values),
+		PruningThreshold: prop.Bm25PruningThreshold,
 	}, nil
 }
 
@@ -368,7 +373,7 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value any) (*Prop
 		if !ok {
 			return nil, fmt.Errorf("expected property %s to be of type string, but got %T", prop.Name, value)
 		}
-		items = a.Text(prop.Tokenization, asString)
+		items = a.Text(propertyTokenization(prop, asString), asString)
 		propertyLength = utf8.RuneCountInString(asString)
 	case schema.DataTypeString:
 		hasFrequency = HasFrequency(dt)
@@ -471,10 +476,11 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value any) (*Prop
 	}
 
 	return &Property{
-		Name:         prop.Name,
-		Items:        items,
-		HasFrequency: hasFrequency,
-		Length:       propertyLength,
+		Name:             prop.Name,
+		Items:            items,
+		HasFrequency:     hasFrequency,
+		Length:           propertyLength,
+		PruningThreshold: prop.Bm25PruningThreshold,
 	}, nil
 }
 
@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/tokenizer"
+)
+
+// TokenizationPreview is how a text is analyzed for the inverted index of a
+// property and for BM25 queries on it
+type TokenizationPreview struct {
+	// Tokens are the terms in the inverted index in the order of the text
+	Tokens []string
+	// QueryTerms are the distinct terms BM25 searches for
+	QueryTerms []string
+	// Stopwords are removed from the query terms
+	Stopwords []string
+}
+
+// PreviewTokenization analyzes the text like the values of the property and
+// BM25 queries on it. The detector may be nil.
+func PreviewTokenization(prop *models.Property, detector stopwords.StopwordDetector,
+	text string,
+) (TokenizationPreview, error) {
+	var preview TokenizationPreview
+	if len(prop.DataType) != 1 {
+		return preview, fmt.Errorf("property %q is not tokenized", prop.Name)
+	}
+
+	isText := false
+	switch dt := schema.DataType(prop.DataType[0]); dt {
+	case schema.DataTypeText, schema.DataTypeTextArray:
+		isText = true
+		preview.Tokens = textArrayTokenize(propertyTokenization(prop, text), []string{text})
+	case schema.DataTypeString, schema.DataTypeStringArray:
+		preview.Tokens = stringArrayTokenize(prop.Tokenization, []string{text})
+	default:
+		return preview, fmt.Errorf("property %q of type %v is not tokenized",
+			prop.Name, dt)
+	}
+
+	// the query terms mirror the tokenization of BM25 queries
+	tokenization, langStopwords := prop.Tokenization, stopwords.StopwordDetector(nil)
+	if isText {
+		tokenization, langStopwords = valueAnalysis(prop, text)
+	}
+	switch {
+	case tokenization == models.PropertyTokenizationWord && isText:
+		for _, term := range distinct(helpers.TokenizeText(text)) {
+			if (detector != nil && detector.IsStopword(term)) ||
+				(langStopwords != nil && langStopwords.IsStopword(term)) {
+				preview.Stopwords = append(preview.Stopwords, term)
+				continue
+			}
+			preview.QueryTerms = append(preview.QueryTerms, term)
+		}
+	case tokenization == models.PropertyTokenizationWord:
+		preview.QueryTerms = distinct(helpers.TokenizeString(text))
+	case tokenizer.Get(tokenization) != nil:
+		preview.QueryTerms = distinct(tokenizer.Get(tokenization).Tokenize(text))
+	default:
+		preview.QueryTerms = []string{text}
+	}
+
+	return preview, nil
+}
+
+// distinct removes duplicates, keeping the first occurrence
+func distinct(terms []string) []string {
+	seen := make(map[string]struct{}, len(terms))
+	out := make([]string, 0, len(terms))
+	for _, term := range terms {
+		if _, ok := seen[term]; ok {
+			continue
+		}
+		seen[term] = struct{}{}
+		out = append(out, term)
+	}
+	return out
+}
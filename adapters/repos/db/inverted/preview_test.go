@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/tokenizer"
+)
+
+func TestPreviewTokenization(t *testing.T) {
+	registerCitationTokenizer.Do(func() {
+		require.Nil(t, tokenizer.Register("citation", citationTokenizer{}))
+	})
+	detector, err := stopwords.NewDetectorFromPreset(stopwords.EnglishPreset)
+	require.Nil(t, err)
+
+	prop := func(dt schema.DataType, tokenization string) *models.Property {
+		return &models.Property{
+			Name:         "prop",
+			DataType:     []string{string(dt)},
+			Tokenization: tokenization,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		prop     *models.Property
+		text     string
+		expected TokenizationPreview
+	}{
+		{
+			name: "text",
+			prop: prop(schema.DataTypeText, models.PropertyTokenizationWord),
+			text: "The Fox and the Hound",
+			expected: TokenizationPreview{
+				Tokens:     []string{"the", "fox", "and", "the", "hound"},
+				QueryTerms: []string{"fox", "hound"},
+				Stopwords:  []string{"the", "and"},
+			},
+		},
+		{
+			name: "string",
+			prop: prop(schema.DataTypeStringArray, models.PropertyTokenizationWord),
+			text: "The Fox and the Fox",
+			expected: TokenizationPreview{
+				Tokens:     []string{"The", "Fox", "and", "the", "Fox"},
+				QueryTerms: []string{"The", "Fox", "and", "the"},
+			},
+		},
+		{
+			name: "field",
+			prop: prop(schema.DataTypeString, models.PropertyTokenizationField),
+			text: " The Fox ",
+			expected: TokenizationPreview{
+				Tokens:     []string{"The Fox"},
+				QueryTerms: []string{" The Fox "},
+			},
+		},
+		{
+			name: "custom",
+			prop: prop(schema.DataTypeText, "citation"),
+			text: "42 U.S.C. § 1983; 18 U.S.C. § 242; 42 U.S.C. § 1983",
+			expected: TokenizationPreview{
+				Tokens:     []string{"42 U.S.C. § 1983", "18 U.S.C. § 242", "42 U.S.C. § 1983"},
+				QueryTerms: []string{"42 U.S.C. § 1983", "18 U.S.C. § 242"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			preview, err := PreviewTokenization(test.prop, detector, test.text)
+			require.Nil(t, err)
+			assert.Equal(t, test.expected, preview)
+		})
+	}
+
+	t.Run("not tokenized", func(t *testing.T) {
+		_, err := PreviewTokenization(prop(schema.DataTypeInt, ""), detector, "1")
+		assert.NotNil(t, err)
+	})
+}
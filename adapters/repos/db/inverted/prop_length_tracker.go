@@ -264,6 +264,34 @@ func (t *PropertyLengthTracker) PropertyMean(propName string) (float32, error) {
 	return sum / totalCount, nil
 }
 
+// CopyProperty copies the lengths tracked for a property to another one, e.g.
+// when a property is renamed. Properties can't be removed from the tracker,
+// the lengths of the source stay in place. Lengths which were tracked for the
+// target before are overwritten.
+func (t *PropertyLengthTracker) CopyProperty(from, to string) error {
+	t.Lock()
+	defer t.Unlock()
+
+	srcPage, srcOffset, ok := t.propExists(from)
+	if !ok {
+		return nil
+	}
+
+	dstPage, dstOffset, ok := t.propExists(to)
+	if !ok {
+		var err error
+		dstPage, dstOffset, err = t.addProperty(to)
+		if err != nil {
+			return err
+		}
+	}
+
+	src := srcPage*4096 + srcOffset
+	dst := dstPage*4096 + dstOffset
+	copy(t.pages[dst:dst+256], t.pages[src:src+256])
+	return nil
+}
+
 func (t *PropertyLengthTracker) createPageIfNotExists(page uint16) {
 	if uint16(len(t.pages))/4096-1 < page {
 		// we need to grow the page buffer
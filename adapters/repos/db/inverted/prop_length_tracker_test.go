@@ -263,3 +263,44 @@ func Test_PropertyLengthTracker_Overflow(t *testing.T) {
 	err = tracker.TrackProperty("OVERFLOW", float32(123))
 	require.NotNil(t, err)
 }
+
+func Test_PropertyLengthTracker_CopyProperty(t *testing.T) {
+	tracker, err := NewPropertyLengthTracker(path.Join(t.TempDir(), "my_test_shard"))
+	require.Nil(t, err)
+	defer tracker.Close()
+
+	for _, v := range []float32{2, 4, 4, 10} {
+		require.Nil(t, tracker.TrackProperty("before", v))
+	}
+	expected, err := tracker.PropertyMean("before")
+	require.Nil(t, err)
+
+	t.Run("copy to a new property", func(t *testing.T) {
+		require.Nil(t, tracker.CopyProperty("before", "after"))
+
+		res, err := tracker.PropertyMean("after")
+		require.Nil(t, err)
+		assert.Equal(t, expected, res)
+
+		res, err = tracker.PropertyMean("before")
+		require.Nil(t, err)
+		assert.Equal(t, expected, res)
+	})
+
+	t.Run("copy over an existing property", func(t *testing.T) {
+		require.Nil(t, tracker.TrackProperty("stale", 100))
+		require.Nil(t, tracker.CopyProperty("before", "stale"))
+
+		res, err := tracker.PropertyMean("stale")
+		require.Nil(t, err)
+		assert.Equal(t, expected, res)
+	})
+
+	t.Run("copy a property which isn't tracked", func(t *testing.T) {
+		require.Nil(t, tracker.CopyProperty("unknown", "other"))
+
+		res, err := tracker.PropertyMean("other")
+		require.Nil(t, err)
+		assert.Equal(t, float32(0), res)
+	})
+}
@@ -34,6 +34,8 @@ type propValuePair struct {
 	// only set if operator=OperatorWithinGeoRange, as that cannot be served by a
 	// byte value from an inverted index
 	valueGeoRange *filters.GeoRange
+	// only set if operator=OperatorWithinGeoPolygon or OperatorWithinGeoBox
+	valueGeoShape filters.GeoShape
 	hasFrequency  bool
 	docIDs        docBitmap
 	children      []*propValuePair
@@ -84,8 +86,13 @@ func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int, skipCache bool) err
 				"add `indexTimestamps: true` to the invertedIndexConfig")
 		}
 
-		if b == nil && pv.operator != filters.OperatorWithinGeoRange {
-			// a nil bucket is ok for a WithinGeoRange filter, as this query is not
+		if b == nil && pv.prop == filters.InternalPropLanguage {
+			return errors.Errorf("languages must be detected to be filterable! " +
+				"add `languageDetection: true` to a text property")
+		}
+
+		if b == nil && !isGeoOperator(pv.operator) {
+			// a nil bucket is ok for a geo filter, as this query is not
 			// served by the inverted index, but propagated to a secondary index in
 			// .docPointers()
 			return errors.Errorf("bucket for prop %s not found - is it indexed?", pv.prop)
@@ -122,6 +129,20 @@ func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int, skipCache bool) err
 	return nil
 }
 
+// postingsCount is the number of doc ids read from the inverted index for
+// this pair, i.e. the sum of the doc ids of all leaves before merging
+func (pv *propValuePair) postingsCount() int {
+	if pv.operator.OnValue() {
+		return pv.docIDs.count()
+	}
+
+	count := 0
+	for _, child := range pv.children {
+		count += child.postingsCount()
+	}
+	return count
+}
+
 func (pv *propValuePair) mergeDocIDs() (*docBitmap, error) {
 	if pv.operator.OnValue() {
 		return &pv.docIDs, nil
@@ -61,7 +61,7 @@ func (pv *propValuePair) fetchHashes(s *Searcher) error {
 		}
 
 		b := s.store.Bucket(bucketName)
-		if b == nil && pv.operator != filters.OperatorWithinGeoRange {
+		if b == nil && !isGeoOperator(pv.operator) {
 			return errors.Errorf("hash bucket for prop %s not found - is it indexed?", pv.prop)
 		}
 
@@ -102,7 +102,7 @@ func (pv *propValuePair) hashForNonEqualOp(store *lsmkv.Store,
 ) ([]byte, error) {
 	bucketName := helpers.BucketFromPropNameLSM(pv.prop)
 	propBucket := store.Bucket(bucketName)
-	if propBucket == nil && pv.operator != filters.OperatorWithinGeoRange {
+	if propBucket == nil && !isGeoOperator(pv.operator) {
 		return nil, errors.Errorf("bucket for prop %s not found - is it indexed?", pv.prop)
 	}
 
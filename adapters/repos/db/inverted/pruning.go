@@ -0,0 +1,58 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// ImpactPruner decides at index time which postings of a property are left
+// out of its searchable bucket. A posting is pruned when the term frequency
+// component of its BM25 score is below the threshold configured on the
+// property. As the average property length is only known approximately at
+// index time, the impact is estimated with the average at the time of the
+// write.
+type ImpactPruner struct {
+	threshold  float64
+	config     schema.BM25Config
+	avgPropLen float64
+}
+
+func NewImpactPruner(threshold float64, config schema.BM25Config,
+	avgPropLen float32,
+) *ImpactPruner {
+	return &ImpactPruner{
+		threshold:  threshold,
+		config:     config,
+		avgPropLen: float64(avgPropLen),
+	}
+}
+
+// Impact is the term frequency component of the BM25 score as calculated by
+// the BM25Searcher, it lies between 0 and 1
+func (p *ImpactPruner) Impact(termFrequency, propLen float32) float64 {
+	freq := float64(termFrequency)
+	lengthRatio := 1.0 // the first object of a shard defines the average
+	if p.avgPropLen > 0 {
+		lengthRatio = float64(propLen) / p.avgPropLen
+	}
+	return freq / (freq + p.config.K1*(1-p.config.B+p.config.B*lengthRatio))
+}
+
+// Keep is true if the posting should be written. A nil pruner keeps every
+// posting
+func (p *ImpactPruner) Keep(termFrequency, propLen float32) bool {
+	if p == nil {
+		return true
+	}
+	return p.Impact(termFrequency, propLen) >= p.threshold
+}
@@ -0,0 +1,46 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestImpactPruner(t *testing.T) {
+	config := schema.BM25Config{K1: 1.2, B: 0.75}
+
+	t.Run("nil pruner keeps every posting", func(t *testing.T) {
+		var pruner *ImpactPruner
+		assert.True(t, pruner.Keep(1, 1000))
+	})
+
+	t.Run("impact matches the term frequency component of BM25", func(t *testing.T) {
+		pruner := NewImpactPruner(0.3, config, 10)
+		assert.InDelta(t, 1/(1+1.2), pruner.Impact(1, 10), 0.0001)
+		assert.InDelta(t, 2/(2+1.2*(0.25+0.75*4)), pruner.Impact(2, 40), 0.0001)
+	})
+
+	t.Run("the first object defines the average length", func(t *testing.T) {
+		pruner := NewImpactPruner(0.3, config, 0)
+		assert.InDelta(t, 1/(1+1.2), pruner.Impact(1, 50), 0.0001)
+	})
+
+	t.Run("postings below the threshold are pruned", func(t *testing.T) {
+		pruner := NewImpactPruner(0.3, config, 10)
+		assert.True(t, pruner.Keep(1, 10))
+		assert.False(t, pruner.Keep(1, 100))
+		assert.True(t, pruner.Keep(20, 100))
+	})
+}
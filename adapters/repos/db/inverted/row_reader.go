@@ -20,6 +20,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/notimplemented"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/querytimeout"
 )
 
 // RowReader reads one or many row(s) depending on the specified operator
@@ -113,7 +114,7 @@ func (rr *RowReader) greaterThan(ctx context.Context, readFn ReadFn,
 	defer c.Close()
 
 	for k, v := c.Seek(rr.value); k != nil; k, v = c.Next() {
-		if err := ctx.Err(); err != nil {
+		if stop, err := querytimeout.Stop(ctx); stop {
 			return err
 		}
 
@@ -144,7 +145,7 @@ func (rr *RowReader) lessThan(ctx context.Context, readFn ReadFn,
 	defer c.Close()
 
 	for k, v := c.First(); k != nil && bytes.Compare(k, rr.value) != 1; k, v = c.Next() {
-		if err := ctx.Err(); err != nil {
+		if stop, err := querytimeout.Stop(ctx); stop {
 			return err
 		}
 
@@ -172,7 +173,7 @@ func (rr *RowReader) notEqual(ctx context.Context, readFn ReadFn) error {
 	defer c.Close()
 
 	for k, v := c.First(); k != nil; k, v = c.Next() {
-		if err := ctx.Err(); err != nil {
+		if stop, err := querytimeout.Stop(ctx); stop {
 			return err
 		}
 
@@ -214,7 +215,7 @@ func (rr *RowReader) like(ctx context.Context, readFn ReadFn) error {
 	}
 
 	for k, v := initialK, initialV; k != nil; k, v = c.Next() {
-		if err := ctx.Err(); err != nil {
+		if stop, err := querytimeout.Stop(ctx); stop {
 			return err
 		}
 
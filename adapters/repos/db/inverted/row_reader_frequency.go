@@ -20,6 +20,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/notimplemented"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/querytimeout"
 )
 
 // RowReaderFrequency reads one or many row(s) depending on the specified operator
@@ -118,7 +119,7 @@ func (rr *RowReaderFrequency) greaterThan(ctx context.Context, readFn ReadFnFreq
 	defer c.Close()
 
 	for k, v := c.Seek(rr.value); k != nil; k, v = c.Next() {
-		if err := ctx.Err(); err != nil {
+		if stop, err := querytimeout.Stop(ctx); stop {
 			return err
 		}
 
@@ -149,7 +150,7 @@ func (rr *RowReaderFrequency) lessThan(ctx context.Context, readFn ReadFnFrequen
 	defer c.Close()
 
 	for k, v := c.First(); k != nil && bytes.Compare(k, rr.value) != 1; k, v = c.Next() {
-		if err := ctx.Err(); err != nil {
+		if stop, err := querytimeout.Stop(ctx); stop {
 			return err
 		}
 
@@ -177,7 +178,7 @@ func (rr *RowReaderFrequency) notEqual(ctx context.Context, readFn ReadFnFrequen
 	defer c.Close()
 
 	for k, v := c.First(); k != nil; k, v = c.Next() {
-		if err := ctx.Err(); err != nil {
+		if stop, err := querytimeout.Stop(ctx); stop {
 			return err
 		}
 
@@ -221,7 +222,7 @@ func (rr *RowReaderFrequency) like(ctx context.Context, readFn ReadFnFrequency)
 	}
 
 	for k, v := initialK, initialV; k != nil; k, v = c.Next() {
-		if err := ctx.Err(); err != nil {
+		if stop, err := querytimeout.Stop(ctx); stop {
 			return err
 		}
 
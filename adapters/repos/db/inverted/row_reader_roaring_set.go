@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/notimplemented"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/querytimeout"
 )
 
 // RowReaderRoaringSet reads one or many row(s) depending on the specified
@@ -119,7 +120,7 @@ func (rr *RowReaderRoaringSet) greaterThan(ctx context.Context,
 	defer c.Close()
 
 	for k, v := c.Seek(rr.value); k != nil; k, v = c.Next() {
-		if err := ctx.Err(); err != nil {
+		if stop, err := querytimeout.Stop(ctx); stop {
 			return err
 		}
 
@@ -147,7 +148,7 @@ func (rr *RowReaderRoaringSet) lessThan(ctx context.Context,
 	defer c.Close()
 
 	for k, v := c.First(); k != nil && bytes.Compare(k, rr.value) < 1; k, v = c.Next() {
-		if err := ctx.Err(); err != nil {
+		if stop, err := querytimeout.Stop(ctx); stop {
 			return err
 		}
 
@@ -174,7 +175,7 @@ func (rr *RowReaderRoaringSet) notEqual(ctx context.Context,
 	defer c.Close()
 
 	for k, v := c.First(); k != nil; k, v = c.Next() {
-		if err := ctx.Err(); err != nil {
+		if stop, err := querytimeout.Stop(ctx); stop {
 			return err
 		}
 
@@ -217,7 +218,7 @@ func (rr *RowReaderRoaringSet) like(ctx context.Context,
 	}
 
 	for k, v := initialK, initialV; k != nil; k, v = c.Next() {
-		if err := ctx.Err(); err != nil {
+		if stop, err := querytimeout.Stop(ctx); stop {
 			return err
 		}
 
@@ -16,6 +16,7 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -31,8 +32,10 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/querycost"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/tokenizer"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -46,6 +49,7 @@ type Searcher struct {
 	deletedDocIDs DeletedDocIDChecker
 	stopwords     stopwords.StopwordDetector
 	shardVersion  uint16
+	filterCache   *FilterCache
 }
 
 type cacher interface {
@@ -89,6 +93,8 @@ func (s *Searcher) Objects(ctx context.Context, limit int,
 	if err := pv.fetchDocIDs(s, limit, !pv.cacheable()); err != nil {
 		return nil, errors.Wrap(err, "fetch doc ids for prop/value pair")
 	}
+	tracker := querycost.FromContext(ctx)
+	tracker.AddPostingsScanned(pv.postingsCount())
 
 	dbm, err := pv.mergeDocIDs()
 	if err != nil {
@@ -104,10 +110,13 @@ func (s *Searcher) Objects(ctx context.Context, limit int,
 		}
 		it = newSliceDocIDsIterator(docIDs)
 	} else {
-		it = allowList.LimitedIterator(limit)
+		// doc ids of objects which have been updated or deleted may remain
+		// in the indexes of index-only properties, they are skipped when the
+		// objects are resolved, so the iterator isn't limited
+		it = allowList.Iterator()
 	}
 
-	return s.objectsByDocID(it, additional)
+	return s.objectsByDocID(it, limit, additional, tracker)
 }
 
 func (s *Searcher) sort(ctx context.Context, limit int, sort []filters.Sort, docIDs helpers.AllowList,
@@ -120,19 +129,24 @@ func (s *Searcher) sort(ctx context.Context, limit int, sort []filters.Sort, doc
 	return lsmSorter.SortDocIDs(ctx, limit, sort, docIDs)
 }
 
-func (s *Searcher) objectsByDocID(it docIDsIterator,
-	additional additional.Properties,
+// objectsByDocID resolves up to limit objects, a limit of 0 resolves all
+func (s *Searcher) objectsByDocID(it docIDsIterator, limit int,
+	additional additional.Properties, tracker *querycost.Tracker,
 ) ([]*storobj.Object, error) {
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 	if bucket == nil {
 		return nil, errors.Errorf("objects bucket not found")
 	}
 
-	out := make([]*storobj.Object, it.Len())
+	size := it.Len()
+	if limit > 0 && limit < size {
+		size = limit
+	}
+	out := make([]*storobj.Object, size)
 	docIDBytes := make([]byte, 8)
 
 	i := 0
-	for docID, ok := it.Next(); ok; docID, ok = it.Next() {
+	for docID, ok := it.Next(); ok && i < size; docID, ok = it.Next() {
 		binary.LittleEndian.PutUint64(docIDBytes, docID)
 		res, err := bucket.GetBySecondary(0, docIDBytes)
 		if err != nil {
@@ -142,6 +156,7 @@ func (s *Searcher) objectsByDocID(it docIDsIterator,
 		if res == nil {
 			continue
 		}
+		tracker.AddObjectLoaded(len(res))
 
 		var unmarshalled *storobj.Object
 		if additional.ReferenceQuery {
@@ -164,6 +179,13 @@ func (s *Searcher) objectsByDocID(it docIDsIterator,
 // full objects. Instead it returns the pure object id pointers. They can then
 // be used in a secondary index (e.g. vector index)
 //
+// WithFilterCache makes DocIDs serve repeated filters from cache, it is
+// optional as the cache is disabled if nil
+func (s *Searcher) WithFilterCache(cache *FilterCache) *Searcher {
+	s.filterCache = cache
+	return s
+}
+
 // DocID queries does not contain a limit by design, as we won't know if the limit
 // wouldn't remove the item that is most important for the follow up query.
 // Imagine the user sets the limit to 1 and the follow-up is a vector search.
@@ -191,6 +213,22 @@ func (s *Searcher) docIDs(ctx context.Context, filter *filters.LocalFilter,
 	additional additional.Properties, className schema.ClassName,
 	allowCaching bool,
 ) (helpers.AllowList, error) {
+	var (
+		filterKey   string
+		filterProps []string
+		generation  uint64
+		useCache    = allowCaching && s.filterCache != nil
+	)
+	if useCache {
+		filterKey, filterProps, useCache = filterCacheKey(filter, s.shardVersion)
+	}
+	if useCache {
+		if res, ok := s.filterCache.Load(filterKey); ok {
+			return res, nil
+		}
+		generation = s.filterCache.Generation()
+	}
+
 	pv, err := s.extractPropValuePair(filter.Root, className)
 	if err != nil {
 		return nil, err
@@ -210,6 +248,7 @@ func (s *Searcher) docIDs(ctx context.Context, filter *filters.LocalFilter,
 	if err := pv.fetchDocIDs(s, 0, !pv.cacheable()); err != nil {
 		return nil, errors.Wrap(err, "fetch doc ids for prop/value pair")
 	}
+	querycost.FromContext(ctx).AddPostingsScanned(pv.postingsCount())
 
 	dbm, err := pv.mergeDocIDs()
 	if err != nil {
@@ -224,6 +263,9 @@ func (s *Searcher) docIDs(ctx context.Context, filter *filters.LocalFilter,
 			Hash:      pv.docIDs.checksum,
 		})
 	}
+	if useCache {
+		s.filterCache.Store(filterKey, filterProps, dbm.docIDs, generation)
+	}
 
 	return out, nil
 }
@@ -290,8 +332,9 @@ func (s *Searcher) extractPropValuePair(filter *filters.Clause,
 			return nil, err
 		}
 
+		tokenization, langStopwords := valueAnalysis(property, filter.Value.Value)
 		return s.extractTokenizableProp(props[0], filter.Value.Type, filter.Value.Value,
-			filter.Operator, property.Tokenization)
+			filter.Operator, tokenization, langStopwords)
 	}
 
 	return s.extractPrimitiveProp(props[0], filter.Value.Type, filter.Value.Value,
@@ -365,18 +408,44 @@ func (s *Searcher) extractGeoFilter(propName string, value interface{},
 ) (*propValuePair, error) {
 	if valueType != schema.DataTypeGeoCoordinates {
 		return nil, fmt.Errorf("prop %q is of type geoCoordinates, it can only"+
-			"be used with geoRange filters", propName)
+			"be used with geoRange, geoPolygon and geoBox filters", propName)
+	}
+
+	out := &propValuePair{
+		value:        nil, // not going to be served by an inverted index
+		hasFrequency: false,
+		prop:         propName,
+		operator:     operator,
 	}
 
-	parsed := value.(filters.GeoRange)
+	var ok bool
+	switch operator {
+	case filters.OperatorWithinGeoRange:
+		var parsed filters.GeoRange
+		parsed, ok = value.(filters.GeoRange)
+		out.valueGeoRange = &parsed
+	case filters.OperatorWithinGeoPolygon:
+		out.valueGeoShape, ok = value.(filters.GeoPolygon)
+	case filters.OperatorWithinGeoBox:
+		out.valueGeoShape, ok = value.(filters.GeoBox)
+	default:
+		return nil, fmt.Errorf("prop %q is of type geoCoordinates, operator %s "+
+			"is not supported", propName, operator.Name())
+	}
+	if !ok {
+		return nil, fmt.Errorf("operator %s on prop %q does not match value of type %T",
+			operator.Name(), propName, value)
+	}
 
-	return &propValuePair{
-		value:         nil, // not going to be served by an inverted index
-		valueGeoRange: &parsed,
-		hasFrequency:  false,
-		prop:          propName,
-		operator:      operator,
-	}, nil
+	return out, nil
+}
+
+// isGeoOperator is true for the operators which are served by the geo index
+// of a property instead of the inverted index
+func isGeoOperator(op filters.Operator) bool {
+	return op == filters.OperatorWithinGeoRange ||
+		op == filters.OperatorWithinGeoPolygon ||
+		op == filters.OperatorWithinGeoBox
 }
 
 func (s *Searcher) extractUUIDFilter(propName string, value interface{},
@@ -414,6 +483,8 @@ func (s *Searcher) extractInternalProp(propName string, propType schema.DataType
 		return s.extractIDProp(value, operator)
 	case filters.InternalPropCreationTimeUnix, filters.InternalPropLastUpdateTimeUnix:
 		return extractTimestampProp(propName, propType, value, operator)
+	case filters.InternalPropLanguage:
+		return extractLanguageProp(value, operator)
 	default:
 		return nil, fmt.Errorf(
 			"failed to extract internal prop, unsupported internal prop '%s'", propName)
@@ -475,8 +546,37 @@ func extractTimestampProp(propName string, propType schema.DataType, value inter
 	}, nil
 }
 
+func extractLanguageProp(value interface{}, operator filters.Operator,
+) (*propValuePair, error) {
+	v, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected value to be string, got %T", value)
+	}
+
+	return &propValuePair{
+		value:        []byte(strings.ToLower(v)),
+		hasFrequency: false,
+		prop:         filters.InternalPropLanguage,
+		operator:     operator,
+	}, nil
+}
+
+func customTokenizeFilterValue(value string, operator filters.Operator,
+	tokenization string, dt schema.DataType,
+) ([]string, error) {
+	t := tokenizer.Get(tokenization)
+	if t == nil {
+		return nil, fmt.Errorf("unsupported tokenization '%v' configured for data type '%v'", tokenization, dt)
+	}
+	if operator == filters.OperatorLike {
+		return tokenizer.TokenizeKeepWildcards(t, value), nil
+	}
+	return t.Tokenize(value), nil
+}
+
 func (s *Searcher) extractTokenizableProp(propName string, dt schema.DataType, value interface{},
 	operator filters.Operator, tokenization string,
+	langStopwords stopwords.StopwordDetector,
 ) (*propValuePair, error) {
 	var parts []string
 
@@ -488,7 +588,11 @@ func (s *Searcher) extractTokenizableProp(propName string, dt schema.DataType, v
 		case models.PropertyTokenizationField:
 			parts = []string{helpers.TrimString(value.(string))}
 		default:
-			return nil, fmt.Errorf("unsupported tokenization '%v' configured for data type '%v'", tokenization, dt)
+			custom, err := customTokenizeFilterValue(value.(string), operator, tokenization, dt)
+			if err != nil {
+				return nil, err
+			}
+			parts = custom
 		}
 	case schema.DataTypeText:
 		switch tokenization {
@@ -501,7 +605,11 @@ func (s *Searcher) extractTokenizableProp(propName string, dt schema.DataType, v
 				parts = helpers.TokenizeText(value.(string))
 			}
 		default:
-			return nil, fmt.Errorf("unsupported tokenization '%v' configured for data type '%v'", tokenization, dt)
+			custom, err := customTokenizeFilterValue(value.(string), operator, tokenization, dt)
+			if err != nil {
+				return nil, err
+			}
+			parts = custom
 		}
 	default:
 		return nil, fmt.Errorf("expected value type to be string or text, got %v", dt)
@@ -509,7 +617,8 @@ func (s *Searcher) extractTokenizableProp(propName string, dt schema.DataType, v
 
 	propValuePairs := make([]*propValuePair, 0, len(parts))
 	for _, part := range parts {
-		if s.stopwords.IsStopword(part) {
+		if s.stopwords.IsStopword(part) ||
+			(langStopwords != nil && langStopwords.IsStopword(part)) {
 			continue
 		}
 		propValuePairs = append(propValuePairs, &propValuePair{
@@ -28,7 +28,7 @@ func (s *Searcher) docBitmap(ctx context.Context, b *lsmkv.Bucket, limit int,
 	// geo props cannot be served by the inverted index and they require an
 	// external index. So, instead of trying to serve this chunk of the filter
 	// request internally, we can pass it to an external geo index
-	if pv.operator == filters.OperatorWithinGeoRange {
+	if isGeoOperator(pv.operator) {
 		return s.docBitmapGeo(ctx, pv)
 	}
 	// all other operators perform operations on the inverted index which we
@@ -208,9 +208,16 @@ func (s *Searcher) docBitmapGeo(ctx context.Context, pv *propValuePair) (docBitm
 		return out, nil
 	}
 
-	res, err := propIndex.GeoIndex.WithinRange(ctx, *pv.valueGeoRange)
+	var res []uint64
+	var err error
+	if pv.valueGeoShape != nil {
+		res, err = propIndex.GeoIndex.WithinShape(ctx, pv.valueGeoShape)
+	} else {
+		res, err = propIndex.GeoIndex.WithinRange(ctx, *pv.valueGeoRange)
+	}
 	if err != nil {
-		return out, errors.Wrapf(err, "geo index range search on prop %q", pv.prop)
+		return out, errors.Wrapf(err, "geo index %s search on prop %q",
+			pv.operator.Name(), pv.prop)
 	}
 
 	out.docIDs.SetMany(res)
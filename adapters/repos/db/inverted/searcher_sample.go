@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// SampleDocIDs returns the doc ids of the shard's part of the sample out of
+// the objects matching the filter, or out of all objects if there is no
+// filter. Only the sampled doc ids are held in memory while the matches are
+// iterated.
+func (s *Searcher) SampleDocIDs(ctx context.Context, filter *filters.LocalFilter,
+	sample filters.Sample, className schema.ClassName,
+) ([]helpers.SampledDoc, error) {
+	reservoir := helpers.NewSampleReservoir(sample)
+
+	if filter != nil {
+		allow, err := s.DocIDs(ctx, filter, additional.Properties{}, className)
+		if err != nil {
+			return nil, err
+		}
+
+		it := allow.Iterator()
+		for docID, ok := it.Next(); ok; docID, ok = it.Next() {
+			reservoir.Add(docID)
+		}
+		return reservoir.Docs(), nil
+	}
+
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	if bucket == nil {
+		return nil, errors.Errorf("objects bucket not found")
+	}
+
+	cursor := bucket.Cursor()
+	defer cursor.Close()
+
+	i := 0
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		if i%1000 == 0 && ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		i++
+
+		docID, err := storobj.DocIDFromBinary(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "read doc id of object %x", k)
+		}
+		reservoir.Add(docID)
+	}
+
+	return reservoir.Docs(), nil
+}
@@ -12,8 +12,14 @@
 package stopwords
 
 const (
-	EnglishPreset = "en"
-	NoPreset      = "none"
+	EnglishPreset    = "en"
+	GermanPreset     = "de"
+	FrenchPreset     = "fr"
+	SpanishPreset    = "es"
+	ItalianPreset    = "it"
+	DutchPreset      = "nl"
+	PortuguesePreset = "pt"
+	NoPreset         = "none"
 )
 
 var Presets = map[string][]string{
@@ -23,5 +29,43 @@ var Presets = map[string][]string{
 		"the", "their", "then", "there", "these", "they", "this", "to", "was", "will",
 		"with",
 	},
+	GermanPreset: {
+		"aber", "als", "am", "an", "auch", "auf", "aus", "bei", "bin", "bis",
+		"das", "dass", "dem", "den", "der", "des", "die", "ein", "eine", "einem",
+		"einen", "einer", "es", "für", "hat", "ich", "im", "in", "ist", "mit",
+		"nach", "nicht", "noch", "oder", "sich", "sie", "sind", "so", "und", "von",
+		"war", "was", "wie", "wir", "wird", "zu", "zum", "zur",
+	},
+	FrenchPreset: {
+		"au", "aux", "avec", "ce", "ces", "dans", "de", "des", "du", "elle",
+		"en", "est", "et", "il", "ils", "je", "la", "le", "les", "leur",
+		"lui", "mais", "me", "mes", "ne", "nous", "on", "ou", "par", "pas",
+		"pour", "qu", "que", "qui", "sa", "se", "ses", "son", "sont", "sur",
+		"un", "une", "vous",
+	},
+	SpanishPreset: {
+		"al", "como", "con", "de", "del", "el", "en", "es", "esta", "este",
+		"fue", "ha", "la", "las", "le", "les", "lo", "los", "más", "me",
+		"mi", "no", "o", "para", "pero", "por", "que", "se", "su", "sus",
+		"un", "una", "y", "ya",
+	},
+	ItalianPreset: {
+		"al", "alla", "anche", "che", "chi", "con", "da", "dei", "del", "della",
+		"di", "e", "è", "gli", "ha", "i", "il", "in", "la", "le",
+		"lo", "ma", "mi", "nel", "nella", "non", "per", "più", "si", "sono",
+		"su", "sua", "suo", "un", "una", "uno",
+	},
+	DutchPreset: {
+		"aan", "al", "als", "bij", "dat", "de", "die", "dit", "een", "en",
+		"er", "het", "hij", "hoe", "ik", "in", "is", "je", "maar", "met",
+		"na", "naar", "niet", "nog", "of", "om", "ook", "op", "te", "tot",
+		"uit", "van", "voor", "was", "wat", "we", "zij", "zijn",
+	},
+	PortuguesePreset: {
+		"ao", "as", "com", "como", "da", "das", "de", "do", "dos", "e",
+		"é", "ela", "ele", "em", "foi", "mais", "mas", "na", "nas", "no",
+		"não", "nos", "o", "os", "ou", "para", "pela", "pelo", "por", "que",
+		"se", "sua", "seu", "um", "uma",
+	},
 	NoPreset: {},
 }
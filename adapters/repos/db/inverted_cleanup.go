@@ -0,0 +1,278 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// invertedCleanupMinDeletedRatio is the share of deleted postings from which
+// the periodic cleanup compacts a searchable bucket. Below it the cleanup
+// isn't worth rewriting the bucket, a manual cleanup compacts it anyway.
+var invertedCleanupMinDeletedRatio = 0.1
+
+// cleanupInverted cleans up the inverted index of all replicas of all shards
+// of the index one after the other, see Shard.cleanupInverted. A replica is
+// cleaned up by the node which holds it.
+func (i *Index) cleanupInverted(ctx context.Context) (*models.ClassInvertedCleanupResult, error) {
+	shardState := i.getSchema.ShardingState(i.Config.ClassName.String())
+	if shardState == nil {
+		return nil, errors.Errorf("no sharding state for class %q", i.Config.ClassName)
+	}
+	thisNode := i.getSchema.NodeName()
+
+	result := &models.ClassInvertedCleanupResult{
+		Class:  i.Config.ClassName.String(),
+		Shards: []*models.ShardInvertedCleanupResult{},
+	}
+	for _, shardName := range shardState.AllPhysicalShards() {
+		for _, node := range shardState.Physical[shardName].BelongsToNodes {
+			var (
+				res *models.ShardInvertedCleanupResult
+				err error
+			)
+			if node == thisNode {
+				res, err = i.IncomingCleanupInvertedShard(ctx, shardName)
+			} else {
+				res, err = i.remote.CleanupInvertedShard(ctx, shardName, node)
+			}
+			if err != nil {
+				return nil, errors.Wrapf(err, "clean up inverted index of shard %q on node %q",
+					shardName, node)
+			}
+
+			result.Shards = append(result.Shards, res)
+			result.ReclaimedBytes += res.ReclaimedBytes
+		}
+	}
+
+	return result, nil
+}
+
+// IncomingCleanupInvertedShard cleans up all searchable buckets of the local
+// replica of a shard which contain deleted postings, regardless of their
+// share. Like an optimization, it fails if a backup of the index is in
+// progress.
+func (i *Index) IncomingCleanupInvertedShard(ctx context.Context,
+	shardName string,
+) (*models.ShardInvertedCleanupResult, error) {
+	if err := i.beginOptimize(); err != nil {
+		return nil, err
+	}
+	defer i.endOptimize()
+
+	shard, ok := i.Shards[shardName]
+	if !ok {
+		return nil, errors.Errorf("shard %q does not exist locally", shardName)
+	}
+
+	return shard.cleanupInverted(ctx, 0)
+}
+
+// initInvertedCleanup starts the cycle which cleans up the deleted postings
+// of the searchable properties of the shard every CleanupIntervalSeconds of
+// the class. Each run updates the deleted ratios of the buckets and cleans
+// up the ones above invertedCleanupMinDeletedRatio. A run is skipped while
+// the index is backed up or optimized.
+func (s *Shard) initInvertedCleanup() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.stopInvertedCleanup = cancel
+
+	go func() {
+		for {
+			// the interval is read on every run, as it can be changed with a
+			// class update
+			interval := s.index.getInvertedIndexConfig().CleanupInterval
+			if interval <= 0 {
+				interval = time.Duration(config.DefaultCleanupIntervalSeconds) * time.Second
+			}
+
+			t := time.NewTimer(interval)
+			select {
+			case <-ctx.Done():
+				t.Stop()
+				return
+			case <-t.C:
+				s.runInvertedCleanup(ctx)
+			}
+		}
+	}()
+}
+
+func (s *Shard) runInvertedCleanup(ctx context.Context) {
+	if s.isReadOnly() {
+		return
+	}
+
+	// the guard against backups is only taken if there is something to clean
+	// up, the stats of the segments are cached
+	due, err := s.invertedCleanupDue(invertedCleanupMinDeletedRatio)
+	if err != nil {
+		s.index.logger.WithField("action", "inverted_cleanup").
+			WithField("shard", s.ID()).WithError(err).
+			Error("could not determine deleted ratios of inverted index")
+		return
+	}
+	if !due {
+		return
+	}
+
+	if err := s.index.beginOptimize(); err != nil {
+		s.index.logger.WithField("action", "inverted_cleanup").
+			WithField("shard", s.ID()).WithError(err).
+			Debug("skipped inverted index cleanup")
+		return
+	}
+	defer s.index.endOptimize()
+
+	res, err := s.cleanupInverted(ctx, invertedCleanupMinDeletedRatio)
+	if err != nil {
+		if ctx.Err() == nil {
+			s.index.logger.WithField("action", "inverted_cleanup").
+				WithField("shard", s.ID()).WithError(err).
+				Error("could not clean up inverted index")
+		}
+		return
+	}
+	if len(res.CleanedProperties) > 0 {
+		s.index.logger.WithField("action", "inverted_cleanup").
+			WithField("shard", s.ID()).
+			WithField("properties", res.CleanedProperties).
+			WithField("reclaimed_bytes", res.ReclaimedBytes).
+			Debug("cleaned up inverted index")
+	}
+}
+
+// cleanupInverted compacts the searchable buckets of the shard whose share of
+// deleted postings is at least minDeletedRatio, which drops the postings of
+// the deleted objects, see lsmkv.Bucket.CleanupTombstones. Buckets without
+// deleted postings are never compacted. The deleted ratios are published as
+// metrics. The result contains the size of the searchable buckets before and
+// after.
+func (s *Shard) cleanupInverted(ctx context.Context,
+	minDeletedRatio float64,
+) (*models.ShardInvertedCleanupResult, error) {
+	if s.isReadOnly() {
+		return nil, storagestate.ErrStatusReadOnly
+	}
+
+	res := &models.ShardInvertedCleanupResult{
+		Name:              s.name,
+		Node:              s.index.getSchema.NodeName(),
+		CleanedProperties: []string{},
+	}
+	var before lsmkv.TombstoneStats
+	for _, sb := range s.searchableBuckets() {
+		prop, bucket := sb.prop, sb.bucket
+		stats, err := bucket.TombstoneStats()
+		if err != nil {
+			return nil, errors.Wrapf(err, "property %q", prop)
+		}
+		before.Values += stats.Values
+		before.Tombstones += stats.Tombstones
+		res.BytesBefore += stats.Bytes
+		s.setDeletedRatio(prop, stats.DeletedRatio())
+
+		if stats.Tombstones == 0 || stats.DeletedRatio() < minDeletedRatio {
+			res.BytesAfter += stats.Bytes
+			continue
+		}
+
+		if _, err := bucket.CleanupTombstones(ctx); err != nil {
+			return nil, errors.Wrapf(err, "clean up property %q", prop)
+		}
+		after, err := bucket.TombstoneStats()
+		if err != nil {
+			return nil, errors.Wrapf(err, "property %q", prop)
+		}
+		res.BytesAfter += after.Bytes
+		res.CleanedProperties = append(res.CleanedProperties, prop)
+		s.setDeletedRatio(prop, after.DeletedRatio())
+	}
+
+	res.DeletedRatioBefore = before.DeletedRatio()
+	res.ReclaimedBytes = res.BytesBefore - res.BytesAfter
+	return res, nil
+}
+
+// invertedCleanupDue updates the deleted ratios of the searchable buckets
+// and returns whether any of them is due for a cleanup
+func (s *Shard) invertedCleanupDue(minDeletedRatio float64) (bool, error) {
+	due := false
+	for _, sb := range s.searchableBuckets() {
+		prop, bucket := sb.prop, sb.bucket
+		stats, err := bucket.TombstoneStats()
+		if err != nil {
+			return false, errors.Wrapf(err, "property %q", prop)
+		}
+		s.setDeletedRatio(prop, stats.DeletedRatio())
+		if stats.Tombstones > 0 && stats.DeletedRatio() >= minDeletedRatio {
+			due = true
+		}
+	}
+	return due, nil
+}
+
+type searchableBucket struct {
+	prop   string
+	bucket *lsmkv.Bucket
+}
+
+// searchableBuckets returns the buckets of the properties of the class which
+// are indexed with term frequencies, in alphabetical order of the properties
+func (s *Shard) searchableBuckets() []searchableBucket {
+	sch := s.index.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(s.index.Config.ClassName)
+	if class == nil {
+		return nil
+	}
+
+	var out []searchableBucket
+	for _, prop := range class.Properties {
+		if prop.IndexInverted != nil && !*prop.IndexInverted {
+			continue
+		}
+		if !inverted.HasFrequency(schema.DataType(prop.DataType[0])) {
+			continue
+		}
+		bucket := s.store.Bucket(helpers.BucketFromPropNameLSM(prop.Name))
+		if bucket == nil || bucket.Strategy() != lsmkv.StrategyMapCollection {
+			continue
+		}
+		out = append(out, searchableBucket{prop: prop.Name, bucket: bucket})
+	}
+	sort.Slice(out, func(a, b int) bool { return out[a].prop < out[b].prop })
+	return out
+}
+
+func (s *Shard) setDeletedRatio(prop string, ratio float64) {
+	if s.promMetrics == nil {
+		return
+	}
+	s.promMetrics.InvertedDeletedRatio.With(prometheus.Labels{
+		"class_name": s.index.Config.ClassName.String(),
+		"shard_name": s.name,
+		"property":   prop,
+	}).Set(ratio)
+}
@@ -430,6 +430,143 @@ func TestIndexByTimestamps_GetClass(t *testing.T) {
 	})
 }
 
+func TestIndexByTimestamps_EnableOnExistingClass(t *testing.T) {
+	dirName := t.TempDir()
+
+	class := &models.Class{
+		Class:             "TestClass",
+		VectorIndexConfig: enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			CleanupIntervalSeconds: 60,
+			Stopwords: &models.StopwordConfig{
+				Preset: "none",
+			},
+		},
+		Properties: []*models.Property{
+			{
+				Name:         "name",
+				DataType:     []string{"string"},
+				Tokenization: "word",
+			},
+		},
+	}
+
+	shardState := singleShardState()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState, schema: schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(testCtx())
+	migrator := NewMigrator(repo, logger)
+
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	then := time.Now().Add(-time.Hour).UnixNano() / int64(time.Millisecond)
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	newID := strfmt.UUID("b0b55b05-bc5b-4cc9-b646-1452d1390a62")
+	oldID := func(i int) strfmt.UUID {
+		return strfmt.UUID(fmt.Sprintf("a0b55b05-bc5b-4cc9-b646-%012d", i))
+	}
+
+	put := func(id strfmt.UUID, ts int64) {
+		obj := &models.Object{
+			ID:                 id,
+			Class:              "TestClass",
+			CreationTimeUnix:   ts,
+			LastUpdateTimeUnix: ts,
+			Properties:         map[string]interface{}{"name": "objectarooni"},
+		}
+		vector := []float32{rand.Float32(), rand.Float32(), rand.Float32()}
+		require.Nil(t, repo.PutObject(context.Background(), obj, vector, nil))
+	}
+
+	changedSince := func(ts int64) []strfmt.UUID {
+		res, err := repo.ClassSearch(context.Background(), dto.GetParams{
+			ClassName:  "TestClass",
+			Pagination: &filters.Pagination{Limit: 10000},
+			Filters: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: filters.OperatorGreaterThanEqual,
+					On: &filters.Path{
+						Class:    "TestClass",
+						Property: "_lastUpdateTimeUnix",
+					},
+					Value: &filters.Value{
+						Value: msToRFC3339(ts),
+						Type:  dtDate,
+					},
+				},
+			},
+		})
+		require.Nil(t, err)
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID
+		}
+		return ids
+	}
+
+	for i := 0; i < 1000; i++ {
+		put(oldID(i), then)
+	}
+
+	t.Run("enable timestamp indexing", func(t *testing.T) {
+		updated := *class.InvertedIndexConfig
+		updated.IndexTimestamps = true
+		require.Nil(t, migrator.UpdateInvertedIndexConfig(context.Background(),
+			class.Class, &updated))
+	})
+
+	t.Run("write while existing objects are indexed", func(t *testing.T) {
+		put(newID, now)
+		for i := 0; i < 100; i++ {
+			put(oldID(i), now)
+		}
+		for i := 100; i < 150; i++ {
+			require.Nil(t, repo.DeleteObject(context.Background(), "TestClass", oldID(i), nil))
+		}
+	})
+
+	t.Run("wait for the indexing to complete", func(t *testing.T) {
+		assert.Eventually(t, func() bool {
+			statuses, err := repo.TimestampIndexingStatus("TestClass")
+			require.Nil(t, err)
+			require.Len(t, statuses, 1)
+			require.NotEqual(t, TimestampIndexingFailed, statuses[0].Status, statuses[0].Error)
+			return statuses[0].Status == TimestampIndexingCompleted
+		}, 30*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("existing objects have been indexed", func(t *testing.T) {
+		expected := []strfmt.UUID{newID}
+		for i := 0; i < 1000; i++ {
+			if i < 100 || i >= 150 {
+				expected = append(expected, oldID(i))
+			}
+		}
+		assert.ElementsMatch(t, expected, changedSince(then))
+	})
+
+	t.Run("range filter", func(t *testing.T) {
+		expected := []strfmt.UUID{newID}
+		for i := 0; i < 100; i++ {
+			expected = append(expected, oldID(i))
+		}
+		assert.ElementsMatch(t, expected, changedSince(now))
+	})
+}
+
 // Cannot filter for property length without enabling in the InvertedIndexConfig
 func TestFilterPropertyLengthError(t *testing.T) {
 	class := createClassWithEverything(false, false)
@@ -279,7 +279,14 @@ func (r *ShardInvertedReindexer) handleProperty(ctx context.Context, checker *re
 
 		if property.HasFrequency {
 			propLen := float32(len(property.Items))
+			pruner, err := r.shard.impactPruner(property)
+			if err != nil {
+				return err
+			}
 			for _, item := range property.Items {
+				if !pruner.Keep(item.TermFrequency, propLen) {
+					continue
+				}
 				key := item.Data
 				if reindexableHashPropValue {
 					if err := r.shard.addToPropertyHashBucket(hashBucketValue, key); err != nil {
@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"container/list"
+	"sync"
+)
+
+// DefaultBlockSize is the size of the blocks in which remote segments are
+// read and cached
+const DefaultBlockSize = 256 * 1024
+
+// BlockCache caches blocks of remote segments in memory. It is shared by all
+// buckets with remote segments and evicts the least recently used blocks
+// once it exceeds its capacity.
+type BlockCache struct {
+	blockSize int64
+	capacity  int64
+
+	sync.Mutex
+	size   int64
+	blocks map[blockKey]*list.Element
+	lru    *list.List
+}
+
+type blockKey struct {
+	object string
+	index  int64
+}
+
+type cachedBlock struct {
+	key  blockKey
+	data []byte
+}
+
+// NewBlockCache creates a cache with a capacity in bytes
+func NewBlockCache(blockSize, capacity int64) *BlockCache {
+	return &BlockCache{
+		blockSize: blockSize,
+		capacity:  capacity,
+		blocks:    map[blockKey]*list.Element{},
+		lru:       list.New(),
+	}
+}
+
+// fetchFn reads the bytes of an object starting at offset into p
+type fetchFn func(p []byte, offset int64) error
+
+// read copies the bytes of the object at offset into p. Missing blocks are
+// read with fetch, objectSize bounds the last block.
+func (c *BlockCache) read(object string, objectSize int64, p []byte,
+	offset int64, fetch fetchFn,
+) error {
+	for len(p) > 0 {
+		index := offset / c.blockSize
+		block, err := c.block(blockKey{object, index}, objectSize, fetch)
+		if err != nil {
+			return err
+		}
+
+		n := copy(p, block[offset-index*c.blockSize:])
+		p = p[n:]
+		offset += int64(n)
+	}
+	return nil
+}
+
+func (c *BlockCache) block(key blockKey, objectSize int64, fetch fetchFn) ([]byte, error) {
+	c.Lock()
+	if elem, ok := c.blocks[key]; ok {
+		c.lru.MoveToFront(elem)
+		c.Unlock()
+		return elem.Value.(*cachedBlock).data, nil
+	}
+	c.Unlock()
+
+	// the block is fetched without holding the lock, concurrent reads of the
+	// same block may fetch it twice, which is cheaper than blocking all reads
+	start := key.index * c.blockSize
+	size := c.blockSize
+	if start+size > objectSize {
+		size = objectSize - start
+	}
+	data := make([]byte, size)
+	if err := fetch(data, start); err != nil {
+		return nil, err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	if elem, ok := c.blocks[key]; ok {
+		c.lru.MoveToFront(elem)
+		return elem.Value.(*cachedBlock).data, nil
+	}
+	c.blocks[key] = c.lru.PushFront(&cachedBlock{key: key, data: data})
+	c.size += size
+	for c.size > c.capacity && c.lru.Len() > 1 {
+		oldest := c.lru.Back()
+		evicted := c.lru.Remove(oldest).(*cachedBlock)
+		delete(c.blocks, evicted.key)
+		c.size -= int64(len(evicted.data))
+	}
+	return data, nil
+}
+
+// drop removes all blocks of an object, e.g. once it has been deleted
+func (c *BlockCache) drop(object string, objectSize int64) {
+	c.Lock()
+	defer c.Unlock()
+
+	for index := int64(0); index*c.blockSize < objectSize; index++ {
+		key := blockKey{object, index}
+		if elem, ok := c.blocks[key]; ok {
+			c.lru.Remove(elem)
+			delete(c.blocks, key)
+			c.size -= int64(len(elem.Value.(*cachedBlock).data))
+		}
+	}
+}
@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockCache(t *testing.T) {
+	object := []byte("0123456789abcdefghij")
+	size := int64(len(object))
+
+	var fetched []int64
+	fetch := func(p []byte, offset int64) error {
+		fetched = append(fetched, offset)
+		copy(p, object[offset:])
+		return nil
+	}
+
+	cache := NewBlockCache(8, 16)
+
+	t.Run("read across blocks", func(t *testing.T) {
+		p := make([]byte, 10)
+		require.Nil(t, cache.read("a", size, p, 5, fetch))
+		assert.Equal(t, "56789abcde", string(p))
+		assert.Equal(t, []int64{0, 8}, fetched)
+	})
+
+	t.Run("read cached blocks", func(t *testing.T) {
+		fetched = nil
+		p := make([]byte, 3)
+		require.Nil(t, cache.read("a", size, p, 9, fetch))
+		assert.Equal(t, "9ab", string(p))
+		assert.Empty(t, fetched)
+	})
+
+	t.Run("read the last, shorter block", func(t *testing.T) {
+		fetched = nil
+		p := make([]byte, 4)
+		require.Nil(t, cache.read("a", size, p, 16, fetch))
+		assert.Equal(t, "ghij", string(p))
+		assert.Equal(t, []int64{16}, fetched)
+	})
+
+	t.Run("least recently used block was evicted", func(t *testing.T) {
+		// block 0 was used least recently, the cache holds at most 16 bytes
+		assert.Equal(t, int64(12), cache.size)
+		fetched = nil
+		p := make([]byte, 1)
+		require.Nil(t, cache.read("a", size, p, 0, fetch))
+		assert.Equal(t, []int64{0}, fetched)
+	})
+
+	t.Run("drop object", func(t *testing.T) {
+		cache.drop("a", size)
+		assert.Equal(t, int64(0), cache.size)
+		assert.Equal(t, 0, cache.lru.Len())
+	})
+
+	t.Run("fetch error", func(t *testing.T) {
+		p := make([]byte, 1)
+		err := cache.read("b", size, p, 0, func([]byte, int64) error {
+			return errors.New("unavailable")
+		})
+		assert.EqualError(t, err, "unavailable")
+		assert.Equal(t, 0, cache.lru.Len())
+	})
+}
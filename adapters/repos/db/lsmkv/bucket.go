@@ -25,6 +25,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/adapters/repos/db/walcompression"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 	"github.com/weaviate/weaviate/entities/storagestate"
@@ -72,7 +73,24 @@ type Bucket struct {
 	// is that of the bucket that holds objects
 	monitorCount bool
 
+	// remoteSegments is set if older segments are offloaded to remote storage
+	remoteSegments *RemoteSegments
+
+	// compactionScheduler limits the compactions of the node, it is nil if
+	// they are not limited
+	compactionScheduler *CompactionScheduler
+
 	pauseTimer *prometheus.Timer // Times the pause
+
+	// walRetention keeps the write-ahead logs of flushed memtables in the WAL
+	// archive for this long, so that recent writes can be replayed. Guarded
+	// by flushLock
+	walRetention   time.Duration
+	lastWALCleanup time.Time
+
+	// walCompression is the codec the write-ahead logs of new memtables are
+	// compressed with
+	walCompression walcompression.Codec
 }
 
 // NewBucket initializes a new bucket. It either loads the state from disk if
@@ -116,7 +134,7 @@ func NewBucket(ctx context.Context, dir, rootDir string, logger logrus.FieldLogg
 	}
 
 	sg, err := newSegmentGroup(dir, logger, b.legacyMapSortingBeforeCompaction,
-		metrics, b.strategy, b.monitorCount)
+		metrics, b.strategy, b.monitorCount, b.remoteSegments, b.compactionScheduler)
 	if err != nil {
 		return nil, errors.Wrap(err, "init disk segments")
 	}
@@ -159,6 +177,10 @@ func (b *Bucket) IterateObjects(ctx context.Context, f func(object *storobj.Obje
 	defer cursor.Close()
 
 	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
 		obj, err := storobj.FromBinary(v)
 		if err != nil {
 			return fmt.Errorf("cannot unmarshal object %d, %v", i, err)
@@ -226,6 +248,34 @@ func (b *Bucket) Get(key []byte) ([]byte, error) {
 	return b.disk.get(key)
 }
 
+// Exists checks if the key is present like [Bucket.Get], but doesn't read
+// the value from disk. Only the bloom filters, the indexes and the tombstone
+// bytes of the segments are read.
+//
+// Exists is specific to ReplaceStrategy.
+func (b *Bucket) Exists(key []byte) (bool, error) {
+	b.flushLock.RLock()
+	defer b.flushLock.RUnlock()
+
+	for _, memtable := range []*Memtable{b.active, b.flushing} {
+		if memtable == nil {
+			continue
+		}
+		_, err := memtable.get(key)
+		switch err {
+		case nil:
+			return true, nil
+		case lsmkv.Deleted:
+			return false, nil
+		case lsmkv.NotFound:
+		default:
+			return false, err
+		}
+	}
+
+	return b.disk.exists(key)
+}
+
 // GetBySecondary retrieves an object using one of its secondary keys. A bucket
 // can have an infinite number of secondary keys. Specify the secondary key
 // position as the first argument.
@@ -622,6 +672,12 @@ func (b *Bucket) setNewActiveMemtable() error {
 	if err != nil {
 		return err
 	}
+	if b.walRetention > 0 {
+		mt.walArchiveDir = b.walArchiveDir()
+	}
+	if b.walCompression != walcompression.CodecNone {
+		mt.commitlog.compress(b.walCompression)
+	}
 
 	b.active = mt
 	return nil
@@ -733,6 +789,7 @@ func (b *Bucket) flushAndSwitchIfThresholdsMet(shouldBreak cyclemanager.ShouldBr
 	}
 
 	b.flushLock.RUnlock()
+	b.cleanupWALArchiveIfDue()
 	if shouldSwitch {
 		cycleLength := b.active.ActiveDuration()
 		if err := b.FlushAndSwitch(); err != nil {
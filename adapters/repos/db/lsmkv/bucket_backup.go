@@ -97,13 +97,18 @@ func (b *Bucket) FlushMemtable(ctx context.Context) error {
 
 // ListFiles lists all files that currently exist in the Bucket. The files are only
 // in a stable state if the memtable is empty, and if compactions are paused. If one
-// of those conditions is not given, it errors
+// of those conditions is not given, it errors. Remote segments are downloaded
+// first, so the files are complete without the remote storage.
 func (b *Bucket) ListFiles(ctx context.Context) ([]string, error) {
 	var (
 		bucketRoot = b.disk.dir
 		files      []string
 	)
 
+	if err := b.disk.hydrate(ctx); err != nil {
+		return nil, errors.Wrap(err, "download remote segments")
+	}
+
 	err := filepath.WalkDir(bucketRoot, func(path string, d fs.DirEntry, err error) error {
 		if d.IsDir() {
 			return nil
@@ -0,0 +1,146 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/lsmkv"
+)
+
+// TombstoneStats describes how many of the values in the disk segments of a
+// collection bucket are deleted. In the searchable buckets of the inverted
+// index every deleted document leaves a tombstone for each of its terms.
+type TombstoneStats struct {
+	// Values is the number of values including the tombstones
+	Values int
+	// Tombstones is the number of deleted values
+	Tombstones int
+	// Bytes is the size of the disk segments
+	Bytes int64
+}
+
+// DeletedRatio is the share of values which are tombstones, 0 if there are
+// no values
+func (s TombstoneStats) DeletedRatio() float64 {
+	if s.Values == 0 {
+		return 0
+	}
+	return float64(s.Tombstones) / float64(s.Values)
+}
+
+func (s *TombstoneStats) add(other TombstoneStats) {
+	s.Values += other.Values
+	s.Tombstones += other.Tombstones
+	s.Bytes += other.Bytes
+}
+
+// TombstoneStats counts the values and tombstones in the disk segments of a
+// set or map bucket. The memtable is not included. The counts are computed
+// once per segment, which requires a full scan of it.
+func (b *Bucket) TombstoneStats() (TombstoneStats, error) {
+	if b.strategy != StrategyMapCollection && b.strategy != StrategySetCollection {
+		return TombstoneStats{}, errors.Errorf("tombstone stats only possible for "+
+			"strategies %q, %q", StrategySetCollection, StrategyMapCollection)
+	}
+
+	return b.disk.tombstoneStats()
+}
+
+// CleanupTombstones flushes the memtable and compacts the disk segments of a
+// map bucket into a single one, dropping all deleted pairs. A single segment
+// which still contains tombstones is rewritten. The compaction cycle is
+// paused while it runs. It returns the number of compactions which were
+// performed.
+func (b *Bucket) CleanupTombstones(ctx context.Context) (int, error) {
+	if b.strategy != StrategyMapCollection {
+		return 0, errors.Errorf("tombstone cleanup only possible for strategy %q",
+			StrategyMapCollection)
+	}
+
+	if err := b.FlushMemtable(ctx); err != nil {
+		return 0, errors.Wrap(err, "flush memtable")
+	}
+
+	if err := b.disk.compactionCycle.StopAndWait(ctx); err != nil {
+		return 0, errors.Wrap(err, "long-running compaction in progress")
+	}
+	defer b.disk.compactionCycle.Start()
+
+	return b.disk.cleanupTombstones(ctx)
+}
+
+func (sg *SegmentGroup) tombstoneStats() (TombstoneStats, error) {
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	var stats TombstoneStats
+	for _, seg := range sg.segments {
+		segStats, err := seg.getTombstoneStats()
+		if err != nil {
+			return TombstoneStats{}, errors.Wrapf(err, "segment %q", seg.path)
+		}
+		stats.add(segStats)
+	}
+	return stats, nil
+}
+
+func (sg *SegmentGroup) cleanupTombstones(ctx context.Context) (int, error) {
+	compactions, err := sg.compactAll(ctx)
+	if err != nil {
+		return compactions, err
+	}
+	if sg.Len() != 1 {
+		return compactions, nil
+	}
+
+	stats, err := sg.tombstoneStats()
+	if err != nil {
+		return compactions, err
+	}
+	if stats.Tombstones == 0 {
+		return compactions, nil
+	}
+
+	if err := sg.compactPair([]int{0, 0}, true); err != nil {
+		return compactions, errors.Wrap(err, "rewrite single segment")
+	}
+	return compactions + 1, nil
+}
+
+func (s *segment) getTombstoneStats() (TombstoneStats, error) {
+	if s.strategy != segmentindex.StrategyMapCollection &&
+		s.strategy != segmentindex.StrategySetCollection {
+		return TombstoneStats{}, errors.Errorf("unsupported strategy %v", s.strategy)
+	}
+
+	s.tombstoneStatsOnce.Do(func() {
+		stats := TombstoneStats{Bytes: int64(s.segmentEndPos)}
+		c := s.newCollectionCursorReusable()
+		for k, values, err := c.first(); k != nil; k, values, err = c.next() {
+			if err != nil && err != lsmkv.Deleted {
+				break
+			}
+			stats.Values += len(values)
+			for _, v := range values {
+				if v.tombstone {
+					stats.Tombstones++
+				}
+			}
+		}
+		s.tombstoneStats = stats
+	})
+
+	return s.tombstoneStats, nil
+}
@@ -0,0 +1,133 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBucketCleanupTombstones(t *testing.T) {
+	ctx := context.Background()
+
+	newMapBucket := func(t *testing.T) *Bucket {
+		b, err := NewBucket(ctx, t.TempDir(), "", logrus.New(), nil,
+			WithStrategy(StrategyMapCollection))
+		require.Nil(t, err)
+		t.Cleanup(func() { b.Shutdown(ctx) })
+		return b
+	}
+	pair := func(i int) MapPair {
+		return MapPair{Key: []byte(fmt.Sprintf("doc-%02d", i)), Value: []byte("tf")}
+	}
+
+	t.Run("deleted pairs are dropped", func(t *testing.T) {
+		b := newMapBucket(t)
+
+		for i := 0; i < 10; i++ {
+			require.Nil(t, b.MapSet([]byte("term"), pair(i)))
+		}
+		require.Nil(t, b.MapSet([]byte("gone"), pair(0)))
+		require.Nil(t, b.FlushAndSwitch())
+		for i := 0; i < 4; i++ {
+			require.Nil(t, b.MapDeleteKey([]byte("term"), pair(i).Key))
+		}
+		require.Nil(t, b.MapDeleteKey([]byte("gone"), pair(0).Key))
+		require.Nil(t, b.FlushAndSwitch())
+
+		before, err := b.TombstoneStats()
+		require.Nil(t, err)
+		assert.Equal(t, 16, before.Values)
+		assert.Equal(t, 5, before.Tombstones)
+		assert.InDelta(t, 5.0/16.0, before.DeletedRatio(), 0.0001)
+
+		compactions, err := b.CleanupTombstones(ctx)
+		require.Nil(t, err)
+		assert.Equal(t, 1, compactions)
+		require.Equal(t, 1, b.disk.Len())
+		assert.True(t, b.disk.compactionCycle.Running())
+
+		after, err := b.TombstoneStats()
+		require.Nil(t, err)
+		assert.Equal(t, 6, after.Values)
+		assert.Equal(t, 0, after.Tombstones)
+		assert.Less(t, after.Bytes, before.Bytes)
+
+		pairs, err := b.MapList([]byte("term"))
+		require.Nil(t, err)
+		assert.Len(t, pairs, 6)
+		pairs, err = b.MapList([]byte("gone"))
+		require.Nil(t, err)
+		assert.Empty(t, pairs)
+	})
+
+	t.Run("a single segment with tombstones is rewritten", func(t *testing.T) {
+		b := newMapBucket(t)
+
+		for i := 0; i < 3; i++ {
+			require.Nil(t, b.MapSet([]byte("term"), pair(i)))
+		}
+		require.Nil(t, b.MapDeleteKey([]byte("term"), pair(5).Key))
+		require.Nil(t, b.FlushAndSwitch())
+
+		compactions, err := b.CleanupTombstones(ctx)
+		require.Nil(t, err)
+		assert.Equal(t, 1, compactions)
+		require.Equal(t, 1, b.disk.Len())
+
+		stats, err := b.TombstoneStats()
+		require.Nil(t, err)
+		assert.Equal(t, TombstoneStats{Values: 3, Bytes: stats.Bytes}, stats)
+
+		pairs, err := b.MapList([]byte("term"))
+		require.Nil(t, err)
+		assert.Len(t, pairs, 3)
+	})
+
+	t.Run("an empty row is kept if all pairs are deleted", func(t *testing.T) {
+		b := newMapBucket(t)
+
+		require.Nil(t, b.MapSet([]byte("term"), pair(0)))
+		require.Nil(t, b.FlushAndSwitch())
+		require.Nil(t, b.MapDeleteKey([]byte("term"), pair(0).Key))
+		require.Nil(t, b.FlushAndSwitch())
+
+		_, err := b.CleanupTombstones(ctx)
+		require.Nil(t, err)
+		require.Equal(t, 1, b.disk.Len())
+
+		stats, err := b.TombstoneStats()
+		require.Nil(t, err)
+		assert.Equal(t, 0, stats.Values)
+
+		pairs, err := b.MapList([]byte("term"))
+		require.Nil(t, err)
+		assert.Empty(t, pairs)
+	})
+
+	t.Run("other strategies are rejected", func(t *testing.T) {
+		b, err := NewBucket(ctx, t.TempDir(), "", logrus.New(), nil,
+			WithStrategy(StrategyReplace))
+		require.Nil(t, err)
+		defer b.Shutdown(ctx)
+
+		_, err = b.CleanupTombstones(ctx)
+		assert.NotNil(t, err)
+		_, err = b.TombstoneStats()
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,35 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// CompactAll flushes the memtable and compacts all disk segments of the bucket
+// into a single one, dropping tombstones which no longer shadow anything. The
+// compaction cycle is paused while it runs. It returns the number of
+// compactions which were performed.
+func (b *Bucket) CompactAll(ctx context.Context) (int, error) {
+	if err := b.FlushMemtable(ctx); err != nil {
+		return 0, errors.Wrap(err, "flush memtable")
+	}
+
+	if err := b.disk.compactionCycle.StopAndWait(ctx); err != nil {
+		return 0, errors.Wrap(err, "long-running compaction in progress")
+	}
+	defer b.disk.compactionCycle.Start()
+
+	return b.disk.compactAll(ctx)
+}
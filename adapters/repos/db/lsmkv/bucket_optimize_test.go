@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/lsmkv"
+)
+
+func TestBucketCompactAll(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("segments are compacted into one without tombstones", func(t *testing.T) {
+		b, err := NewBucket(ctx, t.TempDir(), "", logrus.New(), nil,
+			WithStrategy(StrategyReplace))
+		require.Nil(t, err)
+		defer b.Shutdown(ctx)
+
+		for i := 0; i < 10; i++ {
+			require.Nil(t, b.Put([]byte(fmt.Sprint(i)), []byte(fmt.Sprint(i))))
+		}
+		require.Nil(t, b.FlushAndSwitch())
+		for i := 0; i < 5; i++ {
+			require.Nil(t, b.Delete([]byte(fmt.Sprint(i))))
+		}
+		require.Nil(t, b.FlushAndSwitch())
+		for i := 10; i < 15; i++ {
+			require.Nil(t, b.Put([]byte(fmt.Sprint(i)), []byte(fmt.Sprint(i))))
+		}
+		require.Nil(t, b.FlushAndSwitch())
+		// this one is still in the memtable
+		require.Nil(t, b.Put([]byte("15"), []byte("15")))
+
+		compactions, err := b.CompactAll(ctx)
+		require.Nil(t, err)
+		assert.Positive(t, compactions)
+		require.Equal(t, 1, b.disk.Len())
+		assert.True(t, b.disk.compactionCycle.Running())
+
+		for i := 0; i < 16; i++ {
+			val, err := b.Get([]byte(fmt.Sprint(i)))
+			require.Nil(t, err)
+			if i < 5 {
+				assert.Nil(t, val)
+			} else {
+				assert.Equal(t, []byte(fmt.Sprint(i)), val)
+			}
+		}
+
+		c := b.disk.segmentAtPos(0).newCursor()
+		keys := 0
+		for k, _, err := c.first(); k != nil; k, _, err = c.next() {
+			assert.NotEqual(t, lsmkv.Deleted, err)
+			keys++
+		}
+		assert.Equal(t, 11, keys)
+	})
+
+	t.Run("a single tombstone is kept if all keys are deleted", func(t *testing.T) {
+		b, err := NewBucket(ctx, t.TempDir(), "", logrus.New(), nil,
+			WithStrategy(StrategyReplace))
+		require.Nil(t, err)
+		defer b.Shutdown(ctx)
+
+		require.Nil(t, b.Put([]byte("a"), []byte("a")))
+		require.Nil(t, b.FlushAndSwitch())
+		require.Nil(t, b.Delete([]byte("a")))
+		require.Nil(t, b.FlushAndSwitch())
+
+		_, err = b.CompactAll(ctx)
+		require.Nil(t, err)
+		require.Equal(t, 1, b.disk.Len())
+
+		val, err := b.Get([]byte("a"))
+		require.Nil(t, err)
+		assert.Nil(t, val)
+	})
+
+	t.Run("other strategies are compacted", func(t *testing.T) {
+		b, err := NewBucket(ctx, t.TempDir(), "", logrus.New(), nil,
+			WithStrategy(StrategySetCollection))
+		require.Nil(t, err)
+		defer b.Shutdown(ctx)
+
+		for i := 0; i < 3; i++ {
+			require.Nil(t, b.SetAdd([]byte("key"), [][]byte{[]byte(fmt.Sprint(i))}))
+			require.Nil(t, b.FlushAndSwitch())
+		}
+
+		compactions, err := b.CompactAll(ctx)
+		require.Nil(t, err)
+		assert.Positive(t, compactions)
+
+		vals, err := b.SetList([]byte("key"))
+		require.Nil(t, err)
+		assert.Len(t, vals, 3)
+	})
+}
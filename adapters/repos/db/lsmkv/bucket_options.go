@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/walcompression"
 )
 
 type BucketOption func(b *Bucket) error
@@ -54,6 +55,15 @@ func WithIdleThreshold(threshold time.Duration) BucketOption {
 	}
 }
 
+// WithWALRetention keeps the write-ahead logs of flushed memtables for the
+// given duration, see [Bucket.ReplayWAL]
+func WithWALRetention(retention time.Duration) BucketOption {
+	return func(b *Bucket) error {
+		b.walRetention = retention
+		return nil
+	}
+}
+
 func WithSecondaryIndices(count uint16) BucketOption {
 	return func(b *Bucket) error {
 		b.secondaryIndices = count
@@ -111,3 +121,33 @@ func WithMonitorCount() BucketOption {
 		return nil
 	}
 }
+
+// WithRemoteSegments offloads older segments to remote storage, see
+// RemoteSegments. Only "replace" buckets support remote segments.
+func WithRemoteSegments(cfg RemoteSegments) BucketOption {
+	return func(b *Bucket) error {
+		if b.strategy != StrategyReplace {
+			return errors.Errorf("remote segments only supported on 'replace' buckets")
+		}
+		b.remoteSegments = &cfg
+		return nil
+	}
+}
+
+// WithCompactionScheduler limits the compactions of the bucket together with
+// the other buckets of the node, see CompactionScheduler
+func WithCompactionScheduler(scheduler *CompactionScheduler) BucketOption {
+	return func(b *Bucket) error {
+		b.compactionScheduler = scheduler
+		return nil
+	}
+}
+
+// WithWALCompression compresses the write-ahead logs written by the bucket
+// with the codec. Logs are replayed regardless of whether they are compressed.
+func WithWALCompression(codec walcompression.Codec) BucketOption {
+	return func(b *Bucket) error {
+		b.walCompression = codec
+		return nil
+	}
+}
@@ -82,8 +82,18 @@ func (b *Bucket) recoverFromCommitLogs(ctx context.Context) error {
 	}
 
 	// delete the commit logs as we can now be sure that they are part of a disk
-	// segment
+	// segment, retained logs are archived instead
 	for _, fname := range walFileNames {
+		if b.walRetention > 0 {
+			if err := os.MkdirAll(b.walArchiveDir(), 0o700); err != nil {
+				return errors.Wrap(err, "archive commit log")
+			}
+			if err := os.Rename(filepath.Join(b.dir, fname),
+				filepath.Join(b.walArchiveDir(), fname)); err != nil {
+				return errors.Wrap(err, "archive commit log")
+			}
+			continue
+		}
 		if err := os.RemoveAll(filepath.Join(b.dir, fname)); err != nil {
 			return errors.Wrap(err, "clean up commit log")
 		}
@@ -59,3 +59,51 @@ func TestBucket_WasDeleted(t *testing.T) {
 		assert.False(t, deleted)
 	})
 }
+
+func TestBucket_Exists(t *testing.T) {
+	tmpDir := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	b, err := NewBucket(context.Background(), tmpDir, "", logger, nil)
+	require.Nil(t, err)
+	defer b.Shutdown(context.Background())
+
+	assertExists := func(t *testing.T, expected map[string]bool) {
+		for key, exists := range expected {
+			ok, err := b.Exists([]byte(key))
+			require.Nil(t, err)
+			assert.Equal(t, exists, ok, key)
+
+			v, err := b.Get([]byte(key))
+			require.Nil(t, err)
+			assert.Equal(t, exists, v != nil, key)
+		}
+	}
+
+	require.Nil(t, b.Put([]byte("on-disk"), []byte("value")))
+	require.Nil(t, b.Put([]byte("deleted-on-disk"), []byte("value")))
+	require.Nil(t, b.Put([]byte("deleted-in-memtable"), []byte("value")))
+	require.Nil(t, b.Delete([]byte("deleted-on-disk")))
+	require.Nil(t, b.FlushAndSwitch())
+
+	require.Nil(t, b.Put([]byte("in-memtable"), []byte("value")))
+	require.Nil(t, b.Delete([]byte("deleted-in-memtable")))
+
+	assertExists(t, map[string]bool{
+		"on-disk":             true,
+		"in-memtable":         true,
+		"deleted-on-disk":     false,
+		"deleted-in-memtable": false,
+		"never-written":       false,
+	})
+
+	t.Run("after flush", func(t *testing.T) {
+		require.Nil(t, b.FlushAndSwitch())
+		assertExists(t, map[string]bool{
+			"on-disk":             true,
+			"in-memtable":         true,
+			"deleted-on-disk":     false,
+			"deleted-in-memtable": false,
+			"never-written":       false,
+		})
+	})
+}
@@ -0,0 +1,233 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/walcompression"
+)
+
+// walArchiveCleanupInterval is how often expired logs are removed from the
+// WAL archive of a bucket which is not flushed
+const walArchiveCleanupInterval = time.Minute
+
+// WALEntry is a write to a replace bucket as recorded in its write-ahead log
+type WALEntry struct {
+	Key       []byte
+	Value     []byte
+	Tombstone bool
+}
+
+func (b *Bucket) walArchiveDir() string {
+	return filepath.Join(b.dir, "wal_archive")
+}
+
+// SetWALRetention changes how long the write-ahead logs of flushed memtables
+// are retained. A retention of 0 removes all retained logs.
+func (b *Bucket) SetWALRetention(retention time.Duration) error {
+	b.flushLock.Lock()
+	b.walRetention = retention
+	if retention > 0 {
+		b.active.walArchiveDir = b.walArchiveDir()
+	} else {
+		b.active.walArchiveDir = ""
+	}
+	b.flushLock.Unlock()
+
+	return b.cleanupWALArchive()
+}
+
+func (b *Bucket) cleanupWALArchiveIfDue() {
+	b.flushLock.RLock()
+	due := b.walRetention > 0 &&
+		time.Since(b.lastWALCleanup) >= walArchiveCleanupInterval
+	b.flushLock.RUnlock()
+	if !due {
+		return
+	}
+
+	if err := b.cleanupWALArchive(); err != nil {
+		b.logger.WithField("action", "lsm_wal_archive_cleanup").
+			WithField("path", b.dir).
+			WithError(err).
+			Error("failed to remove expired write-ahead logs")
+	}
+}
+
+// cleanupWALArchive removes the retained logs which expired
+func (b *Bucket) cleanupWALArchive() error {
+	b.flushLock.Lock()
+	retention := b.walRetention
+	b.lastWALCleanup = time.Now()
+	b.flushLock.Unlock()
+
+	if retention <= 0 {
+		return os.RemoveAll(b.walArchiveDir())
+	}
+
+	list, err := os.ReadDir(b.walArchiveDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for _, entry := range list {
+		info, err := entry.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+		if time.Since(info.ModTime()) <= retention {
+			continue
+		}
+		if err := os.Remove(filepath.Join(b.walArchiveDir(), entry.Name())); err != nil &&
+			!os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReplayWAL passes the writes recorded in the retained write-ahead logs of a
+// replace bucket to fn, oldest first. The logs of memtables which are yet to
+// be flushed are included, so the most recent writes are replayed as well.
+func (b *Bucket) ReplayWAL(ctx context.Context, fn func(WALEntry) error) error {
+	if b.strategy != StrategyReplace {
+		return errors.Errorf("replay is only supported on %q buckets, got %q",
+			StrategyReplace, b.strategy)
+	}
+
+	logs, err := b.openWALs()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, log := range logs {
+			log.file.Close()
+		}
+	}()
+
+	for _, log := range logs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := b.replayWAL(log, fn); err != nil {
+			return errors.Wrapf(err, "replay %q", log.file.Name())
+		}
+	}
+	return nil
+}
+
+type openWAL struct {
+	file *os.File
+	size int64
+}
+
+// openWALs opens the archived logs and those of the memtables at once, so
+// that a flush happening during the replay neither skips nor repeats a log
+func (b *Bucket) openWALs() ([]openWAL, error) {
+	b.flushLock.Lock()
+	defer b.flushLock.Unlock()
+
+	var paths []string
+	list, err := os.ReadDir(b.walArchiveDir())
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	for _, entry := range list {
+		if filepath.Ext(entry.Name()) == ".wal" {
+			paths = append(paths, filepath.Join(b.walArchiveDir(), entry.Name()))
+		}
+	}
+	// the names contain the creation time of the memtables
+	sort.Strings(paths)
+
+	if b.flushing != nil {
+		paths = append(paths, b.flushing.commitlog.path)
+	}
+	// make the most recent writes visible to the replay
+	if err := b.active.commitlog.flushBuffers(); err != nil {
+		return nil, errors.Wrap(err, "flush commit log")
+	}
+	paths = append(paths, b.active.commitlog.path)
+
+	logs := make([]openWAL, 0, len(paths))
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				// expired and removed by a concurrent cleanup
+				continue
+			}
+			for _, log := range logs {
+				log.file.Close()
+			}
+			return nil, err
+		}
+		info, err := f.Stat()
+		if err != nil {
+			f.Close()
+			for _, log := range logs {
+				log.file.Close()
+			}
+			return nil, err
+		}
+		// the active log grows while it is replayed, only what was written
+		// so far is read
+		logs = append(logs, openWAL{file: f, size: info.Size()})
+	}
+	return logs, nil
+}
+
+func (b *Bucket) replayWAL(log openWAL, fn func(WALEntry) error) error {
+	r := walcompression.NewReader(bufio.NewReader(io.LimitReader(log.file, log.size)))
+
+	for {
+		var commitType CommitType
+		err := binary.Read(r, binary.LittleEndian, &commitType)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			// a log which ended abruptly is skipped from here, just like it is
+			// when recovering from it
+			return nil
+		}
+		if !CommitTypeReplace.Is(commitType) {
+			return errors.Errorf("found a %s commit on a replace bucket", commitType.String())
+		}
+
+		node, err := ParseReplaceNode(r, b.secondaryIndices)
+		if err != nil {
+			return nil
+		}
+		if err := fn(WALEntry{
+			Key:       node.primaryKey,
+			Value:     node.value,
+			Tombstone: node.tombstone,
+		}); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBucketWALRetention(t *testing.T) {
+	ctx := context.Background()
+
+	replay := func(t *testing.T, b *Bucket) []WALEntry {
+		var entries []WALEntry
+		require.Nil(t, b.ReplayWAL(ctx, func(e WALEntry) error {
+			entries = append(entries, e)
+			return nil
+		}))
+		return entries
+	}
+
+	t.Run("logs are deleted after the flush without retention", func(t *testing.T) {
+		b, err := NewBucket(ctx, t.TempDir(), "", logrus.New(), nil,
+			WithStrategy(StrategyReplace))
+		require.Nil(t, err)
+		defer b.Shutdown(ctx)
+
+		require.Nil(t, b.Put([]byte("a"), []byte("1")))
+		require.Nil(t, b.FlushAndSwitch())
+		require.Nil(t, b.Put([]byte("b"), []byte("2")))
+
+		_, err = os.Stat(b.walArchiveDir())
+		assert.True(t, os.IsNotExist(err))
+
+		entries := replay(t, b)
+		require.Len(t, entries, 1)
+		assert.Equal(t, []byte("b"), entries[0].Key)
+	})
+
+	t.Run("retained logs are replayed in order", func(t *testing.T) {
+		b, err := NewBucket(ctx, t.TempDir(), "", logrus.New(), nil,
+			WithStrategy(StrategyReplace), WithWALRetention(time.Hour))
+		require.Nil(t, err)
+		defer b.Shutdown(ctx)
+
+		require.Nil(t, b.Put([]byte("a"), []byte("1")))
+		require.Nil(t, b.FlushAndSwitch())
+		require.Nil(t, b.Put([]byte("a"), []byte("2")))
+		require.Nil(t, b.Delete([]byte("a")))
+		require.Nil(t, b.FlushAndSwitch())
+		// this one is still in the memtable
+		require.Nil(t, b.Put([]byte("b"), []byte("3")))
+
+		entries := replay(t, b)
+		require.Len(t, entries, 4)
+		assert.Equal(t, WALEntry{Key: []byte("a"), Value: []byte("1")}, entries[0])
+		assert.Equal(t, WALEntry{Key: []byte("a"), Value: []byte("2")}, entries[1])
+		assert.Equal(t, []byte("a"), entries[2].Key)
+		assert.True(t, entries[2].Tombstone)
+		assert.Equal(t, WALEntry{Key: []byte("b"), Value: []byte("3")}, entries[3])
+
+		// the archive doesn't interfere with the segments of the bucket
+		val, err := b.Get([]byte("b"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("3"), val)
+	})
+
+	t.Run("expired logs are removed", func(t *testing.T) {
+		b, err := NewBucket(ctx, t.TempDir(), "", logrus.New(), nil,
+			WithStrategy(StrategyReplace), WithWALRetention(time.Hour))
+		require.Nil(t, err)
+		defer b.Shutdown(ctx)
+
+		require.Nil(t, b.Put([]byte("a"), []byte("1")))
+		require.Nil(t, b.FlushAndSwitch())
+		require.Nil(t, b.Put([]byte("b"), []byte("2")))
+		require.Nil(t, b.FlushAndSwitch())
+
+		list, err := os.ReadDir(b.walArchiveDir())
+		require.Nil(t, err)
+		require.Len(t, list, 2)
+		old := time.Now().Add(-2 * time.Hour)
+		require.Nil(t, os.Chtimes(filepath.Join(b.walArchiveDir(), list[0].Name()), old, old))
+
+		require.Nil(t, b.cleanupWALArchive())
+		entries := replay(t, b)
+		require.Len(t, entries, 1)
+		assert.Equal(t, []byte("b"), entries[0].Key)
+
+		require.Nil(t, b.SetWALRetention(0))
+		_, err = os.Stat(b.walArchiveDir())
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("replay requires a replace bucket", func(t *testing.T) {
+		b, err := NewBucket(ctx, t.TempDir(), "", logrus.New(), nil,
+			WithStrategy(StrategyRoaringSet))
+		require.Nil(t, err)
+		defer b.Shutdown(ctx)
+
+		err = b.ReplayWAL(ctx, func(WALEntry) error { return nil })
+		assert.ErrorContains(t, err, "only supported")
+	})
+}
@@ -0,0 +1,196 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/walcompression"
+)
+
+func TestBucketWALCompression(t *testing.T) {
+	ctx := context.Background()
+
+	// copyWALs simulates a crash, the logs are recovered from in a new bucket
+	copyWALs := func(t *testing.T, src string) string {
+		dst := t.TempDir()
+		list, err := os.ReadDir(src)
+		require.Nil(t, err)
+		for _, entry := range list {
+			if filepath.Ext(entry.Name()) != ".wal" {
+				continue
+			}
+			data, err := os.ReadFile(filepath.Join(src, entry.Name()))
+			require.Nil(t, err)
+			require.Nil(t, os.WriteFile(filepath.Join(dst, entry.Name()), data, 0o666))
+		}
+		return dst
+	}
+
+	for _, codec := range []walcompression.Codec{walcompression.CodecSnappy, walcompression.CodecZstd} {
+		t.Run(codec.String(), func(t *testing.T) {
+			t.Run("replace", func(t *testing.T) {
+				dir := t.TempDir()
+				b, err := NewBucket(ctx, dir, "", logrus.New(), nil,
+					WithStrategy(StrategyReplace), WithWALCompression(codec))
+				require.Nil(t, err)
+				defer b.Shutdown(ctx)
+
+				for i := 0; i < 1000; i++ {
+					require.Nil(t, b.Put([]byte(fmt.Sprintf("key-%d", i)),
+						[]byte(fmt.Sprintf("value-%d", i))))
+				}
+				require.Nil(t, b.Delete([]byte("key-7")))
+				require.Nil(t, b.WriteWAL())
+
+				codecOnDisk, ok, err := walcompression.DetectFile(b.active.commitlog.path)
+				require.Nil(t, err)
+				require.True(t, ok)
+				assert.Equal(t, codec, codecOnDisk)
+
+				// the logs are replayed no matter the codec of the bucket
+				rec, err := NewBucket(ctx, copyWALs(t, dir), "", logrus.New(), nil,
+					WithStrategy(StrategyReplace))
+				require.Nil(t, err)
+				defer rec.Shutdown(ctx)
+
+				val, err := rec.Get([]byte("key-999"))
+				require.Nil(t, err)
+				assert.Equal(t, []byte("value-999"), val)
+				val, err = rec.Get([]byte("key-7"))
+				require.Nil(t, err)
+				assert.Nil(t, val)
+			})
+
+			t.Run("collection", func(t *testing.T) {
+				dir := t.TempDir()
+				b, err := NewBucket(ctx, dir, "", logrus.New(), nil,
+					WithStrategy(StrategySetCollection), WithWALCompression(codec))
+				require.Nil(t, err)
+				defer b.Shutdown(ctx)
+
+				require.Nil(t, b.SetAdd([]byte("set"), [][]byte{[]byte("a"), []byte("b")}))
+				require.Nil(t, b.WriteWAL())
+
+				rec, err := NewBucket(ctx, copyWALs(t, dir), "", logrus.New(), nil,
+					WithStrategy(StrategySetCollection))
+				require.Nil(t, err)
+				defer rec.Shutdown(ctx)
+
+				list, err := rec.SetList([]byte("set"))
+				require.Nil(t, err)
+				assert.ElementsMatch(t, [][]byte{[]byte("a"), []byte("b")}, list)
+			})
+
+			t.Run("roaring set", func(t *testing.T) {
+				dir := t.TempDir()
+				b, err := NewBucket(ctx, dir, "", logrus.New(), nil,
+					WithStrategy(StrategyRoaringSet), WithWALCompression(codec))
+				require.Nil(t, err)
+				defer b.Shutdown(ctx)
+
+				require.Nil(t, b.RoaringSetAddOne([]byte("set"), 7))
+				require.Nil(t, b.WriteWAL())
+
+				rec, err := NewBucket(ctx, copyWALs(t, dir), "", logrus.New(), nil,
+					WithStrategy(StrategyRoaringSet))
+				require.Nil(t, err)
+				defer rec.Shutdown(ctx)
+
+				bm, err := rec.RoaringSetGet([]byte("set"))
+				require.Nil(t, err)
+				assert.Equal(t, []uint64{7}, bm.ToArray())
+			})
+		})
+	}
+
+	t.Run("uncompressed logs are recovered by a compressing bucket", func(t *testing.T) {
+		dir := t.TempDir()
+		b, err := NewBucket(ctx, dir, "", logrus.New(), nil,
+			WithStrategy(StrategyReplace))
+		require.Nil(t, err)
+		defer b.Shutdown(ctx)
+
+		require.Nil(t, b.Put([]byte("a"), []byte("1")))
+		require.Nil(t, b.WriteWAL())
+
+		rec, err := NewBucket(ctx, copyWALs(t, dir), "", logrus.New(), nil,
+			WithStrategy(StrategyReplace), WithWALCompression(walcompression.CodecZstd))
+		require.Nil(t, err)
+		defer rec.Shutdown(ctx)
+
+		val, err := rec.Get([]byte("a"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("1"), val)
+	})
+
+	t.Run("a log which ended abruptly is recovered up to the last block", func(t *testing.T) {
+		dir := t.TempDir()
+		b, err := NewBucket(ctx, dir, "", logrus.New(), nil,
+			WithStrategy(StrategyReplace), WithWALCompression(walcompression.CodecSnappy))
+		require.Nil(t, err)
+		defer b.Shutdown(ctx)
+
+		require.Nil(t, b.Put([]byte("a"), []byte("1")))
+		require.Nil(t, b.WriteWAL())
+		require.Nil(t, b.Put([]byte("b"), []byte("2")))
+		require.Nil(t, b.WriteWAL())
+
+		recDir := copyWALs(t, dir)
+		list, err := os.ReadDir(recDir)
+		require.Nil(t, err)
+		require.Len(t, list, 1)
+		path := filepath.Join(recDir, list[0].Name())
+		info, err := os.Stat(path)
+		require.Nil(t, err)
+		require.Nil(t, os.Truncate(path, info.Size()-1))
+
+		rec, err := NewBucket(ctx, recDir, "", logrus.New(), nil,
+			WithStrategy(StrategyReplace))
+		require.Nil(t, err)
+		defer rec.Shutdown(ctx)
+
+		val, err := rec.Get([]byte("a"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("1"), val)
+		val, err = rec.Get([]byte("b"))
+		require.Nil(t, err)
+		assert.Nil(t, val)
+	})
+
+	t.Run("retained logs are replayed", func(t *testing.T) {
+		b, err := NewBucket(ctx, t.TempDir(), "", logrus.New(), nil,
+			WithStrategy(StrategyReplace), WithWALRetention(time.Hour),
+			WithWALCompression(walcompression.CodecZstd))
+		require.Nil(t, err)
+		defer b.Shutdown(ctx)
+
+		require.Nil(t, b.Put([]byte("a"), []byte("1")))
+		require.Nil(t, b.FlushAndSwitch())
+		require.Nil(t, b.Put([]byte("b"), []byte("2")))
+
+		var keys []string
+		require.Nil(t, b.ReplayWAL(ctx, func(e WALEntry) error {
+			keys = append(keys, string(e.Key))
+			return nil
+		}))
+		assert.Equal(t, []string{"a", "b"}, keys)
+	})
+}
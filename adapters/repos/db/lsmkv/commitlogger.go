@@ -14,22 +14,36 @@ package lsmkv
 import (
 	"bufio"
 	"encoding/binary"
+	"io"
 	"os"
+	"path/filepath"
 	"sync/atomic"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/roaringset"
+	"github.com/weaviate/weaviate/adapters/repos/db/walcompression"
 )
 
 type commitLogger struct {
 	file   *os.File
-	writer *bufio.Writer
+	writer walWriter
 	n      atomic.Int64
 	path   string
 
 	// e.g. when recovering from an existing log, we do not want to write into a
 	// new log again
 	paused bool
+
+	// compressed is set if the records are compressed in blocks, it is the
+	// same as writer then
+	compressed *walcompression.Writer
+}
+
+// walWriter is a *bufio.Writer, or a *walcompression.Writer if the log is
+// compressed
+type walWriter interface {
+	io.Writer
+	Flush() error
 }
 
 type CommitType uint16
@@ -76,6 +90,23 @@ func newCommitLogger(path string) (*commitLogger, error) {
 	return out, nil
 }
 
+// compress makes the log compress its records in blocks using codec. It
+// must be called before anything is written to the log.
+func (cl *commitLogger) compress(codec walcompression.Codec) {
+	cl.compressed = walcompression.NewWriter(cl.file, codec,
+		walcompression.DefaultBlockSize)
+	cl.writer = cl.compressed
+}
+
+// endRecord lets a compressed log write a block once it is full, records
+// are never split across blocks
+func (cl *commitLogger) endRecord() error {
+	if cl.compressed == nil {
+		return nil
+	}
+	return cl.compressed.EndRecord()
+}
+
 func (cl *commitLogger) put(node segmentReplaceNode) error {
 	if cl.paused {
 		return nil
@@ -95,7 +126,7 @@ func (cl *commitLogger) put(node segmentReplaceNode) error {
 
 	cl.n.Add(int64(n))
 
-	return nil
+	return cl.endRecord()
 }
 
 func (cl *commitLogger) append(node segmentCollectionNode) error {
@@ -117,7 +148,7 @@ func (cl *commitLogger) append(node segmentCollectionNode) error {
 
 	cl.n.Add(int64(n))
 
-	return nil
+	return cl.endRecord()
 }
 
 func (cl *commitLogger) add(node *roaringset.SegmentNode) error {
@@ -138,7 +169,7 @@ func (cl *commitLogger) add(node *roaringset.SegmentNode) error {
 
 	cl.n.Add(int64(n))
 
-	return nil
+	return cl.endRecord()
 }
 
 // Size returns the amount of data that has been written since the commit
@@ -172,6 +203,14 @@ func (cl *commitLogger) delete() error {
 	return os.Remove(cl.path)
 }
 
+// archive moves the closed log into dir instead of deleting it
+func (cl *commitLogger) archive(dir string) error {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	return os.Rename(cl.path, filepath.Join(dir, filepath.Base(cl.path)))
+}
+
 func (cl *commitLogger) flushBuffers() error {
 	return cl.writer.Flush()
 }
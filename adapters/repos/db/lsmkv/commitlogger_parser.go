@@ -18,6 +18,7 @@ import (
 	"os"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/walcompression"
 	"github.com/weaviate/weaviate/entities/diskio"
 )
 
@@ -64,7 +65,7 @@ func (p *commitloggerParser) doReplace() error {
 	}
 
 	metered := diskio.NewMeteredReader(f, p.metrics.TrackStartupReadWALDiskIO)
-	p.reader = bufio.NewReaderSize(metered, 1*1024*1024)
+	p.reader = walcompression.NewReader(bufio.NewReaderSize(metered, 1*1024*1024))
 
 	// errUnexpectedLength indicates that we could not read the commit log to the
 	// end, for example because the last element on the log was corrupt.
@@ -18,6 +18,7 @@ import (
 	"os"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/walcompression"
 	"github.com/weaviate/weaviate/entities/diskio"
 )
 
@@ -28,7 +29,7 @@ func (p *commitloggerParser) doCollection() error {
 	}
 
 	metered := diskio.NewMeteredReader(f, p.metrics.TrackStartupReadWALDiskIO)
-	p.reader = bufio.NewReaderSize(metered, 1*1024*1024)
+	p.reader = walcompression.NewReader(bufio.NewReaderSize(metered, 1*1024*1024))
 
 	for {
 		var commitType CommitType
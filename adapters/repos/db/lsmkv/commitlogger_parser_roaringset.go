@@ -19,6 +19,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/roaringset"
+	"github.com/weaviate/weaviate/adapters/repos/db/walcompression"
 	"github.com/weaviate/weaviate/entities/diskio"
 )
 
@@ -29,7 +30,7 @@ func (p *commitloggerParser) doRoaringSet() error {
 	}
 
 	metered := diskio.NewMeteredReader(f, p.metrics.TrackStartupReadWALDiskIO)
-	p.reader = bufio.NewReaderSize(metered, 1*1024*1024)
+	p.reader = walcompression.NewReader(bufio.NewReaderSize(metered, 1*1024*1024))
 
 	for {
 		var commitType CommitType
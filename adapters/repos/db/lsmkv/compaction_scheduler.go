@@ -0,0 +1,258 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CompactionSchedule limits the compactions of a node, so they compete less
+// with queries for IO. Zero values don't limit anything.
+type CompactionSchedule struct {
+	// MaxConcurrent is the number of compactions which run at the same time
+	// on the node
+	MaxConcurrent int `json:"maxConcurrent"`
+	// MaxConcurrentPerShard is the number of compactions which run at the
+	// same time in the buckets of a shard
+	MaxConcurrentPerShard int `json:"maxConcurrentPerShard"`
+	// MaxBytesPerSecond is the rate with which all compactions of the node
+	// write their segments together
+	MaxBytesPerSecond int64 `json:"maxBytesPerSecond"`
+	// QuietHours restricts compactions to a daily window in UTC, such as
+	// "22:00-06:00", e.g. the hours with the least queries
+	QuietHours string `json:"quietHours"`
+}
+
+func (s CompactionSchedule) Validate() error {
+	if s.MaxConcurrent < 0 {
+		return fmt.Errorf("maxConcurrent must not be negative")
+	}
+	if s.MaxConcurrentPerShard < 0 {
+		return fmt.Errorf("maxConcurrentPerShard must not be negative")
+	}
+	if s.MaxBytesPerSecond < 0 {
+		return fmt.Errorf("maxBytesPerSecond must not be negative")
+	}
+	_, err := parseQuietHours(s.QuietHours)
+	return err
+}
+
+// quietHours is a daily window in minutes since midnight UTC, the window
+// wraps around midnight if end is before start
+type quietHours struct {
+	start, end int
+}
+
+func parseQuietHours(in string) (*quietHours, error) {
+	if in == "" {
+		return nil, nil
+	}
+
+	startStr, endStr, ok := strings.Cut(in, "-")
+	if !ok {
+		return nil, fmt.Errorf("quietHours %q: expected format HH:MM-HH:MM", in)
+	}
+	start, err := parseMinuteOfDay(strings.TrimSpace(startStr))
+	if err != nil {
+		return nil, fmt.Errorf("quietHours %q: %w", in, err)
+	}
+	end, err := parseMinuteOfDay(strings.TrimSpace(endStr))
+	if err != nil {
+		return nil, fmt.Errorf("quietHours %q: %w", in, err)
+	}
+	if start == end {
+		return nil, fmt.Errorf("quietHours %q: window is empty", in)
+	}
+
+	return &quietHours{start: start, end: end}, nil
+}
+
+func parseMinuteOfDay(in string) (int, error) {
+	t, err := time.Parse("15:04", in)
+	if err != nil {
+		return 0, fmt.Errorf("expected time of day HH:MM, got %q", in)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func (q *quietHours) contains(t time.Time) bool {
+	t = t.UTC()
+	minute := t.Hour()*60 + t.Minute()
+	if q.start < q.end {
+		return minute >= q.start && minute < q.end
+	}
+	return minute >= q.start || minute < q.end
+}
+
+// CompactionScheduler decides when the buckets of a node may compact. It is
+// shared by all buckets of the node and can be adjusted at runtime. A nil
+// scheduler doesn't limit compactions.
+type CompactionScheduler struct {
+	sync.Mutex
+
+	schedule   CompactionSchedule
+	quietHours *quietHours
+
+	running  int
+	perShard map[string]int
+
+	// tokens of the IO rate limit, negative while the compactions are
+	// throttled
+	tokens     float64
+	lastRefill time.Time
+
+	now   func() time.Time
+	sleep func(time.Duration)
+}
+
+func NewCompactionScheduler(schedule CompactionSchedule) (*CompactionScheduler, error) {
+	c := &CompactionScheduler{
+		perShard: map[string]int{},
+		now:      time.Now,
+		sleep:    time.Sleep,
+	}
+	if err := c.Update(schedule); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Schedule returns the active schedule
+func (c *CompactionScheduler) Schedule() CompactionSchedule {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.schedule
+}
+
+// Update replaces the schedule, running compactions are throttled by the new
+// rate limit immediately
+func (c *CompactionScheduler) Update(schedule CompactionSchedule) error {
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+	quiet, _ := parseQuietHours(schedule.QuietHours)
+
+	c.Lock()
+	defer c.Unlock()
+
+	c.schedule = schedule
+	c.quietHours = quiet
+	c.tokens = 0
+	c.lastRefill = c.now()
+	return nil
+}
+
+// tryStart reserves a slot for a compaction of a bucket in the shard. If the
+// schedule doesn't allow another compaction now, ok is false and the bucket
+// tries again in its next compaction cycle. Otherwise done must be called
+// once the compaction is complete.
+func (c *CompactionScheduler) tryStart(shard string) (done func(), ok bool) {
+	if c == nil {
+		return func() {}, true
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if c.quietHours != nil && !c.quietHours.contains(c.now()) {
+		return nil, false
+	}
+	if max := c.schedule.MaxConcurrent; max > 0 && c.running >= max {
+		return nil, false
+	}
+	if max := c.schedule.MaxConcurrentPerShard; max > 0 && c.perShard[shard] >= max {
+		return nil, false
+	}
+
+	c.running++
+	c.perShard[shard]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			c.Lock()
+			defer c.Unlock()
+
+			c.running--
+			if c.perShard[shard]--; c.perShard[shard] <= 0 {
+				delete(c.perShard, shard)
+			}
+		})
+	}, true
+}
+
+// waitBytes blocks until n more bytes may be written. The limit allows
+// bursts of up to one second worth of bytes.
+func (c *CompactionScheduler) waitBytes(n int) {
+	if c == nil {
+		return
+	}
+
+	c.Lock()
+	rate := float64(c.schedule.MaxBytesPerSecond)
+	if rate <= 0 {
+		c.Unlock()
+		return
+	}
+
+	now := c.now()
+	c.tokens += now.Sub(c.lastRefill).Seconds() * rate
+	if c.tokens > rate {
+		c.tokens = rate
+	}
+	c.lastRefill = now
+	c.tokens -= float64(n)
+
+	var wait time.Duration
+	if c.tokens < 0 {
+		wait = time.Duration(-c.tokens / rate * float64(time.Second))
+	}
+	c.Unlock()
+
+	if wait > 0 {
+		c.sleep(wait)
+	}
+}
+
+// throttle limits the rate with which the compaction writes its segment
+func (c *CompactionScheduler) throttle(w io.WriteSeeker) io.WriteSeeker {
+	if c == nil {
+		return w
+	}
+	return &throttledWriter{w: w, scheduler: c}
+}
+
+type throttledWriter struct {
+	w         io.WriteSeeker
+	scheduler *CompactionScheduler
+}
+
+func (t *throttledWriter) Write(p []byte) (int, error) {
+	t.scheduler.waitBytes(len(p))
+	return t.w.Write(p)
+}
+
+func (t *throttledWriter) Seek(offset int64, whence int) (int64, error) {
+	return t.w.Seek(offset, whence)
+}
+
+// compactionShard identifies the shard of a bucket for the per-shard limit,
+// the buckets of a shard share the directory of its store
+func compactionShard(bucketDir string) string {
+	return filepath.Dir(bucketDir)
+}
@@ -0,0 +1,154 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompactionScheduler_Concurrency(t *testing.T) {
+	c, err := NewCompactionScheduler(CompactionSchedule{
+		MaxConcurrent:         2,
+		MaxConcurrentPerShard: 1,
+	})
+	require.Nil(t, err)
+
+	doneA, ok := c.tryStart("shardA")
+	require.True(t, ok)
+
+	_, ok = c.tryStart("shardA")
+	assert.False(t, ok, "limited per shard")
+
+	doneB, ok := c.tryStart("shardB")
+	require.True(t, ok)
+
+	_, ok = c.tryStart("shardC")
+	assert.False(t, ok, "limited per node")
+
+	doneA()
+	doneA() // done is idempotent
+	_, ok = c.tryStart("shardC")
+	assert.True(t, ok)
+
+	_, ok = c.tryStart("shardA")
+	assert.False(t, ok, "limited per node again")
+	doneB()
+	_, ok = c.tryStart("shardA")
+	assert.True(t, ok)
+}
+
+func TestCompactionScheduler_QuietHours(t *testing.T) {
+	c, err := NewCompactionScheduler(CompactionSchedule{QuietHours: "22:00-06:00"})
+	require.Nil(t, err)
+
+	for _, tc := range []struct {
+		at      string
+		allowed bool
+	}{
+		{"21:59", false},
+		{"22:00", true},
+		{"23:30", true},
+		{"05:59", true},
+		{"06:00", false},
+		{"12:00", false},
+	} {
+		at, _ := time.Parse("15:04", tc.at)
+		c.now = func() time.Time { return at }
+
+		done, ok := c.tryStart("shard")
+		assert.Equal(t, tc.allowed, ok, tc.at)
+		if ok {
+			done()
+		}
+	}
+}
+
+func TestCompactionScheduler_Throttle(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	var slept time.Duration
+
+	c, err := NewCompactionScheduler(CompactionSchedule{MaxBytesPerSecond: 1000})
+	require.Nil(t, err)
+	c.now = func() time.Time { return now }
+	c.sleep = func(d time.Duration) {
+		slept += d
+		now = now.Add(d)
+	}
+	require.Nil(t, c.Update(c.Schedule()))
+
+	var buf bytes.Buffer
+	w := c.throttle(&nopSeeker{&buf})
+	for i := 0; i < 5; i++ {
+		_, err := w.Write(make([]byte, 500))
+		require.Nil(t, err)
+	}
+
+	assert.Equal(t, 2500, buf.Len())
+	assert.Equal(t, 2500*time.Millisecond, slept)
+
+	t.Run("unlimited after update", func(t *testing.T) {
+		slept = 0
+		require.Nil(t, c.Update(CompactionSchedule{}))
+		_, err := w.Write(make([]byte, 5000))
+		require.Nil(t, err)
+		assert.Equal(t, time.Duration(0), slept)
+	})
+}
+
+func TestCompactionScheduler_Nil(t *testing.T) {
+	var c *CompactionScheduler
+
+	done, ok := c.tryStart("shard")
+	require.True(t, ok)
+	done()
+
+	w := &nopSeeker{&bytes.Buffer{}}
+	assert.Equal(t, io.WriteSeeker(w), c.throttle(w))
+}
+
+func TestCompactionSchedule_Validate(t *testing.T) {
+	valid := []CompactionSchedule{
+		{},
+		{MaxConcurrent: 1, MaxConcurrentPerShard: 1, MaxBytesPerSecond: 1},
+		{QuietHours: "01:00-05:30"},
+		{QuietHours: "22:00 - 06:00"},
+	}
+	for _, s := range valid {
+		assert.Nil(t, s.Validate(), s)
+	}
+
+	invalid := []CompactionSchedule{
+		{MaxConcurrent: -1},
+		{MaxConcurrentPerShard: -1},
+		{MaxBytesPerSecond: -1},
+		{QuietHours: "22:00"},
+		{QuietHours: "25:00-06:00"},
+		{QuietHours: "06:00-06:00"},
+	}
+	for _, s := range invalid {
+		assert.NotNil(t, s.Validate(), s)
+	}
+}
+
+type nopSeeker struct {
+	io.Writer
+}
+
+func (n *nopSeeker) Seek(offset int64, whence int) (int64, error) {
+	return 0, nil
+}
@@ -39,6 +39,12 @@ type compactorMap struct {
 	// for backward-compatibility with states where the disk state for maps was
 	// not guaranteed to be sorted yet
 	requiresSorting bool
+
+	// cleanupTombstones drops deleted map pairs instead of writing tombstones.
+	// This is only safe if there are no older segments the tombstones could
+	// shadow.
+	cleanupTombstones bool
+	lastDroppedKey    []byte
 }
 
 func newCompactorMapCollection(w io.WriteSeeker,
@@ -142,11 +148,22 @@ func (c *compactorMap) writeKeys() ([]segmentindex.Key, error) {
 			}
 
 			ssm.reset([][]MapPair{pairs.left, pairs.right})
-			mergedPairs, err := ssm.
-				doKeepTombstonesReusable()
+			var mergedPairs []MapPair
+			var err error
+			if c.cleanupTombstones {
+				mergedPairs, err = ssm.doReusable()
+			} else {
+				mergedPairs, err = ssm.doKeepTombstonesReusable()
+			}
 			if err != nil {
 				return nil, err
 			}
+			if len(mergedPairs) == 0 {
+				c.dropKey(key2)
+				key1, value1, _ = c.c1.next()
+				key2, value2, _ = c.c2.next()
+				continue
+			}
 
 			mergedEncoded, err := me.DoMultiReusable(mergedPairs)
 			if err != nil {
@@ -169,6 +186,11 @@ func (c *compactorMap) writeKeys() ([]segmentindex.Key, error) {
 
 		if (key1 != nil && bytes.Compare(key1, key2) == -1) || key2 == nil {
 			// key 1 is smaller
+			value1 = c.dropTombstones(key1, value1)
+			if len(value1) == 0 {
+				key1, value1, _ = c.c1.next()
+				continue
+			}
 			ki, err := c.writeIndividualNode(offset, key1, value1)
 			if err != nil {
 				return nil, errors.Wrap(err, "write individual node (key1 smaller)")
@@ -179,6 +201,11 @@ func (c *compactorMap) writeKeys() ([]segmentindex.Key, error) {
 			key1, value1, _ = c.c1.next()
 		} else {
 			// key 2 is smaller
+			value2 = c.dropTombstones(key2, value2)
+			if len(value2) == 0 {
+				key2, value2, _ = c.c2.next()
+				continue
+			}
 			ki, err := c.writeIndividualNode(offset, key2, value2)
 			if err != nil {
 				return nil, errors.Wrap(err, "write individual node (key2 smaller)")
@@ -191,9 +218,42 @@ func (c *compactorMap) writeKeys() ([]segmentindex.Key, error) {
 		}
 	}
 
+	if len(kis) == 0 && c.lastDroppedKey != nil {
+		// an empty segment cannot be read, keep a single empty row instead
+		ki, err := c.writeIndividualNode(offset, c.lastDroppedKey, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "write individual node (last dropped)")
+		}
+		kis = append(kis, ki)
+	}
+
 	return kis, nil
 }
 
+// dropTombstones removes the deleted pairs of a row if tombstones are cleaned
+// up. A row without remaining pairs is dropped as a whole.
+func (c *compactorMap) dropTombstones(key []byte, values []value) []value {
+	if !c.cleanupTombstones {
+		return values
+	}
+
+	live := values[:0:0]
+	for _, v := range values {
+		if !v.tombstone {
+			live = append(live, v)
+		}
+	}
+	if len(live) == 0 {
+		c.dropKey(key)
+	}
+	return live
+}
+
+func (c *compactorMap) dropKey(key []byte) {
+	// the key is owned by the reusable cursor, it must be copied
+	c.lastDroppedKey = append(c.lastDroppedKey[:0], key...)
+}
+
 func (c *compactorMap) writeIndividualNode(offset int, key []byte,
 	values []value,
 ) (segmentindex.Key, error) {
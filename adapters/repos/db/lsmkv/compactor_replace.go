@@ -32,6 +32,11 @@ type compactorReplace struct {
 
 	secondaryIndexCount uint16
 
+	// cleanupTombstones drops deleted keys instead of writing tombstones. This
+	// is only safe if there are no older segments the tombstones could shadow.
+	cleanupTombstones bool
+	lastDropped       *segmentReplaceNode
+
 	w                io.WriteSeeker
 	bufw             *bufio.Writer
 	scratchSpacePath string
@@ -106,6 +111,11 @@ func (c *compactorReplace) writeKeys() ([]segmentindex.Key, error) {
 			break
 		}
 		if bytes.Equal(res1.primaryKey, res2.primaryKey) {
+			if c.dropTombstone(res2, err2) {
+				res1, err1 = c.c1.nextWithAllKeys()
+				res2, err2 = c.c2.nextWithAllKeys()
+				continue
+			}
 			ki, err := c.writeIndividualNode(offset, res2.primaryKey, res2.value,
 				res2.secondaryKeys, err2 == lsmkv.Deleted)
 			if err != nil {
@@ -123,6 +133,10 @@ func (c *compactorReplace) writeKeys() ([]segmentindex.Key, error) {
 
 		if (res1.primaryKey != nil && bytes.Compare(res1.primaryKey, res2.primaryKey) == -1) || res2.primaryKey == nil {
 			// key 1 is smaller
+			if c.dropTombstone(res1, err1) {
+				res1, err1 = c.c1.nextWithAllKeys()
+				continue
+			}
 			ki, err := c.writeIndividualNode(offset, res1.primaryKey, res1.value,
 				res1.secondaryKeys, err1 == lsmkv.Deleted)
 			if err != nil {
@@ -134,6 +148,10 @@ func (c *compactorReplace) writeKeys() ([]segmentindex.Key, error) {
 			res1, err1 = c.c1.nextWithAllKeys()
 		} else {
 			// key 2 is smaller
+			if c.dropTombstone(res2, err2) {
+				res2, err2 = c.c2.nextWithAllKeys()
+				continue
+			}
 			ki, err := c.writeIndividualNode(offset, res2.primaryKey, res2.value,
 				res2.secondaryKeys, err2 == lsmkv.Deleted)
 			if err != nil {
@@ -147,9 +165,29 @@ func (c *compactorReplace) writeKeys() ([]segmentindex.Key, error) {
 		}
 	}
 
+	if len(kis) == 0 && c.lastDropped != nil {
+		// an empty segment cannot be read, keep a single tombstone instead
+		ki, err := c.writeIndividualNode(offset, c.lastDropped.primaryKey, nil,
+			c.lastDropped.secondaryKeys, true)
+		if err != nil {
+			return nil, errors.Wrap(err, "write individual node (last tombstone)")
+		}
+		kis = append(kis, ki)
+	}
+
 	return kis, nil
 }
 
+// dropTombstone reports whether a node can be left out of the compacted
+// segment, which is the case for tombstones if they are cleaned up
+func (c *compactorReplace) dropTombstone(node segmentReplaceNode, err error) bool {
+	if !c.cleanupTombstones || err != lsmkv.Deleted {
+		return false
+	}
+	c.lastDropped = &node
+	return true
+}
+
 func (c *compactorReplace) writeIndividualNode(offset int, key, value []byte,
 	secondaryKeys [][]byte, tombstone bool,
 ) (segmentindex.Key, error) {
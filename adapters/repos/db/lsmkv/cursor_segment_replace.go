@@ -45,8 +45,12 @@ func (s *segmentCursorReplace) seek(key []byte) ([]byte, []byte, error) {
 		return nil, nil, err
 	}
 
-	err = s.segment.replaceStratParseDataWithKeyInto(
-		s.segment.contents[node.Start:node.End], s.reusableNode)
+	contents, err := s.segment.read(node.Start, node.End)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = s.segment.replaceStratParseDataWithKeyInto(contents, s.reusableNode)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
@@ -65,8 +69,12 @@ func (s *segmentCursorReplace) next() ([]byte, []byte, error) {
 		return nil, nil, lsmkv.NotFound
 	}
 
-	err := s.segment.replaceStratParseDataWithKeyInto(
-		s.segment.contents[s.nextOffset:], s.reusableNode)
+	contents, err := s.segment.readNode(s.nextOffset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = s.segment.replaceStratParseDataWithKeyInto(contents, s.reusableNode)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
@@ -81,8 +89,12 @@ func (s *segmentCursorReplace) next() ([]byte, []byte, error) {
 
 func (s *segmentCursorReplace) first() ([]byte, []byte, error) {
 	s.nextOffset = s.segment.dataStartPos
-	err := s.segment.replaceStratParseDataWithKeyInto(
-		s.segment.contents[s.nextOffset:], s.reusableNode)
+	contents, err := s.segment.readNode(s.nextOffset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = s.segment.replaceStratParseDataWithKeyInto(contents, s.reusableNode)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
@@ -101,8 +113,12 @@ func (s *segmentCursorReplace) nextWithAllKeys() (segmentReplaceNode, error) {
 		return out, lsmkv.NotFound
 	}
 
-	parsed, err := s.segment.replaceStratParseDataWithKey(
-		s.segment.contents[s.nextOffset:])
+	contents, err := s.segment.readNode(s.nextOffset)
+	if err != nil {
+		return segmentReplaceNode{}, err
+	}
+
+	parsed, err := s.segment.replaceStratParseDataWithKey(contents)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
@@ -117,8 +133,12 @@ func (s *segmentCursorReplace) nextWithAllKeys() (segmentReplaceNode, error) {
 
 func (s *segmentCursorReplace) firstWithAllKeys() (segmentReplaceNode, error) {
 	s.nextOffset = s.segment.dataStartPos
-	parsed, err := s.segment.replaceStratParseDataWithKey(
-		s.segment.contents[s.nextOffset:])
+	contents, err := s.segment.readNode(s.nextOffset)
+	if err != nil {
+		return segmentReplaceNode{}, err
+	}
+
+	parsed, err := s.segment.replaceStratParseDataWithKey(contents)
 
 	// make sure to set the next offset before checking the error. The error
 	// could be 'Deleted' which would require that the offset is still advanced
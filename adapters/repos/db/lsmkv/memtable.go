@@ -37,6 +37,9 @@ type Memtable struct {
 	lastWrite          time.Time
 	createdAt          time.Time
 	metrics            *memtableMetrics
+
+	// walArchiveDir is set if the commit log is retained after the flush
+	walArchiveDir string
 }
 
 func newMemtable(path string, strategy string,
@@ -332,6 +335,9 @@ func (l *Memtable) IdleDuration() time.Duration {
 }
 
 func (l *Memtable) countStats() *countStats {
+	l.RLock()
+	defer l.RUnlock()
+
 	return l.key.countStats()
 }
 
@@ -93,9 +93,11 @@ func (l *Memtable) flush() error {
 		return err
 	}
 
-	// only now that the file has been flushed is it safe to delete the commit log
-	// TODO: there might be an interest in keeping the commit logs around for
-	// longer as they might come in handy for replication
+	// only now that the file has been flushed is it safe to delete the commit
+	// log, unless it is retained to replay recent writes
+	if l.walArchiveDir != "" {
+		return l.commitlog.archive(l.walArchiveDir)
+	}
 	return l.commitlog.delete()
 }
 
@@ -15,6 +15,7 @@ import (
 	"bytes"
 	"fmt"
 	"os"
+	"sync"
 	"syscall"
 
 	"github.com/pkg/errors"
@@ -44,6 +45,13 @@ type segment struct {
 
 	// the net addition this segment adds with respect to all previous segments
 	countNetAdditions int
+
+	// remote is set if the data of the segment has been offloaded
+	remote *remoteSegment
+
+	// the tombstone stats are computed on first use, segments are immutable
+	tombstoneStatsOnce sync.Once
+	tombstoneStats     TombstoneStats
 }
 
 type diskIndex interface {
@@ -58,13 +66,21 @@ type diskIndex interface {
 	// AllKeys in no specific order, e.g. for building a bloom filter
 	AllKeys() ([][]byte, error)
 
+	// AllStarts returns the start positions of all nodes in no specific order
+	AllStarts() ([]uint64, error)
+
 	// Size of the index in bytes
 	Size() int
 }
 
 func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
-	existsLower existsOnLowerSegmentsFn,
+	existsLower existsOnLowerSegmentsFn, remoteCfg *RemoteSegments,
 ) (*segment, error) {
+	remote, err := loadRemoteSegment(path, remoteCfg)
+	if err != nil {
+		return nil, err
+	}
+
 	file, err := os.Open(path)
 	if err != nil {
 		return nil, errors.Wrap(err, "open file")
@@ -115,6 +131,7 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 		logger:              logger,
 		metrics:             metrics,
 		bloomFilterMetrics:  newBloomFilterMetrics(metrics),
+		remote:              remote,
 	}
 
 	if ind.secondaryIndexCount > 0 {
@@ -167,6 +184,12 @@ func (ind *segment) drop() error {
 		return fmt.Errorf("drop count net additions file: %w", err)
 	}
 
+	if ind.remote != nil {
+		if err := ind.dropRemote(); err != nil {
+			return err
+		}
+	}
+
 	// for the segment itself, we're not using RemoveAll, but Remove. If there
 	// was a NotExists error here, something would be seriously wrong and we
 	// don't want to ignore it.
@@ -54,11 +54,16 @@ type SegmentGroup struct {
 	// produce a meaningful count. Typically, the only count we're interested in
 	// is that of the bucket that holds objects
 	monitorCount bool
+
+	// remote is set if older segments are offloaded to remote storage
+	remote *RemoteSegments
+
+	scheduler *CompactionScheduler
 }
 
 func newSegmentGroup(dir string, logger logrus.FieldLogger,
 	mapRequiresSorting bool, metrics *Metrics, strategy string,
-	monitorCount bool,
+	monitorCount bool, remote *RemoteSegments, scheduler *CompactionScheduler,
 ) (*SegmentGroup, error) {
 	list, err := os.ReadDir(dir)
 	if err != nil {
@@ -73,6 +78,8 @@ func newSegmentGroup(dir string, logger logrus.FieldLogger,
 		monitorCount:       monitorCount,
 		mapRequiresSorting: mapRequiresSorting,
 		strategy:           strategy,
+		remote:             remote,
+		scheduler:          scheduler,
 	}
 
 	segmentIndex := 0
@@ -116,7 +123,7 @@ func newSegmentGroup(dir string, logger logrus.FieldLogger,
 		}
 
 		segment, err := newSegment(filepath.Join(dir, entry.Name()), logger,
-			metrics, out.makeExistsOnLower(segmentIndex))
+			metrics, out.makeExistsOnLower(segmentIndex), remote)
 		if err != nil {
 			return nil, errors.Wrapf(err, "init segment %s", entry.Name())
 		}
@@ -163,7 +170,7 @@ func (sg *SegmentGroup) add(path string) error {
 
 	newSegmentIndex := len(sg.segments)
 	segment, err := newSegment(path, sg.logger, sg.metrics,
-		sg.makeExistsOnLower(newSegmentIndex))
+		sg.makeExistsOnLower(newSegmentIndex), sg.remote)
 	if err != nil {
 		return errors.Wrapf(err, "init segment %s", path)
 	}
@@ -197,6 +204,10 @@ func (sg *SegmentGroup) getWithUpperSegmentBoundary(key []byte, topMostSegment i
 				return nil, nil
 			}
 
+			if errors.Is(err, errRemoteSegment) {
+				return nil, err
+			}
+
 			panic(fmt.Sprintf("unsupported error in segmentGroup.get(): %v", err))
 		}
 
@@ -206,6 +217,29 @@ func (sg *SegmentGroup) getWithUpperSegmentBoundary(key []byte, topMostSegment i
 	return nil, nil
 }
 
+func (sg *SegmentGroup) exists(key []byte) (bool, error) {
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	// assumes "replace" strategy, the latest segment with the key takes
+	// precedence
+	for i := len(sg.segments) - 1; i >= 0; i-- {
+		err := sg.segments[i].exists(key)
+		switch {
+		case err == nil:
+			return true, nil
+		case err == lsmkv.NotFound:
+			continue
+		case err == lsmkv.Deleted:
+			return false, nil
+		default:
+			return false, err
+		}
+	}
+
+	return false, nil
+}
+
 func (sg *SegmentGroup) getBySecondary(pos int, key []byte) ([]byte, error) {
 	sg.maintenanceLock.RLock()
 	defer sg.maintenanceLock.RUnlock()
@@ -225,6 +259,10 @@ func (sg *SegmentGroup) getBySecondary(pos int, key []byte) ([]byte, error) {
 				return nil, nil
 			}
 
+			if errors.Is(err, errRemoteSegment) {
+				return nil, err
+			}
+
 			panic(fmt.Sprintf("unsupported error in segmentGroup.get(): %v", err))
 		}
 
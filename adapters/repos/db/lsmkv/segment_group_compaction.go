@@ -12,6 +12,7 @@
 package lsmkv
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"os"
@@ -22,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/roaringset"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/storagestate"
 )
 
 func (sg *SegmentGroup) eligibleForCompaction() bool {
@@ -116,18 +118,30 @@ func (sg *SegmentGroup) compactOnce() error {
 		return nil
 	}
 
+	return sg.compactPair(pair, false)
+}
+
+// compactPair compacts the two segments at the positions of pair into one.
+// With cleanupTombstones deleted keys of the replace strategy and deleted
+// pairs of the map strategy are dropped, which requires the pair to contain
+// the oldest segment. A pair of the same position rewrites a single segment.
+func (sg *SegmentGroup) compactPair(pair []int, cleanupTombstones bool) error {
 	path := fmt.Sprintf("%s.tmp", sg.segmentAtPos(pair[1]).path)
-	f, err := os.Create(path)
+	file, err := os.Create(path)
 	if err != nil {
 		return err
 	}
+	f := sg.scheduler.throttle(file)
 
 	scratchSpacePath := sg.segmentAtPos(pair[1]).path + "compaction.scratch.d"
 
-	// the assumption is that both pairs are of the same level, so we can just
-	// take either value. If we want to support asymmetric compaction, then we
-	// might have to choose this value more intelligently
+	// regular compactions only pair segments of the same level. Forced
+	// compactions can pair different levels, the result is placed above the
+	// higher one
 	level := sg.segmentAtPos(pair[0]).level
+	if l := sg.segmentAtPos(pair[1]).level; l > level {
+		level = l
+	}
 	secondaryIndices := sg.segmentAtPos(pair[0]).secondaryIndexCount
 
 	strategy := sg.segmentAtPos(pair[0]).strategy
@@ -139,6 +153,7 @@ func (sg *SegmentGroup) compactOnce() error {
 	case segmentindex.StrategyReplace:
 		c := newCompactorReplace(f, sg.segmentAtPos(pair[0]).newCursor(),
 			sg.segmentAtPos(pair[1]).newCursor(), level, secondaryIndices, scratchSpacePath)
+		c.cleanupTombstones = cleanupTombstones
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionReplace.With(prometheus.Labels{"path": sg.dir}).Set(1)
@@ -166,6 +181,7 @@ func (sg *SegmentGroup) compactOnce() error {
 			sg.segmentAtPos(pair[0]).newCollectionCursorReusable(),
 			sg.segmentAtPos(pair[1]).newCollectionCursorReusable(),
 			level, secondaryIndices, scratchSpacePath, sg.mapRequiresSorting)
+		c.cleanupTombstones = cleanupTombstones
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionMap.With(prometheus.Labels{"path": sg.dir}).Set(1)
@@ -198,7 +214,7 @@ func (sg *SegmentGroup) compactOnce() error {
 		return errors.Errorf("unrecognized strategy %v", strategy)
 	}
 
-	if err := f.Close(); err != nil {
+	if err := file.Close(); err != nil {
 		return errors.Wrap(err, "close compacted segment file")
 	}
 
@@ -213,8 +229,10 @@ func (sg *SegmentGroup) replaceCompactedSegments(old1, old2 int,
 	newPathTmp string,
 ) error {
 	sg.maintenanceLock.RLock()
-	updatedCountNetAdditions := sg.segments[old1].countNetAdditions +
-		sg.segments[old2].countNetAdditions
+	updatedCountNetAdditions := sg.segments[old1].countNetAdditions
+	if old2 != old1 {
+		updatedCountNetAdditions += sg.segments[old2].countNetAdditions
+	}
 	sg.maintenanceLock.RUnlock()
 
 	precomputedFiles, err := preComputeSegmentMeta(newPathTmp,
@@ -230,16 +248,20 @@ func (sg *SegmentGroup) replaceCompactedSegments(old1, old2 int,
 		return errors.Wrap(err, "close disk segment")
 	}
 
-	if err := sg.segments[old2].close(); err != nil {
-		return errors.Wrap(err, "close disk segment")
+	if old2 != old1 {
+		if err := sg.segments[old2].close(); err != nil {
+			return errors.Wrap(err, "close disk segment")
+		}
 	}
 
 	if err := sg.segments[old1].drop(); err != nil {
 		return errors.Wrap(err, "drop disk segment")
 	}
 
-	if err := sg.segments[old2].drop(); err != nil {
-		return errors.Wrap(err, "drop disk segment")
+	if old2 != old1 {
+		if err := sg.segments[old2].drop(); err != nil {
+			return errors.Wrap(err, "drop disk segment")
+		}
 	}
 
 	sg.segments[old1] = nil
@@ -261,14 +283,16 @@ func (sg *SegmentGroup) replaceCompactedSegments(old1, old2 int,
 		}
 	}
 
-	seg, err := newSegment(newPath, sg.logger, sg.metrics, nil)
+	seg, err := newSegment(newPath, sg.logger, sg.metrics, nil, sg.remote)
 	if err != nil {
 		return errors.Wrap(err, "create new segment")
 	}
 
 	sg.segments[old2] = seg
 
-	sg.segments = append(sg.segments[:old1], sg.segments[old1+1:]...)
+	if old2 != old1 {
+		sg.segments = append(sg.segments[:old1], sg.segments[old1+1:]...)
+	}
 
 	return nil
 }
@@ -287,10 +311,41 @@ func (sg *SegmentGroup) stripTmpExtension(oldPath string) (string, error) {
 	return newPath, nil
 }
 
+// compactAll compacts all segments into a single one regardless of their
+// levels and returns the number of compactions. As the result has no older
+// segments, tombstones of the replace and map strategies are dropped on the
+// way.
+func (sg *SegmentGroup) compactAll(ctx context.Context) (int, error) {
+	compactions := 0
+	for sg.Len() > 1 {
+		if err := ctx.Err(); err != nil {
+			return compactions, err
+		}
+		if sg.isReadyOnly() {
+			return compactions, storagestate.ErrStatusReadOnly
+		}
+
+		if err := sg.compactPair([]int{0, 1}, true); err != nil {
+			return compactions, err
+		}
+		compactions++
+	}
+	return compactions, nil
+}
+
 func (sg *SegmentGroup) compactIfLevelsMatch(shouldBreak cyclemanager.ShouldBreakFunc) bool {
 	sg.monitorSegments()
 
 	if sg.eligibleForCompaction() {
+		done, ok := sg.scheduler.tryStart(compactionShard(sg.dir))
+		if !ok {
+			sg.logger.WithField("action", "lsm_compaction").
+				WithField("path", sg.dir).
+				Trace("compaction postponed by the compaction schedule")
+			return false
+		}
+		defer done()
+
 		if err := sg.compactOnce(); err != nil {
 			sg.logger.WithField("action", "lsm_compaction").
 				WithField("path", sg.dir).
@@ -300,6 +355,19 @@ func (sg *SegmentGroup) compactIfLevelsMatch(shouldBreak cyclemanager.ShouldBrea
 		return true
 	}
 
+	if sg.remote != nil {
+		offloaded, err := sg.offloadOnce(context.Background())
+		if err != nil {
+			sg.logger.WithField("action", "lsm_remote_segment_offload").
+				WithField("path", sg.dir).
+				WithError(err).
+				Errorf("offload segment failed")
+		}
+		if offloaded {
+			return true
+		}
+	}
+
 	sg.logger.WithField("action", "lsm_compaction").
 		WithField("path", sg.dir).
 		Trace("no segment eligible for compaction")
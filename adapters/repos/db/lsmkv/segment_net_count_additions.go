@@ -84,7 +84,13 @@ func (ind *segment) initCountNetAdditions(exists existsOnLowerSegmentsFn) error
 		}
 	}
 
-	extr := newBufferedKeyAndTombstoneExtractor(ind.contents, ind.dataStartPos,
+	// the data of remote segments is read entirely, the net additions are
+	// only recalculated if they weren't stored yet
+	contents, err := ind.read(0, ind.dataEndPos)
+	if err != nil {
+		return err
+	}
+	extr := newBufferedKeyAndTombstoneExtractor(contents, ind.dataStartPos,
 		ind.dataEndPos, 10e6, ind.secondaryIndexCount, cb)
 
 	extr.do()
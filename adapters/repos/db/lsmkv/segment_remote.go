@@ -0,0 +1,346 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+)
+
+// RemoteStorage stores segments in object storage
+type RemoteStorage interface {
+	// Upload stores the file at path as object key
+	Upload(ctx context.Context, key, path string) error
+	// Download stores the object key in the file at path
+	Download(ctx context.Context, key, path string) error
+	// ReadAt reads len(p) bytes of the object key starting at offset
+	ReadAt(ctx context.Context, key string, p []byte, offset int64) error
+	Delete(ctx context.Context, key string) error
+}
+
+// RemoteSegments offloads older, fully compacted segments of a bucket to
+// remote storage. Only the data of a remote segment is offloaded: the local
+// file keeps the header and the indexes at their original offsets and has a
+// hole in place of the data, which doesn't use disk space. The data is read
+// through the block cache.
+type RemoteSegments struct {
+	Storage RemoteStorage
+	Cache   *BlockCache
+	// Prefix of the object keys, it must be unique for each bucket
+	Prefix string
+	// KeepLocal is the number of most recent segments which are never offloaded
+	KeepLocal int
+	// MinAge is the minimum age of a segment before it is offloaded
+	MinAge time.Duration
+}
+
+// errRemoteSegment is returned when reading a remote segment failed. Unlike
+// reads of local segments, which can't fail, it is returned to the caller.
+var errRemoteSegment = errors.New("read remote segment")
+
+const remoteTimeout = 30 * time.Second
+
+type remoteSegment struct {
+	Key  string `json:"key"`
+	Size int64  `json:"size"`
+
+	storage RemoteStorage
+	cache   *BlockCache
+
+	// start positions of all nodes in ascending order, they bound the nodes
+	// which are read by cursors
+	startsOnce sync.Once
+	starts     []uint64
+	startsErr  error
+}
+
+func remotePathFromSegmentPath(segPath string) string {
+	extless := strings.TrimSuffix(segPath, filepath.Ext(segPath))
+	return fmt.Sprintf("%s.remote", extless)
+}
+
+// loadRemoteSegment returns nil if the segment is local
+func loadRemoteSegment(segPath string, cfg *RemoteSegments) (*remoteSegment, error) {
+	data, err := os.ReadFile(remotePathFromSegmentPath(segPath))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "read remote segment meta")
+	}
+	if cfg == nil {
+		return nil, errors.Errorf("segment %q is remote, but the bucket has no "+
+			"remote storage configured", segPath)
+	}
+
+	r := &remoteSegment{storage: cfg.Storage, cache: cfg.Cache}
+	if err := json.Unmarshal(data, r); err != nil {
+		return nil, errors.Wrap(err, "parse remote segment meta")
+	}
+	return r, nil
+}
+
+func (r *remoteSegment) read(start, end uint64) ([]byte, error) {
+	out := make([]byte, end-start)
+	err := r.cache.read(r.Key, r.Size, out, int64(start), func(p []byte, offset int64) error {
+		ctx, cancel := context.WithTimeout(context.Background(), remoteTimeout)
+		defer cancel()
+		return r.storage.ReadAt(ctx, r.Key, p, offset)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %v", errRemoteSegment, r.Key, err)
+	}
+	return out, nil
+}
+
+func (r *remoteSegment) nodeEnd(ind *segment, offset uint64) (uint64, error) {
+	r.startsOnce.Do(func() {
+		r.starts, r.startsErr = ind.index.AllStarts()
+		sort.Slice(r.starts, func(a, b int) bool { return r.starts[a] < r.starts[b] })
+	})
+	if r.startsErr != nil {
+		return 0, fmt.Errorf("%w %q: index: %v", errRemoteSegment, r.Key, r.startsErr)
+	}
+
+	i := sort.Search(len(r.starts), func(i int) bool { return r.starts[i] > offset })
+	if i == len(r.starts) {
+		return ind.dataEndPos, nil
+	}
+	return r.starts[i], nil
+}
+
+// read returns the bytes of the segment between start and end. The bytes of
+// local segments point into the mmapped file, those of remote segments are a
+// copy.
+func (ind *segment) read(start, end uint64) ([]byte, error) {
+	if ind.remote == nil {
+		return ind.contents[start:end], nil
+	}
+	return ind.remote.read(start, end)
+}
+
+// readNode returns the bytes of the node starting at offset. Local segments
+// return all bytes after the offset, as the length of a node is only known
+// once it is parsed.
+func (ind *segment) readNode(offset uint64) ([]byte, error) {
+	if ind.remote == nil {
+		return ind.contents[offset:], nil
+	}
+	end, err := ind.remote.nodeEnd(ind, offset)
+	if err != nil {
+		return nil, err
+	}
+	return ind.remote.read(offset, end)
+}
+
+func (ind *segment) dropRemote() error {
+	if err := os.Remove(remotePathFromSegmentPath(ind.path)); err != nil {
+		return fmt.Errorf("drop remote segment meta: %w", err)
+	}
+
+	// the object is deleted in the background, so the maintenance lock of the
+	// segment group isn't held during the request
+	r := ind.remote
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), remoteTimeout)
+		defer cancel()
+		if err := r.storage.Delete(ctx, r.Key); err != nil {
+			ind.logger.WithField("action", "lsm_remote_segment_drop").
+				WithField("key", r.Key).
+				WithError(err).
+				Warn("delete remote segment")
+		}
+		r.cache.drop(r.Key, r.Size)
+	}()
+	return nil
+}
+
+// offloadCandidate returns the position of the oldest local segment which
+// may be offloaded or -1. Only segments without any other segment of the same
+// level are fully compacted, segments which are still waiting for a
+// compaction partner are kept local.
+func (sg *SegmentGroup) offloadCandidate(now time.Time) int {
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	levels := map[uint16]int{}
+	for _, seg := range sg.segments {
+		levels[seg.level]++
+	}
+
+	for i := 0; i < len(sg.segments)-sg.remote.KeepLocal; i++ {
+		seg := sg.segments[i]
+		if seg.remote != nil || levels[seg.level] > 1 ||
+			seg.strategy != segmentindex.StrategyReplace {
+			continue
+		}
+		info, err := os.Stat(seg.path)
+		if err != nil || now.Sub(info.ModTime()) < sg.remote.MinAge {
+			continue
+		}
+		return i
+	}
+	return -1
+}
+
+// offloadOnce uploads a single segment and replaces the local file by a stub
+// without data. It runs in the compaction cycle, so the positions of the
+// segments can't change concurrently, flushes only append new segments.
+func (sg *SegmentGroup) offloadOnce(ctx context.Context) (bool, error) {
+	pos := sg.offloadCandidate(time.Now())
+	if pos < 0 {
+		return false, nil
+	}
+	seg := sg.segmentAtPos(pos)
+
+	r := &remoteSegment{
+		Key:     path.Join(sg.remote.Prefix, filepath.Base(seg.path)),
+		Size:    int64(seg.Size()),
+		storage: sg.remote.Storage,
+		cache:   sg.remote.Cache,
+	}
+	if err := r.storage.Upload(ctx, r.Key, seg.path); err != nil {
+		return false, errors.Wrapf(err, "upload segment %q", seg.path)
+	}
+
+	stubPath := seg.path + ".stub.tmp"
+	if err := writeSegmentStub(seg, stubPath); err != nil {
+		return false, errors.Wrapf(err, "write stub of segment %q", seg.path)
+	}
+
+	// once the meta file exists the segment is read from remote, even if
+	// the local file is still complete after a crash
+	meta, err := json.Marshal(r)
+	if err != nil {
+		return false, err
+	}
+	metaPath := remotePathFromSegmentPath(seg.path)
+	if err := os.WriteFile(metaPath+".tmp", meta, 0o644); err != nil {
+		return false, errors.Wrap(err, "write remote segment meta")
+	}
+	if err := os.Rename(metaPath+".tmp", metaPath); err != nil {
+		return false, errors.Wrap(err, "write remote segment meta")
+	}
+
+	sg.maintenanceLock.Lock()
+	defer sg.maintenanceLock.Unlock()
+
+	if err := seg.close(); err != nil {
+		return false, errors.Wrap(err, "close disk segment")
+	}
+	if err := os.Rename(stubPath, seg.path); err != nil {
+		return false, errors.Wrap(err, "replace segment by stub")
+	}
+	updated, err := newSegment(seg.path, sg.logger, sg.metrics,
+		sg.makeExistsOnLower(pos), sg.remote)
+	if err != nil {
+		return false, errors.Wrap(err, "init remote segment")
+	}
+	sg.segments[pos] = updated
+
+	sg.logger.WithField("action", "lsm_remote_segment_offload").
+		WithField("path", seg.path).
+		WithField("key", r.Key).
+		Debug("offloaded segment to remote storage")
+	return true, nil
+}
+
+// writeSegmentStub writes a file of the size of the segment, which has the
+// header and the indexes of the segment, but no data
+func writeSegmentStub(seg *segment, stubPath string) error {
+	f, err := os.Create(stubPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := f.Write(seg.contents[:segmentindex.HeaderSize]); err != nil {
+		return err
+	}
+	// extending the file leaves a hole, which doesn't use disk space
+	if err := f.Truncate(int64(seg.Size())); err != nil {
+		return err
+	}
+	if _, err := f.WriteAt(seg.contents[seg.segmentStartPos:],
+		int64(seg.segmentStartPos)); err != nil {
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// hydrate downloads all remote segments and replaces their stubs, e.g. so a
+// backup has the complete files. Compactions must be paused, as otherwise
+// they would offload the segments again.
+func (sg *SegmentGroup) hydrate(ctx context.Context) error {
+	for pos := 0; pos < sg.Len(); pos++ {
+		seg := sg.segmentAtPos(pos)
+		if seg.remote == nil {
+			continue
+		}
+
+		tmpPath := seg.path + ".hydrate.tmp"
+		if err := seg.remote.storage.Download(ctx, seg.remote.Key, tmpPath); err != nil {
+			return errors.Wrapf(err, "download segment %q", seg.remote.Key)
+		}
+
+		if err := sg.replaceByHydrated(pos, tmpPath); err != nil {
+			return err
+		}
+
+		if err := seg.remote.storage.Delete(ctx, seg.remote.Key); err != nil {
+			sg.logger.WithField("action", "lsm_remote_segment_hydrate").
+				WithField("key", seg.remote.Key).
+				WithError(err).
+				Warn("delete remote segment")
+		}
+		seg.remote.cache.drop(seg.remote.Key, seg.remote.Size)
+	}
+	return nil
+}
+
+func (sg *SegmentGroup) replaceByHydrated(pos int, tmpPath string) error {
+	sg.maintenanceLock.Lock()
+	defer sg.maintenanceLock.Unlock()
+
+	seg := sg.segments[pos]
+	if err := seg.close(); err != nil {
+		return errors.Wrap(err, "close disk segment")
+	}
+	if err := os.Rename(tmpPath, seg.path); err != nil {
+		return errors.Wrap(err, "replace stub by segment")
+	}
+	if err := os.Remove(remotePathFromSegmentPath(seg.path)); err != nil {
+		return errors.Wrap(err, "remove remote segment meta")
+	}
+
+	updated, err := newSegment(seg.path, sg.logger, sg.metrics,
+		sg.makeExistsOnLower(pos), sg.remote)
+	if err != nil {
+		return errors.Wrap(err, "init hydrated segment")
+	}
+	sg.segments[pos] = updated
+	return nil
+}
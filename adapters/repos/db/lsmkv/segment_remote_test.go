@@ -0,0 +1,217 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRemoteStorage struct {
+	sync.Mutex
+	objects     map[string][]byte
+	unavailable bool
+}
+
+func newFakeRemoteStorage() *fakeRemoteStorage {
+	return &fakeRemoteStorage{objects: map[string][]byte{}}
+}
+
+func (f *fakeRemoteStorage) Upload(ctx context.Context, key, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	f.Lock()
+	defer f.Unlock()
+	f.objects[key] = data
+	return nil
+}
+
+func (f *fakeRemoteStorage) Download(ctx context.Context, key, path string) error {
+	f.Lock()
+	defer f.Unlock()
+	return os.WriteFile(path, f.objects[key], 0o644)
+}
+
+func (f *fakeRemoteStorage) ReadAt(ctx context.Context, key string, p []byte, offset int64) error {
+	f.Lock()
+	defer f.Unlock()
+	if f.unavailable {
+		return errors.New("unavailable")
+	}
+	copy(p, f.objects[key][offset:])
+	return nil
+}
+
+func (f *fakeRemoteStorage) Delete(ctx context.Context, key string) error {
+	f.Lock()
+	defer f.Unlock()
+	delete(f.objects, key)
+	return nil
+}
+
+func (f *fakeRemoteStorage) keys() []string {
+	f.Lock()
+	defer f.Unlock()
+	var keys []string
+	for key := range f.objects {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func TestRemoteSegments(t *testing.T) {
+	ctx := context.Background()
+	rootDir := t.TempDir()
+	dir := rootDir + "/objects"
+	logger, _ := test.NewNullLogger()
+	storage := newFakeRemoteStorage()
+	opts := func() []BucketOption {
+		return []BucketOption{
+			WithStrategy(StrategyReplace),
+			WithSecondaryIndices(1),
+			WithRemoteSegments(RemoteSegments{
+				Storage:   storage,
+				Cache:     NewBlockCache(64, 1024),
+				Prefix:    "objects",
+				KeepLocal: 1,
+			}),
+		}
+	}
+
+	key := func(i int) []byte { return []byte(fmt.Sprintf("key-%03d", i)) }
+	secondary := func(i int) []byte { return []byte(fmt.Sprintf("secondary-%03d", i)) }
+	value := func(i, version int) []byte {
+		return []byte(fmt.Sprintf("value-%03d-%d", i, version))
+	}
+
+	b, err := NewBucket(ctx, dir, rootDir, logger, nil, opts()...)
+	require.Nil(t, err)
+	require.Nil(t, b.PauseCompaction(ctx))
+
+	put := func(from, to, version int) {
+		for i := from; i < to; i++ {
+			require.Nil(t, b.Put(key(i), value(i, version),
+				WithSecondaryKey(0, secondary(i))))
+		}
+	}
+
+	verify := func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			expected := value(i, 1)
+			if i >= 80 {
+				expected = value(i, 2)
+			}
+			if i%10 == 0 {
+				expected = nil
+			}
+
+			v, err := b.Get(key(i))
+			require.Nil(t, err)
+			assert.Equal(t, expected, v)
+
+			v, err = b.GetBySecondary(0, secondary(i))
+			require.Nil(t, err)
+			assert.Equal(t, expected, v)
+		}
+
+		c := b.Cursor()
+		defer c.Close()
+		count := 0
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			assert.Equal(t, key(count+count/9+1), k)
+			assert.NotNil(t, v)
+			count++
+		}
+		assert.Equal(t, 90, count)
+	}
+
+	t.Run("create a fully compacted and a recent segment", func(t *testing.T) {
+		put(0, 50, 1)
+		require.Nil(t, b.FlushAndSwitch())
+		put(50, 100, 1)
+		require.Nil(t, b.FlushAndSwitch())
+		require.Nil(t, b.disk.compactOnce())
+
+		put(80, 100, 2)
+		for i := 0; i < 100; i += 10 {
+			require.Nil(t, b.Delete(key(i), WithSecondaryKey(0, secondary(i))))
+		}
+		require.Nil(t, b.FlushAndSwitch())
+		require.Equal(t, 2, b.disk.Len())
+	})
+
+	t.Run("offload the compacted segment", func(t *testing.T) {
+		offloaded, err := b.disk.offloadOnce(ctx)
+		require.Nil(t, err)
+		assert.True(t, offloaded)
+		assert.NotNil(t, b.disk.segmentAtPos(0).remote)
+		assert.Nil(t, b.disk.segmentAtPos(1).remote)
+		assert.Len(t, storage.keys(), 1)
+
+		// the recent segment is kept local
+		offloaded, err = b.disk.offloadOnce(ctx)
+		require.Nil(t, err)
+		assert.False(t, offloaded)
+	})
+
+	t.Run("read remote segment", verify)
+
+	t.Run("reload remote segment", func(t *testing.T) {
+		require.Nil(t, b.Shutdown(ctx))
+		b, err = NewBucket(ctx, dir, rootDir, logger, nil, opts()...)
+		require.Nil(t, err)
+		require.Nil(t, b.PauseCompaction(ctx))
+		assert.NotNil(t, b.disk.segmentAtPos(0).remote)
+	})
+
+	t.Run("read reloaded remote segment", verify)
+
+	t.Run("remote storage unavailable", func(t *testing.T) {
+		storage.Lock()
+		storage.unavailable = true
+		storage.Unlock()
+		defer func() {
+			storage.Lock()
+			storage.unavailable = false
+			storage.Unlock()
+		}()
+
+		// key-001 is only in the remote segment and isn't cached yet
+		_, err := b.disk.segmentAtPos(0).read(0, 1)
+		require.NotNil(t, err)
+		_, err = b.Get([]byte("key-001"))
+		assert.ErrorIs(t, err, errRemoteSegment)
+	})
+
+	t.Run("list files downloads remote segments", func(t *testing.T) {
+		_, err := b.ListFiles(ctx)
+		require.Nil(t, err)
+		assert.Nil(t, b.disk.segmentAtPos(0).remote)
+		assert.Empty(t, storage.keys())
+		_, err = os.Stat(remotePathFromSegmentPath(b.disk.segmentAtPos(0).path))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("read downloaded segment", verify)
+
+	require.Nil(t, b.Shutdown(ctx))
+}
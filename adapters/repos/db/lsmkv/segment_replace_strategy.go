@@ -56,12 +56,45 @@ func (i *segment) get(key []byte) ([]byte, error) {
 	// invalid memory without the copy, thus leading to a SEGFAULT.
 	// Similar approach was used to fix SEGFAULT in collection strategy
 	// https://github.com/weaviate/weaviate/issues/1837
+	contents, err := i.read(node.Start, node.End)
+	if err != nil {
+		return nil, err
+	}
 	contentsCopy := make([]byte, node.End-node.Start)
-	copy(contentsCopy, i.contents[node.Start:node.End])
+	copy(contentsCopy, contents)
 
 	return i.replaceStratParseData(contentsCopy)
 }
 
+// exists checks if the key is present without reading its value, only the
+// tombstone byte of the node is read
+func (i *segment) exists(key []byte) error {
+	if i.strategy != segmentindex.StrategyReplace {
+		return errors.Errorf("exists only possible for strategy %q", StrategyReplace)
+	}
+
+	if !i.bloomFilter.Test(key) {
+		return lsmkv.NotFound
+	}
+
+	node, err := i.index.Get(key)
+	if err != nil {
+		return err
+	}
+	if node.End == node.Start {
+		return lsmkv.NotFound
+	}
+
+	tombstone, err := i.read(node.Start, node.Start+1)
+	if err != nil {
+		return err
+	}
+	if tombstone[0] == 0x01 {
+		return lsmkv.Deleted
+	}
+	return nil
+}
+
 func (i *segment) getBySecondary(pos int, key []byte) ([]byte, error) {
 	if i.strategy != segmentindex.StrategyReplace {
 		return nil, errors.Errorf("get only possible for strategy %q", StrategyReplace)
@@ -91,8 +124,12 @@ func (i *segment) getBySecondary(pos int, key []byte) ([]byte, error) {
 	// invalid memory without the copy, thus leading to a SEGFAULT.
 	// Similar approach was used to fix SEGFAULT in collection strategy
 	// https://github.com/weaviate/weaviate/issues/1837
+	contents, err := i.read(node.Start, node.End)
+	if err != nil {
+		return nil, err
+	}
 	contentsCopy := make([]byte, node.End-node.Start)
-	copy(contentsCopy, i.contents[node.Start:node.End])
+	copy(contentsCopy, contents)
 
 	return i.replaceStratParseData(contentsCopy)
 }
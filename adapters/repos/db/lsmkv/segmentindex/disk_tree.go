@@ -191,6 +191,27 @@ func (t *DiskTree) AllKeys() ([][]byte, error) {
 	return out, nil
 }
 
+// AllStarts returns the start positions of all nodes in no specific order.
+// Like AllKeys it reads the entire index.
+func (t *DiskTree) AllStarts() ([]uint64, error) {
+	var out []uint64
+	bufferPos := 0
+	for {
+		node, readLength, err := t.readNode(t.data[bufferPos:])
+		bufferPos += readLength
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, node.startPos)
+	}
+
+	return out, nil
+}
+
 func (t *DiskTree) Size() int {
 	return len(t.data)
 }
@@ -208,5 +208,12 @@ func TestTree(t *testing.T) {
 			require.Nil(t, err)
 			assert.ElementsMatch(t, expected, keys)
 		})
+
+		t.Run("get all start positions (for reading remote segments)", func(t *testing.T) {
+			starts, err := dTree.AllStarts()
+
+			require.Nil(t, err)
+			assert.ElementsMatch(t, []uint64{1, 4, 17, 34, 100}, starts)
+		})
 	})
 }
@@ -21,6 +21,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/walcompression"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	"github.com/weaviate/weaviate/entities/storagestate"
 )
@@ -34,6 +35,12 @@ type Store struct {
 	logger        logrus.FieldLogger
 	metrics       *Metrics
 
+	// compactionScheduler is passed to all buckets of the store
+	compactionScheduler *CompactionScheduler
+
+	// walCompression is passed to all buckets of the store
+	walCompression walcompression.Codec
+
 	// Prevent concurrent manipulations to the bucketsByNameMap, most notably
 	// when initializing buckets in parallel
 	bucketAccessLock sync.RWMutex
@@ -56,6 +63,18 @@ func New(dir, rootDir string, logger logrus.FieldLogger,
 	return s, s.init()
 }
 
+// SetCompactionScheduler limits the compactions of the buckets which are
+// created afterwards, see [CompactionScheduler]
+func (s *Store) SetCompactionScheduler(scheduler *CompactionScheduler) {
+	s.compactionScheduler = scheduler
+}
+
+// SetWALCompression compresses the write-ahead logs of the buckets which are
+// created afterwards with the codec
+func (s *Store) SetWALCompression(codec walcompression.Codec) {
+	s.walCompression = codec
+}
+
 func (s *Store) Bucket(name string) *Bucket {
 	s.bucketAccessLock.RLock()
 	defer s.bucketAccessLock.RUnlock()
@@ -115,6 +134,13 @@ func (s *Store) CreateOrLoadBucket(ctx context.Context, bucketName string,
 		return nil
 	}
 
+	if s.compactionScheduler != nil {
+		opts = append([]BucketOption{WithCompactionScheduler(s.compactionScheduler)}, opts...)
+	}
+	if s.walCompression != walcompression.CodecNone {
+		opts = append([]BucketOption{WithWALCompression(s.walCompression)}, opts...)
+	}
+
 	b, err := NewBucket(ctx, s.bucketDir(bucketName), s.rootDir, s.logger, s.metrics, opts...)
 	if err != nil {
 		return err
@@ -197,6 +223,26 @@ func (s *Store) FlushMemtables(ctx context.Context) error {
 	return err
 }
 
+// CompactAll compacts each bucket into a single segment, see
+// Bucket.CompactAll, and returns the total number of compactions
+func (s *Store) CompactAll(ctx context.Context) (int, error) {
+	compactAll := func(ctx context.Context, b *Bucket) (interface{}, error) {
+		return b.CompactAll(ctx)
+	}
+
+	result, err := s.runJobOnBuckets(ctx, compactAll, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	compactions := 0
+	for _, res := range result {
+		compactions += res.(int)
+	}
+
+	return compactions, nil
+}
+
 func (s *Store) ListFiles(ctx context.Context) ([]string, error) {
 	listFiles := func(ctx context.Context, b *Bucket) (interface{}, error) {
 		return b.ListFiles(ctx)
@@ -355,40 +401,98 @@ func (s *Store) ReplaceBuckets(ctx context.Context, bucketName, replacementBucke
 		return errors.Wrapf(err, "failed moving replacement bucket dir '%s'", currReplacementBucketDir)
 	}
 
-	updateDir := func(bucket *Bucket, currBucketDir, newBucketDir string) {
-		updatePath := func(src string) string {
-			return strings.Replace(src, currBucketDir, newBucketDir, 1)
-		}
+	updateBucketDir(bucket, currBucketDir, newBucketDir)
+	updateBucketDir(replacementBucket, currReplacementBucketDir, newReplacementBucketDir)
 
-		bucket.flushLock.Lock()
-		bucket.dir = newBucketDir
-		if bucket.active != nil {
-			bucket.active.path = updatePath(bucket.active.path)
-			bucket.active.commitlog.path = updatePath(bucket.active.commitlog.path)
-		}
-		if bucket.flushing != nil {
-			bucket.flushing.path = updatePath(bucket.flushing.path)
-			bucket.flushing.commitlog.path = updatePath(bucket.flushing.commitlog.path)
-		}
-		bucket.flushLock.Unlock()
+	if err := bucket.Shutdown(ctx); err != nil {
+		return errors.Wrapf(err, "failed shutting down bucket old '%s'", bucketName)
+	}
+	if err := os.RemoveAll(newBucketDir); err != nil {
+		return errors.Wrapf(err, "failed removing dir '%s'", newBucketDir)
+	}
 
-		bucket.disk.maintenanceLock.Lock()
-		bucket.disk.dir = newBucketDir
-		for _, segment := range bucket.disk.segments {
-			segment.path = updatePath(segment.path)
-		}
-		bucket.disk.maintenanceLock.Unlock()
+	return nil
+}
+
+// RenameBucket moves a bucket to a new name. The bucket keeps serving reads
+// and writes, its dir and the paths of all its resources are changed to the
+// dir of the new name. Files which are left in the dir of the new name are
+// removed first. The new name must not be registered in bucketsByName.
+func (s *Store) RenameBucket(ctx context.Context, bucketName, newBucketName string) error {
+	s.bucketAccessLock.Lock()
+	defer s.bucketAccessLock.Unlock()
+
+	bucket := s.bucketsByName[bucketName]
+	if bucket == nil {
+		return fmt.Errorf("bucket '%s' not found", bucketName)
+	}
+	if s.bucketsByName[newBucketName] != nil {
+		return fmt.Errorf("bucket '%s' exists and is already in use", newBucketName)
 	}
 
-	updateDir(bucket, currBucketDir, newBucketDir)
-	updateDir(replacementBucket, currReplacementBucketDir, newReplacementBucketDir)
+	currBucketDir := bucket.dir
+	newBucketDir := s.bucketDir(newBucketName)
+	if err := os.RemoveAll(newBucketDir); err != nil {
+		return errors.Wrapf(err, "failed removing bucket %s files", newBucketName)
+	}
+	if err := os.Rename(currBucketDir, newBucketDir); err != nil {
+		return errors.Wrapf(err, "failed moving bucket dir '%s'", currBucketDir)
+	}
+
+	updateBucketDir(bucket, currBucketDir, newBucketDir)
+	s.bucketsByName[newBucketName] = bucket
+	delete(s.bucketsByName, bucketName)
+
+	return nil
+}
+
+// DropBucket shuts down a bucket and removes its files, e.g. a temporary
+// bucket which is no longer needed
+func (s *Store) DropBucket(ctx context.Context, bucketName string) error {
+	s.bucketAccessLock.Lock()
+	defer s.bucketAccessLock.Unlock()
+
+	bucket := s.bucketsByName[bucketName]
+	if bucket == nil {
+		return fmt.Errorf("bucket '%s' not found", bucketName)
+	}
+	delete(s.bucketsByName, bucketName)
 
 	if err := bucket.Shutdown(ctx); err != nil {
-		return errors.Wrapf(err, "failed shutting down bucket old '%s'", bucketName)
+		return errors.Wrapf(err, "failed shutting down bucket '%s'", bucketName)
 	}
-	if err := os.RemoveAll(newBucketDir); err != nil {
-		return errors.Wrapf(err, "failed removing dir '%s'", newBucketDir)
+	if err := os.RemoveAll(bucket.dir); err != nil {
+		return errors.Wrapf(err, "failed removing dir '%s'", bucket.dir)
 	}
 
 	return nil
 }
+
+// updateBucketDir changes the dir of a bucket whose files have been moved,
+// as well as the paths of its memtables, commit logs and segments
+func updateBucketDir(bucket *Bucket, currBucketDir, newBucketDir string) {
+	updatePath := func(src string) string {
+		return strings.Replace(src, currBucketDir, newBucketDir, 1)
+	}
+
+	bucket.flushLock.Lock()
+	bucket.dir = newBucketDir
+	if bucket.active != nil {
+		bucket.active.path = updatePath(bucket.active.path)
+		bucket.active.commitlog.path = updatePath(bucket.active.commitlog.path)
+		bucket.active.walArchiveDir = updatePath(bucket.active.walArchiveDir)
+	}
+	if bucket.flushing != nil {
+		bucket.flushing.path = updatePath(bucket.flushing.path)
+		bucket.flushing.commitlog.path = updatePath(bucket.flushing.commitlog.path)
+		bucket.flushing.walArchiveDir = updatePath(bucket.flushing.walArchiveDir)
+	}
+	bucket.flushLock.Unlock()
+
+	bucket.disk.maintenanceLock.Lock()
+	bucket.disk.dir = newBucketDir
+	for _, segment := range bucket.disk.segments {
+		segment.path = updatePath(segment.path)
+	}
+	bucket.disk.maintenanceLock.Unlock()
+}
@@ -82,3 +82,54 @@ func TestStoreLifecycle(t *testing.T) {
 		require.Nil(t, err)
 	})
 }
+
+func TestStoreRenameBucket(t *testing.T) {
+	dirName := t.TempDir()
+
+	t.Run("rename a bucket with a flushed segment and a memtable", func(t *testing.T) {
+		store, err := New(dirName, "", nullLogger(), nil)
+		require.Nil(t, err)
+
+		err = store.CreateOrLoadBucket(testCtx(), "before", WithStrategy(StrategyReplace))
+		require.Nil(t, err)
+		b := store.Bucket("before")
+		require.Nil(t, b.Put([]byte("flushed"), []byte("1")))
+		require.Nil(t, b.FlushAndSwitch())
+		require.Nil(t, b.Put([]byte("active"), []byte("2")))
+
+		require.Nil(t, store.RenameBucket(testCtx(), "before", "after"))
+		assert.Nil(t, store.Bucket("before"))
+		require.NotNil(t, store.Bucket("after"))
+
+		require.Nil(t, store.Bucket("after").Put([]byte("renamed"), []byte("3")))
+		require.Nil(t, store.Shutdown(context.Background()))
+	})
+
+	t.Run("the renamed bucket is loaded from its new dir", func(t *testing.T) {
+		store, err := New(dirName, "", nullLogger(), nil)
+		require.Nil(t, err)
+
+		err = store.CreateOrLoadBucket(testCtx(), "after", WithStrategy(StrategyReplace))
+		require.Nil(t, err)
+		b := store.Bucket("after")
+		for key, expected := range map[string]string{"flushed": "1", "active": "2", "renamed": "3"} {
+			res, err := b.Get([]byte(key))
+			require.Nil(t, err)
+			assert.Equal(t, []byte(expected), res)
+		}
+
+		t.Run("renaming to a bucket in use fails", func(t *testing.T) {
+			err = store.CreateOrLoadBucket(testCtx(), "other", WithStrategy(StrategyReplace))
+			require.Nil(t, err)
+			assert.NotNil(t, store.RenameBucket(testCtx(), "after", "other"))
+		})
+
+		t.Run("drop the bucket", func(t *testing.T) {
+			require.Nil(t, store.DropBucket(testCtx(), "other"))
+			assert.Nil(t, store.Bucket("other"))
+			assert.NoDirExists(t, store.bucketDir("other"))
+		})
+
+		require.Nil(t, store.Shutdown(context.Background()))
+	})
+}
@@ -90,6 +90,28 @@ func (s *sortedMapMerger) doKeepTombstonesReusable() ([]MapPair, error) {
 	return s.output[:i], nil
 }
 
+// same as .do() but requires initialization from the outside like
+// .doKeepTombstonesReusable(). It can be used in compactions which cleanup
+// tombstones.
+func (s *sortedMapMerger) doReusable() ([]MapPair, error) {
+	i := 0
+	for {
+		match, ok := s.findSegmentWithLowestKey()
+		if !ok {
+			break
+		}
+
+		if match.Tombstone {
+			continue
+		}
+
+		s.output[i] = match
+		i++
+	}
+
+	return s.output[:i], nil
+}
+
 // init is automatically called by .do() or .doKeepTombstones()
 func (s *sortedMapMerger) init(segments [][]MapPair) error {
 	s.input = segments
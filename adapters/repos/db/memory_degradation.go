@@ -0,0 +1,152 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"sync"
+
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+const (
+	// degradeAfterScans is the number of consecutive resource scans above the
+	// threshold after which search is degraded, so that short spikes are
+	// ignored
+	degradeAfterScans = 3
+	// recoverAfterScans is the number of consecutive resource scans below the
+	// recovery threshold after which search is restored
+	recoverAfterScans = 3
+	// recoverMarginPercent keeps the node degraded until memory usage is well
+	// below the threshold, to avoid flapping
+	recoverMarginPercent = 5
+)
+
+// memoryDegradation tracks whether the node has switched the configured
+// classes to reduced quality search to avoid running out of memory. While
+// degraded, the ef of their vector searches and the number of results per
+// search are capped.
+type memoryDegradation struct {
+	sync.Mutex
+	active bool
+	above  int
+	below  int
+}
+
+// searchEFCapper is implemented by vector indexes whose search time ef can
+// be capped
+type searchEFCapper interface {
+	SetSearchEFCap(ef int)
+}
+
+// checkMemoryDegradation is called on every resource scan with the current
+// memory usage ratio
+func (d *DB) checkMemoryDegradation(ratio float64) {
+	cfg := d.config.ResourceUsage.MemUse
+	if cfg.DegradePercentage == 0 || len(cfg.DegradeClasses) == 0 {
+		return
+	}
+
+	percentUsed := ratio * 100
+	threshold := float64(cfg.DegradePercentage)
+
+	d.degradation.Lock()
+	wasActive := d.degradation.active
+	switch {
+	case percentUsed > threshold:
+		d.degradation.above++
+		d.degradation.below = 0
+	case percentUsed < threshold-recoverMarginPercent:
+		d.degradation.below++
+		d.degradation.above = 0
+	default:
+		d.degradation.above = 0
+		d.degradation.below = 0
+	}
+	if !wasActive && d.degradation.above >= degradeAfterScans {
+		d.degradation.active = true
+	} else if wasActive && d.degradation.below >= recoverAfterScans {
+		d.degradation.active = false
+	}
+	active := d.degradation.active
+	d.degradation.Unlock()
+
+	// applied on every scan, so that shards which were created in the
+	// meantime are degraded as well
+	d.applyMemoryDegradation(active)
+
+	if active == wasActive {
+		return
+	}
+
+	event := "recovered"
+	logger := d.logger.WithField("action", "memory_degradation").
+		WithField("classes", cfg.DegradeClasses).
+		WithField("memory_used_percentage", percentUsed).
+		WithField("threshold_percentage", threshold)
+	if active {
+		event = "degraded"
+		logger.WithField("max_ef", cfg.DegradeEF).
+			WithField("max_limit", cfg.DegradeMaxLimit).
+			Warn("sustained memory pressure, degrading search quality")
+	} else {
+		logger.Info("memory pressure resolved, restoring search quality")
+	}
+
+	if d.promMetrics != nil {
+		d.promMetrics.MemoryDegradationEvents.WithLabelValues(event).Inc()
+		value := 0.0
+		if active {
+			value = 1
+		}
+		for _, className := range cfg.DegradeClasses {
+			d.promMetrics.MemoryDegradationActive.WithLabelValues(className).Set(value)
+		}
+	}
+}
+
+func (d *DB) applyMemoryDegradation(active bool) {
+	cfg := d.config.ResourceUsage.MemUse
+	for _, className := range cfg.DegradeClasses {
+		idx := d.GetIndex(schema.ClassName(className))
+		if idx == nil {
+			continue
+		}
+
+		if active {
+			idx.degrade(cfg.DegradeEF, cfg.DegradeMaxLimit)
+		} else {
+			idx.degrade(0, 0)
+		}
+	}
+}
+
+// degrade caps the ef of vector searches and the number of results of all
+// searches, 0 removes the respective cap
+func (i *Index) degrade(maxEF, maxLimit int) {
+	i.degradedMaxLimit.Store(int64(maxLimit))
+
+	for _, shard := range i.Shards {
+		if capper, ok := shard.getVectorIndex().(searchEFCapper); ok {
+			capper.SetSearchEFCap(maxEF)
+		}
+	}
+}
+
+// degradedLimit applies the cap of the result count while degraded. A limit
+// of -1 signals a search by distance, which is bounded elsewhere.
+func (i *Index) degradedLimit(limit int) int {
+	maxLimit := int(i.degradedMaxLimit.Load())
+	if maxLimit > 0 && limit > maxLimit {
+		return maxLimit
+	}
+	return limit
+}
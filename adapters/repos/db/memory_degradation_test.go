@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestMemoryDegradation(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	vectorIndex := &fakeEFCapIndex{}
+	idx := &Index{
+		Config: IndexConfig{ClassName: schema.ClassName("Degraded")},
+		Shards: map[string]*Shard{"shard": {vectorIndex: vectorIndex}},
+	}
+	other := &Index{
+		Config: IndexConfig{ClassName: schema.ClassName("Other")},
+		Shards: map[string]*Shard{},
+	}
+
+	d := &DB{
+		logger: logger,
+		config: Config{ResourceUsage: config.ResourceUsage{
+			MemUse: config.MemUse{
+				DegradePercentage: 80,
+				DegradeClasses:    []string{"Degraded"},
+				DegradeEF:         32,
+				DegradeMaxLimit:   10,
+			},
+		}},
+		indices: map[string]*Index{idx.ID(): idx, other.ID(): other},
+	}
+
+	scan := func(ratio float64, times int) {
+		for i := 0; i < times; i++ {
+			d.checkMemoryDegradation(ratio)
+		}
+	}
+
+	t.Run("short spikes are ignored", func(t *testing.T) {
+		scan(0.9, degradeAfterScans-1)
+		scan(0.5, 1)
+		scan(0.9, degradeAfterScans-1)
+
+		assert.Equal(t, 100, idx.degradedLimit(100))
+		assert.Equal(t, 0, vectorIndex.efCap)
+	})
+
+	t.Run("sustained pressure degrades the configured classes", func(t *testing.T) {
+		scan(0.9, 1)
+
+		assert.Equal(t, 10, idx.degradedLimit(100))
+		assert.Equal(t, 5, idx.degradedLimit(5))
+		assert.Equal(t, -1, idx.degradedLimit(-1))
+		assert.Equal(t, 32, vectorIndex.efCap)
+		assert.Equal(t, 100, other.degradedLimit(100))
+	})
+
+	t.Run("usage just below the threshold does not recover", func(t *testing.T) {
+		scan(0.78, recoverAfterScans)
+
+		assert.Equal(t, 10, idx.degradedLimit(100))
+	})
+
+	t.Run("recovers once usage is well below the threshold", func(t *testing.T) {
+		scan(0.6, recoverAfterScans)
+
+		assert.Equal(t, 100, idx.degradedLimit(100))
+		assert.Equal(t, 0, vectorIndex.efCap)
+	})
+}
+
+type fakeEFCapIndex struct {
+	VectorIndex
+	efCap int
+}
+
+func (f *fakeEFCapIndex) SetSearchEFCap(ef int) {
+	f.efCap = ef
+}
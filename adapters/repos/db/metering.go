@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/weaviate/weaviate/usecases/metering"
+)
+
+// StorageUsage reports the objects, vector dimensions and bytes on disk of
+// all local shards for the metering collector
+func (db *DB) StorageUsage(ctx context.Context) ([]metering.StorageUsage, error) {
+	db.indexLock.RLock()
+	shards := []*Shard{}
+	for _, index := range db.indices {
+		for _, shard := range index.Shards {
+			shards = append(shards, shard)
+		}
+	}
+	db.indexLock.RUnlock()
+
+	out := make([]metering.StorageUsage, 0, len(shards))
+	for _, shard := range shards {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		bytes, err := shard.diskSize()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, metering.StorageUsage{
+			Class:            shard.index.Config.ClassName.String(),
+			Shard:            shard.name,
+			Objects:          int64(shard.objectCount()),
+			VectorDimensions: int64(shard.Dimensions()),
+			Bytes:            bytes,
+		})
+	}
+	return out, nil
+}
+
+// diskSize is the size of all files of the shard, i.e. of its lsm store,
+// vector index commit logs and counters
+func (s *Shard) diskSize() (int64, error) {
+	root := s.index.Config.RootPath
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return 0, err
+	}
+
+	var size int64
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasPrefix(name, s.ID()+"_") && !strings.HasPrefix(name, s.ID()+".") {
+			continue
+		}
+
+		err := filepath.WalkDir(filepath.Join(root, name),
+			func(path string, d fs.DirEntry, err error) error {
+				if err != nil {
+					// files may be removed by compactions while walking
+					if os.IsNotExist(err) {
+						return nil
+					}
+					return err
+				}
+				if d.IsDir() {
+					return nil
+				}
+				info, err := d.Info()
+				if err != nil {
+					if os.IsNotExist(err) {
+						return nil
+					}
+					return err
+				}
+				size += info.Size()
+				return nil
+			})
+		if err != nil {
+			return 0, err
+		}
+	}
+	return size, nil
+}
@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestStorageUsage(t *testing.T) {
+	dirName := t.TempDir()
+
+	class := &models.Class{
+		Class:               "StorageUsageClass",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "name",
+				DataType: []string{"text"},
+			},
+		},
+	}
+	shardState := singleShardState()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState, schema: schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+		TrackVectorDimensions:     true,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, shardState))
+
+	for i := 0; i < 10; i++ {
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			ID:         strfmt.UUID(fmt.Sprintf("2f1e8f3c-9f55-4bb1-8d6a-%012d", i)),
+			Class:      class.Class,
+			Properties: map[string]interface{}{"name": fmt.Sprintf("object %d", i)},
+		}, []float32{1, 2, 3, 4}, nil))
+	}
+
+	usage, err := repo.StorageUsage(context.Background())
+	require.Nil(t, err)
+	require.Len(t, usage, 1)
+	assert.Equal(t, class.Class, usage[0].Class)
+	assert.Equal(t, shardState.AllPhysicalShards()[0], usage[0].Shard)
+	assert.Equal(t, int64(10), usage[0].Objects)
+	assert.Equal(t, int64(40), usage[0].VectorDimensions)
+	assert.Greater(t, usage[0].Bytes, int64(0))
+}
@@ -12,6 +12,7 @@
 package db
 
 import (
+	"context"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -96,7 +97,7 @@ func (m *Metrics) BatchObject(start time.Time, size int) {
 		Tracef("object batch took %s", took)
 }
 
-func (m *Metrics) ObjectStore(start time.Time) {
+func (m *Metrics) ObjectStore(ctx context.Context, start time.Time) {
 	took := time.Since(start)
 	m.logger.WithField("action", "store_object_store").
 		WithField("took", took).
@@ -106,11 +107,11 @@ func (m *Metrics) ObjectStore(start time.Time) {
 		return
 	}
 
-	m.batchTime.With(prometheus.Labels{"operation": "object_storage"}).
-		Observe(float64(took / time.Millisecond))
+	monitoring.ObserveWithTraceID(ctx, m.batchTime.With(prometheus.Labels{"operation": "object_storage"}),
+		float64(took/time.Millisecond))
 }
 
-func (m *Metrics) VectorIndex(start time.Time) {
+func (m *Metrics) VectorIndex(ctx context.Context, start time.Time) {
 	took := time.Since(start)
 	m.logger.WithField("action", "store_vector_index").
 		WithField("took", took).
@@ -120,8 +121,8 @@ func (m *Metrics) VectorIndex(start time.Time) {
 		return
 	}
 
-	m.batchTime.With(prometheus.Labels{"operation": "vector_storage"}).
-		Observe(float64(took / time.Millisecond))
+	monitoring.ObserveWithTraceID(ctx, m.batchTime.With(prometheus.Labels{"operation": "vector_storage"}),
+		float64(took/time.Millisecond))
 }
 
 func (m *Metrics) PutObject(start time.Time) {
@@ -19,10 +19,13 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
+	dynament "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/sharding"
 	"golang.org/x/sync/errgroup"
@@ -41,19 +44,26 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 	}
 
 	idx, err := NewIndex(ctx,
-		IndexConfig{
-			ClassName:                 schema.ClassName(class.Class),
-			RootPath:                  m.db.config.RootPath,
-			ResourceUsage:             m.db.config.ResourceUsage,
-			QueryMaximumResults:       m.db.config.QueryMaximumResults,
-			MemtablesFlushIdleAfter:   m.db.config.MemtablesFlushIdleAfter,
-			MemtablesInitialSizeMB:    m.db.config.MemtablesInitialSizeMB,
-			MemtablesMaxSizeMB:        m.db.config.MemtablesMaxSizeMB,
-			MemtablesMinActiveSeconds: m.db.config.MemtablesMinActiveSeconds,
-			MemtablesMaxActiveSeconds: m.db.config.MemtablesMaxActiveSeconds,
-			TrackVectorDimensions:     m.db.config.TrackVectorDimensions,
-			ReplicationFactor:         class.ReplicationConfig.Factor,
-		},
+		withIngestConfig(IndexConfig{
+			ClassName:                  schema.ClassName(class.Class),
+			RootPath:                   m.db.config.RootPath,
+			ResourceUsage:              m.db.config.ResourceUsage,
+			QueryMaximumResults:        m.db.config.QueryMaximumResults,
+			MemtablesFlushIdleAfter:    m.db.config.MemtablesFlushIdleAfter,
+			MemtablesInitialSizeMB:     m.db.config.MemtablesInitialSizeMB,
+			MemtablesMaxSizeMB:         m.db.config.MemtablesMaxSizeMB,
+			MemtablesMinActiveSeconds:  m.db.config.MemtablesMinActiveSeconds,
+			MemtablesMaxActiveSeconds:  m.db.config.MemtablesMaxActiveSeconds,
+			TrackVectorDimensions:      m.db.config.TrackVectorDimensions,
+			QuarantineAfterWriteErrors: m.db.config.QuarantineAfterWriteErrors,
+			LeaderWrites:               m.db.config.LeaderWrites,
+			ReplicationFactor:          class.ReplicationConfig.Factor,
+			RemoteSegments:             m.db.remoteSegments(class.Class),
+			AsyncIndexing:              m.db.config.AsyncIndexing,
+			CompactionScheduler:        m.db.config.CompactionScheduler,
+			FilterCache:                m.db.config.FilterCache,
+			WALCompression:             m.db.config.WALCompression,
+		}, class.IngestConfig),
 		shardState,
 		// no backward-compatibility check required, since newly added classes will
 		// always have the field set
@@ -97,6 +107,12 @@ func (m *Migrator) addPropertiesAndNullAndLength(ctx context.Context, prop *mode
 		return errors.Wrapf(err, "extend idx '%s' with property", idx.ID())
 	}
 
+	if prop.LanguageDetection {
+		if err := idx.addLanguageProperty(ctx); err != nil {
+			return errors.Wrapf(err, "extend idx '%s' with language property", idx.ID())
+		}
+	}
+
 	if idx.invertedIndexConfig.IndexNullState {
 		err = idx.addNullStateProperty(ctx, prop)
 		if err != nil {
@@ -129,6 +145,24 @@ func (m *Migrator) DropClass(ctx context.Context, className string) error {
 	return nil
 }
 
+// TrashClass moves the index of the class into the recycle bin instead of
+// deleting it
+func (m *Migrator) TrashClass(ctx context.Context, className, trashID string) error {
+	if err := m.db.TrashIndex(schema.ClassName(className), trashID); err != nil {
+		return errors.Wrapf(err, "trash idx for class '%s'", className)
+	}
+
+	return nil
+}
+
+func (m *Migrator) RestoreTrashedClass(ctx context.Context, trashID string) error {
+	return m.db.RestoreTrashedIndex(trashID)
+}
+
+func (m *Migrator) DeleteTrashedClass(ctx context.Context, trashID string) error {
+	return m.db.DeleteTrashedIndex(trashID)
+}
+
 func (m *Migrator) UpdateClass(ctx context.Context, className string, newClassName *string) error {
 	if newClassName != nil {
 		return errors.New("weaviate does not support renaming of classes")
@@ -143,6 +177,7 @@ func (m *Migrator) AddProperty(ctx context.Context, className string, prop *mode
 		return errors.Errorf("cannot add property to a non-existing index for %s", className)
 	}
 
+	defer idx.notifyWrite()
 	return m.addPropertiesAndNullAndLength(ctx, prop, idx)
 }
 
@@ -161,6 +196,10 @@ func (m *Migrator) UpdateProperty(ctx context.Context, className string, propNam
 }
 
 func (m *Migrator) GetShardsStatus(ctx context.Context, className string) (map[string]string, error) {
+	if m.db.isOffloaded(schema.ClassName(className)) {
+		return m.db.offloadedShardsStatus(className), nil
+	}
+
 	idx := m.db.GetIndex(schema.ClassName(className))
 	if idx == nil {
 		return nil, errors.Errorf("cannot get shards status for a non-existing index for %s", className)
@@ -175,9 +214,85 @@ func (m *Migrator) UpdateShardStatus(ctx context.Context, className, shardName,
 		return errors.Errorf("cannot update shard status to a non-existing index for %s", className)
 	}
 
+	defer idx.notifyWrite()
 	return idx.updateShardStatus(ctx, shardName, targetStatus)
 }
 
+// DropShard drops the local replica of a shard which has been moved to
+// another node
+func (m *Migrator) DropShard(ctx context.Context, className, shardName string) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot drop shard of a non-existing index for %s", className)
+	}
+
+	return idx.dropShard(shardName)
+}
+
+// OptimizeClass optimizes all shards of the class across the cluster, see
+// Index.optimize
+func (m *Migrator) OptimizeClass(ctx context.Context, className string) (*models.ClassOptimizeResult, error) {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, errors.Errorf("cannot optimize a non-existing index for %s", className)
+	}
+
+	return idx.optimize(ctx)
+}
+
+// CleanupInvertedClass drops the postings of deleted objects from the
+// searchable properties of all shards of the class across the cluster, see
+// Index.cleanupInverted
+func (m *Migrator) CleanupInvertedClass(ctx context.Context,
+	className string,
+) (*models.ClassInvertedCleanupResult, error) {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, errors.Errorf("cannot clean up a non-existing index for %s", className)
+	}
+
+	return idx.cleanupInverted(ctx)
+}
+
+// PreparePropertyMigration rewrites all shards of the class across the
+// cluster for a schema migration, see Index.prepareMigration
+func (m *Migrator) PreparePropertyMigration(ctx context.Context, className string,
+	migration *models.SchemaMigration,
+) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot migrate a non-existing index for %s", className)
+	}
+
+	return idx.prepareMigration(ctx, migration)
+}
+
+// CommitPropertyMigration swaps the rewritten data of a schema migration into
+// the local shards of the class, see Index.commitMigration
+func (m *Migrator) CommitPropertyMigration(ctx context.Context, className string,
+	migration *models.SchemaMigration,
+) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot migrate a non-existing index for %s", className)
+	}
+
+	return idx.commitMigration(ctx, migration)
+}
+
+// AbortPropertyMigration drops the rewritten data of a schema migration from
+// the local shards of the class, see Index.abortMigration
+func (m *Migrator) AbortPropertyMigration(ctx context.Context, className string,
+	migration *models.SchemaMigration,
+) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot migrate a non-existing index for %s", className)
+	}
+
+	return idx.abortMigration(ctx, migration)
+}
+
 func NewMigrator(db *DB, logger logrus.FieldLogger) *Migrator {
 	return &Migrator{db: db, logger: logger}
 }
@@ -190,15 +305,19 @@ func (m *Migrator) UpdateVectorIndexConfig(ctx context.Context,
 		return errors.Errorf("cannot update vector index config of non-existing index for %s", className)
 	}
 
+	defer idx.notifyWrite()
 	return idx.updateVectorIndexConfig(ctx, updated)
 }
 
 func (m *Migrator) ValidateVectorIndexConfigUpdate(ctx context.Context,
 	old, updated schema.VectorIndexConfig,
 ) error {
-	// hnsw is the only supported vector index type at the moment, so no need
-	// to check, we can always use that an hnsw-specific validation should be
-	// used for now.
+	switch old.IndexType() {
+	case flatent.IndexType:
+		return flat.ValidateUserConfigUpdate(old, updated)
+	case dynament.IndexType:
+		return validateDynamicUserConfigUpdate(old, updated)
+	}
 	return hnsw.ValidateUserConfigUpdate(old, updated)
 }
 
@@ -218,9 +337,22 @@ func (m *Migrator) UpdateInvertedIndexConfig(ctx context.Context, className stri
 
 	conf := inverted.ConfigFromModel(updated)
 
+	defer idx.notifyWrite()
 	return idx.updateInvertedIndexConfig(ctx, conf)
 }
 
+func (m *Migrator) UpdateVersioningConfig(ctx context.Context, className string,
+	updated *models.VersioningConfig,
+) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot update versioning config of non-existing index for %s", className)
+	}
+
+	idx.updateVersioningConfig(updated)
+	return nil
+}
+
 func (m *Migrator) RecalculateVectorDimensions(ctx context.Context) error {
 	count := 0
 	m.logger.
@@ -0,0 +1,218 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestNamedVectors(t *testing.T) {
+	dirName := t.TempDir()
+	ctx := context.Background()
+
+	titleConfig := hnsw.NewDefaultUserConfig()
+	titleConfig.Distance = hnsw.DistanceL2Squared
+	bodyConfig := flat.NewDefaultUserConfig()
+	bodyConfig.Distance = hnsw.DistanceL2Squared
+	class := &models.Class{
+		Class:               "NamedVectorsClass",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		VectorConfig: map[string]models.VectorConfig{
+			"title_vec": {VectorIndexType: "hnsw", VectorIndexConfig: titleConfig},
+			"body_vec":  {VectorIndexType: flat.IndexType, VectorIndexConfig: bodyConfig},
+		},
+		Properties: []*models.Property{
+			{
+				Name:         "name",
+				DataType:     []string{"text"},
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+	shardState := singleShardState()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState, schema: schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}}
+	newRepo := func() *DB {
+		repo, err := New(logger, Config{
+			MemtablesFlushIdleAfter:   60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+
+	repo := newRepo()
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(ctx, class, shardState))
+
+	ids := make([]strfmt.UUID, 10)
+	for i := range ids {
+		ids[i] = strfmt.UUID(fmt.Sprintf("8c0e4b6a-2f1d-4e3a-9b7c-%012d", i))
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			ID:         ids[i],
+			Class:      class.Class,
+			Properties: map[string]interface{}{"name": "object"},
+			Vectors: models.Vectors{
+				"title_vec": {float32(i), 0},
+				"body_vec":  {float32(10 - i), 0, 0},
+			},
+		}, []float32{1, 2, 3}, nil))
+	}
+
+	search := func(repo *DB, target string, vector []float32) ([]strfmt.UUID, error) {
+		res, err := repo.VectorClassSearch(ctx, dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: vector,
+			NearVector:   &searchparams.NearVector{Vector: vector, TargetVector: target},
+			Pagination:   &filters.Pagination{Limit: 3},
+		})
+		if err != nil {
+			return nil, err
+		}
+		found := make([]strfmt.UUID, len(res))
+		for i := range res {
+			found[i] = res[i].ID
+		}
+		return found, nil
+	}
+	mustSearch := func(repo *DB, target string, vector []float32) []strfmt.UUID {
+		found, err := search(repo, target, vector)
+		require.Nil(t, err)
+		return found
+	}
+
+	t.Run("every target vector is searched on its own", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{ids[3], ids[4], ids[2]},
+			mustSearch(repo, "title_vec", []float32{3.2, 0}))
+		assert.Equal(t, []strfmt.UUID{ids[7], ids[6], ids[8]},
+			mustSearch(repo, "body_vec", []float32{3.2, 0, 0}))
+	})
+
+	t.Run("several target vectors are searched at once", func(t *testing.T) {
+		multiSearch := func(combination string) []strfmt.UUID {
+			res, err := repo.VectorClassSearch(ctx, dto.GetParams{
+				ClassName: class.Class,
+				NearVector: &searchparams.NearVector{
+					TargetVectors: &searchparams.TargetVectors{
+						Targets:     []string{"title_vec", "body_vec"},
+						Vectors:     [][]float32{{3.2, 0}, {4.1, 0, 0}},
+						Combination: combination,
+					},
+				},
+				Pagination: &filters.Pagination{Limit: 3},
+			})
+			require.Nil(t, err)
+			found := make([]strfmt.UUID, len(res))
+			for i := range res {
+				found[i] = res[i].ID
+			}
+			return found
+		}
+
+		// title_vec finds 3, 4, 2 and body_vec finds 6, 5, 7, a missing
+		// distance counts as the furthest one of its target
+		assert.Equal(t, []strfmt.UUID{ids[3], ids[6], ids[4]},
+			multiSearch(searchparams.TargetVectorCombinationSum))
+		assert.Equal(t, []strfmt.UUID{ids[6], ids[3], ids[4]},
+			multiSearch(searchparams.TargetVectorCombinationMin))
+	})
+
+	t.Run("the default vector is still searched without a target", func(t *testing.T) {
+		found := mustSearch(repo, "", []float32{1, 2, 3})
+		assert.Len(t, found, 3)
+	})
+
+	t.Run("unknown target vector", func(t *testing.T) {
+		_, err := search(repo, "other_vec", []float32{3.2, 0})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), `target vector "other_vec" does not exist`)
+	})
+
+	t.Run("objects with unknown target vectors are rejected", func(t *testing.T) {
+		err := repo.PutObject(ctx, &models.Object{
+			ID:      strfmt.UUID("8c0e4b6a-2f1d-4e3a-9b7c-000000000099"),
+			Class:   class.Class,
+			Vectors: models.Vectors{"other_vec": {1, 2}},
+		}, nil, nil)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), `target vector "other_vec" does not exist`)
+	})
+
+	t.Run("the named vectors are returned with the object", func(t *testing.T) {
+		res, err := repo.ObjectByID(ctx, ids[2], nil, additional.Properties{Vector: true})
+		require.Nil(t, err)
+		assert.Equal(t, models.Vectors{
+			"title_vec": {2, 0},
+			"body_vec":  {8, 0, 0},
+		}, res.Vectors)
+	})
+
+	t.Run("merge replaces only the given target vectors", func(t *testing.T) {
+		require.Nil(t, repo.Merge(ctx, objects.MergeDocument{
+			Class:      class.Class,
+			ID:         ids[2],
+			Vectors:    map[string][]float32{"title_vec": {100, 0}},
+			UpdateTime: 1,
+		}, nil))
+
+		assert.Equal(t, ids[2], mustSearch(repo, "title_vec", []float32{99, 0})[0])
+		assert.Equal(t, []strfmt.UUID{ids[3], ids[4], ids[5]},
+			mustSearch(repo, "title_vec", []float32{3.2, 0}))
+		assert.Equal(t, []strfmt.UUID{ids[2], ids[1], ids[3]},
+			mustSearch(repo, "body_vec", []float32{8, 0, 0}))
+	})
+
+	t.Run("deleted objects are removed from all target vectors", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(ctx, class.Class, ids[4], nil))
+		assert.Equal(t, []strfmt.UUID{ids[3], ids[5], ids[6]},
+			mustSearch(repo, "title_vec", []float32{3.9, 0}))
+		assert.Equal(t, []strfmt.UUID{ids[3], ids[5], ids[2]},
+			mustSearch(repo, "body_vec", []float32{6.2, 0, 0}))
+	})
+
+	t.Run("target vectors are persisted across restarts", func(t *testing.T) {
+		require.Nil(t, repo.Shutdown(ctx))
+		repo = newRepo()
+		defer repo.Shutdown(ctx)
+		assert.Equal(t, []strfmt.UUID{ids[3], ids[5], ids[6]},
+			mustSearch(repo, "title_vec", []float32{3.9, 0}))
+		assert.Equal(t, []strfmt.UUID{ids[3], ids[5], ids[2]},
+			mustSearch(repo, "body_vec", []float32{6.2, 0, 0}))
+	})
+}
@@ -68,9 +68,13 @@ func (db *DB) localNodeStatus() *models.NodeStatus {
 		for shardName, shard := range index.Shards {
 			objectCount := int64(shard.objectCount())
 			shardStatus := &models.NodeShardStatus{
-				Name:        shardName,
-				Class:       shard.index.Config.ClassName.String(),
-				ObjectCount: objectCount,
+				Name:                 shardName,
+				Class:                shard.index.Config.ClassName.String(),
+				ObjectCount:          objectCount,
+				Properties:           shard.propertyStats(),
+				Consistency:          db.consistencyReport(shard.index.Config.ClassName.String(), shardName),
+				VectorQueueLength:    shard.vectorQueueLength(),
+				VectorIndexingStatus: shard.vectorIndexingStatus(),
 			}
 			totalObjectCount += objectCount
 			shardCount++
@@ -16,10 +16,12 @@ package db
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"testing"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -134,6 +136,83 @@ func TestNodesAPI_Journey(t *testing.T) {
 	assert.Equal(t, "ClassNodesAPI", nodeStatus.Shards[0].Class)
 	assert.True(t, len(nodeStatus.Shards[0].Name) > 0)
 	assert.Equal(t, int64(2), nodeStatus.Shards[0].ObjectCount)
+	assert.Equal(t, []*models.NodePropertyStats{
+		{Name: "stringProp", ObjectCount: 2, NullCount: 0},
+	}, nodeStatus.Shards[0].Properties)
 	assert.Equal(t, int64(2), nodeStatus.Stats.ObjectCount)
 	assert.Equal(t, int64(1), nodeStatus.Stats.ShardCount)
 }
+
+func TestNodesAPI_PropertyStats(t *testing.T) {
+	dirName := t.TempDir()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+
+	nullState := invertedConfig()
+	nullState.IndexPropertyLength = false
+	propertyLength := invertedConfig()
+	propertyLength.IndexNullState = false
+	noIndex := invertedConfig()
+	noIndex.IndexNullState = false
+	noIndex.IndexPropertyLength = false
+
+	classes := []*models.Class{}
+	for name, cfg := range map[string]*models.InvertedIndexConfig{
+		"NullState":      nullState,
+		"PropertyLength": propertyLength,
+		"NoIndex":        noIndex,
+	} {
+		class := &models.Class{
+			Class:               name,
+			VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+			InvertedIndexConfig: cfg,
+			Properties: []*models.Property{
+				{
+					Name:         "textProp",
+					DataType:     []string{string(schema.DataTypeText)},
+					Tokenization: "word",
+				},
+			},
+		}
+		classes = append(classes, class)
+		schemaGetter.schema.Objects = &models.Schema{Classes: classes}
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+		for i, props := range []map[string]interface{}{
+			{"textProp": "some text"},
+			{"textProp": ""},
+			{},
+		} {
+			id := strfmt.UUID(fmt.Sprintf("8d5a3aa2-3c8d-4589-9ae1-3f638f50697%d", i))
+			require.Nil(t, repo.PutObject(context.Background(),
+				&models.Object{Class: name, ID: id, Properties: props}, []float32{1, 2, 3}, nil))
+		}
+	}
+
+	nodeStatuses, err := repo.GetNodeStatuses(context.Background())
+	require.Nil(t, err)
+	require.Len(t, nodeStatuses, 1)
+
+	stats := map[string][]*models.NodePropertyStats{}
+	for _, shard := range nodeStatuses[0].Shards {
+		stats[shard.Class] = shard.Properties
+	}
+	expected := []*models.NodePropertyStats{
+		{Name: "textProp", ObjectCount: 1, NullCount: 2},
+	}
+	assert.Equal(t, expected, stats["NullState"])
+	assert.Equal(t, expected, stats["PropertyLength"])
+	assert.Empty(t, stats["NoIndex"])
+}
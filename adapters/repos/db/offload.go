@@ -0,0 +1,372 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storagestate"
+)
+
+// offloadMarkerSuffix marks an offloaded class in the root path. The marker
+// <index id>.offloaded holds the manifest of the files in object storage.
+const offloadMarkerSuffix = ".offloaded"
+
+// offloadManifest lists the files of an offloaded class relative to the
+// root path, they are stored under the same names below the offload prefix
+type offloadManifest struct {
+	Files       []string  `json:"files"`
+	OffloadedAt time.Time `json:"offloadedAt"`
+}
+
+func (d *DB) offloadMarkerPath(id string) string {
+	return filepath.Join(d.config.RootPath, id+offloadMarkerSuffix)
+}
+
+func (d *DB) offloadPrefix(id string) string {
+	return path.Join(d.schemaGetter.NodeName(), "offloaded", id)
+}
+
+// isOffloadedOnDisk is true if the class was offloaded before the startup
+func (d *DB) isOffloadedOnDisk(className string) bool {
+	_, err := os.Stat(d.offloadMarkerPath(indexID(schema.ClassName(className))))
+	return err == nil
+}
+
+// OffloadIndex shuts the index of the class down, uploads the files of its
+// local shards to the offload storage and removes them locally. The class is
+// downloaded and loaded again on its next access or by ActivateIndex.
+func (d *DB) OffloadIndex(ctx context.Context, className schema.ClassName) error {
+	if d.config.OffloadStorage == nil {
+		return errors.New("offloading requires an offload storage, " +
+			"see PERSISTENCE_OFFLOAD_BUCKET")
+	}
+
+	id := indexID(className)
+	if d.isOffloaded(className) {
+		return errors.Errorf("index %s is offloaded already", id)
+	}
+	// make sure a lazy index is loaded, so its files are uploaded
+	d.GetIndex(className)
+
+	lazy := &lazyIndex{className: className.String()}
+	lazy.offloaded.Store(true)
+	// accesses during the upload wait for it and activate the class again
+	lazy.Lock()
+	defer lazy.Unlock()
+
+	d.indexLock.Lock()
+	index, ok := d.indices[id]
+	if !ok {
+		d.indexLock.Unlock()
+		return errors.Errorf("index %s does not exist", id)
+	}
+	// writes are blocked by the lock for the rest of the offload, so no backup
+	// or optimization can start
+	index.backupStateLock.Lock()
+	defer index.backupStateLock.Unlock()
+	if index.backupState.InProgress {
+		d.indexLock.Unlock()
+		return errors.Errorf("cannot offload while backup %q is in progress, "+
+			"try again later", index.backupState.BackupID)
+	}
+	if index.optimizing {
+		d.indexLock.Unlock()
+		return errors.Errorf("cannot offload while index %s is optimized, try again later", id)
+	}
+	if index.reindexing {
+		d.indexLock.Unlock()
+		return errors.Errorf("cannot offload while the vector index of %s is rebuilt, try again later", id)
+	}
+	delete(d.indices, id)
+	d.lazyIndexes[id] = lazy
+	d.indexLock.Unlock()
+
+	before := time.Now()
+	entries, files, err := index.offload(ctx, d.config.OffloadStorage, d.offloadPrefix(id))
+	if err == nil {
+		err = d.writeOffloadManifest(ctx, id, files)
+	}
+	if err != nil {
+		// the local files are only removed once all of them are uploaded, so
+		// the index can be loaded from them again
+		lazy.offloaded.Store(false)
+		sch := d.schemaGetter.GetSchemaSkipAuth()
+		if class := sch.GetClass(className); class != nil {
+			if _, initErr := d.initIndex(ctx, class); initErr != nil {
+				return errors.Wrapf(err, "offload index %s, reload failed: %v", id, initErr)
+			}
+		}
+		return errors.Wrapf(err, "offload index %s", id)
+	}
+
+	for _, entry := range entries {
+		if err := os.RemoveAll(filepath.Join(d.config.RootPath, entry)); err != nil {
+			return errors.Wrapf(err, "remove offloaded %s", entry)
+		}
+	}
+
+	d.logger.WithField("action", "offload_index").
+		WithField("class", className).
+		WithField("files", len(files)).
+		WithField("took", time.Since(before)).
+		Info("offloaded index")
+	return nil
+}
+
+// ActivateIndex downloads the files of an offloaded class and loads its
+// index. Offloaded classes are also activated on their first access.
+func (d *DB) ActivateIndex(ctx context.Context, className schema.ClassName) error {
+	id := indexID(className)
+
+	d.indexLock.RLock()
+	lazy := d.lazyIndexes[id]
+	d.indexLock.RUnlock()
+	if lazy == nil || !lazy.offloaded.Load() {
+		return errors.Errorf("index %s is not offloaded", id)
+	}
+
+	index, err := d.loadLazyIndexContext(ctx, id, lazy)
+	if err != nil {
+		return errors.Wrapf(err, "activate index %s", id)
+	}
+	if index == nil {
+		return errors.Errorf("activate index %s: class does not exist", id)
+	}
+	return nil
+}
+
+// OffloadedIndexes returns the names of the offloaded classes
+func (d *DB) OffloadedIndexes() []string {
+	d.indexLock.RLock()
+	defer d.indexLock.RUnlock()
+
+	var classes []string
+	for _, lazy := range d.lazyIndexes {
+		if lazy.offloaded.Load() {
+			classes = append(classes, lazy.className)
+		}
+	}
+	sort.Strings(classes)
+	return classes
+}
+
+func (d *DB) isOffloaded(className schema.ClassName) bool {
+	d.indexLock.RLock()
+	defer d.indexLock.RUnlock()
+
+	lazy := d.lazyIndexes[indexID(className)]
+	return lazy != nil && lazy.offloaded.Load()
+}
+
+// offloadedShardsStatus reports the local shards of an offloaded class
+// without activating it
+func (d *DB) offloadedShardsStatus(className string) map[string]string {
+	status := map[string]string{}
+	shardState := d.schemaGetter.ShardingState(className)
+	if shardState == nil {
+		return status
+	}
+	for _, name := range shardState.AllPhysicalShards() {
+		if shardState.IsShardLocal(name) {
+			status[name] = storagestate.StatusOffloaded.String()
+		}
+	}
+	return status
+}
+
+// writeOffloadManifest stores the manifest in object storage, too, so the
+// files can be found without the local marker
+func (d *DB) writeOffloadManifest(ctx context.Context, id string, files []string) error {
+	manifest, err := json.Marshal(offloadManifest{
+		Files:       files,
+		OffloadedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+
+	marker := d.offloadMarkerPath(id)
+	tmp := marker + ".tmp"
+	if err := os.WriteFile(tmp, manifest, 0o666); err != nil {
+		return errors.Wrap(err, "write offload marker")
+	}
+	if err := d.config.OffloadStorage.Upload(ctx,
+		path.Join(d.offloadPrefix(id), "manifest.json"), tmp); err != nil {
+		os.Remove(tmp)
+		return errors.Wrap(err, "upload offload manifest")
+	}
+	if err := os.Rename(tmp, marker); err != nil {
+		return errors.Wrap(err, "write offload marker")
+	}
+	return nil
+}
+
+func (d *DB) readOffloadManifest(id string) (offloadManifest, error) {
+	var manifest offloadManifest
+	content, err := os.ReadFile(d.offloadMarkerPath(id))
+	if err != nil {
+		return manifest, errors.Wrap(err, "read offload marker")
+	}
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return manifest, errors.Wrap(err, "parse offload marker")
+	}
+	return manifest, nil
+}
+
+// downloadOffloaded restores the local files of an offloaded class and
+// removes its marker. Partially downloaded files are overwritten when the
+// download is retried.
+func (d *DB) downloadOffloaded(ctx context.Context, id string) (offloadManifest, error) {
+	if d.config.OffloadStorage == nil {
+		return offloadManifest{}, errors.New("the class is offloaded, but no offload " +
+			"storage is configured, see PERSISTENCE_OFFLOAD_BUCKET")
+	}
+
+	manifest, err := d.readOffloadManifest(id)
+	if err != nil {
+		return manifest, err
+	}
+
+	prefix := d.offloadPrefix(id)
+	for _, file := range manifest.Files {
+		target := filepath.Join(d.config.RootPath, file)
+		if err := os.MkdirAll(filepath.Dir(target), os.ModePerm); err != nil {
+			return manifest, errors.Wrapf(err, "create folder for %s", file)
+		}
+		if err := d.config.OffloadStorage.Download(ctx, path.Join(prefix, file), target); err != nil {
+			return manifest, errors.Wrapf(err, "download %s", file)
+		}
+	}
+
+	if err := os.Remove(d.offloadMarkerPath(id)); err != nil {
+		return manifest, errors.Wrap(err, "remove offload marker")
+	}
+	return manifest, nil
+}
+
+// deleteOffloaded removes the objects of a class from object storage once
+// it is active again or dropped. Failures leave orphaned objects behind, but
+// don't affect the class, so they are only logged.
+func (d *DB) deleteOffloaded(ctx context.Context, id string, manifest offloadManifest) {
+	prefix := d.offloadPrefix(id)
+	for _, key := range append(manifest.Files[:len(manifest.Files):len(manifest.Files)],
+		"manifest.json") {
+		if err := d.config.OffloadStorage.Delete(ctx, path.Join(prefix, key)); err != nil {
+			d.logger.WithField("action", "offload_index_cleanup").
+				WithField("index", id).
+				WithError(err).
+				Warnf("could not delete offloaded file %s", key)
+		}
+	}
+}
+
+// dropOffloadedIndex deletes an offloaded class without activating it first,
+// it returns false if the class is not offloaded
+func (d *DB) dropOffloadedIndex(ctx context.Context, className schema.ClassName) (bool, error) {
+	id := indexID(className)
+
+	d.indexLock.RLock()
+	lazy := d.lazyIndexes[id]
+	d.indexLock.RUnlock()
+	if lazy == nil {
+		return false, nil
+	}
+
+	lazy.Lock()
+	defer lazy.Unlock()
+	if !lazy.offloaded.Load() {
+		return false, nil
+	}
+
+	manifest, err := d.readOffloadManifest(id)
+	if err != nil {
+		return true, err
+	}
+	if d.config.OffloadStorage != nil {
+		d.deleteOffloaded(ctx, id, manifest)
+	}
+	if err := os.Remove(d.offloadMarkerPath(id)); err != nil {
+		return true, errors.Wrap(err, "remove offload marker")
+	}
+
+	d.indexLock.Lock()
+	delete(d.lazyIndexes, id)
+	d.indexLock.Unlock()
+	return true, nil
+}
+
+// offload shuts the local shards down and uploads their files. It returns
+// the entries of the shards in the root path, which can be removed once the
+// upload is complete, and the uploaded files. The caller holds the
+// backupStateLock.
+func (i *Index) offload(ctx context.Context, storage lsmkv.RemoteStorage,
+	prefix string,
+) ([]string, []string, error) {
+	root := i.Config.RootPath
+	rootEntries, err := os.ReadDir(root)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read root path: %w", err)
+	}
+
+	var entries, files []string
+	for _, shard := range i.Shards {
+		shard.replicationMap.clear()
+		if err := shard.shutdown(ctx); err != nil {
+			return nil, nil, fmt.Errorf("shut down shard %s: %w", shard.ID(), err)
+		}
+		if i.Config.TrackVectorDimensions && shard.promMetrics != nil {
+			shard.sendVectorDimensionsMetric(0)
+		}
+
+		for _, entry := range rootEntries {
+			if !belongsToShard(entry.Name(), shard.ID()) {
+				continue
+			}
+			entries = append(entries, entry.Name())
+			err := filepath.Walk(filepath.Join(root, entry.Name()),
+				func(path string, info os.FileInfo, err error) error {
+					if err != nil || info.IsDir() {
+						return err
+					}
+					rel, err := filepath.Rel(root, path)
+					if err != nil {
+						return err
+					}
+					files = append(files, filepath.ToSlash(rel))
+					return nil
+				})
+			if err != nil {
+				return nil, nil, fmt.Errorf("list files of shard %s: %w", shard.ID(), err)
+			}
+		}
+	}
+
+	for _, file := range files {
+		if err := storage.Upload(ctx, path.Join(prefix, file),
+			filepath.Join(root, filepath.FromSlash(file))); err != nil {
+			return nil, nil, fmt.Errorf("upload %s: %w", file, err)
+		}
+	}
+
+	return entries, files, nil
+}
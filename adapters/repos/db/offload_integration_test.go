@@ -0,0 +1,195 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+type fakeOffloadStorage struct {
+	sync.Mutex
+	objects map[string][]byte
+}
+
+func (f *fakeOffloadStorage) Upload(ctx context.Context, key, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	f.Lock()
+	defer f.Unlock()
+	f.objects[key] = data
+	return nil
+}
+
+func (f *fakeOffloadStorage) Download(ctx context.Context, key, path string) error {
+	f.Lock()
+	defer f.Unlock()
+	return os.WriteFile(path, f.objects[key], 0o644)
+}
+
+func (f *fakeOffloadStorage) ReadAt(ctx context.Context, key string, p []byte, offset int64) error {
+	f.Lock()
+	defer f.Unlock()
+	copy(p, f.objects[key][offset:])
+	return nil
+}
+
+func (f *fakeOffloadStorage) Delete(ctx context.Context, key string) error {
+	f.Lock()
+	defer f.Unlock()
+	delete(f.objects, key)
+	return nil
+}
+
+func (f *fakeOffloadStorage) count() int {
+	f.Lock()
+	defer f.Unlock()
+	return len(f.objects)
+}
+
+func TestOffloadIndex(t *testing.T) {
+	dirName := t.TempDir()
+	storage := &fakeOffloadStorage{objects: map[string][]byte{}}
+
+	class := &models.Class{
+		Class:               "TestClass",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "name",
+				DataType: []string{"text"},
+			},
+		},
+	}
+	shardState := singleShardState()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState, schema: schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}}
+	newRepo := func(t *testing.T) *DB {
+		repo, err := New(logger, Config{
+			MemtablesFlushIdleAfter:   60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+			OffloadStorage:            storage,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+	localFiles := func(t *testing.T) []string {
+		entries, err := os.ReadDir(dirName)
+		require.Nil(t, err)
+		var files []string
+		for _, entry := range entries {
+			if strings.HasPrefix(entry.Name(), "testclass_") {
+				files = append(files, entry.Name())
+			}
+		}
+		return files
+	}
+
+	repo := newRepo(t)
+	migrator := NewMigrator(repo, logger)
+	id := strfmt.UUID("a0b55b05-bc5b-4cc9-b646-1452d1390a62")
+	ctx := context.Background()
+
+	t.Run("add class with an object", func(t *testing.T) {
+		require.Nil(t, migrator.AddClass(ctx, class, shardState))
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			ID:         id,
+			Class:      class.Class,
+			Properties: map[string]interface{}{"name": "offloaded"},
+		}, []float32{1, 2, 3}, nil))
+	})
+
+	t.Run("offload class", func(t *testing.T) {
+		require.Nil(t, repo.OffloadIndex(ctx, schema.ClassName(class.Class)))
+
+		assert.Empty(t, localFiles(t))
+		assert.NotZero(t, storage.count())
+		assert.Equal(t, []string{class.Class}, repo.OffloadedIndexes())
+
+		status, err := migrator.GetShardsStatus(ctx, class.Class)
+		require.Nil(t, err)
+		for _, s := range status {
+			assert.Equal(t, storagestate.StatusOffloaded.String(), s)
+		}
+
+		assert.NotNil(t, repo.OffloadIndex(ctx, schema.ClassName(class.Class)),
+			"already offloaded")
+	})
+
+	t.Run("class stays offloaded after a restart", func(t *testing.T) {
+		require.Nil(t, repo.Shutdown(ctx))
+		repo = newRepo(t)
+		migrator = NewMigrator(repo, logger)
+
+		assert.Empty(t, localFiles(t))
+		assert.Equal(t, []string{class.Class}, repo.OffloadedIndexes())
+	})
+
+	t.Run("class is activated on its next access", func(t *testing.T) {
+		ok, err := repo.Exists(ctx, class.Class, id, nil)
+		require.Nil(t, err)
+		assert.True(t, ok)
+
+		assert.NotEmpty(t, localFiles(t))
+		assert.Empty(t, repo.OffloadedIndexes())
+		assert.Zero(t, storage.count())
+	})
+
+	t.Run("activate explicitly", func(t *testing.T) {
+		require.Nil(t, repo.OffloadIndex(ctx, schema.ClassName(class.Class)))
+		require.Nil(t, repo.ActivateIndex(ctx, schema.ClassName(class.Class)))
+		assert.NotNil(t, repo.ActivateIndex(ctx, schema.ClassName(class.Class)),
+			"not offloaded anymore")
+
+		ok, err := repo.Exists(ctx, class.Class, id, nil)
+		require.Nil(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("delete offloaded class", func(t *testing.T) {
+		require.Nil(t, repo.OffloadIndex(ctx, schema.ClassName(class.Class)))
+		require.Nil(t, migrator.DropClass(ctx, class.Class))
+
+		assert.Empty(t, localFiles(t))
+		assert.Empty(t, repo.OffloadedIndexes())
+		assert.Zero(t, storage.count())
+		_, err := os.Stat(repo.offloadMarkerPath(indexID(schema.ClassName(class.Class))))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	require.Nil(t, repo.Shutdown(ctx))
+}
@@ -0,0 +1,148 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storagestate"
+)
+
+// optimize optimizes all replicas of all shards of the index one after the
+// other, so the additional disk space needed by compactions stays low. A
+// replica is optimized by the node which holds it.
+func (i *Index) optimize(ctx context.Context) (*models.ClassOptimizeResult, error) {
+	shardState := i.getSchema.ShardingState(i.Config.ClassName.String())
+	if shardState == nil {
+		return nil, errors.Errorf("no sharding state for class %q", i.Config.ClassName)
+	}
+	thisNode := i.getSchema.NodeName()
+
+	result := &models.ClassOptimizeResult{
+		Class:  i.Config.ClassName.String(),
+		Shards: []*models.ShardOptimizeResult{},
+	}
+	for _, shardName := range shardState.AllPhysicalShards() {
+		for _, node := range shardState.Physical[shardName].BelongsToNodes {
+			var (
+				res *models.ShardOptimizeResult
+				err error
+			)
+			if node == thisNode {
+				res, err = i.IncomingOptimizeShard(ctx, shardName)
+			} else {
+				res, err = i.remote.OptimizeShard(ctx, shardName, node)
+			}
+			if err != nil {
+				return nil, errors.Wrapf(err, "optimize shard %q on node %q", shardName, node)
+			}
+
+			result.Shards = append(result.Shards, res)
+			result.ReclaimedBytes += res.ReclaimedBytes
+		}
+	}
+
+	return result, nil
+}
+
+// IncomingOptimizeShard optimizes the local replica of a shard. It fails if a
+// backup of the index is in progress, as the backup relies on compactions
+// being paused.
+func (i *Index) IncomingOptimizeShard(ctx context.Context,
+	shardName string,
+) (*models.ShardOptimizeResult, error) {
+	if err := i.beginOptimize(); err != nil {
+		return nil, err
+	}
+	defer i.endOptimize()
+
+	shard, ok := i.Shards[shardName]
+	if !ok {
+		return nil, errors.Errorf("shard %q does not exist locally", shardName)
+	}
+
+	return shard.optimize(ctx)
+}
+
+func (i *Index) beginOptimize() error {
+	i.backupStateLock.Lock()
+	defer i.backupStateLock.Unlock()
+
+	if i.backupState.InProgress {
+		return errors.Errorf("cannot optimize while backup %q is in progress, "+
+			"try again later", i.backupState.BackupID)
+	}
+	if i.optimizing {
+		return errors.Errorf("optimization of index %q already in progress",
+			i.Config.ClassName)
+	}
+	if i.reindexing {
+		return errors.Errorf("cannot optimize while the vector index of %q is "+
+			"rebuilt, try again later", i.Config.ClassName)
+	}
+
+	i.optimizing = true
+	return nil
+}
+
+func (i *Index) endOptimize() {
+	i.backupStateLock.Lock()
+	defer i.backupStateLock.Unlock()
+
+	i.optimizing = false
+}
+
+// optimize compacts all buckets of the shard into single segments, removes
+// the tombstoned nodes of the vector index and condenses its commit logs. The
+// result contains the size of the shard on disk before and after.
+func (s *Shard) optimize(ctx context.Context) (*models.ShardOptimizeResult, error) {
+	if s.isReadOnly() {
+		return nil, storagestate.ErrStatusReadOnly
+	}
+
+	before, err := s.diskSize()
+	if err != nil {
+		return nil, errors.Wrap(err, "size before optimization")
+	}
+
+	compactions, err := s.store.CompactAll(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "compact buckets")
+	}
+
+	if err := s.getVectorIndex().Optimize(ctx); err != nil {
+		return nil, errors.Wrap(err, "optimize vector index")
+	}
+
+	after, err := s.diskSize()
+	if err != nil {
+		return nil, errors.Wrap(err, "size after optimization")
+	}
+
+	s.index.logger.WithField("action", "optimize_shard").
+		WithField("class", s.index.Config.ClassName).
+		WithField("shard", s.name).
+		WithField("compactions", compactions).
+		WithField("reclaimed_bytes", before-after).
+		Info("optimized shard")
+
+	return &models.ShardOptimizeResult{
+		Name:           s.name,
+		Node:           s.index.getSchema.NodeName(),
+		BytesBefore:    before,
+		BytesAfter:     after,
+		ReclaimedBytes: before - after,
+		Compactions:    int64(compactions),
+	}, nil
+}
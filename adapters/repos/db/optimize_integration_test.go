@@ -0,0 +1,137 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestOptimizeClass(t *testing.T) {
+	dirName := t.TempDir()
+
+	class := &models.Class{
+		Class:               "OptimizeClass",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "name",
+				DataType: []string{"text"},
+			},
+		},
+	}
+	shardState := singleShardState()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState, schema: schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, shardState))
+
+	ids := make([]strfmt.UUID, 100)
+	for i := range ids {
+		ids[i] = strfmt.UUID(fmt.Sprintf("a0b55b05-bc5b-4cc9-b646-%012d", i))
+	}
+
+	t.Run("import and delete most objects", func(t *testing.T) {
+		for i, id := range ids {
+			require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+				ID:         id,
+				Class:      class.Class,
+				Properties: map[string]interface{}{"name": fmt.Sprintf("object %d", i)},
+			}, []float32{float32(i), 1, 2}, nil))
+		}
+		for _, id := range ids[10:] {
+			require.Nil(t, repo.DeleteObject(context.Background(), class.Class, id, nil))
+		}
+	})
+
+	t.Run("optimize class", func(t *testing.T) {
+		res, err := migrator.OptimizeClass(context.Background(), class.Class)
+		require.Nil(t, err)
+		assert.Equal(t, class.Class, res.Class)
+		require.Len(t, res.Shards, 1)
+		assert.Equal(t, "node1", res.Shards[0].Node)
+		assert.Equal(t, res.Shards[0].BytesBefore-res.Shards[0].BytesAfter,
+			res.Shards[0].ReclaimedBytes)
+		assert.Equal(t, res.Shards[0].ReclaimedBytes, res.ReclaimedBytes)
+
+		index := repo.GetIndex(schema.ClassName(class.Class))
+		for _, shard := range index.Shards {
+			files, err := shard.store.Bucket(helpers.ObjectsBucketLSM).ListFiles(context.Background())
+			require.Nil(t, err)
+			segments := 0
+			for _, file := range files {
+				if filepath.Ext(file) == ".db" {
+					segments++
+				}
+			}
+			assert.Equal(t, 1, segments)
+		}
+	})
+
+	t.Run("remaining objects are still found", func(t *testing.T) {
+		for i, id := range ids {
+			obj, err := repo.Object(context.Background(), class.Class, id,
+				search.SelectProperties{}, additional.Properties{}, nil)
+			require.Nil(t, err)
+			if i < 10 {
+				require.NotNil(t, obj, "object %d was lost", i)
+			} else {
+				assert.Nil(t, obj, "object %d was resurrected", i)
+			}
+		}
+
+		res, err := repo.VectorSearch(context.Background(), []float32{1, 1, 2}, 0, 100, nil)
+		require.Nil(t, err)
+		assert.Len(t, res, 10)
+	})
+
+	t.Run("optimize is rejected during a backup", func(t *testing.T) {
+		index := repo.GetIndex(schema.ClassName(class.Class))
+		require.Nil(t, index.initBackup("backup-1"))
+		defer index.resetBackupState()
+
+		_, err := migrator.OptimizeClass(context.Background(), class.Class)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "backup")
+	})
+}
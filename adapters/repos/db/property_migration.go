@@ -0,0 +1,724 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"golang.org/x/sync/errgroup"
+)
+
+// prepareMigration rewrites all replicas of all shards of the index for a
+// schema migration, see Shard.prepareMigration. A replica is rewritten by the
+// node which holds it. The nodes rewrite their replicas concurrently, each
+// node one after the other, as an index is only optimized once at a time.
+func (i *Index) prepareMigration(ctx context.Context,
+	migration *models.SchemaMigration,
+) error {
+	shardState := i.getSchema.ShardingState(i.Config.ClassName.String())
+	if shardState == nil {
+		return errors.Errorf("no sharding state for class %q", i.Config.ClassName)
+	}
+	thisNode := i.getSchema.NodeName()
+
+	byNode := map[string][]string{}
+	for _, shardName := range shardState.AllPhysicalShards() {
+		for _, node := range shardState.Physical[shardName].BelongsToNodes {
+			byNode[node] = append(byNode[node], shardName)
+		}
+	}
+
+	eg := errgroup.Group{}
+	for node, shardNames := range byNode {
+		node, shardNames := node, shardNames
+		eg.Go(func() error {
+			for _, shardName := range shardNames {
+				var err error
+				if node == thisNode {
+					err = i.IncomingPrepareMigrationShard(ctx, shardName, migration)
+				} else {
+					err = i.remote.PrepareMigrationShard(ctx, shardName, node, migration)
+				}
+				if err != nil {
+					return errors.Wrapf(err, "migrate shard %q on node %q", shardName, node)
+				}
+			}
+			return nil
+		})
+	}
+
+	return eg.Wait()
+}
+
+// IncomingPrepareMigrationShard rewrites the local replica of a shard for a
+// schema migration. Like an optimization, it fails if a backup of the index
+// is in progress.
+func (i *Index) IncomingPrepareMigrationShard(ctx context.Context,
+	shardName string, migration *models.SchemaMigration,
+) error {
+	if err := i.beginOptimize(); err != nil {
+		return err
+	}
+	defer i.endOptimize()
+
+	shard, ok := i.Shards[shardName]
+	if !ok {
+		return errors.Errorf("shard %q does not exist locally", shardName)
+	}
+
+	return shard.prepareMigration(ctx, migration)
+}
+
+// commitMigration swaps the rewritten buckets into all local shards, see
+// Shard.commitMigration
+func (i *Index) commitMigration(ctx context.Context,
+	migration *models.SchemaMigration,
+) error {
+	for name, shard := range i.Shards {
+		if err := shard.commitMigration(ctx, migration); err != nil {
+			return errors.Wrapf(err, "commit migration of shard %q", name)
+		}
+	}
+
+	return nil
+}
+
+// abortMigration drops the rewritten buckets of all local shards, see
+// Shard.abortMigration
+func (i *Index) abortMigration(ctx context.Context,
+	migration *models.SchemaMigration,
+) error {
+	for name, shard := range i.Shards {
+		if err := shard.abortMigration(ctx, migration); err != nil {
+			return errors.Wrapf(err, "abort migration of shard %q", name)
+		}
+	}
+
+	return nil
+}
+
+// migrationPageSize is the number of keys a rewrite reads with one cursor.
+// The cursor is reopened for every page, so that it doesn't hold back the
+// flushes of the bucket while writes continue.
+const migrationPageSize = 1000
+
+// propertyMigration records the keys written while a schema migration
+// rewrites a shard, so that the temp buckets can catch up with them before
+// they are swapped in. For a rename these are the ids of the objects, for a
+// widened property the keys of its inverted index.
+type propertyMigration struct {
+	migration models.SchemaMigration
+
+	sync.Mutex
+	changed map[string]struct{}
+}
+
+func (m *propertyMigration) record(key []byte) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.changed[string(key)] = struct{}{}
+}
+
+// take returns the keys recorded since the last call
+func (m *propertyMigration) take() []string {
+	m.Lock()
+	defer m.Unlock()
+
+	keys := make([]string, 0, len(m.changed))
+	for key := range m.changed {
+		keys = append(keys, key)
+	}
+	m.changed = map[string]struct{}{}
+	return keys
+}
+
+// beginMigrationWrite must be called before the objects bucket or the
+// inverted index are written for the object with the given id. The returned
+// func must be called once the write completed. While a migration swaps its
+// buckets, writes wait here, so buckets must be looked up after this call.
+func (s *Shard) beginMigrationWrite(id []byte) func() {
+	s.migrationLock.RLock()
+	if m := s.migration.Load(); m != nil &&
+		m.migration.Type == models.SchemaMigrationTypeRenameProperty {
+		m.record(id)
+	}
+	return s.migrationLock.RUnlock
+}
+
+// trackMigrationValue records a written key of the inverted index of a
+// property, it is called within beginMigrationWrite
+func (s *Shard) trackMigrationValue(prop string, key []byte) {
+	if m := s.migration.Load(); m != nil &&
+		m.migration.Type == models.SchemaMigrationTypeWidenPropertyType &&
+		m.migration.Property == prop {
+		m.record(key)
+	}
+}
+
+// prepareMigration writes the data of the migrated property into temp
+// buckets next to the ones in use:
+//   - renameProperty rewrites the objects bucket with the property renamed
+//     in the payload of every object. The buckets of the inverted index are
+//     moved to the new name on commit.
+//   - widenPropertyType rewrites the inverted index of the property with the
+//     int keys converted to number keys. The values in the payloads are
+//     stored as numbers already.
+//
+// Writes continue while the buckets are rewritten, the keys they change are
+// caught up on commit. Temp buckets left over from a previous attempt are
+// dropped first.
+func (s *Shard) prepareMigration(ctx context.Context,
+	migration *models.SchemaMigration,
+) error {
+	if s.isReadOnly() {
+		return storagestate.ErrStatusReadOnly
+	}
+	if migration.Type == models.SchemaMigrationTypeRenameProperty &&
+		s.index.Config.RemoteSegments != nil {
+		return errors.New("properties of classes with remote segments can't be renamed")
+	}
+
+	s.stopMigrationTracking()
+	if err := s.dropMigrationBuckets(ctx, migration); err != nil {
+		return err
+	}
+
+	// writes are tracked before the cursors of the rewrite are created, so a
+	// write is either seen by the cursor or caught up on commit
+	m := &propertyMigration{
+		migration: *migration,
+		changed:   map[string]struct{}{},
+	}
+	s.migrationLock.Lock()
+	s.migration.Store(m)
+	s.migrationLock.Unlock()
+
+	var err error
+	switch migration.Type {
+	case models.SchemaMigrationTypeRenameProperty:
+		err = s.rewriteObjectsForRename(ctx, migration.Property, migration.NewName)
+	case models.SchemaMigrationTypeWidenPropertyType:
+		err = s.rewriteInvertedForWiden(ctx, migration.Property)
+	default:
+		err = errors.Errorf("unsupported migration type %q", migration.Type)
+	}
+	if err != nil {
+		s.stopMigrationTracking()
+		return err
+	}
+
+	// catch up with the writes of the rewrite, so that only the writes
+	// since are left when the buckets are swapped
+	return s.catchUpMigration(ctx, m)
+}
+
+// commitMigration catches up with the writes since the preparation and
+// replaces the buckets in use with the temp buckets of the migration. Writes
+// wait for the final catch up and the swap only. If the writes weren't
+// tracked, e.g. because the node was restarted after the preparation, the
+// shard is rewritten again first.
+func (s *Shard) commitMigration(ctx context.Context,
+	migration *models.SchemaMigration,
+) error {
+	if !s.isTrackingMigration(migration) {
+		if err := s.prepareMigration(ctx, migration); err != nil {
+			return errors.Wrap(err, "prepare migration")
+		}
+	} else if err := s.catchUpMigration(ctx, s.migration.Load()); err != nil {
+		return err
+	}
+
+	s.migrationLock.Lock()
+	defer s.migrationLock.Unlock()
+
+	m := s.migration.Load()
+	if m == nil {
+		return errors.New("migration was aborted")
+	}
+	if err := s.catchUpMigration(ctx, m); err != nil {
+		return err
+	}
+
+	for _, name := range s.migrationBuckets(migration) {
+		tempName := helpers.TempBucketFromBucketName(name)
+		if err := s.store.Bucket(tempName).FlushMemtable(ctx); err != nil {
+			return errors.Wrapf(err, "flush temp bucket %q", tempName)
+		}
+		if err := s.store.ReplaceBuckets(ctx, name, tempName); err != nil {
+			return err
+		}
+		if err := s.store.Bucket(name).ResumeCompaction(ctx); err != nil {
+			return errors.Wrapf(err, "resume compaction of bucket %q", name)
+		}
+	}
+
+	if migration.Type == models.SchemaMigrationTypeRenameProperty {
+		if err := s.renamePropertyBuckets(ctx, migration.Property,
+			migration.NewName); err != nil {
+			return err
+		}
+	}
+
+	s.migration.Store(nil)
+	return nil
+}
+
+// abortMigration stops tracking the writes and drops the temp buckets of the
+// migration
+func (s *Shard) abortMigration(ctx context.Context,
+	migration *models.SchemaMigration,
+) error {
+	s.stopMigrationTracking()
+	return s.dropMigrationBuckets(ctx, migration)
+}
+
+func (s *Shard) isTrackingMigration(migration *models.SchemaMigration) bool {
+	m := s.migration.Load()
+	if m == nil || m.migration.ID != migration.ID ||
+		m.migration.Type != migration.Type ||
+		m.migration.Property != migration.Property ||
+		m.migration.NewName != migration.NewName {
+		return false
+	}
+	for _, name := range s.migrationBuckets(migration) {
+		if s.store.Bucket(helpers.TempBucketFromBucketName(name)) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Shard) stopMigrationTracking() {
+	s.migrationLock.Lock()
+	defer s.migrationLock.Unlock()
+
+	s.migration.Store(nil)
+}
+
+// catchUpMigration makes the temp buckets match the buckets in use for all
+// keys written since the last catch up. It only reads the current state of
+// a key, so recording a key too often or a write which failed is harmless.
+func (s *Shard) catchUpMigration(ctx context.Context, m *propertyMigration) error {
+	if m == nil {
+		return errors.New("migration was aborted")
+	}
+
+	keys := m.take()
+	var err error
+	switch m.migration.Type {
+	case models.SchemaMigrationTypeRenameProperty:
+		err = s.catchUpObjectsForRename(ctx, keys, m.migration.Property,
+			m.migration.NewName)
+	case models.SchemaMigrationTypeWidenPropertyType:
+		err = s.catchUpInvertedForWiden(ctx, keys, m.migration.Property)
+	}
+	if err != nil {
+		// the next attempt catches up with them again
+		for _, key := range keys {
+			m.record([]byte(key))
+		}
+	}
+	return err
+}
+
+// migrationBuckets are the buckets which are rewritten into temp buckets by
+// the migration. The inverted index of a property which isn't filterable
+// has no buckets.
+func (s *Shard) migrationBuckets(migration *models.SchemaMigration) []string {
+	switch migration.Type {
+	case models.SchemaMigrationTypeRenameProperty:
+		return []string{helpers.ObjectsBucketLSM}
+	case models.SchemaMigrationTypeWidenPropertyType:
+		if s.store.Bucket(helpers.BucketFromPropNameLSM(migration.Property)) == nil {
+			return nil
+		}
+		return []string{
+			helpers.BucketFromPropNameLSM(migration.Property),
+			helpers.HashBucketFromPropNameLSM(migration.Property),
+		}
+	default:
+		return nil
+	}
+}
+
+func (s *Shard) dropMigrationBuckets(ctx context.Context,
+	migration *models.SchemaMigration,
+) error {
+	for _, name := range s.migrationBuckets(migration) {
+		tempName := helpers.TempBucketFromBucketName(name)
+		if s.store.Bucket(tempName) == nil {
+			continue
+		}
+		if err := s.store.DropBucket(ctx, tempName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createMigrationBucket creates the temp bucket of a bucket. Its compactions
+// are paused until it replaces the bucket.
+func (s *Shard) createMigrationBucket(ctx context.Context, name string,
+	opts ...lsmkv.BucketOption,
+) (*lsmkv.Bucket, error) {
+	tempName := helpers.TempBucketFromBucketName(name)
+	if err := s.store.CreateBucket(ctx, tempName, opts...); err != nil {
+		return nil, errors.Wrapf(err, "create temp bucket %q", tempName)
+	}
+
+	bucket := s.store.Bucket(tempName)
+	if err := bucket.PauseCompaction(ctx); err != nil {
+		return nil, errors.Wrapf(err, "pause compaction of temp bucket %q", tempName)
+	}
+	return bucket, nil
+}
+
+func (s *Shard) rewriteObjectsForRename(ctx context.Context, from, to string) error {
+	tempBucket, err := s.createMigrationBucket(ctx, helpers.ObjectsBucketLSM,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		lsmkv.WithSecondaryIndices(1),
+		lsmkv.WithMonitorCount(),
+		s.dynamicMemtableSizing(),
+		s.memtableIdleConfig(),
+		lsmkv.WithWALRetention(s.index.getInvertedIndexConfig().WALRetention),
+	)
+	if err != nil {
+		return err
+	}
+
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	var after []byte
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		cursor := bucket.Cursor()
+		k, v := cursor.First()
+		if after != nil {
+			if k, v = cursor.Seek(after); bytes.Equal(k, after) {
+				k, v = cursor.Next()
+			}
+		}
+		for n := 0; k != nil && n < migrationPageSize; k, v = cursor.Next() {
+			after = append(after[:0], k...)
+			id := make([]byte, len(k))
+			copy(id, k)
+			if err := s.putRenamedObject(tempBucket, id, v, from, to); err != nil {
+				cursor.Close()
+				return err
+			}
+			n++
+		}
+		cursor.Close()
+
+		if k == nil {
+			return nil
+		}
+	}
+}
+
+func (s *Shard) catchUpObjectsForRename(ctx context.Context, ids []string,
+	from, to string,
+) error {
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	tempBucket := s.store.Bucket(helpers.TempBucketFromBucketName(helpers.ObjectsBucketLSM))
+
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		v, err := bucket.Get([]byte(id))
+		if err != nil {
+			return errors.Wrapf(err, "get object %x", id)
+		}
+		if v == nil {
+			if err := tempBucket.Delete([]byte(id)); err != nil {
+				return errors.Wrapf(err, "delete object %x", id)
+			}
+			continue
+		}
+		if err := s.putRenamedObject(tempBucket, []byte(id), v, from, to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// putRenamedObject writes the object with the property renamed in its
+// payload to the bucket
+func (s *Shard) putRenamedObject(bucket *lsmkv.Bucket, id, v []byte,
+	from, to string,
+) error {
+	obj, err := storobj.FromBinary(v)
+	if err != nil {
+		return errors.Wrapf(err, "unmarshal object %x", id)
+	}
+	if props, ok := obj.Properties().(map[string]interface{}); ok {
+		if value, ok := props[from]; ok {
+			delete(props, from)
+			props[to] = value
+			obj.SetProperties(props)
+		}
+	}
+	data, err := obj.MarshalBinary()
+	if err != nil {
+		return errors.Wrapf(err, "marshal object %x", id)
+	}
+
+	if err := s.upsertObjectDataLSM(bucket, id, data, obj.DocID()); err != nil {
+		return errors.Wrapf(err, "put object %x", id)
+	}
+	return nil
+}
+
+// renamePropertyBuckets moves all buckets of the inverted index of a
+// property and its tracked lengths to the new name
+func (s *Shard) renamePropertyBuckets(ctx context.Context, from, to string) error {
+	names := func(prop string) []string {
+		return []string{
+			helpers.BucketFromPropNameLSM(prop),
+			helpers.HashBucketFromPropNameLSM(prop),
+			helpers.BucketFromPropNameLengthLSM(prop),
+			helpers.HashBucketFromPropNameLengthLSM(prop),
+			helpers.BucketFromPropNameNullLSM(prop),
+			helpers.HashBucketFromPropNameNullLSM(prop),
+			helpers.BucketFromPropNameLSM(helpers.MetaCountProp(prop)),
+			helpers.HashBucketFromPropNameLSM(helpers.MetaCountProp(prop)),
+		}
+	}
+
+	toNames := names(to)
+	for i, name := range names(from) {
+		if s.store.Bucket(name) == nil {
+			continue
+		}
+		if err := s.store.RenameBucket(ctx, name, toNames[i]); err != nil {
+			return err
+		}
+	}
+
+	if err := s.propLengths.CopyProperty(from, to); err != nil {
+		return errors.Wrap(err, "copy property lengths")
+	}
+	return s.propLengths.Flush()
+}
+
+func (s *Shard) rewriteInvertedForWiden(ctx context.Context, prop string) error {
+	bucket := s.store.Bucket(helpers.BucketFromPropNameLSM(prop))
+	if bucket == nil {
+		return nil
+	}
+
+	tempBucket, err := s.createMigrationBucket(ctx, helpers.BucketFromPropNameLSM(prop),
+		lsmkv.WithStrategy(bucket.Strategy()),
+		s.memtableIdleConfig(),
+		s.dynamicMemtableSizing(),
+	)
+	if err != nil {
+		return err
+	}
+	tempHashBucket, err := s.createMigrationBucket(ctx, helpers.HashBucketFromPropNameLSM(prop),
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		s.memtableIdleConfig(),
+		s.dynamicMemtableSizing(),
+	)
+	if err != nil {
+		return err
+	}
+
+	put := func(key []byte, add func(key []byte) error) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		widened, err := widenIntKey(key)
+		if err != nil {
+			return err
+		}
+		if err := add(widened); err != nil {
+			return err
+		}
+		return s.addToPropertyHashBucket(tempHashBucket, widened)
+	}
+
+	var after []byte
+	if bucket.Strategy() == lsmkv.StrategySetCollection {
+		for {
+			cursor := bucket.SetCursor()
+			k, v := cursor.First()
+			if after != nil {
+				if k, v = cursor.Seek(after); bytes.Equal(k, after) {
+					k, v = cursor.Next()
+				}
+			}
+			for n := 0; k != nil && n < migrationPageSize; k, v = cursor.Next() {
+				after = append(after[:0], k...)
+				if err := put(k, func(key []byte) error {
+					return tempBucket.SetAdd(key, v)
+				}); err != nil {
+					cursor.Close()
+					return err
+				}
+				n++
+			}
+			cursor.Close()
+
+			if k == nil {
+				return nil
+			}
+		}
+	}
+
+	for {
+		cursor := bucket.CursorRoaringSet()
+		k, v := cursor.First()
+		if after != nil {
+			if k, v = cursor.Seek(after); bytes.Equal(k, after) {
+				k, v = cursor.Next()
+			}
+		}
+		for n := 0; k != nil && n < migrationPageSize; k, v = cursor.Next() {
+			after = append(after[:0], k...)
+			if err := put(k, func(key []byte) error {
+				return tempBucket.RoaringSetAddBitmap(key, v.Clone())
+			}); err != nil {
+				cursor.Close()
+				return err
+			}
+			n++
+		}
+		cursor.Close()
+
+		if k == nil {
+			return nil
+		}
+	}
+}
+
+// catchUpInvertedForWiden sets the doc ids of the widened keys in the temp
+// bucket to the doc ids of the int keys in use
+func (s *Shard) catchUpInvertedForWiden(ctx context.Context, keys []string,
+	prop string,
+) error {
+	bucket := s.store.Bucket(helpers.BucketFromPropNameLSM(prop))
+	if bucket == nil {
+		return nil
+	}
+	tempBucket := s.store.Bucket(helpers.TempBucketFromBucketName(
+		helpers.BucketFromPropNameLSM(prop)))
+	tempHashBucket := s.store.Bucket(helpers.TempBucketFromBucketName(
+		helpers.HashBucketFromPropNameLSM(prop)))
+
+	for _, key := range keys {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		widened, err := widenIntKey([]byte(key))
+		if err != nil {
+			return err
+		}
+		if bucket.Strategy() == lsmkv.StrategySetCollection {
+			err = catchUpSetKey(bucket, tempBucket, []byte(key), widened)
+		} else {
+			err = catchUpRoaringSetKey(bucket, tempBucket, []byte(key), widened)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "catch up key %x", key)
+		}
+		if err := s.addToPropertyHashBucket(tempHashBucket, widened); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func catchUpSetKey(bucket, tempBucket *lsmkv.Bucket, key, tempKey []byte) error {
+	values, err := bucket.SetList(key)
+	if err != nil {
+		return err
+	}
+	tempValues, err := tempBucket.SetList(tempKey)
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		current[string(v)] = struct{}{}
+	}
+	for _, v := range tempValues {
+		if _, ok := current[string(v)]; ok {
+			delete(current, string(v))
+			continue
+		}
+		if err := tempBucket.SetDeleteSingle(tempKey, v); err != nil {
+			return err
+		}
+	}
+	if len(current) == 0 {
+		return nil
+	}
+
+	missing := make([][]byte, 0, len(current))
+	for v := range current {
+		missing = append(missing, []byte(v))
+	}
+	return tempBucket.SetAdd(tempKey, missing)
+}
+
+func catchUpRoaringSetKey(bucket, tempBucket *lsmkv.Bucket, key, tempKey []byte) error {
+	current, err := bucket.RoaringSetGet(key)
+	if err != nil {
+		return err
+	}
+	temp, err := tempBucket.RoaringSetGet(tempKey)
+	if err != nil {
+		return err
+	}
+
+	stale := temp.Clone()
+	stale.AndNot(current)
+	for _, docID := range stale.ToArray() {
+		if err := tempBucket.RoaringSetRemoveOne(tempKey, docID); err != nil {
+			return err
+		}
+	}
+
+	missing := current.Clone()
+	missing.AndNot(temp)
+	if missing.IsEmpty() {
+		return nil
+	}
+	return tempBucket.RoaringSetAddBitmap(tempKey, missing)
+}
+
+// widenIntKey converts the key of an int value in the inverted index to the
+// key of the same value as a number
+func widenIntKey(key []byte) ([]byte, error) {
+	value, err := inverted.ParseLexicographicallySortableInt64(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse int key")
+	}
+	return inverted.LexicographicallySortableFloat64(float64(value))
+}
@@ -0,0 +1,223 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestPropertyMigrations(t *testing.T) {
+	dirName := t.TempDir()
+	ctx := context.Background()
+
+	class := &models.Class{
+		Class:               "MigratedClass",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:         "name",
+				DataType:     []string{"text"},
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:     "count",
+				DataType: []string{"int"},
+			},
+		},
+	}
+	shardState := singleShardState()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState, schema: schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(ctx, class, shardState))
+
+	ids := make([]strfmt.UUID, 100)
+	for i := range ids {
+		ids[i] = strfmt.UUID(fmt.Sprintf("b0b55b05-bc5b-4cc9-b646-%012d", i))
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			ID:    ids[i],
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"name":  fmt.Sprintf("name%d", i),
+				"count": float64(i),
+			},
+		}, []float32{float32(i), 1, 2}, nil))
+	}
+
+	search := func(t *testing.T, prop string, value interface{},
+		operator filters.Operator, dataType schema.DataType,
+	) []map[string]interface{} {
+		res, err := repo.ClassSearch(ctx, dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 1000},
+			Filters: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: operator,
+				On: &filters.Path{
+					Class:    schema.ClassName(class.Class),
+					Property: schema.PropertyName(prop),
+				},
+				Value: &filters.Value{Value: value, Type: dataType},
+			}},
+		})
+		require.Nil(t, err)
+		props := make([]map[string]interface{}, len(res))
+		for i := range res {
+			props[i] = res[i].Schema.(map[string]interface{})
+		}
+		return props
+	}
+	shard := func() *Shard {
+		for _, shard := range repo.GetIndex(schema.ClassName(class.Class)).Shards {
+			return shard
+		}
+		return nil
+	}
+
+	rename := &models.SchemaMigration{
+		Type:     models.SchemaMigrationTypeRenameProperty,
+		Property: "name",
+		NewName:  "title",
+	}
+
+	t.Run("writes continue until the migration is aborted", func(t *testing.T) {
+		require.Nil(t, migrator.PreparePropertyMigration(ctx, class.Class, rename))
+		assert.Equal(t, storagestate.StatusReady, shard().getStatus())
+
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			ID:    ids[0],
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"name":  "name0",
+				"count": float64(0),
+			},
+		}, []float32{0, 1, 2}, nil))
+
+		require.Nil(t, migrator.AbortPropertyMigration(ctx, class.Class, rename))
+		assert.Nil(t, shard().migration.Load())
+		assert.Len(t, search(t, "name", "name5", filters.OperatorEqual, schema.DataTypeText), 1)
+	})
+
+	t.Run("rename a property", func(t *testing.T) {
+		require.Nil(t, migrator.PreparePropertyMigration(ctx, class.Class, rename))
+
+		// written after the rewrite, caught up on commit
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			ID:    ids[1],
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"name":  "during",
+				"count": float64(1),
+			},
+		}, []float32{1, 1, 2}, nil))
+		require.Nil(t, repo.DeleteObject(ctx, class.Class, ids[2], nil))
+
+		require.Nil(t, migrator.CommitPropertyMigration(ctx, class.Class, rename))
+		class.Properties[0].Name = "title"
+		assert.Equal(t, storagestate.StatusReady, shard().getStatus())
+
+		res := search(t, "title", "name5", filters.OperatorEqual, schema.DataTypeText)
+		require.Len(t, res, 1)
+		assert.Equal(t, "name5", res[0]["title"])
+		assert.NotContains(t, res[0], "name")
+
+		res = search(t, "title", "during", filters.OperatorEqual, schema.DataTypeText)
+		require.Len(t, res, 1)
+		assert.Equal(t, "during", res[0]["title"])
+		assert.Empty(t, search(t, "title", "name2", filters.OperatorEqual, schema.DataTypeText))
+
+		obj, err := repo.ObjectByID(ctx, ids[2], nil, additional.Properties{})
+		require.Nil(t, err)
+		assert.Nil(t, obj)
+
+		mean, err := shard().propLengths.PropertyMean("title")
+		require.Nil(t, err)
+		assert.Equal(t, float32(1), mean)
+	})
+
+	t.Run("widen a property", func(t *testing.T) {
+		widen := &models.SchemaMigration{
+			Type:        models.SchemaMigrationTypeWidenPropertyType,
+			Property:    "count",
+			NewDataType: []string{"number"},
+		}
+		require.Nil(t, migrator.PreparePropertyMigration(ctx, class.Class, widen))
+
+		// moves the object from key 3 to key 150 of the inverted index
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			ID:    ids[3],
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"title": "name3",
+				"count": float64(150),
+			},
+		}, []float32{3, 1, 2}, nil))
+
+		require.Nil(t, migrator.CommitPropertyMigration(ctx, class.Class, widen))
+		class.Properties[1].DataType = []string{"number"}
+
+		res := search(t, "count", 89.5, filters.OperatorGreaterThan, schema.DataTypeNumber)
+		assert.Len(t, res, 11)
+		res = search(t, "count", 5.0, filters.OperatorEqual, schema.DataTypeNumber)
+		require.Len(t, res, 1)
+		assert.Equal(t, "name5", res[0]["title"])
+		assert.Empty(t, search(t, "count", 3.0, filters.OperatorEqual, schema.DataTypeNumber))
+		res = search(t, "count", 150.0, filters.OperatorEqual, schema.DataTypeNumber)
+		require.Len(t, res, 1)
+		assert.Equal(t, "name3", res[0]["title"])
+	})
+
+	t.Run("migrated objects can be written", func(t *testing.T) {
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			ID:    ids[0],
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"title": "changed",
+				"count": 0.5,
+			},
+		}, []float32{0, 1, 2}, nil))
+
+		assert.Len(t, search(t, "title", "changed", filters.OperatorEqual, schema.DataTypeText), 1)
+		assert.Len(t, search(t, "count", 1.0, filters.OperatorLessThan, schema.DataTypeNumber), 1)
+	})
+}
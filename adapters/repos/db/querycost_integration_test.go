@@ -0,0 +1,181 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/querycost"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestQueryCost(t *testing.T) {
+	dirName := t.TempDir()
+
+	class := &models.Class{
+		Class:               "QueryCostClass",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:         "name",
+				DataType:     []string{"text"},
+				Tokenization: "word",
+			},
+		},
+	}
+	shardState := singleShardState()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState, schema: schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, shardState))
+
+	count := 20
+	for i := 0; i < count; i++ {
+		name := "odd object"
+		if i%2 == 0 {
+			name = "even object"
+		}
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			ID:         strfmt.UUID(fmt.Sprintf("6e3a1c9f-3b1e-4d0a-8f2c-%012d", i)),
+			Class:      class.Class,
+			Properties: map[string]interface{}{"name": name},
+		}, []float32{1, float32(i) / 10, 0.5}, nil))
+	}
+
+	evenFilter := &filters.LocalFilter{
+		Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On: &filters.Path{
+				Class:    schema.ClassName(class.Class),
+				Property: "name",
+			},
+			Value: &filters.Value{
+				Value: "even",
+				Type:  schema.DataTypeText,
+			},
+		},
+	}
+
+	t.Run("untracked queries work as before", func(t *testing.T) {
+		res, err := repo.VectorClassSearch(context.Background(), dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: []float32{1, 0, 0.5},
+			Pagination:   &filters.Pagination{Limit: 5},
+		})
+		require.Nil(t, err)
+		assert.Len(t, res, 5)
+	})
+
+	t.Run("vector search", func(t *testing.T) {
+		tracker := querycost.NewTracker()
+		ctx := querycost.NewContext(context.Background(), tracker)
+		res, err := repo.VectorClassSearch(ctx, dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: []float32{1, 0, 0.5},
+			Pagination:   &filters.Pagination{Limit: 5},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 5)
+
+		cost := tracker.Cost()
+		assert.Equal(t, int64(count), cost.VectorsCompared)
+		assert.Equal(t, int64(0), cost.PostingsScanned)
+		assert.Equal(t, int64(5), cost.ObjectsLoaded)
+		assert.Greater(t, cost.BytesRead, int64(0))
+	})
+
+	t.Run("filtered vector search", func(t *testing.T) {
+		tracker := querycost.NewTracker()
+		ctx := querycost.NewContext(context.Background(), tracker)
+		res, err := repo.VectorClassSearch(ctx, dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: []float32{1, 0, 0.5},
+			Pagination:   &filters.Pagination{Limit: 5},
+			Filters:      evenFilter,
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 5)
+
+		cost := tracker.Cost()
+		// the filter matches less objects than the flat search cutoff, so
+		// only the allowed vectors are compared
+		assert.Equal(t, int64(count/2), cost.VectorsCompared)
+		assert.Equal(t, int64(count/2), cost.PostingsScanned)
+		assert.Equal(t, int64(5), cost.ObjectsLoaded)
+	})
+
+	t.Run("filtered object search", func(t *testing.T) {
+		tracker := querycost.NewTracker()
+		ctx := querycost.NewContext(context.Background(), tracker)
+		res, err := repo.ClassSearch(ctx, dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 3},
+			Filters:    evenFilter,
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+
+		cost := tracker.Cost()
+		assert.Equal(t, int64(0), cost.VectorsCompared)
+		assert.Greater(t, cost.PostingsScanned, int64(0))
+		assert.Equal(t, int64(3), cost.ObjectsLoaded)
+	})
+
+	t.Run("keyword search", func(t *testing.T) {
+		tracker := querycost.NewTracker()
+		ctx := querycost.NewContext(context.Background(), tracker)
+		res, err := repo.ClassSearch(ctx, dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 4},
+			KeywordRanking: &searchparams.KeywordRanking{
+				Query:      "odd",
+				Properties: []string{"name"},
+			},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 4)
+
+		cost := tracker.Cost()
+		assert.Equal(t, int64(count/2), cost.PostingsScanned)
+		assert.Equal(t, int64(4), cost.ObjectsLoaded)
+		assert.Greater(t, cost.BytesRead, int64(0))
+	})
+}
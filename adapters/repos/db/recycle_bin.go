@@ -0,0 +1,151 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// trashDir is the folder in the root path which holds the files of deleted
+// classes while they are in the recycle bin
+const trashDir = ".trash"
+
+func (d *DB) trashPath(trashID string) string {
+	return filepath.Join(d.config.RootPath, trashDir, trashID)
+}
+
+// TrashIndex shuts the index of the class down and moves the files of its
+// local shards into the recycle bin, from where they can be restored with
+// RestoreTrashedIndex
+func (d *DB) TrashIndex(className schema.ClassName, trashID string) error {
+	// make sure a lazy index is loaded, so its files are moved
+	d.GetIndex(className)
+
+	d.indexLock.Lock()
+	defer d.indexLock.Unlock()
+
+	id := indexID(className)
+	index, ok := d.indices[id]
+	if !ok {
+		return fmt.Errorf("index %s does not exist", id)
+	}
+
+	if err := index.trash(d.trashPath(trashID)); err != nil {
+		return fmt.Errorf("trash index %s: %w", id, err)
+	}
+	delete(d.indices, id)
+	return nil
+}
+
+// RestoreTrashedIndex moves the files of a deleted class back from the
+// recycle bin. The index is loaded from the files when the class is added
+// again.
+func (d *DB) RestoreTrashedIndex(trashID string) error {
+	dir := d.trashPath(trashID)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// no local shards
+			return nil
+		}
+		return fmt.Errorf("read recycle bin: %w", err)
+	}
+
+	for _, entry := range entries {
+		if _, err := os.Stat(filepath.Join(d.config.RootPath, entry.Name())); err == nil {
+			return fmt.Errorf("cannot restore %s, the file already exists", entry.Name())
+		}
+	}
+	for _, entry := range entries {
+		if err := os.Rename(filepath.Join(dir, entry.Name()),
+			filepath.Join(d.config.RootPath, entry.Name())); err != nil {
+			return fmt.Errorf("restore %s: %w", entry.Name(), err)
+		}
+	}
+
+	return os.RemoveAll(dir)
+}
+
+// DeleteTrashedIndex removes the files of a deleted class from the recycle
+// bin
+func (d *DB) DeleteTrashedIndex(trashID string) error {
+	if err := os.RemoveAll(d.trashPath(trashID)); err != nil {
+		return fmt.Errorf("remove %s from recycle bin: %w", trashID, err)
+	}
+	return nil
+}
+
+func (i *Index) trash(dir string) error {
+	i.backupStateLock.RLock()
+	defer i.backupStateLock.RUnlock()
+
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return fmt.Errorf("create recycle bin folder: %w", err)
+	}
+
+	for _, name := range i.getSchema.ShardingState(i.Config.ClassName.String()).
+		AllPhysicalShards() {
+		shard, ok := i.Shards[name]
+		if !ok {
+			continue
+		}
+		if err := shard.trash(dir); err != nil {
+			return fmt.Errorf("trash shard %s: %w", shard.ID(), err)
+		}
+	}
+
+	return nil
+}
+
+// trash shuts the shard down and moves all of its files into dir. All files
+// of a shard are prefixed with its ID.
+func (s *Shard) trash(dir string) error {
+	s.replicationMap.clear()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+
+	if err := s.shutdown(ctx); err != nil {
+		return fmt.Errorf("shut down: %w", err)
+	}
+	if s.index.Config.TrackVectorDimensions && s.promMetrics != nil {
+		s.sendVectorDimensionsMetric(0)
+	}
+
+	root := s.index.Config.RootPath
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return fmt.Errorf("read root path: %w", err)
+	}
+	for _, entry := range entries {
+		if !belongsToShard(entry.Name(), s.ID()) {
+			continue
+		}
+		if err := os.Rename(filepath.Join(root, entry.Name()),
+			filepath.Join(dir, entry.Name())); err != nil {
+			return fmt.Errorf("move %s: %w", entry.Name(), err)
+		}
+	}
+
+	return nil
+}
+
+func belongsToShard(file, shardID string) bool {
+	return strings.HasPrefix(file, shardID+"_") || strings.HasPrefix(file, shardID+".")
+}
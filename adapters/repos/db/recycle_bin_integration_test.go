@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestRecycleBin_TrashAndRestoreClass(t *testing.T) {
+	dirName := t.TempDir()
+
+	class := &models.Class{
+		Class:               "TestClass",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "name",
+				DataType: []string{"text"},
+			},
+		},
+	}
+	shardState := singleShardState()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState, schema: schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	id := strfmt.UUID("a0b55b05-bc5b-4cc9-b646-1452d1390a62")
+	trashID := "TestClass-1"
+
+	t.Run("add class with an object", func(t *testing.T) {
+		require.Nil(t, migrator.AddClass(context.Background(), class, shardState))
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			ID:         id,
+			Class:      class.Class,
+			Properties: map[string]interface{}{"name": "trashed"},
+		}, []float32{1, 2, 3}, nil))
+	})
+
+	t.Run("trash class", func(t *testing.T) {
+		require.Nil(t, migrator.TrashClass(context.Background(), class.Class, trashID))
+		assert.Nil(t, repo.GetIndex(schema.ClassName(class.Class)))
+
+		entries, err := os.ReadDir(filepath.Join(dirName, trashDir, trashID))
+		require.Nil(t, err)
+		assert.NotEmpty(t, entries)
+
+		entries, err = os.ReadDir(dirName)
+		require.Nil(t, err)
+		for _, entry := range entries {
+			assert.NotContains(t, entry.Name(), "testclass", "file %s wasn't moved", entry.Name())
+		}
+	})
+
+	t.Run("restore class", func(t *testing.T) {
+		require.Nil(t, migrator.RestoreTrashedClass(context.Background(), trashID))
+		require.Nil(t, migrator.AddClass(context.Background(), class, shardState))
+
+		ok, err := repo.Exists(context.Background(), class.Class, id, nil)
+		require.Nil(t, err)
+		assert.True(t, ok)
+
+		_, err = os.Stat(filepath.Join(dirName, trashDir, trashID))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("trash and delete class", func(t *testing.T) {
+		require.Nil(t, migrator.TrashClass(context.Background(), class.Class, trashID))
+		require.Nil(t, migrator.DeleteTrashedClass(context.Background(), trashID))
+
+		_, err = os.Stat(filepath.Join(dirName, trashDir, trashID))
+		assert.True(t, os.IsNotExist(err))
+	})
+}
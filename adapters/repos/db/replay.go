@@ -0,0 +1,364 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"sort"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/replay"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// ReplayClass replays a page of up to limit writes to a class made between
+// from and to (unix milliseconds), starting after the cursor. For every
+// object the latest write within the range is replayed, which is either its
+// latest version or its deletion. The writes are applied to the target class
+// in their order, or returned if there is none.
+//
+// A failing write stops the replay. The result then contains the error and
+// the cursor after the last applied write, so the replay can be resumed.
+func (m *Migrator) ReplayClass(ctx context.Context, className string, from, to int64,
+	after string, limit int, targetClass string,
+) (*models.ClassReplayResult, error) {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, errors.Errorf("cannot replay a non-existing index for %s", className)
+	}
+
+	cursor, err := replay.ParseCursor(after)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := idx.replay(ctx, from, to, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.ClassReplayResult{
+		Class:       className,
+		TargetClass: targetClass,
+		Objects:     []*models.Object{},
+		Deletions:   []*models.ClassReplayDeletion{},
+	}
+	if page.More && len(page.Writes) > 0 {
+		result.Next = page.Writes[len(page.Writes)-1].Cursor().String()
+	}
+
+	if targetClass == "" {
+		for _, w := range page.Writes {
+			if w.Deleted() {
+				result.Deletions = append(result.Deletions,
+					&models.ClassReplayDeletion{ID: w.ID, DeletedAt: w.Time})
+				result.Deleted++
+				continue
+			}
+			result.Objects = append(result.Objects,
+				w.Object.SearchResult(additional.Properties{}).ObjectWithVector(true))
+			result.Replayed++
+		}
+		return result, nil
+	}
+
+	for _, w := range page.Writes {
+		if err := m.replayWrite(ctx, targetClass, w); err != nil {
+			result.Error = err.Error()
+			result.Next = cursor.String()
+			return result, nil
+		}
+		if w.Deleted() {
+			result.Deleted++
+		} else {
+			result.Replayed++
+		}
+		cursor = w.Cursor()
+	}
+	return result, nil
+}
+
+func (m *Migrator) replayWrite(ctx context.Context, targetClass string, w replay.Write) error {
+	if w.Deleted() {
+		if err := m.db.DeleteObject(ctx, targetClass, w.ID, nil); err != nil {
+			return errors.Wrapf(err, "replay deletion of %s", w.ID)
+		}
+		return nil
+	}
+
+	obj := w.Object.Object
+	obj.Class = targetClass
+	obj.Vectors = nil
+	for name, vector := range w.Object.Vectors {
+		if obj.Vectors == nil {
+			obj.Vectors = models.Vectors{}
+		}
+		obj.Vectors[name] = vector
+	}
+	if err := m.db.PutObject(ctx, &obj, w.Object.Vector, nil); err != nil {
+		return errors.Wrapf(err, "replay object %s", w.ID)
+	}
+	return nil
+}
+
+// replay returns a page of the latest writes to the objects of the index
+// within the time range. Every shard is replayed from a single replica,
+// preferably the local one, as the logs of the replicas contain the same
+// writes.
+func (i *Index) replay(ctx context.Context, from, to int64, after replay.Cursor,
+	limit int,
+) (*replay.Page, error) {
+	shardState := i.getSchema.ShardingState(i.Config.ClassName.String())
+	if shardState == nil {
+		return nil, errors.Errorf("no sharding state for class %q", i.Config.ClassName)
+	}
+	thisNode := i.getSchema.NodeName()
+
+	out := &replay.Page{}
+	for _, shardName := range shardState.AllPhysicalShards() {
+		nodes := shardState.Physical[shardName].BelongsToNodes
+		if len(nodes) == 0 {
+			return nil, errors.Errorf("shard %q has no replicas", shardName)
+		}
+
+		var (
+			page *replay.Page
+			err  error
+		)
+		if node := replayNode(nodes, thisNode); node == thisNode {
+			page, err = i.IncomingReplayShard(ctx, shardName, from, to, after, limit)
+		} else {
+			page, err = i.remote.ReplayShard(ctx, shardName, node, from, to, after, limit)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "replay shard %q", shardName)
+		}
+
+		out.Writes = append(out.Writes, page.Writes...)
+		out.More = out.More || page.More
+	}
+
+	sort.Slice(out.Writes, func(a, b int) bool {
+		return out.Writes[a].Cursor().Before(out.Writes[b].Cursor())
+	})
+	if len(out.Writes) > limit {
+		out.Writes = out.Writes[:limit]
+		out.More = true
+	}
+	return out, nil
+}
+
+func replayNode(nodes []string, thisNode string) string {
+	for _, node := range nodes {
+		if node == thisNode {
+			return node
+		}
+	}
+	return nodes[0]
+}
+
+// IncomingReplayShard replays a page of the writes to the local replica of a
+// shard, see Shard.replay
+func (i *Index) IncomingReplayShard(ctx context.Context, shardName string,
+	from, to int64, after replay.Cursor, limit int,
+) (*replay.Page, error) {
+	shard, ok := i.Shards[shardName]
+	if !ok {
+		return nil, errors.Errorf("shard %q does not exist locally", shardName)
+	}
+
+	return shard.replay(ctx, from, to, after, limit)
+}
+
+// replayMark is the latest write to an object within a replayed time range
+type replayMark struct {
+	time    int64
+	deleted bool
+}
+
+// replay returns up to limit of the latest writes to the objects of the shard
+// within the time range which come after the cursor. Object versions are
+// taken from the write-ahead logs of the objects bucket and deletions from
+// the deletions bucket, as the logs don't record the time of a deletion.
+//
+// Only the ids of the written objects are held in memory. The logs are read
+// twice, first to find the writes of the page and then to load their
+// objects, so every page reads all retained logs of the shard.
+func (s *Shard) replay(ctx context.Context, from, to int64, after replay.Cursor,
+	limit int,
+) (*replay.Page, error) {
+	marks := map[string]replayMark{}
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	err := bucket.ReplayWAL(ctx, func(e lsmkv.WALEntry) error {
+		if e.Tombstone {
+			// deletions are taken from the deletions bucket
+			return nil
+		}
+		t, err := replayedTime(e.Value)
+		if err != nil {
+			return err
+		}
+		if t < from || t > to {
+			return nil
+		}
+		marks[string(e.Key)] = replayMark{time: t}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "replay write-ahead logs")
+	}
+
+	if err := s.markReplayedDeletions(marks, from, to); err != nil {
+		return nil, err
+	}
+
+	selected := make([]replayedKey, 0, len(marks))
+	for key, mark := range marks {
+		id, err := uuid.FromBytes([]byte(key))
+		if err != nil {
+			return nil, errors.Wrap(err, "parse replayed id")
+		}
+		cursor := replay.Cursor{Time: mark.time, ID: strfmt.UUID(id.String())}
+		if !after.Before(cursor) {
+			continue
+		}
+		selected = append(selected, replayedKey{cursor: cursor, key: key, deleted: mark.deleted})
+	}
+	sort.Slice(selected, func(a, b int) bool {
+		return selected[a].cursor.Before(selected[b].cursor)
+	})
+
+	page := &replay.Page{}
+	if len(selected) > limit {
+		selected = selected[:limit]
+		page.More = true
+	}
+
+	versions, err := s.loadReplayedVersions(ctx, selected)
+	if err != nil {
+		return nil, err
+	}
+
+	page.Writes = make([]replay.Write, len(selected))
+	for pos, k := range selected {
+		page.Writes[pos] = replay.Write{Time: k.cursor.Time, ID: k.cursor.ID}
+		if k.deleted {
+			continue
+		}
+		obj, ok := versions[k.key]
+		if !ok {
+			return nil, errors.Errorf("version of object %s at %d is no longer retained",
+				k.cursor.ID, k.cursor.Time)
+		}
+		page.Writes[pos].Object = obj
+	}
+	return page, nil
+}
+
+type replayedKey struct {
+	cursor  replay.Cursor
+	key     string
+	deleted bool
+}
+
+// markReplayedDeletions marks the objects deleted within the time range. A
+// deletion replaces an earlier version of the object, for a version and a
+// deletion within the same millisecond the current state of the object
+// decides.
+func (s *Shard) markReplayedDeletions(marks map[string]replayMark, from, to int64) error {
+	objects := s.store.Bucket(helpers.ObjectsBucketLSM)
+	c := s.store.Bucket(helpers.DeletionsBucketLSM).Cursor()
+	defer c.Close()
+
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if len(k) != 8 || len(v) != 24 || isWatermarkKey(k) {
+			continue
+		}
+
+		t := int64(binary.BigEndian.Uint64(v[16:]))
+		if t < from || t > to {
+			continue
+		}
+
+		key := string(v[:16])
+		if mark, ok := marks[key]; ok && !mark.deleted {
+			if mark.time > t {
+				continue
+			}
+			if mark.time == t {
+				existing, err := objects.Get(v[:16])
+				if err != nil {
+					return errors.Wrap(err, "get replayed object")
+				}
+				if existing != nil {
+					continue
+				}
+			}
+		}
+		marks[key] = replayMark{time: t, deleted: true}
+	}
+	return nil
+}
+
+// loadReplayedVersions reads the logs again to load the versions of the
+// objects which haven't been deleted
+func (s *Shard) loadReplayedVersions(ctx context.Context,
+	keys []replayedKey,
+) (map[string]*storobj.Object, error) {
+	times := map[string]int64{}
+	for _, k := range keys {
+		if !k.deleted {
+			times[k.key] = k.cursor.Time
+		}
+	}
+	versions := make(map[string]*storobj.Object, len(times))
+	if len(times) == 0 {
+		return versions, nil
+	}
+
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	err := bucket.ReplayWAL(ctx, func(e lsmkv.WALEntry) error {
+		t, ok := times[string(e.Key)]
+		if !ok || e.Tombstone {
+			return nil
+		}
+		obj, err := storobj.FromBinary(e.Value)
+		if err != nil {
+			return errors.Wrap(err, "unmarshal object")
+		}
+		if obj.LastUpdateTimeUnix() == t {
+			versions[string(e.Key)] = obj
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "replay write-ahead logs")
+	}
+	return versions, nil
+}
+
+// replayedTime is the last update time of a version of an object
+func replayedTime(value []byte) (int64, error) {
+	obj, err := storobj.FromBinary(value)
+	if err != nil {
+		return 0, errors.Wrap(err, "unmarshal object")
+	}
+	return obj.LastUpdateTimeUnix(), nil
+}
@@ -0,0 +1,237 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestReplayClass(t *testing.T) {
+	dirName := t.TempDir()
+	ctx := context.Background()
+
+	newClass := func(name string, retention int64) *models.Class {
+		inverted := invertedConfig()
+		inverted.WalRetentionSeconds = retention
+		return &models.Class{
+			Class:               name,
+			VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+			InvertedIndexConfig: inverted,
+			Properties: []*models.Property{
+				{
+					Name:     "name",
+					DataType: []string{"text"},
+				},
+			},
+		}
+	}
+	source := newClass("ReplaySource", 3600)
+	target := newClass("ReplayTarget", 0)
+
+	// the writes of the shards are merged into a single order
+	shardState := multiShardState()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState, schema: schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{source, target},
+		},
+	}}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(ctx, source, shardState))
+	require.Nil(t, migrator.AddClass(ctx, target, shardState))
+
+	ids := make([]strfmt.UUID, 10)
+	for i := range ids {
+		ids[i] = strfmt.UUID(fmt.Sprintf("b0b55b05-bc5b-4cc9-b646-%012d", i))
+	}
+	put := func(i int, name string, updated int64) {
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			ID:                 ids[i],
+			Class:              source.Class,
+			Properties:         map[string]interface{}{"name": name},
+			CreationTimeUnix:   1000,
+			LastUpdateTimeUnix: updated,
+		}, []float32{float32(i), 1, 2}, nil))
+	}
+	flush := func() {
+		index := repo.GetIndex(schema.ClassName(source.Class))
+		for _, shard := range index.Shards {
+			require.Nil(t, shard.store.FlushMemtables(ctx))
+		}
+	}
+	targetObject := func(id strfmt.UUID) *search.Result {
+		obj, err := repo.Object(ctx, target.Class, id,
+			search.SelectProperties{}, additional.Properties{}, nil)
+		require.Nil(t, err)
+		return obj
+	}
+
+	t.Run("import objects", func(t *testing.T) {
+		for i := range ids {
+			put(i, fmt.Sprintf("object %d", i), int64(1000*(1+i/5)))
+		}
+		flush()
+		// the update is still in the memtable
+		put(0, "updated", 3000)
+	})
+
+	t.Run("replay a time range", func(t *testing.T) {
+		res, err := migrator.ReplayClass(ctx, source.Class, 1500, 2500, "", 100, "")
+		require.Nil(t, err)
+		assert.Equal(t, int64(5), res.Replayed)
+		assert.Empty(t, res.Next)
+		require.Len(t, res.Objects, 5)
+		for i, obj := range res.Objects {
+			assert.Equal(t, ids[5+i], obj.ID)
+			assert.Equal(t, int64(2000), obj.LastUpdateTimeUnix)
+			assert.NotEmpty(t, obj.Vector)
+		}
+
+		// the version of the object at the end of the range is replayed
+		res, err = migrator.ReplayClass(ctx, source.Class, 0, 1500, "", 100, "")
+		require.Nil(t, err)
+		require.Len(t, res.Objects, 5)
+		assert.Equal(t, ids[0], res.Objects[0].ID)
+		assert.Equal(t, "object 0", res.Objects[0].Properties.(map[string]interface{})["name"])
+	})
+
+	t.Run("replay in pages", func(t *testing.T) {
+		var (
+			replayed []strfmt.UUID
+			after    string
+			pages    int
+		)
+		for {
+			res, err := migrator.ReplayClass(ctx, source.Class, 0, 5000, after, 3, "")
+			require.Nil(t, err)
+			require.LessOrEqual(t, len(res.Objects), 3)
+			for _, obj := range res.Objects {
+				replayed = append(replayed, obj.ID)
+			}
+			pages++
+			if res.Next == "" {
+				break
+			}
+			after = res.Next
+		}
+
+		assert.Equal(t, 4, pages)
+		// ordered by the time of the write, the update of the first object is
+		// the latest one
+		assert.Equal(t, append(append([]strfmt.UUID{}, ids[1:]...), ids[0]), replayed)
+	})
+
+	t.Run("an invalid cursor", func(t *testing.T) {
+		_, err := migrator.ReplayClass(ctx, source.Class, 0, 5000, "not a cursor", 3, "")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("replay into another class", func(t *testing.T) {
+		res, err := migrator.ReplayClass(ctx, source.Class, 0, 5000, "", 100, target.Class)
+		require.Nil(t, err)
+		assert.Equal(t, int64(10), res.Replayed)
+		assert.Empty(t, res.Error)
+		assert.Empty(t, res.Objects)
+
+		for i, id := range ids {
+			obj := targetObject(id)
+			require.NotNil(t, obj, "object %d was not replayed", i)
+			name := fmt.Sprintf("object %d", i)
+			if i == 0 {
+				name = "updated"
+			}
+			assert.Equal(t, name, obj.Schema.(map[string]interface{})["name"])
+		}
+	})
+
+	t.Run("deletions are replayed", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(ctx, source.Class, ids[9], nil))
+		now := time.Now().UnixMilli()
+
+		res, err := migrator.ReplayClass(ctx, source.Class, 0, now, "", 100, "")
+		require.Nil(t, err)
+		assert.Equal(t, int64(9), res.Replayed)
+		assert.Equal(t, int64(1), res.Deleted)
+		require.Len(t, res.Deletions, 1)
+		assert.Equal(t, ids[9], res.Deletions[0].ID)
+		for _, obj := range res.Objects {
+			assert.NotEqual(t, ids[9], obj.ID)
+		}
+
+		// the deletion is the latest write
+		res, err = migrator.ReplayClass(ctx, source.Class, 2500, now, "", 100, target.Class)
+		require.Nil(t, err)
+		assert.Equal(t, int64(1), res.Replayed)
+		assert.Equal(t, int64(1), res.Deleted)
+		assert.Nil(t, targetObject(ids[9]))
+		assert.NotNil(t, targetObject(ids[0]))
+	})
+
+	t.Run("a failed write reports the progress", func(t *testing.T) {
+		targetIndex := repo.GetIndex(schema.ClassName(target.Class))
+		for name := range targetIndex.Shards {
+			require.Nil(t, migrator.UpdateShardStatus(ctx, target.Class, name, "READONLY"))
+		}
+
+		res, err := migrator.ReplayClass(ctx, source.Class, 0, 1500, "", 100, target.Class)
+		require.Nil(t, err)
+		assert.Contains(t, res.Error, "read-only")
+		assert.Equal(t, int64(0), res.Replayed)
+		assert.Empty(t, res.Next)
+
+		for name := range targetIndex.Shards {
+			require.Nil(t, migrator.UpdateShardStatus(ctx, target.Class, name, "READY"))
+		}
+
+		res, err = migrator.ReplayClass(ctx, source.Class, 0, 1500, res.Next, 100, target.Class)
+		require.Nil(t, err)
+		assert.Empty(t, res.Error)
+		assert.Equal(t, int64(5), res.Replayed)
+	})
+
+	t.Run("disabling the retention removes the logs", func(t *testing.T) {
+		updated := invertedConfig()
+		require.Nil(t, migrator.UpdateInvertedIndexConfig(ctx, source.Class, updated))
+
+		// only the logs of the memtables are left
+		res, err := migrator.ReplayClass(ctx, source.Class, 0, 5000, "", 100, "")
+		require.Nil(t, err)
+		require.Len(t, res.Objects, 1)
+		assert.Equal(t, ids[0], res.Objects[0].ID)
+	})
+}
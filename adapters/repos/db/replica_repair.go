@@ -0,0 +1,184 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+// RepairReplicasPeriodically runs the anti-entropy repair of the replicas of
+// the local shards every interval until ctx is cancelled.
+func (db *DB) RepairReplicasPeriodically(ctx context.Context,
+	interval time.Duration, treeHeight int,
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := db.RepairReplicas(ctx, treeHeight); err != nil {
+				db.logger.WithField("action", "replica_anti_entropy").
+					WithError(err).Error("could not repair replicas")
+			}
+		}
+	}
+}
+
+// RepairReplicas makes the replicas of the replicated shards which this node
+// is the first owner of converge, see replica.Finder.RepairShard
+func (db *DB) RepairReplicas(ctx context.Context, treeHeight int) error {
+	thisNode := db.schemaGetter.NodeName()
+
+	type target struct {
+		index *Index
+		shard string
+	}
+	db.indexLock.RLock()
+	var targets []target
+	for _, index := range db.indices {
+		state := db.schemaGetter.ShardingState(index.Config.ClassName.String())
+		for name := range index.Shards {
+			physical, ok := state.Physical[name]
+			if !ok || len(physical.BelongsToNodes) < 2 ||
+				physical.BelongsToNodes[0] != thisNode {
+				continue
+			}
+			targets = append(targets, target{index: index, shard: name})
+		}
+	}
+	db.indexLock.RUnlock()
+
+	for _, t := range targets {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		before := time.Now()
+		report, err := t.index.replicator.RepairShard(ctx, t.shard, treeHeight)
+		logger := db.logger.WithField("action", "replica_anti_entropy").
+			WithField("class", t.index.Config.ClassName).
+			WithField("shard", t.shard)
+		if err != nil {
+			logger.WithError(err).Warn("could not repair replicas of shard")
+			continue
+		}
+		if report.DifferingLeaves > 0 || len(report.Unreachable) > 0 {
+			logger.WithField("differing_leaves", report.DifferingLeaves).
+				WithField("repaired", report.Repaired).
+				WithField("conflicts", report.Conflicts).
+				WithField("unreachable", report.Unreachable).
+				WithField("took", time.Since(before)).
+				Info("repaired replicas of shard")
+		}
+	}
+	return nil
+}
+
+func (db *DB) HashTree(ctx context.Context,
+	class, shardName string, height int,
+) (*replica.HashTree, error) {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return nil, fmt.Errorf("index %q not found locally", class)
+	}
+	s := index.Shards[shardName]
+	if s == nil {
+		return nil, fmt.Errorf("shard %q not found locally", shardName)
+	}
+	return s.hashTree(ctx, height)
+}
+
+func (db *DB) DigestLeaf(ctx context.Context,
+	class, shardName string, height, leaf int,
+) ([]replica.RepairResponse, error) {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return nil, fmt.Errorf("index %q not found locally", class)
+	}
+	s := index.Shards[shardName]
+	if s == nil {
+		return nil, fmt.Errorf("shard %q not found locally", shardName)
+	}
+	return s.digestLeaf(ctx, height, leaf)
+}
+
+// hashTree builds the hash tree of all objects of the shard. It reads the
+// headers of all objects, so it is meant for background jobs.
+func (s *Shard) hashTree(ctx context.Context, height int) (*replica.HashTree, error) {
+	tree, err := replica.NewHashTree(height)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	defer cursor.Close()
+
+	i := 0
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		if i++; i%1000 == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		obj, err := storobj.FromBinaryUUIDOnly(v)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal object header: %w", err)
+		}
+		tree.Add(k, obj.LastUpdateTimeUnix())
+	}
+	tree.Build()
+	return tree, nil
+}
+
+// digestLeaf returns the digests of the objects of a leaf of the hash tree
+func (s *Shard) digestLeaf(ctx context.Context,
+	height, leaf int,
+) ([]replica.RepairResponse, error) {
+	if height < 0 || height > replica.MaxHashTreeHeight ||
+		leaf < 0 || leaf >= replica.Leaves(height) {
+		return nil, fmt.Errorf("leaf %d of hash tree of height %d doesn't exist",
+			leaf, height)
+	}
+	from, to := replica.LeafRange(height, leaf)
+
+	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	defer cursor.Close()
+
+	var result []replica.RepairResponse
+	for k, v := cursor.Seek(from); k != nil; k, v = cursor.Next() {
+		if to != nil && string(k) >= string(to) {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		obj, err := storobj.FromBinaryUUIDOnly(v)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal object header: %w", err)
+		}
+		result = append(result, replica.RepairResponse{
+			ID:         obj.ID().String(),
+			UpdateTime: obj.LastUpdateTimeUnix(),
+		})
+	}
+	return result, nil
+}
@@ -22,9 +22,12 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
 	"github.com/weaviate/weaviate/entities/additional"
-	"github.com/weaviate/weaviate/entities/multi"
+	"github.com/weaviate/weaviate/entities/hlc"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
+	dynament "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
@@ -167,24 +170,49 @@ func (i *Index) writableShard(name string) (*Shard, *replica.SimpleResponse) {
 	return localShard, nil
 }
 
+// replicationConfig returns the current replication config of the class
+func (i *Index) replicationConfig() *models.ReplicationConfig {
+	sch := i.getSchema.GetSchemaSkipAuth()
+	if class := sch.GetClass(i.Config.ClassName); class != nil {
+		return class.ReplicationConfig
+	}
+	return nil
+}
+
+// preparableShard is the writable shard which prepares a replicated write,
+// holding the write lease of the shard if this node is its leader
+func (i *Index) preparableShard(ctx context.Context, name, requestID string,
+) (*Shard, *replica.SimpleResponse) {
+	localShard, pr := i.writableShard(name)
+	if pr != nil {
+		return nil, pr
+	}
+	if pr := i.acquireWriteLease(ctx, localShard, requestID); pr != nil {
+		return nil, pr
+	}
+	return localShard, nil
+}
+
 func (i *Index) ReplicateObject(ctx context.Context, shard, requestID string, object *storobj.Object) replica.SimpleResponse {
-	localShard, pr := i.writableShard(shard)
+	localShard, pr := i.preparableShard(ctx, shard, requestID)
 	if pr != nil {
 		return *pr
 	}
+	hlc.Observe(object.LastUpdateTimeUnix())
 	return localShard.preparePutObject(ctx, requestID, object)
 }
 
 func (i *Index) ReplicateUpdate(ctx context.Context, shard, requestID string, doc *objects.MergeDocument) replica.SimpleResponse {
-	localShard, pr := i.writableShard(shard)
+	localShard, pr := i.preparableShard(ctx, shard, requestID)
 	if pr != nil {
 		return *pr
 	}
+	hlc.Observe(doc.UpdateTime)
 	return localShard.prepareMergeObject(ctx, requestID, doc)
 }
 
 func (i *Index) ReplicateDeletion(ctx context.Context, shard, requestID string, uuid strfmt.UUID) replica.SimpleResponse {
-	localShard, pr := i.writableShard(shard)
+	localShard, pr := i.preparableShard(ctx, shard, requestID)
 	if pr != nil {
 		return *pr
 	}
@@ -192,15 +220,18 @@ func (i *Index) ReplicateDeletion(ctx context.Context, shard, requestID string,
 }
 
 func (i *Index) ReplicateObjects(ctx context.Context, shard, requestID string, objects []*storobj.Object) replica.SimpleResponse {
-	localShard, pr := i.writableShard(shard)
+	localShard, pr := i.preparableShard(ctx, shard, requestID)
 	if pr != nil {
 		return *pr
 	}
+	for _, obj := range objects {
+		hlc.Observe(obj.LastUpdateTimeUnix())
+	}
 	return localShard.preparePutObjects(ctx, requestID, objects)
 }
 
 func (i *Index) ReplicateDeletions(ctx context.Context, shard, requestID string, docIDs []uint64, dryRun bool) replica.SimpleResponse {
-	localShard, pr := i.writableShard(shard)
+	localShard, pr := i.preparableShard(ctx, shard, requestID)
 	if pr != nil {
 		return *pr
 	}
@@ -208,7 +239,7 @@ func (i *Index) ReplicateDeletions(ctx context.Context, shard, requestID string,
 }
 
 func (i *Index) ReplicateReferences(ctx context.Context, shard, requestID string, refs []objects.BatchReference) replica.SimpleResponse {
-	localShard, pr := i.writableShard(shard)
+	localShard, pr := i.preparableShard(ctx, shard, requestID)
 	if pr != nil {
 		return *pr
 	}
@@ -220,6 +251,7 @@ func (i *Index) CommitReplication(shard, requestID string) interface{} {
 	if !ok {
 		return nil
 	}
+	defer localShard.writeLease.release(requestID)
 	return localShard.commit(context.Background(), requestID, &i.backupStateLock)
 }
 
@@ -230,6 +262,7 @@ func (i *Index) AbortReplication(shard, requestID string) interface{} {
 			{Code: replica.StatusShardNotFound, Msg: shard},
 		}}
 	}
+	defer localShard.writeLease.release(requestID)
 	return localShard.abort(context.Background(), requestID)
 }
 
@@ -248,8 +281,15 @@ func (i *Index) IncomingCreateShard(ctx context.Context,
 	shardName string,
 ) error {
 	// TODO: locking???
-	if _, ok := i.Shards[shardName]; ok {
-		return fmt.Errorf("shard %q exists already", shardName)
+	if existing, ok := i.Shards[shardName]; ok {
+		if i.getSchema.ShardingState(i.Config.ClassName.String()).IsShardLocal(shardName) {
+			return fmt.Errorf("shard %q exists already", shardName)
+		}
+		// the shard isn't owned by this node, it is the leftover of a copy
+		// which has been aborted
+		if err := i.dropShard(shardName); err != nil {
+			return fmt.Errorf("drop leftover of shard %q: %w", existing.ID(), err)
+		}
 	}
 
 	// TODO: metrics
@@ -299,26 +339,50 @@ func (s *Shard) reinit(ctx context.Context) error {
 		return fmt.Errorf("shutdown shard: %w", err)
 	}
 
-	hnswUserConfig, ok := s.index.vectorIndexUserConfig.(hnswent.UserConfig)
-	if !ok {
-		return fmt.Errorf("hnsw vector index: config is not hnsw.UserConfig: %T",
+	return s.reload(ctx, nil)
+}
+
+// reload initializes a shard which has been shut down or dropped from the
+// files on disk. If the class is set, the buckets of its properties are
+// created, too.
+func (s *Shard) reload(ctx context.Context, class *models.Class) error {
+
+	hnswUserConfig, isHNSW := s.index.vectorIndexUserConfig.(hnswent.UserConfig)
+	flatUserConfig, isFlat := s.index.vectorIndexUserConfig.(flatent.UserConfig)
+	dynamicUserConfig, isDynamic := s.index.vectorIndexUserConfig.(dynament.UserConfig)
+	if !isHNSW && !isFlat && !isDynamic {
+		return fmt.Errorf("unsupported vector index config: %T",
 			s.index.vectorIndexUserConfig)
 	}
 
-	if hnswUserConfig.Skip {
-		s.vectorIndex = noop.NewIndex()
-	} else {
-		if err := s.initVectorIndex(ctx, hnswUserConfig); err != nil {
-			return fmt.Errorf("init vector index: %w", err)
-		}
+	if isHNSW {
+		if hnswUserConfig.Skip {
+			s.vectorIndex = noop.NewIndex()
+		} else {
+			if err := s.initVectorIndex(ctx, hnswUserConfig); err != nil {
+				return fmt.Errorf("init vector index: %w", err)
+			}
 
-		defer s.vectorIndex.PostStartup()
+			defer s.vectorIndex.PostStartup()
+		}
 	}
 
-	if err := s.initNonVector(ctx, nil); err != nil {
+	if err := s.initNonVector(ctx, class); err != nil {
 		return fmt.Errorf("init non-vector: %w", err)
 	}
 
+	if isFlat {
+		if err := s.initFlatVectorIndex(flatUserConfig); err != nil {
+			return fmt.Errorf("init vector index: %w", err)
+		}
+	}
+
+	if isDynamic {
+		if err := s.initDynamicVectorIndex(ctx, dynamicUserConfig); err != nil {
+			return fmt.Errorf("init vector index: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -349,6 +413,7 @@ func (i *Index) overwriteObjects(ctx context.Context,
 			continue
 		}
 		// valid update
+		hlc.Observe(data.LastUpdateTimeUnix)
 		found, err := s.objectByID(ctx, data.ID, nil, additional.Properties{})
 		var curUpdateTime int64 // 0 means object doesn't exist on this node
 		if found != nil {
@@ -402,12 +467,7 @@ func (i *Index) digestObjects(ctx context.Context,
 		return nil, fmt.Errorf("shard %q not found locally", shardName)
 	}
 
-	multiIDs := make([]multi.Identifier, len(ids))
-	for j := range multiIDs {
-		multiIDs[j] = multi.Identifier{ID: ids[j].String()}
-	}
-
-	objs, err := s.multiObjectByID(ctx, multiIDs)
+	objs, err := s.objectHeadersByID(ids)
 	if err != nil {
 		return nil, fmt.Errorf("shard objects digest: %w", err)
 	}
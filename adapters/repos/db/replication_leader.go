@@ -0,0 +1,128 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+// writeLeaseTimeout releases the write lease of a shard leader if the
+// coordinator of a write neither commits nor aborts it, e.g. because it
+// crashed
+const writeLeaseTimeout = 30 * time.Second
+
+// ShardLeader elects the leader of a shard for the leader-based write path.
+// It is the first node of the shard's replicas, in the order of the
+// sharding state, which is a live member of the cluster. The sharding state
+// is agreed on through schema transactions and membership is gossiped, so
+// all nodes elect the same leader once membership has converged.
+func (i *Index) ShardLeader(shard string) (string, error) {
+	node, err := i.shardLeaderNode(shard)
+	if err != nil {
+		return "", err
+	}
+	host, _ := i.nodeResolver.NodeHostname(node)
+	return host, nil
+}
+
+func (i *Index) shardLeaderNode(shard string) (string, error) {
+	ss := i.getSchema.ShardingState(i.Config.ClassName.String())
+	if ss == nil {
+		return "", fmt.Errorf("no sharding state for class %q", i.Config.ClassName)
+	}
+	physical, ok := ss.Physical[shard]
+	if !ok {
+		return "", fmt.Errorf("class %q has no physical shard %q", i.Config.ClassName, shard)
+	}
+
+	for _, node := range physical.BelongsToNodes {
+		if host, ok := i.nodeResolver.NodeHostname(node); ok && host != "" {
+			return node, nil
+		}
+	}
+	return "", fmt.Errorf("no live replica of shard %q", shard)
+}
+
+// acquireWriteLease is called when a write of a shard is prepared. On the
+// leader it blocks until the previous write of the shard has been committed
+// or aborted. Followers apply writes in the order in which the leader
+// commits them, so they don't hold a lease.
+func (i *Index) acquireWriteLease(ctx context.Context, shard *Shard,
+	requestID string,
+) *replica.SimpleResponse {
+	if !i.Config.LeaderWrites {
+		return nil
+	}
+	if leader, err := i.shardLeaderNode(shard.name); err != nil ||
+		leader != i.getSchema.NodeName() {
+		return nil
+	}
+
+	if err := shard.writeLease.acquire(ctx, requestID); err != nil {
+		return &replica.SimpleResponse{Errors: []replica.Error{{
+			Code: replica.StatusConflict, Msg: err.Error(),
+		}}}
+	}
+	return nil
+}
+
+// writeLease serializes the writes of a shard on its leader
+type writeLease struct {
+	sync.Mutex
+	holder  string
+	expires time.Time
+	// released is closed when the current holder releases the lease
+	released chan struct{}
+}
+
+func (l *writeLease) acquire(ctx context.Context, requestID string) error {
+	for {
+		l.Lock()
+		if l.holder == "" || time.Now().After(l.expires) {
+			l.holder = requestID
+			l.expires = time.Now().Add(writeLeaseTimeout)
+			l.released = make(chan struct{})
+			l.Unlock()
+			return nil
+		}
+		released, wait := l.released, time.Until(l.expires)
+		l.Unlock()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("wait for write lease: %w", ctx.Err())
+		case <-released:
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+}
+
+// release is a no-op unless the request holds the lease, so that it can be
+// called for every commit and abort
+func (l *writeLease) release(requestID string) {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.holder != requestID || l.holder == "" {
+		return
+	}
+	l.holder = ""
+	close(l.released)
+}
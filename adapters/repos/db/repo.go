@@ -13,15 +13,22 @@ package db
 
 import (
 	"context"
+	"fmt"
 	"math"
+	"path"
 	"runtime"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/weaviate/weaviate/entities/storobj"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/walcompression"
+	"github.com/weaviate/weaviate/entities/errorcompounder"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -43,6 +50,14 @@ type DB struct {
 	shutdown        chan struct{}
 	startupComplete atomic.Bool
 
+	// blockCache is shared by the remote segments of all shards
+	blockCache *lsmkv.BlockCache
+
+	// lazyIndexes are the classes which are loaded on their first access,
+	// guarded by the indexLock
+	lazyIndexes      map[string]*lazyIndex
+	shardLoadLimiter loadLimiter
+
 	// indexLock is an RWMutex which allows concurrent access to various indexes,
 	// but only one modifaction at a time. R/W can be a bit confusing here,
 	// because it does not refer to write or read requests from a user's
@@ -67,6 +82,17 @@ type DB struct {
 	jobQueueCh          chan job
 	shutDownWg          sync.WaitGroup
 	maxNumberGoroutines int
+	workerPools         *workerPools
+
+	degradation memoryDegradation
+	// memoryRatio holds the bits of the memory usage ratio of the last
+	// resource scan
+	memoryRatio atomic.Uint64
+
+	// consistencyReports are the results of the last replica consistency
+	// check by class and shard, guarded by the consistencyLock
+	consistencyReports map[string]*models.ShardConsistencyReport
+	consistencyLock    sync.Mutex
 }
 
 func (d *DB) SetSchemaGetter(sg schemaUC.SchemaGetter) {
@@ -96,6 +122,9 @@ func New(logger logrus.FieldLogger, config Config,
 		logger:              logger,
 		config:              config,
 		indices:             map[string]*Index{},
+		lazyIndexes:         map[string]*lazyIndex{},
+		consistencyReports:  map[string]*models.ShardConsistencyReport{},
+		shardLoadLimiter:    newLoadLimiter(config.Startup.ShardLoadParallelism),
 		remoteIndex:         remoteIndex,
 		nodeResolver:        nodeResolver,
 		remoteNode:          sharding.NewRemoteNode(nodeResolver, remoteNodesClient),
@@ -104,10 +133,18 @@ func New(logger logrus.FieldLogger, config Config,
 		shutdown:            make(chan struct{}),
 		jobQueueCh:          make(chan job, 100000),
 		maxNumberGoroutines: int(math.Round(config.MaxImportGoroutinesFactor * float64(runtime.GOMAXPROCS(0)))),
+		workerPools:         newWorkerPools(config.WorkerPools),
+	}
+	if config.WorkerPools.IndexingWorkers > 0 {
+		db.maxNumberGoroutines = config.WorkerPools.IndexingWorkers
 	}
 	if db.maxNumberGoroutines == 0 {
 		return db, errors.New("no workers to add batch-jobs configured.")
 	}
+	if config.RemoteSegmentStorage != nil {
+		db.blockCache = lsmkv.NewBlockCache(lsmkv.DefaultBlockSize,
+			int64(config.RemoteSegments.CacheSizeMB)*1024*1024)
+	}
 	db.shutDownWg.Add(db.maxNumberGoroutines)
 	for i := 0; i < db.maxNumberGoroutines; i++ {
 		go db.worker()
@@ -128,32 +165,73 @@ type Config struct {
 	MemtablesMinActiveSeconds int
 	MemtablesMaxActiveSeconds int
 	TrackVectorDimensions     bool
-	ServerVersion             string
-	GitHash                   string
+	// QuarantineAfterWriteErrors see config.Persistence
+	QuarantineAfterWriteErrors int
+	// QueryMaximumVectorCursorDepth see checkVectorCursorDepth
+	QueryMaximumVectorCursorDepth int64
+	// LeaderWrites serializes the writes of replicated shards through their
+	// leader, see config.Replication
+	LeaderWrites bool
+	// RemoteSegments see config.Persistence, the segments are offloaded to
+	// RemoteSegmentStorage if it is set
+	RemoteSegments       config.RemoteSegments
+	RemoteSegmentStorage lsmkv.RemoteStorage
+	Startup              config.Startup
+	// WorkerPools see config.WorkerPools, IndexingWorkers overrides
+	// MaxImportGoroutinesFactor
+	WorkerPools   config.WorkerPools
+	AsyncIndexing config.AsyncIndexing
+	// CompactionScheduler see config.Compaction, it is shared by all shards
+	CompactionScheduler *lsmkv.CompactionScheduler
+	ServerVersion       string
+	GitHash             string
+	// OffloadStorage stores the files of offloaded classes, see OffloadIndex
+	OffloadStorage lsmkv.RemoteStorage
+	FilterCache    config.FilterCache
+	// WALCompression see config.Persistence
+	WALCompression walcompression.Codec
 }
 
-// GetIndex returns the index if it exists or nil if it doesn't
-func (d *DB) GetIndex(className schema.ClassName) *Index {
-	d.indexLock.RLock()
-	defer d.indexLock.RUnlock()
+// remoteSegments returns the remote segments of the objects buckets of a
+// class or nil if its segments are kept local
+func (d *DB) remoteSegments(className string) *lsmkv.RemoteSegments {
+	if d.config.RemoteSegmentStorage == nil ||
+		!d.config.RemoteSegments.EnabledFor(className) {
+		return nil
+	}
+
+	return &lsmkv.RemoteSegments{
+		Storage:   d.config.RemoteSegmentStorage,
+		Cache:     d.blockCache,
+		Prefix:    path.Join(d.schemaGetter.NodeName(), indexID(schema.ClassName(className))),
+		KeepLocal: d.config.RemoteSegments.KeepLocalSegments,
+		MinAge:    time.Duration(d.config.RemoteSegments.MinAgeSeconds) * time.Second,
+	}
+}
 
+// GetIndex returns the index if it exists or nil if it doesn't. Indexes
+// which are loaded lazily are loaded by their first access.
+func (d *DB) GetIndex(className schema.ClassName) *Index {
 	id := indexID(className)
+
+	d.indexLock.RLock()
 	index, ok := d.indices[id]
-	if !ok {
-		return nil
-	}
+	lazy := d.lazyIndexes[id]
+	d.indexLock.RUnlock()
 
-	return index
+	if ok {
+		return index
+	}
+	if lazy != nil {
+		return d.loadLazyIndex(id, lazy)
+	}
+	return nil
 }
 
 // GetIndexForIncoming returns the index if it exists or nil if it doesn't
 func (d *DB) GetIndexForIncoming(className schema.ClassName) sharding.RemoteIndexIncomingRepo {
-	d.indexLock.RLock()
-	defer d.indexLock.RUnlock()
-
-	id := indexID(className)
-	index, ok := d.indices[id]
-	if !ok {
+	index := d.GetIndex(className)
+	if index == nil {
 		return nil
 	}
 
@@ -162,6 +240,13 @@ func (d *DB) GetIndexForIncoming(className schema.ClassName) sharding.RemoteInde
 
 // DeleteIndex deletes the index
 func (d *DB) DeleteIndex(className schema.ClassName) error {
+	if dropped, err := d.dropOffloadedIndex(context.Background(), className); dropped || err != nil {
+		return err
+	}
+
+	// make sure a lazy index is loaded, so its files are dropped
+	d.GetIndex(className)
+
 	d.indexLock.Lock()
 	defer d.indexLock.Unlock()
 
@@ -190,15 +275,17 @@ func (d *DB) Shutdown(ctx context.Context) error {
 
 	d.indexLock.Lock()
 	defer d.indexLock.Unlock()
+	// a failing index must not keep the others from flushing their data
+	var errs errorcompounder.ErrorCompounder
 	for id, index := range d.indices {
 		if err := index.Shutdown(ctx); err != nil {
-			return errors.Wrapf(err, "shutdown index %q", id)
+			errs.AddWrap(err, fmt.Sprintf("shutdown index %q", id))
 		}
 	}
 
 	d.shutDownWg.Wait() // wait until job queue shutdown is completed
 
-	return nil
+	return errs.ToError()
 }
 
 func (d *DB) worker() {
@@ -207,6 +294,8 @@ func (d *DB) worker() {
 			d.shutDownWg.Done()
 			return
 		}
+		// a cancelled context fails the job itself, so the error is ignored
+		_ = d.workerPools.yieldToQueries(jobToAdd.ctx)
 		jobToAdd.batcher.storeSingleObjectInAdditionalStorage(jobToAdd.ctx, jobToAdd.object, jobToAdd.status, jobToAdd.index)
 		jobToAdd.batcher.wg.Done()
 	}
@@ -13,6 +13,7 @@ package db
 
 import (
 	"fmt"
+	"math"
 	"runtime"
 	"runtime/debug"
 	"syscall"
@@ -57,16 +58,22 @@ func (d *DB) scanResourceUsage() {
 				d.indexLock.RLock()
 				for _, i := range d.indices {
 					for _, s := range i.Shards {
-						if !s.isReadOnly() {
-							diskPath := i.Config.RootPath
-							du := d.getDiskUse(diskPath)
+						diskPath := i.Config.RootPath
+						du := d.getDiskUse(diskPath)
 
+						if !s.isReadOnly() {
 							s.resourceUseWarn(memMonitor, du)
 							s.resourceUseReadonly(memMonitor, du)
+						} else {
+							s.diskUseRecover(du)
 						}
 					}
 				}
 				d.indexLock.RUnlock()
+
+				ratio := memMonitor.Ratio()
+				d.memoryRatio.Store(math.Float64bits(ratio))
+				d.checkMemoryDegradation(ratio)
 			}
 		}
 	}()
@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -29,6 +30,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
 )
 
 func TestRestartJourney(t *testing.T) {
@@ -250,3 +252,89 @@ func TestRestartJourney(t *testing.T) {
 		require.Nil(t, newRepo.Shutdown(context.Background()))
 	})
 }
+
+func TestRestartWithLazyLoading(t *testing.T) {
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	newClass := func(name string) *models.Class {
+		return &models.Class{
+			VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+			InvertedIndexConfig: invertedConfig(),
+			Class:               name,
+			Properties: []*models.Property{
+				{
+					Name:         "description",
+					DataType:     []string{string(schema.DataTypeText)},
+					Tokenization: "word",
+				},
+			},
+		}
+	}
+	priorityClass, lazyClass := newClass("PriorityClass"), newClass("LazyClass")
+	shardState := singleShardState()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	migrator := NewMigrator(repo, logger)
+
+	for _, class := range []*models.Class{priorityClass, lazyClass} {
+		require.Nil(t, migrator.AddClass(context.Background(), class, shardState))
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{lazyClass, priorityClass},
+		},
+	}
+
+	lazyID := strfmt.UUID("9d64350e-5027-40ea-98db-e3b97e6f6f8f")
+	require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+		Class:      lazyClass.Class,
+		ID:         lazyID,
+		Properties: map[string]interface{}{"description": "loaded on access"},
+	}, []float32{0.1, 0.2, 0.3}, nil))
+	require.Nil(t, repo.Shutdown(context.Background()))
+
+	newRepo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+		Startup: config.Startup{
+			ShardLoadParallelism: 2,
+			LazyLoading:          true,
+			PriorityClasses:      []string{priorityClass.Class},
+		},
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	newRepo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, newRepo.WaitForStartup(testCtx()))
+	defer newRepo.Shutdown(context.Background())
+
+	t.Run("only the priority class is loaded", func(t *testing.T) {
+		newRepo.indexLock.RLock()
+		defer newRepo.indexLock.RUnlock()
+		assert.Contains(t, newRepo.indices, indexID(schema.ClassName(priorityClass.Class)))
+		assert.NotContains(t, newRepo.indices, indexID(schema.ClassName(lazyClass.Class)))
+		assert.Contains(t, newRepo.lazyIndexes, indexID(schema.ClassName(lazyClass.Class)))
+	})
+
+	t.Run("the lazy class is loaded on access", func(t *testing.T) {
+		res, err := newRepo.ObjectByID(context.Background(), lazyID, nil, additional.Properties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, "loaded on access", res.Schema.(map[string]interface{})["description"])
+
+		newRepo.indexLock.RLock()
+		defer newRepo.indexLock.RUnlock()
+		assert.Contains(t, newRepo.indices, indexID(schema.ClassName(lazyClass.Class)))
+		assert.Empty(t, newRepo.lazyIndexes)
+	})
+}
@@ -0,0 +1,66 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// addRoutingHints adds the _additional routing of every result if it was
+// requested. The shard of an object is derived from its id, so the hints
+// don't depend on the search path the result took.
+func (d *DB) addRoutingHints(res search.Results, adds additional.Properties) {
+	if !adds.Routing {
+		return
+	}
+
+	localNode := d.schemaGetter.NodeName()
+	states := map[string]*sharding.State{}
+	for i := range res {
+		state, ok := states[res[i].ClassName]
+		if !ok {
+			state = d.schemaGetter.ShardingState(res[i].ClassName)
+			states[res[i].ClassName] = state
+		}
+		if state == nil {
+			continue
+		}
+
+		id, err := uuid.Parse(res[i].ID.String())
+		if err != nil {
+			continue
+		}
+		idBytes, _ := id.MarshalBinary() // cannot error
+		shard := state.PhysicalShard(idBytes)
+
+		routing := &additional.Routing{
+			Node:  localNode,
+			Shard: shard,
+			Nodes: append([]string(nil), state.Physical[shard].BelongsToNodes...),
+		}
+		if !state.IsShardLocal(shard) {
+			routing.Proxied = true
+			if len(routing.Nodes) > 0 {
+				routing.Node = routing.Nodes[0]
+			}
+		}
+
+		if res[i].AdditionalProperties == nil {
+			res[i].AdditionalProperties = models.AdditionalProperties{}
+		}
+		res[i].AdditionalProperties["routing"] = routing
+	}
+}
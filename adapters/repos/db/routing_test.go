@@ -0,0 +1,79 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestRoutingHints(t *testing.T) {
+	config, err := sharding.ParseConfig(map[string]interface{}{
+		"desiredCount": json.Number("2"),
+	}, 1)
+	require.Nil(t, err)
+	state, err := sharding.InitState("RoutingHints", config,
+		fakeNodes{[]string{"node1", "node2"}}, 1)
+	require.Nil(t, err)
+	state.SetLocalName("node1")
+
+	d := &DB{schemaGetter: &fakeSchemaGetter{shardState: state}}
+
+	res := make(search.Results, 50)
+	for i := range res {
+		res[i] = search.Result{ClassName: "RoutingHints", ID: strfmt.UUID(uuid.NewString())}
+	}
+
+	t.Run("not requested", func(t *testing.T) {
+		d.addRoutingHints(res, additional.Properties{})
+		for _, r := range res {
+			assert.Nil(t, r.AdditionalProperties)
+		}
+	})
+
+	t.Run("requested", func(t *testing.T) {
+		d.addRoutingHints(res, additional.Properties{Routing: true})
+
+		proxied := 0
+		for _, r := range res {
+			routing := r.AdditionalProperties["routing"].(*additional.Routing)
+			id, _ := uuid.MustParse(r.ID.String()).MarshalBinary()
+			shard := state.PhysicalShard(id)
+			owners := state.Physical[shard].BelongsToNodes
+
+			assert.Equal(t, shard, routing.Shard)
+			assert.Equal(t, owners, routing.Nodes)
+			if state.IsShardLocal(shard) {
+				assert.False(t, routing.Proxied)
+				assert.Equal(t, "node1", routing.Node)
+			} else {
+				proxied++
+				assert.True(t, routing.Proxied)
+				assert.Equal(t, owners[0], routing.Node)
+			}
+		}
+		// the shards are spread across both nodes
+		assert.Greater(t, proxied, 0)
+		assert.Less(t, proxied, len(res))
+	})
+}
@@ -0,0 +1,207 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/traverser"
+	"golang.org/x/sync/errgroup"
+)
+
+// classSampleSearch is used by ClassSearch if the query asks for a sample of
+// the matching objects instead of the first ones
+func (db *DB) classSampleSearch(ctx context.Context,
+	params dto.GetParams,
+) ([]search.Result, error) {
+	release, err := db.workerPools.acquireQuery(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "wait for query worker")
+	}
+	defer release()
+
+	idx := db.GetIndex(schema.ClassName(params.ClassName))
+	if idx == nil {
+		return nil, fmt.Errorf("tried to browse non-existing index for %s", params.ClassName)
+	}
+
+	if max := db.config.QueryMaximumResults; params.Sample.Size > int(max) {
+		return nil, errors.Errorf("sample size %d exceeds the query maximum results "+
+			"of %d", params.Sample.Size, max)
+	}
+
+	res, _, err := idx.objectSample(ctx, params.Filters, *params.Sample,
+		params.AdditionalProperties)
+	if err != nil {
+		return nil, errors.Wrapf(err, "object sample at index %s", idx.ID())
+	}
+
+	found := storobj.SearchResults(res, params.AdditionalProperties)
+	traverser.AddGeoDistances(found, params)
+	return db.ResolveReferences(ctx, found, params.Properties, params.AdditionalProperties)
+}
+
+type sampleResult struct {
+	obj      *storobj.Object
+	priority uint64
+}
+
+// objectSample returns the sample of the objects matching the filter, ordered
+// by their priority. Every shard only returns its own sample, which is at
+// most as large as the requested sample, and the samples of the shards are
+// merged by keeping the lowest priorities.
+func (i *Index) objectSample(ctx context.Context, filter *filters.LocalFilter,
+	sample filters.Sample, additional additional.Properties,
+) ([]*storobj.Object, []uint64, error) {
+	shardNames := i.getSchema.ShardingState(i.Config.ClassName.String()).
+		AllPhysicalShards()
+
+	errgrp := &errgroup.Group{}
+	m := &sync.Mutex{}
+
+	var found []sampleResult
+	for _, shardName := range shardNames {
+		shardName := shardName
+		errgrp.Go(func() error {
+			local := i.getSchema.
+				ShardingState(i.Config.ClassName.String()).
+				IsShardLocal(shardName)
+
+			var res []*storobj.Object
+			var priorities []uint64
+			var err error
+
+			if local {
+				shard := i.Shards[shardName]
+				res, priorities, err = shard.sampleObjects(ctx, filter, sample, additional)
+				if err != nil {
+					return errors.Wrapf(err, "shard %s", shard.ID())
+				}
+			} else {
+				res, priorities, err = i.remote.SampleObjects(ctx, shardName, filter,
+					sample, additional)
+				if err != nil {
+					return errors.Wrapf(err, "remote shard %s", shardName)
+				}
+			}
+
+			m.Lock()
+			defer m.Unlock()
+			for j, obj := range res {
+				found = append(found, sampleResult{obj: obj, priority: priorities[j]})
+			}
+
+			return nil
+		})
+	}
+
+	if err := errgrp.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	sort.Slice(found, func(a, b int) bool {
+		if found[a].priority != found[b].priority {
+			return found[a].priority < found[b].priority
+		}
+		return found[a].obj.ID() < found[b].obj.ID()
+	})
+	if len(found) > sample.Size {
+		found = found[:sample.Size]
+	}
+
+	objs := make([]*storobj.Object, len(found))
+	priorities := make([]uint64, len(found))
+	for j, res := range found {
+		objs[j], priorities[j] = res.obj, res.priority
+	}
+
+	return objs, priorities, nil
+}
+
+// sampleMaxPriority returns the sample with the priority of the last object
+// of the sample of the whole class as max priority, so that every shard can
+// select its part of the sample on its own. The max priority stays unset if
+// fewer objects than the sample size match, they are all part of the sample.
+func (i *Index) sampleMaxPriority(ctx context.Context,
+	filter *filters.LocalFilter, sample filters.Sample,
+) (filters.Sample, error) {
+	_, priorities, err := i.objectSample(ctx, filter, sample, additional.Properties{})
+	if err != nil {
+		return sample, err
+	}
+
+	if len(priorities) == sample.Size {
+		maxPriority := priorities[len(priorities)-1]
+		sample.MaxPriority = &maxPriority
+	}
+	return sample, nil
+}
+
+func (i *Index) IncomingSampleObjects(ctx context.Context, shardName string,
+	filter *filters.LocalFilter, sample filters.Sample,
+	additional additional.Properties,
+) ([]*storobj.Object, []uint64, error) {
+	shard, ok := i.Shards[shardName]
+	if !ok {
+		return nil, nil, errors.Errorf("shard %q does not exist locally", shardName)
+	}
+
+	res, priorities, err := shard.sampleObjects(ctx, filter, sample, additional)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
+	}
+
+	return res, priorities, nil
+}
+
+func (s *Shard) sampleObjects(ctx context.Context, filter *filters.LocalFilter,
+	sample filters.Sample, additional additional.Properties,
+) ([]*storobj.Object, []uint64, error) {
+	docs, err := inverted.NewSearcher(s.index.logger, s.store,
+		s.index.getSchema.GetSchemaSkipAuth(), s.invertedRowCache,
+		s.propertyIndices, s.index.classSearcher, s.deletedDocIDs,
+		s.index.stopwords, s.versioner.Version()).
+		SampleDocIDs(ctx, filter, sample.ForShard(s.name), s.index.Config.ClassName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	objs := make([]*storobj.Object, 0, len(docs))
+	priorities := make([]uint64, 0, len(docs))
+	for _, doc := range docs {
+		res, err := storobj.ObjectsByDocID(bucket, []uint64{doc.DocID}, additional)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "load sampled object %d", doc.DocID)
+		}
+		if len(res) == 0 {
+			// deleted in the meantime
+			continue
+		}
+		objs = append(objs, res[0])
+		priorities = append(priorities, doc.Priority)
+	}
+
+	return objs, priorities, nil
+}
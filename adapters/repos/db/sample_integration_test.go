@@ -0,0 +1,165 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestSampleObjects(t *testing.T) {
+	dirName := t.TempDir()
+
+	class := &models.Class{
+		Class:               "SampleClass",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "number",
+				DataType: []string{"int"},
+			},
+		},
+	}
+	shardState := multiShardState()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState, schema: schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, shardState))
+
+	count := 200
+	for i := 0; i < count; i++ {
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			ID:         strfmt.UUID(fmt.Sprintf("8a9d1b5e-62e4-4e5b-9a9e-%012d", i)),
+			Class:      class.Class,
+			Properties: map[string]interface{}{"number": int64(i)},
+		}, []float32{1, float32(i) / 10, 0.5}, nil))
+	}
+
+	lessThan := func(n int) *filters.LocalFilter {
+		return &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorLessThan,
+			On: &filters.Path{
+				Class:    schema.ClassName(class.Class),
+				Property: "number",
+			},
+			Value: &filters.Value{Value: n, Type: schema.DataTypeInt},
+		}}
+	}
+
+	sample := func(t *testing.T, filter *filters.LocalFilter,
+		s filters.Sample,
+	) []search.Result {
+		res, err := repo.ClassSearch(context.Background(), dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 100},
+			Filters:    filter,
+			Sample:     &s,
+			Properties: search.SelectProperties{{Name: "number", IsPrimitive: true}},
+		})
+		require.Nil(t, err)
+		return res
+	}
+
+	numbers := func(res []search.Result) []float64 {
+		out := make([]float64, len(res))
+		for i := range res {
+			out[i] = res[i].Schema.(map[string]interface{})["number"].(float64)
+		}
+		return out
+	}
+
+	t.Run("sample of all objects", func(t *testing.T) {
+		res := sample(t, nil, filters.Sample{Size: 20, Seed: 1})
+		require.Len(t, res, 20)
+
+		ids := map[strfmt.UUID]struct{}{}
+		for _, r := range res {
+			ids[r.ID] = struct{}{}
+		}
+		assert.Len(t, ids, 20)
+	})
+
+	t.Run("the same seed returns the same sample", func(t *testing.T) {
+		first := sample(t, nil, filters.Sample{Size: 20, Seed: 1})
+		second := sample(t, nil, filters.Sample{Size: 20, Seed: 1})
+		other := sample(t, nil, filters.Sample{Size: 20, Seed: 2})
+		assert.Equal(t, numbers(first), numbers(second))
+		assert.NotEqual(t, numbers(first), numbers(other))
+	})
+
+	t.Run("sample of filter matches", func(t *testing.T) {
+		res := sample(t, lessThan(50), filters.Sample{Size: 20, Seed: 1})
+		require.Len(t, res, 20)
+		for _, n := range numbers(res) {
+			assert.Less(t, n, float64(50))
+		}
+	})
+
+	t.Run("fewer matches than the sample size", func(t *testing.T) {
+		res := sample(t, lessThan(5), filters.Sample{Size: 20, Seed: 1})
+		assert.ElementsMatch(t, []float64{0, 1, 2, 3, 4}, numbers(res))
+	})
+
+	t.Run("aggregate over the sample", func(t *testing.T) {
+		s := filters.Sample{Size: 20, Seed: 3}
+		res, err := repo.Aggregate(context.Background(), aggregation.Params{
+			ClassName:        schema.ClassName(class.Class),
+			Filters:          lessThan(150),
+			IncludeMetaCount: true,
+			Sample:           &s,
+			Properties: []aggregation.ParamProperty{{
+				Name:        "number",
+				Aggregators: []aggregation.Aggregator{aggregation.SumAggregator},
+			}},
+		})
+		require.Nil(t, err)
+		require.Len(t, res.Groups, 1)
+		assert.Equal(t, 20, res.Groups[0].Count)
+
+		sum := 0.0
+		for _, n := range numbers(sample(t, lessThan(150), s)) {
+			sum += n
+		}
+		assert.Equal(t, sum, res.Groups[0].Properties["number"].NumericalAggregations["sum"])
+	})
+}
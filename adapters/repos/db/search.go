@@ -17,6 +17,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/refcache"
@@ -24,9 +25,12 @@ import (
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/functionscore"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/traverser"
 )
@@ -34,6 +38,12 @@ import (
 func (db *DB) Aggregate(ctx context.Context,
 	params aggregation.Params,
 ) (*aggregation.Result, error) {
+	release, err := db.workerPools.acquireQuery(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "wait for query worker")
+	}
+	defer release()
+
 	idx := db.GetIndex(params.ClassName)
 	if idx == nil {
 		return nil, fmt.Errorf("tried to browse non-existing index for %s", params.ClassName)
@@ -54,6 +64,12 @@ func (db *DB) GetQueryMaximumResults() int {
 func (db *DB) ClassObjectSearch(ctx context.Context,
 	params dto.GetParams,
 ) ([]*storobj.Object, []float32, error) {
+	release, err := db.workerPools.acquireQuery(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "wait for query worker")
+	}
+	defer release()
+
 	idx := db.GetIndex(schema.ClassName(params.ClassName))
 	if idx == nil {
 		return nil, nil, fmt.Errorf("tried to browse non-existing index for %s", params.ClassName)
@@ -81,23 +97,43 @@ func (db *DB) ClassObjectSearch(ctx context.Context,
 func (db *DB) ClassSearch(ctx context.Context,
 	params dto.GetParams,
 ) ([]search.Result, error) {
+	if params.Sample != nil {
+		return db.classSampleSearch(ctx, params)
+	}
+
 	res, _, err := db.ClassObjectSearch(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
-	return db.ResolveReferences(ctx,
-		storobj.SearchResults(db.getStoreObjects(res, params.Pagination), params.AdditionalProperties),
-		params.Properties, params.AdditionalProperties)
+	if params.FunctionScore != nil {
+		found, err := db.rankByFunctionScore(storobj.SearchResults(res,
+			params.AdditionalProperties), params)
+		if err != nil {
+			return nil, err
+		}
+		return db.ResolveReferences(ctx, found, params.Properties,
+			params.AdditionalProperties)
+	}
+
+	found := storobj.SearchResults(db.getStoreObjects(res, params.Pagination), params.AdditionalProperties)
+	traverser.AddGeoDistances(found, params)
+	return db.ResolveReferences(ctx, found, params.Properties, params.AdditionalProperties)
 }
 
 func (db *DB) VectorClassSearch(ctx context.Context,
 	params dto.GetParams,
 ) ([]search.Result, error) {
-	if params.SearchVector == nil {
+	if params.SearchVector == nil && targetVectorsFromParams(params) == nil {
 		return db.ClassSearch(ctx, params)
 	}
 
+	release, err := db.workerPools.acquireQuery(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "wait for query worker")
+	}
+	defer release()
+
 	totalLimit, err := db.getTotalLimit(params.Pagination, params.AdditionalProperties)
 	if err != nil {
 		return nil, errors.Wrapf(err, "invalid pagination params")
@@ -108,9 +144,16 @@ func (db *DB) VectorClassSearch(ctx context.Context,
 		return nil, fmt.Errorf("tried to browse non-existing index for %s", params.ClassName)
 	}
 
+	ctx = hnswent.NewFilterStrategyContext(ctx, filterStrategyFromParams(params))
+
+	if params.VectorCursor != nil || params.AdditionalProperties.VectorCursor {
+		return db.vectorClassSearchAfter(ctx, idx, totalLimit, params)
+	}
+
 	targetDist := extractDistanceFromParams(params)
-	res, dists, err := idx.objectVectorSearch(ctx, params.SearchVector, targetDist,
-		totalLimit, params.Filters, params.Sort, params.AdditionalProperties)
+	res, dists, err := idx.objectVectorSearch(ctx, params.SearchVector, targetVectorFromParams(params),
+		targetVectorsFromParams(params), targetDist, totalLimit, params.Filters, params.Sort,
+		params.AdditionalProperties)
 	if err != nil {
 		return nil, errors.Wrapf(err, "object vector search at index %s", idx.ID())
 	}
@@ -119,10 +162,50 @@ func (db *DB) VectorClassSearch(ctx context.Context,
 		params.Pagination.Limit = len(res)
 	}
 
-	return db.ResolveReferences(ctx,
-		storobj.SearchResultsWithDists(db.getStoreObjects(res, params.Pagination),
-			params.AdditionalProperties, db.getDists(dists, params.Pagination)),
-		params.Properties, params.AdditionalProperties)
+	if params.FunctionScore != nil {
+		found, err := db.rankByFunctionScore(storobj.SearchResultsWithDists(res,
+			params.AdditionalProperties, dists), params)
+		if err != nil {
+			return nil, err
+		}
+		return db.ResolveReferences(ctx, found, params.Properties,
+			params.AdditionalProperties)
+	}
+
+	found := storobj.SearchResultsWithDists(db.getStoreObjects(res, params.Pagination),
+		params.AdditionalProperties, db.getDists(dists, params.Pagination))
+	traverser.AddGeoDistances(found, params)
+	return db.ResolveReferences(ctx, found, params.Properties, params.AdditionalProperties)
+}
+
+// rankByFunctionScore re-ranks the merged results of all shards by the
+// function score of the query and paginates them afterwards, so the pages
+// are consistent with the new order
+func (db *DB) rankByFunctionScore(found search.Results,
+	params dto.GetParams,
+) (search.Results, error) {
+	expr, err := functionscore.Parse(params.FunctionScore.Expression)
+	if err != nil {
+		return nil, err
+	}
+	traverser.AddGeoDistances(found, params)
+	expr.Rank(found, time.Now())
+	return db.getSearchResults(found, params.Pagination.Offset,
+		params.Pagination.Limit), nil
+}
+
+// noDistanceThreshold is the target distance of vector searches which are
+// only limited by the number of results
+const noDistanceThreshold = filters.DistanceFlagNotSet
+
+// unlimitedSearchDistance is the target distance of vector searches which
+// don't set one. Searches without a limit search by distance, they only find
+// exact matches as they did before distance thresholds were applied.
+func unlimitedSearchDistance(limit int) float32 {
+	if limit < 0 {
+		return 0
+	}
+	return noDistanceThreshold
 }
 
 func extractDistanceFromParams(params dto.GetParams) float32 {
@@ -131,10 +214,38 @@ func extractDistanceFromParams(params dto.GetParams) float32 {
 		return float32(additional.CertaintyToDist(certainty))
 	}
 
-	dist, _ := traverser.ExtractDistanceFromParams(params)
+	dist, withDistance := traverser.ExtractDistanceFromParams(params)
+	if dist == 0 && !withDistance {
+		return noDistanceThreshold
+	}
 	return float32(dist)
 }
 
+// targetVectorFromParams returns the named vector a nearVector search is
+// targeting, the empty name is the default vector of the class
+func targetVectorFromParams(params dto.GetParams) string {
+	if params.NearVector == nil {
+		return ""
+	}
+	return params.NearVector.TargetVector
+}
+
+// targetVectorsFromParams returns the named vectors a multi-target nearVector
+// search is targeting, nil for searches of a single vector
+func targetVectorsFromParams(params dto.GetParams) *searchparams.TargetVectors {
+	if params.NearVector == nil {
+		return nil
+	}
+	return params.NearVector.TargetVectors
+}
+
+func filterStrategyFromParams(params dto.GetParams) string {
+	if params.NearVector == nil {
+		return ""
+	}
+	return params.NearVector.FilterStrategy
+}
+
 // ClassObjectVectorSearch is used to perform a vector search on the db
 //
 // Earlier use cases required only []search.Result as a return value from the db, and the
@@ -143,6 +254,12 @@ func extractDistanceFromParams(params dto.GetParams) float32 {
 func (db *DB) ClassObjectVectorSearch(ctx context.Context, class string, vector []float32, offset, limit int,
 	filters *filters.LocalFilter,
 ) ([]*storobj.Object, []float32, error) {
+	release, err := db.workerPools.acquireQuery(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "wait for query worker")
+	}
+	defer release()
+
 	totalLimit := offset + limit
 
 	index := db.GetIndex(schema.ClassName(class))
@@ -151,7 +268,8 @@ func (db *DB) ClassObjectVectorSearch(ctx context.Context, class string, vector
 	}
 
 	objs, dist, err := index.objectVectorSearch(
-		ctx, vector, 0, totalLimit, filters, nil, additional.Properties{})
+		ctx, vector, "", nil, unlimitedSearchDistance(totalLimit), totalLimit,
+		filters, nil, additional.Properties{})
 	if err != nil {
 		return nil, nil, fmt.Errorf("search index %s: %w", index.ID(), err)
 	}
@@ -193,6 +311,12 @@ func (db *DB) ClassVectorSearch(ctx context.Context, class string, vector []floa
 func (db *DB) VectorSearch(ctx context.Context, vector []float32, offset, limit int,
 	filters *filters.LocalFilter,
 ) ([]search.Result, error) {
+	release, err := db.workerPools.acquireQuery(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "wait for query worker")
+	}
+	defer release()
+
 	var found search.Results
 
 	wg := &sync.WaitGroup{}
@@ -200,6 +324,7 @@ func (db *DB) VectorSearch(ctx context.Context, vector []float32, offset, limit
 	var searchErrors []error
 	totalLimit := offset + limit
 
+	db.loadLazyIndexes()
 	db.indexLock.RLock()
 	for _, index := range db.indices {
 		wg.Add(1)
@@ -207,7 +332,8 @@ func (db *DB) VectorSearch(ctx context.Context, vector []float32, offset, limit
 			defer wg.Done()
 
 			objs, dist, err := index.objectVectorSearch(
-				ctx, vector, 0, totalLimit, filters, nil, additional.Properties{})
+				ctx, vector, "", nil, unlimitedSearchDistance(totalLimit), totalLimit,
+				filters, nil, additional.Properties{})
 			if err != nil {
 				mutex.Lock()
 				searchErrors = append(searchErrors, errors.Wrapf(err, "search index %s", index.ID()))
@@ -259,16 +385,32 @@ func (d *DB) Query(ctx context.Context, q *objects.QueryInput) (search.Results,
 	if idx == nil {
 		return nil, &objects.Error{Msg: "class not found " + q.Class, Code: objects.StatusNotFound}
 	}
+	release, err := d.workerPools.acquireQuery(ctx)
+	if err != nil {
+		return nil, &objects.Error{Msg: "wait for query worker", Code: objects.StatusInternalServerError, Err: err}
+	}
+	defer release()
 	if q.Cursor != nil {
 		if err := filters.ValidateCursor(schema.ClassName(q.Class), q.Cursor, q.Offset, q.Filters, q.Sort); err != nil {
 			return nil, &objects.Error{Msg: "cursor api: invalid 'after' parameter", Code: objects.StatusBadRequest, Err: err}
 		}
+		if len(d.schemaGetter.ShardingState(q.Class).AllPhysicalShards()) > 1 {
+			res, _, err := idx.iterateObjects(ctx, q.Cursor, totalLimit, q.Additional)
+			if err != nil {
+				return nil, &objects.Error{Msg: "iterate objects of index " + idx.ID(), Code: objects.StatusInternalServerError, Err: err}
+			}
+			found := storobj.SearchResults(res, q.Additional)
+			d.addRoutingHints(found, q.Additional)
+			return found, nil
+		}
 	}
 	res, _, err := idx.objectSearch(ctx, totalLimit, q.Filters, nil, q.Sort, q.Cursor, q.Additional, nil)
 	if err != nil {
 		return nil, &objects.Error{Msg: "search index " + idx.ID(), Code: objects.StatusInternalServerError, Err: err}
 	}
-	return d.getSearchResults(storobj.SearchResults(res, q.Additional), q.Offset, q.Limit), nil
+	found := d.getSearchResults(storobj.SearchResults(res, q.Additional), q.Offset, q.Limit)
+	d.addRoutingHints(found, q.Additional)
+	return found, nil
 }
 
 // ObjectSearch search each index.
@@ -290,9 +432,16 @@ func (d *DB) objectSearch(ctx context.Context, offset, limit int,
 		return nil, errors.Wrap(err, "search")
 	}
 
+	release, err := d.workerPools.acquireQuery(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "wait for query worker")
+	}
+	defer release()
+
 	totalLimit := offset + limit
 	// TODO: Search in parallel, rather than sequentially or this will be
 	// painfully slow on large schemas
+	d.loadLazyIndexes()
 	d.indexLock.RLock()
 	for _, index := range d.indices {
 		// TODO support all additional props
@@ -311,7 +460,9 @@ func (d *DB) objectSearch(ctx context.Context, offset, limit int,
 	}
 	d.indexLock.RUnlock()
 
-	return d.getSearchResults(storobj.SearchResults(found, additional), offset, limit), nil
+	res := d.getSearchResults(storobj.SearchResults(found, additional), offset, limit)
+	d.addRoutingHints(res, additional)
+	return res, nil
 }
 
 // ResolveReferences takes a list of search results and enriches them
@@ -324,6 +475,7 @@ func (d *DB) ResolveReferences(ctx context.Context, objs search.Results,
 	if err != nil {
 		return nil, fmt.Errorf("resolve cross-refs: %w", err)
 	}
+	d.addRoutingHints(res, additional)
 
 	return res, nil
 }
@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package segmentstorage stores LSM segments and the files of offloaded
+// classes in S3-compatible object storage
+package segmentstorage
+
+import (
+	"context"
+	"io"
+	"path"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/objectstorage"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// S3 implements lsmkv.RemoteStorage
+type S3 struct {
+	client *minio.Client
+	bucket string
+	path   string
+}
+
+func NewS3(cfg config.RemoteSegments) (*S3, error) {
+	return newS3(cfg.Endpoint, cfg.Bucket, cfg.Path, cfg.UseSSL)
+}
+
+// NewOffloadS3 stores the files of offloaded classes
+func NewOffloadS3(cfg config.Offload) (*S3, error) {
+	return newS3(cfg.Endpoint, cfg.Bucket, cfg.Path, cfg.UseSSL)
+}
+
+func newS3(endpoint, bucket, path string, useSSL bool) (*S3, error) {
+	client, err := objectstorage.NewS3Client(endpoint, useSSL)
+	if err != nil {
+		return nil, err
+	}
+	return &S3{client: client, bucket: bucket, path: path}, nil
+}
+
+func (s *S3) objectName(key string) string {
+	return path.Join(s.path, key)
+}
+
+func (s *S3) Upload(ctx context.Context, key, filePath string) error {
+	_, err := s.client.FPutObject(ctx, s.bucket, s.objectName(key), filePath,
+		minio.PutObjectOptions{ContentType: "application/octet-stream"})
+	if err != nil {
+		return errors.Wrapf(err, "put object %q", s.objectName(key))
+	}
+	return nil
+}
+
+func (s *S3) Download(ctx context.Context, key, filePath string) error {
+	err := s.client.FGetObject(ctx, s.bucket, s.objectName(key), filePath,
+		minio.GetObjectOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "get object %q", s.objectName(key))
+	}
+	return nil
+}
+
+func (s *S3) ReadAt(ctx context.Context, key string, p []byte, offset int64) error {
+	if len(p) == 0 {
+		return nil
+	}
+
+	opts := minio.GetObjectOptions{}
+	if err := opts.SetRange(offset, offset+int64(len(p))-1); err != nil {
+		return err
+	}
+	obj, err := s.client.GetObject(ctx, s.bucket, s.objectName(key), opts)
+	if err != nil {
+		return errors.Wrapf(err, "get object %q", s.objectName(key))
+	}
+	defer obj.Close()
+
+	if _, err := io.ReadFull(obj, p); err != nil {
+		return errors.Wrapf(err, "read object %q", s.objectName(key))
+	}
+	return nil
+}
+
+func (s *S3) Delete(ctx context.Context, key string) error {
+	err := s.client.RemoveObject(ctx, s.bucket, s.objectName(key), minio.RemoveObjectOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "remove object %q", s.objectName(key))
+	}
+	return nil
+}
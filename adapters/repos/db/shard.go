@@ -16,7 +16,9 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"path/filepath"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -27,13 +29,15 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/propertyspecific"
-	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storagestate"
+	dynament "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 )
@@ -44,12 +48,14 @@ const IdLockPoolSize = 128
 // database files for all the objects it owns. How a shard is determined for a
 // target object (e.g. Murmur hash, etc.) is still open at this point
 type Shard struct {
-	index             *Index // a reference to the underlying index, which in turn contains schema information
-	name              string
-	store             *lsmkv.Store
-	counter           *indexcounter.Counter
-	vectorIndex       VectorIndex
-	invertedRowCache  *inverted.RowCacher
+	index            *Index // a reference to the underlying index, which in turn contains schema information
+	name             string
+	store            *lsmkv.Store
+	counter          *indexcounter.Counter
+	vectorIndex      VectorIndex
+	invertedRowCache *inverted.RowCacher
+	// filterCache is nil unless config.FilterCache is enabled
+	filterCache       *inverted.FilterCache
 	metrics           *Metrics
 	promMetrics       *monitoring.PrometheusMetrics
 	propertyIndices   propertyspecific.Indices
@@ -60,6 +66,8 @@ type Shard struct {
 	resourceScanState *resourceScanState
 
 	status              storagestate.Status
+	statusReason        statusReason
+	writeErrors         int
 	statusLock          sync.Mutex
 	propertyIndicesLock sync.RWMutex
 	stopMetrics         chan struct{}
@@ -67,8 +75,39 @@ type Shard struct {
 	centralJobQueue chan job // reference to queue used by all shards
 
 	docIdLock []sync.Mutex
+	changes   changeTracker
 	// replication
 	replicationMap pendingReplicaTasks
+	// writeLease serializes writes if this node leads the shard, see
+	// Index.ShardLeader
+	writeLease writeLease
+	// vectorQueue is only set with async or deferred indexing, it is created
+	// under the vectorQueueLock
+	vectorQueue     atomic.Pointer[indexQueue]
+	vectorQueueLock sync.Mutex
+	// vectorIndexLock guards the vectorIndex, which is swapped once it has
+	// been rebuilt, see getVectorIndex
+	vectorIndexLock sync.RWMutex
+	// vectorReindex is set while the vector index is rebuilt
+	vectorReindex atomic.Pointer[vectorReindex]
+	// targetVectors are the indexes of the named vectors of the class, they
+	// are created with the shard and never swapped
+	targetVectors map[string]VectorIndex
+	// stopObjectTTL stops the janitor deleting expired objects
+	stopObjectTTL context.CancelFunc
+	// stopInvertedCleanup stops the cleanup cycle of the inverted index
+	stopInvertedCleanup context.CancelFunc
+	// dynamicUpgrade is only set while a dynamic vector index still uses its
+	// flat index
+	dynamicUpgrade *dynamicUpgrade
+	// migrationLock is held for reading by writes of objects and for writing
+	// while a schema migration swaps its buckets, see beginMigrationWrite
+	migrationLock sync.RWMutex
+	// migration is set while a schema migration rewrites the shard
+	migration atomic.Pointer[propertyMigration]
+
+	// objectVersionsLock guards the creation of the object versions bucket
+	objectVersionsLock sync.Mutex
 }
 
 func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
@@ -97,72 +136,131 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 	}
 
 	s.docIdLock = make([]sync.Mutex, IdLockPoolSize)
+	if cfg := index.Config.FilterCache; cfg.Enabled {
+		s.filterCache = inverted.NewFilterCache(uint64(cfg.MaxSizeMB) * 1024 * 1024)
+	}
 
 	defer s.metrics.ShardStartup(before)
 
-	hnswUserConfig, ok := index.vectorIndexUserConfig.(hnswent.UserConfig)
-	if !ok {
-		return nil, errors.Errorf("hnsw vector index: config is not hnsw.UserConfig: %T",
+	hnswUserConfig, isHNSW := index.vectorIndexUserConfig.(hnswent.UserConfig)
+	flatUserConfig, isFlat := index.vectorIndexUserConfig.(flatent.UserConfig)
+	dynamicUserConfig, isDynamic := index.vectorIndexUserConfig.(dynament.UserConfig)
+	if !isHNSW && !isFlat && !isDynamic {
+		return nil, errors.Errorf("unsupported vector index config: %T",
 			index.vectorIndexUserConfig)
 	}
 
-	if hnswUserConfig.Skip {
-		s.vectorIndex = noop.NewIndex()
-	} else {
-		if err := s.initVectorIndex(ctx, hnswUserConfig); err != nil {
-			return nil, fmt.Errorf("init vector index: %w", err)
-		}
+	if isHNSW {
+		if hnswUserConfig.Skip {
+			s.vectorIndex = noop.NewIndex()
+		} else {
+			if err := s.initVectorIndex(ctx, hnswUserConfig); err != nil {
+				return nil, fmt.Errorf("init vector index: %w", err)
+			}
 
-		defer s.vectorIndex.PostStartup()
+			defer s.vectorIndex.PostStartup()
+		}
 	}
 
 	if err := s.initNonVector(ctx, class); err != nil {
 		return nil, errors.Wrapf(err, "init shard %q", s.ID())
 	}
 
+	// the flat index keeps its vectors in the lsm store of the shard, so it
+	// can only be initialized once the store is
+	if isFlat {
+		if err := s.initFlatVectorIndex(flatUserConfig); err != nil {
+			return nil, fmt.Errorf("init vector index: %w", err)
+		}
+	}
+
+	if isDynamic {
+		if err := s.initDynamicVectorIndex(ctx, dynamicUserConfig); err != nil {
+			return nil, fmt.Errorf("init vector index: %w", err)
+		}
+	}
+
+	if err := s.initTargetVectors(class); err != nil {
+		return nil, err
+	}
+
+	if err := s.initIndexQueue(ctx, hnswUserConfig.DeferIndexing); err != nil {
+		return nil, errors.Wrapf(err, "init shard %q: index queue", s.ID())
+	}
+
+	s.initObjectTTL()
+	s.initInvertedCleanup()
+
 	return s, nil
 }
 
 func (s *Shard) initVectorIndex(
 	ctx context.Context, hnswUserConfig hnswent.UserConfig,
 ) error {
-	var distProv distancer.Provider
+	gen, err := s.vectorIndexGeneration()
+	if err != nil {
+		return errors.Wrapf(err, "init shard %q", s.ID())
+	}
+	if gen > 0 {
+		// the previous index is left over if the shard stopped right after
+		// the rebuilt one replaced it
+		if err := os.RemoveAll(filepath.Join(s.index.Config.RootPath,
+			s.vectorIndexID(gen-1)+".hnsw.commitlog.d")); err != nil {
+			return errors.Wrapf(err, "init shard %q: remove previous hnsw index", s.ID())
+		}
+	}
 
-	switch hnswUserConfig.Distance {
+	vi, err := s.newHNSWIndex(s.vectorIndexID(gen), hnswUserConfig)
+	if err != nil {
+		return errors.Wrapf(err, "init shard %q: hnsw index", s.ID())
+	}
+	s.vectorIndex = vi
+
+	return nil
+}
+
+func (s *Shard) initFlatVectorIndex(flatUserConfig flatent.UserConfig) error {
+	if flatUserConfig.Skip {
+		s.vectorIndex = noop.NewIndex()
+		return nil
+	}
+
+	distProv, err := distanceProvider(flatUserConfig.Distance)
+	if err != nil {
+		return err
+	}
+
+	vi, err := flat.New(flat.Config{
+		ID:               s.ID(),
+		Logger:           s.index.logger,
+		DistanceProvider: distProv,
+		Store:            s.store,
+	}, flatUserConfig)
+	if err != nil {
+		return errors.Wrapf(err, "init shard %q: flat index", s.ID())
+	}
+	vi.PostStartup()
+	s.vectorIndex = vi
+
+	return nil
+}
+
+func distanceProvider(distance string) (distancer.Provider, error) {
+	switch distance {
 	case "", hnswent.DistanceCosine:
-		distProv = distancer.NewCosineDistanceProvider()
+		return distancer.NewCosineDistanceProvider(), nil
 	case hnswent.DistanceDot:
-		distProv = distancer.NewDotProductProvider()
+		return distancer.NewDotProductProvider(), nil
 	case hnswent.DistanceL2Squared:
-		distProv = distancer.NewL2SquaredProvider()
+		return distancer.NewL2SquaredProvider(), nil
 	case hnswent.DistanceManhattan:
-		distProv = distancer.NewManhattanProvider()
+		return distancer.NewManhattanProvider(), nil
 	case hnswent.DistanceHamming:
-		distProv = distancer.NewHammingProvider()
+		return distancer.NewHammingProvider(), nil
 	default:
-		return errors.Errorf("unrecognized distance metric %q,"+
-			"choose one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]", hnswUserConfig.Distance)
-	}
-
-	vi, err := hnsw.New(hnsw.Config{
-		Logger:            s.index.logger,
-		RootPath:          s.index.Config.RootPath,
-		ID:                s.ID(),
-		ShardName:         s.name,
-		ClassName:         s.index.Config.ClassName.String(),
-		PrometheusMetrics: s.promMetrics,
-		MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
-			return hnsw.NewCommitLogger(s.index.Config.RootPath, s.ID(), s.index.logger)
-		},
-		VectorForIDThunk: s.vectorByIndexID,
-		DistanceProvider: distProv,
-	}, hnswUserConfig)
-	if err != nil {
-		return errors.Wrapf(err, "init shard %q: hnsw index", s.ID())
+		return nil, errors.Errorf("unrecognized distance metric %q,"+
+			"choose one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]", distance)
 	}
-	s.vectorIndex = vi
-
-	return nil
 }
 
 func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
@@ -230,18 +328,39 @@ func (s *Shard) initLSMStore(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrapf(err, "init lsmkv store at %s", s.DBPathLSM())
 	}
+	store.SetCompactionScheduler(s.index.Config.CompactionScheduler)
+	store.SetWALCompression(s.index.Config.WALCompression)
 
-	err = store.CreateOrLoadBucket(ctx, helpers.ObjectsBucketLSM,
+	objectsOpts := []lsmkv.BucketOption{
 		lsmkv.WithStrategy(lsmkv.StrategyReplace),
 		lsmkv.WithSecondaryIndices(1),
 		lsmkv.WithMonitorCount(),
 		s.dynamicMemtableSizing(),
 		s.memtableIdleConfig(),
-	)
+		lsmkv.WithWALRetention(s.index.getInvertedIndexConfig().WALRetention),
+	}
+	if remote := s.index.Config.RemoteSegments; remote != nil {
+		cfg := *remote
+		cfg.Prefix = path.Join(cfg.Prefix, s.name, helpers.ObjectsBucketLSM)
+		objectsOpts = append(objectsOpts, lsmkv.WithRemoteSegments(cfg))
+	}
+	err = store.CreateOrLoadBucket(ctx, helpers.ObjectsBucketLSM, objectsOpts...)
 	if err != nil {
 		return errors.Wrap(err, "create objects bucket")
 	}
 
+	err = store.CreateOrLoadBucket(ctx, helpers.DeletionsBucketLSM,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		s.memtableIdleConfig(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "create deletions bucket")
+	}
+
+	if err := s.initObjectVersionsBucket(ctx, store); err != nil {
+		return err
+	}
+
 	s.store = store
 
 	return nil
@@ -250,6 +369,14 @@ func (s *Shard) initLSMStore(ctx context.Context) error {
 func (s *Shard) drop() error {
 	s.replicationMap.clear()
 
+	if s.stopObjectTTL != nil {
+		s.stopObjectTTL()
+	}
+	if s.stopInvertedCleanup != nil {
+		s.stopInvertedCleanup()
+	}
+	s.cancelDynamicUpgrade()
+
 	if s.index.Config.TrackVectorDimensions {
 		// tracking vector dimensions goroutine only works when tracking is enabled
 		// that's why we are trying to stop it only in this case
@@ -284,11 +411,26 @@ func (s *Shard) drop() error {
 	if err != nil {
 		return errors.Wrapf(err, "remove indexcount at %s", s.DBPathLSM())
 	}
+	if q := s.vectorQueue.Load(); q != nil {
+		if err := q.Drop(); err != nil {
+			return errors.Wrapf(err, "remove index queue at %s", s.DBPathLSM())
+		}
+	}
+
 	// remove vector index
-	err = s.vectorIndex.Drop(ctx)
+	err = s.getVectorIndex().Drop(ctx)
 	if err != nil {
 		return errors.Wrapf(err, "remove vector index at %s", s.DBPathLSM())
 	}
+	if err := os.Remove(s.vectorIndexGenerationPath()); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove vector index generation")
+	}
+	if err := os.Remove(s.timestampIndexingPath()); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove timestamp indexing marker")
+	}
+	if err := s.dropTargetVectorIndexes(ctx); err != nil {
+		return errors.Wrapf(err, "remove vector index at %s", s.DBPathLSM())
+	}
 
 	// delete indexcount
 	err = s.propLengths.Drop()
@@ -454,6 +596,31 @@ func (s *Shard) addLastUpdateTimeUnixProperty(ctx context.Context) error {
 	return nil
 }
 
+// addLanguageProperty creates the buckets of the languages detected in the
+// values of properties with language detection
+func (s *Shard) addLanguageProperty(ctx context.Context) error {
+	if s.isReadOnly() {
+		return storagestate.ErrStatusReadOnly
+	}
+
+	err := s.store.CreateOrLoadBucket(ctx,
+		helpers.BucketFromPropNameLSM(filters.InternalPropLanguage),
+		s.memtableIdleConfig(),
+		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet))
+	if err != nil {
+		return err
+	}
+	err = s.store.CreateOrLoadBucket(ctx,
+		helpers.HashBucketFromPropNameLSM(filters.InternalPropLanguage),
+		s.memtableIdleConfig(),
+		lsmkv.WithStrategy(lsmkv.StrategyReplace))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (s *Shard) memtableIdleConfig() lsmkv.BucketOption {
 	return lsmkv.WithIdleThreshold(
 		time.Duration(s.index.Config.MemtablesFlushIdleAfter) * time.Second)
@@ -536,13 +703,35 @@ func (s *Shard) updateVectorIndexConfig(ctx context.Context,
 		return storagestate.ErrStatusReadOnly
 	}
 
+	if parsed, ok := updated.(dynament.UserConfig); ok {
+		current, err := s.dynamicVectorIndexConfig(parsed)
+		if err != nil {
+			return errors.Wrap(err, "update dynamic vector index")
+		}
+		updated = current
+	}
+
+	if parsed, ok := updated.(hnswent.UserConfig); ok {
+		if err := s.setDeferIndexing(parsed.DeferIndexing); err != nil {
+			return errors.Wrap(err, "update deferred indexing")
+		}
+	}
+
 	s.updateStatus(storagestate.StatusReadOnly.String())
-	return s.vectorIndex.UpdateUserConfig(updated, func() {
+	return s.getVectorIndex().UpdateUserConfig(updated, func() {
 		s.updateStatus(storagestate.StatusReady.String())
 	})
 }
 
 func (s *Shard) shutdown(ctx context.Context) error {
+	if s.stopObjectTTL != nil {
+		s.stopObjectTTL()
+	}
+	if s.stopInvertedCleanup != nil {
+		s.stopInvertedCleanup()
+	}
+	s.cancelDynamicUpgrade()
+
 	if s.index.Config.TrackVectorDimensions {
 		// tracking vector dimensions goroutine only works when tracking is enabled
 		// that's why we are trying to stop it only in this case
@@ -553,19 +742,30 @@ func (s *Shard) shutdown(ctx context.Context) error {
 		return errors.Wrap(err, "close prop length tracker")
 	}
 
+	// queued operations are applied after the next startup
+	if q := s.vectorQueue.Load(); q != nil {
+		if err := q.Close(); err != nil {
+			return errors.Wrap(err, "close index queue")
+		}
+	}
+
 	// to ensure that all commitlog entries are written to disk.
 	// otherwise in some cases the tombstone cleanup process'
 	// 'RemoveTombstone' entry is not picked up on restarts
 	// resulting in perpetually attempting to remove a tombstone
 	// which doesn't actually exist anymore
-	if err := s.vectorIndex.Flush(); err != nil {
+	if err := s.getVectorIndex().Flush(); err != nil {
 		return errors.Wrap(err, "flush vector index commitlog")
 	}
 
-	if err := s.vectorIndex.Shutdown(ctx); err != nil {
+	if err := s.getVectorIndex().Shutdown(ctx); err != nil {
 		return errors.Wrap(err, "shut down vector index")
 	}
 
+	if err := s.shutdownTargetVectorIndexes(ctx); err != nil {
+		return err
+	}
+
 	return s.store.Shutdown(ctx)
 }
 
@@ -584,3 +784,13 @@ func (s *Shard) objectCount() int {
 
 	return b.Count()
 }
+
+func (s *Shard) checkVectorIndexIntegrity(ctx context.Context,
+	repair bool,
+) (hnswent.IntegrityReport, error) {
+	if repair && s.isReadOnly() {
+		return hnswent.IntegrityReport{}, storagestate.ErrStatusReadOnly
+	}
+
+	return s.getVectorIndex().CheckIntegrity(ctx, repair)
+}
@@ -21,8 +21,13 @@ import (
 func (s *Shard) aggregate(ctx context.Context,
 	params aggregation.Params,
 ) (*aggregation.Result, error) {
+	if params.Sample != nil {
+		sample := params.Sample.ForShard(s.name)
+		params.Sample = &sample
+	}
+
 	return aggregator.New(s.store, params, s.index.getSchema, s.invertedRowCache,
 		s.index.classSearcher, s.deletedDocIDs, s.index.stopwords, s.versioner.Version(),
-		s.vectorIndex, s.index.logger, s.propLengths).
+		s.getVectorIndex(), s.index.logger, s.propLengths).
 		Do(ctx)
 }
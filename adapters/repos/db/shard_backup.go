@@ -38,12 +38,26 @@ func (s *Shard) beginBackup(ctx context.Context) (err error) {
 	if err = s.store.FlushMemtables(ctx); err != nil {
 		return errors.Wrap(err, "flush memtables")
 	}
-	if err = s.vectorIndex.PauseMaintenance(ctx); err != nil {
+	if err = s.getVectorIndex().PauseMaintenance(ctx); err != nil {
 		return errors.Wrap(err, "pause maintenance")
 	}
-	if err = s.vectorIndex.SwitchCommitLogs(ctx); err != nil {
+	for target, index := range s.targetVectors {
+		if err = index.PauseMaintenance(ctx); err != nil {
+			return errors.Wrapf(err, "pause maintenance of target vector %q", target)
+		}
+	}
+	if q := s.vectorQueue.Load(); q != nil {
+		// the offset of the queue must match the backed up vector index
+		q.pause()
+	}
+	if err = s.getVectorIndex().SwitchCommitLogs(ctx); err != nil {
 		return errors.Wrap(err, "switch commit logs")
 	}
+	for target, index := range s.targetVectors {
+		if err = index.SwitchCommitLogs(ctx); err != nil {
+			return errors.Wrapf(err, "switch commit logs of target vector %q", target)
+		}
+	}
 	return nil
 }
 
@@ -56,14 +70,47 @@ func (s *Shard) listBackupFiles(ctx context.Context, ret *backup.ShardDescriptor
 	if ret.Files, err = s.store.ListFiles(ctx); err != nil {
 		return err
 	}
-	files2, err := s.vectorIndex.ListFiles(ctx)
+	if ret.Generations, err = s.segmentGenerations(ret.Files); err != nil {
+		return err
+	}
+	files2, err := s.getVectorIndex().ListFiles(ctx)
 	if err != nil {
 		return err
 	}
 	ret.Files = append(ret.Files, files2...)
+	for _, index := range s.targetVectors {
+		files, err := index.ListFiles(ctx)
+		if err != nil {
+			return err
+		}
+		ret.Files = append(ret.Files, files...)
+	}
+	if _, err := os.Stat(s.vectorIndexGenerationPath()); err == nil {
+		ret.Files = append(ret.Files, path.Base(s.vectorIndexGenerationPath()))
+	}
+	if q := s.vectorQueue.Load(); q != nil {
+		ret.Files = append(ret.Files, q.files()...)
+	}
 	return nil
 }
 
+// segmentGenerations identifies the contents of the LSM segments among files,
+// so that incremental backups only upload segments which changed
+func (s *Shard) segmentGenerations(files []string) (map[string]string, error) {
+	gens := make(map[string]string, len(files))
+	for _, file := range files {
+		if !backup.IsSegmentFile(file) {
+			continue
+		}
+		info, err := os.Stat(path.Join(s.index.Config.RootPath, file))
+		if err != nil {
+			return nil, fmt.Errorf("stat segment %s: %w", file, err)
+		}
+		gens[file] = backup.SegmentGeneration(info)
+	}
+	return gens, nil
+}
+
 func (s *Shard) resumeMaintenanceCycles(ctx context.Context) error {
 	var g errgroup.Group
 
@@ -72,14 +119,25 @@ func (s *Shard) resumeMaintenanceCycles(ctx context.Context) error {
 	})
 
 	g.Go(func() error {
-		return s.vectorIndex.ResumeMaintenance(ctx)
+		return s.getVectorIndex().ResumeMaintenance(ctx)
 	})
 
+	for _, index := range s.targetVectors {
+		index := index
+		g.Go(func() error {
+			return index.ResumeMaintenance(ctx)
+		})
+	}
+
 	if err := g.Wait(); err != nil {
 		return errors.Wrapf(err,
 			"failed to resume maintenance cycles for shard '%s'", s.name)
 	}
 
+	if q := s.vectorQueue.Load(); q != nil {
+		q.resume()
+	}
+
 	return nil
 }
 
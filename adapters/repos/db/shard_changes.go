@@ -0,0 +1,267 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/changes"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// The changes feed of a shard is based on its doc ids. Since every write
+// allocates a new doc id, the doc ids form a logical clock per shard: an
+// object which is visible at doc id n has been written after all objects with
+// lower doc ids. Deletions allocate a doc id from the same counter and are
+// recorded in the deletions bucket, keyed by that doc id.
+//
+// Reference additions of batch imports re-use the existing doc id (see
+// mutableMergeObjectLSM) and are therefore not part of the feed.
+
+var (
+	// deletionsRetention is the time for which deletions are kept, consumers
+	// with older tokens need to do a full resync
+	deletionsRetention = 7 * 24 * time.Hour
+	// deletionsPruneInterval limits how often expired deletions are pruned
+	deletionsPruneInterval = time.Hour
+	// deletionsWatermarkKey stores the doc id below which deletions have been
+	// pruned. It can never collide with a real doc id.
+	deletionsWatermarkKey = []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+)
+
+// changeTracker keeps track of the doc ids which have been allocated, but
+// whose writes are not yet visible. The feed never advances beyond them, so
+// concurrent writes can't be skipped.
+type changeTracker struct {
+	sync.Mutex
+	inFlight  map[uint64]struct{}
+	lastPrune time.Time
+}
+
+// allocateDocID must be paired with a call to releaseDocID once the write is
+// visible or has failed
+func (s *Shard) allocateDocID() (uint64, error) {
+	s.changes.Lock()
+	defer s.changes.Unlock()
+
+	docID, err := s.counter.GetAndInc()
+	if err != nil {
+		return 0, err
+	}
+
+	if s.changes.inFlight == nil {
+		s.changes.inFlight = map[uint64]struct{}{}
+	}
+	s.changes.inFlight[docID] = struct{}{}
+	return docID, nil
+}
+
+func (s *Shard) releaseDocID(docID uint64) {
+	s.changes.Lock()
+	defer s.changes.Unlock()
+
+	delete(s.changes.inFlight, docID)
+	s.index.notifyWrite()
+}
+
+// changesHorizon returns the doc id below which all writes are visible
+func (s *Shard) changesHorizon() uint64 {
+	s.changes.Lock()
+	defer s.changes.Unlock()
+
+	horizon := s.counter.Get()
+	for docID := range s.changes.inFlight {
+		if docID < horizon {
+			horizon = docID
+		}
+	}
+	return horizon
+}
+
+func (s *Shard) recordDeletion(idBytes []byte) error {
+	seq, err := s.allocateDocID()
+	if err != nil {
+		return errors.Wrap(err, "allocate deletion sequence")
+	}
+	defer s.releaseDocID(seq)
+
+	value := make([]byte, 24)
+	copy(value, idBytes)
+	binary.BigEndian.PutUint64(value[16:], uint64(time.Now().UnixMilli()))
+
+	bucket := s.store.Bucket(helpers.DeletionsBucketLSM)
+	if err := bucket.Put(deletionKey(seq), value); err != nil {
+		return errors.Wrap(err, "record deletion")
+	}
+
+	return s.maybePruneDeletions()
+}
+
+func (s *Shard) maybePruneDeletions() error {
+	s.changes.Lock()
+	if time.Since(s.changes.lastPrune) < deletionsPruneInterval {
+		s.changes.Unlock()
+		return nil
+	}
+	s.changes.lastPrune = time.Now()
+	s.changes.Unlock()
+
+	return s.pruneDeletions(time.Now().Add(-deletionsRetention))
+}
+
+// pruneDeletions removes all deletions before the cutoff. Deletions are
+// ordered by their sequence and therefore also by time, so the scan stops at
+// the first deletion which has to be kept.
+func (s *Shard) pruneDeletions(cutoff time.Time) error {
+	bucket := s.store.Bucket(helpers.DeletionsBucketLSM)
+
+	var expired [][]byte
+	watermark := uint64(0)
+	c := bucket.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if len(k) != 8 || len(v) != 24 || isWatermarkKey(k) {
+			break
+		}
+		if int64(binary.BigEndian.Uint64(v[16:])) >= cutoff.UnixMilli() {
+			break
+		}
+		expired = append(expired, append([]byte{}, k...))
+		watermark = binary.BigEndian.Uint64(k) + 1
+	}
+	c.Close()
+
+	if len(expired) == 0 {
+		return nil
+	}
+
+	if err := bucket.Put(deletionsWatermarkKey, deletionKey(watermark)); err != nil {
+		return errors.Wrap(err, "store deletions watermark")
+	}
+
+	for _, k := range expired {
+		if err := bucket.Delete(k); err != nil {
+			return errors.Wrap(err, "prune deletion")
+		}
+	}
+
+	return nil
+}
+
+func (s *Shard) deletionsWatermark() (uint64, error) {
+	v, err := s.store.Bucket(helpers.DeletionsBucketLSM).Get(deletionsWatermarkKey)
+	if err != nil {
+		return 0, err
+	}
+	if len(v) != 8 {
+		return 0, nil
+	}
+	return binary.BigEndian.Uint64(v), nil
+}
+
+// changesSince returns up to limit changes starting at the token. To bound
+// the cost of a single request, at most maxScan sequences are inspected,
+// the consumer continues with the returned token in that case.
+func (s *Shard) changesSince(ctx context.Context, token string,
+	limit int,
+) (*changes.Changes, error) {
+	from, err := changes.ParseToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	watermark, err := s.deletionsWatermark()
+	if err != nil {
+		return nil, errors.Wrap(err, "read deletions watermark")
+	}
+	if from < watermark {
+		return nil, changes.ErrExpired
+	}
+
+	horizon := s.changesHorizon()
+	maxScan := uint64(100 * limit)
+
+	objects := s.store.Bucket(helpers.ObjectsBucketLSM)
+	c := s.store.Bucket(helpers.DeletionsBucketLSM).Cursor()
+	defer c.Close()
+
+	out := &changes.Changes{
+		Objects:   []*models.Object{},
+		Deletions: []changes.Deletion{},
+	}
+
+	nextDeletion, deletion := c.Seek(deletionKey(from))
+	docIDBytes := make([]byte, 8)
+	seq := from
+	for ; seq < horizon && seq-from < maxScan; seq++ {
+		if len(out.Objects)+len(out.Deletions) >= limit {
+			break
+		}
+
+		if seq%1000 == 0 && ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		if nextDeletion != nil && !isWatermarkKey(nextDeletion) &&
+			binary.BigEndian.Uint64(nextDeletion) == seq {
+			id, err := uuid.FromBytes(deletion[:16])
+			if err != nil {
+				return nil, errors.Wrap(err, "parse deleted id")
+			}
+			out.Deletions = append(out.Deletions, changes.Deletion{
+				ID:        strfmt.UUID(id.String()),
+				DeletedAt: int64(binary.BigEndian.Uint64(deletion[16:])),
+			})
+			nextDeletion, deletion = c.Next()
+			continue
+		}
+
+		binary.LittleEndian.PutUint64(docIDBytes, seq)
+		res, err := objects.GetBySecondary(0, docIDBytes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get object with doc id %d", seq)
+		}
+		if res == nil {
+			// updated or deleted since
+			continue
+		}
+
+		obj, err := storobj.FromBinary(res)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unmarshal object with doc id %d", seq)
+		}
+		out.Objects = append(out.Objects,
+			obj.SearchResult(additional.Properties{}).ObjectWithVector(true))
+	}
+
+	out.Next = changes.FormatToken(seq)
+	return out, nil
+}
+
+func deletionKey(seq uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+	return key
+}
+
+func isWatermarkKey(key []byte) bool {
+	return len(key) == 8 && binary.BigEndian.Uint64(key) == math.MaxUint64
+}
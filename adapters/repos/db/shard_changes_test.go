@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/changes"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+func TestShard_ChangesSince(t *testing.T) {
+	ctx := context.Background()
+	className := "TestClass"
+	shd, _ := testShard(t, ctx, className)
+
+	objs := make([]*storobj.Object, 5)
+	for i := range objs {
+		objs[i] = testObject(className)
+		require.Nil(t, shd.putObject(ctx, objs[i]))
+	}
+
+	var token string
+
+	t.Run("read all objects in pages", func(t *testing.T) {
+		var ids []strfmt.UUID
+		for {
+			res, err := shd.changesSince(ctx, token, 2)
+			require.Nil(t, err)
+			assert.Empty(t, res.Deletions)
+			for _, obj := range res.Objects {
+				ids = append(ids, obj.ID)
+			}
+			if res.Next == token {
+				break
+			}
+			token = res.Next
+		}
+
+		require.Len(t, ids, len(objs))
+		for i := range objs {
+			assert.Equal(t, objs[i].ID(), ids[i])
+		}
+	})
+
+	t.Run("update and delete objects", func(t *testing.T) {
+		objs[1].Object.Properties = map[string]interface{}{}
+		require.Nil(t, shd.putObject(ctx, objs[1]))
+		require.Nil(t, shd.deleteObject(ctx, objs[3].ID()))
+	})
+
+	t.Run("only the changes are returned", func(t *testing.T) {
+		res, err := shd.changesSince(ctx, token, 10)
+		require.Nil(t, err)
+
+		require.Len(t, res.Objects, 1)
+		assert.Equal(t, objs[1].ID(), res.Objects[0].ID)
+		require.Len(t, res.Deletions, 1)
+		assert.Equal(t, objs[3].ID(), res.Deletions[0].ID)
+
+		res2, err := shd.changesSince(ctx, res.Next, 10)
+		require.Nil(t, err)
+		assert.Empty(t, res2.Objects)
+		assert.Empty(t, res2.Deletions)
+		assert.Equal(t, res.Next, res2.Next)
+	})
+
+	t.Run("pruned deletions expire older tokens", func(t *testing.T) {
+		require.Nil(t, shd.pruneDeletions(time.Now().Add(time.Minute)))
+
+		_, err := shd.changesSince(ctx, token, 10)
+		assert.ErrorIs(t, err, changes.ErrExpired)
+
+		_, err = shd.changesSince(ctx, "", 10)
+		assert.ErrorIs(t, err, changes.ErrExpired)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		_, err := shd.changesSince(ctx, "not a token!", 10)
+		assert.ErrorIs(t, err, changes.ErrInvalidToken)
+	})
+}
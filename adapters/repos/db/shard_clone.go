@@ -0,0 +1,269 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// CloneShard copies a crash-consistent snapshot of a local shard into an
+// empty local shard of another class, e.g. to validate a schema or index
+// config migration against real data before switching over to the new class.
+//
+// The snapshot is taken the same way as for backups: memtables are flushed,
+// compactions are paused and the commit log of the vector index is cut.
+// Writes to the source shard which happen after the cut are not part of the
+// clone. The data is copied as is, so settings which only apply at query
+// time take effect on the clone, while the data must be compatible with the
+// target class, see checkCloneCompatible.
+func (db *DB) CloneShard(ctx context.Context, sourceClass, sourceShard,
+	targetClass, targetShard string,
+) error {
+	if sourceClass == targetClass && sourceShard == targetShard {
+		return fmt.Errorf("cannot clone shard %q of class %q into itself",
+			sourceShard, sourceClass)
+	}
+
+	srcIdx := db.GetIndex(schema.ClassName(sourceClass))
+	if srcIdx == nil {
+		return fmt.Errorf("no index for class %q", sourceClass)
+	}
+	dstIdx := db.GetIndex(schema.ClassName(targetClass))
+	if dstIdx == nil {
+		return fmt.Errorf("no index for class %q", targetClass)
+	}
+
+	src, ok := srcIdx.Shards[sourceShard]
+	if !ok {
+		return fmt.Errorf("no local shard %q for class %q", sourceShard, sourceClass)
+	}
+	dst, ok := dstIdx.Shards[targetShard]
+	if !ok {
+		return fmt.Errorf("no local shard %q for class %q", targetShard, targetClass)
+	}
+
+	if err := cloneShard(ctx, src, dst); err != nil {
+		return err
+	}
+
+	db.logger.WithField("action", "clone_shard").
+		WithField("source_class", sourceClass).
+		WithField("source_shard", sourceShard).
+		WithField("target_class", targetClass).
+		WithField("target_shard", targetShard).
+		Infof("cloned shard %s into %s", src.ID(), dst.ID())
+
+	return nil
+}
+
+func cloneShard(ctx context.Context, src, dst *Shard) (err error) {
+	srcIdx, dstIdx := src.index, dst.index
+	if err := checkCloneCompatible(srcIdx, dstIdx); err != nil {
+		return err
+	}
+	if count := dst.objectCount(); count > 0 {
+		return fmt.Errorf("target shard %q of class %q is not empty, it contains %d objects",
+			dst.name, dstIdx.Config.ClassName, count)
+	}
+
+	// the backup state prevents backups of the source class from resuming the
+	// maintenance cycles while the files are being copied
+	cloneID := fmt.Sprintf("clone-%s-%s", dstIdx.Config.ClassName, dst.name)
+	if err := srcIdx.initBackup(cloneID); err != nil {
+		return fmt.Errorf("init clone of class %q: %w", srcIdx.Config.ClassName, err)
+	}
+	defer func() {
+		if err2 := srcIdx.ReleaseBackup(ctx, cloneID); err2 != nil && err == nil {
+			err = fmt.Errorf("release clone of class %q: %w", srcIdx.Config.ClassName, err2)
+		}
+	}()
+
+	if err := src.beginBackup(ctx); err != nil {
+		return fmt.Errorf("class %q: shard %q: begin clone: %w",
+			srcIdx.Config.ClassName, src.name, err)
+	}
+	var snapshot backup.ShardDescriptor
+	if err := src.listBackupFiles(ctx, &snapshot); err != nil {
+		return fmt.Errorf("class %q: shard %q: list files: %w",
+			srcIdx.Config.ClassName, src.name, err)
+	}
+
+	if err := dst.replaceWith(ctx, src, &snapshot); err != nil {
+		return fmt.Errorf("class %q: shard %q: %w",
+			dstIdx.Config.ClassName, dst.name, err)
+	}
+
+	return nil
+}
+
+// checkCloneCompatible makes sure that the files of the source index can be
+// loaded by the target index and are interpreted the same way
+func checkCloneCompatible(src, dst *Index) error {
+	srcVec, err := commonVectorIndexSettings(src.vectorIndexUserConfig)
+	if err != nil {
+		return fmt.Errorf("class %q: %w", src.Config.ClassName, err)
+	}
+	dstVec, err := commonVectorIndexSettings(dst.vectorIndexUserConfig)
+	if err != nil {
+		return fmt.Errorf("class %q: %w", dst.Config.ClassName, err)
+	}
+	if srcType, dstType := src.vectorIndexUserConfig.IndexType(),
+		dst.vectorIndexUserConfig.IndexType(); srcType != dstType {
+		return fmt.Errorf("vector index type differs: %q != %q", srcType, dstType)
+	}
+	if srcVec.Skip != dstVec.Skip {
+		return fmt.Errorf("vector index skip setting differs: %t != %t",
+			srcVec.Skip, dstVec.Skip)
+	}
+	if srcVec.Distance != dstVec.Distance {
+		return fmt.Errorf("vector index distance differs: %q != %q",
+			srcVec.Distance, dstVec.Distance)
+	}
+
+	sch := src.getSchema.GetSchemaSkipAuth()
+	srcClass := sch.GetClass(src.Config.ClassName)
+	dstClass := sch.GetClass(dst.Config.ClassName)
+	if srcClass == nil || dstClass == nil {
+		return fmt.Errorf("class %q or %q not found in schema",
+			src.Config.ClassName, dst.Config.ClassName)
+	}
+
+	dstProps := make(map[string]*models.Property, len(dstClass.Properties))
+	for _, prop := range dstClass.Properties {
+		dstProps[strings.ToLower(prop.Name)] = prop
+	}
+	for _, prop := range srcClass.Properties {
+		dstProp, ok := dstProps[strings.ToLower(prop.Name)]
+		if !ok {
+			return fmt.Errorf("property %q is missing in class %q",
+				prop.Name, dst.Config.ClassName)
+		}
+		if !samePropertyIndexing(prop, dstProp) {
+			return fmt.Errorf("data type or indexing of property %q differs, "+
+				"the data would have to be reindexed", prop.Name)
+		}
+	}
+
+	return nil
+}
+
+func samePropertyIndexing(a, b *models.Property) bool {
+	if strings.Join(a.DataType, ",") != strings.Join(b.DataType, ",") {
+		return false
+	}
+	if a.Tokenization != b.Tokenization {
+		return false
+	}
+	return sameOptionalBool(a.IndexInverted, b.IndexInverted) &&
+		sameOptionalBool(a.IndexOnly, b.IndexOnly)
+}
+
+func sameOptionalBool(a, b *bool) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// replaceWith drops the contents of the shard and replaces them with the
+// files of a snapshot of the source shard
+func (s *Shard) replaceWith(ctx context.Context, src *Shard,
+	snapshot *backup.ShardDescriptor,
+) error {
+	counterPath := s.counter.FileName()
+	propLengthsPath := s.propLengths.FileName()
+	versionPath := s.versioner.path
+
+	if err := s.drop(); err != nil {
+		return fmt.Errorf("drop shard: %w", err)
+	}
+
+	root := s.index.Config.RootPath
+	srcRoot := src.index.Config.RootPath
+	for _, file := range snapshot.Files {
+		target, err := renameShardFile(file, src.ID(), s.ID())
+		if err != nil {
+			return err
+		}
+		if err := copyFile(filepath.Join(srcRoot, file),
+			filepath.Join(root, target)); err != nil {
+			return fmt.Errorf("copy %s: %w", file, err)
+		}
+	}
+
+	for fpath, content := range map[string][]byte{
+		counterPath:     snapshot.DocIDCounter,
+		propLengthsPath: snapshot.PropLengthTracker,
+		versionPath:     snapshot.Version,
+	} {
+		if err := os.WriteFile(fpath, content, os.ModePerm); err != nil {
+			return fmt.Errorf("write %s: %w", fpath, err)
+		}
+	}
+
+	sch := s.index.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(s.index.Config.ClassName)
+	if err := s.reload(ctx, class); err != nil {
+		return fmt.Errorf("reload shard: %w", err)
+	}
+
+	return nil
+}
+
+// renameShardFile maps a file of a shard, relative to the root path, to the
+// respective file of another shard. All files of a shard are prefixed with
+// its ID.
+func renameShardFile(file, srcID, dstID string) (string, error) {
+	rest := strings.TrimPrefix(file, srcID)
+	if rest == file || (!strings.HasPrefix(rest, "_") && !strings.HasPrefix(rest, ".")) {
+		return "", fmt.Errorf("file %q does not belong to shard %q", file, srcID)
+	}
+
+	return dstID + rest, nil
+}
+
+func copyFile(source, target string) error {
+	if err := os.MkdirAll(path.Dir(target), os.ModePerm); err != nil {
+		return fmt.Errorf("create parent folder: %w", err)
+	}
+
+	in, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,179 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestShard_Clone(t *testing.T) {
+	ctx := testCtx()
+	sourceClass, targetClass := "CloneSource", "CloneTarget"
+
+	schemaGetter := &fakeSchemaGetter{
+		schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+			{Class: sourceClass},
+			{Class: targetClass},
+		}}},
+	}
+	withConfig := func(idx *Index) {
+		idx.getSchema = schemaGetter
+		idx.vectorIndexUserConfig = enthnsw.NewDefaultUserConfig()
+	}
+
+	src, srcIdx := testShard(t, ctx, sourceClass, withConfig)
+	dst, dstIdx := testShard(t, ctx, targetClass, withConfig)
+
+	// both shards need to be known for the backup metadata
+	shardState := singleShardState()
+	physical := shardState.Physical[shardState.AllPhysicalShards()[0]]
+	shardState.Physical[src.name] = physical
+	shardState.Physical[dst.name] = physical
+	schemaGetter.shardState = shardState
+
+	amount := 10
+	for i := 0; i < amount; i++ {
+		require.Nil(t, src.putObject(ctx, testObject(sourceClass)))
+	}
+	// commit logs are named by the second they were created in, the cut
+	// would otherwise reopen the log which contains the inserts
+	time.Sleep(time.Second)
+
+	t.Run("clone into empty shard", func(t *testing.T) {
+		require.Nil(t, cloneShard(ctx, src, dst))
+
+		objs, err := dst.objectList(ctx, 2*amount, nil, nil,
+			additional.Properties{}, dstIdx.Config.ClassName)
+		require.Nil(t, err)
+		assert.Len(t, objs, amount)
+
+		res, _, err := dst.objectVectorSearch(ctx, []float32{1, 2, 3}, "", noDistanceThreshold,
+			2*amount, nil, nil, additional.Properties{})
+		require.Nil(t, err)
+		assert.Len(t, res, amount)
+	})
+
+	t.Run("source shard is still writable", func(t *testing.T) {
+		require.Nil(t, src.putObject(ctx, testObject(sourceClass)))
+		assert.Equal(t, amount+1, src.objectCount())
+	})
+
+	t.Run("clone is independent from the source", func(t *testing.T) {
+		require.Nil(t, dst.putObject(ctx, testObject(targetClass)))
+		assert.Equal(t, amount+1, dst.objectCount())
+	})
+
+	t.Run("target shard must be empty", func(t *testing.T) {
+		err := cloneShard(ctx, src, dst)
+		assert.ErrorContains(t, err, "not empty")
+	})
+
+	require.Nil(t, srcIdx.drop())
+	require.Nil(t, dstIdx.drop())
+}
+
+func TestCheckCloneCompatible(t *testing.T) {
+	vTrue := true
+	vFalse := false
+	textProp := func(tokenization string, indexInverted *bool) *models.Property {
+		return &models.Property{
+			Name:          "text",
+			DataType:      []string{string(schema.DataTypeText)},
+			Tokenization:  tokenization,
+			IndexInverted: indexInverted,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		target      []*models.Property
+		targetVec   enthnsw.UserConfig
+		expectedErr string
+	}{
+		{
+			name:   "same properties",
+			target: []*models.Property{textProp("word", &vTrue)},
+		},
+		{
+			name: "additional property",
+			target: []*models.Property{
+				textProp("word", &vTrue),
+				{Name: "other", DataType: []string{string(schema.DataTypeInt)}},
+			},
+		},
+		{
+			name:        "missing property",
+			target:      nil,
+			expectedErr: "property \"text\" is missing",
+		},
+		{
+			name:        "different tokenization",
+			target:      []*models.Property{textProp("field", &vTrue)},
+			expectedErr: "differs",
+		},
+		{
+			name:        "different indexing",
+			target:      []*models.Property{textProp("word", &vFalse)},
+			expectedErr: "differs",
+		},
+		{
+			name:        "different distance",
+			target:      []*models.Property{textProp("word", &vTrue)},
+			targetVec:   enthnsw.UserConfig{Distance: "l2-squared"},
+			expectedErr: "distance differs",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schemaGetter := &fakeSchemaGetter{schema: schema.Schema{
+				Objects: &models.Schema{Classes: []*models.Class{
+					{Class: "Source", Properties: []*models.Property{textProp("word", &vTrue)}},
+					{Class: "Target", Properties: test.target},
+				}},
+			}}
+			targetVec := enthnsw.NewDefaultUserConfig()
+			if test.targetVec.Distance != "" {
+				targetVec.Distance = test.targetVec.Distance
+			}
+
+			src := &Index{
+				Config:                IndexConfig{ClassName: "Source"},
+				vectorIndexUserConfig: enthnsw.NewDefaultUserConfig(),
+				getSchema:             schemaGetter,
+			}
+			dst := &Index{
+				Config:                IndexConfig{ClassName: "Target"},
+				vectorIndexUserConfig: targetVec,
+				getSchema:             schemaGetter,
+			}
+
+			err := checkCloneCompatible(src, dst)
+			if test.expectedErr == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.expectedErr)
+			}
+		})
+	}
+}
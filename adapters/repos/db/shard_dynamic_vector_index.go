@@ -0,0 +1,197 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/entities/schema"
+	dynament "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+)
+
+// dynamicUpgradeInterval is how often a shard with a dynamic vector index
+// checks whether it holds enough objects for an hnsw index
+var dynamicUpgradeInterval = 10 * time.Second
+
+// dynamicUpgrade is the janitor of a shard with a dynamic vector index, which
+// upgrades its flat index to hnsw
+type dynamicUpgrade struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// initDynamicVectorIndex starts the shard with a flat index, or with the hnsw
+// index it has been upgraded to already. The upgrade is a rebuild of the
+// vector index, so the generation marker tells which of both is in use.
+func (s *Shard) initDynamicVectorIndex(ctx context.Context,
+	cfg dynament.UserConfig,
+) error {
+	gen, err := s.vectorIndexGeneration()
+	if err != nil {
+		return errors.Wrapf(err, "init shard %q", s.ID())
+	}
+
+	if gen > 0 {
+		// the vectors of the flat index are left over from before the upgrade,
+		// the bucket isn't loaded anymore
+		if err := os.RemoveAll(filepath.Join(s.DBPathLSM(),
+			helpers.FlatVectorsBucketLSM)); err != nil {
+			return errors.Wrapf(err, "init shard %q: remove flat vector index", s.ID())
+		}
+		if err := s.initVectorIndex(ctx, cfg.HNSW); err != nil {
+			return err
+		}
+		s.vectorIndex.PostStartup()
+		return nil
+	}
+
+	if err := s.initFlatVectorIndex(cfg.Flat); err != nil {
+		return err
+	}
+	s.startDynamicUpgrade()
+	return nil
+}
+
+// startDynamicUpgrade checks periodically whether the shard crossed the
+// threshold of the dynamic index and builds the hnsw index if so. Writes
+// and searches keep using the flat index until the hnsw index is complete,
+// see reindexVectorIndex.
+func (s *Shard) startDynamicUpgrade() {
+	ctx, cancel := context.WithCancel(context.Background())
+	u := &dynamicUpgrade{cancel: cancel, done: make(chan struct{})}
+	s.dynamicUpgrade = u
+
+	go func() {
+		defer close(u.done)
+		t := time.NewTicker(dynamicUpgradeInterval)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+				upgraded, err := s.upgradeDynamicVectorIndex(ctx)
+				if err != nil && ctx.Err() == nil {
+					s.index.logger.WithField("action", "upgrade_vector_index").
+						WithField("shard", s.ID()).WithError(err).
+						Error("could not upgrade flat vector index to hnsw")
+				}
+				if upgraded {
+					return
+				}
+			}
+		}
+	}()
+}
+
+// cancelDynamicUpgrade stops the janitor without waiting for it, as a
+// running upgrade may wait for the backupStateLock held by the caller
+func (s *Shard) cancelDynamicUpgrade() {
+	if s.dynamicUpgrade != nil {
+		s.dynamicUpgrade.cancel()
+	}
+}
+
+// stopDynamicUpgrade stops the janitor and waits for it. It must not be
+// called with the backupStateLock held.
+func (s *Shard) stopDynamicUpgrade() {
+	if u := s.dynamicUpgrade; u != nil {
+		u.cancel()
+		<-u.done
+	}
+}
+
+// upgradeDynamicVectorIndex replaces the flat index with an hnsw index once
+// the shard holds at least as many objects as the threshold of the current
+// config. It reports whether the shard uses an hnsw index.
+func (s *Shard) upgradeDynamicVectorIndex(ctx context.Context) (bool, error) {
+	cfg, ok := s.index.currentVectorIndexConfig().(dynament.UserConfig)
+	if !ok || s.isReadOnly() || s.objectCount() < cfg.Threshold {
+		return false, nil
+	}
+
+	if err := s.index.beginReindex(); err != nil {
+		// a backup or a rebuild is in progress, try again later
+		return false, nil
+	}
+	defer s.index.endReindex()
+
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	// the vector index could have been rebuilt on request in the meantime
+	if gen, err := s.vectorIndexGeneration(); err != nil || gen > 0 {
+		return err == nil, err
+	}
+
+	if err := s.reindexVectorIndex(ctx, cfg.HNSW, func(int64) {}); err != nil {
+		return false, err
+	}
+
+	s.index.logger.WithField("action", "upgrade_vector_index").
+		WithField("shard", s.ID()).
+		Info("upgraded flat vector index to hnsw")
+	return true, nil
+}
+
+// dynamicVectorIndexConfig is the config of the index the dynamic index of
+// the shard currently uses
+func (s *Shard) dynamicVectorIndexConfig(cfg dynament.UserConfig,
+) (schema.VectorIndexConfig, error) {
+	gen, err := s.vectorIndexGeneration()
+	if err != nil {
+		return nil, err
+	}
+	if gen > 0 {
+		return cfg.HNSW, nil
+	}
+	return cfg.Flat, nil
+}
+
+// validateDynamicUserConfigUpdate applies the rules of both indexes, as
+// every shard uses one of them. The threshold can be changed at any time,
+// it only affects shards which haven't been upgraded yet.
+func validateDynamicUserConfigUpdate(initial, updated schema.VectorIndexConfig) error {
+	initialParsed, ok := initial.(dynament.UserConfig)
+	if !ok {
+		return errors.Errorf("initial is not dynamic.UserConfig, but %T", initial)
+	}
+
+	updatedParsed, ok := updated.(dynament.UserConfig)
+	if !ok {
+		return errors.Errorf("updated is not dynamic.UserConfig, but %T", updated)
+	}
+
+	if initialParsed.Distance != updatedParsed.Distance {
+		return errors.Errorf("distance is immutable: attempted change from \"%s\" to \"%s\"",
+			initialParsed.Distance, updatedParsed.Distance)
+	}
+
+	if err := hnsw.ValidateUserConfigUpdate(initialParsed.HNSW,
+		updatedParsed.HNSW); err != nil {
+		return errors.Wrap(err, "hnsw")
+	}
+
+	if err := flat.ValidateUserConfigUpdate(initialParsed.Flat,
+		updatedParsed.Flat); err != nil {
+		return errors.Wrap(err, "flat")
+	}
+
+	return nil
+}
@@ -31,6 +31,7 @@ func (s *Shard) initGeoProp(prop *models.Property) error {
 		CoordinatesForID:   s.makeCoordinatesForID(prop.Name),
 		DisablePersistence: false,
 		Logger:             s.index.logger,
+		WALCompression:     s.index.Config.WALCompression,
 	})
 	if err != nil {
 		return errors.Wrapf(err, "create geo index for prop %q", prop.Name)
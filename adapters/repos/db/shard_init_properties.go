@@ -15,6 +15,7 @@ import (
 	"context"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/propertyspecific"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -91,6 +92,16 @@ func (s *Shard) initProperties(class *models.Class) error {
 		})
 	}
 
+	if inverted.HasLanguageDetection(class.Properties) {
+		eg.Go(func() error {
+			if err := s.addLanguageProperty(context.TODO()); err != nil {
+				return errors.Wrap(err, "init language property")
+			}
+
+			return nil
+		})
+	}
+
 	if s.index.Config.TrackVectorDimensions {
 		eg.Go(func() error {
 			if err := s.addDimensionsProperty(context.TODO()); err != nil {
@@ -0,0 +1,223 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/querycost"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// vectorIndexForTarget resolves the vector index which holds the vectors for
+// the given target. The empty target is the default vector of the class.
+// The vectorIndexLock must be held.
+func (s *Shard) vectorIndexForTarget(target string) (VectorIndex, error) {
+	if target == "" {
+		return s.vectorIndex, nil
+	}
+	if index, ok := s.targetVectors[target]; ok {
+		return index, nil
+	}
+
+	return nil, errors.Errorf("target vector %q does not exist", target)
+}
+
+// objectMultiTargetVectorSearch searches each of the targets individually and
+// merges the results inside the shard using the requested combination
+// strategy, so that only a single, already fused, list leaves the shard.
+// Searches without a limit consider up to the maximum results of every
+// target, the target distance applies to the combined distances.
+func (s *Shard) objectMultiTargetVectorSearch(ctx context.Context,
+	targets searchparams.TargetVectors, targetDist float32, limit int,
+	filters *filters.LocalFilter, sort []filters.Sort, additional additional.Properties,
+) ([]*storobj.Object, []float32, error) {
+	if err := targets.Validate(); err != nil {
+		return nil, nil, errors.Wrap(err, "multi-target search")
+	}
+
+	var allowList helpers.AllowList
+	if filters != nil {
+		list, err := s.buildAllowList(ctx, filters, additional)
+		if err != nil {
+			return nil, nil, err
+		}
+		allowList = list
+	}
+
+	k := limit
+	if k < 0 {
+		k = int(s.index.Config.QueryMaximumResults)
+		if k < hnsw.DefaultSearchByDistInitialLimit {
+			k = hnsw.DefaultSearchByDistInitialLimit
+		}
+	}
+	ids := make([][]uint64, len(targets.Targets))
+	dists := make([][]float32, len(targets.Targets))
+	if err := s.searchTargets(ctx, targets, k, allowList, ids, dists); err != nil {
+		return nil, nil, err
+	}
+
+	fusedIDs, fusedDists := combineMultiTargetResults(targets.Combination, ids, dists, limit)
+	if targetDist != noDistanceThreshold {
+		pos := 0
+		for pos < len(fusedDists) && fusedDists[pos] <= targetDist {
+			pos++
+		}
+		fusedIDs, fusedDists = fusedIDs[:pos], fusedDists[:pos]
+	}
+	if len(fusedIDs) == 0 {
+		return nil, nil, nil
+	}
+
+	if len(sort) > 0 {
+		var err error
+		fusedIDs, fusedDists, err = s.sortDocIDsAndDists(ctx, limit, sort,
+			s.index.Config.ClassName, fusedIDs, fusedDists)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "multi-target search sort")
+		}
+	}
+
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	objs, err := storobj.ObjectsByDocIDWithCost(bucket, fusedIDs, additional,
+		querycost.FromContext(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return objs, fusedDists, nil
+}
+
+// searchTargets searches the vector index of every target, the vector
+// indexes are not swapped meanwhile
+func (s *Shard) searchTargets(ctx context.Context, targets searchparams.TargetVectors,
+	limit int, allowList helpers.AllowList, ids [][]uint64, dists [][]float32,
+) error {
+	s.vectorIndexLock.RLock()
+	defer s.vectorIndexLock.RUnlock()
+
+	for i, target := range targets.Targets {
+		index, err := s.vectorIndexForTarget(target)
+		if err != nil {
+			return err
+		}
+
+		ids[i], dists[i], err = searchByVector(ctx, index, targets.Vectors[i],
+			noDistanceThreshold, limit, int64(limit), allowList)
+		if err != nil {
+			return errors.Wrapf(err, "vector search on target %q", target)
+		}
+	}
+	return nil
+}
+
+// combineMultiTargetResults fuses the per-target result lists into a single
+// list ordered by ascending combined distance. A document which is missing
+// from one target's results was not within that target's top k, so its
+// distance for that target is assumed to be the worst one observed there.
+func combineMultiTargetResults(combination string, ids [][]uint64,
+	dists [][]float32, limit int,
+) ([]uint64, []float32) {
+	type minMax struct{ min, max float32 }
+
+	bounds := make([]minMax, len(ids))
+	perTarget := make([]map[uint64]float32, len(ids))
+	var order []uint64
+	seen := map[uint64]struct{}{}
+
+	for t := range ids {
+		perTarget[t] = make(map[uint64]float32, len(ids[t]))
+		for i, id := range ids[t] {
+			d := dists[t][i]
+			perTarget[t][id] = d
+			if i == 0 || d < bounds[t].min {
+				bounds[t].min = d
+			}
+			if i == 0 || d > bounds[t].max {
+				bounds[t].max = d
+			}
+
+			if _, ok := seen[id]; !ok {
+				seen[id] = struct{}{}
+				order = append(order, id)
+			}
+		}
+	}
+
+	combined := make([]float32, len(order))
+	for i, id := range order {
+		var out float32
+		for t := range ids {
+			d, ok := perTarget[t][id]
+			if !ok {
+				d = bounds[t].max
+			}
+
+			if combination == searchparams.TargetVectorCombinationRelative {
+				if spread := bounds[t].max - bounds[t].min; spread > 0 {
+					d = (d - bounds[t].min) / spread
+				} else {
+					d = 0
+				}
+			}
+
+			switch combination {
+			case searchparams.TargetVectorCombinationMin:
+				if t == 0 || d < out {
+					out = d
+				}
+			default:
+				out += d
+			}
+		}
+
+		if combination == searchparams.TargetVectorCombinationAverage {
+			out /= float32(len(ids))
+		}
+		combined[i] = out
+	}
+
+	sort.Stable(&docIDsByDistance{ids: order, dists: combined})
+
+	if limit > 0 && len(order) > limit {
+		order = order[:limit]
+		combined = combined[:limit]
+	}
+
+	return order, combined
+}
+
+type docIDsByDistance struct {
+	ids   []uint64
+	dists []float32
+}
+
+func (s *docIDsByDistance) Len() int {
+	return len(s.ids)
+}
+
+func (s *docIDsByDistance) Less(i, j int) bool {
+	return s.dists[i] < s.dists[j]
+}
+
+func (s *docIDsByDistance) Swap(i, j int) {
+	s.ids[i], s.ids[j] = s.ids[j], s.ids[i]
+	s.dists[i], s.dists[j] = s.dists[j], s.dists[i]
+}
@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func Test_CombineMultiTargetResults(t *testing.T) {
+	ids := [][]uint64{
+		{1, 2, 3},
+		{3, 1, 4},
+	}
+	dists := [][]float32{
+		{0.1, 0.2, 0.5},
+		{0.1, 0.3, 0.7},
+	}
+
+	type testcase struct {
+		combination   string
+		limit         int
+		expectedIDs   []uint64
+		expectedDists []float32
+	}
+
+	tests := []testcase{
+		{
+			combination:   searchparams.TargetVectorCombinationSum,
+			limit:         10,
+			expectedIDs:   []uint64{1, 3, 2, 4},
+			expectedDists: []float32{0.4, 0.6, 0.9, 1.2},
+		},
+		{
+			combination:   searchparams.TargetVectorCombinationAverage,
+			limit:         2,
+			expectedIDs:   []uint64{1, 3},
+			expectedDists: []float32{0.2, 0.3},
+		},
+		{
+			combination:   searchparams.TargetVectorCombinationMin,
+			limit:         10,
+			expectedIDs:   []uint64{1, 3, 2, 4},
+			expectedDists: []float32{0.1, 0.1, 0.2, 0.5},
+		},
+		{
+			combination:   searchparams.TargetVectorCombinationRelative,
+			limit:         10,
+			expectedIDs:   []uint64{1, 3, 2, 4},
+			expectedDists: []float32{0.3333333, 1, 1.25, 2},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.combination, func(t *testing.T) {
+			resIDs, resDists := combineMultiTargetResults(test.combination,
+				ids, dists, test.limit)
+			assert.Equal(t, test.expectedIDs, resIDs)
+			assert.InDeltaSlice(t, test.expectedDists, resDists, 0.0001)
+		})
+	}
+
+	t.Run("without any results", func(t *testing.T) {
+		resIDs, resDists := combineMultiTargetResults(
+			searchparams.TargetVectorCombinationSum, [][]uint64{{}, {}},
+			[][]float32{{}, {}}, 10)
+		assert.Len(t, resIDs, 0)
+		assert.Len(t, resDists, 0)
+	})
+}
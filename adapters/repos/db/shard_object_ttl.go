@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+var (
+	// objectTTLInterval is the time between two runs of the janitor which
+	// deletes the expired objects of a shard
+	objectTTLInterval = time.Minute
+	// objectTTLBatchSize limits the number of objects which are deleted at once
+	objectTTLBatchSize = 1000
+)
+
+// initObjectTTL starts the janitor which deletes the objects of the shard
+// once the objectTTL of the class has passed since their creation. The
+// janitor always runs, as the TTL can be set or changed with a class update.
+func (s *Shard) initObjectTTL() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.stopObjectTTL = cancel
+
+	go func() {
+		t := time.NewTicker(objectTTLInterval)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+				if _, err := s.deleteExpiredObjects(ctx); err != nil && ctx.Err() == nil {
+					s.index.logger.WithField("action", "object_ttl").
+						WithField("shard", s.ID()).WithError(err).
+						Error("could not delete expired objects")
+				}
+			}
+		}
+	}()
+}
+
+// objectTTL returns the configured TTL of the objects of the shard, 0 if
+// they don't expire
+func (s *Shard) objectTTL() time.Duration {
+	sch := s.index.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(s.index.Config.ClassName)
+	if class == nil || class.ObjectTTL == nil || class.InvertedIndexConfig == nil ||
+		!class.InvertedIndexConfig.IndexTimestamps {
+		return 0
+	}
+	return time.Duration(class.ObjectTTL.DurationSeconds) * time.Second
+}
+
+// deleteExpiredObjects deletes all objects which were created more than the
+// configured TTL ago from the inverted and the vector index. Every replica
+// expires its objects independently, the creation time is the same on all
+// of them.
+func (s *Shard) deleteExpiredObjects(ctx context.Context) (int, error) {
+	ttl := s.objectTTL()
+	if ttl <= 0 || s.isReadOnly() {
+		return 0, nil
+	}
+
+	filter := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorLessThan,
+		On: &filters.Path{
+			Class:    s.index.Config.ClassName,
+			Property: filters.InternalPropCreationTimeUnix,
+		},
+		Value: &filters.Value{
+			Value: time.Now().Add(-ttl),
+			Type:  schema.DataTypeDate,
+		},
+	}}
+	docIDs, err := s.findDocIDs(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for start := 0; start < len(docIDs); start += objectTTLBatchSize {
+		end := start + objectTTLBatchSize
+		if end > len(docIDs) {
+			end = len(docIDs)
+		}
+		for _, res := range s.deleteObjectBatch(ctx, docIDs[start:end], false) {
+			if res.Err != nil {
+				return deleted, res.Err
+			}
+			deleted++
+		}
+	}
+
+	if deleted > 0 {
+		s.index.logger.WithField("action", "object_ttl").
+			WithField("shard", s.ID()).WithField("deleted", deleted).
+			Debug("deleted expired objects")
+	}
+	return deleted, nil
+}
@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestObjectTTL(t *testing.T) {
+	dirName := t.TempDir()
+	ctx := context.Background()
+
+	inverted := invertedConfig()
+	inverted.IndexTimestamps = true
+	class := &models.Class{
+		Class:               "Session",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: inverted,
+		ObjectTTL:           &models.ObjectTTLConfig{DurationSeconds: 3600},
+		Properties: []*models.Property{
+			{
+				Name:     "name",
+				DataType: []string{"text"},
+			},
+		},
+	}
+
+	shardState := singleShardState()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState, schema: schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(ctx, class, shardState))
+
+	now := time.Now()
+	ids := make([]strfmt.UUID, 10)
+	for i := range ids {
+		ids[i] = strfmt.UUID(fmt.Sprintf("5e55105e-bc5b-4cc9-b646-%012d", i))
+		// the first half of the objects was created before the TTL
+		created := now.Add(-time.Duration(i) * time.Minute)
+		if i < 5 {
+			created = now.Add(-2*time.Hour - time.Duration(i)*time.Minute)
+		}
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			ID:                 ids[i],
+			Class:              class.Class,
+			Properties:         map[string]interface{}{"name": fmt.Sprintf("session %d", i)},
+			CreationTimeUnix:   created.UnixMilli(),
+			LastUpdateTimeUnix: created.UnixMilli(),
+		}, []float32{float32(i), 1, 2}, nil))
+	}
+
+	index := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, index)
+	require.Len(t, index.Shards, 1)
+	var shard *Shard
+	for _, s := range index.Shards {
+		shard = s
+	}
+
+	t.Run("expired objects are deleted", func(t *testing.T) {
+		deleted, err := shard.deleteExpiredObjects(ctx)
+		require.Nil(t, err)
+		assert.Equal(t, 5, deleted)
+
+		for i, id := range ids {
+			obj, err := repo.Object(ctx, class.Class, id,
+				search.SelectProperties{}, additional.Properties{}, nil)
+			require.Nil(t, err)
+			if i < 5 {
+				assert.Nil(t, obj, "object %d should have expired", i)
+			} else {
+				assert.NotNil(t, obj, "object %d should not have expired", i)
+			}
+		}
+
+		res, err := repo.VectorSearch(ctx, []float32{0, 1, 2}, 0, 10, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 5)
+		for _, r := range res {
+			assert.NotContains(t, ids[:5], r.ID)
+		}
+
+		deleted, err = shard.deleteExpiredObjects(ctx)
+		require.Nil(t, err)
+		assert.Equal(t, 0, deleted)
+	})
+
+	t.Run("the TTL of the class can be changed", func(t *testing.T) {
+		class.ObjectTTL = nil
+		deleted, err := shard.deleteExpiredObjects(ctx)
+		require.Nil(t, err)
+		assert.Equal(t, 0, deleted)
+
+		class.ObjectTTL = &models.ObjectTTLConfig{DurationSeconds: 60}
+		deleted, err = shard.deleteExpiredObjects(ctx)
+		require.Nil(t, err)
+		assert.Equal(t, 5, deleted)
+		assert.Equal(t, 0, shard.objectCount())
+	})
+}
@@ -0,0 +1,200 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"os"
+	"path"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// The versions of an object are stored in the object versions bucket, keyed
+// by the id of the object followed by the big endian version number. Every
+// write of an object of a class with versioning enabled stores the written
+// object as the next version, starting at 1. The head of an object, keyed by
+// its id and version 0, holds the latest and the oldest retained version, so
+// a write only needs to remove the versions which exceed the configured
+// number.
+//
+// Versions are kept when an object is deleted, so the versions of a deleted
+// object can still be read by its id. They are removed together with the
+// class.
+//
+// The bucket only exists in shards of classes which keep versions or kept
+// them before. It is created with the first versioned write.
+
+// initObjectVersionsBucket loads the object versions bucket if the class
+// keeps versions or the shard has retained versions
+func (s *Shard) initObjectVersionsBucket(ctx context.Context, store *lsmkv.Store) error {
+	dir := path.Join(s.DBPathLSM(), helpers.ObjectVersionsBucketLSM)
+	if _, err := os.Stat(dir); err != nil && s.index.maxObjectVersions() == 0 {
+		return nil
+	}
+
+	err := store.CreateOrLoadBucket(ctx, helpers.ObjectVersionsBucketLSM,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		s.memtableIdleConfig(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "create object versions bucket")
+	}
+	return nil
+}
+
+// objectVersionsBucket returns the object versions bucket, creating it if
+// versioning has been enabled after the shard was loaded
+func (s *Shard) objectVersionsBucket() (*lsmkv.Bucket, error) {
+	if bucket := s.store.Bucket(helpers.ObjectVersionsBucketLSM); bucket != nil {
+		return bucket, nil
+	}
+
+	s.objectVersionsLock.Lock()
+	defer s.objectVersionsLock.Unlock()
+
+	err := s.store.CreateOrLoadBucket(context.Background(), helpers.ObjectVersionsBucketLSM,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		s.memtableIdleConfig(),
+	)
+	if err != nil {
+		return nil, storageError{errors.Wrap(err, "create object versions bucket")}
+	}
+	return s.store.Bucket(helpers.ObjectVersionsBucketLSM), nil
+}
+
+// putObjectVersion stores the object as it was written as the next version.
+// It must be called while holding the doc id lock of the object, so the
+// versions of an object are written in order.
+func (s *Shard) putObjectVersion(idBytes, data []byte) error {
+	maxVersions := s.index.maxObjectVersions()
+	if maxVersions == 0 {
+		return nil
+	}
+
+	bucket, err := s.objectVersionsBucket()
+	if err != nil {
+		return err
+	}
+	latest, oldest, err := s.objectVersionsHead(idBytes)
+	if err != nil {
+		return err
+	}
+
+	latest++
+	if oldest == 0 {
+		oldest = latest
+	}
+	if err := bucket.Put(objectVersionKey(idBytes, latest), data); err != nil {
+		return storageError{errors.Wrap(err, "put object version")}
+	}
+	for ; latest-oldest >= uint64(maxVersions); oldest++ {
+		if err := bucket.Delete(objectVersionKey(idBytes, oldest)); err != nil {
+			return storageError{errors.Wrap(err, "delete expired object version")}
+		}
+	}
+
+	head := make([]byte, 16)
+	binary.BigEndian.PutUint64(head[:8], latest)
+	binary.BigEndian.PutUint64(head[8:], oldest)
+	if err := bucket.Put(objectVersionKey(idBytes, 0), head); err != nil {
+		return storageError{errors.Wrap(err, "put object versions head")}
+	}
+	return nil
+}
+
+// objectVersionsHead returns the latest and the oldest retained version of
+// an object, both are 0 if no version was stored yet
+func (s *Shard) objectVersionsHead(idBytes []byte) (uint64, uint64, error) {
+	bucket := s.store.Bucket(helpers.ObjectVersionsBucketLSM)
+	if bucket == nil {
+		return 0, 0, nil
+	}
+	head, err := bucket.Get(objectVersionKey(idBytes, 0))
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "get object versions head")
+	}
+	if len(head) != 16 {
+		return 0, 0, nil
+	}
+	return binary.BigEndian.Uint64(head[:8]), binary.BigEndian.Uint64(head[8:]), nil
+}
+
+// objectByIDAtVersion returns a retained version of an object. Positive
+// versions are absolute, 0 is the latest version and negative versions are
+// relative to it, e.g. -1 is the version before the latest one. nil is
+// returned if the version isn't retained.
+func (s *Shard) objectByIDAtVersion(ctx context.Context, id strfmt.UUID,
+	version int64,
+) (*storobj.Object, error) {
+	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	latest, oldest, err := s.objectVersionsHead(idBytes)
+	if err != nil {
+		return nil, err
+	}
+	target := version
+	if version <= 0 {
+		target = int64(latest) + version
+	}
+	if latest == 0 || target < int64(oldest) || target > int64(latest) {
+		return nil, nil
+	}
+
+	data, err := s.store.Bucket(helpers.ObjectVersionsBucketLSM).
+		Get(objectVersionKey(idBytes, uint64(target)))
+	if err != nil {
+		return nil, errors.Wrap(err, "get object version")
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	obj, err := storobj.FromBinary(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal object version")
+	}
+	return obj, nil
+}
+
+func objectVersionKey(idBytes []byte, version uint64) []byte {
+	key := make([]byte, 24)
+	copy(key, idBytes)
+	binary.BigEndian.PutUint64(key[16:], version)
+	return key
+}
+
+// maxObjectVersions returns the number of versions kept per object of the
+// class, 0 if versioning is disabled
+func (i *Index) maxObjectVersions() int {
+	return int(i.objectVersions.Load())
+}
+
+// updateVersioningConfig applies the versioning config of the class to the
+// following writes. Lowering the number of versions removes the excess
+// versions of an object with its next write.
+func (i *Index) updateVersioningConfig(updated *models.VersioningConfig) {
+	i.objectVersions.Store(int64(schema.MaxVersions(&models.Class{
+		VersioningConfig: updated,
+	})))
+}
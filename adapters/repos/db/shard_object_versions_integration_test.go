@@ -0,0 +1,190 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestObjectVersions(t *testing.T) {
+	dirName := t.TempDir()
+	ctx := context.Background()
+
+	class := &models.Class{
+		Class:               "Contract",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		VersioningConfig:    &models.VersioningConfig{Enabled: true, MaxVersions: 3},
+		Properties: []*models.Property{
+			{
+				Name:     "name",
+				DataType: []string{"text"},
+			},
+		},
+	}
+
+	shardState := singleShardState()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState, schema: schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(ctx, class, shardState))
+
+	id := strfmt.UUID("c0a7ac7e-bc5b-4cc9-b646-000000000001")
+	put := func(t *testing.T, name string) {
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			ID:         id,
+			Class:      class.Class,
+			Properties: map[string]interface{}{"name": name},
+		}, []float32{1, 2, 3}, nil))
+	}
+	nameAt := func(t *testing.T, version int64) interface{} {
+		res, err := repo.ObjectAtVersion(ctx, class.Class, id, version,
+			search.SelectProperties{}, additional.Properties{})
+		require.Nil(t, err)
+		if res == nil {
+			return nil
+		}
+		return res.Schema.(map[string]interface{})["name"]
+	}
+
+	t.Run("every write is a version", func(t *testing.T) {
+		put(t, "draft")
+		put(t, "reviewed")
+		require.Nil(t, repo.Merge(ctx, objects.MergeDocument{
+			Class:           class.Class,
+			ID:              id,
+			PrimitiveSchema: map[string]interface{}{"name": "signed"},
+		}, nil))
+
+		assert.Equal(t, "draft", nameAt(t, 1))
+		assert.Equal(t, "reviewed", nameAt(t, 2))
+		assert.Equal(t, "signed", nameAt(t, 3))
+		assert.Equal(t, "signed", nameAt(t, 0))
+		assert.Equal(t, "reviewed", nameAt(t, -1))
+		assert.Nil(t, nameAt(t, 4))
+	})
+
+	t.Run("only the last versions are kept", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			put(t, fmt.Sprintf("amendment %d", i))
+		}
+
+		assert.Nil(t, nameAt(t, 3))
+		assert.Equal(t, "amendment 0", nameAt(t, 4))
+		assert.Equal(t, "amendment 2", nameAt(t, 6))
+		assert.Equal(t, "amendment 0", nameAt(t, -2))
+		assert.Nil(t, nameAt(t, -3))
+	})
+
+	t.Run("versions are kept after the object is deleted", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(ctx, class.Class, id, nil))
+		assert.Equal(t, "amendment 2", nameAt(t, 0))
+	})
+
+	t.Run("no versions are written while versioning is disabled", func(t *testing.T) {
+		require.Nil(t, migrator.UpdateVersioningConfig(ctx, class.Class,
+			&models.VersioningConfig{Enabled: false, MaxVersions: 3}))
+		put(t, "unversioned")
+		assert.Equal(t, "amendment 2", nameAt(t, 0))
+
+		require.Nil(t, migrator.UpdateVersioningConfig(ctx, class.Class,
+			&models.VersioningConfig{Enabled: true, MaxVersions: 3}))
+		put(t, "restored")
+		assert.Equal(t, "restored", nameAt(t, 7))
+		assert.Nil(t, nameAt(t, 4))
+	})
+
+	t.Run("classes without versioning have no versions", func(t *testing.T) {
+		other := strfmt.UUID("c0a7ac7e-bc5b-4cc9-b646-000000000002")
+		require.Nil(t, migrator.UpdateVersioningConfig(ctx, class.Class, nil))
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			ID:         other,
+			Class:      class.Class,
+			Properties: map[string]interface{}{"name": "plain"},
+		}, []float32{1, 2, 3}, nil))
+
+		res, err := repo.ObjectAtVersion(ctx, class.Class, other, 0,
+			search.SelectProperties{}, additional.Properties{})
+		require.Nil(t, err)
+		assert.Nil(t, res)
+	})
+	t.Run("the bucket is only created for versioned writes", func(t *testing.T) {
+		plain := &models.Class{
+			Class:               "Memo",
+			VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+			InvertedIndexConfig: invertedConfig(),
+			Properties: []*models.Property{
+				{
+					Name:     "name",
+					DataType: []string{"text"},
+				},
+			},
+		}
+		schemaGetter.schema.Objects.Classes = append(schemaGetter.schema.Objects.Classes, plain)
+		require.Nil(t, migrator.AddClass(ctx, plain, shardState))
+
+		shard := repo.GetIndex(schema.ClassName(plain.Class)).Shards[shardState.AllPhysicalShards()[0]]
+		put := func(name string) {
+			require.Nil(t, repo.PutObject(ctx, &models.Object{
+				ID:         id,
+				Class:      plain.Class,
+				Properties: map[string]interface{}{"name": name},
+			}, []float32{1, 2, 3}, nil))
+		}
+
+		put("unversioned")
+		assert.Nil(t, shard.store.Bucket(helpers.ObjectVersionsBucketLSM))
+
+		require.Nil(t, migrator.UpdateVersioningConfig(ctx, plain.Class,
+			&models.VersioningConfig{Enabled: true}))
+		put("versioned")
+		assert.NotNil(t, shard.store.Bucket(helpers.ObjectVersionsBucketLSM))
+
+		res, err := repo.ObjectAtVersion(ctx, plain.Class, id, 1,
+			search.SelectProperties{}, additional.Properties{})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, "versioned", res.Schema.(map[string]interface{})["name"])
+	})
+}
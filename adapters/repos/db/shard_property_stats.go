@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// propertyStats counts the objects with and without a value of each
+// property. The counts are read from the null state index, or the property
+// length index if there is none. Properties without either index are
+// skipped, as counting them would require reading all objects.
+func (s *Shard) propertyStats() []*models.NodePropertyStats {
+	sch := s.index.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(s.index.Config.ClassName)
+	if class == nil {
+		return []*models.NodePropertyStats{}
+	}
+
+	stats := make([]*models.NodePropertyStats, 0, len(class.Properties))
+	for _, prop := range class.Properties {
+		if stat, ok := s.nullStateStats(prop.Name); ok {
+			stats = append(stats, stat)
+		} else if stat, ok := s.propertyLengthStats(prop.Name); ok {
+			stats = append(stats, stat)
+		}
+	}
+	return stats
+}
+
+func (s *Shard) nullStateStats(propName string) (*models.NodePropertyStats, bool) {
+	b := s.store.Bucket(helpers.BucketFromPropNameNullLSM(propName))
+	if b == nil {
+		return nil, false
+	}
+
+	nullKey, _ := s.keyPropertyNull(true)
+	notNullKey, _ := s.keyPropertyNull(false)
+	nulls, err := b.RoaringSetGet(nullKey)
+	if err != nil {
+		return nil, false
+	}
+	notNulls, err := b.RoaringSetGet(notNullKey)
+	if err != nil {
+		return nil, false
+	}
+
+	return &models.NodePropertyStats{
+		Name:        propName,
+		NullCount:   int64(nulls.GetCardinality()),
+		ObjectCount: int64(notNulls.GetCardinality()),
+	}, true
+}
+
+// propertyLengthStats counts the objects with a non-zero length. Objects
+// without the property are only in the property length index if the null
+// state is indexed too, so all other objects are counted as null.
+func (s *Shard) propertyLengthStats(propName string) (*models.NodePropertyStats, bool) {
+	b := s.store.Bucket(helpers.BucketFromPropNameLengthLSM(propName))
+	if b == nil {
+		return nil, false
+	}
+	zeroKey, err := s.keyPropertyLength(0)
+	if err != nil {
+		return nil, false
+	}
+
+	stat := &models.NodePropertyStats{Name: propName}
+	c := b.CursorRoaringSet()
+	for k, bm := c.First(); k != nil; k, bm = c.Next() {
+		if !bytes.Equal(k, zeroKey) {
+			stat.ObjectCount += int64(bm.GetCardinality())
+		}
+	}
+	c.Close()
+
+	if nulls := int64(s.objectCount()) - stat.ObjectCount; nulls > 0 {
+		stat.NullCount = nulls
+	}
+	return stat, true
+}
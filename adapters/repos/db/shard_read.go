@@ -23,9 +23,12 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/sorter"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/multi"
+	"github.com/weaviate/weaviate/entities/querycost"
+	"github.com/weaviate/weaviate/entities/querytimeout"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
@@ -94,27 +97,49 @@ func (s *Shard) multiObjectByID(ctx context.Context,
 	return objects, nil
 }
 
-// TODO: This does an actual read which is not really needed, if we see this
-// come up in profiling, we could optimize this by adding an explicit Exists()
-// on the LSMKV which only checks the bloom filters, which at least in the case
-// of a true negative would be considerably faster. For a (false) positive,
-// we'd still need to check, though.
+// objectHeadersByID parses only the headers of the objects, e.g. to compare
+// their update times. Missing objects are nil.
+func (s *Shard) objectHeadersByID(ids []strfmt.UUID) ([]*storobj.Object, error) {
+	objects := make([]*storobj.Object, len(ids))
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	for i, id := range ids {
+		idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+
+		bytes, err := bucket.Get(idBytes)
+		if err != nil {
+			return nil, err
+		}
+		if bytes == nil {
+			continue
+		}
+
+		obj, err := storobj.FromBinaryUUIDOnly(bytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "unmarshal object header")
+		}
+		objects[i] = obj
+	}
+
+	return objects, nil
+}
+
+// exists doesn't read the object, only the bloom filters and the indexes of
+// the segments
 func (s *Shard) exists(ctx context.Context, id strfmt.UUID) (bool, error) {
 	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
 	if err != nil {
 		return false, err
 	}
 
-	bytes, err := s.store.Bucket(helpers.ObjectsBucketLSM).Get(idBytes)
+	ok, err := s.store.Bucket(helpers.ObjectsBucketLSM).Exists(idBytes)
 	if err != nil {
 		return false, errors.Wrap(err, "read request")
 	}
 
-	if bytes == nil {
-		return false, nil
-	}
-
-	return true, nil
+	return ok, nil
 }
 
 func (s *Shard) objectByIndexID(ctx context.Context,
@@ -172,6 +197,13 @@ func (s *Shard) objectSearch(ctx context.Context, limit int,
 		}
 
 		bm25Config := s.index.getInvertedIndexConfig().BM25
+		if keywordRanking.BM25 != nil {
+			propertyBoosts := bm25Config.PropertyBoosts
+			bm25Config = *keywordRanking.BM25
+			if bm25Config.PropertyBoosts == nil {
+				bm25Config.PropertyBoosts = propertyBoosts
+			}
+		}
 
 		bm25searcher := inverted.NewBM25Searcher(bm25Config, s.store,
 			s.index.getSchema.GetSchemaSkipAuth(), s.invertedRowCache,
@@ -189,6 +221,7 @@ func (s *Shard) objectSearch(ctx context.Context, limit int,
 				s.index.getSchema.GetSchemaSkipAuth(), s.invertedRowCache,
 				s.propertyIndices, s.index.classSearcher, s.deletedDocIDs,
 				s.index.stopwords, s.versioner.Version()).
+				WithFilterCache(s.filterCache).
 				DocIDs(ctx, filters, additional, s.index.Config.ClassName)
 			if err != nil {
 				return nil, nil, err
@@ -236,8 +269,20 @@ func (s *Shard) objectSearch(ctx context.Context, limit int,
 }
 
 func (s *Shard) objectVectorSearch(ctx context.Context,
-	searchVector []float32, targetDist float32, limit int, filters *filters.LocalFilter,
+	searchVector []float32, targetVector string, targetDist float32, limit int, filters *filters.LocalFilter,
 	sort []filters.Sort, additional additional.Properties,
+) ([]*storobj.Object, []float32, error) {
+	return s.objectVectorSearchAfter(ctx, searchVector, targetVector, targetDist, limit,
+		filters, sort, additional, nil)
+}
+
+// objectVectorSearchAfter is objectVectorSearch for a page of a vector cursor.
+// The results which are closer than the position of the cursor are skipped
+// before their objects are loaded, the ones at the distance of the position
+// are returned as their order depends on the id.
+func (s *Shard) objectVectorSearchAfter(ctx context.Context,
+	searchVector []float32, targetVector string, targetDist float32, limit int, filters *filters.LocalFilter,
+	sort []filters.Sort, additional additional.Properties, after *filters.ShardVectorCursor,
 ) ([]*storobj.Object, []float32, error) {
 	var (
 		ids       []uint64
@@ -257,17 +302,55 @@ func (s *Shard) objectVectorSearch(ctx context.Context,
 	}
 
 	beforeVector := time.Now()
+	// the vector index must not be swapped while it is searched
+	s.vectorIndexLock.RLock()
+	index, err := s.vectorIndexForTarget(targetVector)
+	if err != nil {
+		s.vectorIndexLock.RUnlock()
+		return nil, nil, err
+	}
+	// the maximum results only cap searches by distance which go beyond the
+	// initial batch of results
+	maxResults := s.index.Config.QueryMaximumResults
+	if maxResults < hnsw.DefaultSearchByDistInitialLimit {
+		maxResults = hnsw.DefaultSearchByDistInitialLimit
+	}
+	ids, dists, err = searchByVector(ctx, index, searchVector, targetDist, limit,
+		maxResults, allowList)
 	if limit < 0 {
-		ids, dists, err = s.vectorIndex.SearchByVectorDistance(
-			searchVector, targetDist, s.index.Config.QueryMaximumResults, allowList)
-		if err != nil {
-			return nil, nil, errors.Wrap(err, "vector search by distance")
-		}
+		err = errors.Wrap(err, "vector search by distance")
 	} else {
-		ids, dists, err = s.vectorIndex.SearchByVector(searchVector, limit, allowList)
-		if err != nil {
-			return nil, nil, errors.Wrap(err, "vector search")
+		err = errors.Wrap(err, "vector search")
+	}
+	estimator, isEstimator := index.(searchCostEstimator)
+	s.vectorIndexLock.RUnlock()
+	if err != nil {
+		return nil, nil, err
+	}
+	if limit < 0 && int64(len(ids)) == maxResults {
+		s.index.logger.WithField("action", "vector_search_by_distance").
+			WithField("shard", s.ID()).
+			Warnf("maximum search limit of %d results has been reached, "+
+				"results within the distance may be missing", len(ids))
+	}
+	tracker := querycost.FromContext(ctx)
+	if isEstimator && tracker != nil {
+		k := limit
+		if k < 0 {
+			k = len(ids)
+		}
+		compared := estimator.SearchCost(k, allowList)
+		if count := s.store.Bucket(helpers.ObjectsBucketLSM).Count(); compared > count {
+			compared = count
+		}
+		tracker.AddVectorsCompared(compared)
+	}
+	if after != nil {
+		skip := 0
+		for skip < len(dists) && dists[skip] < after.Dist {
+			skip++
 		}
+		ids, dists = ids[skip:], dists[skip:]
 	}
 	if len(ids) == 0 {
 		return nil, nil, nil
@@ -292,7 +375,7 @@ func (s *Shard) objectVectorSearch(ctx context.Context,
 	beforeObjects := time.Now()
 
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
-	objs, err := storobj.ObjectsByDocID(bucket, ids, additional)
+	objs, err := storobj.ObjectsByDocIDWithCost(bucket, ids, additional, tracker)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -314,7 +397,8 @@ func (s *Shard) objectList(ctx context.Context, limit int,
 			return nil, err
 		}
 		bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
-		return storobj.ObjectsByDocID(bucket, docIDs, additional)
+		return storobj.ObjectsByDocIDWithCost(bucket, docIDs, additional,
+			querycost.FromContext(ctx))
 	}
 
 	if cursor == nil {
@@ -347,8 +431,17 @@ func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
 
 	i := 0
 	out := make([]*storobj.Object, c.Limit)
+	tracker := querycost.FromContext(ctx)
 
 	for ; key != nil && i < c.Limit; key, val = cursor.Next() {
+		if stop, err := querytimeout.Stop(ctx); stop {
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+
+		tracker.AddObjectLoaded(len(val))
 		obj, err := storobj.FromBinary(val)
 		if err != nil {
 			return nil, errors.Wrapf(err, "unmarhsal item %d", i)
@@ -396,6 +489,7 @@ func (s *Shard) buildAllowList(ctx context.Context, filters *filters.LocalFilter
 		s.index.getSchema.GetSchemaSkipAuth(), s.invertedRowCache,
 		s.propertyIndices, s.index.classSearcher, s.deletedDocIDs,
 		s.index.stopwords, s.versioner.Version()).
+		WithFilterCache(s.filterCache).
 		DocIDs(ctx, filters, addl, s.index.Config.ClassName)
 	if err != nil {
 		return nil, errors.Wrap(err, "build inverted filter allow list")
@@ -432,6 +526,9 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID) error {
 		return err
 	}
 
+	done := s.beginMigrationWrite(idBytes)
+	defer done()
+
 	var docID uint64
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 	existing, err := bucket.Get(idBytes)
@@ -453,7 +550,11 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID) error {
 
 	err = bucket.Delete(idBytes)
 	if err != nil {
-		return errors.Wrap(err, "delete object from bucket")
+		return storageError{errors.Wrap(err, "delete object from bucket")}
+	}
+
+	if err := s.recordDeletion(idBytes); err != nil {
+		return err
 	}
 
 	err = s.cleanupInvertedIndexOnDelete(existing, docID)
@@ -465,7 +566,7 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID) error {
 	// TODO: do we still need this?
 	s.deletedDocIDs.Add(docID)
 
-	if err := s.vectorIndex.Delete(docID); err != nil {
+	if err := s.deleteFromVectorIndex(docID); err != nil {
 		return errors.Wrap(err, "delete from vector index")
 	}
 
@@ -120,13 +120,7 @@ func (s *Shard) diskUseReadonly(du diskUse) {
 	diskROPercent := s.index.Config.ResourceUsage.DiskUse.ReadOnlyPercentage
 	if diskROPercent > 0 {
 		if pu := du.percentUsed(); pu > float64(diskROPercent) {
-			err := s.updateStatus(storagestate.StatusReadOnly.String())
-			if err != nil {
-				s.index.logger.WithField("action", "set_shard_read_only").
-					WithField("shard", s.name).
-					WithField("path", s.index.Config.RootPath).
-					Fatal("failed to set to READONLY")
-			}
+			s.setStatus(storagestate.StatusReadOnly, statusReasonDiskUse)
 
 			s.index.logger.WithField("action", "set_shard_read_only").
 				WithField("shard", s.name).
@@ -141,13 +135,7 @@ func (s *Shard) memUseReadonly(mon *memwatch.Monitor) {
 	memROPercent := s.index.Config.ResourceUsage.MemUse.ReadOnlyPercentage
 	if memROPercent > 0 {
 		if pu := mon.Ratio() * 100; pu > float64(memROPercent) {
-			err := s.updateStatus(storagestate.StatusReadOnly.String())
-			if err != nil {
-				s.index.logger.WithField("action", "set_shard_read_only").
-					WithField("shard", s.name).
-					WithField("path", s.index.Config.RootPath).
-					Fatal("failed to set to READONLY")
-			}
+			s.setStatus(storagestate.StatusReadOnly, statusReasonMemUse)
 
 			s.index.logger.WithField("action", "set_shard_read_only").
 				WithField("shard", s.name).
@@ -157,3 +145,25 @@ func (s *Shard) memUseReadonly(mon *memwatch.Monitor) {
 		}
 	}
 }
+
+// sets a shard which was set to readonly because of the disk usage back to
+// ready once the usage has dropped below the user-set recovery threshold. The
+// gap to the readonly threshold avoids flapping between the two states.
+func (s *Shard) diskUseRecover(du diskUse) {
+	recoverPercent := s.index.Config.ResourceUsage.DiskUse.ReadOnlyRecoveryPercentage
+	if recoverPercent == 0 || s.getStatusReason() != statusReasonDiskUse {
+		return
+	}
+
+	if pu := du.percentUsed(); pu < float64(recoverPercent) {
+		if !s.recoverStatus(statusReasonDiskUse) {
+			return
+		}
+
+		s.index.logger.WithField("action", "set_shard_ready").
+			WithField("shard", s.name).
+			WithField("path", s.index.Config.RootPath).
+			Infof("%s set READY, disk usage currently at %.2f%%, recovery threshold set to %.2f%%",
+				s.name, pu, float64(recoverPercent))
+	}
+}
@@ -18,6 +18,18 @@ import (
 	"github.com/weaviate/weaviate/entities/storagestate"
 )
 
+// statusReason records why a shard's status was changed automatically. A
+// status which was set by an operator has no reason and is never changed
+// back automatically.
+type statusReason string
+
+const (
+	statusReasonNone        statusReason = ""
+	statusReasonDiskUse     statusReason = "disk_use"
+	statusReasonMemUse      statusReason = "memory_use"
+	statusReasonWriteErrors statusReason = "write_errors"
+)
+
 func (s *Shard) initStatus() {
 	s.statusLock.Lock()
 	defer s.statusLock.Unlock()
@@ -32,25 +44,139 @@ func (s *Shard) getStatus() storagestate.Status {
 	return s.status
 }
 
+func (s *Shard) getStatusReason() statusReason {
+	s.statusLock.Lock()
+	defer s.statusLock.Unlock()
+
+	return s.statusReason
+}
+
+// isReadOnly is true for all statuses which reject writes
 func (s *Shard) isReadOnly() bool {
-	return s.getStatus() == storagestate.StatusReadOnly
+	return !s.getStatus().AllowsWrites()
 }
 
+// updateStatus is used by operators, e.g. to clear a status which was set
+// automatically after remediation
 func (s *Shard) updateStatus(in string) error {
-	s.statusLock.Lock()
-	defer s.statusLock.Unlock()
-
 	targetStatus, err := storagestate.ValidateStatus(strings.ToUpper(in))
 	if err != nil {
 		return errors.Wrap(err, in)
 	}
 
+	s.setStatus(targetStatus, statusReasonNone)
+	return nil
+}
+
+func (s *Shard) setStatus(targetStatus storagestate.Status, reason statusReason) {
+	s.statusLock.Lock()
+	defer s.statusLock.Unlock()
+
 	s.status = targetStatus
+	s.statusReason = reason
+	s.writeErrors = 0
 	s.updateStoreStatus(targetStatus)
+}
 
-	return nil
+// recoverStatus sets the shard back to READY if its status was set
+// automatically for the given reason. It returns false if the status was
+// changed in the meantime, e.g. by an operator.
+func (s *Shard) recoverStatus(reason statusReason) bool {
+	s.statusLock.Lock()
+	defer s.statusLock.Unlock()
+
+	if s.statusReason != reason || s.status.AllowsWrites() {
+		return false
+	}
+
+	s.status = storagestate.StatusReady
+	s.statusReason = statusReasonNone
+	s.writeErrors = 0
+	s.updateStoreStatus(s.status)
+	return true
 }
 
 func (s *Shard) updateStoreStatus(targetStatus storagestate.Status) {
+	// the buckets only distinguish between accepting and rejecting writes
+	if !targetStatus.AllowsWrites() {
+		targetStatus = storagestate.StatusReadOnly
+	}
 	s.store.UpdateBucketsStatus(targetStatus)
 }
+
+// storageError marks a failed write to the shard's storage, such as a bucket
+// write or a WAL flush. Only these count towards quarantining the shard.
+type storageError struct {
+	err error
+}
+
+func (e storageError) Error() string {
+	return e.err.Error()
+}
+
+func (e storageError) Unwrap() error {
+	return e.err
+}
+
+// batchWriteResult returns the first storage error of a batch, nil if any of
+// its writes succeeded, and otherwise the first error caused by the request
+func batchWriteResult(errs []error) error {
+	var first error
+	succeeded := false
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			succeeded = true
+		case errors.As(err, new(storageError)):
+			return err
+		case first == nil:
+			first = err
+		}
+	}
+	if succeeded {
+		return nil
+	}
+	return first
+}
+
+// trackWriteResult quarantines the shard once the configured number of
+// consecutive writes has failed with a storage error. Errors which are
+// caused by the request rather than the shard, e.g. invalid ids or vectors,
+// neither count nor reset the count.
+func (s *Shard) trackWriteResult(err error) {
+	threshold := s.index.Config.QuarantineAfterWriteErrors
+	if threshold <= 0 {
+		return
+	}
+
+	if err != nil && !errors.As(err, new(storageError)) {
+		return
+	}
+
+	s.statusLock.Lock()
+	if err == nil {
+		s.writeErrors = 0
+		s.statusLock.Unlock()
+		return
+	}
+
+	s.writeErrors++
+	if s.writeErrors < threshold || !s.status.AllowsWrites() {
+		s.statusLock.Unlock()
+		return
+	}
+
+	s.status = storagestate.StatusQuarantined
+	s.statusReason = statusReasonWriteErrors
+	s.writeErrors = 0
+	s.updateStoreStatus(s.status)
+	s.statusLock.Unlock()
+
+	s.index.logger.WithField("action", "set_shard_quarantined").
+		WithField("shard", s.name).
+		WithField("path", s.index.Config.RootPath).
+		WithError(err).
+		Errorf("%s set QUARANTINED after %d consecutive failed writes, "+
+			"set the status to READY once the cause has been remediated",
+			s.name, threshold)
+}
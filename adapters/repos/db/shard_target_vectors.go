@@ -0,0 +1,193 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// initTargetVectors creates a vector index for every named vector of the
+// class. The named vectors are always indexed synchronously, async indexing
+// and rebuilds of the vector index only apply to the default vector.
+func (s *Shard) initTargetVectors(class *models.Class) error {
+	if class == nil || len(class.VectorConfig) == 0 {
+		return nil
+	}
+
+	s.targetVectors = make(map[string]VectorIndex, len(class.VectorConfig))
+	for target, cfg := range class.VectorConfig {
+		index, err := s.newTargetVectorIndex(target, cfg.VectorIndexConfig)
+		if err != nil {
+			return errors.Wrapf(err, "init shard %q: target vector %q", s.ID(), target)
+		}
+		s.targetVectors[target] = index
+	}
+
+	return nil
+}
+
+func (s *Shard) newTargetVectorIndex(target string, cfg interface{}) (VectorIndex, error) {
+	switch uc := cfg.(type) {
+	case hnswent.UserConfig:
+		if uc.Skip {
+			return noop.NewIndex(), nil
+		}
+
+		index, err := s.newHNSWIndexForVectors(s.targetVectorIndexID(target), uc,
+			s.targetVectorByIndexID(target))
+		if err != nil {
+			return nil, errors.Wrap(err, "hnsw index")
+		}
+		index.PostStartup()
+		return index, nil
+	case flatent.UserConfig:
+		if uc.Skip {
+			return noop.NewIndex(), nil
+		}
+
+		distProv, err := distanceProvider(uc.Distance)
+		if err != nil {
+			return nil, err
+		}
+		index, err := flat.New(flat.Config{
+			ID:               s.targetVectorIndexID(target),
+			Logger:           s.index.logger,
+			DistanceProvider: distProv,
+			Store:            s.store,
+			BucketName:       helpers.FlatTargetVectorsBucketLSM(target),
+		}, uc)
+		if err != nil {
+			return nil, errors.Wrap(err, "flat index")
+		}
+		index.PostStartup()
+		return index, nil
+	default:
+		return nil, errors.Errorf("unsupported vector index config: %T", cfg)
+	}
+}
+
+func (s *Shard) targetVectorIndexID(target string) string {
+	return fmt.Sprintf("%s_vector_%s", s.ID(), target)
+}
+
+// targetVectorByIndexID reads the named vector from the stored object, as
+// the vector of the default index is read by vectorByIndexID
+func (s *Shard) targetVectorByIndexID(target string) hnsw.VectorForID {
+	return func(ctx context.Context, indexID uint64) ([]float32, error) {
+		keyBuf := make([]byte, 8)
+		binary.LittleEndian.PutUint64(keyBuf, indexID)
+
+		bytes, err := s.store.Bucket(helpers.ObjectsBucketLSM).
+			GetBySecondary(0, keyBuf)
+		if err != nil {
+			return nil, err
+		}
+
+		vector, err := storobj.TargetVectorFromBinary(bytes, target)
+		if err != nil {
+			return nil, err
+		}
+		if vector == nil {
+			return nil, storobj.NewErrNotFoundf(indexID,
+				"no %q vector for doc id, it could have been deleted", target)
+		}
+		return vector, nil
+	}
+}
+
+// validateTargetVectors needs to happen before any changes are done, just
+// like the validation of the default vector
+func (s *Shard) validateTargetVectors(vectors map[string][]float32) error {
+	for target, vector := range vectors {
+		index, ok := s.targetVectors[target]
+		if !ok {
+			return errors.Errorf("target vector %q does not exist", target)
+		}
+		if err := index.ValidateBeforeInsert(vector); err != nil {
+			return errors.Wrapf(err, "target vector %q", target)
+		}
+	}
+	return nil
+}
+
+// addToTargetVectorIndexes ignores deletes the same way
+// updateVectorIndexIgnoreDelete does, the previous doc id is deleted from all
+// indexes by deleteFromVectorIndex
+func (s *Shard) addToTargetVectorIndexes(docID uint64,
+	vectors map[string][]float32,
+) error {
+	for target, vector := range vectors {
+		if len(vector) == 0 {
+			continue
+		}
+
+		index, ok := s.targetVectors[target]
+		if !ok {
+			return errors.Errorf("target vector %q does not exist", target)
+		}
+		if err := index.Add(docID, vector); err != nil {
+			return errors.Wrapf(err, "insert doc id %d to target vector %q", docID, target)
+		}
+	}
+	return nil
+}
+
+func (s *Shard) deleteFromTargetVectorIndexes(docIDs ...uint64) error {
+	for target, index := range s.targetVectors {
+		if err := index.Delete(docIDs...); err != nil {
+			return errors.Wrapf(err, "target vector %q", target)
+		}
+	}
+	return nil
+}
+
+func (s *Shard) flushTargetVectorIndexes() error {
+	for target, index := range s.targetVectors {
+		if err := index.Flush(); err != nil {
+			return errors.Wrapf(err, "flush target vector %q", target)
+		}
+	}
+	return nil
+}
+
+func (s *Shard) shutdownTargetVectorIndexes(ctx context.Context) error {
+	if err := s.flushTargetVectorIndexes(); err != nil {
+		return err
+	}
+	for target, index := range s.targetVectors {
+		if err := index.Shutdown(ctx); err != nil {
+			return errors.Wrapf(err, "shut down target vector %q", target)
+		}
+	}
+	return nil
+}
+
+func (s *Shard) dropTargetVectorIndexes(ctx context.Context) error {
+	for target, index := range s.targetVectors {
+		if err := index.Drop(ctx); err != nil {
+			return errors.Wrapf(err, "drop target vector %q", target)
+		}
+	}
+	return nil
+}
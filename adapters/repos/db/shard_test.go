@@ -17,6 +17,7 @@ package db
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/rand"
 	"os"
@@ -80,6 +81,96 @@ func TestShard_UpdateStatus(t *testing.T) {
 	require.Nil(t, os.RemoveAll(idx.Config.RootPath))
 }
 
+func TestShard_AutomaticStatus(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className)
+	shd.index.Config.QuarantineAfterWriteErrors = 3
+	shd.index.Config.ResourceUsage.DiskUse.ReadOnlyPercentage = 90
+	shd.index.Config.ResourceUsage.DiskUse.ReadOnlyRecoveryPercentage = 80
+
+	writeErr := storageError{errors.New("disk I/O error")}
+
+	t.Run("consecutive write errors quarantine the shard", func(t *testing.T) {
+		shd.trackWriteResult(writeErr)
+		shd.trackWriteResult(writeErr)
+		// a successful write resets the count
+		shd.trackWriteResult(nil)
+		shd.trackWriteResult(writeErr)
+		shd.trackWriteResult(writeErr)
+		// errors caused by the request don't count
+		shd.trackWriteResult(context.Canceled)
+		assert.True(t, shd.getStatus().AllowsWrites())
+
+		shd.trackWriteResult(writeErr)
+		assert.Equal(t, storagestate.StatusQuarantined, shd.getStatus())
+
+		err := shd.putObject(ctx, testObject(className))
+		require.EqualError(t, err, storagestate.ErrStatusReadOnly.Error())
+	})
+
+	t.Run("errors caused by the request don't move the count", func(t *testing.T) {
+		require.Nil(t, shd.updateStatus(storagestate.StatusReady.String()))
+		shd.trackWriteResult(writeErr)
+		shd.trackWriteResult(writeErr)
+
+		invalidID := testObject(className)
+		invalidID.Object.ID = "not-a-uuid"
+		unknownTarget := testObject(className)
+		unknownTarget.Vectors = map[string][]float32{"unknown": {1, 2, 3}}
+		for i := 0; i < 5; i++ {
+			errs := shd.putObjectBatch(ctx, []*storobj.Object{invalidID, unknownTarget})
+			require.Len(t, errs, 2)
+			assert.ErrorContains(t, errs[0], "invalid id")
+			assert.ErrorContains(t, errs[1], "validate vector index")
+
+			err := shd.putObject(ctx, unknownTarget)
+			assert.ErrorContains(t, err, "does not exist")
+		}
+		assert.Equal(t, 2, shd.writeErrors)
+		assert.True(t, shd.getStatus().AllowsWrites())
+
+		shd.trackWriteResult(writeErr)
+		assert.Equal(t, storagestate.StatusQuarantined, shd.getStatus())
+	})
+
+	t.Run("quarantine is not cleared automatically", func(t *testing.T) {
+		shd.diskUseRecover(diskUse{total: 100, free: 50})
+		assert.Equal(t, storagestate.StatusQuarantined, shd.getStatus())
+	})
+
+	t.Run("operator clears the quarantine", func(t *testing.T) {
+		require.Nil(t, shd.updateStatus(storagestate.StatusReady.String()))
+
+		err := shd.putObject(ctx, testObject(className))
+		require.Nil(t, err)
+	})
+
+	t.Run("high disk usage sets the shard readonly", func(t *testing.T) {
+		shd.diskUseReadonly(diskUse{total: 100, free: 5})
+		assert.Equal(t, storagestate.StatusReadOnly, shd.getStatus())
+	})
+
+	t.Run("readonly is kept between the thresholds", func(t *testing.T) {
+		shd.diskUseRecover(diskUse{total: 100, free: 15})
+		assert.Equal(t, storagestate.StatusReadOnly, shd.getStatus())
+	})
+
+	t.Run("readonly is cleared below the recovery threshold", func(t *testing.T) {
+		shd.diskUseRecover(diskUse{total: 100, free: 25})
+		assert.Equal(t, storagestate.StatusReady, shd.getStatus())
+	})
+
+	t.Run("readonly set by an operator is not cleared", func(t *testing.T) {
+		require.Nil(t, shd.updateStatus(storagestate.StatusReadOnly.String()))
+		shd.diskUseRecover(diskUse{total: 100, free: 25})
+		assert.Equal(t, storagestate.StatusReadOnly, shd.getStatus())
+	})
+
+	require.Nil(t, idx.drop())
+	require.Nil(t, os.RemoveAll(idx.Config.RootPath))
+}
+
 func TestShard_ReadOnly_HaltCompaction(t *testing.T) {
 	amount := 10000
 	sizePerValue := 8
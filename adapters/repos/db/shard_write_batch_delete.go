@@ -33,7 +33,13 @@ func (s *Shard) deleteObjectBatch(ctx context.Context,
 			objects.BatchSimpleObject{Err: storagestate.ErrStatusReadOnly},
 		}
 	}
-	return newDeleteObjectsBatcher(s).Delete(ctx, docIDs, dryRun)
+	res := newDeleteObjectsBatcher(s).Delete(ctx, docIDs, dryRun)
+	errs := make([]error, len(res))
+	for i := range res {
+		errs[i] = res[i].Err
+	}
+	s.trackWriteResult(batchWriteResult(errs))
+	return res
 }
 
 type deleteObjectsBatcher struct {
@@ -119,19 +125,19 @@ func (b *deleteObjectsBatcher) flushWALs(ctx context.Context) {
 
 	if err := b.shard.store.WriteWALs(); err != nil {
 		for i := range b.objects {
-			b.setErrorAtIndex(err, i)
+			b.setErrorAtIndex(storageError{err}, i)
 		}
 	}
 
-	if err := b.shard.vectorIndex.Flush(); err != nil {
+	if err := b.shard.getVectorIndex().Flush(); err != nil {
 		for i := range b.objects {
-			b.setErrorAtIndex(err, i)
+			b.setErrorAtIndex(storageError{err}, i)
 		}
 	}
 
 	if err := b.shard.propLengths.Flush(); err != nil {
 		for i := range b.objects {
-			b.setErrorAtIndex(err, i)
+			b.setErrorAtIndex(storageError{err}, i)
 		}
 	}
 }
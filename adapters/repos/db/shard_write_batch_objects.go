@@ -31,7 +31,9 @@ func (s *Shard) putObjectBatch(ctx context.Context,
 		return []error{storagestate.ErrStatusReadOnly}
 	}
 
-	return s.putBatch(ctx, objects)
+	errs := s.putBatch(ctx, objects)
+	s.trackWriteResult(batchWriteResult(errs))
+	return errs
 }
 
 // Workers are started with the first batch and keep working as there are objects to add from any batch. Each batch
@@ -104,7 +106,7 @@ func (b *objectsBatcher) storeInObjectStore(ctx context.Context) {
 		}
 	}
 
-	b.shard.metrics.ObjectStore(beforeObjectStore)
+	b.shard.metrics.ObjectStore(ctx, beforeObjectStore)
 }
 
 func (b *objectsBatcher) storeSingleBatchInLSM(ctx context.Context,
@@ -145,6 +147,9 @@ func (b *objectsBatcher) storeObjectOfBatchInLSM(ctx context.Context,
 	if _, ok := b.duplicates[objectIndex]; ok {
 		return nil
 	}
+	if err := b.shard.validateTargetVectors(object.Vectors); err != nil {
+		return errors.Wrap(err, "validate vector index")
+	}
 	uuidParsed, err := uuid.Parse(object.ID().String())
 	if err != nil {
 		return errors.Wrap(err, "invalid id")
@@ -191,7 +196,7 @@ func (b *objectsBatcher) markDeletedInVectorStorage(ctx context.Context) {
 		return
 	}
 
-	if err := b.shard.vectorIndex.Delete(docIDsToDelete...); err != nil {
+	if err := b.shard.deleteFromVectorIndex(docIDsToDelete...); err != nil {
 		for _, pos := range positions {
 			b.setErrorAtIndex(err, pos)
 		}
@@ -226,7 +231,7 @@ func (b *objectsBatcher) storeAdditionalStorageWithWorkers(ctx context.Context)
 		}
 	}
 
-	b.shard.metrics.VectorIndex(beforeVectorIndex)
+	b.shard.metrics.VectorIndex(ctx, beforeVectorIndex)
 }
 
 func (b *objectsBatcher) shouldSkipInAdditionalStorage(i int) bool {
@@ -279,6 +284,11 @@ func (b *objectsBatcher) storeSingleObjectInAdditionalStorage(ctx context.Contex
 		}
 	}
 
+	if err := b.shard.addToTargetVectorIndexes(status.docID, object.Vectors); err != nil {
+		b.setErrorAtIndex(errors.Wrap(err, "insert to vector index"), index)
+		return
+	}
+
 	if err := b.shard.updatePropertySpecificIndices(object, status); err != nil {
 		b.setErrorAtIndex(errors.Wrap(err, "update prop-specific indices"), index)
 		return
@@ -325,19 +335,25 @@ func (s *objectsBatcher) checkContext(ctx context.Context) bool {
 func (b *objectsBatcher) flushWALs(ctx context.Context) {
 	if err := b.shard.store.WriteWALs(); err != nil {
 		for i := range b.objects {
-			b.setErrorAtIndex(err, i)
+			b.setErrorAtIndex(storageError{err}, i)
 		}
 	}
 
-	if err := b.shard.vectorIndex.Flush(); err != nil {
+	if err := b.shard.getVectorIndex().Flush(); err != nil {
 		for i := range b.objects {
-			b.setErrorAtIndex(err, i)
+			b.setErrorAtIndex(storageError{err}, i)
+		}
+	}
+
+	if err := b.shard.flushTargetVectorIndexes(); err != nil {
+		for i := range b.objects {
+			b.setErrorAtIndex(storageError{err}, i)
 		}
 	}
 
 	if err := b.shard.propLengths.Flush(); err != nil {
 		for i := range b.objects {
-			b.setErrorAtIndex(err, i)
+			b.setErrorAtIndex(storageError{err}, i)
 		}
 	}
 }
@@ -33,7 +33,9 @@ func (s *Shard) addReferencesBatch(ctx context.Context,
 		return []error{errors.Errorf("shard is read-only")}
 	}
 
-	return newReferencesBatcher(s).References(ctx, refs)
+	errs := newReferencesBatcher(s).References(ctx, refs)
+	s.trackWriteResult(batchWriteResult(errs))
+	return errs
 }
 
 // referencesBatcher is a helper type wrapping around an underlying shard that can
@@ -58,6 +60,7 @@ func (b *referencesBatcher) References(ctx context.Context,
 	b.init(refs)
 	b.storeInObjectStore(ctx)
 	b.flushWALs(ctx)
+	b.shard.index.notifyWrite()
 	return b.errs
 }
 
@@ -184,6 +187,14 @@ func (b *referencesBatcher) writeInverted(in inverted.DeltaMergeResult) error {
 	}
 	b.shard.metrics.InvertedDeleteDelta(before)
 
+	if b.shard.filterCache != nil {
+		var names []string
+		for _, prop := range append(in.Additions, in.Deletions...) {
+			names = append(names, inverted.FilterCacheProp(prop.Name))
+		}
+		b.shard.filterCache.Invalidate(names...)
+	}
+
 	return nil
 }
 
@@ -316,13 +327,13 @@ func mergeDocFromBatchReference(ref objects.BatchReference) objects.MergeDocumen
 func (b *referencesBatcher) flushWALs(ctx context.Context) {
 	if err := b.shard.store.WriteWALs(); err != nil {
 		for i := range b.refs {
-			b.setErrorAtIndex(err, i)
+			b.setErrorAtIndex(storageError{err}, i)
 		}
 	}
 
-	if err := b.shard.vectorIndex.Flush(); err != nil {
+	if err := b.shard.getVectorIndex().Flush(); err != nil {
 		for i := range b.refs {
-			b.setErrorAtIndex(err, i)
+			b.setErrorAtIndex(storageError{err}, i)
 		}
 	}
 }
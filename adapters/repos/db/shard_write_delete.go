@@ -24,7 +24,7 @@ import (
 	"github.com/weaviate/weaviate/entities/storobj"
 )
 
-func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
+func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) (err error) {
 	if s.isReadOnly() {
 		return storagestate.ErrStatusReadOnly
 	}
@@ -33,6 +33,10 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 	if err != nil {
 		return err
 	}
+	defer func() { s.trackWriteResult(err) }()
+
+	done := s.beginMigrationWrite(idBytes)
+	defer done()
 
 	var docID uint64
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
@@ -53,9 +57,17 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 		return errors.Wrap(err, "get existing doc id from object binary")
 	}
 
+	// see comment in timestamp_indexing.go::indexCurrentTimestamps
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(idBytes)]
+	lock.Lock()
 	err = bucket.Delete(idBytes)
+	lock.Unlock()
 	if err != nil {
-		return errors.Wrap(err, "delete object from bucket")
+		return storageError{errors.Wrap(err, "delete object from bucket")}
+	}
+
+	if err := s.recordDeletion(idBytes); err != nil {
+		return err
 	}
 
 	err = s.cleanupInvertedIndexOnDelete(existing, docID)
@@ -67,16 +79,16 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 	// TODO: do we still need this?
 	s.deletedDocIDs.Add(docID)
 
-	if err := s.vectorIndex.Delete(docID); err != nil {
+	if err := s.deleteFromVectorIndex(docID); err != nil {
 		return errors.Wrap(err, "delete from vector index")
 	}
 
 	if err := s.store.WriteWALs(); err != nil {
-		return errors.Wrap(err, "flush all buffered WALs")
+		return storageError{errors.Wrap(err, "flush all buffered WALs")}
 	}
 
-	if err := s.vectorIndex.Flush(); err != nil {
-		return errors.Wrap(err, "flush all vector index buffered WALs")
+	if err := s.getVectorIndex().Flush(); err != nil {
+		return storageError{errors.Wrap(err, "flush all vector index buffered WALs")}
 	}
 
 	return nil
@@ -110,9 +122,23 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 	if obj == nil || bucket == nil {
 		return nil
 	}
+
+	done := s.beginMigrationWrite(idBytes)
+	defer done()
+
+	// the bucket may have been swapped by a migration since it was looked up
+	bucket = s.store.Bucket(helpers.ObjectsBucketLSM)
+	// see comment in timestamp_indexing.go::indexCurrentTimestamps
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(idBytes)]
+	lock.Lock()
 	err := bucket.Delete(idBytes)
+	lock.Unlock()
 	if err != nil {
-		return fmt.Errorf("delete object from bucket: %w", err)
+		return storageError{fmt.Errorf("delete object from bucket: %w", err)}
+	}
+
+	if err := s.recordDeletion(idBytes); err != nil {
+		return err
 	}
 
 	err = s.cleanupInvertedIndexOnDelete(obj, docID)
@@ -124,16 +150,16 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 	// TODO: do we still need this?
 	s.deletedDocIDs.Add(docID)
 
-	if err := s.vectorIndex.Delete(docID); err != nil {
+	if err := s.deleteFromVectorIndex(docID); err != nil {
 		return fmt.Errorf("delete from vector index: %w", err)
 	}
 
 	if err := s.store.WriteWALs(); err != nil {
-		return fmt.Errorf("flush all buffered WALs: %w", err)
+		return storageError{fmt.Errorf("flush all buffered WALs: %w", err)}
 	}
 
-	if err := s.vectorIndex.Flush(); err != nil {
-		return fmt.Errorf("flush all vector index buffered WALs: %w", err)
+	if err := s.getVectorIndex().Flush(); err != nil {
+		return storageError{fmt.Errorf("flush all vector index buffered WALs: %w", err)}
 	}
 
 	return nil
@@ -65,9 +65,26 @@ func (s *Shard) extendInvertedIndicesLSM(props []inverted.Property, nilProps []n
 		}
 	}
 
+	s.invalidateFilterCache(props, nilProps)
 	return nil
 }
 
+// invalidateFilterCache removes the cached filters which read the written
+// props, it must be called once the write is visible
+func (s *Shard) invalidateFilterCache(props []inverted.Property, nilProps []nilProp) {
+	if s.filterCache == nil {
+		return
+	}
+	names := make([]string, 0, len(props)+len(nilProps))
+	for _, prop := range props {
+		names = append(names, inverted.FilterCacheProp(prop.Name))
+	}
+	for _, prop := range nilProps {
+		names = append(names, inverted.FilterCacheProp(prop.Name))
+	}
+	s.filterCache.Invalidate(names...)
+}
+
 func (s *Shard) addToPropertyValueIndex(docID uint64, property inverted.Property) error {
 	bucketValue := s.store.Bucket(helpers.BucketFromPropNameLSM(property.Name))
 	if bucketValue == nil {
@@ -81,7 +98,14 @@ func (s *Shard) addToPropertyValueIndex(docID uint64, property inverted.Property
 
 	if property.HasFrequency {
 		propLen := float32(len(property.Items))
+		pruner, err := s.impactPruner(property)
+		if err != nil {
+			return err
+		}
 		for _, item := range property.Items {
+			if !pruner.Keep(item.TermFrequency, propLen) {
+				continue
+			}
 			key := item.Data
 			if err := s.addToPropertyHashBucket(hashBucketValue, key); err != nil {
 				return errors.Wrapf(err, "failed adding to prop '%s' value hash bucket", property.Name)
@@ -94,6 +118,7 @@ func (s *Shard) addToPropertyValueIndex(docID uint64, property inverted.Property
 	} else {
 		for _, item := range property.Items {
 			key := item.Data
+			s.trackMigrationValue(property.Name, key)
 			if err := s.addToPropertyHashBucket(hashBucketValue, key); err != nil {
 				return errors.Wrapf(err, "failed adding to prop '%s' value hash bucket", property.Name)
 			}
@@ -105,6 +130,21 @@ func (s *Shard) addToPropertyValueIndex(docID uint64, property inverted.Property
 	return nil
 }
 
+// impactPruner returns the pruner of the property's postings or nil if the
+// property did not opt into pruning
+func (s *Shard) impactPruner(property inverted.Property) (*inverted.ImpactPruner, error) {
+	if property.PruningThreshold <= 0 {
+		return nil, nil
+	}
+
+	avgPropLen, err := s.propLengths.PropertyMean(property.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "average length of prop '%s'", property.Name)
+	}
+	return inverted.NewImpactPruner(property.PruningThreshold,
+		s.index.invertedIndexConfig.BM25, avgPropLen), nil
+}
+
 func (s *Shard) addToPropertyLengthIndex(propName string, docID uint64, length int) error {
 	bucketLength := s.store.Bucket(helpers.BucketFromPropNameLengthLSM(propName))
 	if bucketLength == nil {
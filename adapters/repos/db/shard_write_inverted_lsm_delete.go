@@ -45,6 +45,7 @@ func (s *Shard) deleteFromInvertedIndicesLSM(props []inverted.Property,
 			}
 		} else {
 			for _, item := range prop.Items {
+				s.trackMigrationValue(prop.Name, item.Data)
 				if err := s.deleteInvertedIndexItemLSM(b, hashBucket, item, docID); err != nil {
 					return errors.Wrapf(err, "extend index with item '%s'",
 						string(item.Data))
@@ -53,6 +54,7 @@ func (s *Shard) deleteFromInvertedIndicesLSM(props []inverted.Property,
 		}
 	}
 
+	s.invalidateFilterCache(props, nil)
 	return nil
 }
 
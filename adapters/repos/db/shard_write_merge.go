@@ -23,24 +23,28 @@ import (
 	"github.com/weaviate/weaviate/usecases/objects"
 )
 
-func (s *Shard) mergeObject(ctx context.Context, merge objects.MergeDocument) error {
+func (s *Shard) mergeObject(ctx context.Context, merge objects.MergeDocument) (err error) {
 	if s.isReadOnly() {
 		return storagestate.ErrStatusReadOnly
 	}
 
 	if merge.Vector != nil {
 		// validation needs to happen before any changes are done. Otherwise, insertion is aborted somewhere in-between.
-		err := s.vectorIndex.ValidateBeforeInsert(merge.Vector)
+		err := s.getVectorIndex().ValidateBeforeInsert(merge.Vector)
 		if err != nil {
 			return errors.Wrapf(err, "Validate vector index for update of %v", merge.ID)
 		}
 	}
+	if err := s.validateTargetVectors(merge.Vectors); err != nil {
+		return errors.Wrapf(err, "Validate vector index for update of %v", merge.ID)
+	}
 
 	idBytes, err := uuid.MustParse(merge.ID.String()).MarshalBinary()
 	if err != nil {
 		return err
 	}
 
+	defer func() { s.trackWriteResult(err) }()
 	return s.merge(ctx, idBytes, merge)
 }
 
@@ -54,16 +58,24 @@ func (s *Shard) merge(ctx context.Context, idBytes []byte, doc objects.MergeDocu
 		return errors.Wrap(err, "update vector index")
 	}
 
+	if err := s.addToTargetVectorIndexes(status.docID, next.Vectors); err != nil {
+		return errors.Wrap(err, "update target vector indexes")
+	}
+
 	if err := s.updatePropertySpecificIndices(next, status); err != nil {
 		return errors.Wrap(err, "update property-specific indices")
 	}
 
 	if err := s.store.WriteWALs(); err != nil {
-		return errors.Wrap(err, "flush all buffered WALs")
+		return storageError{errors.Wrap(err, "flush all buffered WALs")}
 	}
 
-	if err := s.vectorIndex.Flush(); err != nil {
-		return errors.Wrap(err, "flush all vector index buffered WALs")
+	if err := s.getVectorIndex().Flush(); err != nil {
+		return storageError{errors.Wrap(err, "flush all vector index buffered WALs")}
+	}
+
+	if err := s.flushTargetVectorIndexes(); err != nil {
+		return storageError{errors.Wrap(err, "flush all vector index buffered WALs")}
 	}
 
 	return nil
@@ -72,6 +84,9 @@ func (s *Shard) merge(ctx context.Context, idBytes []byte, doc objects.MergeDocu
 func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 	idBytes []byte,
 ) (*storobj.Object, objectInsertStatus, error) {
+	done := s.beginMigrationWrite(idBytes)
+	defer done()
+
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 
 	// see comment in shard_write_put.go::putObjectLSM
@@ -94,9 +109,10 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 		lock.Unlock()
 		return nil, status, errors.Wrap(err, "check insert/update status")
 	}
+	defer s.releaseDocID(status.docID)
 
 	nextObj.SetDocID(status.docID)
-	nextBytes, err := nextObj.MarshalBinary()
+	nextBytes, err := s.storageBytes(nextObj)
 	if err != nil {
 		lock.Unlock()
 		return nil, status, errors.Wrapf(err, "marshal object %s to binary", nextObj.ID())
@@ -106,6 +122,10 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 		lock.Unlock()
 		return nil, status, errors.Wrap(err, "upsert object data")
 	}
+	if err := s.putObjectVersion(idBytes, nextBytes); err != nil {
+		lock.Unlock()
+		return nil, status, errors.Wrap(err, "store object version")
+	}
 	lock.Unlock()
 
 	if err := s.updateInvertedIndexLSM(nextObj, status, previous); err != nil {
@@ -137,6 +157,9 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 func (s *Shard) mutableMergeObjectLSM(merge objects.MergeDocument,
 	idBytes []byte,
 ) (mutableMergeResult, error) {
+	done := s.beginMigrationWrite(idBytes)
+	defer done()
+
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 	out := mutableMergeResult{}
 
@@ -162,10 +185,11 @@ func (s *Shard) mutableMergeObjectLSM(merge objects.MergeDocument,
 	if err != nil {
 		return out, errors.Wrap(err, "check insert/update status")
 	}
+	defer s.releaseDocID(status.docID)
 	out.status = status
 
 	nextObj.SetDocID(status.docID) // is not changed
-	nextBytes, err := nextObj.MarshalBinary()
+	nextBytes, err := s.storageBytes(nextObj)
 	if err != nil {
 		return out, errors.Wrapf(err, "marshal object %s to binary", nextObj.ID())
 	}
@@ -173,6 +197,9 @@ func (s *Shard) mutableMergeObjectLSM(merge objects.MergeDocument,
 	if err := s.upsertObjectDataLSM(bucket, idBytes, nextBytes, status.docID); err != nil {
 		return out, errors.Wrap(err, "upsert object data")
 	}
+	if err := s.putObjectVersion(idBytes, nextBytes); err != nil {
+		return out, errors.Wrap(err, "store object version")
+	}
 
 	// do not updated inverted index, since this requires delta analysis, which
 	// must be done by the caller!
@@ -244,6 +271,14 @@ func mergeProps(previous *storobj.Object,
 		next.Vector = merge.Vector
 	}
 
+	// named vectors which are not part of the merge are kept
+	for target, vector := range merge.Vectors {
+		if next.Vectors == nil {
+			next.Vectors = make(map[string][]float32, len(merge.Vectors))
+		}
+		next.Vectors[target] = vector
+	}
+
 	next.Object.LastUpdateTimeUnix = merge.UpdateTime
 	next.SetProperties(properties)
 
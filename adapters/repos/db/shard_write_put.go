@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
@@ -39,14 +40,18 @@ func (s *Shard) putObject(ctx context.Context, object *storobj.Object) error {
 	return s.putOne(ctx, uuid, object)
 }
 
-func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object) error {
+func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object) (err error) {
 	if object.Vector != nil {
 		// validation needs to happen before any changes are done. Otherwise, insertion is aborted somewhere in-between.
-		err := s.vectorIndex.ValidateBeforeInsert(object.Vector)
+		err := s.getVectorIndex().ValidateBeforeInsert(object.Vector)
 		if err != nil {
 			return errors.Wrapf(err, "Validate vector index for %v", uuid)
 		}
 	}
+	if err := s.validateTargetVectors(object.Vectors); err != nil {
+		return errors.Wrapf(err, "Validate vector index for %v", uuid)
+	}
+	defer func() { s.trackWriteResult(err) }()
 
 	status, err := s.putObjectLSM(object, uuid, false)
 	if err != nil {
@@ -57,20 +62,28 @@ func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object)
 		return errors.Wrap(err, "update vector index")
 	}
 
+	if err := s.addToTargetVectorIndexes(status.docID, object.Vectors); err != nil {
+		return errors.Wrap(err, "update target vector indexes")
+	}
+
 	if err := s.updatePropertySpecificIndices(object, status); err != nil {
 		return errors.Wrap(err, "update property-specific indices")
 	}
 
 	if err := s.store.WriteWALs(); err != nil {
-		return errors.Wrap(err, "flush all buffered WALs")
+		return storageError{errors.Wrap(err, "flush all buffered WALs")}
 	}
 
 	if err := s.propLengths.Flush(); err != nil {
-		return errors.Wrap(err, "flush prop length tracker to disk")
+		return storageError{errors.Wrap(err, "flush prop length tracker to disk")}
+	}
+
+	if err := s.getVectorIndex().Flush(); err != nil {
+		return storageError{errors.Wrap(err, "flush all vector index buffered WALs")}
 	}
 
-	if err := s.vectorIndex.Flush(); err != nil {
-		return errors.Wrap(err, "flush all vector index buffered WALs")
+	if err := s.flushTargetVectorIndexes(); err != nil {
+		return storageError{errors.Wrap(err, "flush all vector index buffered WALs")}
 	}
 
 	return nil
@@ -88,7 +101,7 @@ func (s *Shard) updateVectorIndexIgnoreDelete(vector []float32,
 		return nil
 	}
 
-	if err := s.vectorIndex.Add(status.docID, vector); err != nil {
+	if err := s.addToVectorIndex(status.docID, vector); err != nil {
 		return errors.Wrapf(err, "insert doc id %d to vector index", status.docID)
 	}
 
@@ -103,7 +116,7 @@ func (s *Shard) updateVectorIndex(vector []float32,
 	// exists. otherwise, the associated doc id is left dangling,
 	// resulting in failed attempts to merge an object on restarts.
 	if status.docIDChanged {
-		if err := s.vectorIndex.Delete(status.oldDocID); err != nil {
+		if err := s.deleteFromVectorIndex(status.oldDocID); err != nil {
 			return errors.Wrapf(err, "delete doc id %d from vector index", status.oldDocID)
 		}
 	}
@@ -114,7 +127,7 @@ func (s *Shard) updateVectorIndex(vector []float32,
 		return nil
 	}
 
-	if err := s.vectorIndex.Add(status.docID, vector); err != nil {
+	if err := s.addToVectorIndex(status.docID, vector); err != nil {
 		return errors.Wrapf(err, "insert doc id %d to vector index", status.docID)
 	}
 
@@ -127,6 +140,9 @@ func (s *Shard) putObjectLSM(object *storobj.Object,
 	before := time.Now()
 	defer s.metrics.PutObject(before)
 
+	done := s.beginMigrationWrite(idBytes)
+	defer done()
+
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 
 	// First the object bucket is checked if already an object with the same uuid is present, to determine if it is new
@@ -144,10 +160,11 @@ func (s *Shard) putObjectLSM(object *storobj.Object,
 		lock.Unlock()
 		return status, errors.Wrap(err, "check insert/update status")
 	}
+	defer s.releaseDocID(status.docID)
 	s.metrics.PutObjectDetermineStatus(before)
 
 	object.SetDocID(status.docID)
-	data, err := object.MarshalBinary()
+	data, err := s.storageBytes(object)
 	if err != nil {
 		lock.Unlock()
 		return status, errors.Wrapf(err, "marshal object %s to binary", object.ID())
@@ -158,6 +175,10 @@ func (s *Shard) putObjectLSM(object *storobj.Object,
 		lock.Unlock()
 		return status, errors.Wrap(err, "upsert object data")
 	}
+	if err := s.putObjectVersion(idBytes, data); err != nil {
+		lock.Unlock()
+		return status, errors.Wrap(err, "store object version")
+	}
 	lock.Unlock()
 	s.metrics.PutObjectUpsertObject(before)
 
@@ -172,6 +193,36 @@ func (s *Shard) putObjectLSM(object *storobj.Object,
 	return status, nil
 }
 
+// storageBytes marshals the object as it is stored in the objects bucket,
+// i.e. without the values of index-only properties. They are only added to
+// the inverted index.
+func (s *Shard) storageBytes(object *storobj.Object) ([]byte, error) {
+	props, ok := object.Properties().(map[string]interface{})
+	if !ok || len(props) == 0 {
+		return object.MarshalBinary()
+	}
+	sch := s.index.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(object.Class())
+	if class == nil {
+		return object.MarshalBinary()
+	}
+	indexOnly := schema.IndexOnlyProperties(class)
+	if len(indexOnly) == 0 {
+		return object.MarshalBinary()
+	}
+
+	stored := make(map[string]interface{}, len(props))
+	for name, value := range props {
+		stored[name] = value
+	}
+	for _, name := range indexOnly {
+		delete(stored, name)
+	}
+	copied := *object
+	copied.Object.Properties = stored
+	return copied.MarshalBinary()
+}
+
 type objectInsertStatus struct {
 	docID        uint64
 	docIDChanged bool
@@ -180,14 +231,15 @@ type objectInsertStatus struct {
 
 // to be called with the current contents of a row, if the row is empty (i.e.
 // didn't exist before), we will get a new docID from the central counter.
-// Otherwise, we will reuse the previous docID and mark this as an update
+// Otherwise, we will reuse the previous docID and mark this as an update.
+// The caller must release the allocated docID once the object is stored.
 func (s *Shard) determineInsertStatus(previous []byte,
 	next *storobj.Object,
 ) (objectInsertStatus, error) {
 	var out objectInsertStatus
 
 	if previous == nil {
-		docID, err := s.counter.GetAndInc()
+		docID, err := s.allocateDocID()
 		if err != nil {
 			return out, errors.Wrap(err, "initial doc id: get new doc id from counter")
 		}
@@ -205,7 +257,7 @@ func (s *Shard) determineInsertStatus(previous []byte,
 	// https://github.com/weaviate/weaviate/issues/1282) there is no
 	// more check if we need to increase a docID. Any update will mean a doc ID
 	// needs to be updated.
-	docID, err = s.counter.GetAndInc()
+	docID, err = s.allocateDocID()
 	if err != nil {
 		return out, errors.Wrap(err, "doc id update: get new doc id from counter")
 	}
@@ -225,7 +277,7 @@ func (s *Shard) determineMutableInsertStatus(previous []byte,
 	var out objectInsertStatus
 
 	if previous == nil {
-		docID, err := s.counter.GetAndInc()
+		docID, err := s.allocateDocID()
 		if err != nil {
 			return out, errors.Wrap(err, "initial doc id: get new doc id from counter")
 		}
@@ -250,7 +302,10 @@ func (s *Shard) upsertObjectDataLSM(bucket *lsmkv.Bucket, id []byte, data []byte
 	binary.Write(keyBuf, binary.LittleEndian, &docID)
 	docIDBytes := keyBuf.Bytes()
 
-	return bucket.Put(id, data, lsmkv.WithSecondaryKey(0, docIDBytes))
+	if err := bucket.Put(id, data, lsmkv.WithSecondaryKey(0, docIDBytes)); err != nil {
+		return storageError{err}
+	}
+	return nil
 }
 
 func (s *Shard) updateInvertedIndexLSM(object *storobj.Object,
@@ -278,7 +333,7 @@ func (s *Shard) updateInvertedIndexLSM(object *storobj.Object,
 
 	err = s.extendInvertedIndicesLSM(props, nilprops, status.docID)
 	if err != nil {
-		return errors.Wrap(err, "put inverted indices props")
+		return storageError{errors.Wrap(err, "put inverted indices props")}
 	}
 	s.metrics.InvertedExtend(before, len(props))
 
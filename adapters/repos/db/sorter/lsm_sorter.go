@@ -20,6 +20,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/querytimeout"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
@@ -109,6 +110,10 @@ func (h *lsmSorterHelper) getSorted(ctx context.Context) ([]uint64, error) {
 	sorter := newInsertSorter(h.comparator, h.limit)
 
 	for k, objData := cursor.First(); k != nil; k, objData = cursor.Next() {
+		if err := querytimeout.Err(ctx); err != nil {
+			return nil, err
+		}
+
 		docID, err := storobj.DocIDFromBinary(objData)
 		if err != nil {
 			return nil, errors.Wrapf(err, "lsm sorter - could not get doc id")
@@ -126,6 +131,10 @@ func (h *lsmSorterHelper) getSortedDocIDs(ctx context.Context, docIDs helpers.Al
 	it := docIDs.Iterator()
 
 	for docID, ok := it.Next(); ok; docID, ok = it.Next() {
+		if err := querytimeout.Err(ctx); err != nil {
+			return nil, err
+		}
+
 		binary.LittleEndian.PutUint64(docIDBytes, docID)
 		objData, err := h.bucket.GetBySecondary(0, docIDBytes)
 		if err != nil {
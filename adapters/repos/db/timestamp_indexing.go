@@ -0,0 +1,280 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+const (
+	TimestampIndexingPending   = "PENDING"
+	TimestampIndexingRunning   = "RUNNING"
+	TimestampIndexingCompleted = "COMPLETED"
+	TimestampIndexingFailed    = "FAILED"
+)
+
+// TimestampIndexingStatus is the progress of indexing the timestamps of the
+// objects of a local shard which have been imported before timestamp
+// indexing was enabled
+type TimestampIndexingStatus struct {
+	Shard      string     `json:"shard"`
+	Status     string     `json:"status"`
+	Processed  int64      `json:"processed"`
+	Total      int64      `json:"total"`
+	Error      string     `json:"error,omitempty"`
+	StartedAt  time.Time  `json:"startedAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+}
+
+// TimestampIndexingStatus reports the progress of indexing the timestamps of
+// the existing objects of the local shards of the class
+func (d *DB) TimestampIndexingStatus(className schema.ClassName) ([]TimestampIndexingStatus, error) {
+	index := d.GetIndex(className)
+	if index == nil {
+		return nil, errors.Errorf("class %s does not exist", className)
+	}
+	return index.TimestampIndexingStatus(), nil
+}
+
+type timestampIndexingJob struct {
+	sync.Mutex
+	statuses map[string]*TimestampIndexingStatus
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+func (j *timestampIndexingJob) update(shard string, fn func(status *TimestampIndexingStatus)) {
+	j.Lock()
+	defer j.Unlock()
+	fn(j.statuses[shard])
+}
+
+// startTimestampIndexing indexes the timestamps of the existing objects of
+// the given local shards one after the other in the background, a previous
+// run is stopped first. Each shard keeps a marker until it is complete, so
+// that an interrupted run is resumed once the index is loaded again.
+func (i *Index) startTimestampIndexing(names []string) {
+	i.stopTimestampIndexing()
+
+	statuses := make(map[string]*TimestampIndexingStatus, len(names))
+	for _, name := range names {
+		shard := i.Shards[name]
+		statuses[name] = &TimestampIndexingStatus{
+			Shard:  name,
+			Status: TimestampIndexingPending,
+			Total:  int64(shard.objectCount()),
+		}
+		if err := shard.markTimestampIndexing(); err != nil {
+			i.logger.WithField("action", "index_timestamps").
+				WithField("class", i.Config.ClassName).
+				WithField("shard", name).
+				WithError(err).Error("could not mark timestamp indexing")
+		}
+	}
+	sort.Strings(names)
+
+	// the indexing outlives the request, it is stopped on shutdown
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	i.timestampIndexing.Lock()
+	i.timestampIndexing.statuses = statuses
+	i.timestampIndexing.cancel = cancel
+	i.timestampIndexing.done = done
+	i.timestampIndexing.Unlock()
+
+	go func() {
+		defer close(done)
+		defer cancel()
+
+		for _, name := range names {
+			if ctx.Err() != nil {
+				return
+			}
+			i.indexShardTimestamps(ctx, name, i.Shards[name])
+		}
+	}()
+}
+
+// resumeTimestampIndexing restarts the indexing of the shards which hadn't
+// completed it before the index was unloaded
+func (i *Index) resumeTimestampIndexing() {
+	if !i.getInvertedIndexConfig().IndexTimestamps {
+		return
+	}
+
+	var names []string
+	for name, shard := range i.Shards {
+		if _, err := os.Stat(shard.timestampIndexingPath()); err == nil {
+			names = append(names, name)
+		}
+	}
+	if len(names) > 0 {
+		i.startTimestampIndexing(names)
+	}
+}
+
+func (i *Index) indexShardTimestamps(ctx context.Context, name string, shard *Shard) {
+	i.timestampIndexing.update(name, func(status *TimestampIndexingStatus) {
+		status.Status = TimestampIndexingRunning
+		status.StartedAt = time.Now()
+	})
+
+	err := shard.indexExistingTimestamps(ctx, func(processed int64) {
+		i.timestampIndexing.update(name, func(status *TimestampIndexingStatus) {
+			status.Processed = processed
+		})
+	})
+	if err == nil {
+		err = os.Remove(shard.timestampIndexingPath())
+	}
+
+	i.timestampIndexing.update(name, func(status *TimestampIndexingStatus) {
+		finishedAt := time.Now()
+		status.FinishedAt = &finishedAt
+		status.Status = TimestampIndexingCompleted
+		if err != nil {
+			status.Status = TimestampIndexingFailed
+			status.Error = err.Error()
+		}
+	})
+
+	logger := i.logger.WithField("action", "index_timestamps").
+		WithField("class", i.Config.ClassName).
+		WithField("shard", name)
+	if err != nil {
+		logger.WithError(err).Error("could not index timestamps of existing objects")
+		return
+	}
+	logger.Info("indexed timestamps of existing objects")
+}
+
+// stopTimestampIndexing cancels a running indexing and waits for it, its
+// shards resume once the index is loaded again
+func (i *Index) stopTimestampIndexing() {
+	i.timestampIndexing.Lock()
+	cancel, done := i.timestampIndexing.cancel, i.timestampIndexing.done
+	i.timestampIndexing.Unlock()
+
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+}
+
+// TimestampIndexingStatus lists the progress of the last indexing per shard
+func (i *Index) TimestampIndexingStatus() []TimestampIndexingStatus {
+	i.timestampIndexing.Lock()
+	defer i.timestampIndexing.Unlock()
+
+	statuses := make([]TimestampIndexingStatus, 0, len(i.timestampIndexing.statuses))
+	for _, status := range i.timestampIndexing.statuses {
+		statuses = append(statuses, *status)
+	}
+	sort.Slice(statuses, func(a, b int) bool {
+		return statuses[a].Shard < statuses[b].Shard
+	})
+	return statuses
+}
+
+// timestampIndexingPath is the marker which is present while the timestamps
+// of the existing objects of the shard are indexed
+func (s *Shard) timestampIndexingPath() string {
+	return filepath.Join(s.index.Config.RootPath, s.ID()+".timestampindexing")
+}
+
+func (s *Shard) markTimestampIndexing() error {
+	return os.WriteFile(s.timestampIndexingPath(), nil, 0o666)
+}
+
+// indexExistingTimestamps adds the timestamps of all objects which have been
+// imported before timestamp indexing was enabled for the class. Writes which
+// started before that are waited for, later ones index the timestamps of
+// their objects themselves.
+func (s *Shard) indexExistingTimestamps(ctx context.Context,
+	progress func(processed int64),
+) error {
+	s.migrationLock.Lock()
+	s.migrationLock.Unlock()
+
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	var processed int64
+	err := bucket.IterateObjects(ctx, func(object *storobj.Object) error {
+		if err := s.indexCurrentTimestamps(bucket, object); err != nil {
+			return err
+		}
+
+		processed++
+		if processed%1000 == 0 {
+			progress(processed)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	progress(processed)
+	return nil
+}
+
+// indexCurrentTimestamps indexes the timestamps of the object as it is
+// currently stored, unless it has been deleted or replaced by another doc
+// id since it was iterated. The doc id lock is held, which writes hold to
+// replace or delete the object, so that they remove the added entries
+// afterwards.
+func (s *Shard) indexCurrentTimestamps(bucket *lsmkv.Bucket,
+	iterated *storobj.Object,
+) error {
+	idBytes, err := uuid.MustParse(iterated.ID().String()).MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(idBytes)]
+	lock.Lock()
+	defer lock.Unlock()
+
+	current, err := bucket.Get(idBytes)
+	if err != nil || current == nil {
+		return err
+	}
+	docID, err := storobj.DocIDFromBinary(current)
+	if err != nil {
+		return errors.Wrap(err, "get doc id from object binary")
+	}
+	if docID != iterated.DocID() {
+		return nil
+	}
+
+	object, err := storobj.FromBinary(current)
+	if err != nil {
+		return errors.Wrap(err, "unmarshal object")
+	}
+	var props []inverted.Property
+	if err := s.addIndexedTimestampsToProps(object, &props); err != nil {
+		return err
+	}
+	return s.extendInvertedIndicesLSM(props, nil, docID)
+}
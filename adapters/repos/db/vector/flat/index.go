@@ -0,0 +1,320 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
+	"github.com/weaviate/weaviate/entities/schema"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+type Config struct {
+	ID               string
+	Logger           logrus.FieldLogger
+	DistanceProvider distancer.Provider
+	// Store is the lsm store of the shard, the vectors are kept in a bucket
+	// of their own within it, so that they are part of the shard's backups
+	Store *lsmkv.Store
+	// BucketName is the bucket holding the vectors, defaults to
+	// helpers.FlatVectorsBucketLSM
+	BucketName string
+}
+
+// flat is a vector index without any graph. The vectors are stored in an lsm
+// bucket and every search compares the query to all vectors (or all allowed
+// vectors if filtered), so results are exact and nothing is held in memory.
+// This makes it a good fit for small collections, such as the classes of
+// rarely queried tenants, where building and keeping a graph is wasteful.
+type flat struct {
+	id                string
+	logger            logrus.FieldLogger
+	distancerProvider distancer.Provider
+	bucket            *lsmkv.Bucket
+	// dims is the length of the vectors in the index, 0 if it is still empty
+	dims atomic.Int32
+}
+
+func New(cfg Config, uc flatent.UserConfig) (*flat, error) {
+	if cfg.Store == nil {
+		return nil, errors.New("flat index requires an lsm store")
+	}
+	if cfg.DistanceProvider == nil {
+		return nil, errors.New("flat index requires a distance provider")
+	}
+
+	if cfg.BucketName == "" {
+		cfg.BucketName = helpers.FlatVectorsBucketLSM
+	}
+
+	err := cfg.Store.CreateOrLoadBucket(context.Background(), cfg.BucketName,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace))
+	if err != nil {
+		return nil, errors.Wrap(err, "create or load flat vectors bucket")
+	}
+
+	return &flat{
+		id:                cfg.ID,
+		logger:            cfg.Logger,
+		distancerProvider: cfg.DistanceProvider,
+		bucket:            cfg.Store.Bucket(cfg.BucketName),
+	}, nil
+}
+
+func (f *flat) Add(id uint64, vector []float32) error {
+	if len(vector) == 0 {
+		return errors.Errorf("insert called with nil-vector")
+	}
+
+	vector = f.normalize(vector)
+	f.dims.CompareAndSwap(0, int32(len(vector)))
+
+	return f.bucket.Put(idToKey(id), vectorToBytes(vector))
+}
+
+func (f *flat) Delete(ids ...uint64) error {
+	for _, id := range ids {
+		if err := f.bucket.Delete(idToKey(id)); err != nil {
+			return errors.Wrapf(err, "delete vector of doc id %d", id)
+		}
+	}
+	return nil
+}
+
+func (f *flat) SearchByVector(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	if k <= 0 {
+		return nil, nil, nil
+	}
+
+	queryDistancer := f.distancerProvider.New(f.normalize(vector))
+	results := priorityqueue.NewMax(k)
+	insert := func(id uint64, v []byte) error {
+		dist, ok, err := queryDistancer.Distance(bytesToVector(v))
+		if err != nil {
+			return errors.Wrapf(err, "calculate distance to doc id %d", id)
+		}
+		if !ok {
+			return nil
+		}
+		if results.Len() < k {
+			results.Insert(id, dist)
+		} else if dist < results.Top().Dist {
+			results.Pop()
+			results.Insert(id, dist)
+		}
+		return nil
+	}
+
+	if allow != nil {
+		// the allow list is typically much smaller than the index, so fetch
+		// the allowed vectors one by one instead of scanning the bucket
+		it := allow.Iterator()
+		for id, ok := it.Next(); ok; id, ok = it.Next() {
+			v, err := f.bucket.Get(idToKey(id))
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "get vector of doc id %d", id)
+			}
+			if v == nil {
+				continue
+			}
+			if err := insert(id, v); err != nil {
+				return nil, nil, err
+			}
+		}
+	} else {
+		c := f.bucket.Cursor()
+		defer c.Close()
+		for key, v := c.First(); key != nil; key, v = c.Next() {
+			if err := insert(binary.BigEndian.Uint64(key), v); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	ids := make([]uint64, results.Len())
+	dists := make([]float32, results.Len())
+	for i := len(ids) - 1; i >= 0; i-- {
+		item := results.Pop()
+		ids[i] = item.ID
+		dists[i] = item.Dist
+	}
+	return ids, dists, nil
+}
+
+func (f *flat) SearchByVectorDistance(vector []float32, targetDistance float32,
+	maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	limit := int(maxLimit)
+	if maxLimit < 0 || maxLimit > math.MaxInt32 {
+		limit = math.MaxInt32
+	}
+	if count := f.bucket.Count(); count < limit {
+		limit = count
+	}
+
+	ids, dists, err := f.SearchByVector(vector, limit, allow)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the results are sorted by distance, so cut off at the first one which
+	// is too far away
+	for i, dist := range dists {
+		if dist > targetDistance {
+			return ids[:i], dists[:i], nil
+		}
+	}
+	return ids, dists, nil
+}
+
+func (f *flat) UpdateUserConfig(updated schema.VectorIndexConfig, callback func()) error {
+	callback()
+	if _, ok := updated.(flatent.UserConfig); !ok {
+		return errors.Errorf("config is not flat.UserConfig, but %T", updated)
+	}
+	// there is nothing to tune at runtime, the distance is immutable
+	return nil
+}
+
+func (f *flat) ValidateBeforeInsert(vector []float32) error {
+	if dims := int(f.dims.Load()); dims != 0 && dims != len(vector) {
+		return fmt.Errorf("new node has a vector with length %v. "+
+			"Existing nodes have vectors with length %v", len(vector), dims)
+	}
+	return nil
+}
+
+// PostStartup restores the vector length from the first stored vector
+func (f *flat) PostStartup() {
+	c := f.bucket.Cursor()
+	defer c.Close()
+	if key, v := c.First(); key != nil {
+		f.dims.Store(int32(len(v) / 4))
+	}
+}
+
+// Drop is a no-op, the bucket is part of the shard's store and removed with it
+func (f *flat) Drop(context.Context) error {
+	return nil
+}
+
+// Flush is a no-op, the bucket is flushed along with the shard's store
+func (f *flat) Flush() error {
+	return nil
+}
+
+func (f *flat) Shutdown(context.Context) error {
+	return nil
+}
+
+func (f *flat) PauseMaintenance(context.Context) error {
+	return nil
+}
+
+func (f *flat) SwitchCommitLogs(context.Context) error {
+	return nil
+}
+
+// ListFiles returns no files, the bucket is backed up with the shard's store
+func (f *flat) ListFiles(context.Context) ([]string, error) {
+	return nil, nil
+}
+
+func (f *flat) ResumeMaintenance(context.Context) error {
+	return nil
+}
+
+func (f *flat) Dump(labels ...string) {
+}
+
+func (f *flat) Optimize(context.Context) error {
+	return nil
+}
+
+func (f *flat) CheckIntegrity(context.Context, bool) (hnswent.IntegrityReport, error) {
+	return hnswent.IntegrityReport{}, errors.Errorf("integrity checks are not supported by the flat index")
+}
+
+// SearchCost is the number of vectors a search compares, see package
+// querycost
+func (f *flat) SearchCost(k int, allow helpers.AllowList) int {
+	if allow != nil {
+		return allow.Len()
+	}
+	return f.bucket.Count()
+}
+
+func (f *flat) normalize(vector []float32) []float32 {
+	if f.distancerProvider.Type() == "cosine-dot" {
+		// cosine-dot requires normalized vectors, as the dot product and cosine
+		// similarity are only identical if the vector is normalized
+		return distancer.Normalize(vector)
+	}
+	return vector
+}
+
+func idToKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
+
+func vectorToBytes(vector []float32) []byte {
+	out := make([]byte, 4*len(vector))
+	for i, x := range vector {
+		binary.LittleEndian.PutUint32(out[i*4:], math.Float32bits(x))
+	}
+	return out
+}
+
+func bytesToVector(in []byte) []float32 {
+	out := make([]float32, len(in)/4)
+	for i := range out {
+		out[i] = math.Float32frombits(binary.LittleEndian.Uint32(in[i*4:]))
+	}
+	return out
+}
+
+func ValidateUserConfigUpdate(initial, updated schema.VectorIndexConfig) error {
+	initialParsed, ok := initial.(flatent.UserConfig)
+	if !ok {
+		return errors.Errorf("initial is not flat.UserConfig, but %T", initial)
+	}
+
+	updatedParsed, ok := updated.(flatent.UserConfig)
+	if !ok {
+		return errors.Errorf("updated is not flat.UserConfig, but %T", updated)
+	}
+
+	if initialParsed.Distance != updatedParsed.Distance {
+		return errors.Errorf("distance is immutable: attempted change from \"%s\" to \"%s\"",
+			initialParsed.Distance, updatedParsed.Distance)
+	}
+
+	if initialParsed.Skip != updatedParsed.Skip {
+		return errors.Errorf("skip is immutable: attempted change from \"%t\" to \"%t\"",
+			initialParsed.Skip, updatedParsed.Skip)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+)
+
+func TestFlatIndex(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dirName := t.TempDir()
+
+	store, err := lsmkv.New(dirName, dirName, logger, nil)
+	require.Nil(t, err)
+	defer store.Shutdown(ctx)
+
+	provider := distancer.NewL2SquaredProvider()
+	vectors, queries := testinghelpers.RandomVecs(500, 10, 16)
+	index, err := New(Config{
+		ID:               "flat",
+		Logger:           logger,
+		DistanceProvider: provider,
+		Store:            store,
+	}, flatent.NewDefaultUserConfig())
+	require.Nil(t, err)
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	bruteForce := func(query []float32, ids []uint64) ([]uint64, []float32) {
+		dists := make([]float32, len(ids))
+		for i, id := range ids {
+			dists[i], _, _ = provider.SingleDist(query, vectors[id])
+		}
+		sort.Sort(byDistance{ids: ids, dists: dists})
+		return ids, dists
+	}
+	allIDs := func() []uint64 {
+		ids := make([]uint64, len(vectors))
+		for i := range ids {
+			ids[i] = uint64(i)
+		}
+		return ids
+	}
+
+	t.Run("search is exact", func(t *testing.T) {
+		for _, query := range queries {
+			ids, dists, err := index.SearchByVector(query, 10, nil)
+			require.Nil(t, err)
+			expectedIDs, expectedDists := bruteForce(query, allIDs())
+			assert.Equal(t, expectedIDs[:10], ids)
+			assert.InDeltaSlice(t, expectedDists[:10], dists, 1e-4)
+		}
+	})
+
+	t.Run("search with an allow list", func(t *testing.T) {
+		allowed := []uint64{3, 7, 42, 99, 123, 256, 499}
+		for _, query := range queries {
+			ids, _, err := index.SearchByVector(query, 5, helpers.NewAllowList(allowed...))
+			require.Nil(t, err)
+			expectedIDs, _ := bruteForce(query, append([]uint64{}, allowed...))
+			assert.Equal(t, expectedIDs[:5], ids)
+		}
+	})
+
+	t.Run("search by distance", func(t *testing.T) {
+		query := queries[0]
+		expectedIDs, expectedDists := bruteForce(query, allIDs())
+		target := expectedDists[20]
+		ids, dists, err := index.SearchByVectorDistance(query, target, -1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, expectedIDs[:21], ids)
+		for _, dist := range dists {
+			assert.LessOrEqual(t, dist, target)
+		}
+	})
+
+	t.Run("deleted vectors are not returned", func(t *testing.T) {
+		query := queries[0]
+		ids, _, err := index.SearchByVector(query, 1, nil)
+		require.Nil(t, err)
+		require.Nil(t, index.Delete(ids[0]))
+
+		after, _, err := index.SearchByVector(query, 1, nil)
+		require.Nil(t, err)
+		assert.NotEqual(t, ids[0], after[0])
+	})
+
+	t.Run("vector length is validated", func(t *testing.T) {
+		assert.Nil(t, index.ValidateBeforeInsert(make([]float32, 16)))
+		assert.NotNil(t, index.ValidateBeforeInsert(make([]float32, 17)))
+	})
+
+	t.Run("vector length is restored on startup", func(t *testing.T) {
+		restarted, err := New(Config{
+			ID:               "flat",
+			Logger:           logger,
+			DistanceProvider: provider,
+			Store:            store,
+		}, flatent.NewDefaultUserConfig())
+		require.Nil(t, err)
+		restarted.PostStartup()
+		assert.NotNil(t, restarted.ValidateBeforeInsert(make([]float32, 17)))
+	})
+}
+
+type byDistance struct {
+	ids   []uint64
+	dists []float32
+}
+
+func (s byDistance) Len() int           { return len(s.ids) }
+func (s byDistance) Less(i, j int) bool { return s.dists[i] < s.dists[j] }
+func (s byDistance) Swap(i, j int) {
+	s.ids[i], s.ids[j] = s.ids[j], s.ids[i]
+	s.dists[i], s.dists[j] = s.dists[j], s.dists[i]
+}
@@ -20,9 +20,11 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/walcompression"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
@@ -55,6 +57,7 @@ type Config struct {
 	DisablePersistence bool
 	RootPath           string
 	Logger             logrus.FieldLogger
+	WALCompression     walcompression.Codec
 }
 
 func NewIndex(config Config) (*Index, error) {
@@ -99,7 +102,8 @@ func makeCommitLoggerFromConfig(config Config) hnsw.MakeCommitLogger {
 	if !config.DisablePersistence {
 		makeCL = func() (hnsw.CommitLogger, error) {
 			return hnsw.NewCommitLogger(config.RootPath, config.ID, config.Logger,
-				hnsw.WithCommitlogCycleTicker(cyclemanager.GeoCommitLoggerCycleTicker))
+				hnsw.WithCommitlogCycleTicker(cyclemanager.GeoCommitLoggerCycleTicker),
+				hnsw.WithCommitlogCompression(config.WALCompression))
 		}
 	}
 	return makeCL
@@ -133,6 +137,35 @@ func (i *Index) WithinRange(ctx context.Context,
 	return i.vectorIndex.KnnSearchByVectorMaxDist(query, geoRange.Distance, 800, nil)
 }
 
+// WithinShape searches the index for the coordinates within the shape. The
+// candidates of the enclosing range of the shape are checked one by one. It
+// is thread-safe and can be called concurrently.
+func (i *Index) WithinShape(ctx context.Context,
+	shape filters.GeoShape,
+) ([]uint64, error) {
+	candidates, err := i.WithinRange(ctx, shape.EnclosingRange())
+	if err != nil {
+		return nil, err
+	}
+
+	out := candidates[:0]
+	for _, id := range candidates {
+		coordinates, err := i.config.CoordinatesForID(ctx, id)
+		if err != nil {
+			var e storobj.ErrNotFound
+			if errors.As(err, &e) {
+				// deleted concurrently
+				continue
+			}
+			return nil, errors.Wrapf(err, "get coordinates of doc id %d", id)
+		}
+		if shape.Contains(coordinates) {
+			out = append(out, id)
+		}
+	}
+	return out, nil
+}
+
 func (i *Index) Delete(id uint64) error {
 	return i.vectorIndex.Delete(id)
 }
@@ -107,6 +107,38 @@ func TestGeoJourney(t *testing.T) {
 		expectedResults := []uint64{0}
 		assert.Equal(t, expectedResults, results)
 	})
+
+	t.Run("searching within a box around munich", func(t *testing.T) {
+		results, err := geoIndex.WithinShape(context.Background(), filters.GeoBox{
+			TopLeft: models.GeoCoordinates{
+				Latitude:  ptFloat32(48.5),
+				Longitude: ptFloat32(11),
+			},
+			BottomRight: models.GeoCoordinates{
+				Latitude:  ptFloat32(48),
+				Longitude: ptFloat32(12),
+			},
+		})
+		require.Nil(t, err)
+
+		expectedResults := []uint64{0}
+		assert.Equal(t, expectedResults, results)
+	})
+
+	t.Run("searching within a polygon around both cities", func(t *testing.T) {
+		results, err := geoIndex.WithinShape(context.Background(), filters.GeoPolygon{
+			Points: []models.GeoCoordinates{
+				{Latitude: ptFloat32(47.5), Longitude: ptFloat32(8.5)},
+				{Latitude: ptFloat32(49.5), Longitude: ptFloat32(8.5)},
+				{Latitude: ptFloat32(47.5), Longitude: ptFloat32(12.5)},
+			},
+		})
+		require.Nil(t, err)
+
+		// munich is close to the hypotenuse, but outside the triangle
+		expectedResults := []uint64{1}
+		assert.Equal(t, expectedResults, results)
+	})
 }
 
 func ptFloat32(in float32) *float32 {
@@ -18,6 +18,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/walcompression"
 )
 
 type CommitLogCombiner struct {
@@ -100,6 +101,16 @@ func (c *CommitLogCombiner) combineFirstMatch(fileNames []string) (bool, error)
 			continue
 		}
 
+		sameFormat, err := c.sameFormat(fileName, fileNames[i+1])
+		if err != nil {
+			return false, err
+		}
+		if !sameFormat {
+			// a compressed log can only be combined with another compressed log,
+			// this happens once after compression was turned on or off
+			continue
+		}
+
 		if err := c.combine(fileName, fileNames[i+1]); err != nil {
 			return false, errors.Wrapf(err, "combine %q and %q", fileName, fileNames[i+1])
 		}
@@ -110,6 +121,25 @@ func (c *CommitLogCombiner) combineFirstMatch(fileNames []string) (bool, error)
 	return false, nil
 }
 
+// sameFormat reports whether either both or none of the logs are compressed,
+// an empty log can be combined with either
+func (c *CommitLogCombiner) sameFormat(first, second string) (bool, error) {
+	firstCodec, firstOK, err := walcompression.DetectFile(first)
+	if err != nil {
+		return false, errors.Wrapf(err, "detect compression of %q", first)
+	}
+	secondCodec, secondOK, err := walcompression.DetectFile(second)
+	if err != nil {
+		return false, errors.Wrapf(err, "detect compression of %q", second)
+	}
+	if !firstOK || !secondOK {
+		return true, nil
+	}
+
+	return (firstCodec == walcompression.CodecNone) ==
+		(secondCodec == walcompression.CodecNone), nil
+}
+
 func (c *CommitLogCombiner) combine(first, second string) error {
 	// all names are based on the first file, so that once file1 + file2 are
 	// combined it is as if file2 had never existed and file 1 was just always
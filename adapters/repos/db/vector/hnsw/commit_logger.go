@@ -26,6 +26,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/commitlog"
 	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/walcompression"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 )
@@ -78,6 +79,10 @@ func NewCommitLogger(rootPath, name string, logger logrus.FieldLogger,
 		}
 	}
 
+	if l.walCompression != walcompression.CodecNone {
+		l.condensor = NewMemoryCondensorWithCodec(logger, l.walCompression)
+	}
+
 	fd, err := getLatestCommitFileOrCreate(rootPath, name)
 	if err != nil {
 		return nil, err
@@ -86,11 +91,28 @@ func NewCommitLogger(rootPath, name string, logger logrus.FieldLogger,
 	l.switchLogCycle = cyclemanager.New(l.cycleTicker(), l.startSwitchLogs)
 	l.condenseCycle = cyclemanager.New(l.cycleTicker(), l.startCombineAndCondenseLogs)
 
-	l.commitLogger = commitlog.NewLoggerWithFile(fd)
+	l.commitLogger, err = l.newLogger(fd)
+	if err != nil {
+		return nil, err
+	}
 	l.Start()
 	return l, nil
 }
 
+// newLogger continues the log in fd with the codec it was written with, an
+// empty log is written with the configured codec
+func (l *hnswCommitLogger) newLogger(fd *os.File) (*commitlog.Logger, error) {
+	codec, ok, err := walcompression.DetectFile(fd.Name())
+	if err != nil {
+		return nil, errors.Wrap(err, "detect commit log compression")
+	}
+	if !ok {
+		codec = l.walCompression
+	}
+
+	return commitlog.NewLoggerWithCodec(fd, codec), nil
+}
+
 func getLatestCommitFileOrCreate(rootPath, name string) (*os.File, error) {
 	dir := commitLogDirectory(rootPath, name)
 	err := os.MkdirAll(dir, os.ModePerm)
@@ -268,6 +290,9 @@ type hnswCommitLogger struct {
 	switchLogCycle *cyclemanager.CycleManager
 	condenseCycle  *cyclemanager.CycleManager
 	cycleTicker    cyclemanager.TickerProvider
+
+	// walCompression is the codec new commit logs are compressed with
+	walCompression walcompression.Codec
 }
 
 type HnswCommitType uint8 // 256 options, plenty of room for future extensions
@@ -285,6 +310,9 @@ const (
 	ClearLinksAtLevel // added in v1.8.0-rc.1, see https://github.com/weaviate/weaviate/issues/1701
 	AddLinksAtLevel   // added in v1.8.0-rc.1, see https://github.com/weaviate/weaviate/issues/1705
 	AddPQ
+	AddPQRotation
+	AddPCA
+	AddSQ
 )
 
 func (t HnswCommitType) String() string {
@@ -313,6 +341,12 @@ func (t HnswCommitType) String() string {
 		return "ClearLinksAtLevel"
 	case AddPQ:
 		return "AddProductQuantizer"
+	case AddPQRotation:
+		return "AddProductQuantizerRotation"
+	case AddPCA:
+		return "AddPrincipalComponentAnalysis"
+	case AddSQ:
+		return "AddScalarQuantizer"
 	}
 	return "unknown commit type"
 }
@@ -328,6 +362,20 @@ func (l *hnswCommitLogger) AddPQ(data ssdhelpers.PQData) error {
 	return l.commitLogger.AddPQ(data)
 }
 
+func (l *hnswCommitLogger) AddSQ(data ssdhelpers.SQData) error {
+	l.Lock()
+	defer l.Unlock()
+
+	return l.commitLogger.AddSQ(data)
+}
+
+func (l *hnswCommitLogger) AddPCA(pca *ssdhelpers.PCA) error {
+	l.Lock()
+	defer l.Unlock()
+
+	return l.commitLogger.AddPCA(pca)
+}
+
 // AddNode adds an empty node
 func (l *hnswCommitLogger) AddNode(node *vertex) error {
 	l.Lock()
@@ -536,7 +584,10 @@ func (l *hnswCommitLogger) switchCommitLogs(force bool) (bool, error) {
 		return true, errors.Wrap(err, "create commit log file")
 	}
 
-	l.commitLogger = commitlog.NewLoggerWithFile(fd)
+	l.commitLogger, err = l.newLogger(fd)
+	if err != nil {
+		return true, err
+	}
 
 	return true, nil
 }
@@ -578,6 +629,32 @@ func (l *hnswCommitLogger) combineLogs() (bool, error) {
 	return NewCommitLogCombiner(l.rootPath, l.id, threshold, l.logger).Do()
 }
 
+// Condense combines and condenses all read-only commit logs until neither is
+// possible anymore. Unlike the condense cycle which handles a single file at
+// a time, it only returns once all logs are condensed. The cycle should be
+// stopped while it runs.
+func (l *hnswCommitLogger) Condense(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		combined, err := l.combineLogs()
+		if err != nil {
+			return errors.Wrap(err, "combine commit logs")
+		}
+
+		condensed, err := l.condenseOldLogs()
+		if err != nil {
+			return errors.Wrap(err, "condense commit logs")
+		}
+
+		if !combined && !condensed {
+			return nil
+		}
+	}
+}
+
 func (l *hnswCommitLogger) Drop(ctx context.Context) error {
 	if err := l.commitLogger.Close(); err != nil {
 		return errors.Wrap(err, "close hnsw commit logger prior to delete")
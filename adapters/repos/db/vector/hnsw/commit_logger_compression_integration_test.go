@@ -0,0 +1,178 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package hnsw
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/walcompression"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestCompressedCommitLogs(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	id := "compressed_test"
+
+	data := make([][]float32, 200)
+	for i := range data {
+		data[i] = make([]float32, 8)
+		for j := range data[i] {
+			data[i][j] = rand.Float32()
+		}
+	}
+
+	newIndex := func(t *testing.T, rootPath string, makeCL MakeCommitLogger) *hnsw {
+		idx, err := New(Config{
+			MakeCommitLoggerThunk: makeCL,
+			ID:                    id,
+			RootPath:              rootPath,
+			DistanceProvider:      distancer.NewL2SquaredProvider(),
+			Logger:                logger,
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				return data[int(id)], nil
+			},
+		}, hnswent.UserConfig{
+			MaxConnections:         30,
+			EFConstruction:         64,
+			CleanupIntervalSeconds: 0,
+		})
+		require.Nil(t, err)
+		return idx
+	}
+
+	newCommitLogger := func(t *testing.T, rootPath string,
+		codec walcompression.Codec,
+	) *hnswCommitLogger {
+		cl, err := NewCommitLogger(rootPath, id, logger,
+			WithCommitlogCycleTicker(cyclemanager.NewNoopTicker),
+			WithCommitlogCompression(codec))
+		require.Nil(t, err)
+		return cl
+	}
+
+	add := func(t *testing.T, idx *hnsw, from, to int) {
+		for i := from; i < to; i++ {
+			require.Nil(t, idx.Add(uint64(i), data[i]))
+		}
+		require.Nil(t, idx.Flush())
+	}
+
+	currentCodec := func(t *testing.T, rootPath string) walcompression.Codec {
+		name, ok, err := getCurrentCommitLogFileName(commitLogDirectory(rootPath, id))
+		require.Nil(t, err)
+		require.True(t, ok)
+		codec, ok, err := walcompression.DetectFile(commitLogFileName(rootPath, id, name))
+		require.Nil(t, err)
+		require.True(t, ok)
+		return codec
+	}
+
+	assertRestored := func(t *testing.T, rootPath string, count int) {
+		idx := newIndex(t, rootPath, MakeNoopCommitLogger)
+		restored := 0
+		for _, node := range idx.nodes {
+			if node != nil {
+				restored++
+			}
+		}
+		assert.Equal(t, count, restored)
+	}
+
+	t.Run("switching compression on keeps existing logs readable", func(t *testing.T) {
+		rootPath := t.TempDir()
+
+		cl := newCommitLogger(t, rootPath, walcompression.CodecNone)
+		idx := newIndex(t, rootPath, func() (CommitLogger, error) { return cl, nil })
+		add(t, idx, 0, 100)
+		assert.Equal(t, walcompression.CodecNone, currentCodec(t, rootPath))
+
+		// the current log is continued uncompressed
+		cl = newCommitLogger(t, rootPath, walcompression.CodecZstd)
+		idx = newIndex(t, rootPath, func() (CommitLogger, error) { return cl, nil })
+		add(t, idx, 100, 150)
+		assert.Equal(t, walcompression.CodecNone, currentCodec(t, rootPath))
+
+		// the logs are named after the second they were created in
+		time.Sleep(time.Second)
+		require.Nil(t, cl.SwitchCommitLogs(true))
+		add(t, idx, 150, 200)
+		assert.Equal(t, walcompression.CodecZstd, currentCodec(t, rootPath))
+		assertRestored(t, rootPath, 200)
+
+		time.Sleep(time.Second)
+		require.Nil(t, cl.SwitchCommitLogs(true))
+		for {
+			ok, err := cl.condenseOldLogs()
+			require.Nil(t, err)
+			if !ok {
+				break
+			}
+		}
+		_, err := cl.combineLogs()
+		require.Nil(t, err)
+
+		fileNames, err := getCommitFileNames(rootPath, id)
+		require.Nil(t, err)
+		for _, fileName := range fileNames[:len(fileNames)-1] {
+			codec, ok, err := walcompression.DetectFile(fileName)
+			require.Nil(t, err)
+			require.True(t, ok)
+			assert.Equal(t, walcompression.CodecZstd, codec, fileName)
+		}
+		assertRestored(t, rootPath, 200)
+	})
+
+	t.Run("a log which ended abruptly is truncated after its last block", func(t *testing.T) {
+		rootPath := t.TempDir()
+
+		cl := newCommitLogger(t, rootPath, walcompression.CodecSnappy)
+		idx := newIndex(t, rootPath, func() (CommitLogger, error) { return cl, nil })
+		add(t, idx, 0, 100)
+		add(t, idx, 100, 200)
+
+		name, ok, err := getCurrentCommitLogFileName(commitLogDirectory(rootPath, id))
+		require.Nil(t, err)
+		require.True(t, ok)
+		fileName := commitLogFileName(rootPath, id, name)
+		info, err := os.Stat(fileName)
+		require.Nil(t, err)
+		require.Nil(t, os.Truncate(fileName, info.Size()-1))
+
+		assertRestored(t, rootPath, 100)
+
+		f, err := os.Open(fileName)
+		require.Nil(t, err)
+		defer f.Close()
+		_, err = io.ReadAll(walcompression.NewReader(bufio.NewReader(f)))
+		assert.Nil(t, err)
+
+		// the log can be appended to again
+		cl = newCommitLogger(t, rootPath, walcompression.CodecSnappy)
+		idx = newIndex(t, rootPath, func() (CommitLogger, error) { return cl, nil })
+		add(t, idx, 100, 200)
+		assertRestored(t, rootPath, 200)
+	})
+}
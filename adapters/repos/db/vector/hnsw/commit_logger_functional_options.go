@@ -11,7 +11,10 @@
 
 package hnsw
 
-import "github.com/weaviate/weaviate/entities/cyclemanager"
+import (
+	"github.com/weaviate/weaviate/adapters/repos/db/walcompression"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
 
 type CommitlogOption func(l *hnswCommitLogger) error
 
@@ -35,3 +38,12 @@ func WithCommitlogCycleTicker(cycleTicker cyclemanager.TickerProvider) Commitlog
 		return nil
 	}
 }
+
+// WithCommitlogCompression compresses new commit logs with the codec. Logs
+// are read regardless of whether they are compressed.
+func WithCommitlogCompression(codec walcompression.Codec) CommitlogOption {
+	return func(l *hnswCommitLogger) error {
+		l.walCompression = codec
+		return nil
+	}
+}
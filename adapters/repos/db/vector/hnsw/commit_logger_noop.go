@@ -29,6 +29,14 @@ func (n *NoopCommitLogger) AddPQ(data ssdhelpers.PQData) error {
 	return nil
 }
 
+func (n *NoopCommitLogger) AddPCA(pca *ssdhelpers.PCA) error {
+	return nil
+}
+
+func (n *NoopCommitLogger) AddSQ(data ssdhelpers.SQData) error {
+	return nil
+}
+
 func (n *NoopCommitLogger) Start() {}
 
 func (n *NoopCommitLogger) AddNode(node *vertex) error {
@@ -107,6 +115,10 @@ func (n *NoopCommitLogger) SwitchCommitLogs(force bool) error {
 	return nil
 }
 
+func (n *NoopCommitLogger) Condense(ctx context.Context) error {
+	return nil
+}
+
 func (n *NoopCommitLogger) MaintenanceInProgress() bool {
 	return false
 }
@@ -13,15 +13,28 @@ package commitlog
 
 import (
 	"encoding/binary"
+	"io"
 	"os"
 
 	"github.com/pkg/errors"
 	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/walcompression"
 )
 
 type Logger struct {
 	file *os.File
-	bufw *bufWriter
+	bufw writer
+
+	// compressed is set if the records are compressed in blocks, it is the
+	// same as bufw then
+	compressed *walcompression.Writer
+}
+
+// writer is a *bufWriter, or a *walcompression.Writer if the log is
+// compressed
+type writer interface {
+	io.Writer
+	Flush() error
 }
 
 // TODO: these are duplicates with the hnsw package, unify them
@@ -41,6 +54,9 @@ const (
 	ClearLinksAtLevel // added in v1.8.0-rc.1, see https://github.com/weaviate/weaviate/issues/1701
 	AddLinksAtLevel   // added in v1.8.0-rc.1, see https://github.com/weaviate/weaviate/issues/1705
 	AddPQ
+	AddPQRotation
+	AddPCA
+	AddSQ
 )
 
 func NewLogger(fileName string) *Logger {
@@ -56,13 +72,40 @@ func NewLoggerWithFile(file *os.File) *Logger {
 	return &Logger{file: file, bufw: NewWriterSize(file, 1024*1024)}
 }
 
+// NewLoggerWithCodec compresses the records in blocks using codec, the
+// records are written uncompressed with walcompression.CodecNone
+func NewLoggerWithCodec(file *os.File, codec walcompression.Codec) *Logger {
+	if codec == walcompression.CodecNone {
+		return NewLoggerWithFile(file)
+	}
+
+	compressed := walcompression.NewWriter(file, codec, 1024*1024)
+	return &Logger{file: file, bufw: compressed, compressed: compressed}
+}
+
+// write writes a complete record
+func (l *Logger) write(record []byte) error {
+	if _, err := l.bufw.Write(record); err != nil {
+		return err
+	}
+	return l.endRecord()
+}
+
+// endRecord lets a compressed log write a block once it is full, records
+// are never split across blocks
+func (l *Logger) endRecord() error {
+	if l.compressed == nil {
+		return nil
+	}
+	return l.compressed.EndRecord()
+}
+
 func (l *Logger) SetEntryPointWithMaxLayer(id uint64, level int) error {
 	toWrite := make([]byte, 11)
 	toWrite[0] = byte(SetEntryPointMaxLevel)
 	binary.LittleEndian.PutUint64(toWrite[1:9], id)
 	binary.LittleEndian.PutUint16(toWrite[9:11], uint16(level))
-	_, err := l.bufw.Write(toWrite)
-	return err
+	return l.write(toWrite)
 }
 
 func (l *Logger) AddNode(id uint64, level int) error {
@@ -70,8 +113,7 @@ func (l *Logger) AddNode(id uint64, level int) error {
 	toWrite[0] = byte(AddNode)
 	binary.LittleEndian.PutUint64(toWrite[1:9], id)
 	binary.LittleEndian.PutUint16(toWrite[9:11], uint16(level))
-	_, err := l.bufw.Write(toWrite)
-	return err
+	return l.write(toWrite)
 }
 
 func (l *Logger) AddPQ(data ssdhelpers.PQData) error {
@@ -91,8 +133,26 @@ func (l *Logger) AddPQ(data ssdhelpers.PQData) error {
 	for _, encoder := range data.Encoders {
 		toWrite = append(toWrite, encoder.ExposeDataForRestore()...)
 	}
-	_, err := l.bufw.Write(toWrite)
-	return err
+
+	// the rotation is logged as a separate entry, so that commit logs of
+	// indexes without a rotation are unchanged
+	if data.Rotation != nil {
+		toWrite = append(toWrite, byte(AddPQRotation))
+		toWrite = append(toWrite, data.Rotation.ExposeDataForRestore()...)
+	}
+	return l.write(toWrite)
+}
+
+func (l *Logger) AddPCA(pca *ssdhelpers.PCA) error {
+	toWrite := []byte{byte(AddPCA)}
+	toWrite = append(toWrite, pca.ExposeDataForRestore()...)
+	return l.write(toWrite)
+}
+
+func (l *Logger) AddSQ(data ssdhelpers.SQData) error {
+	toWrite := []byte{byte(AddSQ)}
+	toWrite = append(toWrite, data.ExposeDataForRestore()...)
+	return l.write(toWrite)
 }
 
 func (l *Logger) AddLinkAtLevel(id uint64, level int, target uint64) error {
@@ -101,8 +161,7 @@ func (l *Logger) AddLinkAtLevel(id uint64, level int, target uint64) error {
 	binary.LittleEndian.PutUint64(toWrite[1:9], id)
 	binary.LittleEndian.PutUint16(toWrite[9:11], uint16(level))
 	binary.LittleEndian.PutUint64(toWrite[11:19], target)
-	_, err := l.bufw.Write(toWrite)
-	return err
+	return l.write(toWrite)
 }
 
 func (l *Logger) AddLinksAtLevel(id uint64, level int, targets []uint64) error {
@@ -116,8 +175,7 @@ func (l *Logger) AddLinksAtLevel(id uint64, level int, targets []uint64) error {
 		offsetEnd := offsetStart + 8
 		binary.LittleEndian.PutUint64(toWrite[offsetStart:offsetEnd], target)
 	}
-	_, err := l.bufw.Write(toWrite)
-	return err
+	return l.write(toWrite)
 }
 
 // chunks links in increments of 8, so that we never have to allocate a dynamic
@@ -164,31 +222,28 @@ func (l *Logger) ReplaceLinksAtLevel(id uint64, level int, targets []uint64) err
 		}
 	}
 
-	return nil
+	return l.endRecord()
 }
 
 func (l *Logger) AddTombstone(id uint64) error {
 	toWrite := make([]byte, 9)
 	toWrite[0] = byte(AddTombstone)
 	binary.LittleEndian.PutUint64(toWrite[1:9], id)
-	_, err := l.bufw.Write(toWrite)
-	return err
+	return l.write(toWrite)
 }
 
 func (l *Logger) RemoveTombstone(id uint64) error {
 	toWrite := make([]byte, 9)
 	toWrite[0] = byte(RemoveTombstone)
 	binary.LittleEndian.PutUint64(toWrite[1:9], id)
-	_, err := l.bufw.Write(toWrite)
-	return err
+	return l.write(toWrite)
 }
 
 func (l *Logger) ClearLinks(id uint64) error {
 	toWrite := make([]byte, 9)
 	toWrite[0] = byte(ClearLinks)
 	binary.LittleEndian.PutUint64(toWrite[1:9], id)
-	_, err := l.bufw.Write(toWrite)
-	return err
+	return l.write(toWrite)
 }
 
 func (l *Logger) ClearLinksAtLevel(id uint64, level uint16) error {
@@ -196,23 +251,20 @@ func (l *Logger) ClearLinksAtLevel(id uint64, level uint16) error {
 	toWrite[0] = byte(ClearLinksAtLevel)
 	binary.LittleEndian.PutUint64(toWrite[1:9], id)
 	binary.LittleEndian.PutUint16(toWrite[9:11], level)
-	_, err := l.bufw.Write(toWrite)
-	return err
+	return l.write(toWrite)
 }
 
 func (l *Logger) DeleteNode(id uint64) error {
 	toWrite := make([]byte, 9)
 	toWrite[0] = byte(DeleteNode)
 	binary.LittleEndian.PutUint64(toWrite[1:9], id)
-	_, err := l.bufw.Write(toWrite)
-	return err
+	return l.write(toWrite)
 }
 
 func (l *Logger) Reset() error {
 	toWrite := make([]byte, 1)
 	toWrite[0] = byte(ResetIndex)
-	_, err := l.bufw.Write(toWrite)
-	return err
+	return l.write(toWrite)
 }
 
 func (l *Logger) FileSize() (int64, error) {
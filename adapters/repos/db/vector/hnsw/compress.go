@@ -23,6 +23,26 @@ import (
 	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
 )
 
+// compressor is implemented by the quantizers which can be used to compress
+// the vectors of the index
+type compressor interface {
+	Encode(vec []float32) []byte
+	Decode(code []byte) []float32
+	DistanceBetweenCompressedVectors(x, y []byte) float32
+	DistanceBetweenCompressedAndUncompressedVectors(x []float32, encoded []byte) float32
+}
+
+type compressedDistancer interface {
+	Distance(x []byte) (float32, bool, error)
+}
+
+func (h *hnsw) newCompressedDistancer(queryVector []float32) compressedDistancer {
+	if h.sq != nil {
+		return h.sq.NewDistancer(queryVector)
+	}
+	return h.pq.NewDistancer(queryVector)
+}
+
 func (h *hnsw) initCompressedStore() error {
 	store, err := lsmkv.New(fmt.Sprintf("%s/%s/%s", h.rootPath, h.className, h.shardName), "", h.logger, nil)
 	if err != nil {
@@ -40,6 +60,9 @@ func (h *hnsw) Compress(segments int, centroids int, useBitsEncoding bool, encod
 	if h.nodes[0] == nil {
 		return errors.New("Compress command cannot be executed before inserting some data. Please, insert your data first.")
 	}
+	if h.reducer.needsTraining() {
+		return errors.New("Compress command cannot be executed before the pca dimension reduction has been trained.")
+	}
 	err := h.initCompressedStore()
 	if err != nil {
 		return errors.Wrap(err, "Initializing compressed vector store")
@@ -68,7 +91,13 @@ func (h *hnsw) Compress(segments int, centroids int, useBitsEncoding bool, encod
 		cleanData = append(cleanData, point)
 	}
 	h.compressedVectorsCache.grow(uint64(len(data)))
-	h.pq.Fit(cleanData)
+	if h.pqConfig.Rotation.Enabled {
+		if err := h.pq.FitWithRotation(cleanData, h.pqConfig.Rotation.Iterations); err != nil {
+			return errors.Wrap(err, "Fitting PQ with rotation")
+		}
+	} else {
+		h.pq.Fit(cleanData)
+	}
 
 	h.compressActionLock.Lock()
 	defer h.compressActionLock.Unlock()
@@ -81,7 +110,62 @@ func (h *hnsw) Compress(segments int, centroids int, useBitsEncoding bool, encod
 	if err := h.commitLog.AddPQ(h.pq.ExposeFields()); err != nil {
 		return errors.Wrap(err, "Adding PQ to the commit logger")
 	}
+	if h.pqConfig.DriftThreshold > 0 {
+		h.pqDrift = ssdhelpers.NewDriftDetector(cleanData)
+	}
+
+	h.compressor = h.pq
+	h.compressed.Store(true)
+	h.cache.drop()
+	return nil
+}
+
+// CompressScalar switches the index to 8-bit scalar quantized vectors. The
+// per-dimension ranges are calibrated on the vectors currently in the cache.
+func (h *hnsw) CompressScalar() error {
+	if h.nodes[0] == nil {
+		return errors.New("Compress command cannot be executed before inserting some data. Please, insert your data first.")
+	}
+	if h.reducer.needsTraining() {
+		return errors.New("Compress command cannot be executed before the pca dimension reduction has been trained.")
+	}
+	err := h.initCompressedStore()
+	if err != nil {
+		return errors.Wrap(err, "Initializing compressed vector store")
+	}
+
+	vec, err := h.vectorForID(context.Background(), h.nodes[0].id)
+	if err != nil {
+		return errors.Wrap(err, "Inferring data dimensions")
+	}
+	sq, err := ssdhelpers.NewScalarQuantizer(h.distancerProvider, len(vec))
+	if err != nil {
+		return errors.Wrap(err, "Compressing vectors.")
+	}
+
+	data := h.cache.all()
+	if err := sq.Fit(data); err != nil {
+		return errors.Wrap(err, "Calibrating SQ")
+	}
+	h.compressedVectorsCache.grow(uint64(len(data)))
+
+	h.compressActionLock.Lock()
+	defer h.compressActionLock.Unlock()
+	ssdhelpers.Concurrently(uint64(len(data)),
+		func(index uint64) {
+			if data[index] == nil {
+				return
+			}
+			encoded := sq.Encode(data[index])
+			h.storeCompressedVector(index, encoded)
+			h.compressedVectorsCache.preload(index, encoded)
+		})
+	if err := h.commitLog.AddSQ(sq.ExposeFields()); err != nil {
+		return errors.Wrap(err, "Adding SQ to the commit logger")
+	}
 
+	h.sq = sq
+	h.compressor = sq
 	h.compressed.Store(true)
 	h.cache.drop()
 	return nil
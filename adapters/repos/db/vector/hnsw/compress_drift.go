@@ -0,0 +1,156 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+)
+
+// the drift is only evaluated every n inserts and only once enough vectors
+// have been observed for the mean to be meaningful
+const (
+	pqDriftCheckInterval   = 1000
+	pqDriftMinObservations = 10000
+)
+
+// observePQDrift must be called with the compressActionLock held (read)
+func (h *hnsw) observePQDrift(vec []float32) {
+	if h.pqDrift == nil {
+		return
+	}
+
+	count := h.pqDrift.Observe(vec)
+	if count < pqDriftMinObservations || count%pqDriftCheckInterval != 0 {
+		return
+	}
+
+	drift, _ := h.pqDrift.Drift()
+	if drift < h.pqConfig.DriftThreshold {
+		return
+	}
+
+	if !h.pqRetraining.CompareAndSwap(false, true) {
+		// a retraining is already running
+		return
+	}
+
+	h.logger.WithField("action", "compress_retrain").
+		WithField("drift", drift).
+		WithField("threshold", h.pqConfig.DriftThreshold).
+		Info("data distribution drifted from pq training data, re-training codebooks")
+
+	go func() {
+		defer h.pqRetraining.Store(false)
+		if err := h.retrainPQ(); err != nil {
+			h.logger.WithField("action", "compress_retrain").Error(err)
+			return
+		}
+		h.logger.WithField("action", "compress_retrain").
+			Info("re-training of pq codebooks complete")
+	}()
+}
+
+// retrainPQ fits new codebooks (and rotation if configured) on the current
+// full precision vectors and re-encodes all nodes. Fitting happens without
+// holding the compressActionLock, only the swap of the quantizer and the
+// re-encoding block inserts and searches. Nodes which were inserted while
+// fitting are encoded with the previous codebooks, they are re-encoded during
+// the swap as well.
+func (h *hnsw) retrainPQ() error {
+	data, present := h.pqTrainingVectors(nil)
+	if len(data) == 0 {
+		return errors.New("no vectors available to re-train pq")
+	}
+
+	fields := h.pq.ExposeFields()
+	pq, err := ssdhelpers.NewProductQuantizer(int(fields.M), int(fields.Ks),
+		fields.UseBitsEncoding, h.distancerProvider, int(fields.Dimensions),
+		fields.EncoderType, ssdhelpers.EncoderDistribution(fields.EncoderDistribution))
+	if err != nil {
+		return errors.Wrap(err, "init product quantizer")
+	}
+
+	h.compressActionLock.RLock()
+	rotation := h.pqConfig.Rotation
+	h.compressActionLock.RUnlock()
+
+	if rotation.Enabled {
+		if err := pq.FitWithRotation(data, rotation.Iterations); err != nil {
+			return errors.Wrap(err, "fit pq with rotation")
+		}
+	} else {
+		pq.Fit(data)
+	}
+
+	h.compressActionLock.Lock()
+	defer h.compressActionLock.Unlock()
+
+	seen := make(map[uint64]struct{}, len(present))
+	for _, id := range present {
+		seen[id] = struct{}{}
+	}
+	added, addedIDs := h.pqTrainingVectors(seen)
+	data = append(data, added...)
+	present = append(present, addedIDs...)
+
+	ssdhelpers.Concurrently(uint64(len(data)), func(i uint64) {
+		encoded := pq.Encode(data[i])
+		h.storeCompressedVector(present[i], encoded)
+		h.compressedVectorsCache.preload(present[i], encoded)
+	})
+
+	if err := h.commitLog.AddPQ(pq.ExposeFields()); err != nil {
+		return errors.Wrap(err, "add pq to commit log")
+	}
+
+	h.pq = pq
+	h.compressor = pq
+	h.pqDrift = ssdhelpers.NewDriftDetector(data)
+	return nil
+}
+
+// pqTrainingVectors returns the full precision vectors of all nodes which
+// are not part of skip, prepared the same way as on insert
+func (h *hnsw) pqTrainingVectors(skip map[uint64]struct{}) ([][]float32, []uint64) {
+	h.RLock()
+	ids := make([]uint64, 0, len(h.nodes))
+	for _, node := range h.nodes {
+		if node == nil {
+			continue
+		}
+		if _, ok := skip[node.id]; !ok {
+			ids = append(ids, node.id)
+		}
+	}
+	h.RUnlock()
+
+	data := make([][]float32, 0, len(ids))
+	present := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		vec, err := h.vectorForIDThunk(context.Background(), id)
+		if err != nil || len(vec) == 0 {
+			// most likely deleted in the meantime
+			continue
+		}
+		vec = h.reducer.reduce(vec)
+		if h.distancerProvider.Type() == "cosine-dot" {
+			vec = distancer.Normalize(vec)
+		}
+		data = append(data, vec)
+		present = append(present, id)
+	}
+	return data, present
+}
@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestRetrainPQ(t *testing.T) {
+	rootPath := t.TempDir()
+
+	vectors, _ := testinghelpers.RandomVecs(1001, 0, 8)
+	inserted := len(vectors) - 1
+	var insertDuringFit sync.Once
+	var index *hnsw
+
+	userConfig := ent.UserConfig{
+		MaxConnections:        16,
+		EFConstruction:        32,
+		VectorCacheMaxObjects: 100000,
+	}
+	index, err := New(Config{
+		RootPath:              rootPath,
+		ID:                    "retrain-pq-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			if index.compressed.Load() {
+				// a node is inserted with the previous codebooks while the new ones
+				// are fitted
+				insertDuringFit.Do(func() {
+					require.Nil(t, index.Add(uint64(inserted), vectors[inserted]))
+				})
+			}
+			return vectors[int(id)], nil
+		},
+	}, userConfig)
+	require.Nil(t, err)
+
+	for i := 0; i < inserted; i++ {
+		require.Nil(t, index.Add(uint64(i), vectors[i]))
+	}
+	require.Nil(t, index.Compress(0, 256, false, int(ssdhelpers.UseKMeansEncoder),
+		int(ssdhelpers.LogNormalEncoderDistribution)))
+
+	require.Nil(t, index.retrainPQ())
+
+	for _, id := range []int{0, inserted} {
+		encoded, err := index.encodedVector(uint64(id))
+		require.Nil(t, err)
+		assert.Equal(t, index.pq.Encode(vectors[id]), encoded,
+			"node %d must be encoded with the new codebooks", id)
+	}
+}
+
+func TestUpdateUserConfigUpdatesPQConfig(t *testing.T) {
+	index, err := New(Config{
+		RootPath:              t.TempDir(),
+		ID:                    "pq-config-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return nil, nil
+		},
+	}, ent.UserConfig{VectorCacheMaxObjects: 1000})
+	require.Nil(t, err)
+	// pretend the index is compressed already, so the update doesn't compress
+	index.compressed.Store(true)
+
+	updated := ent.NewDefaultUserConfig()
+	updated.PQ.Enabled = true
+	updated.PQ.DriftThreshold = 0.3
+	require.Nil(t, index.UpdateUserConfig(updated, func() {}))
+	assert.Equal(t, 0.3, index.pqConfig.DriftThreshold)
+}
@@ -0,0 +1,79 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build !race
+// +build !race
+
+package hnsw_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func Test_NoRaceCompressScalar(t *testing.T) {
+	dimensions := 32
+	vectors, queries := testinghelpers.RandomVecs(5000, 100, dimensions)
+	k := 10
+	distancer := distancer.NewL2SquaredProvider()
+
+	uc := ent.UserConfig{}
+	uc.MaxConnections = 32
+	uc.EFConstruction = 64
+	uc.EF = 64
+	uc.VectorCacheMaxObjects = 10e12
+
+	index, err := hnsw.New(
+		hnsw.Config{
+			RootPath:              t.TempDir(),
+			ID:                    "sq",
+			MakeCommitLoggerThunk: hnsw.MakeNoopCommitLogger,
+			DistanceProvider:      distancer,
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				return vectors[int(id)], nil
+			},
+		}, uc,
+	)
+	require.Nil(t, err)
+
+	// insert half of the vectors before and half after compressing
+	half := len(vectors) / 2
+	ssdhelpers.Concurrently(uint64(half), func(id uint64) {
+		index.Add(id, vectors[id])
+	})
+	require.Nil(t, index.CompressScalar())
+	ssdhelpers.Concurrently(uint64(len(vectors)-half), func(i uint64) {
+		id := uint64(half) + i
+		index.Add(id, vectors[id])
+	})
+
+	distanceFn := func(x, y []float32) float32 {
+		dist, _, _ := distancer.SingleDist(x, y)
+		return dist
+	}
+	var relevant uint64
+	for _, q := range queries {
+		truth := testinghelpers.BruteForce(vectors, q, k, distanceFn)
+		results, _, err := index.SearchByVector(q, k, nil)
+		require.Nil(t, err)
+		relevant += testinghelpers.MatchesInLists(truth, results)
+	}
+	recall := float32(relevant) / float32(k*len(queries))
+	assert.Greater(t, recall, float32(0.9))
+}
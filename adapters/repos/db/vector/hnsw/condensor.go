@@ -15,18 +15,33 @@ import (
 	"bufio"
 	"encoding/binary"
 	"fmt"
+	"io"
 	"math"
 	"os"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/walcompression"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 )
 
 type MemoryCondensor struct {
 	newLogFile *os.File
-	newLog     *bufWriter
+	newLog     condensorWriter
 	logger     logrus.FieldLogger
+
+	// codec compresses the condensed log, compressed is set while writing it
+	// in that case
+	codec      walcompression.Codec
+	compressed *walcompression.Writer
+}
+
+// condensorWriter is a *bufWriter, or a *walcompression.Writer if the
+// condensed log is compressed
+type condensorWriter interface {
+	io.Writer
+	Flush() error
 }
 
 func (c *MemoryCondensor) Do(fileName string) error {
@@ -37,7 +52,7 @@ func (c *MemoryCondensor) Do(fileName string) error {
 	defer fd.Close()
 	fdBuf := bufio.NewReaderSize(fd, 256*1024)
 
-	res, _, err := NewDeserializer(c.logger).Do(fdBuf, nil, true)
+	res, _, err := NewDeserializer(c.logger).Do(walcompression.NewReader(fdBuf), nil, true)
 	if err != nil {
 		return errors.Wrap(err, "read commit log to be condensed")
 	}
@@ -50,7 +65,12 @@ func (c *MemoryCondensor) Do(fileName string) error {
 
 	c.newLogFile = newLogFile
 
-	c.newLog = NewWriterSize(c.newLogFile, 1*1024*1024)
+	if c.codec != walcompression.CodecNone {
+		c.compressed = walcompression.NewWriter(c.newLogFile, c.codec, 1*1024*1024)
+		c.newLog = c.compressed
+	} else {
+		c.newLog = NewWriterSize(c.newLogFile, 1*1024*1024)
+	}
 
 	for _, node := range res.Nodes {
 		if node == nil {
@@ -80,6 +100,10 @@ func (c *MemoryCondensor) Do(fileName string) error {
 				}
 			}
 		}
+
+		if err := c.endRecord(); err != nil {
+			return errors.Wrapf(err, "write node %d to commit log", node.id)
+		}
 	}
 
 	if res.EntrypointChanged {
@@ -94,6 +118,22 @@ func (c *MemoryCondensor) Do(fileName string) error {
 			return errors.Wrapf(err,
 				"write tombstone for node %d to commit log", ts)
 		}
+		if err := c.endRecord(); err != nil {
+			return errors.Wrapf(err,
+				"write tombstone for node %d to commit log", ts)
+		}
+	}
+
+	if res.PCA != nil {
+		if err := c.AddPCA(res.PCA); err != nil {
+			return errors.Wrap(err, "write pca to commit log")
+		}
+	}
+
+	if res.SQData != nil {
+		if err := c.AddSQ(*res.SQData); err != nil {
+			return errors.Wrap(err, "write sq to commit log")
+		}
 	}
 
 	if err := c.newLog.Flush(); err != nil {
@@ -111,7 +151,7 @@ func (c *MemoryCondensor) Do(fileName string) error {
 	return nil
 }
 
-func (c *MemoryCondensor) writeUint64(w *bufWriter, in uint64) error {
+func (c *MemoryCondensor) writeUint64(w condensorWriter, in uint64) error {
 	toWrite := make([]byte, 8)
 	binary.LittleEndian.PutUint64(toWrite[0:8], in)
 	_, err := w.Write(toWrite)
@@ -122,7 +162,7 @@ func (c *MemoryCondensor) writeUint64(w *bufWriter, in uint64) error {
 	return nil
 }
 
-func (c *MemoryCondensor) writeUint16(w *bufWriter, in uint16) error {
+func (c *MemoryCondensor) writeUint16(w condensorWriter, in uint16) error {
 	toWrite := make([]byte, 2)
 	binary.LittleEndian.PutUint16(toWrite[0:2], in)
 	_, err := w.Write(toWrite)
@@ -133,7 +173,7 @@ func (c *MemoryCondensor) writeUint16(w *bufWriter, in uint16) error {
 	return nil
 }
 
-func (c *MemoryCondensor) writeCommitType(w *bufWriter, in HnswCommitType) error {
+func (c *MemoryCondensor) writeCommitType(w condensorWriter, in HnswCommitType) error {
 	toWrite := make([]byte, 1)
 	toWrite[0] = byte(in)
 	_, err := w.Write(toWrite)
@@ -144,7 +184,7 @@ func (c *MemoryCondensor) writeCommitType(w *bufWriter, in HnswCommitType) error
 	return nil
 }
 
-func (c *MemoryCondensor) writeUint64Slice(w *bufWriter, in []uint64) error {
+func (c *MemoryCondensor) writeUint64Slice(w condensorWriter, in []uint64) error {
 	for _, v := range in {
 		err := c.writeUint64(w, v)
 		if err != nil {
@@ -228,6 +268,40 @@ func (c *MemoryCondensor) AddTombstone(nodeid uint64) error {
 	return ec.ToError()
 }
 
+func (c *MemoryCondensor) AddPCA(pca *ssdhelpers.PCA) error {
+	ec := &errorcompounder.ErrorCompounder{}
+	ec.Add(c.writeCommitType(c.newLog, AddPCA))
+	_, err := c.newLog.Write(pca.ExposeDataForRestore())
+	ec.Add(err)
+
+	return ec.ToError()
+}
+
+func (c *MemoryCondensor) AddSQ(data ssdhelpers.SQData) error {
+	ec := &errorcompounder.ErrorCompounder{}
+	ec.Add(c.writeCommitType(c.newLog, AddSQ))
+	_, err := c.newLog.Write(data.ExposeDataForRestore())
+	ec.Add(err)
+
+	return ec.ToError()
+}
+
+// endRecord lets a compressed log write a block once it is full, the records
+// of a node are never split across blocks
+func (c *MemoryCondensor) endRecord() error {
+	if c.compressed == nil {
+		return nil
+	}
+	return c.compressed.EndRecord()
+}
+
 func NewMemoryCondensor(logger logrus.FieldLogger) *MemoryCondensor {
 	return &MemoryCondensor{logger: logger}
 }
+
+// NewMemoryCondensorWithCodec writes the condensed logs compressed with codec
+func NewMemoryCondensorWithCodec(logger logrus.FieldLogger,
+	codec walcompression.Codec,
+) *MemoryCondensor {
+	return &MemoryCondensor{logger: logger, codec: codec}
+}
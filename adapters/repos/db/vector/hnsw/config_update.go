@@ -55,6 +55,13 @@ func ValidateUserConfigUpdate(initial, updated schema.VectorIndexConfig) error {
 		}
 	}
 
+	// the cached vectors and the graph are built on the reduced vectors, so
+	// the reduction cannot be changed without re-indexing
+	if initialParsed.DimensionReduction != updatedParsed.DimensionReduction {
+		return errors.Errorf("dimensionReduction is immutable: attempted change from \"%v\" to \"%v\"",
+			initialParsed.DimensionReduction, updatedParsed.DimensionReduction)
+	}
+
 	return nil
 }
 
@@ -90,12 +97,33 @@ func (h *hnsw) UpdateUserConfig(updated schema.VectorIndexConfig, callback func(
 	atomic.StoreInt64(&h.efMax, int64(parsed.DynamicEFMax))
 	atomic.StoreInt64(&h.efFactor, int64(parsed.DynamicEFFactor))
 	atomic.StoreInt64(&h.flatSearchCutoff, int64(parsed.FlatSearchCutoff))
+	h.filterStrategy.Store(parsed.FilterStrategy)
 
 	if h.compressed.Load() {
 		h.compressedVectorsCache.updateMaxSize(int64(parsed.VectorCacheMaxObjects))
 	} else {
 		h.cache.updateMaxSize(int64(parsed.VectorCacheMaxObjects))
 	}
+	if !h.compressed.Load() && parsed.SQ.Enabled {
+		h.logger.WithField("action", "compress").Info("switching to scalar quantized vectors")
+
+		go func() {
+			if err := h.CompressScalar(); err != nil {
+				h.logger.Error(err)
+				callback()
+				return
+			}
+			h.logger.WithField("action", "compress").Info("vector compression complete")
+			callback()
+		}()
+	}
+
+	// the drift and recall settings can be changed while compressed, they are
+	// read by inserts which hold the compressActionLock
+	h.compressActionLock.Lock()
+	h.pqConfig = parsed.PQ
+	h.compressActionLock.Unlock()
+
 	// ToDo: check atomic operation
 	if !h.compressed.Load() && parsed.PQ.Enabled {
 		h.logger.WithField("action", "compress").Info("switching to compressed vectors")
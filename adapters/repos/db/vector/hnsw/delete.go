@@ -290,7 +290,7 @@ func (h *hnsw) reassignNeighbor(neighbor uint64, deleteList helpers.AllowList, b
 	if h.compressed.Load() {
 		vec, err := h.compressedVectorsCache.get(context.Background(), neighbor)
 		if err == nil {
-			neighborVec = h.pq.Decode(vec)
+			neighborVec = h.compressor.Decode(vec)
 		}
 	} else {
 		neighborVec, err = h.cache.get(context.Background(), neighbor)
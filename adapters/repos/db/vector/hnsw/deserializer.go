@@ -12,7 +12,6 @@
 package hnsw
 
 import (
-	"bufio"
 	"encoding/binary"
 	"io"
 	"math"
@@ -36,6 +35,8 @@ type DeserializationResult struct {
 	EntrypointChanged bool
 	PQData            ssdhelpers.PQData
 	Compressed        bool
+	PCA               *ssdhelpers.PCA
+	SQData            *ssdhelpers.SQData
 
 	// If there is no entry for the links at a level to be replaced, we must
 	// assume that all links were appended and prior state must exist
@@ -77,7 +78,7 @@ func (c *Deserializer) resetReusableConnectionsSlice(size int) {
 	}
 }
 
-func (c *Deserializer) Do(fd *bufio.Reader,
+func (c *Deserializer) Do(fd io.Reader,
 	initialState *DeserializationResult, keepLinkReplaceInformation bool,
 ) (*DeserializationResult, int, error) {
 	validLength := 0
@@ -97,7 +98,9 @@ func (c *Deserializer) Do(fd *bufio.Reader,
 				break
 			}
 
-			return nil, validLength, err
+			// a compressed log which ended abruptly fails right at the commit
+			// type, the records read so far are still valid
+			return out, validLength, err
 		}
 
 		var readThisRound int
@@ -143,6 +146,12 @@ func (c *Deserializer) Do(fd *bufio.Reader,
 		case AddPQ:
 			err = c.ReadPQ(fd, out)
 			readThisRound = 9
+		case AddPQRotation:
+			readThisRound, err = c.ReadPQRotation(fd, out)
+		case AddPCA:
+			readThisRound, err = c.ReadPCA(fd, out)
+		case AddSQ:
+			readThisRound, err = c.ReadSQ(fd, out)
 		default:
 			err = errors.Errorf("unrecognized commit type %d", ct)
 		}
@@ -562,6 +571,56 @@ func (c *Deserializer) ReadPQ(r io.Reader, res *DeserializationResult) error {
 	return nil
 }
 
+func (c *Deserializer) ReadPQRotation(r io.Reader, res *DeserializationResult) (int, error) {
+	dims, err := c.readUint16(r)
+	if err != nil {
+		return 0, err
+	}
+
+	matrix := make([]float32, int(dims)*int(dims))
+	for i := range matrix {
+		matrix[i], err = c.readFloat32(r)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	rotation, err := ssdhelpers.RestoreRotation(int(dims), matrix)
+	if err != nil {
+		return 0, err
+	}
+	res.PQData.Rotation = rotation
+
+	return 2 + 4*len(matrix), nil
+}
+
+func (c *Deserializer) ReadPCA(r io.Reader, res *DeserializationResult) (int, error) {
+	inputDims, err := c.readUint16(r)
+	if err != nil {
+		return 0, err
+	}
+	outputDims, err := c.readUint16(r)
+	if err != nil {
+		return 0, err
+	}
+
+	components := make([]float32, int(inputDims)*int(outputDims))
+	for i := range components {
+		components[i], err = c.readFloat32(r)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	pca, err := ssdhelpers.RestorePCA(int(inputDims), int(outputDims), components)
+	if err != nil {
+		return 0, err
+	}
+	res.PCA = pca
+
+	return 4 + 4*len(components), nil
+}
+
 func (c *Deserializer) readUint64(r io.Reader) (uint64, error) {
 	var value uint64
 	c.resetResusableBuffer(8)
@@ -661,3 +720,27 @@ func maybeGrowConnectionsForLevel(connsPtr *[][]uint64, level uint16) {
 		*connsPtr = newConns
 	}
 }
+
+func (c *Deserializer) ReadSQ(r io.Reader, res *DeserializationResult) (int, error) {
+	dims, err := c.readUint16(r)
+	if err != nil {
+		return 0, err
+	}
+
+	data := &ssdhelpers.SQData{
+		Min: make([]float32, dims),
+		Max: make([]float32, dims),
+	}
+	for _, values := range [][]float32{data.Min, data.Max} {
+		for i := range values {
+			values[i], err = c.readFloat32(r)
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+	res.SQData = data
+	res.Compressed = true
+
+	return 2 + 8*int(dims), nil
+}
@@ -0,0 +1,169 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"math/rand"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// vectorReducer reduces vectors to the configured dimensionality. It is
+// shared with the vector cache, so that vectors loaded from disk are reduced
+// in the same way as inserted and query vectors.
+type vectorReducer struct {
+	config   ent.DimensionReduction
+	pca      atomic.Pointer[ssdhelpers.PCA]
+	training atomic.Bool
+	// number of vectors in the index, only tracked until pca is trained
+	count atomic.Int64
+}
+
+func newVectorReducer(config ent.DimensionReduction) *vectorReducer {
+	return &vectorReducer{config: config}
+}
+
+// reduce is a no-op for vectors which have already been reduced. Until a pca
+// projection has been trained, vectors are returned unchanged.
+func (r *vectorReducer) reduce(vector []float32) []float32 {
+	switch r.config.Type {
+	case ent.DimensionReductionTruncate:
+		if len(vector) <= r.config.Dimensions {
+			return vector
+		}
+		// copy, so the original vector can be garbage collected
+		out := make([]float32, r.config.Dimensions)
+		copy(out, vector)
+		return out
+	case ent.DimensionReductionPCA:
+		if pca := r.pca.Load(); pca != nil {
+			return pca.Project(vector)
+		}
+		return vector
+	default:
+		return vector
+	}
+}
+
+func (r *vectorReducer) wrap(vectorForID VectorForID) VectorForID {
+	if !r.config.Enabled() {
+		return vectorForID
+	}
+
+	return func(ctx context.Context, id uint64) ([]float32, error) {
+		vec, err := vectorForID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return r.reduce(vec), nil
+	}
+}
+
+func (r *vectorReducer) needsTraining() bool {
+	return r.config.Type == ent.DimensionReductionPCA && r.pca.Load() == nil
+}
+
+// maybeTrainPCA starts the training of the pca projection in the background
+// once enough vectors have been imported. added is the number of vectors
+// which have been added since the last call.
+func (h *hnsw) maybeTrainPCA(added int64) {
+	if !h.reducer.needsTraining() {
+		return
+	}
+
+	if h.reducer.count.Add(added) < int64(h.reducer.config.TrainingLimit) {
+		return
+	}
+
+	if !h.reducer.training.CompareAndSwap(false, true) {
+		return
+	}
+
+	go func() {
+		defer h.reducer.training.Store(false)
+		if err := h.trainPCA(); err != nil {
+			h.logger.WithField("action", "dimension_reduction").
+				WithError(err).Error("failed to train pca projection")
+			return
+		}
+		h.logger.WithField("action", "dimension_reduction").
+			WithField("dimensions", h.reducer.config.Dimensions).
+			Info("pca dimension reduction trained")
+	}()
+}
+
+// trainPCA fits the projection on a sample of the full vectors. The graph is
+// kept as is, only the vectors are replaced by their projections.
+func (h *hnsw) trainPCA() error {
+	if h.compressed.Load() {
+		return errors.New("pca cannot be trained on a compressed index")
+	}
+
+	h.RLock()
+	ids := make([]uint64, 0, len(h.nodes))
+	for _, node := range h.nodes {
+		if node != nil {
+			ids = append(ids, node.id)
+		}
+	}
+	h.RUnlock()
+
+	limit := h.reducer.config.TrainingLimit
+	sample := make([][]float32, 0, limit)
+	for _, i := range rand.Perm(len(ids)) {
+		if len(sample) == limit {
+			break
+		}
+		vec, err := h.vectorForIDThunk(context.Background(), ids[i])
+		if err != nil || len(vec) == 0 {
+			continue
+		}
+		sample = append(sample, vec)
+	}
+
+	if len(sample) < h.reducer.config.Dimensions {
+		return errors.Errorf("not enough vectors to train pca: %d", len(sample))
+	}
+
+	pca, err := ssdhelpers.FitPCA(sample, h.reducer.config.Dimensions)
+	if err != nil {
+		return err
+	}
+
+	h.compressActionLock.Lock()
+	defer h.compressActionLock.Unlock()
+
+	if err := h.commitLog.AddPCA(pca); err != nil {
+		return errors.Wrap(err, "add pca to commit log")
+	}
+	h.reducer.pca.Store(pca)
+
+	// project the cached vectors in place, so the cache stays warm. Since the
+	// projection is linear, re-normalizing the projection of a normalized
+	// vector is identical to normalizing the projection of the original.
+	for id, vec := range h.cache.all() {
+		if vec == nil {
+			continue
+		}
+		projected := pca.Project(vec)
+		if h.distancerProvider.Type() == "cosine-dot" {
+			projected = distancer.Normalize(projected)
+		}
+		h.cache.preload(uint64(id), projected)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestDimensionReduction(t *testing.T) {
+	ctx := context.Background()
+	vectors, queries := testinghelpers.RandomVecs(300, 10, 16)
+
+	newIndex := func(t *testing.T, reduction ent.DimensionReduction) *hnsw {
+		uc := ent.NewDefaultUserConfig()
+		uc.MaxConnections = 8
+		uc.EFConstruction = 32
+		uc.DimensionReduction = reduction
+
+		index, err := New(Config{
+			RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+			ID:                    "dimension-reduction-test",
+			MakeCommitLoggerThunk: MakeNoopCommitLogger,
+			DistanceProvider:      distancer.NewL2SquaredProvider(),
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				return vectors[int(id)], nil
+			},
+		}, uc)
+		require.Nil(t, err)
+
+		for i, vec := range vectors {
+			require.Nil(t, index.Add(uint64(i), vec))
+		}
+		return index
+	}
+
+	assertReduced := func(t *testing.T, index *hnsw, dims int) {
+		for i := range vectors {
+			vec, err := index.cache.get(ctx, uint64(i))
+			require.Nil(t, err)
+			assert.Len(t, vec, dims)
+		}
+
+		for _, query := range queries {
+			ids, _, err := index.SearchByVector(query, 10, nil)
+			require.Nil(t, err)
+			assert.Len(t, ids, 10)
+		}
+	}
+
+	t.Run("truncate", func(t *testing.T) {
+		index := newIndex(t, ent.DimensionReduction{
+			Type:       ent.DimensionReductionTruncate,
+			Dimensions: 4,
+		})
+		assertReduced(t, index, 4)
+	})
+
+	t.Run("pca", func(t *testing.T) {
+		index := newIndex(t, ent.DimensionReduction{
+			Type:          ent.DimensionReductionPCA,
+			Dimensions:    4,
+			TrainingLimit: 100,
+		})
+
+		require.Eventually(t, func() bool {
+			return !index.reducer.needsTraining()
+		}, 5*time.Second, 10*time.Millisecond)
+		assertReduced(t, index, 4)
+
+		// vectors inserted after training are projected right away
+		require.Nil(t, index.Add(uint64(len(vectors)), vectors[0]))
+		vec, err := index.cache.get(ctx, uint64(len(vectors)))
+		require.Nil(t, err)
+		assert.Len(t, vec, 4)
+	})
+}
@@ -29,6 +29,7 @@ func Test_DynamicEF(t *testing.T) {
 		name       string
 		config     ent.UserConfig
 		limit      int
+		efCap      int
 		expectedEf int
 	}
 
@@ -78,6 +79,39 @@ func Test_DynamicEF(t *testing.T) {
 			limit:      5,
 			expectedEf: 78,
 		},
+		{
+			name: "explicit ef above the cap",
+			config: ent.UserConfig{
+				VectorCacheMaxObjects: 10,
+				EF:                    78,
+			},
+			limit:      5,
+			efCap:      32,
+			expectedEf: 32,
+		},
+		{
+			name: "dynamic ef above the cap",
+			config: ent.UserConfig{
+				VectorCacheMaxObjects: 10,
+				EF:                    -1,
+				DynamicEFMin:          100,
+				DynamicEFMax:          500,
+				DynamicEFFactor:       8,
+			},
+			limit:      23,
+			efCap:      32,
+			expectedEf: 32,
+		},
+		{
+			name: "cap below the limit",
+			config: ent.UserConfig{
+				VectorCacheMaxObjects: 10,
+				EF:                    78,
+			},
+			limit:      50,
+			efCap:      32,
+			expectedEf: 50,
+		},
 	}
 
 	for _, test := range tests {
@@ -92,6 +126,7 @@ func Test_DynamicEF(t *testing.T) {
 				},
 			}, test.config)
 			require.Nil(t, err)
+			index.SetSearchEFCap(test.efCap)
 
 			actualEF := index.searchTimeEF(test.limit)
 			assert.Equal(t, test.expectedEf, actualEF)
@@ -83,9 +83,18 @@ type hnsw struct {
 	efMax    int64
 	efFactor int64
 
+	// efCap limits the ef at search time regardless of the user config, it is
+	// set while the node degrades search quality under memory pressure. 0
+	// means no cap.
+	efCap int64
+
 	// on filtered searches with less than n elements, perform flat search
 	flatSearchCutoff int64
 
+	// filterStrategy is the default strategy of filtered graph searches, it
+	// holds a string, see ent.FilterStrategySweeping and ent.FilterStrategyAcorn
+	filterStrategy atomic.Value
+
 	levelNormalizer float64
 
 	nodes []*vertex
@@ -93,6 +102,14 @@ type hnsw struct {
 	vectorForID      VectorForID
 	multiVectorForID MultiVectorForID
 
+	// vectorForIDThunk bypasses the cache and always returns the full
+	// precision vector, even if the index is compressed or reduced
+	vectorForIDThunk VectorForID
+
+	// reducer applies the configured dimension reduction to all vectors
+	// before they are indexed or searched
+	reducer *vectorReducer
+
 	cache cache[float32]
 
 	commitLog CommitLogger
@@ -107,6 +124,10 @@ type hnsw struct {
 	cleanupInterval       time.Duration
 	tombstoneCleanupCycle *cyclemanager.CycleManager
 
+	// periodically estimates the recall of the compressed index, only set if
+	// recall sampling is configured
+	recallSamplingCycle *cyclemanager.CycleManager
+
 	// // for distributed spike, can be used to call a insertExternal on a different graph
 	// insertHook func(node, targetLevel int, neighborsAtLevel map[int][]uint32)
 
@@ -147,9 +168,14 @@ type hnsw struct {
 
 	compressed             atomic.Bool
 	pq                     *ssdhelpers.ProductQuantizer
+	sq                     *ssdhelpers.ScalarQuantizer
+	compressor             compressor
 	compressedVectorsCache cache[byte]
 	compressedStore        *lsmkv.Store
 	compressActionLock     *sync.RWMutex
+	pqConfig               ent.PQConfig
+	pqDrift                *ssdhelpers.DriftDetector
+	pqRetraining           atomic.Bool
 	className              string
 	shardName              string
 }
@@ -172,8 +198,11 @@ type CommitLogger interface {
 	Shutdown(ctx context.Context) error
 	RootPath() string
 	SwitchCommitLogs(bool) error
+	Condense(ctx context.Context) error
 	MaintenanceInProgress() bool
 	AddPQ(ssdhelpers.PQData) error
+	AddPCA(*ssdhelpers.PCA) error
+	AddSQ(ssdhelpers.SQData) error
 }
 
 type BufferedLinksLogger interface {
@@ -211,8 +240,9 @@ func New(cfg Config, uc ent.UserConfig) (*hnsw, error) {
 		normalizeOnRead = true
 	}
 
-	vectorCache := newShardedLockCache(cfg.VectorForIDThunk, uc.VectorCacheMaxObjects,
-		cfg.Logger, normalizeOnRead, defaultDeletionInterval)
+	reducer := newVectorReducer(uc.DimensionReduction)
+	vectorCache := newShardedLockCache(reducer.wrap(cfg.VectorForIDThunk),
+		uc.VectorCacheMaxObjects, cfg.Logger, normalizeOnRead, defaultDeletionInterval)
 
 	compressedVectorsCache := newCompressedShardedLockCache(uc.VectorCacheMaxObjects, cfg.Logger)
 	resetCtx, resetCtxCancel := context.WithCancel(context.Background())
@@ -229,6 +259,8 @@ func New(cfg Config, uc ent.UserConfig) (*hnsw, error) {
 		nodes:                  make([]*vertex, initialSize),
 		cache:                  vectorCache,
 		vectorForID:            vectorCache.get,
+		vectorForIDThunk:       cfg.VectorForIDThunk,
+		reducer:                reducer,
 		multiVectorForID:       vectorCache.multiGet,
 		compressedVectorsCache: compressedVectorsCache,
 		id:                     cfg.ID,
@@ -254,14 +286,23 @@ func New(cfg Config, uc ent.UserConfig) (*hnsw, error) {
 
 		randFunc:           rand.Float64,
 		compressActionLock: &sync.RWMutex{},
+		pqConfig:           uc.PQ,
 		className:          cfg.ClassName,
 	}
 
+	index.filterStrategy.Store(uc.FilterStrategy)
+
 	index.tombstoneCleanupCycle = cyclemanager.New(
 		cyclemanager.NewFixedIntervalTicker(index.cleanupInterval),
 		index.tombstoneCleanup)
 	index.insertMetrics = newInsertMetrics(index.metrics)
 
+	if interval := uc.PQ.RecallSampling.IntervalSeconds; interval > 0 {
+		index.recallSamplingCycle = cyclemanager.New(
+			cyclemanager.NewFixedIntervalTicker(time.Duration(interval)*time.Second),
+			index.sampleRecall)
+	}
+
 	if err := index.init(cfg); err != nil {
 		return nil, errors.Wrapf(err, "init index %q", index.id)
 	}
@@ -448,7 +489,7 @@ func (h *hnsw) distBetweenNodes(a, b uint64) (float32, bool, error) {
 			return 0, false, fmt.Errorf("got a nil or zero-length vector at docID %d", b)
 		}
 
-		return h.pq.DistanceBetweenCompressedVectors(v1, v2), true, nil
+		return h.compressor.DistanceBetweenCompressedVectors(v1, v2), true, nil
 	}
 	// TODO: introduce single search/transaction context instead of spawning new
 	// ones
@@ -507,7 +548,7 @@ func (h *hnsw) distBetweenNodeAndVec(node uint64, vecB []float32) (float32, bool
 			return 0, false, fmt.Errorf("got a nil or zero-length vector at docID %d", node)
 		}
 
-		return h.pq.DistanceBetweenCompressedAndUncompressedVectors(vecB, v1), true, nil
+		return h.compressor.DistanceBetweenCompressedAndUncompressedVectors(vecB, v1), true, nil
 	}
 	// TODO: introduce single search/transaction context instead of spawning new
 	// ones
@@ -608,6 +649,9 @@ func (h *hnsw) Drop(ctx context.Context) error {
 			return errors.Wrap(err, "hnsw drop")
 		}
 	}
+	if err := h.stopRecallSampling(ctx); err != nil {
+		return errors.Wrap(err, "hnsw drop")
+	}
 
 	if h.compressed.Load() {
 		h.compressedVectorsCache.drop()
@@ -630,6 +674,9 @@ func (h *hnsw) Shutdown(ctx context.Context) error {
 	if err := h.tombstoneCleanupCycle.StopAndWait(ctx); err != nil {
 		return errors.Wrap(err, "hnsw shutdown")
 	}
+	if err := h.stopRecallSampling(ctx); err != nil {
+		return errors.Wrap(err, "hnsw shutdown")
+	}
 
 	if err := h.commitLog.Shutdown(ctx); err != nil {
 		return errors.Wrap(err, "hnsw shutdown")
@@ -32,9 +32,9 @@ func (h *hnsw) ValidateBeforeInsert(vector []float32) error {
 		return err
 	}
 
-	if len(existingNodeVector) != len(vector) {
+	if reduced := h.reducer.reduce(vector); len(existingNodeVector) != len(reduced) {
 		return fmt.Errorf("new node has a vector with length %v. "+
-			"Existing nodes have vectors with length %v", len(vector), len(existingNodeVector))
+			"Existing nodes have vectors with length %v", len(reduced), len(existingNodeVector))
 	}
 
 	return nil
@@ -53,15 +53,22 @@ func (h *hnsw) Add(id uint64, vector []float32) error {
 		id: id,
 	}
 
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
+
+	vector = h.reducer.reduce(vector)
 	if h.distancerProvider.Type() == "cosine-dot" {
 		// cosine-dot requires normalized vectors, as the dot product and cosine
 		// similarity are only identical if the vector is normalized
 		vector = distancer.Normalize(vector)
 	}
 
-	h.compressActionLock.RLock()
-	defer h.compressActionLock.RUnlock()
-	return h.insert(node, vector)
+	if err := h.insert(node, vector); err != nil {
+		return err
+	}
+
+	h.maybeTrainPCA(1)
+	return nil
 }
 
 func (h *hnsw) insertInitialElement(node *vertex, nodeVec []float32) error {
@@ -89,7 +96,7 @@ func (h *hnsw) insertInitialElement(node *vertex, nodeVec []float32) error {
 
 	h.nodes[node.id] = node
 	if h.compressed.Load() {
-		compressed := h.pq.Encode(nodeVec)
+		compressed := h.compressor.Encode(nodeVec)
 		h.storeCompressedVector(node.id, compressed)
 		h.compressedVectorsCache.preload(node.id, compressed)
 	} else {
@@ -164,9 +171,10 @@ func (h *hnsw) insert(node *vertex, nodeVec []float32) error {
 	// // make sure this new vec is immediately present in the cache, so we don't
 	// // have to read it from disk again
 	if h.compressed.Load() {
-		compressed := h.pq.Encode(nodeVec)
+		compressed := h.compressor.Encode(nodeVec)
 		h.storeCompressedVector(node.id, compressed)
 		h.compressedVectorsCache.preload(node.id, compressed)
+		h.observePQDrift(nodeVec)
 	} else {
 		h.cache.preload(node.id, nodeVec)
 	}
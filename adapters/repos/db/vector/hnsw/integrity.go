@@ -0,0 +1,332 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// CheckIntegrity scans the graph for an inconsistent entrypoint, links
+// pointing to nodes which do not exist (on that level) and nodes which can no
+// longer be reached from the entrypoint. Such issues can for example be the
+// result of a crash while the commit log was being written. If repair is set,
+// every issue which can be fixed is fixed and persisted in the commit log.
+//
+// The tombstone cleanup is paused for the duration of the check, as it would
+// otherwise alter the graph while it is being inspected.
+func (h *hnsw) CheckIntegrity(ctx context.Context,
+	repair bool,
+) (ent.IntegrityReport, error) {
+	report := ent.IntegrityReport{EntrypointValid: true}
+
+	if h.tombstoneCleanupCycle.Running() {
+		if err := h.tombstoneCleanupCycle.StopAndWait(ctx); err != nil {
+			return report, errors.Wrap(err, "pause tombstone cleanup")
+		}
+		defer h.tombstoneCleanupCycle.Start()
+	}
+
+	h.resetLock.Lock()
+	defer h.resetLock.Unlock()
+
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
+
+	if err := h.checkEntrypoint(&report, repair); err != nil {
+		return report, err
+	}
+
+	if err := h.checkLinks(ctx, &report, repair); err != nil {
+		return report, err
+	}
+
+	if err := h.checkReachability(ctx, &report, repair); err != nil {
+		return report, err
+	}
+
+	return report, nil
+}
+
+// checkEntrypoint makes sure the entrypoint exists and is on the highest
+// level of the graph
+func (h *hnsw) checkEntrypoint(report *ent.IntegrityReport, repair bool) error {
+	h.RLock()
+	entrypoint := h.entryPointID
+	maxLayer := h.currentMaximumLayer
+	var epNode *vertex
+	if entrypoint < uint64(len(h.nodes)) {
+		epNode = h.nodes[entrypoint]
+	}
+
+	// find the best candidate in case the entrypoint turns out to be invalid,
+	// prefer nodes without a tombstone
+	candidate, candidateLevel := uint64(0), -1
+	candidateTombstoned := true
+	for _, node := range h.nodes {
+		if node == nil {
+			continue
+		}
+		report.Nodes++
+
+		node.Lock()
+		level := node.level
+		node.Unlock()
+
+		tombstoned := h.hasTombstone(node.id)
+		if level > candidateLevel || (level == candidateLevel &&
+			candidateTombstoned && !tombstoned) {
+			candidate, candidateLevel, candidateTombstoned = node.id, level, tombstoned
+		}
+	}
+	h.RUnlock()
+
+	if report.Nodes == 0 {
+		return nil
+	}
+
+	epLevel := -1
+	if epNode != nil {
+		epNode.Lock()
+		epLevel = epNode.level
+		epNode.Unlock()
+	}
+
+	if epNode != nil && epLevel == maxLayer && maxLayer == candidateLevel {
+		return nil
+	}
+
+	report.EntrypointValid = false
+	if !repair {
+		report.Unrepaired = append(report.Unrepaired, fmt.Sprintf(
+			"entrypoint %d (level %d) is not a node on the highest level %d",
+			entrypoint, maxLayer, candidateLevel))
+		return nil
+	}
+
+	h.Lock()
+	h.entryPointID = candidate
+	h.currentMaximumLayer = candidateLevel
+	h.Unlock()
+	if err := h.commitLog.SetEntryPointWithMaxLayer(candidate, candidateLevel); err != nil {
+		return errors.Wrap(err, "repair entrypoint")
+	}
+
+	report.EntrypointRepaired = true
+	return nil
+}
+
+// checkLinks finds links to nodes which are out of range, nil, the node itself
+// or which do not exist on the level of the link
+func (h *hnsw) checkLinks(ctx context.Context,
+	report *ent.IntegrityReport, repair bool,
+) error {
+	h.RLock()
+	nodes := h.nodes
+	h.RUnlock()
+
+	levelOf := func(id uint64) int {
+		if id >= uint64(len(nodes)) || nodes[id] == nil {
+			return -1
+		}
+		nodes[id].Lock()
+		defer nodes[id].Unlock()
+		return nodes[id].level
+	}
+
+	for _, node := range nodes {
+		if node == nil {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		node.Lock()
+		connections := make([][]uint64, len(node.connections))
+		for level, conns := range node.connections {
+			connections[level] = append([]uint64{}, conns...)
+		}
+		node.Unlock()
+
+		for level, conns := range connections {
+			valid := make([]uint64, 0, len(conns))
+			for _, target := range conns {
+				if target != node.id && levelOf(target) >= level {
+					valid = append(valid, target)
+				}
+			}
+
+			broken := len(conns) - len(valid)
+			if broken == 0 {
+				continue
+			}
+
+			report.BrokenLinks += broken
+			if !repair {
+				report.Unrepaired = append(report.Unrepaired, fmt.Sprintf(
+					"node %d has %d broken links at level %d", node.id, broken, level))
+				continue
+			}
+
+			node.setConnectionsAtLevel(level, valid)
+			if err := h.commitLog.ReplaceLinksAtLevel(node.id, level, valid); err != nil {
+				return errors.Wrapf(err, "repair links of node %d at level %d", node.id, level)
+			}
+			report.BrokenLinksRepaired += broken
+		}
+	}
+
+	return nil
+}
+
+// checkReachability runs a breadth-first search from the entrypoint across all
+// levels. Tombstoned nodes are ignored, they will be removed by the next
+// tombstone cleanup anyway.
+func (h *hnsw) checkReachability(ctx context.Context,
+	report *ent.IntegrityReport, repair bool,
+) error {
+	unreachable := h.unreachableNodes()
+	report.Unreachable = len(unreachable)
+	if !repair {
+		for _, id := range unreachable {
+			report.Unrepaired = append(report.Unrepaired,
+				fmt.Sprintf("node %d is not reachable from the entrypoint", id))
+		}
+		return nil
+	}
+
+	for _, id := range unreachable {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := h.reconnectNode(id); err != nil {
+			report.Unrepaired = append(report.Unrepaired,
+				fmt.Sprintf("node %d could not be reconnected: %v", id, err))
+		}
+	}
+
+	// verify the result, a reconnected node is only counted as repaired if it
+	// can actually be reached now
+	remaining := map[uint64]struct{}{}
+	for _, id := range h.unreachableNodes() {
+		remaining[id] = struct{}{}
+	}
+	for _, id := range unreachable {
+		if _, ok := remaining[id]; ok {
+			report.Unrepaired = append(report.Unrepaired,
+				fmt.Sprintf("node %d is still not reachable from the entrypoint", id))
+			continue
+		}
+		report.UnreachableRepaired++
+	}
+
+	return nil
+}
+
+func (h *hnsw) unreachableNodes() []uint64 {
+	h.RLock()
+	nodes := h.nodes
+	entrypoint := h.entryPointID
+	h.RUnlock()
+
+	if entrypoint >= uint64(len(nodes)) || nodes[entrypoint] == nil {
+		// without an entrypoint nothing can be reached, this is reported by the
+		// entrypoint check already
+		return nil
+	}
+
+	visited := make([]bool, len(nodes))
+	visited[entrypoint] = true
+	queue := []uint64{entrypoint}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		node := nodes[id]
+		node.Lock()
+		for _, conns := range node.connections {
+			for _, target := range conns {
+				if target < uint64(len(nodes)) && nodes[target] != nil && !visited[target] {
+					visited[target] = true
+					queue = append(queue, target)
+				}
+			}
+		}
+		node.Unlock()
+	}
+
+	var unreachable []uint64
+	for id, node := range nodes {
+		if node == nil || visited[id] || h.hasTombstone(uint64(id)) {
+			continue
+		}
+		unreachable = append(unreachable, uint64(id))
+	}
+	return unreachable
+}
+
+// reconnectNode drops all links of the node and searches for new neighbors,
+// the neighbors link back to the node, which makes it reachable again
+func (h *hnsw) reconnectNode(id uint64) error {
+	h.RLock()
+	node := h.nodes[id]
+	entrypoint := h.entryPointID
+	maxLayer := h.currentMaximumLayer
+	h.RUnlock()
+
+	var vec []float32
+	var err error
+	if h.compressed.Load() {
+		var compressed []byte
+		compressed, err = h.compressedVectorsCache.get(context.Background(), id)
+		if err == nil {
+			vec = h.compressor.Decode(compressed)
+		}
+	} else {
+		vec, err = h.cache.get(context.Background(), id)
+	}
+	if err != nil {
+		return errors.Wrap(err, "get vector")
+	}
+
+	node.Lock()
+	level := node.level
+	node.Unlock()
+
+	entrypoint, err = h.findBestEntrypointForNode(maxLayer, level, entrypoint, vec)
+	if err != nil {
+		return errors.Wrap(err, "find best entrypoint")
+	}
+	if entrypoint == id {
+		return errors.New("node is its own entrypoint")
+	}
+
+	node.markAsMaintenance()
+	defer node.unmarkAsMaintenance()
+
+	node.Lock()
+	for level := range node.connections {
+		node.connections[level] = node.connections[level][:0]
+	}
+	node.Unlock()
+	if err := h.commitLog.ClearLinks(id); err != nil {
+		return err
+	}
+
+	return h.findAndConnectNeighbors(node, entrypoint, vec, level, maxLayer,
+		helpers.NewAllowList())
+}
@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestCheckIntegrity(t *testing.T) {
+	ctx := context.Background()
+	vectors, _ := testinghelpers.RandomVecs(200, 0, 8)
+
+	newIndex := func(t *testing.T) *hnsw {
+		uc := ent.NewDefaultUserConfig()
+		uc.MaxConnections = 8
+		uc.EFConstruction = 32
+
+		index, err := New(Config{
+			RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+			ID:                    "integrity-test",
+			MakeCommitLoggerThunk: MakeNoopCommitLogger,
+			DistanceProvider:      distancer.NewL2SquaredProvider(),
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				return vectors[int(id)], nil
+			},
+		}, uc)
+		require.Nil(t, err)
+
+		for i, vec := range vectors {
+			require.Nil(t, index.Add(uint64(i), vec))
+		}
+		return index
+	}
+
+	// isolate removes all links to the node, so it can't be reached anymore
+	isolate := func(index *hnsw, id uint64) {
+		for _, node := range index.nodes {
+			if node == nil || node.id == id {
+				continue
+			}
+			for level, conns := range node.connections {
+				filtered := make([]uint64, 0, len(conns))
+				for _, target := range conns {
+					if target != id {
+						filtered = append(filtered, target)
+					}
+				}
+				node.connections[level] = filtered
+			}
+		}
+	}
+
+	// victims returns nodes other than the entrypoint
+	victims := func(index *hnsw, n int) []uint64 {
+		var out []uint64
+		for id := uint64(5); len(out) < n; id++ {
+			if id != index.entryPointID {
+				out = append(out, id)
+			}
+		}
+		return out
+	}
+
+	t.Run("healthy graph", func(t *testing.T) {
+		index := newIndex(t)
+		defer index.Drop(ctx)
+
+		report, err := index.CheckIntegrity(ctx, false)
+		require.Nil(t, err)
+		assert.True(t, report.Healthy())
+		assert.True(t, report.EntrypointValid)
+		assert.Equal(t, len(vectors), report.Nodes)
+	})
+
+	t.Run("corrupted graph without repair", func(t *testing.T) {
+		index := newIndex(t)
+		defer index.Drop(ctx)
+
+		index.nodes[3].connections[0] = append(index.nodes[3].connections[0], 10000, 3)
+		isolate(index, victims(index, 1)[0])
+
+		report, err := index.CheckIntegrity(ctx, false)
+		require.Nil(t, err)
+		assert.False(t, report.Healthy())
+		assert.Equal(t, 2, report.BrokenLinks)
+		assert.Equal(t, 0, report.BrokenLinksRepaired)
+		assert.Equal(t, 1, report.Unreachable)
+		assert.Equal(t, 0, report.UnreachableRepaired)
+	})
+
+	t.Run("corrupted graph with repair", func(t *testing.T) {
+		index := newIndex(t)
+		defer index.Drop(ctx)
+
+		index.nodes[3].connections[0] = append(index.nodes[3].connections[0], 10000, 3)
+		for _, id := range victims(index, 2) {
+			isolate(index, id)
+		}
+		index.currentMaximumLayer = index.currentMaximumLayer + 1
+
+		report, err := index.CheckIntegrity(ctx, true)
+		require.Nil(t, err)
+		assert.True(t, report.Healthy(), report.Unrepaired)
+		assert.False(t, report.EntrypointValid)
+		assert.True(t, report.EntrypointRepaired)
+		assert.Equal(t, 2, report.BrokenLinksRepaired)
+		assert.Equal(t, 2, report.UnreachableRepaired)
+
+		report, err = index.CheckIntegrity(ctx, false)
+		require.Nil(t, err)
+		assert.True(t, report.Healthy(), report.Unrepaired)
+		assert.True(t, report.EntrypointValid)
+	})
+}
@@ -28,6 +28,7 @@ type Metrics struct {
 	deleteTime       prometheus.ObserverVec
 	cleaned          prometheus.Counter
 	size             prometheus.Gauge
+	estimatedRecall  prometheus.Gauge
 	grow             prometheus.Observer
 	startupProgress  prometheus.Gauge
 	startupDurations prometheus.ObserverVec
@@ -85,6 +86,11 @@ func NewMetrics(prom *monitoring.PrometheusMetrics,
 		"shard_name": shardName,
 	})
 
+	estimatedRecall := prom.VectorIndexEstimatedRecall.With(prometheus.Labels{
+		"class_name": className,
+		"shard_name": shardName,
+	})
+
 	grow := prom.VectorIndexMaintenanceDurations.With(prometheus.Labels{
 		"class_name": className,
 		"shard_name": shardName,
@@ -117,6 +123,7 @@ func NewMetrics(prom *monitoring.PrometheusMetrics,
 		delete:           del,
 		deleteTime:       deleteTime,
 		size:             size,
+		estimatedRecall:  estimatedRecall,
 		grow:             grow,
 		startupProgress:  startupProgress,
 		startupDurations: startupDurations,
@@ -188,6 +195,14 @@ func (m *Metrics) SetSize(size int) {
 	m.size.Set(float64(size))
 }
 
+func (m *Metrics) SetEstimatedRecall(recall float64) {
+	if !m.enabled {
+		return
+	}
+
+	m.estimatedRecall.Set(recall)
+}
+
 func (m *Metrics) GrowDuration(start time.Time) {
 	if !m.enabled {
 		return
@@ -0,0 +1,51 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// Optimize removes all tombstoned nodes from the graph and condenses the
+// commit logs, so the index takes up as little space as possible. The regular
+// maintenance cycles are paused while it runs.
+func (h *hnsw) Optimize(ctx context.Context) (err error) {
+	if err := h.PauseMaintenance(ctx); err != nil {
+		return errors.Wrap(err, "pause maintenance")
+	}
+	defer func() {
+		if rerr := h.ResumeMaintenance(ctx); rerr != nil && err == nil {
+			err = errors.Wrap(rerr, "resume maintenance")
+		}
+	}()
+
+	shouldBreak := func() bool { return ctx.Err() != nil }
+	if err := h.CleanUpTombstonedNodes(shouldBreak); err != nil {
+		return errors.Wrap(err, "clean up tombstoned nodes")
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// the active commit log contains the tombstone cleanup, switching it makes
+	// it a candidate for condensing
+	if err := h.commitLog.SwitchCommitLogs(true); err != nil {
+		return errors.Wrap(err, "switch commit logs")
+	}
+	if err := h.commitLog.Condense(ctx); err != nil {
+		return errors.Wrap(err, "condense commit logs")
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"math/rand"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+const (
+	recallSampleK = 10
+	// recallSampleCandidates bounds the number of vectors the exact search
+	// compares the sample queries against
+	recallSampleCandidates = 10000
+)
+
+// sampleRecall is the cycle function of the recall sampler. It is a no-op
+// while the index is not compressed.
+func (h *hnsw) sampleRecall(shouldBreak cyclemanager.ShouldBreakFunc) bool {
+	if !h.compressed.Load() {
+		return false
+	}
+
+	recall, err := h.estimateRecall(h.pqConfig.RecallSampling.Queries,
+		recallSampleCandidates, shouldBreak)
+	if err != nil {
+		h.logger.WithField("action", "pq_recall_sampling").
+			WithError(err).Warn("failed to estimate recall of compressed index")
+		return false
+	}
+
+	h.metrics.SetEstimatedRecall(recall)
+	if recall < h.pqConfig.RecallSampling.WarningThreshold {
+		h.logger.WithField("action", "pq_recall_sampling").
+			WithField("class", h.className).
+			WithField("shard", h.shardName).
+			WithField("recall", recall).
+			WithField("threshold", h.pqConfig.RecallSampling.WarningThreshold).
+			Warn("estimated recall of compressed vector index dropped below threshold")
+	}
+
+	return true
+}
+
+func (h *hnsw) stopRecallSampling(ctx context.Context) error {
+	if h.recallSamplingCycle == nil {
+		return nil
+	}
+	return h.recallSamplingCycle.StopAndWait(ctx)
+}
+
+// estimateRecall picks random indexed vectors as queries and compares the
+// results of the regular (compressed) search with an exact search on the full
+// precision vectors. The exact search is a single pass over the candidates
+// which is shared by all sample queries. Indexes with more than candidates
+// nodes are sampled: the exact and the regular search are both restricted to
+// a random subset of candidates nodes. The query node itself is never part of
+// the results.
+func (h *hnsw) estimateRecall(queries, candidates int,
+	shouldBreak cyclemanager.ShouldBreakFunc,
+) (float64, error) {
+	h.RLock()
+	ids := make([]uint64, 0, len(h.nodes))
+	for _, node := range h.nodes {
+		if node != nil {
+			ids = append(ids, node.id)
+		}
+	}
+	h.RUnlock()
+
+	if len(ids) == 0 {
+		return 0, errors.New("index is empty")
+	}
+
+	rand.Shuffle(len(ids), func(i, j int) { ids[i], ids[j] = ids[j], ids[i] })
+	var allowList helpers.AllowList
+	if len(ids) > candidates {
+		ids = ids[:candidates]
+		allowList = helpers.NewAllowList(ids...)
+	}
+
+	ctx := context.Background()
+	normalize := h.distancerProvider.Type() == "cosine-dot"
+	sampleIDs := make([]uint64, 0, queries)
+	sample := make([][]float32, 0, queries)
+	for _, id := range ids {
+		if len(sample) == queries {
+			break
+		}
+		vec, err := h.vectorForIDThunk(ctx, id)
+		if err != nil || len(vec) == 0 {
+			continue
+		}
+		if normalize {
+			vec = distancer.Normalize(vec)
+		}
+		sampleIDs = append(sampleIDs, id)
+		sample = append(sample, vec)
+	}
+
+	truth := make([]*priorityqueue.Queue, len(sample))
+	for i := range truth {
+		truth[i] = priorityqueue.NewMax(recallSampleK)
+	}
+
+	for _, id := range ids {
+		if shouldBreak() {
+			return 0, errors.New("recall sampling aborted")
+		}
+
+		vec, err := h.vectorForIDThunk(ctx, id)
+		if err != nil || len(vec) == 0 {
+			continue
+		}
+		if normalize {
+			vec = distancer.Normalize(vec)
+		}
+
+		for i, query := range sample {
+			if id == sampleIDs[i] {
+				continue
+			}
+			dist, _, err := h.distancerProvider.SingleDist(query, vec)
+			if err != nil {
+				return 0, errors.Wrap(err, "exact distance")
+			}
+			if truth[i].Len() < recallSampleK {
+				truth[i].Insert(id, dist)
+			} else if dist < truth[i].Top().Dist {
+				truth[i].Pop()
+				truth[i].Insert(id, dist)
+			}
+		}
+	}
+
+	exact := make([][]uint64, len(sample))
+	approx := make([][]uint64, len(sample))
+	for i, query := range sample {
+		for truth[i].Len() > 0 {
+			exact[i] = append(exact[i], truth[i].Pop().ID)
+		}
+
+		// one more result, as the query node is found as well
+		res, _, err := h.SearchByVector(query, recallSampleK+1, allowList)
+		if err != nil {
+			return 0, errors.Wrap(err, "compressed search")
+		}
+		approx[i] = withoutID(res, sampleIDs[i], recallSampleK)
+	}
+
+	return recallAtK(exact, approx), nil
+}
+
+// withoutID returns at most limit of the ids which differ from id
+func withoutID(ids []uint64, id uint64, limit int) []uint64 {
+	out := make([]uint64, 0, limit)
+	for _, other := range ids {
+		if other != id && len(out) < limit {
+			out = append(out, other)
+		}
+	}
+	return out
+}
+
+// recallAtK is the share of exact results which are also contained in the
+// approximate results, averaged over all queries
+func recallAtK(exact, approx [][]uint64) float64 {
+	var found, total int
+	for i := range exact {
+		contained := make(map[uint64]struct{}, len(approx[i]))
+		for _, id := range approx[i] {
+			contained[id] = struct{}{}
+		}
+		for _, id := range exact[i] {
+			if _, ok := contained[id]; ok {
+				found++
+			}
+		}
+		total += len(exact[i])
+	}
+
+	if total == 0 {
+		return 0
+	}
+	return float64(found) / float64(total)
+}
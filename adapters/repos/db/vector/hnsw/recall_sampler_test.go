@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestRecallAtK(t *testing.T) {
+	exact := [][]uint64{{1, 2, 3, 4}, {5, 6}}
+	approx := [][]uint64{{4, 3, 2, 9}, {5, 6}}
+
+	assert.InDelta(t, 5.0/6.0, recallAtK(exact, approx), 0.0001)
+	assert.Equal(t, float64(0), recallAtK(nil, nil))
+}
+
+func TestWithoutID(t *testing.T) {
+	assert.Equal(t, []uint64{1, 3}, withoutID([]uint64{1, 2, 3, 4}, 2, 2))
+	assert.Equal(t, []uint64{1, 2}, withoutID([]uint64{1, 2, 3}, 7, 2))
+	assert.Equal(t, []uint64{}, withoutID(nil, 7, 2))
+}
+
+func TestEstimateRecall(t *testing.T) {
+	vectors, _ := testinghelpers.RandomVecs(500, 0, 16)
+
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxConnections = 16
+	uc.EFConstruction = 64
+	uc.EF = 64
+
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "recall-sampler-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+	}, uc)
+	require.Nil(t, err)
+	defer index.Drop(context.Background())
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	recall, err := index.estimateRecall(10, len(vectors), func() bool { return false })
+	require.Nil(t, err)
+	assert.Greater(t, recall, 0.9)
+
+	t.Run("a subset of candidates is sampled", func(t *testing.T) {
+		var scanned atomic.Int64
+		index.vectorForIDThunk = func(ctx context.Context, id uint64) ([]float32, error) {
+			scanned.Add(1)
+			return vectors[int(id)], nil
+		}
+		defer func() {
+			index.vectorForIDThunk = func(ctx context.Context, id uint64) ([]float32, error) {
+				return vectors[int(id)], nil
+			}
+		}()
+
+		recall, err := index.estimateRecall(10, 100, func() bool { return false })
+		require.Nil(t, err)
+		assert.Greater(t, recall, 0.9)
+		assert.LessOrEqual(t, scanned.Load(), int64(110), "only the candidates are scanned")
+	})
+
+	t.Run("sampling can be aborted", func(t *testing.T) {
+		_, err := index.estimateRecall(10, len(vectors), func() bool { return true })
+		assert.NotNil(t, err)
+	})
+}
@@ -22,8 +22,9 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/visited"
-	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/entities/querytimeout"
 	"github.com/weaviate/weaviate/entities/storobj"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/floatcomp"
 )
 
@@ -33,7 +34,11 @@ func (h *hnsw) searchTimeEF(k int) int {
 	// can be so common that it would cause considerable overhead
 	ef := int(atomic.LoadInt64(&h.ef))
 	if ef < 1 {
-		return h.autoEfFromK(k)
+		ef = h.autoEfFromK(k)
+	}
+
+	if efCap := int(atomic.LoadInt64(&h.efCap)); efCap > 0 && ef > efCap {
+		ef = efCap
 	}
 
 	if ef < k {
@@ -43,6 +48,12 @@ func (h *hnsw) searchTimeEF(k int) int {
 	return ef
 }
 
+// SetSearchEFCap limits the ef used at search time, 0 removes the limit. The
+// ef is never lower than the number of requested results.
+func (h *hnsw) SetSearchEFCap(ef int) {
+	atomic.StoreInt64(&h.efCap, int64(ef))
+}
+
 func (h *hnsw) autoEfFromK(k int) int {
 	factor := int(atomic.LoadInt64(&h.efFactor))
 	min := int(atomic.LoadInt64(&h.efMin))
@@ -62,105 +73,227 @@ func (h *hnsw) autoEfFromK(k int) int {
 }
 
 func (h *hnsw) SearchByVector(vector []float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error) {
+	return h.SearchByVectorWithFilterStrategy(vector, k, allowList, "")
+}
+
+// SearchByVectorWithFilterStrategy is SearchByVector with the strategy of a
+// filtered graph search set for this search only, see
+// ent.FilterStrategySweeping and ent.FilterStrategyAcorn. An empty strategy
+// uses the one of the user config. Small allow lists are still searched flat.
+func (h *hnsw) SearchByVectorWithFilterStrategy(vector []float32, k int,
+	allowList helpers.AllowList, strategy string,
+) ([]uint64, []float32, error) {
+	return h.searchByVector(context.Background(), vector, k, allowList, strategy)
+}
+
+// SearchByVectorWithContext is SearchByVector with the filter strategy of the
+// query, see ent.FilterStrategyFromContext. The search on the base layer
+// stops once the query is canceled or reaches its partial deadline, see
+// querytimeout.Stop.
+func (h *hnsw) SearchByVectorWithContext(ctx context.Context, vector []float32,
+	k int, allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return h.searchByVector(ctx, vector, k, allowList,
+		ent.FilterStrategyFromContext(ctx))
+}
+
+func (h *hnsw) searchByVector(ctx context.Context, vector []float32, k int,
+	allowList helpers.AllowList, strategy string,
+) ([]uint64, []float32, error) {
+	if err := ent.ValidateFilterStrategy(strategy); err != nil {
+		return nil, nil, err
+	}
+
 	h.compressActionLock.RLock()
 	defer h.compressActionLock.RUnlock()
 
+	vector = h.queryVector(vector)
+	if h.searchFlat(allowList) {
+		return h.flatSearch(vector, k, allowList)
+	}
+	return h.knnSearchByVectorWithOptions(vector, k, h.searchTimeEF(k), allowList,
+		layerSearchOptions{
+			ctx:            ctx,
+			filterStrategy: h.resolveFilterStrategy(strategy),
+		})
+}
+
+// queryVector prepares a query vector for a search in the index
+func (h *hnsw) queryVector(vector []float32) []float32 {
+	vector = h.reducer.reduce(vector)
 	if h.distancerProvider.Type() == "cosine-dot" {
 		// cosine-dot requires normalized vectors, as the dot product and cosine
 		// similarity are only identical if the vector is normalized
 		vector = distancer.Normalize(vector)
 	}
+	return vector
+}
 
+// searchFlat returns whether a filtered search should compare the query
+// against every allowed vector instead of traversing the graph
+func (h *hnsw) searchFlat(allowList helpers.AllowList) bool {
 	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
-	if allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff {
-		return h.flatSearch(vector, k, allowList)
+	return allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff
+}
+
+// SearchCost estimates how many vectors a search for k results compares the
+// query vector against. A flat search compares against every allowed vector,
+// a graph search against the neighbors of each of the ef candidates on the
+// base layer. The latter is an upper bound, the caller may cap it by the
+// number of vectors in the index.
+func (h *hnsw) SearchCost(k int, allowList helpers.AllowList) int {
+	if h.searchFlat(allowList) {
+		return allowList.Len()
 	}
-	return h.knnSearchByVector(vector, k, h.searchTimeEF(k), allowList)
+
+	return h.searchTimeEF(k) * h.maximumConnectionsLayerZero
 }
 
-// SearchByVectorDistance wraps SearchByVector, and calls it recursively until
-// the search results contain all vector within the threshold specified by the
-// target distance.
+// SearchByVectorDistance returns all vectors within the target distance of
+// the query vector, ordered by distance. The threshold is applied while the
+// graph is traversed: the search keeps expanding nodes within the target
+// distance beyond ef, so the results are not cut off by a search limit.
 //
 // The maxLimit param will place an upper bound on the number of search results
-// returned. This is used in situations where the results of the method are all
-// eventually turned into objects, for example, a Get query. If the caller just
-// needs ids for sake of something like aggregation, a maxLimit of -1 can be
-// passed in to truly obtain all results from the vector index.
+// returned, only the closest ones within the target distance are kept. This
+// is used in situations where the results of the method are all eventually
+// turned into objects, for example, a Get query. If the caller just needs
+// ids for sake of something like aggregation, a maxLimit of -1 can be passed
+// in to truly obtain all results from the vector index.
 func (h *hnsw) SearchByVectorDistance(vector []float32, targetDistance float32, maxLimit int64,
 	allowList helpers.AllowList,
 ) ([]uint64, []float32, error) {
-	var (
-		searchParams = newSearchByDistParams(maxLimit)
+	return h.SearchByVectorDistanceWithFilterStrategy(vector, targetDistance,
+		maxLimit, allowList, "")
+}
 
-		resultIDs  []uint64
-		resultDist []float32
-	)
+// SearchByVectorDistanceWithFilterStrategy is SearchByVectorDistance with the
+// strategy of a filtered graph search set for this search only, see
+// SearchByVectorWithFilterStrategy
+func (h *hnsw) SearchByVectorDistanceWithFilterStrategy(vector []float32,
+	targetDistance float32, maxLimit int64, allowList helpers.AllowList,
+	strategy string,
+) ([]uint64, []float32, error) {
+	return h.searchByVectorDistance(context.Background(), vector, targetDistance,
+		maxLimit, allowList, strategy)
+}
 
-	recursiveSearch := func() (bool, error) {
-		shouldContinue := false
+// SearchByVectorDistanceWithContext is SearchByVectorDistance with the filter
+// strategy and the deadline of the query, see SearchByVectorWithContext
+func (h *hnsw) SearchByVectorDistanceWithContext(ctx context.Context,
+	vector []float32, targetDistance float32, maxLimit int64,
+	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return h.searchByVectorDistance(ctx, vector, targetDistance, maxLimit,
+		allowList, ent.FilterStrategyFromContext(ctx))
+}
 
-		ids, dist, err := h.SearchByVector(vector, searchParams.totalLimit, allowList)
-		if err != nil {
-			return false, errors.Wrap(err, "vector search")
-		}
+func (h *hnsw) searchByVectorDistance(ctx context.Context, vector []float32,
+	targetDistance float32, maxLimit int64, allowList helpers.AllowList,
+	strategy string,
+) ([]uint64, []float32, error) {
+	if err := ent.ValidateFilterStrategy(strategy); err != nil {
+		return nil, nil, err
+	}
 
-		// ensures the indexers aren't out of range
-		offsetCap := searchParams.offsetCapacity(ids)
-		totalLimitCap := searchParams.totalLimitCapacity(ids)
+	maxResults := int(maxLimit)
+	if maxLimit < 0 || maxLimit > math.MaxInt32 {
+		maxResults = math.MaxInt32
+	}
+	if maxResults == 0 {
+		return nil, nil, nil
+	}
 
-		ids, dist = ids[offsetCap:totalLimitCap], dist[offsetCap:totalLimitCap]
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
 
-		if len(ids) == 0 {
-			return false, nil
+	vector = h.queryVector(vector)
+	if h.searchFlat(allowList) {
+		limit := allowList.Len()
+		if maxResults < limit {
+			limit = maxResults
 		}
-
-		lastFound := dist[len(dist)-1]
-		shouldContinue = lastFound <= targetDistance
-
-		for i := range ids {
-			if aboveThresh := dist[i] <= targetDistance; aboveThresh ||
-				floatcomp.InDelta(float64(dist[i]), float64(targetDistance), 1e-6) {
-				resultIDs = append(resultIDs, ids[i])
-				resultDist = append(resultDist, dist[i])
-			} else {
-				// as soon as we encounter a certainty which
-				// is below threshold, we can stop searching
-				break
-			}
+		ids, dists, err := h.flatSearch(vector, limit, allowList)
+		if err != nil {
+			return nil, nil, err
 		}
-
-		return shouldContinue, nil
+		ids, dists = cutAtDistance(ids, dists, targetDistance)
+		return ids, dists, nil
 	}
 
-	shouldContinue, err := recursiveSearch()
-	if err != nil {
-		return nil, nil, err
+	// the ef only determines how far the search looks beyond the target
+	// distance to find nodes within it
+	k := DefaultSearchByDistInitialLimit
+	if maxResults < k {
+		k = maxResults
 	}
+	return h.knnSearchByVectorWithOptions(vector, k, h.searchTimeEF(k), allowList,
+		layerSearchOptions{
+			ctx:             ctx,
+			filterStrategy:  h.resolveFilterStrategy(strategy),
+			withMaxDistance: true,
+			maxDistance:     targetDistance,
+			maxResults:      maxResults,
+		})
+}
 
-	for shouldContinue {
-		searchParams.iterate()
-		if searchParams.maxLimitReached() {
-			h.logger.
-				WithField("action", "unlimited_vector_search").
-				Warnf("maximum search limit of %d results has been reached",
-					searchParams.maximumSearchLimit)
-			break
-		}
+// layerSearchOptions are the options of a search on the base layer
+type layerSearchOptions struct {
+	// ctx stops the search once the query is canceled or reaches its partial
+	// deadline, keeping the results found so far in the latter case. A nil
+	// ctx doesn't stop the search.
+	ctx context.Context
+
+	// filterStrategy is the strategy applied to the allow list, the sweeping
+	// strategy is used if it is empty
+	filterStrategy string
+
+	// withMaxDistance turns the search into a range search: the results are
+	// not limited to ef, but contain the nodes within maxDistance, of which
+	// only the closest maxResults are kept
+	withMaxDistance bool
+	maxDistance     float32
+	maxResults      int
+}
 
-		shouldContinue, err = recursiveSearch()
-		if err != nil {
-			return nil, nil, err
+// cutAtDistance cuts off results sorted by distance at the first one which is
+// further away than the target distance
+func cutAtDistance(ids []uint64, dists []float32, targetDistance float32) ([]uint64, []float32) {
+	for i, dist := range dists {
+		if dist > targetDistance &&
+			!floatcomp.InDelta(float64(dist), float64(targetDistance), 1e-6) {
+			return ids[:i], dists[:i]
 		}
 	}
-
-	return resultIDs, resultDist, nil
+	return ids, dists
 }
 
 func (h *hnsw) searchLayerByVector(queryVector []float32,
 	entrypoints *priorityqueue.Queue, ef int, level int,
 	allowList helpers.AllowList) (*priorityqueue.Queue, error,
 ) {
+	return h.searchLayerByVectorWithOptions(queryVector, entrypoints, ef, level,
+		allowList, layerSearchOptions{})
+}
+
+func (h *hnsw) searchLayerByVectorWithOptions(queryVector []float32,
+	entrypoints *priorityqueue.Queue, ef int, level int,
+	allowList helpers.AllowList, opts layerSearchOptions) (*priorityqueue.Queue, error,
+) {
+	// the filter only applies to the base layer, which contains all nodes
+	acorn := level == 0 && allowList != nil && opts.filterStrategy == ent.FilterStrategyAcorn
+
+	// in a range search every node within maxDistance is worth expanding, even
+	// if it is further away than the worst of the ef closest results
+	rangeSearch := level == 0 && opts.withMaxDistance
+	maxDistance := opts.maxDistance
+	searchBound := func(worstResultDistance float32) float32 {
+		if rangeSearch && worstResultDistance < maxDistance {
+			return maxDistance
+		}
+		return worstResultDistance
+	}
+
 	h.pools.visitedListsLock.Lock()
 	visited := h.pools.visitedLists.Borrow()
 	h.pools.visitedListsLock.Unlock()
@@ -168,9 +301,9 @@ func (h *hnsw) searchLayerByVector(queryVector []float32,
 	candidates := h.pools.pqCandidates.GetMin(ef)
 	results := h.pools.pqResults.GetMax(ef)
 	var floatDistancer distancer.Distancer
-	var byteDistancer *ssdhelpers.PQDistancer
+	var byteDistancer compressedDistancer
 	if h.compressed.Load() {
-		byteDistancer = h.pq.NewDistancer(queryVector)
+		byteDistancer = h.newCompressedDistancer(queryVector)
 	} else {
 		floatDistancer = h.distancerProvider.New(queryVector)
 	}
@@ -187,8 +320,22 @@ func (h *hnsw) searchLayerByVector(queryVector []float32,
 	if err != nil {
 		return nil, errors.Wrapf(err, "calculate distance of current last result")
 	}
+	worstResultDistance = searchBound(worstResultDistance)
+
+	// the upper layers are searched with an ef of 1, only the base layer is
+	// worth stopping
+	stoppable := level == 0 && opts.ctx != nil
 
 	for candidates.Len() > 0 {
+		if stoppable {
+			if stop, err := querytimeout.Stop(opts.ctx); stop {
+				if err != nil {
+					return nil, err
+				}
+				break
+			}
+		}
+
 		var dist float32
 		var ok bool
 		var err error
@@ -256,7 +403,12 @@ func (h *hnsw) searchLayerByVector(queryVector []float32,
 		copy(*connections, candidateNode.connections[level])
 		candidateNode.Unlock()
 
-		for _, neighborID := range *connections {
+		neighbors := *connections
+		if acorn {
+			neighbors = h.acornNeighbors(neighbors, visited, allowList)
+		}
+
+		for _, neighborID := range neighbors {
 
 			if ok := visited.Visited(neighborID); ok {
 				// skip if we've already visited this neighbor
@@ -307,12 +459,17 @@ func (h *hnsw) searchLayerByVector(queryVector []float32,
 				}
 
 				// +1 because we have added one node size calculating the len
-				if results.Len() > ef {
+				if results.Len() > ef && (!rangeSearch || results.Top().Dist > maxDistance) {
+					results.Pop()
+				} else if rangeSearch && results.Len() > ef && results.Len() > opts.maxResults {
+					// all results are within range, but only the closest maxResults
+					// are needed, so the range shrinks to the worst of them
 					results.Pop()
+					maxDistance = results.Top().Dist
 				}
 
 				if results.Len() > 0 {
-					worstResultDistance = results.Top().Dist
+					worstResultDistance = searchBound(results.Top().Dist)
 				}
 			}
 		}
@@ -380,7 +537,7 @@ func (h *hnsw) currentWorstResultDistanceToFloat(results *priorityqueue.Queue,
 }
 
 func (h *hnsw) currentWorstResultDistanceToByte(results *priorityqueue.Queue,
-	distancer *ssdhelpers.PQDistancer,
+	distancer compressedDistancer,
 ) (float32, error) {
 	if results.Len() > 0 {
 		id := results.Top().ID
@@ -403,7 +560,7 @@ func (h *hnsw) currentWorstResultDistanceToByte(results *priorityqueue.Queue,
 	}
 }
 
-func (h *hnsw) distanceToByteNode(distancer *ssdhelpers.PQDistancer,
+func (h *hnsw) distanceToByteNode(distancer compressedDistancer,
 	nodeID uint64,
 ) (float32, bool, error) {
 	vec, err := h.compressedVectorsCache.get(context.Background(), nodeID)
@@ -462,6 +619,13 @@ func (h *hnsw) handleDeletedNode(docID uint64) {
 
 func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 	ef int, allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return h.knnSearchByVectorWithOptions(searchVec, k, ef, allowList,
+		layerSearchOptions{})
+}
+
+func (h *hnsw) knnSearchByVectorWithOptions(searchVec []float32, k int,
+	ef int, allowList helpers.AllowList, opts layerSearchOptions,
 ) ([]uint64, []float32, error) {
 	if h.isEmpty() {
 		return nil, nil, nil
@@ -525,13 +689,21 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 
 	eps := priorityqueue.NewMin(10)
 	eps.Insert(entryPointID, entryPointDistance)
-	res, err := h.searchLayerByVector(searchVec, eps, ef, 0, allowList)
+	if allowList != nil && opts.filterStrategy == ent.FilterStrategyAcorn {
+		if err := h.insertAcornEntrypoints(searchVec, eps, entryPointID, allowList); err != nil {
+			return nil, nil, errors.Wrap(err, "knn search: acorn entrypoints")
+		}
+	}
+	res, err := h.searchLayerByVectorWithOptions(searchVec, eps, ef, 0,
+		allowList, opts)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "knn search: search layer at level %d", 0)
 	}
 
-	for res.Len() > k {
-		res.Pop()
+	if !opts.withMaxDistance {
+		for res.Len() > k {
+			res.Pop()
+		}
 	}
 
 	ids := make([]uint64, res.Len())
@@ -549,74 +721,16 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 
 	h.pools.pqResults.Put(res)
 
-	return ids, dists, nil
-}
-
-func newSearchByDistParams(maxLimit int64) *searchByDistParams {
-	initialOffset := 0
-	initialLimit := DefaultSearchByDistInitialLimit
-
-	return &searchByDistParams{
-		offset:             initialOffset,
-		limit:              initialLimit,
-		totalLimit:         initialOffset + initialLimit,
-		maximumSearchLimit: maxLimit,
-	}
-}
-
-const (
-	// DefaultSearchByDistInitialLimit :
-	// the initial limit of 100 here is an
-	// arbitrary decision, and can be tuned
-	// as needed
-	DefaultSearchByDistInitialLimit = 100
-
-	// DefaultSearchByDistLimitMultiplier :
-	// the decision to increase the limit in
-	// multiples of 10 here is an arbitrary
-	// decision, and can be tuned as needed
-	DefaultSearchByDistLimitMultiplier = 10
-)
-
-type searchByDistParams struct {
-	offset             int
-	limit              int
-	totalLimit         int
-	maximumSearchLimit int64
-}
-
-func (params *searchByDistParams) offsetCapacity(ids []uint64) int {
-	var offsetCap int
-	if params.offset < len(ids) {
-		offsetCap = params.offset
-	} else {
-		offsetCap = len(ids)
-	}
-
-	return offsetCap
-}
-
-func (params *searchByDistParams) totalLimitCapacity(ids []uint64) int {
-	var totalLimitCap int
-	if params.totalLimit < len(ids) {
-		totalLimitCap = params.totalLimit
-	} else {
-		totalLimitCap = len(ids)
+	if opts.withMaxDistance {
+		ids, dists = cutAtDistance(ids, dists, opts.maxDistance)
+		if len(ids) > opts.maxResults {
+			ids, dists = ids[:opts.maxResults], dists[:opts.maxResults]
+		}
 	}
 
-	return totalLimitCap
-}
-
-func (params *searchByDistParams) iterate() {
-	params.offset = params.totalLimit
-	params.limit *= DefaultSearchByDistLimitMultiplier
-	params.totalLimit = params.offset + params.limit
+	return ids, dists, nil
 }
 
-func (params *searchByDistParams) maxLimitReached() bool {
-	if params.maximumSearchLimit < 0 {
-		return false
-	}
-
-	return int64(params.totalLimit) > params.maximumSearchLimit
-}
+// DefaultSearchByDistInitialLimit is the number of results a search by
+// distance is expected to return, it determines the ef of the search
+const DefaultSearchByDistInitialLimit = 100
@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/visited"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// acornEntrypoints is the number of nodes of the allow list which are added
+// to the entrypoint of an ACORN search on the base layer. The entrypoint
+// found on the upper layers ignores the filter, with a selective filter it
+// may not have any matching nodes within two hops.
+const acornEntrypoints = 8
+
+// resolveFilterStrategy returns the strategy of a single search, falling back
+// to the one of the user config
+func (h *hnsw) resolveFilterStrategy(strategy string) string {
+	if strategy != "" {
+		return strategy
+	}
+	if strategy, ok := h.filterStrategy.Load().(string); ok && strategy != "" {
+		return strategy
+	}
+	return ent.DefaultFilterStrategy
+}
+
+// acornNeighbors returns the neighborhood of a node on the base layer as
+// seen by an ACORN search: the neighbors which match the filter and, for
+// each neighbor which doesn't, its own neighbors which match the filter. The
+// distances of nodes which don't match are never calculated, they are only
+// marked as visited so their neighbors are expanded once. The neighborhood
+// is truncated to maximumConnectionsLayerZero, the remaining neighbors can
+// still be reached through other nodes as they are not marked as visited.
+func (h *hnsw) acornNeighbors(connections []uint64, visited visited.ListSet,
+	allowList helpers.AllowList,
+) []uint64 {
+	out := make([]uint64, 0, h.maximumConnectionsLayerZero)
+	for _, neighborID := range connections {
+		if allowList.Contains(neighborID) {
+			out = append(out, neighborID)
+		}
+	}
+
+	for _, neighborID := range connections {
+		if len(out) >= h.maximumConnectionsLayerZero {
+			break
+		}
+		if allowList.Contains(neighborID) || visited.Visited(neighborID) {
+			continue
+		}
+		visited.Visit(neighborID)
+
+		neighbor := h.nodeByID(neighborID)
+		if neighbor == nil {
+			continue
+		}
+
+		neighbor.Lock()
+		if len(neighbor.connections) > 0 {
+			for _, secondHopID := range neighbor.connections[0] {
+				if len(out) >= h.maximumConnectionsLayerZero {
+					break
+				}
+				if allowList.Contains(secondHopID) {
+					out = append(out, secondHopID)
+				}
+			}
+		}
+		neighbor.Unlock()
+	}
+
+	return out
+}
+
+// insertAcornEntrypoints adds up to acornEntrypoints nodes of the allow list
+// which are part of the graph to the entrypoints of the base layer, besides
+// the existing entrypoint
+func (h *hnsw) insertAcornEntrypoints(searchVec []float32,
+	eps *priorityqueue.Queue, entryPointID uint64, allowList helpers.AllowList,
+) error {
+	it := allowList.Iterator()
+	added := 0
+	for id, ok := it.Next(); ok && added < acornEntrypoints; id, ok = it.Next() {
+		if id == entryPointID || h.nodeByID(id) == nil || h.hasTombstone(id) {
+			continue
+		}
+
+		dist, ok, err := h.distBetweenNodeAndVec(id, searchVec)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		eps.Insert(id, dist)
+		added++
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestSearchWithAcornFilterStrategy(t *testing.T) {
+	vectors, queries := testinghelpers.RandomVecs(2000, 20, 32)
+	k := 10
+
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxConnections = 16
+	uc.EFConstruction = 64
+	uc.VectorCacheMaxObjects = 100000
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "acorn-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+	}, uc)
+	require.Nil(t, err)
+	index.forbidFlat = true
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	// a selective filter which matches 2% of the vectors
+	allowList := helpers.NewAllowList()
+	for i := range vectors {
+		if i%50 == 0 {
+			allowList.Insert(uint64(i))
+		}
+	}
+
+	bruteForce := func(query []float32) []uint64 {
+		ids := allowList.Slice()
+		dist := func(id uint64) float32 {
+			d, _, _ := distancer.NewL2SquaredProvider().SingleDist(query, vectors[id])
+			return d
+		}
+		sort.Slice(ids, func(a, b int) bool { return dist(ids[a]) < dist(ids[b]) })
+		return ids[:k]
+	}
+
+	t.Run("results match the filter and have a high recall", func(t *testing.T) {
+		var matches, total uint64
+		for _, query := range queries {
+			res, _, err := index.SearchByVectorWithFilterStrategy(query, k, allowList,
+				ent.FilterStrategyAcorn)
+			require.Nil(t, err)
+			for _, id := range res {
+				assert.True(t, allowList.Contains(id), "result %d does not match the filter", id)
+			}
+
+			matches += testinghelpers.MatchesInLists(bruteForce(query), res)
+			total += uint64(k)
+		}
+
+		recall := float64(matches) / float64(total)
+		assert.GreaterOrEqual(t, recall, 0.9)
+	})
+
+	t.Run("the strategy of the user config is used by default", func(t *testing.T) {
+		updated := uc
+		updated.FilterStrategy = ent.FilterStrategyAcorn
+		require.Nil(t, index.UpdateUserConfig(updated, func() {}))
+		assert.Equal(t, ent.FilterStrategyAcorn, index.resolveFilterStrategy(""))
+		assert.Equal(t, ent.FilterStrategySweeping,
+			index.resolveFilterStrategy(ent.FilterStrategySweeping))
+
+		res, _, err := index.SearchByVector(queries[0], k, allowList)
+		require.Nil(t, err)
+		assert.Len(t, res, k)
+	})
+
+	t.Run("an unknown strategy is rejected", func(t *testing.T) {
+		_, _, err := index.SearchByVectorWithFilterStrategy(queries[0], k, allowList,
+			"unknown")
+		assert.NotNil(t, err)
+	})
+}
@@ -12,21 +12,84 @@
 package hnsw
 
 import (
+	"context"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
-func TestSearchByDistParams(t *testing.T) {
-	t.Run("param iteration", func(t *testing.T) {
-		params := newSearchByDistParams(100)
-		assert.Equal(t, 0, params.offset)
-		assert.Equal(t, DefaultSearchByDistInitialLimit, params.limit)
-		assert.Equal(t, 100, params.totalLimit)
-
-		params.iterate()
-		assert.Equal(t, 100, params.offset)
-		assert.Equal(t, 1000, params.limit)
-		assert.Equal(t, 1100, params.totalLimit)
+func TestSearchByVectorDistance(t *testing.T) {
+	vectors, queries := testinghelpers.RandomVecs(3000, 5, 16)
+
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxConnections = 16
+	uc.EFConstruction = 64
+	uc.VectorCacheMaxObjects = 100000
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "search-by-dist-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+	}, uc)
+	require.Nil(t, err)
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	// sortedDists returns the distances of all vectors to the query in
+	// ascending order
+	sortedDists := func(query []float32) []float32 {
+		dists := make([]float32, len(vectors))
+		for i, vec := range vectors {
+			dists[i], _, _ = distancer.NewL2SquaredProvider().SingleDist(query, vec)
+		}
+		sort.Slice(dists, func(a, b int) bool { return dists[a] < dists[b] })
+		return dists
+	}
+
+	t.Run("all vectors within the distance are found beyond ef", func(t *testing.T) {
+		for _, query := range queries {
+			// a distance which includes far more vectors than the ef of the search
+			target := sortedDists(query)[1200]
+
+			ids, dists, err := index.SearchByVectorDistance(query, target, -1, nil)
+			require.Nil(t, err)
+
+			assert.GreaterOrEqual(t, len(ids), 1100)
+			assert.LessOrEqual(t, len(ids), 1201)
+			assert.True(t, sort.SliceIsSorted(dists, func(a, b int) bool {
+				return dists[a] < dists[b]
+			}))
+			for _, dist := range dists {
+				assert.LessOrEqual(t, dist, target)
+			}
+		}
+	})
+
+	t.Run("only the closest results are kept up to the max limit", func(t *testing.T) {
+		query := queries[0]
+		all := sortedDists(query)
+		target := all[1200]
+
+		ids, dists, err := index.SearchByVectorDistance(query, target, 10, nil)
+		require.Nil(t, err)
+		require.Len(t, ids, 10)
+		assert.InDelta(t, all[9], dists[9], 0.0001)
+	})
+
+	t.Run("no results if nothing is within the distance", func(t *testing.T) {
+		query := queries[0]
+		ids, _, err := index.SearchByVectorDistance(query, sortedDists(query)[0]/2, -1, nil)
+		require.Nil(t, err)
+		assert.Empty(t, ids)
 	})
 }
@@ -0,0 +1,76 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/querytimeout"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestSearchWithQueryTimeout(t *testing.T) {
+	vectors, queries := testinghelpers.RandomVecs(1000, 1, 32)
+	k := 100
+
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxConnections = 16
+	uc.EFConstruction = 64
+	uc.VectorCacheMaxObjects = 100000
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "timeout-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+	}, uc)
+	require.Nil(t, err)
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	t.Run("within the deadline", func(t *testing.T) {
+		ctx := querytimeout.WithPartialDeadline(context.Background(),
+			time.Now().Add(time.Minute))
+		res, _, err := index.SearchByVectorWithContext(ctx, queries[0], k, nil)
+		require.Nil(t, err)
+		assert.Len(t, res, k)
+	})
+
+	t.Run("partial results after the deadline", func(t *testing.T) {
+		ctx := querytimeout.WithPartialDeadline(context.Background(), time.Now())
+		res, _, err := index.SearchByVectorWithContext(ctx, queries[0], k, nil)
+		require.Nil(t, err)
+		assert.Less(t, len(res), k)
+
+		res, _, err = index.SearchByVectorDistanceWithContext(ctx, queries[0],
+			1000, -1, nil)
+		require.Nil(t, err)
+		assert.Less(t, len(res), len(vectors))
+	})
+
+	t.Run("canceled search fails", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, _, err := index.SearchByVectorWithContext(ctx, queries[0], k, nil)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
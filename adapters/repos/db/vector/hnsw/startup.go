@@ -23,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/visited"
 	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/walcompression"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/diskio"
 )
@@ -86,9 +87,10 @@ func (h *hnsw) restoreFromDisk() error {
 		metered := diskio.NewMeteredReader(fd,
 			h.metrics.TrackStartupReadCommitlogDiskIO)
 		fdBuf := bufio.NewReaderSize(metered, 256*1024)
+		wal := walcompression.NewReader(fdBuf)
 
 		var valid int
-		state, valid, err = NewDeserializer(h.logger).Do(fdBuf, state, false)
+		state, valid, err = NewDeserializer(h.logger).Do(wal, state, false)
 		if err != nil {
 			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
 				// we need to check for both EOF or UnexpectedEOF, as we don't know where
@@ -101,8 +103,14 @@ func (h *hnsw) restoreFromDisk() error {
 					WithField("path", fileName).
 					Error("write-ahead-log ended abruptly, some elements may not have been recovered")
 
-				// we need to truncate the file to its valid length!
-				if err := os.Truncate(fileName, int64(valid)); err != nil {
+				// we need to truncate the file to its valid length! A compressed log
+				// is truncated after its last complete block instead, the records
+				// never span blocks.
+				validSize := int64(valid)
+				if wal.Compressed() {
+					validSize = wal.ValidOffset()
+				}
+				if err := os.Truncate(fileName, validSize); err != nil {
 					return errors.Wrapf(err, "truncate corrupt commit log %q", fileName)
 				}
 			} else {
@@ -121,8 +129,22 @@ func (h *hnsw) restoreFromDisk() error {
 	h.entryPointID = state.Entrypoint
 	h.tombstones = state.Tombstones
 	h.compressed.Store(state.Compressed)
+	if state.PCA != nil {
+		h.reducer.pca.Store(state.PCA)
+	}
 
-	if state.Compressed {
+	if state.Compressed && state.SQData != nil {
+		err := h.initCompressedStore()
+		if err != nil {
+			return err
+		}
+		h.cache.drop()
+		h.sq, err = ssdhelpers.RestoreScalarQuantizer(h.distancerProvider, *state.SQData)
+		if err != nil {
+			return errors.Wrap(err, "Restoring SQ data.")
+		}
+		h.compressor = h.sq
+	} else if state.Compressed {
 		err := h.initCompressedStore()
 		if err != nil {
 			return err
@@ -139,6 +161,8 @@ func (h *hnsw) restoreFromDisk() error {
 		if err != nil {
 			return errors.Wrap(err, "Restoring PQ data.")
 		}
+		h.pq.SetRotation(state.PQData.Rotation)
+		h.compressor = h.pq
 	} else {
 		// make sure the cache fits the current size
 		h.cache.grow(uint64(len(h.nodes)))
@@ -168,7 +192,22 @@ func (h *hnsw) tombstoneCleanup(shouldBreak cyclemanager.ShouldBreakFunc) bool {
 // getVectorForID.
 func (h *hnsw) PostStartup() {
 	h.tombstoneCleanupCycle.Start()
+	if h.recallSamplingCycle != nil {
+		h.recallSamplingCycle.Start()
+	}
 	h.prefillCache()
+
+	if h.reducer.needsTraining() {
+		h.RLock()
+		count := int64(0)
+		for _, node := range h.nodes {
+			if node != nil {
+				count++
+			}
+		}
+		h.RUnlock()
+		h.maybeTrainPCA(count)
+	}
 }
 
 func (h *hnsw) prefillCache() {
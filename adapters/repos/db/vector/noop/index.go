@@ -101,3 +101,11 @@ func (i *Index) PostStartup() {
 
 func (i *Index) Dump(labels ...string) {
 }
+
+func (i *Index) Optimize(context.Context) error {
+	return nil
+}
+
+func (i *Index) CheckIntegrity(context.Context, bool) (hnsw.IntegrityReport, error) {
+	return hnsw.IntegrityReport{}, errors.Errorf("cannot check integrity of a class not vector-indexed")
+}
@@ -0,0 +1,307 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ssdhelpers
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"sync"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Rotation is an orthogonal matrix which is applied to vectors before they
+// are quantized (OPQ). Since it is orthogonal, it preserves l2-distances and
+// dot products, so distances can be computed in the rotated space.
+type Rotation struct {
+	dimensions int
+	matrix     []float32 // row-major, dimensions x dimensions
+}
+
+func NewIdentityRotation(dimensions int) *Rotation {
+	r := &Rotation{
+		dimensions: dimensions,
+		matrix:     make([]float32, dimensions*dimensions),
+	}
+	for i := 0; i < dimensions; i++ {
+		r.matrix[i*dimensions+i] = 1
+	}
+	return r
+}
+
+func (r *Rotation) Dimensions() int {
+	return r.dimensions
+}
+
+// Rotate returns x * R
+func (r *Rotation) Rotate(x []float32) []float32 {
+	out := make([]float32, r.dimensions)
+	for i, xi := range x {
+		if xi == 0 {
+			continue
+		}
+		row := r.matrix[i*r.dimensions : (i+1)*r.dimensions]
+		for j := range out {
+			out[j] += xi * row[j]
+		}
+	}
+	return out
+}
+
+// Unrotate returns x * R^T, which reverses Rotate
+func (r *Rotation) Unrotate(x []float32) []float32 {
+	out := make([]float32, r.dimensions)
+	for i := range out {
+		row := r.matrix[i*r.dimensions : (i+1)*r.dimensions]
+		var sum float32
+		for j, xj := range x {
+			sum += xj * row[j]
+		}
+		out[i] = sum
+	}
+	return out
+}
+
+func (r *Rotation) ExposeDataForRestore() []byte {
+	buffer := make([]byte, 2+4*len(r.matrix))
+	binary.LittleEndian.PutUint16(buffer[0:2], uint16(r.dimensions))
+	for i, v := range r.matrix {
+		binary.LittleEndian.PutUint32(buffer[2+i*4:], math.Float32bits(v))
+	}
+	return buffer
+}
+
+func RestoreRotation(dimensions int, matrix []float32) (*Rotation, error) {
+	if len(matrix) != dimensions*dimensions {
+		return nil, errors.New("rotation matrix does not match dimensions")
+	}
+	return &Rotation{dimensions: dimensions, matrix: matrix}, nil
+}
+
+func (r *Rotation) rotateAll(data [][]float32) [][]float32 {
+	out := make([][]float32, len(data))
+	Concurrently(uint64(len(data)), func(i uint64) {
+		out[i] = r.Rotate(data[i])
+	})
+	return out
+}
+
+// FitWithRotation trains the codebooks together with an orthogonal rotation.
+// The rotation is initialized with the parametric OPQ solution (eigenvalue
+// allocation) and then refined with the non-parametric approach: alternating
+// between fitting the codebooks on the rotated data and solving the
+// orthogonal Procrustes problem between the data and its reconstruction.
+func (pq *ProductQuantizer) FitWithRotation(data [][]float32, iterations int) error {
+	if len(data) == 0 {
+		return errors.New("cannot fit rotation without data")
+	}
+
+	rotation, err := eigenvalueAllocation(data, pq.dimensions, pq.m)
+	if err != nil {
+		return err
+	}
+	for it := 0; it < iterations; it++ {
+		rotated := rotation.rotateAll(data)
+		pq.rotation = nil
+		pq.Fit(rotated)
+
+		reconstructed := make([][]float32, len(rotated))
+		Concurrently(uint64(len(rotated)), func(i uint64) {
+			reconstructed[i] = pq.Decode(pq.Encode(rotated[i]))
+		})
+
+		next, err := procrustes(data, reconstructed, pq.dimensions)
+		if err != nil {
+			return err
+		}
+		rotation = next
+	}
+
+	pq.rotation = nil
+	pq.Fit(rotation.rotateAll(data))
+	pq.rotation = rotation
+	return nil
+}
+
+// eigenvalueAllocation computes the principal components of the data and
+// distributes them across the segments, so that the product of the variances
+// is roughly balanced between all segments.
+func eigenvalueAllocation(data [][]float32, dims, segments int) (*Rotation, error) {
+	mean := make([]float64, dims)
+	for _, v := range data {
+		for i := range mean {
+			mean[i] += float64(v[i])
+		}
+	}
+	for i := range mean {
+		mean[i] /= float64(len(data))
+	}
+
+	cov := mat.NewSymDense(dims, nil)
+	raw := cov.RawSymmetric().Data
+	for _, v := range data {
+		for i := 0; i < dims; i++ {
+			xi := float64(v[i]) - mean[i]
+			for j := i; j < dims; j++ {
+				raw[i*dims+j] += xi * (float64(v[j]) - mean[j])
+			}
+		}
+	}
+
+	var eig mat.EigenSym
+	if ok := eig.Factorize(cov, true); !ok {
+		return nil, errors.New("eigen decomposition for rotation failed")
+	}
+	values := eig.Values(nil)
+	var vectors mat.Dense
+	eig.VectorsTo(&vectors)
+
+	// values are in ascending order, allocate the largest ones first
+	ds := dims / segments
+	filled := make([]int, segments)
+	logProducts := make([]float64, segments)
+	rotation := &Rotation{dimensions: dims, matrix: make([]float32, dims*dims)}
+	for e := dims - 1; e >= 0; e-- {
+		target := -1
+		for s := 0; s < segments; s++ {
+			if filled[s] == ds {
+				continue
+			}
+			if target == -1 || logProducts[s] < logProducts[target] {
+				target = s
+			}
+		}
+
+		pos := target*ds + filled[target]
+		filled[target]++
+		logProducts[target] += math.Log(math.Max(values[e], 1e-12))
+		for i := 0; i < dims; i++ {
+			rotation.matrix[i*dims+pos] = float32(vectors.At(i, e))
+		}
+	}
+
+	return rotation, nil
+}
+
+// procrustes finds the orthogonal matrix R which minimizes ||X*R - Y||. With
+// the SVD X^T*Y = U*S*V^T the solution is R = U*V^T.
+func procrustes(x, y [][]float32, dims int) (*Rotation, error) {
+	raw := make([]float64, dims*dims)
+	for n := range x {
+		for i := 0; i < dims; i++ {
+			xi := float64(x[n][i])
+			if xi == 0 {
+				continue
+			}
+			row := raw[i*dims : (i+1)*dims]
+			for j := range row {
+				row[j] += xi * float64(y[n][j])
+			}
+		}
+	}
+	cov := mat.NewDense(dims, dims, raw)
+
+	var svd mat.SVD
+	if ok := svd.Factorize(cov, mat.SVDFull); !ok {
+		return nil, errors.New("svd factorization for rotation failed")
+	}
+
+	var u, v, r mat.Dense
+	svd.UTo(&u)
+	svd.VTo(&v)
+	r.Mul(&u, v.T())
+
+	rotation := &Rotation{dimensions: dims, matrix: make([]float32, dims*dims)}
+	for i := 0; i < dims; i++ {
+		for j := 0; j < dims; j++ {
+			rotation.matrix[i*dims+j] = float32(r.At(i, j))
+		}
+	}
+	return rotation, nil
+}
+
+// DriftDetector compares the mean of vectors seen after training with the
+// mean of the training data. The drift is expressed relative to the spread
+// (root mean square distance to the mean) of the training data.
+type DriftDetector struct {
+	sync.Mutex
+	trainMean   []float64
+	trainSpread float64
+	sum         []float64
+	count       int
+}
+
+func NewDriftDetector(data [][]float32) *DriftDetector {
+	if len(data) == 0 {
+		return nil
+	}
+
+	dims := len(data[0])
+	mean := make([]float64, dims)
+	for _, v := range data {
+		for i := range mean {
+			mean[i] += float64(v[i])
+		}
+	}
+	for i := range mean {
+		mean[i] /= float64(len(data))
+	}
+
+	var spread float64
+	for _, v := range data {
+		for i := range mean {
+			diff := float64(v[i]) - mean[i]
+			spread += diff * diff
+		}
+	}
+	spread = math.Sqrt(spread / float64(len(data)))
+
+	return &DriftDetector{
+		trainMean:   mean,
+		trainSpread: spread,
+		sum:         make([]float64, dims),
+	}
+}
+
+// Observe adds a vector to the running mean and returns the number of
+// vectors observed so far
+func (d *DriftDetector) Observe(v []float32) int {
+	d.Lock()
+	defer d.Unlock()
+
+	if len(v) != len(d.sum) {
+		return d.count
+	}
+	for i := range d.sum {
+		d.sum[i] += float64(v[i])
+	}
+	d.count++
+	return d.count
+}
+
+// Drift returns the relative drift and the number of vectors it is based on
+func (d *DriftDetector) Drift() (float64, int) {
+	d.Lock()
+	defer d.Unlock()
+
+	if d.count == 0 || d.trainSpread == 0 {
+		return 0, d.count
+	}
+
+	var dist float64
+	for i := range d.sum {
+		diff := d.sum[i]/float64(d.count) - d.trainMean[i]
+		dist += diff * diff
+	}
+	return math.Sqrt(dist) / d.trainSpread, d.count
+}
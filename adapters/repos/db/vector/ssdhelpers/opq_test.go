@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build !race
+// +build !race
+
+package ssdhelpers_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+)
+
+// anisotropicVecs produces vectors where almost all of the variance is
+// concentrated in the first segment, which plain PQ handles poorly
+func anisotropicVecs(size, dims int) [][]float32 {
+	vecs := make([][]float32, size)
+	for i := range vecs {
+		vecs[i] = make([]float32, dims)
+		for j := range vecs[i] {
+			scale := float32(0.01)
+			if j < dims/4 {
+				scale = 10
+			}
+			vecs[i][j] = scale * float32(rand.NormFloat64())
+		}
+	}
+	return vecs
+}
+
+func reconstructionError(pq *ssdhelpers.ProductQuantizer, vecs [][]float32) float64 {
+	var sum float64
+	for _, v := range vecs {
+		rec := pq.Decode(pq.Encode(v))
+		for i := range v {
+			diff := float64(v[i] - rec[i])
+			sum += diff * diff
+		}
+	}
+	return sum / float64(len(vecs))
+}
+
+func Test_NoRaceOPQ(t *testing.T) {
+	rand.Seed(0)
+	dimensions := 16
+	vecs := anisotropicVecs(500, dimensions)
+
+	newPQ := func() *ssdhelpers.ProductQuantizer {
+		pq, err := ssdhelpers.NewProductQuantizer(4, 16, false,
+			distancer.NewL2SquaredProvider(), dimensions,
+			ssdhelpers.UseKMeansEncoder, ssdhelpers.LogNormalEncoderDistribution)
+		require.Nil(t, err)
+		return pq
+	}
+
+	plain := newPQ()
+	plain.Fit(vecs)
+
+	rotated := newPQ()
+	require.Nil(t, rotated.FitWithRotation(vecs, 3))
+
+	t.Run("rotation is orthogonal", func(t *testing.T) {
+		rotation := rotated.ExposeFields().Rotation
+		require.NotNil(t, rotation)
+		for _, v := range vecs[:10] {
+			assert.InDeltaSlice(t, v, rotation.Unrotate(rotation.Rotate(v)), 0.001)
+		}
+	})
+
+	t.Run("rotation improves reconstruction", func(t *testing.T) {
+		assert.Less(t, reconstructionError(rotated, vecs), reconstructionError(plain, vecs))
+	})
+
+	t.Run("restored rotation encodes identically", func(t *testing.T) {
+		fields := rotated.ExposeFields()
+		restored, err := ssdhelpers.NewProductQuantizerWithEncoders(4, 16, false,
+			distancer.NewL2SquaredProvider(), dimensions, ssdhelpers.UseKMeansEncoder,
+			fields.Encoders)
+		require.Nil(t, err)
+
+		raw := fields.Rotation.ExposeDataForRestore()[2:]
+		matrix := make([]float32, len(raw)/4)
+		for i := range matrix {
+			matrix[i] = math.Float32frombits(uint32(raw[i*4]) | uint32(raw[i*4+1])<<8 |
+				uint32(raw[i*4+2])<<16 | uint32(raw[i*4+3])<<24)
+		}
+		rotation, err := ssdhelpers.RestoreRotation(dimensions, matrix)
+		require.Nil(t, err)
+		restored.SetRotation(rotation)
+
+		for _, v := range vecs[:10] {
+			assert.Equal(t, rotated.Encode(v), restored.Encode(v))
+		}
+	})
+}
+
+func TestDriftDetector(t *testing.T) {
+	rand.Seed(0)
+	dimensions := 8
+	training := anisotropicVecs(200, dimensions)
+	detector := ssdhelpers.NewDriftDetector(training)
+
+	t.Run("similar data does not drift", func(t *testing.T) {
+		for _, v := range anisotropicVecs(200, dimensions) {
+			detector.Observe(v)
+		}
+		drift, count := detector.Drift()
+		assert.Equal(t, 200, count)
+		assert.Less(t, drift, 0.1)
+	})
+
+	t.Run("shifted data drifts", func(t *testing.T) {
+		for _, v := range anisotropicVecs(200, dimensions) {
+			for i := range v {
+				v[i] += 30
+			}
+			detector.Observe(v)
+		}
+		drift, _ := detector.Drift()
+		assert.Greater(t, drift, 1.0)
+	})
+}
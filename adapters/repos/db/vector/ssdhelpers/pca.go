@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ssdhelpers
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// PCA projects vectors onto the principal components of the training data.
+// The components are computed from the uncentered second moment matrix, so
+// that dot products are preserved as well as l2-distances.
+type PCA struct {
+	inputDims  int
+	outputDims int
+	components []float32 // row-major, inputDims x outputDims
+}
+
+func FitPCA(data [][]float32, dims int) (*PCA, error) {
+	if len(data) == 0 {
+		return nil, errors.New("cannot fit pca without data")
+	}
+
+	inputDims := len(data[0])
+	if dims < 1 || dims > inputDims {
+		return nil, errors.New("pca dimensions must be between 1 and the input dimensions")
+	}
+
+	moments := mat.NewSymDense(inputDims, nil)
+	raw := moments.RawSymmetric().Data
+	for _, v := range data {
+		if len(v) != inputDims {
+			return nil, errors.New("pca training data has inconsistent dimensions")
+		}
+		for i := 0; i < inputDims; i++ {
+			xi := float64(v[i])
+			if xi == 0 {
+				continue
+			}
+			for j := i; j < inputDims; j++ {
+				raw[i*inputDims+j] += xi * float64(v[j])
+			}
+		}
+	}
+
+	var eig mat.EigenSym
+	if ok := eig.Factorize(moments, true); !ok {
+		return nil, errors.New("eigen decomposition for pca failed")
+	}
+	var vectors mat.Dense
+	eig.VectorsTo(&vectors)
+
+	// eigenvalues are in ascending order, keep the largest ones
+	pca := &PCA{
+		inputDims:  inputDims,
+		outputDims: dims,
+		components: make([]float32, inputDims*dims),
+	}
+	for c := 0; c < dims; c++ {
+		e := inputDims - 1 - c
+		for i := 0; i < inputDims; i++ {
+			pca.components[i*dims+c] = float32(vectors.At(i, e))
+		}
+	}
+
+	return pca, nil
+}
+
+func RestorePCA(inputDims, outputDims int, components []float32) (*PCA, error) {
+	if len(components) != inputDims*outputDims {
+		return nil, errors.New("pca components do not match dimensions")
+	}
+	return &PCA{
+		inputDims:  inputDims,
+		outputDims: outputDims,
+		components: components,
+	}, nil
+}
+
+func (p *PCA) InputDimensions() int {
+	return p.inputDims
+}
+
+func (p *PCA) OutputDimensions() int {
+	return p.outputDims
+}
+
+// Project returns the projection of x onto the components. Vectors which do
+// not match the input dimensions are returned unchanged, so projecting an
+// already projected vector is a no-op.
+func (p *PCA) Project(x []float32) []float32 {
+	if len(x) != p.inputDims {
+		return x
+	}
+
+	out := make([]float32, p.outputDims)
+	for i, xi := range x {
+		if xi == 0 {
+			continue
+		}
+		row := p.components[i*p.outputDims : (i+1)*p.outputDims]
+		for j := range out {
+			out[j] += xi * row[j]
+		}
+	}
+	return out
+}
+
+func (p *PCA) ExposeDataForRestore() []byte {
+	buffer := make([]byte, 4+4*len(p.components))
+	binary.LittleEndian.PutUint16(buffer[0:2], uint16(p.inputDims))
+	binary.LittleEndian.PutUint16(buffer[2:4], uint16(p.outputDims))
+	for i, v := range p.components {
+		binary.LittleEndian.PutUint32(buffer[4+i*4:], math.Float32bits(v))
+	}
+	return buffer
+}
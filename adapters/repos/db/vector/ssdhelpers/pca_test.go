@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build !race
+// +build !race
+
+package ssdhelpers_test
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+)
+
+func TestPCA(t *testing.T) {
+	// almost all of the energy is in the first quarter of the dimensions
+	vecs := anisotropicVecs(1000, 32)
+
+	pca, err := ssdhelpers.FitPCA(vecs, 8)
+	require.Nil(t, err)
+	assert.Equal(t, 32, pca.InputDimensions())
+	assert.Equal(t, 8, pca.OutputDimensions())
+
+	t.Run("projection preserves distances", func(t *testing.T) {
+		dist := distancer.NewL2SquaredProvider()
+		for i := 0; i < 100; i++ {
+			a, b := vecs[i], vecs[i+100]
+			expected, _, _ := dist.SingleDist(a, b)
+			actual, _, _ := dist.SingleDist(pca.Project(a), pca.Project(b))
+			assert.InDelta(t, expected, actual, 0.05*float64(expected)+0.1)
+		}
+	})
+
+	t.Run("projecting twice is a no-op", func(t *testing.T) {
+		projected := pca.Project(vecs[0])
+		assert.Equal(t, projected, pca.Project(projected))
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		data := pca.ExposeDataForRestore()
+		in := int(binary.LittleEndian.Uint16(data[0:2]))
+		out := int(binary.LittleEndian.Uint16(data[2:4]))
+		components := make([]float32, (len(data)-4)/4)
+		for i := range components {
+			components[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[4+i*4:]))
+		}
+
+		restored, err := ssdhelpers.RestorePCA(in, out, components)
+		require.Nil(t, err)
+		assert.Equal(t, pca.Project(vecs[1]), restored.Project(vecs[1]))
+	})
+
+	t.Run("invalid dimensions", func(t *testing.T) {
+		_, err := ssdhelpers.FitPCA(vecs, 33)
+		assert.NotNil(t, err)
+	})
+}
@@ -90,6 +90,7 @@ type ProductQuantizer struct {
 	codingMask          uint64
 	sharpCodes          bool
 	useBitsEncoding     bool
+	rotation            *Rotation
 	ExtractCode         func(encoded []byte, index int) uint64
 	PutCode             func(code uint64, encoded []byte, index int)
 }
@@ -102,6 +103,7 @@ type PQData struct {
 	EncoderDistribution byte
 	Encoders            []PQEncoder
 	UseBitsEncoding     bool
+	Rotation            *Rotation
 }
 
 type PQEncoder interface {
@@ -266,9 +268,16 @@ func (pq *ProductQuantizer) ExposeFields() PQData {
 		EncoderDistribution: byte(pq.encoderDistribution),
 		Encoders:            pq.kms,
 		UseBitsEncoding:     pq.useBitsEncoding,
+		Rotation:            pq.rotation,
 	}
 }
 
+// SetRotation sets a previously learned rotation, e.g. when restoring from
+// disk. A nil rotation disables rotating vectors.
+func (pq *ProductQuantizer) SetRotation(rotation *Rotation) {
+	pq.rotation = rotation
+}
+
 func (pq *ProductQuantizer) DistanceBetweenCompressedVectors(x, y []byte) float32 {
 	dist := float32(0)
 
@@ -282,6 +291,9 @@ func (pq *ProductQuantizer) DistanceBetweenCompressedVectors(x, y []byte) float3
 }
 
 func (pq *ProductQuantizer) DistanceBetweenCompressedAndUncompressedVectors(x []float32, encoded []byte) float32 {
+	if pq.rotation != nil {
+		x = pq.rotation.Rotate(x)
+	}
 	dist := float32(0)
 	for i := 0; i < pq.m; i++ {
 		cY := pq.kms[i].Centroid(pq.ExtractCode(encoded, i))
@@ -346,6 +358,9 @@ func (pq *ProductQuantizer) Fit(data [][]float32) {
 }
 
 func (pq *ProductQuantizer) Encode(vec []float32) []byte {
+	if pq.rotation != nil {
+		vec = pq.rotation.Rotate(vec)
+	}
 	codes := make([]byte, pq.m*pq.bytes)
 	for i := 0; i < pq.m; i++ {
 		pq.PutCode(pq.kms[i].Encode(vec), codes, i)
@@ -358,10 +373,16 @@ func (pq *ProductQuantizer) Decode(code []byte) []float32 {
 	for i := 0; i < pq.m; i++ {
 		vec = append(vec, pq.kms[i].Centroid(pq.ExtractCode(code, i))...)
 	}
+	if pq.rotation != nil {
+		return pq.rotation.Unrotate(vec)
+	}
 	return vec
 }
 
 func (pq *ProductQuantizer) CenterAt(vec []float32) *DistanceLookUpTable {
+	if pq.rotation != nil {
+		vec = pq.rotation.Rotate(vec)
+	}
 	return NewDistanceLookUpTable(int(pq.m), int(pq.ks), vec)
 }
 
@@ -0,0 +1,196 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ssdhelpers
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+const sqLevels = 255
+
+// ScalarQuantizer compresses vectors to one byte per dimension. Every
+// dimension is mapped linearly onto 256 levels between the minimum and
+// maximum value observed for that dimension during calibration. In contrast
+// to product quantization no codebooks need to be trained, so calibration is
+// a single pass over the data.
+type ScalarQuantizer struct {
+	dimensions int
+	distance   distancer.Provider
+	min        []float32
+	max        []float32
+	step       []float32
+}
+
+func NewScalarQuantizer(distance distancer.Provider, dimensions int) (*ScalarQuantizer, error) {
+	if dimensions <= 0 {
+		return nil, errors.New("Dimensions cannot be 0 nor negative")
+	}
+	return &ScalarQuantizer{
+		dimensions: dimensions,
+		distance:   distance,
+	}, nil
+}
+
+func RestoreScalarQuantizer(distance distancer.Provider, data SQData) (*ScalarQuantizer, error) {
+	if len(data.Min) == 0 || len(data.Min) != len(data.Max) {
+		return nil, errors.New("sq calibration does not match dimensions")
+	}
+	sq := &ScalarQuantizer{
+		dimensions: len(data.Min),
+		distance:   distance,
+		min:        data.Min,
+		max:        data.Max,
+	}
+	sq.computeSteps()
+	return sq, nil
+}
+
+func (sq *ScalarQuantizer) Dimensions() int {
+	return sq.dimensions
+}
+
+// Fit calibrates the per-dimension ranges on the given vectors. Vectors which
+// do not match the dimensions of the quantizer are ignored.
+func (sq *ScalarQuantizer) Fit(data [][]float32) error {
+	min := make([]float32, sq.dimensions)
+	max := make([]float32, sq.dimensions)
+	for i := range min {
+		min[i] = math.MaxFloat32
+		max[i] = -math.MaxFloat32
+	}
+
+	fitted := 0
+	for _, v := range data {
+		if len(v) != sq.dimensions {
+			continue
+		}
+		for i, x := range v {
+			if x < min[i] {
+				min[i] = x
+			}
+			if x > max[i] {
+				max[i] = x
+			}
+		}
+		fitted++
+	}
+	if fitted == 0 {
+		return errors.New("cannot fit sq without data")
+	}
+
+	sq.min = min
+	sq.max = max
+	sq.computeSteps()
+	return nil
+}
+
+func (sq *ScalarQuantizer) computeSteps() {
+	sq.step = make([]float32, sq.dimensions)
+	for i := range sq.step {
+		sq.step[i] = (sq.max[i] - sq.min[i]) / sqLevels
+	}
+}
+
+// Encode maps every dimension onto its closest level. Values outside of the
+// calibrated range are clamped.
+func (sq *ScalarQuantizer) Encode(vec []float32) []byte {
+	codes := make([]byte, sq.dimensions)
+	for i := 0; i < sq.dimensions && i < len(vec); i++ {
+		if sq.step[i] == 0 {
+			continue
+		}
+		level := math.Round(float64((vec[i] - sq.min[i]) / sq.step[i]))
+		if level < 0 {
+			level = 0
+		} else if level > sqLevels {
+			level = sqLevels
+		}
+		codes[i] = byte(level)
+	}
+	return codes
+}
+
+func (sq *ScalarQuantizer) Decode(code []byte) []float32 {
+	vec := make([]float32, len(code))
+	sq.decodeInto(code, vec)
+	return vec
+}
+
+func (sq *ScalarQuantizer) decodeInto(code []byte, vec []float32) {
+	for i, c := range code {
+		vec[i] = sq.min[i] + float32(c)*sq.step[i]
+	}
+}
+
+func (sq *ScalarQuantizer) DistanceBetweenCompressedVectors(x, y []byte) float32 {
+	dist, _, _ := sq.distance.SingleDist(sq.Decode(x), sq.Decode(y))
+	return dist
+}
+
+func (sq *ScalarQuantizer) DistanceBetweenCompressedAndUncompressedVectors(x []float32, encoded []byte) float32 {
+	dist, _, _ := sq.distance.SingleDist(x, sq.Decode(encoded))
+	return dist
+}
+
+// SQDistancer calculates the distances between a full precision query and
+// compressed vectors. The decode buffer is reused, so a distancer must not be
+// shared between goroutines.
+type SQDistancer struct {
+	sq     *ScalarQuantizer
+	query  distancer.Distancer
+	buffer []float32
+}
+
+func (sq *ScalarQuantizer) NewDistancer(a []float32) *SQDistancer {
+	return &SQDistancer{
+		sq:     sq,
+		query:  sq.distance.New(a),
+		buffer: make([]float32, sq.dimensions),
+	}
+}
+
+func (d *SQDistancer) Distance(x []byte) (float32, bool, error) {
+	if len(x) != len(d.buffer) {
+		return 0, false, errors.New("compressed vector does not match sq dimensions")
+	}
+	d.sq.decodeInto(x, d.buffer)
+	return d.query.Distance(d.buffer)
+}
+
+// SQData contains the calibration of a scalar quantizer, which is all that is
+// needed to restore it
+type SQData struct {
+	Min []float32
+	Max []float32
+}
+
+func (sq *ScalarQuantizer) ExposeFields() SQData {
+	return SQData{
+		Min: sq.min,
+		Max: sq.max,
+	}
+}
+
+func (d SQData) ExposeDataForRestore() []byte {
+	dims := len(d.Min)
+	buffer := make([]byte, 2+8*dims)
+	binary.LittleEndian.PutUint16(buffer[0:2], uint16(dims))
+	for i := 0; i < dims; i++ {
+		binary.LittleEndian.PutUint32(buffer[2+i*4:], math.Float32bits(d.Min[i]))
+		binary.LittleEndian.PutUint32(buffer[2+(dims+i)*4:], math.Float32bits(d.Max[i]))
+	}
+	return buffer
+}
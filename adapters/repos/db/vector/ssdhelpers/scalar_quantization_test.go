@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ssdhelpers_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+)
+
+func TestScalarQuantizer(t *testing.T) {
+	dimensions := 32
+	vecs, queries := testinghelpers.RandomVecs(1000, 10, dimensions)
+	dist := distancer.NewL2SquaredProvider()
+
+	sq, err := ssdhelpers.NewScalarQuantizer(dist, dimensions)
+	require.Nil(t, err)
+	require.Nil(t, sq.Fit(vecs))
+
+	t.Run("encoding uses one byte per dimension", func(t *testing.T) {
+		assert.Len(t, sq.Encode(vecs[0]), dimensions)
+	})
+
+	t.Run("decoding stays within half a level", func(t *testing.T) {
+		fields := sq.ExposeFields()
+		for _, v := range vecs[:100] {
+			decoded := sq.Decode(sq.Encode(v))
+			for i := range v {
+				step := (fields.Max[i] - fields.Min[i]) / 255
+				assert.InDelta(t, v[i], decoded[i], float64(step)/2+1e-6)
+			}
+		}
+	})
+
+	t.Run("values outside the calibrated range are clamped", func(t *testing.T) {
+		fields := sq.ExposeFields()
+		out := make([]float32, dimensions)
+		for i := range out {
+			out[i] = fields.Max[i] + 1000
+		}
+		decoded := sq.Decode(sq.Encode(out))
+		for i := range decoded {
+			assert.InDelta(t, fields.Max[i], decoded[i], 1e-4)
+		}
+	})
+
+	t.Run("distances approximate the full precision distances", func(t *testing.T) {
+		for _, q := range queries {
+			distancer := sq.NewDistancer(q)
+			for _, v := range vecs[:100] {
+				expected, _, _ := dist.SingleDist(q, v)
+				encoded := sq.Encode(v)
+				actual, ok, err := distancer.Distance(encoded)
+				require.Nil(t, err)
+				require.True(t, ok)
+				assert.InDelta(t, expected, actual, 0.02*float64(expected)+0.01)
+				assert.Equal(t, actual, sq.DistanceBetweenCompressedAndUncompressedVectors(q, encoded))
+			}
+		}
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		restored, err := ssdhelpers.RestoreScalarQuantizer(dist, sq.ExposeFields())
+		require.Nil(t, err)
+		for _, v := range vecs[:10] {
+			assert.Equal(t, sq.Encode(v), restored.Encode(v))
+		}
+	})
+
+	t.Run("fitting without data fails", func(t *testing.T) {
+		empty, err := ssdhelpers.NewScalarQuantizer(dist, dimensions)
+		require.Nil(t, err)
+		assert.NotNil(t, empty.Fit(nil))
+	})
+}
@@ -0,0 +1,194 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/traverser"
+	"golang.org/x/sync/errgroup"
+)
+
+// vectorClassSearchAfter is used by VectorClassSearch if the query continues
+// after a vector cursor or asks for the cursors of the results
+func (db *DB) vectorClassSearchAfter(ctx context.Context, idx *Index,
+	limit int, params dto.GetParams,
+) ([]search.Result, error) {
+	if targetVectorsFromParams(params) != nil {
+		return nil, errors.New("vector cursors are not supported by multi-target searches")
+	}
+	if limit < 0 {
+		// a search by distance is returned in pages of the maximum results,
+		// the cursor of the last result continues with the next page. The
+		// shards search beyond the maximum results then, so that all results
+		// within the distance can be extracted.
+		limit = int(db.config.QueryMaximumResults)
+	}
+	if err := db.checkVectorCursorDepth(params.VectorCursor, limit); err != nil {
+		return nil, err
+	}
+
+	res, dists, cursors, err := idx.objectVectorSearchAfter(ctx, params.SearchVector,
+		targetVectorFromParams(params), extractDistanceFromParams(params), limit, params.Filters,
+		params.AdditionalProperties, params.VectorCursor)
+	if err != nil {
+		return nil, errors.Wrapf(err, "object vector search at index %s", idx.ID())
+	}
+
+	found := storobj.SearchResultsWithDists(res, params.AdditionalProperties, dists)
+	if params.AdditionalProperties.VectorCursor {
+		for j := range found {
+			encoded, err := cursors[j].Encode()
+			if err != nil {
+				return nil, err
+			}
+			found[j].AdditionalProperties["vectorCursor"] = encoded
+		}
+	}
+	traverser.AddGeoDistances(found, params)
+	return db.ResolveReferences(ctx, found, params.Properties, params.AdditionalProperties)
+}
+
+// checkVectorCursorDepth bounds the cost of a page of a vector cursor. The
+// vector index can't resume a search at a position, so a shard searches
+// through the results it returned to the previous pages again. Paging
+// through n results of a shard thus costs O(n²/limit), which is bounded by
+// the maximum depth of a cursor.
+func (db *DB) checkVectorCursorDepth(cursor *filters.VectorCursor, limit int) error {
+	maxDepth := db.config.QueryMaximumVectorCursorDepth
+	if cursor == nil || maxDepth <= 0 {
+		return nil
+	}
+	for shard, pos := range cursor.Shards {
+		if int64(pos.Count+limit) > maxDepth {
+			return errors.Errorf("vector cursor is %d results deep in shard %s, the next "+
+				"page would exceed the maximum depth of %d results, narrow the search "+
+				"by distance or with a filter instead", pos.Count, shard, maxDepth)
+		}
+	}
+	return nil
+}
+
+type cursorResult struct {
+	obj   *storobj.Object
+	dist  float32
+	shard string
+}
+
+// objectVectorSearchAfter is a vector search which starts after the positions
+// of the cursor. Every shard only has to return the results of the page on
+// top of the ones it contributed to the previous pages, which is a fraction
+// of the offset if the class has several shards. Local and remote shards
+// skip the results before their position before the objects are loaded, but
+// the vector index still has to find them, see checkVectorCursorDepth. The
+// result order is stable for equal distances, so that no result is repeated
+// or skipped across pages. The returned cursors are the positions after each
+// of the results.
+func (i *Index) objectVectorSearchAfter(ctx context.Context, searchVector []float32,
+	targetVector string, dist float32, limit int, filter *filters.LocalFilter,
+	additional additional.Properties, cursor *filters.VectorCursor,
+) ([]*storobj.Object, []float32, []*filters.VectorCursor, error) {
+	limit = i.degradedLimit(limit)
+	shardNames := i.getSchema.ShardingState(i.Config.ClassName.String()).
+		AllPhysicalShards()
+
+	errgrp := &errgroup.Group{}
+	m := &sync.Mutex{}
+
+	var found []cursorResult
+	for _, shardName := range shardNames {
+		shardName := shardName
+		errgrp.Go(func() error {
+			pos, hasPos := cursor.Shard(shardName)
+			shardLimit := limit
+			if hasPos && limit > 0 {
+				// the shard has to find the results before the position again,
+				// the vector index can't start a search in the middle
+				shardLimit += pos.Count
+			}
+
+			local := i.getSchema.
+				ShardingState(i.Config.ClassName.String()).
+				IsShardLocal(shardName)
+
+			var after *filters.ShardVectorCursor
+			if hasPos {
+				after = &pos
+			}
+
+			var res []*storobj.Object
+			var resDists []float32
+			var err error
+
+			if local {
+				shard := i.Shards[shardName]
+				res, resDists, err = shard.objectVectorSearchAfter(ctx, searchVector,
+					targetVector, dist, shardLimit, filter, nil, additional, after)
+				if err != nil {
+					return errors.Wrapf(err, "shard %s", shard.ID())
+				}
+			} else {
+				res, resDists, err = i.remote.SearchShard(
+					filters.NewShardVectorCursorContext(ctx, after), shardName, searchVector,
+					targetVector, nil, dist, shardLimit, filter, nil, nil, nil, additional,
+					i.replicationEnabled())
+				if err != nil {
+					return errors.Wrapf(err, "remote shard %s", shardName)
+				}
+			}
+
+			m.Lock()
+			defer m.Unlock()
+			for j, obj := range res {
+				if hasPos && !pos.After(resDists[j], obj.ID()) {
+					continue
+				}
+				found = append(found, cursorResult{obj: obj, dist: resDists[j], shard: shardName})
+			}
+
+			return nil
+		})
+	}
+
+	if err := errgrp.Wait(); err != nil {
+		return nil, nil, nil, err
+	}
+
+	sort.Slice(found, func(a, b int) bool {
+		if found[a].dist != found[b].dist {
+			return found[a].dist < found[b].dist
+		}
+		return found[a].obj.ID() < found[b].obj.ID()
+	})
+	if limit > 0 && len(found) > limit {
+		found = found[:limit]
+	}
+
+	objs := make([]*storobj.Object, len(found))
+	dists := make([]float32, len(found))
+	cursors := make([]*filters.VectorCursor, len(found))
+	for j, res := range found {
+		objs[j], dists[j] = res.obj, res.dist
+		cursor = cursor.Advance(res.shard, res.dist, res.obj.ID())
+		cursors[j] = cursor
+	}
+
+	return objs, dists, cursors, nil
+}
@@ -0,0 +1,202 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestVectorSearch_PaginateWithCursor(t *testing.T) {
+	dirName := t.TempDir()
+
+	class := &models.Class{
+		Class:               "VectorCursorClass",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "name",
+				DataType: []string{"text"},
+			},
+		},
+	}
+	shardState := multiShardState()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState, schema: schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, shardState))
+
+	count := 40
+	for i := 0; i < count; i++ {
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			ID:         strfmt.UUID(fmt.Sprintf("8a9d1b5e-62e4-4e5b-9a9e-%012d", i)),
+			Class:      class.Class,
+			Properties: map[string]interface{}{"name": fmt.Sprintf("object %d", i)},
+		}, []float32{1, float32(i) / 10, 0.5}, nil))
+	}
+
+	searchVector := []float32{1, 0, 0.5}
+	// search returns the ids of the page and the cursor of its last result
+	searchWithin := func(t *testing.T, limit int, nearVector *searchparams.NearVector,
+		cursor *filters.VectorCursor,
+	) ([]strfmt.UUID, *filters.VectorCursor) {
+		res, err := repo.VectorClassSearch(context.Background(), dto.GetParams{
+			ClassName:            class.Class,
+			SearchVector:         searchVector,
+			NearVector:           nearVector,
+			Pagination:           &filters.Pagination{Limit: limit},
+			VectorCursor:         cursor,
+			AdditionalProperties: additional.Properties{VectorCursor: true},
+		})
+		require.Nil(t, err)
+		if len(res) == 0 {
+			return nil, cursor
+		}
+
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID
+		}
+		next, err := filters.ParseVectorCursor(
+			res[len(res)-1].AdditionalProperties["vectorCursor"].(string))
+		require.Nil(t, err)
+		return ids, next
+	}
+	search := func(t *testing.T, limit int,
+		cursor *filters.VectorCursor,
+	) ([]strfmt.UUID, *filters.VectorCursor) {
+		return searchWithin(t, limit, nil, cursor)
+	}
+
+	expected, _ := search(t, count, nil)
+	require.Len(t, expected, count)
+
+	t.Run("pages match the results of a single search", func(t *testing.T) {
+		var cursor *filters.VectorCursor
+		var paged []strfmt.UUID
+		for i := 0; i < 10 && len(paged) < count; i++ {
+			var page []strfmt.UUID
+			page, cursor = search(t, 7, cursor)
+			paged = append(paged, page...)
+		}
+		assert.Equal(t, expected, paged)
+	})
+
+	t.Run("shards only return what's needed for the page", func(t *testing.T) {
+		_, cursor := search(t, 9, nil)
+		total := 0
+		for _, pos := range cursor.Shards {
+			total += pos.Count
+		}
+		assert.Equal(t, 9, total)
+		assert.Greater(t, len(cursor.Shards), 1)
+	})
+	t.Run("remote searches skip the results before the position", func(t *testing.T) {
+		_, cursor := search(t, 9, nil)
+		index := repo.GetIndex(schema.ClassName(class.Class))
+		for name, pos := range cursor.Shards {
+			pos := pos
+			ctx := filters.NewShardVectorCursorContext(context.Background(), &pos)
+			_, dists, err := index.IncomingSearch(ctx, name, searchVector, "", nil,
+				filters.DistanceFlagNotSet, pos.Count+5, nil, nil, nil, nil, additional.Properties{})
+			require.Nil(t, err)
+			require.NotEmpty(t, dists)
+			for _, dist := range dists {
+				assert.GreaterOrEqual(t, dist, pos.Dist)
+			}
+		}
+	})
+
+	t.Run("the depth of a cursor is bounded", func(t *testing.T) {
+		defer func() { repo.config.QueryMaximumVectorCursorDepth = 0 }()
+
+		_, cursor := search(t, 9, nil)
+		deepest := 0
+		for _, pos := range cursor.Shards {
+			if pos.Count > deepest {
+				deepest = pos.Count
+			}
+		}
+		searchPage := func() error {
+			_, err := repo.VectorClassSearch(context.Background(), dto.GetParams{
+				ClassName:    class.Class,
+				SearchVector: searchVector,
+				Pagination:   &filters.Pagination{Limit: 9},
+				VectorCursor: cursor,
+			})
+			return err
+		}
+
+		repo.config.QueryMaximumVectorCursorDepth = int64(deepest + 9)
+		assert.Nil(t, searchPage())
+
+		repo.config.QueryMaximumVectorCursorDepth = int64(deepest + 8)
+		err := searchPage()
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "maximum depth")
+	})
+
+	t.Run("all results within a distance are paged without a limit", func(t *testing.T) {
+		maxResults := repo.config.QueryMaximumResults
+		repo.config.QueryMaximumResults = 6
+		defer func() { repo.config.QueryMaximumResults = maxResults }()
+
+		// the distances of the objects grow with their number, 25 of them are
+		// within the distance
+		nearVector := &searchparams.NearVector{Distance: 0.585, WithDistance: true}
+
+		var cursor *filters.VectorCursor
+		var paged []strfmt.UUID
+		for i := 0; i < 10; i++ {
+			var page []strfmt.UUID
+			page, cursor = searchWithin(t, filters.LimitFlagSearchByDist, nearVector, cursor)
+			assert.LessOrEqual(t, len(page), 6)
+			if len(page) == 0 {
+				break
+			}
+			paged = append(paged, page...)
+		}
+		require.Len(t, paged, 25)
+		assert.Equal(t, expected[:25], paged)
+	})
+}
@@ -0,0 +1,38 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// ValidateVectorDimensions checks that the vector can be added to the vector
+// indexes of the local shards of the class, i.e. that it has the
+// dimensionality of the vectors they already contain. Classes without local
+// shards and empty indexes accept any vector.
+func (d *DB) ValidateVectorDimensions(className string, vector []float32) error {
+	index := d.GetIndex(schema.ClassName(className))
+	if index == nil {
+		return nil
+	}
+
+	for _, shard := range index.Shards {
+		vectorIndex := shard.getVectorIndex()
+		if vectorIndex == nil {
+			continue
+		}
+		if err := vectorIndex.ValidateBeforeInsert(vector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestValidateVectorDimensions(t *testing.T) {
+	dirName := t.TempDir()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	class := &models.Class{
+		Class:               "Embedded",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+	}
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+	require.Nil(t, NewMigrator(repo, logger).AddClass(context.Background(), class, schemaGetter.shardState))
+
+	t.Run("empty index", func(t *testing.T) {
+		assert.Nil(t, repo.ValidateVectorDimensions("Embedded", []float32{1, 2, 3, 4}))
+	})
+
+	obj := &models.Object{Class: "Embedded", ID: strfmt.UUID("8d5a3aa2-3c8d-4589-9ae1-3f638f506970")}
+	require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+
+	t.Run("same dimensions", func(t *testing.T) {
+		assert.Nil(t, repo.ValidateVectorDimensions("Embedded", []float32{3, 2, 1}))
+	})
+
+	t.Run("other dimensions", func(t *testing.T) {
+		assert.ErrorContains(t, repo.ValidateVectorDimensions("Embedded", []float32{1, 2, 3, 4}),
+			"Existing nodes have vectors with length 3")
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		assert.Nil(t, repo.ValidateVectorDimensions("Unknown", []float32{1, 2, 3, 4}))
+	})
+}
@@ -13,9 +13,14 @@ package db
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/schema"
+	dynament "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
 // VectorIndex is anything that indexes vectors efficiently. For an example
@@ -37,4 +42,69 @@ type VectorIndex interface {
 	ResumeMaintenance(ctx context.Context) error
 	PostStartup()
 	ValidateBeforeInsert(vector []float32) error
+	CheckIntegrity(ctx context.Context, repair bool) (hnswent.IntegrityReport, error)
+	Optimize(ctx context.Context) error
+}
+
+// searchCostEstimator is implemented by vector indexes which can estimate the
+// number of vectors a search compares, see package querycost
+type searchCostEstimator interface {
+	SearchCost(k int, allow helpers.AllowList) int
+}
+
+// contextSearcher is implemented by vector indexes which search with the
+// options of the query in its context, such as the filter strategy, see
+// hnswent.FilterStrategyFromContext, and stop at its deadline, see package
+// querytimeout
+type contextSearcher interface {
+	SearchByVectorWithContext(ctx context.Context, vector []float32, k int,
+		allow helpers.AllowList) ([]uint64, []float32, error)
+	SearchByVectorDistanceWithContext(ctx context.Context, vector []float32,
+		dist float32, maxLimit int64, allow helpers.AllowList) ([]uint64, []float32, error)
+}
+
+// searchByVector searches the index with the options of the query in its
+// context, see contextSearcher. Other indexes ignore them. A limit below 0
+// searches by distance up to maxLimit results, a limit together with a
+// distance returns at most limit results within the distance.
+func searchByVector(ctx context.Context, index VectorIndex, vector []float32,
+	dist float32, limit int, maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	byDistance := limit < 0 || dist != filters.DistanceFlagNotSet
+	if limit > 0 {
+		maxLimit = int64(limit)
+	}
+
+	searcher, ok := index.(contextSearcher)
+	if !ok {
+		if byDistance {
+			return index.SearchByVectorDistance(vector, dist, maxLimit, allow)
+		}
+		return index.SearchByVector(vector, limit, allow)
+	}
+
+	if byDistance {
+		return searcher.SearchByVectorDistanceWithContext(ctx, vector, dist,
+			maxLimit, allow)
+	}
+	return searcher.SearchByVectorWithContext(ctx, vector, limit, allow)
+}
+
+// vectorIndexSettings are the settings shared by all vector index types
+type vectorIndexSettings struct {
+	Skip     bool
+	Distance string
+}
+
+func commonVectorIndexSettings(cfg schema.VectorIndexConfig) (vectorIndexSettings, error) {
+	switch typed := cfg.(type) {
+	case hnswent.UserConfig:
+		return vectorIndexSettings{Skip: typed.Skip, Distance: typed.Distance}, nil
+	case flatent.UserConfig:
+		return vectorIndexSettings{Skip: typed.Skip, Distance: typed.Distance}, nil
+	case dynament.UserConfig:
+		return vectorIndexSettings{Distance: typed.Distance}, nil
+	default:
+		return vectorIndexSettings{}, fmt.Errorf("unsupported vector index config: %T", cfg)
+	}
 }
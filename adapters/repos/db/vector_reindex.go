@@ -0,0 +1,566 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/entities/storobj"
+	dynament "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const (
+	VectorReindexPending   = "PENDING"
+	VectorReindexRunning   = "RUNNING"
+	VectorReindexCompleted = "COMPLETED"
+	VectorReindexFailed    = "FAILED"
+)
+
+// VectorReindexStatus is the progress of the rebuild of the vector index of
+// a local shard
+type VectorReindexStatus struct {
+	Shard      string     `json:"shard"`
+	Status     string     `json:"status"`
+	Processed  int64      `json:"processed"`
+	Total      int64      `json:"total"`
+	Error      string     `json:"error,omitempty"`
+	StartedAt  time.Time  `json:"startedAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+}
+
+// ReindexVectorIndex rebuilds the vector indexes of the local shards of the
+// class in the background, see Index.ReindexVectorIndex
+func (d *DB) ReindexVectorIndex(ctx context.Context, className schema.ClassName) error {
+	index := d.GetIndex(className)
+	if index == nil {
+		return errors.Errorf("class %s does not exist", className)
+	}
+	return index.ReindexVectorIndex(ctx)
+}
+
+// VectorReindexStatus reports the progress of the last rebuild of the vector
+// indexes of the local shards of the class
+func (d *DB) VectorReindexStatus(className schema.ClassName) ([]VectorReindexStatus, error) {
+	index := d.GetIndex(className)
+	if index == nil {
+		return nil, errors.Errorf("class %s does not exist", className)
+	}
+	return index.VectorReindexStatus(), nil
+}
+
+type vectorReindexJob struct {
+	sync.Mutex
+	statuses map[string]*VectorReindexStatus
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+func (j *vectorReindexJob) update(shard string, fn func(status *VectorReindexStatus)) {
+	j.Lock()
+	defer j.Unlock()
+	fn(j.statuses[shard])
+}
+
+// ReindexVectorIndex rebuilds the vector indexes of the local shards one
+// after the other from the stored objects with the current vector index
+// config of the class, which drops the tombstones of deleted objects. Every
+// shard keeps serving searches and writes from its current index until the
+// new one is complete and replaces it. It returns once the rebuild has started, its
+// progress is reported by VectorReindexStatus.
+func (i *Index) ReindexVectorIndex(ctx context.Context) error {
+	cfg, err := i.reindexConfig()
+	if err != nil {
+		return err
+	}
+	if err := i.beginReindex(); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(i.Shards))
+	statuses := make(map[string]*VectorReindexStatus, len(i.Shards))
+	for name, shard := range i.Shards {
+		names = append(names, name)
+		statuses[name] = &VectorReindexStatus{
+			Shard:  name,
+			Status: VectorReindexPending,
+			Total:  int64(shard.objectCount()),
+		}
+	}
+	sort.Strings(names)
+
+	// the rebuild outlives the request, it is stopped on shutdown
+	reindexCtx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	i.vectorReindex.Lock()
+	i.vectorReindex.statuses = statuses
+	i.vectorReindex.cancel = cancel
+	i.vectorReindex.done = done
+	i.vectorReindex.Unlock()
+
+	go func() {
+		defer close(done)
+		defer i.endReindex()
+		defer cancel()
+
+		for _, name := range names {
+			i.reindexShard(reindexCtx, name, i.Shards[name], cfg)
+		}
+	}()
+
+	return nil
+}
+
+func (i *Index) reindexShard(ctx context.Context, name string, shard *Shard,
+	cfg hnswent.UserConfig,
+) {
+	i.vectorReindex.update(name, func(status *VectorReindexStatus) {
+		status.Status = VectorReindexRunning
+		status.StartedAt = time.Now()
+	})
+
+	err := shard.reindexVectorIndex(ctx, cfg, func(processed int64) {
+		i.vectorReindex.update(name, func(status *VectorReindexStatus) {
+			status.Processed = processed
+		})
+	})
+
+	i.vectorReindex.update(name, func(status *VectorReindexStatus) {
+		finishedAt := time.Now()
+		status.FinishedAt = &finishedAt
+		status.Status = VectorReindexCompleted
+		if err != nil {
+			status.Status = VectorReindexFailed
+			status.Error = err.Error()
+		}
+	})
+
+	logger := i.logger.WithField("action", "reindex_vector_index").
+		WithField("class", i.Config.ClassName).
+		WithField("shard", name)
+	if err != nil {
+		logger.WithError(err).Error("could not rebuild vector index")
+		return
+	}
+	logger.Info("rebuilt vector index")
+}
+
+// currentVectorIndexConfig is the vector index config of the class in the
+// schema, which may have changed since the index was loaded
+func (i *Index) currentVectorIndexConfig() schema.VectorIndexConfig {
+	sch := i.getSchema.GetSchemaSkipAuth()
+	if class := sch.GetClass(i.Config.ClassName); class != nil {
+		if parsed, ok := class.VectorIndexConfig.(schema.VectorIndexConfig); ok {
+			return parsed
+		}
+	}
+	return i.vectorIndexUserConfig
+}
+
+// reindexConfig is the hnsw config to rebuild the vector indexes with. A
+// dynamic index is rebuilt with its hnsw config, which upgrades shards
+// still using the flat index right away.
+func (i *Index) reindexConfig() (hnswent.UserConfig, error) {
+	cfg := i.currentVectorIndexConfig()
+	if dynamicCfg, ok := cfg.(dynament.UserConfig); ok {
+		return dynamicCfg.HNSW, nil
+	}
+
+	hnswCfg, ok := cfg.(hnswent.UserConfig)
+	if !ok {
+		return hnswent.UserConfig{}, errors.Errorf(
+			"only hnsw indexes can be rebuilt, class %s has a %s index",
+			i.Config.ClassName, cfg.IndexType())
+	}
+	if hnswCfg.Skip {
+		return hnswent.UserConfig{}, errors.Errorf(
+			"vector indexing of class %s is skipped", i.Config.ClassName)
+	}
+	return hnswCfg, nil
+}
+
+func (i *Index) beginReindex() error {
+	i.backupStateLock.Lock()
+	defer i.backupStateLock.Unlock()
+
+	if i.backupState.InProgress {
+		return errors.Errorf("cannot rebuild vector index while backup %q is in "+
+			"progress, try again later", i.backupState.BackupID)
+	}
+	if i.optimizing {
+		return errors.Errorf("cannot rebuild vector index while index %q is "+
+			"optimized, try again later", i.Config.ClassName)
+	}
+	if i.reindexing {
+		return errors.Errorf("rebuild of vector index of %q already in progress",
+			i.Config.ClassName)
+	}
+
+	i.reindexing = true
+	return nil
+}
+
+func (i *Index) endReindex() {
+	i.backupStateLock.Lock()
+	defer i.backupStateLock.Unlock()
+
+	i.reindexing = false
+}
+
+// stopVectorReindex cancels a running rebuild as well as the upgrades of
+// dynamic vector indexes and waits for them. It must not be called with the
+// backupStateLock held.
+func (i *Index) stopVectorReindex() {
+	for _, shard := range i.Shards {
+		shard.stopDynamicUpgrade()
+	}
+
+	i.vectorReindex.Lock()
+	cancel, done := i.vectorReindex.cancel, i.vectorReindex.done
+	i.vectorReindex.Unlock()
+
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+}
+
+// VectorReindexStatus lists the progress of the last rebuild per shard
+func (i *Index) VectorReindexStatus() []VectorReindexStatus {
+	i.vectorReindex.Lock()
+	defer i.vectorReindex.Unlock()
+
+	statuses := make([]VectorReindexStatus, 0, len(i.vectorReindex.statuses))
+	for _, status := range i.vectorReindex.statuses {
+		statuses = append(statuses, *status)
+	}
+	sort.Slice(statuses, func(a, b int) bool {
+		return statuses[a].Shard < statuses[b].Shard
+	})
+	return statuses
+}
+
+// getVectorIndex returns the current vector index of the shard, it is
+// replaced once a rebuild of the index completes
+func (s *Shard) getVectorIndex() VectorIndex {
+	s.vectorIndexLock.RLock()
+	defer s.vectorIndexLock.RUnlock()
+	return s.vectorIndex
+}
+
+// vectorIndexGenerationPath is the marker which holds the generation of the
+// vector index of the shard. Every rebuild of the index increases it, the
+// marker is missing as long as the index was never rebuilt.
+func (s *Shard) vectorIndexGenerationPath() string {
+	return filepath.Join(s.index.Config.RootPath, s.ID()+".vectorindex")
+}
+
+func (s *Shard) vectorIndexGeneration() (int, error) {
+	raw, err := os.ReadFile(s.vectorIndexGenerationPath())
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "read vector index generation")
+	}
+	gen, err := strconv.Atoi(strings.TrimSpace(string(raw)))
+	if err != nil {
+		return 0, errors.Wrap(err, "parse vector index generation")
+	}
+	return gen, nil
+}
+
+// writeVectorIndexGeneration replaces the marker atomically, so a crash
+// leaves either the old or the new index in use
+func (s *Shard) writeVectorIndexGeneration(gen int) error {
+	path := s.vectorIndexGenerationPath()
+	if err := os.WriteFile(path+".tmp", []byte(strconv.Itoa(gen)), 0o666); err != nil {
+		return errors.Wrap(err, "write vector index generation")
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return errors.Wrap(err, "write vector index generation")
+	}
+	return nil
+}
+
+// vectorIndexID is the id of the vector index of the given generation, its
+// files are named after it
+func (s *Shard) vectorIndexID(gen int) string {
+	if gen == 0 {
+		return s.ID()
+	}
+	return fmt.Sprintf("%s.v%d", s.ID(), gen)
+}
+
+// vectorReindex builds the shadow index of a rebuild. Vectors written while
+// it is built are added to both the current and the shadow index, seen
+// tracks them so the backfill from the objects bucket doesn't add vectors
+// again or add vectors which were deleted in the meantime.
+type vectorReindex struct {
+	sync.Mutex
+	shadow VectorIndex
+	seen   *sroar.Bitmap
+	added  *sroar.Bitmap
+}
+
+func newVectorReindex(shadow VectorIndex) *vectorReindex {
+	return &vectorReindex{
+		shadow: shadow,
+		seen:   sroar.NewBitmap(),
+		added:  sroar.NewBitmap(),
+	}
+}
+
+func (r *vectorReindex) add(docID uint64, vector []float32) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.seen.Contains(docID) {
+		return nil
+	}
+	r.seen.Set(docID)
+	if err := r.shadow.Add(docID, vector); err != nil {
+		return err
+	}
+	r.added.Set(docID)
+	return nil
+}
+
+func (r *vectorReindex) delete(docIDs ...uint64) error {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, docID := range docIDs {
+		r.seen.Set(docID)
+		if !r.added.Contains(docID) {
+			continue
+		}
+		if err := r.shadow.Delete(docID); err != nil {
+			return err
+		}
+		r.added.Remove(docID)
+	}
+	return nil
+}
+
+// dualWriteIndex is applied by the index queue while the vector index is
+// rebuilt
+type dualWriteIndex struct {
+	VectorIndex
+	reindex *vectorReindex
+}
+
+func (d dualWriteIndex) Add(docID uint64, vector []float32) error {
+	if err := d.VectorIndex.Add(docID, vector); err != nil {
+		return err
+	}
+	return d.reindex.add(docID, vector)
+}
+
+func (d dualWriteIndex) Delete(docIDs ...uint64) error {
+	if err := d.VectorIndex.Delete(docIDs...); err != nil {
+		return err
+	}
+	return d.reindex.delete(docIDs...)
+}
+
+// queueIndex is the index the index queue applies its operations to. The
+// vectorQueueLock must be held.
+func (s *Shard) queueIndex() VectorIndex {
+	if r := s.vectorReindex.Load(); r != nil {
+		return dualWriteIndex{VectorIndex: s.getVectorIndex(), reindex: r}
+	}
+	return s.getVectorIndex()
+}
+
+func (s *Shard) newHNSWIndex(id string, cfg hnswent.UserConfig) (VectorIndex, error) {
+	return s.newHNSWIndexForVectors(id, cfg, s.vectorByIndexID)
+}
+
+// newHNSWIndexForVectors creates an hnsw index which reads the vectors it
+// doesn't hold in its cache with vectorForID
+func (s *Shard) newHNSWIndexForVectors(id string, cfg hnswent.UserConfig,
+	vectorForID hnsw.VectorForID,
+) (VectorIndex, error) {
+	distProv, err := distanceProvider(cfg.Distance)
+	if err != nil {
+		return nil, err
+	}
+
+	return hnsw.New(hnsw.Config{
+		Logger:            s.index.logger,
+		RootPath:          s.index.Config.RootPath,
+		ID:                id,
+		ShardName:         s.name,
+		ClassName:         s.index.Config.ClassName.String(),
+		PrometheusMetrics: s.promMetrics,
+		MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
+			return hnsw.NewCommitLogger(s.index.Config.RootPath, id, s.index.logger,
+				hnsw.WithCommitlogCompression(s.index.Config.WALCompression))
+		},
+		VectorForIDThunk: vectorForID,
+		DistanceProvider: distProv,
+	}, cfg)
+}
+
+// reindexVectorIndex builds a new vector index with the given config from the
+// vectors of all objects of the shard. The current index keeps serving
+// searches until the new one is complete and replaces it. progress is called
+// with the number of backfilled objects.
+func (s *Shard) reindexVectorIndex(ctx context.Context, cfg hnswent.UserConfig,
+	progress func(processed int64),
+) error {
+	if s.isReadOnly() {
+		return storagestate.ErrStatusReadOnly
+	}
+	gen, err := s.vectorIndexGeneration()
+	if err != nil {
+		return err
+	}
+	id := s.vectorIndexID(gen + 1)
+	// left over by a rebuild which didn't complete
+	if err := os.RemoveAll(filepath.Join(s.index.Config.RootPath,
+		id+".hnsw.commitlog.d")); err != nil {
+		return errors.Wrap(err, "remove incomplete vector index")
+	}
+
+	shadow, err := s.newHNSWIndex(id, cfg)
+	if err != nil {
+		return errors.Wrap(err, "init vector index")
+	}
+	shadow.PostStartup()
+
+	r := newVectorReindex(shadow)
+	if !s.startVectorReindex(r) {
+		shadow.Drop(ctx)
+		return errors.Errorf("vector index of shard %q is rebuilt already", s.name)
+	}
+
+	if err := s.backfillVectorIndex(ctx, r, progress); err != nil {
+		s.stopVectorReindex()
+		if dropErr := shadow.Drop(context.Background()); dropErr != nil {
+			s.index.logger.WithField("action", "reindex_vector_index").
+				WithField("shard", s.name).WithError(dropErr).
+				Warn("could not drop incomplete vector index")
+		}
+		return err
+	}
+
+	old := s.swapVectorIndex(shadow)
+	if err := s.writeVectorIndexGeneration(gen + 1); err != nil {
+		return err
+	}
+	if err := old.Drop(context.Background()); err != nil {
+		return errors.Wrap(err, "drop previous vector index")
+	}
+
+	// compression only starts with a config update
+	return shadow.UpdateUserConfig(cfg, func() {})
+}
+
+// startVectorReindex makes writes add their vectors to the shadow index as
+// well, it returns false if a rebuild is running already
+func (s *Shard) startVectorReindex(r *vectorReindex) bool {
+	s.vectorQueueLock.Lock()
+	defer s.vectorQueueLock.Unlock()
+	s.vectorIndexLock.Lock()
+	defer s.vectorIndexLock.Unlock()
+
+	if !s.vectorReindex.CompareAndSwap(nil, r) {
+		return false
+	}
+	if q := s.vectorQueue.Load(); q != nil {
+		q.setIndex(dualWriteIndex{VectorIndex: s.vectorIndex, reindex: r})
+	}
+	return true
+}
+
+func (s *Shard) stopVectorReindex() {
+	s.vectorQueueLock.Lock()
+	defer s.vectorQueueLock.Unlock()
+	s.vectorIndexLock.Lock()
+	defer s.vectorIndexLock.Unlock()
+
+	s.vectorReindex.Store(nil)
+	if q := s.vectorQueue.Load(); q != nil {
+		q.setIndex(s.vectorIndex)
+	}
+}
+
+// swapVectorIndex replaces the vector index and returns the previous one
+func (s *Shard) swapVectorIndex(index VectorIndex) VectorIndex {
+	s.vectorQueueLock.Lock()
+	defer s.vectorQueueLock.Unlock()
+	s.vectorIndexLock.Lock()
+	defer s.vectorIndexLock.Unlock()
+
+	old := s.vectorIndex
+	s.vectorIndex = index
+	s.vectorReindex.Store(nil)
+	if q := s.vectorQueue.Load(); q != nil {
+		q.setIndex(index)
+	}
+	return old
+}
+
+// backfillVectorIndex adds the vectors of all objects to the shadow index
+// which weren't written or deleted since the rebuild started
+func (s *Shard) backfillVectorIndex(ctx context.Context, r *vectorReindex,
+	progress func(processed int64),
+) error {
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	if bucket == nil {
+		return errors.Errorf("objects bucket not found")
+	}
+
+	c := bucket.Cursor()
+	defer c.Close()
+	var processed int64
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		obj, err := storobj.FromBinary(v)
+		if err != nil {
+			return errors.Wrapf(err, "unmarshal object %s", string(k))
+		}
+		if len(obj.Vector) > 0 {
+			if err := r.add(obj.DocID(), obj.Vector); err != nil {
+				return errors.Wrapf(err, "add vector of object %s", obj.ID())
+			}
+		}
+
+		processed++
+		if processed%1000 == 0 {
+			progress(processed)
+		}
+	}
+	progress(processed)
+
+	return r.shadow.Flush()
+}
@@ -0,0 +1,180 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestReindexVectorIndex(t *testing.T) {
+	dirName := t.TempDir()
+
+	class := &models.Class{
+		Class:               "ReindexClass",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "name",
+				DataType: []string{"text"},
+			},
+		},
+	}
+	shardState := singleShardState()
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: shardState, schema: schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}}
+	newRepo := func() *DB {
+		repo, err := New(logger, Config{
+			MemtablesFlushIdleAfter:   60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+	repo := newRepo()
+
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, shardState))
+
+	ids := make([]strfmt.UUID, 200)
+	for i := range ids {
+		ids[i] = strfmt.UUID(fmt.Sprintf("b0b55b05-bc5b-4cc9-b646-%012d", i))
+	}
+	vector := func(i int) []float32 {
+		r := rand.New(rand.NewSource(int64(i)))
+		vec := make([]float32, 16)
+		for j := range vec {
+			vec[j] = r.Float32()
+		}
+		return vec
+	}
+	put := func(t *testing.T, repo *DB, i int) {
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			ID:         ids[i],
+			Class:      class.Class,
+			Properties: map[string]interface{}{"name": fmt.Sprintf("object %d", i)},
+		}, vector(i), nil))
+	}
+	className := schema.ClassName(class.Class)
+
+	t.Run("import objects and delete some", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			put(t, repo, i)
+		}
+		for _, id := range ids[80:100] {
+			require.Nil(t, repo.DeleteObject(context.Background(), class.Class, id, nil))
+		}
+	})
+
+	assertObjectsFound := func(t *testing.T, repo *DB) {
+		for i, id := range ids {
+			deleted := (i >= 80 && i < 100) || (i >= 150 && i < 160)
+
+			obj, err := repo.Object(context.Background(), class.Class, id,
+				search.SelectProperties{}, additional.Properties{}, nil)
+			require.Nil(t, err)
+			assert.Equal(t, !deleted, obj != nil, "object %d", i)
+
+			res, err := repo.VectorSearch(context.Background(), vector(i), 0, 1, nil)
+			require.Nil(t, err)
+			require.Len(t, res, 1)
+			if deleted {
+				assert.NotEqual(t, id, res[0].ID, "object %d", i)
+			} else {
+				assert.Equal(t, id, res[0].ID, "object %d", i)
+			}
+		}
+	}
+
+	t.Run("rebuild the vector index while writing", func(t *testing.T) {
+		require.Nil(t, repo.ReindexVectorIndex(context.Background(), className))
+
+		for i := 100; i < 200; i++ {
+			put(t, repo, i)
+		}
+		for _, id := range ids[150:160] {
+			require.Nil(t, repo.DeleteObject(context.Background(), class.Class, id, nil))
+		}
+
+		assert.Eventually(t, func() bool {
+			statuses, err := repo.VectorReindexStatus(className)
+			require.Nil(t, err)
+			require.Len(t, statuses, 1)
+			return statuses[0].Status == VectorReindexCompleted
+		}, 10*time.Second, 10*time.Millisecond)
+
+		statuses, err := repo.VectorReindexStatus(className)
+		require.Nil(t, err)
+		assert.Equal(t, int64(80), statuses[0].Total)
+		assert.GreaterOrEqual(t, statuses[0].Processed, int64(80))
+		assert.Empty(t, statuses[0].Error)
+		assert.NotNil(t, statuses[0].FinishedAt)
+	})
+
+	t.Run("the rebuilt index replaced the previous one", func(t *testing.T) {
+		assertObjectsFound(t, repo)
+
+		shard := repo.GetIndex(className).Shards[shardState.AllPhysicalShards()[0]]
+		gen, err := shard.vectorIndexGeneration()
+		require.Nil(t, err)
+		assert.Equal(t, 1, gen)
+
+		_, err = os.Stat(filepath.Join(dirName, shard.vectorIndexID(0)+".hnsw.commitlog.d"))
+		assert.True(t, os.IsNotExist(err))
+		_, err = os.Stat(filepath.Join(dirName, shard.vectorIndexID(1)+".hnsw.commitlog.d"))
+		assert.Nil(t, err)
+	})
+
+	t.Run("the rebuilt index is loaded after a restart", func(t *testing.T) {
+		require.Nil(t, repo.Shutdown(context.Background()))
+		repo = newRepo()
+
+		assertObjectsFound(t, repo)
+	})
+	defer repo.Shutdown(context.Background())
+
+	t.Run("a rebuild is rejected during a backup", func(t *testing.T) {
+		index := repo.GetIndex(className)
+		require.Nil(t, index.initBackup("backup-1"))
+		defer index.resetBackupState()
+
+		err := repo.ReindexVectorIndex(context.Background(), className)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "backup")
+	})
+}
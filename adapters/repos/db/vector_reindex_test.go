@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
+)
+
+type recordingVectorIndex struct {
+	VectorIndex
+	vectors map[uint64][]float32
+}
+
+func newRecordingVectorIndex() *recordingVectorIndex {
+	return &recordingVectorIndex{
+		VectorIndex: noop.NewIndex(),
+		vectors:     map[uint64][]float32{},
+	}
+}
+
+func (r *recordingVectorIndex) Add(docID uint64, vector []float32) error {
+	r.vectors[docID] = vector
+	return nil
+}
+
+func (r *recordingVectorIndex) Delete(docIDs ...uint64) error {
+	for _, docID := range docIDs {
+		delete(r.vectors, docID)
+	}
+	return nil
+}
+
+func TestVectorReindex(t *testing.T) {
+	shadow := newRecordingVectorIndex()
+	r := newVectorReindex(shadow)
+
+	// written since the rebuild started
+	require.Nil(t, r.add(1, []float32{1}))
+	require.Nil(t, r.delete(2))
+	require.Nil(t, r.add(3, []float32{3}))
+	require.Nil(t, r.delete(3))
+
+	// backfilled from the objects bucket, the object of 2 was read before
+	// its deletion
+	for docID := uint64(1); docID <= 4; docID++ {
+		require.Nil(t, r.add(docID, []float32{float32(docID) + 10}))
+	}
+
+	assert.Equal(t, map[uint64][]float32{
+		1: {1},
+		4: {14},
+	}, shadow.vectors)
+}
+
+func TestDualWriteIndex(t *testing.T) {
+	current := newRecordingVectorIndex()
+	shadow := newRecordingVectorIndex()
+	index := dualWriteIndex{VectorIndex: current, reindex: newVectorReindex(shadow)}
+
+	require.Nil(t, index.Add(1, []float32{1}))
+	require.Nil(t, index.Add(2, []float32{2}))
+	require.Nil(t, index.Delete(1))
+
+	expected := map[uint64][]float32{2: {2}}
+	assert.Equal(t, expected, current.vectors)
+	assert.Equal(t, expected, shadow.vectors)
+}
@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package walcompression implements the optional block compression of
+// write-ahead logs. A compressed log starts with a header which names the
+// codec, followed by frames which each hold a compressed block of whole
+// records. Logs without the header are read as they are, so logs written
+// before compression was enabled can still be replayed.
+package walcompression
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+)
+
+// Codec is the compression algorithm of the blocks of a log
+type Codec uint8
+
+const (
+	CodecNone Codec = iota
+	CodecSnappy
+	CodecZstd
+)
+
+func (c Codec) String() string {
+	switch c {
+	case CodecNone:
+		return "none"
+	case CodecSnappy:
+		return "snappy"
+	case CodecZstd:
+		return "zstd"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseCodec parses the name of a codec, an empty name is CodecNone
+func ParseCodec(name string) (Codec, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "none":
+		return CodecNone, nil
+	case "snappy":
+		return CodecSnappy, nil
+	case "zstd":
+		return CodecZstd, nil
+	default:
+		return CodecNone, errors.Errorf(
+			"unknown wal compression codec %q, must be one of none, snappy, zstd", name)
+	}
+}
+
+// the zstd encoder and decoder are safe for concurrent use with EncodeAll
+// and DecodeAll, so they are shared by all logs
+var (
+	zstdOnce    sync.Once
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+	zstdErr     error
+)
+
+func initZstd() error {
+	zstdOnce.Do(func() {
+		zstdEncoder, zstdErr = zstd.NewWriter(nil,
+			zstd.WithEncoderLevel(zstd.SpeedFastest))
+		if zstdErr != nil {
+			return
+		}
+		zstdDecoder, zstdErr = zstd.NewReader(nil)
+	})
+	return zstdErr
+}
+
+func (c Codec) encode(dst, src []byte) ([]byte, error) {
+	switch c {
+	case CodecNone:
+		return append(dst[:0], src...), nil
+	case CodecSnappy:
+		return snappy.Encode(dst[:cap(dst)], src), nil
+	case CodecZstd:
+		if err := initZstd(); err != nil {
+			return nil, errors.Wrap(err, "init zstd")
+		}
+		return zstdEncoder.EncodeAll(src, dst[:0]), nil
+	default:
+		return nil, errors.Errorf("unknown wal compression codec %d", c)
+	}
+}
+
+func (c Codec) decode(dst, src []byte) ([]byte, error) {
+	switch c {
+	case CodecNone:
+		return append(dst[:0], src...), nil
+	case CodecSnappy:
+		return snappy.Decode(dst[:cap(dst)], src)
+	case CodecZstd:
+		if err := initZstd(); err != nil {
+			return nil, errors.Wrap(err, "init zstd")
+		}
+		return zstdDecoder.DecodeAll(src, dst[:0])
+	default:
+		return nil, errors.Errorf("unknown wal compression codec %d", c)
+	}
+}
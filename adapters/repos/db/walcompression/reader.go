@@ -0,0 +1,247 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package walcompression
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// Reader reads a log which was written either by a Writer or uncompressed.
+// Which one it is, is detected on the first Read.
+//
+// A frame which is incomplete or fails its checksum, for example because
+// the log ended abruptly, is reported as io.ErrUnexpectedEOF. The
+// successfully read part of the log ends at ValidOffset.
+type Reader struct {
+	r          *bufio.Reader
+	detected   bool
+	compressed bool
+	codec      Codec
+	block      []byte
+	pos        int
+	frame      []byte
+	read       int64
+	valid      int64
+	err        error
+}
+
+// NewReader wraps r, which is buffered unless it already is a *bufio.Reader
+func NewReader(r io.Reader) *Reader {
+	br, ok := r.(*bufio.Reader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	return &Reader{r: br}
+}
+
+// Compressed reports whether the log was written by a Writer, it is only
+// meaningful after the first Read
+func (r *Reader) Compressed() bool {
+	return r.compressed
+}
+
+// ValidOffset is the offset in the underlying log up to which it was read
+// successfully. For a compressed log this is always the end of a frame, so
+// it is safe to truncate the log there and append to it.
+func (r *Reader) ValidOffset() int64 {
+	return r.valid
+}
+
+func (r *Reader) Read(p []byte) (int, error) {
+	if !r.detected {
+		r.detected = true
+		first, err := r.r.Peek(1)
+		if err == nil && first[0] == magic[0] {
+			r.compressed = true
+		}
+	}
+
+	if !r.compressed {
+		n, err := r.r.Read(p)
+		r.read += int64(n)
+		r.valid = r.read
+		return n, err
+	}
+
+	for r.pos == len(r.block) {
+		if r.err != nil {
+			return 0, r.err
+		}
+		if err := r.nextFrame(); err != nil {
+			r.err = err
+			return 0, err
+		}
+	}
+
+	n := copy(p, r.block[r.pos:])
+	r.pos += n
+	return n, nil
+}
+
+// nextFrame decodes the next frame into the block, skipping headers
+func (r *Reader) nextFrame() error {
+	for {
+		marker, err := r.r.ReadByte()
+		if err != nil {
+			if err == io.EOF {
+				return io.EOF
+			}
+			return err
+		}
+		r.read++
+
+		switch marker {
+		case magic[0]:
+			if err := r.readHeader(); err != nil {
+				return err
+			}
+			r.valid = r.read
+		case frameMarker:
+			if err := r.readFrame(); err != nil {
+				return err
+			}
+			r.valid = r.read
+			return nil
+		default:
+			return errors.Wrapf(io.ErrUnexpectedEOF,
+				"unknown frame marker 0x%x at offset %d", marker, r.read-1)
+		}
+	}
+}
+
+func (r *Reader) readHeader() error {
+	var rest [headerSize - 1]byte
+	if err := r.readFull(rest[:]); err != nil {
+		return err
+	}
+	if !bytes.Equal(rest[:len(magic)-1], magic[1:]) {
+		return errors.Wrap(io.ErrUnexpectedEOF, "invalid wal compression header")
+	}
+	if version := rest[len(magic)-1]; version != headerVersion {
+		return errors.Errorf("unsupported wal compression version %d", version)
+	}
+	r.codec = Codec(rest[len(magic)])
+	return nil
+}
+
+func (r *Reader) readFrame() error {
+	rawLen, err := r.readUvarint()
+	if err != nil {
+		return err
+	}
+	compressedLen, err := r.readUvarint()
+	if err != nil {
+		return err
+	}
+	if rawLen > maxFrameSize || compressedLen > maxFrameSize {
+		return errors.Wrap(io.ErrUnexpectedEOF, "invalid wal compression frame length")
+	}
+
+	var checksum [4]byte
+	if err := r.readFull(checksum[:]); err != nil {
+		return err
+	}
+
+	if cap(r.frame) < int(compressedLen) {
+		r.frame = make([]byte, compressedLen)
+	}
+	r.frame = r.frame[:compressedLen]
+	if err := r.readFull(r.frame); err != nil {
+		return err
+	}
+	if crc32.Checksum(r.frame, crcTable) != binary.LittleEndian.Uint32(checksum[:]) {
+		return errors.Wrap(io.ErrUnexpectedEOF, "wal compression frame checksum mismatch")
+	}
+
+	if cap(r.block) < int(rawLen) {
+		r.block = make([]byte, 0, rawLen)
+	}
+	block, err := r.codec.decode(r.block[:0], r.frame)
+	if err != nil {
+		return errors.Wrapf(err, "decode %s frame", r.codec)
+	}
+	if len(block) != int(rawLen) {
+		return errors.Errorf("decoded %s frame has %d bytes, expected %d",
+			r.codec, len(block), rawLen)
+	}
+	r.block = block
+	r.pos = 0
+	return nil
+}
+
+// readFull reports a log that ends within a frame as io.ErrUnexpectedEOF
+func (r *Reader) readFull(p []byte) error {
+	n, err := io.ReadFull(r.r, p)
+	r.read += int64(n)
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func (r *Reader) readUvarint() (uint64, error) {
+	var x uint64
+	var s uint
+	for i := 0; i < binary.MaxVarintLen64; i++ {
+		b, err := r.r.ReadByte()
+		if err != nil {
+			if err == io.EOF {
+				return 0, io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+		r.read++
+		if b < 0x80 {
+			return x | uint64(b)<<s, nil
+		}
+		x |= uint64(b&0x7f) << s
+		s += 7
+	}
+	return 0, errors.Wrap(io.ErrUnexpectedEOF, "invalid wal compression frame length")
+}
+
+// DetectFile returns the codec of the log at path. ok is false if the log is
+// empty or its header is incomplete, so that the codec of the writer can be
+// chosen freely.
+func DetectFile(path string) (codec Codec, ok bool, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return CodecNone, false, err
+	}
+	defer f.Close()
+
+	var header [headerSize]byte
+	n, err := io.ReadFull(f, header[:])
+	if n == 0 {
+		if err == io.EOF {
+			return CodecNone, false, nil
+		}
+		return CodecNone, false, err
+	}
+	if header[0] != magic[0] {
+		return CodecNone, true, nil
+	}
+	if n < headerSize {
+		if err == io.ErrUnexpectedEOF {
+			return CodecNone, false, nil
+		}
+		return CodecNone, false, err
+	}
+	return Codec(header[len(magic)+1]), true, nil
+}
@@ -0,0 +1,197 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package walcompression
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRecords(count int) [][]byte {
+	records := make([][]byte, count)
+	for i := range records {
+		rec := make([]byte, 9+i%13)
+		rec[0] = byte(i % 3)
+		binary.LittleEndian.PutUint64(rec[1:9], uint64(i))
+		records[i] = rec
+	}
+	return records
+}
+
+func writeRecords(t *testing.T, w *Writer, records [][]byte) {
+	for _, rec := range records {
+		_, err := w.Write(rec)
+		require.Nil(t, err)
+		require.Nil(t, w.EndRecord())
+	}
+	require.Nil(t, w.Flush())
+}
+
+func TestParseCodec(t *testing.T) {
+	for name, expected := range map[string]Codec{
+		"":       CodecNone,
+		"none":   CodecNone,
+		"snappy": CodecSnappy,
+		"ZSTD":   CodecZstd,
+	} {
+		codec, err := ParseCodec(name)
+		require.Nil(t, err)
+		assert.Equal(t, expected, codec)
+	}
+
+	_, err := ParseCodec("gzip")
+	assert.NotNil(t, err)
+}
+
+func TestRoundTrip(t *testing.T) {
+	records := testRecords(1000)
+	expected := bytes.Join(records, nil)
+
+	for _, codec := range []Codec{CodecNone, CodecSnappy, CodecZstd} {
+		t.Run(codec.String(), func(t *testing.T) {
+			var buf bytes.Buffer
+			writeRecords(t, NewWriter(&buf, codec, 512), records)
+
+			r := NewReader(&buf)
+			out, err := io.ReadAll(r)
+			require.Nil(t, err)
+			assert.Equal(t, expected, out)
+			assert.True(t, r.Compressed())
+		})
+	}
+}
+
+func TestReadUncompressed(t *testing.T) {
+	expected := bytes.Join(testRecords(100), nil)
+
+	r := NewReader(bytes.NewReader(expected))
+	out, err := io.ReadAll(r)
+	require.Nil(t, err)
+	assert.Equal(t, expected, out)
+	assert.False(t, r.Compressed())
+	assert.Equal(t, int64(len(expected)), r.ValidOffset())
+
+	out, err = io.ReadAll(NewReader(bytes.NewReader(nil)))
+	require.Nil(t, err)
+	assert.Len(t, out, 0)
+}
+
+func TestReadConcatenated(t *testing.T) {
+	first, second := testRecords(300), testRecords(200)
+
+	// e.g. two condensed commit logs which were combined or a log which was
+	// appended to after a restart
+	var buf bytes.Buffer
+	writeRecords(t, NewWriter(&buf, CodecSnappy, 256), first)
+	writeRecords(t, NewWriter(&buf, CodecZstd, 256), second)
+
+	out, err := io.ReadAll(NewReader(&buf))
+	require.Nil(t, err)
+	assert.Equal(t, append(bytes.Join(first, nil), bytes.Join(second, nil)...), out)
+}
+
+func TestReadTruncated(t *testing.T) {
+	records := testRecords(200)
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf, CodecSnappy, 64)
+	// a log which only holds the header is valid as well
+	frameEnds := []int{headerSize}
+	for _, rec := range records {
+		_, err := w.Write(rec)
+		require.Nil(t, err)
+		require.Nil(t, w.EndRecord())
+		if w.Buffered() == 0 {
+			frameEnds = append(frameEnds, buf.Len())
+		}
+	}
+	full := buf.Bytes()
+	require.Equal(t, len(full), frameEnds[len(frameEnds)-1])
+
+	for size := 0; size < len(full); size++ {
+		r := NewReader(bytes.NewReader(full[:size]))
+		out, err := io.ReadAll(r)
+
+		lastFrameEnd := 0
+		for _, end := range frameEnds {
+			if end <= size {
+				lastFrameEnd = end
+			}
+		}
+		if lastFrameEnd == size {
+			require.Nil(t, err, "size %d", size)
+		} else {
+			require.True(t, errors.Is(err, io.ErrUnexpectedEOF), "size %d: %v", size, err)
+		}
+		assert.Equal(t, int64(lastFrameEnd), r.ValidOffset(), "size %d", size)
+
+		// only whole records are returned
+		reread, err := io.ReadAll(NewReader(bytes.NewReader(full[:lastFrameEnd])))
+		require.Nil(t, err)
+		assert.Equal(t, reread, out)
+	}
+}
+
+func TestReadCorruptFrame(t *testing.T) {
+	var buf bytes.Buffer
+	writeRecords(t, NewWriter(&buf, CodecZstd, 128), testRecords(100))
+
+	corrupt := buf.Bytes()
+	corrupt[len(corrupt)-1] ^= 0xff
+
+	r := NewReader(bytes.NewReader(corrupt))
+	_, err := io.ReadAll(r)
+	assert.True(t, errors.Is(err, io.ErrUnexpectedEOF))
+	assert.Less(t, r.ValidOffset(), int64(len(corrupt)))
+}
+
+func TestDetectFile(t *testing.T) {
+	dir := t.TempDir()
+
+	write := func(name string, content []byte) string {
+		path := filepath.Join(dir, name)
+		require.Nil(t, os.WriteFile(path, content, 0o666))
+		return path
+	}
+
+	var compressed bytes.Buffer
+	writeRecords(t, NewWriter(&compressed, CodecZstd, 0), testRecords(10))
+
+	tests := []struct {
+		name    string
+		content []byte
+		codec   Codec
+		ok      bool
+	}{
+		{name: "empty", content: nil, codec: CodecNone, ok: false},
+		{name: "uncompressed", content: []byte{0, 1, 2}, codec: CodecNone, ok: true},
+		{name: "compressed", content: compressed.Bytes(), codec: CodecZstd, ok: true},
+		{name: "partial header", content: compressed.Bytes()[:3], codec: CodecNone, ok: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			codec, ok, err := DetectFile(write(test.name, test.content))
+			require.Nil(t, err)
+			assert.Equal(t, test.codec, codec)
+			assert.Equal(t, test.ok, ok)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package walcompression
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+)
+
+const (
+	headerVersion = 1
+	frameMarker   = 0x01
+	// maxFrameSize protects against huge allocations when reading a corrupt
+	// frame length
+	maxFrameSize = 256 * 1024 * 1024
+
+	// DefaultBlockSize is the size of the records which are collected before
+	// they are compressed into a frame
+	DefaultBlockSize = 64 * 1024
+)
+
+// the first byte of the magic can never be the first byte of an
+// uncompressed log, whose records all start with a small commit type
+var magic = [4]byte{0xff, 'W', 'A', 'L'}
+
+const headerSize = len(magic) + 2
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// Writer compresses the records written to it in blocks. Records are
+// collected until EndRecord finds that the block is full or the Writer is
+// flushed, so a frame never ends in the middle of a record.
+//
+// A Writer is not safe for concurrent use.
+type Writer struct {
+	w         io.Writer
+	codec     Codec
+	blockSize int
+	block     []byte
+	frame     []byte
+	out       []byte
+	header    bool
+}
+
+// NewWriter creates a Writer which writes the header, followed by the frames
+// to w. A blockSize <= 0 is DefaultBlockSize.
+func NewWriter(w io.Writer, codec Codec, blockSize int) *Writer {
+	if blockSize <= 0 {
+		blockSize = DefaultBlockSize
+	}
+	return &Writer{
+		w:         w,
+		codec:     codec,
+		blockSize: blockSize,
+		block:     make([]byte, 0, blockSize),
+	}
+}
+
+// Write adds p to the current block, it never writes to the underlying
+// writer
+func (w *Writer) Write(p []byte) (int, error) {
+	w.block = append(w.block, p...)
+	return len(p), nil
+}
+
+// EndRecord marks the end of a record. The block is written as a frame once
+// it reached the block size.
+func (w *Writer) EndRecord() error {
+	if len(w.block) < w.blockSize {
+		return nil
+	}
+	return w.Flush()
+}
+
+// Buffered is the size of the records which were not written yet
+func (w *Writer) Buffered() int {
+	return len(w.block)
+}
+
+// Flush writes the current block as a frame. It must only be called in
+// between records.
+func (w *Writer) Flush() error {
+	if len(w.block) == 0 {
+		return nil
+	}
+
+	var head [1 + 2*binary.MaxVarintLen64 + 4]byte
+	head[0] = frameMarker
+	n := 1
+	n += binary.PutUvarint(head[n:], uint64(len(w.block)))
+
+	payload, err := w.codec.encode(w.frame, w.block)
+	if err != nil {
+		return err
+	}
+	w.frame = payload[:0]
+
+	n += binary.PutUvarint(head[n:], uint64(len(payload)))
+	binary.LittleEndian.PutUint32(head[n:], crc32.Checksum(payload, crcTable))
+	n += 4
+
+	out := w.out[:0]
+	if !w.header {
+		// appending to an existing compressed log repeats the header, which
+		// is skipped when reading
+		out = append(out, magic[:]...)
+		out = append(out, headerVersion, byte(w.codec))
+	}
+	out = append(out, head[:n]...)
+	out = append(out, payload...)
+
+	w.out = out
+	if _, err := w.w.Write(out); err != nil {
+		return err
+	}
+	w.header = true
+	w.block = w.block[:0]
+	return nil
+}
@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"sync"
+
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// workerPool bounds the number of concurrent operations of one kind. A pool
+// of size 0 is unbounded.
+type workerPool struct {
+	size int
+
+	sync.Mutex
+	active int
+	// freed is closed and replaced whenever a worker is released
+	freed chan struct{}
+}
+
+func newWorkerPool(size int) *workerPool {
+	return &workerPool{size: size, freed: make(chan struct{})}
+}
+
+// acquire blocks until a worker is free, the returned function must be
+// called once the operation is done
+func (p *workerPool) acquire(ctx context.Context) (func(), error) {
+	for {
+		p.Lock()
+		if p.size <= 0 {
+			p.Unlock()
+			return func() {}, nil
+		}
+		if p.active < p.size {
+			p.active++
+			p.Unlock()
+			return p.release, nil
+		}
+		freed := p.freed
+		p.Unlock()
+
+		select {
+		case <-freed:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// resize changes the number of workers. Operations which are running already
+// keep their worker, waiting operations are woken up to check the new size.
+func (p *workerPool) resize(size int) {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.size == size {
+		return
+	}
+	p.size = size
+	close(p.freed)
+	p.freed = make(chan struct{})
+}
+
+func (p *workerPool) release() {
+	p.Lock()
+	defer p.Unlock()
+
+	p.active--
+	close(p.freed)
+	p.freed = make(chan struct{})
+}
+
+// waitIfBusy blocks until the next worker is released if all workers are
+// busy. It doesn't wait for the pool to become idle, so operations which
+// yield to the pool are delayed, but never starved.
+func (p *workerPool) waitIfBusy(ctx context.Context) error {
+	p.Lock()
+	if p.size <= 0 || p.active < p.size {
+		p.Unlock()
+		return nil
+	}
+	freed := p.freed
+	p.Unlock()
+
+	select {
+	case <-freed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// workerPools are the pools of batch imports and queries. The indexing
+// workers are the consumers of the job queue of the DB.
+type workerPools struct {
+	batch         *workerPool
+	query         *workerPool
+	queryPriority bool
+}
+
+func newWorkerPools(cfg config.WorkerPools) *workerPools {
+	return &workerPools{
+		batch:         newWorkerPool(cfg.BatchWorkers),
+		query:         newWorkerPool(cfg.QueryWorkers),
+		queryPriority: cfg.Priority == config.WorkerPoolPriorityQuery,
+	}
+}
+
+// acquireQuery blocks until a query may run
+func (p *workerPools) acquireQuery(ctx context.Context) (func(), error) {
+	return p.query.acquire(ctx)
+}
+
+// acquireBatch blocks until a batch may be written. With query priority it
+// yields to the queries first.
+func (p *workerPools) acquireBatch(ctx context.Context) (func(), error) {
+	if err := p.yieldToQueries(ctx); err != nil {
+		return nil, err
+	}
+	return p.batch.acquire(ctx)
+}
+
+// yieldToQueries waits for a free query worker if all of them are busy and
+// queries have priority over imports
+func (p *workerPools) yieldToQueries(ctx context.Context) error {
+	if !p.queryPriority {
+		return nil
+	}
+	return p.query.waitIfBusy(ctx)
+}
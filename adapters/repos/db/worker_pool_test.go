@@ -0,0 +1,143 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestWorkerPool(t *testing.T) {
+	t.Run("unbounded", func(t *testing.T) {
+		p := newWorkerPool(0)
+		for i := 0; i < 10; i++ {
+			_, err := p.acquire(context.Background())
+			require.Nil(t, err)
+		}
+		assert.Nil(t, p.waitIfBusy(context.Background()))
+	})
+
+	t.Run("blocks until a worker is released", func(t *testing.T) {
+		p := newWorkerPool(1)
+		release, err := p.acquire(context.Background())
+		require.Nil(t, err)
+
+		acquired := make(chan struct{})
+		go func() {
+			release, err := p.acquire(context.Background())
+			require.Nil(t, err)
+			release()
+			close(acquired)
+		}()
+
+		select {
+		case <-acquired:
+			t.Fatal("acquired a worker of a busy pool")
+		case <-time.After(20 * time.Millisecond):
+		}
+
+		release()
+		select {
+		case <-acquired:
+		case <-time.After(time.Second):
+			t.Fatal("worker was not released")
+		}
+	})
+
+	t.Run("cancelled while waiting", func(t *testing.T) {
+		p := newWorkerPool(1)
+		_, err := p.acquire(context.Background())
+		require.Nil(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = p.acquire(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.ErrorIs(t, p.waitIfBusy(ctx), context.DeadlineExceeded)
+	})
+
+	t.Run("resized while waiting", func(t *testing.T) {
+		p := newWorkerPool(1)
+		release, err := p.acquire(context.Background())
+		require.Nil(t, err)
+
+		acquired := make(chan struct{})
+		go func() {
+			release, err := p.acquire(context.Background())
+			require.Nil(t, err)
+			release()
+			close(acquired)
+		}()
+
+		select {
+		case <-acquired:
+			t.Fatal("acquired a worker of a busy pool")
+		case <-time.After(20 * time.Millisecond):
+		}
+
+		p.resize(2)
+		select {
+		case <-acquired:
+		case <-time.After(time.Second):
+			t.Fatal("waiting operation didn't pick up the new size")
+		}
+		release()
+	})
+}
+
+func TestWorkerPoolsQueryPriority(t *testing.T) {
+	pools := newWorkerPools(config.WorkerPools{
+		QueryWorkers: 1,
+		Priority:     config.WorkerPoolPriorityQuery,
+	})
+	releaseQuery, err := pools.acquireQuery(context.Background())
+	require.Nil(t, err)
+
+	batchDone := make(chan struct{})
+	go func() {
+		release, err := pools.acquireBatch(context.Background())
+		require.Nil(t, err)
+		release()
+		close(batchDone)
+	}()
+
+	select {
+	case <-batchDone:
+		t.Fatal("batch didn't yield to the running query")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	releaseQuery()
+	select {
+	case <-batchDone:
+	case <-time.After(time.Second):
+		t.Fatal("batch didn't resume once the query was done")
+	}
+
+	t.Run("without priority", func(t *testing.T) {
+		pools := newWorkerPools(config.WorkerPools{
+			QueryWorkers: 1,
+			Priority:     config.WorkerPoolPriorityNone,
+		})
+		_, err := pools.acquireQuery(context.Background())
+		require.Nil(t, err)
+
+		release, err := pools.acquireBatch(context.Background())
+		require.Nil(t, err)
+		release()
+	})
+}
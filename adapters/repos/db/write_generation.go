@@ -0,0 +1,43 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"time"
+
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// The write generation of an index changes whenever a write to one of its
+// local shards has become visible or its schema has changed. It starts at the
+// creation time of the index, so that a class which is deleted and re-created
+// never returns a generation of its previous incarnation.
+
+func (i *Index) initWriteGeneration() {
+	i.writeGeneration.Store(uint64(time.Now().UnixNano()))
+}
+
+func (i *Index) notifyWrite() {
+	i.writeGeneration.Add(1)
+}
+
+// ClassWriteGeneration returns the write generation of the class, 0 if the
+// class does not exist. Writes which are handled by other nodes are not
+// reflected.
+func (d *DB) ClassWriteGeneration(className string) uint64 {
+	idx := d.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return 0
+	}
+
+	return idx.writeGeneration.Load()
+}
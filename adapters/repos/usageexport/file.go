@@ -0,0 +1,59 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package usageexport
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/usecases/metering"
+)
+
+// File appends the records as JSON lines to one file per day in a directory
+type File struct {
+	sync.Mutex
+	dir string
+}
+
+func NewFile(dir string) *File {
+	return &File{dir: dir}
+}
+
+func (f *File) Export(ctx context.Context, record metering.Record) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "marshal record")
+	}
+	line = append(line, '\n')
+
+	f.Lock()
+	defer f.Unlock()
+
+	if err := os.MkdirAll(f.dir, 0o755); err != nil {
+		return errors.Wrapf(err, "create directory %q", f.dir)
+	}
+	name := filepath.Join(f.dir,
+		"usage-"+record.PeriodEnd.UTC().Format("2006-01-02")+".jsonl")
+	file, err := os.OpenFile(name, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return errors.Wrapf(err, "open %q", name)
+	}
+	if _, err := file.Write(line); err != nil {
+		file.Close()
+		return errors.Wrapf(err, "write %q", name)
+	}
+	return file.Close()
+}
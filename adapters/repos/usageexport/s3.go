@@ -0,0 +1,58 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package usageexport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/objectstorage"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/metering"
+)
+
+// S3 writes each record as an object named
+// <prefix>/<node>/<period end in unix seconds>.json
+type S3 struct {
+	client *minio.Client
+	bucket string
+	prefix string
+}
+
+func NewS3(cfg config.Metering, bucket, prefix string) (*S3, error) {
+	client, err := objectstorage.NewS3Client(cfg.S3Endpoint, cfg.S3UseSSL)
+	if err != nil {
+		return nil, err
+	}
+	return &S3{client: client, bucket: bucket, prefix: prefix}, nil
+}
+
+func (s *S3) Export(ctx context.Context, record metering.Record) error {
+	body, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "marshal record")
+	}
+
+	name := path.Join(s.prefix, record.Node,
+		fmt.Sprintf("%d.json", record.PeriodEnd.Unix()))
+	_, err = s.client.PutObject(ctx, s.bucket, name, bytes.NewReader(body),
+		int64(len(body)), minio.PutObjectOptions{ContentType: "application/json"})
+	if err != nil {
+		return errors.Wrapf(err, "put object %q", name)
+	}
+	return nil
+}
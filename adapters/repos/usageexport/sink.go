@@ -0,0 +1,42 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package usageexport contains the sinks usage records of the metering
+// collector are exported to
+package usageexport
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/metering"
+)
+
+// New creates the sink configured by the URL of cfg.Sink
+func New(cfg config.Metering) (metering.Sink, error) {
+	scheme, location, ok := strings.Cut(cfg.Sink, "://")
+	if !ok {
+		return nil, errors.Errorf("invalid sink %q", cfg.Sink)
+	}
+
+	switch scheme {
+	case "file":
+		return NewFile(location), nil
+	case "s3":
+		bucket, prefix, _ := strings.Cut(location, "/")
+		return NewS3(cfg, bucket, prefix)
+	case "http", "https":
+		return NewHTTP(cfg.Sink), nil
+	default:
+		return nil, errors.Errorf("unsupported sink scheme %q", scheme)
+	}
+}
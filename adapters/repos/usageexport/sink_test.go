@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package usageexport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/metering"
+)
+
+func testRecord(end time.Time) metering.Record {
+	return metering.Record{
+		Node:        "node1",
+		PeriodStart: end.Add(-time.Hour),
+		PeriodEnd:   end,
+		Operations: []metering.OperationUsage{
+			{Class: "Article", User: "alice", Operation: metering.OperationCreate, Count: 2},
+		},
+		Storage: []metering.StorageUsage{
+			{Class: "Article", Shard: "abc", Objects: 2, VectorDimensions: 6, Bytes: 512},
+		},
+	}
+}
+
+func TestNew(t *testing.T) {
+	sink, err := New(config.Metering{Sink: "file:///tmp/usage"})
+	require.Nil(t, err)
+	assert.Equal(t, "/tmp/usage", sink.(*File).dir)
+
+	sink, err = New(config.Metering{Sink: "https://billing.example.com/usage"})
+	require.Nil(t, err)
+	assert.Equal(t, "https://billing.example.com/usage", sink.(*HTTP).url)
+
+	sink, err = New(config.Metering{Sink: "s3://usage/weaviate/prod", S3Endpoint: "localhost:9000"})
+	require.Nil(t, err)
+	assert.Equal(t, "usage", sink.(*S3).bucket)
+	assert.Equal(t, "weaviate/prod", sink.(*S3).prefix)
+
+	_, err = New(config.Metering{Sink: "ftp://usage"})
+	assert.NotNil(t, err)
+}
+
+func TestFile(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "usage")
+	sink := NewFile(dir)
+	day := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	require.Nil(t, sink.Export(context.Background(), testRecord(day)))
+	require.Nil(t, sink.Export(context.Background(), testRecord(day.Add(time.Hour))))
+	require.Nil(t, sink.Export(context.Background(), testRecord(day.Add(24*time.Hour))))
+
+	content, err := os.ReadFile(filepath.Join(dir, "usage-2023-05-01.jsonl"))
+	require.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Len(t, lines, 2)
+
+	var record metering.Record
+	require.Nil(t, json.Unmarshal([]byte(lines[1]), &record))
+	assert.Equal(t, testRecord(day.Add(time.Hour)), record)
+
+	_, err = os.Stat(filepath.Join(dir, "usage-2023-05-02.jsonl"))
+	assert.Nil(t, err)
+}
+
+func TestHTTP(t *testing.T) {
+	var received []metering.Record
+	status := http.StatusNoContent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		var record metering.Record
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&record))
+		received = append(received, record)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	sink := NewHTTP(server.URL)
+	record := testRecord(time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC))
+	require.Nil(t, sink.Export(context.Background(), record))
+	assert.Equal(t, []metering.Record{record}, received)
+
+	status = http.StatusServiceUnavailable
+	assert.NotNil(t, sink.Export(context.Background(), record))
+}
@@ -486,6 +486,9 @@ type BatchObjectsCreateBody struct {
 
 	// objects
 	Objects []*models.Object `json:"objects"`
+
+	// If true, objects without a vector keep the vector of the stored object with the same id unless one of their vectorized properties changed. Defaults to false.
+	Upsert *bool `json:"upsert,omitempty"`
 }
 
 // Validate validates this batch objects create body
@@ -45,6 +45,12 @@ type ClientService interface {
 
 	GraphqlPost(params *GraphqlPostParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GraphqlPostOK, error)
 
+	GraphqlReplayCancel(params *GraphqlReplayCancelParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GraphqlReplayCancelNoContent, error)
+
+	GraphqlReplayGet(params *GraphqlReplayGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GraphqlReplayGetOK, error)
+
+	GraphqlReplayStart(params *GraphqlReplayStartParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GraphqlReplayStartOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -130,6 +136,127 @@ func (a *Client) GraphqlPost(params *GraphqlPostParams, authInfo runtime.ClientA
 	panic(msg)
 }
 
+/*
+GraphqlReplayCancel cancels the current query replay
+
+Cancels the current query replay and waits for the queries in flight.
+*/
+func (a *Client) GraphqlReplayCancel(params *GraphqlReplayCancelParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GraphqlReplayCancelNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGraphqlReplayCancelParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "graphql.replay.cancel",
+		Method:             "DELETE",
+		PathPattern:        "/graphql/replay",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &GraphqlReplayCancelReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GraphqlReplayCancelNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for graphql.replay.cancel: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GraphqlReplayGet gets the report of the current or last query replay
+*/
+func (a *Client) GraphqlReplayGet(params *GraphqlReplayGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GraphqlReplayGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGraphqlReplayGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "graphql.replay.get",
+		Method:             "GET",
+		PathPattern:        "/graphql/replay",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &GraphqlReplayGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GraphqlReplayGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for graphql.replay.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GraphqlReplayStart replays GraphQL queries against a target
+
+Starts replaying the queries of the body or, if there are none, of the slow query log of this node against a target in the background. Only one replay runs at a time.
+*/
+func (a *Client) GraphqlReplayStart(params *GraphqlReplayStartParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GraphqlReplayStartOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGraphqlReplayStartParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "graphql.replay.start",
+		Method:             "POST",
+		PathPattern:        "/graphql/replay",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &GraphqlReplayStartReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GraphqlReplayStartOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for graphql.replay.start: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -41,11 +41,119 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	NodesCompactionGet(params *NodesCompactionGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesCompactionGetOK, error)
+
+	NodesCompactionUpdate(params *NodesCompactionUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesCompactionUpdateOK, error)
+
 	NodesGet(params *NodesGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesGetOK, error)
 
+	NodesMembersDelete(params *NodesMembersDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesMembersDeleteNoContent, error)
+
+	NodesMembersGet(params *NodesMembersGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesMembersGetOK, error)
+
+	NodesMembersJoin(params *NodesMembersJoinParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesMembersJoinOK, error)
+
+	NodesMembersLeave(params *NodesMembersLeaveParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesMembersLeaveNoContent, error)
+
+	NodesNetworkAccessGet(params *NodesNetworkAccessGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesNetworkAccessGetOK, error)
+
+	NodesNetworkAccessUpdate(params *NodesNetworkAccessUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesNetworkAccessUpdateOK, error)
+
+	NodesOffloadsGet(params *NodesOffloadsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesOffloadsGetOK, error)
+
+	NodesOffloadsUpdate(params *NodesOffloadsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesOffloadsUpdateOK, error)
+
+	NodesShardClonesCreate(params *NodesShardClonesCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesShardClonesCreateNoContent, error)
+
+	NodesVectorReindexGet(params *NodesVectorReindexGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesVectorReindexGetOK, error)
+
+	NodesVectorReindexStart(params *NodesVectorReindexStartParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesVectorReindexStartOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+NodesCompactionGet gets the compaction schedule of the node
+
+Returns the limits of the compactions of the node.
+*/
+func (a *Client) NodesCompactionGet(params *NodesCompactionGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesCompactionGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesCompactionGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.compaction.get",
+		Method:             "GET",
+		PathPattern:        "/nodes/compaction",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesCompactionGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesCompactionGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.compaction.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+NodesCompactionUpdate replaces the compaction schedule of the node
+
+Replaces the limits of the compactions of the node, running compactions are throttled by the new limits immediately. The schedule is not persisted, a restart falls back to the configured one.
+*/
+func (a *Client) NodesCompactionUpdate(params *NodesCompactionUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesCompactionUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesCompactionUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.compaction.update",
+		Method:             "PUT",
+		PathPattern:        "/nodes/compaction",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesCompactionUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesCompactionUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.compaction.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 NodesGet Returns status of Weaviate DB.
 */
@@ -85,6 +193,457 @@ func (a *Client) NodesGet(params *NodesGetParams, authInfo runtime.ClientAuthInf
 	panic(msg)
 }
 
+/*
+NodesMembersDelete removes a node from the cluster membership
+
+Removes a node which left or failed from the membership of all nodes, so that a node with a new identity can take over its name.
+*/
+func (a *Client) NodesMembersDelete(params *NodesMembersDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesMembersDeleteNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesMembersDeleteParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.members.delete",
+		Method:             "DELETE",
+		PathPattern:        "/nodes/members/{name}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesMembersDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesMembersDeleteNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.members.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+NodesMembersGet gets the cluster membership of the node
+
+Returns all nodes the node knows about, including nodes which left or failed.
+*/
+func (a *Client) NodesMembersGet(params *NodesMembersGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesMembersGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesMembersGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.members.get",
+		Method:             "GET",
+		PathPattern:        "/nodes/members",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesMembersGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesMembersGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.members.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+NodesMembersJoin joins a cluster
+
+Joins the cluster of the nodes with the given gossip addresses. A node which left can only join again after a restart.
+*/
+func (a *Client) NodesMembersJoin(params *NodesMembersJoinParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesMembersJoinOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesMembersJoinParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.members.join",
+		Method:             "POST",
+		PathPattern:        "/nodes/members/join",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesMembersJoinReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesMembersJoinOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.members.join: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+NodesMembersLeave leaves the cluster
+
+Leaves the cluster gracefully, other nodes can take over the name of the node afterwards.
+*/
+func (a *Client) NodesMembersLeave(params *NodesMembersLeaveParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesMembersLeaveNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesMembersLeaveParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.members.leave",
+		Method:             "POST",
+		PathPattern:        "/nodes/members/leave",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesMembersLeaveReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesMembersLeaveNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.members.leave: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+NodesNetworkAccessGet gets the network access rules of a listener
+
+Returns the CIDR-based allow and deny rules which are active on the listener.
+*/
+func (a *Client) NodesNetworkAccessGet(params *NodesNetworkAccessGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesNetworkAccessGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesNetworkAccessGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.networkAccess.get",
+		Method:             "GET",
+		PathPattern:        "/nodes/network-access/{listener}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesNetworkAccessGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesNetworkAccessGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.networkAccess.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+NodesNetworkAccessUpdate replaces the network access rules of a listener
+
+Replaces the rules of the listener on every node of the cluster and persists them, so that they outlive restarts. Rules which would deny the caller on the api listener, or any node of the cluster on the cluster listener, are refused.
+*/
+func (a *Client) NodesNetworkAccessUpdate(params *NodesNetworkAccessUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesNetworkAccessUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesNetworkAccessUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.networkAccess.update",
+		Method:             "PUT",
+		PathPattern:        "/nodes/network-access/{listener}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesNetworkAccessUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesNetworkAccessUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.networkAccess.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+NodesOffloadsGet gets the offloaded classes
+
+Returns the classes whose local shards are offloaded to object storage on this node.
+*/
+func (a *Client) NodesOffloadsGet(params *NodesOffloadsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesOffloadsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesOffloadsGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.offloads.get",
+		Method:             "GET",
+		PathPattern:        "/nodes/offloads",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesOffloadsGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesOffloadsGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.offloads.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+NodesOffloadsUpdate offloads or activate a class
+
+Offloads the local shards of a class to object storage or activates them again. Offloaded classes have no local files and are also activated on their next access.
+*/
+func (a *Client) NodesOffloadsUpdate(params *NodesOffloadsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesOffloadsUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesOffloadsUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.offloads.update",
+		Method:             "PUT",
+		PathPattern:        "/nodes/offloads",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesOffloadsUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesOffloadsUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.offloads.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+NodesShardClonesCreate clones a shard
+
+Clones a shard on this node into an empty shard of another class on this node, which has to be created beforehand. Writes to the shard which happen while it is cloned are not part of the clone.
+*/
+func (a *Client) NodesShardClonesCreate(params *NodesShardClonesCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesShardClonesCreateNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesShardClonesCreateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.shardClones.create",
+		Method:             "POST",
+		PathPattern:        "/nodes/shard-clones",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesShardClonesCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesShardClonesCreateNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.shardClones.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+NodesVectorReindexGet gets the progress of the rebuild of the vector indexes of a class
+
+Returns the progress of the last rebuild of the vector indexes of the shards of the class on this node.
+*/
+func (a *Client) NodesVectorReindexGet(params *NodesVectorReindexGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesVectorReindexGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesVectorReindexGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.vectorReindex.get",
+		Method:             "GET",
+		PathPattern:        "/nodes/vector-reindex/{className}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesVectorReindexGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesVectorReindexGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.vectorReindex.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+NodesVectorReindexStart rebuilds the vector indexes of a class
+
+Rebuilds the vector indexes of the shards of the class on this node in the background, from the stored objects and with the current vector index config of the class. Searches and writes are served by the current vector indexes until the rebuilt ones replace them.
+*/
+func (a *Client) NodesVectorReindexStart(params *NodesVectorReindexStartParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesVectorReindexStartOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesVectorReindexStartParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.vectorReindex.start",
+		Method:             "POST",
+		PathPattern:        "/nodes/vector-reindex/{className}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesVectorReindexStartReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesVectorReindexStartOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.vectorReindex.start: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -25,6 +25,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewObjectsClassGetParams creates a new ObjectsClassGetParams object,
@@ -101,6 +102,14 @@ type ObjectsClassGetParams struct {
 	*/
 	NodeName *string
 
+	/* Version.
+
+	   Return a retained version of the object instead of the current one, requires the versioningConfig of the class. Positive values are version numbers, the first write of an object is version 1. 0 is the latest version and negative values are relative to it, -1 is the version before the latest one
+
+	   Format: int64
+	*/
+	Version *int64
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -209,6 +218,17 @@ func (o *ObjectsClassGetParams) SetNodeName(nodeName *string) {
 	o.NodeName = nodeName
 }
 
+// WithVersion adds the version to the objects class get params
+func (o *ObjectsClassGetParams) WithVersion(version *int64) *ObjectsClassGetParams {
+	o.SetVersion(version)
+	return o
+}
+
+// SetVersion adds the version to the objects class get params
+func (o *ObjectsClassGetParams) SetVersion(version *int64) {
+	o.Version = version
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ObjectsClassGetParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -278,6 +298,23 @@ func (o *ObjectsClassGetParams) WriteToRequest(r runtime.ClientRequest, reg strf
 		}
 	}
 
+	if o.Version != nil {
+
+		// query param version
+		var qrVersion int64
+
+		if o.Version != nil {
+			qrVersion = *o.Version
+		}
+		qVersion := swag.FormatInt64(qrVersion)
+		if qVersion != "" {
+
+			if err := r.SetQueryParam("version", qVersion); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -49,14 +49,48 @@ type ClientService interface {
 
 	SchemaObjectsGet(params *SchemaObjectsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsGetOK, error)
 
+	SchemaObjectsHybridTuningFeedback(params *SchemaObjectsHybridTuningFeedbackParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsHybridTuningFeedbackNoContent, error)
+
+	SchemaObjectsHybridTuningGet(params *SchemaObjectsHybridTuningGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsHybridTuningGetOK, error)
+
+	SchemaObjectsInvertedCleanup(params *SchemaObjectsInvertedCleanupParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsInvertedCleanupOK, error)
+
+	SchemaObjectsMigrationsCreate(params *SchemaObjectsMigrationsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsMigrationsCreateOK, error)
+
+	SchemaObjectsMigrationsList(params *SchemaObjectsMigrationsListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsMigrationsListOK, error)
+
+	SchemaObjectsOptimize(params *SchemaObjectsOptimizeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsOptimizeOK, error)
+
 	SchemaObjectsPropertiesAdd(params *SchemaObjectsPropertiesAddParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesAddOK, error)
 
+	SchemaObjectsPropertiesTokenize(params *SchemaObjectsPropertiesTokenizeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesTokenizeOK, error)
+
+	SchemaObjectsReplay(params *SchemaObjectsReplayParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsReplayOK, error)
+
+	SchemaObjectsRevectorizeCancel(params *SchemaObjectsRevectorizeCancelParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsRevectorizeCancelNoContent, error)
+
+	SchemaObjectsRevectorizeGet(params *SchemaObjectsRevectorizeGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsRevectorizeGetOK, error)
+
+	SchemaObjectsRevectorizeStart(params *SchemaObjectsRevectorizeStartParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsRevectorizeStartAccepted, error)
+
+	SchemaObjectsShadowCompare(params *SchemaObjectsShadowCompareParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShadowCompareOK, error)
+
+	SchemaObjectsShadowDelete(params *SchemaObjectsShadowDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShadowDeleteNoContent, error)
+
+	SchemaObjectsShadowGet(params *SchemaObjectsShadowGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShadowGetOK, error)
+
+	SchemaObjectsShadowStart(params *SchemaObjectsShadowStartParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShadowStartOK, error)
+
 	SchemaObjectsShardsGet(params *SchemaObjectsShardsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsGetOK, error)
 
 	SchemaObjectsShardsUpdate(params *SchemaObjectsShardsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsUpdateOK, error)
 
 	SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsUpdateOK, error)
 
+	SchemaTrashList(params *SchemaTrashListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaTrashListOK, error)
+
+	SchemaTrashRestore(params *SchemaTrashRestoreParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaTrashRestoreOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -216,6 +250,252 @@ func (a *Client) SchemaObjectsGet(params *SchemaObjectsGetParams, authInfo runti
 	panic(msg)
 }
 
+/*
+SchemaObjectsHybridTuningFeedback reports the clicked results of a hybrid query
+
+Reports the results of a hybrid query of the class which were clicked or accepted. The query must have run on the same node since it started.
+*/
+func (a *Client) SchemaObjectsHybridTuningFeedback(params *SchemaObjectsHybridTuningFeedbackParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsHybridTuningFeedbackNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsHybridTuningFeedbackParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.hybridTuning.feedback",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/hybrid-tuning/feedback",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsHybridTuningFeedbackReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsHybridTuningFeedbackNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.hybridTuning.feedback: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsHybridTuningGet gets the suggested hybrid parameters of a class
+
+Suggests the alpha and BM25 parameters which would have placed the results highest which were reported as clicked for the hybrid queries of the class.
+*/
+func (a *Client) SchemaObjectsHybridTuningGet(params *SchemaObjectsHybridTuningGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsHybridTuningGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsHybridTuningGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.hybridTuning.get",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/hybrid-tuning",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsHybridTuningGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsHybridTuningGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.hybridTuning.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsInvertedCleanup cleans up the inverted index of all shards of a class
+
+Compacts the searchable buckets of the inverted index of every shard of the class which contain deleted postings. The postings of deleted objects are dropped. The sizes of the buckets before and after are reported per shard.
+*/
+func (a *Client) SchemaObjectsInvertedCleanup(params *SchemaObjectsInvertedCleanupParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsInvertedCleanupOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsInvertedCleanupParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.invertedCleanup",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/inverted-cleanup",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsInvertedCleanupReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsInvertedCleanupOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.invertedCleanup: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsMigrationsCreate starts a migration of a property of a class
+
+Renames a property or widens its data type without reimporting the data. The object payloads and the inverted index of every shard are rewritten in the background while writes continue, the writes of a shard only wait while the rewritten data is swapped in. The schema is changed once all shards have been rewritten. Poll the migrations of the class for the outcome.
+*/
+func (a *Client) SchemaObjectsMigrationsCreate(params *SchemaObjectsMigrationsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsMigrationsCreateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsMigrationsCreateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.migrations.create",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/migrations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsMigrationsCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsMigrationsCreateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.migrations.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsMigrationsList lists the migrations of a class
+
+Returns the migrations of the class which are running or have completed or failed, in the order they were started.
+*/
+func (a *Client) SchemaObjectsMigrationsList(params *SchemaObjectsMigrationsListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsMigrationsListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsMigrationsListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.migrations.list",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/migrations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsMigrationsListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsMigrationsListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.migrations.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsOptimize optimizes the storage of all shards of a class
+
+Forces a full compaction of the LSM buckets of every shard of the class, condenses the HNSW commit logs and cleans up tombstones. This is useful after large deletions to reclaim disk space. The reclaimed space is reported per shard.
+*/
+func (a *Client) SchemaObjectsOptimize(params *SchemaObjectsOptimizeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsOptimizeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsOptimizeParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.optimize",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/optimize",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsOptimizeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsOptimizeOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.optimize: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 SchemaObjectsPropertiesAdd adds a property to an object class
 */
@@ -256,22 +536,22 @@ func (a *Client) SchemaObjectsPropertiesAdd(params *SchemaObjectsPropertiesAddPa
 }
 
 /*
-SchemaObjectsShardsGet gets the shards status of an object class
+SchemaObjectsPropertiesTokenize Shows how a text is analyzed for the inverted index of a property and for BM25 queries on it.
 */
-func (a *Client) SchemaObjectsShardsGet(params *SchemaObjectsShardsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsGetOK, error) {
+func (a *Client) SchemaObjectsPropertiesTokenize(params *SchemaObjectsPropertiesTokenizeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesTokenizeOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewSchemaObjectsShardsGetParams()
+		params = NewSchemaObjectsPropertiesTokenizeParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "schema.objects.shards.get",
-		Method:             "GET",
-		PathPattern:        "/schema/{className}/shards",
+		ID:                 "schema.objects.properties.tokenize",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/properties/{propertyName}/tokenize",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
 		Schemes:            []string{"https"},
 		Params:             params,
-		Reader:             &SchemaObjectsShardsGetReader{formats: a.formats},
+		Reader:             &SchemaObjectsPropertiesTokenizeReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -284,33 +564,35 @@ func (a *Client) SchemaObjectsShardsGet(params *SchemaObjectsShardsGetParams, au
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*SchemaObjectsShardsGetOK)
+	success, ok := result.(*SchemaObjectsPropertiesTokenizeOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for schema.objects.shards.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for schema.objects.properties.tokenize: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-SchemaObjectsShardsUpdate Update shard status of an Object Class
+SchemaObjectsReplay replays recent writes to a class
+
+Replays the writes to the class within a time range from the write-ahead logs retained by its shards, see invertedIndexConfig.walRetentionSeconds. For every object the latest write within the range, either its latest version or its deletion, is applied to the target class, to a class of another cluster or returned. Writes are replayed in pages ordered by their time, pass the next cursor of a result as after to continue. Every page reads all retained logs of the class. Replaying into a target requires permission to read the restricted properties of the class, returned objects are redacted.
 */
-func (a *Client) SchemaObjectsShardsUpdate(params *SchemaObjectsShardsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsUpdateOK, error) {
+func (a *Client) SchemaObjectsReplay(params *SchemaObjectsReplayParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsReplayOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewSchemaObjectsShardsUpdateParams()
+		params = NewSchemaObjectsReplayParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "schema.objects.shards.update",
-		Method:             "PUT",
-		PathPattern:        "/schema/{className}/shards/{shardName}",
+		ID:                 "schema.objects.replay",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/replay",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
 		Schemes:            []string{"https"},
 		Params:             params,
-		Reader:             &SchemaObjectsShardsUpdateReader{formats: a.formats},
+		Reader:             &SchemaObjectsReplayReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -323,35 +605,35 @@ func (a *Client) SchemaObjectsShardsUpdate(params *SchemaObjectsShardsUpdatePara
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*SchemaObjectsShardsUpdateOK)
+	success, ok := result.(*SchemaObjectsReplayOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for schema.objects.shards.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for schema.objects.replay: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-SchemaObjectsUpdate updates settings of an existing schema class
+SchemaObjectsRevectorizeCancel cancels the re-vectorization of a class
 
-Use this endpoint to alter an existing class in the schema. Note that not all settings are mutable. If an error about immutable fields is returned and you still need to update this particular setting, you will have to delete the class (and the underlying data) and recreate. This endpoint cannot be used to modify properties. Instead use POST /v1/schema/{className}/properties. A typical use case for this endpoint is to update configuration, such as the vectorIndexConfig. Note that even in mutable sections, such as vectorIndexConfig, some fields may be immutable.
+Cancels the running re-vectorization of the class on this node. It can be resumed by starting it again.
 */
-func (a *Client) SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsUpdateOK, error) {
+func (a *Client) SchemaObjectsRevectorizeCancel(params *SchemaObjectsRevectorizeCancelParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsRevectorizeCancelNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewSchemaObjectsUpdateParams()
+		params = NewSchemaObjectsRevectorizeCancelParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "schema.objects.update",
-		Method:             "PUT",
-		PathPattern:        "/schema/{className}",
+		ID:                 "schema.objects.revectorize.cancel",
+		Method:             "DELETE",
+		PathPattern:        "/schema/{className}/revectorize",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
 		Schemes:            []string{"https"},
 		Params:             params,
-		Reader:             &SchemaObjectsUpdateReader{formats: a.formats},
+		Reader:             &SchemaObjectsRevectorizeCancelReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -364,13 +646,460 @@ func (a *Client) SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*SchemaObjectsUpdateOK)
+	success, ok := result.(*SchemaObjectsRevectorizeCancelNoContent)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for schema.objects.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for schema.objects.revectorize.cancel: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsRevectorizeGet gets the re-vectorization of a class
+
+Returns the state of the current or the last re-vectorization of the class on this node.
+*/
+func (a *Client) SchemaObjectsRevectorizeGet(params *SchemaObjectsRevectorizeGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsRevectorizeGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsRevectorizeGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.revectorize.get",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/revectorize",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsRevectorizeGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsRevectorizeGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.revectorize.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsRevectorizeStart re-vectorizes a class
+
+Re-embeds the objects of the class stored on this node in the background, e.g. after the settings of its vectorizer have been changed. A job which did not complete is resumed unless restart is set.
+*/
+func (a *Client) SchemaObjectsRevectorizeStart(params *SchemaObjectsRevectorizeStartParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsRevectorizeStartAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsRevectorizeStartParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.revectorize.start",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/revectorize",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsRevectorizeStartReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsRevectorizeStartAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.revectorize.start: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsShadowCompare compares a class to its shadow class
+
+Compares the nearest neighbors of objects in the class and in its shadow class.
+*/
+func (a *Client) SchemaObjectsShadowCompare(params *SchemaObjectsShadowCompareParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShadowCompareOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsShadowCompareParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.shadow.compare",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/shadow/compare",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsShadowCompareReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsShadowCompareOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.shadow.compare: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsShadowDelete stops mirroring the writes to a class
+
+Stops mirroring the writes to the class on this node, the shadow class is kept.
+*/
+func (a *Client) SchemaObjectsShadowDelete(params *SchemaObjectsShadowDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShadowDeleteNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsShadowDeleteParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.shadow.delete",
+		Method:             "DELETE",
+		PathPattern:        "/schema/{className}/shadow",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsShadowDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsShadowDeleteNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.shadow.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsShadowGet gets the shadow class of a class
+
+Returns the shadow class the writes to the class are mirrored to on this node.
+*/
+func (a *Client) SchemaObjectsShadowGet(params *SchemaObjectsShadowGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShadowGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsShadowGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.shadow.get",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/shadow",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsShadowGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsShadowGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.shadow.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsShadowStart mirrors the writes to a class to a shadow class
+
+Mirrors the writes to the class which are received by this node to the shadow class for the configured duration, so that the results of both can be compared. An existing shadow of the class is replaced.
+*/
+func (a *Client) SchemaObjectsShadowStart(params *SchemaObjectsShadowStartParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShadowStartOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsShadowStartParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.shadow.start",
+		Method:             "PUT",
+		PathPattern:        "/schema/{className}/shadow",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsShadowStartReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsShadowStartOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.shadow.start: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsShardsGet gets the shards status of an object class
+*/
+func (a *Client) SchemaObjectsShardsGet(params *SchemaObjectsShardsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsShardsGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.shards.get",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/shards",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsShardsGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsShardsGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.shards.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsShardsUpdate Update shard status of an Object Class
+*/
+func (a *Client) SchemaObjectsShardsUpdate(params *SchemaObjectsShardsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsShardsUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.shards.update",
+		Method:             "PUT",
+		PathPattern:        "/schema/{className}/shards/{shardName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsShardsUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsShardsUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.shards.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsUpdate updates settings of an existing schema class
+
+Use this endpoint to alter an existing class in the schema. Note that not all settings are mutable. If an error about immutable fields is returned and you still need to update this particular setting, you will have to delete the class (and the underlying data) and recreate. This endpoint cannot be used to modify properties. Instead use POST /v1/schema/{className}/properties. A typical use case for this endpoint is to update configuration, such as the vectorIndexConfig. Note that even in mutable sections, such as vectorIndexConfig, some fields may be immutable.
+*/
+func (a *Client) SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.update",
+		Method:             "PUT",
+		PathPattern:        "/schema/{className}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaTrashList lists the deleted classes in the recycle bin
+
+Lists the deleted classes which are kept in the recycle bin until their retention window expires. Only populated if RECYCLE_BIN_RETENTION_SECONDS is set.
+*/
+func (a *Client) SchemaTrashList(params *SchemaTrashListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaTrashListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaTrashListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.trash.list",
+		Method:             "GET",
+		PathPattern:        "/schema/trash",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaTrashListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaTrashListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.trash.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaTrashRestore restores a deleted class and its data from the recycle bin
+
+Restores a deleted class including its data from the recycle bin. The restore fails if a class with the same name exists.
+*/
+func (a *Client) SchemaTrashRestore(params *SchemaTrashRestoreParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaTrashRestoreOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaTrashRestoreParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.trash.restore",
+		Method:             "POST",
+		PathPattern:        "/schema/trash/{id}/restore",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaTrashRestoreReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaTrashRestoreOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.trash.restore: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
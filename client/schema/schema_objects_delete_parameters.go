@@ -79,6 +79,12 @@ type SchemaObjectsDeleteParams struct {
 	// Force.
 	Force *bool
 
+	/* OverrideProtection.
+
+	   Required to delete a class with deletion protection or to update a class whose configuration is frozen or whose protection is lowered
+	*/
+	OverrideProtection *bool
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -154,6 +160,17 @@ func (o *SchemaObjectsDeleteParams) SetForce(force *bool) {
 	o.Force = force
 }
 
+// WithOverrideProtection adds the overrideProtection to the schema objects delete params
+func (o *SchemaObjectsDeleteParams) WithOverrideProtection(overrideProtection *bool) *SchemaObjectsDeleteParams {
+	o.SetOverrideProtection(overrideProtection)
+	return o
+}
+
+// SetOverrideProtection adds the overrideProtection to the schema objects delete params
+func (o *SchemaObjectsDeleteParams) SetOverrideProtection(overrideProtection *bool) {
+	o.OverrideProtection = overrideProtection
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *SchemaObjectsDeleteParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -184,6 +201,23 @@ func (o *SchemaObjectsDeleteParams) WriteToRequest(r runtime.ClientRequest, reg
 		}
 	}
 
+	if o.OverrideProtection != nil {
+
+		// query param overrideProtection
+		var qrOverrideProtection bool
+
+		if o.OverrideProtection != nil {
+			qrOverrideProtection = *o.OverrideProtection
+		}
+		qOverrideProtection := swag.FormatBool(qrOverrideProtection)
+		if qOverrideProtection != "" {
+
+			if err := r.SetQueryParam("overrideProtection", qOverrideProtection); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
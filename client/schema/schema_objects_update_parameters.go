@@ -25,6 +25,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/weaviate/weaviate/entities/models"
 )
@@ -80,6 +81,12 @@ type SchemaObjectsUpdateParams struct {
 	// ObjectClass.
 	ObjectClass *models.Class
 
+	/* OverrideProtection.
+
+	   Required to delete a class with deletion protection or to update a class whose configuration is frozen or whose protection is lowered
+	*/
+	OverrideProtection *bool
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -155,6 +162,17 @@ func (o *SchemaObjectsUpdateParams) SetObjectClass(objectClass *models.Class) {
 	o.ObjectClass = objectClass
 }
 
+// WithOverrideProtection adds the overrideProtection to the schema objects update params
+func (o *SchemaObjectsUpdateParams) WithOverrideProtection(overrideProtection *bool) *SchemaObjectsUpdateParams {
+	o.SetOverrideProtection(overrideProtection)
+	return o
+}
+
+// SetOverrideProtection adds the overrideProtection to the schema objects update params
+func (o *SchemaObjectsUpdateParams) SetOverrideProtection(overrideProtection *bool) {
+	o.OverrideProtection = overrideProtection
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *SchemaObjectsUpdateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -173,6 +191,23 @@ func (o *SchemaObjectsUpdateParams) WriteToRequest(r runtime.ClientRequest, reg
 		}
 	}
 
+	if o.OverrideProtection != nil {
+
+		// query param overrideProtection
+		var qrOverrideProtection bool
+
+		if o.OverrideProtection != nil {
+			qrOverrideProtection = *o.OverrideProtection
+		}
+		qOverrideProtection := swag.FormatBool(qrOverrideProtection)
+		if qOverrideProtection != "" {
+
+			if err := r.SetQueryParam("overrideProtection", qOverrideProtection); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
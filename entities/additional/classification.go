@@ -33,6 +33,9 @@ type Properties struct {
 	Distance           bool                   `json:"distance"`
 	Score              bool                   `json:"score"`
 	ExplainScore       bool                   `json:"explainScore"`
+	DistanceMeters     bool                   `json:"distanceMeters"`
+	VectorCursor       bool                   `json:"vectorCursor"`
+	Routing            bool                   `json:"routing"`
 
 	// ReferenceQuery is used to indicate that a search
 	// is being conducted on behalf of a referenced
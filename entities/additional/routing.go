@@ -0,0 +1,25 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package additional
+
+// Routing tells clients where an object is stored, so that follow-up
+// requests can be sent to a node which holds it
+type Routing struct {
+	// Node served the object. It is the coordinator, unless the request was
+	// proxied to the node holding the shard.
+	Node  string `json:"node"`
+	Shard string `json:"shard"`
+	// Nodes hold a replica of the shard
+	Nodes []string `json:"nodes"`
+	// Proxied is true if the coordinator doesn't hold the shard
+	Proxied bool `json:"proxied"`
+}
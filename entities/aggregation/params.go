@@ -27,6 +27,7 @@ type Params struct {
 	IncludeMetaCount bool                 `json:"includeMetaCount"`
 	Limit            *int                 `json:"limit"`
 	ObjectLimit      *int                 `json:"objectLimit"`
+	Sample           *filters.Sample      `json:"sample"`
 	SearchVector     []float32
 	Certainty        float64
 	NearVector       *searchparams.NearVector
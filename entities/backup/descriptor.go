@@ -13,6 +13,9 @@ package backup
 
 import (
 	"fmt"
+	"io/fs"
+	"path"
+	"strings"
 	"time"
 )
 
@@ -33,6 +36,8 @@ type DistributedBackupDescriptor struct {
 	Version       string                     `json:"version"` //
 	ServerVersion string                     `json:"serverVersion"`
 	Error         string                     `json:"error"`
+	// BaseBackupID is the id of the backup an incremental backup builds on
+	BaseBackupID string `json:"baseBackupId,omitempty"`
 }
 
 // Len returns how many nodes exist in d
@@ -186,6 +191,65 @@ type ShardDescriptor struct {
 	PropLengthTracker     []byte `json:"propLengthTracker"`
 	ShardVersionPath      string `json:"shardVersionPath"`
 	Version               []byte `json:"version"`
+
+	// Generations identifies the contents of the LSM segment files of the
+	// shard. Segments are immutable, a compaction writes a new file, so a
+	// segment with the same generation as in an earlier backup is unchanged.
+	Generations map[string]string `json:"generations,omitempty"`
+	// BaseFiles maps the files which an incremental backup did not upload,
+	// because they were unchanged, to the id of the backup which holds them
+	BaseFiles map[string]string `json:"baseFiles,omitempty"`
+	// ChangesToken is the position in the changes feed of the shard at which
+	// the snapshot was taken. Writes from this position on might be missing
+	// from the files.
+	ChangesToken string `json:"changesToken,omitempty"`
+}
+
+// BaseFile returns the id of the backup holding file if the file is unchanged
+// since base was backed up by the backup baseID. It returns "" if the file
+// needs to be uploaded.
+func (d *ShardDescriptor) BaseFile(base *ShardDescriptor, baseID, file string) string {
+	gen, ok := d.Generations[file]
+	if !ok || base == nil || base.Generations[file] != gen {
+		return ""
+	}
+	if id, ok := base.BaseFiles[file]; ok {
+		return id
+	}
+	return baseID
+}
+
+// IsSegmentFile reports whether a file of a shard is an LSM segment or one of
+// its bloom filter and count net additions files. Write-ahead logs of
+// memtables aren't segments.
+func IsSegmentFile(file string) bool {
+	dir, base := path.Split(file)
+	return strings.Contains(dir, "_lsm/") && strings.HasPrefix(base, "segment-") &&
+		path.Ext(base) != ".wal"
+}
+
+// SegmentGeneration identifies the contents of a segment file by its size and
+// modification time
+func SegmentGeneration(info fs.FileInfo) string {
+	return fmt.Sprintf("%d-%d", info.Size(), info.ModTime().UnixNano())
+}
+
+// VectorIndexRebuildSuffix is appended to the id of a shard to name the file
+// which marks that its vector index was skipped by a restore. The vectors of
+// all objects of such a shard are queued when it is loaded.
+const VectorIndexRebuildSuffix = ".vectorindex.rebuild"
+
+// VectorIndexRebuildPath is the path of the rebuild marker of the shard
+// relative to the root path, like the paths of its files
+func (d *ShardDescriptor) VectorIndexRebuildPath() string {
+	return strings.TrimSuffix(d.DocIDCounterPath, ".indexcount") + VectorIndexRebuildSuffix
+}
+
+// IsVectorIndexFile reports whether a file of a shard belongs to its vector
+// index or to the queue of its vectors
+func IsVectorIndexFile(file string) bool {
+	base := strings.SplitN(file, "/", 2)[0]
+	return strings.Contains(base, ".hnsw.") || strings.Contains(base, ".indexqueue")
 }
 
 // ClassDescriptor contains everything needed to completely restore a class
@@ -207,6 +271,8 @@ type BackupDescriptor struct {
 	Version       string            `json:"version"` //
 	ServerVersion string            `json:"serverVersion"`
 	Error         string            `json:"error"`
+	// BaseBackupID is the id of the backup an incremental backup builds on
+	BaseBackupID string `json:"baseBackupId,omitempty"`
 }
 
 // List all existing classes in d
@@ -218,6 +284,22 @@ func (d *BackupDescriptor) List() []string {
 	return lst
 }
 
+// Shard returns the descriptor of a shard of a class or nil if d doesn't
+// contain it
+func (d *BackupDescriptor) Shard(class, shard string) *ShardDescriptor {
+	for i := range d.Classes {
+		if d.Classes[i].Name != class {
+			continue
+		}
+		for j := range d.Classes[i].Shards {
+			if d.Classes[i].Shards[j].Name == shard {
+				return &d.Classes[i].Shards[j]
+			}
+		}
+	}
+	return nil
+}
+
 // AllExist checks if all classes exist in d.
 // It returns either "" or the first class which it could not find
 func (d *BackupDescriptor) AllExist(classes []string) string {
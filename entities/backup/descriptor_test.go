@@ -441,3 +441,67 @@ func TestTestDistributedBackupResetStatus(t *testing.T) {
 	}
 	assert.Equal(t, want, desc)
 }
+
+func TestVectorIndexFiles(t *testing.T) {
+	for _, tc := range []struct {
+		file string
+		want bool
+	}{
+		{"cls_shard.hnsw.commitlog.d/1680000000", true},
+		{"cls_shard.indexqueue", true},
+		{"cls_shard.indexqueue.offset", true},
+		{"cls_shard.lsm/objects/segment-1.db", false},
+		{"cls_shard.lsm/property_hnsw.name/segment-1.db", false},
+	} {
+		assert.Equal(t, tc.want, IsVectorIndexFile(tc.file), tc.file)
+	}
+
+	d := ShardDescriptor{DocIDCounterPath: "cls_shard.indexcount"}
+	assert.Equal(t, "cls_shard.vectorindex.rebuild", d.VectorIndexRebuildPath())
+}
+
+func TestIncrementalBaseFiles(t *testing.T) {
+	for _, tc := range []struct {
+		file string
+		want bool
+	}{
+		{"cls_shard_lsm/objects/segment-1680000000.db", true},
+		{"cls_shard_lsm/objects/segment-1680000000.bloom", true},
+		{"cls_shard_lsm/property_name/segment-1680000000.cna", true},
+		{"cls_shard_lsm/objects/segment-1680000000.wal", false},
+		{"cls_shard.hnsw.commitlog.d/1680000000", false},
+		{"cls_shard.indexqueue", false},
+	} {
+		assert.Equal(t, tc.want, IsSegmentFile(tc.file), tc.file)
+	}
+
+	var (
+		unchanged = "cls_shard_lsm/objects/segment-1.db"
+		inherited = "cls_shard_lsm/objects/segment-2.db"
+		compacted = "cls_shard_lsm/objects/segment-3.db"
+		created   = "cls_shard_lsm/objects/segment-4.db"
+		commitLog = "cls_shard.hnsw.commitlog.d/1"
+	)
+	base := &ShardDescriptor{
+		Generations: map[string]string{unchanged: "10-1", inherited: "10-2", compacted: "10-3"},
+		BaseFiles:   map[string]string{inherited: "first"},
+	}
+	d := ShardDescriptor{
+		Generations: map[string]string{unchanged: "10-1", inherited: "10-2", compacted: "20-5", created: "10-4"},
+	}
+	assert.Equal(t, "base", d.BaseFile(base, "base", unchanged))
+	assert.Equal(t, "first", d.BaseFile(base, "base", inherited))
+	assert.Equal(t, "", d.BaseFile(base, "base", compacted))
+	assert.Equal(t, "", d.BaseFile(base, "base", created))
+	assert.Equal(t, "", d.BaseFile(base, "base", commitLog))
+	assert.Equal(t, "", d.BaseFile(nil, "", unchanged))
+
+	desc := BackupDescriptor{Classes: []ClassDescriptor{{
+		Name:   "Cls",
+		Shards: []ShardDescriptor{{Name: "S1"}, *base},
+	}}}
+	desc.Classes[0].Shards[1].Name = "S2"
+	assert.Equal(t, "10-3", desc.Shard("Cls", "S2").Generations[compacted])
+	assert.Nil(t, desc.Shard("Cls", "S3"))
+	assert.Nil(t, desc.Shard("Other", "S1"))
+}
@@ -0,0 +1,63 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package changes contains the types of the per-shard changes feed, which
+// allows downstream systems to sync incrementally instead of exporting all
+// objects.
+package changes
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ErrExpired is returned for tokens which are older than the retained
+// deletions, the consumer needs to do a full resync
+var ErrExpired = errors.New("changes token has expired, a full resync is required")
+
+var ErrInvalidToken = errors.New("invalid changes token")
+
+// Changes of a shard in the order in which they have been written. Objects
+// which have been changed several times are only contained in their latest
+// version.
+type Changes struct {
+	Objects   []*models.Object `json:"objects"`
+	Deletions []Deletion       `json:"deletions"`
+	// Next is the token for the subsequent request. If it is identical to the
+	// token of the request, the consumer has caught up.
+	Next string `json:"next"`
+}
+
+type Deletion struct {
+	ID        strfmt.UUID `json:"id"`
+	DeletedAt int64       `json:"deletedAt"`
+}
+
+// FormatToken encodes the position in the feed of a shard. Tokens are opaque
+// to consumers, an empty token starts at the beginning of the shard.
+func FormatToken(seq uint64) string {
+	return strconv.FormatUint(seq, 36)
+}
+
+func ParseToken(token string) (uint64, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	seq, err := strconv.ParseUint(token, 36, 64)
+	if err != nil {
+		return 0, ErrInvalidToken
+	}
+	return seq, nil
+}
@@ -38,6 +38,46 @@ func Class(c *models.Class) *models.Class {
 	if c.ReplicationConfig != nil {
 		replicationConf = &models.ReplicationConfig{Factor: c.ReplicationConfig.Factor}
 	}
+	var protectionConf *models.ProtectionConfig = nil
+	if c.ProtectionConfig != nil {
+		pc := *c.ProtectionConfig
+		protectionConf = &pc
+	}
+	var idGenerationConf *models.IDGenerationConfig = nil
+	if c.IDGenerationConfig != nil {
+		idGenerationConf = &models.IDGenerationConfig{
+			Strategy:   c.IDGenerationConfig.Strategy,
+			Properties: append([]string(nil), c.IDGenerationConfig.Properties...),
+		}
+	}
+	var chunkingConf *models.ChunkingConfig = nil
+	if c.ChunkingConfig != nil {
+		chunking := *c.ChunkingConfig
+		chunking.Properties = append([]string(nil), c.ChunkingConfig.Properties...)
+		chunkingConf = &chunking
+	}
+	var objectTTL *models.ObjectTTLConfig = nil
+	if c.ObjectTTL != nil {
+		ttl := *c.ObjectTTL
+		objectTTL = &ttl
+	}
+	var ingestConf *models.IngestConfig = nil
+	if c.IngestConfig != nil {
+		ingest := *c.IngestConfig
+		ingestConf = &ingest
+	}
+	var versioningConf *models.VersioningConfig = nil
+	if c.VersioningConfig != nil {
+		versioning := *c.VersioningConfig
+		versioningConf = &versioning
+	}
+	var vectorConfig map[string]models.VectorConfig = nil
+	if c.VectorConfig != nil {
+		vectorConfig = make(map[string]models.VectorConfig, len(c.VectorConfig))
+		for name, cfg := range c.VectorConfig {
+			vectorConfig[name] = cfg
+		}
+	}
 
 	return &models.Class{
 		Class:               c.Class,
@@ -46,7 +86,14 @@ func Class(c *models.Class) *models.Class {
 		ShardingConfig:      c.ShardingConfig,
 		VectorIndexConfig:   c.VectorIndexConfig,
 		VectorIndexType:     c.VectorIndexType,
+		VectorConfig:        vectorConfig,
 		ReplicationConfig:   replicationConf,
+		ProtectionConfig:    protectionConf,
+		IDGenerationConfig:  idGenerationConf,
+		ObjectTTL:           objectTTL,
+		ChunkingConfig:      chunkingConf,
+		IngestConfig:        ingestConf,
+		VersioningConfig:    versioningConf,
 		Vectorizer:          c.Vectorizer,
 		InvertedIndexConfig: InvertedIndexConfig(c.InvertedIndexConfig),
 		Properties:          properties,
@@ -60,13 +107,29 @@ func Prop(p *models.Property) *models.Property {
 		indexInverted = &b
 	}
 
+	var indexOnly *bool
+	if p.IndexOnly != nil {
+		b := *(p.IndexOnly)
+		indexOnly = &b
+	}
+
+	var snapshot *models.ReferenceSnapshot
+	if p.ReferenceSnapshot != nil {
+		s := *(p.ReferenceSnapshot)
+		snapshot = &s
+	}
+
 	return &models.Property{
-		DataType:      p.DataType,
-		Description:   p.Description,
-		ModuleConfig:  p.ModuleConfig,
-		Name:          p.Name,
-		Tokenization:  p.Tokenization,
-		IndexInverted: indexInverted,
+		DataType:             p.DataType,
+		Description:          p.Description,
+		ModuleConfig:         p.ModuleConfig,
+		Name:                 p.Name,
+		Tokenization:         p.Tokenization,
+		IndexInverted:        indexInverted,
+		IndexOnly:            indexOnly,
+		ReferenceSnapshot:    snapshot,
+		LanguageDetection:    p.LanguageDetection,
+		Bm25PruningThreshold: p.Bm25PruningThreshold,
 	}
 }
 
@@ -78,6 +141,12 @@ func InvertedIndexConfig(i *models.InvertedIndexConfig) *models.InvertedIndexCon
 	var bm25 *models.BM25Config = nil
 	if i.Bm25 != nil {
 		bm25 = &models.BM25Config{B: i.Bm25.B, K1: i.Bm25.K1}
+		if i.Bm25.PropertyBoosts != nil {
+			bm25.PropertyBoosts = make(map[string]float32, len(i.Bm25.PropertyBoosts))
+			for prop, boost := range i.Bm25.PropertyBoosts {
+				bm25.PropertyBoosts[prop] = boost
+			}
+		}
 	}
 
 	var stopwords *models.StopwordConfig = nil
@@ -92,5 +161,6 @@ func InvertedIndexConfig(i *models.InvertedIndexConfig) *models.InvertedIndexCon
 		IndexPropertyLength:    i.IndexPropertyLength,
 		IndexTimestamps:        i.IndexTimestamps,
 		Stopwords:              stopwords,
+		WalRetentionSeconds:    i.WalRetentionSeconds,
 	}
 }
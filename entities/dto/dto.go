@@ -28,12 +28,15 @@ type GetParams struct {
 	ClassName             string
 	Pagination            *filters.Pagination
 	Cursor                *filters.Cursor
+	VectorCursor          *filters.VectorCursor
+	Sample                *filters.Sample
 	Sort                  []filters.Sort
 	Properties            search.SelectProperties
 	NearVector            *searchparams.NearVector
 	NearObject            *searchparams.NearObject
 	KeywordRanking        *searchparams.KeywordRanking
 	HybridSearch          *searchparams.HybridSearch
+	FunctionScore         *searchparams.FunctionScore
 	SearchVector          []float32
 	Group                 *GroupParams
 	ModuleParams          map[string]interface{}
@@ -18,6 +18,7 @@ const (
 	InternalPropertyLength         = "_propertyLength"
 	InternalPropCreationTimeUnix   = "_creationTimeUnix"
 	InternalPropLastUpdateTimeUnix = "_lastUpdateTimeUnix"
+	InternalPropLanguage           = "_language"
 )
 
 // NotNullState is encoded as 0, so it can be read with the IsNull operator and value false.
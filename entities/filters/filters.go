@@ -33,6 +33,8 @@ const (
 	OperatorWithinGeoRange
 	OperatorLike
 	OperatorIsNull
+	OperatorWithinGeoPolygon
+	OperatorWithinGeoBox
 )
 
 func (o Operator) OnValue() bool {
@@ -45,7 +47,9 @@ func (o Operator) OnValue() bool {
 		OperatorLessThanEqual,
 		OperatorWithinGeoRange,
 		OperatorLike,
-		OperatorIsNull:
+		OperatorIsNull,
+		OperatorWithinGeoPolygon,
+		OperatorWithinGeoBox:
 		return true
 	default:
 		return false
@@ -78,6 +82,10 @@ func (o Operator) Name() string {
 		return "Like"
 	case OperatorIsNull:
 		return "IsNull"
+	case OperatorWithinGeoPolygon:
+		return "WithinGeoPolygon"
+	case OperatorWithinGeoBox:
+		return "WithinGeoBox"
 	default:
 		panic("Unknown operator")
 	}
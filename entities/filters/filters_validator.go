@@ -148,7 +148,8 @@ func IsInternalProperty(propName schema.PropertyName) bool {
 	case InternalPropBackwardsCompatID,
 		InternalPropID,
 		InternalPropCreationTimeUnix,
-		InternalPropLastUpdateTimeUnix:
+		InternalPropLastUpdateTimeUnix,
+		InternalPropLanguage:
 		return true
 	default:
 		return false
@@ -170,6 +171,13 @@ func validateInternalPropertyClause(propName schema.PropertyName, clause *Clause
 		}
 		return errors.Errorf(
 			`using ["%s"] to filter by timestamp: must use "valueString" or "valueDate"`, propName)
+	case InternalPropLanguage:
+		if clause.Value.Type == schema.DataTypeText ||
+			clause.Value.Type == schema.DataTypeString {
+			return nil
+		}
+		return errors.Errorf(
+			`using ["%s"] to filter by language: must use "valueText" or "valueString"`, propName)
 	default:
 		return errors.Errorf("unsupported internal property: %s", propName)
 	}
@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filters
+
+import (
+	"math"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// earthRadius in meters, the same as in the geo index
+const earthRadius = 6371e3
+
+// FindGeoRange returns the first WithinGeoRange clause of the filter which
+// applies to a property of the searched class itself. Clauses below a Not
+// or on properties of referenced objects are skipped.
+func FindGeoRange(filter *LocalFilter) (schema.PropertyName, GeoRange, bool) {
+	if filter == nil || filter.Root == nil {
+		return "", GeoRange{}, false
+	}
+	return findGeoRange(filter.Root)
+}
+
+func findGeoRange(clause *Clause) (schema.PropertyName, GeoRange, bool) {
+	switch clause.Operator {
+	case OperatorWithinGeoRange:
+		if clause.On == nil || clause.On.Child != nil || clause.Value == nil {
+			return "", GeoRange{}, false
+		}
+		geoRange, ok := clause.Value.Value.(GeoRange)
+		if !ok || geoRange.GeoCoordinates == nil ||
+			geoRange.Latitude == nil || geoRange.Longitude == nil {
+			return "", GeoRange{}, false
+		}
+		return clause.On.Property, geoRange, true
+	case OperatorAnd, OperatorOr:
+		for i := range clause.Operands {
+			if prop, geoRange, ok := findGeoRange(&clause.Operands[i]); ok {
+				return prop, geoRange, true
+			}
+		}
+	}
+	return "", GeoRange{}, false
+}
+
+// DistanceMeters is the great-circle distance between the point of the range
+// and the coordinates
+func (g GeoRange) DistanceMeters(c *models.GeoCoordinates) float64 {
+	latA := float64(*g.Latitude) * math.Pi / 180
+	latB := float64(*c.Latitude) * math.Pi / 180
+	deltaLat := float64(*c.Latitude-*g.Latitude) * math.Pi / 180
+	deltaLon := float64(*c.Longitude-*g.Longitude) * math.Pi / 180
+
+	a := math.Sin(deltaLat/2)*math.Sin(deltaLat/2) +
+		math.Cos(latA)*math.Cos(latB)*math.Sin(deltaLon/2)*math.Sin(deltaLon/2)
+	return earthRadius * 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
@@ -0,0 +1,164 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filters
+
+import (
+	"math"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// GeoShape is an area which can be searched with a geo index. Indexes which
+// only support ranges find the coordinates within the EnclosingRange of a
+// shape and keep the ones the shape Contains.
+type GeoShape interface {
+	// EnclosingRange returns a range which contains the whole shape
+	EnclosingRange() GeoRange
+	Contains(c *models.GeoCoordinates) bool
+}
+
+// GeoPolygon to be used with fields of type GeoCoordinates. The last point
+// is connected to the first one. Edges are straight lines in the
+// latitude/longitude plane, so polygons must not cross the antimeridian.
+type GeoPolygon struct {
+	Points []models.GeoCoordinates `json:"points"`
+}
+
+// GeoBox to be used with fields of type GeoCoordinates. The box crosses the
+// antimeridian if the longitude of TopLeft is greater than the one of
+// BottomRight.
+type GeoBox struct {
+	TopLeft     models.GeoCoordinates `json:"topLeft"`
+	BottomRight models.GeoCoordinates `json:"bottomRight"`
+}
+
+// Contains uses the even-odd rule, points on an edge may or may not be
+// contained
+func (p GeoPolygon) Contains(c *models.GeoCoordinates) bool {
+	if c == nil || c.Latitude == nil || c.Longitude == nil {
+		return false
+	}
+	lat, lon := float64(*c.Latitude), float64(*c.Longitude)
+
+	inside := false
+	for i, j := 0, len(p.Points)-1; i < len(p.Points); j, i = i, i+1 {
+		latI, lonI := float64(*p.Points[i].Latitude), float64(*p.Points[i].Longitude)
+		latJ, lonJ := float64(*p.Points[j].Latitude), float64(*p.Points[j].Longitude)
+		if (latI > lat) != (latJ > lat) &&
+			lon < (lonJ-lonI)*(lat-latI)/(latJ-latI)+lonI {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+func (p GeoPolygon) EnclosingRange() GeoRange {
+	var lat, lon float64
+	for _, point := range p.Points {
+		lat += float64(*point.Latitude)
+		lon += float64(*point.Longitude)
+	}
+	n := float64(len(p.Points))
+	center := geoCoordinates(lat/n, lon/n)
+
+	var edges [][2]models.GeoCoordinates
+	for i := range p.Points {
+		edges = append(edges, [2]models.GeoCoordinates{
+			p.Points[i], p.Points[(i+1)%len(p.Points)],
+		})
+	}
+	return enclosingRange(center, edges)
+}
+
+func (b GeoBox) Contains(c *models.GeoCoordinates) bool {
+	if c == nil || c.Latitude == nil || c.Longitude == nil {
+		return false
+	}
+	if *c.Latitude > *b.TopLeft.Latitude || *c.Latitude < *b.BottomRight.Latitude {
+		return false
+	}
+	left, right := *b.TopLeft.Longitude, *b.BottomRight.Longitude
+	if left <= right {
+		return *c.Longitude >= left && *c.Longitude <= right
+	}
+	// crosses the antimeridian
+	return *c.Longitude >= left || *c.Longitude <= right
+}
+
+func (b GeoBox) EnclosingRange() GeoRange {
+	top, bottom := float64(*b.TopLeft.Latitude), float64(*b.BottomRight.Latitude)
+	left, right := float64(*b.TopLeft.Longitude), float64(*b.BottomRight.Longitude)
+	if left > right {
+		right += 360
+	}
+	center := geoCoordinates((top+bottom)/2, normalizeLongitude((left+right)/2))
+
+	corners := []models.GeoCoordinates{
+		*geoCoordinates(top, left), *geoCoordinates(top, normalizeLongitude(right)),
+		*geoCoordinates(bottom, normalizeLongitude(right)), *geoCoordinates(bottom, left),
+	}
+	var edges [][2]models.GeoCoordinates
+	for i := range corners {
+		edges = append(edges, [2]models.GeoCoordinates{corners[i], corners[(i+1)%4]})
+	}
+	return enclosingRange(center, edges)
+}
+
+// enclosingSamples is the number of points per edge which are checked for
+// their distance from the center of a shape
+const enclosingSamples = 16
+
+// enclosingRange returns the range around center which contains the edges.
+// The edges are sampled, which is why the distance is increased by a margin.
+func enclosingRange(center *models.GeoCoordinates,
+	edges [][2]models.GeoCoordinates,
+) GeoRange {
+	out := GeoRange{GeoCoordinates: center}
+	var max float64
+	for _, edge := range edges {
+		latA, lonA := float64(*edge[0].Latitude), float64(*edge[0].Longitude)
+		latB, lonB := float64(*edge[1].Latitude), float64(*edge[1].Longitude)
+		if math.Abs(lonB-lonA) > 180 {
+			// the edge of a box which crosses the antimeridian
+			if lonB < lonA {
+				lonB += 360
+			} else {
+				lonA += 360
+			}
+		}
+		for i := 0; i <= enclosingSamples; i++ {
+			t := float64(i) / enclosingSamples
+			point := geoCoordinates(latA+(latB-latA)*t,
+				normalizeLongitude(lonA+(lonB-lonA)*t))
+			if d := out.DistanceMeters(point); d > max {
+				max = d
+			}
+		}
+	}
+	out.Distance = float32(max*1.01 + 1)
+	return out
+}
+
+func geoCoordinates(lat, lon float64) *models.GeoCoordinates {
+	latitude, longitude := float32(lat), float32(lon)
+	return &models.GeoCoordinates{Latitude: &latitude, Longitude: &longitude}
+}
+
+func normalizeLongitude(lon float64) float64 {
+	for lon > 180 {
+		lon -= 360
+	}
+	for lon < -180 {
+		lon += 360
+	}
+	return lon
+}
@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestGeoPolygon(t *testing.T) {
+	// an L-shaped polygon to cover a concave shape
+	polygon := GeoPolygon{Points: []models.GeoCoordinates{
+		*geoCoordinates(0, 0),
+		*geoCoordinates(0, 2),
+		*geoCoordinates(1, 2),
+		*geoCoordinates(1, 1),
+		*geoCoordinates(2, 1),
+		*geoCoordinates(2, 0),
+	}}
+
+	t.Run("contains", func(t *testing.T) {
+		assert.True(t, polygon.Contains(geoCoordinates(0.5, 0.5)))
+		assert.True(t, polygon.Contains(geoCoordinates(0.5, 1.5)))
+		assert.True(t, polygon.Contains(geoCoordinates(1.5, 0.5)))
+		assert.False(t, polygon.Contains(geoCoordinates(1.5, 1.5)))
+		assert.False(t, polygon.Contains(geoCoordinates(-0.5, 0.5)))
+		assert.False(t, polygon.Contains(geoCoordinates(0.5, 2.5)))
+		assert.False(t, polygon.Contains(&models.GeoCoordinates{}))
+	})
+
+	t.Run("enclosing range", func(t *testing.T) {
+		r := polygon.EnclosingRange()
+		for _, point := range polygon.Points {
+			point := point
+			assert.LessOrEqual(t, r.DistanceMeters(&point), float64(r.Distance))
+		}
+	})
+}
+
+func TestGeoBox(t *testing.T) {
+	t.Run("contains", func(t *testing.T) {
+		box := GeoBox{
+			TopLeft:     *geoCoordinates(50, 10),
+			BottomRight: *geoCoordinates(45, 15),
+		}
+		assert.True(t, box.Contains(geoCoordinates(48, 11)))
+		assert.False(t, box.Contains(geoCoordinates(51, 11)))
+		assert.False(t, box.Contains(geoCoordinates(48, 9)))
+		assert.False(t, box.Contains(geoCoordinates(48, 16)))
+	})
+
+	t.Run("contains crossing the antimeridian", func(t *testing.T) {
+		box := GeoBox{
+			TopLeft:     *geoCoordinates(10, 170),
+			BottomRight: *geoCoordinates(-10, -170),
+		}
+		assert.True(t, box.Contains(geoCoordinates(0, 175)))
+		assert.True(t, box.Contains(geoCoordinates(0, -175)))
+		assert.False(t, box.Contains(geoCoordinates(0, 0)))
+		assert.False(t, box.Contains(geoCoordinates(20, 175)))
+	})
+
+	t.Run("enclosing range crossing the antimeridian", func(t *testing.T) {
+		box := GeoBox{
+			TopLeft:     *geoCoordinates(10, 170),
+			BottomRight: *geoCoordinates(-10, -170),
+		}
+		r := box.EnclosingRange()
+		assert.InDelta(t, 180, abs(*r.GeoCoordinates.Longitude), 0.001)
+		for _, corner := range []*models.GeoCoordinates{
+			geoCoordinates(10, 170), geoCoordinates(10, -170),
+			geoCoordinates(-10, 170), geoCoordinates(-10, -170),
+		} {
+			assert.LessOrEqual(t, r.DistanceMeters(corner), float64(r.Distance))
+		}
+		// the range must not span the whole globe
+		assert.Less(t, r.Distance, float32(3000*1000))
+	})
+}
+
+func abs(in float32) float32 {
+	if in < 0 {
+		return -in
+	}
+	return in
+}
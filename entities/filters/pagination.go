@@ -11,6 +11,12 @@
 
 package filters
 
+import "math"
+
+// DistanceFlagNotSet is the target distance of a vector search which is only
+// limited by the number of results, it is larger than any actual distance
+const DistanceFlagNotSet float32 = math.MaxFloat32
+
 const (
 	// LimitFlagSearchByDist indicates that the
 	// vector search should be conducted by
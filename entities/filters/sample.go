@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filters
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+)
+
+// Sample asks for a uniform random sample of Size objects out of the objects
+// matching the filters. Every object has a pseudo-random priority which is
+// derived from the seed, the sample are the objects with the lowest
+// priorities. As the priority of an object doesn't depend on any other
+// object, every shard only has to keep its Size lowest priorities and the
+// samples of the shards can be merged by keeping the lowest overall.
+type Sample struct {
+	Size int   `json:"size"`
+	Seed int64 `json:"seed"`
+	// MaxPriority restricts the sample to the objects with a priority of at
+	// most MaxPriority. It's set once the priority of the last object of the
+	// sample of the whole class is known, so that a shard can select its part
+	// of the sample on its own, e.g. to aggregate over it.
+	MaxPriority *uint64 `json:"maxPriority,omitempty"`
+}
+
+// ForShard returns the sample with a seed for the shard. Doc ids are only
+// unique within a shard, the same doc ids of different shards must not have
+// the same priorities.
+func (s Sample) ForShard(name string) Sample {
+	h := fnv.New64a()
+	h.Write([]byte(name))
+	s.Seed = int64(mix64(uint64(s.Seed)) ^ h.Sum64())
+	return s
+}
+
+// Priority of the object with the doc id in the sample. The same seed leads
+// to the same sample as long as the objects of the shard don't change.
+func (s Sample) Priority(docID uint64) uint64 {
+	return mix64(docID ^ mix64(uint64(s.Seed)))
+}
+
+// mix64 is the splitmix64 finalizer, it spreads consecutive inputs uniformly
+func mix64(z uint64) uint64 {
+	z += 0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+// Includes returns whether an object with the priority can be part of the
+// sample with regards to MaxPriority
+func (s Sample) Includes(priority uint64) bool {
+	return s.MaxPriority == nil || priority <= *s.MaxPriority
+}
+
+// ExtractSampleFromArgs gets the sample key out of a map. Not specific to
+// GQL, but can be used from GQL. A random seed is picked if none is set.
+func ExtractSampleFromArgs(args map[string]interface{}) (*Sample, error) {
+	raw, ok := args["sample"]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+
+	in, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("sample must be an object, instead got: %#v", raw)
+	}
+
+	size, ok := in["size"].(int)
+	if !ok {
+		return nil, fmt.Errorf("sample size must be an int, instead got: %#v", in["size"])
+	}
+	if size <= 0 {
+		return nil, fmt.Errorf("sample size must be a positive integer")
+	}
+
+	sample := &Sample{Size: size}
+	if seed, ok := in["seed"]; ok && seed != nil {
+		asInt, ok := seed.(int)
+		if !ok {
+			return nil, fmt.Errorf("sample seed must be an int, instead got: %#v", seed)
+		}
+		sample.Seed = int64(asInt)
+	} else {
+		sample.Seed = rand.Int63()
+	}
+
+	return sample, nil
+}
@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSample(t *testing.T) {
+	t.Run("priorities depend on the seed", func(t *testing.T) {
+		a, b := Sample{Seed: 1}, Sample{Seed: 2}
+		assert.Equal(t, a.Priority(7), a.Priority(7))
+		assert.NotEqual(t, a.Priority(7), b.Priority(7))
+		assert.NotEqual(t, a.Priority(7), a.Priority(8))
+	})
+
+	t.Run("priorities depend on the shard", func(t *testing.T) {
+		s := Sample{Size: 10, Seed: 1}
+		assert.Equal(t, s.ForShard("a").Priority(7), s.ForShard("a").Priority(7))
+		assert.NotEqual(t, s.ForShard("a").Priority(7), s.ForShard("b").Priority(7))
+		assert.Equal(t, 10, s.ForShard("a").Size)
+	})
+
+	t.Run("max priority", func(t *testing.T) {
+		max := uint64(10)
+		assert.True(t, Sample{}.Includes(11))
+		assert.True(t, Sample{MaxPriority: &max}.Includes(10))
+		assert.False(t, Sample{MaxPriority: &max}.Includes(11))
+	})
+
+	t.Run("extract from args", func(t *testing.T) {
+		sample, err := ExtractSampleFromArgs(map[string]interface{}{})
+		require.Nil(t, err)
+		assert.Nil(t, sample)
+
+		sample, err = ExtractSampleFromArgs(map[string]interface{}{
+			"sample": map[string]interface{}{"size": 10, "seed": 42},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, &Sample{Size: 10, Seed: 42}, sample)
+
+		sample, err = ExtractSampleFromArgs(map[string]interface{}{
+			"sample": map[string]interface{}{"size": 10},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, 10, sample.Size)
+
+		_, err = ExtractSampleFromArgs(map[string]interface{}{
+			"sample": map[string]interface{}{"size": 0},
+		})
+		assert.NotNil(t, err)
+	})
+}
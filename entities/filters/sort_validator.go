@@ -75,6 +75,11 @@ func validateSortClause(sch schema.Schema, className schema.ClassName, sort Sort
 			return errors.Errorf("sorting by reference not supported, "+
 				"property %q is a ref prop to the class %q", propName, prop.DataType[0])
 		}
+
+		if schema.PropertyIsIndexOnly(prop) {
+			return errors.Errorf("sorting by index-only property %q not supported, "+
+				"its values are not stored", propName)
+		}
 		return nil
 	default:
 		return errors.New("sorting by reference not supported, " +
@@ -20,6 +20,7 @@ import (
 )
 
 func TestSortValidation(t *testing.T) {
+	indexOnly := true
 	tests := []struct {
 		name  string
 		prop  string
@@ -50,6 +51,11 @@ func TestSortValidation(t *testing.T) {
 			valid: false,
 			prop:  "my_idz",
 		},
+		{
+			name:  "index-only prop",
+			valid: false,
+			prop:  "vin",
+		},
 	}
 
 	for _, tt := range tests {
@@ -64,6 +70,7 @@ func TestSortValidation(t *testing.T) {
 							{Name: "horsepower", DataType: []string{"int"}},
 							{Name: "my_id", DataType: []string{"uuid"}},
 							{Name: "my_idz", DataType: []string{"uuid[]"}},
+							{Name: "vin", DataType: []string{"string"}, IndexOnly: &indexOnly},
 						},
 					},
 				},
@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filters
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// VectorCursor is the position of a paginated vector search in the results of
+// every shard. It's handed to the user as an opaque string. The next page
+// only contains results after the positions, so the shards don't have to
+// return, and the index doesn't have to merge, the results of all previous
+// pages again as with an offset.
+type VectorCursor struct {
+	Shards map[string]ShardVectorCursor `json:"s"`
+}
+
+// ShardVectorCursor is the last result of a shard which was part of a page.
+// The results are ordered by distance and by id if the distances are equal.
+type ShardVectorCursor struct {
+	Dist float32     `json:"d"`
+	ID   strfmt.UUID `json:"i"`
+	// Count is the number of results of the shard up to the position
+	Count int `json:"n"`
+}
+
+// After returns whether a result with the distance and id comes after the
+// position
+func (c ShardVectorCursor) After(dist float32, id strfmt.UUID) bool {
+	if dist != c.Dist {
+		return dist > c.Dist
+	}
+	return id > c.ID
+}
+
+// Shard returns the position in the results of a shard, it's false if no
+// result of the shard has been part of a page yet
+func (c *VectorCursor) Shard(name string) (ShardVectorCursor, bool) {
+	if c == nil {
+		return ShardVectorCursor{}, false
+	}
+	shard, ok := c.Shards[name]
+	return shard, ok
+}
+
+// Advance returns a copy of the cursor whose position in the shard is the
+// result with the distance and id
+func (c *VectorCursor) Advance(shard string, dist float32, id strfmt.UUID) *VectorCursor {
+	next := &VectorCursor{Shards: map[string]ShardVectorCursor{}}
+	if c != nil {
+		for name, pos := range c.Shards {
+			next.Shards[name] = pos
+		}
+	}
+	count := next.Shards[shard].Count
+	next.Shards[shard] = ShardVectorCursor{Dist: dist, ID: id, Count: count + 1}
+	return next
+}
+
+// Encode the cursor as the opaque string which is handed to the user
+func (c *VectorCursor) Encode() (string, error) {
+	raw, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("encode vector cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// ParseVectorCursor parses a cursor which has been created with Encode
+func ParseVectorCursor(in string) (*VectorCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(in)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vector cursor: %w", err)
+	}
+	var c VectorCursor
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return nil, fmt.Errorf("invalid vector cursor: %w", err)
+	}
+	return &c, nil
+}
+
+type shardVectorCursorKey struct{}
+
+// NewShardVectorCursorContext returns a context for the search of a shard
+// for a page of a vector cursor. A remote shard receives the position with
+// the search, so that it skips the results before it as a local shard does.
+func NewShardVectorCursorContext(ctx context.Context, pos *ShardVectorCursor) context.Context {
+	if pos == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, shardVectorCursorKey{}, pos)
+}
+
+// ShardVectorCursorFromContext returns the position of the shard in the
+// vector cursor of the search, or nil if it doesn't continue a cursor
+func ShardVectorCursorFromContext(ctx context.Context) *ShardVectorCursor {
+	pos, _ := ctx.Value(shardVectorCursorKey{}).(*ShardVectorCursor)
+	return pos
+}
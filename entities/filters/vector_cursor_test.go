@@ -0,0 +1,75 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorCursor(t *testing.T) {
+	t.Run("order of the results", func(t *testing.T) {
+		pos := ShardVectorCursor{Dist: 0.5, ID: "b"}
+		assert.True(t, pos.After(0.6, "a"))
+		assert.True(t, pos.After(0.5, "c"))
+		assert.False(t, pos.After(0.5, "b"))
+		assert.False(t, pos.After(0.5, "a"))
+		assert.False(t, pos.After(0.4, "c"))
+	})
+
+	t.Run("advance", func(t *testing.T) {
+		var c *VectorCursor
+		_, ok := c.Shard("shard1")
+		assert.False(t, ok)
+
+		first := c.Advance("shard1", 0.1, "a")
+		second := first.Advance("shard2", 0.2, "b")
+		third := second.Advance("shard1", 0.3, "c")
+
+		assert.Len(t, first.Shards, 1)
+		assert.Len(t, second.Shards, 2)
+		pos, ok := third.Shard("shard1")
+		require.True(t, ok)
+		assert.Equal(t, ShardVectorCursor{Dist: 0.3, ID: "c", Count: 2}, pos)
+		pos, ok = third.Shard("shard2")
+		require.True(t, ok)
+		assert.Equal(t, ShardVectorCursor{Dist: 0.2, ID: "b", Count: 1}, pos)
+	})
+
+	t.Run("encode and parse", func(t *testing.T) {
+		c := (&VectorCursor{}).Advance("shard1", 0.123456789, "8a9d1b5e-62e4-4e5b-9a9e-0a4e0f3d9b71")
+		encoded, err := c.Encode()
+		require.Nil(t, err)
+
+		parsed, err := ParseVectorCursor(encoded)
+		require.Nil(t, err)
+		assert.Equal(t, c, parsed)
+	})
+
+	t.Run("parse invalid cursor", func(t *testing.T) {
+		_, err := ParseVectorCursor("not a cursor")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("shard position in the context", func(t *testing.T) {
+		assert.Nil(t, ShardVectorCursorFromContext(context.Background()))
+		assert.Equal(t, context.Background(),
+			NewShardVectorCursorContext(context.Background(), nil))
+
+		pos := &ShardVectorCursor{Dist: 0.5, ID: "b", Count: 3}
+		ctx := NewShardVectorCursorContext(context.Background(), pos)
+		assert.Equal(t, pos, ShardVectorCursorFromContext(ctx))
+	})
+}
@@ -0,0 +1,461 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package functionscore implements the expressions which re-rank search
+// results, e.g. "_score * log1p(popularity) * recency(publishedAt, 86400)".
+//
+// Expressions are arithmetic over numbers, variables and a fixed set of
+// functions. They have no loops or side effects and their size is bounded,
+// so they can't be used to exhaust the resources of a node.
+package functionscore
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// MaxLength is the maximum length of an expression in bytes
+	MaxLength = 1024
+	// maxDepth is the maximum nesting of an expression
+	maxDepth = 32
+)
+
+// Variables which are not properties of the objects. All times are in
+// seconds since the unix epoch. The geo distance is the distance in meters
+// to the point of the geo range filter of the query.
+const (
+	VarScore          = "_score"
+	VarDistance       = "_distance"
+	VarGeoDistance    = "_geoDistance"
+	VarCreationTime   = "_creationTime"
+	VarLastUpdateTime = "_lastUpdateTime"
+)
+
+// functions by their number of arguments
+var functions = map[string]int{
+	"abs":     1,
+	"sqrt":    1,
+	"log":     1,
+	"log1p":   1,
+	"min":     2,
+	"max":     2,
+	"pow":     2,
+	"recency": 2,
+	"decay":   2,
+}
+
+// Input are the values of the variables for a single result
+type Input struct {
+	Properties map[string]interface{}
+	// Score is the BM25 or hybrid score of the result
+	Score float64
+	// Distance is the vector distance of the result
+	Distance float64
+	// GeoDistance is the distance in meters to the point of the geo filter
+	GeoDistance        float64
+	CreationTimeUnix   int64 // milliseconds
+	LastUpdateTimeUnix int64 // milliseconds
+	// Now is the reference time of recency, it is the same for all results
+	Now time.Time
+}
+
+// Expression is a parsed expression, it is safe for concurrent use
+type Expression struct {
+	src  string
+	root node
+}
+
+// Parse parses an expression
+func Parse(src string) (*Expression, error) {
+	if len(src) > MaxLength {
+		return nil, fmt.Errorf("function score: expression is longer than %d bytes", MaxLength)
+	}
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, fmt.Errorf("function score: %w", err)
+	}
+
+	p := &parser{tokens: tokens}
+	root, err := p.expr(0)
+	if err != nil {
+		return nil, fmt.Errorf("function score: %w", err)
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("function score: unexpected %q at position %d",
+			p.tokens[p.pos].text, p.tokens[p.pos].pos)
+	}
+
+	return &Expression{src: src, root: root}, nil
+}
+
+func (e *Expression) String() string {
+	return e.src
+}
+
+// Properties returns the names of the properties the expression reads
+func (e *Expression) Properties() []string {
+	var props []string
+	var walk func(n node)
+	walk = func(n node) {
+		switch v := n.(type) {
+		case variable:
+			if !strings.HasPrefix(string(v), "_") {
+				props = append(props, string(v))
+			}
+		case unary:
+			walk(v.x)
+		case binary:
+			walk(v.l)
+			walk(v.r)
+		case call:
+			for _, arg := range v.args {
+				walk(arg)
+			}
+		}
+	}
+	walk(e.root)
+	return props
+}
+
+// Eval evaluates the expression. Undefined results, such as divisions by
+// zero, are 0. Variables of missing or non-numeric properties are 0 as well.
+func (e *Expression) Eval(in *Input) float64 {
+	return finite(e.root.eval(in))
+}
+
+func finite(v float64) float64 {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0
+	}
+	return v
+}
+
+type node interface {
+	eval(in *Input) float64
+}
+
+type number float64
+
+func (n number) eval(*Input) float64 { return float64(n) }
+
+type variable string
+
+func (v variable) eval(in *Input) float64 {
+	switch string(v) {
+	case VarScore:
+		return in.Score
+	case VarDistance:
+		return in.Distance
+	case VarGeoDistance:
+		return in.GeoDistance
+	case VarCreationTime:
+		return float64(in.CreationTimeUnix) / 1000
+	case VarLastUpdateTime:
+		return float64(in.LastUpdateTimeUnix) / 1000
+	}
+	return propertyValue(in.Properties[string(v)])
+}
+
+func propertyValue(v interface{}) float64 {
+	switch val := v.(type) {
+	case float64:
+		return val
+	case float32:
+		return float64(val)
+	case int64:
+		return float64(val)
+	case int:
+		return float64(val)
+	case json.Number:
+		f, _ := val.Float64()
+		return f
+	case bool:
+		if val {
+			return 1
+		}
+		return 0
+	case time.Time:
+		return float64(val.UnixNano()) / 1e9
+	case string:
+		// dates are stored as strings
+		if t, err := time.Parse(time.RFC3339Nano, val); err == nil {
+			return float64(t.UnixNano()) / 1e9
+		}
+	}
+	return 0
+}
+
+type unary struct {
+	x node
+}
+
+func (u unary) eval(in *Input) float64 { return -u.x.eval(in) }
+
+type binary struct {
+	op   byte
+	l, r node
+}
+
+func (b binary) eval(in *Input) float64 {
+	l, r := b.l.eval(in), b.r.eval(in)
+	switch b.op {
+	case '+':
+		return l + r
+	case '-':
+		return l - r
+	case '*':
+		return l * r
+	default:
+		if r == 0 {
+			return 0
+		}
+		return l / r
+	}
+}
+
+type call struct {
+	fn   string
+	args []node
+}
+
+func (c call) eval(in *Input) float64 {
+	x := c.args[0].eval(in)
+	switch c.fn {
+	case "abs":
+		return math.Abs(x)
+	case "sqrt":
+		return math.Sqrt(math.Max(x, 0))
+	case "log":
+		if x <= 0 {
+			return 0
+		}
+		return math.Log(x)
+	case "log1p":
+		return math.Log1p(math.Max(x, 0))
+	}
+
+	y := c.args[1].eval(in)
+	switch c.fn {
+	case "min":
+		return math.Min(x, y)
+	case "max":
+		return math.Max(x, y)
+	case "pow":
+		return finite(math.Pow(x, y))
+	case "decay":
+		return decay(x, y)
+	default: // recency
+		return decay(float64(in.Now.UnixNano())/1e9-x, y)
+	}
+}
+
+// decay is 1 for values up to 0 and halves with every halfLife above, e.g.
+// recency halves with every halfLife seconds which passed since a time and
+// decay(_geoDistance, 1000) with every kilometer
+func decay(x, halfLife float64) float64 {
+	if halfLife <= 0 {
+		return 0
+	}
+	if x < 0 {
+		x = 0
+	}
+	return math.Exp(-math.Ln2 * x / halfLife)
+}
+
+type token struct {
+	text string
+	pos  int
+}
+
+func tokenize(src string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case strings.IndexByte("+-*/(),", c) >= 0:
+			tokens = append(tokens, token{text: string(c), pos: i})
+			i++
+		case isDigit(c) || c == '.':
+			j := i
+			for j < len(src) && (isDigit(src[j]) || src[j] == '.' || src[j] == 'e' || src[j] == 'E' ||
+				((src[j] == '+' || src[j] == '-') && (src[j-1] == 'e' || src[j-1] == 'E'))) {
+				j++
+			}
+			tokens = append(tokens, token{text: src[i:j], pos: i})
+			i = j
+		case isLetter(c):
+			j := i
+			for j < len(src) && (isLetter(src[j]) || isDigit(src[j])) {
+				j++
+			}
+			tokens = append(tokens, token{text: src[i:j], pos: i})
+			i = j
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", c, i)
+		}
+	}
+	return tokens, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// parser is a recursive descent parser of the grammar
+//
+//	expr    = term { ("+" | "-") term }
+//	term    = unary { ("*" | "/") unary }
+//	unary   = "-" unary | primary
+//	primary = number | name | name "(" expr { "," expr } ")" | "(" expr ")"
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos].text
+	}
+	return ""
+}
+
+func (p *parser) expect(text string) error {
+	if p.peek() != text {
+		if p.pos >= len(p.tokens) {
+			return fmt.Errorf("expected %q at the end of the expression", text)
+		}
+		return fmt.Errorf("expected %q at position %d, got %q", text,
+			p.tokens[p.pos].pos, p.tokens[p.pos].text)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *parser) expr(depth int) (node, error) {
+	if depth > maxDepth {
+		return nil, fmt.Errorf("expression is nested deeper than %d levels", maxDepth)
+	}
+	left, err := p.term(depth)
+	if err != nil {
+		return nil, err
+	}
+	for op := p.peek(); op == "+" || op == "-"; op = p.peek() {
+		p.pos++
+		right, err := p.term(depth)
+		if err != nil {
+			return nil, err
+		}
+		left = binary{op: op[0], l: left, r: right}
+	}
+	return left, nil
+}
+
+func (p *parser) term(depth int) (node, error) {
+	left, err := p.unary(depth)
+	if err != nil {
+		return nil, err
+	}
+	for op := p.peek(); op == "*" || op == "/"; op = p.peek() {
+		p.pos++
+		right, err := p.unary(depth)
+		if err != nil {
+			return nil, err
+		}
+		left = binary{op: op[0], l: left, r: right}
+	}
+	return left, nil
+}
+
+func (p *parser) unary(depth int) (node, error) {
+	if p.peek() == "-" {
+		if depth > maxDepth {
+			return nil, fmt.Errorf("expression is nested deeper than %d levels", maxDepth)
+		}
+		p.pos++
+		x, err := p.unary(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		return unary{x: x}, nil
+	}
+	return p.primary(depth)
+}
+
+func (p *parser) primary(depth int) (node, error) {
+	if p.pos >= len(p.tokens) {
+		return nil, fmt.Errorf("unexpected end of the expression")
+	}
+	tok := p.tokens[p.pos]
+	p.pos++
+
+	switch {
+	case tok.text == "(":
+		x, err := p.expr(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		return x, p.expect(")")
+	case isDigit(tok.text[0]) || tok.text[0] == '.':
+		v, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at position %d", tok.text, tok.pos)
+		}
+		return number(v), nil
+	case isLetter(tok.text[0]):
+		if p.peek() != "(" {
+			return variable(tok.text), nil
+		}
+		return p.call(tok, depth)
+	default:
+		return nil, fmt.Errorf("unexpected %q at position %d", tok.text, tok.pos)
+	}
+}
+
+func (p *parser) call(name token, depth int) (node, error) {
+	arity, ok := functions[name.text]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q at position %d", name.text, name.pos)
+	}
+	p.pos++ // "("
+
+	args := make([]node, 0, arity)
+	for {
+		arg, err := p.expr(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if p.peek() != "," {
+			break
+		}
+		p.pos++
+	}
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+
+	if len(args) != arity {
+		return nil, fmt.Errorf("function %q at position %d takes %d argument(s), got %d",
+			name.text, name.pos, arity, len(args))
+	}
+	return call{fn: name.text, args: args}, nil
+}
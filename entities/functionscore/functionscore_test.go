@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package functionscore
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEval(t *testing.T) {
+	now := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	in := &Input{
+		Properties: map[string]interface{}{
+			"popularity":  9.0,
+			"inStock":     true,
+			"publishedAt": now.Add(-48 * time.Hour).Format(time.RFC3339),
+			"title":       "not a number",
+		},
+		Score:              2,
+		Distance:           0.25,
+		GeoDistance:        2000,
+		CreationTimeUnix:   now.Add(-time.Hour).UnixMilli(),
+		LastUpdateTimeUnix: now.UnixMilli(),
+		Now:                now,
+	}
+
+	tests := []struct {
+		expr     string
+		expected float64
+	}{
+		{"1 + 2 * 3", 7},
+		{"(1 + 2) * 3", 9},
+		{"-2 - -3", 1},
+		{"10 / 4", 2.5},
+		{"1 / 0", 0},
+		{"2.5e1", 25},
+		{"_score * sqrt(popularity)", 6},
+		{"1 - _distance", 0.75},
+		{"_score + inStock", 3},
+		{"missing + title", 0},
+		{"max(min(popularity, 5), 1)", 5},
+		{"abs(-3) + pow(2, 3)", 11},
+		{"log(0) + log1p(-1)", 0},
+		{"recency(publishedAt, 86400)", 0.25},
+		{"recency(_lastUpdateTime, 3600)", 1},
+		{"recency(_creationTime, 3600)", 0.5},
+		{"recency(_creationTime, 0)", 0},
+		{"pow(10, 1000)", 0},
+		{"_score * decay(_geoDistance, 1000)", 0.5},
+		{"decay(-5, 1000)", 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			e, err := Parse(test.expr)
+			require.Nil(t, err)
+			assert.InDelta(t, test.expected, e.Eval(in), 1e-9)
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		expr     string
+		errorMsg string
+	}{
+		{"", "unexpected end"},
+		{"1 +", "unexpected end"},
+		{"(1 + 2", "expected \")\""},
+		{"1 2", "unexpected \"2\""},
+		{"popularity > 1", "unexpected character '>'"},
+		{"exec(1)", "unknown function \"exec\""},
+		{"max(1)", "takes 2 argument(s), got 1"},
+		{"1..2", "invalid number"},
+		{strings.Repeat("(", 40) + "1" + strings.Repeat(")", 40), "nested deeper"},
+		{strings.Repeat("-", 40) + "1", "nested deeper"},
+		{strings.Repeat("1+", MaxLength) + "1", "longer than"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			_, err := Parse(test.expr)
+			assert.ErrorContains(t, err, test.errorMsg)
+		})
+	}
+}
+
+func TestProperties(t *testing.T) {
+	e, err := Parse("_score * log1p(popularity) + -recency(publishedAt, 86400) / _distance")
+	require.Nil(t, err)
+	assert.Equal(t, []string{"popularity", "publishedAt"}, e.Properties())
+
+	e, err = Parse("_score * 2")
+	require.Nil(t, err)
+	assert.Empty(t, e.Properties())
+}
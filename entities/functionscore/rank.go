@@ -0,0 +1,54 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package functionscore
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// Rank replaces the scores of the results by the value of the expression and
+// sorts them by the new score, highest first. The geo distance is the
+// additional property "distanceMeters" of the results. Results with the same score
+// keep their previous order. The previous score is kept in the explanation
+// of the score.
+func (e *Expression) Rank(results search.Results, now time.Time) {
+	for i := range results {
+		res := &results[i]
+		props, _ := res.Schema.(map[string]interface{})
+		geoDistance, _ := res.AdditionalProperties["distanceMeters"].(float64)
+		score := e.Eval(&Input{
+			Properties:         props,
+			Score:              float64(res.Score),
+			Distance:           float64(res.Dist),
+			GeoDistance:        geoDistance,
+			CreationTimeUnix:   res.Created,
+			LastUpdateTimeUnix: res.Updated,
+			Now:                now,
+		})
+
+		explanation := fmt.Sprintf("(functionScore) %q: %v, previous score: %v",
+			e.src, score, res.Score)
+		if res.ExplainScore != "" {
+			explanation = res.ExplainScore + "\n" + explanation
+		}
+		res.ExplainScore = explanation
+		res.Score = float32(score)
+	}
+
+	sort.SliceStable(results, func(a, b int) bool {
+		return results[a].Score > results[b].Score
+	})
+}
@@ -0,0 +1,43 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package functionscore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func TestRank(t *testing.T) {
+	results := search.Results{
+		{ID: "1", Score: 3, Schema: map[string]interface{}{"popularity": 1.0}},
+		{ID: "2", Score: 2, Schema: map[string]interface{}{"popularity": 4.0}},
+		{ID: "3", Score: 1, Schema: map[string]interface{}{"popularity": 8.0}},
+		{ID: "4", Score: 4, Schema: map[string]interface{}{"popularity": 2.0}},
+	}
+
+	e, err := Parse("_score * popularity")
+	require.Nil(t, err)
+	e.Rank(results, time.Now())
+
+	ids := make([]string, len(results))
+	for i, res := range results {
+		ids[i] = res.ID.String()
+	}
+	// 2 and 3 have the same score and keep their order
+	assert.Equal(t, []string{"2", "3", "4", "1"}, ids)
+	assert.Equal(t, float32(8), results[0].Score)
+	assert.Contains(t, results[0].ExplainScore, "previous score: 2")
+}
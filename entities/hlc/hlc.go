@@ -0,0 +1,92 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package hlc implements hybrid logical clocks, which order the versions of
+// an object across nodes even if the wall clocks of the nodes are skewed.
+//
+// Timestamps are unix milliseconds, so that they can be used as
+// lastUpdateTimeUnix of objects. The logical component of the clock is
+// folded into the physical one: a timestamp is never lower than the wall
+// clock, but if the wall clock hasn't advanced since the last timestamp, or
+// if the node has observed a higher timestamp of another node, the clock
+// advances by one millisecond instead. A timestamp generated after another
+// one has been observed is therefore always higher than it.
+package hlc
+
+import (
+	"sync"
+	"time"
+)
+
+// MaxOffset is the highest offset of an observed timestamp into the future
+// which is accepted. Timestamps further ahead are most likely the result of
+// a misconfigured clock and would drag the clock of all nodes with them.
+const MaxOffset = time.Hour
+
+// Clock is a hybrid logical clock
+type Clock struct {
+	sync.Mutex
+	last int64
+	wall func() int64
+}
+
+// New returns a clock which is based on wall, which returns the current
+// time in unix milliseconds
+func New(wall func() int64) *Clock {
+	return &Clock{wall: wall}
+}
+
+// Now returns a timestamp which is higher than all timestamps previously
+// returned or observed by this clock
+func (c *Clock) Now() int64 {
+	c.Lock()
+	defer c.Unlock()
+
+	now := c.wall()
+	if now <= c.last {
+		now = c.last + 1
+	}
+	c.last = now
+	return now
+}
+
+// Observe makes sure that subsequent timestamps of this clock are higher
+// than ts, which is a timestamp received from another node. It returns false
+// if ts has been ignored because it is too far ahead of the wall clock.
+func (c *Clock) Observe(ts int64) bool {
+	c.Lock()
+	defer c.Unlock()
+
+	if ts > c.wall()+MaxOffset.Milliseconds() {
+		return false
+	}
+	if ts > c.last {
+		c.last = ts
+	}
+	return true
+}
+
+func wallClock() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+// node is the clock of this node
+var node = New(wallClock)
+
+// Now returns a timestamp of the clock of this node, see Clock.Now
+func Now() int64 {
+	return node.Now()
+}
+
+// Observe advances the clock of this node, see Clock.Observe
+func Observe(ts int64) bool {
+	return node.Observe(ts)
+}
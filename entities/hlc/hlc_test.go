@@ -0,0 +1,50 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hlc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClock(t *testing.T) {
+	wall := int64(1000)
+	c := New(func() int64 { return wall })
+
+	t.Run("follows the wall clock", func(t *testing.T) {
+		assert.Equal(t, int64(1000), c.Now())
+		wall = 1010
+		assert.Equal(t, int64(1010), c.Now())
+	})
+
+	t.Run("is monotonic if the wall clock stands still or goes back", func(t *testing.T) {
+		assert.Equal(t, int64(1011), c.Now())
+		wall = 900
+		assert.Equal(t, int64(1012), c.Now())
+	})
+
+	t.Run("orders after observed timestamps of skewed nodes", func(t *testing.T) {
+		wall = 2000
+		assert.True(t, c.Observe(5000))
+		assert.Equal(t, int64(5001), c.Now())
+		// older timestamps don't move the clock back
+		assert.True(t, c.Observe(3000))
+		assert.Equal(t, int64(5002), c.Now())
+	})
+
+	t.Run("ignores timestamps too far in the future", func(t *testing.T) {
+		far := wall + MaxOffset.Milliseconds() + 1
+		assert.False(t, c.Observe(far))
+		assert.Equal(t, int64(5003), c.Now())
+	})
+}
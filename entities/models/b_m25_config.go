@@ -33,6 +33,9 @@ type BM25Config struct {
 
 	// calibrates term-weight scaling based on the term frequency within a document
 	K1 float32 `json:"k1,omitempty"`
+
+	// Multiplies the term frequencies of each listed property in BM25 scores. A query which boosts a property, e.g. with "title^2", overrides it
+	PropertyBoosts map[string]float32 `json:"propertyBoosts,omitempty"`
 }
 
 // Validate validates this b m25 config
@@ -33,56 +33,162 @@ type Class struct {
 	// Name of the class as URI relative to the schema URL.
 	Class string `json:"class,omitempty"`
 
+	// chunking config
+	ChunkingConfig *ChunkingConfig `json:"chunkingConfig,omitempty"`
+
 	// Description of the class.
 	Description string `json:"description,omitempty"`
 
+	// id generation config
+	IDGenerationConfig *IDGenerationConfig `json:"idGenerationConfig,omitempty"`
+
+	// ingest config
+	IngestConfig *IngestConfig `json:"ingestConfig,omitempty"`
+
 	// inverted index config
 	InvertedIndexConfig *InvertedIndexConfig `json:"invertedIndexConfig,omitempty"`
 
 	// Configuration specific to modules this Weaviate instance has installed
 	ModuleConfig interface{} `json:"moduleConfig,omitempty"`
 
+	// object TTL
+	ObjectTTL *ObjectTTLConfig `json:"objectTTL,omitempty"`
+
 	// The properties of the class.
 	Properties []*Property `json:"properties"`
 
+	// protection config
+	ProtectionConfig *ProtectionConfig `json:"protectionConfig,omitempty"`
+
 	// replication config
 	ReplicationConfig *ReplicationConfig `json:"replicationConfig,omitempty"`
 
 	// Manage how the index should be sharded and distributed in the cluster
 	ShardingConfig interface{} `json:"shardingConfig,omitempty"`
 
+	// Named vectors of the class, keyed by their name. Each named vector has its own vector index and is stored and searched independently of the default vector. Named vectors are set by the user with the vectors of an object and searched with the targetVector of nearVector
+	VectorConfig map[string]VectorConfig `json:"vectorConfig,omitempty"`
+
 	// Vector-index config, that is specific to the type of index selected in vectorIndexType
 	VectorIndexConfig interface{} `json:"vectorIndexConfig,omitempty"`
 
-	// Name of the vector index to use, eg. (HNSW)
+	// Name of the vector index to use, eg. (HNSW), (flat) for an exact brute-force index or (dynamic) for a flat index which is upgraded to HNSW once the shard holds enough objects
 	VectorIndexType string `json:"vectorIndexType,omitempty"`
 
 	// Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.
 	Vectorizer string `json:"vectorizer,omitempty"`
+
+	// versioning config
+	VersioningConfig *VersioningConfig `json:"versioningConfig,omitempty"`
 }
 
 // Validate validates this class
 func (m *Class) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateChunkingConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIDGenerationConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIngestConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateInvertedIndexConfig(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateObjectTTL(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateProperties(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateProtectionConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateReplicationConfig(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateVectorConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVersioningConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *Class) validateChunkingConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.ChunkingConfig) { // not required
+		return nil
+	}
+
+	if m.ChunkingConfig != nil {
+		if err := m.ChunkingConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("chunkingConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("chunkingConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Class) validateIDGenerationConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.IDGenerationConfig) { // not required
+		return nil
+	}
+
+	if m.IDGenerationConfig != nil {
+		if err := m.IDGenerationConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("idGenerationConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("idGenerationConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Class) validateIngestConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.IngestConfig) { // not required
+		return nil
+	}
+
+	if m.IngestConfig != nil {
+		if err := m.IngestConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("ingestConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("ingestConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) validateInvertedIndexConfig(formats strfmt.Registry) error {
 	if swag.IsZero(m.InvertedIndexConfig) { // not required
 		return nil
@@ -102,6 +208,25 @@ func (m *Class) validateInvertedIndexConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateObjectTTL(formats strfmt.Registry) error {
+	if swag.IsZero(m.ObjectTTL) { // not required
+		return nil
+	}
+
+	if m.ObjectTTL != nil {
+		if err := m.ObjectTTL.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("objectTTL")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("objectTTL")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) validateProperties(formats strfmt.Registry) error {
 	if swag.IsZero(m.Properties) { // not required
 		return nil
@@ -128,6 +253,25 @@ func (m *Class) validateProperties(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateProtectionConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.ProtectionConfig) { // not required
+		return nil
+	}
+
+	if m.ProtectionConfig != nil {
+		if err := m.ProtectionConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("protectionConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("protectionConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) validateReplicationConfig(formats strfmt.Registry) error {
 	if swag.IsZero(m.ReplicationConfig) { // not required
 		return nil
@@ -147,28 +291,146 @@ func (m *Class) validateReplicationConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateVectorConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.VectorConfig) { // not required
+		return nil
+	}
+
+	for k := range m.VectorConfig {
+
+		if val, ok := m.VectorConfig[k]; ok {
+			if err := val.Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("vectorConfig" + "." + k)
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("vectorConfig" + "." + k)
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Class) validateVersioningConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.VersioningConfig) { // not required
+		return nil
+	}
+
+	if m.VersioningConfig != nil {
+		if err := m.VersioningConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("versioningConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("versioningConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this class based on the context it is used
 func (m *Class) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateChunkingConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateIDGenerationConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateIngestConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateInvertedIndexConfig(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateObjectTTL(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateProperties(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateProtectionConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateReplicationConfig(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateVectorConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateVersioningConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *Class) contextValidateChunkingConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ChunkingConfig != nil {
+		if err := m.ChunkingConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("chunkingConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("chunkingConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Class) contextValidateIDGenerationConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.IDGenerationConfig != nil {
+		if err := m.IDGenerationConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("idGenerationConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("idGenerationConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Class) contextValidateIngestConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.IngestConfig != nil {
+		if err := m.IngestConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("ingestConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("ingestConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) contextValidateInvertedIndexConfig(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.InvertedIndexConfig != nil {
@@ -185,6 +447,22 @@ func (m *Class) contextValidateInvertedIndexConfig(ctx context.Context, formats
 	return nil
 }
 
+func (m *Class) contextValidateObjectTTL(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ObjectTTL != nil {
+		if err := m.ObjectTTL.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("objectTTL")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("objectTTL")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) contextValidateProperties(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.Properties); i++ {
@@ -205,6 +483,22 @@ func (m *Class) contextValidateProperties(ctx context.Context, formats strfmt.Re
 	return nil
 }
 
+func (m *Class) contextValidateProtectionConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ProtectionConfig != nil {
+		if err := m.ProtectionConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("protectionConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("protectionConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) contextValidateReplicationConfig(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.ReplicationConfig != nil {
@@ -221,6 +515,37 @@ func (m *Class) contextValidateReplicationConfig(ctx context.Context, formats st
 	return nil
 }
 
+func (m *Class) contextValidateVectorConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	for k := range m.VectorConfig {
+
+		if val, ok := m.VectorConfig[k]; ok {
+			if err := val.ContextValidate(ctx, formats); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Class) contextValidateVersioningConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.VersioningConfig != nil {
+		if err := m.VersioningConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("versioningConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("versioningConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Class) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -35,6 +35,9 @@ type GraphQLResponse struct {
 
 	// Array with errors.
 	Errors []*GraphQLError `json:"errors,omitempty"`
+
+	// Additional information about the execution of the query, e.g. partialResults is set if the query was stopped at its maximum duration and its results may be incomplete.
+	Extensions map[string]JSONObject `json:"extensions,omitempty"`
 }
 
 // Validate validates this graph q l response
@@ -32,6 +32,12 @@ type InvertedIndexConfig struct {
 	// bm25
 	Bm25 *BM25Config `json:"bm25,omitempty"`
 
+	// Numeric property whose value is multiplied into the scores of keyword and hybrid searches
+	BoostProperty string `json:"boostProperty,omitempty"`
+
+	// Scores which are boosted by the boostProperty: 'bm25' (default), 'hybrid' or 'all'
+	BoostScores string `json:"boostScores,omitempty"`
+
 	// Asynchronous index clean up happens every n seconds
 	CleanupIntervalSeconds int64 `json:"cleanupIntervalSeconds,omitempty"`
 
@@ -46,6 +52,8 @@ type InvertedIndexConfig struct {
 
 	// stopwords
 	Stopwords *StopwordConfig `json:"stopwords,omitempty"`
+	// Keep the write-ahead logs of the objects of the class for n seconds after they were flushed, so that recent writes can be replayed. 0 (default) disables the retention
+	WalRetentionSeconds int64 `json:"walRetentionSeconds,omitempty"`
 }
 
 // Validate validates this inverted index config
@@ -18,7 +18,9 @@ package models
 
 import (
 	"context"
+	"strconv"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -31,20 +33,139 @@ type NodeShardStatus struct {
 	// The name of shard's class.
 	Class string `json:"class"`
 
+	// consistency
+	Consistency *ShardConsistencyReport `json:"consistency,omitempty"`
+
 	// The name of the shard.
 	Name string `json:"name"`
 
 	// The number of objects in shard.
 	ObjectCount int64 `json:"objectCount"`
+
+	// The statistics of the properties with a null state or property length index.
+	Properties []*NodePropertyStats `json:"properties"`
+
+	// The state of the vector indexing of the shard. READY if all vectors are indexed, INDEXING while queued vectors are indexed and DEFERRED while the indexing of vectors is deferred.
+	VectorIndexingStatus string `json:"vectorIndexingStatus"`
+
+	// The number of vector index operations which are queued with async indexing and not applied yet.
+	VectorQueueLength int64 `json:"vectorQueueLength"`
 }
 
 // Validate validates this node shard status
 func (m *NodeShardStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateConsistency(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateProperties(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NodeShardStatus) validateConsistency(formats strfmt.Registry) error {
+	if swag.IsZero(m.Consistency) { // not required
+		return nil
+	}
+
+	if m.Consistency != nil {
+		if err := m.Consistency.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("consistency")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("consistency")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *NodeShardStatus) validateProperties(formats strfmt.Registry) error {
+	if swag.IsZero(m.Properties) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Properties); i++ {
+		if swag.IsZero(m.Properties[i]) { // not required
+			continue
+		}
+
+		if m.Properties[i] != nil {
+			if err := m.Properties[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("properties" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("properties" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
-// ContextValidate validates this node shard status based on context it is used
+// ContextValidate validate this node shard status based on the context it is used
 func (m *NodeShardStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateConsistency(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateProperties(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NodeShardStatus) contextValidateConsistency(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Consistency != nil {
+		if err := m.Consistency.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("consistency")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("consistency")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *NodeShardStatus) contextValidateProperties(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Properties); i++ {
+
+		if m.Properties[i] != nil {
+			if err := m.Properties[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("properties" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("properties" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
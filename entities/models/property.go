@@ -24,6 +24,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
+	"github.com/weaviate/weaviate/entities/tokenizer"
 )
 
 // Property property
@@ -31,6 +32,9 @@ import (
 // swagger:model Property
 type Property struct {
 
+	// Optional. If set, the postings of a term are left out of the inverted index of this text property when the term's BM25 impact within an object is below the threshold. The impact is the term frequency component of the BM25 score, which lies between 0 and 1. Pruned terms are neither searchable nor filterable for the object they were pruned from, which shrinks the index and speeds up keyword searches on verbose fields at the cost of some recall. Defaults to 0 (no pruning). Requires data type text or text[] and indexInverted
+	Bm25PruningThreshold float64 `json:"bm25PruningThreshold,omitempty"`
+
 	// Can be a reference to another type when it starts with a capital (for example Person), otherwise "string" or "int".
 	DataType []string `json:"dataType"`
 
@@ -40,13 +44,22 @@ type Property struct {
 	// Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters. This property has no affect on vectorization decisions done by modules
 	IndexInverted *bool `json:"indexInverted,omitempty"`
 
+	// Optional. If true, the values of this property are indexed in the inverted index, but not stored with the objects. They can be used in where filters and keyword searches, but are not returned with the objects. Partial updates must set all index-only properties. Defaults to false. Requires indexInverted
+	IndexOnly *bool `json:"indexOnly,omitempty"`
+
+	// Optional. If true, the language of the values of this text property is detected when an object is indexed. Values in Chinese, Japanese or Korean are tokenized with the `cjk` tokenization, the stopwords of the detected language are removed from keyword queries and the detected languages of an object can be filtered on with the internal property `_language`. Defaults to false. Requires data type text or text[] and the `word` tokenization
+	LanguageDetection bool `json:"languageDetection,omitempty"`
+
 	// Configuration specific to modules this Weaviate instance has installed
 	ModuleConfig interface{} `json:"moduleConfig,omitempty"`
 
 	// Name of the property as URI relative to the schema URL.
 	Name string `json:"name,omitempty"`
 
-	// Determines tokenization of the property as separate words or whole field. Optional. Applies to string, string[], text and text[] data types. Allowed values are `word` (default) and `field` for string and string[], `word` (default) for text and text[]. Not supported for remaining data types
+	// reference snapshot
+	ReferenceSnapshot *ReferenceSnapshot `json:"referenceSnapshot,omitempty"`
+
+	// Determines tokenization of the property as separate words or whole field. Optional. Applies to string, string[], text and text[] data types. Allowed values are `word` (default) and `field` for string and string[], `word` (default) for text and text[], `cjk` for all of them to split Chinese, Japanese and Korean text into bigrams, as well as the names of custom tokenizers registered by modules. Not supported for remaining data types
 	// Enum: [word field]
 	Tokenization string `json:"tokenization,omitempty"`
 }
@@ -55,6 +68,10 @@ type Property struct {
 func (m *Property) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateReferenceSnapshot(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTokenization(formats); err != nil {
 		res = append(res, err)
 	}
@@ -65,6 +82,25 @@ func (m *Property) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Property) validateReferenceSnapshot(formats strfmt.Registry) error {
+	if swag.IsZero(m.ReferenceSnapshot) { // not required
+		return nil
+	}
+
+	if m.ReferenceSnapshot != nil {
+		if err := m.ReferenceSnapshot.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("referenceSnapshot")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("referenceSnapshot")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 var propertyTypeTokenizationPropEnum []interface{}
 
 func init() {
@@ -99,6 +135,11 @@ func (m *Property) validateTokenization(formats strfmt.Registry) error {
 		return nil
 	}
 
+	// custom tokenizers extend the enum
+	if tokenizer.Get(m.Tokenization) != nil {
+		return nil
+	}
+
 	// value enum
 	if err := m.validateTokenizationEnum("tokenization", "body", m.Tokenization); err != nil {
 		return err
@@ -107,8 +148,33 @@ func (m *Property) validateTokenization(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this property based on context it is used
+// ContextValidate validate this property based on the context it is used
 func (m *Property) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateReferenceSnapshot(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Property) contextValidateReferenceSnapshot(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ReferenceSnapshot != nil {
+		if err := m.ReferenceSnapshot.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("referenceSnapshot")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("referenceSnapshot")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
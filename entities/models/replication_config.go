@@ -28,6 +28,15 @@ import (
 // swagger:model ReplicationConfig
 type ReplicationConfig struct {
 
+	// Strategy to resolve diverging replicas of an object during read repair: 'lastWriteWins' (default), 'highestVersion' or 'propertyPrecedence'
+	ConflictResolution string `json:"conflictResolution,omitempty"`
+
+	// Values of conflictResolutionProperty ordered by precedence, highest first. Used by the 'propertyPrecedence' strategy
+	ConflictResolutionPrecedence []string `json:"conflictResolutionPrecedence,omitempty"`
+
+	// Property which decides conflicts for the 'highestVersion' (number or int) and 'propertyPrecedence' (text) strategies
+	ConflictResolutionProperty string `json:"conflictResolutionProperty,omitempty"`
+
 	// Number of times a class is replicated
 	Factor int64 `json:"factor,omitempty"`
 }
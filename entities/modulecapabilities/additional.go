@@ -13,6 +13,7 @@ package modulecapabilities
 
 import (
 	"context"
+	"time"
 
 	"github.com/tailor-inc/graphql"
 	"github.com/tailor-inc/graphql/language/ast"
@@ -38,6 +39,26 @@ type AdditionalPropertyFn = func(ctx context.Context,
 	in []search.Result, params interface{}, limit *int,
 	argumentModuleParams map[string]interface{}, cfg moduletools.ClassConfig) ([]search.Result, error)
 
+// RerankBudget bounds the work of an additional property which calls a model
+// for every result and reorders them, such as a cross-encoder, so that a slow
+// model can't exceed the latency budget of a query
+type RerankBudget struct {
+	// MaxCandidates is the number of results which are passed to the
+	// property, the others follow them in their original order. All results
+	// are passed with 0.
+	MaxCandidates int
+	// Timeout bounds the time the property may take, it's unbounded with 0
+	Timeout time.Duration
+	// Fallback returns the results in their original order if the property
+	// fails or times out, instead of failing the query
+	Fallback bool
+}
+
+// RerankBudgetFn returns the budget of a query from the params of the
+// additional property, the module params of the query and the class config
+type RerankBudgetFn = func(params interface{},
+	argumentModuleParams map[string]interface{}, cfg moduletools.ClassConfig) RerankBudget
+
 // AdditionalSearch defines on which type of query a given
 // additional logic can be performed
 type AdditionalSearch struct {
@@ -56,6 +77,9 @@ type AdditionalProperty struct {
 	GraphQLFieldFunction   GraphQLFieldFn
 	GraphQLExtractFunction ExtractAdditionalFn
 	SearchFunctions        AdditionalSearch
+	// RerankBudgetFunction is set for properties which rerank the results,
+	// their search functions are called within the budget it returns
+	RerankBudgetFunction RerankBudgetFn
 }
 
 // AdditionalProperties groups whole interface methods needed
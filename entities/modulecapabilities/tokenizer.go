@@ -0,0 +1,21 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modulecapabilities
+
+import "github.com/weaviate/weaviate/entities/tokenizer"
+
+// Tokenizers defines custom tokenizers of the inverted index, they are
+// registered under their names before the modules are initialized, so they
+// must not depend on the initialization of the module
+type Tokenizers interface {
+	Tokenizers() map[string]tokenizer.Tokenizer
+}
@@ -29,6 +29,17 @@ type Vectorizer interface {
 		cfg moduletools.ClassConfig) error
 }
 
+// BatchVectorizer is implemented by vectorizers which can vectorize many
+// objects with fewer calls to their inference API than one per object
+type BatchVectorizer interface {
+	Vectorizer
+	// VectorizeBatch should mutate the objects like VectorizeObject does. The
+	// returned errors belong to the object at the same index, a nil error
+	// means the object was vectorized successfully
+	VectorizeBatch(ctx context.Context, objs []*models.Object,
+		cfg moduletools.ClassConfig) []error
+}
+
 type FindObjectFn = func(ctx context.Context, class string,
 	id strfmt.UUID, props search.SelectProperties,
 	adds additional.Properties) (*search.Result, error)
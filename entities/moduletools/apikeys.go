@@ -0,0 +1,50 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package moduletools
+
+import (
+	"context"
+	"strings"
+)
+
+// APIKeyFromContext returns the api key which the client passed in the
+// request header of the given name, e.g. "X-Openai-Api-Key". All "X-" headers
+// are injected into the request context, so the key is only used for the
+// request it was sent with and is never stored.
+//
+// Module clients use this key in favor of the one configured on the server,
+// so that usage can be billed to the client even if a server-side key is
+// configured.
+func APIKeyFromContext(ctx context.Context, header string) string {
+	if value, ok := ctx.Value(header).([]string); ok && len(value) > 0 {
+		return value[0]
+	}
+	return ""
+}
+
+// RedactAPIKey replaces all occurrences of the key in s, so that error
+// messages of providers which echo the key can be returned and logged safely
+func RedactAPIKey(s, key string) string {
+	if key == "" {
+		return s
+	}
+	return strings.ReplaceAll(s, key, MaskAPIKey(key))
+}
+
+// MaskAPIKey keeps only the last four characters of keys which are long
+// enough for this not to reveal the key
+func MaskAPIKey(key string) string {
+	if len(key) < 16 {
+		return "***"
+	}
+	return "***" + key[len(key)-4:]
+}
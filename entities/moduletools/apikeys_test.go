@@ -0,0 +1,37 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package moduletools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIKeyFromContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "X-Openai-Api-Key",
+		[]string{"sk-request"})
+
+	assert.Equal(t, "sk-request", APIKeyFromContext(ctx, "X-Openai-Api-Key"))
+	assert.Equal(t, "", APIKeyFromContext(ctx, "X-Cohere-Api-Key"))
+	assert.Equal(t, "", APIKeyFromContext(context.Background(), "X-Openai-Api-Key"))
+}
+
+func TestRedactAPIKey(t *testing.T) {
+	key := "sk-0123456789abcdefghij"
+
+	assert.Equal(t, "Incorrect API key provided: ***ghij.",
+		RedactAPIKey("Incorrect API key provided: "+key+".", key))
+	assert.Equal(t, "invalid key ***", RedactAPIKey("invalid key short", "short"))
+	assert.Equal(t, "unchanged", RedactAPIKey("unchanged", ""))
+}
@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package querycost estimates the work a single query performs, so it can be
+// returned to the caller and aggregated for usage reporting
+package querycost
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// Cost is a snapshot of the work a query performed. All values are estimates,
+// e.g. the number of vectors compared in an HNSW search is derived from the
+// search parameters rather than counted exactly.
+type Cost struct {
+	VectorsCompared int64 `json:"vectorsCompared"`
+	PostingsScanned int64 `json:"postingsScanned"`
+	ObjectsLoaded   int64 `json:"objectsLoaded"`
+	BytesRead       int64 `json:"bytesRead"`
+}
+
+// Tracker accumulates the cost of a query. It is safe for concurrent use, as
+// the shards of a class are searched in parallel. All methods can be called
+// on a nil Tracker, in which case they do nothing, so callers don't have to
+// check whether a query is being tracked.
+type Tracker struct {
+	vectorsCompared atomic.Int64
+	postingsScanned atomic.Int64
+	objectsLoaded   atomic.Int64
+	bytesRead       atomic.Int64
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{}
+}
+
+func (t *Tracker) AddVectorsCompared(n int) {
+	if t == nil {
+		return
+	}
+	t.vectorsCompared.Add(int64(n))
+}
+
+func (t *Tracker) AddPostingsScanned(n int) {
+	if t == nil {
+		return
+	}
+	t.postingsScanned.Add(int64(n))
+}
+
+// AddObjectLoaded records a single object read from disk, with n being its
+// size in bytes
+func (t *Tracker) AddObjectLoaded(n int) {
+	if t == nil {
+		return
+	}
+	t.objectsLoaded.Add(1)
+	t.bytesRead.Add(int64(n))
+}
+
+func (t *Tracker) Cost() Cost {
+	if t == nil {
+		return Cost{}
+	}
+	return Cost{
+		VectorsCompared: t.vectorsCompared.Load(),
+		PostingsScanned: t.postingsScanned.Load(),
+		ObjectsLoaded:   t.objectsLoaded.Load(),
+		BytesRead:       t.bytesRead.Load(),
+	}
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx which carries the tracker. Any search
+// executed with the returned context adds its cost to the tracker.
+func NewContext(ctx context.Context, t *Tracker) context.Context {
+	return context.WithValue(ctx, contextKey{}, t)
+}
+
+// FromContext returns the tracker of ctx or nil if the query is not tracked
+func FromContext(ctx context.Context) *Tracker {
+	t, _ := ctx.Value(contextKey{}).(*Tracker)
+	return t
+}
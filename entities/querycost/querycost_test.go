@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package querycost
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracker(t *testing.T) {
+	t.Run("accumulating concurrently", func(t *testing.T) {
+		tracker := NewTracker()
+		wg := sync.WaitGroup{}
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				tracker.AddVectorsCompared(100)
+				tracker.AddPostingsScanned(20)
+				tracker.AddObjectLoaded(64)
+				tracker.AddObjectLoaded(36)
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, Cost{
+			VectorsCompared: 1000,
+			PostingsScanned: 200,
+			ObjectsLoaded:   20,
+			BytesRead:       1000,
+		}, tracker.Cost())
+	})
+
+	t.Run("nil tracker", func(t *testing.T) {
+		var tracker *Tracker
+		tracker.AddVectorsCompared(1)
+		tracker.AddPostingsScanned(1)
+		tracker.AddObjectLoaded(1)
+		assert.Equal(t, Cost{}, tracker.Cost())
+	})
+
+	t.Run("context", func(t *testing.T) {
+		assert.Nil(t, FromContext(context.Background()))
+
+		tracker := NewTracker()
+		ctx := NewContext(context.Background(), tracker)
+		assert.Same(t, tracker, FromContext(ctx))
+	})
+}
@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package querytimeout bounds the duration of a single query. Once the
+// deadline of a query has passed, it either fails or returns the results
+// found so far.
+package querytimeout
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+)
+
+// ErrPartialDeadline is returned by Err once the partial deadline of a query
+// has passed
+var ErrPartialDeadline = errors.New("query exceeded its maximum duration")
+
+type (
+	deadlineKey struct{}
+	partialKey  struct{}
+)
+
+// partial records whether a search loop of a query stopped at its partial
+// deadline. Marking it marks the partial records of the enclosing scopes,
+// e.g. of the request a query belongs to.
+type partial struct {
+	stopped atomic.Bool
+	parent  *partial
+}
+
+// NewContext bounds the duration of the query executed with the returned
+// context. Without partial results the context is canceled after
+// maxDuration, so that the query fails. With partial results the context is
+// not canceled, only the search loops stop at the deadline, see Stop, and
+// the results found until then are returned. A maxDuration of 0 doesn't bound
+// the query.
+func NewContext(ctx context.Context, maxDuration time.Duration,
+	partialResults bool,
+) (context.Context, context.CancelFunc) {
+	if maxDuration <= 0 {
+		return ctx, func() {}
+	}
+	if !partialResults {
+		return context.WithTimeout(ctx, maxDuration)
+	}
+	return WithPartialDeadline(ctx, time.Now().Add(maxDuration)), func() {}
+}
+
+// WithPartialDeadline returns a context whose search loops stop at the
+// deadline, e.g. to continue a query received from another node. Whether a
+// loop stopped is reported by Partial.
+func WithPartialDeadline(ctx context.Context, deadline time.Time) context.Context {
+	return context.WithValue(WithPartialResults(ctx), deadlineKey{}, deadline)
+}
+
+// WithPartialResults returns a context which records whether a search loop
+// of the queries executed with it stopped at the partial deadline, so that
+// the caller can mark the results as incomplete, see Partial. A query
+// stopped within the returned context also marks the contexts it is derived
+// from.
+func WithPartialResults(ctx context.Context) context.Context {
+	parent, _ := ctx.Value(partialKey{}).(*partial)
+	return context.WithValue(ctx, partialKey{}, &partial{parent: parent})
+}
+
+// Partial returns whether a search loop of a query executed with the context
+// stopped at its partial deadline, in which case its results may be
+// incomplete
+func Partial(ctx context.Context) bool {
+	p, ok := ctx.Value(partialKey{}).(*partial)
+	return ok && p.stopped.Load()
+}
+
+// MarkPartial marks the results of the query as incomplete, e.g. because the
+// search of a remote shard stopped at the partial deadline
+func MarkPartial(ctx context.Context) {
+	p, _ := ctx.Value(partialKey{}).(*partial)
+	for ; p != nil; p = p.parent {
+		p.stopped.Store(true)
+	}
+}
+
+// PartialDeadline returns the deadline at which the search loops of the
+// query stop, if the query returns partial results
+func PartialDeadline(ctx context.Context) (time.Time, bool) {
+	deadline, ok := ctx.Value(deadlineKey{}).(time.Time)
+	return deadline, ok
+}
+
+// Stop returns whether a search loop should stop. The error is set if the
+// query was canceled, e.g. because the client disconnected or the query
+// timed out without partial results. It is nil if the partial deadline of
+// the query has passed, in which case the results found so far are kept and
+// marked as incomplete, see Partial.
+func Stop(ctx context.Context) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return true, err
+	}
+	if deadline, ok := PartialDeadline(ctx); ok && !time.Now().Before(deadline) {
+		MarkPartial(ctx)
+		return true, nil
+	}
+	return false, nil
+}
+
+// Err returns the error with which a loop stops whose partial results would
+// be wrong, such as a sort or an aggregation. Unlike Stop, it fails once the
+// partial deadline of the query has passed.
+func Err(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if deadline, ok := PartialDeadline(ctx); ok && !time.Now().Before(deadline) {
+		return ErrPartialDeadline
+	}
+	return nil
+}
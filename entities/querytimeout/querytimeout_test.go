@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package querytimeout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStop(t *testing.T) {
+	t.Run("unbounded query", func(t *testing.T) {
+		ctx, cancel := NewContext(context.Background(), 0, false)
+		defer cancel()
+
+		stop, err := Stop(ctx)
+		assert.False(t, stop)
+		assert.Nil(t, err)
+		_, ok := ctx.Deadline()
+		assert.False(t, ok)
+	})
+
+	t.Run("timed out query fails", func(t *testing.T) {
+		ctx, cancel := NewContext(context.Background(), time.Millisecond, false)
+		defer cancel()
+
+		<-ctx.Done()
+		stop, err := Stop(ctx)
+		assert.True(t, stop)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("timed out query returns partial results", func(t *testing.T) {
+		ctx, cancel := NewContext(context.Background(), 10*time.Millisecond, true)
+		defer cancel()
+
+		stop, err := Stop(ctx)
+		assert.False(t, stop)
+		assert.Nil(t, err)
+
+		time.Sleep(10 * time.Millisecond)
+		stop, err = Stop(ctx)
+		assert.True(t, stop)
+		assert.Nil(t, err)
+		assert.Nil(t, ctx.Err(), "context must not be canceled")
+	})
+
+	t.Run("canceled query fails with partial results", func(t *testing.T) {
+		parent, cancelParent := context.WithCancel(context.Background())
+		ctx, cancel := NewContext(parent, time.Minute, true)
+		defer cancel()
+
+		cancelParent()
+		stop, err := Stop(ctx)
+		assert.True(t, stop)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestErr(t *testing.T) {
+	ctx := WithPartialDeadline(context.Background(), time.Now().Add(time.Minute))
+	assert.Nil(t, Err(ctx))
+
+	ctx = WithPartialDeadline(context.Background(), time.Now())
+	assert.ErrorIs(t, Err(ctx), ErrPartialDeadline)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.ErrorIs(t, Err(canceled), context.Canceled)
+}
+
+func TestPartial(t *testing.T) {
+	t.Run("stopped query is marked as partial", func(t *testing.T) {
+		request := WithPartialResults(context.Background())
+		ctx, cancel := NewContext(request, 10*time.Millisecond, true)
+		defer cancel()
+
+		stop, _ := Stop(ctx)
+		assert.False(t, stop)
+		assert.False(t, Partial(ctx))
+
+		time.Sleep(10 * time.Millisecond)
+		stop, _ = Stop(ctx)
+		assert.True(t, stop)
+		assert.True(t, Partial(ctx))
+		assert.True(t, Partial(request), "the enclosing request must be marked")
+	})
+
+	t.Run("queries of a request are marked separately", func(t *testing.T) {
+		request := WithPartialResults(context.Background())
+		first := WithPartialDeadline(request, time.Now())
+		second := WithPartialDeadline(request, time.Now().Add(time.Minute))
+
+		Stop(first)
+		Stop(second)
+		assert.True(t, Partial(first))
+		assert.False(t, Partial(second))
+		assert.True(t, Partial(request))
+	})
+
+	t.Run("failing loops don't mark the query", func(t *testing.T) {
+		ctx := WithPartialDeadline(context.Background(), time.Now())
+		assert.ErrorIs(t, Err(ctx), ErrPartialDeadline)
+		assert.False(t, Partial(ctx))
+	})
+
+	t.Run("remote shard marks the query", func(t *testing.T) {
+		ctx := WithPartialResults(context.Background())
+		MarkPartial(ctx)
+		assert.True(t, Partial(ctx))
+
+		// without a record there is nothing to mark
+		MarkPartial(context.Background())
+		assert.False(t, Partial(context.Background()))
+	})
+}
@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package replay contains the types of a replay of the writes to a class from
+// the write-ahead logs retained by its shards. Writes are replayed page by
+// page in the order of their time and id, a cursor marks the last write of a
+// page.
+package replay
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+var ErrInvalidCursor = errors.New("invalid replay cursor")
+
+// Write is the latest write to an object within the replayed time range
+type Write struct {
+	// Time of the write in unix milliseconds
+	Time int64
+	ID   strfmt.UUID
+	// Object is nil if the object has been deleted
+	Object *storobj.Object
+}
+
+func (w Write) Deleted() bool {
+	return w.Object == nil
+}
+
+func (w Write) Cursor() Cursor {
+	return Cursor{Time: w.Time, ID: w.ID}
+}
+
+// Page of writes ordered by their cursors
+type Page struct {
+	Writes []Write
+	// More is set if writes beyond the last one of the page are left
+	More bool
+}
+
+// Cursor is the position of a write in a replay. The zero value is before
+// all writes.
+type Cursor struct {
+	Time int64
+	ID   strfmt.UUID
+}
+
+// Before reports whether c comes before other
+func (c Cursor) Before(other Cursor) bool {
+	if c.Time != other.Time {
+		return c.Time < other.Time
+	}
+	return c.ID < other.ID
+}
+
+// String encodes the cursor, cursors are opaque to clients
+func (c Cursor) String() string {
+	if c == (Cursor{}) {
+		return ""
+	}
+	return fmt.Sprintf("%d_%s", c.Time, c.ID)
+}
+
+func ParseCursor(cursor string) (Cursor, error) {
+	if cursor == "" {
+		return Cursor{}, nil
+	}
+
+	t, id, ok := strings.Cut(cursor, "_")
+	if !ok || !strfmt.IsUUID(id) {
+		return Cursor{}, ErrInvalidCursor
+	}
+	parsed, err := strconv.ParseInt(t, 10, 64)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	return Cursor{Time: parsed, ID: strfmt.UUID(id)}, nil
+}
@@ -62,6 +62,36 @@ func PropertyIsIndexed(schemaDefinition *models.Schema, className, tentativeProp
 	return *indexed
 }
 
+// PropertyIsIndexOnly returns true if the values of the property are only
+// added to the inverted index, but not stored with the objects
+func PropertyIsIndexOnly(p *models.Property) bool {
+	return p.IndexOnly != nil && *p.IndexOnly
+}
+
+// IndexOnlyProperties returns the names of the index-only properties of the
+// class
+func IndexOnlyProperties(class *models.Class) []string {
+	var names []string
+	for _, prop := range class.Properties {
+		if PropertyIsIndexOnly(prop) {
+			names = append(names, prop.Name)
+		}
+	}
+	return names
+}
+
+// ReferenceSnapshotProperties returns the properties of the class which
+// hold copies of a property of referenced objects
+func ReferenceSnapshotProperties(class *models.Class) []*models.Property {
+	var props []*models.Property
+	for _, prop := range class.Properties {
+		if prop.ReferenceSnapshot != nil {
+			props = append(props, prop)
+		}
+	}
+	return props
+}
+
 func (s *Schema) GetProperty(className ClassName, propName PropertyName) (*models.Property, error) {
 	semSchemaClass, err := GetClassByName(s.Objects, string(className))
 	if err != nil {
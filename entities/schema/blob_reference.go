@@ -0,0 +1,35 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"path"
+	"strings"
+)
+
+// BlobReferencePrefix marks values of blob properties which have been
+// offloaded to the blob storage. The prefix can't be part of a base64
+// encoded value, the rest of the value is the key of the blob.
+const BlobReferencePrefix = "blobref:"
+
+// BlobKey is the key of the offloaded value of a blob property
+func BlobKey(className, id, propName string) string {
+	return path.Join(className, id, propName)
+}
+
+// BlobReference returns the key of an offloaded blob value
+func BlobReference(value string) (string, bool) {
+	if !strings.HasPrefix(value, BlobReferencePrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(value, BlobReferencePrefix), true
+}
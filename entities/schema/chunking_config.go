@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import "github.com/weaviate/weaviate/entities/models"
+
+// Strategies used to split text properties into chunks at import
+const (
+	ChunkingStrategyTokens    = "tokens"
+	ChunkingStrategySentences = "sentences"
+)
+
+// Sizes of the chunks if the chunking config doesn't set one
+const (
+	DefaultChunkSizeTokens    = 256
+	DefaultChunkSizeSentences = 8
+)
+
+// Properties of the chunk class, which are set on every chunk object
+const (
+	ChunkTextProperty     = "text"
+	ChunkIndexProperty    = "chunkIndex"
+	ChunkSourceProperty   = "sourceProperty"
+	ChunkParentIDProperty = "parentId"
+	ChunkParentProperty   = "parent"
+)
+
+// ChunkingStrategy returns the strategy used to split the text properties of
+// a class, defaulting to tokens
+func ChunkingStrategy(cfg *models.ChunkingConfig) string {
+	if cfg == nil || cfg.Strategy == "" {
+		return ChunkingStrategyTokens
+	}
+	return cfg.Strategy
+}
+
+// ChunkSize returns the number of tokens or sentences per chunk
+func ChunkSize(cfg *models.ChunkingConfig) int {
+	if cfg != nil && cfg.Size > 0 {
+		return int(cfg.Size)
+	}
+	if ChunkingStrategy(cfg) == ChunkingStrategySentences {
+		return DefaultChunkSizeSentences
+	}
+	return DefaultChunkSizeTokens
+}
+
+// ChunkProperties returns the properties a chunk class of parentClass needs
+func ChunkProperties(parentClass string) []*models.Property {
+	return []*models.Property{
+		{
+			Name:     ChunkTextProperty,
+			DataType: []string{string(DataTypeText)},
+		},
+		{
+			Name:     ChunkIndexProperty,
+			DataType: []string{string(DataTypeInt)},
+		},
+		{
+			Name:     ChunkSourceProperty,
+			DataType: []string{string(DataTypeText)},
+		},
+		{
+			Name:     ChunkParentIDProperty,
+			DataType: []string{string(DataTypeUUID)},
+		},
+		{
+			Name:     ChunkParentProperty,
+			DataType: []string{parentClass},
+		},
+	}
+}
@@ -0,0 +1,32 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import "github.com/weaviate/weaviate/entities/models"
+
+// Strategies the server uses to generate the ids of objects which are
+// imported without one
+const (
+	IDStrategyV4 = "v4"
+	IDStrategyV7 = "v7"
+	IDStrategyV5 = "v5"
+)
+
+// IDStrategy returns the id generation strategy of a class, defaulting to
+// random v4 ids
+func IDStrategy(class *models.Class) string {
+	if class == nil || class.IDGenerationConfig == nil ||
+		class.IDGenerationConfig.Strategy == "" {
+		return IDStrategyV4
+	}
+	return class.IDGenerationConfig.Strategy
+}
@@ -11,7 +11,11 @@
 
 package schema
 
-import "github.com/weaviate/weaviate/entities/models"
+import (
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
 
 type InvertedIndexConfig struct {
 	BM25                BM25Config
@@ -19,9 +23,80 @@ type InvertedIndexConfig struct {
 	IndexTimestamps     bool
 	IndexNullState      bool
 	IndexPropertyLength bool
+	Boost               BoostConfig
+	// WALRetention is how long the write-ahead logs of the objects are kept
+	// after a flush to replay recent writes, they are deleted right away if 0
+	WALRetention time.Duration
+	// CleanupInterval is the time between two runs of the cleanup cycle of
+	// the deleted postings of the searchable properties
+	CleanupInterval time.Duration
 }
 
 type BM25Config struct {
 	K1 float64
 	B  float64
+	// PropertyBoosts multiply the term frequencies of the properties, a
+	// boost in the query takes precedence
+	PropertyBoosts map[string]float32
+}
+
+// Scores which can be boosted by the boost property of a class
+const (
+	BoostScoresBM25   = "bm25"
+	BoostScoresHybrid = "hybrid"
+	BoostScoresAll    = "all"
+)
+
+// BoostConfig declares a numeric property of a class whose value is
+// multiplied into the scores of keyword and hybrid searches, e.g. to rank
+// recent or popular objects higher
+type BoostConfig struct {
+	Property string
+	Scores   string
+}
+
+func BoostConfigFromModel(iicm *models.InvertedIndexConfig) BoostConfig {
+	if iicm == nil {
+		return BoostConfig{}
+	}
+	return BoostConfig{Property: iicm.BoostProperty, Scores: iicm.BoostScores}
+}
+
+// BoostsBM25 is true if the scores of BM25 searches are boosted
+func (b BoostConfig) BoostsBM25() bool {
+	return b.Property != "" &&
+		(b.Scores == "" || b.Scores == BoostScoresBM25 || b.Scores == BoostScoresAll)
+}
+
+// BoostsHybrid is true if the fused scores of hybrid searches are boosted
+func (b BoostConfig) BoostsHybrid() bool {
+	return b.Property != "" &&
+		(b.Scores == BoostScoresHybrid || b.Scores == BoostScoresAll)
+}
+
+// Boost is the factor of an object with the given properties. Objects
+// without a value are not boosted, negative values are treated as 0.
+func (b BoostConfig) Boost(props interface{}) float64 {
+	m, ok := props.(map[string]interface{})
+	if !ok {
+		return 1
+	}
+
+	var boost float64
+	switch v := m[b.Property].(type) {
+	case float64:
+		boost = v
+	case float32:
+		boost = float64(v)
+	case int64:
+		boost = float64(v)
+	case int:
+		boost = float64(v)
+	default:
+		return 1
+	}
+	if boost < 0 {
+		return 0
+	}
+	return boost
 }
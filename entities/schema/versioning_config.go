@@ -0,0 +1,30 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import "github.com/weaviate/weaviate/entities/models"
+
+// DefaultMaxVersions is the number of versions kept per object if the
+// versioning config doesn't set one
+const DefaultMaxVersions = 10
+
+// MaxVersions returns the number of versions kept per object of the class,
+// 0 if versioning is disabled
+func MaxVersions(class *models.Class) int {
+	if class == nil || class.VersioningConfig == nil || !class.VersioningConfig.Enabled {
+		return 0
+	}
+	if class.VersioningConfig.MaxVersions > 0 {
+		return int(class.VersioningConfig.MaxVersions)
+	}
+	return DefaultMaxVersions
+}
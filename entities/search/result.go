@@ -27,6 +27,7 @@ type Result struct {
 	ExplainScore         string
 	Dist                 float32
 	Vector               []float32
+	Vectors              models.Vectors
 	Beacon               string
 	Certainty            float32
 	Schema               models.PropertySchema
@@ -66,6 +67,7 @@ func (r Result) ObjectWithVector(includeVector bool) *models.Object {
 
 	if includeVector {
 		t.Vector = r.Vector
+		t.Vectors = r.Vectors
 	}
 
 	return t
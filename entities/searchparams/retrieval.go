@@ -11,11 +11,24 @@
 
 package searchparams
 
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
 type NearVector struct {
 	Vector       []float32 `json:"vector"`
-	Certainty    float64   `json:"certainty"`
-	Distance     float64   `json:"distance"`
-	WithDistance bool      `json:"-"`
+	TargetVector string    `json:"targetVector"`
+	// TargetVectors searches several named vectors at once and fuses their
+	// distances, it replaces TargetVector
+	TargetVectors *TargetVectors `json:"targetVectors,omitempty"`
+	Certainty     float64        `json:"certainty"`
+	Distance      float64        `json:"distance"`
+	WithDistance  bool           `json:"-"`
+	// FilterStrategy overrides the filter strategy of the vector index for
+	// this search, see hnsw.FilterStrategySweeping and hnsw.FilterStrategyAcorn
+	FilterStrategy string `json:"filterStrategy,omitempty"`
 }
 
 type KeywordRanking struct {
@@ -23,6 +36,14 @@ type KeywordRanking struct {
 	Properties             []string `json:"properties"`
 	Query                  string   `json:"query"`
 	AdditionalExplanations bool     `json:"additionalExplanations"`
+	// BM25 overrides the parameters of the inverted index config of the class
+	BM25 *schema.BM25Config `json:"bm25,omitempty"`
+}
+
+// FunctionScore re-ranks the results of a search by an expression, see
+// package functionscore
+type FunctionScore struct {
+	Expression string `json:"expression"`
 }
 
 type WeightedSearchResult struct {
@@ -38,6 +59,8 @@ type HybridSearch struct {
 	Alpha       float64     `json:"alpha"`
 	Query       string      `json:"query"`
 	Vector      []float32   `json:"vector"`
+	// AlphaDefaulted is set if the query did not specify alpha explicitly
+	AlphaDefaulted bool `json:"-"`
 }
 
 type NearObject struct {
@@ -71,3 +94,46 @@ type NearTextParams struct {
 	Network      bool
 	Autocorrect  bool
 }
+
+// Combination strategies for merging the per-target results of a
+// multi-target vector search into a single ranking
+const (
+	TargetVectorCombinationSum      = "sum"
+	TargetVectorCombinationAverage  = "average"
+	TargetVectorCombinationMin      = "min"
+	TargetVectorCombinationRelative = "relative"
+)
+
+// TargetVectors describes a search against several target vectors at once.
+// Vectors are matched to Targets by position. An empty target name refers to
+// the default (unnamed) vector of a class.
+type TargetVectors struct {
+	Targets     []string    `json:"targets"`
+	Vectors     [][]float32 `json:"vectors"`
+	Combination string      `json:"combination"`
+}
+
+// Validate makes sure that there is a vector for every target and that the
+// combination is supported
+func (t TargetVectors) Validate() error {
+	if len(t.Targets) == 0 {
+		return fmt.Errorf("no targets set")
+	}
+	if len(t.Targets) != len(t.Vectors) {
+		return fmt.Errorf("got %d targets, but %d vectors", len(t.Targets), len(t.Vectors))
+	}
+	if !ValidTargetVectorCombination(t.Combination) {
+		return fmt.Errorf("unrecognized combination %q", t.Combination)
+	}
+	return nil
+}
+
+func ValidTargetVectorCombination(combination string) bool {
+	switch combination {
+	case TargetVectorCombinationSum, TargetVectorCombinationAverage,
+		TargetVectorCombinationMin, TargetVectorCombinationRelative:
+		return true
+	default:
+		return false
+	}
+}
@@ -16,6 +16,14 @@ import "errors"
 const (
 	StatusReadOnly Status = "READONLY"
 	StatusReady    Status = "READY"
+	// StatusQuarantined rejects writes like StatusReadOnly. It is set when
+	// writes fail repeatedly, as the shard may be damaged, and has to be
+	// cleared by an operator after remediation.
+	StatusQuarantined Status = "QUARANTINED"
+	// StatusOffloaded is reported for the shards of an offloaded class, whose
+	// files are in object storage. It can't be set through a status update,
+	// the class is offloaded and activated as a whole.
+	StatusOffloaded Status = "OFFLOADED"
 )
 
 var (
@@ -29,12 +37,19 @@ func (s Status) String() string {
 	return string(s)
 }
 
+// AllowsWrites is false for all statuses which reject writes
+func (s Status) AllowsWrites() bool {
+	return s != StatusReadOnly && s != StatusQuarantined
+}
+
 func ValidateStatus(in string) (status Status, err error) {
 	switch in {
 	case string(StatusReadOnly):
 		status = StatusReadOnly
 	case string(StatusReady):
 		status = StatusReady
+	case string(StatusQuarantined):
+		status = StatusQuarantined
 	default:
 		err = ErrInvalidStatus
 	}
@@ -40,6 +40,7 @@ func TestStatusValidation(t *testing.T) {
 		}{
 			{"READONLY", StatusReadOnly},
 			{"READY", StatusReady},
+			{"QUARANTINED", StatusQuarantined},
 		}
 
 		for _, test := range tests {
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"sort"
 
 	"github.com/buger/jsonparser"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/querycost"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/usecases/byte_operations"
@@ -37,9 +39,12 @@ type Object struct {
 	Object            models.Object `json:"object"`
 	Vector            []float32     `json:"vector"`
 	VectorLen         int           `json:"-"`
-	BelongsToNode     string        `json:"-"`
-	BelongsToShard    string        `json:"-"`
-	docID             uint64
+	// Vectors are the named vectors of the object, keyed by the names of
+	// the vectorConfig of its class
+	Vectors        map[string][]float32 `json:"vectors,omitempty"`
+	BelongsToNode  string               `json:"-"`
+	BelongsToShard string               `json:"-"`
+	docID          uint64
 }
 
 func New(docID uint64) *Object {
@@ -61,9 +66,22 @@ func FromObject(object *models.Object, vector []float32) *Object {
 		object.Properties = properties
 	}
 
+	// the named vectors are kept next to the default vector instead of in
+	// the object, just like the vector
+	var vectors map[string][]float32
+	if len(object.Vectors) > 0 {
+		vectors = make(map[string][]float32, len(object.Vectors))
+		for name, vector := range object.Vectors {
+			vectors[name] = vector
+		}
+	}
+	obj := *object
+	obj.Vectors = nil
+
 	return &Object{
-		Object:            *object,
+		Object:            obj,
 		Vector:            vector,
+		Vectors:           vectors,
 		MarshallerVersion: 1,
 		VectorLen:         len(vector),
 	}
@@ -78,6 +96,8 @@ func FromBinary(data []byte) (*Object, error) {
 	return ko, nil
 }
 
+// FromBinaryUUIDOnly parses only the header of the object: the doc id, the
+// uuid, the timestamps and the class name
 func FromBinaryUUIDOnly(data []byte) (*Object, error) {
 	ko := &Object{}
 
@@ -95,7 +115,8 @@ func FromBinaryUUIDOnly(data []byte) (*Object, error) {
 	}
 	ko.Object.ID = strfmt.UUID(uuidObj.String())
 
-	byteOps.MoveBufferPositionForward(16)
+	ko.Object.CreationTimeUnix = int64(byteOps.ReadUint64())
+	ko.Object.LastUpdateTimeUnix = int64(byteOps.ReadUint64())
 
 	vecLen := byteOps.ReadUint16()
 	byteOps.MoveBufferPositionForward(uint64(vecLen * 4))
@@ -174,6 +195,18 @@ func FromBinaryOptional(data []byte,
 	_, err = r.Read(vectorWeights)
 	ec.AddWrap(err, "vector weights")
 
+	// the named vectors are optional, objects written before their
+	// introduction end after the vector weights
+	if addProp.Vector && r.Len() > 0 {
+		var vectorsLength uint32
+		ec.AddWrap(binary.Read(r, le, &vectorsLength), "named vectors length")
+		vectors := make([]byte, vectorsLength)
+		_, err = r.Read(vectors)
+		ec.AddWrap(err, "named vectors")
+		ko.Vectors, err = unmarshalVectors(vectors)
+		ec.AddWrap(err, "parse named vectors")
+	}
+
 	if err := ec.ToError(); err != nil {
 		return nil, errors.Wrap(err, "compound err")
 	}
@@ -204,6 +237,14 @@ type bucket interface {
 
 func ObjectsByDocID(bucket bucket, ids []uint64,
 	additional additional.Properties,
+) ([]*Object, error) {
+	return ObjectsByDocIDWithCost(bucket, ids, additional, nil)
+}
+
+// ObjectsByDocIDWithCost is ObjectsByDocID, which additionally records the
+// objects loaded and bytes read in the tracker
+func ObjectsByDocIDWithCost(bucket bucket, ids []uint64,
+	additional additional.Properties, tracker *querycost.Tracker,
 ) ([]*Object, error) {
 	if bucket == nil {
 		return nil, fmt.Errorf("objects bucket not found")
@@ -226,6 +267,7 @@ func ObjectsByDocID(bucket bucket, ids []uint64,
 		if res == nil {
 			continue
 		}
+		tracker.AddObjectLoaded(len(res))
 
 		unmarshalled, err := FromBinaryOptional(res, additional)
 		if err != nil {
@@ -366,11 +408,20 @@ func (ko *Object) SearchResult(additional additional.Properties) *search.Result
 		additionalProperties["explainScore"] = ko.ExplainScore()
 	}
 
+	var vectors models.Vectors
+	if len(ko.Vectors) > 0 {
+		vectors = make(models.Vectors, len(ko.Vectors))
+		for name, vector := range ko.Vectors {
+			vectors[name] = vector
+		}
+	}
+
 	return &search.Result{
 		ID:        ko.ID(),
 		ClassName: ko.Class().String(),
 		Schema:    ko.Properties(),
 		Vector:    ko.Vector,
+		Vectors:   vectors,
 		Dims:      ko.VectorLen,
 		// VectorWeights: ko.VectorWeights(), // TODO: add vector weights
 		Created:              ko.CreationTimeUnix(),
@@ -456,6 +507,8 @@ func DocIDFromBinary(in []byte) (uint64, error) {
 // n          | []byte    | meta as json
 // 2          | uint32    | length of vectorweights json
 // n          | []byte    | vectorweights as json
+// 4          | uint32    | length of the named vectors, omitted without named vectors
+// n          | []byte    | named vectors, see marshalVectors
 func (ko *Object) MarshalBinary() ([]byte, error) {
 	if ko.MarshallerVersion != 1 {
 		return nil, errors.Errorf("unsupported marshaller version %d", ko.MarshallerVersion)
@@ -492,7 +545,13 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 	}
 	vectorWeightsLength := uint32(len(vectorWeights))
 
+	vectors := marshalVectors(ko.Vectors)
+	vectorsLength := uint32(len(vectors))
+
 	totalBufferLength := 1 + 8 + 1 + 16 + 8 + 8 + 2 + vectorLength*4 + 2 + classNameLength + 4 + schemaLength + 4 + metaLength + 4 + vectorWeightsLength
+	if vectorsLength > 0 {
+		totalBufferLength += 4 + vectorsLength
+	}
 	byteBuffer := make([]byte, totalBufferLength)
 	byteOps := byte_operations.ByteOperations{Buffer: byteBuffer}
 	byteOps.WriteByte(ko.MarshallerVersion)
@@ -532,9 +591,76 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		return byteBuffer, errors.Wrap(err, "Could not copy vectorWeights")
 	}
 
+	if vectorsLength > 0 {
+		byteOps.WriteUint32(vectorsLength)
+		err = byteOps.CopyBytesToBuffer(vectors)
+		if err != nil {
+			return byteBuffer, errors.Wrap(err, "Could not copy vectors")
+		}
+	}
+
 	return byteBuffer, nil
 }
 
+// marshalVectors encodes the named vectors ordered by their name
+//
+// No. of B   | Type      | Content
+// ------------------------------------------------
+// 2          | uint16    | number of named vectors
+// and for each named vector
+// 2          | uint16    | length of the name
+// n          | []byte    | name
+// 2          | uint16    | VectorLength
+// n*4        | []float32 | vector of length n
+func marshalVectors(vectors map[string][]float32) []byte {
+	if len(vectors) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(vectors))
+	length := 2
+	for name, vector := range vectors {
+		names = append(names, name)
+		length += 2 + len(name) + 2 + len(vector)*4
+	}
+	sort.Strings(names)
+
+	buf := make([]byte, length)
+	byteOps := byte_operations.ByteOperations{Buffer: buf}
+	byteOps.WriteUint16(uint16(len(names)))
+	for _, name := range names {
+		byteOps.WriteUint16(uint16(len(name)))
+		byteOps.CopyBytesToBuffer([]byte(name))
+		vector := vectors[name]
+		byteOps.WriteUint16(uint16(len(vector)))
+		for _, v := range vector {
+			byteOps.WriteUint32(math.Float32bits(v))
+		}
+	}
+	return buf
+}
+
+func unmarshalVectors(data []byte) (map[string][]float32, error) {
+	if len(data) < 2 {
+		return nil, errors.Errorf("named vectors: expected at least 2 bytes, got %d", len(data))
+	}
+
+	byteOps := byte_operations.ByteOperations{Buffer: data}
+	count := int(byteOps.ReadUint16())
+	vectors := make(map[string][]float32, count)
+	for i := 0; i < count; i++ {
+		nameLength := uint64(byteOps.ReadUint16())
+		name := string(byteOps.ReadBytesFromBuffer(nameLength))
+		vectorLength := int(byteOps.ReadUint16())
+		vector := make([]float32, vectorLength)
+		for j := range vector {
+			vector[j] = math.Float32frombits(byteOps.ReadUint32())
+		}
+		vectors[name] = vector
+	}
+	return vectors, nil
+}
+
 // UnmarshalPropertiesFromObject only unmarshals and returns the properties part of the object
 //
 // Check MarshalBinary for the order of elements in the input array
@@ -674,6 +800,16 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 		return errors.Wrap(err, "Could not copy vectorWeights")
 	}
 
+	// the named vectors are optional, objects written before their
+	// introduction end after the vector weights
+	if byteOps.Position < uint64(len(data)) {
+		vectorsLength := uint64(byteOps.ReadUint32())
+		ko.Vectors, err = unmarshalVectors(byteOps.ReadBytesFromBuffer(vectorsLength))
+		if err != nil {
+			return err
+		}
+	}
+
 	return ko.parseObject(
 		strfmt.UUID(uuidParsed.String()),
 		createTime,
@@ -715,6 +851,35 @@ func VectorFromBinary(in []byte) ([]float32, error) {
 	return out, nil
 }
 
+// TargetVectorFromBinary returns the named vector of the given target without
+// parsing the rest of the object, it is nil if the object has no such vector
+func TargetVectorFromBinary(in []byte, target string) ([]float32, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	version := in[0]
+	if version != 1 {
+		return nil, errors.Errorf("unsupported marshaller version %d", version)
+	}
+
+	byteOps := byte_operations.ByteOperations{Position: 42, Buffer: in}
+	byteOps.MoveBufferPositionForward(uint64(byteOps.ReadUint16()) * 4) // vector
+	byteOps.MoveBufferPositionForward(uint64(byteOps.ReadUint16()))     // class name
+	byteOps.DiscardBytesFromBufferWithUint32LengthIndicator()           // schema
+	byteOps.DiscardBytesFromBufferWithUint32LengthIndicator()           // meta
+	byteOps.DiscardBytesFromBufferWithUint32LengthIndicator()           // vector weights
+	if byteOps.Position >= uint64(len(in)) {
+		return nil, nil
+	}
+
+	vectors, err := unmarshalVectors(byteOps.ReadBytesFromBufferWithUint32LengthIndicator())
+	if err != nil {
+		return nil, err
+	}
+	return vectors[target], nil
+}
+
 func (ko *Object) parseObject(uuid strfmt.UUID, create, update int64, className string,
 	schemaB []byte, additionalB []byte, vectorWeightsB []byte,
 ) error {
@@ -779,6 +944,7 @@ func (ko *Object) DeepCopyDangerous() *Object {
 		docID:             ko.docID,
 		Object:            deepCopyObject(ko.Object),
 		Vector:            deepCopyVector(ko.Vector),
+		Vectors:           deepCopyVectors(ko.Vectors),
 	}
 }
 
@@ -795,6 +961,18 @@ func deepCopyVector(orig []float32) []float32 {
 	return out
 }
 
+func deepCopyVectors(orig map[string][]float32) map[string][]float32 {
+	if orig == nil {
+		return nil
+	}
+
+	vectors := make(map[string][]float32, len(orig))
+	for name, vector := range orig {
+		vectors[name] = deepCopyVector(vector)
+	}
+	return vectors
+}
+
 func deepCopyObject(orig models.Object) models.Object {
 	return models.Object{
 		Class:              orig.Class,
@@ -69,6 +69,16 @@ func TestStorageObjectMarshalling(t *testing.T) {
 		assert.Equal(t, uint64(7), id)
 	})
 
+	t.Run("extract only the header and compare", func(t *testing.T) {
+		header, err := FromBinaryUUIDOnly(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(7), header.DocID())
+		assert.Equal(t, before.ID(), header.ID())
+		assert.Equal(t, before.Class(), header.Class())
+		assert.Equal(t, int64(123456), header.CreationTimeUnix())
+		assert.Equal(t, int64(56789), header.LastUpdateTimeUnix())
+	})
+
 	t.Run("extract single text prop", func(t *testing.T) {
 		prop, ok, err := ParseAndExtractTextProp(asBinary, "name")
 		require.Nil(t, err)
@@ -85,6 +95,87 @@ func TestStorageObjectMarshalling(t *testing.T) {
 	})
 }
 
+func TestStorageObjectMarshallingNamedVectors(t *testing.T) {
+	before := FromObject(
+		&models.Object{
+			Class:              "MyFavoriteClass",
+			CreationTimeUnix:   123456,
+			LastUpdateTimeUnix: 56789,
+			ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"name": "MyName",
+			},
+			Vectors: models.Vectors{
+				"title_vec": {1, 2, 3},
+				"body_vec":  {0.5, 0.25},
+			},
+		},
+		[]float32{1, 2, 0.7},
+	)
+	before.SetDocID(7)
+	assert.Nil(t, before.Object.Vectors)
+	assert.Equal(t, map[string][]float32{
+		"title_vec": {1, 2, 3},
+		"body_vec":  {0.5, 0.25},
+	}, before.Vectors)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("full unmarshalling", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, before, after)
+	})
+
+	t.Run("optional unmarshalling with vectors", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary, additional.Properties{Vector: true})
+		require.Nil(t, err)
+		assert.Equal(t, before.Vector, after.Vector)
+		assert.Equal(t, before.Vectors, after.Vectors)
+	})
+
+	t.Run("optional unmarshalling without vectors", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary, additional.Properties{})
+		require.Nil(t, err)
+		assert.Nil(t, after.Vectors)
+		assert.Equal(t, "MyName", after.Properties().(map[string]interface{})["name"])
+	})
+
+	t.Run("target vector only", func(t *testing.T) {
+		vector, err := TargetVectorFromBinary(asBinary, "body_vec")
+		require.Nil(t, err)
+		assert.Equal(t, []float32{0.5, 0.25}, vector)
+
+		vector, err = TargetVectorFromBinary(asBinary, "other_vec")
+		require.Nil(t, err)
+		assert.Nil(t, vector)
+	})
+
+	t.Run("search result", func(t *testing.T) {
+		res := before.SearchResult(additional.Properties{})
+		assert.Equal(t, models.Vectors{
+			"title_vec": {1, 2, 3},
+			"body_vec":  {0.5, 0.25},
+		}, res.Object().Vectors)
+	})
+
+	t.Run("objects without named vectors keep their encoding", func(t *testing.T) {
+		before.Vectors = nil
+		withoutVectors, err := before.MarshalBinary()
+		require.Nil(t, err)
+		assert.Len(t, withoutVectors, len(asBinary)-4-(2+2+8+2+2*4+2+9+2+3*4))
+
+		after, err := FromBinary(withoutVectors)
+		require.Nil(t, err)
+		assert.Nil(t, after.Vectors)
+
+		vector, err := TargetVectorFromBinary(withoutVectors, "title_vec")
+		require.Nil(t, err)
+		assert.Nil(t, vector)
+	})
+}
+
 func TestFilteringNilProperty(t *testing.T) {
 	object := FromObject(
 		&models.Object{
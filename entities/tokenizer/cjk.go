@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package tokenizer
+
+import (
+	"strings"
+	"unicode"
+)
+
+// CJKName is the tokenization of the CJK tokenizer
+const CJKName = "cjk"
+
+func init() {
+	tokenizers[CJKName] = CJK{}
+}
+
+// CJK tokenizes text containing Chinese, Japanese and Korean characters. As
+// these scripts don't separate words by spaces, runs of CJK characters are
+// split into overlapping bigrams, so that a query matches the bigrams of the
+// words it contains. A run of a single character is kept as a unigram. All
+// other characters are tokenized like the word tokenization: they are split
+// on any non-alphanumerical character and lowercased.
+type CJK struct{}
+
+func (CJK) Tokenize(value string) []string {
+	return tokenizeCJK(value, false)
+}
+
+// TokenizeKeepWildcards keeps '*' and '?' as part of the current run, so
+// that e.g. "東京*" matches the bigrams starting with "京"
+func (CJK) TokenizeKeepWildcards(value string) []string {
+	return tokenizeCJK(value, true)
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana,
+		unicode.Hangul) ||
+		// prolonged sound mark, e.g. in "コーヒー"
+		r == 'ー'
+}
+
+func isWildcard(r rune) bool {
+	return r == '*' || r == '?'
+}
+
+func tokenizeCJK(value string, keepWildcards bool) []string {
+	var terms []string
+	var run []rune
+	// leading wildcards of a run join the run type of the next character
+	runIsCJK, runHasChars := false, false
+
+	flush := func() {
+		if len(run) == 0 {
+			return
+		}
+		if runIsCJK {
+			terms = append(terms, bigrams(run)...)
+		} else {
+			terms = append(terms, strings.ToLower(string(run)))
+		}
+		run = run[:0]
+		runHasChars = false
+	}
+
+	for _, r := range value {
+		switch {
+		case keepWildcards && isWildcard(r):
+			run = append(run, r)
+		case isCJK(r):
+			if !runIsCJK && runHasChars {
+				flush()
+			}
+			run = append(run, r)
+			runIsCJK, runHasChars = true, true
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			if runIsCJK && runHasChars {
+				flush()
+			}
+			run = append(run, r)
+			runIsCJK, runHasChars = false, true
+		default:
+			flush()
+		}
+	}
+	flush()
+
+	return terms
+}
+
+func bigrams(run []rune) []string {
+	if len(run) == 1 {
+		return []string{string(run)}
+	}
+
+	terms := make([]string, 0, len(run)-1)
+	for i := 0; i < len(run)-1; i++ {
+		terms = append(terms, string(run[i:i+2]))
+	}
+	return terms
+}
@@ -0,0 +1,53 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package tokenizer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCJK(t *testing.T) {
+	require.NotNil(t, Get(CJKName))
+	assert.ErrorContains(t, Register(CJKName, CJK{}), "already registered")
+
+	tests := []struct {
+		name     string
+		value    string
+		expected []string
+	}{
+		{"chinese", "東京都", []string{"東京", "京都"}},
+		{"single character", "猫", []string{"猫"}},
+		{"japanese", "コーヒーを飲む", []string{"コー", "ーヒ", "ヒー", "ーを", "を飲", "飲む"}},
+		{"korean", "한국어", []string{"한국", "국어"}},
+		{"punctuation", "東京、京都。", []string{"東京", "京都"}},
+		{"mixed scripts", "Weaviate是向量数据库", []string{"weaviate", "是向", "向量", "量数", "数据", "据库"}},
+		{"latin", "Hello, World 42", []string{"hello", "world", "42"}},
+		{"duplicates", "東京 東京", []string{"東京", "東京"}},
+		{"empty", "", nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, CJK{}.Tokenize(test.value))
+		})
+	}
+
+	t.Run("wildcards", func(t *testing.T) {
+		assert.Equal(t, []string{"東京", "京*"}, CJK{}.TokenizeKeepWildcards("東京*"))
+		assert.Equal(t, []string{"*京", "京都"}, CJK{}.TokenizeKeepWildcards("*京都"))
+		assert.Equal(t, []string{"we?viate", "向量"}, CJK{}.TokenizeKeepWildcards("We?viate 向量"))
+		assert.Equal(t, []string{"東京", "京"}, CJK{}.Tokenize("東京* 京?"))
+	})
+}
@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package tokenizer is the registry of custom tokenizers. A registered
+// tokenizer can be used as the tokenization of string and text properties,
+// it splits their values into the terms of the inverted index, as well as
+// the values of filters and BM25 queries on these properties.
+package tokenizer
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Tokenizer splits a value into terms. Duplicate terms must be kept, as
+// they are counted for BM25. A tokenizer must be deterministic, since the
+// values of filters must produce the same terms as the indexed values.
+type Tokenizer interface {
+	Tokenize(value string) []string
+}
+
+// WildcardTokenizer is implemented by tokenizers which support Like filters.
+// The terms must keep the wildcards '*' and '?' of the value. Like filters on
+// properties with other tokenizers use the regular terms.
+type WildcardTokenizer interface {
+	Tokenizer
+	TokenizeKeepWildcards(value string) []string
+}
+
+// Func is a Tokenizer implemented by a function
+type Func func(value string) []string
+
+func (f Func) Tokenize(value string) []string {
+	return f(value)
+}
+
+// builtin tokenizations can't be replaced
+var builtin = map[string]struct{}{
+	"word":  {},
+	"field": {},
+}
+
+var (
+	lock       sync.RWMutex
+	tokenizers = map[string]Tokenizer{}
+)
+
+// Register makes a tokenizer available under name. It fails if the name is
+// taken by a builtin or another registered tokenizer.
+func Register(name string, t Tokenizer) error {
+	if name == "" {
+		return fmt.Errorf("tokenizer name must not be empty")
+	}
+	if t == nil {
+		return fmt.Errorf("tokenizer %q is nil", name)
+	}
+	if _, ok := builtin[name]; ok {
+		return fmt.Errorf("tokenizer %q is builtin", name)
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	if _, ok := tokenizers[name]; ok {
+		return fmt.Errorf("tokenizer %q is already registered", name)
+	}
+	tokenizers[name] = t
+	return nil
+}
+
+// Get returns the tokenizer registered under name or nil
+func Get(name string) Tokenizer {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	return tokenizers[name]
+}
+
+// Names returns the names of all registered tokenizers in order
+func Names() []string {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	names := make([]string, 0, len(tokenizers))
+	for name := range tokenizers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// TokenizeKeepWildcards tokenizes the value of a Like filter
+func TokenizeKeepWildcards(t Tokenizer, value string) []string {
+	if wt, ok := t.(WildcardTokenizer); ok {
+		return wt.TokenizeKeepWildcards(value)
+	}
+	return t.Tokenize(value)
+}
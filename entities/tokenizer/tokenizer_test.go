@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package tokenizer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type wildcardTokenizer struct{}
+
+func (wildcardTokenizer) Tokenize(value string) []string {
+	return strings.Fields(strings.NewReplacer("*", "", "?", "").Replace(value))
+}
+
+func (wildcardTokenizer) TokenizeKeepWildcards(value string) []string {
+	return strings.Fields(value)
+}
+
+func TestRegistry(t *testing.T) {
+	lines := Func(func(value string) []string { return strings.Split(value, "\n") })
+
+	t.Run("register", func(t *testing.T) {
+		require.Nil(t, Register("lines", lines))
+		require.NotNil(t, Get("lines"))
+		assert.Equal(t, []string{"a b", "c"}, Get("lines").Tokenize("a b\nc"))
+		assert.Contains(t, Names(), "lines")
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		assert.Nil(t, Get("unknown"))
+	})
+
+	t.Run("invalid registrations", func(t *testing.T) {
+		assert.ErrorContains(t, Register("lines", lines), "already registered")
+		assert.ErrorContains(t, Register("word", lines), "builtin")
+		assert.ErrorContains(t, Register("field", lines), "builtin")
+		assert.NotNil(t, Register("", lines))
+		assert.NotNil(t, Register("nil", nil))
+	})
+
+	t.Run("wildcards", func(t *testing.T) {
+		assert.Equal(t, []string{"fo*", "b?r"},
+			TokenizeKeepWildcards(wildcardTokenizer{}, "fo* b?r"))
+		assert.Equal(t, []string{"fo* b?r"},
+			TokenizeKeepWildcards(lines, "fo* b?r"))
+	})
+}
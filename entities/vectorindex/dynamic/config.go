@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const (
+	// IndexType is the vectorIndexType of classes using a dynamic index
+	IndexType = "dynamic"
+
+	DefaultThreshold      = 10_000
+	DefaultDistanceMetric = hnsw.DistanceCosine
+)
+
+// UserConfig bundles all values settable by a user in the per-class settings
+// of a dynamic index. Every shard starts out with a flat index and builds an
+// hnsw index once it holds Threshold objects. The distance applies to both
+// indexes, so that the results don't change with the upgrade.
+type UserConfig struct {
+	Distance  string          `json:"distance"`
+	Threshold int             `json:"threshold"`
+	HNSW      hnsw.UserConfig `json:"hnsw"`
+	Flat      flat.UserConfig `json:"flat"`
+}
+
+// IndexType returns the type of the underlying vector index, thus making sure
+// the schema.VectorIndexConfig interface is implemented
+func (u UserConfig) IndexType() string {
+	return IndexType
+}
+
+// SetDefaults in the user-specifyable part of the config
+func (u *UserConfig) SetDefaults() {
+	u.Distance = DefaultDistanceMetric
+	u.Threshold = DefaultThreshold
+	u.HNSW = hnsw.NewDefaultUserConfig()
+	u.Flat = flat.NewDefaultUserConfig()
+}
+
+// ParseAndValidateConfig from an unknown input value, as this is not further
+// specified in the API to allow of exchanging the index type
+func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error) {
+	uc := UserConfig{}
+	uc.SetDefaults()
+
+	if input == nil {
+		return uc, nil
+	}
+
+	asMap, ok := input.(map[string]interface{})
+	if !ok || asMap == nil {
+		return uc, fmt.Errorf("input must be a non-nil map")
+	}
+
+	if value, ok := asMap["distance"].(string); ok {
+		uc.Distance = value
+	}
+
+	switch value := asMap["threshold"].(type) {
+	case json.Number:
+		asInt64, err := value.Int64()
+		if err != nil {
+			return uc, fmt.Errorf("json.Number to int64 for \"threshold\": %w", err)
+		}
+		uc.Threshold = int(asInt64)
+	case float64:
+		uc.Threshold = int(value)
+	}
+
+	hnswConfig, err := hnsw.ParseAndValidateConfig(
+		withDistance(asMap["hnsw"], uc.Distance))
+	if err != nil {
+		return uc, fmt.Errorf("invalid dynamic config: hnsw: %w", err)
+	}
+	uc.HNSW = hnswConfig.(hnsw.UserConfig)
+
+	flatConfig, err := flat.ParseAndValidateConfig(
+		withDistance(asMap["flat"], uc.Distance))
+	if err != nil {
+		return uc, fmt.Errorf("invalid dynamic config: flat: %w", err)
+	}
+	uc.Flat = flatConfig.(flat.UserConfig)
+
+	return uc, uc.validate()
+}
+
+// withDistance sets the distance of the dynamic index in the config of one of
+// its indexes. A distance set there already must be the same.
+func withDistance(input interface{}, distance string) interface{} {
+	asMap, ok := input.(map[string]interface{})
+	if !ok {
+		return map[string]interface{}{"distance": distance}
+	}
+
+	out := make(map[string]interface{}, len(asMap)+1)
+	for key, value := range asMap {
+		out[key] = value
+	}
+	if _, ok := out["distance"]; !ok {
+		out["distance"] = distance
+	}
+	return out
+}
+
+func (u UserConfig) validate() error {
+	if u.Threshold < 0 {
+		return fmt.Errorf("invalid dynamic config: threshold must not be negative")
+	}
+	if u.HNSW.Distance != u.Distance || u.Flat.Distance != u.Distance {
+		return fmt.Errorf("invalid dynamic config: the distance of the hnsw and " +
+			"the flat index must match the distance of the dynamic index")
+	}
+	if u.HNSW.Skip || u.Flat.Skip {
+		return fmt.Errorf("invalid dynamic config: skip is not supported, " +
+			"use a flat or hnsw index instead")
+	}
+	return nil
+}
+
+func NewDefaultUserConfig() UserConfig {
+	uc := UserConfig{}
+	uc.SetDefaults()
+	return uc
+}
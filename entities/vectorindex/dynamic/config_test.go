@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func Test_UserConfig(t *testing.T) {
+	t.Run("with defaults", func(t *testing.T) {
+		cfg, err := ParseAndValidateConfig(nil)
+		require.Nil(t, err)
+		assert.Equal(t, NewDefaultUserConfig(), cfg)
+		assert.Equal(t, "dynamic", cfg.IndexType())
+	})
+
+	t.Run("with the distance applied to both indexes", func(t *testing.T) {
+		cfg, err := ParseAndValidateConfig(map[string]interface{}{
+			"distance":  "l2-squared",
+			"threshold": json.Number("500"),
+			"hnsw": map[string]interface{}{
+				"efConstruction": json.Number("256"),
+			},
+		})
+		require.Nil(t, err)
+
+		parsed := cfg.(UserConfig)
+		assert.Equal(t, 500, parsed.Threshold)
+		assert.Equal(t, "l2-squared", parsed.Distance)
+		assert.Equal(t, "l2-squared", parsed.HNSW.Distance)
+		assert.Equal(t, "l2-squared", parsed.Flat.Distance)
+		assert.Equal(t, 256, parsed.HNSW.EFConstruction)
+		assert.Equal(t, hnsw.DefaultMaxConnections, parsed.HNSW.MaxConnections)
+	})
+
+	t.Run("with a different distance of the hnsw index", func(t *testing.T) {
+		_, err := ParseAndValidateConfig(map[string]interface{}{
+			"distance": "dot",
+			"hnsw": map[string]interface{}{
+				"distance": "cosine",
+			},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "must match the distance")
+	})
+
+	t.Run("with skip", func(t *testing.T) {
+		_, err := ParseAndValidateConfig(map[string]interface{}{
+			"flat": map[string]interface{}{
+				"skip": true,
+			},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "skip is not supported")
+	})
+
+	t.Run("with a negative threshold", func(t *testing.T) {
+		_, err := ParseAndValidateConfig(map[string]interface{}{
+			"threshold": float64(-1),
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "threshold must not be negative")
+	})
+}
@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const (
+	// IndexType is the vectorIndexType of classes using a flat index
+	IndexType = "flat"
+
+	DefaultSkip           = false
+	DefaultDistanceMetric = hnsw.DistanceCosine
+)
+
+// UserConfig bundles all values settable by a user in the per-class settings
+// of a flat index. A flat index does not build a graph, every search compares
+// the query against all (allowed) vectors, so the results are exact.
+type UserConfig struct {
+	Skip             bool                  `json:"skip"`
+	Distance         string                `json:"distance"`
+	VectorValidation hnsw.VectorValidation `json:"vectorValidation"`
+}
+
+// IndexType returns the type of the underlying vector index, thus making sure
+// the schema.VectorIndexConfig interface is implemented
+func (u UserConfig) IndexType() string {
+	return IndexType
+}
+
+// SetDefaults in the user-specifyable part of the config
+func (u *UserConfig) SetDefaults() {
+	u.Skip = DefaultSkip
+	u.Distance = DefaultDistanceMetric
+}
+
+// ParseAndValidateConfig from an unknown input value, as this is not further
+// specified in the API to allow of exchanging the index type
+func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error) {
+	uc := UserConfig{}
+	uc.SetDefaults()
+
+	if input == nil {
+		return uc, nil
+	}
+
+	asMap, ok := input.(map[string]interface{})
+	if !ok || asMap == nil {
+		return uc, fmt.Errorf("input must be a non-nil map")
+	}
+
+	if value, ok := asMap["skip"].(bool); ok {
+		uc.Skip = value
+	}
+
+	if value, ok := asMap["distance"].(string); ok {
+		uc.Distance = value
+	}
+
+	if err := hnsw.ParseVectorValidationMap(asMap, &uc.VectorValidation); err != nil {
+		return uc, err
+	}
+
+	return uc, uc.validate()
+}
+
+func (u UserConfig) validate() error {
+	switch u.Distance {
+	case hnsw.DistanceCosine, hnsw.DistanceDot, hnsw.DistanceL2Squared,
+		hnsw.DistanceManhattan, hnsw.DistanceHamming:
+		return nil
+	default:
+		return fmt.Errorf("invalid flat config: unrecognized distance metric %q", u.Distance)
+	}
+}
+
+func NewDefaultUserConfig() UserConfig {
+	uc := UserConfig{}
+	uc.SetDefaults()
+	return uc
+}
@@ -0,0 +1,58 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func Test_UserConfig(t *testing.T) {
+	t.Run("with defaults", func(t *testing.T) {
+		cfg, err := ParseAndValidateConfig(nil)
+		require.Nil(t, err)
+		assert.Equal(t, UserConfig{Distance: DefaultDistanceMetric}, cfg)
+	})
+
+	t.Run("with all fields set", func(t *testing.T) {
+		cfg, err := ParseAndValidateConfig(map[string]interface{}{
+			"skip":     true,
+			"distance": "l2-squared",
+			"vectorValidation": map[string]interface{}{
+				"dimensions": json.Number("3"),
+				"rejectZero": true,
+			},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, UserConfig{
+			Skip:     true,
+			Distance: "l2-squared",
+			VectorValidation: hnsw.VectorValidation{
+				Dimensions: 3,
+				RejectZero: true,
+			},
+		}, cfg)
+		assert.Equal(t, "flat", cfg.IndexType())
+	})
+
+	t.Run("with an invalid distance", func(t *testing.T) {
+		_, err := ParseAndValidateConfig(map[string]interface{}{
+			"distance": "euclidean",
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "unrecognized distance metric")
+	})
+}
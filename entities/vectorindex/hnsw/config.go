@@ -41,6 +41,7 @@ const (
 	DefaultDynamicEFFactor        = 8
 	DefaultVectorCacheMaxObjects  = 1e12
 	DefaultSkip                   = false
+	DefaultDeferIndexing          = false
 	DefaultFlatSearchCutoff       = 40000
 	DefaultDistanceMetric         = DistanceCosine
 
@@ -51,18 +52,27 @@ const (
 
 // UserConfig bundles all values settable by a user in the per-class settings
 type UserConfig struct {
-	Skip                   bool     `json:"skip"`
-	CleanupIntervalSeconds int      `json:"cleanupIntervalSeconds"`
-	MaxConnections         int      `json:"maxConnections"`
-	EFConstruction         int      `json:"efConstruction"`
-	EF                     int      `json:"ef"`
-	DynamicEFMin           int      `json:"dynamicEfMin"`
-	DynamicEFMax           int      `json:"dynamicEfMax"`
-	DynamicEFFactor        int      `json:"dynamicEfFactor"`
-	VectorCacheMaxObjects  int      `json:"vectorCacheMaxObjects"`
-	FlatSearchCutoff       int      `json:"flatSearchCutoff"`
-	Distance               string   `json:"distance"`
-	PQ                     PQConfig `json:"pq"`
+	Skip                   bool               `json:"skip"`
+	CleanupIntervalSeconds int                `json:"cleanupIntervalSeconds"`
+	MaxConnections         int                `json:"maxConnections"`
+	EFConstruction         int                `json:"efConstruction"`
+	EF                     int                `json:"ef"`
+	DynamicEFMin           int                `json:"dynamicEfMin"`
+	DynamicEFMax           int                `json:"dynamicEfMax"`
+	DynamicEFFactor        int                `json:"dynamicEfFactor"`
+	VectorCacheMaxObjects  int                `json:"vectorCacheMaxObjects"`
+	FlatSearchCutoff       int                `json:"flatSearchCutoff"`
+	FilterStrategy         string             `json:"filterStrategy"`
+	Distance               string             `json:"distance"`
+	PQ                     PQConfig           `json:"pq"`
+	SQ                     SQConfig           `json:"sq"`
+	VectorValidation       VectorValidation   `json:"vectorValidation"`
+	DimensionReduction     DimensionReduction `json:"dimensionReduction"`
+	// DeferIndexing queues the vectors of written objects without indexing
+	// them. Switching it off builds the index from the queued vectors with
+	// parallel workers, which is considerably faster than inserting them one
+	// by one for large one-shot imports and restores.
+	DeferIndexing bool `json:"deferIndexing"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -82,7 +92,9 @@ func (c *UserConfig) SetDefaults() {
 	c.DynamicEFMax = DefaultDynamicEFMax
 	c.DynamicEFMin = DefaultDynamicEFMin
 	c.Skip = DefaultSkip
+	c.DeferIndexing = DefaultDeferIndexing
 	c.FlatSearchCutoff = DefaultFlatSearchCutoff
+	c.FilterStrategy = DefaultFilterStrategy
 	c.Distance = DefaultDistanceMetric
 	c.PQ = PQConfig{
 		Enabled:        DefaultPQEnabled,
@@ -93,6 +105,30 @@ func (c *UserConfig) SetDefaults() {
 			Type:         DefaultPQEncoderType,
 			Distribution: DefaultPQEncoderDistribution,
 		},
+		Rotation: PQRotation{
+			Enabled:    DefaultPQRotationEnabled,
+			Iterations: DefaultPQRotationIterations,
+		},
+		DriftThreshold: DefaultPQDriftThreshold,
+		RecallSampling: PQRecallSampling{
+			IntervalSeconds:  DefaultPQRecallSamplingIntervalSeconds,
+			Queries:          DefaultPQRecallSamplingQueries,
+			WarningThreshold: DefaultPQRecallSamplingWarningThreshold,
+		},
+	}
+	c.SQ = SQConfig{
+		Enabled: DefaultSQEnabled,
+	}
+	c.VectorValidation = VectorValidation{
+		Dimensions:      DefaultVectorValidationDimensions,
+		RejectZero:      DefaultVectorValidationRejectZero,
+		RejectNonFinite: DefaultVectorValidationRejectNonFinite,
+		Normalize:       DefaultVectorValidationNormalize,
+	}
+	c.DimensionReduction = DimensionReduction{
+		Type:          DefaultDimensionReductionType,
+		Dimensions:    DefaultDimensionReductionDimensions,
+		TrainingLimit: DefaultDimensionReductionTrainingLimit,
 	}
 }
 
@@ -165,12 +201,24 @@ func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error)
 		return uc, err
 	}
 
+	if err := optionalStringFromMap(asMap, "filterStrategy", func(v string) {
+		uc.FilterStrategy = v
+	}); err != nil {
+		return uc, err
+	}
+
 	if err := optionalBoolFromMap(asMap, "skip", func(v bool) {
 		uc.Skip = v
 	}); err != nil {
 		return uc, err
 	}
 
+	if err := optionalBoolFromMap(asMap, "deferIndexing", func(v bool) {
+		uc.DeferIndexing = v
+	}); err != nil {
+		return uc, err
+	}
+
 	if err := optionalStringFromMap(asMap, "distance", func(v string) {
 		uc.Distance = v
 	}); err != nil {
@@ -181,6 +229,18 @@ func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error)
 		return uc, err
 	}
 
+	if err := parseSQMap(asMap, &uc.SQ); err != nil {
+		return uc, err
+	}
+
+	if err := ParseVectorValidationMap(asMap, &uc.VectorValidation); err != nil {
+		return uc, err
+	}
+
+	if err := parseDimensionReductionMap(asMap, &uc.DimensionReduction); err != nil {
+		return uc, err
+	}
+
 	return uc, uc.validate()
 }
 
@@ -200,6 +260,14 @@ func (uc *UserConfig) validate() error {
 		))
 	}
 
+	if err := ValidateFilterStrategy(uc.FilterStrategy); err != nil {
+		errMsgs = append(errMsgs, err.Error())
+	}
+
+	if uc.PQ.Enabled && uc.SQ.Enabled {
+		errMsgs = append(errMsgs, "pq and sq cannot be enabled at the same time")
+	}
+
 	if len(errMsgs) > 0 {
 		return fmt.Errorf("invalid hnsw config: %s",
 			strings.Join(errMsgs, ", "))
@@ -244,6 +312,28 @@ func optionalIntFromMap(in map[string]interface{}, name string,
 	return nil
 }
 
+func optionalFloatFromMap(in map[string]interface{}, name string,
+	setFn func(v float64),
+) error {
+	value, ok := in[name]
+	if !ok {
+		return nil
+	}
+
+	switch typed := value.(type) {
+	case json.Number:
+		asFloat, err := typed.Float64()
+		if err != nil {
+			return errors.Wrapf(err, "json.Number to float64 for %q", name)
+		}
+		setFn(asFloat)
+	case float64:
+		setFn(typed)
+	}
+
+	return nil
+}
+
 func optionalBoolFromMap(in map[string]interface{}, name string,
 	setFn func(v bool),
 ) error {
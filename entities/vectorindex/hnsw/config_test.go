@@ -41,6 +41,7 @@ func Test_UserConfig(t *testing.T) {
 				EF:                     DefaultEF,
 				Skip:                   DefaultSkip,
 				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           DefaultDynamicEFMin,
 				DynamicEFMax:           DefaultDynamicEFMax,
 				DynamicEFFactor:        DefaultDynamicEFFactor,
@@ -54,6 +55,21 @@ func Test_UserConfig(t *testing.T) {
 						Type:         DefaultPQEncoderType,
 						Distribution: DefaultPQEncoderDistribution,
 					},
+					Rotation: PQRotation{
+						Enabled:    DefaultPQRotationEnabled,
+						Iterations: DefaultPQRotationIterations,
+					},
+					DriftThreshold: DefaultPQDriftThreshold,
+					RecallSampling: PQRecallSampling{
+						IntervalSeconds:  DefaultPQRecallSamplingIntervalSeconds,
+						Queries:          DefaultPQRecallSamplingQueries,
+						WarningThreshold: DefaultPQRecallSamplingWarningThreshold,
+					},
+				},
+				DimensionReduction: DimensionReduction{
+					Type:          DefaultDimensionReductionType,
+					Dimensions:    DefaultDimensionReductionDimensions,
+					TrainingLimit: DefaultDimensionReductionTrainingLimit,
 				},
 			},
 		},
@@ -70,6 +86,7 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
 				EF:                     DefaultEF,
 				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           DefaultDynamicEFMin,
 				DynamicEFMax:           DefaultDynamicEFMax,
 				DynamicEFFactor:        DefaultDynamicEFFactor,
@@ -83,6 +100,21 @@ func Test_UserConfig(t *testing.T) {
 						Type:         DefaultPQEncoderType,
 						Distribution: DefaultPQEncoderDistribution,
 					},
+					Rotation: PQRotation{
+						Enabled:    DefaultPQRotationEnabled,
+						Iterations: DefaultPQRotationIterations,
+					},
+					DriftThreshold: DefaultPQDriftThreshold,
+					RecallSampling: PQRecallSampling{
+						IntervalSeconds:  DefaultPQRecallSamplingIntervalSeconds,
+						Queries:          DefaultPQRecallSamplingQueries,
+						WarningThreshold: DefaultPQRecallSamplingWarningThreshold,
+					},
+				},
+				DimensionReduction: DimensionReduction{
+					Type:          DefaultDimensionReductionType,
+					Dimensions:    DefaultDimensionReductionDimensions,
+					TrainingLimit: DefaultDimensionReductionTrainingLimit,
 				},
 			},
 		},
@@ -109,6 +141,7 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
@@ -123,6 +156,21 @@ func Test_UserConfig(t *testing.T) {
 						Type:         DefaultPQEncoderType,
 						Distribution: DefaultPQEncoderDistribution,
 					},
+					Rotation: PQRotation{
+						Enabled:    DefaultPQRotationEnabled,
+						Iterations: DefaultPQRotationIterations,
+					},
+					DriftThreshold: DefaultPQDriftThreshold,
+					RecallSampling: PQRecallSampling{
+						IntervalSeconds:  DefaultPQRecallSamplingIntervalSeconds,
+						Queries:          DefaultPQRecallSamplingQueries,
+						WarningThreshold: DefaultPQRecallSamplingWarningThreshold,
+					},
+				},
+				DimensionReduction: DimensionReduction{
+					Type:          DefaultDimensionReductionType,
+					Dimensions:    DefaultDimensionReductionDimensions,
+					TrainingLimit: DefaultDimensionReductionTrainingLimit,
 				},
 			},
 		},
@@ -149,6 +197,7 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
@@ -163,6 +212,21 @@ func Test_UserConfig(t *testing.T) {
 						Type:         DefaultPQEncoderType,
 						Distribution: DefaultPQEncoderDistribution,
 					},
+					Rotation: PQRotation{
+						Enabled:    DefaultPQRotationEnabled,
+						Iterations: DefaultPQRotationIterations,
+					},
+					DriftThreshold: DefaultPQDriftThreshold,
+					RecallSampling: PQRecallSampling{
+						IntervalSeconds:  DefaultPQRecallSamplingIntervalSeconds,
+						Queries:          DefaultPQRecallSamplingQueries,
+						WarningThreshold: DefaultPQRecallSamplingWarningThreshold,
+					},
+				},
+				DimensionReduction: DimensionReduction{
+					Type:          DefaultDimensionReductionType,
+					Dimensions:    DefaultDimensionReductionDimensions,
+					TrainingLimit: DefaultDimensionReductionTrainingLimit,
 				},
 			},
 		},
@@ -189,6 +253,7 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
@@ -203,6 +268,21 @@ func Test_UserConfig(t *testing.T) {
 						Type:         DefaultPQEncoderType,
 						Distribution: DefaultPQEncoderDistribution,
 					},
+					Rotation: PQRotation{
+						Enabled:    DefaultPQRotationEnabled,
+						Iterations: DefaultPQRotationIterations,
+					},
+					DriftThreshold: DefaultPQDriftThreshold,
+					RecallSampling: PQRecallSampling{
+						IntervalSeconds:  DefaultPQRecallSamplingIntervalSeconds,
+						Queries:          DefaultPQRecallSamplingQueries,
+						WarningThreshold: DefaultPQRecallSamplingWarningThreshold,
+					},
+				},
+				DimensionReduction: DimensionReduction{
+					Type:          DefaultDimensionReductionType,
+					Dimensions:    DefaultDimensionReductionDimensions,
+					TrainingLimit: DefaultDimensionReductionTrainingLimit,
 				},
 			},
 		},
@@ -228,6 +308,7 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
@@ -241,6 +322,21 @@ func Test_UserConfig(t *testing.T) {
 						Type:         DefaultPQEncoderType,
 						Distribution: DefaultPQEncoderDistribution,
 					},
+					Rotation: PQRotation{
+						Enabled:    DefaultPQRotationEnabled,
+						Iterations: DefaultPQRotationIterations,
+					},
+					DriftThreshold: DefaultPQDriftThreshold,
+					RecallSampling: PQRecallSampling{
+						IntervalSeconds:  DefaultPQRecallSamplingIntervalSeconds,
+						Queries:          DefaultPQRecallSamplingQueries,
+						WarningThreshold: DefaultPQRecallSamplingWarningThreshold,
+					},
+				},
+				DimensionReduction: DimensionReduction{
+					Type:          DefaultDimensionReductionType,
+					Dimensions:    DefaultDimensionReductionDimensions,
+					TrainingLimit: DefaultDimensionReductionTrainingLimit,
 				},
 			},
 		},
@@ -275,6 +371,7 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
@@ -287,6 +384,21 @@ func Test_UserConfig(t *testing.T) {
 						Type:         "tile",
 						Distribution: "normal",
 					},
+					Rotation: PQRotation{
+						Enabled:    DefaultPQRotationEnabled,
+						Iterations: DefaultPQRotationIterations,
+					},
+					DriftThreshold: DefaultPQDriftThreshold,
+					RecallSampling: PQRecallSampling{
+						IntervalSeconds:  DefaultPQRecallSamplingIntervalSeconds,
+						Queries:          DefaultPQRecallSamplingQueries,
+						WarningThreshold: DefaultPQRecallSamplingWarningThreshold,
+					},
+				},
+				DimensionReduction: DimensionReduction{
+					Type:          DefaultDimensionReductionType,
+					Dimensions:    DefaultDimensionReductionDimensions,
+					TrainingLimit: DefaultDimensionReductionTrainingLimit,
 				},
 			},
 		},
@@ -320,6 +432,7 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
@@ -332,6 +445,21 @@ func Test_UserConfig(t *testing.T) {
 						Type:         "kmeans",
 						Distribution: DefaultPQEncoderDistribution,
 					},
+					Rotation: PQRotation{
+						Enabled:    DefaultPQRotationEnabled,
+						Iterations: DefaultPQRotationIterations,
+					},
+					DriftThreshold: DefaultPQDriftThreshold,
+					RecallSampling: PQRecallSampling{
+						IntervalSeconds:  DefaultPQRecallSamplingIntervalSeconds,
+						Queries:          DefaultPQRecallSamplingQueries,
+						WarningThreshold: DefaultPQRecallSamplingWarningThreshold,
+					},
+				},
+				DimensionReduction: DimensionReduction{
+					Type:          DefaultDimensionReductionType,
+					Dimensions:    DefaultDimensionReductionDimensions,
+					TrainingLimit: DefaultDimensionReductionTrainingLimit,
 				},
 			},
 		},
@@ -364,6 +492,72 @@ func Test_UserConfig(t *testing.T) {
 			expectErrMsg: "invalid encoder distribution: lognormal",
 		},
 
+		{
+			name: "with pq rotation and drift threshold",
+			input: map[string]interface{}{
+				"pq": map[string]interface{}{
+					"enabled":        true,
+					"driftThreshold": json.Number("0.25"),
+					"rotation": map[string]interface{}{
+						"enabled":    true,
+						"iterations": json.Number("3"),
+					},
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				FilterStrategy:         DefaultFilterStrategy,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:   true,
+					Segments:  DefaultPQSegments,
+					Centroids: DefaultPQCentroids,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+					Rotation: PQRotation{
+						Enabled:    true,
+						Iterations: 3,
+					},
+					DriftThreshold: 0.25,
+					RecallSampling: PQRecallSampling{
+						IntervalSeconds:  DefaultPQRecallSamplingIntervalSeconds,
+						Queries:          DefaultPQRecallSamplingQueries,
+						WarningThreshold: DefaultPQRecallSamplingWarningThreshold,
+					},
+				},
+				DimensionReduction: DimensionReduction{
+					Type:          DefaultDimensionReductionType,
+					Dimensions:    DefaultDimensionReductionDimensions,
+					TrainingLimit: DefaultDimensionReductionTrainingLimit,
+				},
+			},
+		},
+
+		{
+			name: "with invalid pq rotation iterations",
+			input: map[string]interface{}{
+				"pq": map[string]interface{}{
+					"enabled": true,
+					"rotation": map[string]interface{}{
+						"enabled":    true,
+						"iterations": json.Number("0"),
+					},
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "pq rotation iterations must be a positive integer",
+		},
+
 		{
 			// opposed to from the API
 			name: "with rounded vectorCacheMaxObjects that would otherwise overflow",
@@ -385,6 +579,7 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  math.MaxInt64,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
@@ -398,9 +593,230 @@ func Test_UserConfig(t *testing.T) {
 						Type:         DefaultPQEncoderType,
 						Distribution: DefaultPQEncoderDistribution,
 					},
+					Rotation: PQRotation{
+						Enabled:    DefaultPQRotationEnabled,
+						Iterations: DefaultPQRotationIterations,
+					},
+					DriftThreshold: DefaultPQDriftThreshold,
+					RecallSampling: PQRecallSampling{
+						IntervalSeconds:  DefaultPQRecallSamplingIntervalSeconds,
+						Queries:          DefaultPQRecallSamplingQueries,
+						WarningThreshold: DefaultPQRecallSamplingWarningThreshold,
+					},
+				},
+				DimensionReduction: DimensionReduction{
+					Type:          DefaultDimensionReductionType,
+					Dimensions:    DefaultDimensionReductionDimensions,
+					TrainingLimit: DefaultDimensionReductionTrainingLimit,
+				},
+			},
+		},
+		{
+			name: "with vector validation",
+			input: map[string]interface{}{
+				"vectorValidation": map[string]interface{}{
+					"dimensions":      json.Number("3"),
+					"rejectZero":      true,
+					"rejectNonFinite": true,
+					"normalize":       true,
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				Skip:                   DefaultSkip,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				FilterStrategy:         DefaultFilterStrategy,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+					Rotation: PQRotation{
+						Enabled:    DefaultPQRotationEnabled,
+						Iterations: DefaultPQRotationIterations,
+					},
+					DriftThreshold: DefaultPQDriftThreshold,
+					RecallSampling: PQRecallSampling{
+						IntervalSeconds:  DefaultPQRecallSamplingIntervalSeconds,
+						Queries:          DefaultPQRecallSamplingQueries,
+						WarningThreshold: DefaultPQRecallSamplingWarningThreshold,
+					},
+				},
+				VectorValidation: VectorValidation{
+					Dimensions:      3,
+					RejectZero:      true,
+					RejectNonFinite: true,
+					Normalize:       true,
+				},
+				DimensionReduction: DimensionReduction{
+					Type:          DefaultDimensionReductionType,
+					Dimensions:    DefaultDimensionReductionDimensions,
+					TrainingLimit: DefaultDimensionReductionTrainingLimit,
 				},
 			},
 		},
+		{
+			name: "with invalid vector validation dimensions",
+			input: map[string]interface{}{
+				"vectorValidation": map[string]interface{}{
+					"dimensions": json.Number("-1"),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "vector validation dimensions must be a non-negative integer",
+		},
+		{
+			name: "with pca dimension reduction",
+			input: map[string]interface{}{
+				"dimensionReduction": map[string]interface{}{
+					"type":          "pca",
+					"dimensions":    json.Number("64"),
+					"trainingLimit": json.Number("5000"),
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				Skip:                   DefaultSkip,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				FilterStrategy:         DefaultFilterStrategy,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+					Rotation: PQRotation{
+						Enabled:    DefaultPQRotationEnabled,
+						Iterations: DefaultPQRotationIterations,
+					},
+					DriftThreshold: DefaultPQDriftThreshold,
+					RecallSampling: PQRecallSampling{
+						IntervalSeconds:  DefaultPQRecallSamplingIntervalSeconds,
+						Queries:          DefaultPQRecallSamplingQueries,
+						WarningThreshold: DefaultPQRecallSamplingWarningThreshold,
+					},
+				},
+				VectorValidation: VectorValidation{
+					Dimensions:      DefaultVectorValidationDimensions,
+					RejectZero:      DefaultVectorValidationRejectZero,
+					RejectNonFinite: DefaultVectorValidationRejectNonFinite,
+					Normalize:       DefaultVectorValidationNormalize,
+				},
+				DimensionReduction: DimensionReduction{
+					Type:          DimensionReductionPCA,
+					Dimensions:    64,
+					TrainingLimit: 5000,
+				},
+			},
+		},
+		{
+			name: "with deferred indexing",
+			input: map[string]interface{}{
+				"deferIndexing": true,
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				Skip:                   DefaultSkip,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				FilterStrategy:         DefaultFilterStrategy,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+					Rotation: PQRotation{
+						Enabled:    DefaultPQRotationEnabled,
+						Iterations: DefaultPQRotationIterations,
+					},
+					DriftThreshold: DefaultPQDriftThreshold,
+					RecallSampling: PQRecallSampling{
+						IntervalSeconds:  DefaultPQRecallSamplingIntervalSeconds,
+						Queries:          DefaultPQRecallSamplingQueries,
+						WarningThreshold: DefaultPQRecallSamplingWarningThreshold,
+					},
+				},
+				VectorValidation: VectorValidation{
+					Dimensions:      DefaultVectorValidationDimensions,
+					RejectZero:      DefaultVectorValidationRejectZero,
+					RejectNonFinite: DefaultVectorValidationRejectNonFinite,
+					Normalize:       DefaultVectorValidationNormalize,
+				},
+				DimensionReduction: DimensionReduction{
+					Type:          DefaultDimensionReductionType,
+					Dimensions:    DefaultDimensionReductionDimensions,
+					TrainingLimit: DefaultDimensionReductionTrainingLimit,
+				},
+				DeferIndexing: true,
+			},
+		},
+		{
+			name: "with invalid dimension reduction type",
+			input: map[string]interface{}{
+				"dimensionReduction": map[string]interface{}{
+					"type":       "svd",
+					"dimensions": json.Number("64"),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid dimension reduction type: svd",
+		},
+		{
+			name: "with truncation but without dimensions",
+			input: map[string]interface{}{
+				"dimensionReduction": map[string]interface{}{
+					"type": "truncate",
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "dimension reduction requires a positive number of dimensions",
+		},
+		{
+			name: "with pca and a too small training limit",
+			input: map[string]interface{}{
+				"dimensionReduction": map[string]interface{}{
+					"type":          "pca",
+					"dimensions":    json.Number("64"),
+					"trainingLimit": json.Number("10"),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "pca dimension reduction requires a trainingLimit of at least 64",
+		},
 		{
 			name: "invalid max connections (json)",
 			input: map[string]interface{}{
@@ -437,6 +853,72 @@ func Test_UserConfig(t *testing.T) {
 			expectErrMsg: "efConstruction must be a positive integer " +
 				"with a minimum of 4",
 		},
+		{
+			name: "with acorn filter strategy",
+			input: map[string]interface{}{
+				"filterStrategy": "acorn",
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				Skip:                   DefaultSkip,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				FilterStrategy:         FilterStrategyAcorn,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+					Rotation: PQRotation{
+						Enabled:    DefaultPQRotationEnabled,
+						Iterations: DefaultPQRotationIterations,
+					},
+					DriftThreshold: DefaultPQDriftThreshold,
+					RecallSampling: PQRecallSampling{
+						IntervalSeconds:  DefaultPQRecallSamplingIntervalSeconds,
+						Queries:          DefaultPQRecallSamplingQueries,
+						WarningThreshold: DefaultPQRecallSamplingWarningThreshold,
+					},
+				},
+				DimensionReduction: DimensionReduction{
+					Type:          DefaultDimensionReductionType,
+					Dimensions:    DefaultDimensionReductionDimensions,
+					TrainingLimit: DefaultDimensionReductionTrainingLimit,
+				},
+			},
+		},
+		{
+			name: "invalid filter strategy",
+			input: map[string]interface{}{
+				"filterStrategy": "unknown",
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid filter strategy \"unknown\"",
+		},
+		{
+			name: "pq and sq enabled at the same time",
+			input: map[string]interface{}{
+				"pq": map[string]interface{}{
+					"enabled": true,
+				},
+				"sq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "pq and sq cannot be enabled at the same time",
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import "fmt"
+
+const (
+	DimensionReductionNone     = "none"
+	DimensionReductionTruncate = "truncate"
+	DimensionReductionPCA      = "pca"
+
+	DefaultDimensionReductionType          = DimensionReductionNone
+	DefaultDimensionReductionDimensions    = 0
+	DefaultDimensionReductionTrainingLimit = 10000
+)
+
+// DimensionReduction reduces the vectors to the configured number of
+// dimensions before they are indexed or searched. "truncate" keeps the first
+// dimensions (suitable for Matryoshka embeddings), "pca" projects onto the
+// principal components which are learned once TrainingLimit vectors have been
+// imported. The stored objects always keep the original vectors.
+type DimensionReduction struct {
+	Type          string `json:"type"`
+	Dimensions    int    `json:"dimensions"`
+	TrainingLimit int    `json:"trainingLimit"`
+}
+
+func (r DimensionReduction) Enabled() bool {
+	return r.Type != "" && r.Type != DimensionReductionNone
+}
+
+func parseDimensionReductionMap(in map[string]interface{}, reduction *DimensionReduction) error {
+	reductionValue, ok := in["dimensionReduction"]
+	if !ok {
+		return nil
+	}
+
+	reductionMap, ok := reductionValue.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := optionalStringFromMap(reductionMap, "type", func(v string) {
+		reduction.Type = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalIntFromMap(reductionMap, "dimensions", func(v int) {
+		reduction.Dimensions = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalIntFromMap(reductionMap, "trainingLimit", func(v int) {
+		reduction.TrainingLimit = v
+	}); err != nil {
+		return err
+	}
+
+	switch reduction.Type {
+	case DimensionReductionNone:
+		return nil
+	case DimensionReductionTruncate:
+		if reduction.Dimensions < 1 {
+			return fmt.Errorf("dimension reduction requires a positive number of dimensions")
+		}
+		return nil
+	case DimensionReductionPCA:
+		if reduction.Dimensions < 1 {
+			return fmt.Errorf("dimension reduction requires a positive number of dimensions")
+		}
+		if reduction.TrainingLimit < reduction.Dimensions {
+			return fmt.Errorf("pca dimension reduction requires a trainingLimit of at least %d",
+				reduction.Dimensions)
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid dimension reduction type: %s", reduction.Type)
+	}
+}
@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	// FilterStrategySweeping traverses the graph as is and skips the nodes
+	// which don't match the filter when collecting results. With a very
+	// selective filter most of the visited nodes are skipped.
+	FilterStrategySweeping = "sweeping"
+	// FilterStrategyAcorn only evaluates the nodes which match the filter and
+	// expands the neighborhood of the ones which don't by a second hop, so the
+	// search keeps moving through the filtered subgraph (ACORN-1).
+	FilterStrategyAcorn = "acorn"
+
+	DefaultFilterStrategy = FilterStrategySweeping
+)
+
+// ValidateFilterStrategy returns an error if the strategy is unknown. An
+// empty strategy is valid and means the default of the index is used.
+func ValidateFilterStrategy(strategy string) error {
+	switch strategy {
+	case "", FilterStrategySweeping, FilterStrategyAcorn:
+		return nil
+	default:
+		return fmt.Errorf("invalid filter strategy %q, must be one of %q, %q",
+			strategy, FilterStrategySweeping, FilterStrategyAcorn)
+	}
+}
+
+type filterStrategyKey struct{}
+
+// NewFilterStrategyContext returns a context which overrides the filter
+// strategy of the vector index for the searches of a single query. An empty
+// strategy leaves the context unchanged.
+func NewFilterStrategyContext(ctx context.Context, strategy string) context.Context {
+	if strategy == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, filterStrategyKey{}, strategy)
+}
+
+// FilterStrategyFromContext returns the filter strategy of the query, or an
+// empty string if the default of the index should be used
+func FilterStrategyFromContext(ctx context.Context) string {
+	strategy, _ := ctx.Value(filterStrategyKey{}).(string)
+	return strategy
+}
@@ -0,0 +1,32 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+// IntegrityReport is the outcome of an integrity check of an hnsw graph. If
+// the check was run with repair enabled, the repaired counters contain the
+// issues which could be fixed, everything else is listed in Unrepaired.
+type IntegrityReport struct {
+	Nodes               int      `json:"nodes"`
+	EntrypointValid     bool     `json:"entrypointValid"`
+	EntrypointRepaired  bool     `json:"entrypointRepaired"`
+	BrokenLinks         int      `json:"brokenLinks"`
+	BrokenLinksRepaired int      `json:"brokenLinksRepaired"`
+	Unreachable         int      `json:"unreachable"`
+	UnreachableRepaired int      `json:"unreachableRepaired"`
+	Unrepaired          []string `json:"unrepaired,omitempty"`
+}
+
+// Healthy is true if the check did not find any issues, or all of them were
+// repaired
+func (r IntegrityReport) Healthy() bool {
+	return len(r.Unrepaired) == 0
+}
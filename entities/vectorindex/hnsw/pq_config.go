@@ -24,6 +24,13 @@ const (
 	DefaultPQEncoderType         = "kmeans"
 	DefaultPQEncoderDistribution = "log-normal"
 	DefaultPQCentroids           = 256
+	DefaultPQRotationEnabled     = false
+	DefaultPQRotationIterations  = 5
+	DefaultPQDriftThreshold      = 0
+
+	DefaultPQRecallSamplingIntervalSeconds  = 0
+	DefaultPQRecallSamplingQueries          = 10
+	DefaultPQRecallSamplingWarningThreshold = 0.9
 )
 
 // Product Quantization encoder configuration
@@ -32,13 +39,36 @@ type PQEncoder struct {
 	Distribution string `json:"distribution,omitempty"`
 }
 
+// Optimized Product Quantization (OPQ) rotation configuration. If enabled
+// an orthogonal rotation is learned alongside the codebooks to balance the
+// variance across segments before quantizing.
+type PQRotation struct {
+	Enabled    bool `json:"enabled"`
+	Iterations int  `json:"iterations"`
+}
+
+// PQRecallSampling controls the periodic estimation of the recall of
+// compressed searches compared to exact searches on full precision vectors.
+// An interval of 0 disables sampling.
+type PQRecallSampling struct {
+	IntervalSeconds  int     `json:"intervalSeconds"`
+	Queries          int     `json:"queries"`
+	WarningThreshold float64 `json:"warningThreshold"`
+}
+
 // Product Quantization configuration
 type PQConfig struct {
-	Enabled        bool      `json:"enabled"`
-	BitCompression bool      `json:"bitCompression"`
-	Segments       int       `json:"segments"`
-	Centroids      int       `json:"centroids"`
-	Encoder        PQEncoder `json:"encoder"`
+	Enabled        bool       `json:"enabled"`
+	BitCompression bool       `json:"bitCompression"`
+	Segments       int        `json:"segments"`
+	Centroids      int        `json:"centroids"`
+	Encoder        PQEncoder  `json:"encoder"`
+	Rotation       PQRotation `json:"rotation"`
+	// DriftThreshold is the relative shift of the mean of newly imported
+	// vectors compared to the training data which triggers a re-training of
+	// the codebooks. 0 disables drift detection.
+	DriftThreshold float64          `json:"driftThreshold"`
+	RecallSampling PQRecallSampling `json:"recallSampling"`
 }
 
 func ValidEncoder(encoder string) (ssdhelpers.Encoder, error) {
@@ -138,6 +168,20 @@ func parsePQMap(in map[string]interface{}, pq *PQConfig) error {
 		return err
 	}
 
+	if err := optionalFloatFromMap(pqConfigMap, "driftThreshold", func(v float64) {
+		pq.DriftThreshold = v
+	}); err != nil {
+		return err
+	}
+
+	if err := parsePQRotationMap(pqConfigMap, &pq.Rotation); err != nil {
+		return err
+	}
+
+	if err := parsePQRecallSamplingMap(pqConfigMap, &pq.RecallSampling); err != nil {
+		return err
+	}
+
 	pqEncoderValue, ok := pqConfigMap["encoder"]
 	if !ok {
 		return nil
@@ -162,3 +206,69 @@ func parsePQMap(in map[string]interface{}, pq *PQConfig) error {
 
 	return nil
 }
+
+func parsePQRotationMap(in map[string]interface{}, rotation *PQRotation) error {
+	rotationValue, ok := in["rotation"]
+	if !ok {
+		return nil
+	}
+
+	rotationMap, ok := rotationValue.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := optionalBoolFromMap(rotationMap, "enabled", func(v bool) {
+		rotation.Enabled = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalIntFromMap(rotationMap, "iterations", func(v int) {
+		rotation.Iterations = v
+	}); err != nil {
+		return err
+	}
+
+	if rotation.Iterations < 1 {
+		return fmt.Errorf("pq rotation iterations must be a positive integer")
+	}
+
+	return nil
+}
+
+func parsePQRecallSamplingMap(in map[string]interface{}, sampling *PQRecallSampling) error {
+	samplingValue, ok := in["recallSampling"]
+	if !ok {
+		return nil
+	}
+
+	samplingMap, ok := samplingValue.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := optionalIntFromMap(samplingMap, "intervalSeconds", func(v int) {
+		sampling.IntervalSeconds = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalIntFromMap(samplingMap, "queries", func(v int) {
+		sampling.Queries = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalFloatFromMap(samplingMap, "warningThreshold", func(v float64) {
+		sampling.WarningThreshold = v
+	}); err != nil {
+		return err
+	}
+
+	if sampling.IntervalSeconds < 0 || sampling.Queries < 1 {
+		return fmt.Errorf("pq recall sampling requires a non-negative interval and at least one query")
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+const (
+	DefaultSQEnabled = false
+)
+
+// Scalar Quantization (SQ) configuration. If enabled every dimension is
+// compressed to a single byte using the per-dimension value ranges of the
+// vectors present at the time of compression. It does not require training
+// codebooks and typically retains more recall than PQ at a lower compression
+// ratio.
+type SQConfig struct {
+	Enabled bool `json:"enabled"`
+}
+
+func parseSQMap(in map[string]interface{}, sq *SQConfig) error {
+	sqConfigValue, ok := in["sq"]
+	if !ok {
+		return nil
+	}
+
+	sqConfigMap, ok := sqConfigValue.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	return optionalBoolFromMap(sqConfigMap, "enabled", func(v bool) {
+		sq.Enabled = v
+	})
+}
@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	DefaultVectorValidationDimensions      = 0
+	DefaultVectorValidationRejectZero      = false
+	DefaultVectorValidationRejectNonFinite = false
+	DefaultVectorValidationNormalize       = false
+)
+
+// VectorValidation is the write-time policy for vectors of a class. A value of
+// 0 for Dimensions means the dimensionality is not enforced.
+type VectorValidation struct {
+	Dimensions      int  `json:"dimensions"`
+	RejectZero      bool `json:"rejectZero"`
+	RejectNonFinite bool `json:"rejectNonFinite"`
+	Normalize       bool `json:"normalize"`
+}
+
+// Apply checks the vector against the policy and returns the vector which
+// should be stored. The input is never modified, if normalization is
+// required a new vector is returned.
+func (v VectorValidation) Apply(vector []float32) ([]float32, error) {
+	if len(vector) == 0 {
+		return vector, nil
+	}
+
+	if v.Dimensions > 0 && len(vector) != v.Dimensions {
+		return nil, fmt.Errorf("vector has %d dimensions, class requires exactly %d",
+			len(vector), v.Dimensions)
+	}
+
+	var norm float64
+	for i, x := range vector {
+		if v.RejectNonFinite && (math.IsNaN(float64(x)) || math.IsInf(float64(x), 0)) {
+			return nil, fmt.Errorf("vector contains non-finite value %v at position %d", x, i)
+		}
+		norm += float64(x) * float64(x)
+	}
+
+	if norm == 0 {
+		if v.RejectZero {
+			return nil, fmt.Errorf("vector is a zero vector")
+		}
+		return vector, nil
+	}
+
+	if !v.Normalize {
+		return vector, nil
+	}
+
+	norm = math.Sqrt(norm)
+	out := make([]float32, len(vector))
+	for i, x := range vector {
+		out[i] = float32(float64(x) / norm)
+	}
+	return out, nil
+}
+
+// ParseVectorValidationMap reads the "vectorValidation" setting of a vector
+// index config, it is shared by all index types
+func ParseVectorValidationMap(in map[string]interface{}, validation *VectorValidation) error {
+	validationValue, ok := in["vectorValidation"]
+	if !ok {
+		return nil
+	}
+
+	validationMap, ok := validationValue.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := optionalIntFromMap(validationMap, "dimensions", func(v int) {
+		validation.Dimensions = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalBoolFromMap(validationMap, "rejectZero", func(v bool) {
+		validation.RejectZero = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalBoolFromMap(validationMap, "rejectNonFinite", func(v bool) {
+		validation.RejectNonFinite = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalBoolFromMap(validationMap, "normalize", func(v bool) {
+		validation.Normalize = v
+	}); err != nil {
+		return err
+	}
+
+	if validation.Dimensions < 0 {
+		return fmt.Errorf("vector validation dimensions must be a non-negative integer")
+	}
+
+	return nil
+}
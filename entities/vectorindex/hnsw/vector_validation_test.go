@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorValidation(t *testing.T) {
+	nan := float32(math.NaN())
+
+	tests := []struct {
+		name         string
+		policy       VectorValidation
+		input        []float32
+		expected     []float32
+		expectErrMsg string
+	}{
+		{
+			name:     "no policy accepts anything",
+			input:    []float32{0, nan},
+			expected: []float32{0, nan},
+		},
+		{
+			name:     "nil vectors are always accepted",
+			policy:   VectorValidation{Dimensions: 3, RejectZero: true},
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name:         "wrong dimensions",
+			policy:       VectorValidation{Dimensions: 3},
+			input:        []float32{1, 2},
+			expectErrMsg: "vector has 2 dimensions, class requires exactly 3",
+		},
+		{
+			name:         "zero vector",
+			policy:       VectorValidation{RejectZero: true},
+			input:        []float32{0, 0, 0},
+			expectErrMsg: "vector is a zero vector",
+		},
+		{
+			name:         "nan",
+			policy:       VectorValidation{RejectNonFinite: true},
+			input:        []float32{1, nan, 0},
+			expectErrMsg: "non-finite value NaN at position 1",
+		},
+		{
+			name:         "infinity",
+			policy:       VectorValidation{RejectNonFinite: true},
+			input:        []float32{float32(math.Inf(-1))},
+			expectErrMsg: "non-finite value -Inf at position 0",
+		},
+		{
+			name:     "normalize",
+			policy:   VectorValidation{Dimensions: 2, Normalize: true},
+			input:    []float32{3, 4},
+			expected: []float32{0.6, 0.8},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out, err := test.policy.Apply(test.input)
+			if test.expectErrMsg != "" {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectErrMsg)
+				return
+			}
+
+			require.Nil(t, err)
+			require.Len(t, out, len(test.expected))
+			for i := range test.expected {
+				if math.IsNaN(float64(test.expected[i])) {
+					assert.True(t, math.IsNaN(float64(out[i])))
+					continue
+				}
+				assert.InDelta(t, test.expected[i], out[i], 0.00001)
+			}
+		})
+	}
+}
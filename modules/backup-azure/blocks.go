@@ -0,0 +1,190 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modstgazure
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/streaming"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blockblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+)
+
+// blockClient is the subset of the block blob API needed to stream a file
+// in blocks
+type blockClient interface {
+	Upload(ctx context.Context, objectName string, body io.ReadSeekCloser,
+		metadata map[string]*string, tags map[string]string) error
+	StageBlock(ctx context.Context, objectName, blockID string,
+		body io.ReadSeekCloser) error
+	CommitBlockList(ctx context.Context, objectName string, blockIDs []string,
+		metadata map[string]*string, tags map[string]string) error
+}
+
+// containerBlockClient implements blockClient for the blobs of a container
+type containerBlockClient struct {
+	client *container.Client
+}
+
+func (c *containerBlockClient) Upload(ctx context.Context, objectName string,
+	body io.ReadSeekCloser, metadata map[string]*string, tags map[string]string,
+) error {
+	_, err := c.client.NewBlockBlobClient(objectName).Upload(ctx, body,
+		&blockblob.UploadOptions{Metadata: metadata, Tags: tags})
+	return err
+}
+
+func (c *containerBlockClient) StageBlock(ctx context.Context, objectName,
+	blockID string, body io.ReadSeekCloser,
+) error {
+	_, err := c.client.NewBlockBlobClient(objectName).StageBlock(ctx, blockID, body, nil)
+	return err
+}
+
+func (c *containerBlockClient) CommitBlockList(ctx context.Context, objectName string,
+	blockIDs []string, metadata map[string]*string, tags map[string]string,
+) error {
+	_, err := c.client.NewBlockBlobClient(objectName).CommitBlockList(ctx, blockIDs,
+		&blockblob.CommitBlockListOptions{Metadata: metadata, Tags: tags})
+	return err
+}
+
+// blockWriter streams files from disk to Azure. Every block is read straight
+// from the file while it is sent, so the memory used doesn't depend on the
+// size of the file, which may be a commit log or segment of many GB. Blocks
+// of a failed upload are never committed, Azure discards them after a week.
+type blockWriter struct {
+	client      blockClient
+	blockSize   int64
+	concurrency int
+}
+
+// blockSizeFor grows the configured block size if a file would otherwise
+// need more blocks than Azure allows
+func (w *blockWriter) blockSizeFor(size int64) int64 {
+	blockSize := w.blockSize
+	if n := (size + blockSize - 1) / blockSize; n > maxBlocksCount {
+		blockSize = (size + maxBlocksCount - 1) / maxBlocksCount
+	}
+	return blockSize
+}
+
+// blockID returns the ID of the i-th block of a blob, all IDs of a blob
+// must have the same length
+func blockID(i int) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%08d", i)))
+}
+
+// putFile uploads the file at srcPath as a block blob tagged with the backup
+// ID and returns its size
+func (w *blockWriter) putFile(ctx context.Context, objectName, srcPath,
+	backupID string,
+) (int64, error) {
+	file, err := os.Open(srcPath)
+	if err != nil {
+		return 0, fmt.Errorf("open file: %w", err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return 0, fmt.Errorf("stat file: %w", err)
+	}
+	size := info.Size()
+	metadata := map[string]*string{"backupid": to.Ptr(backupID)}
+	tags := map[string]string{"backupid": backupID}
+
+	if size <= w.blockSize {
+		body := streaming.NopCloser(io.NewSectionReader(file, 0, size))
+		return size, w.client.Upload(ctx, objectName, body, metadata, tags)
+	}
+
+	blockIDs, err := w.stageBlocks(ctx, objectName, file, size)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := w.client.CommitBlockList(ctx, objectName, blockIDs,
+		metadata, tags); err != nil {
+		return 0, fmt.Errorf("commit block list: %w", err)
+	}
+	return size, nil
+}
+
+// stageBlocks uploads the blocks of a file with a bounded number of workers
+// and returns their IDs in order
+func (w *blockWriter) stageBlocks(ctx context.Context, objectName string,
+	file io.ReaderAt, size int64,
+) ([]string, error) {
+	blockSize := w.blockSizeFor(size)
+	count := int((size + blockSize - 1) / blockSize)
+	blockIDs := make([]string, count)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		blocks   = make(chan int)
+	)
+	for i := 0; i < w.concurrency && i < count; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range blocks {
+				offset := int64(i) * blockSize
+				n := blockSize
+				if offset+n > size {
+					n = size - offset
+				}
+				id := blockID(i)
+				body := streaming.NopCloser(io.NewSectionReader(file, offset, n))
+				if err := w.client.StageBlock(ctx, objectName, id, body); err != nil {
+					once.Do(func() {
+						firstErr = fmt.Errorf("stage block %d: %w", i, err)
+						cancel()
+					})
+					continue
+				}
+				blockIDs[i] = id
+			}
+		}()
+	}
+
+	func() {
+		defer close(blocks)
+		for i := 0; i < count; i++ {
+			select {
+			case blocks <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return blockIDs, nil
+}
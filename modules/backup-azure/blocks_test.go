@@ -0,0 +1,150 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modstgazure
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeBlockClient struct {
+	sync.Mutex
+	objects   map[string][]byte
+	tags      map[string]map[string]string
+	blocks    map[string][]byte
+	failBlock string
+}
+
+func newFakeBlockClient() *fakeBlockClient {
+	return &fakeBlockClient{
+		objects: map[string][]byte{},
+		tags:    map[string]map[string]string{},
+		blocks:  map[string][]byte{},
+	}
+}
+
+func (f *fakeBlockClient) Upload(ctx context.Context, objectName string,
+	body io.ReadSeekCloser, metadata map[string]*string, tags map[string]string,
+) error {
+	b, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	f.Lock()
+	defer f.Unlock()
+	f.objects[objectName] = b
+	f.tags[objectName] = tags
+	return nil
+}
+
+func (f *fakeBlockClient) StageBlock(ctx context.Context, objectName, blockID string,
+	body io.ReadSeekCloser,
+) error {
+	if blockID == f.failBlock {
+		return errors.New("connection reset")
+	}
+	b, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	f.Lock()
+	defer f.Unlock()
+	f.blocks[blockID] = b
+	return nil
+}
+
+func (f *fakeBlockClient) CommitBlockList(ctx context.Context, objectName string,
+	blockIDs []string, metadata map[string]*string, tags map[string]string,
+) error {
+	f.Lock()
+	defer f.Unlock()
+	var buf bytes.Buffer
+	for _, id := range blockIDs {
+		b, ok := f.blocks[id]
+		if !ok {
+			return fmt.Errorf("block %q was not staged", id)
+		}
+		buf.Write(b)
+	}
+	f.objects[objectName] = buf.Bytes()
+	f.tags[objectName] = tags
+	return nil
+}
+
+func TestBlockWriter(t *testing.T) {
+	ctx := context.Background()
+	content := make([]byte, 1000)
+	for i := range content {
+		content[i] = byte(i)
+	}
+	src := filepath.Join(t.TempDir(), "segment.db")
+	require.Nil(t, os.WriteFile(src, content, 0o644))
+
+	t.Run("small file", func(t *testing.T) {
+		client := newFakeBlockClient()
+		w := &blockWriter{client: client, blockSize: 1000, concurrency: 2}
+
+		size, err := w.putFile(ctx, "obj", src, "backup1")
+		require.Nil(t, err)
+		assert.Equal(t, int64(1000), size)
+		assert.Equal(t, content, client.objects["obj"])
+		assert.Equal(t, "backup1", client.tags["obj"]["backupid"])
+		assert.Empty(t, client.blocks)
+	})
+
+	t.Run("file in blocks", func(t *testing.T) {
+		client := newFakeBlockClient()
+		w := &blockWriter{client: client, blockSize: 300, concurrency: 2}
+
+		size, err := w.putFile(ctx, "obj", src, "backup1")
+		require.Nil(t, err)
+		assert.Equal(t, int64(1000), size)
+		assert.Len(t, client.blocks, 4)
+		assert.Len(t, client.blocks[blockID(3)], 100)
+		assert.Equal(t, content, client.objects["obj"])
+		assert.Equal(t, "backup1", client.tags["obj"]["backupid"])
+	})
+
+	t.Run("failed block is not committed", func(t *testing.T) {
+		client := newFakeBlockClient()
+		client.failBlock = blockID(1)
+		w := &blockWriter{client: client, blockSize: 300, concurrency: 2}
+
+		_, err := w.putFile(ctx, "obj", src, "backup1")
+		assert.ErrorContains(t, err, "stage block 1")
+		assert.NotContains(t, client.objects, "obj")
+	})
+
+	t.Run("block IDs have the same length", func(t *testing.T) {
+		assert.Len(t, blockID(maxBlocksCount-1), len(blockID(0)))
+	})
+
+	t.Run("block size grows to stay below the blocks limit", func(t *testing.T) {
+		w := &blockWriter{blockSize: minBlockSize}
+		assert.Equal(t, int64(minBlockSize), w.blockSizeFor(100*minBlockSize))
+
+		size := int64(maxBlocksCount)*minBlockSize + 1
+		blockSize := w.blockSizeFor(size)
+		assert.Greater(t, blockSize, int64(minBlockSize))
+		assert.LessOrEqual(t, (size+blockSize-1)/blockSize, int64(maxBlocksCount))
+	})
+}
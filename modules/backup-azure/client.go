@@ -20,6 +20,8 @@ import (
 	"path"
 	"strings"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
@@ -32,14 +34,46 @@ type azureClient struct {
 	config     clientConfig
 	serviceURL string
 	dataPath   string
+	writer     *blockWriter
 }
 
 func newClient(ctx context.Context, config *clientConfig, dataPath string) (*azureClient, error) {
+	// failed connections, timeouts and throttled requests are retried with
+	// an exponential backoff by the pipeline of the client, which applies
+	// its own default for 0 and doesn't retry with a negative number
+	maxRetries := config.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = -1
+	}
+	options := &azblob.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Retry: policy.RetryOptions{MaxRetries: maxRetries},
+		},
+	}
+	client, serviceURL, err := newBlobClient(options)
+	if err != nil {
+		return nil, err
+	}
+
+	writer := &blockWriter{
+		client: &containerBlockClient{
+			client: client.ServiceClient().NewContainerClient(config.Container),
+		},
+		blockSize:   config.BlockSize,
+		concurrency: config.Concurrency,
+	}
+	return &azureClient{client, *config, serviceURL, dataPath, writer}, nil
+}
+
+// newBlobClient creates a client authenticated with the first of these
+// which is set: a connection string, a shared key, a SAS token or the
+// managed identity. Without any, the container must allow anonymous access.
+func newBlobClient(options *azblob.ClientOptions) (*azblob.Client, string, error) {
 	connectionString := os.Getenv("AZURE_STORAGE_CONNECTION_STRING")
 	if connectionString != "" {
-		client, err := azblob.NewClientFromConnectionString(connectionString, nil)
+		client, err := azblob.NewClientFromConnectionString(connectionString, options)
 		if err != nil {
-			return nil, errors.Wrap(err, "create client using connection string")
+			return nil, "", errors.Wrap(err, "create client using connection string")
 		}
 		serviceURL := ""
 		connectionStrings := strings.Split(connectionString, ";")
@@ -54,38 +88,56 @@ func newClient(ctx context.Context, config *clientConfig, dataPath string) (*azu
 				}
 			}
 		}
-		return &azureClient{client, *config, serviceURL, dataPath}, nil
+		return client, serviceURL, nil
 	}
 
 	// Your account name and key can be obtained from the Azure Portal.
 	accountName := os.Getenv("AZURE_STORAGE_ACCOUNT")
 	accountKey := os.Getenv("AZURE_STORAGE_KEY")
+	sasToken := os.Getenv("AZURE_STORAGE_SAS_TOKEN")
+	useManagedIdentity := strings.ToLower(os.Getenv("AZURE_STORAGE_USE_MANAGED_IDENTITY")) == "true"
 
 	if accountName == "" {
-		return nil, errors.New("AZURE_STORAGE_ACCOUNT must be set")
+		return nil, "", errors.New("AZURE_STORAGE_ACCOUNT must be set")
 	}
 
 	// The service URL for blob endpoints is usually in the form: http(s)://<account>.blob.core.windows.net/
 	serviceURL := fmt.Sprintf("https://%s.blob.core.windows.net/", accountName)
 
-	if accountKey != "" {
+	switch {
+	case accountKey != "":
 		cred, err := azblob.NewSharedKeyCredential(accountName, accountKey)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 
-		client, err := azblob.NewClientWithSharedKeyCredential(serviceURL, cred, nil)
+		client, err := azblob.NewClientWithSharedKeyCredential(serviceURL, cred, options)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "create client using shared key")
+		}
+		return client, serviceURL, nil
+	case sasToken != "":
+		// the token is part of the URL of every request, it must not be part
+		// of the home dir though
+		client, err := azblob.NewClientWithNoCredential(
+			serviceURL+"?"+strings.TrimPrefix(sasToken, "?"), options)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "create client using SAS token")
+		}
+		return client, serviceURL, nil
+	case useManagedIdentity:
+		client, err := azblob.NewClient(serviceURL, newManagedIdentityCredential(), options)
 		if err != nil {
-			return nil, err
+			return nil, "", errors.Wrap(err, "create client using managed identity")
 		}
-		return &azureClient{client, *config, serviceURL, dataPath}, nil
+		return client, serviceURL, nil
 	}
 
-	client, err := azblob.NewClientWithNoCredential(serviceURL, nil)
+	client, err := azblob.NewClientWithNoCredential(serviceURL, options)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	return &azureClient{client, *config, serviceURL, dataPath}, nil
+	return client, serviceURL, nil
 }
 
 func (a *azureClient) HomeDir(backupID string) string {
@@ -121,24 +173,13 @@ func (a *azureClient) GetObject(ctx context.Context, backupID, key string) ([]by
 	return downloadData, nil
 }
 
+// PutFile streams the file at srcPath to Azure, large files are staged in
+// blocks without being buffered in memory
 func (a *azureClient) PutFile(ctx context.Context, backupID, key, srcPath string) error {
 	filePath := path.Join(a.dataPath, srcPath)
-	file, err := os.Open(filePath)
-	if err != nil {
-		return backup.NewErrInternal(errors.Wrapf(err, "open file: %q", filePath))
-	}
-	defer file.Close()
-
 	objectName := a.makeObjectName(backupID, key)
-	_, err = a.client.UploadFile(ctx,
-		a.config.Container,
-		objectName,
-		file,
-		&azblob.UploadFileOptions{
-			Metadata: map[string]*string{"backupid": to.Ptr(backupID)},
-			Tags:     map[string]string{"backupid": backupID},
-		})
-	if err != nil {
+
+	if _, err := a.writer.putFile(ctx, objectName, filePath, backupID); err != nil {
 		return backup.NewErrInternal(errors.Wrapf(err, "upload file for object '%s'", objectName))
 	}
 
@@ -0,0 +1,56 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modstgazure
+
+const (
+	// DefaultBlockSize is the size of the blocks files are staged in, files
+	// up to this size are uploaded with a single request
+	DefaultBlockSize = 8 * 1024 * 1024
+	// DefaultConcurrency is the number of blocks of a file uploaded at once
+	DefaultConcurrency = 4
+	// DefaultMaxRetries is the number of times a failed request to Azure is
+	// retried, the delay between retries grows exponentially
+	DefaultMaxRetries = 5
+
+	// limits of Azure block blobs
+	minBlockSize   = 1024 * 1024
+	maxBlockSize   = 4000 * 1024 * 1024
+	maxBlocksCount = 50000
+)
+
+type clientConfig struct {
+	Container string
+
+	// this is an optional value, allowing for
+	// the backup to be stored in a specific
+	// directory inside the provided bucket
+	BackupPath string
+
+	// BlockSize and Concurrency bound the memory and connections used to
+	// stream a file to Azure as a block blob
+	BlockSize   int64
+	Concurrency int
+
+	// MaxRetries is the number of times a request is retried after a failed
+	// connection, a timeout or a throttled response
+	MaxRetries int32
+}
+
+func newConfig(container, path string) *clientConfig {
+	return &clientConfig{
+		Container:   container,
+		BackupPath:  path,
+		BlockSize:   DefaultBlockSize,
+		Concurrency: DefaultConcurrency,
+		MaxRetries:  DefaultMaxRetries,
+	}
+}
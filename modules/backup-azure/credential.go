@@ -0,0 +1,187 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modstgazure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+)
+
+const (
+	imdsEndpoint         = "http://169.254.169.254/metadata/identity/oauth2/token"
+	defaultAuthorityHost = "https://login.microsoftonline.com/"
+)
+
+// managedIdentityCredential gets tokens for the managed identity of the VM
+// from the instance metadata service or, if the workload identity webhook
+// of AKS injected a federated token, for the identity of the pod from
+// Azure AD. Tokens are cached and refreshed by the client's pipeline.
+type managedIdentityCredential struct {
+	httpClient *http.Client
+	// clientID selects a user-assigned identity, it is optional for the
+	// instance metadata service
+	clientID      string
+	tenantID      string
+	tokenFile     string
+	authorityHost string
+	imdsEndpoint  string
+}
+
+func newManagedIdentityCredential() *managedIdentityCredential {
+	authorityHost := os.Getenv("AZURE_AUTHORITY_HOST")
+	if authorityHost == "" {
+		authorityHost = defaultAuthorityHost
+	}
+	if !strings.HasSuffix(authorityHost, "/") {
+		authorityHost = authorityHost + "/"
+	}
+	return &managedIdentityCredential{
+		httpClient:    &http.Client{Timeout: 30 * time.Second},
+		clientID:      os.Getenv("AZURE_CLIENT_ID"),
+		tenantID:      os.Getenv("AZURE_TENANT_ID"),
+		tokenFile:     os.Getenv("AZURE_FEDERATED_TOKEN_FILE"),
+		authorityHost: authorityHost,
+		imdsEndpoint:  imdsEndpoint,
+	}
+}
+
+type tokenResponse struct {
+	AccessToken string      `json:"access_token"`
+	ExpiresIn   json.Number `json:"expires_in"`
+	ExpiresOn   json.Number `json:"expires_on"`
+}
+
+func (c *managedIdentityCredential) GetToken(ctx context.Context,
+	opts policy.TokenRequestOptions,
+) (azcore.AccessToken, error) {
+	if len(opts.Scopes) != 1 {
+		return azcore.AccessToken{}, fmt.Errorf("managed identity: expected 1 scope, got %d",
+			len(opts.Scopes))
+	}
+
+	var req *http.Request
+	var err error
+	if c.tokenFile != "" {
+		req, err = c.workloadIdentityRequest(ctx, opts.Scopes[0])
+	} else {
+		req, err = c.imdsRequest(ctx, opts.Scopes[0])
+	}
+	if err != nil {
+		return azcore.AccessToken{}, fmt.Errorf("managed identity: %w", err)
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return azcore.AccessToken{}, fmt.Errorf("managed identity: request token: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return azcore.AccessToken{}, fmt.Errorf("managed identity: read token: %w", err)
+	}
+	if res.StatusCode != http.StatusOK {
+		return azcore.AccessToken{}, fmt.Errorf("managed identity: request token: status %d: %s",
+			res.StatusCode, body)
+	}
+
+	var token tokenResponse
+	if err := json.Unmarshal(body, &token); err != nil {
+		return azcore.AccessToken{}, fmt.Errorf("managed identity: parse token: %w", err)
+	}
+	return token.accessToken(time.Now())
+}
+
+// imdsRequest requests a token from the instance metadata service, which
+// expects a resource instead of a scope
+func (c *managedIdentityCredential) imdsRequest(ctx context.Context,
+	scope string,
+) (*http.Request, error) {
+	params := url.Values{}
+	params.Set("api-version", "2018-02-01")
+	params.Set("resource", strings.TrimSuffix(scope, "/.default"))
+	if c.clientID != "" {
+		params.Set("client_id", c.clientID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		c.imdsEndpoint+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Metadata", "true")
+	return req, nil
+}
+
+// workloadIdentityRequest exchanges the federated token of the pod for a
+// token of its identity. The file is read for every request, as the token is
+// rotated by the kubelet.
+func (c *managedIdentityCredential) workloadIdentityRequest(ctx context.Context,
+	scope string,
+) (*http.Request, error) {
+	if c.clientID == "" || c.tenantID == "" {
+		return nil, fmt.Errorf("AZURE_CLIENT_ID and AZURE_TENANT_ID must be set " +
+			"together with AZURE_FEDERATED_TOKEN_FILE")
+	}
+	assertion, err := os.ReadFile(c.tokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("read federated token: %w", err)
+	}
+
+	form := url.Values{}
+	form.Set("client_id", c.clientID)
+	form.Set("scope", scope)
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+	form.Set("client_assertion", strings.TrimSpace(string(assertion)))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		c.authorityHost+c.tenantID+"/oauth2/v2.0/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req, nil
+}
+
+// accessToken returns the token with its expiry, the instance metadata
+// service sends the time it expires on, Azure AD only its lifetime
+func (t tokenResponse) accessToken(now time.Time) (azcore.AccessToken, error) {
+	if t.AccessToken == "" {
+		return azcore.AccessToken{}, fmt.Errorf("managed identity: no access token in response")
+	}
+	if t.ExpiresOn != "" {
+		expiresOn, err := t.ExpiresOn.Int64()
+		if err != nil {
+			return azcore.AccessToken{}, fmt.Errorf("managed identity: parse expires_on: %w", err)
+		}
+		return azcore.AccessToken{Token: t.AccessToken, ExpiresOn: time.Unix(expiresOn, 0)}, nil
+	}
+	expiresIn, err := t.ExpiresIn.Int64()
+	if err != nil {
+		return azcore.AccessToken{}, fmt.Errorf("managed identity: parse expires_in: %w", err)
+	}
+	return azcore.AccessToken{
+		Token:     t.AccessToken,
+		ExpiresOn: now.Add(time.Duration(expiresIn) * time.Second),
+	}, nil
+}
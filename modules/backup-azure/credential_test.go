@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modstgazure
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManagedIdentityCredential(t *testing.T) {
+	ctx := context.Background()
+	opts := policy.TokenRequestOptions{Scopes: []string{"https://storage.azure.com/.default"}}
+
+	t.Run("token of the instance metadata service", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "true", r.Header.Get("Metadata"))
+			assert.Equal(t, "https://storage.azure.com", r.URL.Query().Get("resource"))
+			assert.Equal(t, "client-id", r.URL.Query().Get("client_id"))
+			w.Write([]byte(`{"access_token":"imds-token","expires_in":"3599","expires_on":"1700000000"}`))
+		}))
+		defer server.Close()
+
+		cred := &managedIdentityCredential{
+			httpClient:   server.Client(),
+			clientID:     "client-id",
+			imdsEndpoint: server.URL,
+		}
+		token, err := cred.GetToken(ctx, opts)
+		require.Nil(t, err)
+		assert.Equal(t, "imds-token", token.Token)
+		assert.Equal(t, time.Unix(1700000000, 0), token.ExpiresOn)
+	})
+
+	t.Run("token of the workload identity", func(t *testing.T) {
+		tokenFile := filepath.Join(t.TempDir(), "token")
+		require.Nil(t, os.WriteFile(tokenFile, []byte("federated-token\n"), 0o600))
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/tenant-id/oauth2/v2.0/token", r.URL.Path)
+			require.Nil(t, r.ParseForm())
+			assert.Equal(t, "client-id", r.PostForm.Get("client_id"))
+			assert.Equal(t, "federated-token", r.PostForm.Get("client_assertion"))
+			assert.Equal(t, opts.Scopes[0], r.PostForm.Get("scope"))
+			w.Write([]byte(`{"access_token":"aad-token","expires_in":3599}`))
+		}))
+		defer server.Close()
+
+		cred := &managedIdentityCredential{
+			httpClient:    server.Client(),
+			clientID:      "client-id",
+			tenantID:      "tenant-id",
+			tokenFile:     tokenFile,
+			authorityHost: server.URL + "/",
+		}
+		before := time.Now()
+		token, err := cred.GetToken(ctx, opts)
+		require.Nil(t, err)
+		assert.Equal(t, "aad-token", token.Token)
+		assert.WithinDuration(t, before.Add(3599*time.Second), token.ExpiresOn, time.Minute)
+	})
+
+	t.Run("failed request", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`identity not found`))
+		}))
+		defer server.Close()
+
+		cred := &managedIdentityCredential{httpClient: server.Client(), imdsEndpoint: server.URL}
+		_, err := cred.GetToken(ctx, opts)
+		assert.ErrorContains(t, err, "identity not found")
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"context"
 	"net/http"
 	"os"
+	"strconv"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -35,16 +36,15 @@ const (
 	// be stored directly in the root of the
 	// container.
 	azurePath = "BACKUP_AZURE_PATH"
-)
 
-type clientConfig struct {
-	Container string
+	// optional values tuning block blob uploads: the size of the blocks in
+	// MiB and the number of blocks of a file which are uploaded at once
+	azureBlockSize   = "BACKUP_AZURE_BLOCK_SIZE_MB"
+	azureConcurrency = "BACKUP_AZURE_UPLOAD_CONCURRENCY"
 
-	// this is an optional value, allowing for
-	// the backup to be stored in a specific
-	// directory inside the provided bucket
-	BackupPath string
-}
+	// optional number of times a failed request is retried
+	azureMaxRetries = "BACKUP_AZURE_MAX_RETRIES"
+)
 
 type Module struct {
 	logger logrus.FieldLogger
@@ -78,13 +78,32 @@ func (m *Module) Init(ctx context.Context,
 	m.logger = params.GetLogger()
 	m.dataPath = params.GetStorageProvider().DataPath()
 
-	config := &clientConfig{
-		Container:  os.Getenv(azureContainer),
-		BackupPath: os.Getenv(azurePath),
-	}
+	config := newConfig(os.Getenv(azureContainer), os.Getenv(azurePath))
 	if config.Container == "" {
 		return errors.Errorf("backup init: '%s' must be set", azureContainer)
 	}
+	if v := os.Getenv(azureBlockSize); v != "" {
+		mb, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || mb*1024*1024 < minBlockSize || mb*1024*1024 > maxBlockSize {
+			return errors.Errorf("backup init: '%s' must be a number of MiB between %d and %d",
+				azureBlockSize, minBlockSize/1024/1024, maxBlockSize/1024/1024)
+		}
+		config.BlockSize = mb * 1024 * 1024
+	}
+	if v := os.Getenv(azureConcurrency); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return errors.Errorf("backup init: '%s' must be a positive number", azureConcurrency)
+		}
+		config.Concurrency = n
+	}
+	if v := os.Getenv(azureMaxRetries); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || n < 0 {
+			return errors.Errorf("backup init: '%s' must be a non-negative number", azureMaxRetries)
+		}
+		config.MaxRetries = int32(n)
+	}
 
 	client, err := newClient(ctx, config, m.dataPath)
 	if err != nil {
@@ -100,11 +119,14 @@ func (m *Module) RootHandler() http.Handler {
 }
 
 func (m *Module) MetaInfo() (map[string]interface{}, error) {
-	metaInfo := make(map[string]interface{})
+	metaInfo := make(map[string]interface{}, 5)
 	metaInfo["containerName"] = m.config.Container
 	if root := m.config.BackupPath; root != "" {
 		metaInfo["rootName"] = root
 	}
+	metaInfo["blockSize"] = m.config.BlockSize
+	metaInfo["uploadConcurrency"] = m.config.Concurrency
+	metaInfo["maxRetries"] = m.config.MaxRetries
 	return metaInfo, nil
 }
 
@@ -33,6 +33,7 @@ type s3Client struct {
 	config   *clientConfig
 	logger   logrus.FieldLogger
 	dataPath string
+	writer   *multipartWriter
 }
 
 func newClient(config *clientConfig, logger logrus.FieldLogger, dataPath string) (*s3Client, error) {
@@ -61,7 +62,13 @@ func newClient(config *clientConfig, logger logrus.FieldLogger, dataPath string)
 	if err != nil {
 		return nil, errors.Wrap(err, "create client")
 	}
-	return &s3Client{client, config, logger, dataPath}, nil
+	writer := &multipartWriter{
+		client:      &minio.Core{Client: client},
+		bucket:      config.Bucket,
+		partSize:    config.PartSize,
+		concurrency: config.Concurrency,
+	}
+	return &s3Client{client, config, logger, dataPath, writer}, nil
 }
 
 func (s *s3Client) makeObjectName(parts ...string) string {
@@ -102,24 +109,18 @@ func (s *s3Client) GetObject(ctx context.Context, backupID, key string) ([]byte,
 	return contents, nil
 }
 
+// PutFile streams the file at srcPath to S3, large files are uploaded in
+// parts without being buffered in memory
 func (s *s3Client) PutFile(ctx context.Context, backupID, key string, srcPath string) error {
 	objectName := s.makeObjectName(backupID, key)
 	srcPath = path.Join(s.dataPath, srcPath)
-	opt := minio.PutObjectOptions{ContentType: "application/octet-stream"}
 
-	_, err := s.client.FPutObject(ctx, s.config.Bucket, objectName, srcPath, opt)
+	size, err := s.writer.putFile(ctx, objectName, srcPath)
 	if err != nil {
 		return backup.NewErrInternal(
 			errors.Wrapf(err, "put file '%s'", objectName))
 	}
 
-	// Get filesize
-	file, err := os.Stat(srcPath)
-	if err != nil {
-		return nil
-	}
-	size := file.Size()
-
 	metric, err := monitoring.GetMetrics().BackupStoreDataTransferred.GetMetricWithLabelValues(Name, "class")
 	if err == nil {
 		metric.Add(float64(size))
@@ -11,6 +11,19 @@
 
 package modstgs3
 
+const (
+	// DefaultPartSize is the size of the parts of multipart uploads, files
+	// up to this size are uploaded with a single request
+	DefaultPartSize = 16 * 1024 * 1024
+	// DefaultConcurrency is the number of parts of a file uploaded at once
+	DefaultConcurrency = 4
+
+	// limits of S3 multipart uploads
+	minPartSize   = 5 * 1024 * 1024
+	maxPartSize   = 5 * 1024 * 1024 * 1024
+	maxPartsCount = 10000
+)
+
 type clientConfig struct {
 	Endpoint string
 	Bucket   string
@@ -20,6 +33,11 @@ type clientConfig struct {
 	// the backup to be stored in a specific
 	// directory inside the provided bucket
 	BackupPath string
+
+	// PartSize and Concurrency bound the memory and connections used to
+	// stream a file to S3 with a multipart upload
+	PartSize    int64
+	Concurrency int
 }
 
 func newConfig(endpoint, bucket, path string, useSSL bool) *clientConfig {
@@ -27,5 +45,12 @@ func newConfig(endpoint, bucket, path string, useSSL bool) *clientConfig {
 	if endpoint == "" {
 		endpoint = DEFAULT_ENDPOINT
 	}
-	return &clientConfig{endpoint, bucket, useSSL, path}
+	return &clientConfig{
+		Endpoint:    endpoint,
+		Bucket:      bucket,
+		UseSSL:      useSSL,
+		BackupPath:  path,
+		PartSize:    DefaultPartSize,
+		Concurrency: DefaultConcurrency,
+	}
 }
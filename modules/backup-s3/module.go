@@ -15,6 +15,7 @@ import (
 	"context"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -38,6 +39,11 @@ const (
 	// be stored directly in the root of the
 	// bucket.
 	s3Path = "BACKUP_S3_PATH"
+
+	// optional values tuning multipart uploads: the size of the parts in
+	// MiB and the number of parts of a file which are uploaded at once
+	s3PartSize    = "BACKUP_S3_PART_SIZE_MB"
+	s3Concurrency = "BACKUP_S3_UPLOAD_CONCURRENCY"
 )
 
 type Module struct {
@@ -78,6 +84,21 @@ func (m *Module) Init(ctx context.Context,
 	// SSL on by default
 	useSSL := strings.ToLower(os.Getenv(s3UseSSL)) != "false"
 	config := newConfig(os.Getenv(s3Endpoint), bucket, os.Getenv(s3Path), useSSL)
+	if v := os.Getenv(s3PartSize); v != "" {
+		mb, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || mb*1024*1024 < minPartSize || mb*1024*1024 > maxPartSize {
+			return errors.Errorf("backup init: '%s' must be a number of MiB between %d and %d",
+				s3PartSize, minPartSize/1024/1024, maxPartSize/1024/1024)
+		}
+		config.PartSize = mb * 1024 * 1024
+	}
+	if v := os.Getenv(s3Concurrency); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return errors.Errorf("backup init: '%s' must be a positive number", s3Concurrency)
+		}
+		config.Concurrency = n
+	}
 	client, err := newClient(config, m.logger, m.dataPath)
 	if err != nil {
 		return errors.Wrap(err, "initialize S3 backup module")
@@ -92,13 +113,15 @@ func (m *Module) RootHandler() http.Handler {
 }
 
 func (m *Module) MetaInfo() (map[string]interface{}, error) {
-	metaInfo := make(map[string]interface{}, 4)
+	metaInfo := make(map[string]interface{}, 6)
 	metaInfo["endpoint"] = m.config.Endpoint
 	metaInfo["bucketName"] = m.config.Bucket
 	if root := m.config.BackupPath; root != "" {
 		metaInfo["rootName"] = root
 	}
 	metaInfo["useSSL"] = m.config.UseSSL
+	metaInfo["partSize"] = m.config.PartSize
+	metaInfo["uploadConcurrency"] = m.config.Concurrency
 	return metaInfo, nil
 }
 
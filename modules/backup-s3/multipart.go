@@ -0,0 +1,164 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modstgs3
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+)
+
+// multipartClient is the subset of the low-level S3 API needed to stream a
+// file in parts, it is implemented by minio.Core
+type multipartClient interface {
+	PutObject(ctx context.Context, bucket, object string, data io.Reader, size int64,
+		md5Base64, sha256Hex string, opts minio.PutObjectOptions) (minio.UploadInfo, error)
+	NewMultipartUpload(ctx context.Context, bucket, object string,
+		opts minio.PutObjectOptions) (string, error)
+	PutObjectPart(ctx context.Context, bucket, object, uploadID string, partID int,
+		data io.Reader, size int64, md5Base64, sha256Hex string,
+		sse encrypt.ServerSide) (minio.ObjectPart, error)
+	CompleteMultipartUpload(ctx context.Context, bucket, object, uploadID string,
+		parts []minio.CompletePart, opts minio.PutObjectOptions) (string, error)
+	AbortMultipartUpload(ctx context.Context, bucket, object, uploadID string) error
+}
+
+// multipartWriter streams files from disk to S3. Every part is read straight
+// from the file while it is sent, so the memory used doesn't depend on the
+// size of the file, which may be a commit log or segment of many GB
+type multipartWriter struct {
+	client      multipartClient
+	bucket      string
+	partSize    int64
+	concurrency int
+}
+
+// partSizeFor grows the configured part size if a file would otherwise need
+// more parts than S3 allows
+func (w *multipartWriter) partSizeFor(size int64) int64 {
+	partSize := w.partSize
+	if n := (size + partSize - 1) / partSize; n > maxPartsCount {
+		partSize = (size + maxPartsCount - 1) / maxPartsCount
+	}
+	return partSize
+}
+
+// putFile uploads the file at srcPath and returns its size
+func (w *multipartWriter) putFile(ctx context.Context, objectName, srcPath string) (int64, error) {
+	file, err := os.Open(srcPath)
+	if err != nil {
+		return 0, fmt.Errorf("open file: %w", err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return 0, fmt.Errorf("stat file: %w", err)
+	}
+	size := info.Size()
+	opts := minio.PutObjectOptions{ContentType: "application/octet-stream"}
+
+	if size <= w.partSize {
+		_, err := w.client.PutObject(ctx, w.bucket, objectName, io.NewSectionReader(file, 0, size),
+			size, "", "", opts)
+		return size, err
+	}
+
+	uploadID, err := w.client.NewMultipartUpload(ctx, w.bucket, objectName, opts)
+	if err != nil {
+		return 0, fmt.Errorf("create multipart upload: %w", err)
+	}
+
+	parts, err := w.putParts(ctx, objectName, uploadID, file, size)
+	if err != nil {
+		// the parts which were uploaded are only deleted once the upload is
+		// aborted, the context might have expired already
+		if aerr := w.client.AbortMultipartUpload(context.Background(),
+			w.bucket, objectName, uploadID); aerr != nil {
+			err = fmt.Errorf("%w, abort multipart upload: %v", err, aerr)
+		}
+		return 0, err
+	}
+
+	if _, err := w.client.CompleteMultipartUpload(ctx, w.bucket, objectName, uploadID,
+		parts, opts); err != nil {
+		return 0, fmt.Errorf("complete multipart upload: %w", err)
+	}
+	return size, nil
+}
+
+// putParts uploads the parts of a file with a bounded number of workers
+func (w *multipartWriter) putParts(ctx context.Context, objectName, uploadID string,
+	file io.ReaderAt, size int64,
+) ([]minio.CompletePart, error) {
+	partSize := w.partSizeFor(size)
+	count := int((size + partSize - 1) / partSize)
+	parts := make([]minio.CompletePart, count)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		partIDs  = make(chan int)
+	)
+	for i := 0; i < w.concurrency && i < count; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range partIDs {
+				offset := int64(i) * partSize
+				n := partSize
+				if offset+n > size {
+					n = size - offset
+				}
+				part, err := w.client.PutObjectPart(ctx, w.bucket, objectName, uploadID, i+1,
+					io.NewSectionReader(file, offset, n), n, "", "", nil)
+				if err != nil {
+					once.Do(func() {
+						firstErr = fmt.Errorf("put part %d: %w", i+1, err)
+						cancel()
+					})
+					continue
+				}
+				parts[i] = minio.CompletePart{PartNumber: i + 1, ETag: part.ETag}
+			}
+		}()
+	}
+
+	func() {
+		defer close(partIDs)
+		for i := 0; i < count; i++ {
+			select {
+			case partIDs <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return parts, nil
+}
@@ -0,0 +1,155 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modstgs3
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeMultipartClient struct {
+	sync.Mutex
+	objects  map[string][]byte
+	parts    map[int][]byte
+	failPart int
+	aborted  bool
+}
+
+func newFakeMultipartClient() *fakeMultipartClient {
+	return &fakeMultipartClient{objects: map[string][]byte{}, parts: map[int][]byte{}}
+}
+
+func (f *fakeMultipartClient) PutObject(ctx context.Context, bucket, object string,
+	data io.Reader, size int64, md5Base64, sha256Hex string, opts minio.PutObjectOptions,
+) (minio.UploadInfo, error) {
+	b, err := io.ReadAll(data)
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+	f.Lock()
+	defer f.Unlock()
+	f.objects[object] = b
+	return minio.UploadInfo{Size: size}, nil
+}
+
+func (f *fakeMultipartClient) NewMultipartUpload(ctx context.Context, bucket, object string,
+	opts minio.PutObjectOptions,
+) (string, error) {
+	return "upload", nil
+}
+
+func (f *fakeMultipartClient) PutObjectPart(ctx context.Context, bucket, object, uploadID string,
+	partID int, data io.Reader, size int64, md5Base64, sha256Hex string, sse encrypt.ServerSide,
+) (minio.ObjectPart, error) {
+	if partID == f.failPart {
+		return minio.ObjectPart{}, errors.New("connection reset")
+	}
+	b, err := io.ReadAll(data)
+	if err != nil {
+		return minio.ObjectPart{}, err
+	}
+	f.Lock()
+	defer f.Unlock()
+	f.parts[partID] = b
+	return minio.ObjectPart{PartNumber: partID, ETag: fmt.Sprintf("etag-%d", partID)}, nil
+}
+
+func (f *fakeMultipartClient) CompleteMultipartUpload(ctx context.Context, bucket, object,
+	uploadID string, parts []minio.CompletePart, opts minio.PutObjectOptions,
+) (string, error) {
+	f.Lock()
+	defer f.Unlock()
+	sort.Slice(parts, func(i, j int) bool { return parts[i].PartNumber < parts[j].PartNumber })
+	var buf bytes.Buffer
+	for i, part := range parts {
+		if part.PartNumber != i+1 || part.ETag != fmt.Sprintf("etag-%d", i+1) {
+			return "", fmt.Errorf("unexpected part %+v", part)
+		}
+		buf.Write(f.parts[part.PartNumber])
+	}
+	f.objects[object] = buf.Bytes()
+	return "etag", nil
+}
+
+func (f *fakeMultipartClient) AbortMultipartUpload(ctx context.Context, bucket, object,
+	uploadID string,
+) error {
+	f.aborted = true
+	return nil
+}
+
+func TestMultipartWriter(t *testing.T) {
+	ctx := context.Background()
+	content := make([]byte, 1000)
+	for i := range content {
+		content[i] = byte(i)
+	}
+	src := filepath.Join(t.TempDir(), "segment.db")
+	require.Nil(t, os.WriteFile(src, content, 0o644))
+
+	t.Run("small file", func(t *testing.T) {
+		client := newFakeMultipartClient()
+		w := &multipartWriter{client: client, partSize: 1000, concurrency: 2}
+
+		size, err := w.putFile(ctx, "obj", src)
+		require.Nil(t, err)
+		assert.Equal(t, int64(1000), size)
+		assert.Equal(t, content, client.objects["obj"])
+		assert.Empty(t, client.parts)
+	})
+
+	t.Run("file in parts", func(t *testing.T) {
+		client := newFakeMultipartClient()
+		w := &multipartWriter{client: client, partSize: 300, concurrency: 2}
+
+		size, err := w.putFile(ctx, "obj", src)
+		require.Nil(t, err)
+		assert.Equal(t, int64(1000), size)
+		assert.Len(t, client.parts, 4)
+		assert.Len(t, client.parts[4], 100)
+		assert.Equal(t, content, client.objects["obj"])
+	})
+
+	t.Run("failed part aborts upload", func(t *testing.T) {
+		client := newFakeMultipartClient()
+		client.failPart = 2
+		w := &multipartWriter{client: client, partSize: 300, concurrency: 2}
+
+		_, err := w.putFile(ctx, "obj", src)
+		assert.ErrorContains(t, err, "put part 2")
+		assert.True(t, client.aborted)
+		assert.NotContains(t, client.objects, "obj")
+	})
+
+	t.Run("part size grows to stay below the parts limit", func(t *testing.T) {
+		w := &multipartWriter{partSize: minPartSize}
+		assert.Equal(t, int64(minPartSize), w.partSizeFor(100*minPartSize))
+
+		size := int64(maxPartsCount)*minPartSize + 1
+		partSize := w.partSizeFor(size)
+		assert.Greater(t, partSize, int64(minPartSize))
+		assert.LessOrEqual(t, (size+partSize-1)/partSize, int64(maxPartsCount))
+	})
+}
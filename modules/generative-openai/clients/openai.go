@@ -145,7 +145,7 @@ func (v *openai) Generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 
 	if res.StatusCode >= 500 {
 		errorMessage := getErrorMessage(res.StatusCode, resBody.Error, "connection to OpenAI failed with status: %d error: %v")
-		return nil, errors.Errorf(errorMessage)
+		return nil, errors.New(moduletools.RedactAPIKey(errorMessage, apiKey))
 	} else if res.StatusCode >= 400 {
 		errorMessage := ""
 		if settings.IsLegacy() {
@@ -154,7 +154,7 @@ func (v *openai) Generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 			errorMessage = getErrorMessage(res.StatusCode, resBody.Error, "failed with status: %d and message: %v")
 		}
 
-		return nil, errors.Errorf(errorMessage)
+		return nil, errors.New(moduletools.RedactAPIKey(errorMessage, apiKey))
 	}
 
 	textResponse := resBody.Choices[0].Text
@@ -232,14 +232,13 @@ func countWhitespace(s string) int {
 }
 
 func (v *openai) getApiKey(ctx context.Context) (string, error) {
+	// see moduletools.APIKeyFromContext for the precedence
+	if apiKey := moduletools.APIKeyFromContext(ctx, "X-Openai-Api-Key"); apiKey != "" {
+		return apiKey, nil
+	}
 	if len(v.apiKey) > 0 {
 		return v.apiKey, nil
 	}
-	apiKey := ctx.Value("X-Openai-Api-Key")
-	if apiKeyHeader, ok := apiKey.([]string); ok &&
-		len(apiKeyHeader) > 0 && len(apiKeyHeader[0]) > 0 {
-		return apiKeyHeader[0], nil
-	}
 	return "", errors.New("no api key found " +
 		"neither in request header: X-OpenAI-Api-Key " +
 		"nor in environment variable under OPENAI_APIKEY")
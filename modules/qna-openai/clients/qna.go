@@ -100,10 +100,10 @@ func (v *qna) Answer(ctx context.Context, text, question string, cfg moduletools
 
 	if res.StatusCode >= 500 {
 		errorMessage := getErrorMessage(res.StatusCode, resBody.Error, "connection to OpenAI failed with status: %d error: %v")
-		return nil, errors.Errorf(errorMessage)
+		return nil, errors.New(moduletools.RedactAPIKey(errorMessage, apiKey))
 	} else if res.StatusCode >= 400 {
 		errorMessage := getErrorMessage(res.StatusCode, resBody.Error, "failed with status: %d")
-		return nil, errors.Errorf(errorMessage)
+		return nil, errors.New(moduletools.RedactAPIKey(errorMessage, apiKey))
 
 	}
 
@@ -139,14 +139,13 @@ A:`, strings.ReplaceAll(text, "\n", " "), question)
 }
 
 func (v *qna) getApiKey(ctx context.Context) (string, error) {
+	// see moduletools.APIKeyFromContext for the precedence
+	if apiKey := moduletools.APIKeyFromContext(ctx, "X-Openai-Api-Key"); apiKey != "" {
+		return apiKey, nil
+	}
 	if len(v.apiKey) > 0 {
 		return v.apiKey, nil
 	}
-	apiKey := ctx.Value("X-Openai-Api-Key")
-	if apiKeyHeader, ok := apiKey.([]string); ok &&
-		len(apiKeyHeader) > 0 && len(apiKeyHeader[0]) > 0 {
-		return apiKeyHeader[0], nil
-	}
 	return "", errors.New("no api key found " +
 		"neither in request header: X-OpenAI-Api-Key " +
 		"nor in environment variable under OPENAI_APIKEY")
@@ -14,11 +14,14 @@ package answer
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/tailor-inc/graphql"
 	"github.com/tailor-inc/graphql/language/ast"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/modules/qna-transformers/config"
 	"github.com/weaviate/weaviate/modules/qna-transformers/ent"
 )
 
@@ -35,6 +38,9 @@ type paramsHelper interface {
 	GetCertainty(params interface{}) float64
 	GetDistance(params interface{}) float64
 	GetRerank(params interface{}) bool
+	GetRerankMaxCandidates(params interface{}) int
+	GetRerankTimeout(params interface{}) int
+	GetRerankFallback(params interface{}) *bool
 }
 
 type AnswerProvider struct {
@@ -67,3 +73,32 @@ func (p *AnswerProvider) AdditionalPropertyFn(ctx context.Context,
 	}
 	return nil, errors.New("wrong parameters")
 }
+
+// RerankBudgetFn returns the budget of the reranking of the answers, the
+// values of the ask argument take precedence over the ones of the class.
+// Queries which don't rerank aren't bounded.
+func (p *AnswerProvider) RerankBudgetFn(params interface{},
+	argumentModuleParams map[string]interface{}, cfg moduletools.ClassConfig,
+) modulecapabilities.RerankBudget {
+	ask := argumentModuleParams["ask"]
+	if !p.paramsHelper.GetRerank(ask) {
+		return modulecapabilities.RerankBudget{}
+	}
+
+	settings := config.NewClassSettings(cfg)
+	budget := modulecapabilities.RerankBudget{
+		MaxCandidates: settings.RerankMaxCandidates(),
+		Timeout:       time.Duration(settings.RerankTimeout() * float64(time.Millisecond)),
+		Fallback:      settings.RerankFallback(),
+	}
+	if maxCandidates := p.paramsHelper.GetRerankMaxCandidates(ask); maxCandidates > 0 {
+		budget.MaxCandidates = maxCandidates
+	}
+	if timeout := p.paramsHelper.GetRerankTimeout(ask); timeout > 0 {
+		budget.Timeout = time.Duration(timeout) * time.Millisecond
+	}
+	if fallback := p.paramsHelper.GetRerankFallback(ask); fallback != nil {
+		budget.Fallback = *fallback
+	}
+	return budget
+}
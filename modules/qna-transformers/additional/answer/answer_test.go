@@ -14,10 +14,12 @@ package answer
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/search"
 	qnamodels "github.com/weaviate/weaviate/modules/qna-transformers/additional/models"
 	"github.com/weaviate/weaviate/modules/qna-transformers/ent"
@@ -498,6 +500,64 @@ func TestAdditionalAnswerProvider(t *testing.T) {
 	})
 }
 
+func TestAdditionalAnswerProviderRerankBudget(t *testing.T) {
+	answerProvider := New(&fakeQnAClient{}, &fakeParamsHelper{})
+	cfg := fakeClassConfig{
+		"rerankMaxCandidates": 20,
+		"rerankTimeout":       250.0,
+		"rerankFallback":      true,
+	}
+
+	t.Run("should not bound answers which aren't reranked", func(t *testing.T) {
+		argumentModuleParams := map[string]interface{}{
+			"ask": map[string]interface{}{
+				"question": "question",
+			},
+		}
+
+		budget := answerProvider.RerankBudgetFn(&Params{}, argumentModuleParams, cfg)
+
+		assert.Equal(t, modulecapabilities.RerankBudget{}, budget)
+	})
+
+	t.Run("should use the budget of the class", func(t *testing.T) {
+		argumentModuleParams := map[string]interface{}{
+			"ask": map[string]interface{}{
+				"question": "question",
+				"rerank":   true,
+			},
+		}
+
+		budget := answerProvider.RerankBudgetFn(&Params{}, argumentModuleParams, cfg)
+
+		assert.Equal(t, modulecapabilities.RerankBudget{
+			MaxCandidates: 20,
+			Timeout:       250 * time.Millisecond,
+			Fallback:      true,
+		}, budget)
+	})
+
+	t.Run("should override the budget of the class with the ask argument", func(t *testing.T) {
+		argumentModuleParams := map[string]interface{}{
+			"ask": map[string]interface{}{
+				"question":            "question",
+				"rerank":              true,
+				"rerankMaxCandidates": 5,
+				"rerankTimeout":       1000,
+				"rerankFallback":      false,
+			},
+		}
+
+		budget := answerProvider.RerankBudgetFn(&Params{}, argumentModuleParams, cfg)
+
+		assert.Equal(t, modulecapabilities.RerankBudget{
+			MaxCandidates: 5,
+			Timeout:       time.Second,
+			Fallback:      false,
+		}, budget)
+	})
+}
+
 type fakeQnAClient struct{}
 
 func (c *fakeQnAClient) Answer(ctx context.Context,
@@ -572,6 +632,47 @@ func (h *fakeParamsHelper) GetRerank(params interface{}) bool {
 	return false
 }
 
+func (h *fakeParamsHelper) GetRerankMaxCandidates(params interface{}) int {
+	if fakeParamsMap, ok := params.(map[string]interface{}); ok {
+		if maxCandidates, ok := fakeParamsMap["rerankMaxCandidates"].(int); ok {
+			return maxCandidates
+		}
+	}
+	return 0
+}
+
+func (h *fakeParamsHelper) GetRerankTimeout(params interface{}) int {
+	if fakeParamsMap, ok := params.(map[string]interface{}); ok {
+		if timeout, ok := fakeParamsMap["rerankTimeout"].(int); ok {
+			return timeout
+		}
+	}
+	return 0
+}
+
+func (h *fakeParamsHelper) GetRerankFallback(params interface{}) *bool {
+	if fakeParamsMap, ok := params.(map[string]interface{}); ok {
+		if fallback, ok := fakeParamsMap["rerankFallback"].(bool); ok {
+			return &fallback
+		}
+	}
+	return nil
+}
+
+type fakeClassConfig map[string]interface{}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
+
 func ptFloat(f float64) *float64 {
 	return &f
 }
@@ -28,6 +28,8 @@ type AdditionalProperty interface {
 	ExtractAdditionalFn(param []*ast.Argument) interface{}
 	AdditonalPropertyDefaultValue() interface{}
 	AdditionalFieldFn(classname string) *graphql.Field
+	RerankBudgetFn(params interface{}, argumentModuleParams map[string]interface{},
+		cfg moduletools.ClassConfig) modulecapabilities.RerankBudget
 }
 
 type GraphQLAdditionalArgumentsProvider struct {
@@ -53,5 +55,6 @@ func (p *GraphQLAdditionalArgumentsProvider) getAnswer() modulecapabilities.Addi
 			ExploreGet:  p.answerProvider.AdditionalPropertyFn,
 			ExploreList: p.answerProvider.AdditionalPropertyFn,
 		},
+		RerankBudgetFunction: p.answerProvider.RerankBudgetFn,
 	}
 }
@@ -65,6 +65,18 @@ func (g *GraphQLArgumentsProvider) askFields(prefix string) graphql.InputObjectC
 			Description: "Arranges the results by certainty",
 			Type:        graphql.Boolean,
 		},
+		"rerankMaxCandidates": &graphql.InputObjectFieldConfig{
+			Description: "Number of results which are reranked, the others follow them in their original order",
+			Type:        graphql.Int,
+		},
+		"rerankTimeout": &graphql.InputObjectFieldConfig{
+			Description: "Time in milliseconds the results may take to be reranked",
+			Type:        graphql.Int,
+		},
+		"rerankFallback": &graphql.InputObjectFieldConfig{
+			Description: "Returns the results in their original order if they can't be reranked within the timeout",
+			Type:        graphql.Boolean,
+		},
 	}
 	if g.askTransformer != nil {
 		askFields["autocorrect"] = &graphql.InputObjectFieldConfig{
@@ -33,13 +33,16 @@ func TestAskGraphQLArgument(t *testing.T) {
 		//   distance: 0.9
 		//   properties: ["prop1", "prop2"]
 		//   rerank: true
+		//   rerankMaxCandidates: 10
+		//   rerankTimeout: 500
+		//   rerankFallback: true
 		// }
 		assert.NotNil(t, ask)
 		assert.Equal(t, "QnATransformersPrefixClassAskInpObj", ask.Type.Name())
 		askFields, ok := ask.Type.(*graphql.InputObject)
 		assert.True(t, ok)
 		assert.NotNil(t, askFields)
-		assert.Equal(t, 5, len(askFields.Fields()))
+		assert.Equal(t, 8, len(askFields.Fields()))
 		fields := askFields.Fields()
 		question := fields["question"]
 		questionNonNull, questionNonNullOK := question.Type.(*graphql.NonNull)
@@ -53,6 +56,9 @@ func TestAskGraphQLArgument(t *testing.T) {
 		assert.True(t, propertiesListOK)
 		assert.Equal(t, "String", propertiesList.OfType.Name())
 		assert.NotNil(t, fields["rerank"])
+		assert.Equal(t, "Int", fields["rerankMaxCandidates"].Type.Name())
+		assert.Equal(t, "Int", fields["rerankTimeout"].Type.Name())
+		assert.Equal(t, "Boolean", fields["rerankFallback"].Type.Name())
 	})
 }
 
@@ -72,13 +78,16 @@ func TestAskGraphQLArgumentWithAutocorrect(t *testing.T) {
 		//   properties: ["prop1", "prop2"]
 		//   autocorrect: true
 		//   rerank: true
+		//   rerankMaxCandidates: 10
+		//   rerankTimeout: 500
+		//   rerankFallback: true
 		// }
 		assert.NotNil(t, ask)
 		assert.Equal(t, "QnATransformersPrefixClassAskInpObj", ask.Type.Name())
 		askFields, ok := ask.Type.(*graphql.InputObject)
 		assert.True(t, ok)
 		assert.NotNil(t, askFields)
-		assert.Equal(t, 6, len(askFields.Fields()))
+		assert.Equal(t, 9, len(askFields.Fields()))
 		fields := askFields.Fields()
 		question := fields["question"]
 		questionNonNull, questionNonNullOK := question.Type.(*graphql.NonNull)
@@ -93,5 +102,8 @@ func TestAskGraphQLArgumentWithAutocorrect(t *testing.T) {
 		assert.Equal(t, "String", propertiesList.OfType.Name())
 		assert.NotNil(t, fields["autocorrect"])
 		assert.NotNil(t, fields["rerank"])
+		assert.Equal(t, "Int", fields["rerankMaxCandidates"].Type.Name())
+		assert.Equal(t, "Int", fields["rerankTimeout"].Type.Name())
+		assert.Equal(t, "Boolean", fields["rerankFallback"].Type.Name())
 	})
 }
@@ -58,5 +58,21 @@ func (g *GraphQLArgumentsProvider) extractAskFn(source map[string]interface{}) i
 		args.Rerank = rerank.(bool)
 	}
 
+	rerankMaxCandidates, ok := source["rerankMaxCandidates"]
+	if ok {
+		args.RerankMaxCandidates = rerankMaxCandidates.(int)
+	}
+
+	rerankTimeout, ok := source["rerankTimeout"]
+	if ok {
+		args.RerankTimeout = rerankTimeout.(int)
+	}
+
+	rerankFallback, ok := source["rerankFallback"]
+	if ok {
+		fallback := rerankFallback.(bool)
+		args.RerankFallback = &fallback
+	}
+
 	return &args
 }
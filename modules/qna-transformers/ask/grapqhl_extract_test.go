@@ -136,6 +136,25 @@ func Test_extractAskFn(t *testing.T) {
 				Rerank:     true,
 			},
 		},
+		{
+			name: "should parse properly with question, rerank and rerank budget",
+			args: args{
+				source: map[string]interface{}{
+					"question":            "some question",
+					"rerank":              true,
+					"rerankMaxCandidates": 10,
+					"rerankTimeout":       500,
+					"rerankFallback":      false,
+				},
+			},
+			want: &AskParams{
+				Question:            "some question",
+				Rerank:              true,
+				RerankMaxCandidates: 10,
+				RerankTimeout:       500,
+				RerankFallback:      ptBool(false),
+			},
+		},
 	}
 
 	testsWithAutocorrect := []struct {
@@ -281,3 +300,7 @@ func Test_extractAskFn(t *testing.T) {
 		}
 	})
 }
+
+func ptBool(b bool) *bool {
+	return &b
+}
@@ -23,6 +23,11 @@ type AskParams struct {
 	Properties   []string
 	Autocorrect  bool
 	Rerank       bool
+	// RerankMaxCandidates, RerankTimeout (in milliseconds) and RerankFallback
+	// override the rerank budget of the class
+	RerankMaxCandidates int
+	RerankTimeout       int
+	RerankFallback      *bool
 }
 
 func (n AskParams) GetCertainty() float64 {
@@ -52,5 +57,13 @@ func (g *GraphQLArgumentsProvider) validateAskFn(param interface{}) error {
 			"nearText cannot provide both distance and certainty")
 	}
 
+	if ask.RerankMaxCandidates < 0 {
+		return errors.Errorf("'ask.rerankMaxCandidates' cannot be negative")
+	}
+
+	if ask.RerankTimeout < 0 {
+		return errors.Errorf("'ask.rerankTimeout' cannot be negative")
+	}
+
 	return nil
 }
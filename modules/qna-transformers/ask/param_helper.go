@@ -51,3 +51,24 @@ func (p *ParamsHelper) GetRerank(params interface{}) bool {
 	}
 	return false
 }
+
+func (p *ParamsHelper) GetRerankMaxCandidates(params interface{}) int {
+	if parameters, ok := params.(*AskParams); ok {
+		return parameters.RerankMaxCandidates
+	}
+	return 0
+}
+
+func (p *ParamsHelper) GetRerankTimeout(params interface{}) int {
+	if parameters, ok := params.(*AskParams); ok {
+		return parameters.RerankTimeout
+	}
+	return 0
+}
+
+func (p *ParamsHelper) GetRerankFallback(params interface{}) *bool {
+	if parameters, ok := params.(*AskParams); ok {
+		return parameters.RerankFallback
+	}
+	return nil
+}
@@ -56,6 +56,26 @@ func Test_validateAskFn(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "should not validate when rerankMaxCandidates is negative",
+			args: args{
+				param: &AskParams{
+					Question:            "question",
+					RerankMaxCandidates: -1,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should not validate when rerankTimeout is negative",
+			args: args{
+				param: &AskParams{
+					Question:      "question",
+					RerankTimeout: -1,
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "should not validate when param passed is struct, not a pointer to struct",
 			args: args{
@@ -18,6 +18,7 @@ import (
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/modules/qna-transformers/config"
 )
 
 func (m *QnAModule) ClassConfigDefaults() map[string]interface{} {
@@ -33,7 +34,8 @@ func (m *QnAModule) PropertyConfigDefaults(
 func (m *QnAModule) ValidateClass(ctx context.Context,
 	class *models.Class, cfg moduletools.ClassConfig,
 ) error {
-	return nil
+	settings := config.NewClassSettings(cfg)
+	return settings.Validate(class)
 }
 
 var _ = modulecapabilities.ClassConfigurator(New())
@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+const (
+	rerankMaxCandidatesProperty = "rerankMaxCandidates"
+	rerankTimeoutProperty       = "rerankTimeout"
+	rerankFallbackProperty      = "rerankFallback"
+)
+
+var (
+	// DefaultRerankMaxCandidates and DefaultRerankTimeout don't bound the
+	// reranking of the answers
+	DefaultRerankMaxCandidates float64 = 0
+	DefaultRerankTimeout       float64 = 0
+	DefaultRerankFallback              = false
+)
+
+type classSettings struct {
+	cfg moduletools.ClassConfig
+}
+
+func NewClassSettings(cfg moduletools.ClassConfig) *classSettings {
+	return &classSettings{cfg: cfg}
+}
+
+func (ic *classSettings) Validate(class *models.Class) error {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return errors.New("empty config")
+	}
+
+	maxCandidates := ic.getFloatProperty(rerankMaxCandidatesProperty, &DefaultRerankMaxCandidates)
+	if maxCandidates == nil || *maxCandidates < 0 || *maxCandidates != float64(int(*maxCandidates)) {
+		return errors.Errorf("Wrong rerankMaxCandidates configuration, value must be a non-negative integer")
+	}
+
+	timeout := ic.getFloatProperty(rerankTimeoutProperty, &DefaultRerankTimeout)
+	if timeout == nil || *timeout < 0 {
+		return errors.Errorf("Wrong rerankTimeout configuration, value must be a non-negative number of milliseconds")
+	}
+
+	if _, ok := ic.getBoolProperty(rerankFallbackProperty, DefaultRerankFallback); !ok {
+		return errors.Errorf("Wrong rerankFallback configuration, value must be a boolean")
+	}
+
+	return nil
+}
+
+// RerankMaxCandidates is the number of results whose answers are reranked,
+// 0 reranks all results
+func (ic *classSettings) RerankMaxCandidates() int {
+	return int(*ic.getFloatProperty(rerankMaxCandidatesProperty, &DefaultRerankMaxCandidates))
+}
+
+// RerankTimeout is the time in milliseconds the answers may take to be
+// reranked, 0 doesn't bound it
+func (ic *classSettings) RerankTimeout() float64 {
+	return *ic.getFloatProperty(rerankTimeoutProperty, &DefaultRerankTimeout)
+}
+
+// RerankFallback returns whether the results are returned in their original
+// order if the answers can't be found within the timeout
+func (ic *classSettings) RerankFallback() bool {
+	fallback, _ := ic.getBoolProperty(rerankFallbackProperty, DefaultRerankFallback)
+	return fallback
+}
+
+func (ic *classSettings) getBoolProperty(name string, defaultValue bool) (bool, bool) {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return defaultValue, true
+	}
+
+	val, ok := ic.cfg.ClassByModuleName("qna-transformers")[name]
+	if ok {
+		asBool, ok := val.(bool)
+		return asBool, ok
+	}
+	return defaultValue, true
+}
+
+func (ic *classSettings) getFloatProperty(name string, defaultValue *float64) *float64 {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return defaultValue
+	}
+
+	val, ok := ic.cfg.ClassByModuleName("qna-transformers")[name]
+	if ok {
+		asFloat, ok := val.(float64)
+		if ok {
+			return &asFloat
+		}
+		asNumber, ok := val.(json.Number)
+		if ok {
+			asFloat, _ := asNumber.Float64()
+			return &asFloat
+		}
+		asInt, ok := val.(int)
+		if ok {
+			asFloat := float64(asInt)
+			return &asFloat
+		}
+		var wrongVal float64 = -1.0
+		return &wrongVal
+	}
+
+	if defaultValue != nil {
+		return defaultValue
+	}
+	return nil
+}
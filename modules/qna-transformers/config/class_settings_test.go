@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+func Test_classSettings_Validate(t *testing.T) {
+	tests := []struct {
+		name                    string
+		cfg                     moduletools.ClassConfig
+		wantRerankMaxCandidates int
+		wantRerankTimeout       float64
+		wantRerankFallback      bool
+		wantErr                 error
+	}{
+		{
+			name: "Happy flow",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{},
+			},
+			wantRerankMaxCandidates: 0,
+			wantRerankTimeout:       0,
+			wantRerankFallback:      false,
+			wantErr:                 nil,
+		},
+		{
+			name: "Everything non default configured",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"rerankMaxCandidates": 10,
+					"rerankTimeout":       250.0,
+					"rerankFallback":      true,
+				},
+			},
+			wantRerankMaxCandidates: 10,
+			wantRerankTimeout:       250,
+			wantRerankFallback:      true,
+			wantErr:                 nil,
+		},
+		{
+			name: "Wrong rerankMaxCandidates configured",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"rerankMaxCandidates": true,
+				},
+			},
+			wantErr: errors.Errorf("Wrong rerankMaxCandidates configuration, value must be a non-negative integer"),
+		},
+		{
+			name: "Fractional rerankMaxCandidates configured",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"rerankMaxCandidates": 2.5,
+				},
+			},
+			wantErr: errors.Errorf("Wrong rerankMaxCandidates configuration, value must be a non-negative integer"),
+		},
+		{
+			name: "Negative rerankTimeout configured",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"rerankTimeout": -5,
+				},
+			},
+			wantErr: errors.Errorf("Wrong rerankTimeout configuration, value must be a non-negative number of milliseconds"),
+		},
+		{
+			name: "Wrong rerankFallback configured",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"rerankFallback": "yes",
+				},
+			},
+			wantErr: errors.Errorf("Wrong rerankFallback configuration, value must be a boolean"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ic := NewClassSettings(tt.cfg)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr.Error(), ic.Validate(nil).Error())
+			} else {
+				assert.Nil(t, ic.Validate(nil))
+				assert.Equal(t, tt.wantRerankMaxCandidates, ic.RerankMaxCandidates())
+				assert.Equal(t, tt.wantRerankTimeout, ic.RerankTimeout())
+				assert.Equal(t, tt.wantRerankFallback, ic.RerankFallback())
+			}
+		})
+	}
+}
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
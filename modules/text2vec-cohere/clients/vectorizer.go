@@ -21,6 +21,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/text2vec-cohere/ent"
 )
 
@@ -103,10 +104,10 @@ func (v *vectorizer) vectorize(ctx context.Context, input []string,
 
 	if res.StatusCode >= 500 {
 		errorMessage := getErrorMessage(res.StatusCode, resBody.Message, "connection to Cohere failed with status: %d error: %v")
-		return nil, errors.Errorf(errorMessage)
+		return nil, errors.New(moduletools.RedactAPIKey(errorMessage, apiKey))
 	} else if res.StatusCode > 200 {
 		errorMessage := getErrorMessage(res.StatusCode, resBody.Message, "failed with status: %d error: %v")
-		return nil, errors.Errorf(errorMessage)
+		return nil, errors.New(moduletools.RedactAPIKey(errorMessage, apiKey))
 	}
 
 	if len(resBody.Embeddings) == 0 {
@@ -128,14 +129,13 @@ func getErrorMessage(statusCode int, resBodyError string, errorTemplate string)
 }
 
 func (v *vectorizer) getApiKey(ctx context.Context) (string, error) {
+	// see moduletools.APIKeyFromContext for the precedence
+	if apiKey := moduletools.APIKeyFromContext(ctx, "X-Cohere-Api-Key"); apiKey != "" {
+		return apiKey, nil
+	}
 	if len(v.apiKey) > 0 {
 		return v.apiKey, nil
 	}
-	apiKey := ctx.Value("X-Cohere-Api-Key")
-	if apiKeyHeader, ok := apiKey.([]string); ok &&
-		len(apiKeyHeader) > 0 && len(apiKeyHeader[0]) > 0 {
-		return apiKeyHeader[0], nil
-	}
 	return "", errors.New("no api key found " +
 		"neither in request header: X-Cohere-Api-Key " +
 		"nor in environment variable under COHERE_APIKEY")
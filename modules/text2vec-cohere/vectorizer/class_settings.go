@@ -20,6 +20,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/schema"
+	libvectorizer "github.com/weaviate/weaviate/usecases/vectorizer"
 )
 
 const (
@@ -123,6 +124,10 @@ func (ic *classSettings) Validate(class *models.Class) error {
 		return errors.Errorf("wrong truncate type, available types are: %v", availableTruncates)
 	}
 
+	if err := ic.validateInputRules(class); err != nil {
+		return err
+	}
+
 	err := ic.validateIndexState(class, ic)
 	if err != nil {
 		return err
@@ -197,3 +202,25 @@ func (cv *classSettings) validateIndexState(class *models.Class, settings ClassS
 		"contextionary-valid text/string property which is not excluded from " +
 		"indexing")
 }
+
+// InputRules see libvectorizer.InputRules
+func (ic *classSettings) InputRules() libvectorizer.InputRules {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return libvectorizer.DefaultInputRules()
+	}
+
+	rules, err := libvectorizer.InputRulesFromConfig(ic.cfg.Class())
+	if err != nil {
+		return libvectorizer.DefaultInputRules()
+	}
+	return rules
+}
+
+func (ic *classSettings) validateInputRules(class *models.Class) error {
+	rules, err := libvectorizer.InputRulesFromConfig(ic.cfg.Class())
+	if err != nil {
+		return err
+	}
+	return rules.Validate(class)
+}
@@ -15,6 +15,7 @@ import (
 	"context"
 
 	"github.com/weaviate/weaviate/modules/text2vec-cohere/ent"
+	libvectorizer "github.com/weaviate/weaviate/usecases/vectorizer"
 )
 
 type fakeClient struct {
@@ -73,3 +74,7 @@ func (f *fakeSettings) Model() string {
 func (f *fakeSettings) Truncate() string {
 	return f.truncateType
 }
+
+func (f *fakeSettings) InputRules() libvectorizer.InputRules {
+	return libvectorizer.DefaultInputRules()
+}
@@ -13,14 +13,11 @@ package vectorizer
 
 import (
 	"context"
-	"fmt"
-	"sort"
-	"strings"
 
-	"github.com/fatih/camelcase"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/text2vec-cohere/ent"
+	libvectorizer "github.com/weaviate/weaviate/usecases/vectorizer"
 )
 
 type Vectorizer struct {
@@ -45,19 +42,11 @@ type ClassSettings interface {
 	PropertyIndexed(property string) bool
 	VectorizePropertyName(propertyName string) bool
 	VectorizeClassName() bool
+	InputRules() libvectorizer.InputRules
 	Model() string
 	Truncate() string
 }
 
-func sortStringKeys(schema_map map[string]interface{}) []string {
-	keys := make([]string, 0, len(schema_map))
-	for k := range schema_map {
-		keys = append(keys, k)
-	}
-	sort.Strings(keys)
-	return keys
-}
-
 func (v *Vectorizer) Object(ctx context.Context, object *models.Object,
 	objDiff *moduletools.ObjectDiff, settings ClassSettings,
 ) error {
@@ -70,59 +59,19 @@ func (v *Vectorizer) Object(ctx context.Context, object *models.Object,
 	return nil
 }
 
-func appendPropIfText(icheck ClassSettings, list *[]string, propName string,
-	value interface{},
-) bool {
-	valueString, ok := value.(string)
-	if ok {
-		if icheck.VectorizePropertyName(propName) {
-			// use prop and value
-			*list = append(*list, strings.ToLower(
-				fmt.Sprintf("%s %s", camelCaseToLower(propName), valueString)))
-		} else {
-			*list = append(*list, strings.ToLower(valueString))
-		}
-		return true
-	}
-	return false
-}
-
 func (v *Vectorizer) object(ctx context.Context, className string,
 	schema interface{}, objDiff *moduletools.ObjectDiff, icheck ClassSettings,
 ) ([]float32, error) {
 	vectorize := objDiff == nil || objDiff.GetVec() == nil
 
-	var corpi []string
-	if icheck.VectorizeClassName() {
-		corpi = append(corpi, camelCaseToLower(className))
-	}
+	var props map[string]interface{}
 	if schema != nil {
-		schemamap := schema.(map[string]interface{})
-		for _, prop := range sortStringKeys(schemamap) {
-			if !icheck.PropertyIndexed(prop) {
-				continue
-			}
-
-			appended := false
-			switch val := schemamap[prop].(type) {
-			case []string:
-				for _, elem := range val {
-					appended = appendPropIfText(icheck, &corpi, prop, elem) || appended
-				}
-			case []interface{}:
-				for _, elem := range val {
-					appended = appendPropIfText(icheck, &corpi, prop, elem) || appended
-				}
-			default:
-				appended = appendPropIfText(icheck, &corpi, prop, val)
-			}
-
-			vectorize = vectorize || (appended && objDiff != nil && objDiff.IsChangedProp(prop))
-		}
+		props = schema.(map[string]interface{})
 	}
-	if len(corpi) == 0 {
-		// fall back to using the class name
-		corpi = append(corpi, camelCaseToLower(className))
+
+	text, used := icheck.InputRules().Build(className, props, icheck)
+	for _, prop := range used {
+		vectorize = vectorize || (objDiff != nil && objDiff.IsChangedProp(prop))
 	}
 
 	// no property was changed, old vector can be used
@@ -130,8 +79,7 @@ func (v *Vectorizer) object(ctx context.Context, className string,
 		return objDiff.GetVec(), nil
 	}
 
-	text := []string{strings.Join(corpi, " ")}
-	res, err := v.client.Vectorize(ctx, text, ent.VectorizationConfig{
+	res, err := v.client.Vectorize(ctx, []string{text}, ent.VectorizationConfig{
 		Model: icheck.Model(),
 	})
 	if err != nil {
@@ -140,21 +88,3 @@ func (v *Vectorizer) object(ctx context.Context, className string,
 
 	return res.Vector, nil
 }
-
-func camelCaseToLower(in string) string {
-	parts := camelcase.Split(in)
-	var sb strings.Builder
-	for i, part := range parts {
-		if part == " " {
-			continue
-		}
-
-		if i > 0 {
-			sb.WriteString(" ")
-		}
-
-		sb.WriteString(strings.ToLower(part))
-	}
-
-	return sb.String()
-}
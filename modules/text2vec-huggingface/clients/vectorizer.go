@@ -21,6 +21,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/text2vec-huggingface/ent"
 )
 
@@ -91,7 +92,8 @@ func (v *vectorizer) vectorize(ctx context.Context, url string,
 	if err != nil {
 		return nil, errors.Wrap(err, "create POST request")
 	}
-	if apiKey := v.getApiKey(ctx); apiKey != "" {
+	apiKey := v.getApiKey(ctx)
+	if apiKey != "" {
 		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", apiKey))
 	}
 	req.Header.Add("Content-Type", "application/json")
@@ -108,7 +110,7 @@ func (v *vectorizer) vectorize(ctx context.Context, url string,
 	}
 
 	if err := checkResponse(res, bodyBytes); err != nil {
-		return nil, err
+		return nil, errors.New(moduletools.RedactAPIKey(err.Error(), apiKey))
 	}
 
 	vector, err := v.decodeVector(bodyBytes)
@@ -174,15 +176,11 @@ func (v *vectorizer) decodeVector(bodyBytes []byte) ([]float32, error) {
 }
 
 func (v *vectorizer) getApiKey(ctx context.Context) string {
-	if len(v.apiKey) > 0 {
-		return v.apiKey
+	// see moduletools.APIKeyFromContext for the precedence
+	if apiKey := moduletools.APIKeyFromContext(ctx, "X-Huggingface-Api-Key"); apiKey != "" {
+		return apiKey
 	}
-	apiKey := ctx.Value("X-Huggingface-Api-Key")
-	if apiKeyHeader, ok := apiKey.([]string); ok &&
-		len(apiKeyHeader) > 0 && len(apiKeyHeader[0]) > 0 {
-		return apiKeyHeader[0]
-	}
-	return ""
+	return v.apiKey
 }
 
 func (v *vectorizer) getURL(config ent.VectorizationConfig) string {
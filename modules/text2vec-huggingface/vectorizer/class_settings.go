@@ -19,6 +19,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/schema"
+	libvectorizer "github.com/weaviate/weaviate/usecases/vectorizer"
 )
 
 const (
@@ -138,6 +139,10 @@ func (ic *classSettings) Validate(class *models.Class) error {
 		return err
 	}
 
+	if err := ic.validateInputRules(class); err != nil {
+		return err
+	}
+
 	err = ic.validateIndexState(class, ic)
 	if err != nil {
 		return err
@@ -273,3 +278,25 @@ func (cv *classSettings) validateIndexState(class *models.Class, settings ClassS
 		"contextionary-valid text/string property which is not excluded from " +
 		"indexing.")
 }
+
+// InputRules see libvectorizer.InputRules
+func (ic *classSettings) InputRules() libvectorizer.InputRules {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return libvectorizer.DefaultInputRules()
+	}
+
+	rules, err := libvectorizer.InputRulesFromConfig(ic.cfg.Class())
+	if err != nil {
+		return libvectorizer.DefaultInputRules()
+	}
+	return rules
+}
+
+func (ic *classSettings) validateInputRules(class *models.Class) error {
+	rules, err := libvectorizer.InputRulesFromConfig(ic.cfg.Class())
+	if err != nil {
+		return err
+	}
+	return rules.Validate(class)
+}
@@ -15,6 +15,7 @@ import (
 	"context"
 
 	"github.com/weaviate/weaviate/modules/text2vec-huggingface/ent"
+	libvectorizer "github.com/weaviate/weaviate/usecases/vectorizer"
 )
 
 type fakeClient struct {
@@ -90,3 +91,7 @@ func (f *fakeSettings) OptionUseGPU() bool {
 func (f *fakeSettings) OptionUseCache() bool {
 	return f.useCache
 }
+
+func (f *fakeSettings) InputRules() libvectorizer.InputRules {
+	return libvectorizer.DefaultInputRules()
+}
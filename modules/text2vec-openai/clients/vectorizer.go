@@ -22,12 +22,14 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/text2vec-openai/ent"
 )
 
 type embeddingsRequest struct {
-	Input string `json:"input"`
-	Model string `json:"model"`
+	// Input is a single text or, for batches, a list of texts
+	Input interface{} `json:"input"`
+	Model string      `json:"model"`
 }
 
 type embedding struct {
@@ -79,9 +81,48 @@ func (v *vectorizer) VectorizeQuery(ctx context.Context, input string,
 	return v.vectorize(ctx, input, v.getModelString(config.Type, config.Model, "query", config.ModelVersion))
 }
 
+// VectorizeBatch vectorizes all inputs with a single request, the results
+// are in the order of the inputs
+func (v *vectorizer) VectorizeBatch(ctx context.Context, inputs []string,
+	config ent.VectorizationConfig,
+) ([]*ent.VectorizationResult, error) {
+	vectors, err := v.embeddings(ctx, inputs, len(inputs),
+		v.getModelString(config.Type, config.Model, "document", config.ModelVersion))
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*ent.VectorizationResult, len(inputs))
+	for i := range inputs {
+		out[i] = &ent.VectorizationResult{
+			Text:       inputs[i],
+			Dimensions: len(vectors[i]),
+			Vector:     vectors[i],
+		}
+	}
+	return out, nil
+}
+
 func (v *vectorizer) vectorize(ctx context.Context, input string,
 	model string,
 ) (*ent.VectorizationResult, error) {
+	vectors, err := v.embeddings(ctx, input, 1, model)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ent.VectorizationResult{
+		Text:       input,
+		Dimensions: len(vectors[0]),
+		Vector:     vectors[0],
+	}, nil
+}
+
+// embeddings returns the count embeddings of the input in the order of the
+// input
+func (v *vectorizer) embeddings(ctx context.Context, input interface{},
+	count int, model string,
+) ([][]float32, error) {
 	body, err := json.Marshal(embeddingsRequest{
 		Input: input,
 		Model: model,
@@ -125,22 +166,26 @@ func (v *vectorizer) vectorize(ctx context.Context, input string,
 
 	if res.StatusCode >= 500 {
 		errorMessage := getErrorMessage(res.StatusCode, resBody.Error, "connection to OpenAI failed with status: %d error: %v")
-		return nil, errors.Errorf(errorMessage)
+		return nil, errors.New(moduletools.RedactAPIKey(errorMessage, apiKey))
 	}
 	if res.StatusCode >= 400 {
 		errorMessage := getErrorMessage(res.StatusCode, resBody.Error, "failed with status: %d")
-		return nil, errors.Errorf(errorMessage)
+		return nil, errors.New(moduletools.RedactAPIKey(errorMessage, apiKey))
 	}
 
-	if len(resBody.Data) != 1 {
+	if len(resBody.Data) != count {
 		return nil, errors.Errorf("wrong number of embeddings: %v", len(resBody.Data))
 	}
 
-	return &ent.VectorizationResult{
-		Text:       input,
-		Dimensions: len(resBody.Data[0].Embedding),
-		Vector:     resBody.Data[0].Embedding,
-	}, nil
+	vectors := make([][]float32, count)
+	for _, data := range resBody.Data {
+		if data.Index < 0 || data.Index >= count || vectors[data.Index] != nil {
+			return nil, errors.Errorf("invalid embedding index: %v", data.Index)
+		}
+		vectors[data.Index] = data.Embedding
+	}
+
+	return vectors, nil
 }
 
 func getErrorMessage(statusCode int, resBodyError *openAIApiError, errorTemplate string) string {
@@ -151,14 +196,13 @@ func getErrorMessage(statusCode int, resBodyError *openAIApiError, errorTemplate
 }
 
 func (v *vectorizer) getApiKey(ctx context.Context) (string, error) {
+	// see moduletools.APIKeyFromContext for the precedence
+	if apiKey := moduletools.APIKeyFromContext(ctx, "X-Openai-Api-Key"); apiKey != "" {
+		return apiKey, nil
+	}
 	if len(v.apiKey) > 0 {
 		return v.apiKey, nil
 	}
-	apiKey := ctx.Value("X-Openai-Api-Key")
-	if apiKeyHeader, ok := apiKey.([]string); ok &&
-		len(apiKeyHeader) > 0 && len(apiKeyHeader[0]) > 0 {
-		return apiKeyHeader[0], nil
-	}
 	return "", errors.New("no api key found " +
 		"neither in request header: X-OpenAI-Api-Key " +
 		"nor in environment variable under OPENAI_APIKEY")
@@ -51,6 +51,36 @@ func TestClient(t *testing.T) {
 		assert.Equal(t, expected, res)
 	})
 
+	t.Run("when vectorizing a batch", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t})
+		defer server.Close()
+
+		c := New("apiKey", nullLogger())
+		c.host = server.URL
+
+		expected := []*ent.VectorizationResult{
+			{
+				Text:       "first text",
+				Vector:     []float32{0, 0.2, 0.3},
+				Dimensions: 3,
+			},
+			{
+				Text:       "second text",
+				Vector:     []float32{1, 0.2, 0.3},
+				Dimensions: 3,
+			},
+		}
+		res, err := c.VectorizeBatch(context.Background(),
+			[]string{"first text", "second text"},
+			ent.VectorizationConfig{
+				Type:  "text",
+				Model: "ada",
+			})
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, res)
+	})
+
 	t.Run("when the context is expired", func(t *testing.T) {
 		server := httptest.NewServer(&fakeHandler{t: t})
 		defer server.Close()
@@ -103,6 +133,40 @@ func TestClient(t *testing.T) {
 		assert.Equal(t, expected, res)
 	})
 
+	t.Run("when the X-Openai-Api-Key header overrides the configured key", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t, expectedAuth: "Bearer request-key"})
+		defer server.Close()
+		c := New("server-key", nullLogger())
+		c.host = server.URL
+		ctxWithValue := context.WithValue(context.Background(),
+			"X-Openai-Api-Key", []string{"request-key"})
+
+		_, err := c.Vectorize(ctxWithValue, "This is my text",
+			ent.VectorizationConfig{})
+
+		require.Nil(t, err)
+	})
+
+	t.Run("when the api key is echoed in the error", func(t *testing.T) {
+		key := "sk-0123456789abcdefghij"
+		server := httptest.NewServer(&fakeHandler{
+			t:           t,
+			serverError: errors.Errorf("Incorrect API key provided: %s", key),
+		})
+		defer server.Close()
+		c := New("", nullLogger())
+		c.host = server.URL
+		ctxWithValue := context.WithValue(context.Background(),
+			"X-Openai-Api-Key", []string{key})
+
+		_, err := c.Vectorize(ctxWithValue, "This is my text",
+			ent.VectorizationConfig{})
+
+		require.NotNil(t, err)
+		assert.NotContains(t, err.Error(), key)
+		assert.Contains(t, err.Error(), "Incorrect API key provided: ***ghij")
+	})
+
 	t.Run("when OpenAI key is empty", func(t *testing.T) {
 		server := httptest.NewServer(&fakeHandler{t: t})
 		defer server.Close()
@@ -142,12 +206,16 @@ func TestClient(t *testing.T) {
 }
 
 type fakeHandler struct {
-	t           *testing.T
-	serverError error
+	t            *testing.T
+	serverError  error
+	expectedAuth string
 }
 
 func (f *fakeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	assert.Equal(f.t, http.MethodPost, r.Method)
+	if f.expectedAuth != "" {
+		assert.Equal(f.t, f.expectedAuth, r.Header.Get("Authorization"))
+	}
 
 	if f.serverError != nil {
 		embeddingError := map[string]interface{}{
@@ -172,17 +240,30 @@ func (f *fakeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	var b map[string]interface{}
 	require.Nil(f.t, json.Unmarshal(bodyBytes, &b))
 
-	textInput := b["input"].(string)
-	assert.Greater(f.t, len(textInput), 0)
-
-	embeddingData := map[string]interface{}{
-		"object":    "embedding",
-		"index":     0,
-		"embedding": []float32{0.1, 0.2, 0.3},
+	var data []interface{}
+	if batch, ok := b["input"].([]interface{}); ok {
+		// answer in reverse order, the index is what matters
+		for i := len(batch) - 1; i >= 0; i-- {
+			assert.Greater(f.t, len(batch[i].(string)), 0)
+			data = append(data, map[string]interface{}{
+				"object":    "embedding",
+				"index":     i,
+				"embedding": []float32{float32(i), 0.2, 0.3},
+			})
+		}
+	} else {
+		textInput := b["input"].(string)
+		assert.Greater(f.t, len(textInput), 0)
+
+		data = append(data, map[string]interface{}{
+			"object":    "embedding",
+			"index":     0,
+			"embedding": []float32{0.1, 0.2, 0.3},
+		})
 	}
 	embedding := map[string]interface{}{
 		"object": "list",
-		"data":   []interface{}{embeddingData},
+		"data":   data,
 	}
 
 	outBytes, err := json.Marshal(embedding)
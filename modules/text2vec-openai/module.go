@@ -44,6 +44,8 @@ type OpenAIModule struct {
 type textVectorizer interface {
 	Object(ctx context.Context, obj *models.Object, objDiff *moduletools.ObjectDiff,
 		settings vectorizer.ClassSettings) error
+	Objects(ctx context.Context, objs []*models.Object,
+		settings vectorizer.ClassSettings) []error
 	Texts(ctx context.Context, input []string,
 		settings vectorizer.ClassSettings) ([]float32, error)
 	// TODO all of these should be moved out of here, gh-1470
@@ -129,6 +131,13 @@ func (m *OpenAIModule) VectorizeObject(ctx context.Context,
 	return m.vectorizer.Object(ctx, obj, objDiff, icheck)
 }
 
+func (m *OpenAIModule) VectorizeBatch(ctx context.Context,
+	objs []*models.Object, cfg moduletools.ClassConfig,
+) []error {
+	icheck := vectorizer.NewClassSettings(cfg)
+	return m.vectorizer.Objects(ctx, objs, icheck)
+}
+
 func (m *OpenAIModule) MetaInfo() (map[string]interface{}, error) {
 	return m.metaProvider.MetaInfo()
 }
@@ -148,6 +157,7 @@ func (m *OpenAIModule) VectorizeInput(ctx context.Context,
 var (
 	_ = modulecapabilities.Module(New())
 	_ = modulecapabilities.Vectorizer(New())
+	_ = modulecapabilities.BatchVectorizer(New())
 	_ = modulecapabilities.MetaProvider(New())
 	_ = modulecapabilities.Searcher(New())
 	_ = modulecapabilities.GraphQLArguments(New())
@@ -20,6 +20,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/schema"
+	libvectorizer "github.com/weaviate/weaviate/usecases/vectorizer"
 )
 
 const (
@@ -137,6 +138,10 @@ func (ic *classSettings) Validate(class *models.Class) error {
 		return err
 	}
 
+	if err := ic.validateInputRules(class); err != nil {
+		return err
+	}
+
 	err := ic.validateIndexState(class, ic)
 	if err != nil {
 		return err
@@ -246,3 +251,25 @@ func PickDefaultModelVersion(model, docType string) string {
 	// for all other combinations stick with "001"
 	return "001"
 }
+
+// InputRules see libvectorizer.InputRules
+func (ic *classSettings) InputRules() libvectorizer.InputRules {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return libvectorizer.DefaultInputRules()
+	}
+
+	rules, err := libvectorizer.InputRulesFromConfig(ic.cfg.Class())
+	if err != nil {
+		return libvectorizer.DefaultInputRules()
+	}
+	return rules
+}
+
+func (ic *classSettings) validateInputRules(class *models.Class) error {
+	rules, err := libvectorizer.InputRulesFromConfig(ic.cfg.Class())
+	if err != nil {
+		return err
+	}
+	return rules.Validate(class)
+}
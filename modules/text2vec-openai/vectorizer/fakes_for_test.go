@@ -14,12 +14,17 @@ package vectorizer
 import (
 	"context"
 
+	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/modules/text2vec-openai/ent"
+	libvectorizer "github.com/weaviate/weaviate/usecases/vectorizer"
 )
 
 type fakeClient struct {
 	lastInput  string
 	lastConfig ent.VectorizationConfig
+	// requests which contain the failingInput fail
+	failingInput string
+	batchSizes   []int
 }
 
 func (c *fakeClient) Vectorize(ctx context.Context,
@@ -27,6 +32,9 @@ func (c *fakeClient) Vectorize(ctx context.Context,
 ) (*ent.VectorizationResult, error) {
 	c.lastInput = text
 	c.lastConfig = cfg
+	if c.failingInput != "" && text == c.failingInput {
+		return nil, errors.Errorf("cannot vectorize %q", text)
+	}
 	return &ent.VectorizationResult{
 		Vector:     []float32{0, 1, 2, 3},
 		Dimensions: 4,
@@ -46,6 +54,25 @@ func (c *fakeClient) VectorizeQuery(ctx context.Context,
 	}, nil
 }
 
+func (c *fakeClient) VectorizeBatch(ctx context.Context,
+	texts []string, cfg ent.VectorizationConfig,
+) ([]*ent.VectorizationResult, error) {
+	c.lastConfig = cfg
+	c.batchSizes = append(c.batchSizes, len(texts))
+	out := make([]*ent.VectorizationResult, len(texts))
+	for i, text := range texts {
+		if c.failingInput != "" && text == c.failingInput {
+			return nil, errors.Errorf("cannot vectorize %q", text)
+		}
+		out[i] = &ent.VectorizationResult{
+			Vector:     []float32{float32(i), 1, 2, 3},
+			Dimensions: 4,
+			Text:       text,
+		}
+	}
+	return out, nil
+}
+
 type fakeSettings struct {
 	skippedProperty    string
 	vectorizeClassName bool
@@ -78,3 +105,7 @@ func (f *fakeSettings) Model() string {
 func (f *fakeSettings) ModelVersion() string {
 	return f.openAIModelVersion
 }
+
+func (f *fakeSettings) InputRules() libvectorizer.InputRules {
+	return libvectorizer.DefaultInputRules()
+}
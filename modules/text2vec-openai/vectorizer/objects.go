@@ -13,14 +13,11 @@ package vectorizer
 
 import (
 	"context"
-	"fmt"
-	"sort"
-	"strings"
 
-	"github.com/fatih/camelcase"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/modules/text2vec-openai/ent"
+	libvectorizer "github.com/weaviate/weaviate/usecases/vectorizer"
 )
 
 type Vectorizer struct {
@@ -38,27 +35,30 @@ type Client interface {
 		config ent.VectorizationConfig) (*ent.VectorizationResult, error)
 	VectorizeQuery(ctx context.Context, input string,
 		config ent.VectorizationConfig) (*ent.VectorizationResult, error)
+	VectorizeBatch(ctx context.Context, inputs []string,
+		config ent.VectorizationConfig) ([]*ent.VectorizationResult, error)
 }
 
+const (
+	// batchMaxTexts is the maximum number of inputs of a single request to
+	// the embeddings API
+	batchMaxTexts = 2048
+	// batchMaxTokens keeps the estimated tokens of a single request well
+	// below the limits of the embeddings API
+	batchMaxTokens = 100_000
+)
+
 // IndexCheck returns whether a property of a class should be indexed
 type ClassSettings interface {
 	PropertyIndexed(property string) bool
 	VectorizePropertyName(propertyName string) bool
 	VectorizeClassName() bool
+	InputRules() libvectorizer.InputRules
 	Model() string
 	Type() string
 	ModelVersion() string
 }
 
-func sortStringKeys(schema_map map[string]interface{}) []string {
-	keys := make([]string, 0, len(schema_map))
-	for k := range schema_map {
-		keys = append(keys, k)
-	}
-	sort.Strings(keys)
-	return keys
-}
-
 func (v *Vectorizer) Object(ctx context.Context, object *models.Object,
 	objDiff *moduletools.ObjectDiff, settings ClassSettings,
 ) error {
@@ -71,21 +71,59 @@ func (v *Vectorizer) Object(ctx context.Context, object *models.Object,
 	return nil
 }
 
-func appendPropIfText(icheck ClassSettings, list *[]string, propName string,
-	value interface{},
-) bool {
-	valueString, ok := value.(string)
-	if ok {
-		if icheck.VectorizePropertyName(propName) {
-			// use prop and value
-			*list = append(*list, strings.ToLower(
-				fmt.Sprintf("%s %s", camelCaseToLower(propName), valueString)))
-		} else {
-			*list = append(*list, strings.ToLower(valueString))
+// Objects vectorizes the objects with as few requests as possible. If a
+// request fails, its objects are vectorized one by one, so that the error
+// is only returned for the objects which caused it.
+func (v *Vectorizer) Objects(ctx context.Context, objects []*models.Object,
+	settings ClassSettings,
+) []error {
+	texts := make([]string, len(objects))
+	for i, object := range objects {
+		var props map[string]interface{}
+		if object.Properties != nil {
+			props = object.Properties.(map[string]interface{})
 		}
-		return true
+		texts[i], _ = settings.InputRules().Build(object.Class, props, settings)
 	}
-	return false
+
+	cfg := ent.VectorizationConfig{
+		Type:         settings.Type(),
+		Model:        settings.Model(),
+		ModelVersion: settings.ModelVersion(),
+	}
+
+	errs := make([]error, len(objects))
+	starts := libvectorizer.Batches(texts, batchMaxTexts, batchMaxTokens)
+	for b, start := range starts {
+		end := len(texts)
+		if b+1 < len(starts) {
+			end = starts[b+1]
+		}
+
+		res, err := v.client.VectorizeBatch(ctx, texts[start:end], cfg)
+		if err == nil {
+			for i := start; i < end; i++ {
+				objects[i].Vector = res[i-start].Vector
+			}
+			continue
+		}
+
+		if end-start == 1 {
+			errs[start] = err
+			continue
+		}
+
+		for i := start; i < end; i++ {
+			res, err := v.client.Vectorize(ctx, texts[i], cfg)
+			if err != nil {
+				errs[i] = err
+				continue
+			}
+			objects[i].Vector = res.Vector
+		}
+	}
+
+	return errs
 }
 
 func (v *Vectorizer) object(ctx context.Context, className string,
@@ -93,37 +131,14 @@ func (v *Vectorizer) object(ctx context.Context, className string,
 ) ([]float32, error) {
 	vectorize := objDiff == nil || objDiff.GetVec() == nil
 
-	var corpi []string
-	if icheck.VectorizeClassName() {
-		corpi = append(corpi, camelCaseToLower(className))
-	}
+	var props map[string]interface{}
 	if schema != nil {
-		schemamap := schema.(map[string]interface{})
-		for _, prop := range sortStringKeys(schemamap) {
-			if !icheck.PropertyIndexed(prop) {
-				continue
-			}
-
-			appended := false
-			switch val := schemamap[prop].(type) {
-			case []string:
-				for _, elem := range val {
-					appended = appendPropIfText(icheck, &corpi, prop, elem) || appended
-				}
-			case []interface{}:
-				for _, elem := range val {
-					appended = appendPropIfText(icheck, &corpi, prop, elem) || appended
-				}
-			default:
-				appended = appendPropIfText(icheck, &corpi, prop, val)
-			}
-
-			vectorize = vectorize || (appended && objDiff != nil && objDiff.IsChangedProp(prop))
-		}
+		props = schema.(map[string]interface{})
 	}
-	if len(corpi) == 0 {
-		// fall back to using the class name
-		corpi = append(corpi, camelCaseToLower(className))
+
+	text, used := icheck.InputRules().Build(className, props, icheck)
+	for _, prop := range used {
+		vectorize = vectorize || (objDiff != nil && objDiff.IsChangedProp(prop))
 	}
 
 	// no property was changed, old vector can be used
@@ -131,7 +146,6 @@ func (v *Vectorizer) object(ctx context.Context, className string,
 		return objDiff.GetVec(), nil
 	}
 
-	text := strings.Join(corpi, " ")
 	res, err := v.client.Vectorize(ctx, text, ent.VectorizationConfig{
 		Type:         icheck.Type(),
 		Model:        icheck.Model(),
@@ -143,21 +157,3 @@ func (v *Vectorizer) object(ctx context.Context, className string,
 
 	return res.Vector, nil
 }
-
-func camelCaseToLower(in string) string {
-	parts := camelcase.Split(in)
-	var sb strings.Builder
-	for i, part := range parts {
-		if part == " " {
-			continue
-		}
-
-		if i > 0 {
-			sb.WriteString(" ")
-		}
-
-		sb.WriteString(strings.ToLower(part))
-	}
-
-	return sb.String()
-}
@@ -436,3 +436,42 @@ func TestPickDefaultModelVersion(t *testing.T) {
 		assert.Equal(t, "001", version)
 	})
 }
+
+func TestVectorizingObjectsInBatches(t *testing.T) {
+	car := func(brand string) *models.Object {
+		return &models.Object{
+			Class:      "Car",
+			Properties: map[string]interface{}{"brand": brand},
+		}
+	}
+
+	t.Run("all objects in a single request", func(t *testing.T) {
+		client := &fakeClient{}
+		objects := []*models.Object{car("a"), car("b"), car("c")}
+
+		errs := New(client).Objects(context.Background(), objects,
+			&fakeSettings{vectorizeClassName: true})
+
+		assert.Equal(t, []error{nil, nil, nil}, errs)
+		assert.Equal(t, []int{3}, client.batchSizes)
+		for i, object := range objects {
+			assert.Equal(t, models.C11yVector{float32(i), 1, 2, 3}, object.Vector)
+		}
+	})
+
+	t.Run("a failing object", func(t *testing.T) {
+		client := &fakeClient{failingInput: "car brand fail"}
+		objects := []*models.Object{car("a"), car("fail"), car("c")}
+
+		errs := New(client).Objects(context.Background(), objects,
+			&fakeSettings{vectorizeClassName: true})
+
+		require.Len(t, errs, 3)
+		assert.Nil(t, errs[0])
+		assert.NotNil(t, errs[1])
+		assert.Nil(t, errs[2])
+		assert.Equal(t, models.C11yVector{0, 1, 2, 3}, objects[0].Vector)
+		assert.Nil(t, objects[1].Vector)
+		assert.Equal(t, models.C11yVector{0, 1, 2, 3}, objects[2].Vector)
+	})
+}
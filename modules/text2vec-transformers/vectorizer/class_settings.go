@@ -12,7 +12,9 @@
 package vectorizer
 
 import (
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/moduletools"
+	libvectorizer "github.com/weaviate/weaviate/usecases/vectorizer"
 )
 
 const (
@@ -104,3 +106,29 @@ func (ic *classSettings) PoolingStrategy() string {
 
 	return asString
 }
+
+// InputRules see libvectorizer.InputRules
+func (ic *classSettings) InputRules() libvectorizer.InputRules {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return libvectorizer.DefaultInputRules()
+	}
+
+	rules, err := libvectorizer.InputRulesFromConfig(ic.cfg.Class())
+	if err != nil {
+		return libvectorizer.DefaultInputRules()
+	}
+	return rules
+}
+
+func (ic *classSettings) ValidateInputRules(class *models.Class) error {
+	if ic.cfg == nil {
+		return nil
+	}
+
+	rules, err := libvectorizer.InputRulesFromConfig(ic.cfg.Class())
+	if err != nil {
+		return err
+	}
+	return rules.Validate(class)
+}
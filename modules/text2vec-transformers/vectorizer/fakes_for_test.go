@@ -15,6 +15,7 @@ import (
 	"context"
 
 	"github.com/weaviate/weaviate/modules/text2vec-transformers/ent"
+	libvectorizer "github.com/weaviate/weaviate/usecases/vectorizer"
 )
 
 type fakeClient struct {
@@ -62,3 +63,7 @@ func (f *fakeSettings) VectorizeClassName() bool {
 func (f *fakeSettings) PoolingStrategy() string {
 	return f.poolingStrategy
 }
+
+func (f *fakeSettings) InputRules() libvectorizer.InputRules {
+	return libvectorizer.DefaultInputRules()
+}
@@ -0,0 +1,229 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package authorization
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// VerbReadRestricted is the permission required to read a restricted
+// property. With the adminlist authorizer only admins hold it, read-only
+// users receive redacted objects.
+const VerbReadRestricted = "read_restricted"
+
+// Redactor removes restricted properties from objects before they are
+// returned to principals which are not allowed to read them
+type Redactor struct {
+	authorizer Authorizer
+	restricted map[string][]string // class name -> restricted properties
+}
+
+// NewRedactor for entries of the form "ClassName.propertyName", invalid
+// entries are ignored as they are rejected when the config is validated
+func NewRedactor(authorizer Authorizer, restricted []string) *Redactor {
+	r := &Redactor{
+		authorizer: authorizer,
+		restricted: map[string][]string{},
+	}
+
+	for _, entry := range restricted {
+		class, prop, ok := strings.Cut(strings.TrimSpace(entry), ".")
+		if !ok {
+			continue
+		}
+		r.restricted[class] = append(r.restricted[class], prop)
+	}
+
+	return r
+}
+
+// Object marshals the search result into the object returned to the
+// principal, without the restricted properties it may not read. Search
+// results are marshalled for principals by Object and Objects, so that
+// callers don't redact the objects one by one.
+func (r *Redactor) Object(principal *models.Principal, res *search.Result,
+	includeVector bool,
+) *models.Object {
+	obj := res.ObjectWithVector(includeVector)
+	r.RedactObject(principal, obj)
+	return obj
+}
+
+// Objects marshals the search results, see Object
+func (r *Redactor) Objects(principal *models.Principal, res search.Results,
+	includeVector bool,
+) []*models.Object {
+	objs := res.ObjectsWithVector(includeVector)
+	r.RedactObjects(principal, objs)
+	return objs
+}
+
+// RedactGetResults removes the restricted properties from the results of a
+// GraphQL Get query of the class in place
+func (r *Redactor) RedactGetResults(principal *models.Principal, className string,
+	res []interface{},
+) {
+	for _, item := range res {
+		if props, ok := item.(map[string]interface{}); ok {
+			r.RedactProperties(principal, className, props)
+		}
+	}
+}
+
+// RedactObjects removes the restricted properties of objects which have been
+// marshalled already, e.g. by the changes feed, in place
+func (r *Redactor) RedactObjects(principal *models.Principal, objs []*models.Object) {
+	for _, obj := range objs {
+		r.RedactObject(principal, obj)
+	}
+}
+
+// RedactObject removes the restricted properties of the object in place
+func (r *Redactor) RedactObject(principal *models.Principal, obj *models.Object) {
+	if obj == nil {
+		return
+	}
+
+	props, ok := obj.Properties.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	r.RedactProperties(principal, obj.Class, props)
+}
+
+// RedactProperties removes the restricted properties of the class from props
+// in place. Resolved references are redacted according to their own class.
+func (r *Redactor) RedactProperties(principal *models.Principal, className string,
+	props map[string]interface{},
+) {
+	if r == nil || len(r.restricted) == 0 || props == nil {
+		return
+	}
+
+	for _, prop := range r.restricted[className] {
+		if _, ok := props[prop]; !ok {
+			continue
+		}
+
+		if r.authorized(principal, className, prop) {
+			continue
+		}
+
+		delete(props, prop)
+	}
+
+	for _, value := range props {
+		refs, ok := value.([]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, ref := range refs {
+			if local, ok := ref.(search.LocalRef); ok {
+				r.RedactProperties(principal, local.Class, local.Fields)
+			}
+		}
+	}
+}
+
+// AuthorizeClass returns an error unless the principal may read all
+// restricted properties of the class. It guards operations which copy whole
+// objects to places where they can't be redacted anymore.
+func (r *Redactor) AuthorizeClass(principal *models.Principal, className string) error {
+	if r == nil {
+		return nil
+	}
+
+	for _, prop := range r.restricted[className] {
+		if err := r.authorize(principal, className, prop); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Restricted returns whether the class has restricted properties
+func (r *Redactor) Restricted(className string) bool {
+	return r != nil && len(r.restricted[className]) > 0
+}
+
+// AuthorizeProperties returns an error if one of the properties of the class
+// is restricted and the principal may not read it. It guards parameters which
+// reveal the values of properties without returning them, e.g. sorting,
+// searching or aggregating by a property.
+func (r *Redactor) AuthorizeProperties(principal *models.Principal, className string,
+	props ...string,
+) error {
+	if !r.Restricted(className) {
+		return nil
+	}
+
+	for _, prop := range props {
+		if !r.isRestricted(className, prop) {
+			continue
+		}
+		if err := r.authorize(principal, className, prop); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AuthorizeFilter returns an error if the filter constrains a restricted
+// property the principal may not read, as the matching objects would reveal
+// its values. Paths through references are checked for every class they
+// pass.
+func (r *Redactor) AuthorizeFilter(principal *models.Principal, filter *filters.LocalFilter) error {
+	if r == nil || len(r.restricted) == 0 || filter == nil || filter.Root == nil {
+		return nil
+	}
+	return r.authorizeClause(principal, filter.Root)
+}
+
+func (r *Redactor) authorizeClause(principal *models.Principal, clause *filters.Clause) error {
+	for path := clause.On; path != nil; path = path.Child {
+		err := r.AuthorizeProperties(principal, path.Class.String(), path.Property.String())
+		if err != nil {
+			return err
+		}
+	}
+	for i := range clause.Operands {
+		if err := r.authorizeClause(principal, &clause.Operands[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Redactor) isRestricted(className, prop string) bool {
+	for _, restricted := range r.restricted[className] {
+		if restricted == prop {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Redactor) authorize(principal *models.Principal, className, prop string) error {
+	resource := fmt.Sprintf("schema/%s/properties/%s", className, prop)
+	return r.authorizer.Authorize(principal, VerbReadRestricted, resource)
+}
+
+func (r *Redactor) authorized(principal *models.Principal, className, prop string) bool {
+	return r.authorize(principal, className, prop) == nil
+}
@@ -0,0 +1,150 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package authorization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/adminlist"
+)
+
+func TestRedactor(t *testing.T) {
+	authorizer := adminlist.New(adminlist.Config{
+		Enabled:       true,
+		Users:         []string{"admin"},
+		ReadOnlyUsers: []string{"reader"},
+	})
+	redactor := NewRedactor(authorizer, []string{"Person.email", "Person.salary"})
+
+	newObject := func() *models.Object {
+		return &models.Object{
+			Class: "Person",
+			Properties: map[string]interface{}{
+				"name":  "Alice",
+				"email": "alice@example.com",
+			},
+		}
+	}
+
+	t.Run("admins see restricted properties", func(t *testing.T) {
+		obj := newObject()
+		redactor.RedactObject(&models.Principal{Username: "admin"}, obj)
+		assert.Equal(t, newObject().Properties, obj.Properties)
+	})
+
+	t.Run("read-only users do not", func(t *testing.T) {
+		obj := newObject()
+		redactor.RedactObject(&models.Principal{Username: "reader"}, obj)
+		assert.Equal(t, map[string]interface{}{"name": "Alice"}, obj.Properties)
+	})
+
+	t.Run("anonymous users do not", func(t *testing.T) {
+		obj := newObject()
+		redactor.RedactObject(nil, obj)
+		assert.Equal(t, map[string]interface{}{"name": "Alice"}, obj.Properties)
+	})
+
+	t.Run("other classes are not affected", func(t *testing.T) {
+		obj := newObject()
+		obj.Class = "Company"
+		redactor.RedactObject(nil, obj)
+		assert.Equal(t, newObject().Properties, obj.Properties)
+	})
+
+	t.Run("search results are marshalled redacted", func(t *testing.T) {
+		res := search.Result{
+			ClassName: "Person",
+			Schema:    map[string]interface{}{"name": "Alice", "email": "alice@example.com"},
+			Vector:    []float32{1, 2},
+		}
+
+		obj := redactor.Object(nil, &res, true)
+		assert.Equal(t, map[string]interface{}{"name": "Alice"}, obj.Properties)
+		assert.Equal(t, []float32{1, 2}, []float32(obj.Vector))
+
+		res.Schema = map[string]interface{}{"name": "Alice", "email": "alice@example.com"}
+		objs := redactor.Objects(&models.Principal{Username: "admin"}, search.Results{res}, false)
+		assert.Equal(t, newObject().Properties, objs[0].Properties)
+		assert.Nil(t, objs[0].Vector)
+	})
+
+	t.Run("results of get queries", func(t *testing.T) {
+		res := []interface{}{newObject().Properties, "not a result"}
+		redactor.RedactGetResults(nil, "Person", res)
+		assert.Equal(t, map[string]interface{}{"name": "Alice"}, res[0])
+	})
+
+	t.Run("resolved references", func(t *testing.T) {
+		props := map[string]interface{}{
+			"name": "Acme",
+			"employees": []interface{}{
+				search.LocalRef{
+					Class:  "Person",
+					Fields: map[string]interface{}{"name": "Bob", "salary": 100},
+				},
+			},
+		}
+		redactor.RedactProperties(nil, "Company", props)
+		ref := props["employees"].([]interface{})[0].(search.LocalRef)
+		assert.Equal(t, map[string]interface{}{"name": "Bob"}, ref.Fields)
+	})
+
+	t.Run("without authorization nothing is redacted", func(t *testing.T) {
+		obj := newObject()
+		NewRedactor(&DummyAuthorizer{}, []string{"Person.email"}).RedactObject(nil, obj)
+		assert.Equal(t, newObject().Properties, obj.Properties)
+	})
+
+	t.Run("authorize a class", func(t *testing.T) {
+		assert.Nil(t, redactor.AuthorizeClass(&models.Principal{Username: "admin"}, "Person"))
+		assert.NotNil(t, redactor.AuthorizeClass(&models.Principal{Username: "reader"}, "Person"))
+		assert.Nil(t, redactor.AuthorizeClass(&models.Principal{Username: "reader"}, "Company"))
+	})
+
+	t.Run("authorize properties", func(t *testing.T) {
+		reader := &models.Principal{Username: "reader"}
+		assert.Nil(t, redactor.AuthorizeProperties(reader, "Person", "name"))
+		assert.NotNil(t, redactor.AuthorizeProperties(reader, "Person", "name", "salary"))
+		assert.Nil(t, redactor.AuthorizeProperties(&models.Principal{Username: "admin"},
+			"Person", "salary"))
+		assert.Nil(t, redactor.AuthorizeProperties(reader, "Company", "salary"))
+	})
+
+	t.Run("authorize a filter", func(t *testing.T) {
+		reader := &models.Principal{Username: "reader"}
+		filter := func(path *filters.Path) *filters.LocalFilter {
+			return &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorAnd,
+				Operands: []filters.Clause{
+					{Operator: filters.OperatorEqual, On: &filters.Path{Class: "Person", Property: "name"}},
+					{Operator: filters.OperatorEqual, On: path},
+				},
+			}}
+		}
+
+		assert.Nil(t, redactor.AuthorizeFilter(reader, nil))
+		assert.Nil(t, redactor.AuthorizeFilter(reader,
+			filter(&filters.Path{Class: "Person", Property: "name"})))
+		assert.NotNil(t, redactor.AuthorizeFilter(reader,
+			filter(&filters.Path{Class: "Person", Property: "email"})))
+		assert.NotNil(t, redactor.AuthorizeFilter(reader, filter(&filters.Path{
+			Class: "Company", Property: "employees",
+			Child: &filters.Path{Class: "Person", Property: "salary"},
+		})), "paths through references must be checked")
+		assert.Nil(t, redactor.AuthorizeFilter(&models.Principal{Username: "admin"},
+			filter(&filters.Path{Class: "Person", Property: "email"})))
+	})
+}
@@ -151,19 +151,25 @@ type uploader struct {
 	backend   nodeStore
 	backupID  string
 	setStatus func(st backup.Status)
+
+	// base is the store of the base backup of an incremental backup. Files
+	// which are unchanged since the base backup aren't uploaded.
+	base *nodeStore
+	// baseDesc is the descriptor of the base backup. It is nil if the node
+	// didn't take part in the base backup.
+	baseDesc *backup.BackupDescriptor
 }
 
 func newUploader(sourcer Sourcer, backend nodeStore,
 	backupID string, setstaus func(st backup.Status),
 ) *uploader {
-	return &uploader{sourcer, backend, backupID, setstaus}
+	return &uploader{sourcer: sourcer, backend: backend, backupID: backupID, setStatus: setstaus}
 }
 
 // all uploads all files in addition to the metadata file
 func (u *uploader) all(ctx context.Context, classes []string, desc *backup.BackupDescriptor) (err error) {
 	u.setStatus(backup.Transferring)
 	desc.Status = string(backup.Transferring)
-	ch := u.sourcer.BackupDescriptors(ctx, desc.ID, classes)
 	defer func() {
 		if err != nil {
 			desc.Error = err.Error()
@@ -175,6 +181,10 @@ func (u *uploader) all(ctx context.Context, classes []string, desc *backup.Backu
 			u.setStatus(backup.Success)
 		}
 	}()
+	if err := u.loadBase(ctx, desc.BaseBackupID); err != nil {
+		return err
+	}
+	ch := u.sourcer.BackupDescriptors(ctx, desc.ID, classes)
 Loop:
 	for {
 		select {
@@ -185,7 +195,7 @@ Loop:
 			if cdesc.Error != nil {
 				return cdesc.Error
 			}
-			if err := u.class(ctx, desc.ID, cdesc); err != nil {
+			if err := u.class(ctx, desc.ID, &cdesc); err != nil {
 				return err
 			}
 			desc.Classes = append(desc.Classes, cdesc)
@@ -199,8 +209,29 @@ Loop:
 	return nil
 }
 
-// class uploads one class
-func (u *uploader) class(ctx context.Context, id string, desc backup.ClassDescriptor) (err error) {
+// loadBase loads the descriptor of the base backup of an incremental backup.
+// A node which didn't take part in the base backup uploads all files.
+func (u *uploader) loadBase(ctx context.Context, baseID string) error {
+	if u.base == nil {
+		return nil
+	}
+	meta, err := u.base.Meta(ctx, baseID, false)
+	if err != nil {
+		if _, ok := err.(backup.ErrNotFound); ok {
+			return nil
+		}
+		return fmt.Errorf("get base backup %q: %w", baseID, err)
+	}
+	if meta.Status != string(backup.Success) {
+		return fmt.Errorf("invalid base backup %q status: %s", baseID, meta.Status)
+	}
+	u.baseDesc = meta
+	return nil
+}
+
+// class uploads one class. The files of an incremental backup which are
+// unchanged since its base backup are recorded in the descriptor instead.
+func (u *uploader) class(ctx context.Context, id string, desc *backup.ClassDescriptor) (err error) {
 	metric, err := monitoring.GetMetrics().BackupStoreDurations.GetMetricWithLabelValues(getType(u.backend.b), desc.Name)
 	if err == nil {
 		timer := prometheus.NewTimer(metric)
@@ -212,11 +243,26 @@ func (u *uploader) class(ctx context.Context, id string, desc backup.ClassDescri
 	}()
 	ctx, cancel := context.WithTimeout(ctx, storeTimeout)
 	defer cancel()
-	for _, shard := range desc.Shards {
+	for i := range desc.Shards {
 		if err := ctx.Err(); err != nil {
 			return err
 		}
+		shard := &desc.Shards[i]
+		var (
+			base   *backup.ShardDescriptor
+			baseID string
+		)
+		if u.baseDesc != nil {
+			base, baseID = u.baseDesc.Shard(desc.Name, shard.Name), u.baseDesc.ID
+		}
 		for _, fpath := range shard.Files {
+			if holder := shard.BaseFile(base, baseID, fpath); holder != "" {
+				if shard.BaseFiles == nil {
+					shard.BaseFiles = make(map[string]string)
+				}
+				shard.BaseFiles[fpath] = holder
+				continue
+			}
 			if err := u.backend.PutFile(ctx, fpath, fpath); err != nil {
 				return err
 			}
@@ -229,21 +275,28 @@ func (u *uploader) class(ctx context.Context, id string, desc backup.ClassDescri
 type fileWriter struct {
 	sourcer    Sourcer
 	backend    nodeStore
+	node       string
 	tempDir    string
 	destDir    string
 	movedFiles []string // files successfully moved to destination folder
+
+	// skipVectorIndex skips the files of vector indexes and marks their
+	// shards to be indexed again
+	skipVectorIndex bool
 }
 
 func newFileWriter(sourcer Sourcer, backend nodeStore,
-	backupID string,
+	node, backupID string, skipVectorIndex bool,
 ) *fileWriter {
 	destDir := backend.SourceDataPath()
 	return &fileWriter{
-		sourcer:    sourcer,
-		backend:    backend,
-		destDir:    destDir,
-		tempDir:    path.Join(destDir, _TempDirectory),
-		movedFiles: make([]string, 0, 64),
+		sourcer:         sourcer,
+		backend:         backend,
+		node:            node,
+		destDir:         destDir,
+		tempDir:         path.Join(destDir, _TempDirectory),
+		movedFiles:      make([]string, 0, 64),
+		skipVectorIndex: skipVectorIndex,
 	}
 }
 
@@ -283,12 +336,20 @@ func (fw *fileWriter) writeTempFiles(ctx context.Context, classTempDir string, d
 	}
 	for _, part := range desc.Shards {
 		for _, key := range part.Files {
+			if fw.skipVectorIndex && backup.IsVectorIndexFile(key) {
+				continue
+			}
 			destPath := path.Join(classTempDir, key)
 			destDir := path.Dir(destPath)
 			if err := os.MkdirAll(destDir, os.ModePerm); err != nil {
 				return fmt.Errorf("create folder %s: %w", destDir, err)
 			}
-			if err := fw.backend.WriteToFile(ctx, key, destPath); err != nil {
+			store := fw.backend
+			if holder, ok := part.BaseFiles[key]; ok {
+				// unchanged since a previous backup of an incremental chain
+				store = baseNodeBackend(fw.node, fw.backend, holder)
+			}
+			if err := store.WriteToFile(ctx, key, destPath); err != nil {
 				return fmt.Errorf("write file %s: %w", destPath, err)
 			}
 		}
@@ -304,6 +365,12 @@ func (fw *fileWriter) writeTempFiles(ctx context.Context, classTempDir string, d
 		if err := os.WriteFile(destPath, part.Version, os.ModePerm); err != nil {
 			return fmt.Errorf("write version file %s: %w", destPath, err)
 		}
+		if fw.skipVectorIndex {
+			destPath = path.Join(classTempDir, part.VectorIndexRebuildPath())
+			if err := os.WriteFile(destPath, nil, os.ModePerm); err != nil {
+				return fmt.Errorf("write vector index rebuild file %s: %w", destPath, err)
+			}
+		}
 	}
 	return nil
 }
@@ -151,12 +151,17 @@ func (b *backupper) backup(ctx context.Context,
 
 		}
 		provider := newUploader(b.sourcer, store, req.ID, b.lastOp.set)
+		if req.BaseBackupID != "" {
+			base := baseNodeBackend(b.node, store, req.BaseBackupID)
+			provider.base = &base
+		}
 		result := backup.BackupDescriptor{
 			StartedAt:     time.Now().UTC(),
 			ID:            id,
 			Classes:       make([]backup.ClassDescriptor, 0, len(req.Classes)),
 			Version:       Version,
 			ServerVersion: config.ServerVersion,
+			BaseBackupID:  req.BaseBackupID,
 		}
 		if err := provider.all(context.Background(), req.Classes, &result); err != nil {
 			b.logger.WithField("action", "create_backup").
@@ -13,6 +13,7 @@ package backup
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
@@ -638,6 +639,61 @@ func TestManagerCoordinatedBackup(t *testing.T) {
 		assert.Equal(t, "", backend.meta.Error)
 	})
 
+	t.Run("Incremental", func(t *testing.T) {
+		baseID := "base"
+		classes := genClassDescriptions(cls, cls2)
+		for i := range classes {
+			classes[i].Shards[0].Generations = map[string]string{"dir1/file1": "1-1", "dir2/file2": "2-2"}
+		}
+		base := backup.BackupDescriptor{
+			ID:     baseID,
+			Status: string(backup.Success),
+			Classes: []backup.ClassDescriptor{{
+				Name: cls,
+				Shards: []backup.ShardDescriptor{{
+					Name:        "Shard1",
+					Generations: map[string]string{"dir1/file1": "1-1", "dir2/file2": "2-1"},
+				}},
+			}},
+		}
+		baseBytes, _ := json.Marshal(base)
+
+		sourcer := &fakeSourcer{}
+		sourcer.On("Backupable", ctx, req.Classes).Return(nil)
+		sourcer.On("BackupDescriptors", ctx, backupID, mock.Anything).Return(fakeBackupDescriptor(classes...))
+		sourcer.On("ReleaseBackup", ctx, backupID, mock.Anything).Return(nil)
+
+		backend := &fakeBackend{}
+		backend.On("HomeDir", mock.Anything).Return(path)
+		backend.On("GetObject", mock.Anything, baseID+"/"+nodeName, BackupFile).Return(baseBytes, nil)
+		backend.On("Initialize", ctx, nodeHome).Return(nil)
+		backend.On("PutObject", mock.Anything, nodeHome, BackupFile, mock.Anything).Return(nil).Once()
+		backend.On("PutFile", mock.Anything, nodeHome, mock.Anything, mock.Anything).Return(nil)
+		m := createManager(sourcer, nil, backend, nil)
+
+		req := req
+		req.Duration = time.Hour
+		req.BaseBackupID = baseID
+		got := m.OnCanCommit(ctx, &req)
+		assert.Equal(t, "", got.Err)
+
+		err := m.OnCommit(ctx, &StatusRequest{OpCreate, req.ID, backendName})
+		assert.Nil(t, err)
+		for i := 0; i < 20; i++ {
+			time.Sleep(time.Millisecond * 50)
+			if i > 0 && m.backupper.lastOp.get().Status == "" {
+				break
+			}
+		}
+		assert.Equal(t, string(backup.Success), backend.meta.Status)
+		assert.Equal(t, baseID, backend.meta.BaseBackupID)
+		// the unchanged segment of the first class isn't uploaded again
+		backend.AssertNumberOfCalls(t, "PutFile", 3)
+		shard := backend.meta.Shard(cls, "Shard1")
+		assert.Equal(t, map[string]string{"dir1/file1": baseID}, shard.BaseFiles)
+		assert.Empty(t, backend.meta.Shard(cls2, "Shard1").BaseFiles)
+	})
+
 	t.Run("Abort", func(t *testing.T) {
 		sourcer := &fakeSourcer{}
 		sourcer.On("Backupable", ctx, req.Classes).Return(nil)
@@ -95,6 +95,8 @@ type coordinator struct {
 	// state
 	Participants map[string]participantStatus
 	descriptor   *backup.DistributedBackupDescriptor
+	// skipVectorIndex is passed to participants of a restoration
+	skipVectorIndex bool
 	shardSyncChan
 
 	// timeouts
@@ -142,6 +144,7 @@ func (c *coordinator) Backup(ctx context.Context, store coordStore, req *Request
 		Nodes:         groups,
 		Version:       Version,
 		ServerVersion: config.ServerVersion,
+		BaseBackupID:  req.BaseBackupID,
 	}
 
 	for key := range c.Participants {
@@ -179,7 +182,9 @@ func (c *coordinator) Backup(ctx context.Context, store coordStore, req *Request
 }
 
 // Restore coordinates a distributed restoration among participants
-func (c *coordinator) Restore(ctx context.Context, store coordStore, backend string, desc *backup.DistributedBackupDescriptor) error {
+func (c *coordinator) Restore(ctx context.Context, store coordStore, backend string,
+	desc *backup.DistributedBackupDescriptor, skipVectorIndex bool,
+) error {
 	// make sure there is no active backup
 	if prevID := c.lastOp.renew(desc.ID, store.HomeDir()); prevID != "" {
 		return fmt.Errorf("restoration %s already in progress", prevID)
@@ -189,6 +194,7 @@ func (c *coordinator) Restore(ctx context.Context, store coordStore, backend str
 		delete(c.Participants, key)
 	}
 	c.descriptor = desc.ResetStatus()
+	c.skipVectorIndex = skipVectorIndex
 
 	nodes, err := c.canCommit(ctx, OpRestore, backend)
 	if err != nil {
@@ -261,6 +267,10 @@ func (c *coordinator) canCommit(ctx context.Context, method Op, backend string)
 
 	id := c.descriptor.ID
 	groups := c.descriptor.Nodes
+	baseBackupID := ""
+	if method == OpCreate {
+		baseBackupID = c.descriptor.BaseBackupID
+	}
 
 	g, ctx := errgroup.WithContext(ctx)
 	g.SetLimit(_MaxNumberConns)
@@ -282,11 +292,13 @@ func (c *coordinator) canCommit(ctx context.Context, method Op, backend string)
 			reqChan <- pair{
 				nodeHost{node, host},
 				&Request{
-					Method:   method,
-					ID:       id,
-					Backend:  backend,
-					Classes:  gr.Classes,
-					Duration: _BookingPeriod,
+					Method:          method,
+					ID:              id,
+					Backend:         backend,
+					Classes:         gr.Classes,
+					Duration:        _BookingPeriod,
+					SkipVectorIndex: method == OpRestore && c.skipVectorIndex,
+					BaseBackupID:    baseBackupID,
 				},
 			}
 		}
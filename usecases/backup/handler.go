@@ -105,6 +105,15 @@ type BackupRequest struct {
 	// Exclude means include all classes but those specified in Exclude
 	// The same class cannot appear in both Include and Exclude in the same request
 	Exclude []string
+
+	// SkipVectorIndex restores classes without the files of their vector
+	// indexes. The vectors are indexed again from the restored objects.
+	SkipVectorIndex bool
+
+	// BaseBackupID makes the backup incremental to the backup with this id.
+	// Segments which are unchanged since the base backup aren't uploaded
+	// again, the restore reads them from the backup holding them.
+	BaseBackupID string
 }
 
 func (m *Manager) Backup(ctx context.Context, pr *models.Principal, req *BackupRequest,
@@ -155,10 +164,11 @@ func (m *Manager) Restore(ctx context.Context, pr *models.Principal,
 		return nil, backup.NewErrUnprocessable(err)
 	}
 	rreq := Request{
-		Method:  OpRestore,
-		ID:      meta.ID,
-		Backend: req.Backend,
-		Classes: cs,
+		Method:          OpRestore,
+		ID:              meta.ID,
+		Backend:         req.Backend,
+		Classes:         cs,
+		SkipVectorIndex: req.SkipVectorIndex,
 	}
 	data, err := m.restorer.Restore(ctx, &rreq, meta, store)
 	if err != nil {
@@ -338,6 +348,12 @@ func validateID(backupID string) error {
 	return nil
 }
 
+// baseNodeBackend returns the store of the base backup id on node, on the
+// same backend as store
+func baseNodeBackend(node string, store nodeStore, id string) nodeStore {
+	return nodeStore{objStore{b: store.b, BasePath: fmt.Sprintf("%s/%s", id, node)}}
+}
+
 func nodeBackend(node string, provider BackupBackendProvider, backend, id string) (nodeStore, error) {
 	caps, err := provider.BackupBackend(backend)
 	if err != nil {
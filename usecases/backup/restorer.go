@@ -13,6 +13,7 @@ package backup
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
@@ -125,7 +126,7 @@ func (r *restorer) restore(ctx context.Context,
 			return
 		}
 
-		err = r.restoreAll(context.Background(), desc, store)
+		err = r.restoreAll(context.Background(), desc, store, req.SkipVectorIndex)
 		if err != nil {
 			r.logger.WithField("action", "restore").WithField("backup_id", desc.ID).Error(err)
 		}
@@ -137,10 +138,11 @@ func (r *restorer) restore(ctx context.Context,
 func (r *restorer) restoreAll(ctx context.Context,
 	desc *backup.BackupDescriptor,
 	store nodeStore,
+	skipVectorIndex bool,
 ) (err error) {
 	r.lastOp.set(backup.Transferring)
 	for _, cdesc := range desc.Classes {
-		if err := r.restoreOne(ctx, desc.ID, &cdesc, store); err != nil {
+		if err := r.restoreOne(ctx, desc.ID, &cdesc, store, skipVectorIndex); err != nil {
 			return fmt.Errorf("restore class %s: %w", cdesc.Name, err)
 		}
 		r.logger.WithField("action", "restore").
@@ -161,6 +163,7 @@ func getType(myvar interface{}) string {
 func (r *restorer) restoreOne(ctx context.Context,
 	backupID string, desc *backup.ClassDescriptor,
 	store nodeStore,
+	skipVectorIndex bool,
 ) (err error) {
 	metric, err := monitoring.GetMetrics().BackupRestoreDurations.GetMetricWithLabelValues(getType(store.b), desc.Name)
 	if err != nil {
@@ -171,7 +174,12 @@ func (r *restorer) restoreOne(ctx context.Context,
 	if r.sourcer.ClassExists(desc.Name) {
 		return fmt.Errorf("already exists")
 	}
-	fw := newFileWriter(r.sourcer, store, backupID)
+	if skipVectorIndex {
+		if desc.Schema, err = deferVectorIndexing(desc.Schema); err != nil {
+			return fmt.Errorf("defer vector indexing: %w", err)
+		}
+	}
+	fw := newFileWriter(r.sourcer, store, r.node, backupID, skipVectorIndex)
 	rollback, err := fw.Write(ctx, desc)
 	if err != nil {
 		return fmt.Errorf("write files: %w", err)
@@ -185,6 +193,26 @@ func (r *restorer) restoreOne(ctx context.Context,
 	return nil
 }
 
+// deferVectorIndexing enables deferred indexing in the vector index config of
+// a class schema. A class restored without its vector index is built from
+// its objects only once deferred indexing is turned off again.
+func deferVectorIndexing(schema []byte) ([]byte, error) {
+	var class models.Class
+	if err := json.Unmarshal(schema, &class); err != nil {
+		return nil, fmt.Errorf("unmarshal class schema: %w", err)
+	}
+	if class.VectorIndexType != "" && class.VectorIndexType != "hnsw" {
+		return schema, nil
+	}
+	config, ok := class.VectorIndexConfig.(map[string]interface{})
+	if !ok {
+		config = map[string]interface{}{}
+	}
+	config["deferIndexing"] = true
+	class.VectorIndexConfig = config
+	return json.Marshal(class)
+}
+
 // AnyExists checks if any classes of cs exists in DB
 func (r *restorer) AnyExists(cs []string) string {
 	for _, cls := range cs {
@@ -15,12 +15,15 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"os"
+	"path"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 )
@@ -636,3 +639,111 @@ func marshalMeta(m backup.BackupDescriptor) []byte {
 	bytes, _ := json.MarshalIndent(m, "", "")
 	return bytes
 }
+
+func TestDeferVectorIndexing(t *testing.T) {
+	t.Run("hnsw", func(t *testing.T) {
+		schema := []byte(`{"class":"DemoClass","vectorIndexType":"hnsw","vectorIndexConfig":{"ef":64}}`)
+		got, err := deferVectorIndexing(schema)
+		require.Nil(t, err)
+
+		var class models.Class
+		require.Nil(t, json.Unmarshal(got, &class))
+		assert.Equal(t, "DemoClass", class.Class)
+		assert.Equal(t, map[string]interface{}{
+			"ef":            float64(64),
+			"deferIndexing": true,
+		}, class.VectorIndexConfig)
+	})
+
+	t.Run("without config", func(t *testing.T) {
+		got, err := deferVectorIndexing([]byte(`{"class":"DemoClass"}`))
+		require.Nil(t, err)
+
+		var class models.Class
+		require.Nil(t, json.Unmarshal(got, &class))
+		assert.Equal(t, map[string]interface{}{"deferIndexing": true}, class.VectorIndexConfig)
+	})
+
+	t.Run("invalid schema", func(t *testing.T) {
+		_, err := deferVectorIndexing([]byte("hello"))
+		assert.NotNil(t, err)
+	})
+}
+
+func TestFileWriterSkipVectorIndex(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		rawbytes = []byte("hello")
+		dataPath = t.TempDir()
+	)
+	desc := backup.ClassDescriptor{
+		Name: "DemoClass",
+		Shards: []backup.ShardDescriptor{{
+			Name: "Shard1", Node: "Node-1",
+			Files: []string{
+				"democlass_shard1.lsm/objects/segment-1.db",
+				"democlass_shard1.hnsw.commitlog.d/1",
+				"democlass_shard1.indexqueue",
+			},
+			DocIDCounterPath:      "democlass_shard1.indexcount",
+			ShardVersionPath:      "democlass_shard1.version",
+			PropLengthTrackerPath: "democlass_shard1.proplengths",
+			DocIDCounter:          rawbytes,
+			Version:               rawbytes,
+			PropLengthTracker:     rawbytes,
+		}},
+	}
+	backend := newFakeBackend()
+	backend.On("SourceDataPath").Return(dataPath)
+	backend.On("WriteToFile", ctx, "1", "democlass_shard1.lsm/objects/segment-1.db", mock.Anything).Return(nil)
+	store := nodeStore{objStore{b: backend, BasePath: "1"}}
+
+	fw := newFileWriter(&fakeSourcer{}, store, nodeName, "1", true)
+	_, err := fw.Write(ctx, &desc)
+	require.Nil(t, err)
+
+	backend.AssertNumberOfCalls(t, "WriteToFile", 1)
+	_, err = os.Stat(path.Join(dataPath, "democlass_shard1.vectorindex.rebuild"))
+	assert.Nil(t, err)
+}
+
+func TestFileWriterIncremental(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		rawbytes = []byte("hello")
+		dataPath = t.TempDir()
+	)
+	desc := backup.ClassDescriptor{
+		Name: "DemoClass",
+		Shards: []backup.ShardDescriptor{{
+			Name: "Shard1", Node: nodeName,
+			Files: []string{
+				"democlass_shard1_lsm/objects/segment-1.db",
+				"democlass_shard1_lsm/objects/segment-2.db",
+				"democlass_shard1_lsm/objects/segment-3.db",
+			},
+			BaseFiles: map[string]string{
+				"democlass_shard1_lsm/objects/segment-1.db": "first",
+				"democlass_shard1_lsm/objects/segment-2.db": "second",
+			},
+			DocIDCounterPath:      "democlass_shard1.indexcount",
+			ShardVersionPath:      "democlass_shard1.version",
+			PropLengthTrackerPath: "democlass_shard1.proplengths",
+			DocIDCounter:          rawbytes,
+			Version:               rawbytes,
+			PropLengthTracker:     rawbytes,
+		}},
+	}
+	backend := newFakeBackend()
+	backend.On("SourceDataPath").Return(dataPath)
+	backend.On("WriteToFile", ctx, "first/"+nodeName, "democlass_shard1_lsm/objects/segment-1.db", mock.Anything).Return(nil)
+	backend.On("WriteToFile", ctx, "second/"+nodeName, "democlass_shard1_lsm/objects/segment-2.db", mock.Anything).Return(nil)
+	backend.On("WriteToFile", ctx, "third/"+nodeName, "democlass_shard1_lsm/objects/segment-3.db", mock.Anything).Return(nil)
+	store := nodeStore{objStore{b: backend, BasePath: "third/" + nodeName}}
+
+	fw := newFileWriter(&fakeSourcer{}, store, nodeName, "third", false)
+	_, err := fw.Write(ctx, &desc)
+	require.Nil(t, err)
+
+	backend.AssertNumberOfCalls(t, "WriteToFile", 3)
+}
@@ -87,10 +87,11 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 		return nil, backup.NewErrUnprocessable(fmt.Errorf("init uploader: %w", err))
 	}
 	breq := Request{
-		Method:  OpCreate,
-		ID:      req.ID,
-		Backend: req.Backend,
-		Classes: classes,
+		Method:       OpCreate,
+		ID:           req.ID,
+		Backend:      req.Backend,
+		Classes:      classes,
+		BaseBackupID: req.BaseBackupID,
 	}
 	if err := s.backupper.Backup(ctx, store, &breq); err != nil {
 		return nil, backup.NewErrUnprocessable(err)
@@ -136,7 +137,7 @@ func (s *Scheduler) Restore(ctx context.Context, pr *models.Principal,
 		Path:    store.HomeDir(),
 		Classes: meta.Classes(),
 	}
-	err = s.restorer.Restore(ctx, store, req.Backend, meta)
+	err = s.restorer.Restore(ctx, store, req.Backend, meta, req.SkipVectorIndex)
 	if err != nil {
 		status = string(backup.Failed)
 		data.Error = err.Error()
@@ -235,9 +236,35 @@ func (s *Scheduler) validateBackupRequest(ctx context.Context, store coordStore,
 	if _, ok := err.(backup.ErrNotFound); !ok {
 		return nil, fmt.Errorf("check if backup %q exists at %q: %w", req.ID, destPath, err)
 	}
+	if req.BaseBackupID != "" {
+		if err := validateID(req.BaseBackupID); err != nil {
+			return nil, fmt.Errorf("base backup: %w", err)
+		}
+		if _, err := s.baseBackup(ctx, req.Backend, req.BaseBackupID); err != nil {
+			return nil, err
+		}
+	}
 	return classes, nil
 }
 
+// baseBackup returns the descriptor of the base of an incremental backup. It
+// fails if the base backup doesn't exist or wasn't successful.
+func (s *Scheduler) baseBackup(ctx context.Context, backend, id string,
+) (*backup.DistributedBackupDescriptor, error) {
+	store, err := coordBackend(s.backends, backend, id)
+	if err != nil {
+		return nil, fmt.Errorf("base backup %q: %w", id, err)
+	}
+	meta, err := store.Meta(ctx, GlobalBackupFile)
+	if err != nil {
+		return nil, fmt.Errorf("find base backup %q at %q: %w", id, store.HomeDir(), err)
+	}
+	if meta.Status != backup.Success {
+		return nil, fmt.Errorf("invalid base backup %q status: %s", id, meta.Status)
+	}
+	return meta, nil
+}
+
 func (s *Scheduler) validateRestoreRequest(ctx context.Context, store coordStore, req *BackupRequest) (*backup.DistributedBackupDescriptor, error) {
 	if !store.b.IsExternal() && s.restorer.nodeResolver.NodeCount() > 1 {
 		return nil, errLocalBackendDBRO
@@ -266,6 +293,20 @@ func (s *Scheduler) validateRestoreRequest(ctx context.Context, store coordStore
 	if err := meta.Validate(); err != nil {
 		return nil, fmt.Errorf("corrupted backup file: %w", err)
 	}
+	// an incremental backup is restored together with the chain of its base
+	// backups, which hold the files it didn't upload
+	seen := map[string]bool{meta.ID: true}
+	for baseID := meta.BaseBackupID; baseID != ""; {
+		if seen[baseID] {
+			return nil, fmt.Errorf("base backup %q: cyclic chain of incremental backups", baseID)
+		}
+		seen[baseID] = true
+		base, err := s.baseBackup(ctx, req.Backend, baseID)
+		if err != nil {
+			return nil, err
+		}
+		baseID = base.BaseBackupID
+	}
 	cs := meta.Classes()
 	if len(req.Include) > 0 {
 		if first := meta.AllExist(req.Include); first != "" {
@@ -135,6 +135,41 @@ func TestSchedulerValidateCreateBackup(t *testing.T) {
 		assert.Contains(t, err.Error(), fmt.Sprintf("backup %q already exists", id))
 		assert.IsType(t, backup.ErrUnprocessable{}, err)
 	})
+	t.Run("BaseBackupNotFound", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.selector.On("Backupable", ctx, []string{cls}).Return(nil)
+		fs.backend.On("HomeDir", mock.Anything).Return(path)
+		fs.backend.On("GetObject", ctx, mock.Anything, mock.Anything).Return(nil, backup.ErrNotFound{})
+		meta, err := fs.scheduler().Backup(ctx, nil, &BackupRequest{
+			Backend:      backendName,
+			ID:           id,
+			Include:      []string{cls},
+			BaseBackupID: "base",
+		})
+
+		assert.Nil(t, meta)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), `find base backup "base"`)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+	})
+	t.Run("BaseBackupFailed", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.selector.On("Backupable", ctx, []string{cls}).Return(nil)
+		fs.backend.On("HomeDir", mock.Anything).Return(path)
+		fs.backend.On("GetObject", ctx, id, mock.Anything).Return(nil, backup.ErrNotFound{})
+		bytes := marshalCoordinatorMeta(backup.DistributedBackupDescriptor{ID: "base", Status: backup.Failed})
+		fs.backend.On("GetObject", ctx, "base", GlobalBackupFile).Return(bytes, nil)
+		meta, err := fs.scheduler().Backup(ctx, nil, &BackupRequest{
+			Backend:      backendName,
+			ID:           id,
+			Include:      []string{cls},
+			BaseBackupID: "base",
+		})
+
+		assert.Nil(t, meta)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), `invalid base backup "base" status`)
+	})
 }
 
 func TestSchedulerBackupStatus(t *testing.T) {
@@ -42,6 +42,12 @@ type Request struct {
 
 	// Duration
 	Duration time.Duration
+
+	// SkipVectorIndex restores classes without their vector indexes
+	SkipVectorIndex bool
+
+	// BaseBackupID makes a backup incremental to the backup with this id
+	BaseBackupID string
 }
 
 type CanCommitResponse struct {
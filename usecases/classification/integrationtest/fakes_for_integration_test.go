@@ -27,6 +27,7 @@ import (
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/replay"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
@@ -355,6 +356,12 @@ func (f *fakeRemoteClient) GetObject(ctx context.Context, hostName, indexName,
 	return nil, nil
 }
 
+func (f *fakeRemoteClient) GetObjectAtVersion(ctx context.Context, hostName, indexName,
+	shardName string, id strfmt.UUID, version int64,
+) (*storobj.Object, error) {
+	return nil, nil
+}
+
 func (f *fakeRemoteClient) FindObject(ctx context.Context, hostName, indexName,
 	shardName string, id strfmt.UUID, props search.SelectProperties,
 	additional additional.Properties,
@@ -387,8 +394,9 @@ func (f *fakeRemoteClient) MergeObject(ctx context.Context, hostName, indexName,
 }
 
 func (f *fakeRemoteClient) SearchShard(ctx context.Context, hostName, indexName,
-	shardName string, vector []float32, limit int, filters *filters.LocalFilter,
-	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
+	shardName string, vector []float32, targetVector string, _ *searchparams.TargetVectors,
+	distance float32, limit int,
+	filters *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
 	return nil, nil, nil
@@ -416,6 +424,13 @@ func (f *fakeRemoteClient) Aggregate(ctx context.Context, hostName, indexName,
 	return nil, nil
 }
 
+func (f *fakeRemoteClient) SampleObjects(ctx context.Context, hostName, indexName,
+	shardName string, filters *filters.LocalFilter, sample filters.Sample,
+	additional additional.Properties,
+) ([]*storobj.Object, []uint64, error) {
+	return nil, nil, nil
+}
+
 func (f *fakeRemoteClient) FindDocIDs(ctx context.Context, hostName, indexName, shardName string,
 	filters *filters.LocalFilter,
 ) ([]uint64, error) {
@@ -440,6 +455,30 @@ func (f *fakeRemoteClient) UpdateShardStatus(ctx context.Context, hostName, inde
 	return nil
 }
 
+func (f *fakeRemoteClient) OptimizeShard(ctx context.Context, hostName, indexName,
+	shardName string,
+) (*models.ShardOptimizeResult, error) {
+	return &models.ShardOptimizeResult{Name: shardName}, nil
+}
+
+func (f *fakeRemoteClient) CleanupInvertedShard(ctx context.Context, hostName, indexName,
+	shardName string,
+) (*models.ShardInvertedCleanupResult, error) {
+	return &models.ShardInvertedCleanupResult{Name: shardName}, nil
+}
+
+func (f *fakeRemoteClient) PrepareMigrationShard(ctx context.Context, hostName, indexName,
+	shardName string, migration *models.SchemaMigration,
+) error {
+	return nil
+}
+
+func (f *fakeRemoteClient) ReplayShard(ctx context.Context, hostName, indexName,
+	shardName string, from, to int64, after replay.Cursor, limit int,
+) (*replay.Page, error) {
+	return &replay.Page{}, nil
+}
+
 func (f *fakeRemoteClient) DigestObjects(ctx context.Context,
 	hostName, indexName, shardName string, ids []strfmt.UUID,
 ) (result []replica.RepairResponse, err error) {
@@ -529,6 +568,18 @@ func (c *fakeReplicationClient) DigestObjects(ctx context.Context,
 	return nil, nil
 }
 
+func (c *fakeReplicationClient) HashTree(ctx context.Context,
+	host, index, shard string, height int,
+) (*replica.HashTree, error) {
+	return nil, nil
+}
+
+func (c *fakeReplicationClient) DigestLeaf(ctx context.Context,
+	host, index, shard string, height, leaf int,
+) ([]replica.RepairResponse, error) {
+	return nil, nil
+}
+
 func (c *fakeReplicationClient) OverwriteObjects(ctx context.Context,
 	host, index, shard string, vobjects []*objects.VObject,
 ) ([]replica.RepairResponse, error) {
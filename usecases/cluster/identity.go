@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/google/uuid"
+)
+
+const identityFile = "node_identity.json"
+
+// Identity identifies a node independently of its hostname. It is stored
+// with the data of the node, so a node which lost its data has a new identity.
+type Identity struct {
+	ID string `json:"id"`
+	// Generation is incremented on every start of the node. A node which
+	// starts from an older copy of its data has a lower generation than the
+	// cluster has seen before.
+	Generation uint64 `json:"generation"`
+}
+
+// LoadIdentity reads the identity of the node from the data path, increments
+// its generation and persists it again. A new identity is created if there is
+// none yet. Without data path the identity isn't persisted.
+func LoadIdentity(dataPath string) (Identity, error) {
+	if dataPath == "" {
+		return Identity{ID: uuid.NewString(), Generation: 1}, nil
+	}
+
+	path := filepath.Join(dataPath, identityFile)
+	var id Identity
+	bytes, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(bytes, &id); err != nil {
+			return id, fmt.Errorf("parse node identity %q: %w", path, err)
+		}
+	case errors.Is(err, os.ErrNotExist):
+		id.ID = uuid.NewString()
+	default:
+		return id, fmt.Errorf("read node identity: %w", err)
+	}
+	id.Generation++
+
+	if err := os.MkdirAll(dataPath, 0o755); err != nil {
+		return id, fmt.Errorf("create data path: %w", err)
+	}
+	bytes, err = json.Marshal(id)
+	if err != nil {
+		return id, err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, bytes, 0o644); err != nil {
+		return id, fmt.Errorf("write node identity: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return id, fmt.Errorf("write node identity: %w", err)
+	}
+	return id, nil
+}
@@ -0,0 +1,313 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/memberlist"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	MemberAlive  = "ALIVE"
+	MemberLeft   = "LEFT"
+	MemberFailed = "FAILED"
+)
+
+// nodeMeta is gossiped as the meta data of each node
+type nodeMeta struct {
+	ID         string            `json:"id"`
+	Generation uint64            `json:"generation"`
+	Labels     map[string]string `json:"labels,omitempty"`
+}
+
+// Member is a node as seen by the local node. Nodes of versions without
+// node identities have an empty ID.
+type Member struct {
+	Name       string            `json:"name"`
+	ID         string            `json:"id"`
+	Generation uint64            `json:"generation"`
+	Address    string            `json:"address"`
+	Status     string            `json:"status"`
+	Labels     map[string]string `json:"labels,omitempty"`
+}
+
+// MembershipView are all nodes the local node knows about, including nodes
+// which left or failed
+type MembershipView struct {
+	// Epoch is incremented on every change of the membership which the local
+	// node observes, views with the same epoch are the same
+	Epoch   uint64   `json:"epoch"`
+	Local   string   `json:"local"`
+	Members []Member `json:"members"`
+}
+
+// membership tracks the identities of the nodes. A node which rejoins under
+// a known name is rejected if it has a different identity, e.g. because its
+// data was lost, or if it starts from older data than the cluster has seen.
+// Otherwise it would serve stale or missing shards as if they were current.
+// A failed node must be removed explicitly before it can be replaced, nodes
+// which left gracefully can be replaced right away.
+type membership struct {
+	local      string
+	meta       []byte
+	logger     logrus.FieldLogger
+	broadcasts *memberlist.TransmitLimitedQueue
+
+	sync.Mutex
+	epoch   uint64
+	members map[string]*Member
+	left    bool
+}
+
+func newMembership(local string, identity Identity, labels map[string]string,
+	logger logrus.FieldLogger,
+) (*membership, error) {
+	meta, err := json.Marshal(nodeMeta{
+		ID:         identity.ID,
+		Generation: identity.Generation,
+		Labels:     labels,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal node meta data: %w", err)
+	}
+	if len(meta) > memberlist.MetaMaxSize {
+		return nil, fmt.Errorf("node labels and identity are %d bytes, at most %d bytes "+
+			"are supported", len(meta), memberlist.MetaMaxSize)
+	}
+
+	return &membership{
+		local:   local,
+		meta:    meta,
+		logger:  logger,
+		members: map[string]*Member{},
+	}, nil
+}
+
+func parseNodeMeta(in []byte) (nodeMeta, bool) {
+	var meta nodeMeta
+	if len(in) == 0 || json.Unmarshal(in, &meta) != nil || meta.ID == "" {
+		return meta, false
+	}
+	return meta, true
+}
+
+// NotifyAlive implements memberlist.AliveDelegate
+func (m *membership) NotifyAlive(peer *memberlist.Node) error {
+	meta, ok := parseNodeMeta(peer.Meta)
+	if !ok {
+		return nil
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	known, ok := m.members[peer.Name]
+	if !ok || known.ID == "" {
+		return nil
+	}
+	if known.ID != meta.ID && known.Status != MemberLeft {
+		err := fmt.Errorf("node %q rejoined with identity %s, but is known with identity %s, "+
+			"remove the old node from the cluster first", peer.Name, meta.ID, known.ID)
+		m.logger.WithField("action", "cluster_membership").WithError(err).Warn("rejected node")
+		return err
+	}
+	if known.ID == meta.ID && meta.Generation < known.Generation {
+		err := fmt.Errorf("node %q rejoined with generation %d of its data, but generation %d "+
+			"has been seen before", peer.Name, meta.Generation, known.Generation)
+		m.logger.WithField("action", "cluster_membership").WithError(err).Warn("rejected node")
+		return err
+	}
+	return nil
+}
+
+// NotifyJoin implements memberlist.EventDelegate
+func (m *membership) NotifyJoin(node *memberlist.Node) {
+	m.update(node, MemberAlive)
+}
+
+// NotifyUpdate implements memberlist.EventDelegate
+func (m *membership) NotifyUpdate(node *memberlist.Node) {
+	m.update(node, MemberAlive)
+}
+
+// NotifyLeave implements memberlist.EventDelegate
+func (m *membership) NotifyLeave(node *memberlist.Node) {
+	status := MemberFailed
+	if node.State == memberlist.StateLeft {
+		status = MemberLeft
+	}
+	m.update(node, status)
+}
+
+func (m *membership) update(node *memberlist.Node, status string) {
+	m.Lock()
+	defer m.Unlock()
+
+	member := &Member{
+		Name:    node.Name,
+		Address: node.Address(),
+		Status:  status,
+	}
+	if meta, ok := parseNodeMeta(node.Meta); ok {
+		member.ID = meta.ID
+		member.Generation = meta.Generation
+		member.Labels = meta.Labels
+	}
+	m.members[node.Name] = member
+	m.epoch++
+}
+
+// forget removes a node which isn't alive, so it can be replaced by a node
+// with another identity
+func (m *membership) forget(name string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	member, ok := m.members[name]
+	if !ok {
+		return nil
+	}
+	if member.Status == MemberAlive {
+		return fmt.Errorf("node %q is alive, only nodes which left or failed can be removed",
+			name)
+	}
+	delete(m.members, name)
+	m.epoch++
+	return nil
+}
+
+func (m *membership) view() MembershipView {
+	m.Lock()
+	defer m.Unlock()
+
+	view := MembershipView{
+		Epoch:   m.epoch,
+		Local:   m.local,
+		Members: make([]Member, 0, len(m.members)),
+	}
+	for _, member := range m.members {
+		view.Members = append(view.Members, *member)
+	}
+	sort.Slice(view.Members, func(i, j int) bool {
+		return view.Members[i].Name < view.Members[j].Name
+	})
+	return view
+}
+
+func (m *membership) labels(name string) map[string]string {
+	m.Lock()
+	defer m.Unlock()
+
+	if member, ok := m.members[name]; ok && member.Status == MemberAlive {
+		return member.Labels
+	}
+	return nil
+}
+
+// membershipMessage is broadcast to all nodes
+type membershipMessage struct {
+	Forget string `json:"forget"`
+}
+
+type membershipBroadcast []byte
+
+func (b membershipBroadcast) Invalidates(memberlist.Broadcast) bool { return false }
+func (b membershipBroadcast) Message() []byte                       { return b }
+func (b membershipBroadcast) Finished()                             {}
+
+// NodeMeta implements memberlist.Delegate
+func (m *membership) NodeMeta(limit int) []byte {
+	if len(m.meta) > limit {
+		return nil
+	}
+	return m.meta
+}
+
+// NotifyMsg implements memberlist.Delegate
+func (m *membership) NotifyMsg(msg []byte) {
+	var parsed membershipMessage
+	if err := json.Unmarshal(msg, &parsed); err != nil {
+		m.logger.WithField("action", "cluster_membership").WithError(err).
+			Warn("invalid membership message")
+		return
+	}
+	if parsed.Forget != "" {
+		if err := m.forget(parsed.Forget); err != nil {
+			m.logger.WithField("action", "cluster_membership").WithError(err).
+				Warn("remove node")
+		}
+	}
+}
+
+// GetBroadcasts implements memberlist.Delegate
+func (m *membership) GetBroadcasts(overhead, limit int) [][]byte {
+	if m.broadcasts == nil {
+		return nil
+	}
+	return m.broadcasts.GetBroadcasts(overhead, limit)
+}
+
+// LocalState implements memberlist.Delegate
+func (m *membership) LocalState(join bool) []byte { return nil }
+
+// MergeRemoteState implements memberlist.Delegate
+func (m *membership) MergeRemoteState(buf []byte, join bool) {}
+
+// Members returns the membership view of the local node
+func (s *State) Members() MembershipView {
+	return s.membership.view()
+}
+
+// NodeLabels returns the labels of a live member of the cluster
+func (s *State) NodeLabels(nodeName string) map[string]string {
+	return s.membership.labels(nodeName)
+}
+
+// Join joins the cluster of the nodes with the given gossip addresses
+func (s *State) Join(addresses []string) (int, error) {
+	s.membership.Lock()
+	left := s.membership.left
+	s.membership.Unlock()
+	if left {
+		return 0, fmt.Errorf("node left the cluster, restart it to join again")
+	}
+	return s.list.Join(addresses)
+}
+
+// Leave leaves the cluster gracefully, other nodes can take over the name of
+// the node afterwards
+func (s *State) Leave(timeout time.Duration) error {
+	s.membership.Lock()
+	s.membership.left = true
+	s.membership.Unlock()
+	return s.list.Leave(timeout)
+}
+
+// Forget removes a node which left or failed from the membership of all
+// nodes, so a node with a new identity can take over its name
+func (s *State) Forget(nodeName string) error {
+	if err := s.membership.forget(nodeName); err != nil {
+		return err
+	}
+	msg, err := json.Marshal(membershipMessage{Forget: nodeName})
+	if err != nil {
+		return err
+	}
+	s.membership.broadcasts.QueueBroadcast(membershipBroadcast(msg))
+	return nil
+}
@@ -0,0 +1,123 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+
+	"github.com/hashicorp/memberlist"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadIdentity(t *testing.T) {
+	dir := t.TempDir()
+
+	first, err := LoadIdentity(dir)
+	require.Nil(t, err)
+	assert.NotEmpty(t, first.ID)
+	assert.Equal(t, uint64(1), first.Generation)
+
+	second, err := LoadIdentity(dir)
+	require.Nil(t, err)
+	assert.Equal(t, first.ID, second.ID)
+	assert.Equal(t, uint64(2), second.Generation)
+
+	other, err := LoadIdentity(t.TempDir())
+	require.Nil(t, err)
+	assert.NotEqual(t, first.ID, other.ID)
+}
+
+func TestMembership(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	m, err := newMembership("node1", Identity{ID: "id1", Generation: 1},
+		map[string]string{"region": "eu"}, logger)
+	require.Nil(t, err)
+
+	node := func(name, id string, generation uint64, state memberlist.NodeStateType) *memberlist.Node {
+		meta, err := json.Marshal(nodeMeta{ID: id, Generation: generation})
+		require.Nil(t, err)
+		return &memberlist.Node{
+			Name: name, Addr: net.ParseIP("10.0.0.2"), Port: 7946,
+			Meta: meta, State: state,
+		}
+	}
+
+	self := &memberlist.Node{Name: "node1", Addr: net.ParseIP("10.0.0.1"), Port: 7946,
+		Meta: m.NodeMeta(memberlist.MetaMaxSize)}
+	require.Nil(t, m.NotifyAlive(self))
+	m.NotifyJoin(self)
+	require.Nil(t, m.NotifyAlive(node("node2", "id2", 3, memberlist.StateAlive)))
+	m.NotifyJoin(node("node2", "id2", 3, memberlist.StateAlive))
+
+	t.Run("view", func(t *testing.T) {
+		view := m.view()
+		assert.Equal(t, uint64(2), view.Epoch)
+		assert.Equal(t, "node1", view.Local)
+		require.Len(t, view.Members, 2)
+		assert.Equal(t, Member{
+			Name: "node1", ID: "id1", Generation: 1, Address: "10.0.0.1:7946",
+			Status: MemberAlive, Labels: map[string]string{"region": "eu"},
+		}, view.Members[0])
+		assert.Equal(t, "id2", view.Members[1].ID)
+		assert.Equal(t, map[string]string{"region": "eu"}, m.labels("node1"))
+	})
+
+	t.Run("node restarts with a newer generation", func(t *testing.T) {
+		m.NotifyLeave(node("node2", "id2", 3, memberlist.StateDead))
+		assert.Equal(t, MemberFailed, m.view().Members[1].Status)
+		assert.Nil(t, m.labels("node2"))
+
+		assert.Nil(t, m.NotifyAlive(node("node2", "id2", 4, memberlist.StateAlive)))
+		m.NotifyJoin(node("node2", "id2", 4, memberlist.StateAlive))
+	})
+
+	t.Run("node rejoins with stale data", func(t *testing.T) {
+		err := m.NotifyAlive(node("node2", "id2", 2, memberlist.StateAlive))
+		assert.ErrorContains(t, err, "generation 2 of its data")
+	})
+
+	t.Run("node rejoins with another identity", func(t *testing.T) {
+		err := m.NotifyAlive(node("node2", "id3", 1, memberlist.StateAlive))
+		assert.ErrorContains(t, err, "known with identity id2")
+
+		assert.ErrorContains(t, m.forget("node2"), "is alive")
+		m.NotifyLeave(node("node2", "id2", 4, memberlist.StateDead))
+		require.Nil(t, m.forget("node2"))
+		assert.Nil(t, m.NotifyAlive(node("node2", "id3", 1, memberlist.StateAlive)))
+	})
+
+	t.Run("node replaces a node which left", func(t *testing.T) {
+		m.NotifyJoin(node("node3", "id4", 1, memberlist.StateAlive))
+		m.NotifyLeave(node("node3", "id4", 1, memberlist.StateLeft))
+		assert.Equal(t, MemberLeft, m.view().Members[1].Status)
+		assert.Nil(t, m.NotifyAlive(node("node3", "id5", 1, memberlist.StateAlive)))
+	})
+
+	t.Run("forget broadcast", func(t *testing.T) {
+		m.NotifyLeave(node("node3", "id4", 1, memberlist.StateDead))
+		m.NotifyMsg([]byte(`{"forget":"node3"}`))
+		for _, member := range m.view().Members {
+			assert.NotEqual(t, "node3", member.Name)
+		}
+	})
+
+	t.Run("nodes without identity", func(t *testing.T) {
+		legacy := &memberlist.Node{Name: "node4", Addr: net.ParseIP("10.0.0.4"), Port: 7946}
+		assert.Nil(t, m.NotifyAlive(legacy))
+		m.NotifyJoin(legacy)
+		assert.Nil(t, m.NotifyAlive(node("node4", "id6", 1, memberlist.StateAlive)))
+	})
+}
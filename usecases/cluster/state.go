@@ -22,8 +22,9 @@ import (
 )
 
 type State struct {
-	config Config
-	list   *memberlist.Memberlist
+	config     Config
+	list       *memberlist.Memberlist
+	membership *membership
 }
 
 type Config struct {
@@ -32,9 +33,12 @@ type Config struct {
 	DataBindPort            int    `json:"dataBindPort" yaml:"dataBindPort"`
 	Join                    string `json:"join" yaml:"join"`
 	IgnoreStartupSchemaSync bool   `json:"ignoreStartupSchemaSync" yaml:"ignoreStartupSchemaSync"`
+	// Labels of the node, e.g. storage=nvme, classes can restrict the nodes
+	// their shards are placed on by labels
+	Labels map[string]string `json:"labels" yaml:"labels"`
 }
 
-func Init(userConfig Config, logger logrus.FieldLogger) (*State, error) {
+func Init(userConfig Config, dataPath string, logger logrus.FieldLogger) (*State, error) {
 	cfg := memberlist.DefaultLANConfig()
 	cfg.LogOutput = newLogParser(logger)
 
@@ -46,6 +50,18 @@ func Init(userConfig Config, logger logrus.FieldLogger) (*State, error) {
 		cfg.BindPort = userConfig.GossipBindPort
 	}
 
+	identity, err := LoadIdentity(dataPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "load node identity")
+	}
+	membership, err := newMembership(cfg.Name, identity, userConfig.Labels, logger)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Delegate = membership
+	cfg.Events = membership
+	cfg.Alive = membership
+
 	list, err := memberlist.Create(cfg)
 	if err != nil {
 		logger.WithField("action", "memberlist_init").
@@ -55,6 +71,10 @@ func Init(userConfig Config, logger logrus.FieldLogger) (*State, error) {
 			Error("memberlist not created")
 		return nil, errors.Wrap(err, "create member list")
 	}
+	membership.broadcasts = &memberlist.TransmitLimitedQueue{
+		NumNodes:       list.NumMembers,
+		RetransmitMult: cfg.RetransmitMult,
+	}
 
 	var joinAddr []string
 	if userConfig.Join != "" {
@@ -82,7 +102,12 @@ func Init(userConfig Config, logger logrus.FieldLogger) (*State, error) {
 		}
 	}
 
-	return &State{list: list, config: userConfig}, nil
+	logger.WithField("action", "memberlist_init").
+		WithField("node_id", identity.ID).
+		WithField("generation", identity.Generation).
+		Info("node identity loaded")
+
+	return &State{list: list, config: userConfig, membership: membership}, nil
 }
 
 // Hostnames for all live members, except self. Use AllHostnames to include
@@ -13,6 +13,7 @@ package config
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/weaviate/weaviate/usecases/auth/authorization/adminlist"
 )
@@ -20,6 +21,14 @@ import (
 // Authorization configuration
 type Authorization struct {
 	AdminList adminlist.Config `json:"admin_list" yaml:"admin_list"`
+
+	// RestrictedProperties are omitted from the objects returned to principals
+	// which are not authorized to read them, including the changes feed and
+	// replays. Queries which filter, sort, search or aggregate by them are
+	// rejected for these principals, as are queries on their classes using
+	// hybrid search or modules which receive the unredacted objects, such as
+	// generative prompts. Entries have the form "ClassName.propertyName".
+	RestrictedProperties []string `json:"restricted_properties" yaml:"restricted_properties"`
 }
 
 // Validate the Authorization configuration. This only validates at a general
@@ -32,5 +41,13 @@ func (a Authorization) Validate() error {
 		}
 	}
 
+	for _, entry := range a.RestrictedProperties {
+		class, prop, ok := strings.Cut(entry, ".")
+		if !ok || class == "" || prop == "" {
+			return fmt.Errorf("authorization: restricted property '%s' must have the "+
+				"form 'ClassName.propertyName'", entry)
+		}
+	}
+
 	return nil
 }
@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"time"
 
 	"github.com/go-openapi/swag"
 	"github.com/pkg/errors"
@@ -54,6 +55,20 @@ const (
 const (
 	DefaultMaxImportGoroutinesFactor = float64(1.5)
 
+	DefaultHybridTuningMinFeedback = 50
+
+	DefaultQueryCacheMaxEntries = 1000
+	DefaultQueryCacheTTLSeconds = 10
+	DefaultFilterCacheMaxSizeMB = 64
+
+	DefaultQueryAdmissionQueueSize           = 100
+	DefaultQueryAdmissionQueueTimeoutSeconds = 10
+
+	DefaultReferenceSnapshotsRefreshIntervalSeconds = 3600
+
+	DefaultBatchMaxInFlightObjects = 10000
+	DefaultBatchMaxSuggestedSize   = 1000
+
 	DefaultDiskUseWarningPercentage  = uint64(80)
 	DefaultDiskUseReadonlyPercentage = uint64(90)
 	DefaultMemUseWarningPercentage   = uint64(80)
@@ -61,6 +76,8 @@ const (
 	//       the measurement is reliable. once
 	//       confirmed, we can set this to 90
 	DefaultMemUseReadonlyPercentage = uint64(0)
+	DefaultMemUseDegradeEF          = 32
+	DefaultMemUseDegradeMaxLimit    = 25
 )
 
 // Flags are input options
@@ -70,29 +87,49 @@ type Flags struct {
 
 // Config outline of the config file
 type Config struct {
-	Name                             string         `json:"name" yaml:"name"`
-	Debug                            bool           `json:"debug" yaml:"debug"`
-	QueryDefaults                    QueryDefaults  `json:"query_defaults" yaml:"query_defaults"`
-	QueryMaximumResults              int64          `json:"query_maximum_results" yaml:"query_maximum_results"`
-	Contextionary                    Contextionary  `json:"contextionary" yaml:"contextionary"`
-	Authentication                   Authentication `json:"authentication" yaml:"authentication"`
-	Authorization                    Authorization  `json:"authorization" yaml:"authorization"`
-	Origin                           string         `json:"origin" yaml:"origin"`
-	Persistence                      Persistence    `json:"persistence" yaml:"persistence"`
-	DefaultVectorizerModule          string         `json:"default_vectorizer_module" yaml:"default_vectorizer_module"`
-	DefaultVectorDistanceMetric      string         `json:"default_vector_distance_metric" yaml:"default_vector_distance_metric"`
-	EnableModules                    string         `json:"enable_modules" yaml:"enable_modules"`
-	ModulesPath                      string         `json:"modules_path" yaml:"modules_path"`
-	AutoSchema                       AutoSchema     `json:"auto_schema" yaml:"auto_schema"`
-	Cluster                          cluster.Config `json:"cluster" yaml:"cluster"`
-	Monitoring                       Monitoring     `json:"monitoring" yaml:"monitoring"`
-	Profiling                        Profiling      `json:"profiling" yaml:"profiling"`
-	ResourceUsage                    ResourceUsage  `json:"resource_usage" yaml:"resource_usage"`
-	MaxImportGoroutinesFactor        float64        `json:"max_import_goroutine_factor" yaml:"max_import_goroutine_factor"`
-	MaximumConcurrentGetRequests     int            `json:"maximum_concurrent_get_requests" yaml:"maximum_concurrent_get_requests"`
-	TrackVectorDimensions            bool           `json:"track_vector_dimensions" yaml:"track_vector_dimensions"`
-	ReindexVectorDimensionsAtStartup bool           `json:"reindex_vector_dimensions_at_startup" yaml:"reindex_vector_dimensions_at_startup"`
-	ReindexSetToRoaringsetAtStartup  bool           `json:"reindex_set_to_roaringset_at_startup" yaml:"reindex_set_to_roaringset_at_startup"`
+	Name                             string             `json:"name" yaml:"name"`
+	Debug                            bool               `json:"debug" yaml:"debug"`
+	QueryDefaults                    QueryDefaults      `json:"query_defaults" yaml:"query_defaults"`
+	QueryMaximumResults              int64              `json:"query_maximum_results" yaml:"query_maximum_results"`
+	QueryMaximumVectorCursorDepth    int64              `json:"query_maximum_vector_cursor_depth" yaml:"query_maximum_vector_cursor_depth"`
+	Contextionary                    Contextionary      `json:"contextionary" yaml:"contextionary"`
+	Authentication                   Authentication     `json:"authentication" yaml:"authentication"`
+	Authorization                    Authorization      `json:"authorization" yaml:"authorization"`
+	NetworkAccess                    NetworkAccess      `json:"network_access" yaml:"network_access"`
+	Origin                           string             `json:"origin" yaml:"origin"`
+	Persistence                      Persistence        `json:"persistence" yaml:"persistence"`
+	DefaultVectorizerModule          string             `json:"default_vectorizer_module" yaml:"default_vectorizer_module"`
+	DefaultVectorDistanceMetric      string             `json:"default_vector_distance_metric" yaml:"default_vector_distance_metric"`
+	EnableModules                    string             `json:"enable_modules" yaml:"enable_modules"`
+	ModulesPath                      string             `json:"modules_path" yaml:"modules_path"`
+	AutoSchema                       AutoSchema         `json:"auto_schema" yaml:"auto_schema"`
+	Cluster                          cluster.Config     `json:"cluster" yaml:"cluster"`
+	Monitoring                       Monitoring         `json:"monitoring" yaml:"monitoring"`
+	Profiling                        Profiling          `json:"profiling" yaml:"profiling"`
+	ResourceUsage                    ResourceUsage      `json:"resource_usage" yaml:"resource_usage"`
+	MaxImportGoroutinesFactor        float64            `json:"max_import_goroutine_factor" yaml:"max_import_goroutine_factor"`
+	MaximumConcurrentGetRequests     int                `json:"maximum_concurrent_get_requests" yaml:"maximum_concurrent_get_requests"`
+	TrackVectorDimensions            bool               `json:"track_vector_dimensions" yaml:"track_vector_dimensions"`
+	ReindexVectorDimensionsAtStartup bool               `json:"reindex_vector_dimensions_at_startup" yaml:"reindex_vector_dimensions_at_startup"`
+	ReindexSetToRoaringsetAtStartup  bool               `json:"reindex_set_to_roaringset_at_startup" yaml:"reindex_set_to_roaringset_at_startup"`
+	HybridTuning                     HybridTuning       `json:"hybrid_tuning" yaml:"hybrid_tuning"`
+	QueryCache                       QueryCache         `json:"query_cache" yaml:"query_cache"`
+	QueryAdmission                   QueryAdmission     `json:"query_admission" yaml:"query_admission"`
+	QueryTimeout                     QueryTimeout       `json:"query_timeout" yaml:"query_timeout"`
+	BatchBackpressure                BatchBackpressure  `json:"batch_backpressure" yaml:"batch_backpressure"`
+	ReferenceSnapshots               ReferenceSnapshots `json:"reference_snapshots" yaml:"reference_snapshots"`
+	RecycleBin                       RecycleBin         `json:"recycle_bin" yaml:"recycle_bin"`
+	Replication                      Replication        `json:"replication" yaml:"replication"`
+	Startup                          Startup            `json:"startup" yaml:"startup"`
+	Shutdown                         Shutdown           `json:"shutdown" yaml:"shutdown"`
+	WorkerPools                      WorkerPools        `json:"worker_pools" yaml:"worker_pools"`
+	BlobStorage                      BlobStorage        `json:"blob_storage" yaml:"blob_storage"`
+	Metering                         Metering           `json:"metering" yaml:"metering"`
+	AsyncIndexing                    AsyncIndexing      `json:"async_indexing" yaml:"async_indexing"`
+	GraphQLLimits                    GraphQLLimits      `json:"graphql_limits" yaml:"graphql_limits"`
+	GRPC                             GRPC               `json:"grpc" yaml:"grpc"`
+	FilterCache                      FilterCache        `json:"filter_cache" yaml:"filter_cache"`
+	SlowQueryLog                     SlowQueryLog       `json:"slow_query_log" yaml:"slow_query_log"`
 }
 
 type moduleProvider interface {
@@ -156,6 +193,288 @@ type QueryDefaults struct {
 	Limit int64 `json:"limit" yaml:"limit"`
 }
 
+// HybridTuning derives the alpha and BM25 parameters of hybrid queries from
+// the results which applications report as clicked
+type HybridTuning struct {
+	// MinFeedback is the number of feedback events of a class which are
+	// required before parameters are suggested
+	MinFeedback int `json:"min_feedback" yaml:"min_feedback"`
+	// AutoApply uses the suggestions for hybrid queries which do not set alpha
+	AutoApply bool `json:"auto_apply" yaml:"auto_apply"`
+}
+
+// QueryCache caches the results of identical Get queries. Entries are
+// invalidated on writes to the class on this node and expire after the TTL,
+// which bounds the staleness of writes handled by other nodes.
+type QueryCache struct {
+	Enabled    bool `json:"enabled" yaml:"enabled"`
+	MaxEntries int  `json:"max_entries" yaml:"max_entries"`
+	TTLSeconds int  `json:"ttl_seconds" yaml:"ttl_seconds"`
+}
+
+// FilterCache caches the allow lists of repeated where filters per shard.
+// Entries are invalidated by writes to the properties their filter reads.
+type FilterCache struct {
+	Enabled   bool `json:"enabled" yaml:"enabled"`
+	MaxSizeMB int  `json:"max_size_mb" yaml:"max_size_mb"`
+}
+
+// SlowQueryLog appends the GraphQL queries which took at least the
+// threshold to a file, the captured queries can be replayed to load-test a
+// config change, see package queryreplay
+type SlowQueryLog struct {
+	// Path enables the log if set
+	Path        string `json:"path" yaml:"path"`
+	ThresholdMs int    `json:"threshold_ms" yaml:"threshold_ms"`
+	// MaxSizeMB is the size at which the log is rotated to <path>.1
+	MaxSizeMB int `json:"max_size_mb" yaml:"max_size_mb"`
+}
+
+func (s SlowQueryLog) Enabled() bool {
+	return s.Path != ""
+}
+
+// QueryTimeout bounds the duration of searches and aggregations. A query
+// which runs longer fails with a timeout or, with PartialResults, returns the
+// results found until then. GraphQL responses with such results have the
+// partialResults extension set.
+type QueryTimeout struct {
+	// MaxDurationMs is the time a query may take, 0 doesn't bound it
+	MaxDurationMs  int  `json:"max_duration_ms" yaml:"max_duration_ms"`
+	PartialResults bool `json:"partial_results" yaml:"partial_results"`
+}
+
+func (q QueryTimeout) MaxDuration() time.Duration {
+	return time.Duration(q.MaxDurationMs) * time.Millisecond
+}
+
+// QueryAdmission limits the number of concurrent searches per class, so
+// that expensive queries on one class can't starve the queries on other
+// classes. Searches above the limit wait in a bounded queue.
+type QueryAdmission struct {
+	// MaxConcurrent is the limit of classes which are not listed in
+	// ClassLimits, 0 means unlimited
+	MaxConcurrent int            `json:"max_concurrent" yaml:"max_concurrent"`
+	ClassLimits   map[string]int `json:"class_limits" yaml:"class_limits"`
+	// QueueSize is the number of searches per class which may wait for
+	// admission, further searches are rejected right away
+	QueueSize           int `json:"queue_size" yaml:"queue_size"`
+	QueueTimeoutSeconds int `json:"queue_timeout_seconds" yaml:"queue_timeout_seconds"`
+}
+
+// Limit is the maximum number of concurrent searches of the class, 0 means
+// unlimited
+func (q QueryAdmission) Limit(className string) int {
+	if limit, ok := q.ClassLimits[className]; ok {
+		return limit
+	}
+	return q.MaxConcurrent
+}
+
+// GraphQLLimits rejects abusive GraphQL queries before they are executed,
+// 0 means unlimited
+type GraphQLLimits struct {
+	MaxDepth      int `json:"max_depth" yaml:"max_depth"`
+	MaxAliases    int `json:"max_aliases" yaml:"max_aliases"`
+	MaxComplexity int `json:"max_complexity" yaml:"max_complexity"`
+	// KeyLimits replace the limits for the users of API keys or OIDC tokens
+	KeyLimits map[string]GraphQLLimit `json:"key_limits" yaml:"key_limits"`
+}
+
+type GraphQLLimit struct {
+	MaxDepth      int `json:"max_depth" yaml:"max_depth"`
+	MaxAliases    int `json:"max_aliases" yaml:"max_aliases"`
+	MaxComplexity int `json:"max_complexity" yaml:"max_complexity"`
+}
+
+// Limit returns the limits of the user, anonymous users have the username ""
+func (g GraphQLLimits) Limit(username string) GraphQLLimit {
+	if limit, ok := g.KeyLimits[username]; ok {
+		return limit
+	}
+	return GraphQLLimit{
+		MaxDepth:      g.MaxDepth,
+		MaxAliases:    g.MaxAliases,
+		MaxComplexity: g.MaxComplexity,
+	}
+}
+
+// ReferenceSnapshots controls the background job which updates the
+// properties copied from referenced objects
+type ReferenceSnapshots struct {
+	RefreshIntervalSeconds int `json:"refresh_interval_seconds" yaml:"refresh_interval_seconds"`
+}
+
+// RecycleBin keeps the data of deleted classes, so that they can be restored
+// until the retention ends
+type RecycleBin struct {
+	// RetentionSeconds is the time a deleted class can be restored, 0 disables
+	// the recycle bin, so that the data of deleted classes is removed right away
+	RetentionSeconds int `json:"retention_seconds" yaml:"retention_seconds"`
+}
+
+// Enabled is true if deleted classes are moved into the recycle bin
+func (r RecycleBin) Enabled() bool {
+	return r.RetentionSeconds > 0
+}
+
+// Startup controls how classes and their shards are loaded when the node
+// starts
+type Startup struct {
+	// ShardLoadParallelism is the number of shards which are loaded
+	// concurrently, across all classes
+	ShardLoadParallelism int `json:"shard_load_parallelism" yaml:"shard_load_parallelism"`
+	// LazyLoading loads only the PriorityClasses at startup, all other
+	// classes are loaded on their first access
+	LazyLoading bool `json:"lazy_loading" yaml:"lazy_loading"`
+	// PriorityClasses are loaded first, in this order
+	PriorityClasses []string `json:"priority_classes" yaml:"priority_classes"`
+}
+
+// Shutdown controls the graceful shutdown of the node. New requests are
+// rejected while the in-flight ones are drained, before the data is flushed.
+type Shutdown struct {
+	// DrainTimeoutSeconds bounds the wait for in-flight requests. It should be
+	// shorter than the --graceful-timeout of the server, which includes it.
+	DrainTimeoutSeconds int `json:"drain_timeout_seconds" yaml:"drain_timeout_seconds"`
+}
+
+// GRPC configures the gRPC server, which serves the streaming batch import.
+// It is disabled unless a port is set.
+type GRPC struct {
+	Port int `json:"port" yaml:"port"`
+	// MaxMessageSizeBytes bounds a single message of a stream
+	MaxMessageSizeBytes int `json:"max_message_size_bytes" yaml:"max_message_size_bytes"`
+}
+
+func (g GRPC) Enabled() bool {
+	return g.Port > 0
+}
+
+// Priorities of the worker pools
+const (
+	// WorkerPoolPriorityQuery makes imports wait for a free query worker
+	// whenever all query workers are busy
+	WorkerPoolPriorityQuery = "query"
+	// WorkerPoolPriorityNone schedules imports and queries independently
+	WorkerPoolPriorityNone = "none"
+)
+
+// WorkerPools separates the workers of batch imports, of the vector and
+// inverted indexing of imported objects and of queries, so large imports
+// don't delay queries. Unlike QueryAdmission, the query pool is shared by
+// all classes and bounds the searches in the storage, including those of the
+// REST API.
+type WorkerPools struct {
+	// BatchWorkers is the number of batches which are written concurrently,
+	// 0 means unlimited
+	BatchWorkers int `json:"batch_workers" yaml:"batch_workers"`
+	// IndexingWorkers is the number of workers which add imported objects
+	// to the indexes, 0 derives it from MaxImportGoroutinesFactor
+	IndexingWorkers int `json:"indexing_workers" yaml:"indexing_workers"`
+	// QueryWorkers is the number of searches which run concurrently, 0
+	// means unlimited
+	QueryWorkers int    `json:"query_workers" yaml:"query_workers"`
+	Priority     string `json:"priority" yaml:"priority"`
+}
+
+// BlobStorage offloads the values of blob properties to S3-compatible object
+// storage. Objects only keep a reference to the offloaded value, which is
+// streamed by the blobs endpoint of the object.
+type BlobStorage struct {
+	// Bucket enables the blob storage if set
+	Bucket   string `json:"bucket" yaml:"bucket"`
+	Endpoint string `json:"endpoint" yaml:"endpoint"`
+	// Path is the prefix of the objects in the bucket
+	Path   string `json:"path" yaml:"path"`
+	UseSSL bool   `json:"use_ssl" yaml:"use_ssl"`
+	// InlineMaxBytes is the size of the largest value which is stored with
+	// the object, 0 offloads all values
+	InlineMaxBytes int `json:"inline_max_bytes" yaml:"inline_max_bytes"`
+}
+
+func (b BlobStorage) Enabled() bool {
+	return b.Bucket != ""
+}
+
+// AsyncIndexing makes writes append vectors to a durable queue per shard
+// instead of inserting them into the vector index. Background workers index
+// the queued vectors, so imports are no longer bound by graph construction,
+// but objects are only found by vector searches once they are indexed.
+type AsyncIndexing struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// BatchSize is the number of queued operations a worker applies to the
+	// vector index at once
+	BatchSize int `json:"batch_size" yaml:"batch_size"`
+}
+
+// Metering aggregates the usage of the node per class, shard and user and
+// exports it as usage records every interval
+type Metering struct {
+	// Sink enables metering if set. Records are appended to a file in a
+	// directory (file:///var/lib/weaviate-usage), written as objects to a
+	// bucket (s3://bucket/prefix) or POSTed to an http(s):// URL.
+	Sink            string `json:"sink" yaml:"sink"`
+	IntervalSeconds int    `json:"interval_seconds" yaml:"interval_seconds"`
+	// S3Endpoint and S3UseSSL configure the client of s3 sinks
+	S3Endpoint string `json:"s3_endpoint" yaml:"s3_endpoint"`
+	S3UseSSL   bool   `json:"s3_use_ssl" yaml:"s3_use_ssl"`
+}
+
+func (m Metering) Enabled() bool {
+	return m.Sink != ""
+}
+
+// BatchBackpressure controls the hints on the load of the node which are
+// returned with batch responses and whether batches wait for capacity
+type BatchBackpressure struct {
+	// Blocking makes batches wait until the number of objects in flight
+	// drops below MaxInFlightObjects instead of accepting unbounded work
+	Blocking           bool `json:"blocking" yaml:"blocking"`
+	MaxInFlightObjects int  `json:"max_in_flight_objects" yaml:"max_in_flight_objects"`
+	// MaxSuggestedSize is the batch size suggested to clients while the node
+	// is idle
+	MaxSuggestedSize int `json:"max_suggested_size" yaml:"max_suggested_size"`
+}
+
+const (
+	// ReplicationWriteModeLeaderless lets every node coordinate the writes of
+	// a shard
+	ReplicationWriteModeLeaderless = "leaderless"
+	// ReplicationWriteModeLeader serializes the writes of a shard through the
+	// first of its replicas which is a live member of the cluster
+	ReplicationWriteModeLeader = "leader"
+)
+
+type Replication struct {
+	WriteMode string `json:"write_mode" yaml:"write_mode"`
+	// DeadNodeGracePeriodSeconds is the time after which the replicas of an
+	// unreachable node are re-created on other nodes, 0 disables it
+	DeadNodeGracePeriodSeconds int `json:"dead_node_grace_period_seconds" yaml:"dead_node_grace_period_seconds"`
+	// ConsistencyCheckIntervalSeconds is the time between two comparisons of
+	// the replicas of the local shards, 0 disables the check
+	ConsistencyCheckIntervalSeconds int `json:"consistency_check_interval_seconds" yaml:"consistency_check_interval_seconds"`
+	// ConsistencyCheckSampleSize is the number of objects per shard whose
+	// digests are compared across replicas on each check
+	ConsistencyCheckSampleSize int `json:"consistency_check_sample_size" yaml:"consistency_check_sample_size"`
+	// AntiEntropyIntervalSeconds is the time between two repairs of the
+	// replicas of the local shards, 0 disables the repair
+	AntiEntropyIntervalSeconds int `json:"anti_entropy_interval_seconds" yaml:"anti_entropy_interval_seconds"`
+	// AntiEntropyTreeHeight is the height of the hash trees which are
+	// compared, a shard is split into 2^height ranges of objects
+	AntiEntropyTreeHeight int `json:"anti_entropy_tree_height" yaml:"anti_entropy_tree_height"`
+	// RebalanceIntervalSeconds is the time between two rebalances of the
+	// shards across the nodes, 0 disables the rebalancing
+	RebalanceIntervalSeconds int `json:"rebalance_interval_seconds" yaml:"rebalance_interval_seconds"`
+	// TransferMaxBytesPerSecond limits the rate at which shard files are sent
+	// to other nodes when replicas are added or moved, 0 means unlimited
+	TransferMaxBytesPerSecond int `json:"transfer_max_bytes_per_second" yaml:"transfer_max_bytes_per_second"`
+}
+
+func (r Replication) LeaderWrites() bool {
+	return r.WriteMode == ReplicationWriteModeLeader
+}
+
 type Contextionary struct {
 	URL string `json:"url" yaml:"url"`
 }
@@ -177,6 +496,87 @@ type Persistence struct {
 	MemtablesMaxSizeMB                int    `json:"memtablesMaxSizeMB" yaml:"memtablesMaxSizeMB"`
 	MemtablesMinActiveDurationSeconds int    `json:"memtablesMinActiveDurationSeconds" yaml:"memtablesMinActiveDurationSeconds"`
 	MemtablesMaxActiveDurationSeconds int    `json:"memtablesMaxActiveDurationSeconds" yaml:"memtablesMaxActiveDurationSeconds"`
+	// QuarantineAfterWriteErrors sets a shard to QUARANTINED after this many
+	// consecutive failed writes, 0 disables quarantining
+	QuarantineAfterWriteErrors int            `json:"quarantineAfterWriteErrors" yaml:"quarantineAfterWriteErrors"`
+	RemoteSegments             RemoteSegments `json:"remoteSegments" yaml:"remoteSegments"`
+	Compaction                 Compaction     `json:"compaction" yaml:"compaction"`
+	Offload                    Offload        `json:"offload" yaml:"offload"`
+	// WALCompression is the codec the write-ahead logs of the LSM stores and
+	// the HNSW commit logs are compressed with: none, snappy or zstd
+	WALCompression string `json:"walCompression" yaml:"walCompression"`
+}
+
+// Offload configures the S3-compatible object storage which classes are
+// offloaded to through PUT /v1/nodes/offloads. Offloaded classes have no
+// local files and are downloaded again on their next access.
+type Offload struct {
+	// Bucket enables offloading if set
+	Bucket   string `json:"bucket" yaml:"bucket"`
+	Endpoint string `json:"endpoint" yaml:"endpoint"`
+	// Path is the prefix of the objects in the bucket
+	Path   string `json:"path" yaml:"path"`
+	UseSSL bool   `json:"useSSL" yaml:"useSSL"`
+}
+
+func (o Offload) Enabled() bool {
+	return o.Bucket != ""
+}
+
+// Compaction limits the LSM compactions of the node, so they compete less
+// with queries for IO. Zero values don't limit anything. The limits can be
+// adjusted at runtime through PUT /v1/nodes/compaction.
+type Compaction struct {
+	MaxConcurrent         int `json:"maxConcurrent" yaml:"maxConcurrent"`
+	MaxConcurrentPerShard int `json:"maxConcurrentPerShard" yaml:"maxConcurrentPerShard"`
+	// MaxMBPerSecond is the rate with which all compactions of the node
+	// write their segments together
+	MaxMBPerSecond int `json:"maxMBPerSecond" yaml:"maxMBPerSecond"`
+	// QuietHours restricts compactions to a daily window in UTC, such as
+	// "22:00-06:00"
+	QuietHours string `json:"quietHours" yaml:"quietHours"`
+}
+
+// RemoteSegments offloads older, fully compacted segments of the objects
+// buckets to S3-compatible object storage. They are read through a block
+// cache in memory, only recent segments and the indexes stay on local disk.
+type RemoteSegments struct {
+	// Bucket enables remote segments if set
+	Bucket   string `json:"bucket" yaml:"bucket"`
+	Endpoint string `json:"endpoint" yaml:"endpoint"`
+	// Path is the prefix of the objects in the bucket
+	Path   string `json:"path" yaml:"path"`
+	UseSSL bool   `json:"useSSL" yaml:"useSSL"`
+	// CacheSizeMB is the size of the block cache shared by all shards
+	CacheSizeMB int `json:"cacheSizeMB" yaml:"cacheSizeMB"`
+	// KeepLocalSegments is the number of most recent segments of each bucket
+	// which are never offloaded
+	KeepLocalSegments int `json:"keepLocalSegments" yaml:"keepLocalSegments"`
+	// MinAgeSeconds is the minimum age of a segment before it is offloaded
+	MinAgeSeconds int `json:"minAgeSeconds" yaml:"minAgeSeconds"`
+	// Classes limits remote segments to these classes, all classes use them
+	// if it is empty
+	Classes []string `json:"classes" yaml:"classes"`
+}
+
+func (r RemoteSegments) Enabled() bool {
+	return r.Bucket != ""
+}
+
+// EnabledFor returns true if the segments of the class may be offloaded
+func (r RemoteSegments) EnabledFor(className string) bool {
+	if !r.Enabled() {
+		return false
+	}
+	if len(r.Classes) == 0 {
+		return true
+	}
+	for _, class := range r.Classes {
+		if class == className {
+			return true
+		}
+	}
+	return false
 }
 
 func (p Persistence) Validate() error {
@@ -190,6 +590,10 @@ func (p Persistence) Validate() error {
 type DiskUse struct {
 	WarningPercentage  uint64 `json:"warning_percentage" yaml:"warning_percentage"`
 	ReadOnlyPercentage uint64 `json:"readonly_percentage" yaml:"readonly_percentage"`
+	// ReadOnlyRecoveryPercentage sets shards which were set READONLY because
+	// of the disk usage back to READY once the usage has dropped below it, 0
+	// disables the automatic recovery
+	ReadOnlyRecoveryPercentage uint64 `json:"readonly_recovery_percentage" yaml:"readonly_recovery_percentage"`
 }
 
 func (d DiskUse) Validate() error {
@@ -201,12 +605,25 @@ func (d DiskUse) Validate() error {
 		return fmt.Errorf("disk_use.read_only_percentage must be between 0 and 100")
 	}
 
+	if d.ReadOnlyRecoveryPercentage > 0 &&
+		d.ReadOnlyRecoveryPercentage >= d.ReadOnlyPercentage {
+		return fmt.Errorf("disk_use.readonly_recovery_percentage must be lower than disk_use.read_only_percentage")
+	}
+
 	return nil
 }
 
 type MemUse struct {
 	WarningPercentage  uint64 `json:"warning_percentage" yaml:"warning_percentage"`
 	ReadOnlyPercentage uint64 `json:"readonly_percentage" yaml:"readonly_percentage"`
+	// DegradePercentage switches the DegradeClasses to reduced quality search
+	// while memory usage stays above it, 0 disables degradation
+	DegradePercentage uint64   `json:"degrade_percentage" yaml:"degrade_percentage"`
+	DegradeClasses    []string `json:"degrade_classes" yaml:"degrade_classes"`
+	// DegradeEF caps the ef of vector searches while degraded
+	DegradeEF int `json:"degrade_ef" yaml:"degrade_ef"`
+	// DegradeMaxLimit caps the number of results per search while degraded
+	DegradeMaxLimit int `json:"degrade_max_limit" yaml:"degrade_max_limit"`
 }
 
 func (m MemUse) Validate() error {
@@ -218,6 +635,10 @@ func (m MemUse) Validate() error {
 		return fmt.Errorf("mem_use.read_only_percentage must be between 0 and 100")
 	}
 
+	if m.DegradePercentage > 100 {
+		return fmt.Errorf("mem_use.degrade_percentage must be between 0 and 100")
+	}
+
 	return nil
 }
 
@@ -299,6 +720,10 @@ func (f *WeaviateConfig) LoadConfig(flags *swag.CommandLineOptionsGroup, logger
 		return configErr(err)
 	}
 
+	if err := f.Config.NetworkAccess.Validate(); err != nil {
+		return configErr(err)
+	}
+
 	if err := f.Config.Persistence.Validate(); err != nil {
 		return configErr(err)
 	}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/network/ipfilter"
 )
 
 // FromEnv takes a *Config as it will respect initial config that has been
@@ -114,6 +115,14 @@ func FromEnv(config *Config) error {
 		}
 	}
 
+	if restricted, ok := os.LookupEnv("AUTHORIZATION_RESTRICTED_PROPERTIES"); ok {
+		config.Authorization.RestrictedProperties = strings.Split(restricted, ",")
+	}
+
+	parseNetworkAccessRules("API", &config.NetworkAccess.API)
+	parseNetworkAccessRules("METRICS", &config.NetworkAccess.Metrics)
+	parseNetworkAccessRules("CLUSTER", &config.NetworkAccess.Cluster)
+
 	clusterCfg, err := parseClusterConfig()
 	if err != nil {
 		return err
@@ -128,6 +137,20 @@ func FromEnv(config *Config) error {
 		return err
 	}
 
+	if err := config.parseRemoteSegmentsConfig(); err != nil {
+		return err
+	}
+
+	config.parseOffloadConfig()
+
+	if err := config.parseCompactionConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseWALCompressionConfig(); err != nil {
+		return err
+	}
+
 	if v := os.Getenv("ORIGIN"); v != "" {
 		config.Origin = v
 	}
@@ -156,6 +179,17 @@ func FromEnv(config *Config) error {
 		config.QueryMaximumResults = DefaultQueryMaximumResults
 	}
 
+	if v := os.Getenv("QUERY_MAXIMUM_VECTOR_CURSOR_DEPTH"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse QUERY_MAXIMUM_VECTOR_CURSOR_DEPTH as int")
+		}
+
+		config.QueryMaximumVectorCursorDepth = int64(asInt)
+	} else {
+		config.QueryMaximumVectorCursorDepth = DefaultQueryMaximumVectorCursorDepth
+	}
+
 	if v := os.Getenv("MAX_IMPORT_GOROUTINES_FACTOR"); v != "" {
 		asFloat, err := strconv.ParseFloat(v, 64)
 		if err != nil {
@@ -238,6 +272,440 @@ func FromEnv(config *Config) error {
 		config.MaximumConcurrentGetRequests = DefaultMaxConcurrentGetRequests
 	}
 
+	if err := parsePositiveInt(
+		"HYBRID_TUNING_MIN_FEEDBACK",
+		func(val int) { config.HybridTuning.MinFeedback = val },
+		DefaultHybridTuningMinFeedback,
+	); err != nil {
+		return err
+	}
+	config.HybridTuning.AutoApply = enabled(os.Getenv("HYBRID_TUNING_AUTO_APPLY"))
+
+	config.QueryCache.Enabled = enabled(os.Getenv("QUERY_CACHE_ENABLED"))
+	if err := parsePositiveInt(
+		"QUERY_CACHE_MAX_ENTRIES",
+		func(val int) { config.QueryCache.MaxEntries = val },
+		DefaultQueryCacheMaxEntries,
+	); err != nil {
+		return err
+	}
+	if err := parsePositiveInt(
+		"QUERY_CACHE_TTL_SECONDS",
+		func(val int) { config.QueryCache.TTLSeconds = val },
+		DefaultQueryCacheTTLSeconds,
+	); err != nil {
+		return err
+	}
+
+	config.FilterCache.Enabled = enabled(os.Getenv("FILTER_CACHE_ENABLED"))
+	if err := parsePositiveInt(
+		"FILTER_CACHE_MAX_SIZE_MB",
+		func(val int) { config.FilterCache.MaxSizeMB = val },
+		DefaultFilterCacheMaxSizeMB,
+	); err != nil {
+		return err
+	}
+
+	if err := parseSlowQueryLogEnvVars(&config.SlowQueryLog); err != nil {
+		return err
+	}
+
+	if err := parseQueryAdmissionEnvVars(&config.QueryAdmission); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"QUERY_TIMEOUT_MAX_DURATION_MS",
+		func(val int) { config.QueryTimeout.MaxDurationMs = val },
+		0,
+	); err != nil {
+		return err
+	}
+	config.QueryTimeout.PartialResults = enabled(os.Getenv("QUERY_TIMEOUT_PARTIAL_RESULTS"))
+
+	if err := parseGraphQLLimitsEnvVars(&config.GraphQLLimits); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"REFERENCE_SNAPSHOTS_REFRESH_INTERVAL_SECONDS",
+		func(val int) { config.ReferenceSnapshots.RefreshIntervalSeconds = val },
+		DefaultReferenceSnapshotsRefreshIntervalSeconds,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"RECYCLE_BIN_RETENTION_SECONDS",
+		func(val int) { config.RecycleBin.RetentionSeconds = val },
+		0,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"STARTUP_SHARD_LOAD_PARALLELISM",
+		func(val int) { config.Startup.ShardLoadParallelism = val },
+		DefaultStartupShardLoadParallelism,
+	); err != nil {
+		return err
+	}
+	config.Startup.LazyLoading = enabled(os.Getenv("STARTUP_LAZY_LOADING"))
+	if v := os.Getenv("STARTUP_PRIORITY_CLASSES"); v != "" {
+		for _, class := range strings.Split(v, ",") {
+			if class = strings.TrimSpace(class); class != "" {
+				config.Startup.PriorityClasses = append(config.Startup.PriorityClasses, class)
+			}
+		}
+	}
+
+	if err := parsePositiveInt(
+		"SHUTDOWN_DRAIN_TIMEOUT_SECONDS",
+		func(val int) { config.Shutdown.DrainTimeoutSeconds = val },
+		DefaultShutdownDrainTimeoutSeconds,
+	); err != nil {
+		return err
+	}
+
+	if v := os.Getenv("GRPC_PORT"); v != "" {
+		port, err := strconv.Atoi(v)
+		if err != nil || port <= 0 || port > 65535 {
+			return fmt.Errorf("parse GRPC_PORT: %q is not a valid port", v)
+		}
+		config.GRPC.Port = port
+	}
+	if err := parsePositiveInt(
+		"GRPC_MAX_MESSAGE_SIZE_BYTES",
+		func(val int) { config.GRPC.MaxMessageSizeBytes = val },
+		DefaultGRPCMaxMessageSizeBytes,
+	); err != nil {
+		return err
+	}
+
+	if err := parseWorkerPoolsEnvVars(&config.WorkerPools); err != nil {
+		return err
+	}
+
+	if err := parseBlobStorageEnvVars(&config.BlobStorage); err != nil {
+		return err
+	}
+
+	if err := parseMeteringEnvVars(&config.Metering); err != nil {
+		return err
+	}
+
+	config.AsyncIndexing.Enabled = enabled(os.Getenv("ASYNC_INDEXING"))
+	if err := parsePositiveInt(
+		"ASYNC_INDEXING_BATCH_SIZE",
+		func(val int) { config.AsyncIndexing.BatchSize = val },
+		DefaultAsyncIndexingBatchSize,
+	); err != nil {
+		return err
+	}
+
+	switch v := os.Getenv("REPLICATION_WRITE_MODE"); v {
+	case "":
+		config.Replication.WriteMode = ReplicationWriteModeLeaderless
+	case ReplicationWriteModeLeaderless, ReplicationWriteModeLeader:
+		config.Replication.WriteMode = v
+	default:
+		return errors.Errorf("REPLICATION_WRITE_MODE must be one of %q or %q",
+			ReplicationWriteModeLeaderless, ReplicationWriteModeLeader)
+	}
+
+	if err := parsePositiveInt(
+		"REPLICATION_DEAD_NODE_GRACE_PERIOD_SECONDS",
+		func(val int) { config.Replication.DeadNodeGracePeriodSeconds = val },
+		0,
+	); err != nil {
+		return err
+	}
+	if err := parsePositiveInt(
+		"REPLICATION_CONSISTENCY_CHECK_INTERVAL_SECONDS",
+		func(val int) { config.Replication.ConsistencyCheckIntervalSeconds = val },
+		0,
+	); err != nil {
+		return err
+	}
+	if err := parsePositiveInt(
+		"REPLICATION_CONSISTENCY_CHECK_SAMPLE_SIZE",
+		func(val int) { config.Replication.ConsistencyCheckSampleSize = val },
+		DefaultReplicationConsistencyCheckSampleSize,
+	); err != nil {
+		return err
+	}
+	if err := parsePositiveInt(
+		"REPLICATION_ANTI_ENTROPY_INTERVAL_SECONDS",
+		func(val int) { config.Replication.AntiEntropyIntervalSeconds = val },
+		0,
+	); err != nil {
+		return err
+	}
+	if err := parsePositiveInt(
+		"REPLICATION_ANTI_ENTROPY_TREE_HEIGHT",
+		func(val int) { config.Replication.AntiEntropyTreeHeight = val },
+		DefaultReplicationAntiEntropyTreeHeight,
+	); err != nil {
+		return err
+	}
+	if h := config.Replication.AntiEntropyTreeHeight; h > maxReplicationAntiEntropyTreeHeight {
+		return errors.Errorf("REPLICATION_ANTI_ENTROPY_TREE_HEIGHT must not be larger than %d",
+			maxReplicationAntiEntropyTreeHeight)
+	}
+	if err := parsePositiveInt(
+		"REPLICATION_REBALANCE_INTERVAL_SECONDS",
+		func(val int) { config.Replication.RebalanceIntervalSeconds = val },
+		0,
+	); err != nil {
+		return err
+	}
+	if err := parsePositiveInt(
+		"REPLICATION_TRANSFER_MAX_BYTES_PER_SECOND",
+		func(val int) { config.Replication.TransferMaxBytesPerSecond = val },
+		0,
+	); err != nil {
+		return err
+	}
+
+	config.BatchBackpressure.Blocking = enabled(os.Getenv("BATCH_BACKPRESSURE_BLOCKING"))
+	if err := parsePositiveInt(
+		"BATCH_MAX_IN_FLIGHT_OBJECTS",
+		func(val int) { config.BatchBackpressure.MaxInFlightObjects = val },
+		DefaultBatchMaxInFlightObjects,
+	); err != nil {
+		return err
+	}
+	if err := parsePositiveInt(
+		"BATCH_MAX_SUGGESTED_SIZE",
+		func(val int) { config.BatchBackpressure.MaxSuggestedSize = val },
+		DefaultBatchMaxSuggestedSize,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func parseWorkerPoolsEnvVars(wp *WorkerPools) error {
+	for _, pool := range []struct {
+		name string
+		dst  *int
+	}{
+		{"WORKER_POOL_BATCH_SIZE", &wp.BatchWorkers},
+		{"WORKER_POOL_INDEXING_SIZE", &wp.IndexingWorkers},
+		{"WORKER_POOL_QUERY_SIZE", &wp.QueryWorkers},
+	} {
+		dst := pool.dst
+		if err := parsePositiveInt(pool.name, func(val int) { *dst = val }, 0); err != nil {
+			return err
+		}
+	}
+
+	switch v := os.Getenv("WORKER_POOL_PRIORITY"); v {
+	case "":
+		wp.Priority = WorkerPoolPriorityQuery
+	case WorkerPoolPriorityQuery, WorkerPoolPriorityNone:
+		wp.Priority = v
+	default:
+		return errors.Errorf("WORKER_POOL_PRIORITY must be one of %q or %q",
+			WorkerPoolPriorityQuery, WorkerPoolPriorityNone)
+	}
+	return nil
+}
+
+func parseBlobStorageEnvVars(bs *BlobStorage) error {
+	bs.Bucket = os.Getenv("BLOB_STORAGE_BUCKET")
+	if !bs.Enabled() {
+		return nil
+	}
+
+	bs.Endpoint = DefaultBlobStorageEndpoint
+	if v := os.Getenv("BLOB_STORAGE_ENDPOINT"); v != "" {
+		bs.Endpoint = v
+	}
+	bs.Path = os.Getenv("BLOB_STORAGE_PATH")
+	bs.UseSSL = true
+	if v, ok := os.LookupEnv("BLOB_STORAGE_USE_SSL"); ok {
+		bs.UseSSL = enabled(v)
+	}
+
+	if v := os.Getenv("BLOB_STORAGE_INLINE_MAX_BYTES"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("parse BLOB_STORAGE_INLINE_MAX_BYTES as int: %w", err)
+		} else if asInt < 0 {
+			return fmt.Errorf("BLOB_STORAGE_INLINE_MAX_BYTES must not be negative")
+		}
+		bs.InlineMaxBytes = asInt
+	} else {
+		bs.InlineMaxBytes = DefaultBlobStorageInlineMaxBytes
+	}
+	return nil
+}
+
+func parseMeteringEnvVars(m *Metering) error {
+	m.Sink = os.Getenv("METERING_SINK")
+	if !m.Enabled() {
+		return nil
+	}
+
+	scheme, _, ok := strings.Cut(m.Sink, "://")
+	if !ok {
+		return fmt.Errorf("METERING_SINK must be a URL, got %q", m.Sink)
+	}
+	switch scheme {
+	case "file", "s3", "http", "https":
+	default:
+		return fmt.Errorf("METERING_SINK must be a file://, s3:// or http(s):// URL, "+
+			"got scheme %q", scheme)
+	}
+
+	m.S3Endpoint = DefaultMeteringS3Endpoint
+	if v := os.Getenv("METERING_S3_ENDPOINT"); v != "" {
+		m.S3Endpoint = v
+	}
+	m.S3UseSSL = true
+	if v, ok := os.LookupEnv("METERING_S3_USE_SSL"); ok {
+		m.S3UseSSL = enabled(v)
+	}
+
+	return parsePositiveInt(
+		"METERING_INTERVAL_SECONDS",
+		func(val int) { m.IntervalSeconds = val },
+		DefaultMeteringIntervalSeconds,
+	)
+}
+
+func parseSlowQueryLogEnvVars(s *SlowQueryLog) error {
+	s.Path = os.Getenv("SLOW_QUERY_LOG_PATH")
+	if !s.Enabled() {
+		return nil
+	}
+
+	if err := parsePositiveInt(
+		"SLOW_QUERY_LOG_THRESHOLD_MS",
+		func(val int) { s.ThresholdMs = val },
+		DefaultSlowQueryLogThresholdMs,
+	); err != nil {
+		return err
+	}
+
+	return parsePositiveInt(
+		"SLOW_QUERY_LOG_MAX_SIZE_MB",
+		func(val int) { s.MaxSizeMB = val },
+		DefaultSlowQueryLogMaxSizeMB,
+	)
+}
+
+func parseQueryAdmissionEnvVars(qa *QueryAdmission) error {
+	if err := parsePositiveInt(
+		"QUERY_ADMISSION_MAX_CONCURRENT",
+		func(val int) { qa.MaxConcurrent = val },
+		0,
+	); err != nil {
+		return err
+	}
+
+	// e.g. "Article:4,Product:16"
+	if v := os.Getenv("QUERY_ADMISSION_CLASS_LIMITS"); v != "" {
+		qa.ClassLimits = map[string]int{}
+		for _, pair := range strings.Split(v, ",") {
+			if pair = strings.TrimSpace(pair); pair == "" {
+				continue
+			}
+			class, limit, ok := strings.Cut(pair, ":")
+			if !ok {
+				return errors.Errorf("parse QUERY_ADMISSION_CLASS_LIMITS: "+
+					"expected <class>:<limit>, got %q", pair)
+			}
+			asInt, err := strconv.Atoi(strings.TrimSpace(limit))
+			if err != nil || asInt < 0 {
+				return errors.Errorf("parse QUERY_ADMISSION_CLASS_LIMITS: "+
+					"limit of class %q must be a non-negative int", class)
+			}
+			qa.ClassLimits[strings.TrimSpace(class)] = asInt
+		}
+	}
+
+	if err := parsePositiveInt(
+		"QUERY_ADMISSION_QUEUE_SIZE",
+		func(val int) { qa.QueueSize = val },
+		DefaultQueryAdmissionQueueSize,
+	); err != nil {
+		return err
+	}
+
+	return parsePositiveInt(
+		"QUERY_ADMISSION_QUEUE_TIMEOUT_SECONDS",
+		func(val int) { qa.QueueTimeoutSeconds = val },
+		DefaultQueryAdmissionQueueTimeoutSeconds,
+	)
+}
+
+func parseGraphQLLimitsEnvVars(gl *GraphQLLimits) error {
+	if err := parsePositiveInt(
+		"GRAPHQL_MAX_DEPTH",
+		func(val int) { gl.MaxDepth = val },
+		0,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"GRAPHQL_MAX_ALIASES",
+		func(val int) { gl.MaxAliases = val },
+		0,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"GRAPHQL_MAX_COMPLEXITY",
+		func(val int) { gl.MaxComplexity = val },
+		0,
+	); err != nil {
+		return err
+	}
+
+	// e.g. "etl-key:depth=20;complexity=0,reader:aliases=10", the limits which
+	// are not listed are the ones of all users
+	v := os.Getenv("GRAPHQL_KEY_LIMITS")
+	if v == "" {
+		return nil
+	}
+	gl.KeyLimits = map[string]GraphQLLimit{}
+	for _, entry := range strings.Split(v, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		user, settings, ok := strings.Cut(entry, ":")
+		if !ok {
+			return errors.Errorf("parse GRAPHQL_KEY_LIMITS: "+
+				"expected <user>:<limit>=<value>;..., got %q", entry)
+		}
+		user = strings.TrimSpace(user)
+		limit := gl.Limit("")
+		for _, setting := range strings.Split(settings, ";") {
+			name, value, ok := strings.Cut(setting, "=")
+			asInt, err := strconv.Atoi(strings.TrimSpace(value))
+			if !ok || err != nil || asInt < 0 {
+				return errors.Errorf("parse GRAPHQL_KEY_LIMITS: limit %q of user %q "+
+					"must be a non-negative int", setting, user)
+			}
+			switch strings.TrimSpace(name) {
+			case "depth":
+				limit.MaxDepth = asInt
+			case "aliases":
+				limit.MaxAliases = asInt
+			case "complexity":
+				limit.MaxComplexity = asInt
+			default:
+				return errors.Errorf("parse GRAPHQL_KEY_LIMITS: unknown limit %q of user %q, "+
+					"expected depth, aliases or complexity", name, user)
+			}
+		}
+		gl.KeyLimits[user] = limit
+	}
 	return nil
 }
 
@@ -284,9 +752,117 @@ func (c *Config) parseMemtableConfig() error {
 		return err
 	}
 
+	if err := parsePositiveInt(
+		"PERSISTENCE_QUARANTINE_AFTER_WRITE_ERRORS",
+		func(val int) { c.Persistence.QuarantineAfterWriteErrors = val },
+		0,
+	); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+func (c *Config) parseRemoteSegmentsConfig() error {
+	rs := &c.Persistence.RemoteSegments
+	rs.Bucket = os.Getenv("PERSISTENCE_REMOTE_SEGMENTS_BUCKET")
+	if !rs.Enabled() {
+		return nil
+	}
+
+	rs.Endpoint = DefaultRemoteSegmentsEndpoint
+	if v := os.Getenv("PERSISTENCE_REMOTE_SEGMENTS_ENDPOINT"); v != "" {
+		rs.Endpoint = v
+	}
+	rs.Path = os.Getenv("PERSISTENCE_REMOTE_SEGMENTS_PATH")
+	rs.UseSSL = true
+	if v, ok := os.LookupEnv("PERSISTENCE_REMOTE_SEGMENTS_USE_SSL"); ok {
+		rs.UseSSL = enabled(v)
+	}
+	if v := os.Getenv("PERSISTENCE_REMOTE_SEGMENTS_CLASSES"); v != "" {
+		rs.Classes = strings.Split(v, ",")
+	}
+
+	if err := parsePositiveInt(
+		"PERSISTENCE_REMOTE_SEGMENTS_CACHE_MB",
+		func(val int) { rs.CacheSizeMB = val },
+		DefaultRemoteSegmentsCacheSizeMB,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"PERSISTENCE_REMOTE_SEGMENTS_KEEP_LOCAL",
+		func(val int) { rs.KeepLocalSegments = val },
+		DefaultRemoteSegmentsKeepLocal,
+	); err != nil {
+		return err
+	}
+
+	return parsePositiveInt(
+		"PERSISTENCE_REMOTE_SEGMENTS_MIN_AGE_SECONDS",
+		func(val int) { rs.MinAgeSeconds = val },
+		DefaultRemoteSegmentsMinAgeSeconds,
+	)
+}
+
+func (c *Config) parseOffloadConfig() {
+	o := &c.Persistence.Offload
+	o.Bucket = os.Getenv("PERSISTENCE_OFFLOAD_BUCKET")
+	if !o.Enabled() {
+		return
+	}
+
+	o.Endpoint = DefaultOffloadEndpoint
+	if v := os.Getenv("PERSISTENCE_OFFLOAD_ENDPOINT"); v != "" {
+		o.Endpoint = v
+	}
+	o.Path = os.Getenv("PERSISTENCE_OFFLOAD_PATH")
+	o.UseSSL = true
+	if v, ok := os.LookupEnv("PERSISTENCE_OFFLOAD_USE_SSL"); ok {
+		o.UseSSL = enabled(v)
+	}
+}
+
+func (c *Config) parseCompactionConfig() error {
+	cc := &c.Persistence.Compaction
+	cc.QuietHours = os.Getenv("PERSISTENCE_COMPACTION_QUIET_HOURS")
+
+	if err := parsePositiveInt(
+		"PERSISTENCE_COMPACTION_MAX_CONCURRENT",
+		func(val int) { cc.MaxConcurrent = val },
+		0,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"PERSISTENCE_COMPACTION_MAX_CONCURRENT_PER_SHARD",
+		func(val int) { cc.MaxConcurrentPerShard = val },
+		0,
+	); err != nil {
+		return err
+	}
+
+	return parsePositiveInt(
+		"PERSISTENCE_COMPACTION_MAX_MB_PER_SECOND",
+		func(val int) { cc.MaxMBPerSecond = val },
+		0,
+	)
+}
+
+func (c *Config) parseWALCompressionConfig() error {
+	v := strings.ToLower(os.Getenv("PERSISTENCE_WAL_COMPRESSION"))
+	switch v {
+	case "", "none", "snappy", "zstd":
+		c.Persistence.WALCompression = v
+		return nil
+	default:
+		return errors.Errorf("parse PERSISTENCE_WAL_COMPRESSION: unknown codec %q, "+
+			"expected none, snappy or zstd", v)
+	}
+}
+
 func parsePositiveInt(varName string, cb func(val int), defaultValue int) error {
 	if v := os.Getenv(varName); v != "" {
 		asInt, err := strconv.Atoi(v)
@@ -306,6 +882,12 @@ func parsePositiveInt(varName string, cb func(val int), defaultValue int) error
 
 const DefaultQueryMaximumResults = int64(10000)
 
+// DefaultQueryMaximumVectorCursorDepth is the maximum number of results a
+// shard may have returned to the previous pages of a vector cursor. The
+// vector index can't resume a search, so every page searches through the
+// results of the previous pages again.
+const DefaultQueryMaximumVectorCursorDepth = int64(100000)
+
 const (
 	DefaultPersistenceFlushIdleMemtablesAfter = 60
 	DefaultPersistenceMemtablesMaxSize        = 200
@@ -314,6 +896,52 @@ const (
 	DefaultMaxConcurrentGetRequests           = 0
 )
 
+const DefaultReplicationConsistencyCheckSampleSize = 100
+
+// DefaultReplicationAntiEntropyTreeHeight splits a shard into 1024 ranges
+const DefaultReplicationAntiEntropyTreeHeight = 10
+
+// maxReplicationAntiEntropyTreeHeight matches replica.MaxHashTreeHeight,
+// which isn't imported to keep the config free of dependencies
+const maxReplicationAntiEntropyTreeHeight = 16
+
+const DefaultAsyncIndexingBatchSize = 1000
+
+// DefaultStartupShardLoadParallelism loads the shards one after another
+const DefaultStartupShardLoadParallelism = 1
+
+// DefaultShutdownDrainTimeoutSeconds leaves 5s of the default graceful
+// timeout of 15s to shut the HTTP servers down
+const DefaultShutdownDrainTimeoutSeconds = 10
+
+// DefaultGRPCMaxMessageSizeBytes allows for batches of a few thousand
+// objects per message
+const DefaultGRPCMaxMessageSizeBytes = 64 * 1024 * 1024
+
+const (
+	DefaultBlobStorageEndpoint       = "s3.amazonaws.com"
+	DefaultBlobStorageInlineMaxBytes = 64 * 1024
+)
+
+const (
+	DefaultSlowQueryLogThresholdMs = 1000
+	DefaultSlowQueryLogMaxSizeMB   = 100
+)
+
+const (
+	DefaultMeteringIntervalSeconds = 3600
+	DefaultMeteringS3Endpoint      = "s3.amazonaws.com"
+)
+
+const (
+	DefaultRemoteSegmentsEndpoint      = "s3.amazonaws.com"
+	DefaultRemoteSegmentsCacheSizeMB   = 256
+	DefaultRemoteSegmentsKeepLocal     = 2
+	DefaultRemoteSegmentsMinAgeSeconds = 3600
+)
+
+const DefaultOffloadEndpoint = "s3.amazonaws.com"
+
 const VectorizerModuleNone = "none"
 
 // DefaultGossipBindPort uses the hashicorp/memberlist default
@@ -338,6 +966,17 @@ func enabled(value string) bool {
 	return false
 }
 
+// parseNetworkAccessRules reads the comma-separated CIDR lists of a listener
+// from NETWORK_ACCESS_<LISTENER>_ALLOW and NETWORK_ACCESS_<LISTENER>_DENY
+func parseNetworkAccessRules(listener string, rules *ipfilter.Rules) {
+	if v, ok := os.LookupEnv(fmt.Sprintf("NETWORK_ACCESS_%s_ALLOW", listener)); ok {
+		rules.Allow = strings.Split(v, ",")
+	}
+	if v, ok := os.LookupEnv(fmt.Sprintf("NETWORK_ACCESS_%s_DENY", listener)); ok {
+		rules.Deny = strings.Split(v, ",")
+	}
+}
+
 func parseResourceUsageEnvVars() (ResourceUsage, error) {
 	ru := ResourceUsage{}
 
@@ -361,6 +1000,14 @@ func parseResourceUsageEnvVars() (ResourceUsage, error) {
 		ru.DiskUse.ReadOnlyPercentage = DefaultDiskUseReadonlyPercentage
 	}
 
+	if v := os.Getenv("DISK_USE_READONLY_RECOVERY_PERCENTAGE"); v != "" {
+		asUint, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return ru, errors.Wrapf(err, "parse DISK_USE_READONLY_RECOVERY_PERCENTAGE as uint")
+		}
+		ru.DiskUse.ReadOnlyRecoveryPercentage = asUint
+	}
+
 	if v := os.Getenv("MEMORY_WARNING_PERCENTAGE"); v != "" {
 		asUint, err := strconv.ParseUint(v, 10, 64)
 		if err != nil {
@@ -381,6 +1028,38 @@ func parseResourceUsageEnvVars() (ResourceUsage, error) {
 		ru.MemUse.ReadOnlyPercentage = DefaultMemUseReadonlyPercentage
 	}
 
+	if v := os.Getenv("MEMORY_DEGRADE_PERCENTAGE"); v != "" {
+		asUint, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return ru, errors.Wrapf(err, "parse MEMORY_DEGRADE_PERCENTAGE as uint")
+		}
+		ru.MemUse.DegradePercentage = asUint
+	}
+
+	if v := os.Getenv("MEMORY_DEGRADE_CLASSES"); v != "" {
+		for _, class := range strings.Split(v, ",") {
+			if class = strings.TrimSpace(class); class != "" {
+				ru.MemUse.DegradeClasses = append(ru.MemUse.DegradeClasses, class)
+			}
+		}
+	}
+
+	if err := parsePositiveInt(
+		"MEMORY_DEGRADE_EF",
+		func(val int) { ru.MemUse.DegradeEF = val },
+		DefaultMemUseDegradeEF,
+	); err != nil {
+		return ru, err
+	}
+
+	if err := parsePositiveInt(
+		"MEMORY_DEGRADE_MAX_LIMIT",
+		func(val int) { ru.MemUse.DegradeMaxLimit = val },
+		DefaultMemUseDegradeMaxLimit,
+	); err != nil {
+		return ru, err
+	}
+
 	return ru, nil
 }
 
@@ -423,5 +1102,13 @@ func parseClusterConfig() (cluster.Config, error) {
 	cfg.IgnoreStartupSchemaSync = enabled(
 		os.Getenv("CLUSTER_IGNORE_SCHEMA_SYNC"))
 
+	if v := os.Getenv("CLUSTER_NODE_LABELS"); v != "" {
+		labels, err := cluster.ParseLabels(v)
+		if err != nil {
+			return cfg, fmt.Errorf("parse CLUSTER_NODE_LABELS: %w", err)
+		}
+		cfg.Labels = labels
+	}
+
 	return cfg, nil
 }
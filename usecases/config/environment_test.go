@@ -344,3 +344,542 @@ func TestEnvironmentMaxConcurrentGetRequests(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvironmentQueryAdmissionClassLimits(t *testing.T) {
+	factors := []struct {
+		name        string
+		value       []string
+		expected    map[string]int
+		expectedErr bool
+	}{
+		{"Valid", []string{"Article:4, Product:16"}, map[string]int{"Article": 4, "Product": 16}, false},
+		{"not given", []string{}, nil, false},
+		{"missing limit", []string{"Article"}, nil, true},
+		{"not parsable", []string{"Article:four"}, nil, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.value) == 1 {
+				t.Setenv("QUERY_ADMISSION_CLASS_LIMITS", tt.value[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expected, conf.QueryAdmission.ClassLimits)
+				require.Equal(t, DefaultQueryAdmissionQueueSize, conf.QueryAdmission.QueueSize)
+			}
+		})
+	}
+}
+
+func TestEnvironmentRemoteSegments(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.False(t, conf.Persistence.RemoteSegments.Enabled())
+		assert.False(t, conf.Persistence.RemoteSegments.EnabledFor("Article"))
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_REMOTE_SEGMENTS_BUCKET", "segments")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, RemoteSegments{
+			Bucket:            "segments",
+			Endpoint:          DefaultRemoteSegmentsEndpoint,
+			UseSSL:            true,
+			CacheSizeMB:       DefaultRemoteSegmentsCacheSizeMB,
+			KeepLocalSegments: DefaultRemoteSegmentsKeepLocal,
+			MinAgeSeconds:     DefaultRemoteSegmentsMinAgeSeconds,
+		}, conf.Persistence.RemoteSegments)
+		assert.True(t, conf.Persistence.RemoteSegments.EnabledFor("Article"))
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_REMOTE_SEGMENTS_BUCKET", "segments")
+		t.Setenv("PERSISTENCE_REMOTE_SEGMENTS_ENDPOINT", "minio:9000")
+		t.Setenv("PERSISTENCE_REMOTE_SEGMENTS_PATH", "prod")
+		t.Setenv("PERSISTENCE_REMOTE_SEGMENTS_USE_SSL", "false")
+		t.Setenv("PERSISTENCE_REMOTE_SEGMENTS_CACHE_MB", "64")
+		t.Setenv("PERSISTENCE_REMOTE_SEGMENTS_KEEP_LOCAL", "4")
+		t.Setenv("PERSISTENCE_REMOTE_SEGMENTS_MIN_AGE_SECONDS", "60")
+		t.Setenv("PERSISTENCE_REMOTE_SEGMENTS_CLASSES", "Article,Log")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, RemoteSegments{
+			Bucket:            "segments",
+			Endpoint:          "minio:9000",
+			Path:              "prod",
+			UseSSL:            false,
+			CacheSizeMB:       64,
+			KeepLocalSegments: 4,
+			MinAgeSeconds:     60,
+			Classes:           []string{"Article", "Log"},
+		}, conf.Persistence.RemoteSegments)
+		assert.True(t, conf.Persistence.RemoteSegments.EnabledFor("Log"))
+		assert.False(t, conf.Persistence.RemoteSegments.EnabledFor("Product"))
+	})
+
+	t.Run("invalid cache size", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_REMOTE_SEGMENTS_BUCKET", "segments")
+		t.Setenv("PERSISTENCE_REMOTE_SEGMENTS_CACHE_MB", "0")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentCompaction(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, Compaction{}, conf.Persistence.Compaction)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_COMPACTION_MAX_CONCURRENT", "4")
+		t.Setenv("PERSISTENCE_COMPACTION_MAX_CONCURRENT_PER_SHARD", "1")
+		t.Setenv("PERSISTENCE_COMPACTION_MAX_MB_PER_SECOND", "50")
+		t.Setenv("PERSISTENCE_COMPACTION_QUIET_HOURS", "22:00-06:00")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, Compaction{
+			MaxConcurrent:         4,
+			MaxConcurrentPerShard: 1,
+			MaxMBPerSecond:        50,
+			QuietHours:            "22:00-06:00",
+		}, conf.Persistence.Compaction)
+	})
+
+	t.Run("invalid concurrency", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_COMPACTION_MAX_CONCURRENT", "-1")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentShutdown(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, DefaultShutdownDrainTimeoutSeconds, conf.Shutdown.DrainTimeoutSeconds)
+	})
+
+	t.Run("drain timeout", func(t *testing.T) {
+		t.Setenv("SHUTDOWN_DRAIN_TIMEOUT_SECONDS", "30")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, 30, conf.Shutdown.DrainTimeoutSeconds)
+	})
+
+	t.Run("invalid drain timeout", func(t *testing.T) {
+		t.Setenv("SHUTDOWN_DRAIN_TIMEOUT_SECONDS", "0")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentGRPC(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.False(t, conf.GRPC.Enabled())
+		assert.Equal(t, DefaultGRPCMaxMessageSizeBytes, conf.GRPC.MaxMessageSizeBytes)
+	})
+
+	t.Run("port given", func(t *testing.T) {
+		t.Setenv("GRPC_PORT", "50051")
+		t.Setenv("GRPC_MAX_MESSAGE_SIZE_BYTES", "1024")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.True(t, conf.GRPC.Enabled())
+		assert.Equal(t, GRPC{Port: 50051, MaxMessageSizeBytes: 1024}, conf.GRPC)
+	})
+
+	t.Run("invalid port", func(t *testing.T) {
+		t.Setenv("GRPC_PORT", "70000")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentGraphQLLimits(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, GraphQLLimits{}, conf.GraphQLLimits)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		t.Setenv("GRAPHQL_MAX_DEPTH", "10")
+		t.Setenv("GRAPHQL_MAX_ALIASES", "20")
+		t.Setenv("GRAPHQL_MAX_COMPLEXITY", "5000")
+		t.Setenv("GRAPHQL_KEY_LIMITS", "etl:depth=20;complexity=0, reader:aliases=5")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, GraphQLLimits{
+			MaxDepth:      10,
+			MaxAliases:    20,
+			MaxComplexity: 5000,
+			KeyLimits: map[string]GraphQLLimit{
+				"etl":    {MaxDepth: 20, MaxAliases: 20, MaxComplexity: 0},
+				"reader": {MaxDepth: 10, MaxAliases: 5, MaxComplexity: 5000},
+			},
+		}, conf.GraphQLLimits)
+
+		assert.Equal(t, GraphQLLimit{MaxDepth: 10, MaxAliases: 20, MaxComplexity: 5000},
+			conf.GraphQLLimits.Limit("anyone"))
+		assert.Equal(t, GraphQLLimit{MaxDepth: 20, MaxAliases: 20},
+			conf.GraphQLLimits.Limit("etl"))
+	})
+
+	t.Run("invalid key limits", func(t *testing.T) {
+		for _, v := range []string{"etl", "etl:depth", "etl:depth=-1", "etl:width=3"} {
+			t.Setenv("GRAPHQL_KEY_LIMITS", v)
+			conf := Config{}
+			assert.NotNil(t, FromEnv(&conf), v)
+		}
+	})
+}
+
+func TestEnvironmentStartup(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, Startup{
+			ShardLoadParallelism: DefaultStartupShardLoadParallelism,
+		}, conf.Startup)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		t.Setenv("STARTUP_SHARD_LOAD_PARALLELISM", "8")
+		t.Setenv("STARTUP_LAZY_LOADING", "true")
+		t.Setenv("STARTUP_PRIORITY_CLASSES", "Article, Product,")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, Startup{
+			ShardLoadParallelism: 8,
+			LazyLoading:          true,
+			PriorityClasses:      []string{"Article", "Product"},
+		}, conf.Startup)
+	})
+
+	t.Run("invalid parallelism", func(t *testing.T) {
+		t.Setenv("STARTUP_SHARD_LOAD_PARALLELISM", "0")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentWorkerPools(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, WorkerPools{
+			Priority: WorkerPoolPriorityQuery,
+		}, conf.WorkerPools)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		t.Setenv("WORKER_POOL_BATCH_SIZE", "2")
+		t.Setenv("WORKER_POOL_INDEXING_SIZE", "6")
+		t.Setenv("WORKER_POOL_QUERY_SIZE", "16")
+		t.Setenv("WORKER_POOL_PRIORITY", "none")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, WorkerPools{
+			BatchWorkers:    2,
+			IndexingWorkers: 6,
+			QueryWorkers:    16,
+			Priority:        WorkerPoolPriorityNone,
+		}, conf.WorkerPools)
+	})
+
+	t.Run("invalid size", func(t *testing.T) {
+		t.Setenv("WORKER_POOL_QUERY_SIZE", "-1")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+
+	t.Run("invalid priority", func(t *testing.T) {
+		t.Setenv("WORKER_POOL_PRIORITY", "import")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentBlobStorage(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.False(t, conf.BlobStorage.Enabled())
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Setenv("BLOB_STORAGE_BUCKET", "blobs")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, BlobStorage{
+			Bucket:         "blobs",
+			Endpoint:       DefaultBlobStorageEndpoint,
+			UseSSL:         true,
+			InlineMaxBytes: DefaultBlobStorageInlineMaxBytes,
+		}, conf.BlobStorage)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		t.Setenv("BLOB_STORAGE_BUCKET", "blobs")
+		t.Setenv("BLOB_STORAGE_ENDPOINT", "minio:9000")
+		t.Setenv("BLOB_STORAGE_PATH", "weaviate")
+		t.Setenv("BLOB_STORAGE_USE_SSL", "false")
+		t.Setenv("BLOB_STORAGE_INLINE_MAX_BYTES", "0")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, BlobStorage{
+			Bucket:   "blobs",
+			Endpoint: "minio:9000",
+			Path:     "weaviate",
+		}, conf.BlobStorage)
+	})
+
+	t.Run("invalid inline size", func(t *testing.T) {
+		t.Setenv("BLOB_STORAGE_BUCKET", "blobs")
+		t.Setenv("BLOB_STORAGE_INLINE_MAX_BYTES", "-1")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentMetering(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.False(t, conf.Metering.Enabled())
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Setenv("METERING_SINK", "file:///var/lib/weaviate-usage")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, Metering{
+			Sink:            "file:///var/lib/weaviate-usage",
+			IntervalSeconds: DefaultMeteringIntervalSeconds,
+			S3Endpoint:      DefaultMeteringS3Endpoint,
+			S3UseSSL:        true,
+		}, conf.Metering)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		t.Setenv("METERING_SINK", "s3://usage/weaviate")
+		t.Setenv("METERING_INTERVAL_SECONDS", "300")
+		t.Setenv("METERING_S3_ENDPOINT", "minio:9000")
+		t.Setenv("METERING_S3_USE_SSL", "false")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, Metering{
+			Sink:            "s3://usage/weaviate",
+			IntervalSeconds: 300,
+			S3Endpoint:      "minio:9000",
+		}, conf.Metering)
+	})
+
+	t.Run("invalid sink", func(t *testing.T) {
+		for _, sink := range []string{"/var/lib/weaviate-usage", "ftp://usage"} {
+			t.Setenv("METERING_SINK", sink)
+			conf := Config{}
+			require.NotNil(t, FromEnv(&conf))
+		}
+	})
+}
+
+func TestEnvironmentSlowQueryLog(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.False(t, conf.SlowQueryLog.Enabled())
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Setenv("SLOW_QUERY_LOG_PATH", "/var/lib/weaviate/slow-queries.log")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, SlowQueryLog{
+			Path:        "/var/lib/weaviate/slow-queries.log",
+			ThresholdMs: DefaultSlowQueryLogThresholdMs,
+			MaxSizeMB:   DefaultSlowQueryLogMaxSizeMB,
+		}, conf.SlowQueryLog)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		t.Setenv("SLOW_QUERY_LOG_PATH", "/var/lib/weaviate/slow-queries.log")
+		t.Setenv("SLOW_QUERY_LOG_THRESHOLD_MS", "1")
+		t.Setenv("SLOW_QUERY_LOG_MAX_SIZE_MB", "10")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, 1, conf.SlowQueryLog.ThresholdMs)
+		assert.Equal(t, 10, conf.SlowQueryLog.MaxSizeMB)
+	})
+
+	t.Run("invalid threshold", func(t *testing.T) {
+		t.Setenv("SLOW_QUERY_LOG_PATH", "/var/lib/weaviate/slow-queries.log")
+		t.Setenv("SLOW_QUERY_LOG_THRESHOLD_MS", "-1")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentWALCompression(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, "", conf.Persistence.WALCompression)
+	})
+
+	t.Run("given", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_WAL_COMPRESSION", "ZSTD")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, "zstd", conf.Persistence.WALCompression)
+	})
+
+	t.Run("unknown codec", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_WAL_COMPRESSION", "gzip")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentReplicationConsistencyCheck(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, 0, conf.Replication.ConsistencyCheckIntervalSeconds)
+		assert.Equal(t, DefaultReplicationConsistencyCheckSampleSize,
+			conf.Replication.ConsistencyCheckSampleSize)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		t.Setenv("REPLICATION_CONSISTENCY_CHECK_INTERVAL_SECONDS", "600")
+		t.Setenv("REPLICATION_CONSISTENCY_CHECK_SAMPLE_SIZE", "25")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, 600, conf.Replication.ConsistencyCheckIntervalSeconds)
+		assert.Equal(t, 25, conf.Replication.ConsistencyCheckSampleSize)
+	})
+
+	t.Run("invalid sample size", func(t *testing.T) {
+		t.Setenv("REPLICATION_CONSISTENCY_CHECK_SAMPLE_SIZE", "0")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentReplicationAntiEntropy(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, 0, conf.Replication.AntiEntropyIntervalSeconds)
+		assert.Equal(t, DefaultReplicationAntiEntropyTreeHeight,
+			conf.Replication.AntiEntropyTreeHeight)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		t.Setenv("REPLICATION_ANTI_ENTROPY_INTERVAL_SECONDS", "3600")
+		t.Setenv("REPLICATION_ANTI_ENTROPY_TREE_HEIGHT", "12")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, 3600, conf.Replication.AntiEntropyIntervalSeconds)
+		assert.Equal(t, 12, conf.Replication.AntiEntropyTreeHeight)
+	})
+
+	t.Run("tree too high", func(t *testing.T) {
+		t.Setenv("REPLICATION_ANTI_ENTROPY_TREE_HEIGHT", "17")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentReplicationRebalance(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, 0, conf.Replication.RebalanceIntervalSeconds)
+		assert.Equal(t, 0, conf.Replication.TransferMaxBytesPerSecond)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		t.Setenv("REPLICATION_REBALANCE_INTERVAL_SECONDS", "600")
+		t.Setenv("REPLICATION_TRANSFER_MAX_BYTES_PER_SECOND", "1048576")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, 600, conf.Replication.RebalanceIntervalSeconds)
+		assert.Equal(t, 1048576, conf.Replication.TransferMaxBytesPerSecond)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Setenv("REPLICATION_TRANSFER_MAX_BYTES_PER_SECOND", "-1")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentAsyncIndexing(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, AsyncIndexing{BatchSize: DefaultAsyncIndexingBatchSize},
+			conf.AsyncIndexing)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		t.Setenv("ASYNC_INDEXING", "true")
+		t.Setenv("ASYNC_INDEXING_BATCH_SIZE", "250")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, AsyncIndexing{Enabled: true, BatchSize: 250}, conf.AsyncIndexing)
+	})
+
+	t.Run("invalid batch size", func(t *testing.T) {
+		t.Setenv("ASYNC_INDEXING_BATCH_SIZE", "-1")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentQueryTimeout(t *testing.T) {
+	factors := []struct {
+		name           string
+		maxDuration    []string
+		partialResults []string
+		expected       QueryTimeout
+		expectedErr    bool
+	}{
+		{"not given", []string{}, []string{}, QueryTimeout{}, false},
+		{"Valid", []string{"1500"}, []string{}, QueryTimeout{MaxDurationMs: 1500}, false},
+		{"partial results", []string{"200"}, []string{"true"}, QueryTimeout{MaxDurationMs: 200, PartialResults: true}, false},
+		{"negative", []string{"-1"}, []string{}, QueryTimeout{}, true},
+		{"not parsable", []string{"1s"}, []string{}, QueryTimeout{}, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.maxDuration) == 1 {
+				t.Setenv("QUERY_TIMEOUT_MAX_DURATION_MS", tt.maxDuration[0])
+			}
+			if len(tt.partialResults) == 1 {
+				t.Setenv("QUERY_TIMEOUT_PARTIAL_RESULTS", tt.partialResults[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expected, conf.QueryTimeout)
+			}
+		})
+	}
+}
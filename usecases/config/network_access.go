@@ -0,0 +1,39 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/usecases/network/ipfilter"
+)
+
+// NetworkAccess contains the CIDR-based allow and deny rules for each of the
+// listeners
+type NetworkAccess struct {
+	API     ipfilter.Rules `json:"api" yaml:"api"`
+	Metrics ipfilter.Rules `json:"metrics" yaml:"metrics"`
+	Cluster ipfilter.Rules `json:"cluster" yaml:"cluster"`
+}
+
+func (n NetworkAccess) Validate() error {
+	if err := n.API.Validate(); err != nil {
+		return fmt.Errorf("network access: api: %w", err)
+	}
+	if err := n.Metrics.Validate(); err != nil {
+		return fmt.Errorf("network access: metrics: %w", err)
+	}
+	if err := n.Cluster.Validate(); err != nil {
+		return fmt.Errorf("network access: cluster: %w", err)
+	}
+	return nil
+}
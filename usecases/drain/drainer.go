@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package drain tracks in-flight requests, so they can complete before the
+// node shuts down
+package drain
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Drainer counts the in-flight requests. Once draining has started, new
+// requests are rejected and Drain waits for the in-flight ones.
+type Drainer struct {
+	lock     sync.Mutex
+	draining bool
+	inFlight int
+	// idle is closed once the drainer is draining and no request is in
+	// flight anymore
+	idle chan struct{}
+}
+
+func New() *Drainer {
+	return &Drainer{idle: make(chan struct{})}
+}
+
+// Begin registers a request. It returns false if the drainer is draining,
+// otherwise done has to be called once the request is complete.
+func (d *Drainer) Begin() (done func(), ok bool) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.draining {
+		return nil, false
+	}
+	d.inFlight++
+	return d.done, true
+}
+
+// Track registers a request even if the drainer is draining. It is used for
+// requests which must not fail during a shutdown, such as those of other
+// nodes of the cluster.
+func (d *Drainer) Track() (done func()) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.inFlight++
+	return d.done
+}
+
+func (d *Drainer) done() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.inFlight--
+	if d.draining && d.inFlight == 0 {
+		d.closeIdle()
+	}
+}
+
+// must be called with the lock held
+func (d *Drainer) closeIdle() {
+	select {
+	case <-d.idle:
+	default:
+		close(d.idle)
+	}
+}
+
+// Draining is true once Drain was called
+func (d *Drainer) Draining() bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	return d.draining
+}
+
+// InFlight returns the number of requests in flight
+func (d *Drainer) InFlight() int {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	return d.inFlight
+}
+
+// Drain rejects all new requests and waits until the in-flight requests are
+// complete or the context expires. In the latter case it returns the error
+// of the context.
+func (d *Drainer) Drain(ctx context.Context) error {
+	d.lock.Lock()
+	d.draining = true
+	if d.inFlight == 0 {
+		d.closeIdle()
+	}
+	d.lock.Unlock()
+
+	select {
+	case <-d.idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Middleware rejects new requests with 503 Service Unavailable while the
+// drainer is draining, so load balancers retry them on other nodes. The
+// liveness and readiness probes are never rejected.
+func (d *Drainer) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/v1/.well-known/") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		done, ok := d.Begin()
+		if !ok {
+			w.Header().Set("Connection", "close")
+			w.Header().Set("Retry-After", "1")
+			http.Error(w, "node is shutting down", http.StatusServiceUnavailable)
+			return
+		}
+		defer done()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// TrackingMiddleware tracks requests without rejecting any, see Track
+func (d *Drainer) TrackingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer d.Track()()
+		next.ServeHTTP(w, r)
+	})
+}
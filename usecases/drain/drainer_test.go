@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package drain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrainer(t *testing.T) {
+	t.Run("drain without requests in flight", func(t *testing.T) {
+		d := New()
+		require.Nil(t, d.Drain(context.Background()))
+		assert.True(t, d.Draining())
+	})
+
+	t.Run("drain waits for the requests in flight", func(t *testing.T) {
+		d := New()
+		done, ok := d.Begin()
+		require.True(t, ok)
+
+		drained := make(chan error)
+		go func() { drained <- d.Drain(context.Background()) }()
+
+		select {
+		case <-drained:
+			t.Fatal("drained with a request in flight")
+		case <-time.After(20 * time.Millisecond):
+		}
+
+		_, ok = d.Begin()
+		assert.False(t, ok, "new requests are rejected")
+		trackDone := d.Track()
+		assert.Equal(t, 2, d.InFlight())
+
+		done()
+		trackDone()
+		assert.Nil(t, <-drained)
+		assert.Equal(t, 0, d.InFlight())
+	})
+
+	t.Run("drain is bounded by the context", func(t *testing.T) {
+		d := New()
+		_, ok := d.Begin()
+		require.True(t, ok)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, d.Drain(ctx), context.DeadlineExceeded)
+		assert.Equal(t, 1, d.InFlight())
+	})
+}
+
+func TestDrainerMiddleware(t *testing.T) {
+	d := New()
+	handler := d.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	serve := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+
+	assert.Equal(t, http.StatusOK, serve("/v1/objects").Code)
+
+	require.Nil(t, d.Drain(context.Background()))
+	res := serve("/v1/objects")
+	assert.Equal(t, http.StatusServiceUnavailable, res.Code)
+	assert.Equal(t, "close", res.Header().Get("Connection"))
+	assert.Equal(t, http.StatusOK, serve("/v1/.well-known/live").Code)
+}
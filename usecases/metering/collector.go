@@ -0,0 +1,221 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package metering aggregates the usage of a node per class, shard and user
+// and periodically exports it as usage records, e.g. for billing
+package metering
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// Operations which are counted per class and user
+const (
+	OperationCreate      = "create"
+	OperationGet         = "get"
+	OperationUpdate      = "update"
+	OperationDelete      = "delete"
+	OperationBatchCreate = "batch_create"
+	OperationBatchDelete = "batch_delete"
+	OperationQuery       = "query"
+	OperationAggregate   = "aggregate"
+)
+
+// Record is the usage of a node during one export period
+type Record struct {
+	Node        string           `json:"node"`
+	PeriodStart time.Time        `json:"periodStart"`
+	PeriodEnd   time.Time        `json:"periodEnd"`
+	Operations  []OperationUsage `json:"operations"`
+	Storage     []StorageUsage   `json:"storage"`
+}
+
+// OperationUsage is the number of objects a user created, read, updated or
+// deleted with an operation on a class during the period
+type OperationUsage struct {
+	Class     string `json:"class"`
+	User      string `json:"user"`
+	Operation string `json:"operation"`
+	Count     int64  `json:"count"`
+}
+
+// StorageUsage is the size of a shard at the end of the period
+type StorageUsage struct {
+	Class            string `json:"class"`
+	Shard            string `json:"shard"`
+	Objects          int64  `json:"objects"`
+	VectorDimensions int64  `json:"vectorDimensions"`
+	Bytes            int64  `json:"bytes"`
+}
+
+// Sink receives the usage records, see adapters/repos/usageexport
+type Sink interface {
+	Export(ctx context.Context, record Record) error
+}
+
+// StorageSource reports the size of the local shards
+type StorageSource interface {
+	StorageUsage(ctx context.Context) ([]StorageUsage, error)
+}
+
+type operationKey struct {
+	class     string
+	user      string
+	operation string
+}
+
+// Collector counts operations as they happen and exports them together with
+// the storage used at the end of each period. Operations of a period whose
+// export fails are carried over to the next period, so nothing is lost.
+type Collector struct {
+	sync.Mutex
+	node        string
+	storage     StorageSource
+	sink        Sink
+	logger      logrus.FieldLogger
+	ops         map[operationKey]int64
+	periodStart time.Time
+	now         func() time.Time
+}
+
+func NewCollector(node string, storage StorageSource, sink Sink,
+	logger logrus.FieldLogger,
+) *Collector {
+	return &Collector{
+		node:        node,
+		storage:     storage,
+		sink:        sink,
+		logger:      logger,
+		ops:         map[operationKey]int64{},
+		periodStart: time.Now(),
+		now:         time.Now,
+	}
+}
+
+// User is the name usage of the principal is recorded under
+func User(principal *models.Principal) string {
+	if principal == nil {
+		return "anonymous"
+	}
+	return principal.Username
+}
+
+// Record counts n objects affected by an operation of the principal on the
+// class. It can be called on a nil Collector, if metering is disabled.
+func (c *Collector) Record(principal *models.Principal, class, operation string, n int) {
+	if c == nil || n <= 0 {
+		return
+	}
+
+	key := operationKey{class: class, user: User(principal), operation: operation}
+	c.Lock()
+	c.ops[key] += int64(n)
+	c.Unlock()
+}
+
+// Export ends the current period and sends its usage to the sink
+func (c *Collector) Export(ctx context.Context) error {
+	c.Lock()
+	ops := c.ops
+	start := c.periodStart
+	end := c.now()
+	c.ops = map[operationKey]int64{}
+	c.periodStart = end
+	c.Unlock()
+
+	record := Record{
+		Node:        c.node,
+		PeriodStart: start,
+		PeriodEnd:   end,
+		Operations:  operationUsage(ops),
+		Storage:     []StorageUsage{},
+	}
+
+	storage, err := c.storage.StorageUsage(ctx)
+	if err == nil {
+		record.Storage = storage
+		err = c.sink.Export(ctx, record)
+	}
+	if err != nil {
+		c.carryOver(ops, start)
+		return errors.Wrap(err, "export usage record")
+	}
+	return nil
+}
+
+// carryOver adds the operations of a period which couldn't be exported to
+// the current period
+func (c *Collector) carryOver(ops map[operationKey]int64, start time.Time) {
+	c.Lock()
+	defer c.Unlock()
+
+	for key, count := range ops {
+		c.ops[key] += count
+	}
+	c.periodStart = start
+}
+
+// Run exports the usage every interval until ctx is done. The usage of the
+// last period is exported before returning.
+func (c *Collector) Run(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			finalCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			c.export(finalCtx)
+			cancel()
+			return
+		case <-t.C:
+			c.export(ctx)
+		}
+	}
+}
+
+func (c *Collector) export(ctx context.Context) {
+	if err := c.Export(ctx); err != nil {
+		c.logger.WithField("action", "metering_export").
+			WithError(err).Error("usage record could not be exported, " +
+			"its operations are carried over to the next period")
+	}
+}
+
+func operationUsage(ops map[operationKey]int64) []OperationUsage {
+	out := make([]OperationUsage, 0, len(ops))
+	for key, count := range ops {
+		out = append(out, OperationUsage{
+			Class:     key.class,
+			User:      key.user,
+			Operation: key.operation,
+			Count:     count,
+		})
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Class != out[j].Class {
+			return out[i].Class < out[j].Class
+		}
+		if out[i].User != out[j].User {
+			return out[i].User < out[j].User
+		}
+		return out[i].Operation < out[j].Operation
+	})
+	return out
+}
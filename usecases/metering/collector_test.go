@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package metering
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type fakeStorage struct {
+	usage []StorageUsage
+	err   error
+}
+
+func (f *fakeStorage) StorageUsage(ctx context.Context) ([]StorageUsage, error) {
+	return f.usage, f.err
+}
+
+type fakeSink struct {
+	records []Record
+	err     error
+}
+
+func (f *fakeSink) Export(ctx context.Context, record Record) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.records = append(f.records, record)
+	return nil
+}
+
+func TestCollector(t *testing.T) {
+	alice := &models.Principal{Username: "alice"}
+	storage := &fakeStorage{usage: []StorageUsage{
+		{Class: "Article", Shard: "abc", Objects: 3, VectorDimensions: 9, Bytes: 1024},
+	}}
+
+	newCollector := func(sink Sink) (*Collector, *time.Time) {
+		logger, _ := test.NewNullLogger()
+		c := NewCollector("node1", storage, sink, logger)
+		now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+		c.periodStart = now
+		c.now = func() time.Time { return now }
+		return c, &now
+	}
+
+	t.Run("aggregates operations per class, user and operation", func(t *testing.T) {
+		sink := &fakeSink{}
+		c, now := newCollector(sink)
+		c.Record(alice, "Article", OperationCreate, 1)
+		c.Record(alice, "Article", OperationCreate, 1)
+		c.Record(alice, "Article", OperationBatchCreate, 100)
+		c.Record(nil, "Article", OperationQuery, 10)
+		c.Record(alice, "Article", OperationQuery, 0)
+		*now = now.Add(time.Hour)
+
+		require.Nil(t, c.Export(context.Background()))
+		require.Len(t, sink.records, 1)
+		assert.Equal(t, Record{
+			Node:        "node1",
+			PeriodStart: time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC),
+			PeriodEnd:   time.Date(2023, 5, 1, 13, 0, 0, 0, time.UTC),
+			Operations: []OperationUsage{
+				{Class: "Article", User: "alice", Operation: OperationBatchCreate, Count: 100},
+				{Class: "Article", User: "alice", Operation: OperationCreate, Count: 2},
+				{Class: "Article", User: "anonymous", Operation: OperationQuery, Count: 10},
+			},
+			Storage: storage.usage,
+		}, sink.records[0])
+
+		t.Run("the next period starts empty", func(t *testing.T) {
+			*now = now.Add(time.Hour)
+			require.Nil(t, c.Export(context.Background()))
+			require.Len(t, sink.records, 2)
+			assert.Empty(t, sink.records[1].Operations)
+			assert.Equal(t, sink.records[0].PeriodEnd, sink.records[1].PeriodStart)
+		})
+	})
+
+	t.Run("failed exports are carried over", func(t *testing.T) {
+		sink := &fakeSink{err: errors.New("unavailable")}
+		c, now := newCollector(sink)
+		start := *now
+		c.Record(alice, "Article", OperationDelete, 1)
+		*now = now.Add(time.Hour)
+		assert.NotNil(t, c.Export(context.Background()))
+
+		c.Record(alice, "Article", OperationDelete, 2)
+		*now = now.Add(time.Hour)
+		sink.err = nil
+		require.Nil(t, c.Export(context.Background()))
+		require.Len(t, sink.records, 1)
+		assert.Equal(t, start, sink.records[0].PeriodStart)
+		assert.Equal(t, []OperationUsage{
+			{Class: "Article", User: "alice", Operation: OperationDelete, Count: 3},
+		}, sink.records[0].Operations)
+	})
+
+	t.Run("nil collector", func(t *testing.T) {
+		var c *Collector
+		c.Record(alice, "Article", OperationCreate, 1)
+	})
+}
@@ -13,6 +13,7 @@ package modules
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/go-openapi/strfmt"
@@ -70,6 +71,33 @@ func (m dummyText2VecModuleNoCapabilities) VectorizeObject(ctx context.Context,
 	return nil
 }
 
+type dummyBatchText2VecModule struct {
+	dummyText2VecModuleNoCapabilities
+	batches *[][]*models.Object
+}
+
+func newDummyBatchText2VecModule(name string) dummyBatchText2VecModule {
+	return dummyBatchText2VecModule{
+		dummyText2VecModuleNoCapabilities: newDummyText2VecModule(name),
+		batches:                           &[][]*models.Object{},
+	}
+}
+
+func (m dummyBatchText2VecModule) VectorizeBatch(ctx context.Context,
+	objs []*models.Object, cfg moduletools.ClassConfig,
+) []error {
+	*m.batches = append(*m.batches, objs)
+	errs := make([]error, len(objs))
+	for i, obj := range objs {
+		if obj.ID == "" {
+			errs[i] = errors.New("no id")
+			continue
+		}
+		obj.Vector = []float32{4, 5, 6}
+	}
+	return errs
+}
+
 func newDummyRef2VecModule(name string) dummyRef2VecModuleNoCapabilities {
 	return dummyRef2VecModuleNoCapabilities{name: name}
 }
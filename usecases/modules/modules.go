@@ -24,6 +24,8 @@ import (
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/tokenizer"
+	"github.com/weaviate/weaviate/usecases/monitoring"
 )
 
 var (
@@ -36,6 +38,8 @@ type Provider struct {
 	altNames               map[string]string
 	schemaGetter           schemaGetter
 	hasMultipleVectorizers bool
+	dimensionsValidator    VectorDimensionsValidator
+	metrics                *monitoring.PrometheusMetrics
 }
 
 type schemaGetter interface {
@@ -136,6 +140,22 @@ func (m *Provider) Init(ctx context.Context,
 	return nil
 }
 
+// RegisterTokenizers registers the custom tokenizers of all modules. It
+// runs before the modules are initialized, as the tokenizers are needed as
+// soon as the DB indexes objects.
+func (m *Provider) RegisterTokenizers() error {
+	for _, mod := range m.GetAll() {
+		if modTokenizers, ok := mod.(modulecapabilities.Tokenizers); ok {
+			for name, t := range modTokenizers.Tokenizers() {
+				if err := tokenizer.Register(name, t); err != nil {
+					return errors.Wrapf(err, "register tokenizer of module %q", mod.Name())
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func (m *Provider) validate() error {
 	searchers := map[string][]string{}
 	additionalGraphQLProps := map[string][]string{}
@@ -492,7 +512,12 @@ func (m *Provider) additionalExtend(ctx context.Context, in []search.Result,
 						searchVectorValue.SetSearchVector(searchVector)
 						searchValue = searchVectorValue
 					}
-					resArray, err := additionalPropertyFn(ctx, toBeExtended, searchValue, nil, argumentModuleParams, cfg)
+					var budget modulecapabilities.RerankBudget
+					if budgetFn := allAdditionalProperties[name].RerankBudgetFunction; budgetFn != nil {
+						budget = budgetFn(searchValue, argumentModuleParams, cfg)
+					}
+					resArray, err := extendWithinBudget(ctx, additionalPropertyFn, budget,
+						toBeExtended, searchValue, argumentModuleParams, cfg)
 					if err != nil {
 						return nil, errors.Errorf("extend %s: %v", name, err)
 					}
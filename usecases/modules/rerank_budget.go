@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// extendWithinBudget calls the function of an additional property which
+// reranks the results with the candidates of the budget. The property works
+// on copies of the results, so that they are unchanged if it's still running
+// after the timeout.
+func extendWithinBudget(ctx context.Context, fn modulecapabilities.AdditionalPropertyFn,
+	budget modulecapabilities.RerankBudget, in []search.Result, params interface{},
+	argumentModuleParams map[string]interface{}, cfg moduletools.ClassConfig,
+) ([]search.Result, error) {
+	if budget == (modulecapabilities.RerankBudget{}) {
+		return fn(ctx, in, params, nil, argumentModuleParams, cfg)
+	}
+
+	candidates, rest := in, []search.Result(nil)
+	if budget.MaxCandidates > 0 && len(in) > budget.MaxCandidates {
+		candidates, rest = in[:budget.MaxCandidates], in[budget.MaxCandidates:]
+	}
+	candidates = copyResults(candidates)
+
+	if budget.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, budget.Timeout)
+		defer cancel()
+	}
+
+	type extended struct {
+		res []search.Result
+		err error
+	}
+	done := make(chan extended, 1)
+	go func() {
+		res, err := fn(ctx, candidates, params, nil, argumentModuleParams, cfg)
+		done <- extended{res, err}
+	}()
+
+	var out extended
+	select {
+	case out = <-done:
+	case <-ctx.Done():
+		out.err = ctx.Err()
+	}
+	if out.err != nil {
+		if budget.Fallback {
+			return in, nil
+		}
+		return nil, out.err
+	}
+
+	res := make([]search.Result, 0, len(out.res)+len(rest))
+	res = append(res, out.res...)
+	return append(res, rest...), nil
+}
+
+// copyResults copies the results with their additional properties, which
+// are extended in place
+func copyResults(in []search.Result) []search.Result {
+	out := make([]search.Result, len(in))
+	for i := range in {
+		out[i] = in[i]
+		if in[i].AdditionalProperties != nil {
+			out[i].AdditionalProperties = make(models.AdditionalProperties,
+				len(in[i].AdditionalProperties))
+			for name, value := range in[i].AdditionalProperties {
+				out[i].AdditionalProperties[name] = value
+			}
+		}
+	}
+	return out
+}
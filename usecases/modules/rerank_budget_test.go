@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func TestExtendWithinBudget(t *testing.T) {
+	ctx := context.Background()
+	results := func() []search.Result {
+		return []search.Result{
+			{ID: "1", Score: 0.1},
+			{ID: "2", Score: 0.9},
+			{ID: "3", Score: 0.5},
+			{ID: "4", Score: 0.7},
+		}
+	}
+	ids := func(in []search.Result) []string {
+		out := make([]string, len(in))
+		for i := range in {
+			out[i] = string(in[i].ID)
+		}
+		return out
+	}
+
+	// rerank orders the results by score and marks them as reranked
+	var reranked []string
+	rerank := func(ctx context.Context, in []search.Result, params interface{}, limit *int,
+		argumentModuleParams map[string]interface{}, cfg moduletools.ClassConfig,
+	) ([]search.Result, error) {
+		reranked = ids(in)
+		for i := range in {
+			in[i].AdditionalProperties = models.AdditionalProperties{"reranked": true}
+		}
+		for i := 1; i < len(in); i++ {
+			for j := i; j > 0 && in[j].Score > in[j-1].Score; j-- {
+				in[j], in[j-1] = in[j-1], in[j]
+			}
+		}
+		return in, nil
+	}
+	slow := func(ctx context.Context, in []search.Result, params interface{}, limit *int,
+		argumentModuleParams map[string]interface{}, cfg moduletools.ClassConfig,
+	) ([]search.Result, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	failing := func(ctx context.Context, in []search.Result, params interface{}, limit *int,
+		argumentModuleParams map[string]interface{}, cfg moduletools.ClassConfig,
+	) ([]search.Result, error) {
+		return nil, errors.New("model unavailable")
+	}
+
+	t.Run("without budget all results are reranked", func(t *testing.T) {
+		res, err := extendWithinBudget(ctx, rerank, modulecapabilities.RerankBudget{},
+			results(), nil, nil, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"1", "2", "3", "4"}, reranked)
+		assert.Equal(t, []string{"2", "4", "3", "1"}, ids(res))
+	})
+
+	t.Run("only the candidates are reranked", func(t *testing.T) {
+		res, err := extendWithinBudget(ctx, rerank, modulecapabilities.RerankBudget{MaxCandidates: 3},
+			results(), nil, nil, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"1", "2", "3"}, reranked)
+		assert.Equal(t, []string{"2", "3", "1", "4"}, ids(res))
+		assert.Nil(t, res[3].AdditionalProperties)
+	})
+
+	t.Run("timeout falls back to the original order", func(t *testing.T) {
+		in := results()
+		budget := modulecapabilities.RerankBudget{Timeout: 10 * time.Millisecond, Fallback: true}
+		res, err := extendWithinBudget(ctx, slow, budget, in, nil, nil, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"1", "2", "3", "4"}, ids(res))
+	})
+
+	t.Run("timeout fails without fallback", func(t *testing.T) {
+		budget := modulecapabilities.RerankBudget{Timeout: 10 * time.Millisecond}
+		_, err := extendWithinBudget(ctx, slow, budget, results(), nil, nil, nil)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("failed rerank falls back to the unchanged results", func(t *testing.T) {
+		in := results()
+		in[0].AdditionalProperties = models.AdditionalProperties{"distance": 0.1}
+		budget := modulecapabilities.RerankBudget{MaxCandidates: 2, Fallback: true}
+		res, err := extendWithinBudget(ctx, failing, budget, in, nil, nil, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"1", "2", "3", "4"}, ids(res))
+		assert.Equal(t, models.AdditionalProperties{"distance": 0.1}, res[0].AdditionalProperties)
+	})
+}
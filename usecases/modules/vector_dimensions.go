@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// VectorDimensionMismatch is the code of ErrVectorDimensions, clients can
+// match on it to tell a changed vectorizer model apart from invalid objects
+const VectorDimensionMismatch = "VECTOR_DIMENSION_MISMATCH"
+
+// ErrVectorDimensions is returned when a vectorizer generates a vector whose
+// dimensionality differs from the one of the class, e.g. because the model
+// of the provider changed. Such vectors must not reach the vector index.
+type ErrVectorDimensions struct {
+	Class      string
+	Vectorizer string
+	Dimensions int
+	Reason     string
+}
+
+func (e ErrVectorDimensions) Error() string {
+	return fmt.Sprintf("%s: vectorizer %q generated a vector with %d dimensions "+
+		"for class %q: %s", VectorDimensionMismatch, e.Vectorizer, e.Dimensions,
+		e.Class, e.Reason)
+}
+
+// VectorDimensionsValidator checks generated vectors against the vectors
+// already stored for a class, see db.DB.ValidateVectorDimensions
+type VectorDimensionsValidator interface {
+	ValidateVectorDimensions(className string, vector []float32) error
+}
+
+// SetVectorDimensionsValidator sets the check of generated vectors against
+// the stored vectors and the metrics its mismatches are counted with
+func (m *Provider) SetVectorDimensionsValidator(v VectorDimensionsValidator,
+	metrics *monitoring.PrometheusMetrics,
+) {
+	m.dimensionsValidator = v
+	m.metrics = metrics
+}
+
+// validateVectorDimensions checks the dimensionality of generated vectors.
+// The expected dimensionality is the one required by the vector validation
+// of the class, otherwise the one of the vectors already stored for the
+// class. Within a batch, all generated vectors of a class must have the same
+// dimensionality, even if the class has no vectors yet. It returns an error
+// for every object, nil objects aren't checked.
+func (m *Provider) validateVectorDimensions(objects []*models.Object,
+	classes []*models.Class,
+) []error {
+	errs := make([]error, len(objects))
+	// the dimensionality of the first valid vector of each class
+	batchDims := map[string]int{}
+	for i, object := range objects {
+		if object == nil || len(object.Vector) == 0 {
+			continue
+		}
+		class := classes[i]
+
+		if err := m.validateClassVectorDimensions(class, object.Vector); err != nil {
+			errs[i] = m.vectorDimensionsError(class, len(object.Vector), err.Error())
+			continue
+		}
+
+		dims, ok := batchDims[class.Class]
+		if !ok {
+			batchDims[class.Class] = len(object.Vector)
+			continue
+		}
+		if dims != len(object.Vector) {
+			errs[i] = m.vectorDimensionsError(class, len(object.Vector),
+				fmt.Sprintf("other vectors of the batch have %d dimensions", dims))
+		}
+	}
+	return errs
+}
+
+func (m *Provider) validateClassVectorDimensions(class *models.Class,
+	vector []float32,
+) error {
+	if dims := VectorValidation(class).Dimensions; dims > 0 {
+		if len(vector) != dims {
+			return fmt.Errorf("class requires exactly %d", dims)
+		}
+		return nil
+	}
+
+	if m.dimensionsValidator == nil {
+		return nil
+	}
+	return m.dimensionsValidator.ValidateVectorDimensions(class.Class, vector)
+}
+
+func (m *Provider) vectorDimensionsError(class *models.Class, dims int,
+	reason string,
+) ErrVectorDimensions {
+	if m.metrics != nil {
+		m.metrics.VectorDimensionMismatches.
+			WithLabelValues(class.Class, class.Vectorizer).Inc()
+	}
+	return ErrVectorDimensions{
+		Class:      class.Class,
+		Vectorizer: class.Vectorizer,
+		Dimensions: dims,
+		Reason:     reason,
+	}
+}
+
+// VectorValidation returns the vector validation policy of the class,
+// regardless of the type of its vector index. A dynamic index uses the policy
+// of its hnsw config, as that's the index it ends up with.
+func VectorValidation(class *models.Class) hnsw.VectorValidation {
+	switch vectorIndexConfig := class.VectorIndexConfig.(type) {
+	case hnsw.UserConfig:
+		return vectorIndexConfig.VectorValidation
+	case flat.UserConfig:
+		return vectorIndexConfig.VectorValidation
+	case dynamic.UserConfig:
+		return vectorIndexConfig.HNSW.VectorValidation
+	default:
+		return hnsw.VectorValidation{}
+	}
+}
@@ -14,6 +14,7 @@ package modules
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -21,6 +22,8 @@ import (
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/config"
 )
@@ -29,8 +32,8 @@ const (
 	errorVectorizerCapability = "module %q exists, but does not provide the " +
 		"Vectorizer or ReferenceVectorizer capability"
 
-	errorVectorIndexType = "vector index config (%T) is not of type HNSW, " +
-		"but objects manager is restricted to HNSW"
+	errorVectorIndexType = "vector index config (%T) is not of type HNSW, flat or dynamic, " +
+		"but objects manager is restricted to HNSW, flat and dynamic"
 
 	warningVectorIgnored = "This vector will be ignored. If you meant to index " +
 		"the vector, make sure to set vectorIndexConfig.skip to 'false'. If the previous " +
@@ -84,21 +87,144 @@ func (m *Provider) UpdateVector(ctx context.Context, object *models.Object, clas
 	objectDiff *moduletools.ObjectDiff, findObjectFn modulecapabilities.FindObjectFn,
 	logger logrus.FieldLogger,
 ) error {
-	hnswConfig, ok := class.VectorIndexConfig.(hnsw.UserConfig)
-	if !ok {
-		return fmt.Errorf(errorVectorIndexType, class.VectorIndexConfig)
+	found, cfg, err := m.classVectorizer(object, class, logger)
+	if err != nil || found == nil {
+		return err
+	}
+
+	generates := generatesVector(found, object)
+	if err := m.vectorize(ctx, object, found, cfg, objectDiff, findObjectFn); err != nil {
+		return err
+	}
+	if !generates {
+		return nil
+	}
+	return m.validateVectorDimensions([]*models.Object{object},
+		[]*models.Class{class})[0]
+}
+
+// BatchUpdateVector updates the vectors of the objects of a batch. Objects
+// whose vectorizer is a modulecapabilities.BatchVectorizer are vectorized
+// together with the other objects of their class, all other objects one by
+// one. The returned errors belong to the object at the same index, nil
+// objects are skipped.
+func (m *Provider) BatchUpdateVector(ctx context.Context, objects []*models.Object,
+	classes []*models.Class, findObjectFn modulecapabilities.FindObjectFn,
+	logger logrus.FieldLogger,
+) []error {
+	type batch struct {
+		vectorizer modulecapabilities.BatchVectorizer
+		cfg        moduletools.ClassConfig
+		indexes    []int
+	}
+
+	errs := make([]error, len(objects))
+	// the objects whose vectors are generated and need to be checked
+	generated := make([]*models.Object, len(objects))
+	batches := map[string]*batch{}
+	wg := &sync.WaitGroup{}
+	for i, object := range objects {
+		if object == nil {
+			continue
+		}
+
+		found, cfg, err := m.classVectorizer(object, classes[i], logger)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		if found == nil {
+			continue
+		}
+		if generatesVector(found, object) {
+			generated[i] = object
+		}
+
+		if vectorizer, ok := found.(modulecapabilities.BatchVectorizer); ok && object.Vector == nil {
+			b, ok := batches[classes[i].Class]
+			if !ok {
+				b = &batch{vectorizer: vectorizer, cfg: cfg}
+				batches[classes[i].Class] = b
+			}
+			b.indexes = append(b.indexes, i)
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, found modulecapabilities.Module, cfg moduletools.ClassConfig) {
+			defer wg.Done()
+			errs[i] = m.vectorize(ctx, objects[i], found, cfg, nil, findObjectFn)
+		}(i, found, cfg)
+	}
+
+	for _, b := range batches {
+		wg.Add(1)
+		go func(b *batch) {
+			defer wg.Done()
+			objs := make([]*models.Object, len(b.indexes))
+			for j, i := range b.indexes {
+				objs[j] = objects[i]
+			}
+			batchErrs := b.vectorizer.VectorizeBatch(ctx, objs, b.cfg)
+			for j, i := range b.indexes {
+				if j < len(batchErrs) && batchErrs[j] != nil {
+					errs[i] = fmt.Errorf("update vector: %w", batchErrs[j])
+				}
+			}
+		}(b)
+	}
+
+	wg.Wait()
+
+	for i := range generated {
+		if errs[i] != nil {
+			generated[i] = nil
+		}
+	}
+	for i, err := range m.validateVectorDimensions(generated, classes) {
+		if err != nil {
+			errs[i] = err
+		}
+	}
+	return errs
+}
+
+// generatesVector is true if the vectorizer sets the vector of the object,
+// reference vectorizers replace even vectors which are provided
+func generatesVector(found modulecapabilities.Module, object *models.Object) bool {
+	if _, ok := found.(modulecapabilities.Vectorizer); ok {
+		return object.Vector == nil
+	}
+	return true
+}
+
+// classVectorizer returns the vectorizer module of the class together with
+// its config, or a nil module if the object must not be vectorized
+func (m *Provider) classVectorizer(object *models.Object, class *models.Class,
+	logger logrus.FieldLogger,
+) (modulecapabilities.Module, moduletools.ClassConfig, error) {
+	var skip bool
+	switch vectorIndexConfig := class.VectorIndexConfig.(type) {
+	case hnsw.UserConfig:
+		skip = vectorIndexConfig.Skip
+	case flat.UserConfig:
+		skip = vectorIndexConfig.Skip
+	case dynamic.UserConfig:
+		// a dynamic index can't be skipped
+	default:
+		return nil, nil, fmt.Errorf(errorVectorIndexType, class.VectorIndexConfig)
 	}
 
 	if class.Vectorizer == config.VectorizerModuleNone {
-		if hnswConfig.Skip && len(object.Vector) > 0 {
+		if skip && len(object.Vector) > 0 {
 			logger.WithField("className", object.Class).
 				Warningf(warningSkipVectorProvided)
 		}
 
-		return nil
+		return nil, nil, nil
 	}
 
-	if hnswConfig.Skip {
+	if skip {
 		logger.WithField("className", object.Class).
 			WithField("vectorizer", class.Vectorizer).
 			Warningf(warningSkipVectorGenerated, class.Vectorizer)
@@ -106,7 +232,7 @@ func (m *Provider) UpdateVector(ctx context.Context, object *models.Object, clas
 
 	modConfig, ok := class.ModuleConfig.(map[string]interface{})
 	if !ok {
-		return fmt.Errorf("class %v not present", object.Class)
+		return nil, nil, fmt.Errorf("class %v not present", object.Class)
 	}
 	var found modulecapabilities.Module
 	for modName := range modConfig {
@@ -117,12 +243,17 @@ func (m *Provider) UpdateVector(ctx context.Context, object *models.Object, clas
 	}
 
 	if found == nil {
-		return fmt.Errorf(
+		return nil, nil, fmt.Errorf(
 			"no vectorizer found for class %q", object.Class)
 	}
 
-	cfg := NewClassBasedModuleConfig(class, found.Name())
+	return found, NewClassBasedModuleConfig(class, found.Name()), nil
+}
 
+func (m *Provider) vectorize(ctx context.Context, object *models.Object,
+	found modulecapabilities.Module, cfg moduletools.ClassConfig,
+	objectDiff *moduletools.ObjectDiff, findObjectFn modulecapabilities.FindObjectFn,
+) error {
 	if vectorizer, ok := found.(modulecapabilities.Vectorizer); ok {
 		if object.Vector == nil {
 			if err := vectorizer.VectorizeObject(ctx, object, objectDiff, cfg); err != nil {
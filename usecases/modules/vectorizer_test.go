@@ -13,6 +13,7 @@ package modules
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -20,9 +21,12 @@ import (
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
@@ -250,8 +254,8 @@ func TestProvider_UpdateVector(t *testing.T) {
 
 		obj := &models.Object{Class: className, ID: newUUID()}
 		err := p.UpdateVector(ctx, obj, class, nil, repo.Object, logger)
-		expectedErr := "vector index config (struct {}) is not of type HNSW, " +
-			"but objects manager is restricted to HNSW"
+		expectedErr := "vector index config (struct {}) is not of type HNSW, flat or dynamic, " +
+			"but objects manager is restricted to HNSW, flat and dynamic"
 		assert.EqualError(t, err, expectedErr)
 	})
 }
@@ -259,3 +263,183 @@ func TestProvider_UpdateVector(t *testing.T) {
 func newUUID() strfmt.UUID {
 	return strfmt.UUID(uuid.NewString())
 }
+
+func TestProvider_BatchUpdateVector(t *testing.T) {
+	ctx := context.Background()
+	batchMod := newDummyBatchText2VecModule("batch-vzr")
+	mod := newDummyModule("some-vzr", modulecapabilities.Text2Vec)
+	batchClass := &models.Class{
+		Class: "BatchClass",
+		ModuleConfig: map[string]interface{}{
+			"batch-vzr": struct{}{},
+		},
+		VectorIndexConfig: hnsw.UserConfig{},
+	}
+	class := &models.Class{
+		Class: "SomeClass",
+		ModuleConfig: map[string]interface{}{
+			"some-vzr": struct{}{},
+		},
+		VectorIndexConfig: hnsw.UserConfig{},
+	}
+	sch := schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{batchClass, class},
+	}}
+	repo := &fakeObjectsRepo{}
+	logger, _ := test.NewNullLogger()
+
+	p := NewProvider()
+	p.Register(batchMod)
+	p.Register(mod)
+	p.SetSchemaGetter(&fakeSchemaGetter{sch})
+
+	objects := []*models.Object{
+		{Class: batchClass.Class, ID: newUUID()},
+		{Class: class.Class, ID: newUUID()},
+		nil,
+		{Class: batchClass.Class, ID: newUUID(), Vector: []float32{7, 8, 9}},
+		{Class: batchClass.Class},
+		{Class: batchClass.Class, ID: newUUID()},
+	}
+	classes := []*models.Class{batchClass, class, nil, batchClass, batchClass, batchClass}
+
+	errs := p.BatchUpdateVector(ctx, objects, classes, repo.Object, logger)
+
+	require.Len(t, errs, len(objects))
+	assert.Nil(t, errs[0])
+	assert.Nil(t, errs[1])
+	assert.Nil(t, errs[2])
+	assert.Nil(t, errs[3])
+	assert.EqualError(t, errs[4], "update vector: no id")
+	assert.Nil(t, errs[5])
+
+	// only the objects without a vector are vectorized, all with one call
+	require.Len(t, *batchMod.batches, 1)
+	assert.Equal(t, []*models.Object{objects[0], objects[4], objects[5]},
+		(*batchMod.batches)[0])
+	assert.Equal(t, models.C11yVector{4, 5, 6}, objects[0].Vector)
+	assert.Equal(t, models.C11yVector{1, 2, 3}, objects[1].Vector)
+	assert.Equal(t, models.C11yVector{7, 8, 9}, objects[3].Vector)
+	assert.Equal(t, models.C11yVector{4, 5, 6}, objects[5].Vector)
+}
+
+type fakeDimensionsValidator struct {
+	dims int
+}
+
+func (v fakeDimensionsValidator) ValidateVectorDimensions(className string, vector []float32) error {
+	if len(vector) != v.dims {
+		return fmt.Errorf("existing vectors have %d dimensions", v.dims)
+	}
+	return nil
+}
+
+func TestProvider_VectorDimensions(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	repo := &fakeObjectsRepo{}
+	newClass := func(dims int) *models.Class {
+		cfg := hnsw.UserConfig{}
+		cfg.VectorValidation.Dimensions = dims
+		return &models.Class{
+			Class:             "SomeClass",
+			Vectorizer:        "some-vzr",
+			ModuleConfig:      map[string]interface{}{"some-vzr": struct{}{}},
+			VectorIndexConfig: cfg,
+		}
+	}
+	newProvider := func(class *models.Class, validator VectorDimensionsValidator) *Provider {
+		p := NewProvider()
+		p.Register(newDummyModule("some-vzr", modulecapabilities.Text2Vec))
+		p.Register(newDummyBatchText2VecModule("batch-vzr"))
+		p.SetSchemaGetter(&fakeSchemaGetter{schema.Schema{Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		}}})
+		p.SetVectorDimensionsValidator(validator, nil)
+		return p
+	}
+
+	t.Run("configured dimensions", func(t *testing.T) {
+		p := newProvider(newClass(3), nil)
+		obj := &models.Object{Class: "SomeClass", ID: newUUID()}
+		require.Nil(t, p.UpdateVector(ctx, obj, newClass(3), nil, repo.Object, logger))
+
+		p = newProvider(newClass(4), nil)
+		obj = &models.Object{Class: "SomeClass", ID: newUUID()}
+		err := p.UpdateVector(ctx, obj, newClass(4), nil, repo.Object, logger)
+		var dimsErr ErrVectorDimensions
+		require.True(t, errors.As(err, &dimsErr))
+		assert.Equal(t, ErrVectorDimensions{
+			Class:      "SomeClass",
+			Vectorizer: "some-vzr",
+			Dimensions: 3,
+			Reason:     "class requires exactly 4",
+		}, dimsErr)
+		assert.Contains(t, err.Error(), VectorDimensionMismatch)
+	})
+
+	t.Run("configured dimensions of other index types", func(t *testing.T) {
+		dynamicCfg := dynamic.NewDefaultUserConfig()
+		dynamicCfg.HNSW.VectorValidation.Dimensions = 4
+		flatCfg := flat.NewDefaultUserConfig()
+		flatCfg.VectorValidation.Dimensions = 4
+
+		for _, cfg := range []schema.VectorIndexConfig{dynamicCfg, flatCfg} {
+			class := newClass(0)
+			class.VectorIndexConfig = cfg
+			p := newProvider(class, nil)
+			obj := &models.Object{Class: "SomeClass", ID: newUUID()}
+			err := p.UpdateVector(ctx, obj, class, nil, repo.Object, logger)
+			assert.ErrorContains(t, err, "class requires exactly 4", cfg.IndexType())
+		}
+	})
+
+	t.Run("stored vectors", func(t *testing.T) {
+		p := newProvider(newClass(0), fakeDimensionsValidator{dims: 3})
+		obj := &models.Object{Class: "SomeClass", ID: newUUID()}
+		require.Nil(t, p.UpdateVector(ctx, obj, newClass(0), nil, repo.Object, logger))
+
+		p = newProvider(newClass(0), fakeDimensionsValidator{dims: 1536})
+		obj = &models.Object{Class: "SomeClass", ID: newUUID()}
+		err := p.UpdateVector(ctx, obj, newClass(0), nil, repo.Object, logger)
+		assert.ErrorContains(t, err, "existing vectors have 1536 dimensions")
+	})
+
+	t.Run("provided vectors aren't checked", func(t *testing.T) {
+		p := newProvider(newClass(0), fakeDimensionsValidator{dims: 1536})
+		obj := &models.Object{Class: "SomeClass", ID: newUUID(), Vector: []float32{1, 2}}
+		require.Nil(t, p.UpdateVector(ctx, obj, newClass(0), nil, repo.Object, logger))
+	})
+
+	t.Run("batch", func(t *testing.T) {
+		class := newClass(0)
+		class.ModuleConfig = map[string]interface{}{"batch-vzr": struct{}{}}
+		p := newProvider(class, fakeDimensionsValidator{dims: 1536})
+
+		objects := []*models.Object{
+			{Class: "SomeClass", ID: newUUID()},
+			{Class: "SomeClass", ID: newUUID(), Vector: []float32{1, 2}},
+		}
+		errs := p.BatchUpdateVector(ctx, objects, []*models.Class{class, class}, repo.Object, logger)
+		var dimsErr ErrVectorDimensions
+		assert.True(t, errors.As(errs[0], &dimsErr))
+		assert.Nil(t, errs[1])
+	})
+
+	t.Run("mixed dimensions within a batch", func(t *testing.T) {
+		class := newClass(0)
+		p := newProvider(class, nil)
+
+		objects := []*models.Object{
+			{Class: "SomeClass", Vector: []float32{1, 2, 3}},
+			nil,
+			{Class: "SomeClass", Vector: []float32{1, 2, 3}},
+			{Class: "SomeClass", Vector: []float32{1, 2, 3, 4}},
+		}
+		errs := p.validateVectorDimensions(objects, []*models.Class{class, nil, class, class})
+		assert.Nil(t, errs[0])
+		assert.Nil(t, errs[1])
+		assert.Nil(t, errs[2])
+		assert.ErrorContains(t, errs[3], "other vectors of the batch have 3 dimensions")
+	})
+}
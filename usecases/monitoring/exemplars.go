@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package monitoring
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// TraceparentHeader is the W3C trace context header with which OpenTelemetry
+// propagates traces
+const TraceparentHeader = "traceparent"
+
+type traceIDKey struct{}
+
+// ContextWithTraceID attaches the trace of a request to its context, the
+// latency histograms observed with the context link to the trace through an
+// exemplar
+func ContextWithTraceID(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, traceIDKey{}, traceID)
+}
+
+// TraceIDFromContext returns the trace ID of the request or "" if it is not
+// traced
+func TraceIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	traceID, _ := ctx.Value(traceIDKey{}).(string)
+	return traceID
+}
+
+// TraceIDFromTraceparent extracts the trace ID from a traceparent header of
+// the form "00-<trace-id>-<parent-id>-<flags>". Future versions of the
+// header may append fields, the trace ID stays in the same place.
+func TraceIDFromTraceparent(header string) (string, bool) {
+	parts := strings.Split(strings.TrimSpace(header), "-")
+	if len(parts) < 4 {
+		return "", false
+	}
+	version, traceID, parentID := parts[0], parts[1], parts[2]
+	if len(version) != 2 || version == "ff" || (version == "00" && len(parts) != 4) {
+		return "", false
+	}
+	if !isLowerHex(traceID, 32) || !isLowerHex(parentID, 16) {
+		return "", false
+	}
+	if traceID == strings.Repeat("0", 32) {
+		return "", false
+	}
+	return traceID, true
+}
+
+func isLowerHex(s string, length int) bool {
+	if len(s) != length || strings.ToLower(s) != s {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
+
+// ObserveWithTraceID observes the value and attaches the trace of the context
+// as exemplar, so a spike in a latency histogram can be followed to the trace
+// of a slow request. Exemplars are only exposed in the OpenMetrics format.
+func ObserveWithTraceID(ctx context.Context, observer prometheus.Observer, value float64) {
+	if traceID := TraceIDFromContext(ctx); traceID != "" {
+		if eo, ok := observer.(prometheus.ExemplarObserver); ok {
+			eo.ObserveWithExemplar(value, prometheus.Labels{"trace_id": traceID})
+			return
+		}
+	}
+	observer.Observe(value)
+}
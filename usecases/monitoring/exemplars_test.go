@@ -0,0 +1,75 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package monitoring
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceIDFromTraceparent(t *testing.T) {
+	tests := []struct {
+		header  string
+		traceID string
+	}{
+		{"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", "4bf92f3577b34da6a3ce929d0e0e4736"},
+		{" 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00 ", "4bf92f3577b34da6a3ce929d0e0e4736"},
+		// future versions may append fields
+		{"01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra", "4bf92f3577b34da6a3ce929d0e0e4736"},
+		{"", ""},
+		{"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra", ""},
+		{"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", ""},
+		{"00-00000000000000000000000000000000-00f067aa0ba902b7-01", ""},
+		{"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01", ""},
+		{"00-4bf92f3577b34da6a3ce929d0e0e47-00f067aa0ba902b7-01", ""},
+		{"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902-01", ""},
+	}
+
+	for _, test := range tests {
+		traceID, ok := TraceIDFromTraceparent(test.header)
+		assert.Equal(t, test.traceID != "", ok, test.header)
+		assert.Equal(t, test.traceID, traceID, test.header)
+	}
+}
+
+func TestObserveWithTraceID(t *testing.T) {
+	histogram := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "test_durations_ms",
+		Buckets: []float64{10, 100},
+	})
+	write := func() *dto.Histogram {
+		var m dto.Metric
+		require.Nil(t, histogram.Write(&m))
+		return m.Histogram
+	}
+
+	ObserveWithTraceID(context.Background(), histogram, 5)
+	h := write()
+	assert.Equal(t, uint64(1), h.GetSampleCount())
+	assert.Nil(t, h.Bucket[0].Exemplar)
+
+	ctx := ContextWithTraceID(context.Background(), "4bf92f3577b34da6a3ce929d0e0e4736")
+	ObserveWithTraceID(ctx, histogram, 50)
+	h = write()
+	assert.Equal(t, uint64(2), h.GetSampleCount())
+	exemplar := h.Bucket[1].Exemplar
+	require.NotNil(t, exemplar)
+	assert.Equal(t, 50.0, exemplar.GetValue())
+	require.Len(t, exemplar.Label, 1)
+	assert.Equal(t, "trace_id", exemplar.Label[0].GetName())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", exemplar.Label[0].GetValue())
+}
@@ -37,6 +37,7 @@ type PrometheusMetrics struct {
 	VectorIndexDurations               *prometheus.SummaryVec
 	VectorIndexSize                    *prometheus.GaugeVec
 	VectorIndexMaintenanceDurations    *prometheus.SummaryVec
+	VectorIndexEstimatedRecall         *prometheus.GaugeVec
 	ObjectCount                        *prometheus.GaugeVec
 	QueriesCount                       *prometheus.GaugeVec
 	QueriesDurations                   *prometheus.HistogramVec
@@ -52,6 +53,14 @@ type PrometheusMetrics struct {
 	BackupRestoreDataTransferred       *prometheus.CounterVec
 	BackupStoreDataTransferred         *prometheus.CounterVec
 	VectorDimensionsSum                *prometheus.GaugeVec
+	MemoryDegradationActive            *prometheus.GaugeVec
+	MemoryDegradationEvents            *prometheus.CounterVec
+	ReplicationConflicts               *prometheus.CounterVec
+	QueryAdmissionRejected             *prometheus.CounterVec
+	QueryCost                          *prometheus.CounterVec
+	ReplicaInconsistency               *prometheus.GaugeVec
+	VectorDimensionMismatches          *prometheus.CounterVec
+	InvertedDeletedRatio               *prometheus.GaugeVec
 
 	StartupProgress  *prometheus.GaugeVec
 	StartupDurations *prometheus.SummaryVec
@@ -167,6 +176,10 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "vector_index_size",
 			Help: "The size of the vector index. Typically larger than number of vectors, as it grows proactively.",
 		}, []string{"class_name", "shard_name"}),
+		VectorIndexEstimatedRecall: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "vector_index_estimated_recall",
+			Help: "Recall of compressed vector searches estimated by comparing a sample of queries to exact searches",
+		}, []string{"class_name", "shard_name"}),
 		VectorIndexMaintenanceDurations: promauto.NewSummaryVec(prometheus.SummaryOpts{
 			Name: "vector_index_maintenance_durations_ms",
 			Help: "Duration of a sync or async vector index maintenance operation",
@@ -179,6 +192,38 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "vector_dimensions_sum",
 			Help: "Total dimensions in a shard",
 		}, []string{"class_name", "shard_name"}),
+		MemoryDegradationActive: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "memory_degradation_active",
+			Help: "1 while search of a class is degraded due to memory pressure",
+		}, []string{"class_name"}),
+		MemoryDegradationEvents: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "memory_degradation_events_total",
+			Help: "Number of times the node entered or left degraded search due to memory pressure",
+		}, []string{"event"}),
+		ReplicationConflicts: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "replication_conflicts_total",
+			Help: "Number of objects whose replicas diverged and were resolved by read repair",
+		}, []string{"class_name", "strategy"}),
+		QueryAdmissionRejected: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "query_admission_rejected_total",
+			Help: "Number of searches rejected because the concurrency limit of their class was exhausted",
+		}, []string{"class_name", "reason"}),
+		QueryCost: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "query_cost_total",
+			Help: "Estimated work performed by the queries of a user, by resource (vectors_compared, postings_scanned, objects_loaded, bytes_read)",
+		}, []string{"user", "resource"}),
+		VectorDimensionMismatches: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "vector_dimension_mismatches_total",
+			Help: "Number of generated vectors rejected because their dimensionality differs from the one of their class",
+		}, []string{"class_name", "vectorizer"}),
+		ReplicaInconsistency: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "replica_inconsistency",
+			Help: "Result of the last replica consistency check of a shard, by kind (object_count_difference, mismatched_objects, unreachable_replicas)",
+		}, []string{"class_name", "shard_name", "kind"}),
+		InvertedDeletedRatio: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "inverted_index_deleted_ratio",
+			Help: "Share of the postings of a searchable property which belong to deleted objects and are removed by the next inverted index cleanup",
+		}, []string{"class_name", "shard_name", "property"}),
 
 		StartupProgress: promauto.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "startup_progress",
@@ -0,0 +1,208 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package ipfilter restricts the clients which may connect to a listener
+// based on their IP address, for deployments without an external gateway.
+package ipfilter
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Rules of a single listener. Deny takes precedence over Allow. If Allow is
+// empty, every address which is not denied is allowed.
+type Rules struct {
+	Allow []string `json:"allow"`
+	Deny  []string `json:"deny"`
+}
+
+// Validate that all entries are either CIDR ranges or single IP addresses
+func (r Rules) Validate() error {
+	if _, err := parseNets(r.Allow); err != nil {
+		return fmt.Errorf("allow: %w", err)
+	}
+	if _, err := parseNets(r.Deny); err != nil {
+		return fmt.Errorf("deny: %w", err)
+	}
+	return nil
+}
+
+// Filter is a thread-safe set of rules, which can be replaced at runtime
+type Filter struct {
+	sync.RWMutex
+	rules Rules
+	allow []*net.IPNet
+	deny  []*net.IPNet
+}
+
+func New(rules Rules) (*Filter, error) {
+	f := &Filter{}
+	if err := f.Update(rules); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Update replaces the rules. If the rules are invalid, the previous rules
+// remain active.
+func (f *Filter) Update(rules Rules) error {
+	allow, err := parseNets(rules.Allow)
+	if err != nil {
+		return fmt.Errorf("allow: %w", err)
+	}
+	deny, err := parseNets(rules.Deny)
+	if err != nil {
+		return fmt.Errorf("deny: %w", err)
+	}
+
+	f.Lock()
+	defer f.Unlock()
+	f.rules = rules
+	f.allow = allow
+	f.deny = deny
+	return nil
+}
+
+func (f *Filter) Rules() Rules {
+	f.RLock()
+	defer f.RUnlock()
+	return f.rules
+}
+
+func (f *Filter) Allowed(ip net.IP) bool {
+	f.RLock()
+	defer f.RUnlock()
+
+	if len(f.allow) == 0 && len(f.deny) == 0 {
+		return true
+	}
+
+	if ip == nil {
+		return false
+	}
+
+	for _, n := range f.deny {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+
+	if len(f.allow) == 0 {
+		return true
+	}
+
+	for _, n := range f.allow {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Middleware rejects requests from clients which are not allowed with a 403.
+// Only the address of the direct peer is considered, forwarding headers are
+// ignored as they can be set by any client.
+func (f *Filter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !f.Allowed(remoteIP(r.RemoteAddr)) {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func remoteIP(addr string) net.IP {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+	return net.ParseIP(host)
+}
+
+func parseNets(entries []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid ip address %q", entry)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 8 * net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, n, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cidr %q", entry)
+		}
+		nets = append(nets, n)
+	}
+	return nets, nil
+}
+
+const (
+	ListenerAPI     = "api"
+	ListenerMetrics = "metrics"
+	ListenerCluster = "cluster"
+)
+
+// Listeners holds the filters of all listeners, so they can be inspected and
+// updated at runtime
+type Listeners struct {
+	API     *Filter
+	Metrics *Filter
+	Cluster *Filter
+}
+
+func NewListeners(api, metrics, cluster Rules) (*Listeners, error) {
+	l := &Listeners{}
+	var err error
+	if l.API, err = New(api); err != nil {
+		return nil, fmt.Errorf("%s: %w", ListenerAPI, err)
+	}
+	if l.Metrics, err = New(metrics); err != nil {
+		return nil, fmt.Errorf("%s: %w", ListenerMetrics, err)
+	}
+	if l.Cluster, err = New(cluster); err != nil {
+		return nil, fmt.Errorf("%s: %w", ListenerCluster, err)
+	}
+	return l, nil
+}
+
+// Get returns the filter of the listener with the given name
+func (l *Listeners) Get(name string) (*Filter, bool) {
+	switch name {
+	case ListenerAPI:
+		return l.API, true
+	case ListenerMetrics:
+		return l.Metrics, true
+	case ListenerCluster:
+		return l.Cluster, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ipfilter
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter(t *testing.T) {
+	type test struct {
+		name     string
+		rules    Rules
+		ip       string
+		expected bool
+	}
+
+	tests := []test{
+		{name: "no rules", ip: "1.2.3.4", expected: true},
+		{
+			name:     "allowed by cidr",
+			rules:    Rules{Allow: []string{"10.0.0.0/8"}},
+			ip:       "10.1.2.3",
+			expected: true,
+		},
+		{
+			name:     "not on allowlist",
+			rules:    Rules{Allow: []string{"10.0.0.0/8"}},
+			ip:       "192.168.0.1",
+			expected: false,
+		},
+		{
+			name:     "single address",
+			rules:    Rules{Allow: []string{"192.168.0.1"}},
+			ip:       "192.168.0.1",
+			expected: true,
+		},
+		{
+			name:     "denied",
+			rules:    Rules{Deny: []string{"10.0.0.0/8"}},
+			ip:       "10.1.2.3",
+			expected: false,
+		},
+		{
+			name:     "deny takes precedence",
+			rules:    Rules{Allow: []string{"10.0.0.0/8"}, Deny: []string{"10.1.0.0/16"}},
+			ip:       "10.1.2.3",
+			expected: false,
+		},
+		{
+			name:     "ipv6",
+			rules:    Rules{Allow: []string{"fd00::/8"}},
+			ip:       "fd00::1",
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := New(test.rules)
+			require.Nil(t, err)
+			assert.Equal(t, test.expected, f.Allowed(net.ParseIP(test.ip)))
+		})
+	}
+}
+
+func TestFilterUpdate(t *testing.T) {
+	f, err := New(Rules{Allow: []string{"10.0.0.0/8"}})
+	require.Nil(t, err)
+
+	err = f.Update(Rules{Allow: []string{"not-an-ip"}})
+	assert.EqualError(t, err, `allow: invalid ip address "not-an-ip"`)
+	assert.Equal(t, []string{"10.0.0.0/8"}, f.Rules().Allow)
+
+	require.Nil(t, f.Update(Rules{Deny: []string{"10.0.0.0/8"}}))
+	assert.False(t, f.Allowed(net.ParseIP("10.0.0.1")))
+}
+
+func TestFilterMiddleware(t *testing.T) {
+	f, err := New(Rules{Allow: []string{"127.0.0.1"}})
+	require.Nil(t, err)
+
+	handler := f.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for addr, code := range map[string]int{
+		"127.0.0.1:1234": http.StatusOK,
+		"10.0.0.1:1234":  http.StatusForbidden,
+	} {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.RemoteAddr = addr
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		assert.Equal(t, code, w.Code, addr)
+	}
+}
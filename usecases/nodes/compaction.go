@@ -0,0 +1,47 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nodes
+
+import (
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type compactionScheduler interface {
+	Schedule() lsmkv.CompactionSchedule
+	Update(schedule lsmkv.CompactionSchedule) error
+}
+
+// CompactionSchedule returns the limits of the compactions of this node
+func (m *Manager) CompactionSchedule(principal *models.Principal,
+) (lsmkv.CompactionSchedule, error) {
+	if err := m.authorizer.Authorize(principal, "list", "nodes"); err != nil {
+		return lsmkv.CompactionSchedule{}, err
+	}
+	return m.compaction.Schedule(), nil
+}
+
+// UpdateCompactionSchedule replaces the limits of the compactions of this
+// node. The schedule is not persisted, a restart falls back to the
+// configured one.
+func (m *Manager) UpdateCompactionSchedule(principal *models.Principal,
+	schedule lsmkv.CompactionSchedule,
+) (lsmkv.CompactionSchedule, error) {
+	if err := m.authorizer.Authorize(principal, "update", "nodes"); err != nil {
+		return lsmkv.CompactionSchedule{}, err
+	}
+	if err := m.compaction.Update(schedule); err != nil {
+		return lsmkv.CompactionSchedule{}, enterrors.NewErrUnprocessable(err)
+	}
+	return m.compaction.Schedule(), nil
+}
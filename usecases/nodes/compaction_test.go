@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nodes
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+func TestCompactionSchedule(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	newScheduler := func(t *testing.T) *lsmkv.CompactionScheduler {
+		scheduler, err := lsmkv.NewCompactionScheduler(lsmkv.CompactionSchedule{})
+		require.Nil(t, err)
+		return scheduler
+	}
+
+	t.Run("update", func(t *testing.T) {
+		authorizer := &fakeAuthorizer{}
+		scheduler := newScheduler(t)
+		m := NewManager(logger, authorizer, nil, nil, nil, scheduler)
+
+		schedule := lsmkv.CompactionSchedule{MaxConcurrent: 2, QuietHours: "22:00-06:00"}
+		updated, err := m.UpdateCompactionSchedule(nil, schedule)
+		require.Nil(t, err)
+		assert.Equal(t, schedule, updated)
+
+		got, err := m.CompactionSchedule(nil)
+		require.Nil(t, err)
+		assert.Equal(t, schedule, got)
+		assert.Equal(t, [][2]string{{"update", "nodes"}, {"list", "nodes"}}, authorizer.calls)
+	})
+
+	t.Run("invalid schedule", func(t *testing.T) {
+		m := NewManager(logger, &fakeAuthorizer{}, nil, nil, nil, newScheduler(t))
+
+		_, err := m.UpdateCompactionSchedule(nil, lsmkv.CompactionSchedule{QuietHours: "late"})
+		assert.IsType(t, enterrors.ErrUnprocessable{}, err)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		forbidden := errors.New("forbidden")
+		scheduler := newScheduler(t)
+		m := NewManager(logger, &fakeAuthorizer{err: forbidden}, nil, nil, nil, scheduler)
+
+		_, err := m.CompactionSchedule(nil)
+		assert.Equal(t, forbidden, err)
+		_, err = m.UpdateCompactionSchedule(nil, lsmkv.CompactionSchedule{MaxConcurrent: 2})
+		assert.Equal(t, forbidden, err)
+		assert.Equal(t, lsmkv.CompactionSchedule{}, scheduler.Schedule())
+	})
+}
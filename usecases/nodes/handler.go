@@ -15,7 +15,9 @@ import (
 	"context"
 
 	"github.com/sirupsen/logrus"
+	repodb "github.com/weaviate/weaviate/adapters/repos/db"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 )
 
@@ -25,6 +27,13 @@ type authorizer interface {
 
 type db interface {
 	GetNodeStatuses(ctx context.Context) ([]*models.NodeStatus, error)
+	ReindexVectorIndex(ctx context.Context, className schema.ClassName) error
+	VectorReindexStatus(className schema.ClassName) ([]repodb.VectorReindexStatus, error)
+	CloneShard(ctx context.Context, sourceClass, sourceShard,
+		targetClass, targetShard string) error
+	OffloadIndex(ctx context.Context, className schema.ClassName) error
+	ActivateIndex(ctx context.Context, className schema.ClassName) error
+	OffloadedIndexes() []string
 }
 
 type Manager struct {
@@ -32,12 +41,15 @@ type Manager struct {
 	authorizer    authorizer
 	db            db
 	schemaManager *schemaUC.Manager
+	membership    membership
+	compaction    compactionScheduler
 }
 
 func NewManager(logger logrus.FieldLogger, authorizer authorizer,
-	db db, schemaManager *schemaUC.Manager,
+	db db, schemaManager *schemaUC.Manager, membership membership,
+	compaction compactionScheduler,
 ) *Manager {
-	return &Manager{logger, authorizer, db, schemaManager}
+	return &Manager{logger, authorizer, db, schemaManager, membership, compaction}
 }
 
 func (m *Manager) GetNodeStatuses(ctx context.Context,
@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nodes
+
+import (
+	"fmt"
+	"time"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+// leaveTimeout bounds the time to broadcast that the node leaves
+const leaveTimeout = 10 * time.Second
+
+type membership interface {
+	Members() cluster.MembershipView
+	Join(addresses []string) (int, error)
+	Leave(timeout time.Duration) error
+	Forget(nodeName string) error
+}
+
+// Members returns all nodes the local node knows about, including nodes which
+// left or failed
+func (m *Manager) Members(principal *models.Principal) (cluster.MembershipView, error) {
+	if err := m.authorizer.Authorize(principal, "list", "nodes"); err != nil {
+		return cluster.MembershipView{}, err
+	}
+	return m.membership.Members(), nil
+}
+
+// Join joins the cluster of the nodes with the given gossip addresses and
+// returns the number of nodes which were contacted successfully
+func (m *Manager) Join(principal *models.Principal, addresses []string) (int, error) {
+	if err := m.authorizer.Authorize(principal, "update", "nodes"); err != nil {
+		return 0, err
+	}
+	if len(addresses) == 0 {
+		return 0, enterrors.NewErrUnprocessable(
+			fmt.Errorf("join requires at least one address"))
+	}
+	return m.membership.Join(addresses)
+}
+
+// Leave leaves the cluster gracefully
+func (m *Manager) Leave(principal *models.Principal) error {
+	if err := m.authorizer.Authorize(principal, "update", "nodes"); err != nil {
+		return err
+	}
+	return m.membership.Leave(leaveTimeout)
+}
+
+// Forget removes a node which left or failed from the membership of all
+// nodes, so a node with a new identity can take over its name
+func (m *Manager) Forget(principal *models.Principal, nodeName string) error {
+	if err := m.authorizer.Authorize(principal, "delete", fmt.Sprintf("nodes/%s", nodeName)); err != nil {
+		return err
+	}
+	return m.membership.Forget(nodeName)
+}
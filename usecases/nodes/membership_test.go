@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nodes
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+type fakeAuthorizer struct {
+	err   error
+	calls [][2]string
+}
+
+func (a *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	a.calls = append(a.calls, [2]string{verb, resource})
+	return a.err
+}
+
+type fakeMembership struct {
+	joined    []string
+	left      bool
+	forgotten []string
+}
+
+func (f *fakeMembership) Members() cluster.MembershipView {
+	return cluster.MembershipView{Local: "node1"}
+}
+
+func (f *fakeMembership) Join(addresses []string) (int, error) {
+	f.joined = append(f.joined, addresses...)
+	return len(addresses), nil
+}
+
+func (f *fakeMembership) Leave(timeout time.Duration) error {
+	f.left = true
+	return nil
+}
+
+func (f *fakeMembership) Forget(nodeName string) error {
+	f.forgotten = append(f.forgotten, nodeName)
+	return nil
+}
+
+func TestMembership(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	t.Run("authorized", func(t *testing.T) {
+		authorizer := &fakeAuthorizer{}
+		membership := &fakeMembership{}
+		m := NewManager(logger, authorizer, nil, nil, membership, nil)
+
+		view, err := m.Members(nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "node1", view.Local)
+
+		joined, err := m.Join(nil, []string{"10.0.0.2:7946"})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, joined)
+
+		_, err = m.Join(nil, nil)
+		assert.IsType(t, enterrors.ErrUnprocessable{}, err)
+
+		assert.Nil(t, m.Forget(nil, "node2"))
+		assert.Nil(t, m.Leave(nil))
+
+		assert.Equal(t, []string{"10.0.0.2:7946"}, membership.joined)
+		assert.Equal(t, []string{"node2"}, membership.forgotten)
+		assert.True(t, membership.left)
+		assert.Equal(t, [][2]string{
+			{"list", "nodes"},
+			{"update", "nodes"},
+			{"update", "nodes"},
+			{"delete", "nodes/node2"},
+			{"update", "nodes"},
+		}, authorizer.calls)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		forbidden := errors.New("forbidden")
+		membership := &fakeMembership{}
+		m := NewManager(logger, &fakeAuthorizer{err: forbidden}, nil, nil, membership, nil)
+
+		_, err := m.Members(nil)
+		assert.Equal(t, forbidden, err)
+		_, err = m.Join(nil, []string{"10.0.0.2:7946"})
+		assert.Equal(t, forbidden, err)
+		assert.Equal(t, forbidden, m.Forget(nil, "node2"))
+		assert.Equal(t, forbidden, m.Leave(nil))
+
+		assert.Empty(t, membership.joined)
+		assert.Empty(t, membership.forgotten)
+		assert.False(t, membership.left)
+	})
+}
@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nodes
+
+import (
+	"context"
+	"fmt"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storagestate"
+)
+
+// OffloadedClasses returns the classes whose local shards are offloaded to
+// object storage
+func (m *Manager) OffloadedClasses(ctx context.Context,
+	principal *models.Principal,
+) ([]string, error) {
+	if err := m.authorizer.Authorize(principal, "list", "nodes"); err != nil {
+		return nil, err
+	}
+	return m.offloadedClasses(), nil
+}
+
+// UpdateOffload offloads the local shards of the class to object storage if
+// status is OFFLOADED and activates them again if it is READY. Offloaded
+// classes are also activated on their next access.
+func (m *Manager) UpdateOffload(ctx context.Context, principal *models.Principal,
+	className, status string,
+) ([]string, error) {
+	err := m.authorizer.Authorize(principal, "update",
+		fmt.Sprintf("schema/%s/shards", className))
+	if err != nil {
+		return nil, err
+	}
+
+	switch storagestate.Status(status) {
+	case storagestate.StatusOffloaded:
+		err = m.db.OffloadIndex(ctx, schema.ClassName(className))
+	case storagestate.StatusReady:
+		err = m.db.ActivateIndex(ctx, schema.ClassName(className))
+	default:
+		err = fmt.Errorf("status must be %s or %s",
+			storagestate.StatusOffloaded, storagestate.StatusReady)
+	}
+	if err != nil {
+		return nil, enterrors.NewErrUnprocessable(err)
+	}
+	return m.offloadedClasses(), nil
+}
+
+func (m *Manager) offloadedClasses() []string {
+	if classes := m.db.OffloadedIndexes(); classes != nil {
+		return classes
+	}
+	return []string{}
+}
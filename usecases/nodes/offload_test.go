@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nodes
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+func TestOffload(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	t.Run("offload and activate", func(t *testing.T) {
+		authorizer := &fakeAuthorizer{}
+		m := NewManager(logger, authorizer, &fakeDB{}, nil, nil, nil)
+
+		classes, err := m.OffloadedClasses(ctx, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []string{}, classes)
+
+		classes, err = m.UpdateOffload(ctx, nil, "Existing", "OFFLOADED")
+		require.Nil(t, err)
+		assert.Equal(t, []string{"Existing"}, classes)
+
+		classes, err = m.UpdateOffload(ctx, nil, "Existing", "READY")
+		require.Nil(t, err)
+		assert.Equal(t, []string{}, classes)
+
+		assert.Equal(t, [][2]string{
+			{"list", "nodes"},
+			{"update", "schema/Existing/shards"},
+			{"update", "schema/Existing/shards"},
+		}, authorizer.calls)
+	})
+
+	t.Run("invalid updates", func(t *testing.T) {
+		m := NewManager(logger, &fakeAuthorizer{}, &fakeDB{}, nil, nil, nil)
+
+		_, err := m.UpdateOffload(ctx, nil, "Existing", "HOT")
+		assert.IsType(t, enterrors.ErrUnprocessable{}, err)
+		_, err = m.UpdateOffload(ctx, nil, "Missing", "OFFLOADED")
+		assert.IsType(t, enterrors.ErrUnprocessable{}, err)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		forbidden := errors.New("forbidden")
+		db := &fakeDB{}
+		m := NewManager(logger, &fakeAuthorizer{err: forbidden}, db, nil, nil, nil)
+
+		_, err := m.OffloadedClasses(ctx, nil)
+		assert.Equal(t, forbidden, err)
+		_, err = m.UpdateOffload(ctx, nil, "Existing", "OFFLOADED")
+		assert.Equal(t, forbidden, err)
+		assert.Empty(t, db.offloaded)
+	})
+}
@@ -0,0 +1,48 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nodes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// CloneShard clones a shard on this node into an empty shard of another
+// class on this node, which has to be created beforehand
+func (m *Manager) CloneShard(ctx context.Context, principal *models.Principal,
+	sourceClass, sourceShard, targetClass, targetShard string,
+) error {
+	err := m.authorizer.Authorize(principal, "list",
+		fmt.Sprintf("schema/%s/shards", sourceClass))
+	if err != nil {
+		return err
+	}
+	err = m.authorizer.Authorize(principal, "update",
+		fmt.Sprintf("schema/%s/shards", targetClass))
+	if err != nil {
+		return err
+	}
+
+	if sourceClass == "" || sourceShard == "" || targetClass == "" || targetShard == "" {
+		return enterrors.NewErrUnprocessable(errors.New(
+			"sourceClass, sourceShard, targetClass and targetShard are required"))
+	}
+	err = m.db.CloneShard(ctx, sourceClass, sourceShard, targetClass, targetShard)
+	if err != nil {
+		return enterrors.NewErrUnprocessable(err)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nodes
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+func TestCloneShard(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	t.Run("clone", func(t *testing.T) {
+		authorizer := &fakeAuthorizer{}
+		db := &fakeDB{}
+		m := NewManager(logger, authorizer, db, nil, nil, nil)
+
+		require.Nil(t, m.CloneShard(ctx, nil, "Existing", "shard1", "Target", "shard2"))
+		assert.Equal(t, []string{"Existing/shard1 -> Target/shard2"}, db.cloned)
+		assert.Equal(t, [][2]string{
+			{"list", "schema/Existing/shards"},
+			{"update", "schema/Target/shards"},
+		}, authorizer.calls)
+	})
+
+	t.Run("invalid clones", func(t *testing.T) {
+		m := NewManager(logger, &fakeAuthorizer{}, &fakeDB{}, nil, nil, nil)
+
+		err := m.CloneShard(ctx, nil, "Existing", "shard1", "Target", "")
+		assert.IsType(t, enterrors.ErrUnprocessable{}, err)
+		err = m.CloneShard(ctx, nil, "Missing", "shard1", "Target", "shard2")
+		assert.IsType(t, enterrors.ErrUnprocessable{}, err)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		forbidden := errors.New("forbidden")
+		db := &fakeDB{}
+		m := NewManager(logger, &fakeAuthorizer{err: forbidden}, db, nil, nil, nil)
+
+		err := m.CloneShard(ctx, nil, "Existing", "shard1", "Target", "shard2")
+		assert.Equal(t, forbidden, err)
+		assert.Empty(t, db.cloned)
+	})
+}
@@ -0,0 +1,57 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nodes
+
+import (
+	"context"
+	"fmt"
+
+	repodb "github.com/weaviate/weaviate/adapters/repos/db"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// ReindexVectorIndex starts to rebuild the vector indexes of the shards of
+// the class on this node and returns their progress
+func (m *Manager) ReindexVectorIndex(ctx context.Context, principal *models.Principal,
+	className string,
+) ([]repodb.VectorReindexStatus, error) {
+	err := m.authorizer.Authorize(principal, "update",
+		fmt.Sprintf("schema/%s/shards", className))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.db.ReindexVectorIndex(ctx, schema.ClassName(className)); err != nil {
+		return nil, enterrors.NewErrUnprocessable(err)
+	}
+	return m.db.VectorReindexStatus(schema.ClassName(className))
+}
+
+// VectorReindexStatus returns the progress of the last rebuild of the vector
+// indexes of the shards of the class on this node
+func (m *Manager) VectorReindexStatus(ctx context.Context, principal *models.Principal,
+	className string,
+) ([]repodb.VectorReindexStatus, error) {
+	err := m.authorizer.Authorize(principal, "list",
+		fmt.Sprintf("schema/%s/shards", className))
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := m.db.VectorReindexStatus(schema.ClassName(className))
+	if err != nil {
+		return nil, enterrors.NewErrNotFound(err)
+	}
+	return statuses, nil
+}
@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nodes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	repodb "github.com/weaviate/weaviate/adapters/repos/db"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+type fakeDB struct {
+	reindexed []schema.ClassName
+	cloned    []string
+	offloaded []string
+}
+
+func (f *fakeDB) GetNodeStatuses(ctx context.Context) ([]*models.NodeStatus, error) {
+	return nil, nil
+}
+
+func (f *fakeDB) ReindexVectorIndex(ctx context.Context, className schema.ClassName) error {
+	if className != "Existing" {
+		return fmt.Errorf("class %s does not exist", className)
+	}
+	f.reindexed = append(f.reindexed, className)
+	return nil
+}
+
+func (f *fakeDB) VectorReindexStatus(className schema.ClassName,
+) ([]repodb.VectorReindexStatus, error) {
+	if className != "Existing" {
+		return nil, fmt.Errorf("class %s does not exist", className)
+	}
+	return []repodb.VectorReindexStatus{{Shard: "shard1", Status: repodb.VectorReindexRunning}}, nil
+}
+
+func (f *fakeDB) CloneShard(ctx context.Context, sourceClass, sourceShard,
+	targetClass, targetShard string,
+) error {
+	if sourceClass != "Existing" {
+		return fmt.Errorf("class %s does not exist", sourceClass)
+	}
+	f.cloned = append(f.cloned, fmt.Sprintf("%s/%s -> %s/%s",
+		sourceClass, sourceShard, targetClass, targetShard))
+	return nil
+}
+
+func (f *fakeDB) OffloadIndex(ctx context.Context, className schema.ClassName) error {
+	if className != "Existing" {
+		return fmt.Errorf("class %s does not exist", className)
+	}
+	f.offloaded = append(f.offloaded, string(className))
+	return nil
+}
+
+func (f *fakeDB) ActivateIndex(ctx context.Context, className schema.ClassName) error {
+	if className != "Existing" {
+		return fmt.Errorf("class %s does not exist", className)
+	}
+	f.offloaded = nil
+	return nil
+}
+
+func (f *fakeDB) OffloadedIndexes() []string {
+	return f.offloaded
+}
+
+func TestVectorReindex(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	t.Run("start and status", func(t *testing.T) {
+		authorizer := &fakeAuthorizer{}
+		db := &fakeDB{}
+		m := NewManager(logger, authorizer, db, nil, nil, nil)
+
+		statuses, err := m.ReindexVectorIndex(ctx, nil, "Existing")
+		require.Nil(t, err)
+		assert.Len(t, statuses, 1)
+		assert.Equal(t, []schema.ClassName{"Existing"}, db.reindexed)
+
+		statuses, err = m.VectorReindexStatus(ctx, nil, "Existing")
+		require.Nil(t, err)
+		assert.Equal(t, repodb.VectorReindexRunning, statuses[0].Status)
+		assert.Equal(t, [][2]string{
+			{"update", "schema/Existing/shards"},
+			{"list", "schema/Existing/shards"},
+		}, authorizer.calls)
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		m := NewManager(logger, &fakeAuthorizer{}, &fakeDB{}, nil, nil, nil)
+
+		_, err := m.ReindexVectorIndex(ctx, nil, "Missing")
+		assert.IsType(t, enterrors.ErrUnprocessable{}, err)
+		_, err = m.VectorReindexStatus(ctx, nil, "Missing")
+		assert.IsType(t, enterrors.ErrNotFound{}, err)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		forbidden := errors.New("forbidden")
+		db := &fakeDB{}
+		m := NewManager(logger, &fakeAuthorizer{err: forbidden}, db, nil, nil, nil)
+
+		_, err := m.ReindexVectorIndex(ctx, nil, "Existing")
+		assert.Equal(t, forbidden, err)
+		_, err = m.VectorReindexStatus(ctx, nil, "Existing")
+		assert.Equal(t, forbidden, err)
+		assert.Empty(t, db.reindexed)
+	})
+}
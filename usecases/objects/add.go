@@ -56,12 +56,21 @@ func (m *Manager) AddObject(ctx context.Context, principal *models.Principal,
 	return m.addObjectToConnectorAndSchema(ctx, principal, object, repl)
 }
 
-func (m *Manager) checkIDOrAssignNew(ctx context.Context, class string,
-	id strfmt.UUID, repl *additional.ReplicationProperties,
+func (m *Manager) checkIDOrAssignNew(ctx context.Context, principal *models.Principal,
+	class string, id strfmt.UUID, props interface{}, repl *additional.ReplicationProperties,
 ) (strfmt.UUID, error) {
 	if id == "" {
-		newID, err := generateUUID()
+		// the class is nil if it is yet to be created by auto schema, which
+		// then uses the default strategy
+		c, err := m.schemaManager.GetClass(ctx, principal, class)
 		if err != nil {
+			return "", err
+		}
+		newID, err := generateID(c, props)
+		if err != nil {
+			if _, ok := err.(ErrInvalidUserInput); ok {
+				return "", err
+			}
 			return "", NewErrInternal("could not generate id: %v", err)
 		}
 		return newID, nil
@@ -86,7 +95,8 @@ func (m *Manager) checkIDOrAssignNew(ctx context.Context, class string,
 func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *models.Principal,
 	object *models.Object, repl *additional.ReplicationProperties,
 ) (*models.Object, error) {
-	id, err := m.checkIDOrAssignNew(ctx, object.Class, object.ID, repl)
+	id, err := m.checkIDOrAssignNew(ctx, principal, object.Class, object.ID,
+		object.Properties, repl)
 	if err != nil {
 		return nil, err
 	}
@@ -112,8 +122,24 @@ func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *
 	if err != nil {
 		return nil, err
 	}
+	props := object.Properties.(map[string]interface{})
+	if err := applyReferenceSnapshots(ctx, class, props, nil, m.findObject); err != nil {
+		return nil, NewErrInternal("add object: %v", err)
+	}
+	if err := m.blobs.inlineForVectorizer(ctx, class, object.ID, props); err != nil {
+		return nil, err
+	}
 	err = m.modulesProvider.UpdateVector(ctx, object, class, nil, m.findObject, m.logger)
 	if err != nil {
+		if dimsErr, ok := vectorDimensionsInput(err); ok {
+			return nil, dimsErr
+		}
+		return nil, err
+	}
+	if err := applyVectorValidation(class, object); err != nil {
+		return nil, err
+	}
+	if err := m.blobs.offload(ctx, class, object.ID, props); err != nil {
 		return nil, err
 	}
 
@@ -121,6 +147,10 @@ func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *
 	if err != nil {
 		return nil, fmt.Errorf("put object: %s", err)
 	}
+	m.shadows.mirrorPut(object)
+	if err := m.chunks.chunk(ctx, principal, []*models.Object{object}, m.findObject, repl)[0]; err != nil {
+		return nil, NewErrInternal("chunk object: %v", err)
+	}
 
 	return object, nil
 }
@@ -74,7 +74,7 @@ func Test_Add_Object_WithNoVectorizerModule(t *testing.T) {
 		modulesProvider = getFakeModulesProvider()
 		metrics := &fakeMetrics{}
 		manager = NewManager(locks, schemaManager, cfg, logger, authorizer,
-			vectorRepo, modulesProvider, metrics)
+			vectorRepo, modulesProvider, metrics, nil, nil)
 	}
 
 	reset := func() {
@@ -269,7 +269,7 @@ func Test_Add_Object_WithExternalVectorizerModule(t *testing.T) {
 		modulesProvider = getFakeModulesProvider()
 		modulesProvider.On("UsingRef2Vec", mock.Anything).Return(false)
 		manager = NewManager(locks, schemaManager, cfg, logger, authorizer,
-			vectorRepo, modulesProvider, metrics)
+			vectorRepo, modulesProvider, metrics, nil, nil)
 	}
 
 	t.Run("without an id set", func(t *testing.T) {
@@ -382,7 +382,7 @@ func Test_Add_Object_OverrideVectorizer(t *testing.T) {
 		modulesProvider = getFakeModulesProvider()
 		metrics := &fakeMetrics{}
 		manager = NewManager(locks, schemaManager, cfg, logger,
-			authorizer, vectorRepo, modulesProvider, metrics)
+			authorizer, vectorRepo, modulesProvider, metrics, nil, nil)
 	}
 
 	t.Run("overriding the vector by explicitly specifying it", func(t *testing.T) {
@@ -442,7 +442,7 @@ func Test_AddObjectEmptyProperties(t *testing.T) {
 		modulesProvider = getFakeModulesProvider()
 		metrics := &fakeMetrics{}
 		manager = NewManager(locks, schemaManager, cfg, logger,
-			authorizer, vectorRepo, modulesProvider, metrics)
+			authorizer, vectorRepo, modulesProvider, metrics, nil, nil)
 	}
 	reset()
 	ctx := context.Background()
@@ -498,7 +498,7 @@ func Test_AddObjectWithUUIDProps(t *testing.T) {
 		modulesProvider = getFakeModulesProvider()
 		metrics := &fakeMetrics{}
 		manager = NewManager(locks, schemaManager, cfg, logger,
-			authorizer, vectorRepo, modulesProvider, metrics)
+			authorizer, vectorRepo, modulesProvider, metrics, nil, nil)
 	}
 	reset()
 	ctx := context.Background()
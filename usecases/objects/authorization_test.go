@@ -91,6 +91,27 @@ func Test_Kinds_Authorization(t *testing.T) {
 			expectedVerb:     "update",
 			expectedResource: "objects/class/foo",
 		},
+		{
+			methodName: "MergeObjects",
+			additionalArgs: []interface{}{
+				[]*models.Object{}, false,
+				(*additional.ReplicationProperties)(nil),
+			},
+			expectedVerb:     "update",
+			expectedResource: "batch/objects",
+		},
+		{
+			methodName:       "GetObjectAtVersion",
+			additionalArgs:   []interface{}{"class", strfmt.UUID("foo"), int64(1), additional.Properties{}},
+			expectedVerb:     "get",
+			expectedResource: "objects/class/foo",
+		},
+		{
+			methodName:       "GetObjectBlob",
+			additionalArgs:   []interface{}{"class", strfmt.UUID("foo"), "prop"},
+			expectedVerb:     "get",
+			expectedResource: "objects/class/foo",
+		},
 		{
 			methodName:       "GetObjectsClass",
 			additionalArgs:   []interface{}{strfmt.UUID("foo")},
@@ -172,7 +193,7 @@ func Test_Kinds_Authorization(t *testing.T) {
 				vectorRepo := &fakeVectorRepo{}
 				manager := NewManager(locks, schemaManager,
 					cfg, logger, authorizer,
-					vectorRepo, getFakeModulesProvider(), nil)
+					vectorRepo, getFakeModulesProvider(), nil, nil, nil)
 
 				args := append([]interface{}{context.Background(), principal}, test.additionalArgs...)
 				out, _ := callFuncByName(manager, test.methodName, args...)
@@ -212,6 +233,7 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 			additionalArgs: []interface{}{
 				[]*models.Object{},
 				[]*string{},
+				false,
 				&additional.ReplicationProperties{},
 			},
 			expectedVerb:     "create",
@@ -262,7 +284,7 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 			authorizer := &authDenier{}
 			vectorRepo := &fakeVectorRepo{}
 			modulesProvider := getFakeModulesProvider()
-			manager := NewBatchManager(vectorRepo, modulesProvider, locks, schemaManager, cfg, logger, authorizer, nil)
+			manager := NewBatchManager(vectorRepo, modulesProvider, locks, schemaManager, cfg, logger, authorizer, nil, nil, nil)
 
 			args := append([]interface{}{context.Background(), principal}, test.additionalArgs...)
 			out, _ := callFuncByName(manager, test.methodName, args...)
@@ -21,13 +21,17 @@ import (
 	"github.com/google/uuid"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
+	"github.com/weaviate/weaviate/entities/hlc"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/objects/validation"
 )
 
-// AddObjects Class Instances in batch to the connected DB
+// AddObjects Class Instances in batch to the connected DB. With upsert,
+// objects without a vector keep the vector of the stored object as long as
+// none of their vectorized properties changed.
 func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Principal,
-	objects []*models.Object, fields []*string, repl *additional.ReplicationProperties,
+	objects []*models.Object, fields []*string, upsert bool,
+	repl *additional.ReplicationProperties,
 ) (BatchObjects, error) {
 	err := b.authorizer.Authorize(principal, "create", "batch/objects")
 	if err != nil {
@@ -42,37 +46,70 @@ func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Princip
 
 	before := time.Now()
 	b.metrics.BatchInc()
-	defer b.metrics.BatchOp("total_uc_level", before.UnixNano())
+	defer b.metrics.BatchOp(ctx, "total_uc_level", before.UnixNano())
 	defer b.metrics.BatchDec()
 
-	return b.addObjects(ctx, principal, objects, fields, repl)
+	return b.addObjects(ctx, principal, objects, fields, upsert, repl)
 }
 
 func (b *BatchManager) addObjects(ctx context.Context, principal *models.Principal,
-	classes []*models.Object, fields []*string, repl *additional.ReplicationProperties,
+	classes []*models.Object, fields []*string, upsert bool,
+	repl *additional.ReplicationProperties,
 ) (BatchObjects, error) {
 	beforePreProcessing := time.Now()
 	if err := b.validateObjectForm(classes); err != nil {
 		return nil, NewErrInvalidUserInput("invalid param 'objects': %v", err)
 	}
 
-	batchObjects := b.validateObjectsConcurrently(ctx, principal, classes, fields, repl)
-	b.metrics.BatchOp("total_preprocessing", beforePreProcessing.UnixNano())
-
-	var (
-		res BatchObjects
-		err error
-	)
+	batchObjects, err := b.validateObjectsConcurrently(ctx, principal, classes, fields, upsert, repl)
+	b.metrics.BatchOp(ctx, "total_preprocessing", beforePreProcessing.UnixNano())
+	if err != nil {
+		return nil, err
+	}
 
+	var res BatchObjects
 	beforePersistence := time.Now()
-	defer b.metrics.BatchOp("total_persistence_level", beforePersistence.UnixNano())
+	defer b.metrics.BatchOp(ctx, "total_persistence_level", beforePersistence.UnixNano())
 	if res, err = b.vectorRepo.BatchPutObjects(ctx, batchObjects, repl); err != nil {
 		return nil, NewErrInternal("batch objects: %#v", err)
 	}
+	b.mirrorBatch(res)
+	b.chunkBatch(ctx, principal, res, repl)
 
 	return res, nil
 }
 
+// chunkBatch replaces the chunks of the written objects, objects whose
+// chunks can't be written are reported as failed
+func (b *BatchManager) chunkBatch(ctx context.Context, principal *models.Principal,
+	res BatchObjects, repl *additional.ReplicationProperties,
+) {
+	objects := make([]*models.Object, len(res))
+	for i, obj := range res {
+		if obj.Err == nil {
+			objects[i] = obj.Object
+		}
+	}
+	for i, err := range b.chunks.chunk(ctx, principal, objects, b.findObject, repl) {
+		if err != nil {
+			res[i].Err = fmt.Errorf("chunk object: %w", err)
+		}
+	}
+}
+
+func (b *BatchManager) mirrorBatch(res BatchObjects) {
+	if b.shadows == nil {
+		return
+	}
+	objects := make([]*models.Object, 0, len(res))
+	for _, obj := range res {
+		if obj.Err == nil {
+			objects = append(objects, obj.Object)
+		}
+	}
+	b.shadows.mirrorPut(objects...)
+}
+
 func (b *BatchManager) validateObjectForm(classes []*models.Object) error {
 	if len(classes) == 0 {
 		return fmt.Errorf("cannot be empty, need at least one object for batching")
@@ -82,27 +119,93 @@ func (b *BatchManager) validateObjectForm(classes []*models.Object) error {
 }
 
 func (b *BatchManager) validateObjectsConcurrently(ctx context.Context, principal *models.Principal,
-	classes []*models.Object, fields []*string, repl *additional.ReplicationProperties,
-) BatchObjects {
+	classes []*models.Object, fields []*string, upsert bool,
+	repl *additional.ReplicationProperties,
+) (BatchObjects, error) {
 	fieldsToKeep := determineResponseFields(fields)
 	c := make(chan BatchObject, len(classes))
+	// all objects of a batch share a timestamp, the hybrid logical clock
+	// would run ahead of the wall clock if every object ticked it
+	now := unixNow()
+
+	// the class of every valid object, it is needed again for vectorization
+	validClasses := make([]*models.Class, len(classes))
 
 	wg := new(sync.WaitGroup)
 
 	// Generate a goroutine for each separate request
 	for i, object := range classes {
 		wg.Add(1)
-		go b.validateObject(ctx, principal, wg, object, i, &c, fieldsToKeep, repl)
+		go b.validateObject(ctx, principal, wg, object, i, &c, fieldsToKeep, upsert, repl, now,
+			validClasses)
 	}
 
 	wg.Wait()
 	close(c)
-	return objectsChanToSlice(c)
+
+	batchObjects := objectsChanToSlice(c)
+	if err := b.vectorizeObjects(ctx, batchObjects, validClasses); err != nil {
+		return nil, err
+	}
+	return batchObjects, nil
+}
+
+// vectorizeObjects vectorizes all valid objects of the batch at once, so
+// that vectorizers can combine the objects into as few requests as possible.
+// The whole batch fails if a vectorizer generated a vector of the wrong
+// dimensionality, as the vectorizer's model most likely changed.
+func (b *BatchManager) vectorizeObjects(ctx context.Context,
+	batchObjects BatchObjects, classes []*models.Class,
+) error {
+	objects := make([]*models.Object, len(batchObjects))
+	for i := range batchObjects {
+		if classes[i] != nil {
+			objects[i] = batchObjects[i].Object
+		}
+	}
+
+	errs := b.modulesProvider.BatchUpdateVector(ctx, objects, classes, b.findObject, b.logger)
+	for _, err := range errs {
+		if dimsErr, ok := vectorDimensionsInput(err); ok {
+			return dimsErr
+		}
+	}
+
+	wg := new(sync.WaitGroup)
+	for i := range batchObjects {
+		if objects[i] == nil {
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ec := &errorcompounder.ErrorCompounder{}
+			ec.Add(errs[i])
+
+			object := batchObjects[i].Object
+			err := applyVectorValidation(classes[i], object)
+			ec.Add(err)
+
+			// blobs are only offloaded if the object is valid
+			if ec.ToError() == nil {
+				props, _ := object.Properties.(map[string]interface{})
+				err = b.blobs.offload(ctx, classes[i], object.ID, props)
+				ec.Add(err)
+			}
+
+			batchObjects[i].Err = ec.ToError()
+			batchObjects[i].Vector = object.Vector
+		}(i)
+	}
+	wg.Wait()
+	return nil
 }
 
 func (b *BatchManager) validateObject(ctx context.Context, principal *models.Principal,
 	wg *sync.WaitGroup, concept *models.Object, originalIndex int, resultsC *chan BatchObject,
-	fieldsToKeep map[string]struct{}, repl *additional.ReplicationProperties,
+	fieldsToKeep map[string]struct{}, upsert bool, repl *additional.ReplicationProperties,
+	now int64, validClasses []*models.Class,
 ) {
 	defer wg.Done()
 
@@ -114,12 +217,7 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 	err := b.autoSchemaManager.autoSchema(ctx, principal, concept)
 	ec.Add(err)
 
-	if concept.ID == "" {
-		// Generate UUID for the new object
-		uid, err := generateUUID()
-		id = uid
-		ec.Add(err)
-	} else {
+	if concept.ID != "" {
 		if _, err := uuid.Parse(concept.ID.String()); err != nil {
 			ec.Add(err)
 		}
@@ -142,7 +240,6 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 	if object.Properties == nil {
 		object.Properties = map[string]interface{}{}
 	}
-	now := unixNow()
 	if _, ok := fieldsToKeep["creationTimeUnix"]; ok {
 		object.CreationTimeUnix = now
 	}
@@ -154,12 +251,36 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 	if class == nil {
 		ec.Add(fmt.Errorf("class '%s' not present in schema", object.Class))
 	} else {
+		if id == "" {
+			// the id of a new object depends on the strategy of its class
+			id, err = generateID(class, concept.Properties)
+			object.ID = id
+			ec.Add(err)
+		}
+
 		// not possible without the class being present
 		err = validation.New(b.vectorRepo.Exists, b.config, repl).Object(ctx, object, class)
 		ec.Add(err)
 
-		err = b.modulesProvider.UpdateVector(ctx, object, class, nil, b.findObject, b.logger)
+		props, _ := object.Properties.(map[string]interface{})
+		if ec.ToError() == nil {
+			err = applyReferenceSnapshots(ctx, class, props, nil, b.findObject)
+			ec.Add(err)
+		}
+
+		if upsert && ec.ToError() == nil {
+			err = b.keepUnchangedVector(ctx, class, object, repl)
+			ec.Add(err)
+		}
+
+		err = b.blobs.inlineForVectorizer(ctx, class, id, props)
 		ec.Add(err)
+
+		// valid objects are vectorized together once the whole batch is
+		// validated
+		if ec.ToError() == nil {
+			validClasses[originalIndex] = class
+		}
 	}
 
 	*resultsC <- BatchObject{
@@ -181,5 +302,5 @@ func objectsChanToSlice(c chan BatchObject) BatchObjects {
 }
 
 func unixNow() int64 {
-	return time.Now().UnixNano() / int64(time.Millisecond)
+	return hlc.Now()
 }
@@ -13,6 +13,8 @@ package objects
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -21,10 +23,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/modules"
 )
 
 func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
@@ -71,7 +76,7 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 		authorizer := &fakeAuthorizer{}
 		modulesProvider = getFakeModulesProvider()
 		manager = NewBatchManager(vectorRepo, modulesProvider, locks,
-			schemaManager, config, logger, authorizer, nil)
+			schemaManager, config, logger, authorizer, nil, nil, nil)
 	}
 
 	reset := func() {
@@ -84,7 +89,7 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 		expectedErr := NewErrInvalidUserInput("invalid param 'objects': cannot be empty, need at least" +
 			" one object for batching")
 
-		_, err := manager.AddObjects(ctx, nil, []*models.Object{}, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, []*models.Object{}, []*string{}, false, nil)
 
 		assert.Equal(t, expectedErr, err)
 	})
@@ -108,7 +113,7 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 				Return(nil, nil)
 		}
 
-		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, false, nil)
 		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
 
 		assert.Nil(t, err)
@@ -144,7 +149,7 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 				Return(nil, nil)
 		}
 
-		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, false, nil)
 		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
 
 		assert.Nil(t, err)
@@ -184,7 +189,7 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 				Return(nil, nil)
 		}
 
-		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, false, nil)
 		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
 
 		assert.Nil(t, err)
@@ -222,7 +227,7 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 				Return(nil, nil)
 		}
 
-		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, false, nil)
 		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
 
 		assert.Nil(t, err)
@@ -255,7 +260,7 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 				Return(nil, nil)
 		}
 
-		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, false, nil)
 		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
 
 		assert.Nil(t, err)
@@ -295,7 +300,7 @@ func Test_BatchManager_AddObjects_WithExternalVectorizerModule(t *testing.T) {
 		authorizer := &fakeAuthorizer{}
 		modulesProvider = getFakeModulesProvider()
 		manager = NewBatchManager(vectorRepo, modulesProvider, locks,
-			schemaManager, config, logger, authorizer, nil)
+			schemaManager, config, logger, authorizer, nil, nil, nil)
 	}
 
 	ctx := context.Background()
@@ -305,7 +310,7 @@ func Test_BatchManager_AddObjects_WithExternalVectorizerModule(t *testing.T) {
 		expectedErr := NewErrInvalidUserInput("invalid param 'objects': cannot be empty, need at least" +
 			" one object for batching")
 
-		_, err := manager.AddObjects(ctx, nil, []*models.Object{}, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, []*models.Object{}, []*string{}, false, nil)
 
 		assert.Equal(t, expectedErr, err)
 	})
@@ -328,7 +333,7 @@ func Test_BatchManager_AddObjects_WithExternalVectorizerModule(t *testing.T) {
 				Return(expectedVector, nil)
 		}
 
-		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, false, nil)
 		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
 
 		assert.Nil(t, err)
@@ -364,7 +369,7 @@ func Test_BatchManager_AddObjects_WithExternalVectorizerModule(t *testing.T) {
 				Return(nil, nil)
 		}
 
-		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, false, nil)
 		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
 
 		assert.Nil(t, err)
@@ -394,7 +399,7 @@ func Test_BatchManager_AddObjects_WithExternalVectorizerModule(t *testing.T) {
 				Return(nil, nil)
 		}
 
-		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, false, nil)
 		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
 
 		assert.Nil(t, err)
@@ -402,6 +407,25 @@ func Test_BatchManager_AddObjects_WithExternalVectorizerModule(t *testing.T) {
 		assert.Equal(t, repoCalledWithObjects[0].Err.Error(), fmt.Sprintf("invalid UUID length: %d", len(id1)))
 		assert.Equal(t, id2, repoCalledWithObjects[1].UUID, "the user-specified uuid was used")
 	})
+
+	t.Run("with a vector of the wrong dimensionality", func(t *testing.T) {
+		reset()
+		objects := []*models.Object{{Class: "Foo"}, {Class: "Foo"}}
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return([]float32{0, 1, 2}, nil).Once()
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return([]float32{0, 1}, modules.ErrVectorDimensions{
+				Class: "Foo", Vectorizer: "text2vec-contextionary", Dimensions: 2,
+				Reason: "other vectors of the batch have 3 dimensions",
+			}).Once()
+
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, false, nil)
+
+		var invalid ErrInvalidUserInput
+		require.True(t, errors.As(err, &invalid))
+		assert.Contains(t, err.Error(), modules.VectorDimensionMismatch)
+		vectorRepo.AssertNotCalled(t, "BatchPutObjects", mock.Anything)
+	})
 }
 
 func Test_BatchManager_AddObjectsEmptyProperties(t *testing.T) {
@@ -439,7 +463,7 @@ func Test_BatchManager_AddObjectsEmptyProperties(t *testing.T) {
 		authorizer := &fakeAuthorizer{}
 		modulesProvider = getFakeModulesProvider()
 		manager = NewBatchManager(vectorRepo, modulesProvider, locks,
-			schemaManager, config, logger, authorizer, nil)
+			schemaManager, config, logger, authorizer, nil, nil, nil)
 	}
 	reset()
 	objects := []*models.Object{
@@ -463,9 +487,115 @@ func Test_BatchManager_AddObjectsEmptyProperties(t *testing.T) {
 		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
 			Return(nil, nil)
 	}
-	addedObjects, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+	addedObjects, err := manager.AddObjects(ctx, nil, objects, []*string{}, false, nil)
 	assert.Nil(t, err)
 	require.Len(t, addedObjects, 2)
 	require.NotNil(t, addedObjects[0].Object.Properties)
 	require.NotNil(t, addedObjects[1].Object.Properties)
 }
+
+func Test_BatchManager_AddObjects_Upsert(t *testing.T) {
+	var (
+		vectorRepo      *fakeVectorRepo
+		modulesProvider *fakeModulesProvider
+		manager         *BatchManager
+
+		unchanged    = strfmt.UUID("cf918366-3d3b-4b90-9bc6-bc5ea8762ff6")
+		changed      = strfmt.UUID("cf918366-3d3b-4b90-9bc6-bc5ea8762ff3")
+		storedVector = []float32{0.1, 0.2}
+		newVector    = []float32{1, 2}
+	)
+	skip := map[string]interface{}{"text2vec-contextionary": map[string]interface{}{"skip": true}}
+	schema := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:             "Article",
+					Vectorizer:        "text2vec-contextionary",
+					VectorIndexConfig: hnsw.UserConfig{},
+					Properties: []*models.Property{
+						{Name: "title", DataType: []string{"text"}},
+						{Name: "views", DataType: []string{"int"}},
+						{Name: "slug", DataType: []string{"text"}, ModuleConfig: skip},
+					},
+				},
+			},
+		},
+	}
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+		vectorRepo.On("Object", "Article", mock.Anything, search.SelectProperties(nil), additional.Properties{}).
+			Return(&search.Result{
+				ClassName: "Article",
+				Schema: map[string]interface{}{
+					"title": "stored title",
+					"views": int64(1),
+					"slug":  "stored-title",
+				},
+				Vector: storedVector,
+			}, nil)
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema,
+		}
+		logger, _ := test.NewNullLogger()
+		modulesProvider = getFakeModulesProvider()
+		modulesProvider.On("UsingRef2Vec", "Article").Return(false)
+		// the fake vectorizer only vectorizes objects without a vector, like
+		// the real ones
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Run(func(args mock.Arguments) {
+				if obj := args.Get(0).(*models.Object); obj.Vector == nil {
+					obj.Vector = newVector
+				}
+			}).Return(nil, nil)
+		manager = NewBatchManager(vectorRepo, modulesProvider, &fakeLocks{},
+			schemaManager, &config.WeaviateConfig{}, logger, &fakeAuthorizer{}, nil, nil, nil)
+	}
+
+	objects := func() []*models.Object {
+		return []*models.Object{
+			{
+				ID:    unchanged,
+				Class: "Article",
+				Properties: map[string]interface{}{
+					"title": "stored title",
+					"views": json.Number("2"),
+					"slug":  "renamed",
+				},
+			},
+			{
+				ID:    changed,
+				Class: "Article",
+				Properties: map[string]interface{}{
+					"title": "changed title",
+				},
+			},
+		}
+	}
+	ctx := context.Background()
+
+	t.Run("with upsert", func(t *testing.T) {
+		reset()
+		res, err := manager.AddObjects(ctx, nil, objects(), []*string{}, true, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		require.Nil(t, res[0].Err)
+		require.Nil(t, res[1].Err)
+		assert.Equal(t, storedVector, res[0].Vector,
+			"only properties which aren't vectorized changed")
+		assert.Equal(t, newVector, res[1].Vector)
+	})
+
+	t.Run("without upsert", func(t *testing.T) {
+		reset()
+		res, err := manager.AddObjects(ctx, nil, objects(), []*string{}, false, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, newVector, res[0].Vector)
+		assert.Equal(t, newVector, res[1].Vector)
+		vectorRepo.AssertNotCalled(t, "Object", mock.Anything, mock.Anything,
+			mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+const (
+	// minSuggestedBatchSize keeps clients making progress under full load
+	minSuggestedBatchSize = 10
+	// maxSuggestedBatchDelay is suggested to clients under full load
+	maxSuggestedBatchDelay = 5 * time.Second
+)
+
+// BatchLoad is the load of the node which is relevant to batch imports
+type BatchLoad struct {
+	// QueueDepth is the number of objects waiting to be indexed
+	QueueDepth    int
+	QueueCapacity int
+	// MemoryRatio is the share of the memory limit in use
+	MemoryRatio float64
+}
+
+// BatchLoadReporter is implemented by vector repos which can report their
+// load, see db.DB.BatchLoad
+type BatchLoadReporter interface {
+	BatchLoad() BatchLoad
+}
+
+// BatchHint tells clients how to size and pace their next batch
+type BatchHint struct {
+	QueueDepth         int
+	SuggestedBatchSize int
+	SuggestedDelay     time.Duration
+}
+
+// BatchBackpressure tracks the objects of all batches in flight and derives
+// hints from them and the load of the node. In blocking mode, batches wait
+// for capacity before they are processed.
+type BatchBackpressure struct {
+	cfg    config.BatchBackpressure
+	memUse config.MemUse
+	load   BatchLoadReporter
+
+	sync.Mutex
+	inFlight int
+	// released is closed and replaced whenever objects are released
+	released chan struct{}
+}
+
+func NewBatchBackpressure(cfg config.BatchBackpressure, memUse config.MemUse,
+	load BatchLoadReporter,
+) *BatchBackpressure {
+	if cfg.MaxSuggestedSize <= 0 {
+		cfg.MaxSuggestedSize = config.DefaultBatchMaxSuggestedSize
+	}
+	return &BatchBackpressure{
+		cfg:      cfg,
+		memUse:   memUse,
+		load:     load,
+		released: make(chan struct{}),
+	}
+}
+
+// Acquire reserves capacity for n objects, the returned function releases
+// it. In blocking mode it waits until the objects fit into the configured
+// limit, a batch is always admitted if nothing else is in flight so that
+// oversized batches make progress.
+func (bp *BatchBackpressure) Acquire(ctx context.Context, n int) (func(), error) {
+	for {
+		bp.Lock()
+		if !bp.cfg.Blocking || bp.cfg.MaxInFlightObjects <= 0 || bp.inFlight == 0 ||
+			bp.inFlight+n <= bp.cfg.MaxInFlightObjects {
+			bp.inFlight += n
+			bp.Unlock()
+			return func() { bp.release(n) }, nil
+		}
+		released := bp.released
+		bp.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-released:
+		}
+	}
+}
+
+func (bp *BatchBackpressure) release(n int) {
+	bp.Lock()
+	defer bp.Unlock()
+
+	bp.inFlight -= n
+	close(bp.released)
+	bp.released = make(chan struct{})
+}
+
+// Hint returns the current hint for clients of the batch API
+func (bp *BatchBackpressure) Hint() BatchHint {
+	bp.Lock()
+	inFlight := bp.inFlight
+	bp.Unlock()
+
+	var load BatchLoad
+	if bp.load != nil {
+		load = bp.load.BatchLoad()
+	}
+
+	// the pressure is the highest of the individual utilizations, between
+	// 0 for an idle node and 1 for a node which should not take more work
+	pressure := 0.0
+	if load.QueueCapacity > 0 {
+		pressure = math.Max(pressure, float64(load.QueueDepth)/float64(load.QueueCapacity))
+	}
+	if bp.cfg.MaxInFlightObjects > 0 {
+		pressure = math.Max(pressure, float64(inFlight)/float64(bp.cfg.MaxInFlightObjects))
+	}
+	pressure = math.Max(pressure, bp.memoryPressure(load.MemoryRatio))
+	pressure = math.Min(pressure, 1)
+
+	size := int(math.Round(float64(bp.cfg.MaxSuggestedSize) * (1 - pressure)))
+	if size < minSuggestedBatchSize {
+		size = minSuggestedBatchSize
+	}
+
+	// clients are only slowed down once the node is half way loaded
+	var delay time.Duration
+	if pressure > 0.5 {
+		delay = time.Duration((pressure - 0.5) * 2 * float64(maxSuggestedBatchDelay)).
+			Round(time.Millisecond)
+	}
+
+	return BatchHint{
+		QueueDepth:         load.QueueDepth + inFlight,
+		SuggestedBatchSize: size,
+		SuggestedDelay:     delay,
+	}
+}
+
+// memoryPressure grows from 0 at the memory warning threshold to 1 at the
+// threshold at which shards are set to read-only
+func (bp *BatchBackpressure) memoryPressure(ratio float64) float64 {
+	low := float64(bp.memUse.WarningPercentage)
+	high := float64(bp.memUse.ReadOnlyPercentage)
+	if high == 0 {
+		high = 100
+	}
+	if low == 0 || low >= high {
+		low = high * 0.8
+	}
+
+	percentUsed := ratio * 100
+	switch {
+	case percentUsed <= low:
+		return 0
+	case percentUsed >= high:
+		return 1
+	default:
+		return (percentUsed - low) / (high - low)
+	}
+}
@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestBatchBackpressure(t *testing.T) {
+	memUse := config.MemUse{WarningPercentage: 80, ReadOnlyPercentage: 90}
+
+	t.Run("idle node", func(t *testing.T) {
+		bp := NewBatchBackpressure(config.BatchBackpressure{MaxInFlightObjects: 100},
+			memUse, &fakeBatchLoad{BatchLoad{QueueCapacity: 1000}})
+
+		assert.Equal(t, BatchHint{SuggestedBatchSize: config.DefaultBatchMaxSuggestedSize},
+			bp.Hint())
+	})
+
+	t.Run("indexing backlog", func(t *testing.T) {
+		bp := NewBatchBackpressure(config.BatchBackpressure{MaxSuggestedSize: 100},
+			memUse, &fakeBatchLoad{BatchLoad{QueueDepth: 750, QueueCapacity: 1000}})
+
+		assert.Equal(t, BatchHint{
+			QueueDepth:         750,
+			SuggestedBatchSize: 25,
+			SuggestedDelay:     2500 * time.Millisecond,
+		}, bp.Hint())
+	})
+
+	t.Run("memory pressure", func(t *testing.T) {
+		load := &fakeBatchLoad{BatchLoad{QueueCapacity: 1000, MemoryRatio: 0.85}}
+		bp := NewBatchBackpressure(config.BatchBackpressure{MaxSuggestedSize: 100},
+			memUse, load)
+		assert.Equal(t, 50, bp.Hint().SuggestedBatchSize)
+
+		load.load.MemoryRatio = 0.95
+		hint := bp.Hint()
+		assert.Equal(t, minSuggestedBatchSize, hint.SuggestedBatchSize)
+		assert.Equal(t, maxSuggestedBatchDelay, hint.SuggestedDelay)
+	})
+
+	t.Run("objects in flight", func(t *testing.T) {
+		bp := NewBatchBackpressure(config.BatchBackpressure{
+			MaxInFlightObjects: 100, MaxSuggestedSize: 100,
+		}, memUse, nil)
+
+		release, err := bp.Acquire(context.Background(), 50)
+		require.Nil(t, err)
+		hint := bp.Hint()
+		assert.Equal(t, 50, hint.QueueDepth)
+		assert.Equal(t, 50, hint.SuggestedBatchSize)
+
+		release()
+		assert.Equal(t, 0, bp.Hint().QueueDepth)
+	})
+
+	t.Run("non-blocking mode accepts unbounded work", func(t *testing.T) {
+		bp := NewBatchBackpressure(config.BatchBackpressure{MaxInFlightObjects: 10},
+			memUse, nil)
+
+		_, err := bp.Acquire(context.Background(), 10)
+		require.Nil(t, err)
+		_, err = bp.Acquire(context.Background(), 10)
+		require.Nil(t, err)
+	})
+
+	t.Run("blocking mode waits for capacity", func(t *testing.T) {
+		bp := NewBatchBackpressure(config.BatchBackpressure{
+			Blocking: true, MaxInFlightObjects: 10,
+		}, memUse, nil)
+
+		// oversized batches are admitted if nothing else is in flight
+		release, err := bp.Acquire(context.Background(), 20)
+		require.Nil(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = bp.Acquire(ctx, 5)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		admitted := make(chan struct{})
+		go func() {
+			release, err := bp.Acquire(context.Background(), 5)
+			require.Nil(t, err)
+			release()
+			close(admitted)
+		}()
+
+		select {
+		case <-admitted:
+			t.Fatal("batch must wait while the node is at capacity")
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		release()
+		select {
+		case <-admitted:
+		case <-time.After(time.Second):
+			t.Fatal("batch must be admitted once capacity is released")
+		}
+	})
+}
+
+type fakeBatchLoad struct {
+	load BatchLoad
+}
+
+func (f *fakeBatchLoad) BatchLoad() BatchLoad {
+	return f.load
+}
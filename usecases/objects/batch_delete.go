@@ -15,6 +15,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/filterext"
 	"github.com/weaviate/weaviate/entities/additional"
@@ -63,10 +64,65 @@ func (b *BatchManager) deleteObjects(ctx context.Context, principal *models.Prin
 	if err != nil {
 		return nil, NewErrInternal("batch delete objects: %#v", err)
 	}
+	b.deleteBlobs(ctx, principal, match.Class, result)
+	b.mirrorBatchDelete(match.Class, result)
+	b.deleteChunks(ctx, principal, match.Class, result, repl)
 
 	return b.toResponse(match, params.Output, result)
 }
 
+func (b *BatchManager) mirrorBatchDelete(className string, result BatchDeleteResult) {
+	if b.shadows == nil || result.DryRun {
+		return
+	}
+	ids := make([]strfmt.UUID, 0, len(result.Objects))
+	for _, obj := range result.Objects {
+		if obj.Err == nil {
+			ids = append(ids, obj.UUID)
+		}
+	}
+	b.shadows.mirrorDelete(className, ids...)
+}
+
+// deleteBlobs deletes the offloaded values of the deleted objects
+func (b *BatchManager) deleteBlobs(ctx context.Context, principal *models.Principal,
+	className string, result BatchDeleteResult,
+) {
+	if b.blobs.store == nil || result.DryRun {
+		return
+	}
+
+	class, err := b.schemaManager.GetClass(ctx, principal, className)
+	if err != nil {
+		return
+	}
+	for _, obj := range result.Objects {
+		if obj.Err == nil {
+			b.blobs.deleteAll(ctx, class, obj.UUID)
+		}
+	}
+}
+
+// deleteChunks deletes the chunks of the deleted objects
+func (b *BatchManager) deleteChunks(ctx context.Context, principal *models.Principal,
+	className string, result BatchDeleteResult, repl *additional.ReplicationProperties,
+) {
+	if result.DryRun {
+		return
+	}
+
+	ids := make([]strfmt.UUID, 0, len(result.Objects))
+	for _, obj := range result.Objects {
+		if obj.Err == nil {
+			ids = append(ids, obj.UUID)
+		}
+	}
+	if err := b.chunks.deleteChunksOf(ctx, principal, className, ids, repl); err != nil {
+		b.logger.WithError(err).WithField("class", className).
+			Warn("could not delete chunks of deleted objects")
+	}
+}
+
 func (b *BatchManager) toResponse(match *models.BatchDeleteMatch, output string,
 	result BatchDeleteResult,
 ) (*BatchDeleteResponse, error) {
@@ -32,6 +32,9 @@ type BatchManager struct {
 	modulesProvider   ModulesProvider
 	autoSchemaManager *autoSchemaManager
 	metrics           *Metrics
+	blobs             blobOffloader
+	shadows           *Shadows
+	chunks            chunker
 }
 
 type BatchVectorRepo interface {
@@ -51,7 +54,7 @@ type batchRepoNew interface {
 func NewBatchManager(vectorRepo BatchVectorRepo, modulesProvider ModulesProvider,
 	locks locks, schemaManager schemaManager, config *config.WeaviateConfig,
 	logger logrus.FieldLogger, authorizer authorizer,
-	prom *monitoring.PrometheusMetrics,
+	prom *monitoring.PrometheusMetrics, blobStore BlobStore, shadows *Shadows,
 ) *BatchManager {
 	return &BatchManager{
 		config:            config,
@@ -63,5 +66,8 @@ func NewBatchManager(vectorRepo BatchVectorRepo, modulesProvider ModulesProvider
 		authorizer:        authorizer,
 		autoSchemaManager: newAutoSchemaManager(schemaManager, vectorRepo, config, logger),
 		metrics:           NewMetrics(prom),
+		blobs:             newBlobOffloader(config, logger, blobStore),
+		shadows:           shadows,
+		chunks:            newChunker(schemaManager, vectorRepo, modulesProvider, logger),
 	}
 }
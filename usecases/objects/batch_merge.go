@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// MergeObjects merges the partial updates into the stored objects, i.e. only
+// the provided properties change. Unless vectorize is set, the stored vectors
+// are kept for all objects without a vector in the update.
+//
+// The updates are applied one after another, so several updates of the same
+// object are applied in order. A failed update doesn't abort the batch, its
+// error is set on the corresponding item of the result.
+func (m *Manager) MergeObjects(ctx context.Context, principal *models.Principal,
+	updates []*models.Object, vectorize bool, repl *additional.ReplicationProperties,
+) (BatchObjects, error) {
+	if err := m.authorizer.Authorize(principal, "update", "batch/objects"); err != nil {
+		return nil, err
+	}
+
+	if len(updates) == 0 {
+		return nil, NewErrInvalidUserInput("invalid param 'objects': " +
+			"cannot be empty, need at least one object for batching")
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	m.metrics.BatchMergeInc()
+	defer m.metrics.BatchMergeDec()
+
+	res := make(BatchObjects, len(updates))
+	for i, obj := range updates {
+		res[i] = BatchObject{OriginalIndex: i, Object: obj}
+		if obj == nil {
+			res[i].Object = &models.Object{}
+		} else {
+			res[i].UUID = obj.ID
+		}
+		if err := m.validateInputs(obj); err != nil {
+			res[i].Err = err
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			res[i].Err = err
+			continue
+		}
+		if err := m.mergeObject(ctx, principal, obj, repl, !vectorize); err != nil {
+			res[i].Err = err
+		}
+	}
+	return res, nil
+}
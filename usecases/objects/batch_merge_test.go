@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func Test_MergeObjects(t *testing.T) {
+	var (
+		cls       = "ZooAction"
+		found     = strfmt.UUID("dd59815b-142b-4c54-9b12-482434bd54ca")
+		missing   = strfmt.UUID("a8ffc82c-9845-4014-876c-11369353c33c")
+		oldVector = []float32{0.7, 0.3}
+		newVector = []float32{1, 2, 3}
+	)
+
+	newManager := func() fakeGetManager {
+		m := newFakeGetManager(zooAnimalSchemaForTest())
+		m.timeSource = fakeTimeSource{}
+		m.repo.On("Object", cls, found, search.SelectProperties(nil), additional.Properties{}).
+			Return(&search.Result{
+				ClassName: cls,
+				Schema:    map[string]interface{}{"name": "old name"},
+				Vector:    oldVector,
+			}, nil)
+		m.repo.On("Object", cls, missing, search.SelectProperties(nil), additional.Properties{}).
+			Return((*search.Result)(nil), nil)
+		m.modulesProvider.On("VectorizerName", cls).Maybe().Return("some-module", nil)
+		// the fake vectorizer only vectorizes objects without a vector, like
+		// the real ones
+		m.modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Run(func(args mock.Arguments) {
+				if obj := args.Get(0).(*models.Object); obj.Vector == nil {
+					obj.Vector = newVector
+				}
+			}).Return(nil, nil)
+		return m
+	}
+
+	updates := func() []*models.Object {
+		return []*models.Object{
+			{
+				Class:      cls,
+				ID:         found,
+				Properties: map[string]interface{}{"name": "new name"},
+			},
+			{
+				Class:      cls,
+				ID:         missing,
+				Properties: map[string]interface{}{"name": "new name"},
+			},
+			{
+				ID: found,
+			},
+		}
+	}
+
+	expectMerge := func(m fakeGetManager, vector []float32) {
+		m.repo.On("Merge", MergeDocument{
+			Class:           cls,
+			ID:              found,
+			PrimitiveSchema: map[string]interface{}{"name": "new name"},
+			Vector:          vector,
+			UpdateTime:      12345,
+		}).Return(nil).Once()
+	}
+
+	assertResults := func(t *testing.T, res BatchObjects) {
+		require.Len(t, res, 3)
+		assert.Nil(t, res[0].Err)
+		assert.Equal(t, found, res[0].UUID)
+
+		var notFound *Error
+		require.ErrorAs(t, res[1].Err, &notFound)
+		assert.True(t, notFound.NotFound())
+		assert.Equal(t, missing, res[1].UUID)
+
+		assert.ErrorContains(t, res[2].Err, "empty class")
+	}
+
+	t.Run("keep stored vectors", func(t *testing.T) {
+		m := newManager()
+		expectMerge(m, oldVector)
+
+		res, err := m.MergeObjects(context.Background(), nil, updates(), false, nil)
+		require.Nil(t, err)
+		assertResults(t, res)
+		m.repo.AssertExpectations(t)
+	})
+
+	t.Run("recompute vectors", func(t *testing.T) {
+		m := newManager()
+		expectMerge(m, newVector)
+
+		res, err := m.MergeObjects(context.Background(), nil, updates(), true, nil)
+		require.Nil(t, err)
+		assertResults(t, res)
+		m.repo.AssertExpectations(t)
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		m := newManager()
+		_, err := m.MergeObjects(context.Background(), nil, nil, true, nil)
+		assert.ErrorAs(t, err, &ErrInvalidUserInput{})
+	})
+}
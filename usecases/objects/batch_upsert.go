@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// keepUnchangedVector sets the vector of the stored object on object if the
+// vectorized properties of both are the same, so that the vectorizer isn't
+// called again. Objects with their own vector and classes without a
+// vectorizer or with a reference vectorizer are left as they are.
+func (b *BatchManager) keepUnchangedVector(ctx context.Context, class *models.Class,
+	object *models.Object, repl *additional.ReplicationProperties,
+) error {
+	if object.Vector != nil || class.Vectorizer == "" ||
+		class.Vectorizer == config.VectorizerModuleNone ||
+		b.modulesProvider.UsingRef2Vec(class.Class) {
+		return nil
+	}
+
+	stored, err := b.vectorRepo.Object(ctx, class.Class, object.ID, nil,
+		additional.Properties{}, repl)
+	if err != nil {
+		return fmt.Errorf("find stored object: %w", err)
+	}
+	if stored == nil || len(stored.Vector) == 0 {
+		return nil
+	}
+
+	before, err := vectorSourceHash(class, stored.Schema)
+	if err != nil {
+		return err
+	}
+	after, err := vectorSourceHash(class, object.Properties)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(before, after) {
+		object.Vector = stored.Vector
+	}
+	return nil
+}
+
+// vectorSourceHash hashes the values of all properties which the vectorizer
+// of the class may use, i.e. the text and blob properties which aren't
+// skipped in its module config
+func vectorSourceHash(class *models.Class, properties interface{}) ([]byte, error) {
+	props, _ := properties.(map[string]interface{})
+
+	source := map[string]interface{}{}
+	for _, prop := range class.Properties {
+		value, ok := props[prop.Name]
+		if !ok || value == nil || !isVectorSource(class.Vectorizer, prop) {
+			continue
+		}
+		source[prop.Name] = value
+	}
+
+	// maps are marshalled with sorted keys, so the hash doesn't depend on the
+	// order of the properties
+	marshalled, err := json.Marshal(source)
+	if err != nil {
+		return nil, fmt.Errorf("hash vectorized properties: %w", err)
+	}
+	hash := sha256.Sum256(marshalled)
+	return hash[:], nil
+}
+
+func isVectorSource(vectorizer string, prop *models.Property) bool {
+	switch schema.DataType(prop.DataType[0]) {
+	case schema.DataTypeText, schema.DataTypeString, schema.DataTypeTextArray,
+		schema.DataTypeStringArray, schema.DataTypeBlob:
+	default:
+		return false
+	}
+
+	modConfig, _ := prop.ModuleConfig.(map[string]interface{})
+	propConfig, _ := modConfig[vectorizer].(map[string]interface{})
+	skip, _ := propConfig["skip"].(bool)
+	return !skip
+}
@@ -0,0 +1,279 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// BlobStore stores the values of blob properties which are too large to be
+// stored with the object
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte) error
+	Open(ctx context.Context, key string) (io.ReadSeekCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// Blob is the value of a blob property
+type Blob struct {
+	io.ReadSeekCloser
+	LastModified time.Time
+}
+
+// blobOffloader replaces large blob values by references to the blob store.
+// Without store all values are stored inline.
+type blobOffloader struct {
+	store          BlobStore
+	inlineMaxBytes int
+	logger         logrus.FieldLogger
+}
+
+func blobProperties(class *models.Class) []string {
+	if class == nil {
+		return nil
+	}
+
+	var props []string
+	for _, prop := range class.Properties {
+		if len(prop.DataType) == 1 && prop.DataType[0] == string(schema.DataTypeBlob) {
+			props = append(props, prop.Name)
+		}
+	}
+	return props
+}
+
+// offload uploads the blob values of props which exceed the inline size and
+// replaces them by references in place. Values which are references already
+// must reference the blob of the same object and property, so an object
+// can't expose the blobs of other objects.
+func (b blobOffloader) offload(ctx context.Context, class *models.Class,
+	id strfmt.UUID, props map[string]interface{},
+) error {
+	for _, name := range blobProperties(class) {
+		value, ok := props[name].(string)
+		if !ok {
+			continue
+		}
+
+		key := schema.BlobKey(class.Class, id.String(), name)
+		if ref, ok := schema.BlobReference(value); ok {
+			if b.store == nil || ref != key {
+				return NewErrInvalidUserInput("property %q: invalid blob reference %q",
+					name, value)
+			}
+			continue
+		}
+		if b.store == nil || base64.StdEncoding.DecodedLen(len(value)) <= b.inlineMaxBytes {
+			continue
+		}
+
+		data, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return NewErrInvalidUserInput("property %q: %v", name, err)
+		}
+		if len(data) <= b.inlineMaxBytes {
+			continue
+		}
+		if err := b.store.Put(ctx, key, data); err != nil {
+			return NewErrInternal("offload blob %q: %v", key, err)
+		}
+		props[name] = schema.BlobReferencePrefix + key
+	}
+	return nil
+}
+
+// inlineForVectorizer replaces the references in props by the offloaded
+// values in place if the class is vectorized by a module, so the vectorizer
+// sees the actual values
+func (b blobOffloader) inlineForVectorizer(ctx context.Context, class *models.Class,
+	id strfmt.UUID, props map[string]interface{},
+) error {
+	if class == nil || class.Vectorizer == config.VectorizerModuleNone {
+		return nil
+	}
+
+	for _, name := range blobProperties(class) {
+		value, _ := props[name].(string)
+		ref, ok := schema.BlobReference(value)
+		if !ok {
+			continue
+		}
+		key := schema.BlobKey(class.Class, id.String(), name)
+		if b.store == nil || ref != key {
+			return NewErrInvalidUserInput("property %q: invalid blob reference %q",
+				name, value)
+		}
+
+		r, err := b.store.Open(ctx, key)
+		if err != nil {
+			return fmt.Errorf("open blob %q: %w", key, err)
+		}
+		data, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			return fmt.Errorf("read blob %q: %w", key, err)
+		}
+		props[name] = base64.StdEncoding.EncodeToString(data)
+	}
+	return nil
+}
+
+// deleteStale deletes the offloaded values of old which aren't referenced by
+// updated anymore. It is best-effort, a failed deletion leaves an orphaned
+// blob, but doesn't fail the write.
+func (b blobOffloader) deleteStale(ctx context.Context, class *models.Class,
+	id strfmt.UUID, old, updated map[string]interface{},
+) {
+	if b.store == nil {
+		return
+	}
+
+	for _, name := range blobProperties(class) {
+		oldValue, _ := old[name].(string)
+		if _, ok := schema.BlobReference(oldValue); !ok {
+			continue
+		}
+		newValue, _ := updated[name].(string)
+		if _, ok := schema.BlobReference(newValue); ok {
+			continue
+		}
+		b.delete(ctx, schema.BlobKey(class.Class, id.String(), name))
+	}
+}
+
+// deleteAll deletes all offloaded values of a deleted object. Deleting a
+// blob which doesn't exist isn't an error, so the values don't need to be
+// read first.
+func (b blobOffloader) deleteAll(ctx context.Context, class *models.Class, id strfmt.UUID) {
+	if b.store == nil {
+		return
+	}
+
+	for _, name := range blobProperties(class) {
+		b.delete(ctx, schema.BlobKey(class.Class, id.String(), name))
+	}
+}
+
+func (b blobOffloader) delete(ctx context.Context, key string) {
+	if err := b.store.Delete(ctx, key); err != nil {
+		b.logger.WithField("action", "delete_blob").
+			WithField("key", key).
+			WithError(err).
+			Warn("delete offloaded blob")
+	}
+}
+
+// deleteBlobs deletes all offloaded values of a deleted object
+func (m *Manager) deleteBlobs(ctx context.Context, principal *models.Principal,
+	className string, id strfmt.UUID,
+) {
+	if m.blobs.store == nil {
+		return
+	}
+
+	class, err := m.schemaManager.GetClass(ctx, principal, className)
+	if err != nil {
+		return
+	}
+	m.blobs.deleteAll(ctx, class, id)
+}
+
+// newBlobOffloader offloads blob values which exceed the inline size of the
+// config, without store all values are stored inline
+func newBlobOffloader(cfg *config.WeaviateConfig, logger logrus.FieldLogger,
+	store BlobStore,
+) blobOffloader {
+	b := blobOffloader{store: store, logger: logger}
+	if cfg != nil {
+		b.inlineMaxBytes = cfg.Config.BlobStorage.InlineMaxBytes
+	}
+	return b
+}
+
+// GetObjectBlob returns the value of a blob property, regardless of whether
+// it is stored inline or offloaded. The caller must close the blob.
+func (m *Manager) GetObjectBlob(ctx context.Context, principal *models.Principal,
+	className string, id strfmt.UUID, propName string,
+	repl *additional.ReplicationProperties,
+) (*Blob, error) {
+	path := fmt.Sprintf("objects/%s/%s", className, id)
+	if err := m.authorizer.Authorize(principal, "get", path); err != nil {
+		return nil, err
+	}
+
+	m.metrics.GetObjectInc()
+	defer m.metrics.GetObjectDec()
+
+	class, err := m.schemaManager.GetClass(ctx, principal, className)
+	if err != nil {
+		return nil, err
+	}
+	if class == nil {
+		return nil, NewErrNotFound("class %q not found", className)
+	}
+	prop, err := schema.GetPropertyByName(class, propName)
+	if err != nil {
+		return nil, NewErrNotFound("%v", err)
+	}
+	if len(prop.DataType) != 1 || prop.DataType[0] != string(schema.DataTypeBlob) {
+		return nil, NewErrInvalidUserInput("property %q is not a blob", propName)
+	}
+
+	res, err := m.getObjectFromRepo(ctx, className, id, additional.Properties{}, repl)
+	if err != nil {
+		return nil, err
+	}
+	obj := m.redactor.Object(principal, res, false)
+	props, _ := obj.Properties.(map[string]interface{})
+	value, ok := props[prop.Name].(string)
+	if !ok {
+		return nil, NewErrNotFound("object %s has no value of property %q", id, propName)
+	}
+	lastModified := time.UnixMilli(obj.LastUpdateTimeUnix)
+
+	key, ok := schema.BlobReference(value)
+	if !ok {
+		data, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, NewErrInternal("decode blob: %v", err)
+		}
+		return &Blob{nopCloser{bytes.NewReader(data)}, lastModified}, nil
+	}
+
+	if m.blobs.store == nil {
+		return nil, NewErrInternal("blob %q is offloaded, but no blob storage "+
+			"is configured", key)
+	}
+	r, err := m.blobs.store.Open(ctx, key)
+	if err != nil {
+		return nil, NewErrInternal("open blob %q: %v", key, err)
+	}
+	return &Blob{r, lastModified}, nil
+}
+
+type nopCloser struct {
+	io.ReadSeeker
+}
+
+func (nopCloser) Close() error { return nil }
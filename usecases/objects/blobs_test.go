@@ -0,0 +1,187 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type fakeBlobStore struct {
+	sync.Mutex
+	blobs map[string][]byte
+}
+
+func newFakeBlobStore() *fakeBlobStore {
+	return &fakeBlobStore{blobs: map[string][]byte{}}
+}
+
+func (f *fakeBlobStore) Put(ctx context.Context, key string, data []byte) error {
+	f.Lock()
+	defer f.Unlock()
+	f.blobs[key] = data
+	return nil
+}
+
+func (f *fakeBlobStore) Open(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+	f.Lock()
+	defer f.Unlock()
+	data, ok := f.blobs[key]
+	if !ok {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return nopCloser{bytes.NewReader(data)}, nil
+}
+
+func (f *fakeBlobStore) Delete(ctx context.Context, key string) error {
+	f.Lock()
+	defer f.Unlock()
+	delete(f.blobs, key)
+	return nil
+}
+
+func Test_Blobs(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		store      *fakeBlobStore
+		manager    *Manager
+	)
+
+	id := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+	key := schema.BlobKey("Image", id.String(), "image")
+	small := []byte("small")
+	large := bytes.Repeat([]byte("large"), 10)
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		store = newFakeBlobStore()
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{Objects: &models.Schema{
+				Classes: []*models.Class{{
+					Class:             "Image",
+					Vectorizer:        config.VectorizerModuleNone,
+					VectorIndexConfig: hnsw.UserConfig{},
+					Properties: []*models.Property{
+						{Name: "image", DataType: []string{string(schema.DataTypeBlob)}},
+						{Name: "name", DataType: []string{string(schema.DataTypeText)}},
+					},
+				}},
+			}},
+		}
+		cfg := &config.WeaviateConfig{Config: config.Config{
+			BlobStorage: config.BlobStorage{Bucket: "blobs", InlineMaxBytes: 16},
+		}}
+		logger, _ := test.NewNullLogger()
+		modulesProvider := getFakeModulesProvider()
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+		manager = NewManager(&fakeLocks{}, schemaManager, cfg, logger,
+			&fakeAuthorizer{}, vectorRepo, modulesProvider, &fakeMetrics{}, store, nil)
+	}
+
+	addObject := func(t *testing.T, data []byte) *models.Object {
+		vectorRepo.On("Exists", "Image", id).Return(false, nil).Once()
+		vectorRepo.On("PutObject", mock.Anything, mock.Anything).Return(nil).Once()
+		obj, err := manager.AddObject(context.Background(), nil, &models.Object{
+			ID:    id,
+			Class: "Image",
+			Properties: map[string]interface{}{
+				"image": base64.StdEncoding.EncodeToString(data),
+			},
+		}, nil)
+		require.Nil(t, err)
+		return obj
+	}
+
+	readBlob := func(t *testing.T, obj *models.Object) []byte {
+		vectorRepo.On("Object", "Image", id, mock.Anything, mock.Anything).
+			Return(&search.Result{
+				ID:        id,
+				ClassName: "Image",
+				Schema:    obj.Properties,
+			}, nil).Once()
+		blob, err := manager.GetObjectBlob(context.Background(), nil, "Image", id, "image", nil)
+		require.Nil(t, err)
+		defer blob.Close()
+		data, err := io.ReadAll(blob)
+		require.Nil(t, err)
+		return data
+	}
+
+	t.Run("small blobs are stored inline", func(t *testing.T) {
+		reset()
+		obj := addObject(t, small)
+
+		props := obj.Properties.(map[string]interface{})
+		assert.Equal(t, base64.StdEncoding.EncodeToString(small), props["image"])
+		assert.Empty(t, store.blobs)
+		assert.Equal(t, small, readBlob(t, obj))
+	})
+
+	t.Run("large blobs are offloaded", func(t *testing.T) {
+		reset()
+		obj := addObject(t, large)
+
+		props := obj.Properties.(map[string]interface{})
+		assert.Equal(t, schema.BlobReferencePrefix+key, props["image"])
+		assert.Equal(t, large, store.blobs[key])
+		assert.Equal(t, large, readBlob(t, obj))
+	})
+
+	t.Run("references to other blobs are rejected", func(t *testing.T) {
+		reset()
+		other := schema.BlobKey("Image", "73f2eb5f-5abf-447a-81ca-74b1dd168247", "image")
+		store.blobs[other] = large
+		vectorRepo.On("Exists", "Image", id).Return(false, nil).Once()
+
+		_, err := manager.AddObject(context.Background(), nil, &models.Object{
+			ID:    id,
+			Class: "Image",
+			Properties: map[string]interface{}{
+				"image": schema.BlobReferencePrefix + other,
+			},
+		}, nil)
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+
+	t.Run("offloaded blobs are deleted with the object", func(t *testing.T) {
+		reset()
+		addObject(t, large)
+		require.Contains(t, store.blobs, key)
+
+		vectorRepo.On("Exists", "Image", id).Return(true, nil).Once()
+		vectorRepo.On("DeleteObject", "Image", id).Return(nil).Once()
+		require.Nil(t, manager.DeleteObject(context.Background(), nil, "Image", id, nil))
+		assert.NotContains(t, store.blobs, key)
+	})
+
+	t.Run("blob of a property of another type", func(t *testing.T) {
+		reset()
+		_, err := manager.GetObjectBlob(context.Background(), nil, "Image", id, "name", nil)
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+}
@@ -0,0 +1,345 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+)
+
+// chunkRepo writes and deletes the chunk objects
+type chunkRepo interface {
+	BatchPutObjects(ctx context.Context, objects BatchObjects,
+		repl *additional.ReplicationProperties) (BatchObjects, error)
+	BatchDeleteObjects(ctx context.Context, params BatchDeleteParams,
+		repl *additional.ReplicationProperties) (BatchDeleteResult, error)
+}
+
+// chunker splits the text properties of objects of classes with a chunking
+// config and writes the chunks as vectorized objects of the chunk class,
+// which reference their parent. The chunks of an object are replaced
+// whenever the object is written and deleted together with it.
+type chunker struct {
+	schemaManager schemaManager
+	repo          chunkRepo
+	modules       ModulesProvider
+	logger        logrus.FieldLogger
+}
+
+// newChunker creates a chunker writing to the repo, which must support batch
+// writes and deletes for classes with a chunking config to be imported
+func newChunker(schemaManager schemaManager, repo VectorRepo,
+	modules ModulesProvider, logger logrus.FieldLogger,
+) chunker {
+	chunks, _ := repo.(chunkRepo)
+	return chunker{
+		schemaManager: schemaManager,
+		repo:          chunks,
+		modules:       modules,
+		logger:        logger,
+	}
+}
+
+// chunk replaces the chunks of the objects by the chunks of their current
+// properties. The errors correspond to the objects, objects of classes
+// without chunking config are skipped.
+func (c chunker) chunk(ctx context.Context, principal *models.Principal,
+	objects []*models.Object, findObject modulecapabilities.FindObjectFn,
+	repl *additional.ReplicationProperties,
+) []error {
+	errs := make([]error, len(objects))
+	var (
+		chunks  []*models.Object
+		classes []*models.Class
+		owners  []int // index of the parent of each chunk
+		parents = map[string][]int{}
+	)
+	for i, object := range objects {
+		if object == nil {
+			continue
+		}
+		class, err := c.schemaManager.GetClass(ctx, principal, object.Class)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		if class == nil || class.ChunkingConfig == nil {
+			continue
+		}
+		if c.repo == nil {
+			errs[i] = fmt.Errorf("chunking is not supported by the repo")
+			continue
+		}
+		chunkClass, err := c.schemaManager.GetClass(ctx, principal, class.ChunkingConfig.ChunkClass)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		if chunkClass == nil {
+			errs[i] = fmt.Errorf("chunk class %q of class %q not present in schema",
+				class.ChunkingConfig.ChunkClass, class.Class)
+			continue
+		}
+
+		parents[chunkClass.Class] = append(parents[chunkClass.Class], i)
+		for _, chunk := range chunkObjects(class, object) {
+			chunks = append(chunks, chunk)
+			classes = append(classes, chunkClass)
+			owners = append(owners, i)
+		}
+	}
+
+	for chunkClass, indexes := range parents {
+		ids := make([]strfmt.UUID, len(indexes))
+		for j, i := range indexes {
+			ids[j] = objects[i].ID
+		}
+		if err := c.deleteChunks(ctx, chunkClass, ids, repl); err != nil {
+			for _, i := range indexes {
+				errs[i] = fmt.Errorf("delete stale chunks: %w", err)
+			}
+		}
+	}
+	if len(chunks) == 0 {
+		return errs
+	}
+
+	vecErrs := c.modules.BatchUpdateVector(ctx, chunks, classes, findObject, c.logger)
+	batch := make(BatchObjects, 0, len(chunks))
+	for j, chunk := range chunks {
+		i := owners[j]
+		if errs[i] != nil {
+			continue
+		}
+		if err := vecErrs[j]; err != nil {
+			errs[i] = fmt.Errorf("vectorize chunk %s: %w", chunk.ID, err)
+			continue
+		}
+		if err := applyVectorValidation(classes[j], chunk); err != nil {
+			errs[i] = fmt.Errorf("vectorize chunk %s: %w", chunk.ID, err)
+			continue
+		}
+		batch = append(batch, BatchObject{
+			OriginalIndex: j,
+			Object:        chunk,
+			UUID:          chunk.ID,
+			Vector:        chunk.Vector,
+		})
+	}
+	if len(batch) == 0 {
+		return errs
+	}
+
+	res, err := c.repo.BatchPutObjects(ctx, batch, repl)
+	if err != nil {
+		for _, obj := range batch {
+			errs[owners[obj.OriginalIndex]] = fmt.Errorf("put chunks: %w", err)
+		}
+		return errs
+	}
+	for _, obj := range res {
+		if obj.Err != nil {
+			errs[owners[obj.OriginalIndex]] = fmt.Errorf("put chunk %s: %w", obj.UUID, obj.Err)
+		}
+	}
+	return errs
+}
+
+// deleteChunksOf deletes the chunks of the deleted objects of the class
+func (c chunker) deleteChunksOf(ctx context.Context, principal *models.Principal,
+	className string, ids []strfmt.UUID, repl *additional.ReplicationProperties,
+) error {
+	if c.repo == nil || len(ids) == 0 {
+		return nil
+	}
+	class, err := c.schemaManager.GetClass(ctx, principal, className)
+	if err != nil {
+		return err
+	}
+	if class == nil || class.ChunkingConfig == nil {
+		return nil
+	}
+	return c.deleteChunks(ctx, class.ChunkingConfig.ChunkClass, ids, repl)
+}
+
+// deleteChunks deletes all chunks of the parents from the chunk class
+func (c chunker) deleteChunks(ctx context.Context, chunkClass string,
+	parents []strfmt.UUID, repl *additional.ReplicationProperties,
+) error {
+	operands := make([]filters.Clause, len(parents))
+	for i, id := range parents {
+		operands[i] = filters.Clause{
+			Operator: filters.OperatorEqual,
+			On: &filters.Path{
+				Class:    schema.ClassName(chunkClass),
+				Property: schema.ChunkParentIDProperty,
+			},
+			Value: &filters.Value{Value: id.String(), Type: schema.DataTypeString},
+		}
+	}
+	root := &operands[0]
+	if len(operands) > 1 {
+		root = &filters.Clause{Operator: filters.OperatorOr, Operands: operands}
+	}
+
+	_, err := c.repo.BatchDeleteObjects(ctx, BatchDeleteParams{
+		ClassName: schema.ClassName(chunkClass),
+		Filters:   &filters.LocalFilter{Root: root},
+		Output:    OutputMinimal,
+	}, repl)
+	return err
+}
+
+// chunkObjects splits the chunked properties of the object into chunk
+// objects of the chunk class. The ids of the chunks are derived from their
+// parent, property and position, so rewriting unchanged text doesn't change
+// them.
+func chunkObjects(class *models.Class, object *models.Object) []*models.Object {
+	cfg := class.ChunkingConfig
+	props, _ := object.Properties.(map[string]interface{})
+	parentID := uuid.MustParse(object.ID.String())
+	parent := crossref.NewLocalhost(class.Class, object.ID).SingleRef()
+
+	var chunks []*models.Object
+	for _, prop := range cfg.Properties {
+		text, ok := props[prop].(string)
+		if !ok {
+			continue
+		}
+		for index, chunk := range splitText(text, schema.ChunkingStrategy(cfg),
+			schema.ChunkSize(cfg), int(cfg.Overlap)) {
+			chunks = append(chunks, &models.Object{
+				Class: cfg.ChunkClass,
+				ID:    chunkID(cfg.ChunkClass, object.ID, prop, index),
+				Properties: map[string]interface{}{
+					schema.ChunkTextProperty:     chunk,
+					schema.ChunkIndexProperty:    int64(index),
+					schema.ChunkSourceProperty:   prop,
+					schema.ChunkParentIDProperty: parentID,
+					schema.ChunkParentProperty:   models.MultipleRef{parent},
+				},
+				CreationTimeUnix:   object.CreationTimeUnix,
+				LastUpdateTimeUnix: object.LastUpdateTimeUnix,
+			})
+		}
+	}
+	return chunks
+}
+
+func chunkID(chunkClass string, parent strfmt.UUID, prop string, index int) strfmt.UUID {
+	name, _ := json.Marshal([]interface{}{chunkClass, parent, prop, index})
+	return strfmt.UUID(uuid.NewSHA1(idNamespace, name).String())
+}
+
+// splitText splits the text into chunks of size tokens or sentences, each
+// chunk repeats the last overlap units of the previous one. Chunks are
+// substrings of the text, so whitespace and punctuation are kept.
+func splitText(text, strategy string, size, overlap int) []string {
+	var units [][2]int
+	if strategy == schema.ChunkingStrategySentences {
+		units = sentenceSpans(text)
+	} else {
+		units = tokenSpans(text)
+	}
+	if len(units) == 0 || size <= 0 {
+		return nil
+	}
+	step := size - overlap
+	if step <= 0 {
+		step = size
+	}
+
+	var chunks []string
+	for start := 0; start < len(units); start += step {
+		end := start + size
+		if end > len(units) {
+			end = len(units)
+		}
+		chunks = append(chunks, text[units[start][0]:units[end-1][1]])
+		if end == len(units) {
+			break
+		}
+	}
+	return chunks
+}
+
+// tokenSpans returns the byte offsets of the runs of non-space characters
+func tokenSpans(text string) [][2]int {
+	var spans [][2]int
+	start := -1
+	for i, r := range text {
+		if unicode.IsSpace(r) {
+			if start >= 0 {
+				spans = append(spans, [2]int{start, i})
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		spans = append(spans, [2]int{start, len(text)})
+	}
+	return spans
+}
+
+// sentenceSpans returns the byte offsets of the sentences, which end at
+// '.', '!' or '?' followed by whitespace, or at a blank line
+func sentenceSpans(text string) [][2]int {
+	var spans [][2]int
+	start, last := -1, -1 // last is the end of the last non-space character
+	flush := func() {
+		if start >= 0 {
+			spans = append(spans, [2]int{start, last})
+			start = -1
+		}
+	}
+	for i := 0; i < len(text); {
+		r, width := utf8.DecodeRuneInString(text[i:])
+		next := i + width
+		if unicode.IsSpace(r) {
+			if r == '\n' && start >= 0 && strings.HasPrefix(strings.TrimLeft(text[next:], " \t\r"), "\n") {
+				flush()
+			}
+			i = next
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+		last = next
+		if r == '.' || r == '!' || r == '?' {
+			if next == len(text) {
+				flush()
+			} else if after, _ := utf8.DecodeRuneInString(text[next:]); unicode.IsSpace(after) {
+				flush()
+			}
+		}
+		i = next
+	}
+	flush()
+	return spans
+}
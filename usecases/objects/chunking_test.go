@@ -0,0 +1,242 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestSplitText(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		text     string
+		strategy string
+		size     int
+		overlap  int
+		expected []string
+	}{
+		{
+			name:     "empty text",
+			text:     "  \n ",
+			strategy: schema.ChunkingStrategyTokens,
+			size:     2,
+		},
+		{
+			name:     "tokens",
+			text:     "one two  three\tfour five",
+			strategy: schema.ChunkingStrategyTokens,
+			size:     2,
+			expected: []string{"one two", "three\tfour", "five"},
+		},
+		{
+			name:     "tokens with overlap",
+			text:     "one two three four five",
+			strategy: schema.ChunkingStrategyTokens,
+			size:     3,
+			overlap:  1,
+			expected: []string{"one two three", "three four five"},
+		},
+		{
+			name:     "text shorter than a chunk",
+			text:     " one two ",
+			strategy: schema.ChunkingStrategyTokens,
+			size:     5,
+			overlap:  2,
+			expected: []string{"one two"},
+		},
+		{
+			name:     "sentences",
+			text:     "First one. Second one? Third, v1.2 is out! Fourth",
+			strategy: schema.ChunkingStrategySentences,
+			size:     2,
+			expected: []string{"First one. Second one?", "Third, v1.2 is out! Fourth"},
+		},
+		{
+			name:     "sentences end at blank lines",
+			text:     "A heading\n\nFirst one. Second\none.",
+			strategy: schema.ChunkingStrategySentences,
+			size:     1,
+			expected: []string{"A heading", "First one.", "Second\none."},
+		},
+		{
+			name:     "sentences with overlap",
+			text:     "A. B. C. D.",
+			strategy: schema.ChunkingStrategySentences,
+			size:     2,
+			overlap:  1,
+			expected: []string{"A. B.", "B. C.", "C. D."},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected,
+				splitText(test.text, test.strategy, test.size, test.overlap))
+		})
+	}
+}
+
+func TestChunker(t *testing.T) {
+	ctx := context.Background()
+	parentID := strfmt.UUID("a3c6a2e8-8bd5-4b36-9b0e-ea0bbd3ec5a1")
+	otherID := strfmt.UUID("3b1e5a6c-98a4-4c7e-9a5e-6f0f4dbd3e52")
+	chunkClass := &models.Class{
+		Class:      "ArticleChunk",
+		Properties: schema.ChunkProperties("Article"),
+	}
+	article := &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: []string{"text"}},
+			{Name: "body", DataType: []string{"text"}},
+		},
+		ChunkingConfig: &models.ChunkingConfig{
+			ChunkClass: "ArticleChunk",
+			Properties: []string{"title", "body"},
+			Size:       2,
+		},
+	}
+	plain := &models.Class{Class: "Plain"}
+
+	newChunker := func() (chunker, *fakeVectorRepo, *fakeModulesProvider) {
+		repo := &fakeVectorRepo{}
+		modules := getFakeModulesProvider()
+		logger, _ := test.NewNullLogger()
+		sm := &fakeSchemaManager{GetSchemaResponse: schema.Schema{
+			Objects: &models.Schema{Classes: []*models.Class{article, chunkClass, plain}},
+		}}
+		return chunker{schemaManager: sm, repo: repo, modules: modules, logger: logger}, repo, modules
+	}
+	parentFilter := func(ids ...strfmt.UUID) *filters.LocalFilter {
+		operands := make([]filters.Clause, len(ids))
+		for i, id := range ids {
+			operands[i] = filters.Clause{
+				Operator: filters.OperatorEqual,
+				On:       &filters.Path{Class: "ArticleChunk", Property: "parentId"},
+				Value:    &filters.Value{Value: id.String(), Type: schema.DataTypeString},
+			}
+		}
+		if len(operands) == 1 {
+			return &filters.LocalFilter{Root: &operands[0]}
+		}
+		return &filters.LocalFilter{Root: &filters.Clause{Operator: filters.OperatorOr, Operands: operands}}
+	}
+
+	t.Run("chunks replace the stale chunks and reference their parent", func(t *testing.T) {
+		c, repo, modules := newChunker()
+		repo.On("BatchDeleteObjects", BatchDeleteParams{
+			ClassName: "ArticleChunk",
+			Filters:   parentFilter(parentID),
+			Output:    OutputMinimal,
+		}).Return(BatchDeleteResult{}, nil).Once()
+		repo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+		modules.On("UpdateVector", mock.Anything, mock.Anything).
+			Return([]float32{1, 2, 3}, nil)
+
+		errs := c.chunk(ctx, nil, []*models.Object{{
+			Class: "Article",
+			ID:    parentID,
+			Properties: map[string]interface{}{
+				"title": "A title",
+				"body":  "one two three",
+			},
+			CreationTimeUnix:   10,
+			LastUpdateTimeUnix: 20,
+		}}, nil, nil)
+		assert.Equal(t, []error{nil}, errs)
+		repo.AssertExpectations(t)
+
+		batch := repo.Calls[1].Arguments.Get(0).(BatchObjects)
+		require.Len(t, batch, 3)
+		texts := make([]string, len(batch))
+		for i, obj := range batch {
+			props := obj.Object.Properties.(map[string]interface{})
+			texts[i] = props["text"].(string)
+			assert.Equal(t, "ArticleChunk", obj.Object.Class)
+			assert.Equal(t, obj.UUID, obj.Object.ID)
+			assert.Equal(t, []float32{1, 2, 3}, obj.Vector)
+			assert.Equal(t, uuid.MustParse(parentID.String()), props["parentId"])
+			assert.Equal(t, strfmt.URI("weaviate://localhost/Article/"+parentID),
+				props["parent"].(models.MultipleRef)[0].Beacon)
+			assert.Equal(t, int64(10), obj.Object.CreationTimeUnix)
+			assert.Equal(t, int64(20), obj.Object.LastUpdateTimeUnix)
+		}
+		assert.Equal(t, []string{"A title", "one two", "three"}, texts)
+		assert.Equal(t, "body", batch[2].Object.Properties.(map[string]interface{})["sourceProperty"])
+		assert.Equal(t, int64(1), batch[2].Object.Properties.(map[string]interface{})["chunkIndex"])
+		assert.Equal(t, chunkID("ArticleChunk", parentID, "body", 1), batch[2].UUID)
+	})
+
+	t.Run("objects of other classes are skipped", func(t *testing.T) {
+		c, repo, _ := newChunker()
+		repo.On("BatchDeleteObjects", BatchDeleteParams{
+			ClassName: "ArticleChunk",
+			Filters:   parentFilter(parentID, otherID),
+			Output:    OutputMinimal,
+		}).Return(BatchDeleteResult{}, nil).Once()
+
+		// the parents have no text left, so their chunks are only deleted
+		errs := c.chunk(ctx, nil, []*models.Object{
+			{Class: "Article", ID: parentID},
+			nil,
+			{Class: "Plain", ID: otherID, Properties: map[string]interface{}{"title": "plain"}},
+			{Class: "Article", ID: otherID, Properties: map[string]interface{}{}},
+		}, nil, nil)
+		assert.Equal(t, []error{nil, nil, nil, nil}, errs)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("errors are reported for their parent", func(t *testing.T) {
+		c, repo, modules := newChunker()
+		repo.On("BatchDeleteObjects", mock.Anything).Return(BatchDeleteResult{}, nil).Once()
+		repo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+		modules.On("UpdateVector", mock.MatchedBy(func(obj *models.Object) bool {
+			return obj.Properties.(map[string]interface{})["text"] == "fails"
+		}), mock.Anything).Return(nil, errors.New("vectorizer down"))
+		modules.On("UpdateVector", mock.Anything, mock.Anything).Return([]float32{1, 2, 3}, nil)
+
+		errs := c.chunk(ctx, nil, []*models.Object{
+			{Class: "Article", ID: parentID, Properties: map[string]interface{}{"title": "fails"}},
+			{Class: "Article", ID: otherID, Properties: map[string]interface{}{"title": "works"}},
+		}, nil, nil)
+		require.Len(t, errs, 2)
+		assert.ErrorContains(t, errs[0], "vectorizer down")
+		assert.Nil(t, errs[1])
+
+		batch := repo.Calls[1].Arguments.Get(0).(BatchObjects)
+		require.Len(t, batch, 1)
+		assert.Equal(t, "works", batch[0].Object.Properties.(map[string]interface{})["text"])
+	})
+
+	t.Run("chunks are deleted with their parents", func(t *testing.T) {
+		c, repo, _ := newChunker()
+		repo.On("BatchDeleteObjects", BatchDeleteParams{
+			ClassName: "ArticleChunk",
+			Filters:   parentFilter(parentID, otherID),
+			Output:    OutputMinimal,
+		}).Return(BatchDeleteResult{}, nil).Once()
+
+		require.Nil(t, c.deleteChunksOf(ctx, nil, "Article", []strfmt.UUID{parentID, otherID}, nil))
+		require.Nil(t, c.deleteChunksOf(ctx, nil, "Plain", []strfmt.UUID{parentID}, nil))
+		repo.AssertExpectations(t)
+	})
+}
@@ -46,7 +46,7 @@ func (m *Manager) DeleteObject(ctx context.Context, principal *models.Principal,
 	defer m.metrics.DeleteObjectDec()
 
 	if class == "" { // deprecated
-		return m.deleteObjectFromRepo(ctx, id)
+		return m.deleteObjectFromRepo(ctx, principal, id)
 	}
 
 	ok, err := m.vectorRepo.Exists(ctx, class, id, repl)
@@ -61,13 +61,20 @@ func (m *Manager) DeleteObject(ctx context.Context, principal *models.Principal,
 	if err != nil {
 		return NewErrInternal("could not delete object from vector repo: %v", err)
 	}
+	m.shadows.mirrorDelete(class, id)
+	m.deleteBlobs(ctx, principal, class, id)
+	if err := m.chunks.deleteChunksOf(ctx, principal, class, []strfmt.UUID{id}, repl); err != nil {
+		return NewErrInternal("could not delete chunks of object: %v", err)
+	}
 	return nil
 }
 
 // deleteObjectFromRepo deletes objects with same id and different classes.
 //
 // Deprecated
-func (m *Manager) deleteObjectFromRepo(ctx context.Context, id strfmt.UUID) error {
+func (m *Manager) deleteObjectFromRepo(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID,
+) error {
 	// There might be a situation to have UUIDs which are not unique across classes.
 	// Added loop in order to delete all of the objects with given UUID across all classes.
 	// This change is added in response to this issue:
@@ -91,6 +98,11 @@ func (m *Manager) deleteObjectFromRepo(ctx context.Context, id strfmt.UUID) erro
 		if err != nil {
 			return NewErrInternal("could not delete object from vector repo: %v", err)
 		}
+		m.shadows.mirrorDelete(object.Class, id)
+		m.deleteBlobs(ctx, principal, object.Class, id)
+		if err := m.chunks.deleteChunksOf(ctx, principal, object.Class, []strfmt.UUID{id}, nil); err != nil {
+			return NewErrInternal("could not delete chunks of object: %v", err)
+		}
 		deleteCounter++
 	}
 }
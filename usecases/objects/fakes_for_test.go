@@ -69,6 +69,9 @@ func (f *fakeSchemaManager) GetSchema(principal *models.Principal) (schema.Schem
 func (f *fakeSchemaManager) GetClass(ctx context.Context, principal *models.Principal,
 	name string,
 ) (*models.Class, error) {
+	if f.GetSchemaResponse.Objects == nil {
+		return nil, f.GetschemaErr
+	}
 	classes := f.GetSchemaResponse.Objects.Classes
 	for _, class := range classes {
 		if class.Class == name {
@@ -167,6 +170,17 @@ func (f *fakeVectorRepo) ObjectByID(ctx context.Context,
 	return nil, args.Error(1)
 }
 
+func (f *fakeVectorRepo) ObjectAtVersion(ctx context.Context, class string,
+	id strfmt.UUID, version int64, props search.SelectProperties,
+	additional additional.Properties,
+) (*search.Result, error) {
+	args := f.Called(class, id, version)
+	if args.Get(0) != nil {
+		return args.Get(0).(*search.Result), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
 func (f *fakeVectorRepo) ObjectSearch(ctx context.Context, offset, limit int, filters *filters.LocalFilter,
 	sort []filters.Sort, additional additional.Properties,
 ) (search.Results, error) {
@@ -332,6 +346,18 @@ func (p *fakeModulesProvider) UpdateVector(ctx context.Context, object *models.O
 	}
 }
 
+func (p *fakeModulesProvider) BatchUpdateVector(ctx context.Context, objects []*models.Object,
+	classes []*models.Class, findObjFn modulecapabilities.FindObjectFn, logger logrus.FieldLogger,
+) []error {
+	errs := make([]error, len(objects))
+	for i, object := range objects {
+		if object != nil {
+			errs[i] = p.UpdateVector(ctx, object, classes[i], nil, findObjFn, logger)
+		}
+	}
+	return errs
+}
+
 func (p *fakeModulesProvider) VectorizerName(className string) (string, error) {
 	args := p.Called(className)
 	return args.String(0), args.Error(1)
@@ -743,6 +769,12 @@ func (f *fakeMetrics) BatchDeleteInc() {
 func (f *fakeMetrics) BatchDeleteDec() {
 }
 
+func (f *fakeMetrics) BatchMergeInc() {
+}
+
+func (f *fakeMetrics) BatchMergeDec() {
+}
+
 func (f *fakeMetrics) AddObjectInc() {
 }
 
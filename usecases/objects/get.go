@@ -56,7 +56,44 @@ func (m *Manager) GetObject(ctx context.Context, principal *models.Principal, cl
 		m.trackUsageSingle(res)
 	}
 
-	return res.ObjectWithVector(additional.Vector), nil
+	return m.redactor.Object(principal, res, additional.Vector), nil
+}
+
+// GetObjectAtVersion returns a retained version of an object of a class with
+// versioning enabled. Positive versions are absolute, the first write of an
+// object is version 1. 0 is the latest version and negative versions are
+// relative to it.
+func (m *Manager) GetObjectAtVersion(ctx context.Context, principal *models.Principal,
+	class string, id strfmt.UUID, version int64, additional additional.Properties,
+) (*models.Object, error) {
+	err := m.authorizer.Authorize(principal, "get", fmt.Sprintf("objects/%s/%s", class, id))
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	m.metrics.GetObjectInc()
+	defer m.metrics.GetObjectDec()
+
+	res, err := m.vectorRepo.ObjectAtVersion(ctx, class, id, version,
+		search.SelectProperties{}, additional)
+	if err != nil {
+		return nil, NewErrInternal("repo: object at version: %v", err)
+	}
+	if res == nil {
+		return nil, NewErrNotFound("no version %d of object with id '%s'", version, id)
+	}
+
+	if additional.Vector {
+		m.trackUsageSingle(res)
+	}
+
+	return m.redactor.Object(principal, res, additional.Vector), nil
 }
 
 // GetObjects Class from the connected DB
@@ -77,7 +114,8 @@ func (m *Manager) GetObjects(ctx context.Context, principal *models.Principal,
 
 	m.metrics.GetObjectInc()
 	defer m.metrics.GetObjectDec()
-	return m.getObjectsFromRepo(ctx, offset, limit, sort, order, after, additional)
+
+	return m.getObjectsFromRepo(ctx, principal, offset, limit, sort, order, after, additional)
 }
 
 func (m *Manager) GetObjectsClass(ctx context.Context, principal *models.Principal,
@@ -135,7 +173,7 @@ func (m *Manager) getObjectFromRepo(ctx context.Context, class string, id strfmt
 	return res, nil
 }
 
-func (m *Manager) getObjectsFromRepo(ctx context.Context,
+func (m *Manager) getObjectsFromRepo(ctx context.Context, principal *models.Principal,
 	offset, limit *int64, sort, order *string, after *string,
 	additional additional.Properties,
 ) ([]*models.Object, error) {
@@ -163,7 +201,7 @@ func (m *Manager) getObjectsFromRepo(ctx context.Context,
 		m.trackUsageList(res)
 	}
 
-	return res.ObjectsWithVector(additional.Vector), nil
+	return m.redactor.Objects(principal, res, additional.Vector), nil
 }
 
 func (m *Manager) getSort(sort, order *string) []filters.Sort {
@@ -64,7 +64,7 @@ func Test_GetAction(t *testing.T) {
 		metrics = &fakeMetrics{}
 		manager = NewManager(locks, schemaManager, cfg, logger,
 			authorizer, vectorRepo,
-			getFakeModulesProviderWithCustomExtenders(extender, projectorFake), metrics)
+			getFakeModulesProviderWithCustomExtenders(extender, projectorFake), metrics, nil, nil)
 	}
 
 	t.Run("get non-existing action by id", func(t *testing.T) {
@@ -101,6 +101,28 @@ func Test_GetAction(t *testing.T) {
 		assert.Equal(t, expected, res)
 	})
 
+	t.Run("get a version of an action", func(t *testing.T) {
+		reset()
+		id := strfmt.UUID("99ee9968-22ec-416a-9032-cff80f2f7fdf")
+
+		result := &search.Result{
+			ID:        id,
+			ClassName: "ActionClass",
+			Schema:    map[string]interface{}{"foo": "previous"},
+		}
+		vectorRepo.On("ObjectAtVersion", "ActionClass", id, int64(-1)).Return(result, nil).Once()
+		vectorRepo.On("ObjectAtVersion", "ActionClass", id, int64(7)).Return(nil, nil).Once()
+
+		res, err := manager.GetObjectAtVersion(context.Background(),
+			&models.Principal{}, "ActionClass", id, -1, additional.Properties{})
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"foo": "previous"}, res.Properties)
+
+		_, err = manager.GetObjectAtVersion(context.Background(),
+			&models.Principal{}, "ActionClass", id, 7, additional.Properties{})
+		assert.Equal(t, NewErrNotFound("no version 7 of object with id '99ee9968-22ec-416a-9032-cff80f2f7fdf'"), err)
+	})
+
 	t.Run("get existing object by id with vector without classname (deprecated)", func(t *testing.T) {
 		reset()
 		id := strfmt.UUID("99ee9968-22ec-416a-9032-cff80f2f7fdf")
@@ -703,7 +725,7 @@ func Test_GetThing(t *testing.T) {
 		metrics := &fakeMetrics{}
 		manager = NewManager(locks, schemaManager, cfg, logger,
 			authorizer, vectorRepo,
-			getFakeModulesProviderWithCustomExtenders(extender, projectorFake), metrics)
+			getFakeModulesProviderWithCustomExtenders(extender, projectorFake), metrics, nil, nil)
 	}
 
 	t.Run("get non-existing thing by id", func(t *testing.T) {
@@ -1102,7 +1124,7 @@ func newFakeGetManager(schema schema.Schema, opts ...func(*fakeGetManager)) fake
 	logger, _ := test.NewNullLogger()
 	r.modulesProvider = getFakeModulesProviderWithCustomExtenders(r.extender, r.projector)
 	r.Manager = NewManager(r.locks, schemaManager, cfg, logger,
-		r.authorizer, r.repo, r.modulesProvider, r.metrics)
+		r.authorizer, r.repo, r.modulesProvider, r.metrics, nil, nil)
 
 	return r
 }
@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// idNamespace is the namespace of deterministic v5 ids, all of them are
+// derived from the class name and the values of the configured properties
+var idNamespace = uuid.MustParse("b1b3a0a8-5e0c-4b6a-9a43-6f1ad0f1d6a5")
+
+// generateID assigns an id to an object imported without one, using the
+// strategy configured for its class
+func generateID(class *models.Class, properties interface{}) (strfmt.UUID, error) {
+	switch schema.IDStrategy(class) {
+	case schema.IDStrategyV7:
+		return generateUUIDv7(time.Now())
+	case schema.IDStrategyV5:
+		props, _ := properties.(map[string]interface{})
+		return deterministicID(class, props)
+	default:
+		return generateUUID()
+	}
+}
+
+// generateUUIDv7 returns a time-ordered id as defined by RFC 9562, the first
+// 48 bits hold the unix timestamp in milliseconds and the rest is random
+func generateUUIDv7(now time.Time) (strfmt.UUID, error) {
+	var id uuid.UUID
+	if _, err := rand.Read(id[6:]); err != nil {
+		return "", fmt.Errorf("could not generate uuid v7: %v", err)
+	}
+
+	var ts [8]byte
+	binary.BigEndian.PutUint64(ts[:], uint64(now.UnixMilli()))
+	copy(id[:6], ts[2:])
+	id[6] = (id[6] & 0x0f) | 0x70 // version 7
+	id[8] = (id[8] & 0x3f) | 0x80 // RFC 4122 variant
+
+	return strfmt.UUID(id.String()), nil
+}
+
+// deterministicID derives a v5 id from the values of the properties
+// configured for the class, so that re-importing the same object results in
+// the same id instead of a duplicate
+func deterministicID(class *models.Class, props map[string]interface{}) (strfmt.UUID, error) {
+	values := make([]interface{}, len(class.IDGenerationConfig.Properties))
+	for i, name := range class.IDGenerationConfig.Properties {
+		value, ok := props[name]
+		if !ok || value == nil {
+			return "", NewErrInvalidUserInput(
+				"property '%s' is required to generate the id of class '%s'",
+				name, class.Class)
+		}
+		values[i] = value
+	}
+
+	name, err := json.Marshal(append([]interface{}{class.Class}, values...))
+	if err != nil {
+		return "", NewErrInvalidUserInput("generate id: %v", err)
+	}
+
+	return strfmt.UUID(uuid.NewSHA1(idNamespace, name).String()), nil
+}
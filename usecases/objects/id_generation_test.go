@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func Test_GenerateID(t *testing.T) {
+	class := func(strategy string, props ...string) *models.Class {
+		return &models.Class{
+			Class: "Article",
+			IDGenerationConfig: &models.IDGenerationConfig{
+				Strategy:   strategy,
+				Properties: props,
+			},
+		}
+	}
+
+	t.Run("default is v4", func(t *testing.T) {
+		for _, c := range []*models.Class{nil, {Class: "Article"}, class("")} {
+			id, err := generateID(c, nil)
+			require.Nil(t, err)
+			assert.Equal(t, uuid.Version(4), uuid.MustParse(id.String()).Version())
+		}
+	})
+
+	t.Run("v7 is time-ordered", func(t *testing.T) {
+		now := time.Now()
+		first, err := generateUUIDv7(now)
+		require.Nil(t, err)
+		second, err := generateUUIDv7(now.Add(time.Millisecond))
+		require.Nil(t, err)
+
+		parsed := uuid.MustParse(first.String())
+		assert.Equal(t, uuid.Version(7), parsed.Version())
+		assert.Equal(t, uuid.RFC4122, parsed.Variant())
+		assert.Less(t, first.String(), second.String())
+
+		id, err := generateID(class("v7"), nil)
+		require.Nil(t, err)
+		assert.Equal(t, uuid.Version(7), uuid.MustParse(id.String()).Version())
+	})
+
+	t.Run("v5 is deterministic", func(t *testing.T) {
+		c := class("v5", "title", "year")
+		props := map[string]interface{}{"title": "Dune", "year": 1965.0, "pages": 412.0}
+
+		first, err := generateID(c, props)
+		require.Nil(t, err)
+		assert.Equal(t, uuid.Version(5), uuid.MustParse(first.String()).Version())
+
+		// properties which aren't configured don't change the id
+		props["pages"] = 896.0
+		second, err := generateID(c, props)
+		require.Nil(t, err)
+		assert.Equal(t, first, second)
+
+		props["year"] = 1966.0
+		third, err := generateID(c, props)
+		require.Nil(t, err)
+		assert.NotEqual(t, first, third)
+
+		other := class("v5", "title", "year")
+		other.Class = "Book"
+		fourth, err := generateID(other, props)
+		require.Nil(t, err)
+		assert.NotEqual(t, third, fourth)
+	})
+
+	t.Run("v5 with missing property", func(t *testing.T) {
+		_, err := generateID(class("v5", "title"), map[string]interface{}{})
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+}
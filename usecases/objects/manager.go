@@ -18,17 +18,18 @@ package objects
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/hlc"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -40,11 +41,15 @@ type Manager struct {
 	schemaManager     schemaManager
 	logger            logrus.FieldLogger
 	authorizer        authorizer
+	redactor          *authorization.Redactor
 	vectorRepo        VectorRepo
 	timeSource        timeSource
 	modulesProvider   ModulesProvider
 	autoSchemaManager *autoSchemaManager
 	metrics           objectsMetrics
+	blobs             blobOffloader
+	shadows           *Shadows
+	chunks            chunker
 }
 
 type objectsMetrics interface {
@@ -54,6 +59,8 @@ type objectsMetrics interface {
 	BatchRefDec()
 	BatchDeleteInc()
 	BatchDeleteDec()
+	BatchMergeInc()
+	BatchMergeDec()
 	AddObjectInc()
 	AddObjectDec()
 	UpdateObjectInc()
@@ -99,6 +106,10 @@ type VectorRepo interface {
 	Exists(ctx context.Context, class string, id strfmt.UUID, repl *additional.ReplicationProperties) (bool, error)
 	ObjectByID(ctx context.Context, id strfmt.UUID, props search.SelectProperties,
 		additional additional.Properties) (*search.Result, error)
+	// ObjectAtVersion returns a retained version of an object, nil if the
+	// version isn't retained
+	ObjectAtVersion(ctx context.Context, class string, id strfmt.UUID, version int64,
+		props search.SelectProperties, additional additional.Properties) (*search.Result, error)
 	ObjectSearch(ctx context.Context, offset, limit int, filters *filters.LocalFilter,
 		sort []filters.Sort, additional additional.Properties) (search.Results, error)
 	AddReference(ctx context.Context, className string, source strfmt.UUID, propName string, ref *models.SingleRef, repl *additional.ReplicationProperties) error
@@ -114,6 +125,8 @@ type ModulesProvider interface {
 	UsingRef2Vec(className string) bool
 	UpdateVector(ctx context.Context, object *models.Object, class *models.Class, objectDiff *moduletools.ObjectDiff,
 		repo modulecapabilities.FindObjectFn, logger logrus.FieldLogger) error
+	BatchUpdateVector(ctx context.Context, objects []*models.Object, classes []*models.Class,
+		repo modulecapabilities.FindObjectFn, logger logrus.FieldLogger) []error
 	VectorizerName(className string) (string, error)
 }
 
@@ -121,19 +134,29 @@ type ModulesProvider interface {
 func NewManager(locks locks, schemaManager schemaManager,
 	config *config.WeaviateConfig, logger logrus.FieldLogger,
 	authorizer authorizer, vectorRepo VectorRepo,
-	modulesProvider ModulesProvider, metrics objectsMetrics,
+	modulesProvider ModulesProvider, metrics objectsMetrics, blobStore BlobStore,
+	shadows *Shadows,
 ) *Manager {
+	var restricted []string
+	if config != nil {
+		restricted = config.Config.Authorization.RestrictedProperties
+	}
+
 	return &Manager{
 		config:            config,
 		locks:             locks,
 		schemaManager:     schemaManager,
 		logger:            logger,
 		authorizer:        authorizer,
+		redactor:          authorization.NewRedactor(authorizer, restricted),
 		vectorRepo:        vectorRepo,
 		timeSource:        defaultTimeSource{},
 		modulesProvider:   modulesProvider,
 		autoSchemaManager: newAutoSchemaManager(schemaManager, vectorRepo, config, logger),
 		metrics:           metrics,
+		blobs:             newBlobOffloader(config, logger, blobStore),
+		shadows:           shadows,
+		chunks:            newChunker(schemaManager, vectorRepo, modulesProvider, logger),
 	}
 }
 
@@ -148,6 +171,8 @@ func generateUUID() (strfmt.UUID, error) {
 
 type defaultTimeSource struct{}
 
+// Now returns a timestamp of the hybrid logical clock of this node, which
+// orders the versions of an object across nodes with skewed clocks
 func (ts defaultTimeSource) Now() int64 {
-	return time.Now().UnixNano() / int64(time.Millisecond)
+	return hlc.Now()
 }
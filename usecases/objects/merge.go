@@ -13,10 +13,13 @@ package objects
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/hlc"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -31,6 +34,7 @@ type MergeDocument struct {
 	PrimitiveSchema      map[string]interface{}      `json:"primitiveSchema"`
 	References           BatchReferences             `json:"references"`
 	Vector               []float32                   `json:"vector"`
+	Vectors              map[string][]float32        `json:"vectors"`
 	UpdateTime           int64                       `json:"updateTime"`
 	AdditionalProperties models.AdditionalProperties `json:"additionalProperties"`
 	PropertiesToDelete   []string                    `json:"propertiesToDelete"`
@@ -51,6 +55,16 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 	m.metrics.MergeObjectInc()
 	defer m.metrics.MergeObjectDec()
 
+	return m.mergeObject(ctx, principal, updates, repl, false)
+}
+
+// mergeObject merges updates into the stored object. With keepVector the
+// stored vector is kept unless updates has a vector, otherwise the vector is
+// recomputed by the vectorizer of the class.
+func (m *Manager) mergeObject(ctx context.Context, principal *models.Principal,
+	updates *models.Object, repl *additional.ReplicationProperties, keepVector bool,
+) *Error {
+	cls, id := updates.Class, updates.ID
 	var propertiesToDelete []string
 	if updates.Properties != nil {
 		for key, val := range updates.Properties.(map[string]interface{}) {
@@ -64,6 +78,10 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 		return &Error{"bad request", StatusBadRequest, err}
 	}
 
+	if err := m.validateIndexOnlyMerge(ctx, principal, updates); err != nil {
+		return &Error{"bad request", StatusBadRequest, err}
+	}
+
 	if updates.Properties == nil {
 		updates.Properties = map[string]interface{}{}
 	}
@@ -74,6 +92,11 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 	if obj == nil {
 		return &Error{"not found", StatusNotFound, err}
 	}
+	if keepVector && updates.Vector == nil {
+		withVec := *updates
+		withVec.Vector = obj.Vector
+		updates = &withVec
+	}
 	return m.patchObject(ctx, principal, obj, updates, repl, propertiesToDelete)
 }
 
@@ -82,18 +105,52 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 	obj *search.Result, updates *models.Object, repl *additional.ReplicationProperties, propertiesToDelete []string,
 ) *Error {
 	cls, id := updates.Class, updates.ID
-	primitive, refs := m.splitPrimitiveAndRefs(updates.Properties.(map[string]interface{}), cls, id)
-	objWithVec, err := m.mergeObjectSchemaAndVectorize(ctx, cls, obj.Schema,
+	class, err := m.schemaManager.GetClass(ctx, principal, cls)
+	if err != nil {
+		return &Error{"get class", StatusInternalServerError, err}
+	}
+	// the previous properties are merged in place
+	oldProps := map[string]interface{}{}
+	if prev, ok := obj.Schema.(map[string]interface{}); ok {
+		for key, value := range prev {
+			oldProps[key] = value
+		}
+	}
+	props := updates.Properties.(map[string]interface{})
+	if err := applyReferenceSnapshots(ctx, class, props, oldProps, m.findObject); err != nil {
+		return &Error{"reference snapshots", StatusInternalServerError, err}
+	}
+	for target := range updates.Vectors {
+		if _, ok := class.VectorConfig[target]; !ok {
+			return &Error{"bad request", StatusBadRequest,
+				fmt.Errorf("target vector %q is not configured for class %s", target, cls)}
+		}
+	}
+	primitive, refs := m.splitPrimitiveAndRefs(props, cls, id)
+	objWithVec, err := m.mergeObjectSchemaAndVectorize(ctx, cls, id, obj.Schema,
 		primitive, principal, obj.Vector, updates.Vector)
 	if err != nil {
+		var invalid ErrInvalidUserInput
+		if errors.As(err, &invalid) {
+			return &Error{"bad request", StatusBadRequest, err}
+		}
 		return &Error{"merge and vectorize", StatusInternalServerError, err}
 	}
+	if err := m.blobs.offload(ctx, class, id, primitive); err != nil {
+		var invalid ErrInvalidUserInput
+		if errors.As(err, &invalid) {
+			return &Error{"bad request", StatusBadRequest, err}
+		}
+		return &Error{"offload blobs", StatusInternalServerError, err}
+	}
+	hlc.Observe(obj.Updated) // order the merge after the current version
 	mergeDoc := MergeDocument{
 		Class:              cls,
 		ID:                 id,
 		PrimitiveSchema:    primitive,
 		References:         refs,
 		Vector:             objWithVec.Vector,
+		Vectors:            mergeVectors(updates.Vectors),
 		UpdateTime:         m.timeSource.Now(),
 		PropertiesToDelete: propertiesToDelete,
 	}
@@ -105,10 +162,88 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 	if err := m.vectorRepo.Merge(ctx, mergeDoc, repl); err != nil {
 		return &Error{"repo.merge", StatusInternalServerError, err}
 	}
+	m.shadows.mirrorRefresh(cls, id)
+
+	updatedProps := map[string]interface{}{}
+	for key, value := range oldProps {
+		updatedProps[key] = value
+	}
+	for key, value := range primitive {
+		updatedProps[key] = value
+	}
+	m.blobs.deleteStale(ctx, class, id, oldProps, updatedProps)
+	if err := m.rechunk(ctx, principal, class, id, obj.Created, props, updatedProps,
+		propertiesToDelete, repl); err != nil {
+		return &Error{"chunk object", StatusInternalServerError, err}
+	}
 
 	return nil
 }
 
+// rechunk replaces the chunks of the merged object if one of its chunked
+// properties was changed or deleted
+func (m *Manager) rechunk(ctx context.Context, principal *models.Principal,
+	class *models.Class, id strfmt.UUID, created int64, updates, merged map[string]interface{},
+	propertiesToDelete []string, repl *additional.ReplicationProperties,
+) error {
+	if class.ChunkingConfig == nil {
+		return nil
+	}
+	changed := false
+	for _, prop := range class.ChunkingConfig.Properties {
+		if _, ok := updates[prop]; ok {
+			changed = true
+		}
+		for _, deleted := range propertiesToDelete {
+			changed = changed || deleted == prop
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	current := make(map[string]interface{}, len(merged))
+	for key, value := range merged {
+		current[key] = value
+	}
+	for _, prop := range propertiesToDelete {
+		delete(current, prop)
+	}
+	return m.chunks.chunk(ctx, principal, []*models.Object{{
+		Class:              class.Class,
+		ID:                 id,
+		Properties:         current,
+		CreationTimeUnix:   created,
+		LastUpdateTimeUnix: m.timeSource.Now(),
+	}}, m.findObject, repl)[0]
+}
+
+// validateIndexOnlyMerge makes sure that a merge sets all index-only
+// properties. Their values aren't stored, so the merged object would be
+// indexed without them otherwise.
+func (m *Manager) validateIndexOnlyMerge(ctx context.Context,
+	principal *models.Principal, updates *models.Object,
+) error {
+	class, err := m.schemaManager.GetClass(ctx, principal, updates.Class)
+	if err != nil || class == nil {
+		return err
+	}
+	props, _ := updates.Properties.(map[string]interface{})
+
+	var missing []string
+	for _, name := range schema.IndexOnlyProperties(class) {
+		if _, ok := props[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("class %q has index-only properties which aren't stored, "+
+			"a partial update must set all of them, missing: %s",
+			updates.Class, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
 func (m *Manager) validateInputs(updates *models.Object) error {
 	if updates == nil {
 		return fmt.Errorf("empty updates")
@@ -123,7 +258,7 @@ func (m *Manager) validateInputs(updates *models.Object) error {
 }
 
 func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className string,
-	old interface{}, new map[string]interface{},
+	id strfmt.UUID, old interface{}, new map[string]interface{},
 	principal *models.Principal, oldVec, newVec []float32,
 ) (*models.Object, error) {
 	var merged map[string]interface{}
@@ -166,7 +301,16 @@ func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className s
 	if err != nil {
 		return nil, err
 	}
+	if err := m.blobs.inlineForVectorizer(ctx, class, id, merged); err != nil {
+		return nil, err
+	}
 	if err := m.modulesProvider.UpdateVector(ctx, obj, class, objDiff, m.findObject, m.logger); err != nil {
+		if dimsErr, ok := vectorDimensionsInput(err); ok {
+			return nil, dimsErr
+		}
+		return nil, err
+	}
+	if err := applyVectorValidation(class, obj); err != nil {
 		return nil, err
 	}
 
@@ -206,3 +350,17 @@ func (m *Manager) splitPrimitiveAndRefs(in map[string]interface{}, sourceClass s
 
 	return primitive, outRefs
 }
+
+// mergeVectors returns the named vectors of the patch, the vectors which are
+// not part of it are kept by the merge
+func mergeVectors(vectors models.Vectors) map[string][]float32 {
+	if len(vectors) == 0 {
+		return nil
+	}
+
+	out := make(map[string][]float32, len(vectors))
+	for target, vector := range vectors {
+		out[target] = vector
+	}
+	return out
+}
@@ -19,11 +19,16 @@ import (
 	"time"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
 )
 
 type stage int
@@ -517,3 +522,31 @@ func (f fakeTimeSource) Now() int64 {
 func ptFloat32(in float32) *float32 {
 	return &in
 }
+
+func Test_MergeObject_IndexOnlyProperties(t *testing.T) {
+	indexOnly := true
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{{
+				Class:             "Document",
+				VectorIndexConfig: hnsw.UserConfig{},
+				Vectorizer:        config.VectorizerModuleNone,
+				Properties: []*models.Property{
+					{Name: "title", DataType: []string{"text"}},
+					{Name: "body", DataType: []string{"text"}, IndexOnly: &indexOnly},
+				},
+			}},
+		},
+	}
+	m := newFakeGetManager(sch)
+	id := strfmt.UUID("dd59815b-142b-4c54-9b12-482434bd54ca")
+
+	err := m.MergeObject(context.Background(), nil, &models.Object{
+		Class:      "Document",
+		ID:         id,
+		Properties: map[string]interface{}{"title": "updated"},
+	}, nil)
+	require.NotNil(t, err)
+	assert.Equal(t, StatusBadRequest, err.Code)
+	assert.ErrorContains(t, err, "missing: body")
+}
@@ -12,6 +12,7 @@
 package objects
 
 import (
+	"context"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -82,6 +83,14 @@ func (m *Metrics) BatchDeleteDec() {
 	m.queriesDec("batch_delete")
 }
 
+func (m *Metrics) BatchMergeInc() {
+	m.queriesInc("batch_merge")
+}
+
+func (m *Metrics) BatchMergeDec() {
+	m.queriesDec("batch_merge")
+}
+
 func (m *Metrics) AddObjectInc() {
 	m.queriesInc("add_object")
 }
@@ -154,18 +163,18 @@ func (m *Metrics) DeleteReferenceDec() {
 	m.queriesDec("delete_reference")
 }
 
-func (m *Metrics) BatchOp(op string, startNs int64) {
+func (m *Metrics) BatchOp(ctx context.Context, op string, startNs int64) {
 	if m == nil {
 		return
 	}
 
 	took := float64(time.Now().UnixNano()-startNs) / float64(time.Millisecond)
 
-	m.batchTime.With(prometheus.Labels{
+	monitoring.ObserveWithTraceID(ctx, m.batchTime.With(prometheus.Labels{
 		"operation":  op,
 		"class_name": "n/a",
 		"shard_name": "n/a",
-	}).Observe(float64(took))
+	}), took)
 }
 
 func (m *Metrics) AddUsageDimensions(className, queryType, operation string, dims int) {
@@ -75,6 +75,9 @@ func (m *Manager) Query(ctx context.Context, principal *models.Principal, params
 	if err != nil {
 		return nil, &Error{"offset or limit", StatusBadRequest, err}
 	}
+	if err := m.authorizeQueryRestricted(principal, q); err != nil {
+		return nil, &Error{path, StatusForbidden, err}
+	}
 	res, rerr := m.vectorRepo.Query(ctx, q)
 	if rerr != nil {
 		return nil, rerr
@@ -91,5 +94,24 @@ func (m *Manager) Query(ctx context.Context, principal *models.Principal, params
 		m.trackUsageList(res)
 	}
 
-	return res.ObjectsWithVector(q.Additional.Vector), nil
+	return m.redactor.Objects(principal, res, q.Additional.Vector), nil
+}
+
+// authorizeQueryRestricted rejects sorting by restricted properties the
+// principal may not read, as the order would reveal their values. Modules
+// extending the results receive the objects before they are redacted.
+func (m *Manager) authorizeQueryRestricted(principal *models.Principal, q *QueryInput) error {
+	if len(q.Additional.ModuleParams) > 0 {
+		if err := m.redactor.AuthorizeClass(principal, q.Class); err != nil {
+			return err
+		}
+	}
+
+	props := make([]string, 0, len(q.Sort))
+	for _, sort := range q.Sort {
+		if len(sort.Path) > 0 {
+			props = append(props, sort.Path[0])
+		}
+	}
+	return m.redactor.AuthorizeProperties(principal, q.Class, props...)
 }
@@ -0,0 +1,302 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/hlc"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+const defaultReferenceSnapshotBatchSize = 100
+
+// applyReferenceSnapshots sets the snapshot properties of the class in props
+// to the values of the referenced objects. In a merge, previous are the
+// properties of the stored object, as the references of the update are
+// added to the stored ones. Snapshots whose reference isn't part of props
+// are removed, unless in a merge, where they are kept as they are.
+func applyReferenceSnapshots(ctx context.Context, class *models.Class,
+	props, previous map[string]interface{}, find modulecapabilities.FindObjectFn,
+) error {
+	if class == nil {
+		return nil
+	}
+
+	for _, prop := range schema.ReferenceSnapshotProperties(class) {
+		refs, ok := props[prop.ReferenceSnapshot.Reference]
+		if !ok {
+			if previous == nil {
+				delete(props, prop.Name)
+			}
+			continue
+		}
+
+		targets := beacons(refs)
+		if previous != nil && refs != nil {
+			targets = append(beacons(previous[prop.ReferenceSnapshot.Reference]), targets...)
+		}
+		values, err := referenceSnapshot(ctx, targets, prop.ReferenceSnapshot.Property, find)
+		if err != nil {
+			return fmt.Errorf("snapshot of property %q: %w", prop.Name, err)
+		}
+		props[prop.Name] = values
+	}
+	return nil
+}
+
+// referenceSnapshot returns the values of property of all objects referenced
+// by the beacons in their order. Missing objects are skipped.
+func referenceSnapshot(ctx context.Context, targets []string, property string,
+	find modulecapabilities.FindObjectFn,
+) ([]string, error) {
+	values := []string{}
+	for _, beacon := range targets {
+		ref, err := crossref.Parse(beacon)
+		if err != nil {
+			return nil, err
+		}
+		target, err := find(ctx, ref.Class, ref.TargetID, search.SelectProperties{},
+			additional.Properties{})
+		if err != nil {
+			return nil, fmt.Errorf("find %s: %w", beacon, err)
+		}
+		if target == nil {
+			continue
+		}
+		targetProps, _ := target.Schema.(map[string]interface{})
+		values = append(values, stringValues(targetProps[property])...)
+	}
+	return values, nil
+}
+
+// beacons of a reference property, which is either validated or as it was
+// unmarshalled from JSON
+func beacons(refs interface{}) []string {
+	var out []string
+	switch typed := refs.(type) {
+	case models.MultipleRef:
+		for _, ref := range typed {
+			if ref != nil {
+				out = append(out, ref.Beacon.String())
+			}
+		}
+	case []interface{}:
+		for _, ref := range typed {
+			if asMap, ok := ref.(map[string]interface{}); ok {
+				if beacon, ok := asMap["beacon"].(string); ok {
+					out = append(out, beacon)
+				}
+			}
+		}
+	}
+	return out
+}
+
+func stringValues(value interface{}) []string {
+	switch typed := value.(type) {
+	case string:
+		return []string{typed}
+	case []string:
+		return typed
+	case []interface{}:
+		out := make([]string, 0, len(typed))
+		for _, elem := range typed {
+			if s, ok := elem.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+type referenceSnapshotRepo interface {
+	Query(context.Context, *QueryInput) (search.Results, *Error)
+	Merge(ctx context.Context, merge MergeDocument, repl *additional.ReplicationProperties) error
+	Object(ctx context.Context, class string, id strfmt.UUID, props search.SelectProperties,
+		additional additional.Properties, repl *additional.ReplicationProperties) (*search.Result, error)
+}
+
+// ReferenceSnapshotRefresher periodically updates the snapshot properties of
+// all objects, so that changes of the referenced objects are picked up. The
+// snapshots are set when an object is written, so they are only stale until
+// the next run after a referenced object changed.
+type ReferenceSnapshotRefresher struct {
+	repo     referenceSnapshotRepo
+	schema   revectorizeSchema
+	interval time.Duration
+	logger   logrus.FieldLogger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewReferenceSnapshotRefresher creates a refresher which runs every interval
+// once it is started
+func NewReferenceSnapshotRefresher(repo referenceSnapshotRepo, schema revectorizeSchema,
+	interval time.Duration, logger logrus.FieldLogger,
+) *ReferenceSnapshotRefresher {
+	return &ReferenceSnapshotRefresher{
+		repo:     repo,
+		schema:   schema,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Start refreshing the snapshots in the background
+func (r *ReferenceSnapshotRefresher) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.refreshAll(ctx)
+			}
+		}
+	}()
+}
+
+// Shutdown stops the background refresh and waits for a running refresh to
+// stop
+func (r *ReferenceSnapshotRefresher) Shutdown() {
+	if r.cancel == nil {
+		return
+	}
+	r.cancel()
+	r.wg.Wait()
+}
+
+func (r *ReferenceSnapshotRefresher) refreshAll(ctx context.Context) {
+	sch := r.schema.GetSchemaSkipAuth()
+	if sch.Objects == nil {
+		return
+	}
+
+	for _, class := range sch.Objects.Classes {
+		if len(schema.ReferenceSnapshotProperties(class)) == 0 {
+			continue
+		}
+
+		logger := r.logger.WithField("action", "reference_snapshot_refresh").
+			WithField("class", class.Class)
+		// a merge would drop the values of index-only properties from the
+		// inverted index, as they aren't stored
+		if len(schema.IndexOnlyProperties(class)) > 0 {
+			logger.Warn("skip class with index-only properties")
+			continue
+		}
+
+		updated, err := r.RefreshClass(ctx, class)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			logger.WithError(err).Error("refresh reference snapshots")
+			continue
+		}
+		logger.WithField("updated", updated).Debug("refreshed reference snapshots")
+	}
+}
+
+// RefreshClass updates the snapshot properties of all objects of the class
+// which are out of date and returns the number of updated objects
+func (r *ReferenceSnapshotRefresher) RefreshClass(ctx context.Context,
+	class *models.Class,
+) (int, error) {
+	snapshots := schema.ReferenceSnapshotProperties(class)
+	find := func(ctx context.Context, class string, id strfmt.UUID,
+		props search.SelectProperties, addl additional.Properties,
+	) (*search.Result, error) {
+		return r.repo.Object(ctx, class, id, props, addl, nil)
+	}
+
+	var cursor strfmt.UUID
+	updated := 0
+	for {
+		res, qErr := r.repo.Query(ctx, &QueryInput{
+			Class: class.Class,
+			Limit: defaultReferenceSnapshotBatchSize,
+			Cursor: &filters.Cursor{
+				After: cursor.String(),
+				Limit: defaultReferenceSnapshotBatchSize,
+			},
+		})
+		if qErr != nil {
+			return updated, fmt.Errorf("read objects: %w", qErr)
+		}
+		if len(res) == 0 {
+			return updated, nil
+		}
+
+		for _, item := range res {
+			props, _ := item.Schema.(map[string]interface{})
+			changed := map[string]interface{}{}
+			for _, prop := range snapshots {
+				values, err := referenceSnapshot(ctx,
+					beacons(props[prop.ReferenceSnapshot.Reference]),
+					prop.ReferenceSnapshot.Property, find)
+				if err != nil {
+					return updated, fmt.Errorf("object %s: %w", item.ID, err)
+				}
+				current := stringValues(props[prop.Name])
+				if current == nil {
+					current = []string{}
+				}
+				if !reflect.DeepEqual(current, values) {
+					changed[prop.Name] = values
+				}
+			}
+			if len(changed) == 0 {
+				continue
+			}
+
+			if err := r.repo.Merge(ctx, MergeDocument{
+				Class:           class.Class,
+				ID:              item.ID,
+				PrimitiveSchema: changed,
+				UpdateTime:      hlc.Now(),
+			}, nil); err != nil {
+				return updated, fmt.Errorf("update object %s: %w", item.ID, err)
+			}
+			updated++
+		}
+
+		cursor = res[len(res)-1].ID
+		if ctx.Err() != nil {
+			return updated, ctx.Err()
+		}
+	}
+}
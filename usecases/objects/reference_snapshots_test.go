@@ -0,0 +1,158 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func referenceSnapshotClassForTest() *models.Class {
+	return &models.Class{
+		Class: "Book",
+		Properties: []*models.Property{
+			{Name: "title", DataType: []string{"text"}},
+			{Name: "writtenBy", DataType: []string{"Author"}},
+			{
+				Name:     "authorNames",
+				DataType: []string{"text[]"},
+				ReferenceSnapshot: &models.ReferenceSnapshot{
+					Reference: "writtenBy",
+					Property:  "name",
+				},
+			},
+		},
+	}
+}
+
+func Test_ApplyReferenceSnapshots(t *testing.T) {
+	var (
+		alice   = strfmt.UUID("dd59815b-142b-4c54-9b12-482434bd54ca")
+		bob     = strfmt.UUID("a8ffc82c-9845-4014-876c-11369353c33c")
+		missing = strfmt.UUID("5b0e5ae3-2d1b-4f5c-8e59-b1ab0c5d86d2")
+		class   = referenceSnapshotClassForTest()
+	)
+
+	find := func(ctx context.Context, class string, id strfmt.UUID,
+		props search.SelectProperties, addl additional.Properties,
+	) (*search.Result, error) {
+		names := map[strfmt.UUID]string{alice: "Alice", bob: "Bob"}
+		name, ok := names[id]
+		if !ok {
+			return nil, nil
+		}
+		return &search.Result{
+			ClassName: class,
+			ID:        id,
+			Schema:    map[string]interface{}{"name": name},
+		}, nil
+	}
+
+	ref := func(id strfmt.UUID) map[string]interface{} {
+		return map[string]interface{}{"beacon": "weaviate://localhost/Author/" + id.String()}
+	}
+
+	t.Run("set snapshot of all references", func(t *testing.T) {
+		props := map[string]interface{}{
+			"writtenBy": []interface{}{ref(alice), ref(missing), ref(bob)},
+		}
+		require.Nil(t, applyReferenceSnapshots(context.Background(), class, props, nil, find))
+		assert.Equal(t, []string{"Alice", "Bob"}, props["authorNames"])
+	})
+
+	t.Run("remove snapshot without references", func(t *testing.T) {
+		props := map[string]interface{}{
+			"title":       "Some book",
+			"authorNames": []string{"Mallory"},
+		}
+		require.Nil(t, applyReferenceSnapshots(context.Background(), class, props, nil, find))
+		assert.Equal(t, map[string]interface{}{"title": "Some book"}, props)
+	})
+
+	t.Run("merge adds to the stored references", func(t *testing.T) {
+		previous := map[string]interface{}{
+			"writtenBy": []interface{}{ref(alice)},
+		}
+		props := map[string]interface{}{
+			"writtenBy": []interface{}{ref(bob)},
+		}
+		require.Nil(t, applyReferenceSnapshots(context.Background(), class, props, previous, find))
+		assert.Equal(t, []string{"Alice", "Bob"}, props["authorNames"])
+	})
+
+	t.Run("merge keeps snapshot without references", func(t *testing.T) {
+		previous := map[string]interface{}{
+			"writtenBy":   []interface{}{ref(alice)},
+			"authorNames": []string{"Alice"},
+		}
+		props := map[string]interface{}{"title": "Some book"}
+		require.Nil(t, applyReferenceSnapshots(context.Background(), class, props, previous, find))
+		assert.Equal(t, map[string]interface{}{"title": "Some book"}, props)
+	})
+}
+
+func Test_ReferenceSnapshotRefresher_RefreshClass(t *testing.T) {
+	var (
+		author   = strfmt.UUID("dd59815b-142b-4c54-9b12-482434bd54ca")
+		upToDate = strfmt.UUID("a8ffc82c-9845-4014-876c-11369353c33c")
+		outdated = strfmt.UUID("5b0e5ae3-2d1b-4f5c-8e59-b1ab0c5d86d2")
+		class    = referenceSnapshotClassForTest()
+		beacon   = "weaviate://localhost/Author/" + author.String()
+	)
+
+	book := func(id strfmt.UUID, names ...string) search.Result {
+		return search.Result{
+			ClassName: "Book",
+			ID:        id,
+			Schema: map[string]interface{}{
+				"writtenBy":   []interface{}{map[string]interface{}{"beacon": beacon}},
+				"authorNames": names,
+			},
+		}
+	}
+
+	repo := new(fakeVectorRepo)
+	repo.On("Query", mock.MatchedBy(func(q *QueryInput) bool {
+		return q.Cursor.After == ""
+	})).Return([]search.Result{book(upToDate, "Alice"), book(outdated, "Alicia")}, (*Error)(nil)).Once()
+	repo.On("Query", mock.MatchedBy(func(q *QueryInput) bool {
+		return q.Cursor.After == outdated.String()
+	})).Return([]search.Result{}, (*Error)(nil)).Once()
+	repo.On("Object", "Author", author, search.SelectProperties{}, additional.Properties{}).
+		Return(&search.Result{
+			ClassName: "Author",
+			ID:        author,
+			Schema:    map[string]interface{}{"name": "Alice"},
+		}, nil)
+	repo.On("Merge", mock.MatchedBy(func(merge MergeDocument) bool {
+		return merge.ID == outdated &&
+			assert.ObjectsAreEqual(map[string]interface{}{"authorNames": []string{"Alice"}},
+				merge.PrimitiveSchema)
+	})).Return(nil).Once()
+
+	logger, _ := test.NewNullLogger()
+	refresher := NewReferenceSnapshotRefresher(repo, &fakeRevectorizeSchema{}, time.Hour, logger)
+	updated, err := refresher.RefreshClass(context.Background(), class)
+	require.Nil(t, err)
+	assert.Equal(t, 1, updated)
+	repo.AssertExpectations(t)
+}
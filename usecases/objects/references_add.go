@@ -78,6 +78,7 @@ func (m *Manager) AddObjectReference(
 	if err := m.updateRefVector(ctx, principal, input.Class, input.ID); err != nil {
 		return &Error{"update ref vector", StatusInternalServerError, err}
 	}
+	m.shadows.mirrorRefresh(input.Class, input.ID)
 
 	return nil
 }
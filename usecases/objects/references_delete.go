@@ -18,6 +18,7 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/hlc"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -79,6 +80,7 @@ func (m *Manager) DeleteObjectReference(
 	if !ok {
 		return nil
 	}
+	hlc.Observe(obj.LastUpdateTimeUnix)
 	obj.LastUpdateTimeUnix = m.timeSource.Now()
 
 	err = m.vectorRepo.PutObject(ctx, obj, res.Vector, repl)
@@ -89,6 +91,7 @@ func (m *Manager) DeleteObjectReference(
 	if err := m.updateRefVector(ctx, principal, input.Class, input.ID); err != nil {
 		return &Error{"update ref vector", StatusInternalServerError, err}
 	}
+	m.shadows.mirrorRefresh(input.Class, input.ID)
 
 	return nil
 }
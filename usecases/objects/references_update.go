@@ -18,6 +18,7 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/hlc"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/objects/validation"
@@ -81,11 +82,13 @@ func (m *Manager) UpdateObjectReferences(
 	} else {
 		obj.Properties.(map[string]interface{})[input.Property] = input.Refs
 	}
+	hlc.Observe(obj.LastUpdateTimeUnix)
 	obj.LastUpdateTimeUnix = m.timeSource.Now()
 	err = m.vectorRepo.PutObject(ctx, obj, res.Vector, repl)
 	if err != nil {
 		return &Error{"repo.putobject", StatusInternalServerError, err}
 	}
+	m.shadows.mirrorRefresh(input.Class, input.ID)
 	return nil
 }
 
@@ -0,0 +1,428 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+const (
+	RevectorizeRunning   = "running"
+	RevectorizeCompleted = "completed"
+	RevectorizeFailed    = "failed"
+	RevectorizeCancelled = "cancelled"
+)
+
+const defaultRevectorizeBatchSize = 100
+
+// RevectorizeParams control the pace of a re-vectorization job
+type RevectorizeParams struct {
+	BatchSize int `json:"batchSize"`
+	// MaxObjectsPerSecond limits the rate at which the vectorizer is called,
+	// 0 means unlimited
+	MaxObjectsPerSecond float64 `json:"maxObjectsPerSecond"`
+	// Restart starts from the beginning, even if a previous job of the class
+	// did not complete
+	Restart bool `json:"restart"`
+}
+
+// RevectorizeJob is the state of a re-vectorization job. It is persisted
+// after every batch, so that an interrupted job can be resumed from its
+// cursor.
+type RevectorizeJob struct {
+	Class      string            `json:"class"`
+	Status     string            `json:"status"`
+	Params     RevectorizeParams `json:"params"`
+	Cursor     strfmt.UUID       `json:"cursor,omitempty"`
+	Processed  int64             `json:"processed"`
+	Failed     int64             `json:"failed"`
+	Error      string            `json:"error,omitempty"`
+	StartedAt  time.Time         `json:"startedAt"`
+	FinishedAt time.Time         `json:"finishedAt,omitempty"`
+}
+
+type revectorizeRepo interface {
+	Query(context.Context, *QueryInput) (search.Results, *Error)
+	Merge(ctx context.Context, merge MergeDocument, repl *additional.ReplicationProperties) error
+	Object(ctx context.Context, class string, id strfmt.UUID, props search.SelectProperties,
+		additional additional.Properties, repl *additional.ReplicationProperties) (*search.Result, error)
+}
+
+type revectorizeModules interface {
+	UpdateVector(ctx context.Context, object *models.Object, class *models.Class,
+		objectDiff *moduletools.ObjectDiff, repo modulecapabilities.FindObjectFn,
+		logger logrus.FieldLogger) error
+}
+
+type revectorizeSchema interface {
+	GetSchemaSkipAuth() schema.Schema
+}
+
+// Revectorizer re-embeds all objects of a class in the background, e.g.
+// after the settings of its vectorizer have been changed. The vectors are
+// replaced in place, objects are served with their previous vector until
+// they have been processed.
+type Revectorizer struct {
+	sync.Mutex
+	repo       revectorizeRepo
+	modules    revectorizeModules
+	schema     revectorizeSchema
+	authorizer authorizer
+	logger     logrus.FieldLogger
+	rootDir    string
+
+	jobs    map[string]*RevectorizeJob
+	cancels map[string]context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// NewRevectorizer persists the state of its jobs in the revectorize folder
+// of dataPath
+func NewRevectorizer(repo revectorizeRepo, modules revectorizeModules,
+	schema revectorizeSchema, authorizer authorizer, dataPath string,
+	logger logrus.FieldLogger,
+) *Revectorizer {
+	return &Revectorizer{
+		repo:       repo,
+		modules:    modules,
+		schema:     schema,
+		authorizer: authorizer,
+		logger:     logger,
+		rootDir:    filepath.Join(dataPath, "revectorize"),
+		jobs:       map[string]*RevectorizeJob{},
+		cancels:    map[string]context.CancelFunc{},
+	}
+}
+
+// Start a job for the class. If a previous job did not complete, it is
+// resumed from its cursor unless params.Restart is set.
+func (r *Revectorizer) Start(principal *models.Principal, className string,
+	params RevectorizeParams,
+) (RevectorizeJob, error) {
+	err := r.authorizer.Authorize(principal, "update", revectorizeResource(className))
+	if err != nil {
+		return RevectorizeJob{}, err
+	}
+
+	class := r.class(className)
+	if class == nil {
+		return RevectorizeJob{}, NewErrInvalidUserInput("class %q not found", className)
+	}
+	if class.Vectorizer == "" || class.Vectorizer == "none" {
+		return RevectorizeJob{}, NewErrInvalidUserInput("class %q has no vectorizer", className)
+	}
+	if params.BatchSize < 0 || params.MaxObjectsPerSecond < 0 {
+		return RevectorizeJob{}, NewErrInvalidUserInput("batchSize and maxObjectsPerSecond must not be negative")
+	}
+	if params.BatchSize == 0 {
+		params.BatchSize = defaultRevectorizeBatchSize
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	if _, running := r.cancels[className]; running {
+		return RevectorizeJob{}, NewErrInvalidUserInput("class %q is already being re-vectorized", className)
+	}
+
+	job := &RevectorizeJob{
+		Class:     className,
+		Status:    RevectorizeRunning,
+		Params:    params,
+		StartedAt: time.Now(),
+	}
+
+	previous, err := r.loadJob(className)
+	if err != nil {
+		return RevectorizeJob{}, err
+	}
+	if previous != nil && previous.Status != RevectorizeCompleted && !params.Restart {
+		job.Cursor = previous.Cursor
+		job.Processed = previous.Processed
+		job.Failed = previous.Failed
+	}
+
+	if err := r.persist(job); err != nil {
+		return RevectorizeJob{}, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.jobs[className] = job
+	r.cancels[className] = cancel
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.run(ctx, job)
+	}()
+
+	return *job, nil
+}
+
+// Status of the current or the last job of the class
+func (r *Revectorizer) Status(principal *models.Principal, className string) (RevectorizeJob, error) {
+	err := r.authorizer.Authorize(principal, "get", revectorizeResource(className))
+	if err != nil {
+		return RevectorizeJob{}, err
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	if job, ok := r.jobs[className]; ok {
+		return *job, nil
+	}
+
+	job, err := r.loadJob(className)
+	if err != nil {
+		return RevectorizeJob{}, err
+	}
+	if job == nil {
+		return RevectorizeJob{}, NewErrNotFound("no re-vectorization job for class %q", className)
+	}
+	return *job, nil
+}
+
+// Cancel the running job of the class. It can be resumed with Start.
+func (r *Revectorizer) Cancel(principal *models.Principal, className string) error {
+	err := r.authorizer.Authorize(principal, "update", revectorizeResource(className))
+	if err != nil {
+		return err
+	}
+
+	r.Lock()
+	cancel, ok := r.cancels[className]
+	r.Unlock()
+
+	if !ok {
+		return NewErrNotFound("no running re-vectorization job for class %q", className)
+	}
+
+	cancel()
+	return nil
+}
+
+// Shutdown cancels all running jobs and waits for them to stop, their state
+// is kept so they can be resumed after a restart
+func (r *Revectorizer) Shutdown() {
+	r.Lock()
+	for _, cancel := range r.cancels {
+		cancel()
+	}
+	r.Unlock()
+
+	r.wg.Wait()
+}
+
+func (r *Revectorizer) run(ctx context.Context, job *RevectorizeJob) {
+	err := r.process(ctx, job)
+
+	r.Lock()
+	defer r.Unlock()
+
+	switch {
+	case err == nil:
+		job.Status = RevectorizeCompleted
+	case errors.Is(err, context.Canceled):
+		job.Status = RevectorizeCancelled
+	default:
+		job.Status = RevectorizeFailed
+		job.Error = err.Error()
+	}
+	job.FinishedAt = time.Now()
+	delete(r.cancels, job.Class)
+
+	logger := r.logger.WithField("action", "revectorize").
+		WithField("class", job.Class).
+		WithField("processed", job.Processed).
+		WithField("failed", job.Failed)
+	if err := r.persist(job); err != nil {
+		logger.WithError(err).Error("persist re-vectorization state")
+	}
+	logger.WithField("status", job.Status).Info("re-vectorization finished")
+}
+
+func (r *Revectorizer) process(ctx context.Context, job *RevectorizeJob) error {
+	var interval time.Duration
+	if job.Params.MaxObjectsPerSecond > 0 {
+		interval = time.Duration(float64(time.Second) / job.Params.MaxObjectsPerSecond)
+	}
+	next := time.Now()
+
+	for {
+		class := r.class(job.Class)
+		if class == nil {
+			return errors.Errorf("class %q was deleted", job.Class)
+		}
+
+		r.Lock()
+		cursor := job.Cursor
+		r.Unlock()
+
+		res, qErr := r.repo.Query(ctx, &QueryInput{
+			Class:  job.Class,
+			Limit:  job.Params.BatchSize,
+			Cursor: &filters.Cursor{After: cursor.String(), Limit: job.Params.BatchSize},
+		})
+		if qErr != nil {
+			return fmt.Errorf("read objects: %w", qErr)
+		}
+		if len(res) == 0 {
+			return nil
+		}
+
+		var processed, failed int64
+		for _, item := range res {
+			if interval > 0 {
+				if err := waitUntil(ctx, next); err != nil {
+					return err
+				}
+				next = next.Add(interval)
+				if now := time.Now(); next.Before(now) {
+					next = now
+				}
+			}
+
+			if err := r.revectorizeObject(ctx, class, item); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				r.logger.WithField("action", "revectorize").
+					WithField("class", job.Class).
+					WithField("id", item.ID).
+					WithError(err).Warn("re-vectorize object")
+				failed++
+				continue
+			}
+			processed++
+		}
+
+		r.Lock()
+		job.Cursor = res[len(res)-1].ID
+		job.Processed += processed
+		job.Failed += failed
+		err := r.persist(job)
+		r.Unlock()
+		if err != nil {
+			return err
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+}
+
+func (r *Revectorizer) revectorizeObject(ctx context.Context, class *models.Class,
+	item search.Result,
+) error {
+	obj := item.ObjectWithVector(false)
+	obj.Vector = nil
+
+	findObject := func(ctx context.Context, class string, id strfmt.UUID,
+		props search.SelectProperties, addl additional.Properties,
+	) (*search.Result, error) {
+		return r.repo.Object(ctx, class, id, props, addl, nil)
+	}
+	if err := r.modules.UpdateVector(ctx, obj, class, nil, findObject, r.logger); err != nil {
+		return err
+	}
+	if err := applyVectorValidation(class, obj); err != nil {
+		return err
+	}
+
+	return r.repo.Merge(ctx, MergeDocument{
+		Class:      class.Class,
+		ID:         obj.ID,
+		Vector:     obj.Vector,
+		UpdateTime: obj.LastUpdateTimeUnix,
+	}, nil)
+}
+
+func (r *Revectorizer) class(className string) *models.Class {
+	sch := r.schema.GetSchemaSkipAuth()
+	return sch.GetClass(schema.ClassName(className))
+}
+
+func waitUntil(ctx context.Context, t time.Time) error {
+	d := time.Until(t)
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func revectorizeResource(className string) string {
+	return fmt.Sprintf("schema/%s/revectorize", className)
+}
+
+func (r *Revectorizer) jobPath(className string) string {
+	return filepath.Join(r.rootDir, className+".json")
+}
+
+func (r *Revectorizer) persist(job *RevectorizeJob) error {
+	if err := os.MkdirAll(r.rootDir, 0o755); err != nil {
+		return errors.Wrap(err, "create re-vectorization state dir")
+	}
+
+	bytes, err := json.Marshal(job)
+	if err != nil {
+		return errors.Wrap(err, "marshal re-vectorization state")
+	}
+
+	tmp := r.jobPath(job.Class) + ".tmp"
+	if err := os.WriteFile(tmp, bytes, 0o644); err != nil {
+		return errors.Wrap(err, "write re-vectorization state")
+	}
+	return os.Rename(tmp, r.jobPath(job.Class))
+}
+
+func (r *Revectorizer) loadJob(className string) (*RevectorizeJob, error) {
+	bytes, err := os.ReadFile(r.jobPath(className))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "read re-vectorization state")
+	}
+
+	var job RevectorizeJob
+	if err := json.Unmarshal(bytes, &job); err != nil {
+		return nil, errors.Wrap(err, "unmarshal re-vectorization state")
+	}
+	return &job, nil
+}
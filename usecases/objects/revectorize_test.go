@@ -0,0 +1,213 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func TestRevectorizer(t *testing.T) {
+	ids := make([]strfmt.UUID, 5)
+	for i := range ids {
+		ids[i] = strfmt.UUID(fmt.Sprintf("00000000-0000-0000-0000-00000000000%d", i))
+	}
+
+	newRevectorizer := func(t *testing.T, dir string, repo *fakeRevectorizeRepo) *Revectorizer {
+		logger, _ := test.NewNullLogger()
+		return NewRevectorizer(repo, &fakeRevectorizeModules{},
+			&fakeRevectorizeSchema{}, &fakeAuthorizer{}, dir, logger)
+	}
+
+	waitForStatus := func(t *testing.T, r *Revectorizer, status string) RevectorizeJob {
+		var job RevectorizeJob
+		require.Eventually(t, func() bool {
+			var err error
+			job, err = r.Status(nil, "Article")
+			require.Nil(t, err)
+			return job.Status == status
+		}, 5*time.Second, 10*time.Millisecond)
+		return job
+	}
+
+	t.Run("all objects are re-vectorized in batches", func(t *testing.T) {
+		repo := newFakeRevectorizeRepo(ids)
+		r := newRevectorizer(t, t.TempDir(), repo)
+
+		_, err := r.Start(nil, "Article", RevectorizeParams{BatchSize: 2})
+		require.Nil(t, err)
+
+		job := waitForStatus(t, r, RevectorizeCompleted)
+		assert.Equal(t, int64(5), job.Processed)
+		assert.Equal(t, int64(0), job.Failed)
+		assert.Equal(t, ids[4], job.Cursor)
+		assert.Equal(t, 4, repo.queries) // 3 batches and the final empty one
+		for _, id := range ids {
+			assert.Equal(t, []float32{1, 2, 3}, repo.merged[id])
+		}
+	})
+
+	t.Run("an interrupted job is resumed from its cursor", func(t *testing.T) {
+		dir := t.TempDir()
+		repo := newFakeRevectorizeRepo(ids)
+		r := newRevectorizer(t, dir, repo)
+		require.Nil(t, r.persist(&RevectorizeJob{
+			Class:     "Article",
+			Status:    RevectorizeRunning,
+			Cursor:    ids[2],
+			Processed: 3,
+		}))
+
+		_, err := r.Start(nil, "Article", RevectorizeParams{BatchSize: 10})
+		require.Nil(t, err)
+
+		job := waitForStatus(t, r, RevectorizeCompleted)
+		assert.Equal(t, int64(5), job.Processed)
+		assert.Len(t, repo.merged, 2)
+		assert.Contains(t, repo.merged, ids[3])
+		assert.Contains(t, repo.merged, ids[4])
+
+		t.Run("the state is persisted", func(t *testing.T) {
+			job, err := newRevectorizer(t, dir, repo).Status(nil, "Article")
+			require.Nil(t, err)
+			assert.Equal(t, RevectorizeCompleted, job.Status)
+			assert.Equal(t, int64(5), job.Processed)
+		})
+	})
+
+	t.Run("a job can be cancelled", func(t *testing.T) {
+		repo := newFakeRevectorizeRepo(ids)
+		r := newRevectorizer(t, t.TempDir(), repo)
+
+		_, err := r.Start(nil, "Article", RevectorizeParams{BatchSize: 1, MaxObjectsPerSecond: 1})
+		require.Nil(t, err)
+
+		_, err = r.Start(nil, "Article", RevectorizeParams{})
+		assert.NotNil(t, err, "only one job per class may run")
+
+		require.Nil(t, r.Cancel(nil, "Article"))
+		job := waitForStatus(t, r, RevectorizeCancelled)
+		assert.Less(t, job.Processed, int64(5))
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		r := newRevectorizer(t, t.TempDir(), newFakeRevectorizeRepo(ids))
+
+		_, err := r.Start(nil, "Unknown", RevectorizeParams{})
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+
+		_, err = r.Start(nil, "Article", RevectorizeParams{BatchSize: -1})
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+
+		_, err = r.Status(nil, "Article")
+		assert.IsType(t, ErrNotFound{}, err)
+
+		assert.IsType(t, ErrNotFound{}, r.Cancel(nil, "Article"))
+	})
+
+	t.Run("unauthorized requests", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		authorizer := &fakeAuthorizer{Err: errors.New("forbidden")}
+		r := NewRevectorizer(newFakeRevectorizeRepo(ids), &fakeRevectorizeModules{},
+			&fakeRevectorizeSchema{}, authorizer, t.TempDir(), logger)
+
+		_, err := r.Start(nil, "Article", RevectorizeParams{})
+		assert.Equal(t, authorizer.Err, err)
+		_, err = r.Status(nil, "Article")
+		assert.Equal(t, authorizer.Err, err)
+		assert.Equal(t, authorizer.Err, r.Cancel(nil, "Article"))
+	})
+}
+
+type fakeRevectorizeRepo struct {
+	sync.Mutex
+	ids     []strfmt.UUID
+	merged  map[strfmt.UUID][]float32
+	queries int
+}
+
+func newFakeRevectorizeRepo(ids []strfmt.UUID) *fakeRevectorizeRepo {
+	return &fakeRevectorizeRepo{ids: ids, merged: map[strfmt.UUID][]float32{}}
+}
+
+func (f *fakeRevectorizeRepo) Query(ctx context.Context, q *QueryInput) (search.Results, *Error) {
+	f.Lock()
+	defer f.Unlock()
+
+	f.queries++
+	var res search.Results
+	for _, id := range f.ids {
+		if id.String() > q.Cursor.After && len(res) < q.Cursor.Limit {
+			res = append(res, search.Result{
+				ID:        id,
+				ClassName: q.Class,
+				Schema:    map[string]interface{}{"title": "title of " + id.String()},
+			})
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeRevectorizeRepo) Merge(ctx context.Context, merge MergeDocument,
+	repl *additional.ReplicationProperties,
+) error {
+	f.Lock()
+	defer f.Unlock()
+
+	f.merged[merge.ID] = merge.Vector
+	return nil
+}
+
+func (f *fakeRevectorizeRepo) Object(ctx context.Context, class string, id strfmt.UUID,
+	props search.SelectProperties, additional additional.Properties,
+	repl *additional.ReplicationProperties,
+) (*search.Result, error) {
+	return nil, nil
+}
+
+type fakeRevectorizeModules struct{}
+
+func (f *fakeRevectorizeModules) UpdateVector(ctx context.Context, object *models.Object,
+	class *models.Class, objectDiff *moduletools.ObjectDiff,
+	repo modulecapabilities.FindObjectFn, logger logrus.FieldLogger,
+) error {
+	object.Vector = []float32{1, 2, 3}
+	return nil
+}
+
+type fakeRevectorizeSchema struct{}
+
+func (f *fakeRevectorizeSchema) GetSchemaSkipAuth() schema.Schema {
+	return schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{Class: "Article", Vectorizer: "text2vec-contextionary"},
+			},
+		},
+	}
+}
@@ -0,0 +1,662 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+const (
+	ShadowActive  = "active"
+	ShadowExpired = "expired"
+)
+
+const (
+	// shadowQueueSize bounds the writes waiting to be mirrored, further
+	// writes are dropped rather than slowing down the writes to the class
+	shadowQueueSize          = 10000
+	defaultShadowSamples     = 20
+	defaultShadowCompareSize = 10
+)
+
+// ShadowParams configure the mirroring of the writes to a class
+type ShadowParams struct {
+	ShadowClass     string `json:"shadowClass"`
+	DurationSeconds int64  `json:"durationSeconds"`
+}
+
+// Shadow mirrors the writes to a class to its shadow class until the
+// configured period has passed. The counters are kept in memory only.
+type Shadow struct {
+	Class       string    `json:"class"`
+	ShadowClass string    `json:"shadowClass"`
+	Status      string    `json:"status"`
+	StartedAt   time.Time `json:"startedAt"`
+	Until       time.Time `json:"until"`
+	Mirrored    int64     `json:"mirrored"`
+	Failed      int64     `json:"failed"`
+	Dropped     int64     `json:"dropped"`
+}
+
+func (s *Shadow) active(now time.Time) bool {
+	return now.Before(s.Until)
+}
+
+// ShadowCompareParams select the objects whose nearest neighbors are compared
+// between a class and its shadow class. Without ids, the first samples
+// objects of the class are compared.
+type ShadowCompareParams struct {
+	IDs     []strfmt.UUID `json:"ids"`
+	Samples int           `json:"samples"`
+	Limit   int           `json:"limit"`
+}
+
+// ShadowQueryComparison compares the nearest neighbors of an object in the
+// class and in its shadow class. Overlap is the share of the neighbors in
+// the class which are neighbors in the shadow class as well.
+type ShadowQueryComparison struct {
+	ID      strfmt.UUID   `json:"id"`
+	Overlap float64       `json:"overlap"`
+	Missing []strfmt.UUID `json:"missing,omitempty"`
+	Extra   []strfmt.UUID `json:"extra,omitempty"`
+	Error   string        `json:"error,omitempty"`
+}
+
+type ShadowComparison struct {
+	Class       string                  `json:"class"`
+	ShadowClass string                  `json:"shadowClass"`
+	Limit       int                     `json:"limit"`
+	MeanOverlap float64                 `json:"meanOverlap"`
+	Queries     []ShadowQueryComparison `json:"queries"`
+}
+
+type shadowRepo interface {
+	PutObject(ctx context.Context, concept *models.Object, vector []float32,
+		repl *additional.ReplicationProperties) error
+	DeleteObject(ctx context.Context, className string, id strfmt.UUID,
+		repl *additional.ReplicationProperties) error
+	Object(ctx context.Context, class string, id strfmt.UUID, props search.SelectProperties,
+		additional additional.Properties, repl *additional.ReplicationProperties) (*search.Result, error)
+	Query(context.Context, *QueryInput) (search.Results, *Error)
+	ClassVectorSearch(ctx context.Context, class string, vector []float32, offset, limit int,
+		filters *filters.LocalFilter) ([]search.Result, error)
+}
+
+type shadowModules interface {
+	BatchUpdateVector(ctx context.Context, objects []*models.Object, classes []*models.Class,
+		repo modulecapabilities.FindObjectFn, logger logrus.FieldLogger) []error
+}
+
+type shadowOp struct {
+	class   string
+	objects []*models.Object
+	// refresh mirrors the current state of the objects, e.g. after a merge
+	refresh []strfmt.UUID
+	deletes []strfmt.UUID
+}
+
+func (op shadowOp) size() int64 {
+	return int64(len(op.objects) + len(op.refresh) + len(op.deletes))
+}
+
+// Shadows mirror the writes to classes to shadow classes with a different
+// config, e.g. another vectorizer or sharding, so that the results of both
+// can be compared before clients are switched to the shadow class. Writes
+// are mirrored in order in the background, a failed mirror never fails the
+// write to the class. References added or removed by batches are not
+// mirrored.
+//
+// Shadows are configured per node, they have to be started on every node
+// which receives writes.
+type Shadows struct {
+	sync.Mutex
+	repo       shadowRepo
+	modules    shadowModules
+	schema     revectorizeSchema
+	authorizer authorizer
+	redactor   *authorization.Redactor
+	logger     logrus.FieldLogger
+	rootDir    string
+
+	shadows map[string]*Shadow
+	ops     chan shadowOp
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// NewShadows persists the shadows in the shadows folder of dataPath and
+// starts mirroring the writes of the shadows which haven't expired.
+// Restricted are the restricted properties of the authorization config.
+func NewShadows(repo shadowRepo, modules shadowModules, schema revectorizeSchema,
+	authorizer authorizer, restricted []string, dataPath string, logger logrus.FieldLogger,
+) *Shadows {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Shadows{
+		repo:       repo,
+		modules:    modules,
+		schema:     schema,
+		authorizer: authorizer,
+		redactor:   authorization.NewRedactor(authorizer, restricted),
+		logger:     logger,
+		rootDir:    filepath.Join(dataPath, "shadows"),
+		shadows:    map[string]*Shadow{},
+		ops:        make(chan shadowOp, shadowQueueSize),
+		cancel:     cancel,
+		done:       make(chan struct{}),
+	}
+	if err := s.load(); err != nil {
+		logger.WithField("action", "shadow").WithError(err).
+			Error("load shadows")
+	}
+
+	go s.run(ctx)
+	return s
+}
+
+// Start mirroring the writes to the class for the configured duration. An
+// existing shadow of the class is replaced. The objects are copied as a
+// whole, so the principal has to be allowed to read all restricted
+// properties of the class.
+func (s *Shadows) Start(principal *models.Principal, className string,
+	params ShadowParams,
+) (Shadow, error) {
+	err := s.authorizer.Authorize(principal, "update", shadowResource(className))
+	if err != nil {
+		return Shadow{}, err
+	}
+	if err := s.redactor.AuthorizeClass(principal, className); err != nil {
+		return Shadow{}, err
+	}
+
+	if s.class(className) == nil {
+		return Shadow{}, NewErrInvalidUserInput("class %q not found", className)
+	}
+	if s.class(params.ShadowClass) == nil {
+		return Shadow{}, NewErrInvalidUserInput("shadow class %q not found", params.ShadowClass)
+	}
+	if params.ShadowClass == className {
+		return Shadow{}, NewErrInvalidUserInput("a class cannot be its own shadow class")
+	}
+	if params.DurationSeconds <= 0 {
+		return Shadow{}, NewErrInvalidUserInput("durationSeconds must be positive")
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	for _, other := range s.shadows {
+		if other.Class == params.ShadowClass || other.ShadowClass == className {
+			return Shadow{}, NewErrInvalidUserInput(
+				"class %q is mirrored to %q already, shadows cannot be chained",
+				other.Class, other.ShadowClass)
+		}
+	}
+
+	now := time.Now()
+	shadow := &Shadow{
+		Class:       className,
+		ShadowClass: params.ShadowClass,
+		StartedAt:   now,
+		Until:       now.Add(time.Duration(params.DurationSeconds) * time.Second),
+	}
+	if err := s.persist(shadow); err != nil {
+		return Shadow{}, err
+	}
+	s.shadows[className] = shadow
+
+	return s.status(shadow), nil
+}
+
+func (s *Shadows) Status(principal *models.Principal, className string) (Shadow, error) {
+	err := s.authorizer.Authorize(principal, "get", shadowResource(className))
+	if err != nil {
+		return Shadow{}, err
+	}
+	return s.get(className)
+}
+
+// Stop mirroring the writes to the class, the shadow class is kept
+func (s *Shadows) Stop(principal *models.Principal, className string) error {
+	err := s.authorizer.Authorize(principal, "update", shadowResource(className))
+	if err != nil {
+		return err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	if _, ok := s.shadows[className]; !ok {
+		return NewErrNotFound("no shadow class for class %q", className)
+	}
+	if err := os.Remove(s.shadowPath(className)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove shadow")
+	}
+	delete(s.shadows, className)
+	return nil
+}
+
+// Shutdown stops mirroring, writes which haven't been mirrored yet are lost
+func (s *Shadows) Shutdown() {
+	s.cancel()
+	<-s.done
+}
+
+func (s *Shadows) get(className string) (Shadow, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	shadow, ok := s.shadows[className]
+	if !ok {
+		return Shadow{}, NewErrNotFound("no shadow class for class %q", className)
+	}
+	return s.status(shadow), nil
+}
+
+func (s *Shadows) status(shadow *Shadow) Shadow {
+	status := *shadow
+	status.Status = ShadowExpired
+	if shadow.active(time.Now()) {
+		status.Status = ShadowActive
+	}
+	return status
+}
+
+// mirrorPut mirrors objects which have been added or replaced. They are
+// copied, as the caller keeps using them.
+func (s *Shadows) mirrorPut(objects ...*models.Object) {
+	if s == nil {
+		return
+	}
+	byClass := map[string][]*models.Object{}
+	for _, obj := range objects {
+		if obj == nil {
+			continue
+		}
+		cp := *obj
+		if props, ok := obj.Properties.(map[string]interface{}); ok {
+			propsCopy := make(map[string]interface{}, len(props))
+			for key, value := range props {
+				propsCopy[key] = value
+			}
+			cp.Properties = propsCopy
+		}
+		byClass[obj.Class] = append(byClass[obj.Class], &cp)
+	}
+	for className, objs := range byClass {
+		s.enqueue(shadowOp{class: className, objects: objs})
+	}
+}
+
+// mirrorRefresh mirrors objects which have been changed partially
+func (s *Shadows) mirrorRefresh(className string, ids ...strfmt.UUID) {
+	if s == nil || len(ids) == 0 {
+		return
+	}
+	s.enqueue(shadowOp{class: className, refresh: ids})
+}
+
+func (s *Shadows) mirrorDelete(className string, ids ...strfmt.UUID) {
+	if s == nil || len(ids) == 0 {
+		return
+	}
+	s.enqueue(shadowOp{class: className, deletes: ids})
+}
+
+func (s *Shadows) enqueue(op shadowOp) {
+	s.Lock()
+	defer s.Unlock()
+
+	shadow, ok := s.shadows[op.class]
+	if !ok || !shadow.active(time.Now()) {
+		return
+	}
+	select {
+	case s.ops <- op:
+	default:
+		shadow.Dropped += op.size()
+	}
+}
+
+func (s *Shadows) run(ctx context.Context) {
+	defer close(s.done)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case op := <-s.ops:
+			s.apply(ctx, op)
+		}
+	}
+}
+
+func (s *Shadows) apply(ctx context.Context, op shadowOp) {
+	s.Lock()
+	shadow, ok := s.shadows[op.class]
+	var shadowClassName string
+	if ok {
+		shadowClassName = shadow.ShadowClass
+	}
+	s.Unlock()
+	if !ok {
+		return
+	}
+
+	logger := s.logger.WithField("action", "shadow").
+		WithField("class", op.class).
+		WithField("shadow_class", shadowClassName)
+
+	var mirrored, failed int64
+	count := func(err error) {
+		if err != nil {
+			logger.WithError(err).Warn("mirror write to shadow class")
+			failed++
+			return
+		}
+		mirrored++
+	}
+
+	shadowClass := s.class(shadowClassName)
+	if shadowClass == nil {
+		logger.Warn("shadow class not found, writes are not mirrored")
+		failed = op.size()
+	} else {
+		objects := op.objects
+		for _, id := range op.refresh {
+			obj, err := s.currentObject(ctx, op.class, id)
+			switch {
+			case err != nil:
+				count(err)
+			case obj == nil:
+				op.deletes = append(op.deletes, id)
+			default:
+				objects = append(objects, obj)
+			}
+		}
+		for _, err := range s.putShadowObjects(ctx, shadowClass, objects) {
+			count(err)
+		}
+		for _, id := range op.deletes {
+			count(s.repo.DeleteObject(ctx, shadowClass.Class, id, nil))
+		}
+	}
+
+	s.Lock()
+	shadow.Mirrored += mirrored
+	shadow.Failed += failed
+	s.Unlock()
+}
+
+func (s *Shadows) currentObject(ctx context.Context, className string,
+	id strfmt.UUID,
+) (*models.Object, error) {
+	res, err := s.repo.Object(ctx, className, id, search.SelectProperties{},
+		additional.Properties{Vector: true}, nil)
+	if err != nil || res == nil {
+		return nil, err
+	}
+	return res.ObjectWithVector(true), nil
+}
+
+// putShadowObjects puts the objects into the shadow class. They are
+// vectorized again if the shadow class has a vectorizer, otherwise they keep
+// their vectors.
+func (s *Shadows) putShadowObjects(ctx context.Context, class *models.Class,
+	objects []*models.Object,
+) []error {
+	if len(objects) == 0 {
+		return nil
+	}
+
+	classes := make([]*models.Class, len(objects))
+	for i, obj := range objects {
+		obj.Class = class.Class
+		if class.Vectorizer != "" && class.Vectorizer != "none" {
+			obj.Vector = nil
+		}
+		classes[i] = class
+	}
+
+	findObject := func(ctx context.Context, class string, id strfmt.UUID,
+		props search.SelectProperties, addl additional.Properties,
+	) (*search.Result, error) {
+		return s.repo.Object(ctx, class, id, props, addl, nil)
+	}
+	errs := s.modules.BatchUpdateVector(ctx, objects, classes, findObject, s.logger)
+
+	for i, obj := range objects {
+		if errs[i] != nil {
+			continue
+		}
+		if err := applyVectorValidation(class, obj); err != nil {
+			errs[i] = err
+			continue
+		}
+		errs[i] = s.repo.PutObject(ctx, obj, obj.Vector, nil)
+	}
+	return errs
+}
+
+// Compare the nearest neighbors of objects in the class and its shadow class.
+// Each object is searched with its own vector in either class, so the
+// comparison shows how much the neighborhoods change with the config of the
+// shadow class.
+func (s *Shadows) Compare(ctx context.Context, principal *models.Principal,
+	className string, params ShadowCompareParams,
+) (ShadowComparison, error) {
+	err := s.authorizer.Authorize(principal, "get", shadowResource(className))
+	if err != nil {
+		return ShadowComparison{}, err
+	}
+
+	shadow, err := s.get(className)
+	if err != nil {
+		return ShadowComparison{}, err
+	}
+	// the neighbors are ranked by vectors which may have been computed from
+	// restricted properties of either class
+	if err := s.redactor.AuthorizeClass(principal, className); err != nil {
+		return ShadowComparison{}, err
+	}
+	if err := s.redactor.AuthorizeClass(principal, shadow.ShadowClass); err != nil {
+		return ShadowComparison{}, err
+	}
+	if params.Samples < 0 || params.Limit < 0 {
+		return ShadowComparison{}, NewErrInvalidUserInput("samples and limit must not be negative")
+	}
+	if params.Samples == 0 {
+		params.Samples = defaultShadowSamples
+	}
+	if params.Limit == 0 {
+		params.Limit = defaultShadowCompareSize
+	}
+
+	ids := params.IDs
+	if len(ids) == 0 {
+		res, qErr := s.repo.Query(ctx, &QueryInput{
+			Class:  className,
+			Limit:  params.Samples,
+			Cursor: &filters.Cursor{Limit: params.Samples},
+		})
+		if qErr != nil {
+			return ShadowComparison{}, errors.Wrap(qErr, "sample objects")
+		}
+		for _, item := range res {
+			ids = append(ids, item.ID)
+		}
+	}
+
+	comparison := ShadowComparison{
+		Class:       className,
+		ShadowClass: shadow.ShadowClass,
+		Limit:       params.Limit,
+		Queries:     make([]ShadowQueryComparison, 0, len(ids)),
+	}
+	var compared int
+	for _, id := range ids {
+		query := s.compareNeighbors(ctx, className, shadow.ShadowClass, id, params.Limit)
+		if query.Error == "" {
+			comparison.MeanOverlap += query.Overlap
+			compared++
+		}
+		comparison.Queries = append(comparison.Queries, query)
+	}
+	if compared > 0 {
+		comparison.MeanOverlap /= float64(compared)
+	}
+
+	return comparison, nil
+}
+
+func (s *Shadows) compareNeighbors(ctx context.Context, className, shadowClass string,
+	id strfmt.UUID, limit int,
+) ShadowQueryComparison {
+	query := ShadowQueryComparison{ID: id}
+
+	neighbors, err := s.neighbors(ctx, className, id, limit)
+	if err != nil {
+		query.Error = err.Error()
+		return query
+	}
+	shadowNeighbors, err := s.neighbors(ctx, shadowClass, id, limit)
+	if err != nil {
+		query.Error = err.Error()
+		return query
+	}
+
+	inShadow := make(map[strfmt.UUID]struct{}, len(shadowNeighbors))
+	for _, neighbor := range shadowNeighbors {
+		inShadow[neighbor] = struct{}{}
+	}
+	inClass := make(map[strfmt.UUID]struct{}, len(neighbors))
+	for _, neighbor := range neighbors {
+		inClass[neighbor] = struct{}{}
+		if _, ok := inShadow[neighbor]; !ok {
+			query.Missing = append(query.Missing, neighbor)
+		}
+	}
+	for _, neighbor := range shadowNeighbors {
+		if _, ok := inClass[neighbor]; !ok {
+			query.Extra = append(query.Extra, neighbor)
+		}
+	}
+
+	query.Overlap = 1
+	if len(neighbors) > 0 {
+		query.Overlap = float64(len(neighbors)-len(query.Missing)) / float64(len(neighbors))
+	}
+	return query
+}
+
+// neighbors are the ids of the nearest neighbors of the object in the class,
+// without the object itself
+func (s *Shadows) neighbors(ctx context.Context, className string, id strfmt.UUID,
+	limit int,
+) ([]strfmt.UUID, error) {
+	obj, err := s.repo.Object(ctx, className, id, search.SelectProperties{},
+		additional.Properties{Vector: true}, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get object from %s", className)
+	}
+	if obj == nil {
+		return nil, errors.Errorf("object not found in %s", className)
+	}
+	if len(obj.Vector) == 0 {
+		return nil, errors.Errorf("object has no vector in %s", className)
+	}
+
+	res, err := s.repo.ClassVectorSearch(ctx, className, obj.Vector, 0, limit+1, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "search %s", className)
+	}
+	ids := make([]strfmt.UUID, 0, len(res))
+	for _, item := range res {
+		if item.ID != id && len(ids) < limit {
+			ids = append(ids, item.ID)
+		}
+	}
+	return ids, nil
+}
+
+func (s *Shadows) class(className string) *models.Class {
+	sch := s.schema.GetSchemaSkipAuth()
+	return sch.GetClass(schema.ClassName(className))
+}
+
+func shadowResource(className string) string {
+	return fmt.Sprintf("schema/%s/shadow", className)
+}
+
+func (s *Shadows) shadowPath(className string) string {
+	return filepath.Join(s.rootDir, className+".json")
+}
+
+func (s *Shadows) persist(shadow *Shadow) error {
+	if err := os.MkdirAll(s.rootDir, 0o755); err != nil {
+		return errors.Wrap(err, "create shadows dir")
+	}
+
+	bytes, err := json.Marshal(shadow)
+	if err != nil {
+		return errors.Wrap(err, "marshal shadow")
+	}
+
+	tmp := s.shadowPath(shadow.Class) + ".tmp"
+	if err := os.WriteFile(tmp, bytes, 0o644); err != nil {
+		return errors.Wrap(err, "write shadow")
+	}
+	return os.Rename(tmp, s.shadowPath(shadow.Class))
+}
+
+func (s *Shadows) load() error {
+	entries, err := os.ReadDir(s.rootDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrap(err, "read shadows dir")
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		bytes, err := os.ReadFile(filepath.Join(s.rootDir, entry.Name()))
+		if err != nil {
+			return errors.Wrap(err, "read shadow")
+		}
+		var shadow Shadow
+		if err := json.Unmarshal(bytes, &shadow); err != nil {
+			return errors.Wrapf(err, "unmarshal shadow %s", entry.Name())
+		}
+		shadow.Mirrored, shadow.Failed, shadow.Dropped = 0, 0, 0
+		s.shadows[shadow.Class] = &shadow
+	}
+	return nil
+}
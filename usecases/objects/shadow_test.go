@@ -0,0 +1,395 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+func TestShadows(t *testing.T) {
+	ids := make([]strfmt.UUID, 5)
+	for i := range ids {
+		ids[i] = strfmt.UUID(fmt.Sprintf("00000000-0000-0000-0000-00000000000%d", i))
+	}
+
+	newShadows := func(t *testing.T, dir string, repo *fakeShadowRepo) *Shadows {
+		logger, _ := test.NewNullLogger()
+		s := NewShadows(repo, &fakeShadowModules{}, &fakeShadowSchema{},
+			&fakeAuthorizer{}, nil, dir, logger)
+		t.Cleanup(s.Shutdown)
+		return s
+	}
+
+	waitForMirrored := func(t *testing.T, s *Shadows, mirrored int64) {
+		require.Eventually(t, func() bool {
+			shadow, err := s.Status(nil, "Article")
+			require.Nil(t, err)
+			return shadow.Mirrored == mirrored
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	t.Run("writes are mirrored to the shadow class", func(t *testing.T) {
+		repo := newFakeShadowRepo()
+		repo.put("Article", ids[1], []float32{1, 1, 1})
+		repo.put("ArticleV2", ids[2], []float32{2, 2, 2})
+		s := newShadows(t, t.TempDir(), repo)
+
+		shadow, err := s.Start(nil, "Article", ShadowParams{ShadowClass: "ArticleV2", DurationSeconds: 60})
+		require.Nil(t, err)
+		assert.Equal(t, ShadowActive, shadow.Status)
+
+		added := &models.Object{
+			Class:      "Article",
+			ID:         ids[0],
+			Properties: map[string]interface{}{"title": "added"},
+			Vector:     []float32{1, 2, 3},
+		}
+		s.mirrorPut(added)
+		s.mirrorRefresh("Article", ids[1])
+		s.mirrorDelete("Article", ids[2])
+		waitForMirrored(t, s, 3)
+
+		// the shadow class is vectorized with its own vectorizer
+		assert.Equal(t, []float32{9, 9, 9}, repo.vector("ArticleV2", ids[0]))
+		assert.Equal(t, []float32{9, 9, 9}, repo.vector("ArticleV2", ids[1]))
+		assert.Nil(t, repo.vector("ArticleV2", ids[2]))
+		assert.Equal(t, "Article", added.Class)
+		assert.Equal(t, models.C11yVector{1, 2, 3}, added.Vector)
+	})
+
+	t.Run("vectors are kept without a vectorizer", func(t *testing.T) {
+		repo := newFakeShadowRepo()
+		s := newShadows(t, t.TempDir(), repo)
+
+		_, err := s.Start(nil, "Article", ShadowParams{ShadowClass: "ArticleCopy", DurationSeconds: 60})
+		require.Nil(t, err)
+
+		s.mirrorPut(&models.Object{Class: "Article", ID: ids[0], Vector: []float32{1, 2, 3}})
+		waitForMirrored(t, s, 1)
+		assert.Equal(t, []float32{1, 2, 3}, repo.vector("ArticleCopy", ids[0]))
+	})
+
+	t.Run("other classes and expired shadows are not mirrored", func(t *testing.T) {
+		dir := t.TempDir()
+		repo := newFakeShadowRepo()
+		s := newShadows(t, dir, repo)
+		require.Nil(t, s.persist(&Shadow{
+			Class:       "Article",
+			ShadowClass: "ArticleV2",
+			StartedAt:   time.Now().Add(-2 * time.Hour),
+			Until:       time.Now().Add(-time.Hour),
+		}))
+
+		s = newShadows(t, dir, repo)
+		shadow, err := s.Status(nil, "Article")
+		require.Nil(t, err)
+		assert.Equal(t, ShadowExpired, shadow.Status)
+
+		s.mirrorPut(&models.Object{Class: "Article", ID: ids[0]})
+		s.mirrorPut(&models.Object{Class: "ArticleV2", ID: ids[1]})
+		s.mirrorDelete("Article", ids[2])
+		time.Sleep(50 * time.Millisecond)
+		assert.Equal(t, 0, repo.writes)
+	})
+
+	t.Run("shadows are persisted until they are stopped", func(t *testing.T) {
+		dir := t.TempDir()
+		repo := newFakeShadowRepo()
+		_, err := newShadows(t, dir, repo).Start(nil, "Article",
+			ShadowParams{ShadowClass: "ArticleV2", DurationSeconds: 60})
+		require.Nil(t, err)
+
+		s := newShadows(t, dir, repo)
+		shadow, err := s.Status(nil, "Article")
+		require.Nil(t, err)
+		assert.Equal(t, "ArticleV2", shadow.ShadowClass)
+		assert.Equal(t, ShadowActive, shadow.Status)
+
+		require.Nil(t, s.Stop(nil, "Article"))
+		_, err = newShadows(t, dir, repo).Status(nil, "Article")
+		assert.IsType(t, ErrNotFound{}, err)
+	})
+
+	t.Run("nearest neighbors are compared", func(t *testing.T) {
+		repo := newFakeShadowRepo()
+		for i, id := range ids {
+			repo.put("Article", id, []float32{float32(i)})
+		}
+		// the order of the last two objects is swapped in the shadow class
+		for i, id := range ids {
+			vec := []float32{float32(i)}
+			switch i {
+			case 3:
+				vec = []float32{10}
+			case 4:
+				vec = []float32{3}
+			}
+			repo.put("ArticleCopy", id, vec)
+		}
+		s := newShadows(t, t.TempDir(), repo)
+		_, err := s.Start(nil, "Article", ShadowParams{ShadowClass: "ArticleCopy", DurationSeconds: 60})
+		require.Nil(t, err)
+
+		res, err := s.Compare(context.Background(), nil, "Article",
+			ShadowCompareParams{IDs: []strfmt.UUID{ids[2], ids[4]}, Limit: 2})
+		require.Nil(t, err)
+		assert.Equal(t, "ArticleCopy", res.ShadowClass)
+		assert.Equal(t, []ShadowQueryComparison{
+			{
+				// 1 and 3 in the class, 1 and 4 in the shadow class
+				ID:      ids[2],
+				Overlap: 0.5,
+				Missing: []strfmt.UUID{ids[3]},
+				Extra:   []strfmt.UUID{ids[4]},
+			},
+			{
+				// 3 and 2 in the class, 2 and 1 in the shadow class
+				ID:      ids[4],
+				Overlap: 0.5,
+				Missing: []strfmt.UUID{ids[3]},
+				Extra:   []strfmt.UUID{ids[1]},
+			},
+		}, res.Queries)
+		assert.Equal(t, 0.5, res.MeanOverlap)
+
+		t.Run("with sampled objects", func(t *testing.T) {
+			res, err := s.Compare(context.Background(), nil, "Article",
+				ShadowCompareParams{Samples: 3})
+			require.Nil(t, err)
+			require.Len(t, res.Queries, 3)
+			assert.Equal(t, ids[0], res.Queries[0].ID)
+			assert.Equal(t, defaultShadowCompareSize, res.Limit)
+		})
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		s := newShadows(t, t.TempDir(), newFakeShadowRepo())
+
+		_, err := s.Start(nil, "Unknown", ShadowParams{ShadowClass: "ArticleV2", DurationSeconds: 60})
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+
+		_, err = s.Start(nil, "Article", ShadowParams{ShadowClass: "Unknown", DurationSeconds: 60})
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+
+		_, err = s.Start(nil, "Article", ShadowParams{ShadowClass: "Article", DurationSeconds: 60})
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+
+		_, err = s.Start(nil, "Article", ShadowParams{ShadowClass: "ArticleV2"})
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+
+		_, err = s.Start(nil, "Article", ShadowParams{ShadowClass: "ArticleV2", DurationSeconds: 60})
+		require.Nil(t, err)
+		_, err = s.Start(nil, "ArticleV2", ShadowParams{ShadowClass: "ArticleCopy", DurationSeconds: 60})
+		assert.IsType(t, ErrInvalidUserInput{}, err, "shadows cannot be chained")
+
+		_, err = s.Status(nil, "ArticleV2")
+		assert.IsType(t, ErrNotFound{}, err)
+		assert.IsType(t, ErrNotFound{}, s.Stop(nil, "ArticleV2"))
+
+		_, err = s.Compare(context.Background(), nil, "ArticleV2", ShadowCompareParams{})
+		assert.IsType(t, ErrNotFound{}, err)
+	})
+
+	t.Run("restricted properties", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		authorizer := &fakeShadowAuthorizer{denied: authorization.VerbReadRestricted}
+		repo := newFakeShadowRepo()
+		repo.put("Article", ids[0], []float32{1, 1, 1})
+		repo.put("ArticleCopy", ids[0], []float32{1, 1, 1})
+		s := NewShadows(repo, &fakeShadowModules{}, &fakeShadowSchema{}, authorizer,
+			[]string{"ArticleCopy.title"}, t.TempDir(), logger)
+		t.Cleanup(s.Shutdown)
+
+		_, err := s.Start(nil, "Article", ShadowParams{ShadowClass: "ArticleCopy", DurationSeconds: 60})
+		require.Nil(t, err)
+		_, err = s.Compare(context.Background(), nil, "Article", ShadowCompareParams{})
+		assert.ErrorIs(t, err, errDenied, "neighbors are ranked by the restricted properties")
+
+		_, err = s.Start(nil, "ArticleCopy", ShadowParams{ShadowClass: "ArticleV2", DurationSeconds: 60})
+		assert.ErrorIs(t, err, errDenied, "the objects are copied as a whole")
+
+		authorizer.denied = "get"
+		_, err = s.Status(nil, "Article")
+		assert.ErrorIs(t, err, errDenied)
+	})
+
+	t.Run("a nil shadows doesn't mirror", func(t *testing.T) {
+		var s *Shadows
+		s.mirrorPut(&models.Object{Class: "Article", ID: ids[0]})
+		s.mirrorRefresh("Article", ids[0])
+		s.mirrorDelete("Article", ids[0])
+	})
+}
+
+type fakeShadowRepo struct {
+	sync.Mutex
+	objects map[string]map[strfmt.UUID]*models.Object
+	writes  int
+}
+
+func newFakeShadowRepo() *fakeShadowRepo {
+	return &fakeShadowRepo{objects: map[string]map[strfmt.UUID]*models.Object{}}
+}
+
+func (f *fakeShadowRepo) put(class string, id strfmt.UUID, vector []float32) {
+	if f.objects[class] == nil {
+		f.objects[class] = map[strfmt.UUID]*models.Object{}
+	}
+	f.objects[class][id] = &models.Object{Class: class, ID: id, Vector: vector}
+}
+
+func (f *fakeShadowRepo) vector(class string, id strfmt.UUID) []float32 {
+	f.Lock()
+	defer f.Unlock()
+
+	if obj, ok := f.objects[class][id]; ok {
+		return obj.Vector
+	}
+	return nil
+}
+
+func (f *fakeShadowRepo) PutObject(ctx context.Context, obj *models.Object, vector []float32,
+	repl *additional.ReplicationProperties,
+) error {
+	f.Lock()
+	defer f.Unlock()
+
+	f.writes++
+	f.put(obj.Class, obj.ID, vector)
+	return nil
+}
+
+func (f *fakeShadowRepo) DeleteObject(ctx context.Context, class string, id strfmt.UUID,
+	repl *additional.ReplicationProperties,
+) error {
+	f.Lock()
+	defer f.Unlock()
+
+	f.writes++
+	delete(f.objects[class], id)
+	return nil
+}
+
+func (f *fakeShadowRepo) Object(ctx context.Context, class string, id strfmt.UUID,
+	props search.SelectProperties, additional additional.Properties,
+	repl *additional.ReplicationProperties,
+) (*search.Result, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	obj, ok := f.objects[class][id]
+	if !ok {
+		return nil, nil
+	}
+	return &search.Result{ID: id, ClassName: class, Vector: obj.Vector}, nil
+}
+
+func (f *fakeShadowRepo) Query(ctx context.Context, q *QueryInput) (search.Results, *Error) {
+	f.Lock()
+	defer f.Unlock()
+
+	var res search.Results
+	for id := range f.objects[q.Class] {
+		res = append(res, search.Result{ID: id, ClassName: q.Class})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	if len(res) > q.Limit {
+		res = res[:q.Limit]
+	}
+	return res, nil
+}
+
+func (f *fakeShadowRepo) ClassVectorSearch(ctx context.Context, class string,
+	vector []float32, offset, limit int, filters *filters.LocalFilter,
+) ([]search.Result, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	var res []search.Result
+	for id, obj := range f.objects[class] {
+		var dist float32
+		for i := range vector {
+			diff := vector[i] - obj.Vector[i]
+			dist += diff * diff
+		}
+		res = append(res, search.Result{ID: id, ClassName: class, Dist: dist})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Dist != res[j].Dist {
+			return res[i].Dist < res[j].Dist
+		}
+		return res[i].ID < res[j].ID
+	})
+	if len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
+var errDenied = errors.New("denied")
+
+type fakeShadowAuthorizer struct {
+	denied string
+}
+
+func (f *fakeShadowAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	if verb == f.denied {
+		return errDenied
+	}
+	return nil
+}
+
+type fakeShadowModules struct{}
+
+func (f *fakeShadowModules) BatchUpdateVector(ctx context.Context, objects []*models.Object,
+	classes []*models.Class, repo modulecapabilities.FindObjectFn, logger logrus.FieldLogger,
+) []error {
+	for _, obj := range objects {
+		if obj.Vector == nil {
+			obj.Vector = []float32{9, 9, 9}
+		}
+	}
+	return make([]error, len(objects))
+}
+
+type fakeShadowSchema struct{}
+
+func (f *fakeShadowSchema) GetSchemaSkipAuth() schema.Schema {
+	return schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{Class: "Article", Vectorizer: "text2vec-contextionary"},
+				{Class: "ArticleV2", Vectorizer: "text2vec-openai"},
+				{Class: "ArticleCopy", Vectorizer: "none"},
+			},
+		},
+	}
+}
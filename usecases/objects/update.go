@@ -17,6 +17,7 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/hlc"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -77,21 +78,49 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context, principa
 	// directly from the request body, therefore `CreationTimeUnix`
 	// inherits the zero value.
 	updates.CreationTimeUnix = obj.Created
+	// the new version must be ordered after the current one, which might have
+	// been written by a node whose clock is ahead
+	hlc.Observe(obj.Updated)
 	updates.LastUpdateTimeUnix = m.timeSource.Now()
 
 	class, err := m.schemaManager.GetClass(ctx, principal, className)
 	if err != nil {
 		return nil, err
 	}
+	if updates.Properties == nil {
+		updates.Properties = map[string]interface{}{}
+	}
+	props := updates.Properties.(map[string]interface{})
+	if err := applyReferenceSnapshots(ctx, class, props, nil, m.findObject); err != nil {
+		return nil, NewErrInternal("update object: %v", err)
+	}
+	if err := m.blobs.inlineForVectorizer(ctx, class, id, props); err != nil {
+		return nil, err
+	}
 	err = m.modulesProvider.UpdateVector(ctx, updates, class, nil, m.findObject, m.logger)
 	if err != nil {
+		if dimsErr, ok := vectorDimensionsInput(err); ok {
+			return nil, dimsErr
+		}
 		return nil, NewErrInternal("update object: %v", err)
 	}
+	if err := applyVectorValidation(class, updates); err != nil {
+		return nil, err
+	}
+	if err := m.blobs.offload(ctx, class, id, props); err != nil {
+		return nil, err
+	}
 
 	err = m.vectorRepo.PutObject(ctx, updates, updates.Vector, repl)
 	if err != nil {
 		return nil, NewErrInternal("put object: %v", err)
 	}
+	m.shadows.mirrorPut(updates)
+	oldProps, _ := obj.Schema.(map[string]interface{})
+	m.blobs.deleteStale(ctx, class, id, oldProps, props)
+	if err := m.chunks.chunk(ctx, principal, []*models.Object{updates}, m.findObject, repl)[0]; err != nil {
+		return nil, NewErrInternal("chunk object: %v", err)
+	}
 
 	return updates, nil
 }
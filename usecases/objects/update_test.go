@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/hlc"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
@@ -72,7 +73,7 @@ func Test_UpdateAction(t *testing.T) {
 		metrics := &fakeMetrics{}
 		modulesProvider = getFakeModulesProviderWithCustomExtenders(extender, projectorFake)
 		manager = NewManager(locks, schemaManager, cfg,
-			logger, authorizer, db, modulesProvider, metrics)
+			logger, authorizer, db, modulesProvider, metrics, nil, nil)
 	}
 
 	t.Run("ensure creation timestamp persists", func(t *testing.T) {
@@ -108,7 +109,9 @@ func Test_UpdateAction(t *testing.T) {
 			CreationTimeUnix: beforeUpdate,
 		}
 
-		afterUpdate := time.Now().UnixNano() / int64(time.Millisecond)
+		// the clock may be ahead of the wall clock if it issued several
+		// timestamps within the same millisecond
+		afterUpdate := hlc.Now()
 
 		assert.Equal(t, expected.Class, res.Class)
 		assert.Equal(t, expected.ID, res.ID)
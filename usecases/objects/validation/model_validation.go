@@ -82,9 +82,29 @@ func (v *Validator) Object(ctx context.Context, object *models.Object, class *mo
 		return err
 	}
 
+	if err := validateVectors(object.Vectors, class); err != nil {
+		return err
+	}
+
 	return v.properties(ctx, object, class)
 }
 
+// validateVectors checks that all named vectors of the object are defined in
+// the vectorConfig of the class
+func validateVectors(vectors models.Vectors, class *models.Class) error {
+	for target, vector := range vectors {
+		if _, ok := class.VectorConfig[target]; !ok {
+			return fmt.Errorf("target vector %q is not configured for class %s",
+				target, class.Class)
+		}
+		if len(vector) == 0 {
+			return fmt.Errorf("target vector %q is empty", target)
+		}
+	}
+
+	return nil
+}
+
 func validateClass(class string) error {
 	// If the given class is empty, return an error
 	if class == "" {
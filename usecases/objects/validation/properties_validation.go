@@ -410,6 +410,12 @@ func blobVal(val interface{}) (string, error) {
 		return "", fmt.Errorf("not a blob base64 string, but %T", val)
 	}
 
+	// references of offloaded values are checked when the object is written,
+	// as they must reference the blob of the same object
+	if _, ok := schema.BlobReference(typed); ok {
+		return typed, nil
+	}
+
 	base64Regex := regexp.MustCompile(`^(?:[A-Za-z0-9+/]{4})*(?:[A-Za-z0-9+/]{2}==|[A-Za-z0-9+/]{3}=|[A-Za-z0-9+/]{4})$`)
 	ok = base64Regex.MatchString(typed)
 	if !ok {
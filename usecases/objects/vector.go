@@ -13,6 +13,7 @@ package objects
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/weaviate/weaviate/entities/models"
@@ -20,6 +21,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/modules"
 )
 
 func (m *Manager) updateRefVector(ctx context.Context, principal *models.Principal,
@@ -56,6 +58,33 @@ func (m *Manager) updateRefVector(ctx context.Context, principal *models.Princip
 	return nil
 }
 
+// applyVectorValidation enforces the vector validation policy of the class
+// and replaces the vector of the object with the one to be stored. It needs
+// to run after the vectorizer, so that generated vectors are covered as well.
+func applyVectorValidation(class *models.Class, object *models.Object) error {
+	if class == nil {
+		return nil
+	}
+
+	vector, err := modules.VectorValidation(class).Apply(object.Vector)
+	if err != nil {
+		return NewErrInvalidUserInput("invalid vector: %v", err)
+	}
+
+	object.Vector = vector
+	return nil
+}
+
+// vectorDimensionsInput reports a vectorizer which generated a vector of the
+// wrong dimensionality as invalid input, see modules.ErrVectorDimensions
+func vectorDimensionsInput(err error) (ErrInvalidUserInput, bool) {
+	var dimsErr modules.ErrVectorDimensions
+	if !errors.As(err, &dimsErr) {
+		return ErrInvalidUserInput{}, false
+	}
+	return NewErrInvalidUserInput("%v", dimsErr), true
+}
+
 // TODO: remove this method and just pass m.vectorRepo.Object to
 // m.modulesProvider.UpdateVector when m.vectorRepo.ObjectByID
 // is finally removed
@@ -0,0 +1,193 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package queryreplay captures slow GraphQL queries in a log and replays
+// captured queries against a node or cluster at a configurable rate, so that
+// config changes can be validated with realistic traffic before a rollout
+package queryreplay
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Entry is a captured query. The principal is not captured, replays use
+// the headers of the replay instead.
+type Entry struct {
+	Time          time.Time              `json:"time"`
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+	DurationMs    float64                `json:"durationMs"`
+}
+
+// SlowQueryLog appends the queries which took at least the threshold to a
+// file as JSON lines. Once the file exceeds the max size it is rotated to
+// <path>.1, replacing the previous rotation. A nil log records nothing.
+type SlowQueryLog struct {
+	sync.Mutex
+	path      string
+	threshold time.Duration
+	maxSize   int64
+	file      *os.File
+	size      int64
+	logger    logrus.FieldLogger
+}
+
+func NewSlowQueryLog(path string, threshold time.Duration, maxSize int64,
+	logger logrus.FieldLogger,
+) (*SlowQueryLog, error) {
+	l := &SlowQueryLog{
+		path:      path,
+		threshold: threshold,
+		maxSize:   maxSize,
+		logger:    logger,
+	}
+	if err := l.open(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *SlowQueryLog) open() error {
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return errors.Wrap(err, "open slow query log")
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return errors.Wrap(err, "stat slow query log")
+	}
+	l.file = f
+	l.size = info.Size()
+	return nil
+}
+
+// Path is the file the queries are appended to
+func (l *SlowQueryLog) Path() string {
+	if l == nil {
+		return ""
+	}
+	return l.path
+}
+
+// Record logs the query if it took at least the threshold. Errors are only
+// logged, they must not fail the query.
+func (l *SlowQueryLog) Record(query, operationName string,
+	variables map[string]interface{}, took time.Duration,
+) {
+	if l == nil || took < l.threshold {
+		return
+	}
+
+	line, err := json.Marshal(Entry{
+		Time:          time.Now().UTC(),
+		Query:         query,
+		OperationName: operationName,
+		Variables:     variables,
+		DurationMs:    float64(took) / float64(time.Millisecond),
+	})
+	if err != nil {
+		l.logger.WithField("action", "slow_query_log").WithError(err).
+			Warn("could not marshal slow query")
+		return
+	}
+	line = append(line, '\n')
+
+	l.Lock()
+	defer l.Unlock()
+
+	if l.file == nil {
+		return
+	}
+	if l.maxSize > 0 && l.size+int64(len(line)) > l.maxSize && l.size > 0 {
+		if err := l.rotate(); err != nil {
+			l.logger.WithField("action", "slow_query_log").WithError(err).
+				Warn("could not rotate slow query log")
+			return
+		}
+	}
+	n, err := l.file.Write(line)
+	l.size += int64(n)
+	if err != nil {
+		l.logger.WithField("action", "slow_query_log").WithError(err).
+			Warn("could not write slow query")
+	}
+}
+
+func (l *SlowQueryLog) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return err
+	}
+	l.file = nil
+	if err := os.Rename(l.path, l.path+".1"); err != nil {
+		return err
+	}
+	return l.open()
+}
+
+func (l *SlowQueryLog) Close() error {
+	if l == nil {
+		return nil
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	if l.file == nil {
+		return nil
+	}
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
+
+// ReadLog reads the entries of a slow query log, empty lines are skipped
+func ReadLog(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, errors.Wrapf(err, "line %d", line)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "read slow query log")
+	}
+	return entries, nil
+}
+
+// ReadLogFile reads the entries of the slow query log at path
+func ReadLogFile(path string) ([]Entry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "open slow query log")
+	}
+	defer f.Close()
+
+	return ReadLog(f)
+}
@@ -0,0 +1,92 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package queryreplay
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlowQueryLog(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	path := filepath.Join(t.TempDir(), "slow-queries.log")
+
+	t.Run("records queries above the threshold", func(t *testing.T) {
+		l, err := NewSlowQueryLog(path, 100*time.Millisecond, 0, logger)
+		require.Nil(t, err)
+
+		l.Record("{ Get { Article { title } } }", "", nil, 50*time.Millisecond)
+		l.Record("query q($t: String) { Get { Article { title } } }", "q",
+			map[string]interface{}{"t": "x"}, 150*time.Millisecond)
+		l.Record("{ Aggregate { Article { meta { count } } } }", "", nil, 100*time.Millisecond)
+		require.Nil(t, l.Close())
+
+		entries, err := ReadLogFile(path)
+		require.Nil(t, err)
+		require.Len(t, entries, 2)
+		assert.Equal(t, "q", entries[0].OperationName)
+		assert.Equal(t, map[string]interface{}{"t": "x"}, entries[0].Variables)
+		assert.Equal(t, float64(150), entries[0].DurationMs)
+		assert.Equal(t, "{ Aggregate { Article { meta { count } } } }", entries[1].Query)
+	})
+
+	t.Run("appends after restart", func(t *testing.T) {
+		l, err := NewSlowQueryLog(path, 0, 0, logger)
+		require.Nil(t, err)
+		l.Record("{ Get { Article { body } } }", "", nil, time.Millisecond)
+		require.Nil(t, l.Close())
+
+		entries, err := ReadLogFile(path)
+		require.Nil(t, err)
+		assert.Len(t, entries, 3)
+	})
+
+	t.Run("rotates at max size", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "slow-queries.log")
+		l, err := NewSlowQueryLog(path, 0, 300, logger)
+		require.Nil(t, err)
+		for i := 0; i < 5; i++ {
+			l.Record("{ Get { Article { "+strings.Repeat("title ", 10)+"} } }", "", nil, time.Millisecond)
+		}
+		require.Nil(t, l.Close())
+
+		current, err := ReadLogFile(path)
+		require.Nil(t, err)
+		rotated, err := ReadLogFile(path + ".1")
+		require.Nil(t, err)
+		assert.NotEmpty(t, current)
+		assert.NotEmpty(t, rotated)
+		assert.LessOrEqual(t, len(current)+len(rotated), 5)
+
+		info, err := os.Stat(path)
+		require.Nil(t, err)
+		assert.LessOrEqual(t, info.Size(), int64(300))
+	})
+
+	t.Run("nil log", func(t *testing.T) {
+		var l *SlowQueryLog
+		l.Record("{ Get { Article { title } } }", "", nil, time.Second)
+		assert.Nil(t, l.Close())
+	})
+
+	t.Run("invalid line", func(t *testing.T) {
+		_, err := ReadLog(strings.NewReader("{\"query\":\"{}\"}\n\nnot json\n"))
+		assert.ErrorContains(t, err, "line 3")
+	})
+}
@@ -0,0 +1,459 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package queryreplay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const (
+	StatusRunning   = "RUNNING"
+	StatusCompleted = "COMPLETED"
+	StatusCancelled = "CANCELLED"
+)
+
+// limits of a replay, so that a replay can't be used to flood the target
+const (
+	maxRate        = 100
+	maxConcurrency = 16
+	maxRepeat      = 100
+)
+
+// ErrRunning is returned when a replay is started while another one runs
+var ErrRunning = errors.New("a replay is already running")
+
+// ErrNoReplay is returned for the status of a replayer which never replayed
+var ErrNoReplay = errors.New("no replay has been started")
+
+type ErrInvalidParams struct {
+	msg string
+}
+
+func (e ErrInvalidParams) Error() string {
+	return e.msg
+}
+
+func invalidParams(format string, args ...interface{}) ErrInvalidParams {
+	return ErrInvalidParams{msg: fmt.Sprintf(format, args...)}
+}
+
+// Params of a replay. Queries are taken from the slow query log of the node
+// unless they are passed with the params.
+type Params struct {
+	// Target is the base URL of the node or load balancer the queries are
+	// sent to, e.g. http://weaviate:8080
+	Target string `json:"target"`
+	// Rate is the number of queries sent per second, at most 100. 0 sends
+	// them at the maximum rate.
+	Rate float64 `json:"rate"`
+	// Concurrency is the maximum number of queries in flight, default 1 and
+	// at most 16
+	Concurrency int `json:"concurrency"`
+	// Repeat is the number of times the queries are replayed, default 1 and
+	// at most 100
+	Repeat int `json:"repeat"`
+	// Headers are set on every query, e.g. Authorization
+	Headers map[string]string `json:"headers,omitempty"`
+	Queries []Entry           `json:"queries,omitempty"`
+}
+
+// Latency summarizes a distribution of query latencies in milliseconds
+type Latency struct {
+	Min  float64 `json:"min"`
+	Mean float64 `json:"mean"`
+	P50  float64 `json:"p50"`
+	P90  float64 `json:"p90"`
+	P99  float64 `json:"p99"`
+	Max  float64 `json:"max"`
+}
+
+// Report of the current or last replay. Captured is the latency of the
+// replayed queries when they were captured, so that it can be compared to
+// the latency of the replay.
+type Report struct {
+	Status     string     `json:"status"`
+	Target     string     `json:"target"`
+	Total      int        `json:"total"`
+	Sent       int        `json:"sent"`
+	Succeeded  int        `json:"succeeded"`
+	Failed     int        `json:"failed"`
+	StartedAt  time.Time  `json:"startedAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	// Throughput is the number of queries completed per second
+	Throughput float64  `json:"throughput"`
+	Latency    *Latency `json:"latency,omitempty"`
+	Captured   *Latency `json:"captured,omitempty"`
+	// LastError is the error of the last failed query
+	LastError string `json:"lastError,omitempty"`
+}
+
+// Replayer runs one replay at a time
+type Replayer struct {
+	sync.Mutex
+	log        *SlowQueryLog
+	authorizer authorizer
+	client     *http.Client
+	logger     logrus.FieldLogger
+
+	replay *replay
+}
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+func NewReplayer(log *SlowQueryLog, authorizer authorizer,
+	logger logrus.FieldLogger,
+) *Replayer {
+	return &Replayer{
+		log:        log,
+		authorizer: authorizer,
+		client:     &http.Client{},
+		logger:     logger,
+	}
+}
+
+type replay struct {
+	sync.Mutex
+	report    Report
+	latencies []float64
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+// Start validates the params and starts the replay in the background
+func (r *Replayer) Start(principal *models.Principal, params Params) (Report, error) {
+	if err := r.authorizer.Authorize(principal, "create", "graphql/replay"); err != nil {
+		return Report{}, err
+	}
+	if err := r.validate(&params); err != nil {
+		return Report{}, err
+	}
+
+	queries := params.Queries
+	if len(queries) == 0 {
+		if r.log == nil {
+			return Report{}, invalidParams("no queries given and the slow query log is disabled")
+		}
+		var err error
+		if queries, err = ReadLogFile(r.log.Path()); err != nil {
+			return Report{}, err
+		}
+		if len(queries) == 0 {
+			return Report{}, invalidParams("the slow query log is empty")
+		}
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	if r.replay != nil && r.replay.running() {
+		return Report{}, ErrRunning
+	}
+
+	captured := make([]float64, len(queries))
+	for i := range queries {
+		captured[i] = queries[i].DurationMs
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	rp := &replay{
+		report: Report{
+			Status:    StatusRunning,
+			Target:    params.Target,
+			Total:     len(queries) * params.Repeat,
+			StartedAt: time.Now().UTC(),
+			Captured:  summarize(captured),
+		},
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	r.replay = rp
+
+	go func() {
+		defer close(rp.done)
+		r.run(ctx, rp, params, queries)
+	}()
+
+	return rp.snapshot(), nil
+}
+
+func (r *Replayer) validate(params *Params) error {
+	params.Target = strings.TrimSuffix(params.Target, "/")
+	if !strings.HasPrefix(params.Target, "http://") &&
+		!strings.HasPrefix(params.Target, "https://") {
+		return invalidParams("target must be an http(s):// URL, got %q", params.Target)
+	}
+	if params.Rate < 0 || params.Rate > maxRate {
+		return invalidParams("rate must be between 0 and %d", maxRate)
+	}
+	if params.Rate == 0 {
+		params.Rate = maxRate
+	}
+	if params.Concurrency < 0 || params.Concurrency > maxConcurrency {
+		return invalidParams("concurrency must be between 0 and %d", maxConcurrency)
+	}
+	if params.Concurrency == 0 {
+		params.Concurrency = 1
+	}
+	if params.Repeat < 0 || params.Repeat > maxRepeat {
+		return invalidParams("repeat must be between 0 and %d", maxRepeat)
+	}
+	if params.Repeat == 0 {
+		params.Repeat = 1
+	}
+	for i, q := range params.Queries {
+		if q.Query == "" {
+			return invalidParams("query %d is empty", i)
+		}
+	}
+	return nil
+}
+
+func (r *Replayer) run(ctx context.Context, rp *replay, params Params, queries []Entry) {
+	work := make(chan Entry)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < params.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for q := range work {
+				took, err := r.send(ctx, params, q)
+				rp.record(took, err)
+			}
+		}()
+	}
+
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / params.Rate))
+	defer ticker.Stop()
+
+dispatch:
+	for i := 0; i < params.Repeat; i++ {
+		for _, q := range queries {
+			select {
+			case <-ctx.Done():
+				break dispatch
+			case <-ticker.C:
+			}
+			select {
+			case <-ctx.Done():
+				break dispatch
+			case work <- q:
+			}
+		}
+	}
+	close(work)
+	wg.Wait()
+
+	rp.finish(ctx.Err() != nil)
+	report := rp.snapshot()
+	r.logger.WithField("action", "query_replay").
+		WithField("target", report.Target).
+		WithField("sent", report.Sent).
+		WithField("failed", report.Failed).
+		Info("query replay finished")
+}
+
+// send posts the query to the GraphQL API of the target. A query fails if
+// the request fails or the response contains errors.
+func (r *Replayer) send(ctx context.Context, params Params, q Entry) (time.Duration, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"query":         q.Query,
+		"operationName": q.OperationName,
+		"variables":     q.Variables,
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "marshal query")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		params.Target+"/v1/graphql", bytes.NewReader(body))
+	if err != nil {
+		return 0, errors.Wrap(err, "create request")
+	}
+	req.Header.Set("content-type", "application/json")
+	for k, v := range params.Headers {
+		req.Header.Set(k, v)
+	}
+
+	before := time.Now()
+	res, err := r.client.Do(req)
+	if err != nil {
+		return time.Since(before), err
+	}
+	defer res.Body.Close()
+
+	var gqlRes struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	resBody, err := io.ReadAll(res.Body)
+	took := time.Since(before)
+	if err != nil {
+		return took, errors.Wrap(err, "read response")
+	}
+	if res.StatusCode != http.StatusOK {
+		return took, errors.Errorf("unexpected status code %d: %s", res.StatusCode, resBody)
+	}
+	if err := json.Unmarshal(resBody, &gqlRes); err != nil {
+		return took, errors.Wrap(err, "unmarshal response")
+	}
+	if len(gqlRes.Errors) > 0 {
+		return took, errors.New(gqlRes.Errors[0].Message)
+	}
+	return took, nil
+}
+
+// Status returns the report of the current or last replay
+func (r *Replayer) Status(principal *models.Principal) (Report, error) {
+	if err := r.authorizer.Authorize(principal, "get", "graphql/replay"); err != nil {
+		return Report{}, err
+	}
+
+	r.Lock()
+	rp := r.replay
+	r.Unlock()
+
+	if rp == nil {
+		return Report{}, ErrNoReplay
+	}
+	return rp.snapshot(), nil
+}
+
+// Cancel stops the current replay and waits for the queries in flight
+func (r *Replayer) Cancel(principal *models.Principal) error {
+	if err := r.authorizer.Authorize(principal, "delete", "graphql/replay"); err != nil {
+		return err
+	}
+	return r.cancel()
+}
+
+func (r *Replayer) cancel() error {
+	r.Lock()
+	rp := r.replay
+	r.Unlock()
+
+	if rp == nil {
+		return ErrNoReplay
+	}
+	rp.cancel()
+	<-rp.done
+	return nil
+}
+
+func (r *Replayer) Shutdown() {
+	if r == nil {
+		return
+	}
+	r.cancel()
+}
+
+func (rp *replay) running() bool {
+	select {
+	case <-rp.done:
+		return false
+	default:
+		return true
+	}
+}
+
+func (rp *replay) record(took time.Duration, err error) {
+	rp.Lock()
+	defer rp.Unlock()
+
+	rp.report.Sent++
+	if err != nil {
+		rp.report.Failed++
+		rp.report.LastError = err.Error()
+		return
+	}
+	rp.report.Succeeded++
+	rp.latencies = append(rp.latencies, float64(took)/float64(time.Millisecond))
+}
+
+func (rp *replay) finish(cancelled bool) {
+	rp.Lock()
+	defer rp.Unlock()
+
+	now := time.Now().UTC()
+	rp.report.FinishedAt = &now
+	rp.report.Status = StatusCompleted
+	if cancelled {
+		rp.report.Status = StatusCancelled
+	}
+}
+
+func (rp *replay) snapshot() Report {
+	rp.Lock()
+	defer rp.Unlock()
+
+	report := rp.report
+	report.Latency = summarize(rp.latencies)
+
+	end := time.Now()
+	if report.FinishedAt != nil {
+		end = *report.FinishedAt
+	}
+	if elapsed := end.Sub(report.StartedAt).Seconds(); elapsed > 0 {
+		report.Throughput = float64(report.Sent) / elapsed
+	}
+	return report
+}
+
+// summarize returns the latency distribution of the values or nil if there
+// are none
+func summarize(values []float64) *Latency {
+	if len(values) == 0 {
+		return nil
+	}
+
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	sum := 0.0
+	for _, v := range sorted {
+		sum += v
+	}
+	return &Latency{
+		Min:  sorted[0],
+		Mean: sum / float64(len(sorted)),
+		P50:  percentile(sorted, 0.5),
+		P90:  percentile(sorted, 0.9),
+		P99:  percentile(sorted, 0.99),
+		Max:  sorted[len(sorted)-1],
+	}
+}
+
+// percentile of sorted values with the nearest-rank method
+func percentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
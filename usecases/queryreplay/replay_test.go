@@ -0,0 +1,190 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package queryreplay
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type fakeAuthorizer struct {
+	err error
+}
+
+func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return f.err
+}
+
+func fakeGraphQLServer(t *testing.T, received *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(received, 1)
+		assert.Equal(t, "/v1/graphql", r.URL.Path)
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		var body struct {
+			Query string `json:"query"`
+		}
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		if body.Query == "invalid" {
+			w.Write([]byte(`{"errors":[{"message":"syntax error"}]}`))
+			return
+		}
+		time.Sleep(time.Millisecond)
+		w.Write([]byte(`{"data":{}}`))
+	}))
+}
+
+func waitForReplay(t *testing.T, r *Replayer) Report {
+	var report Report
+	require.Eventually(t, func() bool {
+		var err error
+		report, err = r.Status(nil)
+		require.Nil(t, err)
+		return report.Status != StatusRunning
+	}, 10*time.Second, 10*time.Millisecond)
+	return report
+}
+
+func TestReplayer(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	var received int32
+	server := fakeGraphQLServer(t, &received)
+	defer server.Close()
+	headers := map[string]string{"Authorization": "Bearer secret"}
+
+	t.Run("no replay", func(t *testing.T) {
+		r := NewReplayer(nil, &fakeAuthorizer{}, logger)
+		_, err := r.Status(nil)
+		assert.True(t, errors.Is(err, ErrNoReplay))
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		forbidden := errors.New("forbidden")
+		r := NewReplayer(nil, &fakeAuthorizer{err: forbidden}, logger)
+		_, err := r.Start(nil, Params{Target: server.URL, Queries: []Entry{{Query: "{}"}}})
+		assert.Equal(t, forbidden, err)
+		_, err = r.Status(nil)
+		assert.Equal(t, forbidden, err)
+		assert.Equal(t, forbidden, r.Cancel(nil))
+	})
+
+	t.Run("invalid params", func(t *testing.T) {
+		r := NewReplayer(nil, &fakeAuthorizer{}, logger)
+		queries := []Entry{{Query: "{ Get { Article { title } } }"}}
+		for _, params := range []Params{
+			{Target: "weaviate:8080", Queries: queries},
+			{Target: server.URL, Rate: -1, Queries: queries},
+			{Target: server.URL, Rate: 1000, Queries: queries},
+			{Target: server.URL, Concurrency: -1, Queries: queries},
+			{Target: server.URL, Concurrency: 100, Queries: queries},
+			{Target: server.URL, Repeat: 1000, Queries: queries},
+			{Target: server.URL, Queries: []Entry{{}}},
+			{Target: server.URL},
+		} {
+			_, err := r.Start(nil, params)
+			var invalid ErrInvalidParams
+			assert.True(t, errors.As(err, &invalid), "%v", params)
+		}
+	})
+
+	t.Run("replay the slow query log", func(t *testing.T) {
+		atomic.StoreInt32(&received, 0)
+		log, err := NewSlowQueryLog(filepath.Join(t.TempDir(), "slow.log"), 0, 0, logger)
+		require.Nil(t, err)
+		defer log.Close()
+		log.Record("{ Get { Article { title } } }", "", nil, 10*time.Millisecond)
+		log.Record("invalid", "", nil, 30*time.Millisecond)
+
+		r := NewReplayer(log, &fakeAuthorizer{}, logger)
+		_, err = r.Start(nil, Params{Target: server.URL + "/", Concurrency: 2, Repeat: 3, Headers: headers})
+		require.Nil(t, err)
+
+		report := waitForReplay(t, r)
+		assert.Equal(t, StatusCompleted, report.Status)
+		assert.Equal(t, 6, report.Total)
+		assert.Equal(t, 6, report.Sent)
+		assert.Equal(t, 3, report.Succeeded)
+		assert.Equal(t, 3, report.Failed)
+		assert.Equal(t, "syntax error", report.LastError)
+		assert.Equal(t, int32(6), atomic.LoadInt32(&received))
+		require.NotNil(t, report.Latency)
+		assert.Greater(t, report.Latency.P50, float64(0))
+		require.NotNil(t, report.Captured)
+		assert.Equal(t, float64(10), report.Captured.Min)
+		assert.Equal(t, float64(30), report.Captured.Max)
+	})
+
+	t.Run("failed requests", func(t *testing.T) {
+		r := NewReplayer(nil, &fakeAuthorizer{}, logger)
+		_, err := r.Start(nil, Params{Target: server.URL, Queries: []Entry{{Query: "{}"}}})
+		require.Nil(t, err)
+
+		report := waitForReplay(t, r)
+		assert.Equal(t, 1, report.Failed)
+		assert.Contains(t, report.LastError, "401")
+		assert.Nil(t, report.Latency)
+	})
+
+	t.Run("rate and cancel", func(t *testing.T) {
+		r := NewReplayer(nil, &fakeAuthorizer{}, logger)
+		_, err := r.Start(nil, Params{
+			Target:  server.URL,
+			Rate:    20,
+			Repeat:  100,
+			Headers: headers,
+			Queries: []Entry{{Query: "{ Get { Article { title } } }"}},
+		})
+		require.Nil(t, err)
+
+		_, err = r.Start(nil, Params{Target: server.URL, Queries: []Entry{{Query: "{}"}}})
+		assert.True(t, errors.Is(err, ErrRunning))
+
+		time.Sleep(200 * time.Millisecond)
+		require.Nil(t, r.Cancel(nil))
+		report, err := r.Status(nil)
+		require.Nil(t, err)
+		assert.Equal(t, StatusCancelled, report.Status)
+		assert.Less(t, report.Sent, 20)
+		assert.NotNil(t, report.FinishedAt)
+	})
+}
+
+func TestSummarize(t *testing.T) {
+	assert.Nil(t, summarize(nil))
+
+	values := make([]float64, 100)
+	for i := range values {
+		values[i] = float64(100 - i)
+	}
+	assert.Equal(t, &Latency{
+		Min:  1,
+		Mean: 50.5,
+		P50:  50,
+		P90:  90,
+		P99:  99,
+		Max:  100,
+	}, summarize(values))
+}
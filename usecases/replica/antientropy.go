@@ -0,0 +1,291 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// ShardRepairReport summarizes an anti-entropy run over the replicas of a
+// shard
+type ShardRepairReport struct {
+	// DifferingLeaves is the number of leaves of the hash trees on which the
+	// replicas disagreed
+	DifferingLeaves int
+	// Repaired is the number of objects which were written to replicas
+	// which missed them or held a stale version
+	Repaired int
+	// Conflicts is the number of objects which were deleted on some replicas
+	// and exist on others. They are left alone, see RepairShard.
+	Conflicts int
+	// Unreachable replicas didn't take part in the run
+	Unreachable []string
+}
+
+// leafVersions holds the digests of the objects of a leaf per replica
+type leafVersions struct {
+	hosts []string
+	// digests[i] are the digests sent by hosts[i] by object id
+	digests []map[string]RepairResponse
+}
+
+// RepairShard makes the replicas of a shard converge. The hash trees of all
+// replicas are compared and for every leaf on which they differ, the winning
+// version of each diverging object is written to the replicas which miss it
+// or hold another version. The winner is picked by the conflict resolution
+// of the class, like in read repair. Objects which were deleted on some of
+// the replicas are left alone, because a missed deletion can't be told
+// apart from a missed insert.
+func (f *Finder) RepairShard(ctx context.Context, shard string,
+	height int,
+) (ShardRepairReport, error) {
+	var report ShardRepairReport
+	state, err := f.resolver.State(shard, One)
+	if err != nil {
+		return report, fmt.Errorf("resolve replicas: %w", err)
+	}
+	report.Unreachable = append(report.Unreachable, state.nodes...)
+
+	var hosts []string
+	var trees []*HashTree
+	for _, host := range state.Hosts {
+		tree, err := f.client.cl.HashTree(ctx, host, f.class, shard, height)
+		if err != nil {
+			f.log.WithField("op", "anti_entropy").WithField("class", f.class).
+				WithField("shard", shard).WithField("replica", host).
+				WithError(err).Warn("could not get hash tree")
+			report.Unreachable = append(report.Unreachable, host)
+			continue
+		}
+		hosts = append(hosts, host)
+		trees = append(trees, tree)
+	}
+	if len(trees) < 2 {
+		return report, nil
+	}
+
+	differing := map[int]struct{}{}
+	for _, tree := range trees[1:] {
+		leaves, err := trees[0].DiffLeaves(tree)
+		if err != nil {
+			return report, err
+		}
+		for _, leaf := range leaves {
+			differing[leaf] = struct{}{}
+		}
+	}
+	leaves := make([]int, 0, len(differing))
+	for leaf := range differing {
+		leaves = append(leaves, leaf)
+	}
+	sort.Ints(leaves)
+	report.DifferingLeaves = len(leaves)
+
+	for _, leaf := range leaves {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+		if err := f.repairLeaf(ctx, shard, height, leaf, hosts, &report); err != nil {
+			return report, fmt.Errorf("leaf %d: %w", leaf, err)
+		}
+	}
+	return report, nil
+}
+
+func (f *Finder) repairLeaf(ctx context.Context, shard string,
+	height, leaf int, hosts []string, report *ShardRepairReport,
+) error {
+	versions := leafVersions{hosts: hosts, digests: make([]map[string]RepairResponse, len(hosts))}
+	for i, host := range hosts {
+		xs, err := f.client.cl.DigestLeaf(ctx, host, f.class, shard, height, leaf)
+		if err != nil {
+			return fmt.Errorf("digest leaf on %s: %w", host, err)
+		}
+		versions.digests[i] = make(map[string]RepairResponse, len(xs))
+		for _, x := range xs {
+			versions.digests[i][x.ID] = x
+		}
+	}
+
+	diverged := versions.diverged()
+	if len(diverged) == 0 {
+		return nil
+	}
+	deleted, err := f.deletedOnAnyReplica(ctx, shard, versions, diverged)
+	if err != nil {
+		return err
+	}
+
+	cr := f.conflictResolver()
+	countConflicts(f.class, cr.strategy, len(diverged))
+	var candidates []strfmt.UUID
+	for _, id := range diverged {
+		if deleted[id] {
+			report.Conflicts++
+			continue
+		}
+		candidates = append(candidates, id)
+	}
+
+	if cr.byContent() {
+		for _, id := range candidates {
+			times := versions.updateTimes(id)
+			if _, err := f.repairByContent(ctx, cr, shard, id, hosts, times, nil, -1); err != nil {
+				f.log.WithField("op", "anti_entropy").WithField("class", f.class).
+					WithField("shard", shard).WithField("id", id).
+					WithError(err).Warn("could not repair object")
+				continue
+			}
+			report.Repaired++
+		}
+		return nil
+	}
+
+	report.Repaired += f.repairLastWriteWins(ctx, shard, versions, candidates)
+	return nil
+}
+
+// repairLastWriteWins propagates the most recent version of each object and
+// returns the number of objects which were repaired
+func (f *Finder) repairLastWriteWins(ctx context.Context, shard string,
+	versions leafVersions, ids []strfmt.UUID,
+) int {
+	// the winners are fetched in one request per replica which holds them
+	byWinner := map[int][]strfmt.UUID{}
+	for _, id := range ids {
+		winner, latest := 0, int64(0)
+		for i, t := range versions.updateTimes(id) {
+			if t > latest {
+				winner, latest = i, t
+			}
+		}
+		byWinner[winner] = append(byWinner[winner], id)
+	}
+
+	repaired := 0
+	for winner, ids := range byWinner {
+		host := versions.hosts[winner]
+		objs, err := f.client.FullReads(ctx, host, f.class, shard, ids)
+		if err != nil {
+			f.log.WithField("op", "anti_entropy").WithField("class", f.class).
+				WithField("shard", shard).WithField("replica", host).
+				WithError(err).Warn("could not fetch objects")
+			continue
+		}
+
+		updates := make([][]*objects.VObject, len(versions.hosts))
+		for j, id := range ids {
+			x := objs[j]
+			latest := versions.digests[winner][id.String()].UpdateTime
+			if x.Object == nil || x.UpdateTime() != latest {
+				// changed in the meantime, the next run will pick it up
+				continue
+			}
+			for i, t := range versions.updateTimes(id) {
+				if t == latest {
+					continue
+				}
+				updates[i] = append(updates[i], &objects.VObject{
+					LatestObject:    &x.Object.Object,
+					StaleUpdateTime: t,
+				})
+			}
+			repaired++
+		}
+
+		for i, ups := range updates {
+			if len(ups) == 0 {
+				continue
+			}
+			resp, err := f.client.Overwrite(ctx, versions.hosts[i], f.class, shard, ups)
+			if err == nil && len(resp) > 0 {
+				err = fmt.Errorf("%d objects: %s", len(resp), resp[0].Err)
+			}
+			if err != nil {
+				f.log.WithField("op", "anti_entropy").WithField("class", f.class).
+					WithField("shard", shard).WithField("replica", versions.hosts[i]).
+					WithError(err).Warn("could not overwrite objects")
+			}
+		}
+	}
+	return repaired
+}
+
+// deletedOnAnyReplica looks up whether the objects which are missing on a
+// replica were deleted there
+func (f *Finder) deletedOnAnyReplica(ctx context.Context, shard string,
+	versions leafVersions, ids []strfmt.UUID,
+) (map[strfmt.UUID]bool, error) {
+	deleted := map[strfmt.UUID]bool{}
+	for i, host := range versions.hosts {
+		var missing []strfmt.UUID
+		for _, id := range ids {
+			if _, ok := versions.digests[i][id.String()]; !ok {
+				missing = append(missing, id)
+			}
+		}
+		if len(missing) == 0 {
+			continue
+		}
+
+		xs, err := f.client.DigestReads(ctx, host, f.class, shard, missing)
+		if err != nil {
+			return nil, fmt.Errorf("digest objects on %s: %w", host, err)
+		}
+		for j, x := range xs {
+			if x.Deleted {
+				deleted[missing[j]] = true
+			}
+		}
+	}
+	return deleted, nil
+}
+
+// diverged returns the ids of the objects which are missing on a replica or
+// whose update times differ, in ascending order
+func (v leafVersions) diverged() []strfmt.UUID {
+	all := map[string]struct{}{}
+	for _, digests := range v.digests {
+		for id := range digests {
+			all[id] = struct{}{}
+		}
+	}
+
+	var diverged []strfmt.UUID
+	for id := range all {
+		times := v.updateTimes(strfmt.UUID(id))
+		for _, t := range times[1:] {
+			if t != times[0] {
+				diverged = append(diverged, strfmt.UUID(id))
+				break
+			}
+		}
+	}
+	sort.Slice(diverged, func(a, b int) bool { return diverged[a] < diverged[b] })
+	return diverged
+}
+
+// updateTimes of an object per replica, 0 if a replica doesn't hold it
+func (v leafVersions) updateTimes(id strfmt.UUID) []int64 {
+	times := make([]int64, len(v.hosts))
+	for i, digests := range v.digests {
+		if x, ok := digests[id.String()]; ok {
+			times[i] = x.UpdateTime
+		}
+	}
+	return times
+}
@@ -0,0 +1,184 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestHashTree(t *testing.T) {
+	ids := make([][]byte, 100)
+	for i := range ids {
+		ids[i], _ = uuid.New().MarshalBinary()
+	}
+	build := func(height int, times func(i int) int64) *HashTree {
+		tree, err := NewHashTree(height)
+		require.Nil(t, err)
+		for i, id := range ids {
+			if ts := times(i); ts > 0 {
+				tree.Add(id, ts)
+			}
+		}
+		tree.Build()
+		return tree
+	}
+
+	t.Run("invalid height", func(t *testing.T) {
+		_, err := NewHashTree(MaxHashTreeHeight + 1)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("independent of the order", func(t *testing.T) {
+		a := build(4, func(i int) int64 { return int64(i + 1) })
+		b, _ := NewHashTree(4)
+		for i := len(ids) - 1; i >= 0; i-- {
+			b.Add(ids[i], int64(i+1))
+		}
+		b.Build()
+		assert.Equal(t, a.Root(), b.Root())
+
+		leaves, err := a.DiffLeaves(b)
+		require.Nil(t, err)
+		assert.Empty(t, leaves)
+	})
+
+	t.Run("differing leaves", func(t *testing.T) {
+		a := build(4, func(i int) int64 { return 1 })
+		b := build(4, func(i int) int64 {
+			switch i {
+			case 3:
+				return 2 // stale
+			case 7:
+				return 0 // missing
+			}
+			return 1
+		})
+		assert.NotEqual(t, a.Root(), b.Root())
+
+		leaves, err := a.DiffLeaves(b)
+		require.Nil(t, err)
+		expected := []int{a.Leaf(ids[3]), a.Leaf(ids[7])}
+		if expected[0] == expected[1] {
+			expected = expected[:1]
+		} else if expected[0] > expected[1] {
+			expected[0], expected[1] = expected[1], expected[0]
+		}
+		assert.Equal(t, expected, leaves)
+
+		_, err = a.DiffLeaves(build(3, func(i int) int64 { return 1 }))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("leaf ranges", func(t *testing.T) {
+		for _, height := range []int{0, 1, 4, MaxHashTreeHeight} {
+			tree, _ := NewHashTree(height)
+			for _, id := range ids {
+				from, to := LeafRange(height, tree.Leaf(id))
+				assert.True(t, string(id) >= string(from))
+				assert.True(t, to == nil || string(id) < string(to))
+			}
+			_, to := LeafRange(height, Leaves(height)-1)
+			assert.Nil(t, to)
+		}
+	})
+}
+
+func TestFinderRepairShard(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		ctx   = context.Background()
+		same  = strfmt.UUID("10000000-0000-0000-0000-000000000000")
+		stale = strfmt.UUID("20000000-0000-0000-0000-000000000000")
+		gone  = strfmt.UUID("30000000-0000-0000-0000-000000000000")
+	)
+	tree := func(xs []RepairResponse) *HashTree {
+		tree, _ := NewHashTree(0)
+		for _, x := range xs {
+			id, _ := uuid.MustParse(x.ID).MarshalBinary()
+			tree.Add(id, x.UpdateTime)
+		}
+		tree.Build()
+		return tree
+	}
+
+	t.Run("InSync", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		finder := f.newFinder()
+		digests := []RepairResponse{{ID: same.String(), UpdateTime: 1}}
+		for _, n := range nodes {
+			f.RClient.On("HashTree", anyVal, n, cls, shard, 0).Return(tree(digests), nil)
+		}
+
+		report, err := finder.RepairShard(ctx, shard, 0)
+		require.Nil(t, err)
+		assert.Equal(t, ShardRepairReport{}, report)
+	})
+
+	t.Run("RepairsStaleAndMissingObjects", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		finder := f.newFinder()
+		digests := map[string][]RepairResponse{
+			"A": {{ID: same.String(), UpdateTime: 1}, {ID: stale.String(), UpdateTime: 5}},
+			"B": {
+				{ID: same.String(), UpdateTime: 1}, {ID: stale.String(), UpdateTime: 3},
+				{ID: gone.String(), UpdateTime: 2},
+			},
+			"C": {{ID: same.String(), UpdateTime: 1}, {ID: gone.String(), UpdateTime: 2}},
+		}
+		for _, n := range nodes {
+			f.RClient.On("HashTree", anyVal, n, cls, shard, 0).Return(tree(digests[n]), nil)
+			f.RClient.On("DigestLeaf", anyVal, n, cls, shard, 0, 0).Return(digests[n], nil)
+		}
+		// gone was deleted on A, stale never arrived on C
+		f.RClient.On("DigestObjects", anyVal, "A", cls, shard, []strfmt.UUID{gone}).
+			Return([]RepairResponse{{ID: gone.String(), Deleted: true}}, nil)
+		f.RClient.On("DigestObjects", anyVal, "C", cls, shard, []strfmt.UUID{stale}).
+			Return([]RepairResponse{{ID: stale.String()}}, nil)
+
+		latest := replica(stale, 5, false)
+		f.RClient.On("FetchObjects", anyVal, "A", cls, shard, []strfmt.UUID{stale}).
+			Return([]objects.Replica{latest}, nil)
+		f.RClient.On("OverwriteObjects", anyVal, "B", cls, shard, []*objects.VObject{{
+			LatestObject: &latest.Object.Object, StaleUpdateTime: 3,
+		}}).Return([]RepairResponse(nil), nil).Once()
+		f.RClient.On("OverwriteObjects", anyVal, "C", cls, shard, []*objects.VObject{{
+			LatestObject: &latest.Object.Object, StaleUpdateTime: 0,
+		}}).Return([]RepairResponse(nil), nil).Once()
+
+		report, err := finder.RepairShard(ctx, shard, 0)
+		require.Nil(t, err)
+		assert.Equal(t, ShardRepairReport{DifferingLeaves: 1, Repaired: 1, Conflicts: 1}, report)
+		f.RClient.AssertExpectations(t)
+	})
+
+	t.Run("UnreachableReplica", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		finder := f.newFinder()
+		digests := []RepairResponse{{ID: same.String(), UpdateTime: 1}}
+		f.RClient.On("HashTree", anyVal, "A", cls, shard, 0).Return(tree(digests), nil)
+		f.RClient.On("HashTree", anyVal, "B", cls, shard, 0).Return((*HashTree)(nil), errAny)
+		f.RClient.On("HashTree", anyVal, "C", cls, shard, 0).Return(tree(digests), nil)
+
+		report, err := finder.RepairShard(ctx, shard, 0)
+		require.Nil(t, err)
+		assert.Equal(t, ShardRepairReport{Unreachable: []string{"B"}}, report)
+	})
+}
@@ -31,7 +31,7 @@ func ValidateConfig(class *models.Class) error {
 		class.ReplicationConfig.Factor = 1
 	}
 
-	return nil
+	return validateConflictResolution(class)
 }
 
 func ValidateConfigUpdate(old, updated *models.Class, nodeCounter nodeCounter) error {
@@ -53,5 +53,5 @@ func ValidateConfigUpdate(old, updated *models.Class, nodeCounter nodeCounter) e
 		}
 	}
 
-	return nil
+	return validateConflictResolution(updated)
 }
@@ -0,0 +1,193 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// Strategies to resolve conflicts between diverging replicas of an object
+const (
+	// ConflictLastWriteWins picks the object which was updated last
+	ConflictLastWriteWins = "lastWriteWins"
+	// ConflictHighestVersion picks the object with the highest value of a
+	// numeric property, e.g. a version maintained by the application
+	ConflictHighestVersion = "highestVersion"
+	// ConflictPropertyPrecedence picks the object whose value of a text
+	// property comes first in a list of values, e.g. the source of the data
+	ConflictPropertyPrecedence = "propertyPrecedence"
+)
+
+// conflictResolver decides which one of the diverging replicas of an object
+// is propagated to the other replicas by read repair
+type conflictResolver struct {
+	strategy string
+	property string
+	// rank of the values of property for ConflictPropertyPrecedence
+	rank map[string]int
+}
+
+// UseReplicationConfig makes read repair resolve conflicts according to the
+// replication config of the class, which is returned by get
+func (r *Replicator) UseReplicationConfig(get func() *models.ReplicationConfig) {
+	r.Finder.repairer.config = get
+}
+
+func newConflictResolver(cfg *models.ReplicationConfig) conflictResolver {
+	if cfg == nil || cfg.ConflictResolution == "" {
+		return conflictResolver{strategy: ConflictLastWriteWins}
+	}
+	r := conflictResolver{
+		strategy: cfg.ConflictResolution,
+		property: cfg.ConflictResolutionProperty,
+	}
+	if r.strategy == ConflictPropertyPrecedence {
+		r.rank = make(map[string]int, len(cfg.ConflictResolutionPrecedence))
+		for i, v := range cfg.ConflictResolutionPrecedence {
+			if _, ok := r.rank[v]; !ok {
+				r.rank[v] = i
+			}
+		}
+	}
+	return r
+}
+
+// byContent is true if conflicts can't be decided by update times alone
+func (r conflictResolver) byContent() bool {
+	return r.strategy != ConflictLastWriteWins
+}
+
+// pick returns the index of the winning object, nil objects never win
+func (r conflictResolver) pick(xs []*storobj.Object) int {
+	winner := -1
+	for i, x := range xs {
+		if x == nil {
+			continue
+		}
+		if winner == -1 || r.less(xs[winner], x) {
+			winner = i
+		}
+	}
+	return winner
+}
+
+// less is true if b wins over a. Ties are decided by the update time.
+func (r conflictResolver) less(a, b *storobj.Object) bool {
+	switch r.strategy {
+	case ConflictHighestVersion:
+		va, vb := r.version(a), r.version(b)
+		if va != vb {
+			return va < vb
+		}
+	case ConflictPropertyPrecedence:
+		ra, rb := r.precedence(a), r.precedence(b)
+		if ra != rb {
+			return ra > rb
+		}
+	}
+	return a.LastUpdateTimeUnix() < b.LastUpdateTimeUnix()
+}
+
+// version of an object, objects without a version lose against all others
+func (r conflictResolver) version(x *storobj.Object) float64 {
+	props, _ := x.Properties().(map[string]interface{})
+	switch v := props[r.property].(type) {
+	case float64:
+		return v
+	case int64:
+		return float64(v)
+	case int:
+		return float64(v)
+	case json.Number:
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+	}
+	return math.Inf(-1)
+}
+
+// precedence of an object, lower is better. Objects whose value is not
+// listed lose against all others.
+func (r conflictResolver) precedence(x *storobj.Object) int {
+	props, _ := x.Properties().(map[string]interface{})
+	if v, ok := props[r.property].(string); ok {
+		if rank, ok := r.rank[v]; ok {
+			return rank
+		}
+	}
+	return len(r.rank)
+}
+
+// countConflicts records n objects whose replicas diverged
+func countConflicts(class, strategy string, n int) {
+	if n == 0 {
+		return
+	}
+	metric, err := monitoring.GetMetrics().ReplicationConflicts.
+		GetMetricWithLabelValues(class, strategy)
+	if err == nil {
+		metric.Add(float64(n))
+	}
+}
+
+// validateConflictResolution validates the conflict resolution settings of
+// the replication config of a class
+func validateConflictResolution(class *models.Class) error {
+	cfg := class.ReplicationConfig
+	switch cfg.ConflictResolution {
+	case "", ConflictLastWriteWins:
+		return nil
+	case ConflictHighestVersion, ConflictPropertyPrecedence:
+	default:
+		return fmt.Errorf("unknown conflict resolution %q, use one of %q, %q or %q",
+			cfg.ConflictResolution, ConflictLastWriteWins, ConflictHighestVersion,
+			ConflictPropertyPrecedence)
+	}
+
+	if cfg.ConflictResolutionProperty == "" {
+		return fmt.Errorf("conflict resolution %q requires conflictResolutionProperty",
+			cfg.ConflictResolution)
+	}
+	prop, err := schema.GetPropertyByName(class, cfg.ConflictResolutionProperty)
+	if err != nil {
+		return fmt.Errorf("conflict resolution property: %w", err)
+	}
+	var dt schema.DataType
+	if len(prop.DataType) == 1 {
+		dt = schema.DataType(prop.DataType[0])
+	}
+
+	if cfg.ConflictResolution == ConflictHighestVersion {
+		if dt != schema.DataTypeInt && dt != schema.DataTypeNumber {
+			return fmt.Errorf("conflict resolution property %q must be of type %q or %q",
+				prop.Name, schema.DataTypeInt, schema.DataTypeNumber)
+		}
+		return nil
+	}
+
+	if dt != schema.DataTypeText && dt != schema.DataTypeString {
+		return fmt.Errorf("conflict resolution property %q must be of type %q",
+			prop.Name, schema.DataTypeText)
+	}
+	if len(cfg.ConflictResolutionPrecedence) == 0 {
+		return fmt.Errorf("conflict resolution %q requires conflictResolutionPrecedence",
+			cfg.ConflictResolution)
+	}
+	return nil
+}
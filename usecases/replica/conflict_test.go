@@ -0,0 +1,198 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func objectWithProps(id strfmt.UUID, lastTime int64, props map[string]interface{}) *storobj.Object {
+	x := object(id, lastTime)
+	x.Object.Properties = props
+	return x
+}
+
+func TestConflictResolverPick(t *testing.T) {
+	id := strfmt.UUID("123")
+	xs := []*storobj.Object{
+		objectWithProps(id, 3, map[string]interface{}{"version": 1.0, "source": "import"}),
+		nil,
+		objectWithProps(id, 2, map[string]interface{}{"version": 5.0, "source": "crm"}),
+		objectWithProps(id, 1, map[string]interface{}{"source": "manual"}),
+	}
+
+	t.Run("LastWriteWins", func(t *testing.T) {
+		cr := newConflictResolver(nil)
+		assert.False(t, cr.byContent())
+		assert.Equal(t, 0, cr.pick(xs))
+	})
+
+	t.Run("HighestVersion", func(t *testing.T) {
+		cr := newConflictResolver(&models.ReplicationConfig{
+			ConflictResolution:         ConflictHighestVersion,
+			ConflictResolutionProperty: "version",
+		})
+		assert.True(t, cr.byContent())
+		assert.Equal(t, 2, cr.pick(xs))
+	})
+
+	t.Run("PropertyPrecedence", func(t *testing.T) {
+		cr := newConflictResolver(&models.ReplicationConfig{
+			ConflictResolution:           ConflictPropertyPrecedence,
+			ConflictResolutionProperty:   "source",
+			ConflictResolutionPrecedence: []string{"manual", "crm"},
+		})
+		assert.Equal(t, 3, cr.pick(xs))
+	})
+
+	t.Run("TieIsDecidedByUpdateTime", func(t *testing.T) {
+		cr := newConflictResolver(&models.ReplicationConfig{
+			ConflictResolution:           ConflictPropertyPrecedence,
+			ConflictResolutionProperty:   "source",
+			ConflictResolutionPrecedence: []string{"crm"},
+		})
+		assert.Equal(t, 2, cr.pick(xs[:3]))
+		assert.Equal(t, 0, cr.pick([]*storobj.Object{xs[0], xs[3]}))
+	})
+
+	t.Run("NoObject", func(t *testing.T) {
+		assert.Equal(t, -1, newConflictResolver(nil).pick([]*storobj.Object{nil, nil}))
+	})
+}
+
+func TestValidateConflictResolution(t *testing.T) {
+	newClass := func(cfg models.ReplicationConfig) *models.Class {
+		return &models.Class{
+			Class: "C1",
+			Properties: []*models.Property{
+				{Name: "version", DataType: []string{"int"}},
+				{Name: "source", DataType: []string{"text"}},
+			},
+			ReplicationConfig: &cfg,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		cfg    models.ReplicationConfig
+		errMsg string
+	}{
+		{name: "default"},
+		{name: "lastWriteWins", cfg: models.ReplicationConfig{ConflictResolution: ConflictLastWriteWins}},
+		{
+			name:   "unknown strategy",
+			cfg:    models.ReplicationConfig{ConflictResolution: "firstWriteWins"},
+			errMsg: "unknown conflict resolution",
+		},
+		{
+			name:   "missing property",
+			cfg:    models.ReplicationConfig{ConflictResolution: ConflictHighestVersion},
+			errMsg: "requires conflictResolutionProperty",
+		},
+		{
+			name: "unknown property",
+			cfg: models.ReplicationConfig{
+				ConflictResolution: ConflictHighestVersion, ConflictResolutionProperty: "rev",
+			},
+			errMsg: "conflict resolution property",
+		},
+		{
+			name: "highestVersion",
+			cfg: models.ReplicationConfig{
+				ConflictResolution: ConflictHighestVersion, ConflictResolutionProperty: "version",
+			},
+		},
+		{
+			name: "highestVersion on text",
+			cfg: models.ReplicationConfig{
+				ConflictResolution: ConflictHighestVersion, ConflictResolutionProperty: "source",
+			},
+			errMsg: "must be of type",
+		},
+		{
+			name: "propertyPrecedence",
+			cfg: models.ReplicationConfig{
+				ConflictResolution:           ConflictPropertyPrecedence,
+				ConflictResolutionProperty:   "source",
+				ConflictResolutionPrecedence: []string{"crm"},
+			},
+		},
+		{
+			name: "propertyPrecedence without precedence",
+			cfg: models.ReplicationConfig{
+				ConflictResolution:         ConflictPropertyPrecedence,
+				ConflictResolutionProperty: "source",
+			},
+			errMsg: "requires conflictResolutionPrecedence",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateConfig(newClass(test.cfg))
+			if test.errMsg == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.errMsg)
+			}
+		})
+	}
+}
+
+func TestRepairerOneByContent(t *testing.T) {
+	var (
+		id        = strfmt.UUID("123")
+		cls       = "C1"
+		shard     = "SH1"
+		nodes     = []string{"A", "B", "C"}
+		ctx       = context.Background()
+		adds      = additional.Properties{}
+		proj      = search.SelectProperties{}
+		digestIDs = []strfmt.UUID{id}
+		cfg       = &models.ReplicationConfig{
+			ConflictResolution:         ConflictHighestVersion,
+			ConflictResolutionProperty: "version",
+		}
+	)
+
+	f := newFakeFactory(cls, shard, nodes)
+	finder := f.newFinder()
+	finder.repairer.config = func() *models.ReplicationConfig { return cfg }
+
+	// B has an older update time but a higher version
+	item3 := objects.Replica{ID: id, Object: objectWithProps(id, 3, map[string]interface{}{"version": 1.0})}
+	item2 := objects.Replica{ID: id, Object: objectWithProps(id, 2, map[string]interface{}{"version": 2.0})}
+	digestR2 := []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+	digestR3 := []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+	f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item3, nil)
+	f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+	f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+	f.RClient.On("FetchObject", anyVal, nodes[1], cls, shard, id, proj, adds).Return(item2, nil)
+
+	updates := []*objects.VObject{{LatestObject: &item2.Object.Object, StaleUpdateTime: 3}}
+	f.RClient.On("OverwriteObjects", anyVal, nodes[0], cls, shard, updates).Return([]RepairResponse{}, nil)
+	f.RClient.On("OverwriteObjects", anyVal, nodes[2], cls, shard, updates).Return([]RepairResponse{}, nil)
+
+	got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
+	assert.Nil(t, err)
+	assert.Equal(t, item2.Object, got)
+	f.RClient.AssertCalled(t, "OverwriteObjects", anyVal, nodes[0], cls, shard, updates)
+	f.RClient.AssertCalled(t, "OverwriteObjects", anyVal, nodes[2], cls, shard, updates)
+}
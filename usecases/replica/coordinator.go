@@ -37,6 +37,9 @@ type (
 		Class    string
 		Shard    string
 		TxID     string // transaction ID
+		// leader elects the replica which serializes writes, nil for the
+		// leaderless write path
+		leader leaderElector
 	}
 )
 
@@ -50,10 +53,11 @@ func newCoordinator[T any](r *Replicator, shard, requestID string, l logrus.Fiel
 			nodeResolver: r.resolver,
 			class:        r.class,
 		},
-		log:   l,
-		Class: r.class,
-		Shard: shard,
-		TxID:  requestID,
+		log:    l,
+		Class:  r.class,
+		Shard:  shard,
+		TxID:   requestID,
+		leader: r.leader,
 	}
 }
 
@@ -164,6 +168,13 @@ func (c *coordinator[T]) Push(ctx context.Context,
 		return nil, 0, fmt.Errorf("%w : class %q shard %q", err, c.Class, c.Shard)
 	}
 	level := state.Level
+	if c.leader != nil {
+		leader, err := c.leader.ShardLeader(c.Shard)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%w : class %q shard %q", err, c.Class, c.Shard)
+		}
+		return c.pushThroughLeader(ctx, leader, state, ask, com), level, nil
+	}
 	nodeCh := c.broadcast(ctx, state.Hosts, ask, level)
 	return c.commitAll(context.Background(), nodeCh, com), level, nil
 }
@@ -109,6 +109,9 @@ func (f *Finder) GetOne(ctx context.Context,
 	result := <-f.readOne(ctx, shard, id, replyCh, state)
 	if err = result.Err; err != nil {
 		err = fmt.Errorf("%s %q: %w", msgCLevel, l, err)
+	} else if v := sessionVersion(ctx, l, f.class, shard); v > 0 && isOlder(result.Value, v) {
+		// the replicas read might have missed a write of the session
+		return f.GetOne(ctx, All, shard, id, props, adds)
 	}
 	return result.Value, err
 }
@@ -137,11 +140,22 @@ func (f *Finder) GetAll(ctx context.Context,
 	result := <-f.readAll(ctx, shard, ids, replyCh, state)
 	if err = result.Err; err != nil {
 		err = fmt.Errorf("%s %q: %w", msgCLevel, l, err)
+	} else if v := sessionVersion(ctx, l, f.class, shard); v > 0 {
+		for _, x := range result.Value {
+			if isOlder(x, v) {
+				return f.GetAll(ctx, All, shard, ids)
+			}
+		}
 	}
 
 	return result.Value, err
 }
 
+// isOlder returns true if obj is missing or older than the given version
+func isOlder(obj *storobj.Object, version int64) bool {
+	return obj == nil || obj.LastUpdateTimeUnix() < version
+}
+
 func (f *Finder) CheckConsistency(ctx context.Context,
 	l ConsistencyLevel, objs []*storobj.Object,
 	scores []float32,
@@ -161,6 +175,11 @@ func (f *Finder) Exists(ctx context.Context,
 	shard string,
 	id strfmt.UUID,
 ) (bool, error) {
+	// digests carry no version which could be compared with the session's,
+	// hence a session which has written to this shard reads at level ALL
+	if sessionVersion(ctx, l, f.class, shard) > 0 {
+		l = All
+	}
 	c := newReadCoordinator[existReply](f, shard)
 	op := func(ctx context.Context, host string, _ bool) (existReply, error) {
 		xs, err := f.client.DigestReads(ctx, host, f.class, shard, []strfmt.UUID{id})
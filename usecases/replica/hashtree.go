@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+)
+
+// MaxHashTreeHeight bounds the size of a hash tree to 2^17-1 nodes
+const MaxHashTreeHeight = 16
+
+// HashTree is a Merkle tree over the objects of a shard replica. An object
+// is assigned to a leaf by the leading bits of its id, the digest of a leaf
+// combines the ids and update times of its objects independently of their
+// order. Replicas with equal roots hold the same versions of all objects,
+// otherwise the differing leaves are found by descending the tree.
+type HashTree struct {
+	Height int `json:"height"`
+	// Nodes in breadth-first order, node i has the children 2i+1 and 2i+2
+	Nodes []uint64 `json:"nodes"`
+}
+
+func NewHashTree(height int) (*HashTree, error) {
+	if height < 0 || height > MaxHashTreeHeight {
+		return nil, fmt.Errorf("hash tree height %d not in range [0, %d]",
+			height, MaxHashTreeHeight)
+	}
+	return &HashTree{
+		Height: height,
+		Nodes:  make([]uint64, 1<<(height+1)-1),
+	}, nil
+}
+
+// Leaves is the number of leaves of a tree of the given height
+func Leaves(height int) int {
+	return 1 << height
+}
+
+// Leaf returns the leaf of the object with the binary id
+func (t *HashTree) Leaf(id []byte) int {
+	return leafOf(id, t.Height)
+}
+
+// Add adds an object to the digest of its leaf. Build has to be called
+// once all objects have been added.
+func (t *HashTree) Add(id []byte, updateTime int64) {
+	h := fnv.New64a()
+	h.Write(id)
+	var ts [8]byte
+	binary.BigEndian.PutUint64(ts[:], uint64(updateTime))
+	h.Write(ts[:])
+
+	// a sum doesn't depend on the order in which objects are added
+	t.Nodes[Leaves(t.Height)-1+t.Leaf(id)] += h.Sum64()
+}
+
+// Build computes the inner nodes from the leaves
+func (t *HashTree) Build() {
+	var buf [16]byte
+	for i := Leaves(t.Height) - 2; i >= 0; i-- {
+		binary.BigEndian.PutUint64(buf[:8], t.Nodes[2*i+1])
+		binary.BigEndian.PutUint64(buf[8:], t.Nodes[2*i+2])
+		h := fnv.New64a()
+		h.Write(buf[:])
+		t.Nodes[i] = h.Sum64()
+	}
+}
+
+func (t *HashTree) Root() uint64 {
+	return t.Nodes[0]
+}
+
+// DiffLeaves returns the leaves whose digests differ, in ascending order.
+// Subtrees with equal digests are skipped.
+func (t *HashTree) DiffLeaves(other *HashTree) ([]int, error) {
+	if t.Height != other.Height || len(t.Nodes) != len(other.Nodes) {
+		return nil, fmt.Errorf("hash trees of height %d and %d can't be compared",
+			t.Height, other.Height)
+	}
+
+	firstLeaf := Leaves(t.Height) - 1
+	var leaves []int
+	var descend func(i int)
+	descend = func(i int) {
+		if t.Nodes[i] == other.Nodes[i] {
+			return
+		}
+		if i >= firstLeaf {
+			leaves = append(leaves, i-firstLeaf)
+			return
+		}
+		descend(2*i + 1)
+		descend(2*i + 2)
+	}
+	descend(0)
+	return leaves, nil
+}
+
+// LeafRange returns the range of binary ids of a leaf. to is exclusive, it
+// is nil for the last leaf.
+func LeafRange(height, leaf int) (from, to []byte) {
+	from = leafStart(height, leaf)
+	if leaf+1 < Leaves(height) {
+		to = leafStart(height, leaf+1)
+	}
+	return from, to
+}
+
+func leafStart(height, leaf int) []byte {
+	id := make([]byte, 16)
+	if height > 0 {
+		binary.BigEndian.PutUint64(id, uint64(leaf)<<(64-height))
+	}
+	return id
+}
+
+func leafOf(id []byte, height int) int {
+	if height == 0 {
+		return 0
+	}
+	var prefix [8]byte
+	copy(prefix[:], id)
+	return int(binary.BigEndian.Uint64(prefix[:]) >> (64 - height))
+}
@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+)
+
+// leaderElector returns the host of the replica which serializes the writes
+// of a shard. All nodes must elect the same replica, e.g. the first replica
+// of the shard which is a live member of the cluster.
+type leaderElector interface {
+	ShardLeader(shard string) (host string, err error)
+}
+
+// EnableLeaderWrites switches the replicator to the leader-based write path
+func (r *Replicator) EnableLeaderWrites(leader leaderElector) {
+	r.leader = leader
+}
+
+// pushThroughLeader is the leader-based variant of the two-phase commit.
+//
+// The leader holds a lock on the shard from its prepare until its commit.
+// It is prepared before and committed after all other replicas, so that a
+// write can only be prepared on the other replicas once the previous write
+// of the shard has been committed on all of them. This way all replicas
+// apply the writes of a shard in the same order.
+func (c *coordinator[T]) pushThroughLeader(ctx context.Context,
+	leader string, state rState, ask readyOp, com commitOp[T],
+) <-chan _Result[T] {
+	followers := make([]string, 0, len(state.Hosts))
+	for _, host := range state.Hosts {
+		if host != leader {
+			followers = append(followers, host)
+		}
+	}
+
+	replyCh := make(chan _Result[T], len(state.Hosts))
+	go func() {
+		defer close(replyCh)
+		log := c.log.WithFields(logrus.Fields{"op": "broadcast.leader", "leader": leader})
+
+		if len(followers) == len(state.Hosts) {
+			log.Error(fmt.Errorf("shard leader is not a replica of shard %q", c.Shard))
+			return
+		}
+
+		if err := ask(ctx, leader, c.TxID); err != nil {
+			log.Error(err)
+			c.Abort(ctx, leader, c.Class, c.Shard, c.TxID)
+			return
+		}
+
+		// the leader counts towards the consistency level
+		level := state.Level - 1
+		prepared := make(chan string, len(followers))
+		n := 0
+		for replica := range c.broadcast(ctx, followers, ask, level) {
+			prepared <- replica
+			n++
+		}
+		close(prepared)
+		if n < level { // followers have been aborted by the broadcast
+			c.Abort(ctx, leader, c.Class, c.Shard, c.TxID)
+			return
+		}
+
+		for res := range c.commitAll(context.Background(), prepared, com) {
+			replyCh <- res
+		}
+		resp, err := com(context.Background(), leader, c.TxID)
+		replyCh <- _Result[T]{resp, err}
+	}()
+
+	return replyCh
+}
@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+type fakeLeaderElector string
+
+func (f fakeLeaderElector) ShardLeader(shard string) (string, error) {
+	return string(f), nil
+}
+
+type callRecorder struct {
+	sync.Mutex
+	calls []string
+}
+
+func (r *callRecorder) record(call string) func(mock.Arguments) {
+	return func(a mock.Arguments) {
+		r.Lock()
+		defer r.Unlock()
+		r.calls = append(r.calls, call+":"+a[1].(string))
+	}
+}
+
+func TestReplicatorLeaderWrites(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		ctx   = context.Background()
+		obj   = &storobj.Object{}
+	)
+
+	t.Run("LeaderIsPreparedFirstAndCommittedLast", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		rep := f.newReplicator()
+		rep.EnableLeaderWrites(fakeLeaderElector("B"))
+		rec := &callRecorder{}
+		for _, n := range nodes {
+			f.WClient.On("PutObject", ctx, n, cls, shard, anyVal, obj).
+				Return(SimpleResponse{}, nil).Run(rec.record("prepare"))
+			f.WClient.On("Commit", anyVal, n, cls, shard, anyVal, anyVal).
+				Return(nil).Run(rec.record("commit"))
+		}
+
+		err := rep.PutObject(ctx, shard, obj, All)
+		assert.Nil(t, err)
+		assert.Len(t, rec.calls, 6)
+		assert.Equal(t, "prepare:B", rec.calls[0])
+		assert.Equal(t, "commit:B", rec.calls[5])
+	})
+
+	t.Run("LeaderIsAbortedIfFollowersFail", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		rep := f.newReplicator()
+		rep.EnableLeaderWrites(fakeLeaderElector("A"))
+		resp := SimpleResponse{}
+		f.WClient.On("PutObject", ctx, "A", cls, shard, anyVal, obj).Return(resp, nil)
+		f.WClient.On("PutObject", ctx, "B", cls, shard, anyVal, obj).Return(resp, nil)
+		f.WClient.On("PutObject", ctx, "C", cls, shard, anyVal, obj).Return(resp, errAny)
+		for _, n := range nodes {
+			f.WClient.On("Abort", anyVal, n, cls, shard, anyVal).Return(resp, nil)
+		}
+
+		err := rep.PutObject(ctx, shard, obj, All)
+		assert.ErrorIs(t, err, errReplicas)
+		f.WClient.AssertCalled(t, "Abort", anyVal, "A", cls, shard, anyVal)
+		f.WClient.AssertNotCalled(t, "Commit", anyVal, "A", cls, shard, anyVal, anyVal)
+	})
+
+	t.Run("FollowersAreNotAskedIfLeaderFails", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		rep := f.newReplicator()
+		rep.EnableLeaderWrites(fakeLeaderElector("A"))
+		resp := SimpleResponse{}
+		f.WClient.On("PutObject", ctx, "A", cls, shard, anyVal, obj).Return(resp, errAny)
+		f.WClient.On("Abort", anyVal, "A", cls, shard, anyVal).Return(resp, nil)
+
+		err := rep.PutObject(ctx, shard, obj, One)
+		assert.ErrorIs(t, err, errReplicas)
+		f.WClient.AssertNotCalled(t, "PutObject", ctx, "B", cls, shard, anyVal, obj)
+	})
+}
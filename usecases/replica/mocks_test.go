@@ -56,6 +56,20 @@ func (f *fakeRClient) DigestObjects(ctx context.Context, host, index, shard stri
 	return args.Get(0).([]RepairResponse), args.Error(1)
 }
 
+func (f *fakeRClient) HashTree(ctx context.Context, host, index, shard string,
+	height int,
+) (*HashTree, error) {
+	args := f.Called(ctx, host, index, shard, height)
+	return args.Get(0).(*HashTree), args.Error(1)
+}
+
+func (f *fakeRClient) DigestLeaf(ctx context.Context, host, index, shard string,
+	height, leaf int,
+) ([]RepairResponse, error) {
+	args := f.Called(ctx, host, index, shard, height, leaf)
+	return args.Get(0).([]RepairResponse), args.Error(1)
+}
+
 type fakeClient struct {
 	mock.Mock
 }
@@ -46,6 +46,10 @@ type RemoteIncomingRepo interface {
 		shardName string, ids []strfmt.UUID) ([]objects.Replica, error)
 	DigestObjects(ctx context.Context, class, shardName string,
 		ids []strfmt.UUID) (result []RepairResponse, err error)
+	HashTree(ctx context.Context, class, shardName string,
+		height int) (*HashTree, error)
+	DigestLeaf(ctx context.Context, class, shardName string,
+		height, leaf int) ([]RepairResponse, error)
 }
 
 type RemoteReplicaIncoming struct {
@@ -129,3 +133,15 @@ func (rri *RemoteReplicaIncoming) DigestObjects(ctx context.Context,
 ) (result []RepairResponse, err error) {
 	return rri.repo.DigestObjects(ctx, indexName, shardName, ids)
 }
+
+func (rri *RemoteReplicaIncoming) HashTree(ctx context.Context,
+	indexName, shardName string, height int,
+) (*HashTree, error) {
+	return rri.repo.HashTree(ctx, indexName, shardName, height)
+}
+
+func (rri *RemoteReplicaIncoming) DigestLeaf(ctx context.Context,
+	indexName, shardName string, height, leaf int,
+) ([]RepairResponse, error) {
+	return rri.repo.DigestLeaf(ctx, indexName, shardName, height, leaf)
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/objects"
@@ -41,6 +42,17 @@ var (
 type repairer struct {
 	class  string
 	client finderClient // needed to commit and abort operation
+	// config returns the replication config of the class, which specifies
+	// how conflicts are resolved. It is read on every repair to pick up
+	// updates of the class.
+	config func() *models.ReplicationConfig
+}
+
+func (r *repairer) conflictResolver() conflictResolver {
+	if r.config == nil {
+		return newConflictResolver(nil)
+	}
+	return newConflictResolver(r.config())
 }
 
 // repairOne repairs a single object (used by Finder::GetOne)
@@ -54,7 +66,9 @@ func (r *repairer) repairOne(ctx context.Context,
 		lastUTime int64
 		winnerIdx int
 		cl        = r.client
+		cr        = r.conflictResolver()
 	)
+	countConflicts(r.class, cr.strategy, 1)
 	for i, x := range votes {
 		if x.o.Deleted {
 			return nil, errConflictExistOrDeleted
@@ -64,6 +78,14 @@ func (r *repairer) repairOne(ctx context.Context,
 			winnerIdx = i
 		}
 	}
+	if cr.byContent() {
+		senders, times := make([]string, len(votes)), make([]int64, len(votes))
+		for i, x := range votes {
+			senders[i], times[i] = x.sender, x.UTime
+		}
+		return r.repairByContent(ctx, cr, shard, id, senders, times,
+			votes[contentIdx].o.Object, contentIdx)
+	}
 	// fetch most recent object
 	updates := votes[contentIdx].o
 	winner := votes[winnerIdx]
@@ -122,7 +144,9 @@ func (r *repairer) repairExist(ctx context.Context,
 		lastUTime int64
 		winnerIdx int
 		cl        = r.client
+		cr        = r.conflictResolver()
 	)
+	countConflicts(r.class, cr.strategy, 1)
 	for i, x := range votes {
 		if x.o.Deleted {
 			return false, errConflictExistOrDeleted
@@ -132,6 +156,14 @@ func (r *repairer) repairExist(ctx context.Context,
 			winnerIdx = i
 		}
 	}
+	if cr.byContent() {
+		senders, times := make([]string, len(votes)), make([]int64, len(votes))
+		for i, x := range votes {
+			senders[i], times[i] = x.sender, x.UTime
+		}
+		obj, err := r.repairByContent(ctx, cr, shard, id, senders, times, nil, -1)
+		return obj != nil, err
+	}
 	// fetch most recent object
 	winner := votes[winnerIdx]
 	resp, err := cl.FullRead(ctx, winner.sender, r.class, shard, id, search.SelectProperties{}, additional.Properties{})
@@ -179,7 +211,12 @@ func (r *repairer) repairAll(ctx context.Context,
 		ms         = make([]iTuple, 0, len(ids))       // mismatches
 		nDeletions = 0
 		cl         = r.client
+		cr         = r.conflictResolver()
 	)
+	countConflicts(r.class, cr.strategy, countDiverged(votes, len(ids)))
+	if cr.byContent() {
+		return r.repairAllByContent(ctx, cr, shard, ids, votes, contentIdx)
+	}
 	// find most recent objects
 	for i, x := range votes[contentIdx].FullData {
 		lastTimes[i] = iTuple{S: contentIdx, O: i, T: x.UpdateTime(), Deleted: x.Deleted}
@@ -283,3 +320,207 @@ func (r *repairer) repairAll(ctx context.Context,
 
 	return result, err
 }
+
+// countDiverged counts the objects whose update times differ between votes
+func countDiverged(votes []vote, n int) int {
+	count := 0
+	for j := 0; j < n; j++ {
+		for _, v := range votes[1:] {
+			if v.UpdateTimeAt(j) != votes[0].UpdateTimeAt(j) {
+				count++
+				break
+			}
+		}
+	}
+	return count
+}
+
+// repairByContent repairs a single object whose conflict is decided by the
+// contents of the diverging replicas. All distinct versions of the object
+// are fetched, known is the version sent by the replica at knownIdx if any.
+func (r *repairer) repairByContent(ctx context.Context, cr conflictResolver,
+	shard string, id strfmt.UUID, senders []string, times []int64,
+	known *storobj.Object, knownIdx int,
+) (*storobj.Object, error) {
+	xs := make([]*storobj.Object, len(senders))
+	gr, gctx := errgroup.WithContext(ctx)
+	for i := range senders {
+		if knownIdx >= 0 && times[i] == times[knownIdx] {
+			xs[i] = known
+			continue
+		}
+		i := i
+		gr.Go(func() error {
+			x, err := r.client.FullRead(gctx, senders[i], r.class, shard, id,
+				search.SelectProperties{}, additional.Properties{})
+			if err != nil {
+				return fmt.Errorf("get object from %s: %w", senders[i], err)
+			}
+			if x.UpdateTime() != times[i] {
+				return fmt.Errorf("fetch state from %s: %w", senders[i], errConflictObjectChanged)
+			}
+			if x.Deleted {
+				return errConflictExistOrDeleted
+			}
+			xs[i] = x.Object
+			return nil
+		})
+	}
+	if err := gr.Wait(); err != nil {
+		return nil, err
+	}
+
+	w := cr.pick(xs)
+	if w == -1 { // object doesn't exist on any replica
+		return nil, nil
+	}
+	winner := xs[w]
+
+	gr, gctx = errgroup.WithContext(ctx)
+	for i := range senders {
+		if times[i] == times[w] {
+			continue
+		}
+		i := i
+		gr.Go(func() error {
+			ups := []*objects.VObject{{
+				LatestObject:    &winner.Object,
+				StaleUpdateTime: times[i],
+			}}
+			resp, err := r.client.Overwrite(gctx, senders[i], r.class, shard, ups)
+			if err != nil {
+				return fmt.Errorf("node %q could not repair object: %w", senders[i], err)
+			}
+			if len(resp) > 0 && resp[0].Err != "" {
+				return fmt.Errorf("overwrite %w %s: %s", errConflictObjectChanged, senders[i], resp[0].Err)
+			}
+			return nil
+		})
+	}
+
+	return winner, gr.Wait()
+}
+
+// repairAllByContent is the variant of repairAll for conflicts which are
+// decided by the contents of the diverging replicas
+func (r *repairer) repairAllByContent(ctx context.Context, cr conflictResolver,
+	shard string, ids []strfmt.UUID, votes []vote, contentIdx int,
+) ([]*storobj.Object, error) {
+	var (
+		content    = votes[contentIdx].FullData
+		result     = make([]*storobj.Object, len(ids))
+		diverged   = make([]int, 0, len(ids)) // indices of diverged objects
+		nDeletions = 0
+	)
+	for j := range ids {
+		deleted, same := content[j].Deleted, true
+		for _, v := range votes {
+			if v.UpdateTimeAt(j) != content[j].UpdateTime() {
+				same = false
+			}
+			if len(v.DigestData) > 0 && v.DigestData[j].Deleted {
+				deleted = true
+			}
+		}
+		switch {
+		case deleted: // conflict
+			nDeletions++
+		case same:
+			result[j] = content[j].Object
+		default:
+			diverged = append(diverged, j)
+		}
+	}
+
+	// fetch the versions of the diverged objects which are not known yet,
+	// candidates[i][k] is the version of replica i of object diverged[k]
+	candidates := make([][]*storobj.Object, len(votes))
+	gr, gctx := errgroup.WithContext(ctx)
+	for i, v := range votes {
+		candidates[i] = make([]*storobj.Object, len(diverged))
+		query, pos := make([]strfmt.UUID, 0, len(diverged)), make([]int, 0, len(diverged))
+		for k, j := range diverged {
+			if v.UpdateTimeAt(j) == content[j].UpdateTime() {
+				candidates[i][k] = content[j].Object
+				continue
+			}
+			query = append(query, ids[j])
+			pos = append(pos, k)
+		}
+		if len(query) == 0 {
+			continue
+		}
+		i, receiver := i, v.Sender
+		gr.Go(func() error {
+			resp, err := r.client.FullReads(gctx, receiver, r.class, shard, query)
+			if err != nil {
+				return err
+			}
+			if len(resp) != len(query) {
+				return fmt.Errorf("node %s returned %d objects, expected %d",
+					receiver, len(resp), len(query))
+			}
+			for n, k := range pos {
+				j := diverged[k]
+				if resp[n].UpdateTime() != votes[i].UpdateTimeAt(j) {
+					return fmt.Errorf("object %s changed on %s", ids[j], receiver)
+				}
+				candidates[i][k] = resp[n].Object
+			}
+			return nil
+		})
+	}
+	if err := gr.Wait(); err != nil {
+		return nil, err
+	}
+
+	winners := make([]int, len(diverged))
+	xs := make([]*storobj.Object, len(votes))
+	for k, j := range diverged {
+		for i := range votes {
+			xs[i] = candidates[i][k]
+		}
+		if winners[k] = cr.pick(xs); winners[k] >= 0 {
+			result[j] = xs[winners[k]]
+		}
+	}
+
+	// concurrent repairs
+	gr, gctx = errgroup.WithContext(ctx)
+	for _, v := range votes {
+		query := make([]*objects.VObject, 0, len(diverged))
+		for k, j := range diverged {
+			w := winners[k]
+			if w < 0 {
+				continue
+			}
+			if cTime := v.UpdateTimeAt(j); cTime != votes[w].UpdateTimeAt(j) {
+				query = append(query, &objects.VObject{
+					LatestObject: &result[j].Object, StaleUpdateTime: cTime,
+				})
+			}
+		}
+		if len(query) == 0 {
+			continue
+		}
+		receiver := v.Sender
+		gr.Go(func() error {
+			rs, err := r.client.Overwrite(gctx, receiver, r.class, shard, query)
+			if err != nil {
+				return fmt.Errorf("node %q could not repair objects: %w", receiver, err)
+			}
+			for _, r := range rs {
+				if r.Err != "" {
+					return fmt.Errorf("object changed in the meantime on node %s: %s", receiver, r.Err)
+				}
+			}
+			return nil
+		})
+	}
+	err := gr.Wait()
+	if nDeletions > 0 {
+		return result, errConflictExistOrDeleted
+	}
+
+	return result, err
+}
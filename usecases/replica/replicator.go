@@ -61,6 +61,9 @@ type Replicator struct {
 	log            logrus.FieldLogger
 	requestCounter atomic.Uint64
 	stream         replicatorStream
+	// leader is set if the writes of a shard are serialized through the
+	// replica elected as its leader
+	leader leaderElector
 	*Finder
 }
 
@@ -107,6 +110,8 @@ func (r *Replicator) PutObject(ctx context.Context,
 	if err != nil {
 		r.log.WithField("op", "put").WithField("class", r.class).
 			WithField("shard", shard).WithField("uuid", obj.ID()).Error(err)
+	} else {
+		observeWrite(ctx, r.class, shard, obj.LastUpdateTimeUnix())
 	}
 	return err
 }
@@ -137,6 +142,8 @@ func (r *Replicator) MergeObject(ctx context.Context,
 	if err != nil {
 		r.log.WithField("op", "put").WithField("class", r.class).
 			WithField("shard", shard).WithField("uuid", doc.ID).Error(err)
+	} else {
+		observeWrite(ctx, r.class, shard, doc.UpdateTime)
 	}
 	return err
 }
@@ -167,6 +174,8 @@ func (r *Replicator) DeleteObject(ctx context.Context,
 	if err != nil {
 		r.log.WithField("op", "put").WithField("class", r.class).
 			WithField("shard", shard).WithField("uuid", id).Error(err)
+	} else {
+		observeWrite(ctx, r.class, shard, 0)
 	}
 	return err
 }
@@ -204,6 +213,15 @@ func (r *Replicator) PutObjects(ctx context.Context,
 		r.log.WithField("op", "put.many").WithField("class", r.class).
 			WithField("shard", shard).Error(errs)
 	}
+	var version int64
+	for i, err := range errs {
+		if err == nil && objs[i].LastUpdateTimeUnix() > version {
+			version = objs[i].LastUpdateTimeUnix()
+		}
+	}
+	if version > 0 {
+		observeWrite(ctx, r.class, shard, version)
+	}
 	return errs
 }
 
@@ -253,6 +271,9 @@ func (r *Replicator) DeleteObjects(ctx context.Context,
 		r.log.WithField("op", "put.many").WithField("class", r.class).
 			WithField("shard", shard).Error(rs)
 	}
+	if !dryRun {
+		observeWrite(ctx, r.class, shard, 0)
+	}
 	return rs
 }
 
@@ -288,6 +309,7 @@ func (r *Replicator) AddReferences(ctx context.Context,
 		r.log.WithField("op", "put.refs").WithField("class", r.class).
 			WithField("shard", shard).Error(errs)
 	}
+	observeWrite(ctx, r.class, shard, 0)
 	return errs
 }
 
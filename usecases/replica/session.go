@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// SessionTokenHeader is the HTTP header used to exchange session tokens
+// with clients
+const SessionTokenHeader = "X-Weaviate-Session-Token"
+
+type sessionKey struct{}
+
+// Session tracks the shard versions written within a client session.
+//
+// A version is the latest update time written to a shard. A read of a shard
+// at a consistency level lower than ALL is only accepted if the replica
+// returns an object at least as new as the session's version of that shard.
+// Otherwise the read is repeated at level ALL, which guarantees that the
+// client reads its own writes.
+//
+// A session is transferred between requests as an opaque token, see Token
+// and ParseSessionToken.
+type Session struct {
+	sync.Mutex
+	versions map[string]int64 // class/shard -> version
+	changed  bool
+}
+
+// NewSession creates an empty session
+func NewSession() *Session {
+	return &Session{versions: make(map[string]int64)}
+}
+
+// ParseSessionToken decodes a token previously returned by Token
+func ParseSessionToken(token string) (*Session, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid session token: %w", err)
+	}
+	s := NewSession()
+	if err := json.Unmarshal(bytes, &s.versions); err != nil {
+		return nil, fmt.Errorf("invalid session token: %w", err)
+	}
+	if s.versions == nil {
+		s.versions = make(map[string]int64)
+	}
+	return s, nil
+}
+
+// Token encodes the version vector of the session
+func (s *Session) Token() string {
+	s.Lock()
+	defer s.Unlock()
+	bytes, _ := json.Marshal(s.versions)
+	return base64.RawURLEncoding.EncodeToString(bytes)
+}
+
+// Changed returns true if a write has been observed since the session was
+// created
+func (s *Session) Changed() bool {
+	s.Lock()
+	defer s.Unlock()
+	return s.changed
+}
+
+// Observe records a successful write to shard with the given update time
+func (s *Session) Observe(class, shard string, version int64) {
+	s.Lock()
+	defer s.Unlock()
+	key := sessionShard(class, shard)
+	if version > s.versions[key] {
+		s.versions[key] = version
+		s.changed = true
+	}
+}
+
+// Version returns the latest version written to shard within this session
+// or zero if the session has not written to it
+func (s *Session) Version(class, shard string) int64 {
+	s.Lock()
+	defer s.Unlock()
+	return s.versions[sessionShard(class, shard)]
+}
+
+func sessionShard(class, shard string) string {
+	return class + "/" + shard
+}
+
+// ContextWithSession attaches a session to ctx
+func ContextWithSession(ctx context.Context, s *Session) context.Context {
+	return context.WithValue(ctx, sessionKey{}, s)
+}
+
+// SessionFromContext returns the session attached to ctx if any
+func SessionFromContext(ctx context.Context) *Session {
+	s, _ := ctx.Value(sessionKey{}).(*Session)
+	return s
+}
+
+// observeWrite records a successful write in the session of ctx if any.
+// Writes without a known update time use the current time, which is an
+// upper bound of the update time assigned by the coordinator.
+func observeWrite(ctx context.Context, class, shard string, version int64) {
+	s := SessionFromContext(ctx)
+	if s == nil {
+		return
+	}
+	if version <= 0 {
+		version = time.Now().UnixMilli()
+	}
+	s.Observe(class, shard, version)
+}
+
+// sessionVersion returns the version the session of ctx requires from shard.
+// It returns zero if there is no such requirement for a read at level l.
+func sessionVersion(ctx context.Context, l ConsistencyLevel, class, shard string) int64 {
+	if l == All {
+		return 0
+	}
+	if s := SessionFromContext(ctx); s != nil {
+		return s.Version(class, shard)
+	}
+	return 0
+}
@@ -0,0 +1,123 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestSessionToken(t *testing.T) {
+	s := NewSession()
+	assert.False(t, s.Changed())
+	s.Observe("C1", "S1", 5)
+	s.Observe("C1", "S1", 3)
+	s.Observe("C2", "S1", 7)
+	assert.True(t, s.Changed())
+
+	got, err := ParseSessionToken(s.Token())
+	require.Nil(t, err)
+	assert.False(t, got.Changed())
+	assert.Equal(t, int64(5), got.Version("C1", "S1"))
+	assert.Equal(t, int64(7), got.Version("C2", "S1"))
+	assert.Equal(t, int64(0), got.Version("C1", "S2"))
+
+	_, err = ParseSessionToken("not a token")
+	assert.NotNil(t, err)
+}
+
+func TestReplicatorObservesSessionWrites(t *testing.T) {
+	var (
+		cls     = "C1"
+		shard   = "SH1"
+		nodes   = []string{"A", "B"}
+		session = NewSession()
+		ctx     = ContextWithSession(context.Background(), session)
+		obj     = object("123", 5)
+		resp    = SimpleResponse{}
+	)
+	f := newFakeFactory(cls, shard, nodes)
+	rep := f.newReplicator()
+	for _, n := range nodes {
+		f.WClient.On("PutObject", anyVal, n, cls, shard, anyVal, obj).Return(resp, nil)
+		f.WClient.On("Commit", anyVal, n, cls, shard, anyVal, anyVal).Return(nil)
+	}
+	require.Nil(t, rep.PutObject(ctx, shard, obj, One))
+	assert.Equal(t, int64(5), session.Version(cls, shard))
+}
+
+func TestFinderReadYourWrites(t *testing.T) {
+	var (
+		id    = strfmt.UUID("123")
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		adds  = additional.Properties{}
+		proj  = search.SelectProperties{}
+	)
+	newCtx := func(version int64) context.Context {
+		s := NewSession()
+		s.Observe(cls, shard, version)
+		return ContextWithSession(context.Background(), s)
+	}
+
+	t.Run("UpToDate", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		finder := f.newFinder()
+		item := objects.Replica{ID: id, Object: object(id, 5)}
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+
+		got, err := finder.GetOne(newCtx(5), One, shard, id, proj, adds)
+		assert.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+		f.RClient.AssertNotCalled(t, "DigestObjects", anyVal, anyVal, anyVal, anyVal, anyVal)
+	})
+
+	t.Run("Stale", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		finder := f.newFinder()
+		item := objects.Replica{ID: id, Object: object(id, 5)}
+		digest := []RepairResponse{{ID: id.String(), UpdateTime: 5}}
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		for _, n := range nodes[1:] {
+			f.RClient.On("DigestObjects", anyVal, n, cls, shard, []strfmt.UUID{id}).Return(digest, nil)
+		}
+
+		// the session has written a newer version than any replica returns,
+		// which forces the read to query all replicas
+		got, err := finder.GetOne(newCtx(6), One, shard, id, proj, adds)
+		assert.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+		f.RClient.AssertExpectations(t)
+	})
+
+	t.Run("Exists", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		finder := f.newFinder()
+		digest := []RepairResponse{{ID: id.String(), UpdateTime: 5}}
+		for _, n := range nodes {
+			f.RClient.On("DigestObjects", anyVal, n, cls, shard, []strfmt.UUID{id}).Return(digest, nil)
+		}
+
+		got, err := finder.Exists(newCtx(5), One, shard, id)
+		assert.Nil(t, err)
+		assert.True(t, got)
+		f.RClient.AssertExpectations(t)
+	})
+}
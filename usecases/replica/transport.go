@@ -202,6 +202,15 @@ type rClient interface {
 	// object
 	DigestObjects(ctx context.Context, host, index, shard string,
 		ids []strfmt.UUID) ([]RepairResponse, error)
+
+	// HashTree returns the hash tree of the objects of a shard replica
+	HashTree(ctx context.Context, host, index, shard string,
+		height int) (*HashTree, error)
+
+	// DigestLeaf returns the digests of the objects of a leaf of the hash
+	// tree of a shard replica
+	DigestLeaf(ctx context.Context, host, index, shard string,
+		height, leaf int) ([]RepairResponse, error)
 }
 
 // finderClient extends RClient with consistency checks
@@ -17,10 +17,14 @@ import (
 	"io"
 	"sort"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/mock"
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/changes"
+	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
@@ -150,6 +154,13 @@ func (s *fakeSource) ShardsBackup(
 	return args.Get(0).(backup.ClassDescriptor), args.Error(1)
 }
 
+func (s *fakeSource) ChangesSince(ctx context.Context, class, shard, token string,
+	limit int,
+) (*changes.Changes, error) {
+	args := s.Called(ctx, class, shard, token, limit)
+	return args.Get(0).(*changes.Changes), args.Error(1)
+}
+
 type fakeClient struct {
 	mock.Mock
 }
@@ -178,3 +189,37 @@ func (f *fakeClient) IncreaseReplicationFactor(ctx context.Context,
 	args := f.Called(ctx, host, class, dist)
 	return args.Error(0)
 }
+
+func (f *fakeClient) MoveReplicas(ctx context.Context,
+	host, class string, dist ShardDist,
+) error {
+	args := f.Called(ctx, host, class, dist)
+	return args.Error(0)
+}
+
+func (f *fakeClient) GetShardStatus(ctx context.Context, host, class, shard string,
+) (string, error) {
+	args := f.Called(ctx, host, class, shard)
+	return args.String(0), args.Error(1)
+}
+
+func (f *fakeClient) UpdateShardStatus(ctx context.Context, host, class, shard,
+	status string,
+) error {
+	args := f.Called(ctx, host, class, shard, status)
+	return args.Error(0)
+}
+
+func (f *fakeClient) BatchPutObjects(ctx context.Context, host, class, shard string,
+	objs []*storobj.Object, repl *additional.ReplicationProperties,
+) []error {
+	args := f.Called(ctx, host, class, shard, objs, repl)
+	return args.Get(0).([]error)
+}
+
+func (f *fakeClient) DeleteObject(ctx context.Context, host, class, shard string,
+	id strfmt.UUID,
+) error {
+	args := f.Called(ctx, host, class, shard, id)
+	return args.Error(0)
+}
@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scaler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// MoveReplica copies the replica of a shard to the node which takes it over,
+// see sharding.State.RebalancePlan. The copy is streamed by the node which
+// holds the replica, see LocalMoveReplicas. Once MoveReplica returns the
+// replica doesn't accept writes anymore and the copy is identical to it.
+//
+// The caller must then switch the ownership in the sharding state, which
+// drops the previous replica. If the switch fails, CancelMove makes the
+// replica writable again.
+func (s *Scaler) MoveReplica(ctx context.Context, className string,
+	m sharding.Move,
+) error {
+	host, ok := s.cluster.NodeHostname(m.From)
+	if !ok {
+		return fmt.Errorf("%w, %q", ErrUnresolvedName, m.From)
+	}
+
+	// replicas which don't accept writes are not moved, as the move would
+	// make them writable again if it fails
+	status, err := s.client.GetShardStatus(ctx, host, className, m.Shard)
+	if err != nil {
+		return fmt.Errorf("get status of shard %q on node %q: %w", m.Shard, m.From, err)
+	}
+	if status != storagestate.StatusReady.String() {
+		return fmt.Errorf("shard %q is %s on node %q, only %s shards are moved",
+			m.Shard, status, m.From, storagestate.StatusReady)
+	}
+
+	dist := ShardDist{m.Shard: {m.To}}
+	if m.From == s.cluster.LocalName() {
+		return s.LocalMoveReplicas(ctx, className, dist)
+	}
+	if err := s.client.MoveReplicas(ctx, host, className, dist); err != nil {
+		return fmt.Errorf("move replicas of class %q on node %q: %w", className, m.From, err)
+	}
+	return nil
+}
+
+// CancelMove makes the replica of a move writable again if the ownership
+// couldn't be switched
+func (s *Scaler) CancelMove(ctx context.Context, className string,
+	m sharding.Move,
+) error {
+	host, ok := s.cluster.NodeHostname(m.From)
+	if !ok {
+		return fmt.Errorf("%w, %q", ErrUnresolvedName, m.From)
+	}
+	return s.client.UpdateShardStatus(ctx, host, className, m.Shard,
+		storagestate.StatusReady.String())
+}
+
+// LocalMoveReplicas copies local shards to the nodes which take them over.
+// The shards keep accepting writes while their files are copied. Afterwards
+// they are marked READONLY and the writes of the meantime are replayed on the
+// copies. Shards are moved one after the other, so the transfer limit of the
+// node isn't shared by several of them.
+func (s *Scaler) LocalMoveReplicas(ctx context.Context, className string,
+	dist ShardDist,
+) error {
+	if len(dist) < 1 {
+		return nil
+	}
+	for shard, nodes := range dist {
+		if len(nodes) != 1 {
+			return fmt.Errorf("shard %q must be moved to exactly one node, got %v",
+				shard, nodes)
+		}
+	}
+	host, ok := s.cluster.NodeHostname(s.cluster.LocalName())
+	if !ok {
+		return fmt.Errorf("%w, %q", ErrUnresolvedName, s.cluster.LocalName())
+	}
+
+	bakID := fmt.Sprintf("_internal_move_%s", uuid.New().String())
+	bak, err := s.source.ShardsBackup(ctx, bakID, className, dist.shards())
+	if err != nil {
+		return fmt.Errorf("create snapshot: %w", err)
+	}
+	defer func() {
+		err := s.source.ReleaseBackup(context.Background(), bakID, className)
+		if err != nil {
+			s.logger.WithField("scaler", "releaseBackup").WithField("class", className).Error(err)
+		}
+	}()
+
+	rsync := newRSync(s.client, s.source, s.cluster, s.persistenceRoot, s.throttle)
+	for _, desc := range bak.Shards {
+		frozen := false
+		freeze := func() error {
+			err := s.client.UpdateShardStatus(ctx, host, className, desc.Name,
+				storagestate.StatusReadOnly.String())
+			frozen = err == nil
+			return err
+		}
+
+		err := rsync.MoveShard(ctx, className, desc, dist[desc.Name][0], freeze)
+		if err == nil {
+			continue
+		}
+		if frozen {
+			if uerr := s.client.UpdateShardStatus(context.Background(), host, className,
+				desc.Name, storagestate.StatusReady.String()); uerr != nil {
+				s.logger.WithField("action", "move_replica").
+					WithField("class", className).
+					WithField("shard", desc.Name).
+					WithError(uerr).
+					Error("make shard writable again after failed move")
+			}
+		}
+		return fmt.Errorf("move shard %q: %w", desc.Name, err)
+	}
+	return nil
+}
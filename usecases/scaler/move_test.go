@@ -0,0 +1,146 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scaler
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/changes"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestScalerMoveReplica(t *testing.T) {
+	var (
+		dataDir = t.TempDir()
+		ctx     = context.Background()
+		cls     = "C"
+		move    = sharding.Move{Shard: "S1", From: "N1", To: "N3"}
+		bak     = backup.ClassDescriptor{
+			Name: "C",
+			Shards: []backup.ShardDescriptor{
+				{
+					Name: "S1", Files: []string{"f1"},
+					PropLengthTrackerPath: "f2",
+					ShardVersionPath:      "f2",
+					DocIDCounterPath:      "f2",
+					ChangesToken:          "a",
+				},
+			},
+		}
+		written = &models.Object{
+			Class: cls,
+			ID:    "8d1c3ad4-2b8e-4d8e-9b3f-6a3e7cfd3c61",
+		}
+	)
+	for _, name := range []string{"f1", "f2"} {
+		file, err := os.Create(path.Join(dataDir, name))
+		require.Nil(t, err)
+		file.Close()
+	}
+
+	// copied expects the files of S1 to be copied to N3, the write to
+	// arrive after the copy has caught up and before the shard is frozen
+	copied := func(f *fakeFactory) (frozen *bool) {
+		frozen = new(bool)
+		f.Client.On("GetShardStatus", anyVal, "H1", cls, "S1").Return("READY", nil)
+		f.Source.On("ShardsBackup", anyVal, anyVal, cls, []string{"S1"}).Return(bak, nil)
+		f.Client.On("CreateShard", anyVal, "H3", cls, "S1").Return(nil)
+		f.Client.On("PutFile", anyVal, "H3", cls, "S1", "f1", anyVal).Return(nil)
+		f.Client.On("PutFile", anyVal, "H3", cls, "S1", "f2", anyVal).Return(nil)
+		f.Client.On("ReInitShard", anyVal, "H3", cls, "S1").Return(nil)
+		f.Source.On("ChangesSince", anyVal, cls, "S1", "a", tailBatchSize).
+			Return(&changes.Changes{Next: "a"}, nil).Once()
+		f.Client.On("UpdateShardStatus", anyVal, "H1", cls, "S1", "READONLY").
+			Run(func(mock.Arguments) { *frozen = true }).Return(nil)
+		f.Source.On("ChangesSince", anyVal, cls, "S1", "a", tailBatchSize).
+			Return(&changes.Changes{Objects: []*models.Object{written}, Next: "b"}, nil).Once()
+		f.Source.On("ChangesSince", anyVal, cls, "S1", "b", tailBatchSize).
+			Return(&changes.Changes{Next: "b"}, nil)
+		f.Source.On("ReleaseBackup", anyVal, anyVal, cls).Return(nil)
+		return frozen
+	}
+	isWritten := mock.MatchedBy(func(objs []*storobj.Object) bool {
+		return len(objs) == 1 && objs[0].ID() == written.ID
+	})
+
+	t.Run("local replica", func(t *testing.T) {
+		f := newFakeFactory()
+		frozen := copied(f)
+		f.Client.On("BatchPutObjects", anyVal, "H3", cls, "S1", isWritten, anyVal).
+			Run(func(mock.Arguments) { assert.True(t, *frozen, "replayed before freeze") }).
+			Return([]error{nil}).Once()
+
+		require.Nil(t, f.Scaler(dataDir).MoveReplica(ctx, cls, move))
+		f.Client.AssertExpectations(t)
+		f.Source.AssertExpectations(t)
+	})
+
+	t.Run("replay after freeze fails", func(t *testing.T) {
+		f := newFakeFactory()
+		copied(f)
+		f.Client.On("BatchPutObjects", anyVal, "H3", cls, "S1", isWritten, anyVal).
+			Return([]error{errAny}).Once()
+		f.Client.On("UpdateShardStatus", anyVal, "H1", cls, "S1", "READY").Return(nil).Once()
+
+		err := f.Scaler(dataDir).MoveReplica(ctx, cls, move)
+		assert.ErrorIs(t, err, errAny)
+		f.Client.AssertExpectations(t)
+	})
+
+	t.Run("remote replica", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Client.On("GetShardStatus", anyVal, "H2", cls, "S1").Return("READY", nil)
+		f.Client.On("MoveReplicas", anyVal, "H2", cls, ShardDist{"S1": {"N3"}}).Return(nil)
+
+		remote := sharding.Move{Shard: "S1", From: "N2", To: "N3"}
+		require.Nil(t, f.Scaler(dataDir).MoveReplica(ctx, cls, remote))
+		f.Client.AssertExpectations(t)
+	})
+
+	t.Run("read-only replica", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Client.On("GetShardStatus", anyVal, "H1", cls, "S1").Return("READONLY", nil)
+
+		err := f.Scaler(dataDir).MoveReplica(ctx, cls, move)
+		assert.ErrorContains(t, err, "only READY shards are moved")
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Client.On("UpdateShardStatus", anyVal, "H1", cls, "S1", "READY").Return(nil).Once()
+
+		require.Nil(t, f.Scaler(dataDir).CancelMove(ctx, cls, move))
+		f.Client.AssertExpectations(t)
+	})
+}
+
+func TestThrottle(t *testing.T) {
+	assert.Nil(t, newThrottle(0))
+	assert.Nil(t, (*throttle)(nil).wait(context.Background(), 1<<30))
+
+	th := newThrottle(1000)
+	// the burst of one second is available right away
+	require.Nil(t, th.wait(context.Background(), 1000))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, th.wait(ctx, 1000), context.Canceled)
+}
@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scaler
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ShardRebalancer moves replicas of shards between nodes
+type ShardRebalancer interface {
+	// RebalanceShards spreads the replicas of all classes evenly across the
+	// nodes and broadcasts the updated sharding states
+	RebalanceShards(ctx context.Context) error
+}
+
+// Rebalancer periodically spreads the shards evenly across the nodes, so
+// nodes which joined the cluster take over their share of the shards. As
+// with the Rereplicator, only the live node with the lowest name
+// coordinates the moves.
+type Rebalancer struct {
+	cluster    cluster
+	rebalancer ShardRebalancer
+	interval   time.Duration
+	logger     logrus.FieldLogger
+}
+
+func NewRebalancer(cl cluster, rebalancer ShardRebalancer, interval time.Duration,
+	logger logrus.FieldLogger,
+) *Rebalancer {
+	return &Rebalancer{
+		cluster:    cl,
+		rebalancer: rebalancer,
+		interval:   interval,
+		logger:     logger,
+	}
+}
+
+// Run rebalances the shards until the context is cancelled
+func (r *Rebalancer) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.check(ctx)
+		}
+	}
+}
+
+func (r *Rebalancer) check(ctx context.Context) {
+	if !r.isCoordinator() {
+		return
+	}
+
+	if err := r.rebalancer.RebalanceShards(ctx); err != nil {
+		r.logger.WithField("action", "rebalance").
+			WithError(err).
+			Error("rebalance shards")
+	}
+}
+
+func (r *Rebalancer) isCoordinator() bool {
+	local := r.cluster.LocalName()
+	for _, name := range r.cluster.AllNames() {
+		if name < local {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scaler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeShardRebalancer struct {
+	calls int
+	err   error
+}
+
+func (f *fakeShardRebalancer) RebalanceShards(ctx context.Context) error {
+	f.calls++
+	return f.err
+}
+
+func TestRebalancer(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+	ctx := context.Background()
+
+	t.Run("coordinator rebalances", func(t *testing.T) {
+		cl := newFakeNodeResolver("N1", map[string]string{"N1": "H1", "N2": "H2"})
+		rebalancer := &fakeShardRebalancer{}
+		r := NewRebalancer(cl, rebalancer, time.Minute, logger)
+
+		r.check(ctx)
+		r.check(ctx)
+		assert.Equal(t, 2, rebalancer.calls)
+	})
+
+	t.Run("other nodes don't", func(t *testing.T) {
+		cl := newFakeNodeResolver("N2", map[string]string{"N1": "H1", "N2": "H2"})
+		rebalancer := &fakeShardRebalancer{}
+		r := NewRebalancer(cl, rebalancer, time.Minute, logger)
+
+		r.check(ctx)
+		assert.Equal(t, 0, rebalancer.calls)
+
+		// N1 left, so N2 takes over
+		delete(cl.M, "N1")
+		r.check(ctx)
+		assert.Equal(t, 1, rebalancer.calls)
+	})
+
+	t.Run("errors are logged", func(t *testing.T) {
+		hook.Reset()
+		cl := newFakeNodeResolver("N1", map[string]string{"N1": "H1"})
+		r := NewRebalancer(cl, &fakeShardRebalancer{err: errAny}, time.Minute, logger)
+
+		r.check(ctx)
+		assert.Len(t, hook.AllEntries(), 1)
+	})
+}
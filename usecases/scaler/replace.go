@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scaler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// ReplaceNodes re-creates the replicas of the class which are on the given
+// nodes on other nodes. The shards are copied from their surviving replicas.
+// Shards without surviving replica are left as they are.
+//
+// It returns the updated sharding state or nil if no shard is affected. As
+// with Scale, the caller must broadcast the updated state.
+func (s *Scaler) ReplaceNodes(ctx context.Context, className string,
+	replFactor int64, nodes []string,
+) (*sharding.State, error) {
+	ssBefore := s.schema.ShardingState(className)
+	if ssBefore == nil {
+		return nil, fmt.Errorf("no sharding state for class %q", className)
+	}
+
+	gone := make(map[string]struct{}, len(nodes))
+	for _, node := range nodes {
+		gone[node] = struct{}{}
+	}
+
+	// the surviving replicas are the sources of the copies
+	survivors := ssBefore.DeepCopy()
+	affected := false
+	for name, shard := range survivors.Physical {
+		alive := make([]string, 0, len(shard.BelongsToNodes))
+		for _, node := range shard.BelongsToNodes {
+			if _, ok := gone[node]; !ok {
+				alive = append(alive, node)
+			}
+		}
+		if len(alive) == len(shard.BelongsToNodes) {
+			continue
+		}
+		if len(alive) == 0 {
+			s.logger.WithField("action", "replace_nodes").
+				WithField("class", className).
+				WithField("shard", name).
+				Warn("shard has no surviving replica and can't be re-created")
+			continue
+		}
+		shard.BelongsToNodes = alive
+		survivors.Physical[name] = shard
+		affected = true
+	}
+	if !affected {
+		return nil, nil
+	}
+
+	placement, err := survivors.Config.Placement(s.cluster)
+	if err != nil {
+		return nil, err
+	}
+	ssAfter := survivors.DeepCopy()
+	for name, shard := range ssAfter.Physical {
+		if len(shard.BelongsToNodes) == len(ssBefore.Physical[name].BelongsToNodes) {
+			// unaffected, or without surviving replica
+			continue
+		}
+		count := int(replFactor)
+		if n := len(placement.AllNames()); count > n {
+			// restore as many replicas as possible
+			count = n
+		}
+		if count <= len(shard.BelongsToNodes) {
+			continue
+		}
+		if err := shard.AdjustReplicas(count, placement); err != nil {
+			return nil, fmt.Errorf("shard %q: %w", name, err)
+		}
+		ssAfter.Physical[name] = shard
+	}
+
+	if err := s.replicate(ctx, className, &survivors, &ssAfter); err != nil {
+		return nil, err
+	}
+	return &ssAfter, nil
+}
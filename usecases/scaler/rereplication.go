@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scaler
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// NodeReplacer re-creates the replicas of failed nodes on other nodes
+type NodeReplacer interface {
+	// ShardedNodes returns all nodes which hold shards
+	ShardedNodes() []string
+	// ReplaceNodes re-creates the replicas on the nodes and broadcasts the
+	// updated sharding states
+	ReplaceNodes(ctx context.Context, nodes []string) error
+}
+
+// Rereplicator restores the replication factor of the classes after nodes
+// have been unreachable for longer than a grace period. Only the live node
+// with the lowest name replaces failed nodes, so there is a single
+// coordinator as long as the live nodes agree on the membership. Nodes are
+// only replaced while a majority of the sharded nodes is reachable, so the
+// minority side of a network partition never replaces the majority.
+type Rereplicator struct {
+	cluster  cluster
+	replacer NodeReplacer
+	grace    time.Duration
+	interval time.Duration
+	logger   logrus.FieldLogger
+
+	// unreachableSince is the time each node was first seen unreachable
+	unreachableSince map[string]time.Time
+}
+
+func NewRereplicator(cl cluster, replacer NodeReplacer, grace time.Duration,
+	logger logrus.FieldLogger,
+) *Rereplicator {
+	interval := grace / 10
+	if interval < time.Second {
+		interval = time.Second
+	} else if interval > time.Minute {
+		interval = time.Minute
+	}
+
+	return &Rereplicator{
+		cluster:          cl,
+		replacer:         replacer,
+		grace:            grace,
+		interval:         interval,
+		logger:           logger,
+		unreachableSince: map[string]time.Time{},
+	}
+}
+
+// Run checks for failed nodes until the context is cancelled
+func (r *Rereplicator) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			r.check(ctx, now)
+		}
+	}
+}
+
+func (r *Rereplicator) check(ctx context.Context, now time.Time) {
+	alive := map[string]struct{}{}
+	for _, name := range r.cluster.AllNames() {
+		alive[name] = struct{}{}
+	}
+
+	var failed []string
+	unreachable := map[string]time.Time{}
+	sharded := r.replacer.ShardedNodes()
+	for _, node := range sharded {
+		if _, ok := alive[node]; ok {
+			continue
+		}
+		since, ok := r.unreachableSince[node]
+		if !ok {
+			since = now
+		}
+		unreachable[node] = since
+		if now.Sub(since) >= r.grace {
+			failed = append(failed, node)
+		}
+	}
+	// nodes which are reachable again, or don't hold shards anymore, are
+	// forgotten, so the grace period starts again if they become unreachable
+	r.unreachableSince = unreachable
+
+	if len(failed) == 0 || !r.isCoordinator(alive) {
+		return
+	}
+
+	if reachable := len(sharded) - len(unreachable); 2*reachable <= len(sharded) {
+		r.logger.WithField("action", "rereplication").
+			WithField("nodes", failed).
+			WithField("reachable", reachable).
+			WithField("sharded", len(sharded)).
+			Warn("not re-creating replicas of failed nodes, as only a minority " +
+				"of the nodes is reachable")
+		return
+	}
+
+	sort.Strings(failed)
+	r.logger.WithField("action", "rereplication").
+		WithField("nodes", failed).
+		WithField("grace_period", r.grace).
+		Warn("nodes unreachable for longer than the grace period, re-creating their replicas")
+
+	if err := r.replacer.ReplaceNodes(ctx, failed); err != nil {
+		r.logger.WithField("action", "rereplication").
+			WithField("nodes", failed).
+			WithError(err).
+			Error("re-create replicas of failed nodes")
+	}
+}
+
+func (r *Rereplicator) isCoordinator(alive map[string]struct{}) bool {
+	local := r.cluster.LocalName()
+	for name := range alive {
+		if name < local {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,171 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scaler
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+func TestScalerReplaceNodes(t *testing.T) {
+	var (
+		dataDir = t.TempDir()
+		ctx     = context.Background()
+		cls     = "C"
+		bak     = backup.ClassDescriptor{
+			Name: "C",
+			Shards: []backup.ShardDescriptor{
+				{
+					Name: "S1", Files: []string{"f1"},
+					PropLengthTrackerPath: "f2",
+					ShardVersionPath:      "f2",
+					DocIDCounterPath:      "f2",
+				},
+			},
+		}
+	)
+	for _, name := range []string{"f1", "f2"} {
+		file, err := os.Create(path.Join(dataDir, name))
+		require.Nil(t, err)
+		file.Close()
+	}
+
+	t.Run("copy from surviving replica", func(t *testing.T) {
+		f := newFakeFactory()
+		f.ShardingState.M = map[string][]string{
+			"S1": {"N2", "N1"},
+			"S3": {"N3", "N4"},
+		}
+		delete(f.NodeHostMap, "N2")
+
+		f.Source.On("ShardsBackup", anyVal, anyVal, cls, []string{"S1"}).Return(bak, nil)
+		f.Client.On("CreateShard", anyVal, "H3", cls, "S1").Return(nil)
+		f.Client.On("PutFile", anyVal, "H3", cls, "S1", "f1", anyVal).Return(nil)
+		f.Client.On("PutFile", anyVal, "H3", cls, "S1", "f2", anyVal).Return(nil)
+		f.Client.On("ReInitShard", anyVal, "H3", cls, "S1").Return(nil)
+		// S3 is unaffected, its owner has nothing to copy
+		f.Client.On("IncreaseReplicationFactor", anyVal, "H3", cls,
+			ShardDist{"S3": {}}).Return(nil)
+		f.Source.On("ReleaseBackup", anyVal, anyVal, "C").Return(nil)
+
+		state, err := f.Scaler(dataDir).ReplaceNodes(ctx, cls, 2, []string{"N2"})
+		require.Nil(t, err)
+		require.NotNil(t, state)
+		assert.Equal(t, []string{"N1", "N3"}, state.Physical["S1"].BelongsToNodes)
+		assert.Equal(t, []string{"N3", "N4"}, state.Physical["S3"].BelongsToNodes)
+		f.Client.AssertExpectations(t)
+	})
+
+	t.Run("no surviving replica", func(t *testing.T) {
+		f := newFakeFactory()
+		delete(f.NodeHostMap, "N3")
+		delete(f.NodeHostMap, "N4")
+
+		state, err := f.Scaler(dataDir).ReplaceNodes(ctx, cls, 2, []string{"N3", "N4"})
+		require.Nil(t, err)
+		assert.Nil(t, state)
+	})
+
+	t.Run("unaffected nodes", func(t *testing.T) {
+		state, err := newFakeFactory().Scaler(dataDir).ReplaceNodes(ctx, cls, 2, []string{"N5"})
+		require.Nil(t, err)
+		assert.Nil(t, state)
+	})
+}
+
+type fakeNodeReplacer struct {
+	nodes    []string
+	replaced [][]string
+}
+
+func (f *fakeNodeReplacer) ShardedNodes() []string {
+	return f.nodes
+}
+
+func (f *fakeNodeReplacer) ReplaceNodes(ctx context.Context, nodes []string) error {
+	f.replaced = append(f.replaced, nodes)
+	replaced := map[string]bool{}
+	for _, node := range nodes {
+		replaced[node] = true
+	}
+	remaining := f.nodes[:0]
+	for _, node := range f.nodes {
+		if !replaced[node] {
+			remaining = append(remaining, node)
+		}
+	}
+	f.nodes = remaining
+	return nil
+}
+
+func TestRereplicator(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	ctx := context.Background()
+	grace := time.Minute
+	start := time.Now()
+
+	t.Run("replace after grace period", func(t *testing.T) {
+		cl := newFakeNodeResolver("N1", map[string]string{"N1": "H1", "N3": "H3", "N5": "H5"})
+		replacer := &fakeNodeReplacer{nodes: []string{"N1", "N2", "N3", "N4", "N5"}}
+		r := NewRereplicator(cl, replacer, grace, logger)
+
+		r.check(ctx, start)
+		r.check(ctx, start.Add(grace/2))
+		assert.Empty(t, replacer.replaced)
+
+		// N4 is back within the grace period
+		cl.M["N4"] = "H4"
+		r.check(ctx, start.Add(grace))
+		assert.Equal(t, [][]string{{"N2"}}, replacer.replaced)
+
+		// the grace period of N4 starts again
+		delete(cl.M, "N4")
+		r.check(ctx, start.Add(grace+time.Second))
+		r.check(ctx, start.Add(2*grace))
+		assert.Equal(t, [][]string{{"N2"}}, replacer.replaced)
+		r.check(ctx, start.Add(2*grace+time.Second))
+		assert.Equal(t, [][]string{{"N2"}, {"N4"}}, replacer.replaced)
+	})
+
+	t.Run("only the coordinator replaces nodes", func(t *testing.T) {
+		cl := newFakeNodeResolver("N3", map[string]string{"N1": "H1", "N3": "H3"})
+		replacer := &fakeNodeReplacer{nodes: []string{"N1", "N2", "N3"}}
+		r := NewRereplicator(cl, replacer, grace, logger)
+
+		r.check(ctx, start)
+		r.check(ctx, start.Add(grace))
+		assert.Empty(t, replacer.replaced)
+	})
+
+	t.Run("a minority doesn't replace nodes", func(t *testing.T) {
+		cl := newFakeNodeResolver("N1", map[string]string{"N1": "H1", "N2": "H2"})
+		replacer := &fakeNodeReplacer{nodes: []string{"N1", "N2", "N3", "N4"}}
+		r := NewRereplicator(cl, replacer, grace, logger)
+
+		r.check(ctx, start)
+		r.check(ctx, start.Add(grace))
+		assert.Empty(t, replacer.replaced)
+
+		// a majority is reachable again
+		cl.M["N3"] = "H3"
+		r.check(ctx, start.Add(grace+time.Second))
+		assert.Equal(t, [][]string{{"N4"}}, replacer.replaced)
+	})
+}
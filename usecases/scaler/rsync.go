@@ -18,7 +18,10 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/storobj"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -38,17 +41,41 @@ type client interface {
 	ReInitShard(ctx context.Context,
 		hostName, indexName, shardName string) error
 	IncreaseReplicationFactor(ctx context.Context, host, class string, dist ShardDist) error
+
+	// MoveReplicas moves the shards of the remote node to new owners, see
+	// Scaler.LocalMoveReplicas
+	MoveReplicas(ctx context.Context, host, class string, dist ShardDist) error
+	GetShardStatus(ctx context.Context, hostName, indexName, shardName string) (string, error)
+	UpdateShardStatus(ctx context.Context, hostName, indexName, shardName,
+		targetStatus string) error
+
+	// BatchPutObjects and DeleteObject replay the writes which happened after
+	// the files of a shard have been copied
+	BatchPutObjects(ctx context.Context, hostName, indexName, shardName string,
+		objs []*storobj.Object, repl *additional.ReplicationProperties) []error
+	DeleteObject(ctx context.Context, hostName, indexName, shardName string,
+		id strfmt.UUID) error
 }
 
+// tailBatchSize is the number of changes which are read and replayed at once
+var tailBatchSize = 100
+
 // rsync synchronizes shards with remote nodes
 type rsync struct {
 	client          client
+	source          BackUpper
 	cluster         cluster
 	persistenceRoot string
+	throttle        *throttle
 }
 
-func newRSync(c client, cl cluster, rootPath string) *rsync {
-	return &rsync{client: c, cluster: cl, persistenceRoot: rootPath}
+func newRSync(c client, source BackUpper, cl cluster, rootPath string,
+	t *throttle,
+) *rsync {
+	return &rsync{
+		client: c, source: source, cluster: cl,
+		persistenceRoot: rootPath, throttle: t,
+	}
 }
 
 // Push pushes local shards of a class to remote nodes
@@ -70,46 +97,138 @@ func (r *rsync) Push(ctx context.Context, shardsBackups []backup.ShardDescriptor
 func (r *rsync) PushShard(ctx context.Context, className string, desc backup.ShardDescriptor, nodes []string) error {
 	// Iterate over the new target nodes and copy files
 	for _, node := range nodes {
-		host, ok := r.cluster.NodeHostname(node)
-		if !ok {
-			return fmt.Errorf("%w: %q", ErrUnresolvedName, node)
-		}
-		if err := r.client.CreateShard(ctx, host, className, desc.Name); err != nil {
-			return fmt.Errorf("create new shard on remote node %q: %w", node, err)
+		if _, err := r.pushShardTo(ctx, className, desc, node); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		// Transfer each file that's part of the backup.
-		for _, file := range desc.Files {
-			err := r.PutFile(ctx, file, host, className, desc.Name)
-			if err != nil {
-				return fmt.Errorf("copy files to remote node %q: %w", node, err)
-			}
-		}
+// MoveShard copies a shard to the node which takes over its replica. Once
+// the copy has caught up with the writes, freeze is called to stop the
+// writes to the local shard, and the writes which happened in the meantime
+// are replayed as well. Afterwards the copy is identical to the local shard.
+func (r *rsync) MoveShard(ctx context.Context, className string,
+	desc backup.ShardDescriptor, node string, freeze func() error,
+) error {
+	if desc.ChangesToken == "" {
+		return fmt.Errorf("shard %q has no position in its changes feed", desc.Name)
+	}
+
+	token, err := r.pushShardTo(ctx, className, desc, node)
+	if err != nil {
+		return err
+	}
+	if err := freeze(); err != nil {
+		return fmt.Errorf("stop writes to shard %q: %w", desc.Name, err)
+	}
+
+	host, ok := r.cluster.NodeHostname(node)
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrUnresolvedName, node)
+	}
+	if _, err := r.pushChanges(ctx, className, desc.Name, token, host); err != nil {
+		return fmt.Errorf("replay writes on remote node %q: %w", node, err)
+	}
+	return nil
+}
+
+// pushShardTo copies the shard to the node and replays the writes which
+// happened since the backup was taken. It returns the position in the
+// changes feed at which the copy has caught up.
+func (r *rsync) pushShardTo(ctx context.Context, className string,
+	desc backup.ShardDescriptor, node string,
+) (string, error) {
+	host, ok := r.cluster.NodeHostname(node)
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnresolvedName, node)
+	}
+	if err := r.client.CreateShard(ctx, host, className, desc.Name); err != nil {
+		return "", fmt.Errorf("create new shard on remote node %q: %w", node, err)
+	}
 
-		// Transfer shard metadata files
-		err := r.PutFile(ctx, desc.ShardVersionPath, host, className, desc.Name)
+	// Transfer each file that's part of the backup.
+	for _, file := range desc.Files {
+		err := r.PutFile(ctx, file, host, className, desc.Name)
 		if err != nil {
-			return fmt.Errorf("copy shard version to remote node %q: %w", node, err)
+			return "", fmt.Errorf("copy files to remote node %q: %w", node, err)
 		}
+	}
 
-		err = r.PutFile(ctx, desc.DocIDCounterPath, host, className, desc.Name)
+	// Transfer shard metadata files
+	err := r.PutFile(ctx, desc.ShardVersionPath, host, className, desc.Name)
+	if err != nil {
+		return "", fmt.Errorf("copy shard version to remote node %q: %w", node, err)
+	}
+
+	err = r.PutFile(ctx, desc.DocIDCounterPath, host, className, desc.Name)
+	if err != nil {
+		return "", fmt.Errorf("copy index counter to remote node %q: %w", node, err)
+	}
+
+	err = r.PutFile(ctx, desc.PropLengthTrackerPath, host, className, desc.Name)
+	if err != nil {
+		return "", fmt.Errorf("copy prop length tracker to remote node %q: %w", node, err)
+	}
+
+	// Now that all files are on the remote node's new shard, the shard needs
+	// to be reinitialized. Otherwise, it would not recognize the files when
+	// serving traffic later.
+	if err := r.client.ReInitShard(ctx, host, className, desc.Name); err != nil {
+		return "", fmt.Errorf("create new shard on remote node %q: %w", node, err)
+	}
+
+	if desc.ChangesToken == "" {
+		// the backup doesn't know its position in the feed
+		return "", nil
+	}
+	token, err := r.pushChanges(ctx, className, desc.Name, desc.ChangesToken, host)
+	if err != nil {
+		return "", fmt.Errorf("replay writes on remote node %q: %w", node, err)
+	}
+	return token, nil
+}
+
+// pushChanges replays the writes which the shard received after the token
+// on the copy, until the copy has caught up. The writes are read from the
+// changes feed of the shard, which contains every object in its latest
+// version only. Deletions are replayed first, as an object which has been
+// deleted since the write is not part of the feed anymore. It returns the
+// token at which the copy has caught up.
+func (r *rsync) pushChanges(ctx context.Context, className, shardName,
+	token, host string,
+) (string, error) {
+	for {
+		res, err := r.source.ChangesSince(ctx, className, shardName, token, tailBatchSize)
 		if err != nil {
-			return fmt.Errorf("copy index counter to remote node %q: %w", node, err)
+			return "", fmt.Errorf("read changes of shard %q: %w", shardName, err)
 		}
 
-		err = r.PutFile(ctx, desc.PropLengthTrackerPath, host, className, desc.Name)
-		if err != nil {
-			return fmt.Errorf("copy prop length tracker to remote node %q: %w", node, err)
+		for _, deletion := range res.Deletions {
+			if err := r.client.DeleteObject(ctx, host, className, shardName,
+				deletion.ID); err != nil {
+				return "", fmt.Errorf("delete object %s: %w", deletion.ID, err)
+			}
+		}
+
+		if len(res.Objects) > 0 {
+			objs := make([]*storobj.Object, len(res.Objects))
+			for i, obj := range res.Objects {
+				objs[i] = storobj.FromObject(obj, obj.Vector)
+			}
+			for i, err := range r.client.BatchPutObjects(ctx, host, className,
+				shardName, objs, nil) {
+				if err != nil {
+					return "", fmt.Errorf("put object %s: %w", objs[i].ID(), err)
+				}
+			}
 		}
 
-		// Now that all files are on the remote node's new shard, the shard needs
-		// to be reinitialized. Otherwise, it would not recognize the files when
-		// serving traffic later.
-		if err := r.client.ReInitShard(ctx, host, className, desc.Name); err != nil {
-			return fmt.Errorf("create new shard on remote node %q: %w", node, err)
+		if res.Next == token {
+			return token, nil
 		}
+		token = res.Next
 	}
-	return nil
 }
 
 func (r *rsync) PutFile(ctx context.Context, sourceFileName string,
@@ -121,5 +240,6 @@ func (r *rsync) PutFile(ctx context.Context, sourceFileName string,
 		return fmt.Errorf("open file %q for reading: %w", absPath, err)
 	}
 
-	return r.client.PutFile(ctx, hostname, className, shardName, sourceFileName, f)
+	return r.client.PutFile(ctx, hostname, className, shardName, sourceFileName,
+		r.throttle.reader(ctx, f))
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/changes"
 	"github.com/weaviate/weaviate/usecases/sharding"
 	"golang.org/x/sync/errgroup"
 )
@@ -45,6 +46,9 @@ type Scaler struct {
 	client          client    // client for remote nodes
 	logger          logrus.FieldLogger
 	persistenceRoot string
+	// throttle limits the rate with which shard files are sent, nil if
+	// unlimited
+	throttle *throttle
 }
 
 // New returns a new instance of Scaler
@@ -66,6 +70,10 @@ type BackUpper interface {
 	ShardsBackup(_ context.Context, id, class string, shards []string) (backup.ClassDescriptor, error)
 	// ReleaseBackup releases the backup specified by its id
 	ReleaseBackup(ctx context.Context, id, className string) error
+	// ChangesSince returns the writes to a local shard starting at the token
+	// of a shard backup
+	ChangesSince(ctx context.Context, class, shard, token string,
+		limit int) (*changes.Changes, error)
 }
 
 // cluster is used by the scaler to query cluster
@@ -87,6 +95,12 @@ func (s *Scaler) SetSchemaManager(sm SchemaManager) {
 	s.schema = sm
 }
 
+// SetTransferLimit limits the rate with which the files of local shards are
+// streamed to other nodes, 0 is unlimited
+func (s *Scaler) SetTransferLimit(bytesPerSecond int) {
+	s.throttle = newThrottle(bytesPerSecond)
+}
+
 // Scale increase/decrease class replicas.
 //
 // It returns the updated sharding state if successful. The caller must then
@@ -130,19 +144,41 @@ func (s *Scaler) scaleOut(ctx context.Context, className string, ssBefore *shard
 
 	// Identify all shards of the class and adjust the replicas. After this is
 	// done, the affected shards now belong to more nodes than they did before.
+	placement, err := updated.Placement(s.cluster)
+	if err != nil {
+		return nil, err
+	}
 	for name, shard := range ssAfter.Physical {
-		if err := shard.AdjustReplicas(int(replFactor), s.cluster); err != nil {
+		if err := shard.AdjustReplicas(int(replFactor), placement); err != nil {
 			return nil, err
 		}
 		ssAfter.Physical[name] = shard
 	}
-	lDist, nodeDist := distributions(ssBefore, &ssAfter)
+	if err := s.replicate(ctx, className, ssBefore, &ssAfter); err != nil {
+		return nil, err
+	}
+
+	// Finally, return sharding state back to schema manager. The schema manager
+	// will then broadcast this updated state to the cluster. This is essentially
+	// what will take the new replication shards live: On the new nodes, if
+	// traffic is incoming, IsShardLocal() would have returned false before. But
+	// now that a copy of the local shard is present it will return true and
+	// serve the traffic.
+	return &ssAfter, nil
+}
+
+// replicate copies the shards to the nodes which they belong to after, but
+// not before. The first node of a shard before is the source of the copy.
+func (s *Scaler) replicate(ctx context.Context, className string,
+	ssBefore, ssAfter *sharding.State,
+) error {
+	lDist, nodeDist := distributions(ssBefore, ssAfter)
 	g, ctx := errgroup.WithContext(ctx)
 	// resolve hosts beforehand
 	nodes := nodeDist.nodes()
 	hosts, err := hosts(nodes, s.cluster)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	for i, node := range nodes {
 		dist := nodeDist[node]
@@ -162,17 +198,7 @@ func (s *Scaler) scaleOut(ctx context.Context, className string, ssBefore *shard
 		}
 		return nil
 	})
-	if err := g.Wait(); err != nil {
-		return nil, err
-	}
-
-	// Finally, return sharding state back to schema manager. The schema manager
-	// will then broadcast this updated state to the cluster. This is essentially
-	// what will take the new replication shards live: On the new nodes, if
-	// traffic is incoming, IsShardLocal() would have returned false before. But
-	// now that a copy of the local shard is present it will return true and
-	// serve the traffic.
-	return &ssAfter, nil
+	return g.Wait()
 }
 
 // LocalScaleOut syncs local shards with new replicas.
@@ -185,6 +211,7 @@ func (s *Scaler) scaleOut(ctx context.Context, className string, ssBefore *shard
 //   - Create an empty shard on the target node
 //   - Copy over all files from the backup
 //   - ReInit the shard to recognize the copied files
+//   - Replay the writes which happened after the backup was taken
 //   - Release the single-shard backup
 func (s *Scaler) LocalScaleOut(ctx context.Context,
 	className string, dist ShardDist,
@@ -205,7 +232,7 @@ func (s *Scaler) LocalScaleOut(ctx context.Context,
 			s.logger.WithField("scaler", "releaseBackup").WithField("class", className).Error(err)
 		}
 	}()
-	rsync := newRSync(s.client, s.cluster, s.persistenceRoot)
+	rsync := newRSync(s.client, s.source, s.cluster, s.persistenceRoot, s.throttle)
 	return rsync.Push(ctx, bak.Shards, dist, className)
 }
 
@@ -18,8 +18,13 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/changes"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
@@ -167,4 +172,72 @@ func TestScalerScaleOut(t *testing.T) {
 		_, err := scaler.Scale(ctx, "C", old, 1, 3)
 		assert.Nil(t, err)
 	})
+
+	t.Run("ReplayWritesAfterBackup", func(t *testing.T) {
+		f := newFakeFactory()
+		withToken := backup.ClassDescriptor{Name: "C", Shards: []backup.ShardDescriptor{bak.Shards[0]}}
+		withToken.Shards[0].ChangesToken = "a"
+		f.Source.On("ShardsBackup", anyVal, anyVal, cls, []string{"S1"}).Return(withToken, nil)
+
+		written := &models.Object{
+			Class:  cls,
+			ID:     "8d1c3ad4-2b8e-4d8e-9b3f-6a3e7cfd3c61",
+			Vector: []float32{1, 2},
+		}
+		deleted := strfmt.UUID("8d1c3ad4-2b8e-4d8e-9b3f-6a3e7cfd3c62")
+		f.Source.On("ChangesSince", anyVal, cls, "S1", "a", tailBatchSize).Return(&changes.Changes{
+			Objects:   []*models.Object{written},
+			Deletions: []changes.Deletion{{ID: deleted}},
+			Next:      "b",
+		}, nil)
+		f.Source.On("ChangesSince", anyVal, cls, "S1", "b", tailBatchSize).Return(&changes.Changes{
+			Next: "b",
+		}, nil)
+
+		isWritten := mock.MatchedBy(func(objs []*storobj.Object) bool {
+			return len(objs) == 1 && objs[0].ID() == written.ID &&
+				assert.ObjectsAreEqual([]float32(written.Vector), objs[0].Vector)
+		})
+		for _, host := range []string{"H2", "H3"} {
+			f.Client.On("CreateShard", anyVal, host, cls, "S1").Return(nil)
+			f.Client.On("PutFile", anyVal, host, cls, "S1", "f1", anyVal).Return(nil)
+			f.Client.On("PutFile", anyVal, host, cls, "S1", "f4", anyVal).Return(nil)
+			f.Client.On("ReInitShard", anyVal, host, cls, "S1").Return(nil)
+			f.Client.On("DeleteObject", anyVal, host, cls, "S1", deleted).Return(nil).Once()
+			f.Client.On("BatchPutObjects", anyVal, host, cls, "S1", isWritten, anyVal).
+				Return([]error{nil}).Once()
+		}
+
+		f.Client.On("IncreaseReplicationFactor", anyVal, "H3", cls, anyVal, anyVal).Return(nil)
+		f.Source.On("ReleaseBackup", anyVal, anyVal, "C").Return(nil)
+
+		scaler := f.Scaler(dataDir)
+		_, err := scaler.Scale(ctx, "C", old, 1, 3)
+		assert.Nil(t, err)
+		f.Client.AssertExpectations(t)
+		f.Source.AssertExpectations(t)
+	})
+
+	t.Run("ReplayWritesFails", func(t *testing.T) {
+		f := newFakeFactory()
+		withToken := backup.ClassDescriptor{Name: "C", Shards: []backup.ShardDescriptor{bak.Shards[0]}}
+		withToken.Shards[0].ChangesToken = "a"
+		f.Source.On("ShardsBackup", anyVal, anyVal, cls, []string{"S1"}).Return(withToken, nil)
+		f.Source.On("ChangesSince", anyVal, cls, "S1", "a", tailBatchSize).
+			Return((*changes.Changes)(nil), changes.ErrExpired)
+
+		for _, host := range []string{"H2", "H3"} {
+			f.Client.On("CreateShard", anyVal, host, cls, "S1").Return(nil)
+			f.Client.On("PutFile", anyVal, host, cls, "S1", "f1", anyVal).Return(nil)
+			f.Client.On("PutFile", anyVal, host, cls, "S1", "f4", anyVal).Return(nil)
+			f.Client.On("ReInitShard", anyVal, host, cls, "S1").Return(nil)
+		}
+
+		f.Client.On("IncreaseReplicationFactor", anyVal, "H3", cls, anyVal, anyVal).Return(nil)
+		f.Source.On("ReleaseBackup", anyVal, anyVal, "C").Return(nil)
+
+		scaler := f.Scaler(dataDir)
+		_, err := scaler.Scale(ctx, "C", old, 1, 3)
+		assert.ErrorIs(t, err, changes.ErrExpired)
+	})
 }
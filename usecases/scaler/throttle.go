@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scaler
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// throttle limits the rate with which shard files are streamed to other
+// nodes. It is shared by all transfers of the node. The limit allows bursts
+// of up to one second worth of bytes.
+type throttle struct {
+	sync.Mutex
+	bytesPerSecond float64
+	tokens         float64
+	lastRefill     time.Time
+}
+
+func newThrottle(bytesPerSecond int) *throttle {
+	if bytesPerSecond <= 0 {
+		return nil
+	}
+	return &throttle{bytesPerSecond: float64(bytesPerSecond), lastRefill: time.Now()}
+}
+
+// wait blocks until n more bytes may be sent
+func (t *throttle) wait(ctx context.Context, n int) error {
+	if t == nil {
+		return nil
+	}
+
+	t.Lock()
+	now := time.Now()
+	t.tokens += now.Sub(t.lastRefill).Seconds() * t.bytesPerSecond
+	if t.tokens > t.bytesPerSecond {
+		t.tokens = t.bytesPerSecond
+	}
+	t.lastRefill = now
+	t.tokens -= float64(n)
+
+	var wait time.Duration
+	if t.tokens < 0 {
+		wait = time.Duration(-t.tokens / t.bytesPerSecond * float64(time.Second))
+	}
+	t.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// reader limits the rate with which r is read
+func (t *throttle) reader(ctx context.Context, r io.ReadSeekCloser) io.ReadSeekCloser {
+	if t == nil {
+		return r
+	}
+	return &throttledReader{ReadSeekCloser: r, ctx: ctx, throttle: t}
+}
+
+type throttledReader struct {
+	io.ReadSeekCloser
+	ctx      context.Context
+	throttle *throttle
+}
+
+func (r *throttledReader) Read(p []byte) (int, error) {
+	n, err := r.ReadSeekCloser.Read(p)
+	if n > 0 {
+		if werr := r.throttle.wait(r.ctx, n); werr != nil {
+			return n, werr
+		}
+	}
+	return n, err
+}
@@ -23,6 +23,8 @@ import (
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/replica"
@@ -44,8 +46,12 @@ func (m *Manager) AddClass(ctx context.Context, principal *models.Principal,
 	}
 
 	// call to migrator needs to be outside the lock that is set in addClass
-	return m.migrator.AddClass(ctx, class, shardState)
-	// TODO gh-846: Rollback state upate if migration fails
+	if err := m.migrator.AddClass(ctx, class, shardState); err != nil {
+		return err
+		// TODO gh-846: Rollback state upate if migration fails
+	}
+
+	return m.ensureChunkClass(ctx, principal, class)
 }
 
 func (m *Manager) RestoreClass(ctx context.Context, d *backup.ClassDescriptor) error {
@@ -211,11 +217,30 @@ func (m *Manager) setClassDefaults(class *models.Class) {
 		}
 	}
 
+	for name, cfg := range class.VectorConfig {
+		if cfg.VectorIndexType == "" {
+			cfg.VectorIndexType = "hnsw"
+		}
+		if m.config.DefaultVectorDistanceMetric != "" {
+			if cfg.VectorIndexConfig == nil {
+				cfg.VectorIndexConfig = map[string]interface{}{"distance": m.config.DefaultVectorDistanceMetric}
+			} else if asMap, ok := cfg.VectorIndexConfig.(map[string]interface{}); ok && asMap["distance"] == nil {
+				asMap["distance"] = m.config.DefaultVectorDistanceMetric
+			}
+		}
+		class.VectorConfig[name] = cfg
+	}
+
 	setInvertedConfigDefaults(class)
 	for _, prop := range class.Properties {
 		m.setPropertyDefaults(prop)
 	}
 
+	if class.ChunkingConfig != nil {
+		class.ChunkingConfig.ChunkClass = schema.UppercaseClassName(
+			class.ChunkingConfig.ChunkClass)
+	}
+
 	m.moduleConfig.SetClassDefaults(class)
 }
 
@@ -259,6 +284,10 @@ func (m *Manager) validateCanAddClass(
 		existingPropertyNames[strings.ToLower(property.Name)] = true
 	}
 
+	if err := m.validateReferenceSnapshots(class, relaxCrossRefValidation); err != nil {
+		return err
+	}
+
 	if err := m.validateVectorSettings(ctx, class); err != nil {
 		return err
 	}
@@ -271,6 +300,40 @@ func (m *Manager) validateCanAddClass(
 		return err
 	}
 
+	if err := validateBoostConfig(class); err != nil {
+		return err
+	}
+
+	if err := validateBM25PropertyBoosts(class); err != nil {
+		return err
+	}
+
+	if err := validateIDGenerationConfig(class); err != nil {
+		return err
+	}
+
+	if err := validateObjectTTL(class); err != nil {
+		return err
+	}
+
+	if err := validateIngestConfig(class); err != nil {
+		return err
+	}
+
+	if err := validateVersioningConfig(class); err != nil {
+		return err
+	}
+
+	if err := validateChunkingConfig(class); err != nil {
+		return err
+	}
+
+	if !relaxCrossRefValidation {
+		if err := m.validateChunkClass(class); err != nil {
+			return err
+		}
+	}
+
 	// all is fine!
 	return nil
 }
@@ -304,6 +367,18 @@ func (m *Manager) validateProperty(
 		return err
 	}
 
+	if err := validatePropertyIndexOnly(property, propertyDataType); err != nil {
+		return err
+	}
+
+	if err := validateLanguageDetection(property, propertyDataType); err != nil {
+		return err
+	}
+
+	if err := validateBM25Pruning(property, propertyDataType); err != nil {
+		return err
+	}
+
 	// all is fine!
 	return nil
 }
@@ -311,22 +386,53 @@ func (m *Manager) validateProperty(
 func (m *Manager) parseVectorIndexConfig(ctx context.Context,
 	class *models.Class,
 ) error {
-	if class.VectorIndexType != "hnsw" {
-		return errors.Errorf(
-			"parse vector index config: unsupported vector index type: %q",
-			class.VectorIndexType)
-	}
-
-	parsed, err := m.hnswConfigParser(class.VectorIndexConfig)
+	parsed, err := m.parseVectorIndexConfigOfType(class.VectorIndexType,
+		class.VectorIndexConfig)
 	if err != nil {
-		return errors.Wrap(err, "parse vector index config")
+		return err
 	}
 
 	class.VectorIndexConfig = parsed
 
+	for name, cfg := range class.VectorConfig {
+		parsed, err := m.parseVectorIndexConfigOfType(cfg.VectorIndexType,
+			cfg.VectorIndexConfig)
+		if err != nil {
+			return errors.Wrapf(err, "vectorConfig %q", name)
+		}
+
+		cfg.VectorIndexConfig = parsed
+		class.VectorConfig[name] = cfg
+	}
+
 	return nil
 }
 
+func (m *Manager) parseVectorIndexConfigOfType(indexType string,
+	config interface{},
+) (schema.VectorIndexConfig, error) {
+	var parser VectorConfigParser
+	switch indexType {
+	case "hnsw":
+		parser = m.hnswConfigParser
+	case flat.IndexType:
+		parser = flat.ParseAndValidateConfig
+	case dynamic.IndexType:
+		parser = dynamic.ParseAndValidateConfig
+	default:
+		return nil, errors.Errorf(
+			"parse vector index config: unsupported vector index type: %q",
+			indexType)
+	}
+
+	parsed, err := parser(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse vector index config")
+	}
+
+	return parsed, nil
+}
+
 func (m *Manager) parseShardingConfig(ctx context.Context,
 	class *models.Class,
 ) error {
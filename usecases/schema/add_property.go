@@ -45,6 +45,11 @@ func (m *Manager) addClassProperty(ctx context.Context,
 		return err
 	}
 	prop.Name = schema.LowercaseFirstLetter(prop.Name)
+	if running := m.runningMigration(className); running != nil &&
+		strings.EqualFold(running.Migration.NewName, prop.Name) {
+		return fmt.Errorf("class %q: conflict for property %q: migration %q renames "+
+			"a property to it", className, prop.Name, running.Migration.ID)
+	}
 
 	if err := m.setNewPropDefaults(class, prop); err != nil {
 		return err
@@ -57,6 +62,13 @@ func (m *Manager) addClassProperty(ctx context.Context,
 	if err := m.validateProperty(prop, className, existingPropertyNames, false); err != nil {
 		return err
 	}
+	withProp := &models.Class{
+		Class:      class.Class,
+		Properties: append(class.Properties[:len(class.Properties):len(class.Properties)], prop),
+	}
+	if err := m.validateReferenceSnapshots(withProp, false); err != nil {
+		return err
+	}
 
 	tx, err := m.cluster.BeginTransaction(ctx, AddProperty,
 		AddPropertyPayload{className, prop}, DefaultTxTTL)
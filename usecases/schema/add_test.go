@@ -17,6 +17,9 @@ import (
 
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/usecases/config"
 
 	"github.com/stretchr/testify/assert"
@@ -30,6 +33,111 @@ func TestAddClass(t *testing.T) {
 		require.EqualError(t, err, "'' is not a valid class name")
 	})
 
+	t.Run("with flat vector index", func(t *testing.T) {
+		mgr := newSchemaManager()
+
+		err := mgr.AddClass(context.Background(),
+			nil, &models.Class{
+				Class:             "NewClass",
+				VectorIndexType:   "flat",
+				VectorIndexConfig: map[string]interface{}{"distance": "dot"},
+			})
+		require.Nil(t, err)
+
+		require.NotEmpty(t, mgr.state.ObjectSchema.Classes)
+		expected := flat.UserConfig{Distance: "dot"}
+		require.Equal(t, expected, mgr.state.ObjectSchema.Classes[0].VectorIndexConfig)
+	})
+
+	t.Run("with dynamic vector index", func(t *testing.T) {
+		mgr := newSchemaManager()
+
+		err := mgr.AddClass(context.Background(),
+			nil, &models.Class{
+				Class:           "NewClass",
+				VectorIndexType: "dynamic",
+				VectorIndexConfig: map[string]interface{}{
+					"distance":  "dot",
+					"threshold": float64(100),
+				},
+			})
+		require.Nil(t, err)
+
+		require.NotEmpty(t, mgr.state.ObjectSchema.Classes)
+		parsed, ok := mgr.state.ObjectSchema.Classes[0].VectorIndexConfig.(dynamic.UserConfig)
+		require.True(t, ok)
+		assert.Equal(t, 100, parsed.Threshold)
+		assert.Equal(t, "dot", parsed.HNSW.Distance)
+		assert.Equal(t, flat.UserConfig{Distance: "dot"}, parsed.Flat)
+	})
+
+	t.Run("with chunking creates the chunk class", func(t *testing.T) {
+		mgr := newSchemaManager()
+
+		err := mgr.AddClass(context.Background(), nil, &models.Class{
+			Class:      "Document",
+			Properties: []*models.Property{{Name: "body", DataType: []string{"text"}}},
+			ChunkingConfig: &models.ChunkingConfig{
+				ChunkClass: "documentChunk",
+				Properties: []string{"body"},
+			},
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, "DocumentChunk", mgr.getClassByName("Document").ChunkingConfig.ChunkClass)
+		chunkClass := mgr.getClassByName("DocumentChunk")
+		require.NotNil(t, chunkClass)
+		require.Len(t, chunkClass.Properties, 5)
+		parent, err := schema.GetPropertyByName(chunkClass, "parent")
+		require.Nil(t, err)
+		assert.Equal(t, []string{"Document"}, parent.DataType)
+	})
+
+	t.Run("with chunking completes an existing chunk class", func(t *testing.T) {
+		mgr := newSchemaManager()
+		require.Nil(t, mgr.AddClass(context.Background(), nil, &models.Class{
+			Class:      "Chunk",
+			Properties: []*models.Property{{Name: "text", DataType: []string{"text"}}},
+		}))
+
+		err := mgr.AddClass(context.Background(), nil, &models.Class{
+			Class:      "Document",
+			Properties: []*models.Property{{Name: "body", DataType: []string{"text"}}},
+			ChunkingConfig: &models.ChunkingConfig{
+				ChunkClass: "Chunk",
+				Properties: []string{"body"},
+			},
+		})
+		require.Nil(t, err)
+		assert.Len(t, mgr.getClassByName("Chunk").Properties, 5)
+	})
+
+	t.Run("with chunking into an incompatible class", func(t *testing.T) {
+		mgr := newSchemaManager()
+		require.Nil(t, mgr.AddClass(context.Background(), nil, &models.Class{
+			Class:      "Chunk",
+			Properties: []*models.Property{{Name: "chunkIndex", DataType: []string{"text"}}},
+		}))
+
+		err := mgr.AddClass(context.Background(), nil, &models.Class{
+			Class:      "Document",
+			Properties: []*models.Property{{Name: "body", DataType: []string{"text"}}},
+			ChunkingConfig: &models.ChunkingConfig{
+				ChunkClass: "Chunk",
+				Properties: []string{"body"},
+			},
+		})
+		assert.ErrorContains(t, err, `property "chunkIndex" of class "Chunk" must be of type [int]`)
+		assert.Nil(t, mgr.getClassByName("Document"))
+	})
+
+	t.Run("with unknown vector index type", func(t *testing.T) {
+		err := newSchemaManager().AddClass(context.Background(),
+			nil, &models.Class{Class: "NewClass", VectorIndexType: "ivf"})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "unsupported vectorIndexType")
+	})
+
 	t.Run("with default BM25 params", func(t *testing.T) {
 		mgr := newSchemaManager()
 
@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"reflect"
 	"testing"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/network/ipfilter"
 )
 
 // A component-test like test suite that makes sure that every available UC is
@@ -54,6 +56,12 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:     "list",
 			expectedResource: "schema/className/shards",
 		},
+		{
+			methodName:       "TokenizeProperty",
+			additionalArgs:   []interface{}{"className", "propName", "text"},
+			expectedVerb:     "list",
+			expectedResource: "schema/*",
+		},
 		{
 			methodName:       "AddClass",
 			additionalArgs:   []interface{}{&models.Class{}},
@@ -62,7 +70,7 @@ func Test_Schema_Authorization(t *testing.T) {
 		},
 		{
 			methodName:       "UpdateClass",
-			additionalArgs:   []interface{}{"somename", &models.Class{}},
+			additionalArgs:   []interface{}{"somename", &models.Class{}, false},
 			expectedVerb:     "update",
 			expectedResource: "schema/objects",
 		},
@@ -74,7 +82,7 @@ func Test_Schema_Authorization(t *testing.T) {
 		},
 		{
 			methodName:       "DeleteClass",
-			additionalArgs:   []interface{}{"somename", false},
+			additionalArgs:   []interface{}{"somename", false, false},
 			expectedVerb:     "delete",
 			expectedResource: "schema/objects",
 		},
@@ -96,6 +104,59 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:     "update",
 			expectedResource: "schema/className/shards/shardName",
 		},
+		{
+			methodName:       "OptimizeClass",
+			additionalArgs:   []interface{}{"className"},
+			expectedVerb:     "update",
+			expectedResource: "schema/className/shards",
+		},
+		{
+			methodName:       "CleanupInvertedIndex",
+			additionalArgs:   []interface{}{"className"},
+			expectedVerb:     "update",
+			expectedResource: "schema/className/shards",
+		},
+		{
+			methodName:       "ReplayClass",
+			additionalArgs:   []interface{}{"className", &models.ClassReplayRequest{}},
+			expectedVerb:     "update",
+			expectedResource: "schema/className/shards",
+		},
+		{
+			methodName:       "StartMigration",
+			additionalArgs:   []interface{}{"className", &models.SchemaMigration{}},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
+		{
+			methodName:       "ListMigrations",
+			additionalArgs:   []interface{}{"className"},
+			expectedVerb:     "list",
+			expectedResource: "schema/*",
+		},
+		{
+			methodName:       "ListTrash",
+			expectedVerb:     "list",
+			expectedResource: "schema/*",
+		},
+		{
+			methodName:       "RestoreTrashedClass",
+			additionalArgs:   []interface{}{"someid"},
+			expectedVerb:     "create",
+			expectedResource: "schema/objects",
+		},
+		{
+			methodName:       "GetNetworkAccess",
+			additionalArgs:   []interface{}{"api"},
+			expectedVerb:     "get",
+			expectedResource: "network-access/api",
+		},
+		{
+			methodName:       "UpdateNetworkAccess",
+			additionalArgs:   []interface{}{"api", ipfilter.Rules{}, net.ParseIP("10.0.0.1")},
+			expectedVerb:     "update",
+			expectedResource: "network-access/api",
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
@@ -110,7 +171,9 @@ func Test_Schema_Authorization(t *testing.T) {
 				"UpdateMeta", "GetSchemaSkipAuth", "IndexedInverted", "RLock", "RUnlock", "Lock", "Unlock",
 				"TryLock", "RLocker", "TryRLock", // introduced by sync.Mutex in go 1.18
 				"Nodes", "NodeName", "ClusterHealthScore", "ResolveParentNodes",
-				"ShardingState", "TxManager", "RestoreClass":
+				"ShardingState", "TxManager", "RestoreClass", "ShardedNodes", "ReplaceNodes",
+				"PurgeExpiredTrash", "PurgeTrashPeriodically", "RebalanceShards",
+				"ResumeMigrations", "SetNetworkAccess":
 				// don't require auth on methods which are exported because other
 				// packages need to call them for maintenance and other regular jobs,
 				// but aren't user facing
@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// validateChunkClass makes sure that an existing chunk class of a class can
+// hold its chunks. Missing chunk properties are added by ensureChunkClass.
+func (m *Manager) validateChunkClass(class *models.Class) error {
+	if class.ChunkingConfig == nil {
+		return nil
+	}
+
+	chunkClass := m.getClassByName(class.ChunkingConfig.ChunkClass)
+	if chunkClass == nil {
+		return nil
+	}
+	if chunkClass.ChunkingConfig != nil {
+		return fmt.Errorf("chunkingConfig.chunkClass: class %q chunks its own "+
+			"properties, chunks can't be chunked again", chunkClass.Class)
+	}
+	for _, prop := range schema.ChunkProperties(class.Class) {
+		existing, err := schema.GetPropertyByName(chunkClass, prop.Name)
+		if err != nil {
+			continue
+		}
+		if !reflect.DeepEqual(existing.DataType, prop.DataType) {
+			return fmt.Errorf("chunkingConfig.chunkClass: property %q of class %q "+
+				"must be of type %v, got %v", prop.Name, chunkClass.Class,
+				prop.DataType, existing.DataType)
+		}
+	}
+	return nil
+}
+
+// ensureChunkClass creates the chunk class of a class which chunks its text
+// properties, or adds the missing chunk properties to an existing one. A new
+// chunk class is vectorized like the class itself.
+func (m *Manager) ensureChunkClass(ctx context.Context,
+	principal *models.Principal, class *models.Class,
+) error {
+	if class.ChunkingConfig == nil {
+		return nil
+	}
+
+	name := class.ChunkingConfig.ChunkClass
+	chunkClass := m.getClassByName(name)
+	if chunkClass == nil {
+		err := m.AddClass(ctx, principal, &models.Class{
+			Class:        name,
+			Description:  fmt.Sprintf("Chunks of the text properties of %s", class.Class),
+			Vectorizer:   class.Vectorizer,
+			ModuleConfig: class.ModuleConfig,
+			Properties:   schema.ChunkProperties(class.Class),
+		})
+		if err != nil {
+			return fmt.Errorf("add chunk class %q: %w", name, err)
+		}
+		return nil
+	}
+
+	for _, prop := range schema.ChunkProperties(class.Class) {
+		if _, err := schema.GetPropertyByName(chunkClass, prop.Name); err == nil {
+			continue
+		}
+		if err := m.AddClassProperty(ctx, principal, name, prop); err != nil {
+			return fmt.Errorf("add property %q to chunk class %q: %w",
+				prop.Name, name, err)
+		}
+	}
+	return nil
+}
@@ -14,27 +14,48 @@ package schema
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
-// DeleteClass from the schema
-func (m *Manager) DeleteClass(ctx context.Context, principal *models.Principal, class string, force bool) error {
+// DeleteClass from the schema. Classes with deletion protection are only
+// deleted if overrideProtection is set.
+func (m *Manager) DeleteClass(ctx context.Context, principal *models.Principal,
+	class string, force, overrideProtection bool,
+) error {
 	err := m.Authorizer.Authorize(principal, "delete", "schema/objects")
 	if err != nil {
 		return err
 	}
 
-	return m.deleteClass(ctx, class, force)
+	return m.deleteClass(ctx, class, force, overrideProtection)
 }
 
-func (m *Manager) deleteClass(ctx context.Context, className string, force bool) error {
+func (m *Manager) deleteClass(ctx context.Context, className string,
+	force, overrideProtection bool,
+) error {
 	m.Lock()
 	defer m.Unlock()
 
-	tx, err := m.cluster.BeginTransaction(ctx, DeleteClass,
-		DeleteClassPayload{className, force}, DefaultTxTTL)
+	if err := validateDeletionProtection(m.getClassByName(className),
+		overrideProtection); err != nil {
+		return err
+	}
+	if running := m.runningMigration(className); running != nil {
+		return fmt.Errorf("class %q can't be deleted while migration %q is running",
+			className, running.Migration.ID)
+	}
+
+	payload := DeleteClassPayload{ClassName: className, Force: force}
+	if m.config.RecycleBin.Enabled() {
+		now := time.Now().UnixMilli()
+		payload.TrashID = fmt.Sprintf("%s-%d", className, now)
+		payload.DeletionTime = now
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, DeleteClass, payload, DefaultTxTTL)
 	if err != nil {
 		// possible causes for errors could be nodes down (we expect every node to
 		// be up for a schema transaction) or concurrent transactions from other
@@ -59,12 +80,16 @@ func (m *Manager) deleteClass(ctx context.Context, className string, force bool)
 		m.logger.WithError(err).Errorf("not every node was able to commit")
 	}
 
-	return m.deleteClassApplyChanges(ctx, className, force)
+	return m.deleteClassApplyChanges(ctx, payload)
 }
 
+// deleteClassApplyChanges removes the class from the schema and drops its
+// index. If the payload has a trash ID, the class and its data are moved into
+// the recycle bin instead.
 func (m *Manager) deleteClassApplyChanges(ctx context.Context,
-	className string, force bool,
+	pl DeleteClassPayload,
 ) error {
+	className, force := pl.ClassName, pl.Force
 	sch := m.state.ObjectSchema
 	classIdx := -1
 	for idx, class := range sch.Classes {
@@ -78,7 +103,23 @@ func (m *Manager) deleteClassApplyChanges(ctx context.Context,
 		return fmt.Errorf("could not find class '%s'", className)
 	}
 
+	var trashed *TrashedClass
+	if classIdx > -1 && pl.TrashID != "" {
+		m.shardingStateLock.RLock()
+		trashed = &TrashedClass{
+			ID:            pl.TrashID,
+			Class:         sch.Classes[classIdx],
+			ShardingState: m.state.ShardingState[className],
+			DeletionTime:  pl.DeletionTime,
+		}
+		m.shardingStateLock.RUnlock()
+		// the entry is kept even if moving the files fails, so that whatever
+		// has been moved is removed once the retention ends
+		m.state.Trash = append(m.state.Trash, trashed)
+	}
+
 	if classIdx > -1 {
+		m.removeMigrations(className)
 		// make sure not to delete another class if the force flag is set, but the class does not exist
 		sch.Classes[classIdx] = sch.Classes[len(sch.Classes)-1]
 		sch.Classes[len(sch.Classes)-1] = nil // to prevent leaking this pointer.
@@ -90,7 +131,11 @@ func (m *Manager) deleteClassApplyChanges(ctx context.Context,
 		return err
 	}
 
-	err = m.migrator.DropClass(ctx, className)
+	if trashed != nil {
+		err = m.migrator.TrashClass(ctx, className, trashed.ID)
+	} else {
+		err = m.migrator.DropClass(ctx, className)
+	}
 	if err != nil {
 		if !force {
 			return err
@@ -102,7 +102,7 @@ func TestForceDelete(t *testing.T) {
 
 			sm, err := newManagerWithClusterAndTx(t, clusterState, txClient, initialSchema)
 			require.Nil(t, err)
-			err = sm.DeleteClass(context.Background(), nil, test.classToDelete, test.force)
+			err = sm.DeleteClass(context.Background(), nil, test.classToDelete, test.force, false)
 
 			if test.expErr {
 				require.NotNil(t, err, "opeartion should have errord")
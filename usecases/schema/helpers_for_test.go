@@ -118,6 +118,8 @@ func (f *fakeModuleConfig) ValidateClass(ctx context.Context, class *models.Clas
 type fakeClusterState struct {
 	hosts       []string
 	syncIgnored bool
+	// addresses of the nodes by name
+	addresses map[string]string
 }
 
 func (f *fakeClusterState) SchemaSyncIgnored() bool {
@@ -149,8 +151,9 @@ func (f *fakeClusterState) ResolveParentNodes(string, string,
 	return nil, nil, nil
 }
 
-func (f *fakeClusterState) NodeHostname(string) (string, bool) {
-	return "", false
+func (f *fakeClusterState) NodeHostname(name string) (string, bool) {
+	addr, ok := f.addresses[name]
+	return addr, ok
 }
 
 type fakeTxClient struct {
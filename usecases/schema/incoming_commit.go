@@ -28,6 +28,14 @@ func (m *Manager) handleCommit(ctx context.Context, tx *cluster.Transaction) err
 		return m.handleDeleteClassCommit(ctx, tx)
 	case UpdateClass:
 		return m.handleUpdateClassCommit(ctx, tx)
+	case RestoreTrashedClass:
+		return m.handleRestoreTrashedClassCommit(ctx, tx)
+	case AddMigration:
+		return m.handleAddMigrationCommit(ctx, tx)
+	case FinishMigration:
+		return m.handleFinishMigrationCommit(ctx, tx)
+	case UpdateNetworkAccess:
+		return m.handleUpdateNetworkAccessCommit(ctx, tx)
 	default:
 		return errors.Errorf("unrecognized commit type %q", tx.Type)
 	}
@@ -111,7 +119,7 @@ func (m *Manager) handleDeleteClassCommit(ctx context.Context,
 			tx.Payload)
 	}
 
-	return m.deleteClassApplyChanges(ctx, pl.ClassName, pl.Force)
+	return m.deleteClassApplyChanges(ctx, pl)
 }
 
 func (m *Manager) handleUpdateClassCommit(ctx context.Context,
@@ -136,3 +144,66 @@ func (m *Manager) handleUpdateClassCommit(ctx context.Context,
 
 	return m.updateClassApplyChanges(ctx, pl.ClassName, pl.Class, pl.State)
 }
+
+func (m *Manager) handleRestoreTrashedClassCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	pl, ok := tx.Payload.(RestoreTrashedClassPayload)
+	if !ok {
+		m.Unlock()
+		return errors.Errorf("expected commit payload to be RestoreTrashedClassPayload, but got %T",
+			tx.Payload)
+	}
+
+	class, shardState, err := m.restoreTrashedClassApplyChanges(ctx, pl.ID)
+	m.Unlock()
+	if err != nil {
+		return err
+	}
+	// call to migrator needs to be outside the lock
+	return m.migrator.AddClass(ctx, class, shardState)
+}
+
+func (m *Manager) handleAddMigrationCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(AddMigrationPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be AddMigrationPayload, but got %T",
+			tx.Payload)
+	}
+
+	return m.addMigrationApplyChanges(ctx, pl.Migration)
+}
+
+func (m *Manager) handleFinishMigrationCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	pl, ok := tx.Payload.(FinishMigrationPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be FinishMigrationPayload, but got %T",
+			tx.Payload)
+	}
+
+	// the lock is taken once the local shards have been migrated
+	return m.finishMigrationApplyChanges(ctx, pl)
+}
+
+func (m *Manager) handleUpdateNetworkAccessCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(UpdateNetworkAccessPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be UpdateNetworkAccessPayload, but got %T",
+			tx.Payload)
+	}
+
+	return m.updateNetworkAccessApplyChanges(ctx, pl.Listener, pl.Rules)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/network/ipfilter"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/scaler"
 	"github.com/weaviate/weaviate/usecases/schema/migrate"
@@ -45,6 +46,7 @@ type Manager struct {
 	hnswConfigParser        VectorConfigParser
 	invertedConfigValidator InvertedConfigValidator
 	scaleOut                scaleOut
+	networkAccess           *ipfilter.Listeners
 	RestoreStatus           sync.Map
 	RestoreError            sync.Map
 	sync.RWMutex
@@ -104,6 +106,10 @@ type scaleOut interface {
 	SetSchemaManager(sm scaler.SchemaManager)
 	Scale(ctx context.Context, className string,
 		updated sharding.Config, prevReplFactor, newReplFactor int64) (*sharding.State, error)
+	ReplaceNodes(ctx context.Context, className string,
+		replFactor int64, nodes []string) (*sharding.State, error)
+	MoveReplica(ctx context.Context, className string, m sharding.Move) error
+	CancelMove(ctx context.Context, className string, m sharding.Move) error
 }
 
 // NewManager creates a new manager
@@ -158,6 +164,13 @@ type authorizer interface {
 type State struct {
 	ObjectSchema  *models.Schema `json:"object"`
 	ShardingState map[string]*sharding.State
+	// Trash are the deleted classes in the recycle bin
+	Trash []*TrashedClass `json:"trash,omitempty"`
+	// Migrations of properties, running or finished
+	Migrations []*Migration `json:"migrations,omitempty"`
+	// NetworkAccess are the rules of the listeners which have been updated at
+	// runtime
+	NetworkAccess map[string]ipfilter.Rules `json:"networkAccess,omitempty"`
 }
 
 func (m *Manager) saveSchema(ctx context.Context) error {
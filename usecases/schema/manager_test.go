@@ -40,6 +40,18 @@ func (n *NilMigrator) DropClass(ctx context.Context, className string) error {
 	return nil
 }
 
+func (n *NilMigrator) TrashClass(ctx context.Context, className, trashID string) error {
+	return nil
+}
+
+func (n *NilMigrator) RestoreTrashedClass(ctx context.Context, trashID string) error {
+	return nil
+}
+
+func (n *NilMigrator) DeleteTrashedClass(ctx context.Context, trashID string) error {
+	return nil
+}
+
 func (n *NilMigrator) UpdateClass(ctx context.Context, className string, newClassName *string) error {
 	return nil
 }
@@ -52,10 +64,46 @@ func (n *NilMigrator) UpdateShardStatus(ctx context.Context, className, shardNam
 	return nil
 }
 
+func (n *NilMigrator) DropShard(ctx context.Context, className, shardName string) error {
+	return nil
+}
+
+func (n *NilMigrator) OptimizeClass(ctx context.Context, className string) (*models.ClassOptimizeResult, error) {
+	return nil, nil
+}
+
+func (n *NilMigrator) CleanupInvertedClass(ctx context.Context, className string) (*models.ClassInvertedCleanupResult, error) {
+	return nil, nil
+}
+
+func (n *NilMigrator) ReplayClass(ctx context.Context, className string, from, to int64,
+	after string, limit int, targetClass string,
+) (*models.ClassReplayResult, error) {
+	return nil, nil
+}
+
 func (n *NilMigrator) AddProperty(ctx context.Context, className string, prop *models.Property) error {
 	return nil
 }
 
+func (n *NilMigrator) PreparePropertyMigration(ctx context.Context, className string,
+	migration *models.SchemaMigration,
+) error {
+	return nil
+}
+
+func (n *NilMigrator) CommitPropertyMigration(ctx context.Context, className string,
+	migration *models.SchemaMigration,
+) error {
+	return nil
+}
+
+func (n *NilMigrator) AbortPropertyMigration(ctx context.Context, className string,
+	migration *models.SchemaMigration,
+) error {
+	return nil
+}
+
 func (n *NilMigrator) UpdateProperty(ctx context.Context, className string, propName string, newName *string) error {
 	return nil
 }
@@ -84,6 +132,10 @@ func (n *NilMigrator) UpdateInvertedIndexConfig(ctx context.Context, className s
 	return nil
 }
 
+func (n *NilMigrator) UpdateVersioningConfig(ctx context.Context, className string, updated *models.VersioningConfig) error {
+	return nil
+}
+
 func (n *NilMigrator) RecalculateVectorDimensions(ctx context.Context) error {
 	return nil
 }
@@ -275,7 +327,7 @@ func testRemoveObjectClass(t *testing.T, lsm *Manager) {
 	assert.Contains(t, objectClasses, "Car")
 
 	// Now delete the class
-	err = lsm.DeleteClass(context.Background(), nil, "Car", false)
+	err = lsm.DeleteClass(context.Background(), nil, "Car", false, false)
 	assert.Nil(t, err)
 
 	objectClasses = testGetClassNames(lsm)
@@ -480,7 +532,7 @@ func TestSchema(t *testing.T) {
 func newSchemaManager() *Manager {
 	logger, _ := test.NewNullLogger()
 	vectorizerValidator := &fakeVectorizerValidator{
-		valid: []string{"text2vec-contextionary", "model1", "model2"},
+		valid: []string{"text2vec-contextionary", "model1", "model2", "my-module1"},
 	}
 	dummyConfig := config.Config{
 		DefaultVectorizerModule:     config.VectorizerModuleNone,
@@ -601,5 +653,23 @@ func (f *fakeScaleOutManager) Scale(ctx context.Context,
 	return nil, nil
 }
 
+func (f *fakeScaleOutManager) ReplaceNodes(ctx context.Context,
+	className string, replFactor int64, nodes []string,
+) (*sharding.State, error) {
+	return nil, nil
+}
+
+func (f *fakeScaleOutManager) MoveReplica(ctx context.Context,
+	className string, m sharding.Move,
+) error {
+	return nil
+}
+
+func (f *fakeScaleOutManager) CancelMove(ctx context.Context,
+	className string, m sharding.Move,
+) error {
+	return nil
+}
+
 func (f *fakeScaleOutManager) SetSchemaManager(sm scaler.SchemaManager) {
 }
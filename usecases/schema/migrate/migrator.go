@@ -26,12 +26,26 @@ import (
 type Migrator interface {
 	AddClass(ctx context.Context, class *models.Class, shardingState *sharding.State) error
 	DropClass(ctx context.Context, className string) error
+	TrashClass(ctx context.Context, className, trashID string) error
+	RestoreTrashedClass(ctx context.Context, trashID string) error
+	DeleteTrashedClass(ctx context.Context, trashID string) error
 	UpdateClass(ctx context.Context, className string,
 		newClassName *string) error
 	GetShardsStatus(ctx context.Context, className string) (map[string]string, error)
 	UpdateShardStatus(ctx context.Context, className, shardName, targetStatus string) error
+	DropShard(ctx context.Context, className, shardName string) error
+	OptimizeClass(ctx context.Context, className string) (*models.ClassOptimizeResult, error)
+	CleanupInvertedClass(ctx context.Context, className string) (*models.ClassInvertedCleanupResult, error)
+	ReplayClass(ctx context.Context, className string, from, to int64,
+		after string, limit int, targetClass string) (*models.ClassReplayResult, error)
 	AddProperty(ctx context.Context, className string,
 		prop *models.Property) error
+	PreparePropertyMigration(ctx context.Context, className string,
+		migration *models.SchemaMigration) error
+	CommitPropertyMigration(ctx context.Context, className string,
+		migration *models.SchemaMigration) error
+	AbortPropertyMigration(ctx context.Context, className string,
+		migration *models.SchemaMigration) error
 	UpdateProperty(ctx context.Context, className string,
 		propName string, newName *string) error
 	ValidateVectorIndexConfigUpdate(ctx context.Context,
@@ -42,6 +56,8 @@ type Migrator interface {
 		old, updated *models.InvertedIndexConfig) error
 	UpdateInvertedIndexConfig(ctx context.Context, className string,
 		updated *models.InvertedIndexConfig) error
+	UpdateVersioningConfig(ctx context.Context, className string,
+		updated *models.VersioningConfig) error
 	RecalculateVectorDimensions(ctx context.Context) error
 	InvertedReindex(ctx context.Context, taskNames ...string) error
 }
@@ -0,0 +1,394 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+var (
+	// finishMigrationAttempts and finishMigrationRetryInterval bound how long
+	// the coordinator tries to open the transaction which completes or fails a
+	// migration, e.g. while another schema transaction is in progress
+	finishMigrationAttempts      = 10
+	finishMigrationRetryInterval = 10 * time.Second
+)
+
+// Migration is a change of a property whose data is rewritten shard by shard
+// in the background. The schema only changes once the data of all replicas
+// has been rewritten. Migrations are part of the schema state, so that the
+// coordinating node can resume a running migration after a restart.
+type Migration struct {
+	Migration *models.SchemaMigration `json:"migration"`
+	// Node coordinates the migration
+	Node string `json:"node"`
+}
+
+func (m *Manager) findMigration(className, id string) *Migration {
+	for _, migration := range m.state.Migrations {
+		if migration.Migration.Class == className && migration.Migration.ID == id {
+			return migration
+		}
+	}
+	return nil
+}
+
+// runningMigration returns the migration of the class which is running, there
+// is at most one
+func (m *Manager) runningMigration(className string) *Migration {
+	for _, migration := range m.state.Migrations {
+		if migration.Migration.Class == className &&
+			migration.Migration.Status == models.SchemaMigrationStatusRUNNING {
+			return migration
+		}
+	}
+	return nil
+}
+
+// ListMigrations returns the migrations of the class in the order they were
+// started
+func (m *Manager) ListMigrations(ctx context.Context, principal *models.Principal,
+	className string,
+) (models.SchemaMigrationList, error) {
+	err := m.Authorizer.Authorize(principal, "list", "schema/*")
+	if err != nil {
+		return nil, err
+	}
+
+	m.RLock()
+	defer m.RUnlock()
+
+	if m.getClassByName(className) == nil {
+		return nil, ErrNotFound
+	}
+
+	out := models.SchemaMigrationList{}
+	for _, migration := range m.state.Migrations {
+		if migration.Migration.Class == className {
+			copied := *migration.Migration
+			out = append(out, &copied)
+		}
+	}
+	return out, nil
+}
+
+// StartMigration validates the migration of a property and starts rewriting
+// its data in the background. The returned migration is RUNNING, its outcome
+// is reported by ListMigrations.
+func (m *Manager) StartMigration(ctx context.Context, principal *models.Principal,
+	className string, in *models.SchemaMigration,
+) (*models.SchemaMigration, error) {
+	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return nil, err
+	}
+
+	migration, err := m.addMigration(ctx, className, in)
+	if err != nil {
+		return nil, err
+	}
+
+	out := *migration.Migration
+	go m.runMigration(context.Background(), out)
+	return &out, nil
+}
+
+func (m *Manager) addMigration(ctx context.Context, className string,
+	in *models.SchemaMigration,
+) (*Migration, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	class := m.getClassByName(className)
+	if class == nil {
+		return nil, ErrNotFound
+	}
+	if running := m.runningMigration(className); running != nil {
+		return nil, fmt.Errorf("migration %q of class %q is still running",
+			running.Migration.ID, className)
+	}
+	if err := validateMigration(class, in); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UnixMilli()
+	migration := &Migration{
+		Migration: &models.SchemaMigration{
+			ID:            fmt.Sprintf("%s-%d", className, now),
+			Class:         className,
+			Type:          in.Type,
+			Property:      in.Property,
+			NewName:       in.NewName,
+			NewDataType:   in.NewDataType,
+			Status:        models.SchemaMigrationStatusRUNNING,
+			StartTimeUnix: now,
+		},
+		Node: m.clusterState.LocalName(),
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, AddMigration,
+		AddMigrationPayload{Migration: migration}, DefaultTxTTL)
+	if err != nil {
+		// possible causes for errors could be nodes down (we expect every node to
+		// be up for a schema transaction) or concurrent transactions from other
+		// nodes
+		return nil, errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		// Only log the commit error, but do not abort the changes locally. Once
+		// we've told others to commit, we also need to commit ourselves!
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	if err := m.addMigrationApplyChanges(ctx, migration); err != nil {
+		return nil, err
+	}
+	return migration, nil
+}
+
+func (m *Manager) addMigrationApplyChanges(ctx context.Context, migration *Migration) error {
+	if m.findMigration(migration.Migration.Class, migration.Migration.ID) != nil {
+		return nil
+	}
+
+	m.state.Migrations = append(m.state.Migrations, migration)
+	return m.saveSchema(ctx)
+}
+
+// validateMigration checks whether the property of the class can be migrated
+// as requested. Renaming geo properties isn't supported, as their index is
+// not kept in buckets.
+func validateMigration(class *models.Class, in *models.SchemaMigration) error {
+	if in == nil {
+		return fmt.Errorf("migration is missing")
+	}
+
+	var prop *models.Property
+	for _, p := range class.Properties {
+		if p.Name == in.Property {
+			prop = p
+			break
+		}
+	}
+	if prop == nil {
+		return fmt.Errorf("class %q has no property %q", class.Class, in.Property)
+	}
+
+	switch in.Type {
+	case models.SchemaMigrationTypeRenameProperty:
+		in.NewName = schema.LowercaseFirstLetter(in.NewName)
+		if _, err := schema.ValidatePropertyName(in.NewName); err != nil {
+			return err
+		}
+		if err := schema.ValidateReservedPropertyName(in.NewName); err != nil {
+			return err
+		}
+		for _, p := range class.Properties {
+			if strings.EqualFold(p.Name, in.NewName) {
+				return fmt.Errorf("class %q: conflict for property %q: already in use",
+					class.Class, in.NewName)
+			}
+		}
+		if schema.DataType(prop.DataType[0]) == schema.DataTypeGeoCoordinates {
+			return fmt.Errorf("property %q: renaming %s properties is not supported",
+				prop.Name, schema.DataTypeGeoCoordinates)
+		}
+		return nil
+
+	case models.SchemaMigrationTypeWidenPropertyType:
+		if len(in.NewDataType) != 1 {
+			return fmt.Errorf("property %q: exactly one new data type is required", prop.Name)
+		}
+		from, to := schema.DataType(prop.DataType[0]), schema.DataType(in.NewDataType[0])
+		if (from == schema.DataTypeInt && to == schema.DataTypeNumber) ||
+			(from == schema.DataTypeIntArray && to == schema.DataTypeNumberArray) {
+			return nil
+		}
+		return fmt.Errorf("property %q: data type %s can't be widened to %s",
+			prop.Name, from, to)
+
+	default:
+		return fmt.Errorf("unsupported migration type %q", in.Type)
+	}
+}
+
+// runMigration rewrites the data of all replicas and completes the migration
+// if all of them succeeded, or fails it otherwise
+func (m *Manager) runMigration(ctx context.Context, migration models.SchemaMigration) {
+	logger := m.logger.WithField("action", "schema_migration").
+		WithField("class", migration.Class).
+		WithField("id", migration.ID)
+	logger.Info("rewriting data of migrated property")
+
+	migrationErr := m.migrator.PreparePropertyMigration(ctx, migration.Class, &migration)
+	if migrationErr != nil {
+		logger.WithError(migrationErr).Error("migration failed")
+	}
+
+	var err error
+	for attempt := 1; attempt <= finishMigrationAttempts; attempt++ {
+		if err = m.finishMigration(ctx, migration.Class, migration.ID, migrationErr); err == nil {
+			return
+		}
+		logger.WithError(err).Warnf("could not finish migration, attempt %d", attempt)
+		time.Sleep(finishMigrationRetryInterval)
+	}
+	logger.WithError(err).Error("gave up finishing migration, it is resumed once the node restarts")
+}
+
+func (m *Manager) finishMigration(ctx context.Context, className, id string,
+	migrationErr error,
+) error {
+	pl := FinishMigrationPayload{
+		ClassName: className,
+		ID:        id,
+		EndTime:   time.Now().UnixMilli(),
+	}
+	if migrationErr != nil {
+		pl.Error = migrationErr.Error()
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, FinishMigration, pl, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.finishMigrationApplyChanges(ctx, pl)
+}
+
+// finishMigrationApplyChanges swaps in the rewritten data of the local
+// replicas and changes the schema if the migration succeeded, or drops the
+// rewritten data if it failed. The shards accept writes again afterwards.
+func (m *Manager) finishMigrationApplyChanges(ctx context.Context,
+	pl FinishMigrationPayload,
+) error {
+	m.RLock()
+	migration := m.findMigration(pl.ClassName, pl.ID)
+	var running models.SchemaMigration
+	if migration != nil {
+		running = *migration.Migration
+	}
+	m.RUnlock()
+
+	if migration == nil {
+		return fmt.Errorf("migration %q of class %q not found", pl.ID, pl.ClassName)
+	}
+	if running.Status != models.SchemaMigrationStatusRUNNING {
+		return nil
+	}
+
+	// the changes of the other nodes can't be rolled back, if the data of this
+	// node can't be swapped in the schema is changed anyway
+	var err error
+	if pl.Error == "" {
+		err = m.migrator.CommitPropertyMigration(ctx, pl.ClassName, &running)
+	} else {
+		err = m.migrator.AbortPropertyMigration(ctx, pl.ClassName, &running)
+	}
+	if err != nil {
+		m.logger.WithField("action", "schema_migration").
+			WithField("class", pl.ClassName).
+			WithField("id", pl.ID).
+			WithError(err).
+			Error("could not apply migration to local shards")
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	if pl.Error == "" {
+		if class := m.getClassByName(pl.ClassName); class != nil {
+			applyMigration(class, &running)
+		}
+		migration.Migration.Status = models.SchemaMigrationStatusCOMPLETED
+	} else {
+		migration.Migration.Status = models.SchemaMigrationStatusFAILED
+		migration.Migration.Error = pl.Error
+	}
+	migration.Migration.EndTimeUnix = pl.EndTime
+	return m.saveSchema(ctx)
+}
+
+// applyMigration changes the property of the class
+func applyMigration(class *models.Class, migration *models.SchemaMigration) {
+	for _, prop := range class.Properties {
+		if prop.Name != migration.Property {
+			continue
+		}
+		switch migration.Type {
+		case models.SchemaMigrationTypeRenameProperty:
+			prop.Name = migration.NewName
+		case models.SchemaMigrationTypeWidenPropertyType:
+			prop.DataType = migration.NewDataType
+		}
+	}
+
+	if migration.Type == models.SchemaMigrationTypeRenameProperty &&
+		class.IDGenerationConfig != nil {
+		for i, name := range class.IDGenerationConfig.Properties {
+			if name == migration.Property {
+				class.IDGenerationConfig.Properties[i] = migration.NewName
+			}
+		}
+	}
+
+	if migration.Type == models.SchemaMigrationTypeRenameProperty &&
+		class.ChunkingConfig != nil {
+		for i, name := range class.ChunkingConfig.Properties {
+			if name == migration.Property {
+				class.ChunkingConfig.Properties[i] = migration.NewName
+			}
+		}
+	}
+}
+
+// ResumeMigrations restarts the migrations which were running on this node
+// when it was stopped. The data of the replicas is rewritten from scratch.
+// It must be called once the local shards have been loaded.
+func (m *Manager) ResumeMigrations(ctx context.Context) {
+	m.RLock()
+	var resumed []models.SchemaMigration
+	for _, migration := range m.state.Migrations {
+		if migration.Node == m.clusterState.LocalName() &&
+			migration.Migration.Status == models.SchemaMigrationStatusRUNNING {
+			resumed = append(resumed, *migration.Migration)
+		}
+	}
+	m.RUnlock()
+
+	for _, migration := range resumed {
+		go m.runMigration(ctx, migration)
+	}
+}
+
+// removeMigrations removes the migrations of a deleted class
+func (m *Manager) removeMigrations(className string) {
+	var kept []*Migration
+	for _, migration := range m.state.Migrations {
+		if migration.Migration.Class != className {
+			kept = append(kept, migration)
+		}
+	}
+	m.state.Migrations = kept
+}
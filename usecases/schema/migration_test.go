@@ -0,0 +1,281 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+type fakePropertyMigrator struct {
+	NilMigrator
+	sync.Mutex
+	prepareErr error
+	// release blocks the preparation until it is closed
+	release chan struct{}
+	calls   []string
+}
+
+func (f *fakePropertyMigrator) PreparePropertyMigration(ctx context.Context, className string,
+	migration *models.SchemaMigration,
+) error {
+	if f.release != nil {
+		<-f.release
+	}
+	f.record("prepare")
+	return f.prepareErr
+}
+
+func (f *fakePropertyMigrator) CommitPropertyMigration(ctx context.Context, className string,
+	migration *models.SchemaMigration,
+) error {
+	f.record("commit")
+	return nil
+}
+
+func (f *fakePropertyMigrator) AbortPropertyMigration(ctx context.Context, className string,
+	migration *models.SchemaMigration,
+) error {
+	f.record("abort")
+	return nil
+}
+
+func (f *fakePropertyMigrator) record(call string) {
+	f.Lock()
+	defer f.Unlock()
+	f.calls = append(f.calls, call)
+}
+
+func (f *fakePropertyMigrator) recorded() []string {
+	f.Lock()
+	defer f.Unlock()
+	return append([]string(nil), f.calls...)
+}
+
+func TestMigrations(t *testing.T) {
+	ctx := context.Background()
+	newManager := func(t *testing.T, migrator *fakePropertyMigrator) *Manager {
+		sm := newSchemaManager()
+		sm.migrator = migrator
+		require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+			Class: "Migrated",
+			Properties: []*models.Property{
+				{Name: "count", DataType: []string{"int"}},
+				{Name: "counts", DataType: []string{"int[]"}},
+				{Name: "name", DataType: []string{"text"}},
+				{Name: "location", DataType: []string{"geoCoordinates"}},
+			},
+			IDGenerationConfig: &models.IDGenerationConfig{
+				Strategy:   schema.IDStrategyV5,
+				Properties: []string{"name"},
+			},
+		}))
+		return sm
+	}
+	waitFor := func(t *testing.T, sm *Manager, status string) *models.SchemaMigration {
+		var last *models.SchemaMigration
+		require.Eventually(t, func() bool {
+			migrations, err := sm.ListMigrations(ctx, nil, "Migrated")
+			require.Nil(t, err)
+			last = migrations[len(migrations)-1]
+			return last.Status == status
+		}, 5*time.Second, 10*time.Millisecond)
+		return last
+	}
+	propByName := func(sm *Manager, name string) *models.Property {
+		for _, prop := range sm.getClassByName("Migrated").Properties {
+			if prop.Name == name {
+				return prop
+			}
+		}
+		return nil
+	}
+
+	t.Run("rename a property", func(t *testing.T) {
+		migrator := &fakePropertyMigrator{}
+		sm := newManager(t, migrator)
+
+		started, err := sm.StartMigration(ctx, nil, "Migrated", &models.SchemaMigration{
+			Type:     models.SchemaMigrationTypeRenameProperty,
+			Property: "name",
+			NewName:  "Title",
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "Migrated", started.Class)
+		assert.Equal(t, "title", started.NewName)
+		assert.Equal(t, models.SchemaMigrationStatusRUNNING, started.Status)
+
+		completed := waitFor(t, sm, models.SchemaMigrationStatusCOMPLETED)
+		assert.Equal(t, started.ID, completed.ID)
+		assert.NotZero(t, completed.EndTimeUnix)
+		assert.Equal(t, []string{"prepare", "commit"}, migrator.recorded())
+
+		assert.Nil(t, propByName(sm, "name"))
+		assert.NotNil(t, propByName(sm, "title"))
+		assert.Equal(t, []string{"title"},
+			sm.getClassByName("Migrated").IDGenerationConfig.Properties)
+	})
+
+	t.Run("widen a property", func(t *testing.T) {
+		sm := newManager(t, &fakePropertyMigrator{})
+
+		_, err := sm.StartMigration(ctx, nil, "Migrated", &models.SchemaMigration{
+			Type:        models.SchemaMigrationTypeWidenPropertyType,
+			Property:    "counts",
+			NewDataType: []string{"number[]"},
+		})
+		require.Nil(t, err)
+
+		waitFor(t, sm, models.SchemaMigrationStatusCOMPLETED)
+		assert.Equal(t, []string{"number[]"}, propByName(sm, "counts").DataType)
+	})
+
+	t.Run("failed rewrite leaves the schema unchanged", func(t *testing.T) {
+		migrator := &fakePropertyMigrator{prepareErr: errors.New("disk full")}
+		sm := newManager(t, migrator)
+
+		_, err := sm.StartMigration(ctx, nil, "Migrated", &models.SchemaMigration{
+			Type:        models.SchemaMigrationTypeWidenPropertyType,
+			Property:    "count",
+			NewDataType: []string{"number"},
+		})
+		require.Nil(t, err)
+
+		failed := waitFor(t, sm, models.SchemaMigrationStatusFAILED)
+		assert.Equal(t, "disk full", failed.Error)
+		assert.Equal(t, []string{"prepare", "abort"}, migrator.recorded())
+		assert.Equal(t, []string{"int"}, propByName(sm, "count").DataType)
+	})
+
+	t.Run("guards while a migration is running", func(t *testing.T) {
+		migrator := &fakePropertyMigrator{release: make(chan struct{})}
+		sm := newManager(t, migrator)
+
+		_, err := sm.StartMigration(ctx, nil, "Migrated", &models.SchemaMigration{
+			Type:     models.SchemaMigrationTypeRenameProperty,
+			Property: "name",
+			NewName:  "title",
+		})
+		require.Nil(t, err)
+
+		_, err = sm.StartMigration(ctx, nil, "Migrated", &models.SchemaMigration{
+			Type:        models.SchemaMigrationTypeWidenPropertyType,
+			Property:    "count",
+			NewDataType: []string{"number"},
+		})
+		assert.ErrorContains(t, err, "still running")
+
+		err = sm.AddClassProperty(ctx, nil, "Migrated",
+			&models.Property{Name: "title", DataType: []string{"text"}})
+		assert.ErrorContains(t, err, "conflict for property")
+
+		err = sm.DeleteClass(ctx, nil, "Migrated", false, false)
+		assert.ErrorContains(t, err, "can't be deleted")
+
+		close(migrator.release)
+		waitFor(t, sm, models.SchemaMigrationStatusCOMPLETED)
+		require.Nil(t, sm.DeleteClass(ctx, nil, "Migrated", false, false))
+		assert.Len(t, sm.state.Migrations, 0)
+	})
+
+	t.Run("invalid migrations", func(t *testing.T) {
+		sm := newManager(t, &fakePropertyMigrator{})
+
+		for _, test := range []struct {
+			name      string
+			migration *models.SchemaMigration
+			expected  string
+		}{
+			{
+				name:      "unknown property",
+				migration: &models.SchemaMigration{Type: models.SchemaMigrationTypeRenameProperty, Property: "unknown", NewName: "other"},
+				expected:  "has no property",
+			},
+			{
+				name:      "rename to an existing property",
+				migration: &models.SchemaMigration{Type: models.SchemaMigrationTypeRenameProperty, Property: "name", NewName: "Count"},
+				expected:  "conflict for property",
+			},
+			{
+				name:      "rename to a reserved name",
+				migration: &models.SchemaMigration{Type: models.SchemaMigrationTypeRenameProperty, Property: "name", NewName: "id"},
+				expected:  "reserved",
+			},
+			{
+				name:      "rename a geo property",
+				migration: &models.SchemaMigration{Type: models.SchemaMigrationTypeRenameProperty, Property: "location", NewName: "place"},
+				expected:  "not supported",
+			},
+			{
+				name:      "widen text",
+				migration: &models.SchemaMigration{Type: models.SchemaMigrationTypeWidenPropertyType, Property: "name", NewDataType: []string{"number"}},
+				expected:  "can't be widened",
+			},
+			{
+				name:      "widen int to number[]",
+				migration: &models.SchemaMigration{Type: models.SchemaMigrationTypeWidenPropertyType, Property: "count", NewDataType: []string{"number[]"}},
+				expected:  "can't be widened",
+			},
+			{
+				name:      "unknown type",
+				migration: &models.SchemaMigration{Type: "dropProperty", Property: "name"},
+				expected:  "unsupported migration type",
+			},
+		} {
+			t.Run(test.name, func(t *testing.T) {
+				_, err := sm.StartMigration(ctx, nil, "Migrated", test.migration)
+				assert.ErrorContains(t, err, test.expected)
+			})
+		}
+
+		_, err := sm.StartMigration(ctx, nil, "Unknown", &models.SchemaMigration{})
+		assert.Equal(t, ErrNotFound, err)
+		migrations, err := sm.ListMigrations(ctx, nil, "Migrated")
+		require.Nil(t, err)
+		assert.Len(t, migrations, 0)
+	})
+
+	t.Run("resume running migrations of this node", func(t *testing.T) {
+		migrator := &fakePropertyMigrator{}
+		sm := newManager(t, migrator)
+		sm.state.Migrations = []*Migration{
+			{
+				Migration: &models.SchemaMigration{
+					ID: "Migrated-1", Class: "Migrated", Type: models.SchemaMigrationTypeRenameProperty,
+					Property: "name", NewName: "title", Status: models.SchemaMigrationStatusRUNNING,
+				},
+				Node: "other-node",
+			},
+			{
+				Migration: &models.SchemaMigration{
+					ID: "Migrated-2", Class: "Migrated", Type: models.SchemaMigrationTypeWidenPropertyType,
+					Property: "count", NewDataType: []string{"number"}, Status: models.SchemaMigrationStatusRUNNING,
+				},
+				Node: sm.clusterState.LocalName(),
+			},
+		}
+
+		sm.ResumeMigrations(ctx)
+		waitFor(t, sm, models.SchemaMigrationStatusCOMPLETED)
+		assert.Equal(t, []string{"number"}, propByName(sm, "count").DataType)
+		assert.NotNil(t, propByName(sm, "name"))
+		assert.Equal(t, []string{"prepare", "commit"}, migrator.recorded())
+	})
+}
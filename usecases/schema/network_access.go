@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/network/ipfilter"
+)
+
+// SetNetworkAccess sets the filters of the listeners which are updated by
+// UpdateNetworkAccess. Rules which have been updated at runtime are part of
+// the schema state and take precedence over the configured rules.
+func (m *Manager) SetNetworkAccess(listeners *ipfilter.Listeners) error {
+	m.Lock()
+	defer m.Unlock()
+
+	m.networkAccess = listeners
+	for name, rules := range m.state.NetworkAccess {
+		filter, ok := listeners.Get(name)
+		if !ok {
+			continue
+		}
+		if err := filter.Update(rules); err != nil {
+			return errors.Wrapf(err, "apply network access rules of listener %s", name)
+		}
+	}
+	return nil
+}
+
+// GetNetworkAccess returns the active rules of the listener
+func (m *Manager) GetNetworkAccess(ctx context.Context, principal *models.Principal,
+	listener string,
+) (ipfilter.Rules, error) {
+	err := m.Authorizer.Authorize(principal, "get", fmt.Sprintf("network-access/%s", listener))
+	if err != nil {
+		return ipfilter.Rules{}, err
+	}
+
+	m.RLock()
+	defer m.RUnlock()
+
+	filter, ok := m.networkAccessFilter(listener)
+	if !ok {
+		return ipfilter.Rules{}, ErrNotFound
+	}
+	return filter.Rules(), nil
+}
+
+// UpdateNetworkAccess replaces the rules of the listener on every node of the
+// cluster. Rules which would lock out the caller on the API listener or one
+// of the nodes on the cluster listener are refused, as they could only be
+// reverted by restarting the affected nodes with a different configuration.
+func (m *Manager) UpdateNetworkAccess(ctx context.Context, principal *models.Principal,
+	listener string, rules ipfilter.Rules, callerIP net.IP,
+) (ipfilter.Rules, error) {
+	err := m.Authorizer.Authorize(principal, "update", fmt.Sprintf("network-access/%s", listener))
+	if err != nil {
+		return ipfilter.Rules{}, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	if _, ok := m.networkAccessFilter(listener); !ok {
+		return ipfilter.Rules{}, ErrNotFound
+	}
+
+	if err := m.validateNetworkAccess(listener, rules, callerIP); err != nil {
+		return ipfilter.Rules{}, err
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, UpdateNetworkAccess,
+		UpdateNetworkAccessPayload{Listener: listener, Rules: rules}, DefaultTxTTL)
+	if err != nil {
+		// possible causes for errors could be nodes down (we expect every node to
+		// be up for a schema transaction) or concurrent transactions from other
+		// nodes
+		return ipfilter.Rules{}, errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		return ipfilter.Rules{}, errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	if err := m.updateNetworkAccessApplyChanges(ctx, listener, rules); err != nil {
+		return ipfilter.Rules{}, err
+	}
+	return rules, nil
+}
+
+func (m *Manager) validateNetworkAccess(listener string, rules ipfilter.Rules,
+	callerIP net.IP,
+) error {
+	filter, err := ipfilter.New(rules)
+	if err != nil {
+		return err
+	}
+
+	switch listener {
+	case ipfilter.ListenerAPI:
+		if !filter.Allowed(callerIP) {
+			return fmt.Errorf("rules would deny the caller %s", callerIP)
+		}
+	case ipfilter.ListenerCluster:
+		for _, name := range m.clusterState.AllNames() {
+			hostname, ok := m.clusterState.NodeHostname(name)
+			if !ok {
+				continue
+			}
+			host, _, err := net.SplitHostPort(hostname)
+			if err != nil {
+				host = hostname
+			}
+			if !filter.Allowed(net.ParseIP(host)) {
+				return fmt.Errorf("rules would deny node %s at %s", name, host)
+			}
+		}
+	}
+	return nil
+}
+
+func (m *Manager) updateNetworkAccessApplyChanges(ctx context.Context,
+	listener string, rules ipfilter.Rules,
+) error {
+	if filter, ok := m.networkAccessFilter(listener); ok {
+		if err := filter.Update(rules); err != nil {
+			return err
+		}
+	}
+
+	if m.state.NetworkAccess == nil {
+		m.state.NetworkAccess = map[string]ipfilter.Rules{}
+	}
+	m.state.NetworkAccess[listener] = rules
+	return m.saveSchema(ctx)
+}
+
+func (m *Manager) networkAccessFilter(listener string) (*ipfilter.Filter, bool) {
+	if m.networkAccess == nil {
+		return nil, false
+	}
+	return m.networkAccess.Get(listener)
+}
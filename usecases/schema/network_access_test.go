@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/network/ipfilter"
+)
+
+func TestNetworkAccess(t *testing.T) {
+	ctx := context.Background()
+	caller := net.ParseIP("10.0.0.1")
+	newManager := func(t *testing.T) (*Manager, *ipfilter.Listeners) {
+		sm := newSchemaManager()
+		sm.clusterState.(*fakeClusterState).addresses = map[string]string{
+			"node1": "10.0.1.1:7101",
+		}
+		listeners, err := ipfilter.NewListeners(ipfilter.Rules{}, ipfilter.Rules{},
+			ipfilter.Rules{})
+		require.Nil(t, err)
+		require.Nil(t, sm.SetNetworkAccess(listeners))
+		return sm, listeners
+	}
+
+	t.Run("update is applied and persisted", func(t *testing.T) {
+		sm, listeners := newManager(t)
+		rules := ipfilter.Rules{Allow: []string{"10.0.0.0/24"}}
+
+		updated, err := sm.UpdateNetworkAccess(ctx, nil, ipfilter.ListenerAPI, rules, caller)
+		require.Nil(t, err)
+		assert.Equal(t, rules, updated)
+		assert.Equal(t, rules, listeners.API.Rules())
+		assert.False(t, listeners.API.Allowed(net.ParseIP("10.0.1.1")))
+
+		got, err := sm.GetNetworkAccess(ctx, nil, ipfilter.ListenerAPI)
+		require.Nil(t, err)
+		assert.Equal(t, rules, got)
+
+		// the rules of a restarted node take precedence over its configuration
+		logger, _ := test.NewNullLogger()
+		restarted, err := NewManager(&NilMigrator{}, sm.repo, logger, &fakeAuthorizer{},
+			config.Config{}, dummyParseVectorConfig, &fakeVectorizerValidator{},
+			dummyValidateInvertedConfig, &fakeModuleConfig{},
+			&fakeClusterState{hosts: []string{"node1"}}, &fakeTxClient{},
+			&fakeScaleOutManager{})
+		require.Nil(t, err)
+		configured, err := ipfilter.NewListeners(ipfilter.Rules{Deny: []string{"10.0.0.5"}},
+			ipfilter.Rules{}, ipfilter.Rules{})
+		require.Nil(t, err)
+		require.Nil(t, restarted.SetNetworkAccess(configured))
+		assert.Equal(t, rules, configured.API.Rules())
+	})
+
+	t.Run("unknown listener", func(t *testing.T) {
+		sm, _ := newManager(t)
+
+		_, err := sm.GetNetworkAccess(ctx, nil, "unknown")
+		assert.Equal(t, ErrNotFound, err)
+		_, err = sm.UpdateNetworkAccess(ctx, nil, "unknown", ipfilter.Rules{}, caller)
+		assert.Equal(t, ErrNotFound, err)
+	})
+
+	t.Run("invalid rules", func(t *testing.T) {
+		sm, listeners := newManager(t)
+
+		_, err := sm.UpdateNetworkAccess(ctx, nil, ipfilter.ListenerMetrics,
+			ipfilter.Rules{Deny: []string{"not-an-ip"}}, caller)
+		assert.NotNil(t, err)
+		assert.Equal(t, ipfilter.Rules{}, listeners.Metrics.Rules())
+	})
+
+	t.Run("rules which deny the caller are refused", func(t *testing.T) {
+		sm, listeners := newManager(t)
+
+		for _, rules := range []ipfilter.Rules{
+			{Allow: []string{"192.168.0.0/16"}},
+			{Deny: []string{"10.0.0.0/8"}},
+		} {
+			_, err := sm.UpdateNetworkAccess(ctx, nil, ipfilter.ListenerAPI, rules, caller)
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), "deny the caller")
+		}
+		assert.Equal(t, ipfilter.Rules{}, listeners.API.Rules())
+	})
+
+	t.Run("rules which deny a node are refused", func(t *testing.T) {
+		sm, listeners := newManager(t)
+
+		_, err := sm.UpdateNetworkAccess(ctx, nil, ipfilter.ListenerCluster,
+			ipfilter.Rules{Allow: []string{"10.0.0.0/24"}}, caller)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "deny node node1")
+		assert.Equal(t, ipfilter.Rules{}, listeners.Cluster.Rules())
+
+		rules := ipfilter.Rules{Allow: []string{"10.0.1.0/24"}}
+		_, err = sm.UpdateNetworkAccess(ctx, nil, ipfilter.ListenerCluster, rules, caller)
+		require.Nil(t, err)
+		assert.Equal(t, rules, listeners.Cluster.Rules())
+	})
+
+	t.Run("incoming commit", func(t *testing.T) {
+		sm, listeners := newManager(t)
+		rules := ipfilter.Rules{Deny: []string{"10.0.2.0/24"}}
+
+		raw, err := json.Marshal(UpdateNetworkAccessPayload{
+			Listener: ipfilter.ListenerMetrics,
+			Rules:    rules,
+		})
+		require.Nil(t, err)
+		pl, err := UnmarshalTransaction(UpdateNetworkAccess, raw)
+		require.Nil(t, err)
+
+		err = sm.handleCommit(ctx, &cluster.Transaction{
+			Type:    UpdateNetworkAccess,
+			Payload: pl,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, rules, listeners.Metrics.Rules())
+		assert.Equal(t, rules, sm.state.NetworkAccess[ipfilter.ListenerMetrics])
+	})
+}
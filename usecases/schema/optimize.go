@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// OptimizeClass compacts the storage of all shards of a class and cleans up
+// what is left of deleted objects. It is meant to be run after large
+// deletions and blocks until all shards are optimized.
+func (m *Manager) OptimizeClass(ctx context.Context, principal *models.Principal,
+	className string,
+) (*models.ClassOptimizeResult, error) {
+	err := m.Authorizer.Authorize(principal, "update",
+		fmt.Sprintf("schema/%s/shards", className))
+	if err != nil {
+		return nil, err
+	}
+
+	class := m.getClassByName(className)
+	if class == nil {
+		return nil, ErrNotFound
+	}
+
+	return m.migrator.OptimizeClass(ctx, class.Class)
+}
+
+// CleanupInvertedIndex drops the postings of deleted objects from the
+// searchable properties of all shards of a class right away, instead of
+// waiting for the cleanup cycle, which runs every CleanupIntervalSeconds.
+// The result reports the size of the searchable buckets before and after.
+func (m *Manager) CleanupInvertedIndex(ctx context.Context, principal *models.Principal,
+	className string,
+) (*models.ClassInvertedCleanupResult, error) {
+	err := m.Authorizer.Authorize(principal, "update",
+		fmt.Sprintf("schema/%s/shards", className))
+	if err != nil {
+		return nil, err
+	}
+
+	class := m.getClassByName(className)
+	if class == nil {
+		return nil, ErrNotFound
+	}
+
+	return m.migrator.CleanupInvertedClass(ctx, class.Class)
+}
@@ -0,0 +1,59 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func deletionProtected(class *models.Class) bool {
+	return class.ProtectionConfig != nil && class.ProtectionConfig.DeletionProtection
+}
+
+func configFrozen(class *models.Class) bool {
+	return class.ProtectionConfig != nil && class.ProtectionConfig.ConfigFrozen
+}
+
+// validateDeletionProtection makes sure that a class with deletion protection
+// is only deleted if the protection is explicitly overridden
+func validateDeletionProtection(class *models.Class, override bool) error {
+	if class == nil || override || !deletionProtected(class) {
+		return nil
+	}
+
+	return fmt.Errorf("class %q has deletion protection enabled, "+
+		"set 'overrideProtection' to delete it", class.Class)
+}
+
+// validateProtectionUpdate makes sure that a class with a frozen config is
+// only updated if the protection is explicitly overridden. The same applies to
+// lowering the protection, so that it can't be removed by accident, e.g. by
+// an update which doesn't contain the protection config.
+func validateProtectionUpdate(initial, updated *models.Class, override bool) error {
+	if override {
+		return nil
+	}
+
+	if configFrozen(initial) {
+		return fmt.Errorf("the config of class %q is frozen, "+
+			"set 'overrideProtection' to update it", initial.Class)
+	}
+
+	if deletionProtected(initial) && !deletionProtected(updated) {
+		return fmt.Errorf("disabling the deletion protection of class %q "+
+			"requires 'overrideProtection'", initial.Class)
+	}
+
+	return nil
+}
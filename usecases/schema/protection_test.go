@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestDeletionProtection(t *testing.T) {
+	protected := func() *models.Class {
+		return &models.Class{
+			Class:            "Protected",
+			ProtectionConfig: &models.ProtectionConfig{DeletionProtection: true},
+		}
+	}
+
+	t.Run("delete without override", func(t *testing.T) {
+		sm := newSchemaManager()
+		require.Nil(t, sm.AddClass(context.Background(), nil, protected()))
+
+		err := sm.DeleteClass(context.Background(), nil, "Protected", false, false)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "deletion protection")
+		assert.NotNil(t, sm.getClassByName("Protected"))
+	})
+
+	t.Run("force doesn't override the protection", func(t *testing.T) {
+		sm := newSchemaManager()
+		require.Nil(t, sm.AddClass(context.Background(), nil, protected()))
+
+		err := sm.DeleteClass(context.Background(), nil, "Protected", true, false)
+		require.NotNil(t, err)
+		assert.NotNil(t, sm.getClassByName("Protected"))
+	})
+
+	t.Run("delete with override", func(t *testing.T) {
+		sm := newSchemaManager()
+		require.Nil(t, sm.AddClass(context.Background(), nil, protected()))
+
+		require.Nil(t, sm.DeleteClass(context.Background(), nil, "Protected", false, true))
+		assert.Nil(t, sm.getClassByName("Protected"))
+	})
+
+	t.Run("disable protection without override", func(t *testing.T) {
+		sm := newSchemaManager()
+		require.Nil(t, sm.AddClass(context.Background(), nil, protected()))
+
+		err := sm.UpdateClass(context.Background(), nil, "Protected",
+			&models.Class{Class: "Protected"}, false)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "requires 'overrideProtection'")
+	})
+
+	t.Run("disable protection with override", func(t *testing.T) {
+		sm := newSchemaManager()
+		require.Nil(t, sm.AddClass(context.Background(), nil, protected()))
+
+		require.Nil(t, sm.UpdateClass(context.Background(), nil, "Protected",
+			&models.Class{Class: "Protected"}, true))
+		require.Nil(t, sm.DeleteClass(context.Background(), nil, "Protected", false, false))
+	})
+}
+
+func TestConfigFreeze(t *testing.T) {
+	frozen := func() *models.Class {
+		return &models.Class{
+			Class:            "Frozen",
+			ProtectionConfig: &models.ProtectionConfig{ConfigFrozen: true},
+		}
+	}
+
+	t.Run("update without override", func(t *testing.T) {
+		sm := newSchemaManager()
+		require.Nil(t, sm.AddClass(context.Background(), nil, frozen()))
+
+		update := frozen()
+		update.Description = "changed"
+		err := sm.UpdateClass(context.Background(), nil, "Frozen", update, false)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "is frozen")
+		assert.Empty(t, sm.getClassByName("Frozen").Description)
+	})
+
+	t.Run("update with override", func(t *testing.T) {
+		sm := newSchemaManager()
+		require.Nil(t, sm.AddClass(context.Background(), nil, frozen()))
+
+		update := frozen()
+		update.Description = "changed"
+		require.Nil(t, sm.UpdateClass(context.Background(), nil, "Frozen", update, true))
+		assert.Equal(t, "changed", sm.getClassByName("Frozen").Description)
+	})
+
+	t.Run("frozen class can still be deleted", func(t *testing.T) {
+		sm := newSchemaManager()
+		require.Nil(t, sm.AddClass(context.Background(), nil, frozen()))
+
+		require.Nil(t, sm.DeleteClass(context.Background(), nil, "Frozen", false, false))
+	})
+}
@@ -0,0 +1,133 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/errorcompounder"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// RebalanceShards moves replicas of the shards of all classes from
+// overloaded nodes to nodes which hold less than their share, e.g. after
+// nodes have been added, see sharding.State.RebalancePlan. The replicas are
+// moved one after the other. Each move is committed to the cluster as soon
+// as the new owner has an identical copy, so the ownership switches
+// atomically and the progress isn't lost if a later move fails. A failing
+// class doesn't keep the others from being rebalanced.
+func (m *Manager) RebalanceShards(ctx context.Context) error {
+	m.RLock()
+	classes := make([]string, 0, len(m.state.ObjectSchema.Classes))
+	for _, class := range m.state.ObjectSchema.Classes {
+		classes = append(classes, class.Class)
+	}
+	m.RUnlock()
+
+	var errs errorcompounder.ErrorCompounder
+	for _, className := range classes {
+		if err := m.rebalanceClass(ctx, className); err != nil {
+			errs.AddWrap(err, fmt.Sprintf("rebalance class %q", className))
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return errs.ToError()
+}
+
+func (m *Manager) rebalanceClass(ctx context.Context, className string) error {
+	m.shardingStateLock.RLock()
+	current, ok := m.state.ShardingState[className]
+	var state sharding.State
+	if ok {
+		state = current.DeepCopy()
+	}
+	m.shardingStateLock.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	moves, err := state.RebalancePlan(m.clusterState)
+	if err != nil {
+		return err
+	}
+
+	for _, move := range moves {
+		// the copy is taken without holding the lock of the schema, as it may
+		// take long, the move is validated against the current state when
+		// the ownership is switched
+		if err := m.scaleOut.MoveReplica(ctx, className, move); err != nil {
+			return fmt.Errorf("move shard %q from node %q to %q: %w",
+				move.Shard, move.From, move.To, err)
+		}
+
+		if committed, err := m.switchReplica(ctx, className, move); err != nil {
+			if committed {
+				return fmt.Errorf("apply move of shard %q from node %q to %q: %w",
+					move.Shard, move.From, move.To, err)
+			}
+			if cerr := m.scaleOut.CancelMove(context.Background(), className, move); cerr != nil {
+				m.logger.WithField("action", "rebalance").
+					WithField("class", className).
+					WithField("shard", move.Shard).
+					WithError(cerr).
+					Error("make shard writable again after failed move")
+			}
+			return fmt.Errorf("switch shard %q from node %q to %q: %w",
+				move.Shard, move.From, move.To, err)
+		}
+
+		m.logger.WithField("action", "rebalance").
+			WithField("class", className).
+			WithField("shard", move.Shard).
+			WithField("from", move.From).
+			WithField("to", move.To).
+			Info("moved replica of shard")
+	}
+	return nil
+}
+
+// switchReplica commits the move of the replica to the cluster. It returns
+// whether the move has been committed, as the previous owner is dropped from
+// then on.
+func (m *Manager) switchReplica(ctx context.Context, className string,
+	move sharding.Move,
+) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	class := m.getClassByName(className)
+	if class == nil {
+		return false, ErrNotFound
+	}
+
+	m.shardingStateLock.RLock()
+	updated := m.state.ShardingState[className].DeepCopy()
+	m.shardingStateLock.RUnlock()
+	if err := updated.ApplyMove(move); err != nil {
+		return false, err
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, UpdateClass,
+		UpdateClassPayload{className, class, &updated}, DefaultTxTTL)
+	if err != nil {
+		return false, errors.Wrap(err, "open cluster-wide transaction")
+	}
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		return false, errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	return true, m.updateClassApplyChanges(ctx, className, class, &updated)
+}
@@ -0,0 +1,220 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// TrashedClass is a deleted class in the recycle bin. Its data is kept until
+// the retention ends, so that it can be restored.
+type TrashedClass struct {
+	ID            string          `json:"id"`
+	Class         *models.Class   `json:"class"`
+	ShardingState *sharding.State `json:"shardingState"`
+	// DeletionTime in ms since epoch
+	DeletionTime int64 `json:"deletionTime"`
+}
+
+func (m *Manager) trashRetention() time.Duration {
+	return time.Duration(m.config.RecycleBin.RetentionSeconds) * time.Second
+}
+
+func (m *Manager) findTrashedClass(id string) (int, *TrashedClass) {
+	for i, trashed := range m.state.Trash {
+		if trashed.ID == id {
+			return i, trashed
+		}
+	}
+	return -1, nil
+}
+
+// ListTrash returns the deleted classes which can be restored
+func (m *Manager) ListTrash(ctx context.Context, principal *models.Principal) (models.TrashedClassList, error) {
+	err := m.Authorizer.Authorize(principal, "list", "schema/*")
+	if err != nil {
+		return nil, err
+	}
+
+	m.RLock()
+	defer m.RUnlock()
+
+	retention := m.trashRetention().Milliseconds()
+	out := make(models.TrashedClassList, len(m.state.Trash))
+	for i, trashed := range m.state.Trash {
+		out[i] = &models.TrashedClass{
+			ID:                 trashed.ID,
+			Class:              trashed.Class.Class,
+			DeletionTimeUnix:   trashed.DeletionTime,
+			ExpirationTimeUnix: trashed.DeletionTime + retention,
+		}
+	}
+	return out, nil
+}
+
+// RestoreTrashedClass restores a deleted class and its data from the recycle
+// bin. This fails if a class with the same name has been created since.
+func (m *Manager) RestoreTrashedClass(ctx context.Context, principal *models.Principal,
+	id string,
+) (*models.Class, error) {
+	err := m.Authorizer.Authorize(principal, "create", "schema/objects")
+	if err != nil {
+		return nil, err
+	}
+
+	class, shardState, err := m.restoreTrashedClass(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// call to migrator needs to be outside the lock that is set in
+	// restoreTrashedClass
+	if err := m.migrator.AddClass(ctx, class, shardState); err != nil {
+		return nil, errors.Wrap(err, "load restored class")
+	}
+	return class, nil
+}
+
+func (m *Manager) restoreTrashedClass(ctx context.Context, id string,
+) (*models.Class, *sharding.State, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	_, trashed := m.findTrashedClass(id)
+	if trashed == nil {
+		return nil, nil, ErrNotFound
+	}
+	if m.getClassByName(trashed.Class.Class) != nil {
+		return nil, nil, fmt.Errorf("class %q already exists, it has to be deleted "+
+			"before restoring the deleted class", trashed.Class.Class)
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, RestoreTrashedClass,
+		RestoreTrashedClassPayload{ID: id}, DefaultTxTTL)
+	if err != nil {
+		// possible causes for errors could be nodes down (we expect every node to
+		// be up for a schema transaction) or concurrent transactions from other
+		// nodes
+		return nil, nil, errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		return nil, nil, errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	return m.restoreTrashedClassApplyChanges(ctx, id)
+}
+
+func (m *Manager) restoreTrashedClassApplyChanges(ctx context.Context, id string,
+) (*models.Class, *sharding.State, error) {
+	idx, trashed := m.findTrashedClass(id)
+	if trashed == nil {
+		return nil, nil, ErrNotFound
+	}
+
+	// the configs of the class are only parsed while it's part of the schema,
+	// the round trip makes sure they're parsed from the same input as after a
+	// restart
+	raw, err := json.Marshal(trashed)
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshal deleted class: %w", err)
+	}
+	var restored TrashedClass
+	if err := json.Unmarshal(raw, &restored); err != nil {
+		return nil, nil, fmt.Errorf("unmarshal deleted class: %w", err)
+	}
+	class, shardState := restored.Class, restored.ShardingState
+	if err := m.parseShardingConfig(ctx, class); err != nil {
+		return nil, nil, err
+	}
+	if err := m.parseVectorIndexConfig(ctx, class); err != nil {
+		return nil, nil, err
+	}
+	shardState.SetLocalName(m.clusterState.LocalName())
+
+	if err := m.migrator.RestoreTrashedClass(ctx, id); err != nil {
+		return nil, nil, errors.Wrap(err, "restore files")
+	}
+
+	m.state.Trash = append(m.state.Trash[:idx], m.state.Trash[idx+1:]...)
+	if err := m.addClassApplyChanges(ctx, class, shardState); err != nil {
+		return nil, nil, err
+	}
+	return class, shardState, nil
+}
+
+// PurgeExpiredTrash removes the deleted classes whose retention has ended from
+// the recycle bin. Every node purges its recycle bin independently.
+func (m *Manager) PurgeExpiredTrash(ctx context.Context) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if len(m.state.Trash) == 0 {
+		return nil
+	}
+
+	cutoff := time.Now().Add(-m.trashRetention()).UnixMilli()
+	var kept []*TrashedClass
+	var purgeErr error
+	for _, trashed := range m.state.Trash {
+		if trashed.DeletionTime > cutoff {
+			kept = append(kept, trashed)
+			continue
+		}
+		if err := m.migrator.DeleteTrashedClass(ctx, trashed.ID); err != nil {
+			if purgeErr == nil {
+				purgeErr = err
+			}
+			kept = append(kept, trashed)
+			continue
+		}
+		m.logger.WithField("action", "purge_recycle_bin").
+			WithField("class", trashed.Class.Class).
+			WithField("id", trashed.ID).
+			Info("removed deleted class from recycle bin")
+	}
+
+	if len(kept) == len(m.state.Trash) {
+		return purgeErr
+	}
+	m.state.Trash = kept
+	if err := m.saveSchema(ctx); err != nil {
+		return err
+	}
+	return purgeErr
+}
+
+// PurgeTrashPeriodically calls PurgeExpiredTrash every interval until ctx is
+// done
+func (m *Manager) PurgeTrashPeriodically(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := m.PurgeExpiredTrash(ctx); err != nil {
+				m.logger.WithField("action", "purge_recycle_bin").
+					WithError(err).Error("could not purge recycle bin")
+			}
+		}
+	}
+}
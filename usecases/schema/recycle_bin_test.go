@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestRecycleBin(t *testing.T) {
+	ctx := context.Background()
+	newManager := func(t *testing.T) *Manager {
+		sm := newSchemaManager()
+		sm.config.RecycleBin.RetentionSeconds = 3600
+		require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+			Class: "Deleted",
+			Properties: []*models.Property{{
+				Name:     "name",
+				DataType: []string{"text"},
+			}},
+		}))
+		require.Nil(t, sm.DeleteClass(ctx, nil, "Deleted", false, false))
+		return sm
+	}
+
+	t.Run("deleted class is moved into the recycle bin", func(t *testing.T) {
+		sm := newManager(t)
+		assert.Nil(t, sm.getClassByName("Deleted"))
+
+		trash, err := sm.ListTrash(ctx, nil)
+		require.Nil(t, err)
+		require.Len(t, trash, 1)
+		assert.Equal(t, "Deleted", trash[0].Class)
+		assert.Equal(t, trash[0].DeletionTimeUnix+3600*1000, trash[0].ExpirationTimeUnix)
+	})
+
+	t.Run("recycle bin disabled", func(t *testing.T) {
+		sm := newSchemaManager()
+		require.Nil(t, sm.AddClass(ctx, nil, &models.Class{Class: "Deleted"}))
+		require.Nil(t, sm.DeleteClass(ctx, nil, "Deleted", false, false))
+
+		trash, err := sm.ListTrash(ctx, nil)
+		require.Nil(t, err)
+		assert.Len(t, trash, 0)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		sm := newManager(t)
+		trash, err := sm.ListTrash(ctx, nil)
+		require.Nil(t, err)
+
+		class, err := sm.RestoreTrashedClass(ctx, nil, trash[0].ID)
+		require.Nil(t, err)
+		assert.Equal(t, "Deleted", class.Class)
+
+		restored := sm.getClassByName("Deleted")
+		require.NotNil(t, restored)
+		require.Len(t, restored.Properties, 1)
+		assert.Equal(t, "name", restored.Properties[0].Name)
+		assert.NotNil(t, sm.ShardingState("Deleted"))
+
+		trash, err = sm.ListTrash(ctx, nil)
+		require.Nil(t, err)
+		assert.Len(t, trash, 0)
+	})
+
+	t.Run("restore unknown id", func(t *testing.T) {
+		sm := newManager(t)
+		_, err := sm.RestoreTrashedClass(ctx, nil, "Unknown-1")
+		assert.Equal(t, ErrNotFound, err)
+	})
+
+	t.Run("restore when a class with the same name exists", func(t *testing.T) {
+		sm := newManager(t)
+		require.Nil(t, sm.AddClass(ctx, nil, &models.Class{Class: "Deleted"}))
+		trash, err := sm.ListTrash(ctx, nil)
+		require.Nil(t, err)
+
+		_, err = sm.RestoreTrashedClass(ctx, nil, trash[0].ID)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "already exists")
+
+		trash, err = sm.ListTrash(ctx, nil)
+		require.Nil(t, err)
+		assert.Len(t, trash, 1)
+	})
+
+	t.Run("purge expired classes", func(t *testing.T) {
+		sm := newManager(t)
+		require.Nil(t, sm.AddClass(ctx, nil, &models.Class{Class: "Expired"}))
+		require.Nil(t, sm.DeleteClass(ctx, nil, "Expired", false, false))
+		_, expired := sm.findTrashedClass(sm.state.Trash[1].ID)
+		expired.DeletionTime = time.Now().Add(-2 * time.Hour).UnixMilli()
+
+		require.Nil(t, sm.PurgeExpiredTrash(ctx))
+
+		trash, err := sm.ListTrash(ctx, nil)
+		require.Nil(t, err)
+		require.Len(t, trash, 1)
+		assert.Equal(t, "Deleted", trash[0].Class)
+	})
+}
@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// ShardedNodes returns all nodes which hold shards of any class
+func (m *Manager) ShardedNodes() []string {
+	m.shardingStateLock.RLock()
+	defer m.shardingStateLock.RUnlock()
+
+	seen := map[string]struct{}{}
+	var nodes []string
+	for _, state := range m.state.ShardingState {
+		for _, shard := range state.Physical {
+			for _, node := range shard.BelongsToNodes {
+				if _, ok := seen[node]; !ok {
+					seen[node] = struct{}{}
+					nodes = append(nodes, node)
+				}
+			}
+		}
+	}
+	return nodes
+}
+
+// ReplaceNodes re-creates the replicas of all replicated classes which are on
+// the given nodes on other nodes, and broadcasts the updated sharding states
+// to the cluster. It is used to restore the replication factor after nodes
+// failed permanently.
+func (m *Manager) ReplaceNodes(ctx context.Context, nodes []string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	for _, class := range m.state.ObjectSchema.Classes {
+		if class.ReplicationConfig == nil || class.ReplicationConfig.Factor <= 1 {
+			continue
+		}
+
+		updatedState, err := m.scaleOut.ReplaceNodes(ctx, class.Class,
+			class.ReplicationConfig.Factor, nodes)
+		if err != nil {
+			return fmt.Errorf("replace replicas of class %q: %w", class.Class, err)
+		}
+		if updatedState == nil {
+			continue
+		}
+
+		tx, err := m.cluster.BeginTransaction(ctx, UpdateClass,
+			UpdateClassPayload{class.Class, class, updatedState}, DefaultTxTTL)
+		if err != nil {
+			return errors.Wrap(err, "open cluster-wide transaction")
+		}
+		if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+			return errors.Wrap(err, "commit cluster-wide transaction")
+		}
+		if err := m.updateClassApplyChanges(ctx, class.Class, class, updatedState); err != nil {
+			return err
+		}
+
+		m.logger.WithField("action", "replace_nodes").
+			WithField("class", class.Class).
+			WithField("nodes", nodes).
+			Info("re-created replicas of failed nodes")
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/replay"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+const (
+	replayDefaultLimit = 100
+	replayMaxLimit     = 1000
+)
+
+// ReplayClass replays a page of the writes to a class within a time range
+// from the write-ahead logs retained by its shards, see
+// InvertedIndexConfig.WalRetentionSeconds. The writes are applied to the
+// target class, to a class of another cluster or returned if the request has
+// no target.
+//
+// Objects copied to a target can't be redacted anymore, so replaying into a
+// target requires the permission to read all restricted properties of the
+// class. Returned objects are redacted.
+func (m *Manager) ReplayClass(ctx context.Context, principal *models.Principal,
+	className string, req *models.ClassReplayRequest,
+) (*models.ClassReplayResult, error) {
+	err := m.Authorizer.Authorize(principal, "update",
+		fmt.Sprintf("schema/%s/shards", className))
+	if err != nil {
+		return nil, err
+	}
+
+	class := m.getClassByName(className)
+	if class == nil {
+		return nil, ErrNotFound
+	}
+
+	from, to := req.From, req.To
+	if to == 0 {
+		to = time.Now().UnixMilli()
+	}
+	if from < 0 || from > to {
+		return nil, fmt.Errorf("invalid time range from %d to %d", from, to)
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = replayDefaultLimit
+	}
+	if limit < 0 || limit > replayMaxLimit {
+		return nil, fmt.Errorf("limit must be between 1 and %d", replayMaxLimit)
+	}
+
+	if _, err := replay.ParseCursor(req.After); err != nil {
+		return nil, err
+	}
+
+	redactor := authorization.NewRedactor(m.Authorizer,
+		m.config.Authorization.RestrictedProperties)
+
+	if req.TargetURL != "" {
+		target, err := newClusterReplayTarget(req, class.Class)
+		if err != nil {
+			return nil, err
+		}
+		if err := redactor.AuthorizeClass(principal, class.Class); err != nil {
+			return nil, err
+		}
+
+		page, err := m.migrator.ReplayClass(ctx, class.Class, from, to, req.After, limit, "")
+		if err != nil {
+			return nil, err
+		}
+		return target.apply(ctx, page, req.After), nil
+	}
+
+	target := req.TargetClass
+	if target != "" {
+		if err := m.Authorizer.Authorize(principal, "create", "batch/objects"); err != nil {
+			return nil, err
+		}
+		if err := redactor.AuthorizeClass(principal, class.Class); err != nil {
+			return nil, err
+		}
+		targetClass := m.getClassByName(target)
+		if targetClass == nil {
+			return nil, fmt.Errorf("target class %q does not exist", target)
+		}
+		if targetClass.Class == class.Class {
+			return nil, fmt.Errorf("target class must differ from the replayed class")
+		}
+		target = targetClass.Class
+	}
+
+	res, err := m.migrator.ReplayClass(ctx, class.Class, from, to, req.After, limit, target)
+	if err != nil {
+		return nil, err
+	}
+	redactor.RedactObjects(principal, res.Objects)
+	return res, nil
+}
@@ -0,0 +1,226 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/replay"
+)
+
+// replayBatchSize is the maximum number of objects sent to another cluster
+// with a single batch request
+const replayBatchSize = 100
+
+// clusterReplayTarget applies replayed writes to a class of another cluster
+// through its REST API
+type clusterReplayTarget struct {
+	client  *http.Client
+	baseURL string
+	headers map[string]string
+	class   string
+}
+
+func newClusterReplayTarget(req *models.ClassReplayRequest,
+	className string,
+) (*clusterReplayTarget, error) {
+	if err := validateReplayTargetURL(req.TargetURL); err != nil {
+		return nil, err
+	}
+
+	target := &clusterReplayTarget{
+		client:  &http.Client{},
+		baseURL: strings.TrimSuffix(req.TargetURL, "/"),
+		headers: req.TargetHeaders,
+		class:   req.TargetClass,
+	}
+	if target.class == "" {
+		target.class = className
+	}
+	return target, nil
+}
+
+func validateReplayTargetURL(target string) error {
+	parsed, err := url.Parse(target)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("invalid target url %q", target)
+	}
+	return nil
+}
+
+// replayedWrite is a returned object or deletion of a replayed page
+type replayedWrite struct {
+	cursor replay.Cursor
+	object *models.Object
+}
+
+// apply applies the writes of a page in their order. Objects are sent in
+// batches, a batch is cut short by a deletion so that the order of the
+// writes is kept. If a write fails, the result contains the error and the
+// cursor after the last applied write.
+func (t *clusterReplayTarget) apply(ctx context.Context, page *models.ClassReplayResult,
+	after string,
+) *models.ClassReplayResult {
+	writes := make([]replayedWrite, 0, len(page.Objects)+len(page.Deletions))
+	for _, obj := range page.Objects {
+		writes = append(writes, replayedWrite{
+			cursor: replay.Cursor{Time: obj.LastUpdateTimeUnix, ID: obj.ID},
+			object: obj,
+		})
+	}
+	for _, del := range page.Deletions {
+		writes = append(writes, replayedWrite{
+			cursor: replay.Cursor{Time: del.DeletedAt, ID: del.ID},
+		})
+	}
+	sort.Slice(writes, func(a, b int) bool {
+		return writes[a].cursor.Before(writes[b].cursor)
+	})
+
+	result := &models.ClassReplayResult{
+		Class:       page.Class,
+		TargetClass: t.class,
+		Objects:     []*models.Object{},
+		Deletions:   []*models.ClassReplayDeletion{},
+		Next:        page.Next,
+	}
+	fail := func(pos int, err error) *models.ClassReplayResult {
+		result.Error = err.Error()
+		result.Next = after
+		if pos > 0 {
+			result.Next = writes[pos-1].cursor.String()
+		}
+		return result
+	}
+
+	for pos := 0; pos < len(writes); {
+		if writes[pos].object == nil {
+			if err := t.deleteObject(ctx, writes[pos].cursor.ID); err != nil {
+				return fail(pos, err)
+			}
+			result.Deleted++
+			pos++
+			continue
+		}
+
+		end := pos
+		for end < len(writes) && end-pos < replayBatchSize && writes[end].object != nil {
+			end++
+		}
+		batch := make([]*models.Object, 0, end-pos)
+		for _, w := range writes[pos:end] {
+			batch = append(batch, w.object)
+		}
+		applied, err := t.putObjects(ctx, batch)
+		result.Replayed += int64(applied)
+		if err != nil {
+			return fail(pos+applied, err)
+		}
+		pos = end
+	}
+	return result
+}
+
+// putObjects returns the number of objects of the batch which have been
+// stored before the first failed one
+func (t *clusterReplayTarget) putObjects(ctx context.Context,
+	objs []*models.Object,
+) (int, error) {
+	body := struct {
+		Objects []*models.Object `json:"objects"`
+	}{Objects: make([]*models.Object, len(objs))}
+	for i, obj := range objs {
+		o := *obj
+		o.Class = t.class
+		o.Additional = nil
+		body.Objects[i] = &o
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return 0, errors.Wrap(err, "marshal objects")
+	}
+
+	resBody, err := t.do(ctx, http.MethodPost, "/v1/batch/objects", payload, http.StatusOK)
+	if err != nil {
+		return 0, err
+	}
+
+	var res []*models.ObjectsGetResponse
+	if err := json.Unmarshal(resBody, &res); err != nil {
+		return 0, errors.Wrap(err, "unmarshal batch response")
+	}
+	if len(res) != len(objs) {
+		return 0, errors.Errorf("batch response has %d results for %d objects",
+			len(res), len(objs))
+	}
+	for i, r := range res {
+		if r.Result != nil && r.Result.Errors != nil && len(r.Result.Errors.Error) > 0 {
+			return i, errors.Errorf("replay object %s: %s", objs[i].ID,
+				r.Result.Errors.Error[0].Message)
+		}
+	}
+	return len(objs), nil
+}
+
+func (t *clusterReplayTarget) deleteObject(ctx context.Context, id strfmt.UUID) error {
+	path := fmt.Sprintf("/v1/objects/%s/%s", t.class, id)
+	_, err := t.do(ctx, http.MethodDelete, path, nil, http.StatusNoContent, http.StatusNotFound)
+	if err != nil {
+		return errors.Wrapf(err, "replay deletion of %s", id)
+	}
+	return nil
+}
+
+func (t *clusterReplayTarget) do(ctx context.Context, method, path string,
+	payload []byte, expected ...int,
+) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, t.baseURL+path,
+		bytes.NewReader(payload))
+	if err != nil {
+		return nil, errors.Wrap(err, "create request")
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for key, value := range t.headers {
+		req.Header.Set(key, value)
+	}
+
+	res, err := t.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send request")
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response")
+	}
+	for _, code := range expected {
+		if res.StatusCode == code {
+			return body, nil
+		}
+	}
+	return nil, errors.Errorf("%s %s: status code %d: %s", method, path,
+		res.StatusCode, body)
+}
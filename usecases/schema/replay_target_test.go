@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type fakeReplayCluster struct {
+	sync.Mutex
+	objects map[string]*models.Object
+	deleted []string
+	headers []string
+	// failID is rejected by batch requests
+	failID string
+}
+
+func (c *fakeReplayCluster) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.Lock()
+	defer c.Unlock()
+	c.headers = append(c.headers, r.Header.Get("Authorization"))
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/batch/objects":
+		var body struct {
+			Objects []*models.Object `json:"objects"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		res := make([]*models.ObjectsGetResponse, len(body.Objects))
+		for i, obj := range body.Objects {
+			res[i] = &models.ObjectsGetResponse{Object: *obj}
+			if obj.ID.String() == c.failID {
+				res[i].Result = &models.ObjectsGetResponseAO2Result{
+					Errors: &models.ErrorResponse{Error: []*models.ErrorResponseErrorItems0{
+						{Message: "rejected"},
+					}},
+				}
+				continue
+			}
+			c.objects[obj.ID.String()] = obj
+		}
+		json.NewEncoder(w).Encode(res)
+	case r.Method == http.MethodDelete:
+		c.deleted = append(c.deleted, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestClusterReplayTarget(t *testing.T) {
+	page := &models.ClassReplayResult{
+		Class: "Article",
+		Objects: []*models.Object{
+			{ID: "00000000-0000-0000-0000-000000000003", Class: "Article", LastUpdateTimeUnix: 3000},
+			{ID: "00000000-0000-0000-0000-000000000001", Class: "Article", LastUpdateTimeUnix: 1000},
+			{ID: "00000000-0000-0000-0000-000000000004", Class: "Article", LastUpdateTimeUnix: 4000},
+		},
+		Deletions: []*models.ClassReplayDeletion{
+			{ID: "00000000-0000-0000-0000-000000000002", DeletedAt: 2000},
+		},
+		Next: "4000_00000000-0000-0000-0000-000000000004",
+	}
+
+	newTarget := func(t *testing.T, cluster *fakeReplayCluster) *clusterReplayTarget {
+		server := httptest.NewServer(cluster)
+		t.Cleanup(server.Close)
+
+		target, err := newClusterReplayTarget(&models.ClassReplayRequest{
+			TargetURL:     server.URL + "/",
+			TargetClass:   "Copy",
+			TargetHeaders: map[string]string{"Authorization": "Bearer key"},
+		}, "Article")
+		require.Nil(t, err)
+		return target
+	}
+
+	t.Run("writes are applied in their order", func(t *testing.T) {
+		cluster := &fakeReplayCluster{objects: map[string]*models.Object{}}
+
+		res := newTarget(t, cluster).apply(context.Background(), page, "")
+		assert.Empty(t, res.Error)
+		assert.Equal(t, "Copy", res.TargetClass)
+		assert.Equal(t, int64(3), res.Replayed)
+		assert.Equal(t, int64(1), res.Deleted)
+		assert.Equal(t, page.Next, res.Next)
+
+		assert.Equal(t, []string{"/v1/objects/Copy/00000000-0000-0000-0000-000000000002"},
+			cluster.deleted)
+		require.Len(t, cluster.objects, 3)
+		assert.Equal(t, "Copy", cluster.objects["00000000-0000-0000-0000-000000000001"].Class)
+		// one batch before and one after the deletion
+		assert.Equal(t, []string{"Bearer key", "Bearer key", "Bearer key"}, cluster.headers)
+	})
+
+	t.Run("a failed write reports the progress", func(t *testing.T) {
+		cluster := &fakeReplayCluster{
+			objects: map[string]*models.Object{},
+			failID:  "00000000-0000-0000-0000-000000000004",
+		}
+
+		res := newTarget(t, cluster).apply(context.Background(), page, "500_00000000-0000-0000-0000-000000000000")
+		assert.Contains(t, res.Error, "rejected")
+		assert.Equal(t, int64(2), res.Replayed)
+		assert.Equal(t, int64(1), res.Deleted)
+		assert.Equal(t, "3000_00000000-0000-0000-0000-000000000003", res.Next)
+
+		cluster.failID = "00000000-0000-0000-0000-000000000001"
+		res = newTarget(t, cluster).apply(context.Background(), page, "500_00000000-0000-0000-0000-000000000000")
+		assert.Equal(t, int64(0), res.Replayed)
+		assert.Equal(t, "500_00000000-0000-0000-0000-000000000000", res.Next)
+	})
+
+	t.Run("invalid target url", func(t *testing.T) {
+		_, err := newClusterReplayTarget(&models.ClassReplayRequest{
+			TargetURL: "ftp://weaviate",
+		}, "Article")
+		assert.NotNil(t, err)
+	})
+}
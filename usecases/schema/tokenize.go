@@ -0,0 +1,76 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// TokenizeProperty shows how the text is analyzed for the inverted index of a
+// property and for BM25 queries on it, e.g. to find out why a query doesn't
+// match the expected objects
+func (m *Manager) TokenizeProperty(ctx context.Context, principal *models.Principal,
+	className, propName, text string,
+) (*models.TokenizationPreview, error) {
+	err := m.Authorizer.Authorize(principal, "list", "schema/*")
+	if err != nil {
+		return nil, err
+	}
+
+	class := m.getClassByName(className)
+	if class == nil {
+		return nil, ErrNotFound
+	}
+	prop, err := schema.GetPropertyByName(class, propName)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+	if prop.IndexInverted != nil && !*prop.IndexInverted {
+		return nil, fmt.Errorf("property %q is not indexed", propName)
+	}
+
+	var detector stopwords.StopwordDetector
+	if cfg := class.InvertedIndexConfig; cfg != nil && cfg.Stopwords != nil {
+		d, err := stopwords.NewDetectorFromConfig(*cfg.Stopwords)
+		if err != nil {
+			return nil, err
+		}
+		detector = d
+	}
+
+	preview, err := inverted.PreviewTokenization(prop, detector, text)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.TokenizationPreview{
+		Text:         text,
+		Tokenization: prop.Tokenization,
+		Tokens:       nonNil(preview.Tokens),
+		QueryTerms:   nonNil(preview.QueryTerms),
+		Stopwords:    nonNil(preview.Stopwords),
+	}, nil
+}
+
+// nonNil makes empty lists marshal as such instead of null
+func nonNil(terms []string) []string {
+	if terms == nil {
+		return []string{}
+	}
+	return terms
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/network/ipfilter"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
@@ -28,6 +29,13 @@ const (
 	DeleteClass cluster.TransactionType = "delete_class"
 	UpdateClass cluster.TransactionType = "update_class"
 
+	RestoreTrashedClass cluster.TransactionType = "restore_trashed_class"
+
+	AddMigration    cluster.TransactionType = "add_migration"
+	FinishMigration cluster.TransactionType = "finish_migration"
+
+	UpdateNetworkAccess cluster.TransactionType = "update_network_access"
+
 	// read-only
 	ReadSchema cluster.TransactionType = "read_schema"
 
@@ -47,6 +55,33 @@ type AddPropertyPayload struct {
 type DeleteClassPayload struct {
 	ClassName string `json:"className"`
 	Force     bool   `json:"force"`
+
+	// TrashID is set if the class is moved into the recycle bin
+	TrashID string `json:"trashId,omitempty"`
+	// DeletionTime in ms since epoch
+	DeletionTime int64 `json:"deletionTime,omitempty"`
+}
+
+type RestoreTrashedClassPayload struct {
+	ID string `json:"id"`
+}
+
+type AddMigrationPayload struct {
+	Migration *Migration `json:"migration"`
+}
+
+type FinishMigrationPayload struct {
+	ClassName string `json:"className"`
+	ID        string `json:"id"`
+	// Error is set if the migration failed
+	Error string `json:"error,omitempty"`
+	// EndTime in ms since epoch
+	EndTime int64 `json:"endTime"`
+}
+
+type UpdateNetworkAccessPayload struct {
+	Listener string         `json:"listener"`
+	Rules    ipfilter.Rules `json:"rules"`
 }
 
 type UpdateClassPayload struct {
@@ -80,6 +115,18 @@ func UnmarshalTransaction(txType cluster.TransactionType,
 	case UpdateClass:
 		return unmarshalUpdateClass(payload)
 
+	case RestoreTrashedClass:
+		return unmarshalRestoreTrashedClass(payload)
+
+	case AddMigration:
+		return unmarshalAddMigration(payload)
+
+	case FinishMigration:
+		return unmarshalFinishMigration(payload)
+
+	case UpdateNetworkAccess:
+		return unmarshalUpdateNetworkAccess(payload)
+
 	case ReadSchema:
 		return unmarshalReadSchema(payload)
 
@@ -125,6 +172,42 @@ func unmarshalUpdateClass(payload json.RawMessage) (interface{}, error) {
 	return pl, nil
 }
 
+func unmarshalRestoreTrashedClass(payload json.RawMessage) (interface{}, error) {
+	var pl RestoreTrashedClassPayload
+	if err := json.Unmarshal(payload, &pl); err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
+
+func unmarshalAddMigration(payload json.RawMessage) (interface{}, error) {
+	var pl AddMigrationPayload
+	if err := json.Unmarshal(payload, &pl); err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
+
+func unmarshalFinishMigration(payload json.RawMessage) (interface{}, error) {
+	var pl FinishMigrationPayload
+	if err := json.Unmarshal(payload, &pl); err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
+
+func unmarshalUpdateNetworkAccess(payload json.RawMessage) (interface{}, error) {
+	var pl UpdateNetworkAccessPayload
+	if err := json.Unmarshal(payload, &pl); err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
+
 func unmarshalReadSchema(payload json.RawMessage) (interface{}, error) {
 	var pl ReadSchemaPayload
 	if err := json.Unmarshal(payload, &pl); err != nil {
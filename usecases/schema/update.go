@@ -23,8 +23,23 @@ import (
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
+// UpdateClass replaces the mutable settings of the class. Classes with a
+// frozen config and updates which lower the protection of a class require
+// overrideProtection.
 func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
-	className string, updated *models.Class,
+	className string, updated *models.Class, overrideProtection bool,
+) error {
+	if err := m.replaceClass(ctx, principal, className, updated,
+		overrideProtection); err != nil {
+		return err
+	}
+
+	// the chunk class is added outside the lock held while updating the class
+	return m.ensureChunkClass(ctx, principal, updated)
+}
+
+func (m *Manager) replaceClass(ctx context.Context, principal *models.Principal,
+	className string, updated *models.Class, overrideProtection bool,
 ) error {
 	m.Lock()
 	defer m.Unlock()
@@ -39,6 +54,10 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 		return ErrNotFound
 	}
 
+	if err := validateProtectionUpdate(initial, updated, overrideProtection); err != nil {
+		return err
+	}
+
 	// make sure unset optionals on 'updated' don't lead to an error, as all
 	// optionals would have been set with defaults on the initial already
 	m.setClassDefaults(updated)
@@ -47,6 +66,13 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 		return err
 	}
 
+	vectorizerConfigChanged := !reflect.DeepEqual(initial.ModuleConfig, updated.ModuleConfig)
+	if vectorizerConfigChanged {
+		if err := m.moduleConfig.ValidateClass(ctx, updated); err != nil {
+			return errors.Wrap(err, "module config")
+		}
+	}
+
 	if err := m.parseVectorIndexConfig(ctx, updated); err != nil {
 		return err
 	}
@@ -61,11 +87,47 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 		return errors.Wrap(err, "vector index config")
 	}
 
+	if err := validateVectorConfigUpdate(initial, updated); err != nil {
+		return err
+	}
+
 	if err := m.migrator.ValidateInvertedIndexConfigUpdate(ctx,
 		initial.InvertedIndexConfig, updated.InvertedIndexConfig); err != nil {
 		return errors.Wrap(err, "inverted index config")
 	}
 
+	if err := validateBoostConfig(updated); err != nil {
+		return err
+	}
+
+	if err := validateBM25PropertyBoosts(updated); err != nil {
+		return err
+	}
+
+	if err := validateIDGenerationConfig(updated); err != nil {
+		return err
+	}
+
+	if err := validateObjectTTL(updated); err != nil {
+		return err
+	}
+
+	if err := validateIngestConfig(updated); err != nil {
+		return err
+	}
+
+	if err := validateVersioningConfig(updated); err != nil {
+		return err
+	}
+
+	if err := validateChunkingConfig(updated); err != nil {
+		return err
+	}
+
+	if err := m.validateChunkClass(updated); err != nil {
+		return err
+	}
+
 	if err := sharding.ValidateConfigUpdate(initial.ShardingConfig.(sharding.Config),
 		updated.ShardingConfig.(sharding.Config), m.clusterState); err != nil {
 		return errors.Wrap(err, "sharding config")
@@ -101,7 +163,19 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 		return errors.Wrap(err, "commit cluster-wide transaction")
 	}
 
-	return m.updateClassApplyChanges(ctx, className, updated, updatedState)
+	if err := m.updateClassApplyChanges(ctx, className, updated, updatedState); err != nil {
+		return err
+	}
+
+	if vectorizerConfigChanged {
+		m.logger.WithField("action", "update_class").
+			WithField("class", className).
+			WithField("vectorizer", updated.Vectorizer).
+			Warn("vectorizer settings changed, existing objects keep their " +
+				"previous vectors until the class is re-vectorized")
+	}
+
+	return nil
 }
 
 func (m *Manager) updateClassApplyChanges(ctx context.Context, className string,
@@ -117,6 +191,11 @@ func (m *Manager) updateClassApplyChanges(ctx context.Context, className string,
 		return errors.Wrap(err, "inverted index config")
 	}
 
+	if err := m.migrator.UpdateVersioningConfig(ctx, className,
+		updated.VersioningConfig); err != nil {
+		return errors.Wrap(err, "versioning config")
+	}
+
 	initial := m.getClassByName(className)
 	if initial == nil {
 		return ErrNotFound
@@ -124,6 +203,7 @@ func (m *Manager) updateClassApplyChanges(ctx context.Context, className string,
 
 	*initial = *updated
 
+	var previous *sharding.State
 	if updatedShardingState != nil {
 		// do not override if transaction does not contain an updated state
 
@@ -131,11 +211,40 @@ func (m *Manager) updateClassApplyChanges(ctx context.Context, className string,
 		// explicitly now.
 		updatedShardingState.SetLocalName(m.clusterState.LocalName())
 		m.shardingStateLock.Lock()
+		previous = m.state.ShardingState[className]
 		m.state.ShardingState[className] = updatedShardingState
 		m.shardingStateLock.Unlock()
 	}
 
-	return m.saveSchema(ctx)
+	if err := m.saveSchema(ctx); err != nil {
+		return err
+	}
+
+	// replicas which have been moved to other nodes are only dropped once
+	// the state without them is persisted, so they are not expected after a
+	// restart
+	if previous != nil {
+		m.dropMovedShards(ctx, className, previous, updatedShardingState)
+	}
+	return nil
+}
+
+// dropMovedShards drops the local shards which the node doesn't own anymore
+func (m *Manager) dropMovedShards(ctx context.Context, className string,
+	previous, updated *sharding.State,
+) {
+	for _, name := range previous.AllLocalPhysicalShards() {
+		if updated.IsShardLocal(name) {
+			continue
+		}
+		if err := m.migrator.DropShard(ctx, className, name); err != nil {
+			m.logger.WithField("action", "drop_moved_shard").
+				WithField("class", className).
+				WithField("shard", name).
+				WithError(err).
+				Error("drop local replica of a shard which has been moved")
+		}
+	}
 }
 
 func (m *Manager) validateImmutableFields(initial, updated *models.Class) error {
@@ -167,13 +276,34 @@ func (m *Manager) validateImmutableFields(initial, updated *models.Class) error
 				"to add additional properties")
 	}
 
-	if !reflect.DeepEqual(initial.ModuleConfig, updated.ModuleConfig) {
-		return errors.Errorf("module config is immutable")
+	if !reflect.DeepEqual(
+		moduleConfigWithoutVectorizer(initial),
+		moduleConfigWithoutVectorizer(updated)) {
+		return errors.Errorf("module config is immutable, except for the " +
+			"class-level settings of the vectorizer")
 	}
 
 	return nil
 }
 
+// moduleConfigWithoutVectorizer returns the module config of the class
+// without the settings of its vectorizer, which may be changed. Objects need
+// to be re-vectorized after such a change.
+func moduleConfigWithoutVectorizer(class *models.Class) interface{} {
+	cfg, ok := class.ModuleConfig.(map[string]interface{})
+	if !ok {
+		return class.ModuleConfig
+	}
+
+	out := make(map[string]interface{}, len(cfg))
+	for module, settings := range cfg {
+		if module != class.Vectorizer {
+			out[module] = settings
+		}
+	}
+	return out
+}
+
 type immutableText struct {
 	accessor func(c *models.Class) string
 	name     string
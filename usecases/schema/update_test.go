@@ -29,7 +29,7 @@ import (
 func TestClassUpdates(t *testing.T) {
 	t.Run("a class which doesn't exist", func(t *testing.T) {
 		err := newSchemaManager().UpdateClass(context.Background(),
-			nil, "WrongClass", &models.Class{})
+			nil, "WrongClass", &models.Class{}, false)
 		require.NotNil(t, err)
 		assert.Equal(t, ErrNotFound, err)
 	})
@@ -213,7 +213,58 @@ func TestClassUpdates(t *testing.T) {
 						},
 					},
 				},
-				expectedError: errors.Errorf("module config is immutable"),
+				expectedError: errors.Errorf("module config is immutable, except for " +
+					"the class-level settings of the vectorizer"),
+			},
+			{
+				name: "updating the module config of the vectorizer",
+				initial: &models.Class{
+					Class:      "InitialName",
+					Vectorizer: "my-module1",
+					ModuleConfig: map[string]interface{}{
+						"my-module1": map[string]interface{}{
+							"my-setting": "some-value",
+						},
+					},
+				},
+				update: &models.Class{
+					Class:      "InitialName",
+					Vectorizer: "my-module1",
+					ModuleConfig: map[string]interface{}{
+						"my-module1": map[string]interface{}{
+							"my-setting": "updated-value",
+						},
+					},
+				},
+			},
+			{
+				name: "attempting to update the module config of another module",
+				initial: &models.Class{
+					Class:      "InitialName",
+					Vectorizer: "my-module1",
+					ModuleConfig: map[string]interface{}{
+						"my-module1": map[string]interface{}{
+							"my-setting": "some-value",
+						},
+						"my-module2": map[string]interface{}{
+							"my-setting": "some-value",
+						},
+					},
+				},
+				update: &models.Class{
+					Class:      "InitialName",
+					Vectorizer: "my-module1",
+					ModuleConfig: map[string]interface{}{
+						"my-module1": map[string]interface{}{
+							"my-setting": "some-value",
+						},
+						"my-module2": map[string]interface{}{
+							"my-setting": "updated-value",
+						},
+					},
+				},
+				expectedError: errors.Errorf("module config is immutable, except for " +
+					"the class-level settings of the vectorizer"),
 			},
 			{
 				name: "updating vector index config",
@@ -237,7 +288,7 @@ func TestClassUpdates(t *testing.T) {
 			t.Run(test.name, func(t *testing.T) {
 				sm := newSchemaManager()
 				assert.Nil(t, sm.AddClass(context.Background(), nil, test.initial))
-				err := sm.UpdateClass(context.Background(), nil, test.initial.Class, test.update)
+				err := sm.UpdateClass(context.Background(), nil, test.initial.Class, test.update, false)
 				if test.expectedError == nil {
 					assert.Nil(t, err)
 				} else {
@@ -274,7 +325,7 @@ func TestClassUpdates(t *testing.T) {
 						VectorIndexConfig: map[string]interface{}{
 							"setting-1": "updated-value",
 						},
-					})
+					}, false)
 				expectedErrMsg := "vector index config: don't think so!"
 				expectedValidateCalledWith := fakeVectorConfig{
 					raw: map[string]interface{}{
@@ -314,7 +365,7 @@ func TestClassUpdates(t *testing.T) {
 						VectorIndexConfig: map[string]interface{}{
 							"setting-1": "updated-value",
 						},
-					})
+					}, false)
 				expectedValidateCalledWith := fakeVectorConfig{
 					raw: map[string]interface{}{
 						"distance":  "cosine",
@@ -371,7 +422,7 @@ func TestClassUpdates(t *testing.T) {
 						ShardingConfig: map[string]interface{}{
 							"desiredCount": json.Number("7"),
 						},
-					})
+					}, false)
 				expectedErrMsg := "sharding config: re-sharding not supported yet: shard count is immutable: attempted change from \"1\" to \"7\""
 				require.NotNil(t, err)
 				assert.Equal(t, expectedErrMsg, err.Error())
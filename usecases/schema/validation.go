@@ -14,10 +14,15 @@ package schema
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"regexp"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/tokenizer"
+	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -47,11 +52,17 @@ func validatePropertyTokenization(tokenization string, propertyDataType schema.P
 			case models.PropertyTokenizationField, models.PropertyTokenizationWord:
 				return nil
 			}
+			if tokenizer.Get(tokenization) != nil {
+				return nil
+			}
 		case schema.DataTypeText, schema.DataTypeTextArray:
 			switch tokenization {
 			case models.PropertyTokenizationWord:
 				return nil
 			}
+			if tokenizer.Get(tokenization) != nil {
+				return nil
+			}
 		default:
 			if tokenization == "" {
 				return nil
@@ -68,6 +79,136 @@ func validatePropertyTokenization(tokenization string, propertyDataType schema.P
 	return fmt.Errorf("Tokenization '%s' is not allowed for reference data type", tokenization)
 }
 
+// validatePropertyIndexOnly makes sure that index-only properties are
+// indexed, otherwise their values would be dropped
+func validatePropertyIndexOnly(property *models.Property, propertyDataType schema.PropertyDataType) error {
+	if !schema.PropertyIsIndexOnly(property) {
+		return nil
+	}
+
+	if property.IndexInverted != nil && !*property.IndexInverted {
+		return fmt.Errorf("property %q: indexOnly requires indexInverted", property.Name)
+	}
+	if !propertyDataType.IsPrimitive() {
+		return fmt.Errorf("property %q: indexOnly is not allowed for reference data type", property.Name)
+	}
+	if propertyDataType.AsPrimitive() == schema.DataTypeBlob {
+		return fmt.Errorf("property %q: indexOnly is not allowed for data type %q",
+			property.Name, schema.DataTypeBlob)
+	}
+	return nil
+}
+
+// validateLanguageDetection makes sure that the language is only detected
+// for indexed text properties which are tokenized into words, the only ones
+// whose analysis depends on the language
+func validateLanguageDetection(property *models.Property, propertyDataType schema.PropertyDataType) error {
+	if !property.LanguageDetection {
+		return nil
+	}
+
+	if !propertyDataType.IsPrimitive() ||
+		(propertyDataType.AsPrimitive() != schema.DataTypeText &&
+			propertyDataType.AsPrimitive() != schema.DataTypeTextArray) {
+		return fmt.Errorf("property %q: languageDetection requires data type %q or %q",
+			property.Name, schema.DataTypeText, schema.DataTypeTextArray)
+	}
+	if property.Tokenization != models.PropertyTokenizationWord {
+		return fmt.Errorf("property %q: languageDetection requires tokenization %q",
+			property.Name, models.PropertyTokenizationWord)
+	}
+	if property.IndexInverted != nil && !*property.IndexInverted {
+		return fmt.Errorf("property %q: languageDetection requires indexInverted", property.Name)
+	}
+	return nil
+}
+
+// validateBM25Pruning makes sure that postings are only pruned for indexed
+// text properties, the only ones which are scored with BM25. The threshold
+// is compared with the term frequency component of the score and must therefore lie in [0, 1)
+func validateBM25Pruning(property *models.Property, propertyDataType schema.PropertyDataType) error {
+	if property.Bm25PruningThreshold == 0 {
+		return nil
+	}
+
+	if property.Bm25PruningThreshold < 0 || property.Bm25PruningThreshold >= 1 {
+		return fmt.Errorf("property %q: bm25PruningThreshold must be at least 0 and below 1, got %v",
+			property.Name, property.Bm25PruningThreshold)
+	}
+	if !propertyDataType.IsPrimitive() ||
+		(propertyDataType.AsPrimitive() != schema.DataTypeText &&
+			propertyDataType.AsPrimitive() != schema.DataTypeTextArray) {
+		return fmt.Errorf("property %q: bm25PruningThreshold requires data type %q or %q",
+			property.Name, schema.DataTypeText, schema.DataTypeTextArray)
+	}
+	if property.IndexInverted != nil && !*property.IndexInverted {
+		return fmt.Errorf("property %q: bm25PruningThreshold requires indexInverted", property.Name)
+	}
+	return nil
+}
+
+// validateReferenceSnapshots makes sure that the snapshot properties of the
+// class copy a text property of the targets of one of its reference
+// properties. With relaxCrossRefValidation targets which don't exist yet are
+// skipped.
+func (m *Manager) validateReferenceSnapshots(class *models.Class,
+	relaxCrossRefValidation bool,
+) error {
+	sch := m.getSchema()
+	for _, prop := range schema.ReferenceSnapshotProperties(class) {
+		snapshot := prop.ReferenceSnapshot
+		if len(prop.DataType) != 1 || (prop.DataType[0] != string(schema.DataTypeTextArray) &&
+			prop.DataType[0] != string(schema.DataTypeStringArray)) {
+			return fmt.Errorf("property %q: referenceSnapshot requires data type %q or %q",
+				prop.Name, schema.DataTypeTextArray, schema.DataTypeStringArray)
+		}
+
+		ref := findProperty(class, snapshot.Reference)
+		if ref == nil || !schema.IsRefDataType(ref.DataType) {
+			return fmt.Errorf("property %q: referenceSnapshot: %q is not a reference property of class %q",
+				prop.Name, snapshot.Reference, class.Class)
+		}
+
+		for _, targetName := range ref.DataType {
+			target := sch.GetClass(schema.ClassName(targetName))
+			if targetName == class.Class {
+				target = class
+			}
+			if target == nil {
+				if relaxCrossRefValidation {
+					continue
+				}
+				return fmt.Errorf("property %q: referenceSnapshot: class %q not found",
+					prop.Name, targetName)
+			}
+
+			source := findProperty(target, snapshot.Property)
+			if source == nil {
+				return fmt.Errorf("property %q: referenceSnapshot: class %q has no property %q",
+					prop.Name, targetName, snapshot.Property)
+			}
+			switch schema.DataType(source.DataType[0]) {
+			case schema.DataTypeText, schema.DataTypeTextArray,
+				schema.DataTypeString, schema.DataTypeStringArray:
+			default:
+				return fmt.Errorf("property %q: referenceSnapshot: property %q of class %q "+
+					"must be of data type text, text[], string or string[]",
+					prop.Name, snapshot.Property, targetName)
+			}
+		}
+	}
+	return nil
+}
+
+func findProperty(class *models.Class, name string) *models.Property {
+	for _, prop := range class.Properties {
+		if prop.Name == name {
+			return prop
+		}
+	}
+	return nil
+}
+
 func (m *Manager) validateVectorSettings(ctx context.Context, class *models.Class) error {
 	if err := m.validateVectorizer(ctx, class); err != nil {
 		return err
@@ -94,10 +235,267 @@ func (m *Manager) validateVectorizer(ctx context.Context, class *models.Class) e
 
 func (m *Manager) validateVectorIndex(ctx context.Context, class *models.Class) error {
 	switch class.VectorIndexType {
-	case "hnsw":
-		return nil
+	case "hnsw", flat.IndexType, dynamic.IndexType:
 	default:
 		return errors.Errorf("unrecognized or unsupported vectorIndexType %q",
 			class.VectorIndexType)
 	}
+
+	return validateVectorConfig(class)
+}
+
+var validateTargetVectorName = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]{0,229}$`).MatchString
+
+// validateVectorConfig makes sure that the named vectors of the class can
+// be used as file names of their vector indexes and referenced as
+// targetVector of nearVector
+func validateVectorConfig(class *models.Class) error {
+	for name, cfg := range class.VectorConfig {
+		if !validateTargetVectorName(name) {
+			return errors.Errorf("vectorConfig: invalid name %q, names must match "+
+				"/[a-zA-Z_][a-zA-Z0-9_]*/ and have at most 230 characters", name)
+		}
+
+		switch cfg.VectorIndexType {
+		case "hnsw", flat.IndexType:
+		default:
+			return errors.Errorf("vectorConfig %q: unrecognized or unsupported vectorIndexType %q",
+				name, cfg.VectorIndexType)
+		}
+	}
+	return nil
+}
+
+// validateVectorConfigUpdate makes sure that the named vectors of a class
+// are left unchanged. Their vector indexes are created with the shards of
+// the class, so named vectors can neither be added nor removed later on.
+// Both configs must have been parsed.
+func validateVectorConfigUpdate(initial, updated *models.Class) error {
+	if len(initial.VectorConfig) != len(updated.VectorConfig) {
+		return errors.Errorf("vectorConfig is immutable: named vectors cannot be added or removed")
+	}
+
+	for name, initialCfg := range initial.VectorConfig {
+		updatedCfg, ok := updated.VectorConfig[name]
+		if !ok {
+			return errors.Errorf("vectorConfig is immutable: named vectors cannot be added or removed")
+		}
+		if !reflect.DeepEqual(initialCfg, updatedCfg) {
+			return errors.Errorf("vectorConfig %q is immutable", name)
+		}
+	}
+	return nil
+}
+
+// validateBoostConfig makes sure that the boost property of a class exists
+// and is numeric
+func validateBoostConfig(class *models.Class) error {
+	cfg := class.InvertedIndexConfig
+	if cfg == nil || (cfg.BoostProperty == "" && cfg.BoostScores == "") {
+		return nil
+	}
+
+	switch cfg.BoostScores {
+	case "", schema.BoostScoresBM25, schema.BoostScoresHybrid, schema.BoostScoresAll:
+	default:
+		return fmt.Errorf("invertedIndexConfig.boostScores must be one of %q, %q or %q",
+			schema.BoostScoresBM25, schema.BoostScoresHybrid, schema.BoostScoresAll)
+	}
+	if cfg.BoostProperty == "" {
+		return fmt.Errorf("invertedIndexConfig.boostScores requires a boostProperty")
+	}
+
+	prop, err := schema.GetPropertyByName(class, cfg.BoostProperty)
+	if err != nil {
+		return fmt.Errorf("invertedIndexConfig.boostProperty: %w", err)
+	}
+	if len(prop.DataType) != 1 || (prop.DataType[0] != string(schema.DataTypeInt) &&
+		prop.DataType[0] != string(schema.DataTypeNumber)) {
+		return fmt.Errorf("invertedIndexConfig.boostProperty %q must be of type %q or %q",
+			prop.Name, schema.DataTypeInt, schema.DataTypeNumber)
+	}
+	return nil
+}
+
+// validateBM25PropertyBoosts makes sure that the boosted properties exist and
+// are searchable
+func validateBM25PropertyBoosts(class *models.Class) error {
+	cfg := class.InvertedIndexConfig
+	if cfg == nil || cfg.Bm25 == nil {
+		return nil
+	}
+
+	for name := range cfg.Bm25.PropertyBoosts {
+		prop, err := schema.GetPropertyByName(class, name)
+		if err != nil {
+			return fmt.Errorf("invertedIndexConfig.bm25.propertyBoosts: %w", err)
+		}
+		dt := schema.DataType(prop.DataType[0])
+		switch dt {
+		case schema.DataTypeText, schema.DataTypeTextArray,
+			schema.DataTypeString, schema.DataTypeStringArray:
+		default:
+			return fmt.Errorf("invertedIndexConfig.bm25.propertyBoosts: property %q "+
+				"of type %q is not searchable", prop.Name, dt)
+		}
+	}
+	return nil
+}
+
+// validateIDGenerationConfig makes sure that the id generation strategy of a
+// class is known and that deterministic ids are derived from existing
+// primitive properties
+func validateIDGenerationConfig(class *models.Class) error {
+	cfg := class.IDGenerationConfig
+	if cfg == nil {
+		return nil
+	}
+
+	switch cfg.Strategy {
+	case "", schema.IDStrategyV4, schema.IDStrategyV7:
+		if len(cfg.Properties) > 0 {
+			return fmt.Errorf("idGenerationConfig.properties requires strategy %q",
+				schema.IDStrategyV5)
+		}
+		return nil
+	case schema.IDStrategyV5:
+	default:
+		return fmt.Errorf("idGenerationConfig.strategy must be one of %q, %q or %q",
+			schema.IDStrategyV4, schema.IDStrategyV7, schema.IDStrategyV5)
+	}
+
+	if len(cfg.Properties) == 0 {
+		return fmt.Errorf("idGenerationConfig.strategy %q requires at least one property",
+			schema.IDStrategyV5)
+	}
+	seen := map[string]struct{}{}
+	for _, name := range cfg.Properties {
+		if _, ok := seen[name]; ok {
+			return fmt.Errorf("idGenerationConfig.properties: duplicate property %q", name)
+		}
+		seen[name] = struct{}{}
+
+		prop, err := schema.GetPropertyByName(class, name)
+		if err != nil {
+			return fmt.Errorf("idGenerationConfig.properties: %w", err)
+		}
+		if len(prop.DataType) > 0 && schema.IsRefDataType(prop.DataType) {
+			return fmt.Errorf("idGenerationConfig.properties: reference property %q "+
+				"can't be used to generate ids", prop.Name)
+		}
+	}
+	return nil
+}
+
+// validateObjectTTL makes sure that the expiry of objects can be determined
+// from the creation time index of the class
+func validateObjectTTL(class *models.Class) error {
+	cfg := class.ObjectTTL
+	if cfg == nil || cfg.DurationSeconds == 0 {
+		return nil
+	}
+
+	if cfg.DurationSeconds < 0 {
+		return fmt.Errorf("objectTTL.durationSeconds must not be negative, got %d",
+			cfg.DurationSeconds)
+	}
+	if class.InvertedIndexConfig == nil || !class.InvertedIndexConfig.IndexTimestamps {
+		return fmt.Errorf("objectTTL requires invertedIndexConfig.indexTimestamps")
+	}
+	return nil
+}
+
+// validateIngestConfig makes sure that the overrides of the import settings
+// of the node are not negative, 0 keeps the setting of the node
+func validateIngestConfig(class *models.Class) error {
+	cfg := class.IngestConfig
+	if cfg == nil {
+		return nil
+	}
+
+	if cfg.Concurrency < 0 {
+		return fmt.Errorf("ingestConfig.concurrency must not be negative, got %d",
+			cfg.Concurrency)
+	}
+	if cfg.MemtablesMaxSizeMB < 0 {
+		return fmt.Errorf("ingestConfig.memtablesMaxSizeMB must not be negative, got %d",
+			cfg.MemtablesMaxSizeMB)
+	}
+	if cfg.MemtablesMaxActiveSeconds < 0 {
+		return fmt.Errorf("ingestConfig.memtablesMaxActiveSeconds must not be negative, got %d",
+			cfg.MemtablesMaxActiveSeconds)
+	}
+	return nil
+}
+
+// maxVersionsLimit bounds the number of versions kept per object, as they are
+// stored for every object and lowering the number removes all excess versions
+// of an object with its next write
+const maxVersionsLimit = 1000
+
+// validateVersioningConfig makes sure that the number of versions kept per
+// object is bounded, 0 keeps the default number
+func validateVersioningConfig(class *models.Class) error {
+	cfg := class.VersioningConfig
+	if cfg == nil {
+		return nil
+	}
+
+	if cfg.MaxVersions < 0 || cfg.MaxVersions > maxVersionsLimit {
+		return fmt.Errorf("versioningConfig.maxVersions must be between 0 and %d, got %d",
+			maxVersionsLimit, cfg.MaxVersions)
+	}
+	return nil
+}
+
+// validateChunkingConfig makes sure that the text properties of a class are
+// split with a known strategy into chunks of another class
+func validateChunkingConfig(class *models.Class) error {
+	cfg := class.ChunkingConfig
+	if cfg == nil {
+		return nil
+	}
+
+	if cfg.ChunkClass == "" {
+		return fmt.Errorf("chunkingConfig.chunkClass is required")
+	}
+	if cfg.ChunkClass == class.Class {
+		return fmt.Errorf("chunkingConfig.chunkClass must be another class than %q",
+			class.Class)
+	}
+
+	switch cfg.Strategy {
+	case "", schema.ChunkingStrategyTokens, schema.ChunkingStrategySentences:
+	default:
+		return fmt.Errorf("chunkingConfig.strategy must be one of %q or %q",
+			schema.ChunkingStrategyTokens, schema.ChunkingStrategySentences)
+	}
+	if cfg.Size < 0 {
+		return fmt.Errorf("chunkingConfig.size must not be negative, got %d", cfg.Size)
+	}
+	if size := schema.ChunkSize(cfg); cfg.Overlap < 0 || cfg.Overlap >= int64(size) {
+		return fmt.Errorf("chunkingConfig.overlap must be between 0 and the size "+
+			"of %d, got %d", size, cfg.Overlap)
+	}
+
+	if len(cfg.Properties) == 0 {
+		return fmt.Errorf("chunkingConfig.properties requires at least one property")
+	}
+	seen := map[string]struct{}{}
+	for _, name := range cfg.Properties {
+		if _, ok := seen[name]; ok {
+			return fmt.Errorf("chunkingConfig.properties: duplicate property %q", name)
+		}
+		seen[name] = struct{}{}
+
+		prop, err := schema.GetPropertyByName(class, name)
+		if err != nil {
+			return fmt.Errorf("chunkingConfig.properties: %w", err)
+		}
+		if len(prop.DataType) != 1 || prop.DataType[0] != string(schema.DataTypeText) {
+			return fmt.Errorf("chunkingConfig.properties: property %q of type %v "+
+				"is not a text property", prop.Name, prop.DataType)
+		}
+	}
+	return nil
 }
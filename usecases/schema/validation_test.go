@@ -13,11 +13,14 @@ package schema
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/tokenizer"
 )
 
 func Test_Validation_ClassNames(t *testing.T) {
@@ -368,3 +371,708 @@ func Test_Validation_PropertyNames(t *testing.T) {
 		})
 	})
 }
+
+func Test_Validation_BoostConfig(t *testing.T) {
+	class := func(boostProperty, boostScores string) *models.Class {
+		return &models.Class{
+			Class: "Article",
+			Properties: []*models.Property{
+				{Name: "title", DataType: []string{"text"}},
+				{Name: "popularity", DataType: []string{"number"}},
+				{Name: "views", DataType: []string{"int"}},
+			},
+			InvertedIndexConfig: &models.InvertedIndexConfig{
+				BoostProperty: boostProperty,
+				BoostScores:   boostScores,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		class    *models.Class
+		errorMsg string
+	}{
+		{name: "no boost", class: class("", "")},
+		{name: "number property", class: class("popularity", "")},
+		{name: "int property", class: class("views", "all")},
+		{name: "unknown scores", class: class("views", "vector"), errorMsg: "boostScores must be one of"},
+		{name: "scores without property", class: class("", "hybrid"), errorMsg: "requires a boostProperty"},
+		{name: "missing property", class: class("likes", ""), errorMsg: "boostProperty"},
+		{name: "text property", class: class("title", ""), errorMsg: "must be of type"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateBoostConfig(test.class)
+			if test.errorMsg == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.errorMsg)
+			}
+		})
+	}
+}
+
+func Test_Validation_BM25PropertyBoosts(t *testing.T) {
+	class := func(boosts map[string]float32) *models.Class {
+		return &models.Class{
+			Class: "Article",
+			Properties: []*models.Property{
+				{Name: "title", DataType: []string{"text"}},
+				{Name: "tags", DataType: []string{"string[]"}},
+				{Name: "views", DataType: []string{"int"}},
+			},
+			InvertedIndexConfig: &models.InvertedIndexConfig{
+				Bm25: &models.BM25Config{K1: 1.2, B: 0.75, PropertyBoosts: boosts},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		class    *models.Class
+		errorMsg string
+	}{
+		{name: "no boosts", class: class(nil)},
+		{name: "text and string properties", class: class(map[string]float32{"title": 2, "tags": 0.5})},
+		{name: "missing property", class: class(map[string]float32{"body": 2}), errorMsg: "propertyBoosts"},
+		{name: "int property", class: class(map[string]float32{"views": 2}), errorMsg: "is not searchable"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateBM25PropertyBoosts(test.class)
+			if test.errorMsg == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.errorMsg)
+			}
+		})
+	}
+}
+
+func Test_Validation_IDGenerationConfig(t *testing.T) {
+	class := func(strategy string, props ...string) *models.Class {
+		return &models.Class{
+			Class: "Article",
+			Properties: []*models.Property{
+				{Name: "title", DataType: []string{"text"}},
+				{Name: "year", DataType: []string{"int"}},
+				{Name: "author", DataType: []string{"Author"}},
+			},
+			IDGenerationConfig: &models.IDGenerationConfig{
+				Strategy:   strategy,
+				Properties: props,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		class    *models.Class
+		errorMsg string
+	}{
+		{name: "default", class: class("")},
+		{name: "v4", class: class("v4")},
+		{name: "v7", class: class("v7")},
+		{name: "v5", class: class("v5", "title", "year")},
+		{name: "unknown strategy", class: class("v1"), errorMsg: "strategy must be one of"},
+		{name: "properties without v5", class: class("v7", "title"), errorMsg: "requires strategy"},
+		{name: "v5 without properties", class: class("v5"), errorMsg: "requires at least one property"},
+		{name: "duplicate property", class: class("v5", "title", "title"), errorMsg: "duplicate property"},
+		{name: "missing property", class: class("v5", "isbn"), errorMsg: "idGenerationConfig.properties"},
+		{name: "reference property", class: class("v5", "author"), errorMsg: "reference property"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateIDGenerationConfig(test.class)
+			if test.errorMsg == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.errorMsg)
+			}
+		})
+	}
+}
+
+func Test_Validation_ObjectTTL(t *testing.T) {
+	class := func(seconds int64, indexTimestamps bool) *models.Class {
+		return &models.Class{
+			Class:               "Session",
+			InvertedIndexConfig: &models.InvertedIndexConfig{IndexTimestamps: indexTimestamps},
+			ObjectTTL:           &models.ObjectTTLConfig{DurationSeconds: seconds},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		class    *models.Class
+		errorMsg string
+	}{
+		{name: "not set", class: &models.Class{Class: "Session"}},
+		{name: "disabled", class: class(0, false)},
+		{name: "with timestamps", class: class(3600, true)},
+		{name: "negative", class: class(-1, true), errorMsg: "must not be negative"},
+		{name: "without timestamps", class: class(3600, false), errorMsg: "indexTimestamps"},
+		{
+			name:     "without inverted index config",
+			class:    &models.Class{Class: "Session", ObjectTTL: &models.ObjectTTLConfig{DurationSeconds: 60}},
+			errorMsg: "indexTimestamps",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateObjectTTL(test.class)
+			if test.errorMsg == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.errorMsg)
+			}
+		})
+	}
+}
+
+func Test_Validation_IngestConfig(t *testing.T) {
+	class := func(cfg models.IngestConfig) *models.Class {
+		return &models.Class{Class: "Log", IngestConfig: &cfg}
+	}
+
+	tests := []struct {
+		name     string
+		class    *models.Class
+		errorMsg string
+	}{
+		{name: "not set", class: &models.Class{Class: "Log"}},
+		{name: "node defaults", class: class(models.IngestConfig{})},
+		{
+			name: "all overrides",
+			class: class(models.IngestConfig{
+				Concurrency: 4, MemtablesMaxSizeMB: 512, MemtablesMaxActiveSeconds: 600,
+			}),
+		},
+		{
+			name:     "negative concurrency",
+			class:    class(models.IngestConfig{Concurrency: -1}),
+			errorMsg: "ingestConfig.concurrency",
+		},
+		{
+			name:     "negative memtable size",
+			class:    class(models.IngestConfig{MemtablesMaxSizeMB: -1}),
+			errorMsg: "ingestConfig.memtablesMaxSizeMB",
+		},
+		{
+			name:     "negative memtable duration",
+			class:    class(models.IngestConfig{MemtablesMaxActiveSeconds: -1}),
+			errorMsg: "ingestConfig.memtablesMaxActiveSeconds",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateIngestConfig(test.class)
+			if test.errorMsg == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.errorMsg)
+			}
+		})
+	}
+}
+
+func Test_Validation_VersioningConfig(t *testing.T) {
+	class := func(cfg models.VersioningConfig) *models.Class {
+		return &models.Class{Class: "Contract", VersioningConfig: &cfg}
+	}
+
+	tests := []struct {
+		name     string
+		class    *models.Class
+		errorMsg string
+	}{
+		{name: "not set", class: &models.Class{Class: "Contract"}},
+		{name: "default number of versions", class: class(models.VersioningConfig{Enabled: true})},
+		{name: "maximum number of versions", class: class(models.VersioningConfig{Enabled: true, MaxVersions: 1000})},
+		{
+			name:     "negative number of versions",
+			class:    class(models.VersioningConfig{Enabled: true, MaxVersions: -1}),
+			errorMsg: "versioningConfig.maxVersions",
+		},
+		{
+			name:     "too many versions",
+			class:    class(models.VersioningConfig{Enabled: true, MaxVersions: 1001}),
+			errorMsg: "versioningConfig.maxVersions",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateVersioningConfig(test.class)
+			if test.errorMsg == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.errorMsg)
+			}
+		})
+	}
+}
+
+func Test_Validation_ChunkingConfig(t *testing.T) {
+	class := func(cfg *models.ChunkingConfig) *models.Class {
+		return &models.Class{
+			Class: "Document",
+			Properties: []*models.Property{
+				{Name: "body", DataType: []string{"text"}},
+				{Name: "tags", DataType: []string{"text[]"}},
+			},
+			ChunkingConfig: cfg,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		config   *models.ChunkingConfig
+		errorMsg string
+	}{
+		{name: "not set"},
+		{
+			name:   "defaults",
+			config: &models.ChunkingConfig{ChunkClass: "Chunk", Properties: []string{"body"}},
+		},
+		{
+			name: "sentences with overlap",
+			config: &models.ChunkingConfig{
+				ChunkClass: "Chunk", Properties: []string{"body"},
+				Strategy: schema.ChunkingStrategySentences, Size: 4, Overlap: 1,
+			},
+		},
+		{
+			name:     "without chunk class",
+			config:   &models.ChunkingConfig{Properties: []string{"body"}},
+			errorMsg: "chunkClass is required",
+		},
+		{
+			name:     "chunks of the class itself",
+			config:   &models.ChunkingConfig{ChunkClass: "Document", Properties: []string{"body"}},
+			errorMsg: "another class",
+		},
+		{
+			name:     "unknown strategy",
+			config:   &models.ChunkingConfig{ChunkClass: "Chunk", Properties: []string{"body"}, Strategy: "paragraphs"},
+			errorMsg: "strategy must be one of",
+		},
+		{
+			name:     "overlap as large as the size",
+			config:   &models.ChunkingConfig{ChunkClass: "Chunk", Properties: []string{"body"}, Size: 10, Overlap: 10},
+			errorMsg: "overlap must be between",
+		},
+		{
+			name:     "negative size",
+			config:   &models.ChunkingConfig{ChunkClass: "Chunk", Properties: []string{"body"}, Size: -1},
+			errorMsg: "must not be negative",
+		},
+		{
+			name:     "without properties",
+			config:   &models.ChunkingConfig{ChunkClass: "Chunk"},
+			errorMsg: "at least one property",
+		},
+		{
+			name:     "unknown property",
+			config:   &models.ChunkingConfig{ChunkClass: "Chunk", Properties: []string{"title"}},
+			errorMsg: "chunkingConfig.properties",
+		},
+		{
+			name:     "text array property",
+			config:   &models.ChunkingConfig{ChunkClass: "Chunk", Properties: []string{"tags"}},
+			errorMsg: "not a text property",
+		},
+		{
+			name:     "duplicate property",
+			config:   &models.ChunkingConfig{ChunkClass: "Chunk", Properties: []string{"body", "body"}},
+			errorMsg: "duplicate property",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateChunkingConfig(class(test.config))
+			if test.errorMsg == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.errorMsg)
+			}
+		})
+	}
+}
+
+func Test_Validation_PropertyIndexOnly(t *testing.T) {
+	vTrue, vFalse := true, false
+	sch := schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{{Class: "Author"}},
+	}}
+	tests := []struct {
+		name     string
+		prop     *models.Property
+		dataType []string
+		errorMsg string
+	}{
+		{
+			name:     "stored property",
+			prop:     &models.Property{Name: "title"},
+			dataType: []string{"text"},
+		},
+		{
+			name:     "index-only text",
+			prop:     &models.Property{Name: "title", IndexOnly: &vTrue},
+			dataType: []string{"text"},
+		},
+		{
+			name:     "not indexed",
+			prop:     &models.Property{Name: "title", IndexOnly: &vTrue, IndexInverted: &vFalse},
+			dataType: []string{"text"},
+			errorMsg: "requires indexInverted",
+		},
+		{
+			name:     "blob",
+			prop:     &models.Property{Name: "image", IndexOnly: &vTrue},
+			dataType: []string{"blob"},
+			errorMsg: "not allowed for data type",
+		},
+		{
+			name:     "reference",
+			prop:     &models.Property{Name: "author", IndexOnly: &vTrue},
+			dataType: []string{"Author"},
+			errorMsg: "reference data type",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dataType, err := sch.FindPropertyDataType(test.dataType)
+			require.Nil(t, err)
+			err = validatePropertyIndexOnly(test.prop, dataType)
+			if test.errorMsg == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.errorMsg)
+			}
+		})
+	}
+}
+
+func Test_Validation_LanguageDetection(t *testing.T) {
+	vFalse := false
+	sch := schema.Schema{Objects: &models.Schema{}}
+	prop := func(tokenization string) *models.Property {
+		return &models.Property{Name: "body", Tokenization: tokenization, LanguageDetection: true}
+	}
+	tests := []struct {
+		name     string
+		prop     *models.Property
+		dataType []string
+		errorMsg string
+	}{
+		{
+			name:     "disabled",
+			prop:     &models.Property{Name: "year"},
+			dataType: []string{"int"},
+		},
+		{
+			name:     "text",
+			prop:     prop(models.PropertyTokenizationWord),
+			dataType: []string{"text"},
+		},
+		{
+			name:     "text array",
+			prop:     prop(models.PropertyTokenizationWord),
+			dataType: []string{"text[]"},
+		},
+		{
+			name:     "string",
+			prop:     prop(models.PropertyTokenizationWord),
+			dataType: []string{"string"},
+			errorMsg: "requires data type",
+		},
+		{
+			name:     "int",
+			prop:     prop(""),
+			dataType: []string{"int"},
+			errorMsg: "requires data type",
+		},
+		{
+			name:     "cjk tokenization",
+			prop:     prop(tokenizer.CJKName),
+			dataType: []string{"text"},
+			errorMsg: "requires tokenization",
+		},
+		{
+			name: "not indexed",
+			prop: &models.Property{
+				Name: "body", Tokenization: models.PropertyTokenizationWord,
+				LanguageDetection: true, IndexInverted: &vFalse,
+			},
+			dataType: []string{"text"},
+			errorMsg: "requires indexInverted",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dataType, err := sch.FindPropertyDataType(test.dataType)
+			require.Nil(t, err)
+			err = validateLanguageDetection(test.prop, dataType)
+			if test.errorMsg == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.errorMsg)
+			}
+		})
+	}
+}
+
+func Test_Validation_BM25Pruning(t *testing.T) {
+	vFalse := false
+	sch := schema.Schema{Objects: &models.Schema{}}
+	prop := func(threshold float64) *models.Property {
+		return &models.Property{Name: "body", Bm25PruningThreshold: threshold}
+	}
+	tests := []struct {
+		name     string
+		prop     *models.Property
+		dataType []string
+		errorMsg string
+	}{
+		{
+			name:     "disabled",
+			prop:     prop(0),
+			dataType: []string{"int"},
+		},
+		{
+			name:     "text",
+			prop:     prop(0.3),
+			dataType: []string{"text"},
+		},
+		{
+			name:     "text array",
+			prop:     prop(0.3),
+			dataType: []string{"text[]"},
+		},
+		{
+			name:     "negative",
+			prop:     prop(-0.1),
+			dataType: []string{"text"},
+			errorMsg: "must be at least 0 and below 1",
+		},
+		{
+			name:     "above upper bound",
+			prop:     prop(1),
+			dataType: []string{"text"},
+			errorMsg: "must be at least 0 and below 1",
+		},
+		{
+			name:     "string",
+			prop:     prop(0.3),
+			dataType: []string{"string"},
+			errorMsg: "requires data type",
+		},
+		{
+			name:     "not indexed",
+			prop:     &models.Property{Name: "body", Bm25PruningThreshold: 0.3, IndexInverted: &vFalse},
+			dataType: []string{"text"},
+			errorMsg: "requires indexInverted",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dataType, err := sch.FindPropertyDataType(test.dataType)
+			require.Nil(t, err)
+			err = validateBM25Pruning(test.prop, dataType)
+			if test.errorMsg == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.errorMsg)
+			}
+		})
+	}
+}
+
+func Test_Validation_VectorConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   map[string]models.VectorConfig
+		errorMsg string
+	}{
+		{
+			name: "no named vectors",
+		},
+		{
+			name: "hnsw and flat",
+			config: map[string]models.VectorConfig{
+				"title_vec": {VectorIndexType: "hnsw"},
+				"body_vec":  {VectorIndexType: "flat"},
+			},
+		},
+		{
+			name: "invalid name",
+			config: map[string]models.VectorConfig{
+				"title-vec": {VectorIndexType: "hnsw"},
+			},
+			errorMsg: `invalid name "title-vec"`,
+		},
+		{
+			name: "name too long",
+			config: map[string]models.VectorConfig{
+				strings.Repeat("a", 231): {VectorIndexType: "hnsw"},
+			},
+			errorMsg: "invalid name",
+		},
+		{
+			name: "unsupported index type",
+			config: map[string]models.VectorConfig{
+				"title_vec": {VectorIndexType: "ivf"},
+			},
+			errorMsg: `unrecognized or unsupported vectorIndexType "ivf"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateVectorConfig(&models.Class{Class: "Article", VectorConfig: test.config})
+			if test.errorMsg == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.errorMsg)
+			}
+		})
+	}
+
+	t.Run("named vectors are immutable", func(t *testing.T) {
+		initial := &models.Class{VectorConfig: map[string]models.VectorConfig{
+			"title_vec": {VectorIndexType: "hnsw", VectorIndexConfig: "a"},
+		}}
+
+		assert.Nil(t, validateVectorConfigUpdate(initial, initial))
+		assert.ErrorContains(t, validateVectorConfigUpdate(initial, &models.Class{}),
+			"cannot be added or removed")
+		assert.ErrorContains(t, validateVectorConfigUpdate(initial,
+			&models.Class{VectorConfig: map[string]models.VectorConfig{
+				"body_vec": {VectorIndexType: "hnsw", VectorIndexConfig: "a"},
+			}}), "cannot be added or removed")
+		assert.ErrorContains(t, validateVectorConfigUpdate(initial,
+			&models.Class{VectorConfig: map[string]models.VectorConfig{
+				"title_vec": {VectorIndexType: "hnsw", VectorIndexConfig: "b"},
+			}}), `vectorConfig "title_vec" is immutable`)
+	})
+}
+
+func Test_Validation_PropertyTokenization_Custom(t *testing.T) {
+	sch := schema.Schema{Objects: &models.Schema{}}
+	require.Nil(t, tokenizer.Register("schema-validation-test", tokenizer.Func(strings.Fields)))
+
+	for _, dataType := range []string{"string", "string[]", "text", "text[]"} {
+		propertyDataType, err := sch.FindPropertyDataType([]string{dataType})
+		require.Nil(t, err)
+		assert.Nil(t, validatePropertyTokenization("schema-validation-test", propertyDataType))
+		assert.NotNil(t, validatePropertyTokenization("unregistered", propertyDataType))
+	}
+
+	propertyDataType, err := sch.FindPropertyDataType([]string{"int"})
+	require.Nil(t, err)
+	assert.NotNil(t, validatePropertyTokenization("schema-validation-test", propertyDataType))
+}
+
+func Test_Validation_ReferenceSnapshots(t *testing.T) {
+	m := newSchemaManager()
+	m.state.ObjectSchema.Classes = []*models.Class{{
+		Class: "Author",
+		Properties: []*models.Property{
+			{Name: "name", DataType: []string{"text"}},
+			{Name: "born", DataType: []string{"int"}},
+		},
+	}}
+
+	articleWith := func(snapshot *models.Property) *models.Class {
+		return &models.Class{
+			Class: "Article",
+			Properties: []*models.Property{
+				{Name: "title", DataType: []string{"text"}},
+				{Name: "writtenBy", DataType: []string{"Author"}},
+				{Name: "editedBy", DataType: []string{"Editor"}},
+				snapshot,
+			},
+		}
+	}
+	snapshotOf := func(ref, prop string, dataType ...string) *models.Property {
+		return &models.Property{
+			Name:              "authorName",
+			DataType:          dataType,
+			ReferenceSnapshot: &models.ReferenceSnapshot{Reference: ref, Property: prop},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		prop     *models.Property
+		relax    bool
+		errorMsg string
+	}{
+		{
+			name: "valid",
+			prop: snapshotOf("writtenBy", "name", "text[]"),
+		},
+		{
+			name:     "scalar data type",
+			prop:     snapshotOf("writtenBy", "name", "text"),
+			errorMsg: "requires data type",
+		},
+		{
+			name:     "not a reference",
+			prop:     snapshotOf("title", "name", "text[]"),
+			errorMsg: "is not a reference property",
+		},
+		{
+			name:     "missing property",
+			prop:     snapshotOf("writtenBy", "alias", "text[]"),
+			errorMsg: `has no property "alias"`,
+		},
+		{
+			name:     "not a text property",
+			prop:     snapshotOf("writtenBy", "born", "text[]"),
+			errorMsg: "must be of data type text",
+		},
+		{
+			name:     "missing target class",
+			prop:     snapshotOf("editedBy", "name", "text[]"),
+			errorMsg: `class "Editor" not found`,
+		},
+		{
+			name:  "missing target class with relaxed validation",
+			prop:  snapshotOf("editedBy", "name", "text[]"),
+			relax: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := m.validateReferenceSnapshots(articleWith(test.prop), test.relax)
+			if test.errorMsg == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.errorMsg)
+			}
+		})
+	}
+}
+
+func Test_Validation_PropertyTokenization_CJK(t *testing.T) {
+	sch := schema.Schema{Objects: &models.Schema{}}
+
+	for _, dataType := range []string{"string", "string[]", "text", "text[]"} {
+		propertyDataType, err := sch.FindPropertyDataType([]string{dataType})
+		require.Nil(t, err)
+		assert.Nil(t, validatePropertyTokenization(tokenizer.CJKName, propertyDataType))
+	}
+}
@@ -34,6 +34,11 @@ type Config struct {
 	Key                 string `json:"key"`
 	Strategy            string `json:"strategy"`
 	Function            string `json:"function"`
+
+	// NodeLabels are labels in the form key=value which a node must have to
+	// hold shards of the class, ExcludedNodeLabels are labels it must not have
+	NodeLabels         []string `json:"nodeLabels,omitempty"`
+	ExcludedNodeLabels []string `json:"excludedNodeLabels,omitempty"`
 }
 
 func (c *Config) setDefaults(nodeCount int) {
@@ -66,7 +71,7 @@ func (c *Config) validate() error {
 			"got: %s", c.Function)
 	}
 
-	return nil
+	return c.validatePlacement()
 }
 
 func ParseConfig(input interface{}, nodeCount int) (Config, error) {
@@ -120,6 +125,18 @@ func ParseConfig(input interface{}, nodeCount int) (Config, error) {
 		return out, err
 	}
 
+	if err := optionalStringsFromMap(asMap, "nodeLabels", func(v []string) {
+		out.NodeLabels = v
+	}); err != nil {
+		return out, err
+	}
+
+	if err := optionalStringsFromMap(asMap, "excludedNodeLabels", func(v []string) {
+		out.ExcludedNodeLabels = v
+	}); err != nil {
+		return out, err
+	}
+
 	// these will only differ once there is an async component through replication
 	// or dynamic scaling. For now they have to be the same
 	out.ActualCount = out.DesiredCount
@@ -177,3 +194,31 @@ func optionalStringFromMap(in map[string]interface{}, name string,
 	setFn(asString)
 	return nil
 }
+
+func optionalStringsFromMap(in map[string]interface{}, name string,
+	setFn func(v []string),
+) error {
+	value, ok := in[name]
+	if !ok || value == nil {
+		return nil
+	}
+
+	switch typed := value.(type) {
+	case []string:
+		setFn(typed)
+	case []interface{}:
+		out := make([]string, len(typed))
+		for i, elem := range typed {
+			asString, ok := elem.(string)
+			if !ok {
+				return errors.Errorf("field %q must be a list of strings, got element of type %T",
+					name, elem)
+			}
+			out[i] = asString
+		}
+		setFn(out)
+	default:
+		return errors.Errorf("field %q must be a list of strings, got: %T", name, value)
+	}
+	return nil
+}
@@ -122,6 +122,34 @@ func Test_Config(t *testing.T) {
 			expectedErr: errors.New("sharding only supported with function 'murmur3' " +
 				"for now, got: md5"),
 		},
+
+		{
+			name: "placement constraints, from disk",
+			input: map[string]interface{}{
+				"nodeLabels":         []interface{}{"storage=nvme", "region=eu"},
+				"excludedNodeLabels": []interface{}{"zone=eu-1b"},
+			},
+			expected: Config{
+				VirtualPerPhysical:  DefaultVirtualPerPhysical,
+				DesiredCount:        7,
+				DesiredVirtualCount: DefaultVirtualPerPhysical * 7,
+				ActualCount:         7,
+				ActualVirtualCount:  DefaultVirtualPerPhysical * 7,
+				Key:                 DefaultKey,
+				Strategy:            DefaultStrategy,
+				Function:            DefaultFunction,
+				NodeLabels:          []string{"storage=nvme", "region=eu"},
+				ExcludedNodeLabels:  []string{"zone=eu-1b"},
+			},
+		},
+
+		{
+			name: "invalid placement constraint",
+			input: map[string]interface{}{
+				"nodeLabels": []interface{}{"nvme"},
+			},
+			expectedErr: errors.New("invalid node label \"nvme\", expected key=value"),
+		},
 	}
 
 	for _, test := range tests {
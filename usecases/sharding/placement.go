@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sharding
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+// nodeLabeler is implemented by clusters whose nodes have labels
+type nodeLabeler interface {
+	NodeLabels(nodeName string) map[string]string
+}
+
+// hasPlacementConstraints is true if the shards of the class may only be
+// placed on some of the nodes
+func (c Config) hasPlacementConstraints() bool {
+	return len(c.NodeLabels) > 0 || len(c.ExcludedNodeLabels) > 0
+}
+
+// Placement returns the nodes on which shards may be placed. These are the
+// nodes which have all of the labels of c.NodeLabels and none of the labels
+// of c.ExcludedNodeLabels. Without constraints all nodes are eligible.
+func (c Config) Placement(nodes nodes) (*Placement, error) {
+	names := nodes.AllNames()
+	if !c.hasPlacementConstraints() {
+		return &Placement{names: names, local: nodes.LocalName()}, nil
+	}
+
+	labeler, ok := nodes.(nodeLabeler)
+	if !ok {
+		return nil, fmt.Errorf("placement constraints require node labels")
+	}
+
+	eligible := make([]string, 0, len(names))
+	for _, name := range names {
+		if c.placeableOn(labeler.NodeLabels(name)) {
+			eligible = append(eligible, name)
+		}
+	}
+	if len(eligible) == 0 {
+		return nil, fmt.Errorf("no node satisfies the placement constraints: "+
+			"nodeLabels=[%s] excludedNodeLabels=[%s]", strings.Join(c.NodeLabels, ","),
+			strings.Join(c.ExcludedNodeLabels, ","))
+	}
+	return &Placement{names: eligible, local: nodes.LocalName()}, nil
+}
+
+func (c Config) placeableOn(labels map[string]string) bool {
+	for _, label := range c.NodeLabels {
+		key, value, _ := cluster.ParseLabel(label)
+		if v, ok := labels[key]; !ok || v != value {
+			return false
+		}
+	}
+	for _, label := range c.ExcludedNodeLabels {
+		key, value, _ := cluster.ParseLabel(label)
+		if v, ok := labels[key]; ok && v == value {
+			return false
+		}
+	}
+	return true
+}
+
+func (c Config) validatePlacement() error {
+	for _, label := range append(c.NodeLabels, c.ExcludedNodeLabels...) {
+		if _, _, err := cluster.ParseLabel(label); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Placement are the nodes which satisfy the placement constraints of a class
+type Placement struct {
+	names []string
+	local string
+}
+
+func (p *Placement) AllNames() []string {
+	return p.names
+}
+
+func (p *Placement) LocalName() string {
+	return p.local
+}
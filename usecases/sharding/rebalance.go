@@ -0,0 +1,188 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sharding
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Move transfers the replica of a physical shard from one node to another
+type Move struct {
+	Shard string `json:"shard"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// RebalancePlan computes the moves which spread the replicas of the
+// physical shards evenly across the nodes on which they may be placed, for
+// example after nodes have been added.
+//
+// The load of a node is the share of the token ring which its replicas own,
+// which is the sum of the virtual shards assigned to them. Physical shards
+// are moved as a whole and keep their virtual shards, so no object changes
+// its shard. A replica is only moved if this brings both nodes closer to the
+// average load by more than half a replica, so shards don't move back and
+// forth between nodes of almost equal load, and only replicas of overloaded
+// nodes move. Replicas on nodes which are not eligible, e.g. unreachable
+// ones, are left as they are. The plan is deterministic.
+func (s *State) RebalancePlan(nodes nodes) ([]Move, error) {
+	placement, err := s.Config.Placement(nodes)
+	if err != nil {
+		return nil, err
+	}
+	names := append([]string(nil), placement.AllNames()...)
+	sort.Strings(names)
+	if len(names) < 2 {
+		return nil, nil
+	}
+
+	shards := s.AllPhysicalShards()
+	weights := s.shardWeights(shards)
+
+	load := make(map[string]float64, len(names))
+	owners := make(map[string]map[string]struct{}, len(shards))
+	replicas := 0
+	for _, node := range names {
+		load[node] = 0
+	}
+	for _, name := range shards {
+		owners[name] = map[string]struct{}{}
+		for _, node := range s.Physical[name].BelongsToNodes {
+			owners[name][node] = struct{}{}
+			if _, ok := load[node]; ok {
+				load[node] += weights[name]
+				replicas++
+			}
+		}
+	}
+	if replicas == 0 {
+		return nil, nil
+	}
+
+	total := 0.0
+	for _, l := range load {
+		total += l
+	}
+	avg := total / float64(len(names))
+	tolerance := total / float64(replicas) / 2
+	deviation := func(l float64) float64 { return math.Abs(l - avg) }
+
+	// a replica is moved at most once per plan
+	moved := map[string]map[string]struct{}{}
+	var moves []Move
+	for i := 0; i < replicas; i++ {
+		m, ok := nextMove(names, shards, load, weights, owners, moved, deviation, tolerance)
+		if !ok {
+			break
+		}
+
+		load[m.From] -= weights[m.Shard]
+		load[m.To] += weights[m.Shard]
+		delete(owners[m.Shard], m.From)
+		owners[m.Shard][m.To] = struct{}{}
+		if moved[m.Shard] == nil {
+			moved[m.Shard] = map[string]struct{}{}
+		}
+		moved[m.Shard][m.To] = struct{}{}
+		moves = append(moves, m)
+	}
+
+	return moves, nil
+}
+
+// nextMove returns the move of a replica from the most loaded node which
+// improves the balance the most
+func nextMove(names, shards []string, load, weights map[string]float64,
+	owners, moved map[string]map[string]struct{},
+	deviation func(float64) float64, tolerance float64,
+) (Move, bool) {
+	byLoad := append([]string(nil), names...)
+	sort.SliceStable(byLoad, func(a, b int) bool {
+		return load[byLoad[a]] > load[byLoad[b]]
+	})
+
+	for f, from := range byLoad {
+		for t := len(byLoad) - 1; t > f; t-- {
+			to := byLoad[t]
+			before := math.Max(deviation(load[from]), deviation(load[to]))
+
+			best, bestAfter := "", before-tolerance
+			for _, shard := range shards {
+				if _, ok := owners[shard][from]; !ok {
+					continue
+				}
+				if _, ok := owners[shard][to]; ok {
+					continue
+				}
+				if _, ok := moved[shard][from]; ok {
+					continue
+				}
+				w := weights[shard]
+				after := math.Max(deviation(load[from]-w), deviation(load[to]+w))
+				if after < bestAfter {
+					best, bestAfter = shard, after
+				}
+			}
+			if best != "" {
+				return Move{Shard: best, From: from, To: to}, true
+			}
+		}
+	}
+	return Move{}, false
+}
+
+// shardWeights returns the share of the token ring of each physical shard.
+// States without virtual shards weigh all shards equally.
+func (s *State) shardWeights(shards []string) map[string]float64 {
+	weights := make(map[string]float64, len(shards))
+	total := 0.0
+	for _, name := range shards {
+		weights[name] = s.Physical[name].OwnsPercentage
+		total += weights[name]
+	}
+	if total == 0 {
+		for _, name := range shards {
+			weights[name] = 1 / float64(len(shards))
+		}
+	}
+	return weights
+}
+
+// ApplyMove switches the ownership of the replica of the shard from m.From to
+// m.To. The new owner takes the position of the previous one, so it becomes
+// the first node of the shard if the previous owner was.
+func (s *State) ApplyMove(m Move) error {
+	shard, ok := s.Physical[m.Shard]
+	if !ok {
+		return fmt.Errorf("shard %q does not exist", m.Shard)
+	}
+
+	pos := -1
+	for i, node := range shard.BelongsToNodes {
+		if node == m.To {
+			return fmt.Errorf("shard %q already belongs to node %q", m.Shard, m.To)
+		}
+		if node == m.From {
+			pos = i
+		}
+	}
+	if pos < 0 {
+		return fmt.Errorf("shard %q does not belong to node %q", m.Shard, m.From)
+	}
+
+	shard = shard.DeepCopy()
+	shard.BelongsToNodes[pos] = m.To
+	s.Physical[m.Shard] = shard
+	return nil
+}
@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sharding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRebalancePlan(t *testing.T) {
+	// nodeLoads returns the number of replicas of each node after the moves
+	nodeLoads := func(t *testing.T, state *State, moves []Move) map[string]int {
+		for _, m := range moves {
+			require.Nil(t, state.ApplyMove(m))
+		}
+		loads := map[string]int{}
+		for _, shard := range state.Physical {
+			for _, node := range shard.BelongsToNodes {
+				loads[node]++
+			}
+		}
+		return loads
+	}
+
+	t.Run("new node receives shards", func(t *testing.T) {
+		cfg := Config{DesiredCount: 4, DesiredVirtualCount: 512}
+		state, err := InitState("my-index", cfg, fakeNodes{[]string{"N1"}}, 1)
+		require.Nil(t, err)
+
+		moves, err := state.RebalancePlan(fakeNodes{[]string{"N1", "N2"}})
+		require.Nil(t, err)
+		require.Len(t, moves, 2)
+		for _, m := range moves {
+			assert.Equal(t, "N1", m.From)
+			assert.Equal(t, "N2", m.To)
+		}
+		assert.Equal(t, map[string]int{"N1": 2, "N2": 2}, nodeLoads(t, state, moves))
+
+		moves, err = state.RebalancePlan(fakeNodes{[]string{"N1", "N2"}})
+		require.Nil(t, err)
+		assert.Empty(t, moves, "balanced state is stable")
+	})
+
+	t.Run("uneven count of shards", func(t *testing.T) {
+		cfg := Config{DesiredCount: 3, DesiredVirtualCount: 384}
+		state, err := InitState("my-index", cfg, fakeNodes{[]string{"N1", "N2"}}, 1)
+		require.Nil(t, err)
+
+		moves, err := state.RebalancePlan(fakeNodes{[]string{"N1", "N2"}})
+		require.Nil(t, err)
+		assert.Empty(t, moves)
+	})
+
+	t.Run("replicated shards", func(t *testing.T) {
+		cfg := Config{DesiredCount: 3, DesiredVirtualCount: 384}
+		state, err := InitState("my-index", cfg, fakeNodes{[]string{"N1", "N2", "N3"}}, 2)
+		require.Nil(t, err)
+
+		nodes := fakeNodes{[]string{"N1", "N2", "N3", "N4"}}
+		moves, err := state.RebalancePlan(nodes)
+		require.Nil(t, err)
+		require.NotEmpty(t, moves)
+		for _, m := range moves {
+			assert.Equal(t, "N4", m.To)
+		}
+
+		loads := nodeLoads(t, state, moves)
+		for _, node := range nodes.nodes {
+			assert.GreaterOrEqual(t, loads[node], 1, node)
+			assert.LessOrEqual(t, loads[node], 2, node)
+		}
+		for name, shard := range state.Physical {
+			assert.Len(t, shard.BelongsToNodes, 2, name)
+			assert.NotEqual(t, shard.BelongsToNodes[0], shard.BelongsToNodes[1], name)
+		}
+	})
+
+	t.Run("only eligible nodes", func(t *testing.T) {
+		nodes := fakeLabeledNodes{
+			fakeNodes: fakeNodes{nodes: []string{"N1", "N2", "N3"}},
+			labels: map[string]map[string]string{
+				"N1": {"storage": "nvme"},
+				"N2": {"storage": "hdd"},
+				"N3": {"storage": "nvme"},
+			},
+		}
+		cfg := Config{DesiredCount: 4, DesiredVirtualCount: 512, NodeLabels: []string{"storage=nvme"}}
+		initial := nodes
+		initial.nodes = []string{"N1"}
+		state, err := InitState("my-index", cfg, initial, 1)
+		require.Nil(t, err)
+
+		moves, err := state.RebalancePlan(nodes)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]int{"N1": 2, "N3": 2}, nodeLoads(t, state, moves))
+	})
+
+	t.Run("unreachable owners", func(t *testing.T) {
+		cfg := Config{DesiredCount: 4, DesiredVirtualCount: 512}
+		state, err := InitState("my-index", cfg, fakeNodes{[]string{"N1", "N2"}}, 1)
+		require.Nil(t, err)
+
+		// N2 is gone, its shards can't be moved and N1 is not overloaded
+		// compared to the new node N3
+		moves, err := state.RebalancePlan(fakeNodes{[]string{"N1", "N3"}})
+		require.Nil(t, err)
+		for _, m := range moves {
+			assert.Equal(t, "N1", m.From)
+		}
+		assert.Equal(t, 2, nodeLoads(t, state, moves)["N2"])
+	})
+}
+
+func TestApplyMove(t *testing.T) {
+	state := &State{Physical: map[string]Physical{
+		"S1": {Name: "S1", BelongsToNodes: []string{"N1", "N2"}},
+	}}
+	before := state.DeepCopy()
+
+	assert.ErrorContains(t, state.ApplyMove(Move{Shard: "S2", From: "N1", To: "N3"}),
+		"does not exist")
+	assert.ErrorContains(t, state.ApplyMove(Move{Shard: "S1", From: "N3", To: "N4"}),
+		"does not belong to node")
+	assert.ErrorContains(t, state.ApplyMove(Move{Shard: "S1", From: "N1", To: "N2"}),
+		"already belongs to node")
+
+	require.Nil(t, state.ApplyMove(Move{Shard: "S1", From: "N1", To: "N3"}))
+	assert.Equal(t, []string{"N3", "N2"}, state.Physical["S1"].BelongsToNodes)
+	assert.Equal(t, []string{"N1", "N2"}, before.Physical["S1"].BelongsToNodes)
+}
@@ -20,6 +20,8 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/replay"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -63,6 +65,8 @@ type RemoteIndexClient interface {
 	GetObject(ctx context.Context, hostname, indexName, shardName string,
 		id strfmt.UUID, props search.SelectProperties,
 		additional additional.Properties) (*storobj.Object, error)
+	GetObjectAtVersion(ctx context.Context, hostname, indexName, shardName string,
+		id strfmt.UUID, version int64) (*storobj.Object, error)
 	Exists(ctx context.Context, hostname, indexName, shardName string,
 		id strfmt.UUID) (bool, error)
 	DeleteObject(ctx context.Context, hostname, indexName, shardName string,
@@ -72,12 +76,17 @@ type RemoteIndexClient interface {
 	MultiGetObjects(ctx context.Context, hostname, indexName, shardName string,
 		ids []strfmt.UUID) ([]*storobj.Object, error)
 	SearchShard(ctx context.Context, hostname, indexName, shardName string,
-		searchVector []float32, limit int, filters *filters.LocalFilter,
+		searchVector []float32, targetVector string, targets *searchparams.TargetVectors,
+		distance float32, limit int,
+		filters *filters.LocalFilter,
 		keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 		cursor *filters.Cursor, additional additional.Properties,
 	) ([]*storobj.Object, []float32, error)
 	Aggregate(ctx context.Context, hostname, indexName, shardName string,
 		params aggregation.Params) (*aggregation.Result, error)
+	SampleObjects(ctx context.Context, hostName, indexName, shardName string,
+		filters *filters.LocalFilter, sample filters.Sample,
+		additional additional.Properties) ([]*storobj.Object, []uint64, error)
 	FindDocIDs(ctx context.Context, hostName, indexName, shardName string,
 		filters *filters.LocalFilter) ([]uint64, error)
 	DeleteObjectBatch(ctx context.Context, hostName, indexName, shardName string,
@@ -85,6 +94,14 @@ type RemoteIndexClient interface {
 	GetShardStatus(ctx context.Context, hostName, indexName, shardName string) (string, error)
 	UpdateShardStatus(ctx context.Context, hostName, indexName, shardName,
 		targetStatus string) error
+	OptimizeShard(ctx context.Context, hostName, indexName,
+		shardName string) (*models.ShardOptimizeResult, error)
+	CleanupInvertedShard(ctx context.Context, hostName, indexName,
+		shardName string) (*models.ShardInvertedCleanupResult, error)
+	PrepareMigrationShard(ctx context.Context, hostName, indexName,
+		shardName string, migration *models.SchemaMigration) error
+	ReplayShard(ctx context.Context, hostName, indexName, shardName string,
+		from, to int64, after replay.Cursor, limit int) (*replay.Page, error)
 
 	PutFile(ctx context.Context, hostName, indexName, shardName, fileName string,
 		payload io.ReadSeekCloser) error
@@ -217,6 +234,24 @@ func (ri *RemoteIndex) GetObject(ctx context.Context, shardName string,
 	return ri.client.GetObject(ctx, host, ri.class, shardName, id, props, additional)
 }
 
+// GetObjectAtVersion returns a retained version of an object from the node
+// holding the shard
+func (ri *RemoteIndex) GetObjectAtVersion(ctx context.Context, shardName string,
+	id strfmt.UUID, version int64,
+) (*storobj.Object, error) {
+	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
+	if !ok {
+		return nil, errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
+	}
+
+	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode())
+	if !ok {
+		return nil, errors.Errorf("resolve node name %q to host", shard.BelongsToNode())
+	}
+
+	return ri.client.GetObjectAtVersion(ctx, host, ri.class, shardName, id, version)
+}
+
 func (ri *RemoteIndex) MultiGetObjects(ctx context.Context, shardName string,
 	ids []strfmt.UUID,
 ) ([]*storobj.Object, error) {
@@ -234,7 +269,9 @@ func (ri *RemoteIndex) MultiGetObjects(ctx context.Context, shardName string,
 }
 
 func (ri *RemoteIndex) SearchShard(ctx context.Context, shardName string,
-	searchVector []float32, limit int, filters *filters.LocalFilter,
+	searchVector []float32, targetVector string, targets *searchparams.TargetVectors,
+	distance float32, limit int,
+	filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, additional additional.Properties, replEnabled bool,
 ) ([]*storobj.Object, []float32, error) {
@@ -248,8 +285,8 @@ func (ri *RemoteIndex) SearchShard(ctx context.Context, shardName string,
 		return nil, nil, errors.Errorf("resolve node name %q to host", shard.BelongsToNode())
 	}
 
-	objs, scores, err := ri.client.SearchShard(ctx, host, ri.class, shardName, searchVector, limit,
-		filters, keywordRanking, sort, cursor, additional)
+	objs, scores, err := ri.client.SearchShard(ctx, host, ri.class, shardName, searchVector, targetVector, targets,
+		distance, limit, filters, keywordRanking, sort, cursor, additional)
 	if replEnabled {
 		storobj.AddOwnership(objs, shard.BelongsToNode(), shard.Name)
 	}
@@ -272,6 +309,24 @@ func (ri *RemoteIndex) Aggregate(ctx context.Context, shardName string,
 	return ri.client.Aggregate(ctx, host, ri.class, shardName, params)
 }
 
+func (ri *RemoteIndex) SampleObjects(ctx context.Context, shardName string,
+	filters *filters.LocalFilter, sample filters.Sample,
+	additional additional.Properties,
+) ([]*storobj.Object, []uint64, error) {
+	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
+	if !ok {
+		return nil, nil, errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
+	}
+
+	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode())
+	if !ok {
+		return nil, nil, errors.Errorf("resolve node name %q to host", shard.BelongsToNode())
+	}
+
+	return ri.client.SampleObjects(ctx, host, ri.class, shardName, filters,
+		sample, additional)
+}
+
 func (ri *RemoteIndex) FindDocIDs(ctx context.Context, shardName string,
 	filters *filters.LocalFilter,
 ) ([]uint64, error) {
@@ -320,6 +375,57 @@ func (ri *RemoteIndex) GetShardStatus(ctx context.Context, shardName string) (st
 	return ri.client.GetShardStatus(ctx, host, ri.class, shardName)
 }
 
+// OptimizeShard optimizes the replica of a shard held by the given node
+func (ri *RemoteIndex) OptimizeShard(ctx context.Context, shardName,
+	nodeName string,
+) (*models.ShardOptimizeResult, error) {
+	host, ok := ri.nodeResolver.NodeHostname(nodeName)
+	if !ok {
+		return nil, errors.Errorf("resolve node name %q to host", nodeName)
+	}
+
+	return ri.client.OptimizeShard(ctx, host, ri.class, shardName)
+}
+
+// CleanupInvertedShard cleans up the inverted index of the replica of a
+// shard held by the given node
+func (ri *RemoteIndex) CleanupInvertedShard(ctx context.Context, shardName,
+	nodeName string,
+) (*models.ShardInvertedCleanupResult, error) {
+	host, ok := ri.nodeResolver.NodeHostname(nodeName)
+	if !ok {
+		return nil, errors.Errorf("resolve node name %q to host", nodeName)
+	}
+
+	return ri.client.CleanupInvertedShard(ctx, host, ri.class, shardName)
+}
+
+// PrepareMigrationShard rewrites the replica of a shard held by the given
+// node for a schema migration
+func (ri *RemoteIndex) PrepareMigrationShard(ctx context.Context, shardName,
+	nodeName string, migration *models.SchemaMigration,
+) error {
+	host, ok := ri.nodeResolver.NodeHostname(nodeName)
+	if !ok {
+		return errors.Errorf("resolve node name %q to host", nodeName)
+	}
+
+	return ri.client.PrepareMigrationShard(ctx, host, ri.class, shardName, migration)
+}
+
+// ReplayShard replays a page of the writes to the replica of a shard held by
+// the given node
+func (ri *RemoteIndex) ReplayShard(ctx context.Context, shardName, nodeName string,
+	from, to int64, after replay.Cursor, limit int,
+) (*replay.Page, error) {
+	host, ok := ri.nodeResolver.NodeHostname(nodeName)
+	if !ok {
+		return nil, errors.Errorf("resolve node name %q to host", nodeName)
+	}
+
+	return ri.client.ReplayShard(ctx, host, ri.class, shardName, from, to, after, limit)
+}
+
 func (ri *RemoteIndex) UpdateShardStatus(ctx context.Context, shardName, targetStatus string) error {
 	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
 	if !ok {
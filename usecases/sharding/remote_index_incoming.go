@@ -20,11 +20,15 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/changes"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/replay"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
 )
@@ -40,6 +44,8 @@ type RemoteIndexIncomingRepo interface {
 		objs []*storobj.Object) []error
 	IncomingBatchAddReferences(ctx context.Context, shardName string,
 		refs objects.BatchReferences) []error
+	IncomingGetObjectAtVersion(ctx context.Context, shardName string,
+		id strfmt.UUID, version int64) (*storobj.Object, error)
 	IncomingGetObject(ctx context.Context, shardName string, id strfmt.UUID,
 		selectProperties search.SelectProperties,
 		additional additional.Properties) (*storobj.Object, error)
@@ -52,12 +58,16 @@ type RemoteIndexIncomingRepo interface {
 	IncomingMultiGetObjects(ctx context.Context, shardName string,
 		ids []strfmt.UUID) ([]*storobj.Object, error)
 	IncomingSearch(ctx context.Context, shardName string,
-		vector []float32, distance float32, limit int, filters *filters.LocalFilter,
+		vector []float32, targetVector string, targets *searchparams.TargetVectors,
+		distance float32, limit int, filters *filters.LocalFilter,
 		keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 		cursor *filters.Cursor, additional additional.Properties,
 	) ([]*storobj.Object, []float32, error)
 	IncomingAggregate(ctx context.Context, shardName string,
 		params aggregation.Params) (*aggregation.Result, error)
+	IncomingSampleObjects(ctx context.Context, shardName string,
+		filters *filters.LocalFilter, sample filters.Sample,
+		additional additional.Properties) ([]*storobj.Object, []uint64, error)
 	IncomingFindDocIDs(ctx context.Context, shardName string,
 		filters *filters.LocalFilter) ([]uint64, error)
 	IncomingDeleteObjectBatch(ctx context.Context, shardName string,
@@ -74,6 +84,19 @@ type RemoteIndexIncomingRepo interface {
 		filePath string) (io.WriteCloser, error)
 	IncomingCreateShard(ctx context.Context, shardName string) error
 	IncomingReinitShard(ctx context.Context, shardName string) error
+
+	IncomingCheckVectorIndexIntegrity(ctx context.Context, shardName string,
+		repair bool) (hnsw.IntegrityReport, error)
+	IncomingOptimizeShard(ctx context.Context,
+		shardName string) (*models.ShardOptimizeResult, error)
+	IncomingCleanupInvertedShard(ctx context.Context,
+		shardName string) (*models.ShardInvertedCleanupResult, error)
+	IncomingPrepareMigrationShard(ctx context.Context, shardName string,
+		migration *models.SchemaMigration) error
+	IncomingChangesSince(ctx context.Context, shardName, token string,
+		limit int) (*changes.Changes, error)
+	IncomingReplayShard(ctx context.Context, shardName string, from, to int64,
+		after replay.Cursor, limit int) (*replay.Page, error)
 }
 
 type RemoteIndexIncoming struct {
@@ -133,6 +156,17 @@ func (rii *RemoteIndexIncoming) GetObject(ctx context.Context, indexName,
 	return index.IncomingGetObject(ctx, shardName, id, selectProperties, additional)
 }
 
+func (rii *RemoteIndexIncoming) GetObjectAtVersion(ctx context.Context, indexName,
+	shardName string, id strfmt.UUID, version int64,
+) (*storobj.Object, error) {
+	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
+	if index == nil {
+		return nil, errors.Errorf("local index %q not found", indexName)
+	}
+
+	return index.IncomingGetObjectAtVersion(ctx, shardName, id, version)
+}
+
 func (rii *RemoteIndexIncoming) Exists(ctx context.Context, indexName,
 	shardName string, id strfmt.UUID,
 ) (bool, error) {
@@ -178,7 +212,8 @@ func (rii *RemoteIndexIncoming) MultiGetObjects(ctx context.Context, indexName,
 }
 
 func (rii *RemoteIndexIncoming) Search(ctx context.Context, indexName, shardName string,
-	vector []float32, distance float32, limit int, filters *filters.LocalFilter,
+	vector []float32, targetVector string, targets *searchparams.TargetVectors,
+	distance float32, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor,
 	additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
@@ -188,7 +223,7 @@ func (rii *RemoteIndexIncoming) Search(ctx context.Context, indexName, shardName
 	}
 
 	return index.IncomingSearch(
-		ctx, shardName, vector, distance, limit, filters, keywordRanking, sort, cursor, additional)
+		ctx, shardName, vector, targetVector, targets, distance, limit, filters, keywordRanking, sort, cursor, additional)
 }
 
 func (rii *RemoteIndexIncoming) Aggregate(ctx context.Context, indexName, shardName string,
@@ -202,6 +237,18 @@ func (rii *RemoteIndexIncoming) Aggregate(ctx context.Context, indexName, shardN
 	return index.IncomingAggregate(ctx, shardName, params)
 }
 
+func (rii *RemoteIndexIncoming) SampleObjects(ctx context.Context, indexName,
+	shardName string, filters *filters.LocalFilter, sample filters.Sample,
+	additional additional.Properties,
+) ([]*storobj.Object, []uint64, error) {
+	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
+	if index == nil {
+		return nil, nil, errors.Errorf("local index %q not found", indexName)
+	}
+
+	return index.IncomingSampleObjects(ctx, shardName, filters, sample, additional)
+}
+
 func (rii *RemoteIndexIncoming) FindDocIDs(ctx context.Context, indexName, shardName string,
 	filters *filters.LocalFilter,
 ) ([]uint64, error) {
@@ -280,6 +327,72 @@ func (rii *RemoteIndexIncoming) ReInitShard(ctx context.Context,
 	return index.IncomingReinitShard(ctx, shardName)
 }
 
+func (rii *RemoteIndexIncoming) CheckVectorIndexIntegrity(ctx context.Context,
+	indexName, shardName string, repair bool,
+) (hnsw.IntegrityReport, error) {
+	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
+	if index == nil {
+		return hnsw.IntegrityReport{}, errors.Errorf("local index %q not found", indexName)
+	}
+
+	return index.IncomingCheckVectorIndexIntegrity(ctx, shardName, repair)
+}
+
+func (rii *RemoteIndexIncoming) ChangesSince(ctx context.Context,
+	indexName, shardName, token string, limit int,
+) (*changes.Changes, error) {
+	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
+	if index == nil {
+		return nil, errors.Errorf("local index %q not found", indexName)
+	}
+
+	return index.IncomingChangesSince(ctx, shardName, token, limit)
+}
+
+func (rii *RemoteIndexIncoming) OptimizeShard(ctx context.Context,
+	indexName, shardName string,
+) (*models.ShardOptimizeResult, error) {
+	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
+	if index == nil {
+		return nil, errors.Errorf("local index %q not found", indexName)
+	}
+
+	return index.IncomingOptimizeShard(ctx, shardName)
+}
+
+func (rii *RemoteIndexIncoming) CleanupInvertedShard(ctx context.Context,
+	indexName, shardName string,
+) (*models.ShardInvertedCleanupResult, error) {
+	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
+	if index == nil {
+		return nil, errors.Errorf("local index %q not found", indexName)
+	}
+
+	return index.IncomingCleanupInvertedShard(ctx, shardName)
+}
+
+func (rii *RemoteIndexIncoming) PrepareMigrationShard(ctx context.Context,
+	indexName, shardName string, migration *models.SchemaMigration,
+) error {
+	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
+	if index == nil {
+		return errors.Errorf("local index %q not found", indexName)
+	}
+
+	return index.IncomingPrepareMigrationShard(ctx, shardName, migration)
+}
+
+func (rii *RemoteIndexIncoming) ReplayShard(ctx context.Context,
+	indexName, shardName string, from, to int64, after replay.Cursor, limit int,
+) (*replay.Page, error) {
+	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
+	if index == nil {
+		return nil, errors.Errorf("local index %q not found", indexName)
+	}
+
+	return index.IncomingReplayShard(ctx, shardName, from, to, after, limit)
+}
+
 func (rii *RemoteIndexIncoming) OverwriteObjects(ctx context.Context,
 	indexName, shardName string, vobjects []*objects.VObject,
 ) ([]replica.RepairResponse, error) {
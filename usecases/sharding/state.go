@@ -120,7 +120,11 @@ type nodes interface {
 
 func InitState(id string, config Config, nodes nodes, replFactor int64) (*State, error) {
 	out := &State{Config: config, IndexID: id, localNodeName: nodes.LocalName()}
-	names := nodes.AllNames()
+	placement, err := config.Placement(nodes)
+	if err != nil {
+		return nil, err
+	}
+	names := placement.AllNames()
 	if f, n := replFactor, len(names); f > int64(n) {
 		return nil, fmt.Errorf("not enough replicas: found %d want %d", n, f)
 	}
@@ -379,7 +383,18 @@ func (c Config) DeepCopy() Config {
 		Key:                 c.Key,
 		Strategy:            c.Strategy,
 		Function:            c.Function,
+		NodeLabels:          copyStrings(c.NodeLabels),
+		ExcludedNodeLabels:  copyStrings(c.ExcludedNodeLabels),
+	}
+}
+
+func copyStrings(in []string) []string {
+	if in == nil {
+		return nil
 	}
+	out := make([]string, len(in))
+	copy(out, in)
+	return out
 }
 
 func (p Physical) DeepCopy() Physical {
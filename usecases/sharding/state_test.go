@@ -229,6 +229,74 @@ func TestAdjustReplicas(t *testing.T) {
 	})
 }
 
+type fakeLabeledNodes struct {
+	fakeNodes
+	labels map[string]map[string]string
+}
+
+func (f fakeLabeledNodes) NodeLabels(name string) map[string]string {
+	return f.labels[name]
+}
+
+func TestPlacementConstraints(t *testing.T) {
+	nodes := fakeLabeledNodes{
+		fakeNodes: fakeNodes{nodes: []string{"N1", "N2", "N3", "N4"}},
+		labels: map[string]map[string]string{
+			"N1": {"storage": "nvme", "region": "eu"},
+			"N2": {"storage": "hdd", "region": "eu"},
+			"N3": {"storage": "nvme", "region": "us"},
+			"N4": {"storage": "nvme", "region": "eu", "zone": "eu-1b"},
+		},
+	}
+
+	t.Run("init state on required labels", func(t *testing.T) {
+		cfg := Config{DesiredCount: 4, NodeLabels: []string{"storage=nvme", "region=eu"}}
+		state, err := InitState("my-index", cfg, nodes, 2)
+		require.Nil(t, err)
+		require.Len(t, state.Physical, 4)
+		for _, shard := range state.Physical {
+			assert.ElementsMatch(t, []string{"N1", "N4"}, shard.BelongsToNodes)
+		}
+	})
+
+	t.Run("init state without excluded labels", func(t *testing.T) {
+		cfg := Config{DesiredCount: 3, ExcludedNodeLabels: []string{"storage=hdd", "zone=eu-1b"}}
+		state, err := InitState("my-index", cfg, nodes, 1)
+		require.Nil(t, err)
+		for _, shard := range state.Physical {
+			assert.Contains(t, []string{"N1", "N3"}, shard.BelongsToNode())
+		}
+	})
+
+	t.Run("not enough eligible nodes for the replication factor", func(t *testing.T) {
+		cfg := Config{DesiredCount: 1, NodeLabels: []string{"region=eu"}}
+		_, err := InitState("my-index", cfg, nodes, 4)
+		assert.ErrorContains(t, err, "not enough replicas")
+	})
+
+	t.Run("no eligible node", func(t *testing.T) {
+		cfg := Config{DesiredCount: 1, NodeLabels: []string{"region=ap"}}
+		_, err := InitState("my-index", cfg, nodes, 1)
+		assert.ErrorContains(t, err, "no node satisfies the placement constraints")
+	})
+
+	t.Run("nodes without labels", func(t *testing.T) {
+		cfg := Config{DesiredCount: 1, NodeLabels: []string{"region=eu"}}
+		_, err := InitState("my-index", cfg, nodes.fakeNodes, 1)
+		assert.ErrorContains(t, err, "require node labels")
+	})
+
+	t.Run("adjust replicas on eligible nodes", func(t *testing.T) {
+		cfg := Config{ExcludedNodeLabels: []string{"storage=hdd"}}
+		placement, err := cfg.Placement(nodes)
+		require.Nil(t, err)
+		shard := Physical{BelongsToNodes: []string{"N1"}}
+		require.Nil(t, shard.AdjustReplicas(3, placement))
+		assert.ElementsMatch(t, []string{"N1", "N3", "N4"}, shard.BelongsToNodes)
+		assert.NotNil(t, shard.AdjustReplicas(4, placement))
+	})
+}
+
 func TestStateDeepCopy(t *testing.T) {
 	original := State{
 		IndexID: "original",
@@ -13,6 +13,7 @@ package traverser
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
@@ -20,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/functionscore"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
@@ -43,6 +45,7 @@ type Explorer struct {
 	schemaGetter     uc.SchemaGetter
 	nearParamsVector *nearParamsVector
 	metrics          explorerMetrics
+	hybridTuner      *hybrid.Tuner
 }
 
 type explorerMetrics interface {
@@ -103,6 +106,13 @@ func (e *Explorer) SetSchemaGetter(sg uc.SchemaGetter) {
 	e.schemaGetter = sg
 }
 
+// SetHybridTuner enables recording hybrid queries for tuning, if the tuner
+// auto-applies its suggestions, they are used for hybrid queries without an
+// explicit alpha
+func (e *Explorer) SetHybridTuner(tuner *hybrid.Tuner) {
+	e.hybridTuner = tuner
+}
+
 // GetClass from search and connector repo
 func (e *Explorer) GetClass(ctx context.Context,
 	params dto.GetParams,
@@ -126,6 +136,14 @@ func (e *Explorer) GetClass(ctx context.Context,
 		return nil, errors.Wrap(err, "cursor api: invalid 'after' parameter")
 	}
 
+	if err := e.validateVectorCursor(params); err != nil {
+		return nil, errors.Wrap(err, "invalid 'vectorCursor' parameter")
+	}
+
+	if err := e.validateSample(params); err != nil {
+		return nil, errors.Wrap(err, "invalid 'sample' parameter")
+	}
+
 	if params.KeywordRanking != nil {
 		return e.getClassKeywordBased(ctx, params)
 	}
@@ -224,10 +242,21 @@ func (e *Explorer) getClassVectorSearch(ctx context.Context,
 }
 
 func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.Result, error) {
+	var bm25Override *schema.BM25Config
+	if params.HybridSearch.AlphaDefaulted {
+		if alpha, bm25, ok := e.hybridTuner.Applied(params.ClassName); ok {
+			hybridSearch := *params.HybridSearch
+			hybridSearch.Alpha = alpha
+			params.HybridSearch = &hybridSearch
+			bm25Override = bm25
+		}
+	}
+
 	sparseSearch := func() ([]*storobj.Object, []float32, error) {
 		params.KeywordRanking = &searchparams.KeywordRanking{
 			Query: params.HybridSearch.Query,
 			Type:  "bm25",
+			BM25:  bm25Override,
 		}
 
 		res, dists, err := e.search.ClassObjectSearch(ctx, params)
@@ -265,15 +294,57 @@ func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.R
 		HybridSearch: params.HybridSearch,
 		Keyword:      params.KeywordRanking,
 		Class:        params.ClassName,
+		Boost:        e.boostConfig(params.ClassName),
 	}, e.logger, sparseSearch, denseSearch,
 		postProcess, e.modulesProvider)
+	if e.hybridTuner != nil {
+		h.WithRecorder(e.hybridTuner)
+	}
 
 	res, err := h.Search(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return res.SearchResults(), nil
+	found := res.SearchResults()
+	AddGeoDistances(found, params)
+	if params.FunctionScore != nil {
+		expr, err := functionscore.Parse(params.FunctionScore.Expression)
+		if err != nil {
+			return nil, err
+		}
+		expr.Rank(found, time.Now())
+	}
+	return found, nil
+}
+
+// HybridSparseRanking returns the ids of the keyword results of a query with
+// the given BM25 parameters, it is used by the hybrid.Tuner
+func (e *Explorer) HybridSparseRanking(ctx context.Context, className, query string,
+	bm25 schema.BM25Config, limit int,
+) ([]strfmt.UUID, error) {
+	if limit <= 0 {
+		limit = hybrid.DefaultLimit
+	}
+
+	res, _, err := e.search.ClassObjectSearch(ctx, dto.GetParams{
+		ClassName:  className,
+		Pagination: &filters.Pagination{Limit: limit},
+		KeywordRanking: &searchparams.KeywordRanking{
+			Query: query,
+			Type:  "bm25",
+			BM25:  &bm25,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]strfmt.UUID, len(res))
+	for i, obj := range res {
+		ids[i] = obj.ID()
+	}
+	return ids, nil
 }
 
 func (e *Explorer) getClassList(ctx context.Context,
@@ -595,6 +666,22 @@ func (e *Explorer) crossClassVectorFromModules(ctx context.Context,
 	return nil, errors.New("no modules defined")
 }
 
+func (e *Explorer) boostConfig(className string) schema.BoostConfig {
+	if e.schemaGetter == nil {
+		// explorers which are used without a schema don't boost
+		return schema.BoostConfig{}
+	}
+	s := e.schemaGetter.GetSchemaSkipAuth()
+	if s.Objects == nil {
+		return schema.BoostConfig{}
+	}
+	class := s.GetClass(schema.ClassName(className))
+	if class == nil {
+		return schema.BoostConfig{}
+	}
+	return schema.BoostConfigFromModel(class.InvertedIndexConfig)
+}
+
 func (e *Explorer) checkCertaintyCompatibility(className string) error {
 	s := e.schemaGetter.GetSchemaSkipAuth()
 	if s.Objects == nil {
@@ -604,12 +691,12 @@ func (e *Explorer) checkCertaintyCompatibility(className string) error {
 	if class == nil {
 		return errors.Errorf("failed to get class: %s", className)
 	}
-	hnswConfig, err := typeAssertVectorIndex(class)
+	distance, err := vectorIndexDistance(class)
 	if err != nil {
 		return err
 	}
-	if hnswConfig.Distance != hnsw.DistanceCosine {
-		return certaintyUnsupportedError(hnswConfig.Distance)
+	if distance != hnsw.DistanceCosine {
+		return certaintyUnsupportedError(distance)
 	}
 
 	return nil
@@ -96,6 +96,39 @@ func Test_Explorer_GetClass(t *testing.T) {
 		})
 	})
 
+	t.Run("when an explore param is set for nearVector with several target vectors", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName: "BestClass",
+			NearVector: &searchparams.NearVector{
+				Vector: []float32{0.8, 0.2, 0.7},
+				TargetVectors: &searchparams.TargetVectors{
+					Targets:     []string{"title", "body"},
+					Vectors:     [][]float32{{0.8, 0.2, 0.7}, {0.1, 0.2}},
+					Combination: searchparams.TargetVectorCombinationMin,
+				},
+			},
+			Pagination: &filters.Pagination{Limit: 100},
+		}
+
+		searchResults := []search.Result{{ID: "id1", Dims: 128}}
+
+		search := &fakeVectorSearcher{}
+		metrics := &fakeMetrics{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(search, log, getFakeModulesProvider(), metrics)
+		expectedParamsToSearch := params
+		expectedParamsToSearch.SearchVector = []float32{0.8, 0.2, 0.7}
+		search.
+			On("VectorClassSearch", expectedParamsToSearch).
+			Return(searchResults, nil)
+		metrics.On("AddUsageDimensions", "BestClass", "get_graphql", "nearVector", 128)
+
+		res, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+		assert.Len(t, res, 1)
+		search.AssertExpectations(t)
+	})
+
 	t.Run("when an explore param is set for nearObject without id and beacon", func(t *testing.T) {
 		t.Run("with distance", func(t *testing.T) {
 			// TODO: this is a module specific test case, which relies on the
@@ -0,0 +1,56 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/dto"
+)
+
+func (e *Explorer) validateSample(params dto.GetParams) error {
+	if params.Sample == nil {
+		return nil
+	}
+
+	var conflicts []string
+	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 {
+		conflicts = append(conflicts, "near<Media>")
+	}
+	if params.KeywordRanking != nil {
+		conflicts = append(conflicts, "bm25")
+	}
+	if params.HybridSearch != nil {
+		conflicts = append(conflicts, "hybrid")
+	}
+	if params.Pagination != nil && params.Pagination.Offset > 0 {
+		conflicts = append(conflicts, "offset")
+	}
+	if len(params.Sort) > 0 {
+		conflicts = append(conflicts, "sort")
+	}
+	if params.FunctionScore != nil {
+		conflicts = append(conflicts, "functionScore")
+	}
+	if params.Cursor != nil {
+		conflicts = append(conflicts, "after")
+	}
+	if params.VectorCursor != nil {
+		conflicts = append(conflicts, "vectorCursor")
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("%s cannot be combined with sample",
+			strings.Join(conflicts, ","))
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func Test_Explorer_ValidateSample(t *testing.T) {
+	sample := &filters.Sample{Size: 10, Seed: 1}
+
+	tests := []struct {
+		name          string
+		params        dto.GetParams
+		expectedError string
+	}{
+		{
+			name:   "without a sample",
+			params: dto.GetParams{NearVector: &searchparams.NearVector{Vector: []float32{1, 2}}},
+		},
+		{
+			name: "sample of filter matches",
+			params: dto.GetParams{
+				Sample:     sample,
+				Pagination: &filters.Pagination{Limit: 100},
+				Filters:    &filters.LocalFilter{},
+			},
+		},
+		{
+			name: "with a vector search",
+			params: dto.GetParams{
+				Sample:     sample,
+				NearVector: &searchparams.NearVector{Vector: []float32{1, 2}},
+			},
+			expectedError: "near<Media> cannot be combined with sample",
+		},
+		{
+			name: "with offset and sort",
+			params: dto.GetParams{
+				Sample:     sample,
+				Pagination: &filters.Pagination{Offset: 10, Limit: 10},
+				Sort:       []filters.Sort{{Path: []string{"name"}, Order: "asc"}},
+			},
+			expectedError: "offset,sort cannot be combined with sample",
+		},
+		{
+			name: "with bm25",
+			params: dto.GetParams{
+				Sample:         sample,
+				KeywordRanking: &searchparams.KeywordRanking{Type: "bm25", Query: "foo"},
+			},
+			expectedError: "bm25 cannot be combined with sample",
+		},
+	}
+
+	explorer := &Explorer{}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := explorer.validateSample(test.params)
+			if test.expectedError == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/dto"
+)
+
+func (e *Explorer) validateVectorCursor(params dto.GetParams) error {
+	if params.VectorCursor == nil && !params.AdditionalProperties.VectorCursor {
+		return nil
+	}
+
+	if params.NearVector == nil && params.NearObject == nil && len(params.ModuleParams) == 0 {
+		return fmt.Errorf("only supported for vector searches with nearVector, " +
+			"nearObject or a near<Media> argument")
+	}
+
+	var conflicts []string
+	if params.Pagination != nil && params.Pagination.Offset > 0 {
+		conflicts = append(conflicts, "offset")
+	}
+	if len(params.Sort) > 0 {
+		conflicts = append(conflicts, "sort")
+	}
+	if params.Group != nil {
+		conflicts = append(conflicts, "group")
+	}
+	if params.FunctionScore != nil {
+		conflicts = append(conflicts, "functionScore")
+	}
+	if params.HybridSearch != nil {
+		conflicts = append(conflicts, "hybrid")
+	}
+	if params.Cursor != nil {
+		conflicts = append(conflicts, "after")
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("%s cannot be combined with a vector cursor",
+			strings.Join(conflicts, ","))
+	}
+	return nil
+}
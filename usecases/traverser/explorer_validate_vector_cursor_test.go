@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func Test_Explorer_ValidateVectorCursor(t *testing.T) {
+	cursor := (&filters.VectorCursor{}).Advance("shard1", 0.1, "id")
+	nearVector := &searchparams.NearVector{Vector: []float32{1, 2}}
+
+	tests := []struct {
+		name          string
+		params        dto.GetParams
+		expectedError string
+	}{
+		{
+			name:   "without a cursor",
+			params: dto.GetParams{Pagination: &filters.Pagination{Offset: 10}},
+		},
+		{
+			name:   "continue a vector search",
+			params: dto.GetParams{NearVector: nearVector, VectorCursor: cursor},
+		},
+		{
+			name: "ask for the cursors of a vector search",
+			params: dto.GetParams{
+				NearVector:           nearVector,
+				AdditionalProperties: additional.Properties{VectorCursor: true},
+			},
+		},
+		{
+			name:          "not a vector search",
+			params:        dto.GetParams{VectorCursor: cursor},
+			expectedError: "only supported for vector searches",
+		},
+		{
+			name: "with offset and sort",
+			params: dto.GetParams{
+				NearVector:   nearVector,
+				VectorCursor: cursor,
+				Pagination:   &filters.Pagination{Offset: 10, Limit: 10},
+				Sort:         []filters.Sort{{Path: []string{"name"}, Order: "asc"}},
+			},
+			expectedError: "offset,sort cannot be combined with a vector cursor",
+		},
+		{
+			name: "with function score",
+			params: dto.GetParams{
+				NearVector:    nearVector,
+				VectorCursor:  cursor,
+				FunctionScore: &searchparams.FunctionScore{Expression: "_score"},
+			},
+			expectedError: "functionScore cannot be combined with a vector cursor",
+		},
+	}
+
+	explorer := &Explorer{}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := explorer.validateVectorCursor(test.params)
+			if test.expectedError == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.expectedError)
+			}
+		})
+	}
+}
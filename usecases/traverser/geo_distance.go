@@ -0,0 +1,48 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// AddGeoDistances sets the additional property "distanceMeters" of the
+// results of a query with a geo range filter. It is the distance between the
+// point of the filter and the geo coordinates of the result. The distances
+// are only set if they are requested or used by the function score.
+func AddGeoDistances(results search.Results, params dto.GetParams) {
+	if !params.AdditionalProperties.DistanceMeters && params.FunctionScore == nil {
+		return
+	}
+	prop, geoRange, ok := filters.FindGeoRange(params.Filters)
+	if !ok {
+		return
+	}
+
+	for i := range results {
+		props, ok := results[i].Schema.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		coordinates, ok := props[prop.String()].(*models.GeoCoordinates)
+		if !ok || coordinates.Latitude == nil || coordinates.Longitude == nil {
+			continue
+		}
+		if results[i].AdditionalProperties == nil {
+			results[i].AdditionalProperties = models.AdditionalProperties{}
+		}
+		results[i].AdditionalProperties["distanceMeters"] = geoRange.DistanceMeters(coordinates)
+	}
+}
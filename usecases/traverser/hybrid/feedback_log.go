@@ -0,0 +1,191 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hybrid
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+)
+
+const feedbackLogExt = ".jsonl"
+
+// feedbackLog persists the feedback events of every class as JSON lines in
+// <dir>/<class>.jsonl, so that the feedback and the suggestions derived from
+// it survive restarts. Once a file holds twice as many events as are kept,
+// it is rewritten with the kept events only. A nil log persists nothing.
+type feedbackLog struct {
+	dir string
+	// lines is the number of events in the file of each class
+	lines map[string]int
+}
+
+type loggedFeedback struct {
+	Query   string        `json:"query"`
+	Clicked []strfmt.UUID `json:"clicked"`
+	Alpha   float64       `json:"alpha"`
+	Sparse  []strfmt.UUID `json:"sparse"`
+	Dense   []strfmt.UUID `json:"dense"`
+}
+
+func newFeedbackLog(dir string) (*feedbackLog, error) {
+	if err := os.MkdirAll(dir, 0o777); err != nil {
+		return nil, errors.Wrap(err, "create hybrid feedback dir")
+	}
+	return &feedbackLog{dir: dir, lines: map[string]int{}}, nil
+}
+
+// load reads the most recent maxFeedbackPerClass events of every class
+func (l *feedbackLog) load() (map[string][]*feedbackEvent, error) {
+	entries, err := os.ReadDir(l.dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "read hybrid feedback dir")
+	}
+
+	feedback := map[string][]*feedbackEvent{}
+	for _, entry := range entries {
+		className := strings.TrimSuffix(entry.Name(), feedbackLogExt)
+		if entry.IsDir() || className == entry.Name() {
+			continue
+		}
+
+		events, lines, err := l.read(className)
+		if err != nil {
+			return nil, errors.Wrapf(err, "read hybrid feedback of class %s", className)
+		}
+		if len(events) > maxFeedbackPerClass {
+			events = events[len(events)-maxFeedbackPerClass:]
+		}
+		feedback[className] = events
+		l.lines[className] = lines
+	}
+	return feedback, nil
+}
+
+func (l *feedbackLog) read(className string) ([]*feedbackEvent, int, error) {
+	f, err := os.Open(l.path(className))
+	if err != nil {
+		return nil, 0, err
+	}
+	defer f.Close()
+
+	var events []*feedbackEvent
+	lines := 0
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		lines++
+		var logged loggedFeedback
+		if err := json.Unmarshal(scanner.Bytes(), &logged); err != nil {
+			// the last line is incomplete if the node crashed while it was
+			// appended
+			continue
+		}
+
+		event := &feedbackEvent{
+			query:   logged.Query,
+			clicked: make(map[strfmt.UUID]struct{}, len(logged.Clicked)),
+			recordedQuery: &recordedQuery{
+				alpha:  logged.Alpha,
+				sparse: logged.Sparse,
+				dense:  logged.Dense,
+			},
+		}
+		for _, id := range logged.Clicked {
+			event.clicked[id] = struct{}{}
+		}
+		events = append(events, event)
+	}
+	return events, lines, scanner.Err()
+}
+
+// append adds the event to the file of the class. events are the events which
+// are kept, they replace the file once it has grown too large.
+func (l *feedbackLog) append(className string, event *feedbackEvent,
+	events []*feedbackEvent,
+) error {
+	if l == nil {
+		return nil
+	}
+
+	if l.lines[className] >= 2*maxFeedbackPerClass {
+		return l.rewrite(className, events)
+	}
+
+	line, err := marshalFeedback(event)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(l.path(className), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o666)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(line); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	l.lines[className]++
+	return nil
+}
+
+// rewrite replaces the file of the class with the events
+func (l *feedbackLog) rewrite(className string, events []*feedbackEvent) error {
+	var content []byte
+	for _, event := range events {
+		line, err := marshalFeedback(event)
+		if err != nil {
+			return err
+		}
+		content = append(content, line...)
+	}
+
+	tmp := l.path(className) + ".tmp"
+	if err := os.WriteFile(tmp, content, 0o666); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, l.path(className)); err != nil {
+		return err
+	}
+	l.lines[className] = len(events)
+	return nil
+}
+
+func (l *feedbackLog) path(className string) string {
+	return filepath.Join(l.dir, className+feedbackLogExt)
+}
+
+func marshalFeedback(event *feedbackEvent) ([]byte, error) {
+	logged := loggedFeedback{
+		Query:   event.query,
+		Clicked: make([]strfmt.UUID, 0, len(event.clicked)),
+		Alpha:   event.alpha,
+		Sparse:  event.sparse,
+		Dense:   event.dense,
+	}
+	for id := range event.clicked {
+		logged.Clicked = append(logged.Clicked, id)
+	}
+
+	line, err := json.Marshal(logged)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal feedback")
+	}
+	return append(line, '\n'), nil
+}
@@ -16,6 +16,7 @@ import (
 	"sort"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 )
 
@@ -103,6 +104,17 @@ func FusionReciprocal(weights []float64, results [][]*Result) []*Result {
 	return concat
 }
 
+// boostFused multiplies the fused scores with the boost of their objects and
+// restores the order of the results
+func boostFused(results []*Result, boost schema.BoostConfig) {
+	for _, res := range results {
+		res.Score *= float32(boost.Boost(res.Schema))
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+}
+
 func FusionScoreConcatenate(results [][]*search.Result) []*search.Result {
 	// Concatenate the results
 	concatenatedResults := []*search.Result{}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -28,6 +29,8 @@ type Params struct {
 	*searchparams.HybridSearch
 	Keyword *searchparams.KeywordRanking
 	Class   string
+	// Boost of the class, it is applied to the fused scores if configured
+	Boost schema.BoostConfig
 }
 
 // Result facilitates the pairing of a search result with its internal doc id.
@@ -72,6 +75,11 @@ type modulesProvider interface {
 		className string, input string) ([]float32, error)
 }
 
+// queryRecorder receives the individual rankings of hybrid queries, see Tuner
+type queryRecorder interface {
+	RecordQuery(className, query string, alpha float64, sparse, dense []*Result)
+}
+
 type Searcher struct {
 	params           *Params
 	logger           logrus.FieldLogger
@@ -79,6 +87,7 @@ type Searcher struct {
 	denseSearchFunc  denseSearchFunc
 	postProcFunc     postProcFunc
 	modulesProvider  modulesProvider
+	recorder         queryRecorder
 }
 
 func NewSearcher(params *Params, logger logrus.FieldLogger,
@@ -95,6 +104,12 @@ func NewSearcher(params *Params, logger logrus.FieldLogger,
 	}
 }
 
+// WithRecorder sets a recorder for the rankings of the query
+func (s *Searcher) WithRecorder(recorder queryRecorder) *Searcher {
+	s.recorder = recorder
+	return s
+}
+
 // Search executes sparse and dense searches and combines the result sets using Reciprocal Rank Fusion
 func (s *Searcher) Search(ctx context.Context) (Results, error) {
 	var (
@@ -104,11 +119,12 @@ func (s *Searcher) Search(ctx context.Context) (Results, error) {
 
 	if s.params.Query != "" {
 		alpha := s.params.Alpha
+		var sparse, dense []*Result
 
 		if alpha < 1 {
 			res, err := s.sparseSearch()
 			if err == nil {
-
+				sparse = res
 				found = append(found, res)
 				weights = append(weights, 1-alpha)
 			}
@@ -117,11 +133,15 @@ func (s *Searcher) Search(ctx context.Context) (Results, error) {
 		if alpha > 0 {
 			res, err := s.denseSearch(ctx)
 			if err == nil {
-
+				dense = res
 				found = append(found, res)
 				weights = append(weights, alpha)
 			}
 		}
+
+		if s.recorder != nil {
+			s.recorder.RecordQuery(s.params.Class, s.params.Query, alpha, sparse, dense)
+		}
 	} else {
 		ss := s.params.SubSearches
 		for _, subsearch := range ss.([]searchparams.WeightedSearchResult) {
@@ -139,6 +159,9 @@ func (s *Searcher) Search(ctx context.Context) (Results, error) {
 	}
 
 	fused := FusionReciprocal(weights, found)
+	if s.params.Boost.BoostsHybrid() {
+		boostFused(fused, s.params.Boost)
+	}
 
 	if s.params.Limit >= 1 && (len(fused) > s.params.Limit) { //-1 is possible?
 		s.logger.Debugf("found more hybrid search results than limit, "+
@@ -13,13 +13,16 @@ package hybrid
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
@@ -449,3 +452,51 @@ func (f *fakeModuleProvider) VectorFromInput(ctx context.Context,
 	args := f.Called(ctx, className, input)
 	return args.Get(0).([]float32), nil
 }
+
+func TestSearcherBoost(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	class := "HybridClass"
+
+	sparse := func() ([]*storobj.Object, []float32, error) {
+		objs := make([]*storobj.Object, 2)
+		for i, props := range []map[string]any{
+			{"popularity": 1.0},
+			{"popularity": 10.0},
+		} {
+			id := strfmt.UUID(fmt.Sprintf("00000000-0000-0000-0000-%012d", i))
+			objs[i] = storobj.FromObject(&models.Object{Class: class, ID: id, Properties: props}, nil)
+			objs[i].SetDocID(uint64(i))
+		}
+		return objs, []float32{2, 1}, nil
+	}
+	dense := func([]float32) ([]*storobj.Object, []float32, error) { return nil, nil, nil }
+
+	search := func(scores string) Results {
+		s := NewSearcher(&Params{
+			HybridSearch: &searchparams.HybridSearch{
+				Type:  "hybrid",
+				Alpha: 0,
+				Query: "some query",
+			},
+			Class: class,
+			Boost: schema.BoostConfig{Property: "popularity", Scores: scores},
+		}, logger, sparse, dense, nil, nil)
+		res, err := s.Search(ctx)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		return res
+	}
+
+	t.Run("fused scores are boosted", func(t *testing.T) {
+		res := search(schema.BoostScoresHybrid)
+		assert.Equal(t, uint64(1), res[0].DocID)
+		assert.Equal(t, uint64(0), res[1].DocID)
+	})
+
+	t.Run("fused scores are not boosted for bm25 only", func(t *testing.T) {
+		res := search(schema.BoostScoresBM25)
+		assert.Equal(t, uint64(0), res[0].DocID)
+		assert.Equal(t, uint64(1), res[1].DocID)
+	})
+}
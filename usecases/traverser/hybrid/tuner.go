@@ -0,0 +1,415 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hybrid
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+const (
+	// maxRecordedQueries bounds the number of queries for which the rankings
+	// are kept, so that feedback can be related to them
+	maxRecordedQueries = 10000
+	// maxFeedbackPerClass bounds the number of feedback events per class,
+	// older events are dropped first
+	maxFeedbackPerClass = 1000
+	// maxBM25TuningQueries bounds the number of keyword searches which are
+	// re-run for every candidate set of BM25 parameters
+	maxBM25TuningQueries = 20
+
+	alphaStep = 0.05
+	// fusionOffset is the rank offset used by FusionReciprocal
+	fusionOffset = 61
+)
+
+// bm25Candidates are evaluated in order, so that the defaults win ties
+var bm25Candidates = func() []schema.BM25Config {
+	out := []schema.BM25Config{{K1: 1.2, B: 0.75}}
+	for _, k1 := range []float64{0.6, 0.9, 1.2, 1.5, 2.0} {
+		for _, b := range []float64{0.3, 0.5, 0.75, 0.9} {
+			if k1 == 1.2 && b == 0.75 {
+				continue
+			}
+			out = append(out, schema.BM25Config{K1: k1, B: b})
+		}
+	}
+	return out
+}()
+
+// ErrUnknownQuery is returned for feedback on queries which have not been
+// recorded, e.g. because they were issued before a restart
+var ErrUnknownQuery = errors.New("query has not been recorded")
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+// SparseRanker re-runs the keyword part of a hybrid query with the given BM25
+// parameters
+type SparseRanker interface {
+	HybridSparseRanking(ctx context.Context, className, query string,
+		bm25 schema.BM25Config, limit int) ([]strfmt.UUID, error)
+}
+
+// Suggestion for the hybrid parameters of a class, derived from feedback
+type Suggestion struct {
+	Class   string `json:"class"`
+	Samples int    `json:"samples"`
+	// Ready is false as long as there are fewer samples than required, all
+	// other fields are empty in that case
+	Ready bool    `json:"ready"`
+	Alpha float64 `json:"alpha,omitempty"`
+	// BaselineMRR is the mean reciprocal rank of the clicked results with the
+	// parameters that were used at query time
+	BaselineMRR float64         `json:"baselineMRR,omitempty"`
+	MRR         float64         `json:"mrr,omitempty"`
+	BM25        *BM25Suggestion `json:"bm25,omitempty"`
+}
+
+type BM25Suggestion struct {
+	K1  float64 `json:"k1"`
+	B   float64 `json:"b"`
+	MRR float64 `json:"mrr"`
+}
+
+type queryKey struct {
+	class string
+	query string
+}
+
+type recordedQuery struct {
+	alpha  float64
+	sparse []strfmt.UUID
+	dense  []strfmt.UUID
+}
+
+type feedbackEvent struct {
+	query   string
+	clicked map[strfmt.UUID]struct{}
+	*recordedQuery
+}
+
+// Tuner relates the clicked results which applications report for hybrid
+// queries to the keyword and vector rankings of those queries. From this it
+// suggests the alpha and the BM25 parameters which would have placed the
+// clicked results highest.
+//
+// If auto-apply is enabled, the last suggestion of a class is used for hybrid
+// queries which do not set alpha explicitly. The alpha suggestion is updated
+// with every feedback event, the BM25 suggestion only when it is requested,
+// as it requires re-running keyword searches.
+//
+// The feedback is persisted, the recorded queries are not. Feedback can only
+// be given for queries which ran on the same node since it started.
+type Tuner struct {
+	sync.Mutex
+	ranker      SparseRanker
+	authorizer  authorizer
+	log         *feedbackLog
+	minFeedback int
+	autoApply   bool
+
+	queries     map[queryKey]*recordedQuery
+	queryOrder  []queryKey
+	feedback    map[string][]*feedbackEvent
+	suggestions map[string]*Suggestion
+}
+
+// NewTuner loads the feedback persisted in the data path, an empty data path
+// keeps the feedback in memory only
+func NewTuner(ranker SparseRanker, authorizer authorizer, dataPath string,
+	minFeedback int, autoApply bool,
+) (*Tuner, error) {
+	t := &Tuner{
+		ranker:      ranker,
+		authorizer:  authorizer,
+		minFeedback: minFeedback,
+		autoApply:   autoApply,
+		queries:     map[queryKey]*recordedQuery{},
+		feedback:    map[string][]*feedbackEvent{},
+		suggestions: map[string]*Suggestion{},
+	}
+	if dataPath == "" {
+		return t, nil
+	}
+
+	log, err := newFeedbackLog(filepath.Join(dataPath, "hybrid_tuning"))
+	if err != nil {
+		return nil, err
+	}
+	if t.feedback, err = log.load(); err != nil {
+		return nil, err
+	}
+	t.log = log
+	if autoApply {
+		for className, events := range t.feedback {
+			t.updateAlpha(className, events)
+		}
+	}
+	return t, nil
+}
+
+// RecordQuery keeps the individual rankings of a hybrid query. Only queries
+// for which both rankings exist can be used for tuning.
+func (t *Tuner) RecordQuery(className, query string, alpha float64,
+	sparse, dense []*Result,
+) {
+	if t == nil || sparse == nil || dense == nil {
+		return
+	}
+
+	rec := &recordedQuery{alpha: alpha, sparse: resultIDs(sparse), dense: resultIDs(dense)}
+	key := queryKey{className, query}
+
+	t.Lock()
+	defer t.Unlock()
+
+	if _, ok := t.queries[key]; !ok {
+		t.queryOrder = append(t.queryOrder, key)
+	}
+	t.queries[key] = rec
+
+	if len(t.queryOrder) > maxRecordedQueries {
+		delete(t.queries, t.queryOrder[0])
+		t.queryOrder = t.queryOrder[1:]
+	}
+}
+
+// Feedback reports the results which were clicked or accepted for a query
+func (t *Tuner) Feedback(principal *models.Principal, className, query string,
+	clicked []strfmt.UUID,
+) error {
+	err := t.authorizer.Authorize(principal, "update",
+		fmt.Sprintf("schema/%s/hybrid-tuning", className))
+	if err != nil {
+		return err
+	}
+
+	if len(clicked) == 0 {
+		return errors.New("at least one clicked result is required")
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	rec, ok := t.queries[queryKey{className, query}]
+	if !ok {
+		return ErrUnknownQuery
+	}
+
+	event := &feedbackEvent{
+		query:         query,
+		clicked:       make(map[strfmt.UUID]struct{}, len(clicked)),
+		recordedQuery: rec,
+	}
+	for _, id := range clicked {
+		event.clicked[id] = struct{}{}
+	}
+
+	events := append(t.feedback[className], event)
+	if len(events) > maxFeedbackPerClass {
+		events = events[len(events)-maxFeedbackPerClass:]
+	}
+	if err := t.log.append(className, event, events); err != nil {
+		return errors.Wrap(err, "persist feedback")
+	}
+	t.feedback[className] = events
+
+	if t.autoApply {
+		t.updateAlpha(className, events)
+	}
+	return nil
+}
+
+// Suggest computes the suggestion for the class. The BM25 parameters are
+// only tuned once enough feedback has been collected.
+func (t *Tuner) Suggest(ctx context.Context, principal *models.Principal,
+	className string,
+) (Suggestion, error) {
+	err := t.authorizer.Authorize(principal, "get",
+		fmt.Sprintf("schema/%s/hybrid-tuning", className))
+	if err != nil {
+		return Suggestion{}, err
+	}
+
+	t.Lock()
+	events := append([]*feedbackEvent{}, t.feedback[className]...)
+	t.Unlock()
+
+	if len(events) < t.minFeedback || len(events) == 0 {
+		return Suggestion{Class: className, Samples: len(events)}, nil
+	}
+
+	sugg := suggestAlpha(className, events)
+
+	bm25, err := t.suggestBM25(ctx, className, sugg.Alpha, events)
+	if err != nil {
+		return Suggestion{}, err
+	}
+	sugg.BM25 = bm25
+
+	t.Lock()
+	t.suggestions[className] = &sugg
+	t.Unlock()
+
+	return sugg, nil
+}
+
+// Applied returns the suggested alpha and BM25 parameters of the class if
+// auto-apply is enabled and a suggestion exists
+func (t *Tuner) Applied(className string) (float64, *schema.BM25Config, bool) {
+	if t == nil || !t.autoApply {
+		return 0, nil, false
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	sugg, ok := t.suggestions[className]
+	if !ok {
+		return 0, nil, false
+	}
+
+	var bm25 *schema.BM25Config
+	if sugg.BM25 != nil {
+		bm25 = &schema.BM25Config{K1: sugg.BM25.K1, B: sugg.BM25.B}
+	}
+	return sugg.Alpha, bm25, true
+}
+
+// updateAlpha must be called with the lock held
+func (t *Tuner) updateAlpha(className string, events []*feedbackEvent) {
+	if len(events) < t.minFeedback {
+		return
+	}
+
+	sugg := suggestAlpha(className, events)
+	if prev, ok := t.suggestions[className]; ok {
+		sugg.BM25 = prev.BM25
+	}
+	t.suggestions[className] = &sugg
+}
+
+func (t *Tuner) suggestBM25(ctx context.Context, className string, alpha float64,
+	events []*feedbackEvent,
+) (*BM25Suggestion, error) {
+	if t.ranker == nil {
+		return nil, nil
+	}
+
+	if len(events) > maxBM25TuningQueries {
+		events = events[len(events)-maxBM25TuningQueries:]
+	}
+
+	var best *BM25Suggestion
+	for _, cfg := range bm25Candidates {
+		var sum float64
+		for _, event := range events {
+			sparse, err := t.ranker.HybridSparseRanking(ctx, className, event.query,
+				cfg, len(event.sparse))
+			if err != nil {
+				return nil, errors.Wrap(err, "re-run keyword search")
+			}
+			sum += reciprocalRank(fuse(alpha, sparse, event.dense), event.clicked)
+		}
+
+		mrr := sum / float64(len(events))
+		if best == nil || mrr > best.MRR {
+			best = &BM25Suggestion{K1: cfg.K1, B: cfg.B, MRR: mrr}
+		}
+	}
+
+	return best, nil
+}
+
+// suggestAlpha evaluates alpha in steps of alphaStep. Ties are resolved in
+// favor of the alpha that is closest to the one most recently used.
+func suggestAlpha(className string, events []*feedbackEvent) Suggestion {
+	sugg := Suggestion{Class: className, Samples: len(events), Ready: true}
+
+	for _, event := range events {
+		sugg.BaselineMRR += reciprocalRank(fuse(event.alpha, event.sparse, event.dense),
+			event.clicked)
+	}
+	sugg.BaselineMRR /= float64(len(events))
+
+	current := events[len(events)-1].alpha
+	sugg.MRR = -1
+	for step := 0; step <= int(math.Round(1/alphaStep)); step++ {
+		alpha := float64(step) * alphaStep
+
+		var sum float64
+		for _, event := range events {
+			sum += reciprocalRank(fuse(alpha, event.sparse, event.dense), event.clicked)
+		}
+		mrr := sum / float64(len(events))
+
+		if mrr > sugg.MRR || (mrr == sugg.MRR &&
+			math.Abs(alpha-current) < math.Abs(sugg.Alpha-current)) {
+			sugg.MRR = mrr
+			sugg.Alpha = alpha
+		}
+	}
+
+	return sugg
+}
+
+// fuse ranks the ids the same way FusionReciprocal ranks results. Ties are
+// resolved by the order of first appearance to keep the result stable.
+func fuse(alpha float64, sparse, dense []strfmt.UUID) []strfmt.UUID {
+	scores := map[strfmt.UUID]float64{}
+	var order []strfmt.UUID
+	add := func(ids []strfmt.UUID, weight float64) {
+		for i, id := range ids {
+			if _, ok := scores[id]; !ok {
+				order = append(order, id)
+			}
+			scores[id] += weight / float64(i+fusionOffset)
+		}
+	}
+	add(sparse, 1-alpha)
+	add(dense, alpha)
+
+	sort.SliceStable(order, func(a, b int) bool {
+		return scores[order[a]] > scores[order[b]]
+	})
+	return order
+}
+
+// reciprocalRank of the highest ranked clicked result, 0 if none of them is
+// part of the ranking
+func reciprocalRank(ranking []strfmt.UUID, clicked map[strfmt.UUID]struct{}) float64 {
+	for i, id := range ranking {
+		if _, ok := clicked[id]; ok {
+			return 1 / float64(i+1)
+		}
+	}
+	return 0
+}
+
+func resultIDs(results []*Result) []strfmt.UUID {
+	out := make([]strfmt.UUID, len(results))
+	for i, res := range results {
+		out[i] = res.ID
+	}
+	return out
+}
@@ -0,0 +1,180 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hybrid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func TestTuner(t *testing.T) {
+	ctx := context.Background()
+	class := "TunedClass"
+
+	ids := make([]strfmt.UUID, 10)
+	for i := range ids {
+		ids[i] = strfmt.UUID(fmt.Sprintf("00000000-0000-0000-0000-%012d", i))
+	}
+	results := func(order ...int) []*Result {
+		out := make([]*Result, len(order))
+		for i, pos := range order {
+			out[i] = &Result{Result: &search.Result{ID: ids[pos]}}
+		}
+		return out
+	}
+
+	// the keyword search ranks the clicked result (9) last, the vector
+	// search ranks it first. It is fused into first place for any alpha
+	// above 0.5, ties are resolved towards the alpha in use.
+	sparse := results(0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+	dense := results(9, 8, 7, 6, 5, 4, 3, 2, 1, 0)
+
+	t.Run("feedback for unknown query", func(t *testing.T) {
+		tuner := newTuner(t, nil, "", 1, false)
+		err := tuner.Feedback(nil, class, "unknown", []strfmt.UUID{ids[0]})
+		assert.ErrorIs(t, err, ErrUnknownQuery)
+	})
+
+	t.Run("not enough feedback", func(t *testing.T) {
+		tuner := newTuner(t, nil, "", 3, false)
+		tuner.RecordQuery(class, "query", 0.5, sparse, dense)
+		require.Nil(t, tuner.Feedback(nil, class, "query", []strfmt.UUID{ids[9]}))
+
+		sugg, err := tuner.Suggest(ctx, nil, class)
+		require.Nil(t, err)
+		assert.Equal(t, Suggestion{Class: class, Samples: 1}, sugg)
+	})
+
+	t.Run("suggests alpha and bm25 parameters", func(t *testing.T) {
+		ranker := &fakeSparseRanker{
+			best:    schema.BM25Config{K1: 2.0, B: 0.3},
+			ranking: []strfmt.UUID{ids[9], ids[0], ids[1]},
+			other:   []strfmt.UUID{ids[0], ids[1], ids[2]},
+		}
+		tuner := newTuner(t, ranker, "", 2, false)
+		for i := 0; i < 2; i++ {
+			query := fmt.Sprintf("query %d", i)
+			tuner.RecordQuery(class, query, 0.5, sparse, dense)
+			require.Nil(t, tuner.Feedback(nil, class, query, []strfmt.UUID{ids[9]}))
+		}
+
+		sugg, err := tuner.Suggest(ctx, nil, class)
+		require.Nil(t, err)
+		assert.True(t, sugg.Ready)
+		assert.Equal(t, 2, sugg.Samples)
+		assert.InDelta(t, 0.55, sugg.Alpha, 1e-9)
+		assert.Equal(t, 1.0, sugg.MRR)
+		assert.Less(t, sugg.BaselineMRR, sugg.MRR)
+		require.NotNil(t, sugg.BM25)
+		assert.Equal(t, 2.0, sugg.BM25.K1)
+		assert.Equal(t, 0.3, sugg.BM25.B)
+
+		_, _, ok := tuner.Applied(class)
+		assert.False(t, ok, "suggestions are not applied unless enabled")
+	})
+
+	t.Run("auto-applies the suggested alpha", func(t *testing.T) {
+		tuner := newTuner(t, nil, "", 1, true)
+		_, _, ok := tuner.Applied(class)
+		assert.False(t, ok)
+
+		tuner.RecordQuery(class, "query", 0.5, sparse, dense)
+		require.Nil(t, tuner.Feedback(nil, class, "query", []strfmt.UUID{ids[9]}))
+
+		alpha, bm25, ok := tuner.Applied(class)
+		require.True(t, ok)
+		assert.InDelta(t, 0.55, alpha, 1e-9)
+		assert.Nil(t, bm25)
+	})
+
+	t.Run("ties prefer the alpha in use", func(t *testing.T) {
+		tuner := newTuner(t, nil, "", 1, true)
+		tuner.RecordQuery(class, "query", 0.75, results(3), results(3))
+		require.Nil(t, tuner.Feedback(nil, class, "query", []strfmt.UUID{ids[3]}))
+
+		alpha, _, ok := tuner.Applied(class)
+		require.True(t, ok)
+		assert.InDelta(t, 0.75, alpha, 1e-9)
+	})
+
+	t.Run("feedback is persisted", func(t *testing.T) {
+		dir := t.TempDir()
+		tuner := newTuner(t, nil, dir, 1, false)
+		tuner.RecordQuery(class, "query", 0.5, sparse, dense)
+		for i := 0; i < 2*maxFeedbackPerClass+1; i++ {
+			require.Nil(t, tuner.Feedback(nil, class, "query", []strfmt.UUID{ids[9]}))
+		}
+		assert.Equal(t, maxFeedbackPerClass, tuner.log.lines[class],
+			"the log is rewritten once it holds twice the kept events")
+
+		restarted := newTuner(t, nil, dir, 1, true)
+		alpha, _, ok := restarted.Applied(class)
+		require.True(t, ok)
+		assert.InDelta(t, 0.55, alpha, 1e-9)
+
+		sugg, err := restarted.Suggest(ctx, nil, class)
+		require.Nil(t, err)
+		assert.Equal(t, maxFeedbackPerClass, sugg.Samples)
+		assert.Equal(t, 1.0, sugg.MRR)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		forbidden := errors.New("forbidden")
+		tuner, err := NewTuner(nil, &fakeAuthorizer{err: forbidden}, "", 1, false)
+		require.Nil(t, err)
+		tuner.RecordQuery(class, "query", 0.5, sparse, dense)
+
+		assert.Equal(t, forbidden, tuner.Feedback(nil, class, "query", []strfmt.UUID{ids[9]}))
+		_, err = tuner.Suggest(ctx, nil, class)
+		assert.Equal(t, forbidden, err)
+	})
+}
+
+func newTuner(t *testing.T, ranker SparseRanker, dir string, minFeedback int,
+	autoApply bool,
+) *Tuner {
+	tuner, err := NewTuner(ranker, &fakeAuthorizer{}, dir, minFeedback, autoApply)
+	require.Nil(t, err)
+	return tuner
+}
+
+type fakeAuthorizer struct {
+	err error
+}
+
+func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return f.err
+}
+
+type fakeSparseRanker struct {
+	best    schema.BM25Config
+	ranking []strfmt.UUID
+	other   []strfmt.UUID
+}
+
+func (f *fakeSparseRanker) HybridSparseRanking(ctx context.Context, className,
+	query string, bm25 schema.BM25Config, limit int,
+) ([]strfmt.UUID, error) {
+	if bm25.K1 == f.best.K1 && bm25.B == f.best.B {
+		return f.ranking, nil
+	}
+	return f.other, nil
+}
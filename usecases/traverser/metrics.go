@@ -12,6 +12,7 @@
 package traverser
 
 import (
+	"context"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -22,6 +23,7 @@ type Metrics struct {
 	queriesCount     *prometheus.GaugeVec
 	queriesDurations *prometheus.HistogramVec
 	dimensions       *prometheus.CounterVec
+	rejected         *prometheus.CounterVec
 }
 
 func NewMetrics(prom *monitoring.PrometheusMetrics) *Metrics {
@@ -33,6 +35,7 @@ func NewMetrics(prom *monitoring.PrometheusMetrics) *Metrics {
 		queriesCount:     prom.QueriesCount,
 		queriesDurations: prom.QueriesDurations,
 		dimensions:       prom.QueryDimensions,
+		rejected:         prom.QueryAdmissionRejected,
 	}
 }
 
@@ -69,17 +72,17 @@ func (m *Metrics) QueriesGetInc(className string) {
 	}).Inc()
 }
 
-func (m *Metrics) QueriesObserveDuration(className string, startMs int64) {
+func (m *Metrics) QueriesObserveDuration(ctx context.Context, className string, startMs int64) {
 	if m == nil {
 		return
 	}
 
 	took := float64(time.Now().UnixMilli() - startMs)
 
-	m.queriesDurations.With(prometheus.Labels{
+	monitoring.ObserveWithTraceID(ctx, m.queriesDurations.With(prometheus.Labels{
 		"class_name": className,
 		"query_type": "get_graphql",
-	}).Observe(float64(took))
+	}), took)
 }
 
 func (m *Metrics) QueriesGetDec(className string) {
@@ -104,3 +107,14 @@ func (m *Metrics) AddUsageDimensions(className, queryType, operation string, dim
 		"query_type": queryType,
 	}).Add(float64(dims))
 }
+
+func (m *Metrics) QueryAdmissionRejected(className, reason string) {
+	if m == nil {
+		return
+	}
+
+	m.rejected.With(prometheus.Labels{
+		"class_name": className,
+		"reason":     reason,
+	}).Inc()
+}
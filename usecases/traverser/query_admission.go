@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// queryAdmission limits the concurrent searches of each class. Searches
+// above the limit of their class wait in a bounded queue until a slot
+// becomes free, the queue is full or they time out. Classes don't share
+// slots, so expensive queries on one class don't delay the others.
+type queryAdmission struct {
+	cfg     config.QueryAdmission
+	metrics *Metrics
+
+	sync.Mutex
+	classes map[string]*classAdmission
+}
+
+type classAdmission struct {
+	slots  chan struct{}
+	queued int
+}
+
+func newQueryAdmission(cfg config.QueryAdmission, metrics *Metrics) *queryAdmission {
+	return &queryAdmission{
+		cfg:     cfg,
+		metrics: metrics,
+		classes: map[string]*classAdmission{},
+	}
+}
+
+// admit blocks until the search may run, the returned function must be
+// called once it is done
+func (a *queryAdmission) admit(ctx context.Context, className string) (func(), error) {
+	if a == nil {
+		return func() {}, nil
+	}
+	limit := a.cfg.Limit(className)
+	if limit <= 0 {
+		return func() {}, nil
+	}
+
+	a.Lock()
+	ca, ok := a.classes[className]
+	if !ok {
+		ca = &classAdmission{slots: make(chan struct{}, limit)}
+		a.classes[className] = ca
+	}
+
+	select {
+	case ca.slots <- struct{}{}:
+		a.Unlock()
+		return func() { <-ca.slots }, nil
+	default:
+	}
+
+	if ca.queued >= a.cfg.QueueSize {
+		a.Unlock()
+		a.metrics.QueryAdmissionRejected(className, "queue_full")
+		// see GetClass for why the status code is part of the message
+		return nil, fmt.Errorf("429 Too many requests: the admission queue of class %q is full",
+			className)
+	}
+	ca.queued++
+	a.Unlock()
+
+	defer func() {
+		a.Lock()
+		ca.queued--
+		a.Unlock()
+	}()
+
+	timer := time.NewTimer(time.Duration(a.cfg.QueueTimeoutSeconds) * time.Second)
+	defer timer.Stop()
+
+	select {
+	case ca.slots <- struct{}{}:
+		return func() { <-ca.slots }, nil
+	case <-timer.C:
+		a.metrics.QueryAdmissionRejected(className, "timeout")
+		return nil, fmt.Errorf("429 Too many requests: timed out waiting for admission "+
+			"of a search on class %q", className)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
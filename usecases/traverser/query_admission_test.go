@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestQueryAdmission(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.QueryAdmission{
+		ClassLimits:         map[string]int{"Expensive": 1},
+		QueueSize:           1,
+		QueueTimeoutSeconds: 1,
+	}
+
+	t.Run("classes without a limit are not limited", func(t *testing.T) {
+		a := newQueryAdmission(cfg, nil)
+		for i := 0; i < 10; i++ {
+			_, err := a.admit(ctx, "Cheap")
+			require.Nil(t, err)
+		}
+	})
+
+	t.Run("queued search runs once a slot is released", func(t *testing.T) {
+		a := newQueryAdmission(cfg, nil)
+		release, err := a.admit(ctx, "Expensive")
+		require.Nil(t, err)
+
+		admitted := make(chan error)
+		go func() {
+			_, err := a.admit(ctx, "Expensive")
+			admitted <- err
+		}()
+
+		select {
+		case <-admitted:
+			t.Fatal("search admitted above the limit")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		release()
+		assert.Nil(t, <-admitted)
+	})
+
+	t.Run("searches are rejected if the queue is full", func(t *testing.T) {
+		a := newQueryAdmission(cfg, nil)
+		_, err := a.admit(ctx, "Expensive")
+		require.Nil(t, err)
+
+		queued := make(chan error)
+		go func() {
+			_, err := a.admit(ctx, "Expensive")
+			queued <- err
+		}()
+		require.Eventually(t, func() bool {
+			a.Lock()
+			defer a.Unlock()
+			return a.classes["Expensive"].queued == 1
+		}, time.Second, time.Millisecond)
+
+		_, err = a.admit(ctx, "Expensive")
+		assert.ErrorContains(t, err, "queue of class \"Expensive\" is full")
+
+		// other classes are not affected
+		_, err = a.admit(ctx, "Cheap")
+		assert.Nil(t, err)
+
+		assert.ErrorContains(t, <-queued, "timed out")
+	})
+
+	t.Run("queued search stops waiting when its context is done", func(t *testing.T) {
+		a := newQueryAdmission(cfg, nil)
+		_, err := a.admit(ctx, "Expensive")
+		require.Nil(t, err)
+
+		cctx, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err = a.admit(cctx, "Expensive")
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -0,0 +1,164 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// WriteGenerations reports a value per class which changes with every write
+// to the class, see db.DB.ClassWriteGeneration
+type WriteGenerations interface {
+	ClassWriteGeneration(className string) uint64
+}
+
+// QueryCache holds the results of recent Get queries, so that identical
+// queries, e.g. from UI retries or pagination, don't hit the shards again.
+//
+// Entries are invalidated when the class is written to on this node. Writes
+// which are handled by other nodes of a cluster can't be observed, which is
+// why entries also expire after a short TTL.
+type QueryCache struct {
+	sync.Mutex
+	generations WriteGenerations
+	maxEntries  int
+	ttl         time.Duration
+
+	entries map[[sha256.Size]byte]*list.Element
+	lru     *list.List
+}
+
+type queryCacheEntry struct {
+	key        [sha256.Size]byte
+	generation uint64
+	expires    time.Time
+	result     []interface{}
+}
+
+func NewQueryCache(generations WriteGenerations, maxEntries int,
+	ttl time.Duration,
+) *QueryCache {
+	return &QueryCache{
+		generations: generations,
+		maxEntries:  maxEntries,
+		ttl:         ttl,
+		entries:     map[[sha256.Size]byte]*list.Element{},
+		lru:         list.New(),
+	}
+}
+
+// queryCacheKeyInput is the normalized form of a query. Results are
+// redacted per principal, so the principal is part of the key.
+type queryCacheKeyInput struct {
+	Principal        *models.Principal `json:"principal"`
+	Params           dto.GetParams     `json:"params"`
+	ConsistencyLevel string            `json:"consistencyLevel"`
+}
+
+// key returns false if the query can't be cached. This is the case if it
+// can't be serialized, or if it resolves references, as writes to the
+// referenced classes don't invalidate the entry.
+func (c *QueryCache) key(principal *models.Principal,
+	params dto.GetParams,
+) ([sha256.Size]byte, bool) {
+	if params.Properties.HasRefs() {
+		return [sha256.Size]byte{}, false
+	}
+
+	in := queryCacheKeyInput{Principal: principal, Params: params}
+	if params.Pagination == nil {
+		// the default of the explorer
+		in.Params.Pagination = &filters.Pagination{Limit: 100}
+	}
+	if repl := params.ReplicationProperties; repl != nil {
+		in.ConsistencyLevel = repl.ConsistencyLevel
+		in.Params.ReplicationProperties = nil
+	}
+
+	// maps are marshalled with sorted keys, so equal queries result in equal
+	// keys
+	bytes, err := json.Marshal(in)
+	if err != nil {
+		return [sha256.Size]byte{}, false
+	}
+	return sha256.Sum256(bytes), true
+}
+
+// Get returns the cached result of the query. If there is none, the returned
+// function stores the result once it has been computed.
+func (c *QueryCache) Get(principal *models.Principal,
+	params dto.GetParams,
+) ([]interface{}, func([]interface{}), bool) {
+	key, ok := c.key(principal, params)
+	if !ok {
+		return nil, func([]interface{}) {}, false
+	}
+
+	// the generation must be read before the query is executed, so that a
+	// write which completes during the query invalidates its result
+	generation := c.generations.ClassWriteGeneration(params.ClassName)
+	put := func(result []interface{}) {
+		c.put(key, generation, result)
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, put, false
+	}
+
+	entry := elem.Value.(*queryCacheEntry)
+	if entry.generation != generation || time.Now().After(entry.expires) {
+		c.remove(elem)
+		return nil, put, false
+	}
+
+	c.lru.MoveToFront(elem)
+	return entry.result, put, true
+}
+
+func (c *QueryCache) put(key [sha256.Size]byte, generation uint64,
+	result []interface{},
+) {
+	c.Lock()
+	defer c.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+
+	c.entries[key] = c.lru.PushFront(&queryCacheEntry{
+		key:        key,
+		generation: generation,
+		expires:    time.Now().Add(c.ttl),
+		result:     result,
+	})
+
+	for c.lru.Len() > c.maxEntries {
+		c.remove(c.lru.Back())
+	}
+}
+
+func (c *QueryCache) remove(elem *list.Element) {
+	c.lru.Remove(elem)
+	delete(c.entries, elem.Value.(*queryCacheEntry).key)
+}
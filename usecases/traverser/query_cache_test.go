@@ -0,0 +1,156 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func TestQueryCache(t *testing.T) {
+	query := func(q string) dto.GetParams {
+		return dto.GetParams{
+			ClassName:      "CachedClass",
+			KeywordRanking: &searchparams.KeywordRanking{Type: "bm25", Query: q},
+		}
+	}
+	result := []interface{}{map[string]interface{}{"name": "cached"}}
+
+	t.Run("identical queries are cached", func(t *testing.T) {
+		cache := NewQueryCache(&fakeWriteGenerations{}, 10, time.Minute)
+
+		_, put, ok := cache.Get(nil, query("foo"))
+		assert.False(t, ok)
+		put(result)
+
+		res, _, ok := cache.Get(nil, query("foo"))
+		assert.True(t, ok)
+		assert.Equal(t, result, res)
+
+		// the explorer applies the same default limit
+		withDefaultLimit := query("foo")
+		withDefaultLimit.Pagination = &filters.Pagination{Limit: 100}
+		_, _, ok = cache.Get(nil, withDefaultLimit)
+		assert.True(t, ok)
+
+		_, _, ok = cache.Get(nil, query("bar"))
+		assert.False(t, ok)
+	})
+
+	t.Run("principal and consistency level are part of the key", func(t *testing.T) {
+		cache := NewQueryCache(&fakeWriteGenerations{}, 10, time.Minute)
+
+		params := query("foo")
+		params.ReplicationProperties = &additional.ReplicationProperties{
+			ConsistencyLevel: "QUORUM",
+		}
+		_, put, _ := cache.Get(nil, params)
+		put(result)
+
+		_, _, ok := cache.Get(&models.Principal{Username: "other"}, params)
+		assert.False(t, ok)
+
+		_, _, ok = cache.Get(nil, query("foo"))
+		assert.False(t, ok)
+
+		_, _, ok = cache.Get(nil, params)
+		assert.True(t, ok)
+	})
+
+	t.Run("writes invalidate entries", func(t *testing.T) {
+		generations := &fakeWriteGenerations{}
+		cache := NewQueryCache(generations, 10, time.Minute)
+
+		_, put, _ := cache.Get(nil, query("foo"))
+		// the write completes while the query is running
+		generations.generation++
+		put(result)
+
+		_, put, ok := cache.Get(nil, query("foo"))
+		assert.False(t, ok)
+		put(result)
+
+		_, _, ok = cache.Get(nil, query("foo"))
+		assert.True(t, ok)
+	})
+
+	t.Run("queries resolving references are not cached", func(t *testing.T) {
+		cache := NewQueryCache(&fakeWriteGenerations{}, 10, time.Minute)
+
+		params := query("foo")
+		params.Properties = search.SelectProperties{
+			{Name: "name", IsPrimitive: true},
+			{Name: "hasAuthor", Refs: []search.SelectClass{{ClassName: "Author"}}},
+		}
+		_, put, _ := cache.Get(nil, params)
+		put(result)
+
+		// writes to the referenced class can't be observed
+		_, _, ok := cache.Get(nil, params)
+		assert.False(t, ok)
+
+		params.Properties = params.Properties[:1]
+		_, put, _ = cache.Get(nil, params)
+		put(result)
+
+		_, _, ok = cache.Get(nil, params)
+		assert.True(t, ok)
+	})
+
+	t.Run("entries expire", func(t *testing.T) {
+		cache := NewQueryCache(&fakeWriteGenerations{}, 10, time.Nanosecond)
+
+		_, put, _ := cache.Get(nil, query("foo"))
+		put(result)
+		time.Sleep(time.Millisecond)
+
+		_, _, ok := cache.Get(nil, query("foo"))
+		assert.False(t, ok)
+	})
+
+	t.Run("least recently used entries are evicted", func(t *testing.T) {
+		cache := NewQueryCache(&fakeWriteGenerations{}, 2, time.Minute)
+
+		for _, q := range []string{"a", "b"} {
+			_, put, _ := cache.Get(nil, query(q))
+			put(result)
+		}
+		_, _, ok := cache.Get(nil, query("a"))
+		assert.True(t, ok)
+
+		_, put, _ := cache.Get(nil, query("c"))
+		put(result)
+
+		_, _, ok = cache.Get(nil, query("b"))
+		assert.False(t, ok)
+		_, _, ok = cache.Get(nil, query("a"))
+		assert.True(t, ok)
+		_, _, ok = cache.Get(nil, query("c"))
+		assert.True(t, ok)
+	})
+}
+
+type fakeWriteGenerations struct {
+	generation uint64
+}
+
+func (f *fakeWriteGenerations) ClassWriteGeneration(className string) uint64 {
+	return f.generation
+}
@@ -13,6 +13,7 @@ package traverser
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus"
@@ -21,7 +22,9 @@ import (
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/querytimeout"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/ratelimiter"
 	"github.com/weaviate/weaviate/usecases/schema"
@@ -42,12 +45,15 @@ type Traverser struct {
 	locks            locks
 	logger           logrus.FieldLogger
 	authorizer       authorizer
+	redactor         *authorization.Redactor
 	vectorSearcher   VectorSearcher
 	explorer         explorer
 	schemaGetter     schema.SchemaGetter
 	nearParamsVector *nearParamsVector
 	metrics          *Metrics
 	ratelimiter      *ratelimiter.Limiter
+	queryCache       *QueryCache
+	admission        *queryAdmission
 }
 
 type VectorSearcher interface {
@@ -74,18 +80,46 @@ func NewTraverser(config *config.WeaviateConfig, locks locks,
 	modulesProvider ModulesProvider,
 	metrics *Metrics, maxGetRequests int,
 ) *Traverser {
+	var restricted []string
+	var queryCache *QueryCache
+	var admission *queryAdmission
+	if config != nil {
+		restricted = config.Config.Authorization.RestrictedProperties
+
+		// the write generations are provided by the db
+		generations, ok := vectorSearcher.(WriteGenerations)
+		if cacheCfg := config.Config.QueryCache; cacheCfg.Enabled && ok {
+			queryCache = NewQueryCache(generations, cacheCfg.MaxEntries,
+				time.Duration(cacheCfg.TTLSeconds)*time.Second)
+		}
+		admission = newQueryAdmission(config.Config.QueryAdmission, metrics)
+	}
+
 	return &Traverser{
 		config:           config,
 		locks:            locks,
 		logger:           logger,
 		authorizer:       authorizer,
+		redactor:         authorization.NewRedactor(authorizer, restricted),
 		vectorSearcher:   vectorSearcher,
 		explorer:         explorer,
 		schemaGetter:     schemaGetter,
 		nearParamsVector: newNearParamsVector(modulesProvider, vectorSearcher),
 		metrics:          metrics,
 		ratelimiter:      ratelimiter.New(maxGetRequests),
+		queryCache:       queryCache,
+		admission:        admission,
+	}
+}
+
+// withQueryTimeout bounds the duration of the query executed with the
+// returned context, see config.QueryTimeout
+func (t *Traverser) withQueryTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if t.config == nil {
+		return ctx, func() {}
 	}
+	cfg := t.config.Config.QueryTimeout
+	return querytimeout.NewContext(ctx, cfg.MaxDuration(), cfg.PartialResults)
 }
 
 // TraverserRepo describes the dependencies of the Traverser UC to the
@@ -31,12 +31,25 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
+	if err := t.authorizeAggregateRestricted(principal, params); err != nil {
+		return nil, err
+	}
+
+	release, err := t.admission.admit(ctx, params.ClassName.String())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	unlock, err := t.locks.LockConnector()
 	if err != nil {
 		return nil, fmt.Errorf("could not acquire lock: %v", err)
 	}
 	defer unlock()
 
+	ctx, cancel := t.withQueryTimeout(ctx)
+	defer cancel()
+
 	inspector := newTypeInspector(t.schemaGetter)
 
 	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 {
@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/functionscore"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// authorizeGetRestricted rejects Get queries which would reveal restricted
+// properties the principal may not read, even though they are redacted from
+// the results: filtering, sorting, searching or scoring by a property tells
+// which objects have which values. Keyword and hybrid searches without
+// properties rank by all of them. Modules which add additional properties,
+// such as generative prompts, receive the objects before they are redacted.
+func (t *Traverser) authorizeGetRestricted(principal *models.Principal,
+	params dto.GetParams,
+) error {
+	if err := t.redactor.AuthorizeFilter(principal, params.Filters); err != nil {
+		return err
+	}
+	if !t.redactor.Restricted(params.ClassName) {
+		return nil
+	}
+
+	if params.HybridSearch != nil || len(params.AdditionalProperties.ModuleParams) > 0 ||
+		(params.KeywordRanking != nil && len(params.KeywordRanking.Properties) == 0) {
+		return t.redactor.AuthorizeClass(principal, params.ClassName)
+	}
+
+	var props []string
+	for _, sort := range params.Sort {
+		if len(sort.Path) > 0 {
+			props = append(props, sort.Path[0])
+		}
+	}
+	if params.KeywordRanking != nil {
+		for _, prop := range params.KeywordRanking.Properties {
+			// properties may be boosted, e.g. "title^2"
+			name, _, _ := strings.Cut(prop, "^")
+			props = append(props, name)
+		}
+	}
+	if params.FunctionScore != nil {
+		// invalid expressions are rejected by the explorer
+		if expr, err := functionscore.Parse(params.FunctionScore.Expression); err == nil {
+			props = append(props, expr.Properties()...)
+		}
+	}
+	return t.redactor.AuthorizeProperties(principal, params.ClassName, props...)
+}
+
+// authorizeAggregateRestricted rejects aggregations of restricted properties
+// the principal may not read, or which filter or group by them
+func (t *Traverser) authorizeAggregateRestricted(principal *models.Principal,
+	params *aggregation.Params,
+) error {
+	if err := t.redactor.AuthorizeFilter(principal, params.Filters); err != nil {
+		return err
+	}
+	for path := params.GroupBy; path != nil; path = path.Child {
+		err := t.redactor.AuthorizeProperties(principal, path.Class.String(),
+			path.Property.String())
+		if err != nil {
+			return err
+		}
+	}
+	className := params.ClassName.String()
+	if !t.redactor.Restricted(className) {
+		return nil
+	}
+
+	if params.Hybrid != nil {
+		return t.redactor.AuthorizeClass(principal, className)
+	}
+
+	props := make([]string, 0, len(params.Properties))
+	for _, prop := range params.Properties {
+		props = append(props, prop.Name.String())
+	}
+	return t.redactor.AuthorizeProperties(principal, className, props...)
+}
@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/adminlist"
+)
+
+func TestAuthorizeRestricted(t *testing.T) {
+	authorizer := adminlist.New(adminlist.Config{
+		Enabled:       true,
+		Users:         []string{"admin"},
+		ReadOnlyUsers: []string{"reader"},
+	})
+	traverser := &Traverser{
+		redactor: authorization.NewRedactor(authorizer, []string{"Person.email"}),
+	}
+	admin := &models.Principal{Username: "admin"}
+	reader := &models.Principal{Username: "reader"}
+
+	where := func(prop string) *filters.LocalFilter {
+		return &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: "Person", Property: schema.PropertyName(prop)},
+		}}
+	}
+
+	t.Run("get", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			params  dto.GetParams
+			allowed bool
+		}{
+			{"unrestricted filter", dto.GetParams{Filters: where("name")}, true},
+			{"restricted filter", dto.GetParams{Filters: where("email")}, false},
+			{"unrestricted sort", dto.GetParams{Sort: []filters.Sort{{Path: []string{"name"}}}}, true},
+			{"restricted sort", dto.GetParams{Sort: []filters.Sort{{Path: []string{"email"}}}}, false},
+			{
+				"bm25 on unrestricted properties",
+				dto.GetParams{KeywordRanking: &searchparams.KeywordRanking{Properties: []string{"name^2"}}},
+				true,
+			},
+			{
+				"bm25 on restricted properties",
+				dto.GetParams{KeywordRanking: &searchparams.KeywordRanking{Properties: []string{"email^2"}}},
+				false,
+			},
+			{"bm25 on all properties", dto.GetParams{KeywordRanking: &searchparams.KeywordRanking{}}, false},
+			{"hybrid", dto.GetParams{HybridSearch: &searchparams.HybridSearch{}}, false},
+			{
+				"function score",
+				dto.GetParams{FunctionScore: &searchparams.FunctionScore{Expression: "_score * email"}},
+				false,
+			},
+			{
+				"generative module",
+				dto.GetParams{AdditionalProperties: additional.Properties{
+					ModuleParams: map[string]interface{}{"generate": nil},
+				}},
+				false,
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				test.params.ClassName = "Person"
+				assert.Nil(t, traverser.authorizeGetRestricted(admin, test.params))
+
+				err := traverser.authorizeGetRestricted(reader, test.params)
+				if test.allowed {
+					assert.Nil(t, err)
+				} else {
+					assert.NotNil(t, err)
+				}
+			})
+		}
+
+		t.Run("other classes", func(t *testing.T) {
+			err := traverser.authorizeGetRestricted(reader, dto.GetParams{
+				ClassName:    "Company",
+				HybridSearch: &searchparams.HybridSearch{},
+			})
+			assert.Nil(t, err)
+		})
+	})
+
+	t.Run("aggregate", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			params  aggregation.Params
+			allowed bool
+		}{
+			{"unrestricted property", aggregation.Params{
+				Properties: []aggregation.ParamProperty{{Name: "name"}},
+			}, true},
+			{"restricted property", aggregation.Params{
+				Properties: []aggregation.ParamProperty{{Name: "email"}},
+			}, false},
+			{"restricted filter", aggregation.Params{Filters: where("email")}, false},
+			{"restricted group", aggregation.Params{
+				GroupBy: &filters.Path{Class: "Person", Property: "email"},
+			}, false},
+			{"hybrid", aggregation.Params{Hybrid: &searchparams.HybridSearch{}}, false},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				test.params.ClassName = "Person"
+				assert.Nil(t, traverser.authorizeAggregateRestricted(admin, &test.params))
+
+				err := traverser.authorizeAggregateRestricted(reader, &test.params)
+				if test.allowed {
+					assert.Nil(t, err)
+				} else {
+					assert.NotNil(t, err)
+				}
+			})
+		}
+	})
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/querytimeout"
 )
 
 func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
@@ -37,19 +38,32 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 
 	t.metrics.QueriesGetInc(params.ClassName)
 	defer t.metrics.QueriesGetDec(params.ClassName)
-	defer t.metrics.QueriesObserveDuration(params.ClassName, before.UnixMilli())
+	defer t.metrics.QueriesObserveDuration(ctx, params.ClassName, before.UnixMilli())
 
 	err := t.authorizer.Authorize(principal, "get", "traversal/*")
 	if err != nil {
 		return nil, err
 	}
 
+	if err := t.authorizeGetRestricted(principal, params); err != nil {
+		return nil, err
+	}
+
+	release, err := t.admission.admit(ctx, params.ClassName)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	unlock, err := t.locks.LockConnector()
 	if err != nil {
 		return nil, fmt.Errorf("could not acquire lock: %v", err)
 	}
 	defer unlock()
 
+	ctx, cancel := t.withQueryTimeout(ctx)
+	defer cancel()
+
 	certainty := ExtractCertaintyFromParams(params)
 	if certainty != 0 || params.AdditionalProperties.Certainty {
 		// if certainty is provided as input, we must ensure
@@ -60,5 +74,26 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 		}
 	}
 
-	return t.explorer.GetClass(ctx, params)
+	var cachePut func([]interface{})
+	if t.queryCache != nil {
+		cached, put, ok := t.queryCache.Get(principal, params)
+		if ok {
+			return cached, nil
+		}
+		cachePut = put
+	}
+
+	res, err := t.explorer.GetClass(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	t.redactor.RedactGetResults(principal, params.ClassName, res)
+
+	// results of a query stopped at its partial deadline may be incomplete
+	if cachePut != nil && !querytimeout.Partial(ctx) {
+		cachePut(res)
+	}
+
+	return res, nil
 }
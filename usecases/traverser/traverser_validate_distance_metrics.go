@@ -19,6 +19,8 @@ import (
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
@@ -58,14 +60,14 @@ func (t *Traverser) validateCrossClassDistanceCompatibility() (distType string,
 			continue
 		}
 
-		hnswConfig, assertErr := typeAssertVectorIndex(class)
+		distance, assertErr := vectorIndexDistance(class)
 		if assertErr != nil {
 			err = assertErr
 			return
 		}
 
-		distancerTypes[hnswConfig.Distance] = struct{}{}
-		classDistanceConfigs[class.Class] = hnswConfig.Distance
+		distancerTypes[distance] = struct{}{}
+		classDistanceConfigs[class.Class] = distance
 	}
 
 	if len(distancerTypes) != 1 {
@@ -103,26 +105,32 @@ func (t *Traverser) validateGetDistanceParams(params dto.GetParams) error {
 		return fmt.Errorf("failed to find class '%s' in schema", params.ClassName)
 	}
 
-	hnswConfig, err := typeAssertVectorIndex(class)
+	distance, err := vectorIndexDistance(class)
 	if err != nil {
 		return err
 	}
 
-	if hnswConfig.Distance != hnsw.DistanceCosine {
-		return certaintyUnsupportedError(hnswConfig.Distance)
+	if distance != hnsw.DistanceCosine {
+		return certaintyUnsupportedError(distance)
 	}
 
 	return nil
 }
 
-func typeAssertVectorIndex(class *models.Class) (hnsw.UserConfig, error) {
-	hnswConfig, ok := class.VectorIndexConfig.(hnsw.UserConfig)
-	if !ok {
-		return hnsw.UserConfig{}, fmt.Errorf("class '%s' vector index: config is not hnsw.UserConfig: %T",
+// vectorIndexDistance returns the distance metric of the vector index of the
+// class, regardless of the type of the index
+func vectorIndexDistance(class *models.Class) (string, error) {
+	switch vectorIndexConfig := class.VectorIndexConfig.(type) {
+	case hnsw.UserConfig:
+		return vectorIndexConfig.Distance, nil
+	case flat.UserConfig:
+		return vectorIndexConfig.Distance, nil
+	case dynamic.UserConfig:
+		return vectorIndexConfig.Distance, nil
+	default:
+		return "", fmt.Errorf("class '%s' vector index: config is neither hnsw.UserConfig, flat.UserConfig nor dynamic.UserConfig: %T",
 			class.Class, class.VectorIndexConfig)
 	}
-
-	return hnswConfig, nil
 }
 
 func crossClassDistCompatError(classDistanceConfigs map[string]string) error {
@@ -0,0 +1,40 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+// bytesPerToken is a conservative estimate of the bytes per token of
+// typical inference APIs, so that batches rather stay below their limits
+const bytesPerToken = 3
+
+// EstimateTokens returns an estimate of the number of tokens of the text
+func EstimateTokens(text string) int {
+	return len(text)/bytesPerToken + 1
+}
+
+// Batches splits the texts into consecutive batches of at most maxTexts
+// texts and at most maxTokens estimated tokens and returns the index of the
+// first text of every batch. A text which exceeds maxTokens on its own is
+// put into a batch of its own.
+func Batches(texts []string, maxTexts, maxTokens int) []int {
+	var starts []int
+	count, tokens := 0, 0
+	for i, text := range texts {
+		t := EstimateTokens(text)
+		if i == 0 || count == maxTexts || tokens+t > maxTokens {
+			starts = append(starts, i)
+			count, tokens = 0, 0
+		}
+		count++
+		tokens += t
+	}
+	return starts
+}
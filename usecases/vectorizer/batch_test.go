@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatches(t *testing.T) {
+	short := "short"                    // 2 tokens
+	long := strings.Repeat("a", 30)     // 11 tokens
+	tooLong := strings.Repeat("a", 300) // 101 tokens
+
+	tests := []struct {
+		name      string
+		texts     []string
+		maxTexts  int
+		maxTokens int
+		expected  []int
+	}{
+		{
+			name:      "no texts",
+			texts:     nil,
+			maxTexts:  2,
+			maxTokens: 100,
+			expected:  nil,
+		},
+		{
+			name:      "limited by the number of texts",
+			texts:     []string{short, short, short, short, short},
+			maxTexts:  2,
+			maxTokens: 100,
+			expected:  []int{0, 2, 4},
+		},
+		{
+			name:      "limited by the number of tokens",
+			texts:     []string{long, long, short, long},
+			maxTexts:  10,
+			maxTokens: 24,
+			expected:  []int{0, 3},
+		},
+		{
+			name:      "text exceeding the token limit",
+			texts:     []string{short, tooLong, short},
+			maxTexts:  10,
+			maxTokens: 100,
+			expected:  []int{0, 1, 2},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected,
+				Batches(test.texts, test.maxTexts, test.maxTokens))
+		})
+	}
+}
@@ -0,0 +1,310 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/fatih/camelcase"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+var templatePlaceholder = regexp.MustCompile(`\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// InputRules control how the text of an object is assembled before it is
+// sent to a text vectorizer. They are set in the class-level moduleConfig:
+//
+//	"text2vec-openai": {
+//	  "propertyOrder": ["title", "body"],
+//	  "template": "Title: {title}. {body}",
+//	  "skipEmpty": true,
+//	  "lowercase": false,
+//	  "stripWhitespace": true
+//	}
+//
+// The text modules return them from the InputRules method of their class
+// settings. Invalid rules are rejected when the class is validated, the
+// default rules apply to requests without a class config, such as
+// Explore{}.
+type InputRules struct {
+	// PropertyOrder lists the properties which are placed first, in the
+	// given order. All other properties follow in alphabetical order.
+	PropertyOrder []string
+	// Template replaces the concatenation of all properties. Placeholders of
+	// the form {propName} are replaced with the values of the property, the
+	// remaining text is kept as is.
+	Template string
+	// SkipEmpty omits properties whose value is empty or only whitespace
+	SkipEmpty bool
+	// Lowercase the assembled text, enabled by default
+	Lowercase bool
+	// StripWhitespace trims the text and collapses all runs of whitespace
+	StripWhitespace bool
+}
+
+// InputSettings are the per-property settings which the text vectorizers
+// already support
+type InputSettings interface {
+	PropertyIndexed(property string) bool
+	VectorizePropertyName(propertyName string) bool
+	VectorizeClassName() bool
+}
+
+func DefaultInputRules() InputRules {
+	return InputRules{Lowercase: true}
+}
+
+// InputRulesFromConfig parses the rules from the class-level module config,
+// a nil config results in the default rules
+func InputRulesFromConfig(cfg map[string]interface{}) (InputRules, error) {
+	rules := DefaultInputRules()
+	if cfg == nil {
+		return rules, nil
+	}
+
+	if v, ok := cfg["propertyOrder"]; ok {
+		order, err := stringSlice(v)
+		if err != nil {
+			return rules, errors.Wrap(err, "propertyOrder")
+		}
+		rules.PropertyOrder = order
+	}
+
+	if v, ok := cfg["template"]; ok {
+		asString, ok := v.(string)
+		if !ok {
+			return rules, errors.Errorf("template must be a string, got %T", v)
+		}
+		rules.Template = asString
+	}
+
+	for name, target := range map[string]*bool{
+		"skipEmpty":       &rules.SkipEmpty,
+		"lowercase":       &rules.Lowercase,
+		"stripWhitespace": &rules.StripWhitespace,
+	} {
+		v, ok := cfg[name]
+		if !ok {
+			continue
+		}
+		asBool, ok := v.(bool)
+		if !ok {
+			return rules, errors.Errorf("%s must be a boolean, got %T", name, v)
+		}
+		*target = asBool
+	}
+
+	return rules, nil
+}
+
+// Validate that all properties referenced by the rules exist on the class
+func (r InputRules) Validate(class *models.Class) error {
+	exists := map[string]bool{}
+	for _, prop := range class.Properties {
+		exists[prop.Name] = true
+	}
+
+	seen := map[string]bool{}
+	for _, prop := range r.PropertyOrder {
+		if !exists[prop] {
+			return errors.Errorf("propertyOrder: property %q does not exist on class %q",
+				prop, class.Class)
+		}
+		if seen[prop] {
+			return errors.Errorf("propertyOrder: property %q is listed twice", prop)
+		}
+		seen[prop] = true
+	}
+
+	for _, match := range templatePlaceholder.FindAllStringSubmatch(r.Template, -1) {
+		if !exists[match[1]] {
+			return errors.Errorf("template: property %q does not exist on class %q",
+				match[1], class.Class)
+		}
+	}
+
+	return nil
+}
+
+// Build assembles the text of an object. It also returns the properties
+// which contributed to the text, so callers can decide whether a change of
+// the object requires a new vector.
+func (r InputRules) Build(className string, props map[string]interface{},
+	settings InputSettings,
+) (string, []string) {
+	var text string
+	var used []string
+	if r.Template != "" {
+		text, used = r.render(props, settings)
+	} else {
+		text, used = r.concat(className, props, settings)
+	}
+
+	if r.Lowercase {
+		text = strings.ToLower(text)
+	}
+	if r.StripWhitespace {
+		text = strings.Join(strings.Fields(text), " ")
+	}
+
+	if strings.TrimSpace(text) == "" {
+		// fall back to using the class name
+		text = camelCaseToLower(className)
+	}
+
+	return text, used
+}
+
+func (r InputRules) concat(className string, props map[string]interface{},
+	settings InputSettings,
+) (string, []string) {
+	var corpi []string
+	if settings.VectorizeClassName() {
+		corpi = append(corpi, camelCaseToLower(className))
+	}
+
+	var used []string
+	for _, prop := range r.orderedProps(props) {
+		if !settings.PropertyIndexed(prop) {
+			continue
+		}
+
+		appended := false
+		for _, value := range r.textValues(props[prop]) {
+			if settings.VectorizePropertyName(prop) {
+				value = fmt.Sprintf("%s %s", camelCaseToLower(prop), value)
+			}
+			corpi = append(corpi, value)
+			appended = true
+		}
+		if appended {
+			used = append(used, prop)
+		}
+	}
+
+	return strings.Join(corpi, " "), used
+}
+
+func (r InputRules) render(props map[string]interface{},
+	settings InputSettings,
+) (string, []string) {
+	var used []string
+	text := templatePlaceholder.ReplaceAllStringFunc(r.Template, func(match string) string {
+		prop := match[1 : len(match)-1]
+		if !settings.PropertyIndexed(prop) {
+			return ""
+		}
+
+		values := r.textValues(props[prop])
+		if len(values) > 0 {
+			used = append(used, prop)
+		}
+		return strings.Join(values, " ")
+	})
+
+	return text, used
+}
+
+// orderedProps returns the properties of the object, those listed in
+// PropertyOrder first and all others sorted alphabetically
+func (r InputRules) orderedProps(props map[string]interface{}) []string {
+	out := make([]string, 0, len(props))
+	listed := map[string]bool{}
+	for _, prop := range r.PropertyOrder {
+		listed[prop] = true
+		if _, ok := props[prop]; ok {
+			out = append(out, prop)
+		}
+	}
+
+	rest := make([]string, 0, len(props))
+	for prop := range props {
+		if !listed[prop] {
+			rest = append(rest, prop)
+		}
+	}
+	sort.Strings(rest)
+
+	return append(out, rest...)
+}
+
+// textValues returns the text values of a property, non-text values are
+// ignored
+func (r InputRules) textValues(value interface{}) []string {
+	var values []string
+	add := func(v interface{}) {
+		s, ok := v.(string)
+		if !ok {
+			return
+		}
+		if r.SkipEmpty && strings.TrimSpace(s) == "" {
+			return
+		}
+		values = append(values, s)
+	}
+
+	switch val := value.(type) {
+	case []string:
+		for _, elem := range val {
+			add(elem)
+		}
+	case []interface{}:
+		for _, elem := range val {
+			add(elem)
+		}
+	default:
+		add(val)
+	}
+
+	return values
+}
+
+func stringSlice(in interface{}) ([]string, error) {
+	switch v := in.(type) {
+	case []string:
+		return v, nil
+	case []interface{}:
+		out := make([]string, len(v))
+		for i, elem := range v {
+			asString, ok := elem.(string)
+			if !ok {
+				return nil, errors.Errorf("expected a list of strings, got %T", elem)
+			}
+			out[i] = asString
+		}
+		return out, nil
+	default:
+		return nil, errors.Errorf("expected a list of strings, got %T", in)
+	}
+}
+
+func camelCaseToLower(in string) string {
+	parts := camelcase.Split(in)
+	var sb strings.Builder
+	for i, part := range parts {
+		if part == " " {
+			continue
+		}
+
+		if i > 0 {
+			sb.WriteString(" ")
+		}
+
+		sb.WriteString(strings.ToLower(part))
+	}
+
+	return sb.String()
+}
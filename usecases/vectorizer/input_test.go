@@ -0,0 +1,179 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type fakeInputSettings struct {
+	skipped            string
+	withPropertyName   string
+	vectorizeClassName bool
+}
+
+func (f fakeInputSettings) PropertyIndexed(prop string) bool {
+	return prop != f.skipped
+}
+
+func (f fakeInputSettings) VectorizePropertyName(prop string) bool {
+	return prop == f.withPropertyName
+}
+
+func (f fakeInputSettings) VectorizeClassName() bool {
+	return f.vectorizeClassName
+}
+
+func TestInputRules_Build(t *testing.T) {
+	props := map[string]interface{}{
+		"title":   "The Best  Car",
+		"body":    "Drives FAST",
+		"empty":   "",
+		"tags":    []interface{}{"red", " ", "cabrio"},
+		"mileage": 12000.0,
+	}
+
+	type test struct {
+		name         string
+		rules        InputRules
+		settings     fakeInputSettings
+		expected     string
+		expectedUsed []string
+	}
+
+	tests := []test{
+		{
+			name:         "defaults",
+			rules:        DefaultInputRules(),
+			settings:     fakeInputSettings{vectorizeClassName: true},
+			expected:     "used car drives fast  red   cabrio the best  car",
+			expectedUsed: []string{"body", "empty", "tags", "title"},
+		},
+		{
+			name:         "property names and skipped properties",
+			rules:        DefaultInputRules(),
+			settings:     fakeInputSettings{skipped: "tags", withPropertyName: "body"},
+			expected:     "body drives fast  the best  car",
+			expectedUsed: []string{"body", "empty", "title"},
+		},
+		{
+			name:         "property order",
+			rules:        InputRules{PropertyOrder: []string{"title", "tags"}, Lowercase: true},
+			settings:     fakeInputSettings{},
+			expected:     "the best  car red   cabrio drives fast ",
+			expectedUsed: []string{"title", "tags", "body", "empty"},
+		},
+		{
+			name: "skip empty and strip whitespace",
+			rules: InputRules{
+				PropertyOrder:   []string{"title"},
+				SkipEmpty:       true,
+				StripWhitespace: true,
+			},
+			settings:     fakeInputSettings{},
+			expected:     "The Best Car Drives FAST red cabrio",
+			expectedUsed: []string{"title", "body", "tags"},
+		},
+		{
+			name: "template",
+			rules: InputRules{
+				Template:        "Title: {title}.\nBody: {body} {empty}",
+				StripWhitespace: true,
+			},
+			settings:     fakeInputSettings{},
+			expected:     "Title: The Best Car. Body: Drives FAST",
+			expectedUsed: []string{"title", "body", "empty"},
+		},
+		{
+			name:         "template with skipped property",
+			rules:        InputRules{Template: "{title} / {body}", Lowercase: true},
+			settings:     fakeInputSettings{skipped: "body"},
+			expected:     "the best  car / ",
+			expectedUsed: []string{"title"},
+		},
+		{
+			name:         "fall back to the class name",
+			rules:        InputRules{Template: "{empty}", SkipEmpty: true},
+			settings:     fakeInputSettings{},
+			expected:     "used car",
+			expectedUsed: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			text, used := test.rules.Build("UsedCar", props, test.settings)
+			assert.Equal(t, test.expected, text)
+			assert.Equal(t, test.expectedUsed, used)
+		})
+	}
+}
+
+func TestInputRulesFromConfig(t *testing.T) {
+	t.Run("nil config", func(t *testing.T) {
+		rules, err := InputRulesFromConfig(nil)
+		require.Nil(t, err)
+		assert.Equal(t, DefaultInputRules(), rules)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		rules, err := InputRulesFromConfig(map[string]interface{}{
+			"propertyOrder":   []interface{}{"title", "body"},
+			"template":        "{title}: {body}",
+			"skipEmpty":       true,
+			"lowercase":       false,
+			"stripWhitespace": true,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, InputRules{
+			PropertyOrder:   []string{"title", "body"},
+			Template:        "{title}: {body}",
+			SkipEmpty:       true,
+			Lowercase:       false,
+			StripWhitespace: true,
+		}, rules)
+	})
+
+	t.Run("invalid types", func(t *testing.T) {
+		for _, cfg := range []map[string]interface{}{
+			{"propertyOrder": "title"},
+			{"propertyOrder": []interface{}{"title", 7}},
+			{"template": true},
+			{"skipEmpty": "yes"},
+		} {
+			_, err := InputRulesFromConfig(cfg)
+			assert.NotNil(t, err)
+		}
+	})
+}
+
+func TestInputRules_Validate(t *testing.T) {
+	class := &models.Class{
+		Class: "UsedCar",
+		Properties: []*models.Property{
+			{Name: "title"},
+			{Name: "body"},
+		},
+	}
+
+	assert.Nil(t, InputRules{
+		PropertyOrder: []string{"body"},
+		Template:      "{title}: {body}",
+	}.Validate(class))
+	assert.NotNil(t, InputRules{PropertyOrder: []string{"price"}}.Validate(class))
+	assert.NotNil(t, InputRules{PropertyOrder: []string{"body", "body"}}.Validate(class))
+	assert.NotNil(t, InputRules{Template: "{title} {price}"}.Validate(class))
+}